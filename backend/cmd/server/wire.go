@@ -97,7 +97,12 @@ func provideCleanup(
 	scheduledTestRunner *service.ScheduledTestRunnerService,
 	backupSvc *service.BackupService,
 	paymentOrderExpiry *service.PaymentOrderExpiryService,
+	postpaidBilling *service.PostpaidBillingService,
+	billingRecompute *service.BillingRecomputeService,
+	balanceBucketExpiry *service.BalanceBucketExpiryService,
 	channelMonitorRunner *service.ChannelMonitorRunner,
+	proxyPool *service.ProxyPoolService,
+	accountCircuitBreaker *service.AccountCircuitBreaker,
 ) func() {
 	return func() {
 		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
@@ -152,6 +157,18 @@ func provideCleanup(
 				}
 				return nil
 			}},
+			{"ProxyPoolService", func() error {
+				if proxyPool != nil {
+					proxyPool.Stop()
+				}
+				return nil
+			}},
+			{"AccountCircuitBreaker", func() error {
+				if accountCircuitBreaker != nil {
+					accountCircuitBreaker.Stop()
+				}
+				return nil
+			}},
 			{"UsageCleanupService", func() error {
 				if usageCleanup != nil {
 					usageCleanup.Stop()
@@ -240,6 +257,24 @@ func provideCleanup(
 				}
 				return nil
 			}},
+			{"PostpaidBillingService", func() error {
+				if postpaidBilling != nil {
+					postpaidBilling.Stop()
+				}
+				return nil
+			}},
+			{"BillingRecomputeService", func() error {
+				if billingRecompute != nil {
+					billingRecompute.Stop()
+				}
+				return nil
+			}},
+			{"BalanceBucketExpiryService", func() error {
+				if balanceBucketExpiry != nil {
+					balanceBucketExpiry.Stop()
+				}
+				return nil
+			}},
 			{"ChannelMonitorRunner", func() error {
 				if channelMonitorRunner != nil {
 					channelMonitorRunner.Stop()
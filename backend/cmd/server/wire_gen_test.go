@@ -76,7 +76,12 @@ func TestProvideCleanup_WithMinimalDependencies_NoPanic(t *testing.T) {
 		nil, // scheduledTestRunner
 		nil, // backupSvc
 		nil, // paymentOrderExpiry
+		nil, // postpaidBilling
+		nil, // billingRecompute
+		nil, // balanceBucketExpiry
 		nil, // channelMonitorRunner
+		nil, // proxyPool
+		nil, // accountCircuitBreaker
 	)
 
 	require.NotPanics(t, func() {
@@ -35,6 +35,8 @@ const (
 	FieldExtra = "extra"
 	// FieldProxyID holds the string denoting the proxy_id field in the database.
 	FieldProxyID = "proxy_id"
+	// FieldProxyPoolID holds the string denoting the proxy_pool_id field in the database.
+	FieldProxyPoolID = "proxy_pool_id"
 	// FieldConcurrency holds the string denoting the concurrency field in the database.
 	FieldConcurrency = "concurrency"
 	// FieldLoadFactor holds the string denoting the load_factor field in the database.
@@ -71,6 +73,8 @@ const (
 	FieldSessionWindowEnd = "session_window_end"
 	// FieldSessionWindowStatus holds the string denoting the session_window_status field in the database.
 	FieldSessionWindowStatus = "session_window_status"
+	// FieldAvailabilitySchedule holds the string denoting the availability_schedule field in the database.
+	FieldAvailabilitySchedule = "availability_schedule"
 	// EdgeGroups holds the string denoting the groups edge name in mutations.
 	EdgeGroups = "groups"
 	// EdgeProxy holds the string denoting the proxy edge name in mutations.
@@ -122,6 +126,7 @@ var Columns = []string{
 	FieldCredentials,
 	FieldExtra,
 	FieldProxyID,
+	FieldProxyPoolID,
 	FieldConcurrency,
 	FieldLoadFactor,
 	FieldPriority,
@@ -140,6 +145,7 @@ var Columns = []string{
 	FieldSessionWindowStart,
 	FieldSessionWindowEnd,
 	FieldSessionWindowStatus,
+	FieldAvailabilitySchedule,
 }
 
 var (
@@ -198,6 +204,8 @@ var (
 	DefaultSchedulable bool
 	// SessionWindowStatusValidator is a validator for the "session_window_status" field. It is called by the builders before save.
 	SessionWindowStatusValidator func(string) error
+	// AvailabilityScheduleValidator is a validator for the "availability_schedule" field. It is called by the builders before save.
+	AvailabilityScheduleValidator func(string) error
 )
 
 // OrderOption defines the ordering options for the Account queries.
@@ -248,6 +256,11 @@ func ByProxyID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldProxyID, opts...).ToFunc()
 }
 
+// ByProxyPoolID orders the results by the proxy_pool_id field.
+func ByProxyPoolID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProxyPoolID, opts...).ToFunc()
+}
+
 // ByConcurrency orders the results by the concurrency field.
 func ByConcurrency(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldConcurrency, opts...).ToFunc()
@@ -338,6 +351,11 @@ func BySessionWindowStatus(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSessionWindowStatus, opts...).ToFunc()
 }
 
+// ByAvailabilitySchedule orders the results by the availability_schedule field.
+func ByAvailabilitySchedule(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAvailabilitySchedule, opts...).ToFunc()
+}
+
 // ByGroupsCount orders the results by groups count.
 func ByGroupsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -95,6 +95,11 @@ func ProxyID(v int64) predicate.Account {
 	return predicate.Account(sql.FieldEQ(FieldProxyID, v))
 }
 
+// ProxyPoolID applies equality check predicate on the "proxy_pool_id" field. It's identical to ProxyPoolIDEQ.
+func ProxyPoolID(v int64) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldProxyPoolID, v))
+}
+
 // Concurrency applies equality check predicate on the "concurrency" field. It's identical to ConcurrencyEQ.
 func Concurrency(v int) predicate.Account {
 	return predicate.Account(sql.FieldEQ(FieldConcurrency, v))
@@ -185,6 +190,11 @@ func SessionWindowStatus(v string) predicate.Account {
 	return predicate.Account(sql.FieldEQ(FieldSessionWindowStatus, v))
 }
 
+// AvailabilitySchedule applies equality check predicate on the "availability_schedule" field. It's identical to AvailabilityScheduleEQ.
+func AvailabilitySchedule(v string) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldAvailabilitySchedule, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Account {
 	return predicate.Account(sql.FieldEQ(FieldCreatedAt, v))
@@ -615,6 +625,56 @@ func ProxyIDNotNil() predicate.Account {
 	return predicate.Account(sql.FieldNotNull(FieldProxyID))
 }
 
+// ProxyPoolIDEQ applies the EQ predicate on the "proxy_pool_id" field.
+func ProxyPoolIDEQ(v int64) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldProxyPoolID, v))
+}
+
+// ProxyPoolIDNEQ applies the NEQ predicate on the "proxy_pool_id" field.
+func ProxyPoolIDNEQ(v int64) predicate.Account {
+	return predicate.Account(sql.FieldNEQ(FieldProxyPoolID, v))
+}
+
+// ProxyPoolIDIn applies the In predicate on the "proxy_pool_id" field.
+func ProxyPoolIDIn(vs ...int64) predicate.Account {
+	return predicate.Account(sql.FieldIn(FieldProxyPoolID, vs...))
+}
+
+// ProxyPoolIDNotIn applies the NotIn predicate on the "proxy_pool_id" field.
+func ProxyPoolIDNotIn(vs ...int64) predicate.Account {
+	return predicate.Account(sql.FieldNotIn(FieldProxyPoolID, vs...))
+}
+
+// ProxyPoolIDGT applies the GT predicate on the "proxy_pool_id" field.
+func ProxyPoolIDGT(v int64) predicate.Account {
+	return predicate.Account(sql.FieldGT(FieldProxyPoolID, v))
+}
+
+// ProxyPoolIDGTE applies the GTE predicate on the "proxy_pool_id" field.
+func ProxyPoolIDGTE(v int64) predicate.Account {
+	return predicate.Account(sql.FieldGTE(FieldProxyPoolID, v))
+}
+
+// ProxyPoolIDLT applies the LT predicate on the "proxy_pool_id" field.
+func ProxyPoolIDLT(v int64) predicate.Account {
+	return predicate.Account(sql.FieldLT(FieldProxyPoolID, v))
+}
+
+// ProxyPoolIDLTE applies the LTE predicate on the "proxy_pool_id" field.
+func ProxyPoolIDLTE(v int64) predicate.Account {
+	return predicate.Account(sql.FieldLTE(FieldProxyPoolID, v))
+}
+
+// ProxyPoolIDIsNil applies the IsNil predicate on the "proxy_pool_id" field.
+func ProxyPoolIDIsNil() predicate.Account {
+	return predicate.Account(sql.FieldIsNull(FieldProxyPoolID))
+}
+
+// ProxyPoolIDNotNil applies the NotNil predicate on the "proxy_pool_id" field.
+func ProxyPoolIDNotNil() predicate.Account {
+	return predicate.Account(sql.FieldNotNull(FieldProxyPoolID))
+}
+
 // ConcurrencyEQ applies the EQ predicate on the "concurrency" field.
 func ConcurrencyEQ(v int) predicate.Account {
 	return predicate.Account(sql.FieldEQ(FieldConcurrency, v))
@@ -1495,6 +1555,81 @@ func SessionWindowStatusContainsFold(v string) predicate.Account {
 	return predicate.Account(sql.FieldContainsFold(FieldSessionWindowStatus, v))
 }
 
+// AvailabilityScheduleEQ applies the EQ predicate on the "availability_schedule" field.
+func AvailabilityScheduleEQ(v string) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldAvailabilitySchedule, v))
+}
+
+// AvailabilityScheduleNEQ applies the NEQ predicate on the "availability_schedule" field.
+func AvailabilityScheduleNEQ(v string) predicate.Account {
+	return predicate.Account(sql.FieldNEQ(FieldAvailabilitySchedule, v))
+}
+
+// AvailabilityScheduleIn applies the In predicate on the "availability_schedule" field.
+func AvailabilityScheduleIn(vs ...string) predicate.Account {
+	return predicate.Account(sql.FieldIn(FieldAvailabilitySchedule, vs...))
+}
+
+// AvailabilityScheduleNotIn applies the NotIn predicate on the "availability_schedule" field.
+func AvailabilityScheduleNotIn(vs ...string) predicate.Account {
+	return predicate.Account(sql.FieldNotIn(FieldAvailabilitySchedule, vs...))
+}
+
+// AvailabilityScheduleGT applies the GT predicate on the "availability_schedule" field.
+func AvailabilityScheduleGT(v string) predicate.Account {
+	return predicate.Account(sql.FieldGT(FieldAvailabilitySchedule, v))
+}
+
+// AvailabilityScheduleGTE applies the GTE predicate on the "availability_schedule" field.
+func AvailabilityScheduleGTE(v string) predicate.Account {
+	return predicate.Account(sql.FieldGTE(FieldAvailabilitySchedule, v))
+}
+
+// AvailabilityScheduleLT applies the LT predicate on the "availability_schedule" field.
+func AvailabilityScheduleLT(v string) predicate.Account {
+	return predicate.Account(sql.FieldLT(FieldAvailabilitySchedule, v))
+}
+
+// AvailabilityScheduleLTE applies the LTE predicate on the "availability_schedule" field.
+func AvailabilityScheduleLTE(v string) predicate.Account {
+	return predicate.Account(sql.FieldLTE(FieldAvailabilitySchedule, v))
+}
+
+// AvailabilityScheduleContains applies the Contains predicate on the "availability_schedule" field.
+func AvailabilityScheduleContains(v string) predicate.Account {
+	return predicate.Account(sql.FieldContains(FieldAvailabilitySchedule, v))
+}
+
+// AvailabilityScheduleHasPrefix applies the HasPrefix predicate on the "availability_schedule" field.
+func AvailabilityScheduleHasPrefix(v string) predicate.Account {
+	return predicate.Account(sql.FieldHasPrefix(FieldAvailabilitySchedule, v))
+}
+
+// AvailabilityScheduleHasSuffix applies the HasSuffix predicate on the "availability_schedule" field.
+func AvailabilityScheduleHasSuffix(v string) predicate.Account {
+	return predicate.Account(sql.FieldHasSuffix(FieldAvailabilitySchedule, v))
+}
+
+// AvailabilityScheduleIsNil applies the IsNil predicate on the "availability_schedule" field.
+func AvailabilityScheduleIsNil() predicate.Account {
+	return predicate.Account(sql.FieldIsNull(FieldAvailabilitySchedule))
+}
+
+// AvailabilityScheduleNotNil applies the NotNil predicate on the "availability_schedule" field.
+func AvailabilityScheduleNotNil() predicate.Account {
+	return predicate.Account(sql.FieldNotNull(FieldAvailabilitySchedule))
+}
+
+// AvailabilityScheduleEqualFold applies the EqualFold predicate on the "availability_schedule" field.
+func AvailabilityScheduleEqualFold(v string) predicate.Account {
+	return predicate.Account(sql.FieldEqualFold(FieldAvailabilitySchedule, v))
+}
+
+// AvailabilityScheduleContainsFold applies the ContainsFold predicate on the "availability_schedule" field.
+func AvailabilityScheduleContainsFold(v string) predicate.Account {
+	return predicate.Account(sql.FieldContainsFold(FieldAvailabilitySchedule, v))
+}
+
 // HasGroups applies the HasEdge predicate on the "groups" edge.
 func HasGroups() predicate.Account {
 	return predicate.Account(func(s *sql.Selector) {
@@ -125,6 +125,20 @@ func (_c *AccountCreate) SetNillableProxyID(v *int64) *AccountCreate {
 	return _c
 }
 
+// SetProxyPoolID sets the "proxy_pool_id" field.
+func (_c *AccountCreate) SetProxyPoolID(v int64) *AccountCreate {
+	_c.mutation.SetProxyPoolID(v)
+	return _c
+}
+
+// SetNillableProxyPoolID sets the "proxy_pool_id" field if the given value is not nil.
+func (_c *AccountCreate) SetNillableProxyPoolID(v *int64) *AccountCreate {
+	if v != nil {
+		_c.SetProxyPoolID(*v)
+	}
+	return _c
+}
+
 // SetConcurrency sets the "concurrency" field.
 func (_c *AccountCreate) SetConcurrency(v int) *AccountCreate {
 	_c.mutation.SetConcurrency(v)
@@ -377,6 +391,20 @@ func (_c *AccountCreate) SetNillableSessionWindowStatus(v *string) *AccountCreat
 	return _c
 }
 
+// SetAvailabilitySchedule sets the "availability_schedule" field.
+func (_c *AccountCreate) SetAvailabilitySchedule(v string) *AccountCreate {
+	_c.mutation.SetAvailabilitySchedule(v)
+	return _c
+}
+
+// SetNillableAvailabilitySchedule sets the "availability_schedule" field if the given value is not nil.
+func (_c *AccountCreate) SetNillableAvailabilitySchedule(v *string) *AccountCreate {
+	if v != nil {
+		_c.SetAvailabilitySchedule(*v)
+	}
+	return _c
+}
+
 // AddGroupIDs adds the "groups" edge to the Group entity by IDs.
 func (_c *AccountCreate) AddGroupIDs(ids ...int64) *AccountCreate {
 	_c.mutation.AddGroupIDs(ids...)
@@ -570,6 +598,11 @@ func (_c *AccountCreate) check() error {
 			return &ValidationError{Name: "session_window_status", err: fmt.Errorf(`ent: validator failed for field "Account.session_window_status": %w`, err)}
 		}
 	}
+	if v, ok := _c.mutation.AvailabilitySchedule(); ok {
+		if err := account.AvailabilityScheduleValidator(v); err != nil {
+			return &ValidationError{Name: "availability_schedule", err: fmt.Errorf(`ent: validator failed for field "Account.availability_schedule": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -633,6 +666,10 @@ func (_c *AccountCreate) createSpec() (*Account, *sqlgraph.CreateSpec) {
 		_spec.SetField(account.FieldExtra, field.TypeJSON, value)
 		_node.Extra = value
 	}
+	if value, ok := _c.mutation.ProxyPoolID(); ok {
+		_spec.SetField(account.FieldProxyPoolID, field.TypeInt64, value)
+		_node.ProxyPoolID = &value
+	}
 	if value, ok := _c.mutation.Concurrency(); ok {
 		_spec.SetField(account.FieldConcurrency, field.TypeInt, value)
 		_node.Concurrency = value
@@ -705,6 +742,10 @@ func (_c *AccountCreate) createSpec() (*Account, *sqlgraph.CreateSpec) {
 		_spec.SetField(account.FieldSessionWindowStatus, field.TypeString, value)
 		_node.SessionWindowStatus = &value
 	}
+	if value, ok := _c.mutation.AvailabilitySchedule(); ok {
+		_spec.SetField(account.FieldAvailabilitySchedule, field.TypeString, value)
+		_node.AvailabilitySchedule = &value
+	}
 	if nodes := _c.mutation.GroupsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -936,6 +977,30 @@ func (u *AccountUpsert) ClearProxyID() *AccountUpsert {
 	return u
 }
 
+// SetProxyPoolID sets the "proxy_pool_id" field.
+func (u *AccountUpsert) SetProxyPoolID(v int64) *AccountUpsert {
+	u.Set(account.FieldProxyPoolID, v)
+	return u
+}
+
+// UpdateProxyPoolID sets the "proxy_pool_id" field to the value that was provided on create.
+func (u *AccountUpsert) UpdateProxyPoolID() *AccountUpsert {
+	u.SetExcluded(account.FieldProxyPoolID)
+	return u
+}
+
+// AddProxyPoolID adds v to the "proxy_pool_id" field.
+func (u *AccountUpsert) AddProxyPoolID(v int64) *AccountUpsert {
+	u.Add(account.FieldProxyPoolID, v)
+	return u
+}
+
+// ClearProxyPoolID clears the value of the "proxy_pool_id" field.
+func (u *AccountUpsert) ClearProxyPoolID() *AccountUpsert {
+	u.SetNull(account.FieldProxyPoolID)
+	return u
+}
+
 // SetConcurrency sets the "concurrency" field.
 func (u *AccountUpsert) SetConcurrency(v int) *AccountUpsert {
 	u.Set(account.FieldConcurrency, v)
@@ -1248,6 +1313,24 @@ func (u *AccountUpsert) ClearSessionWindowStatus() *AccountUpsert {
 	return u
 }
 
+// SetAvailabilitySchedule sets the "availability_schedule" field.
+func (u *AccountUpsert) SetAvailabilitySchedule(v string) *AccountUpsert {
+	u.Set(account.FieldAvailabilitySchedule, v)
+	return u
+}
+
+// UpdateAvailabilitySchedule sets the "availability_schedule" field to the value that was provided on create.
+func (u *AccountUpsert) UpdateAvailabilitySchedule() *AccountUpsert {
+	u.SetExcluded(account.FieldAvailabilitySchedule)
+	return u
+}
+
+// ClearAvailabilitySchedule clears the value of the "availability_schedule" field.
+func (u *AccountUpsert) ClearAvailabilitySchedule() *AccountUpsert {
+	u.SetNull(account.FieldAvailabilitySchedule)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -1440,6 +1523,34 @@ func (u *AccountUpsertOne) ClearProxyID() *AccountUpsertOne {
 	})
 }
 
+// SetProxyPoolID sets the "proxy_pool_id" field.
+func (u *AccountUpsertOne) SetProxyPoolID(v int64) *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.SetProxyPoolID(v)
+	})
+}
+
+// AddProxyPoolID adds v to the "proxy_pool_id" field.
+func (u *AccountUpsertOne) AddProxyPoolID(v int64) *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.AddProxyPoolID(v)
+	})
+}
+
+// UpdateProxyPoolID sets the "proxy_pool_id" field to the value that was provided on create.
+func (u *AccountUpsertOne) UpdateProxyPoolID() *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.UpdateProxyPoolID()
+	})
+}
+
+// ClearProxyPoolID clears the value of the "proxy_pool_id" field.
+func (u *AccountUpsertOne) ClearProxyPoolID() *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.ClearProxyPoolID()
+	})
+}
+
 // SetConcurrency sets the "concurrency" field.
 func (u *AccountUpsertOne) SetConcurrency(v int) *AccountUpsertOne {
 	return u.Update(func(s *AccountUpsert) {
@@ -1804,6 +1915,27 @@ func (u *AccountUpsertOne) ClearSessionWindowStatus() *AccountUpsertOne {
 	})
 }
 
+// SetAvailabilitySchedule sets the "availability_schedule" field.
+func (u *AccountUpsertOne) SetAvailabilitySchedule(v string) *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.SetAvailabilitySchedule(v)
+	})
+}
+
+// UpdateAvailabilitySchedule sets the "availability_schedule" field to the value that was provided on create.
+func (u *AccountUpsertOne) UpdateAvailabilitySchedule() *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.UpdateAvailabilitySchedule()
+	})
+}
+
+// ClearAvailabilitySchedule clears the value of the "availability_schedule" field.
+func (u *AccountUpsertOne) ClearAvailabilitySchedule() *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.ClearAvailabilitySchedule()
+	})
+}
+
 // Exec executes the query.
 func (u *AccountUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -2162,6 +2294,34 @@ func (u *AccountUpsertBulk) ClearProxyID() *AccountUpsertBulk {
 	})
 }
 
+// SetProxyPoolID sets the "proxy_pool_id" field.
+func (u *AccountUpsertBulk) SetProxyPoolID(v int64) *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.SetProxyPoolID(v)
+	})
+}
+
+// AddProxyPoolID adds v to the "proxy_pool_id" field.
+func (u *AccountUpsertBulk) AddProxyPoolID(v int64) *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.AddProxyPoolID(v)
+	})
+}
+
+// UpdateProxyPoolID sets the "proxy_pool_id" field to the value that was provided on create.
+func (u *AccountUpsertBulk) UpdateProxyPoolID() *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.UpdateProxyPoolID()
+	})
+}
+
+// ClearProxyPoolID clears the value of the "proxy_pool_id" field.
+func (u *AccountUpsertBulk) ClearProxyPoolID() *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.ClearProxyPoolID()
+	})
+}
+
 // SetConcurrency sets the "concurrency" field.
 func (u *AccountUpsertBulk) SetConcurrency(v int) *AccountUpsertBulk {
 	return u.Update(func(s *AccountUpsert) {
@@ -2526,6 +2686,27 @@ func (u *AccountUpsertBulk) ClearSessionWindowStatus() *AccountUpsertBulk {
 	})
 }
 
+// SetAvailabilitySchedule sets the "availability_schedule" field.
+func (u *AccountUpsertBulk) SetAvailabilitySchedule(v string) *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.SetAvailabilitySchedule(v)
+	})
+}
+
+// UpdateAvailabilitySchedule sets the "availability_schedule" field to the value that was provided on create.
+func (u *AccountUpsertBulk) UpdateAvailabilitySchedule() *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.UpdateAvailabilitySchedule()
+	})
+}
+
+// ClearAvailabilitySchedule clears the value of the "availability_schedule" field.
+func (u *AccountUpsertBulk) ClearAvailabilitySchedule() *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.ClearAvailabilitySchedule()
+	})
+}
+
 // Exec executes the query.
 func (u *AccountUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
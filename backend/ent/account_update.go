@@ -151,6 +151,33 @@ func (_u *AccountUpdate) ClearProxyID() *AccountUpdate {
 	return _u
 }
 
+// SetProxyPoolID sets the "proxy_pool_id" field.
+func (_u *AccountUpdate) SetProxyPoolID(v int64) *AccountUpdate {
+	_u.mutation.ResetProxyPoolID()
+	_u.mutation.SetProxyPoolID(v)
+	return _u
+}
+
+// SetNillableProxyPoolID sets the "proxy_pool_id" field if the given value is not nil.
+func (_u *AccountUpdate) SetNillableProxyPoolID(v *int64) *AccountUpdate {
+	if v != nil {
+		_u.SetProxyPoolID(*v)
+	}
+	return _u
+}
+
+// AddProxyPoolID adds value to the "proxy_pool_id" field.
+func (_u *AccountUpdate) AddProxyPoolID(v int64) *AccountUpdate {
+	_u.mutation.AddProxyPoolID(v)
+	return _u
+}
+
+// ClearProxyPoolID clears the value of the "proxy_pool_id" field.
+func (_u *AccountUpdate) ClearProxyPoolID() *AccountUpdate {
+	_u.mutation.ClearProxyPoolID()
+	return _u
+}
+
 // SetConcurrency sets the "concurrency" field.
 func (_u *AccountUpdate) SetConcurrency(v int) *AccountUpdate {
 	_u.mutation.ResetConcurrency()
@@ -503,6 +530,26 @@ func (_u *AccountUpdate) ClearSessionWindowStatus() *AccountUpdate {
 	return _u
 }
 
+// SetAvailabilitySchedule sets the "availability_schedule" field.
+func (_u *AccountUpdate) SetAvailabilitySchedule(v string) *AccountUpdate {
+	_u.mutation.SetAvailabilitySchedule(v)
+	return _u
+}
+
+// SetNillableAvailabilitySchedule sets the "availability_schedule" field if the given value is not nil.
+func (_u *AccountUpdate) SetNillableAvailabilitySchedule(v *string) *AccountUpdate {
+	if v != nil {
+		_u.SetAvailabilitySchedule(*v)
+	}
+	return _u
+}
+
+// ClearAvailabilitySchedule clears the value of the "availability_schedule" field.
+func (_u *AccountUpdate) ClearAvailabilitySchedule() *AccountUpdate {
+	_u.mutation.ClearAvailabilitySchedule()
+	return _u
+}
+
 // AddGroupIDs adds the "groups" edge to the Group entity by IDs.
 func (_u *AccountUpdate) AddGroupIDs(ids ...int64) *AccountUpdate {
 	_u.mutation.AddGroupIDs(ids...)
@@ -660,6 +707,11 @@ func (_u *AccountUpdate) check() error {
 			return &ValidationError{Name: "session_window_status", err: fmt.Errorf(`ent: validator failed for field "Account.session_window_status": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.AvailabilitySchedule(); ok {
+		if err := account.AvailabilityScheduleValidator(v); err != nil {
+			return &ValidationError{Name: "availability_schedule", err: fmt.Errorf(`ent: validator failed for field "Account.availability_schedule": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -705,6 +757,15 @@ func (_u *AccountUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Extra(); ok {
 		_spec.SetField(account.FieldExtra, field.TypeJSON, value)
 	}
+	if value, ok := _u.mutation.ProxyPoolID(); ok {
+		_spec.SetField(account.FieldProxyPoolID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedProxyPoolID(); ok {
+		_spec.AddField(account.FieldProxyPoolID, field.TypeInt64, value)
+	}
+	if _u.mutation.ProxyPoolIDCleared() {
+		_spec.ClearField(account.FieldProxyPoolID, field.TypeInt64)
+	}
 	if value, ok := _u.mutation.Concurrency(); ok {
 		_spec.SetField(account.FieldConcurrency, field.TypeInt, value)
 	}
@@ -807,6 +868,12 @@ func (_u *AccountUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.SessionWindowStatusCleared() {
 		_spec.ClearField(account.FieldSessionWindowStatus, field.TypeString)
 	}
+	if value, ok := _u.mutation.AvailabilitySchedule(); ok {
+		_spec.SetField(account.FieldAvailabilitySchedule, field.TypeString, value)
+	}
+	if _u.mutation.AvailabilityScheduleCleared() {
+		_spec.ClearField(account.FieldAvailabilitySchedule, field.TypeString)
+	}
 	if _u.mutation.GroupsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -1078,6 +1145,33 @@ func (_u *AccountUpdateOne) ClearProxyID() *AccountUpdateOne {
 	return _u
 }
 
+// SetProxyPoolID sets the "proxy_pool_id" field.
+func (_u *AccountUpdateOne) SetProxyPoolID(v int64) *AccountUpdateOne {
+	_u.mutation.ResetProxyPoolID()
+	_u.mutation.SetProxyPoolID(v)
+	return _u
+}
+
+// SetNillableProxyPoolID sets the "proxy_pool_id" field if the given value is not nil.
+func (_u *AccountUpdateOne) SetNillableProxyPoolID(v *int64) *AccountUpdateOne {
+	if v != nil {
+		_u.SetProxyPoolID(*v)
+	}
+	return _u
+}
+
+// AddProxyPoolID adds value to the "proxy_pool_id" field.
+func (_u *AccountUpdateOne) AddProxyPoolID(v int64) *AccountUpdateOne {
+	_u.mutation.AddProxyPoolID(v)
+	return _u
+}
+
+// ClearProxyPoolID clears the value of the "proxy_pool_id" field.
+func (_u *AccountUpdateOne) ClearProxyPoolID() *AccountUpdateOne {
+	_u.mutation.ClearProxyPoolID()
+	return _u
+}
+
 // SetConcurrency sets the "concurrency" field.
 func (_u *AccountUpdateOne) SetConcurrency(v int) *AccountUpdateOne {
 	_u.mutation.ResetConcurrency()
@@ -1430,6 +1524,26 @@ func (_u *AccountUpdateOne) ClearSessionWindowStatus() *AccountUpdateOne {
 	return _u
 }
 
+// SetAvailabilitySchedule sets the "availability_schedule" field.
+func (_u *AccountUpdateOne) SetAvailabilitySchedule(v string) *AccountUpdateOne {
+	_u.mutation.SetAvailabilitySchedule(v)
+	return _u
+}
+
+// SetNillableAvailabilitySchedule sets the "availability_schedule" field if the given value is not nil.
+func (_u *AccountUpdateOne) SetNillableAvailabilitySchedule(v *string) *AccountUpdateOne {
+	if v != nil {
+		_u.SetAvailabilitySchedule(*v)
+	}
+	return _u
+}
+
+// ClearAvailabilitySchedule clears the value of the "availability_schedule" field.
+func (_u *AccountUpdateOne) ClearAvailabilitySchedule() *AccountUpdateOne {
+	_u.mutation.ClearAvailabilitySchedule()
+	return _u
+}
+
 // AddGroupIDs adds the "groups" edge to the Group entity by IDs.
 func (_u *AccountUpdateOne) AddGroupIDs(ids ...int64) *AccountUpdateOne {
 	_u.mutation.AddGroupIDs(ids...)
@@ -1600,6 +1714,11 @@ func (_u *AccountUpdateOne) check() error {
 			return &ValidationError{Name: "session_window_status", err: fmt.Errorf(`ent: validator failed for field "Account.session_window_status": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.AvailabilitySchedule(); ok {
+		if err := account.AvailabilityScheduleValidator(v); err != nil {
+			return &ValidationError{Name: "availability_schedule", err: fmt.Errorf(`ent: validator failed for field "Account.availability_schedule": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -1662,6 +1781,15 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 	if value, ok := _u.mutation.Extra(); ok {
 		_spec.SetField(account.FieldExtra, field.TypeJSON, value)
 	}
+	if value, ok := _u.mutation.ProxyPoolID(); ok {
+		_spec.SetField(account.FieldProxyPoolID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedProxyPoolID(); ok {
+		_spec.AddField(account.FieldProxyPoolID, field.TypeInt64, value)
+	}
+	if _u.mutation.ProxyPoolIDCleared() {
+		_spec.ClearField(account.FieldProxyPoolID, field.TypeInt64)
+	}
 	if value, ok := _u.mutation.Concurrency(); ok {
 		_spec.SetField(account.FieldConcurrency, field.TypeInt, value)
 	}
@@ -1764,6 +1892,12 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 	if _u.mutation.SessionWindowStatusCleared() {
 		_spec.ClearField(account.FieldSessionWindowStatus, field.TypeString)
 	}
+	if value, ok := _u.mutation.AvailabilitySchedule(); ok {
+		_spec.SetField(account.FieldAvailabilitySchedule, field.TypeString, value)
+	}
+	if _u.mutation.AvailabilityScheduleCleared() {
+		_spec.ClearField(account.FieldAvailabilitySchedule, field.TypeString)
+	}
 	if _u.mutation.GroupsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -61,6 +61,8 @@ const (
 	FieldWindow1dStart = "window_1d_start"
 	// FieldWindow7dStart holds the string denoting the window_7d_start field in the database.
 	FieldWindow7dStart = "window_7d_start"
+	// FieldPriorityClass holds the string denoting the priority_class field in the database.
+	FieldPriorityClass = "priority_class"
 	// EdgeUser holds the string denoting the user edge name in mutations.
 	EdgeUser = "user"
 	// EdgeGroup holds the string denoting the group edge name in mutations.
@@ -118,6 +120,7 @@ var Columns = []string{
 	FieldWindow5hStart,
 	FieldWindow1dStart,
 	FieldWindow7dStart,
+	FieldPriorityClass,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -168,6 +171,10 @@ var (
 	DefaultUsage1d float64
 	// DefaultUsage7d holds the default value on creation for the "usage_7d" field.
 	DefaultUsage7d float64
+	// DefaultPriorityClass holds the default value on creation for the "priority_class" field.
+	DefaultPriorityClass string
+	// PriorityClassValidator is a validator for the "priority_class" field. It is called by the builders before save.
+	PriorityClassValidator func(string) error
 )
 
 // OrderOption defines the ordering options for the APIKey queries.
@@ -283,6 +290,11 @@ func ByWindow7dStart(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldWindow7dStart, opts...).ToFunc()
 }
 
+// ByPriorityClass orders the results by the priority_class field.
+func ByPriorityClass(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPriorityClass, opts...).ToFunc()
+}
+
 // ByUserField orders the results by user field.
 func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
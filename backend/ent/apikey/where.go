@@ -160,6 +160,11 @@ func Window7dStart(v time.Time) predicate.APIKey {
 	return predicate.APIKey(sql.FieldEQ(FieldWindow7dStart, v))
 }
 
+// PriorityClass applies equality check predicate on the "priority_class" field. It's identical to PriorityClassEQ.
+func PriorityClass(v string) predicate.APIKey {
+	return predicate.APIKey(sql.FieldEQ(FieldPriorityClass, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.APIKey {
 	return predicate.APIKey(sql.FieldEQ(FieldCreatedAt, v))
@@ -1125,6 +1130,71 @@ func Window7dStartNotNil() predicate.APIKey {
 	return predicate.APIKey(sql.FieldNotNull(FieldWindow7dStart))
 }
 
+// PriorityClassEQ applies the EQ predicate on the "priority_class" field.
+func PriorityClassEQ(v string) predicate.APIKey {
+	return predicate.APIKey(sql.FieldEQ(FieldPriorityClass, v))
+}
+
+// PriorityClassNEQ applies the NEQ predicate on the "priority_class" field.
+func PriorityClassNEQ(v string) predicate.APIKey {
+	return predicate.APIKey(sql.FieldNEQ(FieldPriorityClass, v))
+}
+
+// PriorityClassIn applies the In predicate on the "priority_class" field.
+func PriorityClassIn(vs ...string) predicate.APIKey {
+	return predicate.APIKey(sql.FieldIn(FieldPriorityClass, vs...))
+}
+
+// PriorityClassNotIn applies the NotIn predicate on the "priority_class" field.
+func PriorityClassNotIn(vs ...string) predicate.APIKey {
+	return predicate.APIKey(sql.FieldNotIn(FieldPriorityClass, vs...))
+}
+
+// PriorityClassGT applies the GT predicate on the "priority_class" field.
+func PriorityClassGT(v string) predicate.APIKey {
+	return predicate.APIKey(sql.FieldGT(FieldPriorityClass, v))
+}
+
+// PriorityClassGTE applies the GTE predicate on the "priority_class" field.
+func PriorityClassGTE(v string) predicate.APIKey {
+	return predicate.APIKey(sql.FieldGTE(FieldPriorityClass, v))
+}
+
+// PriorityClassLT applies the LT predicate on the "priority_class" field.
+func PriorityClassLT(v string) predicate.APIKey {
+	return predicate.APIKey(sql.FieldLT(FieldPriorityClass, v))
+}
+
+// PriorityClassLTE applies the LTE predicate on the "priority_class" field.
+func PriorityClassLTE(v string) predicate.APIKey {
+	return predicate.APIKey(sql.FieldLTE(FieldPriorityClass, v))
+}
+
+// PriorityClassContains applies the Contains predicate on the "priority_class" field.
+func PriorityClassContains(v string) predicate.APIKey {
+	return predicate.APIKey(sql.FieldContains(FieldPriorityClass, v))
+}
+
+// PriorityClassHasPrefix applies the HasPrefix predicate on the "priority_class" field.
+func PriorityClassHasPrefix(v string) predicate.APIKey {
+	return predicate.APIKey(sql.FieldHasPrefix(FieldPriorityClass, v))
+}
+
+// PriorityClassHasSuffix applies the HasSuffix predicate on the "priority_class" field.
+func PriorityClassHasSuffix(v string) predicate.APIKey {
+	return predicate.APIKey(sql.FieldHasSuffix(FieldPriorityClass, v))
+}
+
+// PriorityClassEqualFold applies the EqualFold predicate on the "priority_class" field.
+func PriorityClassEqualFold(v string) predicate.APIKey {
+	return predicate.APIKey(sql.FieldEqualFold(FieldPriorityClass, v))
+}
+
+// PriorityClassContainsFold applies the ContainsFold predicate on the "priority_class" field.
+func PriorityClassContainsFold(v string) predicate.APIKey {
+	return predicate.APIKey(sql.FieldContainsFold(FieldPriorityClass, v))
+}
+
 // HasUser applies the HasEdge predicate on the "user" edge.
 func HasUser() predicate.APIKey {
 	return predicate.APIKey(func(s *sql.Selector) {
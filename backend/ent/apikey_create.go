@@ -307,6 +307,20 @@ func (_c *APIKeyCreate) SetNillableWindow7dStart(v *time.Time) *APIKeyCreate {
 	return _c
 }
 
+// SetPriorityClass sets the "priority_class" field.
+func (_c *APIKeyCreate) SetPriorityClass(v string) *APIKeyCreate {
+	_c.mutation.SetPriorityClass(v)
+	return _c
+}
+
+// SetNillablePriorityClass sets the "priority_class" field if the given value is not nil.
+func (_c *APIKeyCreate) SetNillablePriorityClass(v *string) *APIKeyCreate {
+	if v != nil {
+		_c.SetPriorityClass(*v)
+	}
+	return _c
+}
+
 // SetUser sets the "user" edge to the User entity.
 func (_c *APIKeyCreate) SetUser(v *User) *APIKeyCreate {
 	return _c.SetUserID(v.ID)
@@ -419,6 +433,10 @@ func (_c *APIKeyCreate) defaults() error {
 		v := apikey.DefaultUsage7d
 		_c.mutation.SetUsage7d(v)
 	}
+	if _, ok := _c.mutation.PriorityClass(); !ok {
+		v := apikey.DefaultPriorityClass
+		_c.mutation.SetPriorityClass(v)
+	}
 	return nil
 }
 
@@ -481,6 +499,14 @@ func (_c *APIKeyCreate) check() error {
 	if _, ok := _c.mutation.Usage7d(); !ok {
 		return &ValidationError{Name: "usage_7d", err: errors.New(`ent: missing required field "APIKey.usage_7d"`)}
 	}
+	if _, ok := _c.mutation.PriorityClass(); !ok {
+		return &ValidationError{Name: "priority_class", err: errors.New(`ent: missing required field "APIKey.priority_class"`)}
+	}
+	if v, ok := _c.mutation.PriorityClass(); ok {
+		if err := apikey.PriorityClassValidator(v); err != nil {
+			return &ValidationError{Name: "priority_class", err: fmt.Errorf(`ent: validator failed for field "APIKey.priority_class": %w`, err)}
+		}
+	}
 	if len(_c.mutation.UserIDs()) == 0 {
 		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "APIKey.user"`)}
 	}
@@ -595,6 +621,10 @@ func (_c *APIKeyCreate) createSpec() (*APIKey, *sqlgraph.CreateSpec) {
 		_spec.SetField(apikey.FieldWindow7dStart, field.TypeTime, value)
 		_node.Window7dStart = &value
 	}
+	if value, ok := _c.mutation.PriorityClass(); ok {
+		_spec.SetField(apikey.FieldPriorityClass, field.TypeString, value)
+		_node.PriorityClass = value
+	}
 	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -1063,6 +1093,18 @@ func (u *APIKeyUpsert) ClearWindow7dStart() *APIKeyUpsert {
 	return u
 }
 
+// SetPriorityClass sets the "priority_class" field.
+func (u *APIKeyUpsert) SetPriorityClass(v string) *APIKeyUpsert {
+	u.Set(apikey.FieldPriorityClass, v)
+	return u
+}
+
+// UpdatePriorityClass sets the "priority_class" field to the value that was provided on create.
+func (u *APIKeyUpsert) UpdatePriorityClass() *APIKeyUpsert {
+	u.SetExcluded(apikey.FieldPriorityClass)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -1535,6 +1577,20 @@ func (u *APIKeyUpsertOne) ClearWindow7dStart() *APIKeyUpsertOne {
 	})
 }
 
+// SetPriorityClass sets the "priority_class" field.
+func (u *APIKeyUpsertOne) SetPriorityClass(v string) *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.SetPriorityClass(v)
+	})
+}
+
+// UpdatePriorityClass sets the "priority_class" field to the value that was provided on create.
+func (u *APIKeyUpsertOne) UpdatePriorityClass() *APIKeyUpsertOne {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.UpdatePriorityClass()
+	})
+}
+
 // Exec executes the query.
 func (u *APIKeyUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -2173,6 +2229,20 @@ func (u *APIKeyUpsertBulk) ClearWindow7dStart() *APIKeyUpsertBulk {
 	})
 }
 
+// SetPriorityClass sets the "priority_class" field.
+func (u *APIKeyUpsertBulk) SetPriorityClass(v string) *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.SetPriorityClass(v)
+	})
+}
+
+// UpdatePriorityClass sets the "priority_class" field to the value that was provided on create.
+func (u *APIKeyUpsertBulk) UpdatePriorityClass() *APIKeyUpsertBulk {
+	return u.Update(func(s *APIKeyUpsert) {
+		s.UpdatePriorityClass()
+	})
+}
+
 // Exec executes the query.
 func (u *APIKeyUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -438,6 +438,20 @@ func (_u *APIKeyUpdate) ClearWindow7dStart() *APIKeyUpdate {
 	return _u
 }
 
+// SetPriorityClass sets the "priority_class" field.
+func (_u *APIKeyUpdate) SetPriorityClass(v string) *APIKeyUpdate {
+	_u.mutation.SetPriorityClass(v)
+	return _u
+}
+
+// SetNillablePriorityClass sets the "priority_class" field if the given value is not nil.
+func (_u *APIKeyUpdate) SetNillablePriorityClass(v *string) *APIKeyUpdate {
+	if v != nil {
+		_u.SetPriorityClass(*v)
+	}
+	return _u
+}
+
 // SetUser sets the "user" edge to the User entity.
 func (_u *APIKeyUpdate) SetUser(v *User) *APIKeyUpdate {
 	return _u.SetUserID(v.ID)
@@ -560,6 +574,11 @@ func (_u *APIKeyUpdate) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "APIKey.status": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.PriorityClass(); ok {
+		if err := apikey.PriorityClassValidator(v); err != nil {
+			return &ValidationError{Name: "priority_class", err: fmt.Errorf(`ent: validator failed for field "APIKey.priority_class": %w`, err)}
+		}
+	}
 	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "APIKey.user"`)
 	}
@@ -696,6 +715,9 @@ func (_u *APIKeyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.Window7dStartCleared() {
 		_spec.ClearField(apikey.FieldWindow7dStart, field.TypeTime)
 	}
+	if value, ok := _u.mutation.PriorityClass(); ok {
+		_spec.SetField(apikey.FieldPriorityClass, field.TypeString, value)
+	}
 	if _u.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -1225,6 +1247,20 @@ func (_u *APIKeyUpdateOne) ClearWindow7dStart() *APIKeyUpdateOne {
 	return _u
 }
 
+// SetPriorityClass sets the "priority_class" field.
+func (_u *APIKeyUpdateOne) SetPriorityClass(v string) *APIKeyUpdateOne {
+	_u.mutation.SetPriorityClass(v)
+	return _u
+}
+
+// SetNillablePriorityClass sets the "priority_class" field if the given value is not nil.
+func (_u *APIKeyUpdateOne) SetNillablePriorityClass(v *string) *APIKeyUpdateOne {
+	if v != nil {
+		_u.SetPriorityClass(*v)
+	}
+	return _u
+}
+
 // SetUser sets the "user" edge to the User entity.
 func (_u *APIKeyUpdateOne) SetUser(v *User) *APIKeyUpdateOne {
 	return _u.SetUserID(v.ID)
@@ -1360,6 +1396,11 @@ func (_u *APIKeyUpdateOne) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "APIKey.status": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.PriorityClass(); ok {
+		if err := apikey.PriorityClassValidator(v); err != nil {
+			return &ValidationError{Name: "priority_class", err: fmt.Errorf(`ent: validator failed for field "APIKey.priority_class": %w`, err)}
+		}
+	}
 	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "APIKey.user"`)
 	}
@@ -1513,6 +1554,9 @@ func (_u *APIKeyUpdateOne) sqlSave(ctx context.Context) (_node *APIKey, err erro
 	if _u.mutation.Window7dStartCleared() {
 		_spec.ClearField(apikey.FieldWindow7dStart, field.TypeTime)
 	}
+	if value, ok := _u.mutation.PriorityClass(); ok {
+		_spec.SetField(apikey.FieldPriorityClass, field.TypeString, value)
+	}
 	if _u.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
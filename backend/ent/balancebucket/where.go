@@ -0,0 +1,650 @@
+// Code generated by ent, DO NOT EDIT.
+
+package balancebucket
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Wei-Shaw/sub2api/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int64) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldEQ(FieldUserID, v))
+}
+
+// Source applies equality check predicate on the "source" field. It's identical to SourceEQ.
+func Source(v string) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldEQ(FieldSource, v))
+}
+
+// SourceRef applies equality check predicate on the "source_ref" field. It's identical to SourceRefEQ.
+func SourceRef(v string) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldEQ(FieldSourceRef, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v float64) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldEQ(FieldAmount, v))
+}
+
+// Remaining applies equality check predicate on the "remaining" field. It's identical to RemainingEQ.
+func Remaining(v float64) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldEQ(FieldRemaining, v))
+}
+
+// Priority applies equality check predicate on the "priority" field. It's identical to PriorityEQ.
+func Priority(v int) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldEQ(FieldPriority, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldEQ(FieldStatus, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiredAmount applies equality check predicate on the "expired_amount" field. It's identical to ExpiredAmountEQ.
+func ExpiredAmount(v float64) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldEQ(FieldExpiredAmount, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int64) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int64) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int64) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int64) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v int64) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v int64) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v int64) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v int64) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldLTE(FieldUserID, v))
+}
+
+// SourceEQ applies the EQ predicate on the "source" field.
+func SourceEQ(v string) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldEQ(FieldSource, v))
+}
+
+// SourceNEQ applies the NEQ predicate on the "source" field.
+func SourceNEQ(v string) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldNEQ(FieldSource, v))
+}
+
+// SourceIn applies the In predicate on the "source" field.
+func SourceIn(vs ...string) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldIn(FieldSource, vs...))
+}
+
+// SourceNotIn applies the NotIn predicate on the "source" field.
+func SourceNotIn(vs ...string) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldNotIn(FieldSource, vs...))
+}
+
+// SourceGT applies the GT predicate on the "source" field.
+func SourceGT(v string) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldGT(FieldSource, v))
+}
+
+// SourceGTE applies the GTE predicate on the "source" field.
+func SourceGTE(v string) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldGTE(FieldSource, v))
+}
+
+// SourceLT applies the LT predicate on the "source" field.
+func SourceLT(v string) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldLT(FieldSource, v))
+}
+
+// SourceLTE applies the LTE predicate on the "source" field.
+func SourceLTE(v string) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldLTE(FieldSource, v))
+}
+
+// SourceContains applies the Contains predicate on the "source" field.
+func SourceContains(v string) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldContains(FieldSource, v))
+}
+
+// SourceHasPrefix applies the HasPrefix predicate on the "source" field.
+func SourceHasPrefix(v string) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldHasPrefix(FieldSource, v))
+}
+
+// SourceHasSuffix applies the HasSuffix predicate on the "source" field.
+func SourceHasSuffix(v string) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldHasSuffix(FieldSource, v))
+}
+
+// SourceEqualFold applies the EqualFold predicate on the "source" field.
+func SourceEqualFold(v string) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldEqualFold(FieldSource, v))
+}
+
+// SourceContainsFold applies the ContainsFold predicate on the "source" field.
+func SourceContainsFold(v string) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldContainsFold(FieldSource, v))
+}
+
+// SourceRefEQ applies the EQ predicate on the "source_ref" field.
+func SourceRefEQ(v string) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldEQ(FieldSourceRef, v))
+}
+
+// SourceRefNEQ applies the NEQ predicate on the "source_ref" field.
+func SourceRefNEQ(v string) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldNEQ(FieldSourceRef, v))
+}
+
+// SourceRefIn applies the In predicate on the "source_ref" field.
+func SourceRefIn(vs ...string) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldIn(FieldSourceRef, vs...))
+}
+
+// SourceRefNotIn applies the NotIn predicate on the "source_ref" field.
+func SourceRefNotIn(vs ...string) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldNotIn(FieldSourceRef, vs...))
+}
+
+// SourceRefGT applies the GT predicate on the "source_ref" field.
+func SourceRefGT(v string) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldGT(FieldSourceRef, v))
+}
+
+// SourceRefGTE applies the GTE predicate on the "source_ref" field.
+func SourceRefGTE(v string) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldGTE(FieldSourceRef, v))
+}
+
+// SourceRefLT applies the LT predicate on the "source_ref" field.
+func SourceRefLT(v string) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldLT(FieldSourceRef, v))
+}
+
+// SourceRefLTE applies the LTE predicate on the "source_ref" field.
+func SourceRefLTE(v string) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldLTE(FieldSourceRef, v))
+}
+
+// SourceRefContains applies the Contains predicate on the "source_ref" field.
+func SourceRefContains(v string) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldContains(FieldSourceRef, v))
+}
+
+// SourceRefHasPrefix applies the HasPrefix predicate on the "source_ref" field.
+func SourceRefHasPrefix(v string) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldHasPrefix(FieldSourceRef, v))
+}
+
+// SourceRefHasSuffix applies the HasSuffix predicate on the "source_ref" field.
+func SourceRefHasSuffix(v string) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldHasSuffix(FieldSourceRef, v))
+}
+
+// SourceRefEqualFold applies the EqualFold predicate on the "source_ref" field.
+func SourceRefEqualFold(v string) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldEqualFold(FieldSourceRef, v))
+}
+
+// SourceRefContainsFold applies the ContainsFold predicate on the "source_ref" field.
+func SourceRefContainsFold(v string) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldContainsFold(FieldSourceRef, v))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v float64) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v float64) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...float64) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...float64) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v float64) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v float64) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v float64) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v float64) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldLTE(FieldAmount, v))
+}
+
+// RemainingEQ applies the EQ predicate on the "remaining" field.
+func RemainingEQ(v float64) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldEQ(FieldRemaining, v))
+}
+
+// RemainingNEQ applies the NEQ predicate on the "remaining" field.
+func RemainingNEQ(v float64) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldNEQ(FieldRemaining, v))
+}
+
+// RemainingIn applies the In predicate on the "remaining" field.
+func RemainingIn(vs ...float64) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldIn(FieldRemaining, vs...))
+}
+
+// RemainingNotIn applies the NotIn predicate on the "remaining" field.
+func RemainingNotIn(vs ...float64) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldNotIn(FieldRemaining, vs...))
+}
+
+// RemainingGT applies the GT predicate on the "remaining" field.
+func RemainingGT(v float64) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldGT(FieldRemaining, v))
+}
+
+// RemainingGTE applies the GTE predicate on the "remaining" field.
+func RemainingGTE(v float64) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldGTE(FieldRemaining, v))
+}
+
+// RemainingLT applies the LT predicate on the "remaining" field.
+func RemainingLT(v float64) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldLT(FieldRemaining, v))
+}
+
+// RemainingLTE applies the LTE predicate on the "remaining" field.
+func RemainingLTE(v float64) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldLTE(FieldRemaining, v))
+}
+
+// PriorityEQ applies the EQ predicate on the "priority" field.
+func PriorityEQ(v int) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldEQ(FieldPriority, v))
+}
+
+// PriorityNEQ applies the NEQ predicate on the "priority" field.
+func PriorityNEQ(v int) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldNEQ(FieldPriority, v))
+}
+
+// PriorityIn applies the In predicate on the "priority" field.
+func PriorityIn(vs ...int) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldIn(FieldPriority, vs...))
+}
+
+// PriorityNotIn applies the NotIn predicate on the "priority" field.
+func PriorityNotIn(vs ...int) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldNotIn(FieldPriority, vs...))
+}
+
+// PriorityGT applies the GT predicate on the "priority" field.
+func PriorityGT(v int) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldGT(FieldPriority, v))
+}
+
+// PriorityGTE applies the GTE predicate on the "priority" field.
+func PriorityGTE(v int) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldGTE(FieldPriority, v))
+}
+
+// PriorityLT applies the LT predicate on the "priority" field.
+func PriorityLT(v int) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldLT(FieldPriority, v))
+}
+
+// PriorityLTE applies the LTE predicate on the "priority" field.
+func PriorityLTE(v int) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldLTE(FieldPriority, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtIsNil applies the IsNil predicate on the "expires_at" field.
+func ExpiresAtIsNil() predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldIsNull(FieldExpiresAt))
+}
+
+// ExpiresAtNotNil applies the NotNil predicate on the "expires_at" field.
+func ExpiresAtNotNil() predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldNotNull(FieldExpiresAt))
+}
+
+// ExpiredAmountEQ applies the EQ predicate on the "expired_amount" field.
+func ExpiredAmountEQ(v float64) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldEQ(FieldExpiredAmount, v))
+}
+
+// ExpiredAmountNEQ applies the NEQ predicate on the "expired_amount" field.
+func ExpiredAmountNEQ(v float64) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldNEQ(FieldExpiredAmount, v))
+}
+
+// ExpiredAmountIn applies the In predicate on the "expired_amount" field.
+func ExpiredAmountIn(vs ...float64) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldIn(FieldExpiredAmount, vs...))
+}
+
+// ExpiredAmountNotIn applies the NotIn predicate on the "expired_amount" field.
+func ExpiredAmountNotIn(vs ...float64) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldNotIn(FieldExpiredAmount, vs...))
+}
+
+// ExpiredAmountGT applies the GT predicate on the "expired_amount" field.
+func ExpiredAmountGT(v float64) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldGT(FieldExpiredAmount, v))
+}
+
+// ExpiredAmountGTE applies the GTE predicate on the "expired_amount" field.
+func ExpiredAmountGTE(v float64) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldGTE(FieldExpiredAmount, v))
+}
+
+// ExpiredAmountLT applies the LT predicate on the "expired_amount" field.
+func ExpiredAmountLT(v float64) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldLT(FieldExpiredAmount, v))
+}
+
+// ExpiredAmountLTE applies the LTE predicate on the "expired_amount" field.
+func ExpiredAmountLTE(v float64) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldLTE(FieldExpiredAmount, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BalanceBucket) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BalanceBucket) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BalanceBucket) predicate.BalanceBucket {
+	return predicate.BalanceBucket(sql.NotPredicates(p))
+}
@@ -0,0 +1,1175 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Wei-Shaw/sub2api/ent/balancebucket"
+)
+
+// BalanceBucketCreate is the builder for creating a BalanceBucket entity.
+type BalanceBucketCreate struct {
+	config
+	mutation *BalanceBucketMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *BalanceBucketCreate) SetUserID(v int64) *BalanceBucketCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetSource sets the "source" field.
+func (_c *BalanceBucketCreate) SetSource(v string) *BalanceBucketCreate {
+	_c.mutation.SetSource(v)
+	return _c
+}
+
+// SetSourceRef sets the "source_ref" field.
+func (_c *BalanceBucketCreate) SetSourceRef(v string) *BalanceBucketCreate {
+	_c.mutation.SetSourceRef(v)
+	return _c
+}
+
+// SetNillableSourceRef sets the "source_ref" field if the given value is not nil.
+func (_c *BalanceBucketCreate) SetNillableSourceRef(v *string) *BalanceBucketCreate {
+	if v != nil {
+		_c.SetSourceRef(*v)
+	}
+	return _c
+}
+
+// SetAmount sets the "amount" field.
+func (_c *BalanceBucketCreate) SetAmount(v float64) *BalanceBucketCreate {
+	_c.mutation.SetAmount(v)
+	return _c
+}
+
+// SetRemaining sets the "remaining" field.
+func (_c *BalanceBucketCreate) SetRemaining(v float64) *BalanceBucketCreate {
+	_c.mutation.SetRemaining(v)
+	return _c
+}
+
+// SetPriority sets the "priority" field.
+func (_c *BalanceBucketCreate) SetPriority(v int) *BalanceBucketCreate {
+	_c.mutation.SetPriority(v)
+	return _c
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_c *BalanceBucketCreate) SetNillablePriority(v *int) *BalanceBucketCreate {
+	if v != nil {
+		_c.SetPriority(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *BalanceBucketCreate) SetStatus(v string) *BalanceBucketCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *BalanceBucketCreate) SetNillableStatus(v *string) *BalanceBucketCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *BalanceBucketCreate) SetExpiresAt(v time.Time) *BalanceBucketCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_c *BalanceBucketCreate) SetNillableExpiresAt(v *time.Time) *BalanceBucketCreate {
+	if v != nil {
+		_c.SetExpiresAt(*v)
+	}
+	return _c
+}
+
+// SetExpiredAmount sets the "expired_amount" field.
+func (_c *BalanceBucketCreate) SetExpiredAmount(v float64) *BalanceBucketCreate {
+	_c.mutation.SetExpiredAmount(v)
+	return _c
+}
+
+// SetNillableExpiredAmount sets the "expired_amount" field if the given value is not nil.
+func (_c *BalanceBucketCreate) SetNillableExpiredAmount(v *float64) *BalanceBucketCreate {
+	if v != nil {
+		_c.SetExpiredAmount(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *BalanceBucketCreate) SetCreatedAt(v time.Time) *BalanceBucketCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *BalanceBucketCreate) SetNillableCreatedAt(v *time.Time) *BalanceBucketCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *BalanceBucketCreate) SetUpdatedAt(v time.Time) *BalanceBucketCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *BalanceBucketCreate) SetNillableUpdatedAt(v *time.Time) *BalanceBucketCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the BalanceBucketMutation object of the builder.
+func (_c *BalanceBucketCreate) Mutation() *BalanceBucketMutation {
+	return _c.mutation
+}
+
+// Save creates the BalanceBucket in the database.
+func (_c *BalanceBucketCreate) Save(ctx context.Context) (*BalanceBucket, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BalanceBucketCreate) SaveX(ctx context.Context) *BalanceBucket {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BalanceBucketCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BalanceBucketCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BalanceBucketCreate) defaults() {
+	if _, ok := _c.mutation.SourceRef(); !ok {
+		v := balancebucket.DefaultSourceRef
+		_c.mutation.SetSourceRef(v)
+	}
+	if _, ok := _c.mutation.Priority(); !ok {
+		v := balancebucket.DefaultPriority
+		_c.mutation.SetPriority(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := balancebucket.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.ExpiredAmount(); !ok {
+		v := balancebucket.DefaultExpiredAmount
+		_c.mutation.SetExpiredAmount(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := balancebucket.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := balancebucket.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BalanceBucketCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "BalanceBucket.user_id"`)}
+	}
+	if _, ok := _c.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`ent: missing required field "BalanceBucket.source"`)}
+	}
+	if v, ok := _c.mutation.Source(); ok {
+		if err := balancebucket.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "BalanceBucket.source": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SourceRef(); !ok {
+		return &ValidationError{Name: "source_ref", err: errors.New(`ent: missing required field "BalanceBucket.source_ref"`)}
+	}
+	if v, ok := _c.mutation.SourceRef(); ok {
+		if err := balancebucket.SourceRefValidator(v); err != nil {
+			return &ValidationError{Name: "source_ref", err: fmt.Errorf(`ent: validator failed for field "BalanceBucket.source_ref": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "BalanceBucket.amount"`)}
+	}
+	if _, ok := _c.mutation.Remaining(); !ok {
+		return &ValidationError{Name: "remaining", err: errors.New(`ent: missing required field "BalanceBucket.remaining"`)}
+	}
+	if _, ok := _c.mutation.Priority(); !ok {
+		return &ValidationError{Name: "priority", err: errors.New(`ent: missing required field "BalanceBucket.priority"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "BalanceBucket.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := balancebucket.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "BalanceBucket.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ExpiredAmount(); !ok {
+		return &ValidationError{Name: "expired_amount", err: errors.New(`ent: missing required field "BalanceBucket.expired_amount"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "BalanceBucket.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "BalanceBucket.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *BalanceBucketCreate) sqlSave(ctx context.Context) (*BalanceBucket, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int64(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BalanceBucketCreate) createSpec() (*BalanceBucket, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BalanceBucket{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(balancebucket.Table, sqlgraph.NewFieldSpec(balancebucket.FieldID, field.TypeInt64))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(balancebucket.FieldUserID, field.TypeInt64, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.Source(); ok {
+		_spec.SetField(balancebucket.FieldSource, field.TypeString, value)
+		_node.Source = value
+	}
+	if value, ok := _c.mutation.SourceRef(); ok {
+		_spec.SetField(balancebucket.FieldSourceRef, field.TypeString, value)
+		_node.SourceRef = value
+	}
+	if value, ok := _c.mutation.Amount(); ok {
+		_spec.SetField(balancebucket.FieldAmount, field.TypeFloat64, value)
+		_node.Amount = value
+	}
+	if value, ok := _c.mutation.Remaining(); ok {
+		_spec.SetField(balancebucket.FieldRemaining, field.TypeFloat64, value)
+		_node.Remaining = value
+	}
+	if value, ok := _c.mutation.Priority(); ok {
+		_spec.SetField(balancebucket.FieldPriority, field.TypeInt, value)
+		_node.Priority = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(balancebucket.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(balancebucket.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := _c.mutation.ExpiredAmount(); ok {
+		_spec.SetField(balancebucket.FieldExpiredAmount, field.TypeFloat64, value)
+		_node.ExpiredAmount = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(balancebucket.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(balancebucket.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.BalanceBucket.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BalanceBucketUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *BalanceBucketCreate) OnConflict(opts ...sql.ConflictOption) *BalanceBucketUpsertOne {
+	_c.conflict = opts
+	return &BalanceBucketUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.BalanceBucket.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *BalanceBucketCreate) OnConflictColumns(columns ...string) *BalanceBucketUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &BalanceBucketUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// BalanceBucketUpsertOne is the builder for "upsert"-ing
+	//  one BalanceBucket node.
+	BalanceBucketUpsertOne struct {
+		create *BalanceBucketCreate
+	}
+
+	// BalanceBucketUpsert is the "OnConflict" setter.
+	BalanceBucketUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUserID sets the "user_id" field.
+func (u *BalanceBucketUpsert) SetUserID(v int64) *BalanceBucketUpsert {
+	u.Set(balancebucket.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *BalanceBucketUpsert) UpdateUserID() *BalanceBucketUpsert {
+	u.SetExcluded(balancebucket.FieldUserID)
+	return u
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *BalanceBucketUpsert) AddUserID(v int64) *BalanceBucketUpsert {
+	u.Add(balancebucket.FieldUserID, v)
+	return u
+}
+
+// SetSource sets the "source" field.
+func (u *BalanceBucketUpsert) SetSource(v string) *BalanceBucketUpsert {
+	u.Set(balancebucket.FieldSource, v)
+	return u
+}
+
+// UpdateSource sets the "source" field to the value that was provided on create.
+func (u *BalanceBucketUpsert) UpdateSource() *BalanceBucketUpsert {
+	u.SetExcluded(balancebucket.FieldSource)
+	return u
+}
+
+// SetSourceRef sets the "source_ref" field.
+func (u *BalanceBucketUpsert) SetSourceRef(v string) *BalanceBucketUpsert {
+	u.Set(balancebucket.FieldSourceRef, v)
+	return u
+}
+
+// UpdateSourceRef sets the "source_ref" field to the value that was provided on create.
+func (u *BalanceBucketUpsert) UpdateSourceRef() *BalanceBucketUpsert {
+	u.SetExcluded(balancebucket.FieldSourceRef)
+	return u
+}
+
+// SetAmount sets the "amount" field.
+func (u *BalanceBucketUpsert) SetAmount(v float64) *BalanceBucketUpsert {
+	u.Set(balancebucket.FieldAmount, v)
+	return u
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *BalanceBucketUpsert) UpdateAmount() *BalanceBucketUpsert {
+	u.SetExcluded(balancebucket.FieldAmount)
+	return u
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *BalanceBucketUpsert) AddAmount(v float64) *BalanceBucketUpsert {
+	u.Add(balancebucket.FieldAmount, v)
+	return u
+}
+
+// SetRemaining sets the "remaining" field.
+func (u *BalanceBucketUpsert) SetRemaining(v float64) *BalanceBucketUpsert {
+	u.Set(balancebucket.FieldRemaining, v)
+	return u
+}
+
+// UpdateRemaining sets the "remaining" field to the value that was provided on create.
+func (u *BalanceBucketUpsert) UpdateRemaining() *BalanceBucketUpsert {
+	u.SetExcluded(balancebucket.FieldRemaining)
+	return u
+}
+
+// AddRemaining adds v to the "remaining" field.
+func (u *BalanceBucketUpsert) AddRemaining(v float64) *BalanceBucketUpsert {
+	u.Add(balancebucket.FieldRemaining, v)
+	return u
+}
+
+// SetPriority sets the "priority" field.
+func (u *BalanceBucketUpsert) SetPriority(v int) *BalanceBucketUpsert {
+	u.Set(balancebucket.FieldPriority, v)
+	return u
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *BalanceBucketUpsert) UpdatePriority() *BalanceBucketUpsert {
+	u.SetExcluded(balancebucket.FieldPriority)
+	return u
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *BalanceBucketUpsert) AddPriority(v int) *BalanceBucketUpsert {
+	u.Add(balancebucket.FieldPriority, v)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *BalanceBucketUpsert) SetStatus(v string) *BalanceBucketUpsert {
+	u.Set(balancebucket.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *BalanceBucketUpsert) UpdateStatus() *BalanceBucketUpsert {
+	u.SetExcluded(balancebucket.FieldStatus)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *BalanceBucketUpsert) SetExpiresAt(v time.Time) *BalanceBucketUpsert {
+	u.Set(balancebucket.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *BalanceBucketUpsert) UpdateExpiresAt() *BalanceBucketUpsert {
+	u.SetExcluded(balancebucket.FieldExpiresAt)
+	return u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *BalanceBucketUpsert) ClearExpiresAt() *BalanceBucketUpsert {
+	u.SetNull(balancebucket.FieldExpiresAt)
+	return u
+}
+
+// SetExpiredAmount sets the "expired_amount" field.
+func (u *BalanceBucketUpsert) SetExpiredAmount(v float64) *BalanceBucketUpsert {
+	u.Set(balancebucket.FieldExpiredAmount, v)
+	return u
+}
+
+// UpdateExpiredAmount sets the "expired_amount" field to the value that was provided on create.
+func (u *BalanceBucketUpsert) UpdateExpiredAmount() *BalanceBucketUpsert {
+	u.SetExcluded(balancebucket.FieldExpiredAmount)
+	return u
+}
+
+// AddExpiredAmount adds v to the "expired_amount" field.
+func (u *BalanceBucketUpsert) AddExpiredAmount(v float64) *BalanceBucketUpsert {
+	u.Add(balancebucket.FieldExpiredAmount, v)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *BalanceBucketUpsert) SetUpdatedAt(v time.Time) *BalanceBucketUpsert {
+	u.Set(balancebucket.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *BalanceBucketUpsert) UpdateUpdatedAt() *BalanceBucketUpsert {
+	u.SetExcluded(balancebucket.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.BalanceBucket.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *BalanceBucketUpsertOne) UpdateNewValues() *BalanceBucketUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(balancebucket.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.BalanceBucket.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *BalanceBucketUpsertOne) Ignore() *BalanceBucketUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BalanceBucketUpsertOne) DoNothing() *BalanceBucketUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BalanceBucketCreate.OnConflict
+// documentation for more info.
+func (u *BalanceBucketUpsertOne) Update(set func(*BalanceBucketUpsert)) *BalanceBucketUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BalanceBucketUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *BalanceBucketUpsertOne) SetUserID(v int64) *BalanceBucketUpsertOne {
+	return u.Update(func(s *BalanceBucketUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *BalanceBucketUpsertOne) AddUserID(v int64) *BalanceBucketUpsertOne {
+	return u.Update(func(s *BalanceBucketUpsert) {
+		s.AddUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *BalanceBucketUpsertOne) UpdateUserID() *BalanceBucketUpsertOne {
+	return u.Update(func(s *BalanceBucketUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetSource sets the "source" field.
+func (u *BalanceBucketUpsertOne) SetSource(v string) *BalanceBucketUpsertOne {
+	return u.Update(func(s *BalanceBucketUpsert) {
+		s.SetSource(v)
+	})
+}
+
+// UpdateSource sets the "source" field to the value that was provided on create.
+func (u *BalanceBucketUpsertOne) UpdateSource() *BalanceBucketUpsertOne {
+	return u.Update(func(s *BalanceBucketUpsert) {
+		s.UpdateSource()
+	})
+}
+
+// SetSourceRef sets the "source_ref" field.
+func (u *BalanceBucketUpsertOne) SetSourceRef(v string) *BalanceBucketUpsertOne {
+	return u.Update(func(s *BalanceBucketUpsert) {
+		s.SetSourceRef(v)
+	})
+}
+
+// UpdateSourceRef sets the "source_ref" field to the value that was provided on create.
+func (u *BalanceBucketUpsertOne) UpdateSourceRef() *BalanceBucketUpsertOne {
+	return u.Update(func(s *BalanceBucketUpsert) {
+		s.UpdateSourceRef()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *BalanceBucketUpsertOne) SetAmount(v float64) *BalanceBucketUpsertOne {
+	return u.Update(func(s *BalanceBucketUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *BalanceBucketUpsertOne) AddAmount(v float64) *BalanceBucketUpsertOne {
+	return u.Update(func(s *BalanceBucketUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *BalanceBucketUpsertOne) UpdateAmount() *BalanceBucketUpsertOne {
+	return u.Update(func(s *BalanceBucketUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetRemaining sets the "remaining" field.
+func (u *BalanceBucketUpsertOne) SetRemaining(v float64) *BalanceBucketUpsertOne {
+	return u.Update(func(s *BalanceBucketUpsert) {
+		s.SetRemaining(v)
+	})
+}
+
+// AddRemaining adds v to the "remaining" field.
+func (u *BalanceBucketUpsertOne) AddRemaining(v float64) *BalanceBucketUpsertOne {
+	return u.Update(func(s *BalanceBucketUpsert) {
+		s.AddRemaining(v)
+	})
+}
+
+// UpdateRemaining sets the "remaining" field to the value that was provided on create.
+func (u *BalanceBucketUpsertOne) UpdateRemaining() *BalanceBucketUpsertOne {
+	return u.Update(func(s *BalanceBucketUpsert) {
+		s.UpdateRemaining()
+	})
+}
+
+// SetPriority sets the "priority" field.
+func (u *BalanceBucketUpsertOne) SetPriority(v int) *BalanceBucketUpsertOne {
+	return u.Update(func(s *BalanceBucketUpsert) {
+		s.SetPriority(v)
+	})
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *BalanceBucketUpsertOne) AddPriority(v int) *BalanceBucketUpsertOne {
+	return u.Update(func(s *BalanceBucketUpsert) {
+		s.AddPriority(v)
+	})
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *BalanceBucketUpsertOne) UpdatePriority() *BalanceBucketUpsertOne {
+	return u.Update(func(s *BalanceBucketUpsert) {
+		s.UpdatePriority()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *BalanceBucketUpsertOne) SetStatus(v string) *BalanceBucketUpsertOne {
+	return u.Update(func(s *BalanceBucketUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *BalanceBucketUpsertOne) UpdateStatus() *BalanceBucketUpsertOne {
+	return u.Update(func(s *BalanceBucketUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *BalanceBucketUpsertOne) SetExpiresAt(v time.Time) *BalanceBucketUpsertOne {
+	return u.Update(func(s *BalanceBucketUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *BalanceBucketUpsertOne) UpdateExpiresAt() *BalanceBucketUpsertOne {
+	return u.Update(func(s *BalanceBucketUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *BalanceBucketUpsertOne) ClearExpiresAt() *BalanceBucketUpsertOne {
+	return u.Update(func(s *BalanceBucketUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetExpiredAmount sets the "expired_amount" field.
+func (u *BalanceBucketUpsertOne) SetExpiredAmount(v float64) *BalanceBucketUpsertOne {
+	return u.Update(func(s *BalanceBucketUpsert) {
+		s.SetExpiredAmount(v)
+	})
+}
+
+// AddExpiredAmount adds v to the "expired_amount" field.
+func (u *BalanceBucketUpsertOne) AddExpiredAmount(v float64) *BalanceBucketUpsertOne {
+	return u.Update(func(s *BalanceBucketUpsert) {
+		s.AddExpiredAmount(v)
+	})
+}
+
+// UpdateExpiredAmount sets the "expired_amount" field to the value that was provided on create.
+func (u *BalanceBucketUpsertOne) UpdateExpiredAmount() *BalanceBucketUpsertOne {
+	return u.Update(func(s *BalanceBucketUpsert) {
+		s.UpdateExpiredAmount()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *BalanceBucketUpsertOne) SetUpdatedAt(v time.Time) *BalanceBucketUpsertOne {
+	return u.Update(func(s *BalanceBucketUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *BalanceBucketUpsertOne) UpdateUpdatedAt() *BalanceBucketUpsertOne {
+	return u.Update(func(s *BalanceBucketUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *BalanceBucketUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BalanceBucketCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BalanceBucketUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *BalanceBucketUpsertOne) ID(ctx context.Context) (id int64, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *BalanceBucketUpsertOne) IDX(ctx context.Context) int64 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// BalanceBucketCreateBulk is the builder for creating many BalanceBucket entities in bulk.
+type BalanceBucketCreateBulk struct {
+	config
+	err      error
+	builders []*BalanceBucketCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the BalanceBucket entities in the database.
+func (_c *BalanceBucketCreateBulk) Save(ctx context.Context) ([]*BalanceBucket, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BalanceBucket, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BalanceBucketMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BalanceBucketCreateBulk) SaveX(ctx context.Context) []*BalanceBucket {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BalanceBucketCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BalanceBucketCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.BalanceBucket.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BalanceBucketUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *BalanceBucketCreateBulk) OnConflict(opts ...sql.ConflictOption) *BalanceBucketUpsertBulk {
+	_c.conflict = opts
+	return &BalanceBucketUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.BalanceBucket.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *BalanceBucketCreateBulk) OnConflictColumns(columns ...string) *BalanceBucketUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &BalanceBucketUpsertBulk{
+		create: _c,
+	}
+}
+
+// BalanceBucketUpsertBulk is the builder for "upsert"-ing
+// a bulk of BalanceBucket nodes.
+type BalanceBucketUpsertBulk struct {
+	create *BalanceBucketCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.BalanceBucket.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *BalanceBucketUpsertBulk) UpdateNewValues() *BalanceBucketUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(balancebucket.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.BalanceBucket.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *BalanceBucketUpsertBulk) Ignore() *BalanceBucketUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BalanceBucketUpsertBulk) DoNothing() *BalanceBucketUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BalanceBucketCreateBulk.OnConflict
+// documentation for more info.
+func (u *BalanceBucketUpsertBulk) Update(set func(*BalanceBucketUpsert)) *BalanceBucketUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BalanceBucketUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *BalanceBucketUpsertBulk) SetUserID(v int64) *BalanceBucketUpsertBulk {
+	return u.Update(func(s *BalanceBucketUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *BalanceBucketUpsertBulk) AddUserID(v int64) *BalanceBucketUpsertBulk {
+	return u.Update(func(s *BalanceBucketUpsert) {
+		s.AddUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *BalanceBucketUpsertBulk) UpdateUserID() *BalanceBucketUpsertBulk {
+	return u.Update(func(s *BalanceBucketUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetSource sets the "source" field.
+func (u *BalanceBucketUpsertBulk) SetSource(v string) *BalanceBucketUpsertBulk {
+	return u.Update(func(s *BalanceBucketUpsert) {
+		s.SetSource(v)
+	})
+}
+
+// UpdateSource sets the "source" field to the value that was provided on create.
+func (u *BalanceBucketUpsertBulk) UpdateSource() *BalanceBucketUpsertBulk {
+	return u.Update(func(s *BalanceBucketUpsert) {
+		s.UpdateSource()
+	})
+}
+
+// SetSourceRef sets the "source_ref" field.
+func (u *BalanceBucketUpsertBulk) SetSourceRef(v string) *BalanceBucketUpsertBulk {
+	return u.Update(func(s *BalanceBucketUpsert) {
+		s.SetSourceRef(v)
+	})
+}
+
+// UpdateSourceRef sets the "source_ref" field to the value that was provided on create.
+func (u *BalanceBucketUpsertBulk) UpdateSourceRef() *BalanceBucketUpsertBulk {
+	return u.Update(func(s *BalanceBucketUpsert) {
+		s.UpdateSourceRef()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *BalanceBucketUpsertBulk) SetAmount(v float64) *BalanceBucketUpsertBulk {
+	return u.Update(func(s *BalanceBucketUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *BalanceBucketUpsertBulk) AddAmount(v float64) *BalanceBucketUpsertBulk {
+	return u.Update(func(s *BalanceBucketUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *BalanceBucketUpsertBulk) UpdateAmount() *BalanceBucketUpsertBulk {
+	return u.Update(func(s *BalanceBucketUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetRemaining sets the "remaining" field.
+func (u *BalanceBucketUpsertBulk) SetRemaining(v float64) *BalanceBucketUpsertBulk {
+	return u.Update(func(s *BalanceBucketUpsert) {
+		s.SetRemaining(v)
+	})
+}
+
+// AddRemaining adds v to the "remaining" field.
+func (u *BalanceBucketUpsertBulk) AddRemaining(v float64) *BalanceBucketUpsertBulk {
+	return u.Update(func(s *BalanceBucketUpsert) {
+		s.AddRemaining(v)
+	})
+}
+
+// UpdateRemaining sets the "remaining" field to the value that was provided on create.
+func (u *BalanceBucketUpsertBulk) UpdateRemaining() *BalanceBucketUpsertBulk {
+	return u.Update(func(s *BalanceBucketUpsert) {
+		s.UpdateRemaining()
+	})
+}
+
+// SetPriority sets the "priority" field.
+func (u *BalanceBucketUpsertBulk) SetPriority(v int) *BalanceBucketUpsertBulk {
+	return u.Update(func(s *BalanceBucketUpsert) {
+		s.SetPriority(v)
+	})
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *BalanceBucketUpsertBulk) AddPriority(v int) *BalanceBucketUpsertBulk {
+	return u.Update(func(s *BalanceBucketUpsert) {
+		s.AddPriority(v)
+	})
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *BalanceBucketUpsertBulk) UpdatePriority() *BalanceBucketUpsertBulk {
+	return u.Update(func(s *BalanceBucketUpsert) {
+		s.UpdatePriority()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *BalanceBucketUpsertBulk) SetStatus(v string) *BalanceBucketUpsertBulk {
+	return u.Update(func(s *BalanceBucketUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *BalanceBucketUpsertBulk) UpdateStatus() *BalanceBucketUpsertBulk {
+	return u.Update(func(s *BalanceBucketUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *BalanceBucketUpsertBulk) SetExpiresAt(v time.Time) *BalanceBucketUpsertBulk {
+	return u.Update(func(s *BalanceBucketUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *BalanceBucketUpsertBulk) UpdateExpiresAt() *BalanceBucketUpsertBulk {
+	return u.Update(func(s *BalanceBucketUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *BalanceBucketUpsertBulk) ClearExpiresAt() *BalanceBucketUpsertBulk {
+	return u.Update(func(s *BalanceBucketUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetExpiredAmount sets the "expired_amount" field.
+func (u *BalanceBucketUpsertBulk) SetExpiredAmount(v float64) *BalanceBucketUpsertBulk {
+	return u.Update(func(s *BalanceBucketUpsert) {
+		s.SetExpiredAmount(v)
+	})
+}
+
+// AddExpiredAmount adds v to the "expired_amount" field.
+func (u *BalanceBucketUpsertBulk) AddExpiredAmount(v float64) *BalanceBucketUpsertBulk {
+	return u.Update(func(s *BalanceBucketUpsert) {
+		s.AddExpiredAmount(v)
+	})
+}
+
+// UpdateExpiredAmount sets the "expired_amount" field to the value that was provided on create.
+func (u *BalanceBucketUpsertBulk) UpdateExpiredAmount() *BalanceBucketUpsertBulk {
+	return u.Update(func(s *BalanceBucketUpsert) {
+		s.UpdateExpiredAmount()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *BalanceBucketUpsertBulk) SetUpdatedAt(v time.Time) *BalanceBucketUpsertBulk {
+	return u.Update(func(s *BalanceBucketUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *BalanceBucketUpsertBulk) UpdateUpdatedAt() *BalanceBucketUpsertBulk {
+	return u.Update(func(s *BalanceBucketUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *BalanceBucketUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the BalanceBucketCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BalanceBucketCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BalanceBucketUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
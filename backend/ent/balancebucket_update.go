@@ -0,0 +1,682 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Wei-Shaw/sub2api/ent/balancebucket"
+	"github.com/Wei-Shaw/sub2api/ent/predicate"
+)
+
+// BalanceBucketUpdate is the builder for updating BalanceBucket entities.
+type BalanceBucketUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BalanceBucketMutation
+}
+
+// Where appends a list predicates to the BalanceBucketUpdate builder.
+func (_u *BalanceBucketUpdate) Where(ps ...predicate.BalanceBucket) *BalanceBucketUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *BalanceBucketUpdate) SetUserID(v int64) *BalanceBucketUpdate {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *BalanceBucketUpdate) SetNillableUserID(v *int64) *BalanceBucketUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *BalanceBucketUpdate) AddUserID(v int64) *BalanceBucketUpdate {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *BalanceBucketUpdate) SetSource(v string) *BalanceBucketUpdate {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *BalanceBucketUpdate) SetNillableSource(v *string) *BalanceBucketUpdate {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// SetSourceRef sets the "source_ref" field.
+func (_u *BalanceBucketUpdate) SetSourceRef(v string) *BalanceBucketUpdate {
+	_u.mutation.SetSourceRef(v)
+	return _u
+}
+
+// SetNillableSourceRef sets the "source_ref" field if the given value is not nil.
+func (_u *BalanceBucketUpdate) SetNillableSourceRef(v *string) *BalanceBucketUpdate {
+	if v != nil {
+		_u.SetSourceRef(*v)
+	}
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *BalanceBucketUpdate) SetAmount(v float64) *BalanceBucketUpdate {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *BalanceBucketUpdate) SetNillableAmount(v *float64) *BalanceBucketUpdate {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *BalanceBucketUpdate) AddAmount(v float64) *BalanceBucketUpdate {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetRemaining sets the "remaining" field.
+func (_u *BalanceBucketUpdate) SetRemaining(v float64) *BalanceBucketUpdate {
+	_u.mutation.ResetRemaining()
+	_u.mutation.SetRemaining(v)
+	return _u
+}
+
+// SetNillableRemaining sets the "remaining" field if the given value is not nil.
+func (_u *BalanceBucketUpdate) SetNillableRemaining(v *float64) *BalanceBucketUpdate {
+	if v != nil {
+		_u.SetRemaining(*v)
+	}
+	return _u
+}
+
+// AddRemaining adds value to the "remaining" field.
+func (_u *BalanceBucketUpdate) AddRemaining(v float64) *BalanceBucketUpdate {
+	_u.mutation.AddRemaining(v)
+	return _u
+}
+
+// SetPriority sets the "priority" field.
+func (_u *BalanceBucketUpdate) SetPriority(v int) *BalanceBucketUpdate {
+	_u.mutation.ResetPriority()
+	_u.mutation.SetPriority(v)
+	return _u
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_u *BalanceBucketUpdate) SetNillablePriority(v *int) *BalanceBucketUpdate {
+	if v != nil {
+		_u.SetPriority(*v)
+	}
+	return _u
+}
+
+// AddPriority adds value to the "priority" field.
+func (_u *BalanceBucketUpdate) AddPriority(v int) *BalanceBucketUpdate {
+	_u.mutation.AddPriority(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *BalanceBucketUpdate) SetStatus(v string) *BalanceBucketUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *BalanceBucketUpdate) SetNillableStatus(v *string) *BalanceBucketUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *BalanceBucketUpdate) SetExpiresAt(v time.Time) *BalanceBucketUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *BalanceBucketUpdate) SetNillableExpiresAt(v *time.Time) *BalanceBucketUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *BalanceBucketUpdate) ClearExpiresAt() *BalanceBucketUpdate {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// SetExpiredAmount sets the "expired_amount" field.
+func (_u *BalanceBucketUpdate) SetExpiredAmount(v float64) *BalanceBucketUpdate {
+	_u.mutation.ResetExpiredAmount()
+	_u.mutation.SetExpiredAmount(v)
+	return _u
+}
+
+// SetNillableExpiredAmount sets the "expired_amount" field if the given value is not nil.
+func (_u *BalanceBucketUpdate) SetNillableExpiredAmount(v *float64) *BalanceBucketUpdate {
+	if v != nil {
+		_u.SetExpiredAmount(*v)
+	}
+	return _u
+}
+
+// AddExpiredAmount adds value to the "expired_amount" field.
+func (_u *BalanceBucketUpdate) AddExpiredAmount(v float64) *BalanceBucketUpdate {
+	_u.mutation.AddExpiredAmount(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *BalanceBucketUpdate) SetUpdatedAt(v time.Time) *BalanceBucketUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the BalanceBucketMutation object of the builder.
+func (_u *BalanceBucketUpdate) Mutation() *BalanceBucketMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BalanceBucketUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BalanceBucketUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BalanceBucketUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BalanceBucketUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *BalanceBucketUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := balancebucket.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BalanceBucketUpdate) check() error {
+	if v, ok := _u.mutation.Source(); ok {
+		if err := balancebucket.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "BalanceBucket.source": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SourceRef(); ok {
+		if err := balancebucket.SourceRefValidator(v); err != nil {
+			return &ValidationError{Name: "source_ref", err: fmt.Errorf(`ent: validator failed for field "BalanceBucket.source_ref": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := balancebucket.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "BalanceBucket.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BalanceBucketUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(balancebucket.Table, balancebucket.Columns, sqlgraph.NewFieldSpec(balancebucket.FieldID, field.TypeInt64))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(balancebucket.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(balancebucket.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(balancebucket.FieldSource, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SourceRef(); ok {
+		_spec.SetField(balancebucket.FieldSourceRef, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(balancebucket.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(balancebucket.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Remaining(); ok {
+		_spec.SetField(balancebucket.FieldRemaining, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedRemaining(); ok {
+		_spec.AddField(balancebucket.FieldRemaining, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Priority(); ok {
+		_spec.SetField(balancebucket.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPriority(); ok {
+		_spec.AddField(balancebucket.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(balancebucket.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(balancebucket.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(balancebucket.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ExpiredAmount(); ok {
+		_spec.SetField(balancebucket.FieldExpiredAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedExpiredAmount(); ok {
+		_spec.AddField(balancebucket.FieldExpiredAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(balancebucket.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{balancebucket.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BalanceBucketUpdateOne is the builder for updating a single BalanceBucket entity.
+type BalanceBucketUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BalanceBucketMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *BalanceBucketUpdateOne) SetUserID(v int64) *BalanceBucketUpdateOne {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *BalanceBucketUpdateOne) SetNillableUserID(v *int64) *BalanceBucketUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *BalanceBucketUpdateOne) AddUserID(v int64) *BalanceBucketUpdateOne {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *BalanceBucketUpdateOne) SetSource(v string) *BalanceBucketUpdateOne {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *BalanceBucketUpdateOne) SetNillableSource(v *string) *BalanceBucketUpdateOne {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// SetSourceRef sets the "source_ref" field.
+func (_u *BalanceBucketUpdateOne) SetSourceRef(v string) *BalanceBucketUpdateOne {
+	_u.mutation.SetSourceRef(v)
+	return _u
+}
+
+// SetNillableSourceRef sets the "source_ref" field if the given value is not nil.
+func (_u *BalanceBucketUpdateOne) SetNillableSourceRef(v *string) *BalanceBucketUpdateOne {
+	if v != nil {
+		_u.SetSourceRef(*v)
+	}
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *BalanceBucketUpdateOne) SetAmount(v float64) *BalanceBucketUpdateOne {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *BalanceBucketUpdateOne) SetNillableAmount(v *float64) *BalanceBucketUpdateOne {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *BalanceBucketUpdateOne) AddAmount(v float64) *BalanceBucketUpdateOne {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetRemaining sets the "remaining" field.
+func (_u *BalanceBucketUpdateOne) SetRemaining(v float64) *BalanceBucketUpdateOne {
+	_u.mutation.ResetRemaining()
+	_u.mutation.SetRemaining(v)
+	return _u
+}
+
+// SetNillableRemaining sets the "remaining" field if the given value is not nil.
+func (_u *BalanceBucketUpdateOne) SetNillableRemaining(v *float64) *BalanceBucketUpdateOne {
+	if v != nil {
+		_u.SetRemaining(*v)
+	}
+	return _u
+}
+
+// AddRemaining adds value to the "remaining" field.
+func (_u *BalanceBucketUpdateOne) AddRemaining(v float64) *BalanceBucketUpdateOne {
+	_u.mutation.AddRemaining(v)
+	return _u
+}
+
+// SetPriority sets the "priority" field.
+func (_u *BalanceBucketUpdateOne) SetPriority(v int) *BalanceBucketUpdateOne {
+	_u.mutation.ResetPriority()
+	_u.mutation.SetPriority(v)
+	return _u
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_u *BalanceBucketUpdateOne) SetNillablePriority(v *int) *BalanceBucketUpdateOne {
+	if v != nil {
+		_u.SetPriority(*v)
+	}
+	return _u
+}
+
+// AddPriority adds value to the "priority" field.
+func (_u *BalanceBucketUpdateOne) AddPriority(v int) *BalanceBucketUpdateOne {
+	_u.mutation.AddPriority(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *BalanceBucketUpdateOne) SetStatus(v string) *BalanceBucketUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *BalanceBucketUpdateOne) SetNillableStatus(v *string) *BalanceBucketUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *BalanceBucketUpdateOne) SetExpiresAt(v time.Time) *BalanceBucketUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *BalanceBucketUpdateOne) SetNillableExpiresAt(v *time.Time) *BalanceBucketUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *BalanceBucketUpdateOne) ClearExpiresAt() *BalanceBucketUpdateOne {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// SetExpiredAmount sets the "expired_amount" field.
+func (_u *BalanceBucketUpdateOne) SetExpiredAmount(v float64) *BalanceBucketUpdateOne {
+	_u.mutation.ResetExpiredAmount()
+	_u.mutation.SetExpiredAmount(v)
+	return _u
+}
+
+// SetNillableExpiredAmount sets the "expired_amount" field if the given value is not nil.
+func (_u *BalanceBucketUpdateOne) SetNillableExpiredAmount(v *float64) *BalanceBucketUpdateOne {
+	if v != nil {
+		_u.SetExpiredAmount(*v)
+	}
+	return _u
+}
+
+// AddExpiredAmount adds value to the "expired_amount" field.
+func (_u *BalanceBucketUpdateOne) AddExpiredAmount(v float64) *BalanceBucketUpdateOne {
+	_u.mutation.AddExpiredAmount(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *BalanceBucketUpdateOne) SetUpdatedAt(v time.Time) *BalanceBucketUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the BalanceBucketMutation object of the builder.
+func (_u *BalanceBucketUpdateOne) Mutation() *BalanceBucketMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BalanceBucketUpdate builder.
+func (_u *BalanceBucketUpdateOne) Where(ps ...predicate.BalanceBucket) *BalanceBucketUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BalanceBucketUpdateOne) Select(field string, fields ...string) *BalanceBucketUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BalanceBucket entity.
+func (_u *BalanceBucketUpdateOne) Save(ctx context.Context) (*BalanceBucket, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BalanceBucketUpdateOne) SaveX(ctx context.Context) *BalanceBucket {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BalanceBucketUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BalanceBucketUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *BalanceBucketUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := balancebucket.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BalanceBucketUpdateOne) check() error {
+	if v, ok := _u.mutation.Source(); ok {
+		if err := balancebucket.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "BalanceBucket.source": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SourceRef(); ok {
+		if err := balancebucket.SourceRefValidator(v); err != nil {
+			return &ValidationError{Name: "source_ref", err: fmt.Errorf(`ent: validator failed for field "BalanceBucket.source_ref": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := balancebucket.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "BalanceBucket.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BalanceBucketUpdateOne) sqlSave(ctx context.Context) (_node *BalanceBucket, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(balancebucket.Table, balancebucket.Columns, sqlgraph.NewFieldSpec(balancebucket.FieldID, field.TypeInt64))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BalanceBucket.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, balancebucket.FieldID)
+		for _, f := range fields {
+			if !balancebucket.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != balancebucket.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(balancebucket.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(balancebucket.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(balancebucket.FieldSource, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SourceRef(); ok {
+		_spec.SetField(balancebucket.FieldSourceRef, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(balancebucket.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(balancebucket.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Remaining(); ok {
+		_spec.SetField(balancebucket.FieldRemaining, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedRemaining(); ok {
+		_spec.AddField(balancebucket.FieldRemaining, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Priority(); ok {
+		_spec.SetField(balancebucket.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPriority(); ok {
+		_spec.AddField(balancebucket.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(balancebucket.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(balancebucket.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(balancebucket.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ExpiredAmount(); ok {
+		_spec.SetField(balancebucket.FieldExpiredAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedExpiredAmount(); ok {
+		_spec.AddField(balancebucket.FieldExpiredAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(balancebucket.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &BalanceBucket{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{balancebucket.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,196 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Wei-Shaw/sub2api/ent/billingadjustment"
+)
+
+// BillingAdjustment is the model entity for the BillingAdjustment schema.
+type BillingAdjustment struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// JobID holds the value of the "job_id" field.
+	JobID int64 `json:"job_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int64 `json:"user_id,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount float64 `json:"amount,omitempty"`
+	// BalanceBefore holds the value of the "balance_before" field.
+	BalanceBefore float64 `json:"balance_before,omitempty"`
+	// BalanceAfter holds the value of the "balance_after" field.
+	BalanceAfter float64 `json:"balance_after,omitempty"`
+	// UsageRows holds the value of the "usage_rows" field.
+	UsageRows int64 `json:"usage_rows,omitempty"`
+	// OperatorID holds the value of the "operator_id" field.
+	OperatorID int64 `json:"operator_id,omitempty"`
+	// Note holds the value of the "note" field.
+	Note string `json:"note,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BillingAdjustment) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case billingadjustment.FieldAmount, billingadjustment.FieldBalanceBefore, billingadjustment.FieldBalanceAfter:
+			values[i] = new(sql.NullFloat64)
+		case billingadjustment.FieldID, billingadjustment.FieldJobID, billingadjustment.FieldUserID, billingadjustment.FieldUsageRows, billingadjustment.FieldOperatorID:
+			values[i] = new(sql.NullInt64)
+		case billingadjustment.FieldNote:
+			values[i] = new(sql.NullString)
+		case billingadjustment.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BillingAdjustment fields.
+func (_m *BillingAdjustment) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case billingadjustment.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int64(value.Int64)
+		case billingadjustment.FieldJobID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field job_id", values[i])
+			} else if value.Valid {
+				_m.JobID = value.Int64
+			}
+		case billingadjustment.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = value.Int64
+			}
+		case billingadjustment.FieldAmount:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value.Valid {
+				_m.Amount = value.Float64
+			}
+		case billingadjustment.FieldBalanceBefore:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field balance_before", values[i])
+			} else if value.Valid {
+				_m.BalanceBefore = value.Float64
+			}
+		case billingadjustment.FieldBalanceAfter:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field balance_after", values[i])
+			} else if value.Valid {
+				_m.BalanceAfter = value.Float64
+			}
+		case billingadjustment.FieldUsageRows:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field usage_rows", values[i])
+			} else if value.Valid {
+				_m.UsageRows = value.Int64
+			}
+		case billingadjustment.FieldOperatorID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field operator_id", values[i])
+			} else if value.Valid {
+				_m.OperatorID = value.Int64
+			}
+		case billingadjustment.FieldNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field note", values[i])
+			} else if value.Valid {
+				_m.Note = value.String
+			}
+		case billingadjustment.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BillingAdjustment.
+// This includes values selected through modifiers, order, etc.
+func (_m *BillingAdjustment) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BillingAdjustment.
+// Note that you need to call BillingAdjustment.Unwrap() before calling this method if this BillingAdjustment
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BillingAdjustment) Update() *BillingAdjustmentUpdateOne {
+	return NewBillingAdjustmentClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BillingAdjustment entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BillingAdjustment) Unwrap() *BillingAdjustment {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BillingAdjustment is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BillingAdjustment) String() string {
+	var builder strings.Builder
+	builder.WriteString("BillingAdjustment(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("job_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.JobID))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("balance_before=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BalanceBefore))
+	builder.WriteString(", ")
+	builder.WriteString("balance_after=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BalanceAfter))
+	builder.WriteString(", ")
+	builder.WriteString("usage_rows=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UsageRows))
+	builder.WriteString(", ")
+	builder.WriteString("operator_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OperatorID))
+	builder.WriteString(", ")
+	builder.WriteString("note=")
+	builder.WriteString(_m.Note)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BillingAdjustments is a parsable slice of BillingAdjustment.
+type BillingAdjustments []*BillingAdjustment
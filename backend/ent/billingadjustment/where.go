@@ -0,0 +1,510 @@
+// Code generated by ent, DO NOT EDIT.
+
+package billingadjustment
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Wei-Shaw/sub2api/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldLTE(FieldID, id))
+}
+
+// JobID applies equality check predicate on the "job_id" field. It's identical to JobIDEQ.
+func JobID(v int64) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldEQ(FieldJobID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int64) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldEQ(FieldUserID, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v float64) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldEQ(FieldAmount, v))
+}
+
+// BalanceBefore applies equality check predicate on the "balance_before" field. It's identical to BalanceBeforeEQ.
+func BalanceBefore(v float64) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldEQ(FieldBalanceBefore, v))
+}
+
+// BalanceAfter applies equality check predicate on the "balance_after" field. It's identical to BalanceAfterEQ.
+func BalanceAfter(v float64) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldEQ(FieldBalanceAfter, v))
+}
+
+// UsageRows applies equality check predicate on the "usage_rows" field. It's identical to UsageRowsEQ.
+func UsageRows(v int64) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldEQ(FieldUsageRows, v))
+}
+
+// OperatorID applies equality check predicate on the "operator_id" field. It's identical to OperatorIDEQ.
+func OperatorID(v int64) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldEQ(FieldOperatorID, v))
+}
+
+// Note applies equality check predicate on the "note" field. It's identical to NoteEQ.
+func Note(v string) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldEQ(FieldNote, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// JobIDEQ applies the EQ predicate on the "job_id" field.
+func JobIDEQ(v int64) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldEQ(FieldJobID, v))
+}
+
+// JobIDNEQ applies the NEQ predicate on the "job_id" field.
+func JobIDNEQ(v int64) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldNEQ(FieldJobID, v))
+}
+
+// JobIDIn applies the In predicate on the "job_id" field.
+func JobIDIn(vs ...int64) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldIn(FieldJobID, vs...))
+}
+
+// JobIDNotIn applies the NotIn predicate on the "job_id" field.
+func JobIDNotIn(vs ...int64) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldNotIn(FieldJobID, vs...))
+}
+
+// JobIDGT applies the GT predicate on the "job_id" field.
+func JobIDGT(v int64) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldGT(FieldJobID, v))
+}
+
+// JobIDGTE applies the GTE predicate on the "job_id" field.
+func JobIDGTE(v int64) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldGTE(FieldJobID, v))
+}
+
+// JobIDLT applies the LT predicate on the "job_id" field.
+func JobIDLT(v int64) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldLT(FieldJobID, v))
+}
+
+// JobIDLTE applies the LTE predicate on the "job_id" field.
+func JobIDLTE(v int64) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldLTE(FieldJobID, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int64) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int64) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int64) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int64) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v int64) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v int64) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v int64) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v int64) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldLTE(FieldUserID, v))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v float64) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v float64) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...float64) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...float64) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v float64) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v float64) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v float64) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v float64) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldLTE(FieldAmount, v))
+}
+
+// BalanceBeforeEQ applies the EQ predicate on the "balance_before" field.
+func BalanceBeforeEQ(v float64) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldEQ(FieldBalanceBefore, v))
+}
+
+// BalanceBeforeNEQ applies the NEQ predicate on the "balance_before" field.
+func BalanceBeforeNEQ(v float64) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldNEQ(FieldBalanceBefore, v))
+}
+
+// BalanceBeforeIn applies the In predicate on the "balance_before" field.
+func BalanceBeforeIn(vs ...float64) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldIn(FieldBalanceBefore, vs...))
+}
+
+// BalanceBeforeNotIn applies the NotIn predicate on the "balance_before" field.
+func BalanceBeforeNotIn(vs ...float64) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldNotIn(FieldBalanceBefore, vs...))
+}
+
+// BalanceBeforeGT applies the GT predicate on the "balance_before" field.
+func BalanceBeforeGT(v float64) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldGT(FieldBalanceBefore, v))
+}
+
+// BalanceBeforeGTE applies the GTE predicate on the "balance_before" field.
+func BalanceBeforeGTE(v float64) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldGTE(FieldBalanceBefore, v))
+}
+
+// BalanceBeforeLT applies the LT predicate on the "balance_before" field.
+func BalanceBeforeLT(v float64) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldLT(FieldBalanceBefore, v))
+}
+
+// BalanceBeforeLTE applies the LTE predicate on the "balance_before" field.
+func BalanceBeforeLTE(v float64) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldLTE(FieldBalanceBefore, v))
+}
+
+// BalanceAfterEQ applies the EQ predicate on the "balance_after" field.
+func BalanceAfterEQ(v float64) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldEQ(FieldBalanceAfter, v))
+}
+
+// BalanceAfterNEQ applies the NEQ predicate on the "balance_after" field.
+func BalanceAfterNEQ(v float64) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldNEQ(FieldBalanceAfter, v))
+}
+
+// BalanceAfterIn applies the In predicate on the "balance_after" field.
+func BalanceAfterIn(vs ...float64) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldIn(FieldBalanceAfter, vs...))
+}
+
+// BalanceAfterNotIn applies the NotIn predicate on the "balance_after" field.
+func BalanceAfterNotIn(vs ...float64) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldNotIn(FieldBalanceAfter, vs...))
+}
+
+// BalanceAfterGT applies the GT predicate on the "balance_after" field.
+func BalanceAfterGT(v float64) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldGT(FieldBalanceAfter, v))
+}
+
+// BalanceAfterGTE applies the GTE predicate on the "balance_after" field.
+func BalanceAfterGTE(v float64) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldGTE(FieldBalanceAfter, v))
+}
+
+// BalanceAfterLT applies the LT predicate on the "balance_after" field.
+func BalanceAfterLT(v float64) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldLT(FieldBalanceAfter, v))
+}
+
+// BalanceAfterLTE applies the LTE predicate on the "balance_after" field.
+func BalanceAfterLTE(v float64) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldLTE(FieldBalanceAfter, v))
+}
+
+// UsageRowsEQ applies the EQ predicate on the "usage_rows" field.
+func UsageRowsEQ(v int64) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldEQ(FieldUsageRows, v))
+}
+
+// UsageRowsNEQ applies the NEQ predicate on the "usage_rows" field.
+func UsageRowsNEQ(v int64) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldNEQ(FieldUsageRows, v))
+}
+
+// UsageRowsIn applies the In predicate on the "usage_rows" field.
+func UsageRowsIn(vs ...int64) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldIn(FieldUsageRows, vs...))
+}
+
+// UsageRowsNotIn applies the NotIn predicate on the "usage_rows" field.
+func UsageRowsNotIn(vs ...int64) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldNotIn(FieldUsageRows, vs...))
+}
+
+// UsageRowsGT applies the GT predicate on the "usage_rows" field.
+func UsageRowsGT(v int64) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldGT(FieldUsageRows, v))
+}
+
+// UsageRowsGTE applies the GTE predicate on the "usage_rows" field.
+func UsageRowsGTE(v int64) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldGTE(FieldUsageRows, v))
+}
+
+// UsageRowsLT applies the LT predicate on the "usage_rows" field.
+func UsageRowsLT(v int64) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldLT(FieldUsageRows, v))
+}
+
+// UsageRowsLTE applies the LTE predicate on the "usage_rows" field.
+func UsageRowsLTE(v int64) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldLTE(FieldUsageRows, v))
+}
+
+// OperatorIDEQ applies the EQ predicate on the "operator_id" field.
+func OperatorIDEQ(v int64) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldEQ(FieldOperatorID, v))
+}
+
+// OperatorIDNEQ applies the NEQ predicate on the "operator_id" field.
+func OperatorIDNEQ(v int64) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldNEQ(FieldOperatorID, v))
+}
+
+// OperatorIDIn applies the In predicate on the "operator_id" field.
+func OperatorIDIn(vs ...int64) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldIn(FieldOperatorID, vs...))
+}
+
+// OperatorIDNotIn applies the NotIn predicate on the "operator_id" field.
+func OperatorIDNotIn(vs ...int64) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldNotIn(FieldOperatorID, vs...))
+}
+
+// OperatorIDGT applies the GT predicate on the "operator_id" field.
+func OperatorIDGT(v int64) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldGT(FieldOperatorID, v))
+}
+
+// OperatorIDGTE applies the GTE predicate on the "operator_id" field.
+func OperatorIDGTE(v int64) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldGTE(FieldOperatorID, v))
+}
+
+// OperatorIDLT applies the LT predicate on the "operator_id" field.
+func OperatorIDLT(v int64) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldLT(FieldOperatorID, v))
+}
+
+// OperatorIDLTE applies the LTE predicate on the "operator_id" field.
+func OperatorIDLTE(v int64) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldLTE(FieldOperatorID, v))
+}
+
+// NoteEQ applies the EQ predicate on the "note" field.
+func NoteEQ(v string) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldEQ(FieldNote, v))
+}
+
+// NoteNEQ applies the NEQ predicate on the "note" field.
+func NoteNEQ(v string) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldNEQ(FieldNote, v))
+}
+
+// NoteIn applies the In predicate on the "note" field.
+func NoteIn(vs ...string) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldIn(FieldNote, vs...))
+}
+
+// NoteNotIn applies the NotIn predicate on the "note" field.
+func NoteNotIn(vs ...string) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldNotIn(FieldNote, vs...))
+}
+
+// NoteGT applies the GT predicate on the "note" field.
+func NoteGT(v string) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldGT(FieldNote, v))
+}
+
+// NoteGTE applies the GTE predicate on the "note" field.
+func NoteGTE(v string) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldGTE(FieldNote, v))
+}
+
+// NoteLT applies the LT predicate on the "note" field.
+func NoteLT(v string) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldLT(FieldNote, v))
+}
+
+// NoteLTE applies the LTE predicate on the "note" field.
+func NoteLTE(v string) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldLTE(FieldNote, v))
+}
+
+// NoteContains applies the Contains predicate on the "note" field.
+func NoteContains(v string) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldContains(FieldNote, v))
+}
+
+// NoteHasPrefix applies the HasPrefix predicate on the "note" field.
+func NoteHasPrefix(v string) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldHasPrefix(FieldNote, v))
+}
+
+// NoteHasSuffix applies the HasSuffix predicate on the "note" field.
+func NoteHasSuffix(v string) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldHasSuffix(FieldNote, v))
+}
+
+// NoteIsNil applies the IsNil predicate on the "note" field.
+func NoteIsNil() predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldIsNull(FieldNote))
+}
+
+// NoteNotNil applies the NotNil predicate on the "note" field.
+func NoteNotNil() predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldNotNull(FieldNote))
+}
+
+// NoteEqualFold applies the EqualFold predicate on the "note" field.
+func NoteEqualFold(v string) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldEqualFold(FieldNote, v))
+}
+
+// NoteContainsFold applies the ContainsFold predicate on the "note" field.
+func NoteContainsFold(v string) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldContainsFold(FieldNote, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BillingAdjustment) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BillingAdjustment) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BillingAdjustment) predicate.BillingAdjustment {
+	return predicate.BillingAdjustment(sql.NotPredicates(p))
+}
@@ -0,0 +1,1046 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Wei-Shaw/sub2api/ent/billingadjustment"
+)
+
+// BillingAdjustmentCreate is the builder for creating a BillingAdjustment entity.
+type BillingAdjustmentCreate struct {
+	config
+	mutation *BillingAdjustmentMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetJobID sets the "job_id" field.
+func (_c *BillingAdjustmentCreate) SetJobID(v int64) *BillingAdjustmentCreate {
+	_c.mutation.SetJobID(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *BillingAdjustmentCreate) SetUserID(v int64) *BillingAdjustmentCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetAmount sets the "amount" field.
+func (_c *BillingAdjustmentCreate) SetAmount(v float64) *BillingAdjustmentCreate {
+	_c.mutation.SetAmount(v)
+	return _c
+}
+
+// SetBalanceBefore sets the "balance_before" field.
+func (_c *BillingAdjustmentCreate) SetBalanceBefore(v float64) *BillingAdjustmentCreate {
+	_c.mutation.SetBalanceBefore(v)
+	return _c
+}
+
+// SetBalanceAfter sets the "balance_after" field.
+func (_c *BillingAdjustmentCreate) SetBalanceAfter(v float64) *BillingAdjustmentCreate {
+	_c.mutation.SetBalanceAfter(v)
+	return _c
+}
+
+// SetUsageRows sets the "usage_rows" field.
+func (_c *BillingAdjustmentCreate) SetUsageRows(v int64) *BillingAdjustmentCreate {
+	_c.mutation.SetUsageRows(v)
+	return _c
+}
+
+// SetNillableUsageRows sets the "usage_rows" field if the given value is not nil.
+func (_c *BillingAdjustmentCreate) SetNillableUsageRows(v *int64) *BillingAdjustmentCreate {
+	if v != nil {
+		_c.SetUsageRows(*v)
+	}
+	return _c
+}
+
+// SetOperatorID sets the "operator_id" field.
+func (_c *BillingAdjustmentCreate) SetOperatorID(v int64) *BillingAdjustmentCreate {
+	_c.mutation.SetOperatorID(v)
+	return _c
+}
+
+// SetNote sets the "note" field.
+func (_c *BillingAdjustmentCreate) SetNote(v string) *BillingAdjustmentCreate {
+	_c.mutation.SetNote(v)
+	return _c
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_c *BillingAdjustmentCreate) SetNillableNote(v *string) *BillingAdjustmentCreate {
+	if v != nil {
+		_c.SetNote(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *BillingAdjustmentCreate) SetCreatedAt(v time.Time) *BillingAdjustmentCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *BillingAdjustmentCreate) SetNillableCreatedAt(v *time.Time) *BillingAdjustmentCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the BillingAdjustmentMutation object of the builder.
+func (_c *BillingAdjustmentCreate) Mutation() *BillingAdjustmentMutation {
+	return _c.mutation
+}
+
+// Save creates the BillingAdjustment in the database.
+func (_c *BillingAdjustmentCreate) Save(ctx context.Context) (*BillingAdjustment, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BillingAdjustmentCreate) SaveX(ctx context.Context) *BillingAdjustment {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BillingAdjustmentCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BillingAdjustmentCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BillingAdjustmentCreate) defaults() {
+	if _, ok := _c.mutation.UsageRows(); !ok {
+		v := billingadjustment.DefaultUsageRows
+		_c.mutation.SetUsageRows(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := billingadjustment.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BillingAdjustmentCreate) check() error {
+	if _, ok := _c.mutation.JobID(); !ok {
+		return &ValidationError{Name: "job_id", err: errors.New(`ent: missing required field "BillingAdjustment.job_id"`)}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "BillingAdjustment.user_id"`)}
+	}
+	if _, ok := _c.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "BillingAdjustment.amount"`)}
+	}
+	if _, ok := _c.mutation.BalanceBefore(); !ok {
+		return &ValidationError{Name: "balance_before", err: errors.New(`ent: missing required field "BillingAdjustment.balance_before"`)}
+	}
+	if _, ok := _c.mutation.BalanceAfter(); !ok {
+		return &ValidationError{Name: "balance_after", err: errors.New(`ent: missing required field "BillingAdjustment.balance_after"`)}
+	}
+	if _, ok := _c.mutation.UsageRows(); !ok {
+		return &ValidationError{Name: "usage_rows", err: errors.New(`ent: missing required field "BillingAdjustment.usage_rows"`)}
+	}
+	if _, ok := _c.mutation.OperatorID(); !ok {
+		return &ValidationError{Name: "operator_id", err: errors.New(`ent: missing required field "BillingAdjustment.operator_id"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "BillingAdjustment.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *BillingAdjustmentCreate) sqlSave(ctx context.Context) (*BillingAdjustment, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int64(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BillingAdjustmentCreate) createSpec() (*BillingAdjustment, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BillingAdjustment{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(billingadjustment.Table, sqlgraph.NewFieldSpec(billingadjustment.FieldID, field.TypeInt64))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.JobID(); ok {
+		_spec.SetField(billingadjustment.FieldJobID, field.TypeInt64, value)
+		_node.JobID = value
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(billingadjustment.FieldUserID, field.TypeInt64, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.Amount(); ok {
+		_spec.SetField(billingadjustment.FieldAmount, field.TypeFloat64, value)
+		_node.Amount = value
+	}
+	if value, ok := _c.mutation.BalanceBefore(); ok {
+		_spec.SetField(billingadjustment.FieldBalanceBefore, field.TypeFloat64, value)
+		_node.BalanceBefore = value
+	}
+	if value, ok := _c.mutation.BalanceAfter(); ok {
+		_spec.SetField(billingadjustment.FieldBalanceAfter, field.TypeFloat64, value)
+		_node.BalanceAfter = value
+	}
+	if value, ok := _c.mutation.UsageRows(); ok {
+		_spec.SetField(billingadjustment.FieldUsageRows, field.TypeInt64, value)
+		_node.UsageRows = value
+	}
+	if value, ok := _c.mutation.OperatorID(); ok {
+		_spec.SetField(billingadjustment.FieldOperatorID, field.TypeInt64, value)
+		_node.OperatorID = value
+	}
+	if value, ok := _c.mutation.Note(); ok {
+		_spec.SetField(billingadjustment.FieldNote, field.TypeString, value)
+		_node.Note = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(billingadjustment.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.BillingAdjustment.Create().
+//		SetJobID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BillingAdjustmentUpsert) {
+//			SetJobID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *BillingAdjustmentCreate) OnConflict(opts ...sql.ConflictOption) *BillingAdjustmentUpsertOne {
+	_c.conflict = opts
+	return &BillingAdjustmentUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.BillingAdjustment.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *BillingAdjustmentCreate) OnConflictColumns(columns ...string) *BillingAdjustmentUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &BillingAdjustmentUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// BillingAdjustmentUpsertOne is the builder for "upsert"-ing
+	//  one BillingAdjustment node.
+	BillingAdjustmentUpsertOne struct {
+		create *BillingAdjustmentCreate
+	}
+
+	// BillingAdjustmentUpsert is the "OnConflict" setter.
+	BillingAdjustmentUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetJobID sets the "job_id" field.
+func (u *BillingAdjustmentUpsert) SetJobID(v int64) *BillingAdjustmentUpsert {
+	u.Set(billingadjustment.FieldJobID, v)
+	return u
+}
+
+// UpdateJobID sets the "job_id" field to the value that was provided on create.
+func (u *BillingAdjustmentUpsert) UpdateJobID() *BillingAdjustmentUpsert {
+	u.SetExcluded(billingadjustment.FieldJobID)
+	return u
+}
+
+// AddJobID adds v to the "job_id" field.
+func (u *BillingAdjustmentUpsert) AddJobID(v int64) *BillingAdjustmentUpsert {
+	u.Add(billingadjustment.FieldJobID, v)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *BillingAdjustmentUpsert) SetUserID(v int64) *BillingAdjustmentUpsert {
+	u.Set(billingadjustment.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *BillingAdjustmentUpsert) UpdateUserID() *BillingAdjustmentUpsert {
+	u.SetExcluded(billingadjustment.FieldUserID)
+	return u
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *BillingAdjustmentUpsert) AddUserID(v int64) *BillingAdjustmentUpsert {
+	u.Add(billingadjustment.FieldUserID, v)
+	return u
+}
+
+// SetAmount sets the "amount" field.
+func (u *BillingAdjustmentUpsert) SetAmount(v float64) *BillingAdjustmentUpsert {
+	u.Set(billingadjustment.FieldAmount, v)
+	return u
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *BillingAdjustmentUpsert) UpdateAmount() *BillingAdjustmentUpsert {
+	u.SetExcluded(billingadjustment.FieldAmount)
+	return u
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *BillingAdjustmentUpsert) AddAmount(v float64) *BillingAdjustmentUpsert {
+	u.Add(billingadjustment.FieldAmount, v)
+	return u
+}
+
+// SetBalanceBefore sets the "balance_before" field.
+func (u *BillingAdjustmentUpsert) SetBalanceBefore(v float64) *BillingAdjustmentUpsert {
+	u.Set(billingadjustment.FieldBalanceBefore, v)
+	return u
+}
+
+// UpdateBalanceBefore sets the "balance_before" field to the value that was provided on create.
+func (u *BillingAdjustmentUpsert) UpdateBalanceBefore() *BillingAdjustmentUpsert {
+	u.SetExcluded(billingadjustment.FieldBalanceBefore)
+	return u
+}
+
+// AddBalanceBefore adds v to the "balance_before" field.
+func (u *BillingAdjustmentUpsert) AddBalanceBefore(v float64) *BillingAdjustmentUpsert {
+	u.Add(billingadjustment.FieldBalanceBefore, v)
+	return u
+}
+
+// SetBalanceAfter sets the "balance_after" field.
+func (u *BillingAdjustmentUpsert) SetBalanceAfter(v float64) *BillingAdjustmentUpsert {
+	u.Set(billingadjustment.FieldBalanceAfter, v)
+	return u
+}
+
+// UpdateBalanceAfter sets the "balance_after" field to the value that was provided on create.
+func (u *BillingAdjustmentUpsert) UpdateBalanceAfter() *BillingAdjustmentUpsert {
+	u.SetExcluded(billingadjustment.FieldBalanceAfter)
+	return u
+}
+
+// AddBalanceAfter adds v to the "balance_after" field.
+func (u *BillingAdjustmentUpsert) AddBalanceAfter(v float64) *BillingAdjustmentUpsert {
+	u.Add(billingadjustment.FieldBalanceAfter, v)
+	return u
+}
+
+// SetUsageRows sets the "usage_rows" field.
+func (u *BillingAdjustmentUpsert) SetUsageRows(v int64) *BillingAdjustmentUpsert {
+	u.Set(billingadjustment.FieldUsageRows, v)
+	return u
+}
+
+// UpdateUsageRows sets the "usage_rows" field to the value that was provided on create.
+func (u *BillingAdjustmentUpsert) UpdateUsageRows() *BillingAdjustmentUpsert {
+	u.SetExcluded(billingadjustment.FieldUsageRows)
+	return u
+}
+
+// AddUsageRows adds v to the "usage_rows" field.
+func (u *BillingAdjustmentUpsert) AddUsageRows(v int64) *BillingAdjustmentUpsert {
+	u.Add(billingadjustment.FieldUsageRows, v)
+	return u
+}
+
+// SetOperatorID sets the "operator_id" field.
+func (u *BillingAdjustmentUpsert) SetOperatorID(v int64) *BillingAdjustmentUpsert {
+	u.Set(billingadjustment.FieldOperatorID, v)
+	return u
+}
+
+// UpdateOperatorID sets the "operator_id" field to the value that was provided on create.
+func (u *BillingAdjustmentUpsert) UpdateOperatorID() *BillingAdjustmentUpsert {
+	u.SetExcluded(billingadjustment.FieldOperatorID)
+	return u
+}
+
+// AddOperatorID adds v to the "operator_id" field.
+func (u *BillingAdjustmentUpsert) AddOperatorID(v int64) *BillingAdjustmentUpsert {
+	u.Add(billingadjustment.FieldOperatorID, v)
+	return u
+}
+
+// SetNote sets the "note" field.
+func (u *BillingAdjustmentUpsert) SetNote(v string) *BillingAdjustmentUpsert {
+	u.Set(billingadjustment.FieldNote, v)
+	return u
+}
+
+// UpdateNote sets the "note" field to the value that was provided on create.
+func (u *BillingAdjustmentUpsert) UpdateNote() *BillingAdjustmentUpsert {
+	u.SetExcluded(billingadjustment.FieldNote)
+	return u
+}
+
+// ClearNote clears the value of the "note" field.
+func (u *BillingAdjustmentUpsert) ClearNote() *BillingAdjustmentUpsert {
+	u.SetNull(billingadjustment.FieldNote)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.BillingAdjustment.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *BillingAdjustmentUpsertOne) UpdateNewValues() *BillingAdjustmentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(billingadjustment.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.BillingAdjustment.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *BillingAdjustmentUpsertOne) Ignore() *BillingAdjustmentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BillingAdjustmentUpsertOne) DoNothing() *BillingAdjustmentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BillingAdjustmentCreate.OnConflict
+// documentation for more info.
+func (u *BillingAdjustmentUpsertOne) Update(set func(*BillingAdjustmentUpsert)) *BillingAdjustmentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BillingAdjustmentUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetJobID sets the "job_id" field.
+func (u *BillingAdjustmentUpsertOne) SetJobID(v int64) *BillingAdjustmentUpsertOne {
+	return u.Update(func(s *BillingAdjustmentUpsert) {
+		s.SetJobID(v)
+	})
+}
+
+// AddJobID adds v to the "job_id" field.
+func (u *BillingAdjustmentUpsertOne) AddJobID(v int64) *BillingAdjustmentUpsertOne {
+	return u.Update(func(s *BillingAdjustmentUpsert) {
+		s.AddJobID(v)
+	})
+}
+
+// UpdateJobID sets the "job_id" field to the value that was provided on create.
+func (u *BillingAdjustmentUpsertOne) UpdateJobID() *BillingAdjustmentUpsertOne {
+	return u.Update(func(s *BillingAdjustmentUpsert) {
+		s.UpdateJobID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *BillingAdjustmentUpsertOne) SetUserID(v int64) *BillingAdjustmentUpsertOne {
+	return u.Update(func(s *BillingAdjustmentUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *BillingAdjustmentUpsertOne) AddUserID(v int64) *BillingAdjustmentUpsertOne {
+	return u.Update(func(s *BillingAdjustmentUpsert) {
+		s.AddUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *BillingAdjustmentUpsertOne) UpdateUserID() *BillingAdjustmentUpsertOne {
+	return u.Update(func(s *BillingAdjustmentUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *BillingAdjustmentUpsertOne) SetAmount(v float64) *BillingAdjustmentUpsertOne {
+	return u.Update(func(s *BillingAdjustmentUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *BillingAdjustmentUpsertOne) AddAmount(v float64) *BillingAdjustmentUpsertOne {
+	return u.Update(func(s *BillingAdjustmentUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *BillingAdjustmentUpsertOne) UpdateAmount() *BillingAdjustmentUpsertOne {
+	return u.Update(func(s *BillingAdjustmentUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetBalanceBefore sets the "balance_before" field.
+func (u *BillingAdjustmentUpsertOne) SetBalanceBefore(v float64) *BillingAdjustmentUpsertOne {
+	return u.Update(func(s *BillingAdjustmentUpsert) {
+		s.SetBalanceBefore(v)
+	})
+}
+
+// AddBalanceBefore adds v to the "balance_before" field.
+func (u *BillingAdjustmentUpsertOne) AddBalanceBefore(v float64) *BillingAdjustmentUpsertOne {
+	return u.Update(func(s *BillingAdjustmentUpsert) {
+		s.AddBalanceBefore(v)
+	})
+}
+
+// UpdateBalanceBefore sets the "balance_before" field to the value that was provided on create.
+func (u *BillingAdjustmentUpsertOne) UpdateBalanceBefore() *BillingAdjustmentUpsertOne {
+	return u.Update(func(s *BillingAdjustmentUpsert) {
+		s.UpdateBalanceBefore()
+	})
+}
+
+// SetBalanceAfter sets the "balance_after" field.
+func (u *BillingAdjustmentUpsertOne) SetBalanceAfter(v float64) *BillingAdjustmentUpsertOne {
+	return u.Update(func(s *BillingAdjustmentUpsert) {
+		s.SetBalanceAfter(v)
+	})
+}
+
+// AddBalanceAfter adds v to the "balance_after" field.
+func (u *BillingAdjustmentUpsertOne) AddBalanceAfter(v float64) *BillingAdjustmentUpsertOne {
+	return u.Update(func(s *BillingAdjustmentUpsert) {
+		s.AddBalanceAfter(v)
+	})
+}
+
+// UpdateBalanceAfter sets the "balance_after" field to the value that was provided on create.
+func (u *BillingAdjustmentUpsertOne) UpdateBalanceAfter() *BillingAdjustmentUpsertOne {
+	return u.Update(func(s *BillingAdjustmentUpsert) {
+		s.UpdateBalanceAfter()
+	})
+}
+
+// SetUsageRows sets the "usage_rows" field.
+func (u *BillingAdjustmentUpsertOne) SetUsageRows(v int64) *BillingAdjustmentUpsertOne {
+	return u.Update(func(s *BillingAdjustmentUpsert) {
+		s.SetUsageRows(v)
+	})
+}
+
+// AddUsageRows adds v to the "usage_rows" field.
+func (u *BillingAdjustmentUpsertOne) AddUsageRows(v int64) *BillingAdjustmentUpsertOne {
+	return u.Update(func(s *BillingAdjustmentUpsert) {
+		s.AddUsageRows(v)
+	})
+}
+
+// UpdateUsageRows sets the "usage_rows" field to the value that was provided on create.
+func (u *BillingAdjustmentUpsertOne) UpdateUsageRows() *BillingAdjustmentUpsertOne {
+	return u.Update(func(s *BillingAdjustmentUpsert) {
+		s.UpdateUsageRows()
+	})
+}
+
+// SetOperatorID sets the "operator_id" field.
+func (u *BillingAdjustmentUpsertOne) SetOperatorID(v int64) *BillingAdjustmentUpsertOne {
+	return u.Update(func(s *BillingAdjustmentUpsert) {
+		s.SetOperatorID(v)
+	})
+}
+
+// AddOperatorID adds v to the "operator_id" field.
+func (u *BillingAdjustmentUpsertOne) AddOperatorID(v int64) *BillingAdjustmentUpsertOne {
+	return u.Update(func(s *BillingAdjustmentUpsert) {
+		s.AddOperatorID(v)
+	})
+}
+
+// UpdateOperatorID sets the "operator_id" field to the value that was provided on create.
+func (u *BillingAdjustmentUpsertOne) UpdateOperatorID() *BillingAdjustmentUpsertOne {
+	return u.Update(func(s *BillingAdjustmentUpsert) {
+		s.UpdateOperatorID()
+	})
+}
+
+// SetNote sets the "note" field.
+func (u *BillingAdjustmentUpsertOne) SetNote(v string) *BillingAdjustmentUpsertOne {
+	return u.Update(func(s *BillingAdjustmentUpsert) {
+		s.SetNote(v)
+	})
+}
+
+// UpdateNote sets the "note" field to the value that was provided on create.
+func (u *BillingAdjustmentUpsertOne) UpdateNote() *BillingAdjustmentUpsertOne {
+	return u.Update(func(s *BillingAdjustmentUpsert) {
+		s.UpdateNote()
+	})
+}
+
+// ClearNote clears the value of the "note" field.
+func (u *BillingAdjustmentUpsertOne) ClearNote() *BillingAdjustmentUpsertOne {
+	return u.Update(func(s *BillingAdjustmentUpsert) {
+		s.ClearNote()
+	})
+}
+
+// Exec executes the query.
+func (u *BillingAdjustmentUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BillingAdjustmentCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BillingAdjustmentUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *BillingAdjustmentUpsertOne) ID(ctx context.Context) (id int64, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *BillingAdjustmentUpsertOne) IDX(ctx context.Context) int64 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// BillingAdjustmentCreateBulk is the builder for creating many BillingAdjustment entities in bulk.
+type BillingAdjustmentCreateBulk struct {
+	config
+	err      error
+	builders []*BillingAdjustmentCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the BillingAdjustment entities in the database.
+func (_c *BillingAdjustmentCreateBulk) Save(ctx context.Context) ([]*BillingAdjustment, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BillingAdjustment, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BillingAdjustmentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BillingAdjustmentCreateBulk) SaveX(ctx context.Context) []*BillingAdjustment {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BillingAdjustmentCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BillingAdjustmentCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.BillingAdjustment.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BillingAdjustmentUpsert) {
+//			SetJobID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *BillingAdjustmentCreateBulk) OnConflict(opts ...sql.ConflictOption) *BillingAdjustmentUpsertBulk {
+	_c.conflict = opts
+	return &BillingAdjustmentUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.BillingAdjustment.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *BillingAdjustmentCreateBulk) OnConflictColumns(columns ...string) *BillingAdjustmentUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &BillingAdjustmentUpsertBulk{
+		create: _c,
+	}
+}
+
+// BillingAdjustmentUpsertBulk is the builder for "upsert"-ing
+// a bulk of BillingAdjustment nodes.
+type BillingAdjustmentUpsertBulk struct {
+	create *BillingAdjustmentCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.BillingAdjustment.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *BillingAdjustmentUpsertBulk) UpdateNewValues() *BillingAdjustmentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(billingadjustment.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.BillingAdjustment.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *BillingAdjustmentUpsertBulk) Ignore() *BillingAdjustmentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BillingAdjustmentUpsertBulk) DoNothing() *BillingAdjustmentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BillingAdjustmentCreateBulk.OnConflict
+// documentation for more info.
+func (u *BillingAdjustmentUpsertBulk) Update(set func(*BillingAdjustmentUpsert)) *BillingAdjustmentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BillingAdjustmentUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetJobID sets the "job_id" field.
+func (u *BillingAdjustmentUpsertBulk) SetJobID(v int64) *BillingAdjustmentUpsertBulk {
+	return u.Update(func(s *BillingAdjustmentUpsert) {
+		s.SetJobID(v)
+	})
+}
+
+// AddJobID adds v to the "job_id" field.
+func (u *BillingAdjustmentUpsertBulk) AddJobID(v int64) *BillingAdjustmentUpsertBulk {
+	return u.Update(func(s *BillingAdjustmentUpsert) {
+		s.AddJobID(v)
+	})
+}
+
+// UpdateJobID sets the "job_id" field to the value that was provided on create.
+func (u *BillingAdjustmentUpsertBulk) UpdateJobID() *BillingAdjustmentUpsertBulk {
+	return u.Update(func(s *BillingAdjustmentUpsert) {
+		s.UpdateJobID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *BillingAdjustmentUpsertBulk) SetUserID(v int64) *BillingAdjustmentUpsertBulk {
+	return u.Update(func(s *BillingAdjustmentUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *BillingAdjustmentUpsertBulk) AddUserID(v int64) *BillingAdjustmentUpsertBulk {
+	return u.Update(func(s *BillingAdjustmentUpsert) {
+		s.AddUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *BillingAdjustmentUpsertBulk) UpdateUserID() *BillingAdjustmentUpsertBulk {
+	return u.Update(func(s *BillingAdjustmentUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *BillingAdjustmentUpsertBulk) SetAmount(v float64) *BillingAdjustmentUpsertBulk {
+	return u.Update(func(s *BillingAdjustmentUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *BillingAdjustmentUpsertBulk) AddAmount(v float64) *BillingAdjustmentUpsertBulk {
+	return u.Update(func(s *BillingAdjustmentUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *BillingAdjustmentUpsertBulk) UpdateAmount() *BillingAdjustmentUpsertBulk {
+	return u.Update(func(s *BillingAdjustmentUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetBalanceBefore sets the "balance_before" field.
+func (u *BillingAdjustmentUpsertBulk) SetBalanceBefore(v float64) *BillingAdjustmentUpsertBulk {
+	return u.Update(func(s *BillingAdjustmentUpsert) {
+		s.SetBalanceBefore(v)
+	})
+}
+
+// AddBalanceBefore adds v to the "balance_before" field.
+func (u *BillingAdjustmentUpsertBulk) AddBalanceBefore(v float64) *BillingAdjustmentUpsertBulk {
+	return u.Update(func(s *BillingAdjustmentUpsert) {
+		s.AddBalanceBefore(v)
+	})
+}
+
+// UpdateBalanceBefore sets the "balance_before" field to the value that was provided on create.
+func (u *BillingAdjustmentUpsertBulk) UpdateBalanceBefore() *BillingAdjustmentUpsertBulk {
+	return u.Update(func(s *BillingAdjustmentUpsert) {
+		s.UpdateBalanceBefore()
+	})
+}
+
+// SetBalanceAfter sets the "balance_after" field.
+func (u *BillingAdjustmentUpsertBulk) SetBalanceAfter(v float64) *BillingAdjustmentUpsertBulk {
+	return u.Update(func(s *BillingAdjustmentUpsert) {
+		s.SetBalanceAfter(v)
+	})
+}
+
+// AddBalanceAfter adds v to the "balance_after" field.
+func (u *BillingAdjustmentUpsertBulk) AddBalanceAfter(v float64) *BillingAdjustmentUpsertBulk {
+	return u.Update(func(s *BillingAdjustmentUpsert) {
+		s.AddBalanceAfter(v)
+	})
+}
+
+// UpdateBalanceAfter sets the "balance_after" field to the value that was provided on create.
+func (u *BillingAdjustmentUpsertBulk) UpdateBalanceAfter() *BillingAdjustmentUpsertBulk {
+	return u.Update(func(s *BillingAdjustmentUpsert) {
+		s.UpdateBalanceAfter()
+	})
+}
+
+// SetUsageRows sets the "usage_rows" field.
+func (u *BillingAdjustmentUpsertBulk) SetUsageRows(v int64) *BillingAdjustmentUpsertBulk {
+	return u.Update(func(s *BillingAdjustmentUpsert) {
+		s.SetUsageRows(v)
+	})
+}
+
+// AddUsageRows adds v to the "usage_rows" field.
+func (u *BillingAdjustmentUpsertBulk) AddUsageRows(v int64) *BillingAdjustmentUpsertBulk {
+	return u.Update(func(s *BillingAdjustmentUpsert) {
+		s.AddUsageRows(v)
+	})
+}
+
+// UpdateUsageRows sets the "usage_rows" field to the value that was provided on create.
+func (u *BillingAdjustmentUpsertBulk) UpdateUsageRows() *BillingAdjustmentUpsertBulk {
+	return u.Update(func(s *BillingAdjustmentUpsert) {
+		s.UpdateUsageRows()
+	})
+}
+
+// SetOperatorID sets the "operator_id" field.
+func (u *BillingAdjustmentUpsertBulk) SetOperatorID(v int64) *BillingAdjustmentUpsertBulk {
+	return u.Update(func(s *BillingAdjustmentUpsert) {
+		s.SetOperatorID(v)
+	})
+}
+
+// AddOperatorID adds v to the "operator_id" field.
+func (u *BillingAdjustmentUpsertBulk) AddOperatorID(v int64) *BillingAdjustmentUpsertBulk {
+	return u.Update(func(s *BillingAdjustmentUpsert) {
+		s.AddOperatorID(v)
+	})
+}
+
+// UpdateOperatorID sets the "operator_id" field to the value that was provided on create.
+func (u *BillingAdjustmentUpsertBulk) UpdateOperatorID() *BillingAdjustmentUpsertBulk {
+	return u.Update(func(s *BillingAdjustmentUpsert) {
+		s.UpdateOperatorID()
+	})
+}
+
+// SetNote sets the "note" field.
+func (u *BillingAdjustmentUpsertBulk) SetNote(v string) *BillingAdjustmentUpsertBulk {
+	return u.Update(func(s *BillingAdjustmentUpsert) {
+		s.SetNote(v)
+	})
+}
+
+// UpdateNote sets the "note" field to the value that was provided on create.
+func (u *BillingAdjustmentUpsertBulk) UpdateNote() *BillingAdjustmentUpsertBulk {
+	return u.Update(func(s *BillingAdjustmentUpsert) {
+		s.UpdateNote()
+	})
+}
+
+// ClearNote clears the value of the "note" field.
+func (u *BillingAdjustmentUpsertBulk) ClearNote() *BillingAdjustmentUpsertBulk {
+	return u.Update(func(s *BillingAdjustmentUpsert) {
+		s.ClearNote()
+	})
+}
+
+// Exec executes the query.
+func (u *BillingAdjustmentUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the BillingAdjustmentCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BillingAdjustmentCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BillingAdjustmentUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
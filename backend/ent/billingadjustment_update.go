@@ -0,0 +1,605 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Wei-Shaw/sub2api/ent/billingadjustment"
+	"github.com/Wei-Shaw/sub2api/ent/predicate"
+)
+
+// BillingAdjustmentUpdate is the builder for updating BillingAdjustment entities.
+type BillingAdjustmentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BillingAdjustmentMutation
+}
+
+// Where appends a list predicates to the BillingAdjustmentUpdate builder.
+func (_u *BillingAdjustmentUpdate) Where(ps ...predicate.BillingAdjustment) *BillingAdjustmentUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetJobID sets the "job_id" field.
+func (_u *BillingAdjustmentUpdate) SetJobID(v int64) *BillingAdjustmentUpdate {
+	_u.mutation.ResetJobID()
+	_u.mutation.SetJobID(v)
+	return _u
+}
+
+// SetNillableJobID sets the "job_id" field if the given value is not nil.
+func (_u *BillingAdjustmentUpdate) SetNillableJobID(v *int64) *BillingAdjustmentUpdate {
+	if v != nil {
+		_u.SetJobID(*v)
+	}
+	return _u
+}
+
+// AddJobID adds value to the "job_id" field.
+func (_u *BillingAdjustmentUpdate) AddJobID(v int64) *BillingAdjustmentUpdate {
+	_u.mutation.AddJobID(v)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *BillingAdjustmentUpdate) SetUserID(v int64) *BillingAdjustmentUpdate {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *BillingAdjustmentUpdate) SetNillableUserID(v *int64) *BillingAdjustmentUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *BillingAdjustmentUpdate) AddUserID(v int64) *BillingAdjustmentUpdate {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *BillingAdjustmentUpdate) SetAmount(v float64) *BillingAdjustmentUpdate {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *BillingAdjustmentUpdate) SetNillableAmount(v *float64) *BillingAdjustmentUpdate {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *BillingAdjustmentUpdate) AddAmount(v float64) *BillingAdjustmentUpdate {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetBalanceBefore sets the "balance_before" field.
+func (_u *BillingAdjustmentUpdate) SetBalanceBefore(v float64) *BillingAdjustmentUpdate {
+	_u.mutation.ResetBalanceBefore()
+	_u.mutation.SetBalanceBefore(v)
+	return _u
+}
+
+// SetNillableBalanceBefore sets the "balance_before" field if the given value is not nil.
+func (_u *BillingAdjustmentUpdate) SetNillableBalanceBefore(v *float64) *BillingAdjustmentUpdate {
+	if v != nil {
+		_u.SetBalanceBefore(*v)
+	}
+	return _u
+}
+
+// AddBalanceBefore adds value to the "balance_before" field.
+func (_u *BillingAdjustmentUpdate) AddBalanceBefore(v float64) *BillingAdjustmentUpdate {
+	_u.mutation.AddBalanceBefore(v)
+	return _u
+}
+
+// SetBalanceAfter sets the "balance_after" field.
+func (_u *BillingAdjustmentUpdate) SetBalanceAfter(v float64) *BillingAdjustmentUpdate {
+	_u.mutation.ResetBalanceAfter()
+	_u.mutation.SetBalanceAfter(v)
+	return _u
+}
+
+// SetNillableBalanceAfter sets the "balance_after" field if the given value is not nil.
+func (_u *BillingAdjustmentUpdate) SetNillableBalanceAfter(v *float64) *BillingAdjustmentUpdate {
+	if v != nil {
+		_u.SetBalanceAfter(*v)
+	}
+	return _u
+}
+
+// AddBalanceAfter adds value to the "balance_after" field.
+func (_u *BillingAdjustmentUpdate) AddBalanceAfter(v float64) *BillingAdjustmentUpdate {
+	_u.mutation.AddBalanceAfter(v)
+	return _u
+}
+
+// SetUsageRows sets the "usage_rows" field.
+func (_u *BillingAdjustmentUpdate) SetUsageRows(v int64) *BillingAdjustmentUpdate {
+	_u.mutation.ResetUsageRows()
+	_u.mutation.SetUsageRows(v)
+	return _u
+}
+
+// SetNillableUsageRows sets the "usage_rows" field if the given value is not nil.
+func (_u *BillingAdjustmentUpdate) SetNillableUsageRows(v *int64) *BillingAdjustmentUpdate {
+	if v != nil {
+		_u.SetUsageRows(*v)
+	}
+	return _u
+}
+
+// AddUsageRows adds value to the "usage_rows" field.
+func (_u *BillingAdjustmentUpdate) AddUsageRows(v int64) *BillingAdjustmentUpdate {
+	_u.mutation.AddUsageRows(v)
+	return _u
+}
+
+// SetOperatorID sets the "operator_id" field.
+func (_u *BillingAdjustmentUpdate) SetOperatorID(v int64) *BillingAdjustmentUpdate {
+	_u.mutation.ResetOperatorID()
+	_u.mutation.SetOperatorID(v)
+	return _u
+}
+
+// SetNillableOperatorID sets the "operator_id" field if the given value is not nil.
+func (_u *BillingAdjustmentUpdate) SetNillableOperatorID(v *int64) *BillingAdjustmentUpdate {
+	if v != nil {
+		_u.SetOperatorID(*v)
+	}
+	return _u
+}
+
+// AddOperatorID adds value to the "operator_id" field.
+func (_u *BillingAdjustmentUpdate) AddOperatorID(v int64) *BillingAdjustmentUpdate {
+	_u.mutation.AddOperatorID(v)
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *BillingAdjustmentUpdate) SetNote(v string) *BillingAdjustmentUpdate {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *BillingAdjustmentUpdate) SetNillableNote(v *string) *BillingAdjustmentUpdate {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// ClearNote clears the value of the "note" field.
+func (_u *BillingAdjustmentUpdate) ClearNote() *BillingAdjustmentUpdate {
+	_u.mutation.ClearNote()
+	return _u
+}
+
+// Mutation returns the BillingAdjustmentMutation object of the builder.
+func (_u *BillingAdjustmentUpdate) Mutation() *BillingAdjustmentMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BillingAdjustmentUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BillingAdjustmentUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BillingAdjustmentUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BillingAdjustmentUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *BillingAdjustmentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(billingadjustment.Table, billingadjustment.Columns, sqlgraph.NewFieldSpec(billingadjustment.FieldID, field.TypeInt64))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.JobID(); ok {
+		_spec.SetField(billingadjustment.FieldJobID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedJobID(); ok {
+		_spec.AddField(billingadjustment.FieldJobID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(billingadjustment.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(billingadjustment.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(billingadjustment.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(billingadjustment.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.BalanceBefore(); ok {
+		_spec.SetField(billingadjustment.FieldBalanceBefore, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedBalanceBefore(); ok {
+		_spec.AddField(billingadjustment.FieldBalanceBefore, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.BalanceAfter(); ok {
+		_spec.SetField(billingadjustment.FieldBalanceAfter, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedBalanceAfter(); ok {
+		_spec.AddField(billingadjustment.FieldBalanceAfter, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.UsageRows(); ok {
+		_spec.SetField(billingadjustment.FieldUsageRows, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUsageRows(); ok {
+		_spec.AddField(billingadjustment.FieldUsageRows, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.OperatorID(); ok {
+		_spec.SetField(billingadjustment.FieldOperatorID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedOperatorID(); ok {
+		_spec.AddField(billingadjustment.FieldOperatorID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(billingadjustment.FieldNote, field.TypeString, value)
+	}
+	if _u.mutation.NoteCleared() {
+		_spec.ClearField(billingadjustment.FieldNote, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{billingadjustment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BillingAdjustmentUpdateOne is the builder for updating a single BillingAdjustment entity.
+type BillingAdjustmentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BillingAdjustmentMutation
+}
+
+// SetJobID sets the "job_id" field.
+func (_u *BillingAdjustmentUpdateOne) SetJobID(v int64) *BillingAdjustmentUpdateOne {
+	_u.mutation.ResetJobID()
+	_u.mutation.SetJobID(v)
+	return _u
+}
+
+// SetNillableJobID sets the "job_id" field if the given value is not nil.
+func (_u *BillingAdjustmentUpdateOne) SetNillableJobID(v *int64) *BillingAdjustmentUpdateOne {
+	if v != nil {
+		_u.SetJobID(*v)
+	}
+	return _u
+}
+
+// AddJobID adds value to the "job_id" field.
+func (_u *BillingAdjustmentUpdateOne) AddJobID(v int64) *BillingAdjustmentUpdateOne {
+	_u.mutation.AddJobID(v)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *BillingAdjustmentUpdateOne) SetUserID(v int64) *BillingAdjustmentUpdateOne {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *BillingAdjustmentUpdateOne) SetNillableUserID(v *int64) *BillingAdjustmentUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *BillingAdjustmentUpdateOne) AddUserID(v int64) *BillingAdjustmentUpdateOne {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *BillingAdjustmentUpdateOne) SetAmount(v float64) *BillingAdjustmentUpdateOne {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *BillingAdjustmentUpdateOne) SetNillableAmount(v *float64) *BillingAdjustmentUpdateOne {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *BillingAdjustmentUpdateOne) AddAmount(v float64) *BillingAdjustmentUpdateOne {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetBalanceBefore sets the "balance_before" field.
+func (_u *BillingAdjustmentUpdateOne) SetBalanceBefore(v float64) *BillingAdjustmentUpdateOne {
+	_u.mutation.ResetBalanceBefore()
+	_u.mutation.SetBalanceBefore(v)
+	return _u
+}
+
+// SetNillableBalanceBefore sets the "balance_before" field if the given value is not nil.
+func (_u *BillingAdjustmentUpdateOne) SetNillableBalanceBefore(v *float64) *BillingAdjustmentUpdateOne {
+	if v != nil {
+		_u.SetBalanceBefore(*v)
+	}
+	return _u
+}
+
+// AddBalanceBefore adds value to the "balance_before" field.
+func (_u *BillingAdjustmentUpdateOne) AddBalanceBefore(v float64) *BillingAdjustmentUpdateOne {
+	_u.mutation.AddBalanceBefore(v)
+	return _u
+}
+
+// SetBalanceAfter sets the "balance_after" field.
+func (_u *BillingAdjustmentUpdateOne) SetBalanceAfter(v float64) *BillingAdjustmentUpdateOne {
+	_u.mutation.ResetBalanceAfter()
+	_u.mutation.SetBalanceAfter(v)
+	return _u
+}
+
+// SetNillableBalanceAfter sets the "balance_after" field if the given value is not nil.
+func (_u *BillingAdjustmentUpdateOne) SetNillableBalanceAfter(v *float64) *BillingAdjustmentUpdateOne {
+	if v != nil {
+		_u.SetBalanceAfter(*v)
+	}
+	return _u
+}
+
+// AddBalanceAfter adds value to the "balance_after" field.
+func (_u *BillingAdjustmentUpdateOne) AddBalanceAfter(v float64) *BillingAdjustmentUpdateOne {
+	_u.mutation.AddBalanceAfter(v)
+	return _u
+}
+
+// SetUsageRows sets the "usage_rows" field.
+func (_u *BillingAdjustmentUpdateOne) SetUsageRows(v int64) *BillingAdjustmentUpdateOne {
+	_u.mutation.ResetUsageRows()
+	_u.mutation.SetUsageRows(v)
+	return _u
+}
+
+// SetNillableUsageRows sets the "usage_rows" field if the given value is not nil.
+func (_u *BillingAdjustmentUpdateOne) SetNillableUsageRows(v *int64) *BillingAdjustmentUpdateOne {
+	if v != nil {
+		_u.SetUsageRows(*v)
+	}
+	return _u
+}
+
+// AddUsageRows adds value to the "usage_rows" field.
+func (_u *BillingAdjustmentUpdateOne) AddUsageRows(v int64) *BillingAdjustmentUpdateOne {
+	_u.mutation.AddUsageRows(v)
+	return _u
+}
+
+// SetOperatorID sets the "operator_id" field.
+func (_u *BillingAdjustmentUpdateOne) SetOperatorID(v int64) *BillingAdjustmentUpdateOne {
+	_u.mutation.ResetOperatorID()
+	_u.mutation.SetOperatorID(v)
+	return _u
+}
+
+// SetNillableOperatorID sets the "operator_id" field if the given value is not nil.
+func (_u *BillingAdjustmentUpdateOne) SetNillableOperatorID(v *int64) *BillingAdjustmentUpdateOne {
+	if v != nil {
+		_u.SetOperatorID(*v)
+	}
+	return _u
+}
+
+// AddOperatorID adds value to the "operator_id" field.
+func (_u *BillingAdjustmentUpdateOne) AddOperatorID(v int64) *BillingAdjustmentUpdateOne {
+	_u.mutation.AddOperatorID(v)
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *BillingAdjustmentUpdateOne) SetNote(v string) *BillingAdjustmentUpdateOne {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *BillingAdjustmentUpdateOne) SetNillableNote(v *string) *BillingAdjustmentUpdateOne {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// ClearNote clears the value of the "note" field.
+func (_u *BillingAdjustmentUpdateOne) ClearNote() *BillingAdjustmentUpdateOne {
+	_u.mutation.ClearNote()
+	return _u
+}
+
+// Mutation returns the BillingAdjustmentMutation object of the builder.
+func (_u *BillingAdjustmentUpdateOne) Mutation() *BillingAdjustmentMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BillingAdjustmentUpdate builder.
+func (_u *BillingAdjustmentUpdateOne) Where(ps ...predicate.BillingAdjustment) *BillingAdjustmentUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BillingAdjustmentUpdateOne) Select(field string, fields ...string) *BillingAdjustmentUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BillingAdjustment entity.
+func (_u *BillingAdjustmentUpdateOne) Save(ctx context.Context) (*BillingAdjustment, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BillingAdjustmentUpdateOne) SaveX(ctx context.Context) *BillingAdjustment {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BillingAdjustmentUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BillingAdjustmentUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *BillingAdjustmentUpdateOne) sqlSave(ctx context.Context) (_node *BillingAdjustment, err error) {
+	_spec := sqlgraph.NewUpdateSpec(billingadjustment.Table, billingadjustment.Columns, sqlgraph.NewFieldSpec(billingadjustment.FieldID, field.TypeInt64))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BillingAdjustment.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, billingadjustment.FieldID)
+		for _, f := range fields {
+			if !billingadjustment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != billingadjustment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.JobID(); ok {
+		_spec.SetField(billingadjustment.FieldJobID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedJobID(); ok {
+		_spec.AddField(billingadjustment.FieldJobID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(billingadjustment.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(billingadjustment.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(billingadjustment.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(billingadjustment.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.BalanceBefore(); ok {
+		_spec.SetField(billingadjustment.FieldBalanceBefore, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedBalanceBefore(); ok {
+		_spec.AddField(billingadjustment.FieldBalanceBefore, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.BalanceAfter(); ok {
+		_spec.SetField(billingadjustment.FieldBalanceAfter, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedBalanceAfter(); ok {
+		_spec.AddField(billingadjustment.FieldBalanceAfter, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.UsageRows(); ok {
+		_spec.SetField(billingadjustment.FieldUsageRows, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUsageRows(); ok {
+		_spec.AddField(billingadjustment.FieldUsageRows, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.OperatorID(); ok {
+		_spec.SetField(billingadjustment.FieldOperatorID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedOperatorID(); ok {
+		_spec.AddField(billingadjustment.FieldOperatorID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(billingadjustment.FieldNote, field.TypeString, value)
+	}
+	if _u.mutation.NoteCleared() {
+		_spec.ClearField(billingadjustment.FieldNote, field.TypeString)
+	}
+	_node = &BillingAdjustment{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{billingadjustment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
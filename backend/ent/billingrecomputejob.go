@@ -0,0 +1,304 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Wei-Shaw/sub2api/ent/billingrecomputejob"
+	"github.com/Wei-Shaw/sub2api/internal/model"
+)
+
+// BillingRecomputeJob is the model entity for the BillingRecomputeJob schema.
+type BillingRecomputeJob struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// Mode holds the value of the "mode" field.
+	Mode string `json:"mode,omitempty"`
+	// Params holds the value of the "params" field.
+	Params model.BillingRecomputeParams `json:"params,omitempty"`
+	// Report holds the value of the "report" field.
+	Report *model.BillingRecomputeReport `json:"report,omitempty"`
+	// ScannedRows holds the value of the "scanned_rows" field.
+	ScannedRows int64 `json:"scanned_rows,omitempty"`
+	// ChangedRows holds the value of the "changed_rows" field.
+	ChangedRows int64 `json:"changed_rows,omitempty"`
+	// OriginalCost holds the value of the "original_cost" field.
+	OriginalCost float64 `json:"original_cost,omitempty"`
+	// RecomputedCost holds the value of the "recomputed_cost" field.
+	RecomputedCost float64 `json:"recomputed_cost,omitempty"`
+	// BalanceDiff holds the value of the "balance_diff" field.
+	BalanceDiff float64 `json:"balance_diff,omitempty"`
+	// ErrorMessage holds the value of the "error_message" field.
+	ErrorMessage string `json:"error_message,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy int64 `json:"created_by,omitempty"`
+	// AppliedBy holds the value of the "applied_by" field.
+	AppliedBy *int64 `json:"applied_by,omitempty"`
+	// StartedAt holds the value of the "started_at" field.
+	StartedAt *time.Time `json:"started_at,omitempty"`
+	// FinishedAt holds the value of the "finished_at" field.
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+	// AppliedAt holds the value of the "applied_at" field.
+	AppliedAt *time.Time `json:"applied_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BillingRecomputeJob) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case billingrecomputejob.FieldParams, billingrecomputejob.FieldReport:
+			values[i] = new([]byte)
+		case billingrecomputejob.FieldOriginalCost, billingrecomputejob.FieldRecomputedCost, billingrecomputejob.FieldBalanceDiff:
+			values[i] = new(sql.NullFloat64)
+		case billingrecomputejob.FieldID, billingrecomputejob.FieldScannedRows, billingrecomputejob.FieldChangedRows, billingrecomputejob.FieldCreatedBy, billingrecomputejob.FieldAppliedBy:
+			values[i] = new(sql.NullInt64)
+		case billingrecomputejob.FieldStatus, billingrecomputejob.FieldMode, billingrecomputejob.FieldErrorMessage:
+			values[i] = new(sql.NullString)
+		case billingrecomputejob.FieldStartedAt, billingrecomputejob.FieldFinishedAt, billingrecomputejob.FieldAppliedAt, billingrecomputejob.FieldCreatedAt, billingrecomputejob.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BillingRecomputeJob fields.
+func (_m *BillingRecomputeJob) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case billingrecomputejob.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int64(value.Int64)
+		case billingrecomputejob.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = value.String
+			}
+		case billingrecomputejob.FieldMode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field mode", values[i])
+			} else if value.Valid {
+				_m.Mode = value.String
+			}
+		case billingrecomputejob.FieldParams:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field params", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Params); err != nil {
+					return fmt.Errorf("unmarshal field params: %w", err)
+				}
+			}
+		case billingrecomputejob.FieldReport:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field report", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Report); err != nil {
+					return fmt.Errorf("unmarshal field report: %w", err)
+				}
+			}
+		case billingrecomputejob.FieldScannedRows:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field scanned_rows", values[i])
+			} else if value.Valid {
+				_m.ScannedRows = value.Int64
+			}
+		case billingrecomputejob.FieldChangedRows:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field changed_rows", values[i])
+			} else if value.Valid {
+				_m.ChangedRows = value.Int64
+			}
+		case billingrecomputejob.FieldOriginalCost:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field original_cost", values[i])
+			} else if value.Valid {
+				_m.OriginalCost = value.Float64
+			}
+		case billingrecomputejob.FieldRecomputedCost:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field recomputed_cost", values[i])
+			} else if value.Valid {
+				_m.RecomputedCost = value.Float64
+			}
+		case billingrecomputejob.FieldBalanceDiff:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field balance_diff", values[i])
+			} else if value.Valid {
+				_m.BalanceDiff = value.Float64
+			}
+		case billingrecomputejob.FieldErrorMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error_message", values[i])
+			} else if value.Valid {
+				_m.ErrorMessage = value.String
+			}
+		case billingrecomputejob.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				_m.CreatedBy = value.Int64
+			}
+		case billingrecomputejob.FieldAppliedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field applied_by", values[i])
+			} else if value.Valid {
+				_m.AppliedBy = new(int64)
+				*_m.AppliedBy = value.Int64
+			}
+		case billingrecomputejob.FieldStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started_at", values[i])
+			} else if value.Valid {
+				_m.StartedAt = new(time.Time)
+				*_m.StartedAt = value.Time
+			}
+		case billingrecomputejob.FieldFinishedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field finished_at", values[i])
+			} else if value.Valid {
+				_m.FinishedAt = new(time.Time)
+				*_m.FinishedAt = value.Time
+			}
+		case billingrecomputejob.FieldAppliedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field applied_at", values[i])
+			} else if value.Valid {
+				_m.AppliedAt = new(time.Time)
+				*_m.AppliedAt = value.Time
+			}
+		case billingrecomputejob.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case billingrecomputejob.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BillingRecomputeJob.
+// This includes values selected through modifiers, order, etc.
+func (_m *BillingRecomputeJob) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BillingRecomputeJob.
+// Note that you need to call BillingRecomputeJob.Unwrap() before calling this method if this BillingRecomputeJob
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BillingRecomputeJob) Update() *BillingRecomputeJobUpdateOne {
+	return NewBillingRecomputeJobClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BillingRecomputeJob entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BillingRecomputeJob) Unwrap() *BillingRecomputeJob {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BillingRecomputeJob is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BillingRecomputeJob) String() string {
+	var builder strings.Builder
+	builder.WriteString("BillingRecomputeJob(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("status=")
+	builder.WriteString(_m.Status)
+	builder.WriteString(", ")
+	builder.WriteString("mode=")
+	builder.WriteString(_m.Mode)
+	builder.WriteString(", ")
+	builder.WriteString("params=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Params))
+	builder.WriteString(", ")
+	builder.WriteString("report=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Report))
+	builder.WriteString(", ")
+	builder.WriteString("scanned_rows=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ScannedRows))
+	builder.WriteString(", ")
+	builder.WriteString("changed_rows=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ChangedRows))
+	builder.WriteString(", ")
+	builder.WriteString("original_cost=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OriginalCost))
+	builder.WriteString(", ")
+	builder.WriteString("recomputed_cost=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RecomputedCost))
+	builder.WriteString(", ")
+	builder.WriteString("balance_diff=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BalanceDiff))
+	builder.WriteString(", ")
+	builder.WriteString("error_message=")
+	builder.WriteString(_m.ErrorMessage)
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreatedBy))
+	builder.WriteString(", ")
+	if v := _m.AppliedBy; v != nil {
+		builder.WriteString("applied_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.StartedAt; v != nil {
+		builder.WriteString("started_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.FinishedAt; v != nil {
+		builder.WriteString("finished_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.AppliedAt; v != nil {
+		builder.WriteString("applied_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BillingRecomputeJobs is a parsable slice of BillingRecomputeJob.
+type BillingRecomputeJobs []*BillingRecomputeJob
@@ -0,0 +1,192 @@
+// Code generated by ent, DO NOT EDIT.
+
+package billingrecomputejob
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the billingrecomputejob type in the database.
+	Label = "billing_recompute_job"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldMode holds the string denoting the mode field in the database.
+	FieldMode = "mode"
+	// FieldParams holds the string denoting the params field in the database.
+	FieldParams = "params"
+	// FieldReport holds the string denoting the report field in the database.
+	FieldReport = "report"
+	// FieldScannedRows holds the string denoting the scanned_rows field in the database.
+	FieldScannedRows = "scanned_rows"
+	// FieldChangedRows holds the string denoting the changed_rows field in the database.
+	FieldChangedRows = "changed_rows"
+	// FieldOriginalCost holds the string denoting the original_cost field in the database.
+	FieldOriginalCost = "original_cost"
+	// FieldRecomputedCost holds the string denoting the recomputed_cost field in the database.
+	FieldRecomputedCost = "recomputed_cost"
+	// FieldBalanceDiff holds the string denoting the balance_diff field in the database.
+	FieldBalanceDiff = "balance_diff"
+	// FieldErrorMessage holds the string denoting the error_message field in the database.
+	FieldErrorMessage = "error_message"
+	// FieldCreatedBy holds the string denoting the created_by field in the database.
+	FieldCreatedBy = "created_by"
+	// FieldAppliedBy holds the string denoting the applied_by field in the database.
+	FieldAppliedBy = "applied_by"
+	// FieldStartedAt holds the string denoting the started_at field in the database.
+	FieldStartedAt = "started_at"
+	// FieldFinishedAt holds the string denoting the finished_at field in the database.
+	FieldFinishedAt = "finished_at"
+	// FieldAppliedAt holds the string denoting the applied_at field in the database.
+	FieldAppliedAt = "applied_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the billingrecomputejob in the database.
+	Table = "billing_recompute_jobs"
+)
+
+// Columns holds all SQL columns for billingrecomputejob fields.
+var Columns = []string{
+	FieldID,
+	FieldStatus,
+	FieldMode,
+	FieldParams,
+	FieldReport,
+	FieldScannedRows,
+	FieldChangedRows,
+	FieldOriginalCost,
+	FieldRecomputedCost,
+	FieldBalanceDiff,
+	FieldErrorMessage,
+	FieldCreatedBy,
+	FieldAppliedBy,
+	FieldStartedAt,
+	FieldFinishedAt,
+	FieldAppliedAt,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+	// StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	StatusValidator func(string) error
+	// ModeValidator is a validator for the "mode" field. It is called by the builders before save.
+	ModeValidator func(string) error
+	// DefaultScannedRows holds the default value on creation for the "scanned_rows" field.
+	DefaultScannedRows int64
+	// DefaultChangedRows holds the default value on creation for the "changed_rows" field.
+	DefaultChangedRows int64
+	// DefaultOriginalCost holds the default value on creation for the "original_cost" field.
+	DefaultOriginalCost float64
+	// DefaultRecomputedCost holds the default value on creation for the "recomputed_cost" field.
+	DefaultRecomputedCost float64
+	// DefaultBalanceDiff holds the default value on creation for the "balance_diff" field.
+	DefaultBalanceDiff float64
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the BillingRecomputeJob queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByMode orders the results by the mode field.
+func ByMode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMode, opts...).ToFunc()
+}
+
+// ByScannedRows orders the results by the scanned_rows field.
+func ByScannedRows(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScannedRows, opts...).ToFunc()
+}
+
+// ByChangedRows orders the results by the changed_rows field.
+func ByChangedRows(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChangedRows, opts...).ToFunc()
+}
+
+// ByOriginalCost orders the results by the original_cost field.
+func ByOriginalCost(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOriginalCost, opts...).ToFunc()
+}
+
+// ByRecomputedCost orders the results by the recomputed_cost field.
+func ByRecomputedCost(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRecomputedCost, opts...).ToFunc()
+}
+
+// ByBalanceDiff orders the results by the balance_diff field.
+func ByBalanceDiff(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBalanceDiff, opts...).ToFunc()
+}
+
+// ByErrorMessage orders the results by the error_message field.
+func ByErrorMessage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldErrorMessage, opts...).ToFunc()
+}
+
+// ByCreatedBy orders the results by the created_by field.
+func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
+}
+
+// ByAppliedBy orders the results by the applied_by field.
+func ByAppliedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAppliedBy, opts...).ToFunc()
+}
+
+// ByStartedAt orders the results by the started_at field.
+func ByStartedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartedAt, opts...).ToFunc()
+}
+
+// ByFinishedAt orders the results by the finished_at field.
+func ByFinishedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFinishedAt, opts...).ToFunc()
+}
+
+// ByAppliedAt orders the results by the applied_at field.
+func ByAppliedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAppliedAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
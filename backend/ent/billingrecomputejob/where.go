@@ -0,0 +1,880 @@
+// Code generated by ent, DO NOT EDIT.
+
+package billingrecomputejob
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Wei-Shaw/sub2api/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldLTE(FieldID, id))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldEQ(FieldStatus, v))
+}
+
+// Mode applies equality check predicate on the "mode" field. It's identical to ModeEQ.
+func Mode(v string) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldEQ(FieldMode, v))
+}
+
+// ScannedRows applies equality check predicate on the "scanned_rows" field. It's identical to ScannedRowsEQ.
+func ScannedRows(v int64) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldEQ(FieldScannedRows, v))
+}
+
+// ChangedRows applies equality check predicate on the "changed_rows" field. It's identical to ChangedRowsEQ.
+func ChangedRows(v int64) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldEQ(FieldChangedRows, v))
+}
+
+// OriginalCost applies equality check predicate on the "original_cost" field. It's identical to OriginalCostEQ.
+func OriginalCost(v float64) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldEQ(FieldOriginalCost, v))
+}
+
+// RecomputedCost applies equality check predicate on the "recomputed_cost" field. It's identical to RecomputedCostEQ.
+func RecomputedCost(v float64) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldEQ(FieldRecomputedCost, v))
+}
+
+// BalanceDiff applies equality check predicate on the "balance_diff" field. It's identical to BalanceDiffEQ.
+func BalanceDiff(v float64) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldEQ(FieldBalanceDiff, v))
+}
+
+// ErrorMessage applies equality check predicate on the "error_message" field. It's identical to ErrorMessageEQ.
+func ErrorMessage(v string) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldEQ(FieldErrorMessage, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v int64) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// AppliedBy applies equality check predicate on the "applied_by" field. It's identical to AppliedByEQ.
+func AppliedBy(v int64) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldEQ(FieldAppliedBy, v))
+}
+
+// StartedAt applies equality check predicate on the "started_at" field. It's identical to StartedAtEQ.
+func StartedAt(v time.Time) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// FinishedAt applies equality check predicate on the "finished_at" field. It's identical to FinishedAtEQ.
+func FinishedAt(v time.Time) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// AppliedAt applies equality check predicate on the "applied_at" field. It's identical to AppliedAtEQ.
+func AppliedAt(v time.Time) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldEQ(FieldAppliedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// ModeEQ applies the EQ predicate on the "mode" field.
+func ModeEQ(v string) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldEQ(FieldMode, v))
+}
+
+// ModeNEQ applies the NEQ predicate on the "mode" field.
+func ModeNEQ(v string) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldNEQ(FieldMode, v))
+}
+
+// ModeIn applies the In predicate on the "mode" field.
+func ModeIn(vs ...string) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldIn(FieldMode, vs...))
+}
+
+// ModeNotIn applies the NotIn predicate on the "mode" field.
+func ModeNotIn(vs ...string) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldNotIn(FieldMode, vs...))
+}
+
+// ModeGT applies the GT predicate on the "mode" field.
+func ModeGT(v string) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldGT(FieldMode, v))
+}
+
+// ModeGTE applies the GTE predicate on the "mode" field.
+func ModeGTE(v string) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldGTE(FieldMode, v))
+}
+
+// ModeLT applies the LT predicate on the "mode" field.
+func ModeLT(v string) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldLT(FieldMode, v))
+}
+
+// ModeLTE applies the LTE predicate on the "mode" field.
+func ModeLTE(v string) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldLTE(FieldMode, v))
+}
+
+// ModeContains applies the Contains predicate on the "mode" field.
+func ModeContains(v string) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldContains(FieldMode, v))
+}
+
+// ModeHasPrefix applies the HasPrefix predicate on the "mode" field.
+func ModeHasPrefix(v string) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldHasPrefix(FieldMode, v))
+}
+
+// ModeHasSuffix applies the HasSuffix predicate on the "mode" field.
+func ModeHasSuffix(v string) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldHasSuffix(FieldMode, v))
+}
+
+// ModeEqualFold applies the EqualFold predicate on the "mode" field.
+func ModeEqualFold(v string) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldEqualFold(FieldMode, v))
+}
+
+// ModeContainsFold applies the ContainsFold predicate on the "mode" field.
+func ModeContainsFold(v string) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldContainsFold(FieldMode, v))
+}
+
+// ReportIsNil applies the IsNil predicate on the "report" field.
+func ReportIsNil() predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldIsNull(FieldReport))
+}
+
+// ReportNotNil applies the NotNil predicate on the "report" field.
+func ReportNotNil() predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldNotNull(FieldReport))
+}
+
+// ScannedRowsEQ applies the EQ predicate on the "scanned_rows" field.
+func ScannedRowsEQ(v int64) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldEQ(FieldScannedRows, v))
+}
+
+// ScannedRowsNEQ applies the NEQ predicate on the "scanned_rows" field.
+func ScannedRowsNEQ(v int64) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldNEQ(FieldScannedRows, v))
+}
+
+// ScannedRowsIn applies the In predicate on the "scanned_rows" field.
+func ScannedRowsIn(vs ...int64) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldIn(FieldScannedRows, vs...))
+}
+
+// ScannedRowsNotIn applies the NotIn predicate on the "scanned_rows" field.
+func ScannedRowsNotIn(vs ...int64) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldNotIn(FieldScannedRows, vs...))
+}
+
+// ScannedRowsGT applies the GT predicate on the "scanned_rows" field.
+func ScannedRowsGT(v int64) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldGT(FieldScannedRows, v))
+}
+
+// ScannedRowsGTE applies the GTE predicate on the "scanned_rows" field.
+func ScannedRowsGTE(v int64) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldGTE(FieldScannedRows, v))
+}
+
+// ScannedRowsLT applies the LT predicate on the "scanned_rows" field.
+func ScannedRowsLT(v int64) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldLT(FieldScannedRows, v))
+}
+
+// ScannedRowsLTE applies the LTE predicate on the "scanned_rows" field.
+func ScannedRowsLTE(v int64) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldLTE(FieldScannedRows, v))
+}
+
+// ChangedRowsEQ applies the EQ predicate on the "changed_rows" field.
+func ChangedRowsEQ(v int64) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldEQ(FieldChangedRows, v))
+}
+
+// ChangedRowsNEQ applies the NEQ predicate on the "changed_rows" field.
+func ChangedRowsNEQ(v int64) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldNEQ(FieldChangedRows, v))
+}
+
+// ChangedRowsIn applies the In predicate on the "changed_rows" field.
+func ChangedRowsIn(vs ...int64) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldIn(FieldChangedRows, vs...))
+}
+
+// ChangedRowsNotIn applies the NotIn predicate on the "changed_rows" field.
+func ChangedRowsNotIn(vs ...int64) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldNotIn(FieldChangedRows, vs...))
+}
+
+// ChangedRowsGT applies the GT predicate on the "changed_rows" field.
+func ChangedRowsGT(v int64) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldGT(FieldChangedRows, v))
+}
+
+// ChangedRowsGTE applies the GTE predicate on the "changed_rows" field.
+func ChangedRowsGTE(v int64) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldGTE(FieldChangedRows, v))
+}
+
+// ChangedRowsLT applies the LT predicate on the "changed_rows" field.
+func ChangedRowsLT(v int64) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldLT(FieldChangedRows, v))
+}
+
+// ChangedRowsLTE applies the LTE predicate on the "changed_rows" field.
+func ChangedRowsLTE(v int64) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldLTE(FieldChangedRows, v))
+}
+
+// OriginalCostEQ applies the EQ predicate on the "original_cost" field.
+func OriginalCostEQ(v float64) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldEQ(FieldOriginalCost, v))
+}
+
+// OriginalCostNEQ applies the NEQ predicate on the "original_cost" field.
+func OriginalCostNEQ(v float64) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldNEQ(FieldOriginalCost, v))
+}
+
+// OriginalCostIn applies the In predicate on the "original_cost" field.
+func OriginalCostIn(vs ...float64) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldIn(FieldOriginalCost, vs...))
+}
+
+// OriginalCostNotIn applies the NotIn predicate on the "original_cost" field.
+func OriginalCostNotIn(vs ...float64) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldNotIn(FieldOriginalCost, vs...))
+}
+
+// OriginalCostGT applies the GT predicate on the "original_cost" field.
+func OriginalCostGT(v float64) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldGT(FieldOriginalCost, v))
+}
+
+// OriginalCostGTE applies the GTE predicate on the "original_cost" field.
+func OriginalCostGTE(v float64) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldGTE(FieldOriginalCost, v))
+}
+
+// OriginalCostLT applies the LT predicate on the "original_cost" field.
+func OriginalCostLT(v float64) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldLT(FieldOriginalCost, v))
+}
+
+// OriginalCostLTE applies the LTE predicate on the "original_cost" field.
+func OriginalCostLTE(v float64) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldLTE(FieldOriginalCost, v))
+}
+
+// RecomputedCostEQ applies the EQ predicate on the "recomputed_cost" field.
+func RecomputedCostEQ(v float64) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldEQ(FieldRecomputedCost, v))
+}
+
+// RecomputedCostNEQ applies the NEQ predicate on the "recomputed_cost" field.
+func RecomputedCostNEQ(v float64) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldNEQ(FieldRecomputedCost, v))
+}
+
+// RecomputedCostIn applies the In predicate on the "recomputed_cost" field.
+func RecomputedCostIn(vs ...float64) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldIn(FieldRecomputedCost, vs...))
+}
+
+// RecomputedCostNotIn applies the NotIn predicate on the "recomputed_cost" field.
+func RecomputedCostNotIn(vs ...float64) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldNotIn(FieldRecomputedCost, vs...))
+}
+
+// RecomputedCostGT applies the GT predicate on the "recomputed_cost" field.
+func RecomputedCostGT(v float64) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldGT(FieldRecomputedCost, v))
+}
+
+// RecomputedCostGTE applies the GTE predicate on the "recomputed_cost" field.
+func RecomputedCostGTE(v float64) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldGTE(FieldRecomputedCost, v))
+}
+
+// RecomputedCostLT applies the LT predicate on the "recomputed_cost" field.
+func RecomputedCostLT(v float64) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldLT(FieldRecomputedCost, v))
+}
+
+// RecomputedCostLTE applies the LTE predicate on the "recomputed_cost" field.
+func RecomputedCostLTE(v float64) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldLTE(FieldRecomputedCost, v))
+}
+
+// BalanceDiffEQ applies the EQ predicate on the "balance_diff" field.
+func BalanceDiffEQ(v float64) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldEQ(FieldBalanceDiff, v))
+}
+
+// BalanceDiffNEQ applies the NEQ predicate on the "balance_diff" field.
+func BalanceDiffNEQ(v float64) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldNEQ(FieldBalanceDiff, v))
+}
+
+// BalanceDiffIn applies the In predicate on the "balance_diff" field.
+func BalanceDiffIn(vs ...float64) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldIn(FieldBalanceDiff, vs...))
+}
+
+// BalanceDiffNotIn applies the NotIn predicate on the "balance_diff" field.
+func BalanceDiffNotIn(vs ...float64) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldNotIn(FieldBalanceDiff, vs...))
+}
+
+// BalanceDiffGT applies the GT predicate on the "balance_diff" field.
+func BalanceDiffGT(v float64) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldGT(FieldBalanceDiff, v))
+}
+
+// BalanceDiffGTE applies the GTE predicate on the "balance_diff" field.
+func BalanceDiffGTE(v float64) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldGTE(FieldBalanceDiff, v))
+}
+
+// BalanceDiffLT applies the LT predicate on the "balance_diff" field.
+func BalanceDiffLT(v float64) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldLT(FieldBalanceDiff, v))
+}
+
+// BalanceDiffLTE applies the LTE predicate on the "balance_diff" field.
+func BalanceDiffLTE(v float64) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldLTE(FieldBalanceDiff, v))
+}
+
+// ErrorMessageEQ applies the EQ predicate on the "error_message" field.
+func ErrorMessageEQ(v string) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldEQ(FieldErrorMessage, v))
+}
+
+// ErrorMessageNEQ applies the NEQ predicate on the "error_message" field.
+func ErrorMessageNEQ(v string) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldNEQ(FieldErrorMessage, v))
+}
+
+// ErrorMessageIn applies the In predicate on the "error_message" field.
+func ErrorMessageIn(vs ...string) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldIn(FieldErrorMessage, vs...))
+}
+
+// ErrorMessageNotIn applies the NotIn predicate on the "error_message" field.
+func ErrorMessageNotIn(vs ...string) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldNotIn(FieldErrorMessage, vs...))
+}
+
+// ErrorMessageGT applies the GT predicate on the "error_message" field.
+func ErrorMessageGT(v string) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldGT(FieldErrorMessage, v))
+}
+
+// ErrorMessageGTE applies the GTE predicate on the "error_message" field.
+func ErrorMessageGTE(v string) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldGTE(FieldErrorMessage, v))
+}
+
+// ErrorMessageLT applies the LT predicate on the "error_message" field.
+func ErrorMessageLT(v string) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldLT(FieldErrorMessage, v))
+}
+
+// ErrorMessageLTE applies the LTE predicate on the "error_message" field.
+func ErrorMessageLTE(v string) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldLTE(FieldErrorMessage, v))
+}
+
+// ErrorMessageContains applies the Contains predicate on the "error_message" field.
+func ErrorMessageContains(v string) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldContains(FieldErrorMessage, v))
+}
+
+// ErrorMessageHasPrefix applies the HasPrefix predicate on the "error_message" field.
+func ErrorMessageHasPrefix(v string) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldHasPrefix(FieldErrorMessage, v))
+}
+
+// ErrorMessageHasSuffix applies the HasSuffix predicate on the "error_message" field.
+func ErrorMessageHasSuffix(v string) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldHasSuffix(FieldErrorMessage, v))
+}
+
+// ErrorMessageIsNil applies the IsNil predicate on the "error_message" field.
+func ErrorMessageIsNil() predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldIsNull(FieldErrorMessage))
+}
+
+// ErrorMessageNotNil applies the NotNil predicate on the "error_message" field.
+func ErrorMessageNotNil() predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldNotNull(FieldErrorMessage))
+}
+
+// ErrorMessageEqualFold applies the EqualFold predicate on the "error_message" field.
+func ErrorMessageEqualFold(v string) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldEqualFold(FieldErrorMessage, v))
+}
+
+// ErrorMessageContainsFold applies the ContainsFold predicate on the "error_message" field.
+func ErrorMessageContainsFold(v string) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldContainsFold(FieldErrorMessage, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v int64) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v int64) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...int64) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...int64) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v int64) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v int64) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v int64) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v int64) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// AppliedByEQ applies the EQ predicate on the "applied_by" field.
+func AppliedByEQ(v int64) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldEQ(FieldAppliedBy, v))
+}
+
+// AppliedByNEQ applies the NEQ predicate on the "applied_by" field.
+func AppliedByNEQ(v int64) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldNEQ(FieldAppliedBy, v))
+}
+
+// AppliedByIn applies the In predicate on the "applied_by" field.
+func AppliedByIn(vs ...int64) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldIn(FieldAppliedBy, vs...))
+}
+
+// AppliedByNotIn applies the NotIn predicate on the "applied_by" field.
+func AppliedByNotIn(vs ...int64) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldNotIn(FieldAppliedBy, vs...))
+}
+
+// AppliedByGT applies the GT predicate on the "applied_by" field.
+func AppliedByGT(v int64) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldGT(FieldAppliedBy, v))
+}
+
+// AppliedByGTE applies the GTE predicate on the "applied_by" field.
+func AppliedByGTE(v int64) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldGTE(FieldAppliedBy, v))
+}
+
+// AppliedByLT applies the LT predicate on the "applied_by" field.
+func AppliedByLT(v int64) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldLT(FieldAppliedBy, v))
+}
+
+// AppliedByLTE applies the LTE predicate on the "applied_by" field.
+func AppliedByLTE(v int64) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldLTE(FieldAppliedBy, v))
+}
+
+// AppliedByIsNil applies the IsNil predicate on the "applied_by" field.
+func AppliedByIsNil() predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldIsNull(FieldAppliedBy))
+}
+
+// AppliedByNotNil applies the NotNil predicate on the "applied_by" field.
+func AppliedByNotNil() predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldNotNull(FieldAppliedBy))
+}
+
+// StartedAtEQ applies the EQ predicate on the "started_at" field.
+func StartedAtEQ(v time.Time) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// StartedAtNEQ applies the NEQ predicate on the "started_at" field.
+func StartedAtNEQ(v time.Time) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldNEQ(FieldStartedAt, v))
+}
+
+// StartedAtIn applies the In predicate on the "started_at" field.
+func StartedAtIn(vs ...time.Time) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldIn(FieldStartedAt, vs...))
+}
+
+// StartedAtNotIn applies the NotIn predicate on the "started_at" field.
+func StartedAtNotIn(vs ...time.Time) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldNotIn(FieldStartedAt, vs...))
+}
+
+// StartedAtGT applies the GT predicate on the "started_at" field.
+func StartedAtGT(v time.Time) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldGT(FieldStartedAt, v))
+}
+
+// StartedAtGTE applies the GTE predicate on the "started_at" field.
+func StartedAtGTE(v time.Time) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldGTE(FieldStartedAt, v))
+}
+
+// StartedAtLT applies the LT predicate on the "started_at" field.
+func StartedAtLT(v time.Time) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldLT(FieldStartedAt, v))
+}
+
+// StartedAtLTE applies the LTE predicate on the "started_at" field.
+func StartedAtLTE(v time.Time) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldLTE(FieldStartedAt, v))
+}
+
+// StartedAtIsNil applies the IsNil predicate on the "started_at" field.
+func StartedAtIsNil() predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldIsNull(FieldStartedAt))
+}
+
+// StartedAtNotNil applies the NotNil predicate on the "started_at" field.
+func StartedAtNotNil() predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldNotNull(FieldStartedAt))
+}
+
+// FinishedAtEQ applies the EQ predicate on the "finished_at" field.
+func FinishedAtEQ(v time.Time) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtNEQ applies the NEQ predicate on the "finished_at" field.
+func FinishedAtNEQ(v time.Time) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldNEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtIn applies the In predicate on the "finished_at" field.
+func FinishedAtIn(vs ...time.Time) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtNotIn applies the NotIn predicate on the "finished_at" field.
+func FinishedAtNotIn(vs ...time.Time) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldNotIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtGT applies the GT predicate on the "finished_at" field.
+func FinishedAtGT(v time.Time) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldGT(FieldFinishedAt, v))
+}
+
+// FinishedAtGTE applies the GTE predicate on the "finished_at" field.
+func FinishedAtGTE(v time.Time) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldGTE(FieldFinishedAt, v))
+}
+
+// FinishedAtLT applies the LT predicate on the "finished_at" field.
+func FinishedAtLT(v time.Time) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldLT(FieldFinishedAt, v))
+}
+
+// FinishedAtLTE applies the LTE predicate on the "finished_at" field.
+func FinishedAtLTE(v time.Time) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldLTE(FieldFinishedAt, v))
+}
+
+// FinishedAtIsNil applies the IsNil predicate on the "finished_at" field.
+func FinishedAtIsNil() predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldIsNull(FieldFinishedAt))
+}
+
+// FinishedAtNotNil applies the NotNil predicate on the "finished_at" field.
+func FinishedAtNotNil() predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldNotNull(FieldFinishedAt))
+}
+
+// AppliedAtEQ applies the EQ predicate on the "applied_at" field.
+func AppliedAtEQ(v time.Time) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldEQ(FieldAppliedAt, v))
+}
+
+// AppliedAtNEQ applies the NEQ predicate on the "applied_at" field.
+func AppliedAtNEQ(v time.Time) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldNEQ(FieldAppliedAt, v))
+}
+
+// AppliedAtIn applies the In predicate on the "applied_at" field.
+func AppliedAtIn(vs ...time.Time) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldIn(FieldAppliedAt, vs...))
+}
+
+// AppliedAtNotIn applies the NotIn predicate on the "applied_at" field.
+func AppliedAtNotIn(vs ...time.Time) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldNotIn(FieldAppliedAt, vs...))
+}
+
+// AppliedAtGT applies the GT predicate on the "applied_at" field.
+func AppliedAtGT(v time.Time) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldGT(FieldAppliedAt, v))
+}
+
+// AppliedAtGTE applies the GTE predicate on the "applied_at" field.
+func AppliedAtGTE(v time.Time) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldGTE(FieldAppliedAt, v))
+}
+
+// AppliedAtLT applies the LT predicate on the "applied_at" field.
+func AppliedAtLT(v time.Time) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldLT(FieldAppliedAt, v))
+}
+
+// AppliedAtLTE applies the LTE predicate on the "applied_at" field.
+func AppliedAtLTE(v time.Time) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldLTE(FieldAppliedAt, v))
+}
+
+// AppliedAtIsNil applies the IsNil predicate on the "applied_at" field.
+func AppliedAtIsNil() predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldIsNull(FieldAppliedAt))
+}
+
+// AppliedAtNotNil applies the NotNil predicate on the "applied_at" field.
+func AppliedAtNotNil() predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldNotNull(FieldAppliedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BillingRecomputeJob) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BillingRecomputeJob) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BillingRecomputeJob) predicate.BillingRecomputeJob {
+	return predicate.BillingRecomputeJob(sql.NotPredicates(p))
+}
@@ -0,0 +1,1670 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Wei-Shaw/sub2api/ent/billingrecomputejob"
+	"github.com/Wei-Shaw/sub2api/internal/model"
+)
+
+// BillingRecomputeJobCreate is the builder for creating a BillingRecomputeJob entity.
+type BillingRecomputeJobCreate struct {
+	config
+	mutation *BillingRecomputeJobMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetStatus sets the "status" field.
+func (_c *BillingRecomputeJobCreate) SetStatus(v string) *BillingRecomputeJobCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *BillingRecomputeJobCreate) SetNillableStatus(v *string) *BillingRecomputeJobCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetMode sets the "mode" field.
+func (_c *BillingRecomputeJobCreate) SetMode(v string) *BillingRecomputeJobCreate {
+	_c.mutation.SetMode(v)
+	return _c
+}
+
+// SetParams sets the "params" field.
+func (_c *BillingRecomputeJobCreate) SetParams(v model.BillingRecomputeParams) *BillingRecomputeJobCreate {
+	_c.mutation.SetParams(v)
+	return _c
+}
+
+// SetReport sets the "report" field.
+func (_c *BillingRecomputeJobCreate) SetReport(v *model.BillingRecomputeReport) *BillingRecomputeJobCreate {
+	_c.mutation.SetReport(v)
+	return _c
+}
+
+// SetScannedRows sets the "scanned_rows" field.
+func (_c *BillingRecomputeJobCreate) SetScannedRows(v int64) *BillingRecomputeJobCreate {
+	_c.mutation.SetScannedRows(v)
+	return _c
+}
+
+// SetNillableScannedRows sets the "scanned_rows" field if the given value is not nil.
+func (_c *BillingRecomputeJobCreate) SetNillableScannedRows(v *int64) *BillingRecomputeJobCreate {
+	if v != nil {
+		_c.SetScannedRows(*v)
+	}
+	return _c
+}
+
+// SetChangedRows sets the "changed_rows" field.
+func (_c *BillingRecomputeJobCreate) SetChangedRows(v int64) *BillingRecomputeJobCreate {
+	_c.mutation.SetChangedRows(v)
+	return _c
+}
+
+// SetNillableChangedRows sets the "changed_rows" field if the given value is not nil.
+func (_c *BillingRecomputeJobCreate) SetNillableChangedRows(v *int64) *BillingRecomputeJobCreate {
+	if v != nil {
+		_c.SetChangedRows(*v)
+	}
+	return _c
+}
+
+// SetOriginalCost sets the "original_cost" field.
+func (_c *BillingRecomputeJobCreate) SetOriginalCost(v float64) *BillingRecomputeJobCreate {
+	_c.mutation.SetOriginalCost(v)
+	return _c
+}
+
+// SetNillableOriginalCost sets the "original_cost" field if the given value is not nil.
+func (_c *BillingRecomputeJobCreate) SetNillableOriginalCost(v *float64) *BillingRecomputeJobCreate {
+	if v != nil {
+		_c.SetOriginalCost(*v)
+	}
+	return _c
+}
+
+// SetRecomputedCost sets the "recomputed_cost" field.
+func (_c *BillingRecomputeJobCreate) SetRecomputedCost(v float64) *BillingRecomputeJobCreate {
+	_c.mutation.SetRecomputedCost(v)
+	return _c
+}
+
+// SetNillableRecomputedCost sets the "recomputed_cost" field if the given value is not nil.
+func (_c *BillingRecomputeJobCreate) SetNillableRecomputedCost(v *float64) *BillingRecomputeJobCreate {
+	if v != nil {
+		_c.SetRecomputedCost(*v)
+	}
+	return _c
+}
+
+// SetBalanceDiff sets the "balance_diff" field.
+func (_c *BillingRecomputeJobCreate) SetBalanceDiff(v float64) *BillingRecomputeJobCreate {
+	_c.mutation.SetBalanceDiff(v)
+	return _c
+}
+
+// SetNillableBalanceDiff sets the "balance_diff" field if the given value is not nil.
+func (_c *BillingRecomputeJobCreate) SetNillableBalanceDiff(v *float64) *BillingRecomputeJobCreate {
+	if v != nil {
+		_c.SetBalanceDiff(*v)
+	}
+	return _c
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (_c *BillingRecomputeJobCreate) SetErrorMessage(v string) *BillingRecomputeJobCreate {
+	_c.mutation.SetErrorMessage(v)
+	return _c
+}
+
+// SetNillableErrorMessage sets the "error_message" field if the given value is not nil.
+func (_c *BillingRecomputeJobCreate) SetNillableErrorMessage(v *string) *BillingRecomputeJobCreate {
+	if v != nil {
+		_c.SetErrorMessage(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *BillingRecomputeJobCreate) SetCreatedBy(v int64) *BillingRecomputeJobCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetAppliedBy sets the "applied_by" field.
+func (_c *BillingRecomputeJobCreate) SetAppliedBy(v int64) *BillingRecomputeJobCreate {
+	_c.mutation.SetAppliedBy(v)
+	return _c
+}
+
+// SetNillableAppliedBy sets the "applied_by" field if the given value is not nil.
+func (_c *BillingRecomputeJobCreate) SetNillableAppliedBy(v *int64) *BillingRecomputeJobCreate {
+	if v != nil {
+		_c.SetAppliedBy(*v)
+	}
+	return _c
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_c *BillingRecomputeJobCreate) SetStartedAt(v time.Time) *BillingRecomputeJobCreate {
+	_c.mutation.SetStartedAt(v)
+	return _c
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_c *BillingRecomputeJobCreate) SetNillableStartedAt(v *time.Time) *BillingRecomputeJobCreate {
+	if v != nil {
+		_c.SetStartedAt(*v)
+	}
+	return _c
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_c *BillingRecomputeJobCreate) SetFinishedAt(v time.Time) *BillingRecomputeJobCreate {
+	_c.mutation.SetFinishedAt(v)
+	return _c
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_c *BillingRecomputeJobCreate) SetNillableFinishedAt(v *time.Time) *BillingRecomputeJobCreate {
+	if v != nil {
+		_c.SetFinishedAt(*v)
+	}
+	return _c
+}
+
+// SetAppliedAt sets the "applied_at" field.
+func (_c *BillingRecomputeJobCreate) SetAppliedAt(v time.Time) *BillingRecomputeJobCreate {
+	_c.mutation.SetAppliedAt(v)
+	return _c
+}
+
+// SetNillableAppliedAt sets the "applied_at" field if the given value is not nil.
+func (_c *BillingRecomputeJobCreate) SetNillableAppliedAt(v *time.Time) *BillingRecomputeJobCreate {
+	if v != nil {
+		_c.SetAppliedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *BillingRecomputeJobCreate) SetCreatedAt(v time.Time) *BillingRecomputeJobCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *BillingRecomputeJobCreate) SetNillableCreatedAt(v *time.Time) *BillingRecomputeJobCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *BillingRecomputeJobCreate) SetUpdatedAt(v time.Time) *BillingRecomputeJobCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *BillingRecomputeJobCreate) SetNillableUpdatedAt(v *time.Time) *BillingRecomputeJobCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the BillingRecomputeJobMutation object of the builder.
+func (_c *BillingRecomputeJobCreate) Mutation() *BillingRecomputeJobMutation {
+	return _c.mutation
+}
+
+// Save creates the BillingRecomputeJob in the database.
+func (_c *BillingRecomputeJobCreate) Save(ctx context.Context) (*BillingRecomputeJob, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BillingRecomputeJobCreate) SaveX(ctx context.Context) *BillingRecomputeJob {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BillingRecomputeJobCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BillingRecomputeJobCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BillingRecomputeJobCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := billingrecomputejob.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.ScannedRows(); !ok {
+		v := billingrecomputejob.DefaultScannedRows
+		_c.mutation.SetScannedRows(v)
+	}
+	if _, ok := _c.mutation.ChangedRows(); !ok {
+		v := billingrecomputejob.DefaultChangedRows
+		_c.mutation.SetChangedRows(v)
+	}
+	if _, ok := _c.mutation.OriginalCost(); !ok {
+		v := billingrecomputejob.DefaultOriginalCost
+		_c.mutation.SetOriginalCost(v)
+	}
+	if _, ok := _c.mutation.RecomputedCost(); !ok {
+		v := billingrecomputejob.DefaultRecomputedCost
+		_c.mutation.SetRecomputedCost(v)
+	}
+	if _, ok := _c.mutation.BalanceDiff(); !ok {
+		v := billingrecomputejob.DefaultBalanceDiff
+		_c.mutation.SetBalanceDiff(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := billingrecomputejob.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := billingrecomputejob.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BillingRecomputeJobCreate) check() error {
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "BillingRecomputeJob.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := billingrecomputejob.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "BillingRecomputeJob.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Mode(); !ok {
+		return &ValidationError{Name: "mode", err: errors.New(`ent: missing required field "BillingRecomputeJob.mode"`)}
+	}
+	if v, ok := _c.mutation.Mode(); ok {
+		if err := billingrecomputejob.ModeValidator(v); err != nil {
+			return &ValidationError{Name: "mode", err: fmt.Errorf(`ent: validator failed for field "BillingRecomputeJob.mode": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Params(); !ok {
+		return &ValidationError{Name: "params", err: errors.New(`ent: missing required field "BillingRecomputeJob.params"`)}
+	}
+	if _, ok := _c.mutation.ScannedRows(); !ok {
+		return &ValidationError{Name: "scanned_rows", err: errors.New(`ent: missing required field "BillingRecomputeJob.scanned_rows"`)}
+	}
+	if _, ok := _c.mutation.ChangedRows(); !ok {
+		return &ValidationError{Name: "changed_rows", err: errors.New(`ent: missing required field "BillingRecomputeJob.changed_rows"`)}
+	}
+	if _, ok := _c.mutation.OriginalCost(); !ok {
+		return &ValidationError{Name: "original_cost", err: errors.New(`ent: missing required field "BillingRecomputeJob.original_cost"`)}
+	}
+	if _, ok := _c.mutation.RecomputedCost(); !ok {
+		return &ValidationError{Name: "recomputed_cost", err: errors.New(`ent: missing required field "BillingRecomputeJob.recomputed_cost"`)}
+	}
+	if _, ok := _c.mutation.BalanceDiff(); !ok {
+		return &ValidationError{Name: "balance_diff", err: errors.New(`ent: missing required field "BillingRecomputeJob.balance_diff"`)}
+	}
+	if _, ok := _c.mutation.CreatedBy(); !ok {
+		return &ValidationError{Name: "created_by", err: errors.New(`ent: missing required field "BillingRecomputeJob.created_by"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "BillingRecomputeJob.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "BillingRecomputeJob.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *BillingRecomputeJobCreate) sqlSave(ctx context.Context) (*BillingRecomputeJob, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int64(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BillingRecomputeJobCreate) createSpec() (*BillingRecomputeJob, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BillingRecomputeJob{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(billingrecomputejob.Table, sqlgraph.NewFieldSpec(billingrecomputejob.FieldID, field.TypeInt64))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(billingrecomputejob.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Mode(); ok {
+		_spec.SetField(billingrecomputejob.FieldMode, field.TypeString, value)
+		_node.Mode = value
+	}
+	if value, ok := _c.mutation.Params(); ok {
+		_spec.SetField(billingrecomputejob.FieldParams, field.TypeJSON, value)
+		_node.Params = value
+	}
+	if value, ok := _c.mutation.Report(); ok {
+		_spec.SetField(billingrecomputejob.FieldReport, field.TypeJSON, value)
+		_node.Report = value
+	}
+	if value, ok := _c.mutation.ScannedRows(); ok {
+		_spec.SetField(billingrecomputejob.FieldScannedRows, field.TypeInt64, value)
+		_node.ScannedRows = value
+	}
+	if value, ok := _c.mutation.ChangedRows(); ok {
+		_spec.SetField(billingrecomputejob.FieldChangedRows, field.TypeInt64, value)
+		_node.ChangedRows = value
+	}
+	if value, ok := _c.mutation.OriginalCost(); ok {
+		_spec.SetField(billingrecomputejob.FieldOriginalCost, field.TypeFloat64, value)
+		_node.OriginalCost = value
+	}
+	if value, ok := _c.mutation.RecomputedCost(); ok {
+		_spec.SetField(billingrecomputejob.FieldRecomputedCost, field.TypeFloat64, value)
+		_node.RecomputedCost = value
+	}
+	if value, ok := _c.mutation.BalanceDiff(); ok {
+		_spec.SetField(billingrecomputejob.FieldBalanceDiff, field.TypeFloat64, value)
+		_node.BalanceDiff = value
+	}
+	if value, ok := _c.mutation.ErrorMessage(); ok {
+		_spec.SetField(billingrecomputejob.FieldErrorMessage, field.TypeString, value)
+		_node.ErrorMessage = value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(billingrecomputejob.FieldCreatedBy, field.TypeInt64, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := _c.mutation.AppliedBy(); ok {
+		_spec.SetField(billingrecomputejob.FieldAppliedBy, field.TypeInt64, value)
+		_node.AppliedBy = &value
+	}
+	if value, ok := _c.mutation.StartedAt(); ok {
+		_spec.SetField(billingrecomputejob.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = &value
+	}
+	if value, ok := _c.mutation.FinishedAt(); ok {
+		_spec.SetField(billingrecomputejob.FieldFinishedAt, field.TypeTime, value)
+		_node.FinishedAt = &value
+	}
+	if value, ok := _c.mutation.AppliedAt(); ok {
+		_spec.SetField(billingrecomputejob.FieldAppliedAt, field.TypeTime, value)
+		_node.AppliedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(billingrecomputejob.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(billingrecomputejob.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.BillingRecomputeJob.Create().
+//		SetStatus(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BillingRecomputeJobUpsert) {
+//			SetStatus(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *BillingRecomputeJobCreate) OnConflict(opts ...sql.ConflictOption) *BillingRecomputeJobUpsertOne {
+	_c.conflict = opts
+	return &BillingRecomputeJobUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.BillingRecomputeJob.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *BillingRecomputeJobCreate) OnConflictColumns(columns ...string) *BillingRecomputeJobUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &BillingRecomputeJobUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// BillingRecomputeJobUpsertOne is the builder for "upsert"-ing
+	//  one BillingRecomputeJob node.
+	BillingRecomputeJobUpsertOne struct {
+		create *BillingRecomputeJobCreate
+	}
+
+	// BillingRecomputeJobUpsert is the "OnConflict" setter.
+	BillingRecomputeJobUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetStatus sets the "status" field.
+func (u *BillingRecomputeJobUpsert) SetStatus(v string) *BillingRecomputeJobUpsert {
+	u.Set(billingrecomputejob.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *BillingRecomputeJobUpsert) UpdateStatus() *BillingRecomputeJobUpsert {
+	u.SetExcluded(billingrecomputejob.FieldStatus)
+	return u
+}
+
+// SetMode sets the "mode" field.
+func (u *BillingRecomputeJobUpsert) SetMode(v string) *BillingRecomputeJobUpsert {
+	u.Set(billingrecomputejob.FieldMode, v)
+	return u
+}
+
+// UpdateMode sets the "mode" field to the value that was provided on create.
+func (u *BillingRecomputeJobUpsert) UpdateMode() *BillingRecomputeJobUpsert {
+	u.SetExcluded(billingrecomputejob.FieldMode)
+	return u
+}
+
+// SetParams sets the "params" field.
+func (u *BillingRecomputeJobUpsert) SetParams(v model.BillingRecomputeParams) *BillingRecomputeJobUpsert {
+	u.Set(billingrecomputejob.FieldParams, v)
+	return u
+}
+
+// UpdateParams sets the "params" field to the value that was provided on create.
+func (u *BillingRecomputeJobUpsert) UpdateParams() *BillingRecomputeJobUpsert {
+	u.SetExcluded(billingrecomputejob.FieldParams)
+	return u
+}
+
+// SetReport sets the "report" field.
+func (u *BillingRecomputeJobUpsert) SetReport(v *model.BillingRecomputeReport) *BillingRecomputeJobUpsert {
+	u.Set(billingrecomputejob.FieldReport, v)
+	return u
+}
+
+// UpdateReport sets the "report" field to the value that was provided on create.
+func (u *BillingRecomputeJobUpsert) UpdateReport() *BillingRecomputeJobUpsert {
+	u.SetExcluded(billingrecomputejob.FieldReport)
+	return u
+}
+
+// ClearReport clears the value of the "report" field.
+func (u *BillingRecomputeJobUpsert) ClearReport() *BillingRecomputeJobUpsert {
+	u.SetNull(billingrecomputejob.FieldReport)
+	return u
+}
+
+// SetScannedRows sets the "scanned_rows" field.
+func (u *BillingRecomputeJobUpsert) SetScannedRows(v int64) *BillingRecomputeJobUpsert {
+	u.Set(billingrecomputejob.FieldScannedRows, v)
+	return u
+}
+
+// UpdateScannedRows sets the "scanned_rows" field to the value that was provided on create.
+func (u *BillingRecomputeJobUpsert) UpdateScannedRows() *BillingRecomputeJobUpsert {
+	u.SetExcluded(billingrecomputejob.FieldScannedRows)
+	return u
+}
+
+// AddScannedRows adds v to the "scanned_rows" field.
+func (u *BillingRecomputeJobUpsert) AddScannedRows(v int64) *BillingRecomputeJobUpsert {
+	u.Add(billingrecomputejob.FieldScannedRows, v)
+	return u
+}
+
+// SetChangedRows sets the "changed_rows" field.
+func (u *BillingRecomputeJobUpsert) SetChangedRows(v int64) *BillingRecomputeJobUpsert {
+	u.Set(billingrecomputejob.FieldChangedRows, v)
+	return u
+}
+
+// UpdateChangedRows sets the "changed_rows" field to the value that was provided on create.
+func (u *BillingRecomputeJobUpsert) UpdateChangedRows() *BillingRecomputeJobUpsert {
+	u.SetExcluded(billingrecomputejob.FieldChangedRows)
+	return u
+}
+
+// AddChangedRows adds v to the "changed_rows" field.
+func (u *BillingRecomputeJobUpsert) AddChangedRows(v int64) *BillingRecomputeJobUpsert {
+	u.Add(billingrecomputejob.FieldChangedRows, v)
+	return u
+}
+
+// SetOriginalCost sets the "original_cost" field.
+func (u *BillingRecomputeJobUpsert) SetOriginalCost(v float64) *BillingRecomputeJobUpsert {
+	u.Set(billingrecomputejob.FieldOriginalCost, v)
+	return u
+}
+
+// UpdateOriginalCost sets the "original_cost" field to the value that was provided on create.
+func (u *BillingRecomputeJobUpsert) UpdateOriginalCost() *BillingRecomputeJobUpsert {
+	u.SetExcluded(billingrecomputejob.FieldOriginalCost)
+	return u
+}
+
+// AddOriginalCost adds v to the "original_cost" field.
+func (u *BillingRecomputeJobUpsert) AddOriginalCost(v float64) *BillingRecomputeJobUpsert {
+	u.Add(billingrecomputejob.FieldOriginalCost, v)
+	return u
+}
+
+// SetRecomputedCost sets the "recomputed_cost" field.
+func (u *BillingRecomputeJobUpsert) SetRecomputedCost(v float64) *BillingRecomputeJobUpsert {
+	u.Set(billingrecomputejob.FieldRecomputedCost, v)
+	return u
+}
+
+// UpdateRecomputedCost sets the "recomputed_cost" field to the value that was provided on create.
+func (u *BillingRecomputeJobUpsert) UpdateRecomputedCost() *BillingRecomputeJobUpsert {
+	u.SetExcluded(billingrecomputejob.FieldRecomputedCost)
+	return u
+}
+
+// AddRecomputedCost adds v to the "recomputed_cost" field.
+func (u *BillingRecomputeJobUpsert) AddRecomputedCost(v float64) *BillingRecomputeJobUpsert {
+	u.Add(billingrecomputejob.FieldRecomputedCost, v)
+	return u
+}
+
+// SetBalanceDiff sets the "balance_diff" field.
+func (u *BillingRecomputeJobUpsert) SetBalanceDiff(v float64) *BillingRecomputeJobUpsert {
+	u.Set(billingrecomputejob.FieldBalanceDiff, v)
+	return u
+}
+
+// UpdateBalanceDiff sets the "balance_diff" field to the value that was provided on create.
+func (u *BillingRecomputeJobUpsert) UpdateBalanceDiff() *BillingRecomputeJobUpsert {
+	u.SetExcluded(billingrecomputejob.FieldBalanceDiff)
+	return u
+}
+
+// AddBalanceDiff adds v to the "balance_diff" field.
+func (u *BillingRecomputeJobUpsert) AddBalanceDiff(v float64) *BillingRecomputeJobUpsert {
+	u.Add(billingrecomputejob.FieldBalanceDiff, v)
+	return u
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (u *BillingRecomputeJobUpsert) SetErrorMessage(v string) *BillingRecomputeJobUpsert {
+	u.Set(billingrecomputejob.FieldErrorMessage, v)
+	return u
+}
+
+// UpdateErrorMessage sets the "error_message" field to the value that was provided on create.
+func (u *BillingRecomputeJobUpsert) UpdateErrorMessage() *BillingRecomputeJobUpsert {
+	u.SetExcluded(billingrecomputejob.FieldErrorMessage)
+	return u
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (u *BillingRecomputeJobUpsert) ClearErrorMessage() *BillingRecomputeJobUpsert {
+	u.SetNull(billingrecomputejob.FieldErrorMessage)
+	return u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *BillingRecomputeJobUpsert) SetCreatedBy(v int64) *BillingRecomputeJobUpsert {
+	u.Set(billingrecomputejob.FieldCreatedBy, v)
+	return u
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *BillingRecomputeJobUpsert) UpdateCreatedBy() *BillingRecomputeJobUpsert {
+	u.SetExcluded(billingrecomputejob.FieldCreatedBy)
+	return u
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *BillingRecomputeJobUpsert) AddCreatedBy(v int64) *BillingRecomputeJobUpsert {
+	u.Add(billingrecomputejob.FieldCreatedBy, v)
+	return u
+}
+
+// SetAppliedBy sets the "applied_by" field.
+func (u *BillingRecomputeJobUpsert) SetAppliedBy(v int64) *BillingRecomputeJobUpsert {
+	u.Set(billingrecomputejob.FieldAppliedBy, v)
+	return u
+}
+
+// UpdateAppliedBy sets the "applied_by" field to the value that was provided on create.
+func (u *BillingRecomputeJobUpsert) UpdateAppliedBy() *BillingRecomputeJobUpsert {
+	u.SetExcluded(billingrecomputejob.FieldAppliedBy)
+	return u
+}
+
+// AddAppliedBy adds v to the "applied_by" field.
+func (u *BillingRecomputeJobUpsert) AddAppliedBy(v int64) *BillingRecomputeJobUpsert {
+	u.Add(billingrecomputejob.FieldAppliedBy, v)
+	return u
+}
+
+// ClearAppliedBy clears the value of the "applied_by" field.
+func (u *BillingRecomputeJobUpsert) ClearAppliedBy() *BillingRecomputeJobUpsert {
+	u.SetNull(billingrecomputejob.FieldAppliedBy)
+	return u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *BillingRecomputeJobUpsert) SetStartedAt(v time.Time) *BillingRecomputeJobUpsert {
+	u.Set(billingrecomputejob.FieldStartedAt, v)
+	return u
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *BillingRecomputeJobUpsert) UpdateStartedAt() *BillingRecomputeJobUpsert {
+	u.SetExcluded(billingrecomputejob.FieldStartedAt)
+	return u
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (u *BillingRecomputeJobUpsert) ClearStartedAt() *BillingRecomputeJobUpsert {
+	u.SetNull(billingrecomputejob.FieldStartedAt)
+	return u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *BillingRecomputeJobUpsert) SetFinishedAt(v time.Time) *BillingRecomputeJobUpsert {
+	u.Set(billingrecomputejob.FieldFinishedAt, v)
+	return u
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *BillingRecomputeJobUpsert) UpdateFinishedAt() *BillingRecomputeJobUpsert {
+	u.SetExcluded(billingrecomputejob.FieldFinishedAt)
+	return u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *BillingRecomputeJobUpsert) ClearFinishedAt() *BillingRecomputeJobUpsert {
+	u.SetNull(billingrecomputejob.FieldFinishedAt)
+	return u
+}
+
+// SetAppliedAt sets the "applied_at" field.
+func (u *BillingRecomputeJobUpsert) SetAppliedAt(v time.Time) *BillingRecomputeJobUpsert {
+	u.Set(billingrecomputejob.FieldAppliedAt, v)
+	return u
+}
+
+// UpdateAppliedAt sets the "applied_at" field to the value that was provided on create.
+func (u *BillingRecomputeJobUpsert) UpdateAppliedAt() *BillingRecomputeJobUpsert {
+	u.SetExcluded(billingrecomputejob.FieldAppliedAt)
+	return u
+}
+
+// ClearAppliedAt clears the value of the "applied_at" field.
+func (u *BillingRecomputeJobUpsert) ClearAppliedAt() *BillingRecomputeJobUpsert {
+	u.SetNull(billingrecomputejob.FieldAppliedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *BillingRecomputeJobUpsert) SetUpdatedAt(v time.Time) *BillingRecomputeJobUpsert {
+	u.Set(billingrecomputejob.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *BillingRecomputeJobUpsert) UpdateUpdatedAt() *BillingRecomputeJobUpsert {
+	u.SetExcluded(billingrecomputejob.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.BillingRecomputeJob.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *BillingRecomputeJobUpsertOne) UpdateNewValues() *BillingRecomputeJobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(billingrecomputejob.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.BillingRecomputeJob.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *BillingRecomputeJobUpsertOne) Ignore() *BillingRecomputeJobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BillingRecomputeJobUpsertOne) DoNothing() *BillingRecomputeJobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BillingRecomputeJobCreate.OnConflict
+// documentation for more info.
+func (u *BillingRecomputeJobUpsertOne) Update(set func(*BillingRecomputeJobUpsert)) *BillingRecomputeJobUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BillingRecomputeJobUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *BillingRecomputeJobUpsertOne) SetStatus(v string) *BillingRecomputeJobUpsertOne {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *BillingRecomputeJobUpsertOne) UpdateStatus() *BillingRecomputeJobUpsertOne {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetMode sets the "mode" field.
+func (u *BillingRecomputeJobUpsertOne) SetMode(v string) *BillingRecomputeJobUpsertOne {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.SetMode(v)
+	})
+}
+
+// UpdateMode sets the "mode" field to the value that was provided on create.
+func (u *BillingRecomputeJobUpsertOne) UpdateMode() *BillingRecomputeJobUpsertOne {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.UpdateMode()
+	})
+}
+
+// SetParams sets the "params" field.
+func (u *BillingRecomputeJobUpsertOne) SetParams(v model.BillingRecomputeParams) *BillingRecomputeJobUpsertOne {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.SetParams(v)
+	})
+}
+
+// UpdateParams sets the "params" field to the value that was provided on create.
+func (u *BillingRecomputeJobUpsertOne) UpdateParams() *BillingRecomputeJobUpsertOne {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.UpdateParams()
+	})
+}
+
+// SetReport sets the "report" field.
+func (u *BillingRecomputeJobUpsertOne) SetReport(v *model.BillingRecomputeReport) *BillingRecomputeJobUpsertOne {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.SetReport(v)
+	})
+}
+
+// UpdateReport sets the "report" field to the value that was provided on create.
+func (u *BillingRecomputeJobUpsertOne) UpdateReport() *BillingRecomputeJobUpsertOne {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.UpdateReport()
+	})
+}
+
+// ClearReport clears the value of the "report" field.
+func (u *BillingRecomputeJobUpsertOne) ClearReport() *BillingRecomputeJobUpsertOne {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.ClearReport()
+	})
+}
+
+// SetScannedRows sets the "scanned_rows" field.
+func (u *BillingRecomputeJobUpsertOne) SetScannedRows(v int64) *BillingRecomputeJobUpsertOne {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.SetScannedRows(v)
+	})
+}
+
+// AddScannedRows adds v to the "scanned_rows" field.
+func (u *BillingRecomputeJobUpsertOne) AddScannedRows(v int64) *BillingRecomputeJobUpsertOne {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.AddScannedRows(v)
+	})
+}
+
+// UpdateScannedRows sets the "scanned_rows" field to the value that was provided on create.
+func (u *BillingRecomputeJobUpsertOne) UpdateScannedRows() *BillingRecomputeJobUpsertOne {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.UpdateScannedRows()
+	})
+}
+
+// SetChangedRows sets the "changed_rows" field.
+func (u *BillingRecomputeJobUpsertOne) SetChangedRows(v int64) *BillingRecomputeJobUpsertOne {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.SetChangedRows(v)
+	})
+}
+
+// AddChangedRows adds v to the "changed_rows" field.
+func (u *BillingRecomputeJobUpsertOne) AddChangedRows(v int64) *BillingRecomputeJobUpsertOne {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.AddChangedRows(v)
+	})
+}
+
+// UpdateChangedRows sets the "changed_rows" field to the value that was provided on create.
+func (u *BillingRecomputeJobUpsertOne) UpdateChangedRows() *BillingRecomputeJobUpsertOne {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.UpdateChangedRows()
+	})
+}
+
+// SetOriginalCost sets the "original_cost" field.
+func (u *BillingRecomputeJobUpsertOne) SetOriginalCost(v float64) *BillingRecomputeJobUpsertOne {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.SetOriginalCost(v)
+	})
+}
+
+// AddOriginalCost adds v to the "original_cost" field.
+func (u *BillingRecomputeJobUpsertOne) AddOriginalCost(v float64) *BillingRecomputeJobUpsertOne {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.AddOriginalCost(v)
+	})
+}
+
+// UpdateOriginalCost sets the "original_cost" field to the value that was provided on create.
+func (u *BillingRecomputeJobUpsertOne) UpdateOriginalCost() *BillingRecomputeJobUpsertOne {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.UpdateOriginalCost()
+	})
+}
+
+// SetRecomputedCost sets the "recomputed_cost" field.
+func (u *BillingRecomputeJobUpsertOne) SetRecomputedCost(v float64) *BillingRecomputeJobUpsertOne {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.SetRecomputedCost(v)
+	})
+}
+
+// AddRecomputedCost adds v to the "recomputed_cost" field.
+func (u *BillingRecomputeJobUpsertOne) AddRecomputedCost(v float64) *BillingRecomputeJobUpsertOne {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.AddRecomputedCost(v)
+	})
+}
+
+// UpdateRecomputedCost sets the "recomputed_cost" field to the value that was provided on create.
+func (u *BillingRecomputeJobUpsertOne) UpdateRecomputedCost() *BillingRecomputeJobUpsertOne {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.UpdateRecomputedCost()
+	})
+}
+
+// SetBalanceDiff sets the "balance_diff" field.
+func (u *BillingRecomputeJobUpsertOne) SetBalanceDiff(v float64) *BillingRecomputeJobUpsertOne {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.SetBalanceDiff(v)
+	})
+}
+
+// AddBalanceDiff adds v to the "balance_diff" field.
+func (u *BillingRecomputeJobUpsertOne) AddBalanceDiff(v float64) *BillingRecomputeJobUpsertOne {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.AddBalanceDiff(v)
+	})
+}
+
+// UpdateBalanceDiff sets the "balance_diff" field to the value that was provided on create.
+func (u *BillingRecomputeJobUpsertOne) UpdateBalanceDiff() *BillingRecomputeJobUpsertOne {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.UpdateBalanceDiff()
+	})
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (u *BillingRecomputeJobUpsertOne) SetErrorMessage(v string) *BillingRecomputeJobUpsertOne {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.SetErrorMessage(v)
+	})
+}
+
+// UpdateErrorMessage sets the "error_message" field to the value that was provided on create.
+func (u *BillingRecomputeJobUpsertOne) UpdateErrorMessage() *BillingRecomputeJobUpsertOne {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.UpdateErrorMessage()
+	})
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (u *BillingRecomputeJobUpsertOne) ClearErrorMessage() *BillingRecomputeJobUpsertOne {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.ClearErrorMessage()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *BillingRecomputeJobUpsertOne) SetCreatedBy(v int64) *BillingRecomputeJobUpsertOne {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *BillingRecomputeJobUpsertOne) AddCreatedBy(v int64) *BillingRecomputeJobUpsertOne {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.AddCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *BillingRecomputeJobUpsertOne) UpdateCreatedBy() *BillingRecomputeJobUpsertOne {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// SetAppliedBy sets the "applied_by" field.
+func (u *BillingRecomputeJobUpsertOne) SetAppliedBy(v int64) *BillingRecomputeJobUpsertOne {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.SetAppliedBy(v)
+	})
+}
+
+// AddAppliedBy adds v to the "applied_by" field.
+func (u *BillingRecomputeJobUpsertOne) AddAppliedBy(v int64) *BillingRecomputeJobUpsertOne {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.AddAppliedBy(v)
+	})
+}
+
+// UpdateAppliedBy sets the "applied_by" field to the value that was provided on create.
+func (u *BillingRecomputeJobUpsertOne) UpdateAppliedBy() *BillingRecomputeJobUpsertOne {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.UpdateAppliedBy()
+	})
+}
+
+// ClearAppliedBy clears the value of the "applied_by" field.
+func (u *BillingRecomputeJobUpsertOne) ClearAppliedBy() *BillingRecomputeJobUpsertOne {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.ClearAppliedBy()
+	})
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *BillingRecomputeJobUpsertOne) SetStartedAt(v time.Time) *BillingRecomputeJobUpsertOne {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.SetStartedAt(v)
+	})
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *BillingRecomputeJobUpsertOne) UpdateStartedAt() *BillingRecomputeJobUpsertOne {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.UpdateStartedAt()
+	})
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (u *BillingRecomputeJobUpsertOne) ClearStartedAt() *BillingRecomputeJobUpsertOne {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.ClearStartedAt()
+	})
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *BillingRecomputeJobUpsertOne) SetFinishedAt(v time.Time) *BillingRecomputeJobUpsertOne {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.SetFinishedAt(v)
+	})
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *BillingRecomputeJobUpsertOne) UpdateFinishedAt() *BillingRecomputeJobUpsertOne {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.UpdateFinishedAt()
+	})
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *BillingRecomputeJobUpsertOne) ClearFinishedAt() *BillingRecomputeJobUpsertOne {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.ClearFinishedAt()
+	})
+}
+
+// SetAppliedAt sets the "applied_at" field.
+func (u *BillingRecomputeJobUpsertOne) SetAppliedAt(v time.Time) *BillingRecomputeJobUpsertOne {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.SetAppliedAt(v)
+	})
+}
+
+// UpdateAppliedAt sets the "applied_at" field to the value that was provided on create.
+func (u *BillingRecomputeJobUpsertOne) UpdateAppliedAt() *BillingRecomputeJobUpsertOne {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.UpdateAppliedAt()
+	})
+}
+
+// ClearAppliedAt clears the value of the "applied_at" field.
+func (u *BillingRecomputeJobUpsertOne) ClearAppliedAt() *BillingRecomputeJobUpsertOne {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.ClearAppliedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *BillingRecomputeJobUpsertOne) SetUpdatedAt(v time.Time) *BillingRecomputeJobUpsertOne {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *BillingRecomputeJobUpsertOne) UpdateUpdatedAt() *BillingRecomputeJobUpsertOne {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *BillingRecomputeJobUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BillingRecomputeJobCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BillingRecomputeJobUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *BillingRecomputeJobUpsertOne) ID(ctx context.Context) (id int64, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *BillingRecomputeJobUpsertOne) IDX(ctx context.Context) int64 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// BillingRecomputeJobCreateBulk is the builder for creating many BillingRecomputeJob entities in bulk.
+type BillingRecomputeJobCreateBulk struct {
+	config
+	err      error
+	builders []*BillingRecomputeJobCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the BillingRecomputeJob entities in the database.
+func (_c *BillingRecomputeJobCreateBulk) Save(ctx context.Context) ([]*BillingRecomputeJob, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BillingRecomputeJob, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BillingRecomputeJobMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BillingRecomputeJobCreateBulk) SaveX(ctx context.Context) []*BillingRecomputeJob {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BillingRecomputeJobCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BillingRecomputeJobCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.BillingRecomputeJob.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BillingRecomputeJobUpsert) {
+//			SetStatus(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *BillingRecomputeJobCreateBulk) OnConflict(opts ...sql.ConflictOption) *BillingRecomputeJobUpsertBulk {
+	_c.conflict = opts
+	return &BillingRecomputeJobUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.BillingRecomputeJob.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *BillingRecomputeJobCreateBulk) OnConflictColumns(columns ...string) *BillingRecomputeJobUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &BillingRecomputeJobUpsertBulk{
+		create: _c,
+	}
+}
+
+// BillingRecomputeJobUpsertBulk is the builder for "upsert"-ing
+// a bulk of BillingRecomputeJob nodes.
+type BillingRecomputeJobUpsertBulk struct {
+	create *BillingRecomputeJobCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.BillingRecomputeJob.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *BillingRecomputeJobUpsertBulk) UpdateNewValues() *BillingRecomputeJobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(billingrecomputejob.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.BillingRecomputeJob.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *BillingRecomputeJobUpsertBulk) Ignore() *BillingRecomputeJobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BillingRecomputeJobUpsertBulk) DoNothing() *BillingRecomputeJobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BillingRecomputeJobCreateBulk.OnConflict
+// documentation for more info.
+func (u *BillingRecomputeJobUpsertBulk) Update(set func(*BillingRecomputeJobUpsert)) *BillingRecomputeJobUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BillingRecomputeJobUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *BillingRecomputeJobUpsertBulk) SetStatus(v string) *BillingRecomputeJobUpsertBulk {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *BillingRecomputeJobUpsertBulk) UpdateStatus() *BillingRecomputeJobUpsertBulk {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetMode sets the "mode" field.
+func (u *BillingRecomputeJobUpsertBulk) SetMode(v string) *BillingRecomputeJobUpsertBulk {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.SetMode(v)
+	})
+}
+
+// UpdateMode sets the "mode" field to the value that was provided on create.
+func (u *BillingRecomputeJobUpsertBulk) UpdateMode() *BillingRecomputeJobUpsertBulk {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.UpdateMode()
+	})
+}
+
+// SetParams sets the "params" field.
+func (u *BillingRecomputeJobUpsertBulk) SetParams(v model.BillingRecomputeParams) *BillingRecomputeJobUpsertBulk {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.SetParams(v)
+	})
+}
+
+// UpdateParams sets the "params" field to the value that was provided on create.
+func (u *BillingRecomputeJobUpsertBulk) UpdateParams() *BillingRecomputeJobUpsertBulk {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.UpdateParams()
+	})
+}
+
+// SetReport sets the "report" field.
+func (u *BillingRecomputeJobUpsertBulk) SetReport(v *model.BillingRecomputeReport) *BillingRecomputeJobUpsertBulk {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.SetReport(v)
+	})
+}
+
+// UpdateReport sets the "report" field to the value that was provided on create.
+func (u *BillingRecomputeJobUpsertBulk) UpdateReport() *BillingRecomputeJobUpsertBulk {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.UpdateReport()
+	})
+}
+
+// ClearReport clears the value of the "report" field.
+func (u *BillingRecomputeJobUpsertBulk) ClearReport() *BillingRecomputeJobUpsertBulk {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.ClearReport()
+	})
+}
+
+// SetScannedRows sets the "scanned_rows" field.
+func (u *BillingRecomputeJobUpsertBulk) SetScannedRows(v int64) *BillingRecomputeJobUpsertBulk {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.SetScannedRows(v)
+	})
+}
+
+// AddScannedRows adds v to the "scanned_rows" field.
+func (u *BillingRecomputeJobUpsertBulk) AddScannedRows(v int64) *BillingRecomputeJobUpsertBulk {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.AddScannedRows(v)
+	})
+}
+
+// UpdateScannedRows sets the "scanned_rows" field to the value that was provided on create.
+func (u *BillingRecomputeJobUpsertBulk) UpdateScannedRows() *BillingRecomputeJobUpsertBulk {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.UpdateScannedRows()
+	})
+}
+
+// SetChangedRows sets the "changed_rows" field.
+func (u *BillingRecomputeJobUpsertBulk) SetChangedRows(v int64) *BillingRecomputeJobUpsertBulk {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.SetChangedRows(v)
+	})
+}
+
+// AddChangedRows adds v to the "changed_rows" field.
+func (u *BillingRecomputeJobUpsertBulk) AddChangedRows(v int64) *BillingRecomputeJobUpsertBulk {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.AddChangedRows(v)
+	})
+}
+
+// UpdateChangedRows sets the "changed_rows" field to the value that was provided on create.
+func (u *BillingRecomputeJobUpsertBulk) UpdateChangedRows() *BillingRecomputeJobUpsertBulk {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.UpdateChangedRows()
+	})
+}
+
+// SetOriginalCost sets the "original_cost" field.
+func (u *BillingRecomputeJobUpsertBulk) SetOriginalCost(v float64) *BillingRecomputeJobUpsertBulk {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.SetOriginalCost(v)
+	})
+}
+
+// AddOriginalCost adds v to the "original_cost" field.
+func (u *BillingRecomputeJobUpsertBulk) AddOriginalCost(v float64) *BillingRecomputeJobUpsertBulk {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.AddOriginalCost(v)
+	})
+}
+
+// UpdateOriginalCost sets the "original_cost" field to the value that was provided on create.
+func (u *BillingRecomputeJobUpsertBulk) UpdateOriginalCost() *BillingRecomputeJobUpsertBulk {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.UpdateOriginalCost()
+	})
+}
+
+// SetRecomputedCost sets the "recomputed_cost" field.
+func (u *BillingRecomputeJobUpsertBulk) SetRecomputedCost(v float64) *BillingRecomputeJobUpsertBulk {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.SetRecomputedCost(v)
+	})
+}
+
+// AddRecomputedCost adds v to the "recomputed_cost" field.
+func (u *BillingRecomputeJobUpsertBulk) AddRecomputedCost(v float64) *BillingRecomputeJobUpsertBulk {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.AddRecomputedCost(v)
+	})
+}
+
+// UpdateRecomputedCost sets the "recomputed_cost" field to the value that was provided on create.
+func (u *BillingRecomputeJobUpsertBulk) UpdateRecomputedCost() *BillingRecomputeJobUpsertBulk {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.UpdateRecomputedCost()
+	})
+}
+
+// SetBalanceDiff sets the "balance_diff" field.
+func (u *BillingRecomputeJobUpsertBulk) SetBalanceDiff(v float64) *BillingRecomputeJobUpsertBulk {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.SetBalanceDiff(v)
+	})
+}
+
+// AddBalanceDiff adds v to the "balance_diff" field.
+func (u *BillingRecomputeJobUpsertBulk) AddBalanceDiff(v float64) *BillingRecomputeJobUpsertBulk {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.AddBalanceDiff(v)
+	})
+}
+
+// UpdateBalanceDiff sets the "balance_diff" field to the value that was provided on create.
+func (u *BillingRecomputeJobUpsertBulk) UpdateBalanceDiff() *BillingRecomputeJobUpsertBulk {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.UpdateBalanceDiff()
+	})
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (u *BillingRecomputeJobUpsertBulk) SetErrorMessage(v string) *BillingRecomputeJobUpsertBulk {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.SetErrorMessage(v)
+	})
+}
+
+// UpdateErrorMessage sets the "error_message" field to the value that was provided on create.
+func (u *BillingRecomputeJobUpsertBulk) UpdateErrorMessage() *BillingRecomputeJobUpsertBulk {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.UpdateErrorMessage()
+	})
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (u *BillingRecomputeJobUpsertBulk) ClearErrorMessage() *BillingRecomputeJobUpsertBulk {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.ClearErrorMessage()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *BillingRecomputeJobUpsertBulk) SetCreatedBy(v int64) *BillingRecomputeJobUpsertBulk {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *BillingRecomputeJobUpsertBulk) AddCreatedBy(v int64) *BillingRecomputeJobUpsertBulk {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.AddCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *BillingRecomputeJobUpsertBulk) UpdateCreatedBy() *BillingRecomputeJobUpsertBulk {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// SetAppliedBy sets the "applied_by" field.
+func (u *BillingRecomputeJobUpsertBulk) SetAppliedBy(v int64) *BillingRecomputeJobUpsertBulk {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.SetAppliedBy(v)
+	})
+}
+
+// AddAppliedBy adds v to the "applied_by" field.
+func (u *BillingRecomputeJobUpsertBulk) AddAppliedBy(v int64) *BillingRecomputeJobUpsertBulk {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.AddAppliedBy(v)
+	})
+}
+
+// UpdateAppliedBy sets the "applied_by" field to the value that was provided on create.
+func (u *BillingRecomputeJobUpsertBulk) UpdateAppliedBy() *BillingRecomputeJobUpsertBulk {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.UpdateAppliedBy()
+	})
+}
+
+// ClearAppliedBy clears the value of the "applied_by" field.
+func (u *BillingRecomputeJobUpsertBulk) ClearAppliedBy() *BillingRecomputeJobUpsertBulk {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.ClearAppliedBy()
+	})
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *BillingRecomputeJobUpsertBulk) SetStartedAt(v time.Time) *BillingRecomputeJobUpsertBulk {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.SetStartedAt(v)
+	})
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *BillingRecomputeJobUpsertBulk) UpdateStartedAt() *BillingRecomputeJobUpsertBulk {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.UpdateStartedAt()
+	})
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (u *BillingRecomputeJobUpsertBulk) ClearStartedAt() *BillingRecomputeJobUpsertBulk {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.ClearStartedAt()
+	})
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *BillingRecomputeJobUpsertBulk) SetFinishedAt(v time.Time) *BillingRecomputeJobUpsertBulk {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.SetFinishedAt(v)
+	})
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *BillingRecomputeJobUpsertBulk) UpdateFinishedAt() *BillingRecomputeJobUpsertBulk {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.UpdateFinishedAt()
+	})
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *BillingRecomputeJobUpsertBulk) ClearFinishedAt() *BillingRecomputeJobUpsertBulk {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.ClearFinishedAt()
+	})
+}
+
+// SetAppliedAt sets the "applied_at" field.
+func (u *BillingRecomputeJobUpsertBulk) SetAppliedAt(v time.Time) *BillingRecomputeJobUpsertBulk {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.SetAppliedAt(v)
+	})
+}
+
+// UpdateAppliedAt sets the "applied_at" field to the value that was provided on create.
+func (u *BillingRecomputeJobUpsertBulk) UpdateAppliedAt() *BillingRecomputeJobUpsertBulk {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.UpdateAppliedAt()
+	})
+}
+
+// ClearAppliedAt clears the value of the "applied_at" field.
+func (u *BillingRecomputeJobUpsertBulk) ClearAppliedAt() *BillingRecomputeJobUpsertBulk {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.ClearAppliedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *BillingRecomputeJobUpsertBulk) SetUpdatedAt(v time.Time) *BillingRecomputeJobUpsertBulk {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *BillingRecomputeJobUpsertBulk) UpdateUpdatedAt() *BillingRecomputeJobUpsertBulk {
+	return u.Update(func(s *BillingRecomputeJobUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *BillingRecomputeJobUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the BillingRecomputeJobCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BillingRecomputeJobCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BillingRecomputeJobUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,991 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Wei-Shaw/sub2api/ent/billingrecomputejob"
+	"github.com/Wei-Shaw/sub2api/ent/predicate"
+	"github.com/Wei-Shaw/sub2api/internal/model"
+)
+
+// BillingRecomputeJobUpdate is the builder for updating BillingRecomputeJob entities.
+type BillingRecomputeJobUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BillingRecomputeJobMutation
+}
+
+// Where appends a list predicates to the BillingRecomputeJobUpdate builder.
+func (_u *BillingRecomputeJobUpdate) Where(ps ...predicate.BillingRecomputeJob) *BillingRecomputeJobUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *BillingRecomputeJobUpdate) SetStatus(v string) *BillingRecomputeJobUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *BillingRecomputeJobUpdate) SetNillableStatus(v *string) *BillingRecomputeJobUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetMode sets the "mode" field.
+func (_u *BillingRecomputeJobUpdate) SetMode(v string) *BillingRecomputeJobUpdate {
+	_u.mutation.SetMode(v)
+	return _u
+}
+
+// SetNillableMode sets the "mode" field if the given value is not nil.
+func (_u *BillingRecomputeJobUpdate) SetNillableMode(v *string) *BillingRecomputeJobUpdate {
+	if v != nil {
+		_u.SetMode(*v)
+	}
+	return _u
+}
+
+// SetParams sets the "params" field.
+func (_u *BillingRecomputeJobUpdate) SetParams(v model.BillingRecomputeParams) *BillingRecomputeJobUpdate {
+	_u.mutation.SetParams(v)
+	return _u
+}
+
+// SetNillableParams sets the "params" field if the given value is not nil.
+func (_u *BillingRecomputeJobUpdate) SetNillableParams(v *model.BillingRecomputeParams) *BillingRecomputeJobUpdate {
+	if v != nil {
+		_u.SetParams(*v)
+	}
+	return _u
+}
+
+// SetReport sets the "report" field.
+func (_u *BillingRecomputeJobUpdate) SetReport(v *model.BillingRecomputeReport) *BillingRecomputeJobUpdate {
+	_u.mutation.SetReport(v)
+	return _u
+}
+
+// ClearReport clears the value of the "report" field.
+func (_u *BillingRecomputeJobUpdate) ClearReport() *BillingRecomputeJobUpdate {
+	_u.mutation.ClearReport()
+	return _u
+}
+
+// SetScannedRows sets the "scanned_rows" field.
+func (_u *BillingRecomputeJobUpdate) SetScannedRows(v int64) *BillingRecomputeJobUpdate {
+	_u.mutation.ResetScannedRows()
+	_u.mutation.SetScannedRows(v)
+	return _u
+}
+
+// SetNillableScannedRows sets the "scanned_rows" field if the given value is not nil.
+func (_u *BillingRecomputeJobUpdate) SetNillableScannedRows(v *int64) *BillingRecomputeJobUpdate {
+	if v != nil {
+		_u.SetScannedRows(*v)
+	}
+	return _u
+}
+
+// AddScannedRows adds value to the "scanned_rows" field.
+func (_u *BillingRecomputeJobUpdate) AddScannedRows(v int64) *BillingRecomputeJobUpdate {
+	_u.mutation.AddScannedRows(v)
+	return _u
+}
+
+// SetChangedRows sets the "changed_rows" field.
+func (_u *BillingRecomputeJobUpdate) SetChangedRows(v int64) *BillingRecomputeJobUpdate {
+	_u.mutation.ResetChangedRows()
+	_u.mutation.SetChangedRows(v)
+	return _u
+}
+
+// SetNillableChangedRows sets the "changed_rows" field if the given value is not nil.
+func (_u *BillingRecomputeJobUpdate) SetNillableChangedRows(v *int64) *BillingRecomputeJobUpdate {
+	if v != nil {
+		_u.SetChangedRows(*v)
+	}
+	return _u
+}
+
+// AddChangedRows adds value to the "changed_rows" field.
+func (_u *BillingRecomputeJobUpdate) AddChangedRows(v int64) *BillingRecomputeJobUpdate {
+	_u.mutation.AddChangedRows(v)
+	return _u
+}
+
+// SetOriginalCost sets the "original_cost" field.
+func (_u *BillingRecomputeJobUpdate) SetOriginalCost(v float64) *BillingRecomputeJobUpdate {
+	_u.mutation.ResetOriginalCost()
+	_u.mutation.SetOriginalCost(v)
+	return _u
+}
+
+// SetNillableOriginalCost sets the "original_cost" field if the given value is not nil.
+func (_u *BillingRecomputeJobUpdate) SetNillableOriginalCost(v *float64) *BillingRecomputeJobUpdate {
+	if v != nil {
+		_u.SetOriginalCost(*v)
+	}
+	return _u
+}
+
+// AddOriginalCost adds value to the "original_cost" field.
+func (_u *BillingRecomputeJobUpdate) AddOriginalCost(v float64) *BillingRecomputeJobUpdate {
+	_u.mutation.AddOriginalCost(v)
+	return _u
+}
+
+// SetRecomputedCost sets the "recomputed_cost" field.
+func (_u *BillingRecomputeJobUpdate) SetRecomputedCost(v float64) *BillingRecomputeJobUpdate {
+	_u.mutation.ResetRecomputedCost()
+	_u.mutation.SetRecomputedCost(v)
+	return _u
+}
+
+// SetNillableRecomputedCost sets the "recomputed_cost" field if the given value is not nil.
+func (_u *BillingRecomputeJobUpdate) SetNillableRecomputedCost(v *float64) *BillingRecomputeJobUpdate {
+	if v != nil {
+		_u.SetRecomputedCost(*v)
+	}
+	return _u
+}
+
+// AddRecomputedCost adds value to the "recomputed_cost" field.
+func (_u *BillingRecomputeJobUpdate) AddRecomputedCost(v float64) *BillingRecomputeJobUpdate {
+	_u.mutation.AddRecomputedCost(v)
+	return _u
+}
+
+// SetBalanceDiff sets the "balance_diff" field.
+func (_u *BillingRecomputeJobUpdate) SetBalanceDiff(v float64) *BillingRecomputeJobUpdate {
+	_u.mutation.ResetBalanceDiff()
+	_u.mutation.SetBalanceDiff(v)
+	return _u
+}
+
+// SetNillableBalanceDiff sets the "balance_diff" field if the given value is not nil.
+func (_u *BillingRecomputeJobUpdate) SetNillableBalanceDiff(v *float64) *BillingRecomputeJobUpdate {
+	if v != nil {
+		_u.SetBalanceDiff(*v)
+	}
+	return _u
+}
+
+// AddBalanceDiff adds value to the "balance_diff" field.
+func (_u *BillingRecomputeJobUpdate) AddBalanceDiff(v float64) *BillingRecomputeJobUpdate {
+	_u.mutation.AddBalanceDiff(v)
+	return _u
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (_u *BillingRecomputeJobUpdate) SetErrorMessage(v string) *BillingRecomputeJobUpdate {
+	_u.mutation.SetErrorMessage(v)
+	return _u
+}
+
+// SetNillableErrorMessage sets the "error_message" field if the given value is not nil.
+func (_u *BillingRecomputeJobUpdate) SetNillableErrorMessage(v *string) *BillingRecomputeJobUpdate {
+	if v != nil {
+		_u.SetErrorMessage(*v)
+	}
+	return _u
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (_u *BillingRecomputeJobUpdate) ClearErrorMessage() *BillingRecomputeJobUpdate {
+	_u.mutation.ClearErrorMessage()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *BillingRecomputeJobUpdate) SetCreatedBy(v int64) *BillingRecomputeJobUpdate {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *BillingRecomputeJobUpdate) SetNillableCreatedBy(v *int64) *BillingRecomputeJobUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *BillingRecomputeJobUpdate) AddCreatedBy(v int64) *BillingRecomputeJobUpdate {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// SetAppliedBy sets the "applied_by" field.
+func (_u *BillingRecomputeJobUpdate) SetAppliedBy(v int64) *BillingRecomputeJobUpdate {
+	_u.mutation.ResetAppliedBy()
+	_u.mutation.SetAppliedBy(v)
+	return _u
+}
+
+// SetNillableAppliedBy sets the "applied_by" field if the given value is not nil.
+func (_u *BillingRecomputeJobUpdate) SetNillableAppliedBy(v *int64) *BillingRecomputeJobUpdate {
+	if v != nil {
+		_u.SetAppliedBy(*v)
+	}
+	return _u
+}
+
+// AddAppliedBy adds value to the "applied_by" field.
+func (_u *BillingRecomputeJobUpdate) AddAppliedBy(v int64) *BillingRecomputeJobUpdate {
+	_u.mutation.AddAppliedBy(v)
+	return _u
+}
+
+// ClearAppliedBy clears the value of the "applied_by" field.
+func (_u *BillingRecomputeJobUpdate) ClearAppliedBy() *BillingRecomputeJobUpdate {
+	_u.mutation.ClearAppliedBy()
+	return _u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_u *BillingRecomputeJobUpdate) SetStartedAt(v time.Time) *BillingRecomputeJobUpdate {
+	_u.mutation.SetStartedAt(v)
+	return _u
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_u *BillingRecomputeJobUpdate) SetNillableStartedAt(v *time.Time) *BillingRecomputeJobUpdate {
+	if v != nil {
+		_u.SetStartedAt(*v)
+	}
+	return _u
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (_u *BillingRecomputeJobUpdate) ClearStartedAt() *BillingRecomputeJobUpdate {
+	_u.mutation.ClearStartedAt()
+	return _u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_u *BillingRecomputeJobUpdate) SetFinishedAt(v time.Time) *BillingRecomputeJobUpdate {
+	_u.mutation.SetFinishedAt(v)
+	return _u
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_u *BillingRecomputeJobUpdate) SetNillableFinishedAt(v *time.Time) *BillingRecomputeJobUpdate {
+	if v != nil {
+		_u.SetFinishedAt(*v)
+	}
+	return _u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (_u *BillingRecomputeJobUpdate) ClearFinishedAt() *BillingRecomputeJobUpdate {
+	_u.mutation.ClearFinishedAt()
+	return _u
+}
+
+// SetAppliedAt sets the "applied_at" field.
+func (_u *BillingRecomputeJobUpdate) SetAppliedAt(v time.Time) *BillingRecomputeJobUpdate {
+	_u.mutation.SetAppliedAt(v)
+	return _u
+}
+
+// SetNillableAppliedAt sets the "applied_at" field if the given value is not nil.
+func (_u *BillingRecomputeJobUpdate) SetNillableAppliedAt(v *time.Time) *BillingRecomputeJobUpdate {
+	if v != nil {
+		_u.SetAppliedAt(*v)
+	}
+	return _u
+}
+
+// ClearAppliedAt clears the value of the "applied_at" field.
+func (_u *BillingRecomputeJobUpdate) ClearAppliedAt() *BillingRecomputeJobUpdate {
+	_u.mutation.ClearAppliedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *BillingRecomputeJobUpdate) SetUpdatedAt(v time.Time) *BillingRecomputeJobUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the BillingRecomputeJobMutation object of the builder.
+func (_u *BillingRecomputeJobUpdate) Mutation() *BillingRecomputeJobMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BillingRecomputeJobUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BillingRecomputeJobUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BillingRecomputeJobUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BillingRecomputeJobUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *BillingRecomputeJobUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := billingrecomputejob.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BillingRecomputeJobUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := billingrecomputejob.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "BillingRecomputeJob.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Mode(); ok {
+		if err := billingrecomputejob.ModeValidator(v); err != nil {
+			return &ValidationError{Name: "mode", err: fmt.Errorf(`ent: validator failed for field "BillingRecomputeJob.mode": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BillingRecomputeJobUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(billingrecomputejob.Table, billingrecomputejob.Columns, sqlgraph.NewFieldSpec(billingrecomputejob.FieldID, field.TypeInt64))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(billingrecomputejob.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Mode(); ok {
+		_spec.SetField(billingrecomputejob.FieldMode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Params(); ok {
+		_spec.SetField(billingrecomputejob.FieldParams, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.Report(); ok {
+		_spec.SetField(billingrecomputejob.FieldReport, field.TypeJSON, value)
+	}
+	if _u.mutation.ReportCleared() {
+		_spec.ClearField(billingrecomputejob.FieldReport, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ScannedRows(); ok {
+		_spec.SetField(billingrecomputejob.FieldScannedRows, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedScannedRows(); ok {
+		_spec.AddField(billingrecomputejob.FieldScannedRows, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.ChangedRows(); ok {
+		_spec.SetField(billingrecomputejob.FieldChangedRows, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedChangedRows(); ok {
+		_spec.AddField(billingrecomputejob.FieldChangedRows, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.OriginalCost(); ok {
+		_spec.SetField(billingrecomputejob.FieldOriginalCost, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedOriginalCost(); ok {
+		_spec.AddField(billingrecomputejob.FieldOriginalCost, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.RecomputedCost(); ok {
+		_spec.SetField(billingrecomputejob.FieldRecomputedCost, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedRecomputedCost(); ok {
+		_spec.AddField(billingrecomputejob.FieldRecomputedCost, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.BalanceDiff(); ok {
+		_spec.SetField(billingrecomputejob.FieldBalanceDiff, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedBalanceDiff(); ok {
+		_spec.AddField(billingrecomputejob.FieldBalanceDiff, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.ErrorMessage(); ok {
+		_spec.SetField(billingrecomputejob.FieldErrorMessage, field.TypeString, value)
+	}
+	if _u.mutation.ErrorMessageCleared() {
+		_spec.ClearField(billingrecomputejob.FieldErrorMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(billingrecomputejob.FieldCreatedBy, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(billingrecomputejob.FieldCreatedBy, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AppliedBy(); ok {
+		_spec.SetField(billingrecomputejob.FieldAppliedBy, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAppliedBy(); ok {
+		_spec.AddField(billingrecomputejob.FieldAppliedBy, field.TypeInt64, value)
+	}
+	if _u.mutation.AppliedByCleared() {
+		_spec.ClearField(billingrecomputejob.FieldAppliedBy, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.StartedAt(); ok {
+		_spec.SetField(billingrecomputejob.FieldStartedAt, field.TypeTime, value)
+	}
+	if _u.mutation.StartedAtCleared() {
+		_spec.ClearField(billingrecomputejob.FieldStartedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.FinishedAt(); ok {
+		_spec.SetField(billingrecomputejob.FieldFinishedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FinishedAtCleared() {
+		_spec.ClearField(billingrecomputejob.FieldFinishedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.AppliedAt(); ok {
+		_spec.SetField(billingrecomputejob.FieldAppliedAt, field.TypeTime, value)
+	}
+	if _u.mutation.AppliedAtCleared() {
+		_spec.ClearField(billingrecomputejob.FieldAppliedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(billingrecomputejob.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{billingrecomputejob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BillingRecomputeJobUpdateOne is the builder for updating a single BillingRecomputeJob entity.
+type BillingRecomputeJobUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BillingRecomputeJobMutation
+}
+
+// SetStatus sets the "status" field.
+func (_u *BillingRecomputeJobUpdateOne) SetStatus(v string) *BillingRecomputeJobUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *BillingRecomputeJobUpdateOne) SetNillableStatus(v *string) *BillingRecomputeJobUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetMode sets the "mode" field.
+func (_u *BillingRecomputeJobUpdateOne) SetMode(v string) *BillingRecomputeJobUpdateOne {
+	_u.mutation.SetMode(v)
+	return _u
+}
+
+// SetNillableMode sets the "mode" field if the given value is not nil.
+func (_u *BillingRecomputeJobUpdateOne) SetNillableMode(v *string) *BillingRecomputeJobUpdateOne {
+	if v != nil {
+		_u.SetMode(*v)
+	}
+	return _u
+}
+
+// SetParams sets the "params" field.
+func (_u *BillingRecomputeJobUpdateOne) SetParams(v model.BillingRecomputeParams) *BillingRecomputeJobUpdateOne {
+	_u.mutation.SetParams(v)
+	return _u
+}
+
+// SetNillableParams sets the "params" field if the given value is not nil.
+func (_u *BillingRecomputeJobUpdateOne) SetNillableParams(v *model.BillingRecomputeParams) *BillingRecomputeJobUpdateOne {
+	if v != nil {
+		_u.SetParams(*v)
+	}
+	return _u
+}
+
+// SetReport sets the "report" field.
+func (_u *BillingRecomputeJobUpdateOne) SetReport(v *model.BillingRecomputeReport) *BillingRecomputeJobUpdateOne {
+	_u.mutation.SetReport(v)
+	return _u
+}
+
+// ClearReport clears the value of the "report" field.
+func (_u *BillingRecomputeJobUpdateOne) ClearReport() *BillingRecomputeJobUpdateOne {
+	_u.mutation.ClearReport()
+	return _u
+}
+
+// SetScannedRows sets the "scanned_rows" field.
+func (_u *BillingRecomputeJobUpdateOne) SetScannedRows(v int64) *BillingRecomputeJobUpdateOne {
+	_u.mutation.ResetScannedRows()
+	_u.mutation.SetScannedRows(v)
+	return _u
+}
+
+// SetNillableScannedRows sets the "scanned_rows" field if the given value is not nil.
+func (_u *BillingRecomputeJobUpdateOne) SetNillableScannedRows(v *int64) *BillingRecomputeJobUpdateOne {
+	if v != nil {
+		_u.SetScannedRows(*v)
+	}
+	return _u
+}
+
+// AddScannedRows adds value to the "scanned_rows" field.
+func (_u *BillingRecomputeJobUpdateOne) AddScannedRows(v int64) *BillingRecomputeJobUpdateOne {
+	_u.mutation.AddScannedRows(v)
+	return _u
+}
+
+// SetChangedRows sets the "changed_rows" field.
+func (_u *BillingRecomputeJobUpdateOne) SetChangedRows(v int64) *BillingRecomputeJobUpdateOne {
+	_u.mutation.ResetChangedRows()
+	_u.mutation.SetChangedRows(v)
+	return _u
+}
+
+// SetNillableChangedRows sets the "changed_rows" field if the given value is not nil.
+func (_u *BillingRecomputeJobUpdateOne) SetNillableChangedRows(v *int64) *BillingRecomputeJobUpdateOne {
+	if v != nil {
+		_u.SetChangedRows(*v)
+	}
+	return _u
+}
+
+// AddChangedRows adds value to the "changed_rows" field.
+func (_u *BillingRecomputeJobUpdateOne) AddChangedRows(v int64) *BillingRecomputeJobUpdateOne {
+	_u.mutation.AddChangedRows(v)
+	return _u
+}
+
+// SetOriginalCost sets the "original_cost" field.
+func (_u *BillingRecomputeJobUpdateOne) SetOriginalCost(v float64) *BillingRecomputeJobUpdateOne {
+	_u.mutation.ResetOriginalCost()
+	_u.mutation.SetOriginalCost(v)
+	return _u
+}
+
+// SetNillableOriginalCost sets the "original_cost" field if the given value is not nil.
+func (_u *BillingRecomputeJobUpdateOne) SetNillableOriginalCost(v *float64) *BillingRecomputeJobUpdateOne {
+	if v != nil {
+		_u.SetOriginalCost(*v)
+	}
+	return _u
+}
+
+// AddOriginalCost adds value to the "original_cost" field.
+func (_u *BillingRecomputeJobUpdateOne) AddOriginalCost(v float64) *BillingRecomputeJobUpdateOne {
+	_u.mutation.AddOriginalCost(v)
+	return _u
+}
+
+// SetRecomputedCost sets the "recomputed_cost" field.
+func (_u *BillingRecomputeJobUpdateOne) SetRecomputedCost(v float64) *BillingRecomputeJobUpdateOne {
+	_u.mutation.ResetRecomputedCost()
+	_u.mutation.SetRecomputedCost(v)
+	return _u
+}
+
+// SetNillableRecomputedCost sets the "recomputed_cost" field if the given value is not nil.
+func (_u *BillingRecomputeJobUpdateOne) SetNillableRecomputedCost(v *float64) *BillingRecomputeJobUpdateOne {
+	if v != nil {
+		_u.SetRecomputedCost(*v)
+	}
+	return _u
+}
+
+// AddRecomputedCost adds value to the "recomputed_cost" field.
+func (_u *BillingRecomputeJobUpdateOne) AddRecomputedCost(v float64) *BillingRecomputeJobUpdateOne {
+	_u.mutation.AddRecomputedCost(v)
+	return _u
+}
+
+// SetBalanceDiff sets the "balance_diff" field.
+func (_u *BillingRecomputeJobUpdateOne) SetBalanceDiff(v float64) *BillingRecomputeJobUpdateOne {
+	_u.mutation.ResetBalanceDiff()
+	_u.mutation.SetBalanceDiff(v)
+	return _u
+}
+
+// SetNillableBalanceDiff sets the "balance_diff" field if the given value is not nil.
+func (_u *BillingRecomputeJobUpdateOne) SetNillableBalanceDiff(v *float64) *BillingRecomputeJobUpdateOne {
+	if v != nil {
+		_u.SetBalanceDiff(*v)
+	}
+	return _u
+}
+
+// AddBalanceDiff adds value to the "balance_diff" field.
+func (_u *BillingRecomputeJobUpdateOne) AddBalanceDiff(v float64) *BillingRecomputeJobUpdateOne {
+	_u.mutation.AddBalanceDiff(v)
+	return _u
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (_u *BillingRecomputeJobUpdateOne) SetErrorMessage(v string) *BillingRecomputeJobUpdateOne {
+	_u.mutation.SetErrorMessage(v)
+	return _u
+}
+
+// SetNillableErrorMessage sets the "error_message" field if the given value is not nil.
+func (_u *BillingRecomputeJobUpdateOne) SetNillableErrorMessage(v *string) *BillingRecomputeJobUpdateOne {
+	if v != nil {
+		_u.SetErrorMessage(*v)
+	}
+	return _u
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (_u *BillingRecomputeJobUpdateOne) ClearErrorMessage() *BillingRecomputeJobUpdateOne {
+	_u.mutation.ClearErrorMessage()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *BillingRecomputeJobUpdateOne) SetCreatedBy(v int64) *BillingRecomputeJobUpdateOne {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *BillingRecomputeJobUpdateOne) SetNillableCreatedBy(v *int64) *BillingRecomputeJobUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *BillingRecomputeJobUpdateOne) AddCreatedBy(v int64) *BillingRecomputeJobUpdateOne {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// SetAppliedBy sets the "applied_by" field.
+func (_u *BillingRecomputeJobUpdateOne) SetAppliedBy(v int64) *BillingRecomputeJobUpdateOne {
+	_u.mutation.ResetAppliedBy()
+	_u.mutation.SetAppliedBy(v)
+	return _u
+}
+
+// SetNillableAppliedBy sets the "applied_by" field if the given value is not nil.
+func (_u *BillingRecomputeJobUpdateOne) SetNillableAppliedBy(v *int64) *BillingRecomputeJobUpdateOne {
+	if v != nil {
+		_u.SetAppliedBy(*v)
+	}
+	return _u
+}
+
+// AddAppliedBy adds value to the "applied_by" field.
+func (_u *BillingRecomputeJobUpdateOne) AddAppliedBy(v int64) *BillingRecomputeJobUpdateOne {
+	_u.mutation.AddAppliedBy(v)
+	return _u
+}
+
+// ClearAppliedBy clears the value of the "applied_by" field.
+func (_u *BillingRecomputeJobUpdateOne) ClearAppliedBy() *BillingRecomputeJobUpdateOne {
+	_u.mutation.ClearAppliedBy()
+	return _u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_u *BillingRecomputeJobUpdateOne) SetStartedAt(v time.Time) *BillingRecomputeJobUpdateOne {
+	_u.mutation.SetStartedAt(v)
+	return _u
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_u *BillingRecomputeJobUpdateOne) SetNillableStartedAt(v *time.Time) *BillingRecomputeJobUpdateOne {
+	if v != nil {
+		_u.SetStartedAt(*v)
+	}
+	return _u
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (_u *BillingRecomputeJobUpdateOne) ClearStartedAt() *BillingRecomputeJobUpdateOne {
+	_u.mutation.ClearStartedAt()
+	return _u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_u *BillingRecomputeJobUpdateOne) SetFinishedAt(v time.Time) *BillingRecomputeJobUpdateOne {
+	_u.mutation.SetFinishedAt(v)
+	return _u
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_u *BillingRecomputeJobUpdateOne) SetNillableFinishedAt(v *time.Time) *BillingRecomputeJobUpdateOne {
+	if v != nil {
+		_u.SetFinishedAt(*v)
+	}
+	return _u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (_u *BillingRecomputeJobUpdateOne) ClearFinishedAt() *BillingRecomputeJobUpdateOne {
+	_u.mutation.ClearFinishedAt()
+	return _u
+}
+
+// SetAppliedAt sets the "applied_at" field.
+func (_u *BillingRecomputeJobUpdateOne) SetAppliedAt(v time.Time) *BillingRecomputeJobUpdateOne {
+	_u.mutation.SetAppliedAt(v)
+	return _u
+}
+
+// SetNillableAppliedAt sets the "applied_at" field if the given value is not nil.
+func (_u *BillingRecomputeJobUpdateOne) SetNillableAppliedAt(v *time.Time) *BillingRecomputeJobUpdateOne {
+	if v != nil {
+		_u.SetAppliedAt(*v)
+	}
+	return _u
+}
+
+// ClearAppliedAt clears the value of the "applied_at" field.
+func (_u *BillingRecomputeJobUpdateOne) ClearAppliedAt() *BillingRecomputeJobUpdateOne {
+	_u.mutation.ClearAppliedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *BillingRecomputeJobUpdateOne) SetUpdatedAt(v time.Time) *BillingRecomputeJobUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the BillingRecomputeJobMutation object of the builder.
+func (_u *BillingRecomputeJobUpdateOne) Mutation() *BillingRecomputeJobMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BillingRecomputeJobUpdate builder.
+func (_u *BillingRecomputeJobUpdateOne) Where(ps ...predicate.BillingRecomputeJob) *BillingRecomputeJobUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BillingRecomputeJobUpdateOne) Select(field string, fields ...string) *BillingRecomputeJobUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BillingRecomputeJob entity.
+func (_u *BillingRecomputeJobUpdateOne) Save(ctx context.Context) (*BillingRecomputeJob, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BillingRecomputeJobUpdateOne) SaveX(ctx context.Context) *BillingRecomputeJob {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BillingRecomputeJobUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BillingRecomputeJobUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *BillingRecomputeJobUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := billingrecomputejob.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BillingRecomputeJobUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := billingrecomputejob.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "BillingRecomputeJob.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Mode(); ok {
+		if err := billingrecomputejob.ModeValidator(v); err != nil {
+			return &ValidationError{Name: "mode", err: fmt.Errorf(`ent: validator failed for field "BillingRecomputeJob.mode": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BillingRecomputeJobUpdateOne) sqlSave(ctx context.Context) (_node *BillingRecomputeJob, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(billingrecomputejob.Table, billingrecomputejob.Columns, sqlgraph.NewFieldSpec(billingrecomputejob.FieldID, field.TypeInt64))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BillingRecomputeJob.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, billingrecomputejob.FieldID)
+		for _, f := range fields {
+			if !billingrecomputejob.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != billingrecomputejob.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(billingrecomputejob.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Mode(); ok {
+		_spec.SetField(billingrecomputejob.FieldMode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Params(); ok {
+		_spec.SetField(billingrecomputejob.FieldParams, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.Report(); ok {
+		_spec.SetField(billingrecomputejob.FieldReport, field.TypeJSON, value)
+	}
+	if _u.mutation.ReportCleared() {
+		_spec.ClearField(billingrecomputejob.FieldReport, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ScannedRows(); ok {
+		_spec.SetField(billingrecomputejob.FieldScannedRows, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedScannedRows(); ok {
+		_spec.AddField(billingrecomputejob.FieldScannedRows, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.ChangedRows(); ok {
+		_spec.SetField(billingrecomputejob.FieldChangedRows, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedChangedRows(); ok {
+		_spec.AddField(billingrecomputejob.FieldChangedRows, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.OriginalCost(); ok {
+		_spec.SetField(billingrecomputejob.FieldOriginalCost, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedOriginalCost(); ok {
+		_spec.AddField(billingrecomputejob.FieldOriginalCost, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.RecomputedCost(); ok {
+		_spec.SetField(billingrecomputejob.FieldRecomputedCost, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedRecomputedCost(); ok {
+		_spec.AddField(billingrecomputejob.FieldRecomputedCost, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.BalanceDiff(); ok {
+		_spec.SetField(billingrecomputejob.FieldBalanceDiff, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedBalanceDiff(); ok {
+		_spec.AddField(billingrecomputejob.FieldBalanceDiff, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.ErrorMessage(); ok {
+		_spec.SetField(billingrecomputejob.FieldErrorMessage, field.TypeString, value)
+	}
+	if _u.mutation.ErrorMessageCleared() {
+		_spec.ClearField(billingrecomputejob.FieldErrorMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(billingrecomputejob.FieldCreatedBy, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(billingrecomputejob.FieldCreatedBy, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AppliedBy(); ok {
+		_spec.SetField(billingrecomputejob.FieldAppliedBy, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAppliedBy(); ok {
+		_spec.AddField(billingrecomputejob.FieldAppliedBy, field.TypeInt64, value)
+	}
+	if _u.mutation.AppliedByCleared() {
+		_spec.ClearField(billingrecomputejob.FieldAppliedBy, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.StartedAt(); ok {
+		_spec.SetField(billingrecomputejob.FieldStartedAt, field.TypeTime, value)
+	}
+	if _u.mutation.StartedAtCleared() {
+		_spec.ClearField(billingrecomputejob.FieldStartedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.FinishedAt(); ok {
+		_spec.SetField(billingrecomputejob.FieldFinishedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FinishedAtCleared() {
+		_spec.ClearField(billingrecomputejob.FieldFinishedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.AppliedAt(); ok {
+		_spec.SetField(billingrecomputejob.FieldAppliedAt, field.TypeTime, value)
+	}
+	if _u.mutation.AppliedAtCleared() {
+		_spec.ClearField(billingrecomputejob.FieldAppliedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(billingrecomputejob.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &BillingRecomputeJob{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{billingrecomputejob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,745 @@
+// Code generated by ent, DO NOT EDIT.
+
+package billingstatement
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Wei-Shaw/sub2api/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int64) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldEQ(FieldUserID, v))
+}
+
+// PeriodStart applies equality check predicate on the "period_start" field. It's identical to PeriodStartEQ.
+func PeriodStart(v time.Time) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldEQ(FieldPeriodStart, v))
+}
+
+// PeriodEnd applies equality check predicate on the "period_end" field. It's identical to PeriodEndEQ.
+func PeriodEnd(v time.Time) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldEQ(FieldPeriodEnd, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v float64) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldEQ(FieldAmount, v))
+}
+
+// RequestCount applies equality check predicate on the "request_count" field. It's identical to RequestCountEQ.
+func RequestCount(v int64) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldEQ(FieldRequestCount, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldEQ(FieldStatus, v))
+}
+
+// DueAt applies equality check predicate on the "due_at" field. It's identical to DueAtEQ.
+func DueAt(v time.Time) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldEQ(FieldDueAt, v))
+}
+
+// ReminderSentAt applies equality check predicate on the "reminder_sent_at" field. It's identical to ReminderSentAtEQ.
+func ReminderSentAt(v time.Time) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldEQ(FieldReminderSentAt, v))
+}
+
+// OverdueAt applies equality check predicate on the "overdue_at" field. It's identical to OverdueAtEQ.
+func OverdueAt(v time.Time) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldEQ(FieldOverdueAt, v))
+}
+
+// PaidAt applies equality check predicate on the "paid_at" field. It's identical to PaidAtEQ.
+func PaidAt(v time.Time) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldEQ(FieldPaidAt, v))
+}
+
+// PaymentNote applies equality check predicate on the "payment_note" field. It's identical to PaymentNoteEQ.
+func PaymentNote(v string) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldEQ(FieldPaymentNote, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int64) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int64) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int64) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int64) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v int64) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v int64) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v int64) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v int64) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldLTE(FieldUserID, v))
+}
+
+// PeriodStartEQ applies the EQ predicate on the "period_start" field.
+func PeriodStartEQ(v time.Time) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldEQ(FieldPeriodStart, v))
+}
+
+// PeriodStartNEQ applies the NEQ predicate on the "period_start" field.
+func PeriodStartNEQ(v time.Time) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldNEQ(FieldPeriodStart, v))
+}
+
+// PeriodStartIn applies the In predicate on the "period_start" field.
+func PeriodStartIn(vs ...time.Time) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldIn(FieldPeriodStart, vs...))
+}
+
+// PeriodStartNotIn applies the NotIn predicate on the "period_start" field.
+func PeriodStartNotIn(vs ...time.Time) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldNotIn(FieldPeriodStart, vs...))
+}
+
+// PeriodStartGT applies the GT predicate on the "period_start" field.
+func PeriodStartGT(v time.Time) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldGT(FieldPeriodStart, v))
+}
+
+// PeriodStartGTE applies the GTE predicate on the "period_start" field.
+func PeriodStartGTE(v time.Time) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldGTE(FieldPeriodStart, v))
+}
+
+// PeriodStartLT applies the LT predicate on the "period_start" field.
+func PeriodStartLT(v time.Time) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldLT(FieldPeriodStart, v))
+}
+
+// PeriodStartLTE applies the LTE predicate on the "period_start" field.
+func PeriodStartLTE(v time.Time) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldLTE(FieldPeriodStart, v))
+}
+
+// PeriodEndEQ applies the EQ predicate on the "period_end" field.
+func PeriodEndEQ(v time.Time) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldEQ(FieldPeriodEnd, v))
+}
+
+// PeriodEndNEQ applies the NEQ predicate on the "period_end" field.
+func PeriodEndNEQ(v time.Time) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldNEQ(FieldPeriodEnd, v))
+}
+
+// PeriodEndIn applies the In predicate on the "period_end" field.
+func PeriodEndIn(vs ...time.Time) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldIn(FieldPeriodEnd, vs...))
+}
+
+// PeriodEndNotIn applies the NotIn predicate on the "period_end" field.
+func PeriodEndNotIn(vs ...time.Time) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldNotIn(FieldPeriodEnd, vs...))
+}
+
+// PeriodEndGT applies the GT predicate on the "period_end" field.
+func PeriodEndGT(v time.Time) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldGT(FieldPeriodEnd, v))
+}
+
+// PeriodEndGTE applies the GTE predicate on the "period_end" field.
+func PeriodEndGTE(v time.Time) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldGTE(FieldPeriodEnd, v))
+}
+
+// PeriodEndLT applies the LT predicate on the "period_end" field.
+func PeriodEndLT(v time.Time) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldLT(FieldPeriodEnd, v))
+}
+
+// PeriodEndLTE applies the LTE predicate on the "period_end" field.
+func PeriodEndLTE(v time.Time) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldLTE(FieldPeriodEnd, v))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v float64) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v float64) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...float64) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...float64) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v float64) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v float64) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v float64) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v float64) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldLTE(FieldAmount, v))
+}
+
+// RequestCountEQ applies the EQ predicate on the "request_count" field.
+func RequestCountEQ(v int64) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldEQ(FieldRequestCount, v))
+}
+
+// RequestCountNEQ applies the NEQ predicate on the "request_count" field.
+func RequestCountNEQ(v int64) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldNEQ(FieldRequestCount, v))
+}
+
+// RequestCountIn applies the In predicate on the "request_count" field.
+func RequestCountIn(vs ...int64) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldIn(FieldRequestCount, vs...))
+}
+
+// RequestCountNotIn applies the NotIn predicate on the "request_count" field.
+func RequestCountNotIn(vs ...int64) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldNotIn(FieldRequestCount, vs...))
+}
+
+// RequestCountGT applies the GT predicate on the "request_count" field.
+func RequestCountGT(v int64) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldGT(FieldRequestCount, v))
+}
+
+// RequestCountGTE applies the GTE predicate on the "request_count" field.
+func RequestCountGTE(v int64) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldGTE(FieldRequestCount, v))
+}
+
+// RequestCountLT applies the LT predicate on the "request_count" field.
+func RequestCountLT(v int64) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldLT(FieldRequestCount, v))
+}
+
+// RequestCountLTE applies the LTE predicate on the "request_count" field.
+func RequestCountLTE(v int64) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldLTE(FieldRequestCount, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// DueAtEQ applies the EQ predicate on the "due_at" field.
+func DueAtEQ(v time.Time) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldEQ(FieldDueAt, v))
+}
+
+// DueAtNEQ applies the NEQ predicate on the "due_at" field.
+func DueAtNEQ(v time.Time) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldNEQ(FieldDueAt, v))
+}
+
+// DueAtIn applies the In predicate on the "due_at" field.
+func DueAtIn(vs ...time.Time) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldIn(FieldDueAt, vs...))
+}
+
+// DueAtNotIn applies the NotIn predicate on the "due_at" field.
+func DueAtNotIn(vs ...time.Time) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldNotIn(FieldDueAt, vs...))
+}
+
+// DueAtGT applies the GT predicate on the "due_at" field.
+func DueAtGT(v time.Time) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldGT(FieldDueAt, v))
+}
+
+// DueAtGTE applies the GTE predicate on the "due_at" field.
+func DueAtGTE(v time.Time) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldGTE(FieldDueAt, v))
+}
+
+// DueAtLT applies the LT predicate on the "due_at" field.
+func DueAtLT(v time.Time) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldLT(FieldDueAt, v))
+}
+
+// DueAtLTE applies the LTE predicate on the "due_at" field.
+func DueAtLTE(v time.Time) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldLTE(FieldDueAt, v))
+}
+
+// ReminderSentAtEQ applies the EQ predicate on the "reminder_sent_at" field.
+func ReminderSentAtEQ(v time.Time) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldEQ(FieldReminderSentAt, v))
+}
+
+// ReminderSentAtNEQ applies the NEQ predicate on the "reminder_sent_at" field.
+func ReminderSentAtNEQ(v time.Time) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldNEQ(FieldReminderSentAt, v))
+}
+
+// ReminderSentAtIn applies the In predicate on the "reminder_sent_at" field.
+func ReminderSentAtIn(vs ...time.Time) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldIn(FieldReminderSentAt, vs...))
+}
+
+// ReminderSentAtNotIn applies the NotIn predicate on the "reminder_sent_at" field.
+func ReminderSentAtNotIn(vs ...time.Time) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldNotIn(FieldReminderSentAt, vs...))
+}
+
+// ReminderSentAtGT applies the GT predicate on the "reminder_sent_at" field.
+func ReminderSentAtGT(v time.Time) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldGT(FieldReminderSentAt, v))
+}
+
+// ReminderSentAtGTE applies the GTE predicate on the "reminder_sent_at" field.
+func ReminderSentAtGTE(v time.Time) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldGTE(FieldReminderSentAt, v))
+}
+
+// ReminderSentAtLT applies the LT predicate on the "reminder_sent_at" field.
+func ReminderSentAtLT(v time.Time) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldLT(FieldReminderSentAt, v))
+}
+
+// ReminderSentAtLTE applies the LTE predicate on the "reminder_sent_at" field.
+func ReminderSentAtLTE(v time.Time) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldLTE(FieldReminderSentAt, v))
+}
+
+// ReminderSentAtIsNil applies the IsNil predicate on the "reminder_sent_at" field.
+func ReminderSentAtIsNil() predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldIsNull(FieldReminderSentAt))
+}
+
+// ReminderSentAtNotNil applies the NotNil predicate on the "reminder_sent_at" field.
+func ReminderSentAtNotNil() predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldNotNull(FieldReminderSentAt))
+}
+
+// OverdueAtEQ applies the EQ predicate on the "overdue_at" field.
+func OverdueAtEQ(v time.Time) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldEQ(FieldOverdueAt, v))
+}
+
+// OverdueAtNEQ applies the NEQ predicate on the "overdue_at" field.
+func OverdueAtNEQ(v time.Time) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldNEQ(FieldOverdueAt, v))
+}
+
+// OverdueAtIn applies the In predicate on the "overdue_at" field.
+func OverdueAtIn(vs ...time.Time) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldIn(FieldOverdueAt, vs...))
+}
+
+// OverdueAtNotIn applies the NotIn predicate on the "overdue_at" field.
+func OverdueAtNotIn(vs ...time.Time) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldNotIn(FieldOverdueAt, vs...))
+}
+
+// OverdueAtGT applies the GT predicate on the "overdue_at" field.
+func OverdueAtGT(v time.Time) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldGT(FieldOverdueAt, v))
+}
+
+// OverdueAtGTE applies the GTE predicate on the "overdue_at" field.
+func OverdueAtGTE(v time.Time) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldGTE(FieldOverdueAt, v))
+}
+
+// OverdueAtLT applies the LT predicate on the "overdue_at" field.
+func OverdueAtLT(v time.Time) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldLT(FieldOverdueAt, v))
+}
+
+// OverdueAtLTE applies the LTE predicate on the "overdue_at" field.
+func OverdueAtLTE(v time.Time) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldLTE(FieldOverdueAt, v))
+}
+
+// OverdueAtIsNil applies the IsNil predicate on the "overdue_at" field.
+func OverdueAtIsNil() predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldIsNull(FieldOverdueAt))
+}
+
+// OverdueAtNotNil applies the NotNil predicate on the "overdue_at" field.
+func OverdueAtNotNil() predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldNotNull(FieldOverdueAt))
+}
+
+// PaidAtEQ applies the EQ predicate on the "paid_at" field.
+func PaidAtEQ(v time.Time) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldEQ(FieldPaidAt, v))
+}
+
+// PaidAtNEQ applies the NEQ predicate on the "paid_at" field.
+func PaidAtNEQ(v time.Time) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldNEQ(FieldPaidAt, v))
+}
+
+// PaidAtIn applies the In predicate on the "paid_at" field.
+func PaidAtIn(vs ...time.Time) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldIn(FieldPaidAt, vs...))
+}
+
+// PaidAtNotIn applies the NotIn predicate on the "paid_at" field.
+func PaidAtNotIn(vs ...time.Time) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldNotIn(FieldPaidAt, vs...))
+}
+
+// PaidAtGT applies the GT predicate on the "paid_at" field.
+func PaidAtGT(v time.Time) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldGT(FieldPaidAt, v))
+}
+
+// PaidAtGTE applies the GTE predicate on the "paid_at" field.
+func PaidAtGTE(v time.Time) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldGTE(FieldPaidAt, v))
+}
+
+// PaidAtLT applies the LT predicate on the "paid_at" field.
+func PaidAtLT(v time.Time) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldLT(FieldPaidAt, v))
+}
+
+// PaidAtLTE applies the LTE predicate on the "paid_at" field.
+func PaidAtLTE(v time.Time) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldLTE(FieldPaidAt, v))
+}
+
+// PaidAtIsNil applies the IsNil predicate on the "paid_at" field.
+func PaidAtIsNil() predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldIsNull(FieldPaidAt))
+}
+
+// PaidAtNotNil applies the NotNil predicate on the "paid_at" field.
+func PaidAtNotNil() predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldNotNull(FieldPaidAt))
+}
+
+// PaymentNoteEQ applies the EQ predicate on the "payment_note" field.
+func PaymentNoteEQ(v string) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldEQ(FieldPaymentNote, v))
+}
+
+// PaymentNoteNEQ applies the NEQ predicate on the "payment_note" field.
+func PaymentNoteNEQ(v string) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldNEQ(FieldPaymentNote, v))
+}
+
+// PaymentNoteIn applies the In predicate on the "payment_note" field.
+func PaymentNoteIn(vs ...string) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldIn(FieldPaymentNote, vs...))
+}
+
+// PaymentNoteNotIn applies the NotIn predicate on the "payment_note" field.
+func PaymentNoteNotIn(vs ...string) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldNotIn(FieldPaymentNote, vs...))
+}
+
+// PaymentNoteGT applies the GT predicate on the "payment_note" field.
+func PaymentNoteGT(v string) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldGT(FieldPaymentNote, v))
+}
+
+// PaymentNoteGTE applies the GTE predicate on the "payment_note" field.
+func PaymentNoteGTE(v string) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldGTE(FieldPaymentNote, v))
+}
+
+// PaymentNoteLT applies the LT predicate on the "payment_note" field.
+func PaymentNoteLT(v string) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldLT(FieldPaymentNote, v))
+}
+
+// PaymentNoteLTE applies the LTE predicate on the "payment_note" field.
+func PaymentNoteLTE(v string) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldLTE(FieldPaymentNote, v))
+}
+
+// PaymentNoteContains applies the Contains predicate on the "payment_note" field.
+func PaymentNoteContains(v string) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldContains(FieldPaymentNote, v))
+}
+
+// PaymentNoteHasPrefix applies the HasPrefix predicate on the "payment_note" field.
+func PaymentNoteHasPrefix(v string) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldHasPrefix(FieldPaymentNote, v))
+}
+
+// PaymentNoteHasSuffix applies the HasSuffix predicate on the "payment_note" field.
+func PaymentNoteHasSuffix(v string) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldHasSuffix(FieldPaymentNote, v))
+}
+
+// PaymentNoteIsNil applies the IsNil predicate on the "payment_note" field.
+func PaymentNoteIsNil() predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldIsNull(FieldPaymentNote))
+}
+
+// PaymentNoteNotNil applies the NotNil predicate on the "payment_note" field.
+func PaymentNoteNotNil() predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldNotNull(FieldPaymentNote))
+}
+
+// PaymentNoteEqualFold applies the EqualFold predicate on the "payment_note" field.
+func PaymentNoteEqualFold(v string) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldEqualFold(FieldPaymentNote, v))
+}
+
+// PaymentNoteContainsFold applies the ContainsFold predicate on the "payment_note" field.
+func PaymentNoteContainsFold(v string) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldContainsFold(FieldPaymentNote, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BillingStatement) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BillingStatement) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BillingStatement) predicate.BillingStatement {
+	return predicate.BillingStatement(sql.NotPredicates(p))
+}
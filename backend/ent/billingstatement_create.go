@@ -0,0 +1,1294 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Wei-Shaw/sub2api/ent/billingstatement"
+)
+
+// BillingStatementCreate is the builder for creating a BillingStatement entity.
+type BillingStatementCreate struct {
+	config
+	mutation *BillingStatementMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *BillingStatementCreate) SetUserID(v int64) *BillingStatementCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (_c *BillingStatementCreate) SetPeriodStart(v time.Time) *BillingStatementCreate {
+	_c.mutation.SetPeriodStart(v)
+	return _c
+}
+
+// SetPeriodEnd sets the "period_end" field.
+func (_c *BillingStatementCreate) SetPeriodEnd(v time.Time) *BillingStatementCreate {
+	_c.mutation.SetPeriodEnd(v)
+	return _c
+}
+
+// SetAmount sets the "amount" field.
+func (_c *BillingStatementCreate) SetAmount(v float64) *BillingStatementCreate {
+	_c.mutation.SetAmount(v)
+	return _c
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_c *BillingStatementCreate) SetNillableAmount(v *float64) *BillingStatementCreate {
+	if v != nil {
+		_c.SetAmount(*v)
+	}
+	return _c
+}
+
+// SetRequestCount sets the "request_count" field.
+func (_c *BillingStatementCreate) SetRequestCount(v int64) *BillingStatementCreate {
+	_c.mutation.SetRequestCount(v)
+	return _c
+}
+
+// SetNillableRequestCount sets the "request_count" field if the given value is not nil.
+func (_c *BillingStatementCreate) SetNillableRequestCount(v *int64) *BillingStatementCreate {
+	if v != nil {
+		_c.SetRequestCount(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *BillingStatementCreate) SetStatus(v string) *BillingStatementCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *BillingStatementCreate) SetNillableStatus(v *string) *BillingStatementCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetDueAt sets the "due_at" field.
+func (_c *BillingStatementCreate) SetDueAt(v time.Time) *BillingStatementCreate {
+	_c.mutation.SetDueAt(v)
+	return _c
+}
+
+// SetReminderSentAt sets the "reminder_sent_at" field.
+func (_c *BillingStatementCreate) SetReminderSentAt(v time.Time) *BillingStatementCreate {
+	_c.mutation.SetReminderSentAt(v)
+	return _c
+}
+
+// SetNillableReminderSentAt sets the "reminder_sent_at" field if the given value is not nil.
+func (_c *BillingStatementCreate) SetNillableReminderSentAt(v *time.Time) *BillingStatementCreate {
+	if v != nil {
+		_c.SetReminderSentAt(*v)
+	}
+	return _c
+}
+
+// SetOverdueAt sets the "overdue_at" field.
+func (_c *BillingStatementCreate) SetOverdueAt(v time.Time) *BillingStatementCreate {
+	_c.mutation.SetOverdueAt(v)
+	return _c
+}
+
+// SetNillableOverdueAt sets the "overdue_at" field if the given value is not nil.
+func (_c *BillingStatementCreate) SetNillableOverdueAt(v *time.Time) *BillingStatementCreate {
+	if v != nil {
+		_c.SetOverdueAt(*v)
+	}
+	return _c
+}
+
+// SetPaidAt sets the "paid_at" field.
+func (_c *BillingStatementCreate) SetPaidAt(v time.Time) *BillingStatementCreate {
+	_c.mutation.SetPaidAt(v)
+	return _c
+}
+
+// SetNillablePaidAt sets the "paid_at" field if the given value is not nil.
+func (_c *BillingStatementCreate) SetNillablePaidAt(v *time.Time) *BillingStatementCreate {
+	if v != nil {
+		_c.SetPaidAt(*v)
+	}
+	return _c
+}
+
+// SetPaymentNote sets the "payment_note" field.
+func (_c *BillingStatementCreate) SetPaymentNote(v string) *BillingStatementCreate {
+	_c.mutation.SetPaymentNote(v)
+	return _c
+}
+
+// SetNillablePaymentNote sets the "payment_note" field if the given value is not nil.
+func (_c *BillingStatementCreate) SetNillablePaymentNote(v *string) *BillingStatementCreate {
+	if v != nil {
+		_c.SetPaymentNote(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *BillingStatementCreate) SetCreatedAt(v time.Time) *BillingStatementCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *BillingStatementCreate) SetNillableCreatedAt(v *time.Time) *BillingStatementCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *BillingStatementCreate) SetUpdatedAt(v time.Time) *BillingStatementCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *BillingStatementCreate) SetNillableUpdatedAt(v *time.Time) *BillingStatementCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the BillingStatementMutation object of the builder.
+func (_c *BillingStatementCreate) Mutation() *BillingStatementMutation {
+	return _c.mutation
+}
+
+// Save creates the BillingStatement in the database.
+func (_c *BillingStatementCreate) Save(ctx context.Context) (*BillingStatement, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BillingStatementCreate) SaveX(ctx context.Context) *BillingStatement {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BillingStatementCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BillingStatementCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BillingStatementCreate) defaults() {
+	if _, ok := _c.mutation.Amount(); !ok {
+		v := billingstatement.DefaultAmount
+		_c.mutation.SetAmount(v)
+	}
+	if _, ok := _c.mutation.RequestCount(); !ok {
+		v := billingstatement.DefaultRequestCount
+		_c.mutation.SetRequestCount(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := billingstatement.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := billingstatement.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := billingstatement.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BillingStatementCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "BillingStatement.user_id"`)}
+	}
+	if _, ok := _c.mutation.PeriodStart(); !ok {
+		return &ValidationError{Name: "period_start", err: errors.New(`ent: missing required field "BillingStatement.period_start"`)}
+	}
+	if _, ok := _c.mutation.PeriodEnd(); !ok {
+		return &ValidationError{Name: "period_end", err: errors.New(`ent: missing required field "BillingStatement.period_end"`)}
+	}
+	if _, ok := _c.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "BillingStatement.amount"`)}
+	}
+	if _, ok := _c.mutation.RequestCount(); !ok {
+		return &ValidationError{Name: "request_count", err: errors.New(`ent: missing required field "BillingStatement.request_count"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "BillingStatement.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := billingstatement.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "BillingStatement.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DueAt(); !ok {
+		return &ValidationError{Name: "due_at", err: errors.New(`ent: missing required field "BillingStatement.due_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "BillingStatement.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "BillingStatement.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *BillingStatementCreate) sqlSave(ctx context.Context) (*BillingStatement, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int64(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BillingStatementCreate) createSpec() (*BillingStatement, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BillingStatement{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(billingstatement.Table, sqlgraph.NewFieldSpec(billingstatement.FieldID, field.TypeInt64))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(billingstatement.FieldUserID, field.TypeInt64, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.PeriodStart(); ok {
+		_spec.SetField(billingstatement.FieldPeriodStart, field.TypeTime, value)
+		_node.PeriodStart = value
+	}
+	if value, ok := _c.mutation.PeriodEnd(); ok {
+		_spec.SetField(billingstatement.FieldPeriodEnd, field.TypeTime, value)
+		_node.PeriodEnd = value
+	}
+	if value, ok := _c.mutation.Amount(); ok {
+		_spec.SetField(billingstatement.FieldAmount, field.TypeFloat64, value)
+		_node.Amount = value
+	}
+	if value, ok := _c.mutation.RequestCount(); ok {
+		_spec.SetField(billingstatement.FieldRequestCount, field.TypeInt64, value)
+		_node.RequestCount = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(billingstatement.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.DueAt(); ok {
+		_spec.SetField(billingstatement.FieldDueAt, field.TypeTime, value)
+		_node.DueAt = value
+	}
+	if value, ok := _c.mutation.ReminderSentAt(); ok {
+		_spec.SetField(billingstatement.FieldReminderSentAt, field.TypeTime, value)
+		_node.ReminderSentAt = &value
+	}
+	if value, ok := _c.mutation.OverdueAt(); ok {
+		_spec.SetField(billingstatement.FieldOverdueAt, field.TypeTime, value)
+		_node.OverdueAt = &value
+	}
+	if value, ok := _c.mutation.PaidAt(); ok {
+		_spec.SetField(billingstatement.FieldPaidAt, field.TypeTime, value)
+		_node.PaidAt = &value
+	}
+	if value, ok := _c.mutation.PaymentNote(); ok {
+		_spec.SetField(billingstatement.FieldPaymentNote, field.TypeString, value)
+		_node.PaymentNote = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(billingstatement.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(billingstatement.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.BillingStatement.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BillingStatementUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *BillingStatementCreate) OnConflict(opts ...sql.ConflictOption) *BillingStatementUpsertOne {
+	_c.conflict = opts
+	return &BillingStatementUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.BillingStatement.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *BillingStatementCreate) OnConflictColumns(columns ...string) *BillingStatementUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &BillingStatementUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// BillingStatementUpsertOne is the builder for "upsert"-ing
+	//  one BillingStatement node.
+	BillingStatementUpsertOne struct {
+		create *BillingStatementCreate
+	}
+
+	// BillingStatementUpsert is the "OnConflict" setter.
+	BillingStatementUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUserID sets the "user_id" field.
+func (u *BillingStatementUpsert) SetUserID(v int64) *BillingStatementUpsert {
+	u.Set(billingstatement.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *BillingStatementUpsert) UpdateUserID() *BillingStatementUpsert {
+	u.SetExcluded(billingstatement.FieldUserID)
+	return u
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *BillingStatementUpsert) AddUserID(v int64) *BillingStatementUpsert {
+	u.Add(billingstatement.FieldUserID, v)
+	return u
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (u *BillingStatementUpsert) SetPeriodStart(v time.Time) *BillingStatementUpsert {
+	u.Set(billingstatement.FieldPeriodStart, v)
+	return u
+}
+
+// UpdatePeriodStart sets the "period_start" field to the value that was provided on create.
+func (u *BillingStatementUpsert) UpdatePeriodStart() *BillingStatementUpsert {
+	u.SetExcluded(billingstatement.FieldPeriodStart)
+	return u
+}
+
+// SetPeriodEnd sets the "period_end" field.
+func (u *BillingStatementUpsert) SetPeriodEnd(v time.Time) *BillingStatementUpsert {
+	u.Set(billingstatement.FieldPeriodEnd, v)
+	return u
+}
+
+// UpdatePeriodEnd sets the "period_end" field to the value that was provided on create.
+func (u *BillingStatementUpsert) UpdatePeriodEnd() *BillingStatementUpsert {
+	u.SetExcluded(billingstatement.FieldPeriodEnd)
+	return u
+}
+
+// SetAmount sets the "amount" field.
+func (u *BillingStatementUpsert) SetAmount(v float64) *BillingStatementUpsert {
+	u.Set(billingstatement.FieldAmount, v)
+	return u
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *BillingStatementUpsert) UpdateAmount() *BillingStatementUpsert {
+	u.SetExcluded(billingstatement.FieldAmount)
+	return u
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *BillingStatementUpsert) AddAmount(v float64) *BillingStatementUpsert {
+	u.Add(billingstatement.FieldAmount, v)
+	return u
+}
+
+// SetRequestCount sets the "request_count" field.
+func (u *BillingStatementUpsert) SetRequestCount(v int64) *BillingStatementUpsert {
+	u.Set(billingstatement.FieldRequestCount, v)
+	return u
+}
+
+// UpdateRequestCount sets the "request_count" field to the value that was provided on create.
+func (u *BillingStatementUpsert) UpdateRequestCount() *BillingStatementUpsert {
+	u.SetExcluded(billingstatement.FieldRequestCount)
+	return u
+}
+
+// AddRequestCount adds v to the "request_count" field.
+func (u *BillingStatementUpsert) AddRequestCount(v int64) *BillingStatementUpsert {
+	u.Add(billingstatement.FieldRequestCount, v)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *BillingStatementUpsert) SetStatus(v string) *BillingStatementUpsert {
+	u.Set(billingstatement.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *BillingStatementUpsert) UpdateStatus() *BillingStatementUpsert {
+	u.SetExcluded(billingstatement.FieldStatus)
+	return u
+}
+
+// SetDueAt sets the "due_at" field.
+func (u *BillingStatementUpsert) SetDueAt(v time.Time) *BillingStatementUpsert {
+	u.Set(billingstatement.FieldDueAt, v)
+	return u
+}
+
+// UpdateDueAt sets the "due_at" field to the value that was provided on create.
+func (u *BillingStatementUpsert) UpdateDueAt() *BillingStatementUpsert {
+	u.SetExcluded(billingstatement.FieldDueAt)
+	return u
+}
+
+// SetReminderSentAt sets the "reminder_sent_at" field.
+func (u *BillingStatementUpsert) SetReminderSentAt(v time.Time) *BillingStatementUpsert {
+	u.Set(billingstatement.FieldReminderSentAt, v)
+	return u
+}
+
+// UpdateReminderSentAt sets the "reminder_sent_at" field to the value that was provided on create.
+func (u *BillingStatementUpsert) UpdateReminderSentAt() *BillingStatementUpsert {
+	u.SetExcluded(billingstatement.FieldReminderSentAt)
+	return u
+}
+
+// ClearReminderSentAt clears the value of the "reminder_sent_at" field.
+func (u *BillingStatementUpsert) ClearReminderSentAt() *BillingStatementUpsert {
+	u.SetNull(billingstatement.FieldReminderSentAt)
+	return u
+}
+
+// SetOverdueAt sets the "overdue_at" field.
+func (u *BillingStatementUpsert) SetOverdueAt(v time.Time) *BillingStatementUpsert {
+	u.Set(billingstatement.FieldOverdueAt, v)
+	return u
+}
+
+// UpdateOverdueAt sets the "overdue_at" field to the value that was provided on create.
+func (u *BillingStatementUpsert) UpdateOverdueAt() *BillingStatementUpsert {
+	u.SetExcluded(billingstatement.FieldOverdueAt)
+	return u
+}
+
+// ClearOverdueAt clears the value of the "overdue_at" field.
+func (u *BillingStatementUpsert) ClearOverdueAt() *BillingStatementUpsert {
+	u.SetNull(billingstatement.FieldOverdueAt)
+	return u
+}
+
+// SetPaidAt sets the "paid_at" field.
+func (u *BillingStatementUpsert) SetPaidAt(v time.Time) *BillingStatementUpsert {
+	u.Set(billingstatement.FieldPaidAt, v)
+	return u
+}
+
+// UpdatePaidAt sets the "paid_at" field to the value that was provided on create.
+func (u *BillingStatementUpsert) UpdatePaidAt() *BillingStatementUpsert {
+	u.SetExcluded(billingstatement.FieldPaidAt)
+	return u
+}
+
+// ClearPaidAt clears the value of the "paid_at" field.
+func (u *BillingStatementUpsert) ClearPaidAt() *BillingStatementUpsert {
+	u.SetNull(billingstatement.FieldPaidAt)
+	return u
+}
+
+// SetPaymentNote sets the "payment_note" field.
+func (u *BillingStatementUpsert) SetPaymentNote(v string) *BillingStatementUpsert {
+	u.Set(billingstatement.FieldPaymentNote, v)
+	return u
+}
+
+// UpdatePaymentNote sets the "payment_note" field to the value that was provided on create.
+func (u *BillingStatementUpsert) UpdatePaymentNote() *BillingStatementUpsert {
+	u.SetExcluded(billingstatement.FieldPaymentNote)
+	return u
+}
+
+// ClearPaymentNote clears the value of the "payment_note" field.
+func (u *BillingStatementUpsert) ClearPaymentNote() *BillingStatementUpsert {
+	u.SetNull(billingstatement.FieldPaymentNote)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *BillingStatementUpsert) SetUpdatedAt(v time.Time) *BillingStatementUpsert {
+	u.Set(billingstatement.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *BillingStatementUpsert) UpdateUpdatedAt() *BillingStatementUpsert {
+	u.SetExcluded(billingstatement.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.BillingStatement.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *BillingStatementUpsertOne) UpdateNewValues() *BillingStatementUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(billingstatement.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.BillingStatement.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *BillingStatementUpsertOne) Ignore() *BillingStatementUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BillingStatementUpsertOne) DoNothing() *BillingStatementUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BillingStatementCreate.OnConflict
+// documentation for more info.
+func (u *BillingStatementUpsertOne) Update(set func(*BillingStatementUpsert)) *BillingStatementUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BillingStatementUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *BillingStatementUpsertOne) SetUserID(v int64) *BillingStatementUpsertOne {
+	return u.Update(func(s *BillingStatementUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *BillingStatementUpsertOne) AddUserID(v int64) *BillingStatementUpsertOne {
+	return u.Update(func(s *BillingStatementUpsert) {
+		s.AddUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *BillingStatementUpsertOne) UpdateUserID() *BillingStatementUpsertOne {
+	return u.Update(func(s *BillingStatementUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (u *BillingStatementUpsertOne) SetPeriodStart(v time.Time) *BillingStatementUpsertOne {
+	return u.Update(func(s *BillingStatementUpsert) {
+		s.SetPeriodStart(v)
+	})
+}
+
+// UpdatePeriodStart sets the "period_start" field to the value that was provided on create.
+func (u *BillingStatementUpsertOne) UpdatePeriodStart() *BillingStatementUpsertOne {
+	return u.Update(func(s *BillingStatementUpsert) {
+		s.UpdatePeriodStart()
+	})
+}
+
+// SetPeriodEnd sets the "period_end" field.
+func (u *BillingStatementUpsertOne) SetPeriodEnd(v time.Time) *BillingStatementUpsertOne {
+	return u.Update(func(s *BillingStatementUpsert) {
+		s.SetPeriodEnd(v)
+	})
+}
+
+// UpdatePeriodEnd sets the "period_end" field to the value that was provided on create.
+func (u *BillingStatementUpsertOne) UpdatePeriodEnd() *BillingStatementUpsertOne {
+	return u.Update(func(s *BillingStatementUpsert) {
+		s.UpdatePeriodEnd()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *BillingStatementUpsertOne) SetAmount(v float64) *BillingStatementUpsertOne {
+	return u.Update(func(s *BillingStatementUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *BillingStatementUpsertOne) AddAmount(v float64) *BillingStatementUpsertOne {
+	return u.Update(func(s *BillingStatementUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *BillingStatementUpsertOne) UpdateAmount() *BillingStatementUpsertOne {
+	return u.Update(func(s *BillingStatementUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetRequestCount sets the "request_count" field.
+func (u *BillingStatementUpsertOne) SetRequestCount(v int64) *BillingStatementUpsertOne {
+	return u.Update(func(s *BillingStatementUpsert) {
+		s.SetRequestCount(v)
+	})
+}
+
+// AddRequestCount adds v to the "request_count" field.
+func (u *BillingStatementUpsertOne) AddRequestCount(v int64) *BillingStatementUpsertOne {
+	return u.Update(func(s *BillingStatementUpsert) {
+		s.AddRequestCount(v)
+	})
+}
+
+// UpdateRequestCount sets the "request_count" field to the value that was provided on create.
+func (u *BillingStatementUpsertOne) UpdateRequestCount() *BillingStatementUpsertOne {
+	return u.Update(func(s *BillingStatementUpsert) {
+		s.UpdateRequestCount()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *BillingStatementUpsertOne) SetStatus(v string) *BillingStatementUpsertOne {
+	return u.Update(func(s *BillingStatementUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *BillingStatementUpsertOne) UpdateStatus() *BillingStatementUpsertOne {
+	return u.Update(func(s *BillingStatementUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetDueAt sets the "due_at" field.
+func (u *BillingStatementUpsertOne) SetDueAt(v time.Time) *BillingStatementUpsertOne {
+	return u.Update(func(s *BillingStatementUpsert) {
+		s.SetDueAt(v)
+	})
+}
+
+// UpdateDueAt sets the "due_at" field to the value that was provided on create.
+func (u *BillingStatementUpsertOne) UpdateDueAt() *BillingStatementUpsertOne {
+	return u.Update(func(s *BillingStatementUpsert) {
+		s.UpdateDueAt()
+	})
+}
+
+// SetReminderSentAt sets the "reminder_sent_at" field.
+func (u *BillingStatementUpsertOne) SetReminderSentAt(v time.Time) *BillingStatementUpsertOne {
+	return u.Update(func(s *BillingStatementUpsert) {
+		s.SetReminderSentAt(v)
+	})
+}
+
+// UpdateReminderSentAt sets the "reminder_sent_at" field to the value that was provided on create.
+func (u *BillingStatementUpsertOne) UpdateReminderSentAt() *BillingStatementUpsertOne {
+	return u.Update(func(s *BillingStatementUpsert) {
+		s.UpdateReminderSentAt()
+	})
+}
+
+// ClearReminderSentAt clears the value of the "reminder_sent_at" field.
+func (u *BillingStatementUpsertOne) ClearReminderSentAt() *BillingStatementUpsertOne {
+	return u.Update(func(s *BillingStatementUpsert) {
+		s.ClearReminderSentAt()
+	})
+}
+
+// SetOverdueAt sets the "overdue_at" field.
+func (u *BillingStatementUpsertOne) SetOverdueAt(v time.Time) *BillingStatementUpsertOne {
+	return u.Update(func(s *BillingStatementUpsert) {
+		s.SetOverdueAt(v)
+	})
+}
+
+// UpdateOverdueAt sets the "overdue_at" field to the value that was provided on create.
+func (u *BillingStatementUpsertOne) UpdateOverdueAt() *BillingStatementUpsertOne {
+	return u.Update(func(s *BillingStatementUpsert) {
+		s.UpdateOverdueAt()
+	})
+}
+
+// ClearOverdueAt clears the value of the "overdue_at" field.
+func (u *BillingStatementUpsertOne) ClearOverdueAt() *BillingStatementUpsertOne {
+	return u.Update(func(s *BillingStatementUpsert) {
+		s.ClearOverdueAt()
+	})
+}
+
+// SetPaidAt sets the "paid_at" field.
+func (u *BillingStatementUpsertOne) SetPaidAt(v time.Time) *BillingStatementUpsertOne {
+	return u.Update(func(s *BillingStatementUpsert) {
+		s.SetPaidAt(v)
+	})
+}
+
+// UpdatePaidAt sets the "paid_at" field to the value that was provided on create.
+func (u *BillingStatementUpsertOne) UpdatePaidAt() *BillingStatementUpsertOne {
+	return u.Update(func(s *BillingStatementUpsert) {
+		s.UpdatePaidAt()
+	})
+}
+
+// ClearPaidAt clears the value of the "paid_at" field.
+func (u *BillingStatementUpsertOne) ClearPaidAt() *BillingStatementUpsertOne {
+	return u.Update(func(s *BillingStatementUpsert) {
+		s.ClearPaidAt()
+	})
+}
+
+// SetPaymentNote sets the "payment_note" field.
+func (u *BillingStatementUpsertOne) SetPaymentNote(v string) *BillingStatementUpsertOne {
+	return u.Update(func(s *BillingStatementUpsert) {
+		s.SetPaymentNote(v)
+	})
+}
+
+// UpdatePaymentNote sets the "payment_note" field to the value that was provided on create.
+func (u *BillingStatementUpsertOne) UpdatePaymentNote() *BillingStatementUpsertOne {
+	return u.Update(func(s *BillingStatementUpsert) {
+		s.UpdatePaymentNote()
+	})
+}
+
+// ClearPaymentNote clears the value of the "payment_note" field.
+func (u *BillingStatementUpsertOne) ClearPaymentNote() *BillingStatementUpsertOne {
+	return u.Update(func(s *BillingStatementUpsert) {
+		s.ClearPaymentNote()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *BillingStatementUpsertOne) SetUpdatedAt(v time.Time) *BillingStatementUpsertOne {
+	return u.Update(func(s *BillingStatementUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *BillingStatementUpsertOne) UpdateUpdatedAt() *BillingStatementUpsertOne {
+	return u.Update(func(s *BillingStatementUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *BillingStatementUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BillingStatementCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BillingStatementUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *BillingStatementUpsertOne) ID(ctx context.Context) (id int64, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *BillingStatementUpsertOne) IDX(ctx context.Context) int64 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// BillingStatementCreateBulk is the builder for creating many BillingStatement entities in bulk.
+type BillingStatementCreateBulk struct {
+	config
+	err      error
+	builders []*BillingStatementCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the BillingStatement entities in the database.
+func (_c *BillingStatementCreateBulk) Save(ctx context.Context) ([]*BillingStatement, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BillingStatement, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BillingStatementMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BillingStatementCreateBulk) SaveX(ctx context.Context) []*BillingStatement {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BillingStatementCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BillingStatementCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.BillingStatement.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BillingStatementUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *BillingStatementCreateBulk) OnConflict(opts ...sql.ConflictOption) *BillingStatementUpsertBulk {
+	_c.conflict = opts
+	return &BillingStatementUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.BillingStatement.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *BillingStatementCreateBulk) OnConflictColumns(columns ...string) *BillingStatementUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &BillingStatementUpsertBulk{
+		create: _c,
+	}
+}
+
+// BillingStatementUpsertBulk is the builder for "upsert"-ing
+// a bulk of BillingStatement nodes.
+type BillingStatementUpsertBulk struct {
+	create *BillingStatementCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.BillingStatement.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *BillingStatementUpsertBulk) UpdateNewValues() *BillingStatementUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(billingstatement.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.BillingStatement.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *BillingStatementUpsertBulk) Ignore() *BillingStatementUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BillingStatementUpsertBulk) DoNothing() *BillingStatementUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BillingStatementCreateBulk.OnConflict
+// documentation for more info.
+func (u *BillingStatementUpsertBulk) Update(set func(*BillingStatementUpsert)) *BillingStatementUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BillingStatementUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *BillingStatementUpsertBulk) SetUserID(v int64) *BillingStatementUpsertBulk {
+	return u.Update(func(s *BillingStatementUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *BillingStatementUpsertBulk) AddUserID(v int64) *BillingStatementUpsertBulk {
+	return u.Update(func(s *BillingStatementUpsert) {
+		s.AddUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *BillingStatementUpsertBulk) UpdateUserID() *BillingStatementUpsertBulk {
+	return u.Update(func(s *BillingStatementUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (u *BillingStatementUpsertBulk) SetPeriodStart(v time.Time) *BillingStatementUpsertBulk {
+	return u.Update(func(s *BillingStatementUpsert) {
+		s.SetPeriodStart(v)
+	})
+}
+
+// UpdatePeriodStart sets the "period_start" field to the value that was provided on create.
+func (u *BillingStatementUpsertBulk) UpdatePeriodStart() *BillingStatementUpsertBulk {
+	return u.Update(func(s *BillingStatementUpsert) {
+		s.UpdatePeriodStart()
+	})
+}
+
+// SetPeriodEnd sets the "period_end" field.
+func (u *BillingStatementUpsertBulk) SetPeriodEnd(v time.Time) *BillingStatementUpsertBulk {
+	return u.Update(func(s *BillingStatementUpsert) {
+		s.SetPeriodEnd(v)
+	})
+}
+
+// UpdatePeriodEnd sets the "period_end" field to the value that was provided on create.
+func (u *BillingStatementUpsertBulk) UpdatePeriodEnd() *BillingStatementUpsertBulk {
+	return u.Update(func(s *BillingStatementUpsert) {
+		s.UpdatePeriodEnd()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *BillingStatementUpsertBulk) SetAmount(v float64) *BillingStatementUpsertBulk {
+	return u.Update(func(s *BillingStatementUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *BillingStatementUpsertBulk) AddAmount(v float64) *BillingStatementUpsertBulk {
+	return u.Update(func(s *BillingStatementUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *BillingStatementUpsertBulk) UpdateAmount() *BillingStatementUpsertBulk {
+	return u.Update(func(s *BillingStatementUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetRequestCount sets the "request_count" field.
+func (u *BillingStatementUpsertBulk) SetRequestCount(v int64) *BillingStatementUpsertBulk {
+	return u.Update(func(s *BillingStatementUpsert) {
+		s.SetRequestCount(v)
+	})
+}
+
+// AddRequestCount adds v to the "request_count" field.
+func (u *BillingStatementUpsertBulk) AddRequestCount(v int64) *BillingStatementUpsertBulk {
+	return u.Update(func(s *BillingStatementUpsert) {
+		s.AddRequestCount(v)
+	})
+}
+
+// UpdateRequestCount sets the "request_count" field to the value that was provided on create.
+func (u *BillingStatementUpsertBulk) UpdateRequestCount() *BillingStatementUpsertBulk {
+	return u.Update(func(s *BillingStatementUpsert) {
+		s.UpdateRequestCount()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *BillingStatementUpsertBulk) SetStatus(v string) *BillingStatementUpsertBulk {
+	return u.Update(func(s *BillingStatementUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *BillingStatementUpsertBulk) UpdateStatus() *BillingStatementUpsertBulk {
+	return u.Update(func(s *BillingStatementUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetDueAt sets the "due_at" field.
+func (u *BillingStatementUpsertBulk) SetDueAt(v time.Time) *BillingStatementUpsertBulk {
+	return u.Update(func(s *BillingStatementUpsert) {
+		s.SetDueAt(v)
+	})
+}
+
+// UpdateDueAt sets the "due_at" field to the value that was provided on create.
+func (u *BillingStatementUpsertBulk) UpdateDueAt() *BillingStatementUpsertBulk {
+	return u.Update(func(s *BillingStatementUpsert) {
+		s.UpdateDueAt()
+	})
+}
+
+// SetReminderSentAt sets the "reminder_sent_at" field.
+func (u *BillingStatementUpsertBulk) SetReminderSentAt(v time.Time) *BillingStatementUpsertBulk {
+	return u.Update(func(s *BillingStatementUpsert) {
+		s.SetReminderSentAt(v)
+	})
+}
+
+// UpdateReminderSentAt sets the "reminder_sent_at" field to the value that was provided on create.
+func (u *BillingStatementUpsertBulk) UpdateReminderSentAt() *BillingStatementUpsertBulk {
+	return u.Update(func(s *BillingStatementUpsert) {
+		s.UpdateReminderSentAt()
+	})
+}
+
+// ClearReminderSentAt clears the value of the "reminder_sent_at" field.
+func (u *BillingStatementUpsertBulk) ClearReminderSentAt() *BillingStatementUpsertBulk {
+	return u.Update(func(s *BillingStatementUpsert) {
+		s.ClearReminderSentAt()
+	})
+}
+
+// SetOverdueAt sets the "overdue_at" field.
+func (u *BillingStatementUpsertBulk) SetOverdueAt(v time.Time) *BillingStatementUpsertBulk {
+	return u.Update(func(s *BillingStatementUpsert) {
+		s.SetOverdueAt(v)
+	})
+}
+
+// UpdateOverdueAt sets the "overdue_at" field to the value that was provided on create.
+func (u *BillingStatementUpsertBulk) UpdateOverdueAt() *BillingStatementUpsertBulk {
+	return u.Update(func(s *BillingStatementUpsert) {
+		s.UpdateOverdueAt()
+	})
+}
+
+// ClearOverdueAt clears the value of the "overdue_at" field.
+func (u *BillingStatementUpsertBulk) ClearOverdueAt() *BillingStatementUpsertBulk {
+	return u.Update(func(s *BillingStatementUpsert) {
+		s.ClearOverdueAt()
+	})
+}
+
+// SetPaidAt sets the "paid_at" field.
+func (u *BillingStatementUpsertBulk) SetPaidAt(v time.Time) *BillingStatementUpsertBulk {
+	return u.Update(func(s *BillingStatementUpsert) {
+		s.SetPaidAt(v)
+	})
+}
+
+// UpdatePaidAt sets the "paid_at" field to the value that was provided on create.
+func (u *BillingStatementUpsertBulk) UpdatePaidAt() *BillingStatementUpsertBulk {
+	return u.Update(func(s *BillingStatementUpsert) {
+		s.UpdatePaidAt()
+	})
+}
+
+// ClearPaidAt clears the value of the "paid_at" field.
+func (u *BillingStatementUpsertBulk) ClearPaidAt() *BillingStatementUpsertBulk {
+	return u.Update(func(s *BillingStatementUpsert) {
+		s.ClearPaidAt()
+	})
+}
+
+// SetPaymentNote sets the "payment_note" field.
+func (u *BillingStatementUpsertBulk) SetPaymentNote(v string) *BillingStatementUpsertBulk {
+	return u.Update(func(s *BillingStatementUpsert) {
+		s.SetPaymentNote(v)
+	})
+}
+
+// UpdatePaymentNote sets the "payment_note" field to the value that was provided on create.
+func (u *BillingStatementUpsertBulk) UpdatePaymentNote() *BillingStatementUpsertBulk {
+	return u.Update(func(s *BillingStatementUpsert) {
+		s.UpdatePaymentNote()
+	})
+}
+
+// ClearPaymentNote clears the value of the "payment_note" field.
+func (u *BillingStatementUpsertBulk) ClearPaymentNote() *BillingStatementUpsertBulk {
+	return u.Update(func(s *BillingStatementUpsert) {
+		s.ClearPaymentNote()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *BillingStatementUpsertBulk) SetUpdatedAt(v time.Time) *BillingStatementUpsertBulk {
+	return u.Update(func(s *BillingStatementUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *BillingStatementUpsertBulk) UpdateUpdatedAt() *BillingStatementUpsertBulk {
+	return u.Update(func(s *BillingStatementUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *BillingStatementUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the BillingStatementCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BillingStatementCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BillingStatementUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
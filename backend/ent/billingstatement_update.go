@@ -0,0 +1,744 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Wei-Shaw/sub2api/ent/billingstatement"
+	"github.com/Wei-Shaw/sub2api/ent/predicate"
+)
+
+// BillingStatementUpdate is the builder for updating BillingStatement entities.
+type BillingStatementUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BillingStatementMutation
+}
+
+// Where appends a list predicates to the BillingStatementUpdate builder.
+func (_u *BillingStatementUpdate) Where(ps ...predicate.BillingStatement) *BillingStatementUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *BillingStatementUpdate) SetUserID(v int64) *BillingStatementUpdate {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *BillingStatementUpdate) SetNillableUserID(v *int64) *BillingStatementUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *BillingStatementUpdate) AddUserID(v int64) *BillingStatementUpdate {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (_u *BillingStatementUpdate) SetPeriodStart(v time.Time) *BillingStatementUpdate {
+	_u.mutation.SetPeriodStart(v)
+	return _u
+}
+
+// SetNillablePeriodStart sets the "period_start" field if the given value is not nil.
+func (_u *BillingStatementUpdate) SetNillablePeriodStart(v *time.Time) *BillingStatementUpdate {
+	if v != nil {
+		_u.SetPeriodStart(*v)
+	}
+	return _u
+}
+
+// SetPeriodEnd sets the "period_end" field.
+func (_u *BillingStatementUpdate) SetPeriodEnd(v time.Time) *BillingStatementUpdate {
+	_u.mutation.SetPeriodEnd(v)
+	return _u
+}
+
+// SetNillablePeriodEnd sets the "period_end" field if the given value is not nil.
+func (_u *BillingStatementUpdate) SetNillablePeriodEnd(v *time.Time) *BillingStatementUpdate {
+	if v != nil {
+		_u.SetPeriodEnd(*v)
+	}
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *BillingStatementUpdate) SetAmount(v float64) *BillingStatementUpdate {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *BillingStatementUpdate) SetNillableAmount(v *float64) *BillingStatementUpdate {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *BillingStatementUpdate) AddAmount(v float64) *BillingStatementUpdate {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetRequestCount sets the "request_count" field.
+func (_u *BillingStatementUpdate) SetRequestCount(v int64) *BillingStatementUpdate {
+	_u.mutation.ResetRequestCount()
+	_u.mutation.SetRequestCount(v)
+	return _u
+}
+
+// SetNillableRequestCount sets the "request_count" field if the given value is not nil.
+func (_u *BillingStatementUpdate) SetNillableRequestCount(v *int64) *BillingStatementUpdate {
+	if v != nil {
+		_u.SetRequestCount(*v)
+	}
+	return _u
+}
+
+// AddRequestCount adds value to the "request_count" field.
+func (_u *BillingStatementUpdate) AddRequestCount(v int64) *BillingStatementUpdate {
+	_u.mutation.AddRequestCount(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *BillingStatementUpdate) SetStatus(v string) *BillingStatementUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *BillingStatementUpdate) SetNillableStatus(v *string) *BillingStatementUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetDueAt sets the "due_at" field.
+func (_u *BillingStatementUpdate) SetDueAt(v time.Time) *BillingStatementUpdate {
+	_u.mutation.SetDueAt(v)
+	return _u
+}
+
+// SetNillableDueAt sets the "due_at" field if the given value is not nil.
+func (_u *BillingStatementUpdate) SetNillableDueAt(v *time.Time) *BillingStatementUpdate {
+	if v != nil {
+		_u.SetDueAt(*v)
+	}
+	return _u
+}
+
+// SetReminderSentAt sets the "reminder_sent_at" field.
+func (_u *BillingStatementUpdate) SetReminderSentAt(v time.Time) *BillingStatementUpdate {
+	_u.mutation.SetReminderSentAt(v)
+	return _u
+}
+
+// SetNillableReminderSentAt sets the "reminder_sent_at" field if the given value is not nil.
+func (_u *BillingStatementUpdate) SetNillableReminderSentAt(v *time.Time) *BillingStatementUpdate {
+	if v != nil {
+		_u.SetReminderSentAt(*v)
+	}
+	return _u
+}
+
+// ClearReminderSentAt clears the value of the "reminder_sent_at" field.
+func (_u *BillingStatementUpdate) ClearReminderSentAt() *BillingStatementUpdate {
+	_u.mutation.ClearReminderSentAt()
+	return _u
+}
+
+// SetOverdueAt sets the "overdue_at" field.
+func (_u *BillingStatementUpdate) SetOverdueAt(v time.Time) *BillingStatementUpdate {
+	_u.mutation.SetOverdueAt(v)
+	return _u
+}
+
+// SetNillableOverdueAt sets the "overdue_at" field if the given value is not nil.
+func (_u *BillingStatementUpdate) SetNillableOverdueAt(v *time.Time) *BillingStatementUpdate {
+	if v != nil {
+		_u.SetOverdueAt(*v)
+	}
+	return _u
+}
+
+// ClearOverdueAt clears the value of the "overdue_at" field.
+func (_u *BillingStatementUpdate) ClearOverdueAt() *BillingStatementUpdate {
+	_u.mutation.ClearOverdueAt()
+	return _u
+}
+
+// SetPaidAt sets the "paid_at" field.
+func (_u *BillingStatementUpdate) SetPaidAt(v time.Time) *BillingStatementUpdate {
+	_u.mutation.SetPaidAt(v)
+	return _u
+}
+
+// SetNillablePaidAt sets the "paid_at" field if the given value is not nil.
+func (_u *BillingStatementUpdate) SetNillablePaidAt(v *time.Time) *BillingStatementUpdate {
+	if v != nil {
+		_u.SetPaidAt(*v)
+	}
+	return _u
+}
+
+// ClearPaidAt clears the value of the "paid_at" field.
+func (_u *BillingStatementUpdate) ClearPaidAt() *BillingStatementUpdate {
+	_u.mutation.ClearPaidAt()
+	return _u
+}
+
+// SetPaymentNote sets the "payment_note" field.
+func (_u *BillingStatementUpdate) SetPaymentNote(v string) *BillingStatementUpdate {
+	_u.mutation.SetPaymentNote(v)
+	return _u
+}
+
+// SetNillablePaymentNote sets the "payment_note" field if the given value is not nil.
+func (_u *BillingStatementUpdate) SetNillablePaymentNote(v *string) *BillingStatementUpdate {
+	if v != nil {
+		_u.SetPaymentNote(*v)
+	}
+	return _u
+}
+
+// ClearPaymentNote clears the value of the "payment_note" field.
+func (_u *BillingStatementUpdate) ClearPaymentNote() *BillingStatementUpdate {
+	_u.mutation.ClearPaymentNote()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *BillingStatementUpdate) SetUpdatedAt(v time.Time) *BillingStatementUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the BillingStatementMutation object of the builder.
+func (_u *BillingStatementUpdate) Mutation() *BillingStatementMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BillingStatementUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BillingStatementUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BillingStatementUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BillingStatementUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *BillingStatementUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := billingstatement.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BillingStatementUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := billingstatement.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "BillingStatement.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BillingStatementUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(billingstatement.Table, billingstatement.Columns, sqlgraph.NewFieldSpec(billingstatement.FieldID, field.TypeInt64))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(billingstatement.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(billingstatement.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.PeriodStart(); ok {
+		_spec.SetField(billingstatement.FieldPeriodStart, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.PeriodEnd(); ok {
+		_spec.SetField(billingstatement.FieldPeriodEnd, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(billingstatement.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(billingstatement.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.RequestCount(); ok {
+		_spec.SetField(billingstatement.FieldRequestCount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedRequestCount(); ok {
+		_spec.AddField(billingstatement.FieldRequestCount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(billingstatement.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DueAt(); ok {
+		_spec.SetField(billingstatement.FieldDueAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ReminderSentAt(); ok {
+		_spec.SetField(billingstatement.FieldReminderSentAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReminderSentAtCleared() {
+		_spec.ClearField(billingstatement.FieldReminderSentAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.OverdueAt(); ok {
+		_spec.SetField(billingstatement.FieldOverdueAt, field.TypeTime, value)
+	}
+	if _u.mutation.OverdueAtCleared() {
+		_spec.ClearField(billingstatement.FieldOverdueAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.PaidAt(); ok {
+		_spec.SetField(billingstatement.FieldPaidAt, field.TypeTime, value)
+	}
+	if _u.mutation.PaidAtCleared() {
+		_spec.ClearField(billingstatement.FieldPaidAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.PaymentNote(); ok {
+		_spec.SetField(billingstatement.FieldPaymentNote, field.TypeString, value)
+	}
+	if _u.mutation.PaymentNoteCleared() {
+		_spec.ClearField(billingstatement.FieldPaymentNote, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(billingstatement.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{billingstatement.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BillingStatementUpdateOne is the builder for updating a single BillingStatement entity.
+type BillingStatementUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BillingStatementMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *BillingStatementUpdateOne) SetUserID(v int64) *BillingStatementUpdateOne {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *BillingStatementUpdateOne) SetNillableUserID(v *int64) *BillingStatementUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *BillingStatementUpdateOne) AddUserID(v int64) *BillingStatementUpdateOne {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (_u *BillingStatementUpdateOne) SetPeriodStart(v time.Time) *BillingStatementUpdateOne {
+	_u.mutation.SetPeriodStart(v)
+	return _u
+}
+
+// SetNillablePeriodStart sets the "period_start" field if the given value is not nil.
+func (_u *BillingStatementUpdateOne) SetNillablePeriodStart(v *time.Time) *BillingStatementUpdateOne {
+	if v != nil {
+		_u.SetPeriodStart(*v)
+	}
+	return _u
+}
+
+// SetPeriodEnd sets the "period_end" field.
+func (_u *BillingStatementUpdateOne) SetPeriodEnd(v time.Time) *BillingStatementUpdateOne {
+	_u.mutation.SetPeriodEnd(v)
+	return _u
+}
+
+// SetNillablePeriodEnd sets the "period_end" field if the given value is not nil.
+func (_u *BillingStatementUpdateOne) SetNillablePeriodEnd(v *time.Time) *BillingStatementUpdateOne {
+	if v != nil {
+		_u.SetPeriodEnd(*v)
+	}
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *BillingStatementUpdateOne) SetAmount(v float64) *BillingStatementUpdateOne {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *BillingStatementUpdateOne) SetNillableAmount(v *float64) *BillingStatementUpdateOne {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *BillingStatementUpdateOne) AddAmount(v float64) *BillingStatementUpdateOne {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetRequestCount sets the "request_count" field.
+func (_u *BillingStatementUpdateOne) SetRequestCount(v int64) *BillingStatementUpdateOne {
+	_u.mutation.ResetRequestCount()
+	_u.mutation.SetRequestCount(v)
+	return _u
+}
+
+// SetNillableRequestCount sets the "request_count" field if the given value is not nil.
+func (_u *BillingStatementUpdateOne) SetNillableRequestCount(v *int64) *BillingStatementUpdateOne {
+	if v != nil {
+		_u.SetRequestCount(*v)
+	}
+	return _u
+}
+
+// AddRequestCount adds value to the "request_count" field.
+func (_u *BillingStatementUpdateOne) AddRequestCount(v int64) *BillingStatementUpdateOne {
+	_u.mutation.AddRequestCount(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *BillingStatementUpdateOne) SetStatus(v string) *BillingStatementUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *BillingStatementUpdateOne) SetNillableStatus(v *string) *BillingStatementUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetDueAt sets the "due_at" field.
+func (_u *BillingStatementUpdateOne) SetDueAt(v time.Time) *BillingStatementUpdateOne {
+	_u.mutation.SetDueAt(v)
+	return _u
+}
+
+// SetNillableDueAt sets the "due_at" field if the given value is not nil.
+func (_u *BillingStatementUpdateOne) SetNillableDueAt(v *time.Time) *BillingStatementUpdateOne {
+	if v != nil {
+		_u.SetDueAt(*v)
+	}
+	return _u
+}
+
+// SetReminderSentAt sets the "reminder_sent_at" field.
+func (_u *BillingStatementUpdateOne) SetReminderSentAt(v time.Time) *BillingStatementUpdateOne {
+	_u.mutation.SetReminderSentAt(v)
+	return _u
+}
+
+// SetNillableReminderSentAt sets the "reminder_sent_at" field if the given value is not nil.
+func (_u *BillingStatementUpdateOne) SetNillableReminderSentAt(v *time.Time) *BillingStatementUpdateOne {
+	if v != nil {
+		_u.SetReminderSentAt(*v)
+	}
+	return _u
+}
+
+// ClearReminderSentAt clears the value of the "reminder_sent_at" field.
+func (_u *BillingStatementUpdateOne) ClearReminderSentAt() *BillingStatementUpdateOne {
+	_u.mutation.ClearReminderSentAt()
+	return _u
+}
+
+// SetOverdueAt sets the "overdue_at" field.
+func (_u *BillingStatementUpdateOne) SetOverdueAt(v time.Time) *BillingStatementUpdateOne {
+	_u.mutation.SetOverdueAt(v)
+	return _u
+}
+
+// SetNillableOverdueAt sets the "overdue_at" field if the given value is not nil.
+func (_u *BillingStatementUpdateOne) SetNillableOverdueAt(v *time.Time) *BillingStatementUpdateOne {
+	if v != nil {
+		_u.SetOverdueAt(*v)
+	}
+	return _u
+}
+
+// ClearOverdueAt clears the value of the "overdue_at" field.
+func (_u *BillingStatementUpdateOne) ClearOverdueAt() *BillingStatementUpdateOne {
+	_u.mutation.ClearOverdueAt()
+	return _u
+}
+
+// SetPaidAt sets the "paid_at" field.
+func (_u *BillingStatementUpdateOne) SetPaidAt(v time.Time) *BillingStatementUpdateOne {
+	_u.mutation.SetPaidAt(v)
+	return _u
+}
+
+// SetNillablePaidAt sets the "paid_at" field if the given value is not nil.
+func (_u *BillingStatementUpdateOne) SetNillablePaidAt(v *time.Time) *BillingStatementUpdateOne {
+	if v != nil {
+		_u.SetPaidAt(*v)
+	}
+	return _u
+}
+
+// ClearPaidAt clears the value of the "paid_at" field.
+func (_u *BillingStatementUpdateOne) ClearPaidAt() *BillingStatementUpdateOne {
+	_u.mutation.ClearPaidAt()
+	return _u
+}
+
+// SetPaymentNote sets the "payment_note" field.
+func (_u *BillingStatementUpdateOne) SetPaymentNote(v string) *BillingStatementUpdateOne {
+	_u.mutation.SetPaymentNote(v)
+	return _u
+}
+
+// SetNillablePaymentNote sets the "payment_note" field if the given value is not nil.
+func (_u *BillingStatementUpdateOne) SetNillablePaymentNote(v *string) *BillingStatementUpdateOne {
+	if v != nil {
+		_u.SetPaymentNote(*v)
+	}
+	return _u
+}
+
+// ClearPaymentNote clears the value of the "payment_note" field.
+func (_u *BillingStatementUpdateOne) ClearPaymentNote() *BillingStatementUpdateOne {
+	_u.mutation.ClearPaymentNote()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *BillingStatementUpdateOne) SetUpdatedAt(v time.Time) *BillingStatementUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the BillingStatementMutation object of the builder.
+func (_u *BillingStatementUpdateOne) Mutation() *BillingStatementMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BillingStatementUpdate builder.
+func (_u *BillingStatementUpdateOne) Where(ps ...predicate.BillingStatement) *BillingStatementUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BillingStatementUpdateOne) Select(field string, fields ...string) *BillingStatementUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BillingStatement entity.
+func (_u *BillingStatementUpdateOne) Save(ctx context.Context) (*BillingStatement, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BillingStatementUpdateOne) SaveX(ctx context.Context) *BillingStatement {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BillingStatementUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BillingStatementUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *BillingStatementUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := billingstatement.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BillingStatementUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := billingstatement.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "BillingStatement.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BillingStatementUpdateOne) sqlSave(ctx context.Context) (_node *BillingStatement, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(billingstatement.Table, billingstatement.Columns, sqlgraph.NewFieldSpec(billingstatement.FieldID, field.TypeInt64))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BillingStatement.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, billingstatement.FieldID)
+		for _, f := range fields {
+			if !billingstatement.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != billingstatement.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(billingstatement.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(billingstatement.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.PeriodStart(); ok {
+		_spec.SetField(billingstatement.FieldPeriodStart, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.PeriodEnd(); ok {
+		_spec.SetField(billingstatement.FieldPeriodEnd, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(billingstatement.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(billingstatement.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.RequestCount(); ok {
+		_spec.SetField(billingstatement.FieldRequestCount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedRequestCount(); ok {
+		_spec.AddField(billingstatement.FieldRequestCount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(billingstatement.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DueAt(); ok {
+		_spec.SetField(billingstatement.FieldDueAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ReminderSentAt(); ok {
+		_spec.SetField(billingstatement.FieldReminderSentAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReminderSentAtCleared() {
+		_spec.ClearField(billingstatement.FieldReminderSentAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.OverdueAt(); ok {
+		_spec.SetField(billingstatement.FieldOverdueAt, field.TypeTime, value)
+	}
+	if _u.mutation.OverdueAtCleared() {
+		_spec.ClearField(billingstatement.FieldOverdueAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.PaidAt(); ok {
+		_spec.SetField(billingstatement.FieldPaidAt, field.TypeTime, value)
+	}
+	if _u.mutation.PaidAtCleared() {
+		_spec.ClearField(billingstatement.FieldPaidAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.PaymentNote(); ok {
+		_spec.SetField(billingstatement.FieldPaymentNote, field.TypeString, value)
+	}
+	if _u.mutation.PaymentNoteCleared() {
+		_spec.ClearField(billingstatement.FieldPaymentNote, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(billingstatement.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &BillingStatement{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{billingstatement.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
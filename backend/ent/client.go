@@ -20,24 +20,40 @@ import (
 	"github.com/Wei-Shaw/sub2api/ent/announcement"
 	"github.com/Wei-Shaw/sub2api/ent/announcementread"
 	"github.com/Wei-Shaw/sub2api/ent/apikey"
+	"github.com/Wei-Shaw/sub2api/ent/balancebucket"
+	"github.com/Wei-Shaw/sub2api/ent/billingadjustment"
+	"github.com/Wei-Shaw/sub2api/ent/billingrecomputejob"
+	"github.com/Wei-Shaw/sub2api/ent/billingstatement"
 	"github.com/Wei-Shaw/sub2api/ent/channelmonitor"
 	"github.com/Wei-Shaw/sub2api/ent/channelmonitordailyrollup"
 	"github.com/Wei-Shaw/sub2api/ent/channelmonitorhistory"
 	"github.com/Wei-Shaw/sub2api/ent/channelmonitorrequesttemplate"
+	"github.com/Wei-Shaw/sub2api/ent/commissionwithdrawal"
+	"github.com/Wei-Shaw/sub2api/ent/commissionwithdrawallog"
 	"github.com/Wei-Shaw/sub2api/ent/errorpassthroughrule"
+	"github.com/Wei-Shaw/sub2api/ent/gatewayplugin"
 	"github.com/Wei-Shaw/sub2api/ent/group"
+	"github.com/Wei-Shaw/sub2api/ent/guardrailrule"
 	"github.com/Wei-Shaw/sub2api/ent/idempotencyrecord"
+	"github.com/Wei-Shaw/sub2api/ent/invoice"
+	"github.com/Wei-Shaw/sub2api/ent/invoicesequence"
 	"github.com/Wei-Shaw/sub2api/ent/paygorder"
 	"github.com/Wei-Shaw/sub2api/ent/paymentauditlog"
+	"github.com/Wei-Shaw/sub2api/ent/paymentcoupon"
 	"github.com/Wei-Shaw/sub2api/ent/paymentorder"
 	"github.com/Wei-Shaw/sub2api/ent/paymentproviderinstance"
+	"github.com/Wei-Shaw/sub2api/ent/postpaidaccount"
 	"github.com/Wei-Shaw/sub2api/ent/promocode"
 	"github.com/Wei-Shaw/sub2api/ent/promocodeusage"
 	"github.com/Wei-Shaw/sub2api/ent/proxy"
+	"github.com/Wei-Shaw/sub2api/ent/proxypool"
 	"github.com/Wei-Shaw/sub2api/ent/redeemcode"
+	"github.com/Wei-Shaw/sub2api/ent/referralcommission"
 	"github.com/Wei-Shaw/sub2api/ent/referralreward"
+	"github.com/Wei-Shaw/sub2api/ent/requesttransformrule"
 	"github.com/Wei-Shaw/sub2api/ent/securitysecret"
 	"github.com/Wei-Shaw/sub2api/ent/setting"
+	"github.com/Wei-Shaw/sub2api/ent/subscriptionautorenewal"
 	"github.com/Wei-Shaw/sub2api/ent/subscriptionplan"
 	"github.com/Wei-Shaw/sub2api/ent/usagecleanuptask"
 	"github.com/Wei-Shaw/sub2api/ent/usagelog"
@@ -65,6 +81,14 @@ type Client struct {
 	Announcement *AnnouncementClient
 	// AnnouncementRead is the client for interacting with the AnnouncementRead builders.
 	AnnouncementRead *AnnouncementReadClient
+	// BalanceBucket is the client for interacting with the BalanceBucket builders.
+	BalanceBucket *BalanceBucketClient
+	// BillingAdjustment is the client for interacting with the BillingAdjustment builders.
+	BillingAdjustment *BillingAdjustmentClient
+	// BillingRecomputeJob is the client for interacting with the BillingRecomputeJob builders.
+	BillingRecomputeJob *BillingRecomputeJobClient
+	// BillingStatement is the client for interacting with the BillingStatement builders.
+	BillingStatement *BillingStatementClient
 	// ChannelMonitor is the client for interacting with the ChannelMonitor builders.
 	ChannelMonitor *ChannelMonitorClient
 	// ChannelMonitorDailyRollup is the client for interacting with the ChannelMonitorDailyRollup builders.
@@ -73,34 +97,58 @@ type Client struct {
 	ChannelMonitorHistory *ChannelMonitorHistoryClient
 	// ChannelMonitorRequestTemplate is the client for interacting with the ChannelMonitorRequestTemplate builders.
 	ChannelMonitorRequestTemplate *ChannelMonitorRequestTemplateClient
+	// CommissionWithdrawal is the client for interacting with the CommissionWithdrawal builders.
+	CommissionWithdrawal *CommissionWithdrawalClient
+	// CommissionWithdrawalLog is the client for interacting with the CommissionWithdrawalLog builders.
+	CommissionWithdrawalLog *CommissionWithdrawalLogClient
 	// ErrorPassthroughRule is the client for interacting with the ErrorPassthroughRule builders.
 	ErrorPassthroughRule *ErrorPassthroughRuleClient
+	// GatewayPlugin is the client for interacting with the GatewayPlugin builders.
+	GatewayPlugin *GatewayPluginClient
 	// Group is the client for interacting with the Group builders.
 	Group *GroupClient
+	// GuardrailRule is the client for interacting with the GuardrailRule builders.
+	GuardrailRule *GuardrailRuleClient
 	// IdempotencyRecord is the client for interacting with the IdempotencyRecord builders.
 	IdempotencyRecord *IdempotencyRecordClient
+	// Invoice is the client for interacting with the Invoice builders.
+	Invoice *InvoiceClient
+	// InvoiceSequence is the client for interacting with the InvoiceSequence builders.
+	InvoiceSequence *InvoiceSequenceClient
 	// PaygOrder is the client for interacting with the PaygOrder builders.
 	PaygOrder *PaygOrderClient
 	// PaymentAuditLog is the client for interacting with the PaymentAuditLog builders.
 	PaymentAuditLog *PaymentAuditLogClient
+	// PaymentCoupon is the client for interacting with the PaymentCoupon builders.
+	PaymentCoupon *PaymentCouponClient
 	// PaymentOrder is the client for interacting with the PaymentOrder builders.
 	PaymentOrder *PaymentOrderClient
 	// PaymentProviderInstance is the client for interacting with the PaymentProviderInstance builders.
 	PaymentProviderInstance *PaymentProviderInstanceClient
+	// PostpaidAccount is the client for interacting with the PostpaidAccount builders.
+	PostpaidAccount *PostpaidAccountClient
 	// PromoCode is the client for interacting with the PromoCode builders.
 	PromoCode *PromoCodeClient
 	// PromoCodeUsage is the client for interacting with the PromoCodeUsage builders.
 	PromoCodeUsage *PromoCodeUsageClient
 	// Proxy is the client for interacting with the Proxy builders.
 	Proxy *ProxyClient
+	// ProxyPool is the client for interacting with the ProxyPool builders.
+	ProxyPool *ProxyPoolClient
 	// RedeemCode is the client for interacting with the RedeemCode builders.
 	RedeemCode *RedeemCodeClient
+	// ReferralCommission is the client for interacting with the ReferralCommission builders.
+	ReferralCommission *ReferralCommissionClient
 	// ReferralReward is the client for interacting with the ReferralReward builders.
 	ReferralReward *ReferralRewardClient
+	// RequestTransformRule is the client for interacting with the RequestTransformRule builders.
+	RequestTransformRule *RequestTransformRuleClient
 	// SecuritySecret is the client for interacting with the SecuritySecret builders.
 	SecuritySecret *SecuritySecretClient
 	// Setting is the client for interacting with the Setting builders.
 	Setting *SettingClient
+	// SubscriptionAutoRenewal is the client for interacting with the SubscriptionAutoRenewal builders.
+	SubscriptionAutoRenewal *SubscriptionAutoRenewalClient
 	// SubscriptionPlan is the client for interacting with the SubscriptionPlan builders.
 	SubscriptionPlan *SubscriptionPlanClient
 	// UsageCleanupTask is the client for interacting with the UsageCleanupTask builders.
@@ -133,24 +181,40 @@ func (c *Client) init() {
 	c.AccountGroup = NewAccountGroupClient(c.config)
 	c.Announcement = NewAnnouncementClient(c.config)
 	c.AnnouncementRead = NewAnnouncementReadClient(c.config)
+	c.BalanceBucket = NewBalanceBucketClient(c.config)
+	c.BillingAdjustment = NewBillingAdjustmentClient(c.config)
+	c.BillingRecomputeJob = NewBillingRecomputeJobClient(c.config)
+	c.BillingStatement = NewBillingStatementClient(c.config)
 	c.ChannelMonitor = NewChannelMonitorClient(c.config)
 	c.ChannelMonitorDailyRollup = NewChannelMonitorDailyRollupClient(c.config)
 	c.ChannelMonitorHistory = NewChannelMonitorHistoryClient(c.config)
 	c.ChannelMonitorRequestTemplate = NewChannelMonitorRequestTemplateClient(c.config)
+	c.CommissionWithdrawal = NewCommissionWithdrawalClient(c.config)
+	c.CommissionWithdrawalLog = NewCommissionWithdrawalLogClient(c.config)
 	c.ErrorPassthroughRule = NewErrorPassthroughRuleClient(c.config)
+	c.GatewayPlugin = NewGatewayPluginClient(c.config)
 	c.Group = NewGroupClient(c.config)
+	c.GuardrailRule = NewGuardrailRuleClient(c.config)
 	c.IdempotencyRecord = NewIdempotencyRecordClient(c.config)
+	c.Invoice = NewInvoiceClient(c.config)
+	c.InvoiceSequence = NewInvoiceSequenceClient(c.config)
 	c.PaygOrder = NewPaygOrderClient(c.config)
 	c.PaymentAuditLog = NewPaymentAuditLogClient(c.config)
+	c.PaymentCoupon = NewPaymentCouponClient(c.config)
 	c.PaymentOrder = NewPaymentOrderClient(c.config)
 	c.PaymentProviderInstance = NewPaymentProviderInstanceClient(c.config)
+	c.PostpaidAccount = NewPostpaidAccountClient(c.config)
 	c.PromoCode = NewPromoCodeClient(c.config)
 	c.PromoCodeUsage = NewPromoCodeUsageClient(c.config)
 	c.Proxy = NewProxyClient(c.config)
+	c.ProxyPool = NewProxyPoolClient(c.config)
 	c.RedeemCode = NewRedeemCodeClient(c.config)
+	c.ReferralCommission = NewReferralCommissionClient(c.config)
 	c.ReferralReward = NewReferralRewardClient(c.config)
+	c.RequestTransformRule = NewRequestTransformRuleClient(c.config)
 	c.SecuritySecret = NewSecuritySecretClient(c.config)
 	c.Setting = NewSettingClient(c.config)
+	c.SubscriptionAutoRenewal = NewSubscriptionAutoRenewalClient(c.config)
 	c.SubscriptionPlan = NewSubscriptionPlanClient(c.config)
 	c.UsageCleanupTask = NewUsageCleanupTaskClient(c.config)
 	c.UsageLog = NewUsageLogClient(c.config)
@@ -256,24 +320,40 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		AccountGroup:                  NewAccountGroupClient(cfg),
 		Announcement:                  NewAnnouncementClient(cfg),
 		AnnouncementRead:              NewAnnouncementReadClient(cfg),
+		BalanceBucket:                 NewBalanceBucketClient(cfg),
+		BillingAdjustment:             NewBillingAdjustmentClient(cfg),
+		BillingRecomputeJob:           NewBillingRecomputeJobClient(cfg),
+		BillingStatement:              NewBillingStatementClient(cfg),
 		ChannelMonitor:                NewChannelMonitorClient(cfg),
 		ChannelMonitorDailyRollup:     NewChannelMonitorDailyRollupClient(cfg),
 		ChannelMonitorHistory:         NewChannelMonitorHistoryClient(cfg),
 		ChannelMonitorRequestTemplate: NewChannelMonitorRequestTemplateClient(cfg),
+		CommissionWithdrawal:          NewCommissionWithdrawalClient(cfg),
+		CommissionWithdrawalLog:       NewCommissionWithdrawalLogClient(cfg),
 		ErrorPassthroughRule:          NewErrorPassthroughRuleClient(cfg),
+		GatewayPlugin:                 NewGatewayPluginClient(cfg),
 		Group:                         NewGroupClient(cfg),
+		GuardrailRule:                 NewGuardrailRuleClient(cfg),
 		IdempotencyRecord:             NewIdempotencyRecordClient(cfg),
+		Invoice:                       NewInvoiceClient(cfg),
+		InvoiceSequence:               NewInvoiceSequenceClient(cfg),
 		PaygOrder:                     NewPaygOrderClient(cfg),
 		PaymentAuditLog:               NewPaymentAuditLogClient(cfg),
+		PaymentCoupon:                 NewPaymentCouponClient(cfg),
 		PaymentOrder:                  NewPaymentOrderClient(cfg),
 		PaymentProviderInstance:       NewPaymentProviderInstanceClient(cfg),
+		PostpaidAccount:               NewPostpaidAccountClient(cfg),
 		PromoCode:                     NewPromoCodeClient(cfg),
 		PromoCodeUsage:                NewPromoCodeUsageClient(cfg),
 		Proxy:                         NewProxyClient(cfg),
+		ProxyPool:                     NewProxyPoolClient(cfg),
 		RedeemCode:                    NewRedeemCodeClient(cfg),
+		ReferralCommission:            NewReferralCommissionClient(cfg),
 		ReferralReward:                NewReferralRewardClient(cfg),
+		RequestTransformRule:          NewRequestTransformRuleClient(cfg),
 		SecuritySecret:                NewSecuritySecretClient(cfg),
 		Setting:                       NewSettingClient(cfg),
+		SubscriptionAutoRenewal:       NewSubscriptionAutoRenewalClient(cfg),
 		SubscriptionPlan:              NewSubscriptionPlanClient(cfg),
 		UsageCleanupTask:              NewUsageCleanupTaskClient(cfg),
 		UsageLog:                      NewUsageLogClient(cfg),
@@ -306,24 +386,40 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		AccountGroup:                  NewAccountGroupClient(cfg),
 		Announcement:                  NewAnnouncementClient(cfg),
 		AnnouncementRead:              NewAnnouncementReadClient(cfg),
+		BalanceBucket:                 NewBalanceBucketClient(cfg),
+		BillingAdjustment:             NewBillingAdjustmentClient(cfg),
+		BillingRecomputeJob:           NewBillingRecomputeJobClient(cfg),
+		BillingStatement:              NewBillingStatementClient(cfg),
 		ChannelMonitor:                NewChannelMonitorClient(cfg),
 		ChannelMonitorDailyRollup:     NewChannelMonitorDailyRollupClient(cfg),
 		ChannelMonitorHistory:         NewChannelMonitorHistoryClient(cfg),
 		ChannelMonitorRequestTemplate: NewChannelMonitorRequestTemplateClient(cfg),
+		CommissionWithdrawal:          NewCommissionWithdrawalClient(cfg),
+		CommissionWithdrawalLog:       NewCommissionWithdrawalLogClient(cfg),
 		ErrorPassthroughRule:          NewErrorPassthroughRuleClient(cfg),
+		GatewayPlugin:                 NewGatewayPluginClient(cfg),
 		Group:                         NewGroupClient(cfg),
+		GuardrailRule:                 NewGuardrailRuleClient(cfg),
 		IdempotencyRecord:             NewIdempotencyRecordClient(cfg),
+		Invoice:                       NewInvoiceClient(cfg),
+		InvoiceSequence:               NewInvoiceSequenceClient(cfg),
 		PaygOrder:                     NewPaygOrderClient(cfg),
 		PaymentAuditLog:               NewPaymentAuditLogClient(cfg),
+		PaymentCoupon:                 NewPaymentCouponClient(cfg),
 		PaymentOrder:                  NewPaymentOrderClient(cfg),
 		PaymentProviderInstance:       NewPaymentProviderInstanceClient(cfg),
+		PostpaidAccount:               NewPostpaidAccountClient(cfg),
 		PromoCode:                     NewPromoCodeClient(cfg),
 		PromoCodeUsage:                NewPromoCodeUsageClient(cfg),
 		Proxy:                         NewProxyClient(cfg),
+		ProxyPool:                     NewProxyPoolClient(cfg),
 		RedeemCode:                    NewRedeemCodeClient(cfg),
+		ReferralCommission:            NewReferralCommissionClient(cfg),
 		ReferralReward:                NewReferralRewardClient(cfg),
+		RequestTransformRule:          NewRequestTransformRuleClient(cfg),
 		SecuritySecret:                NewSecuritySecretClient(cfg),
 		Setting:                       NewSettingClient(cfg),
+		SubscriptionAutoRenewal:       NewSubscriptionAutoRenewalClient(cfg),
 		SubscriptionPlan:              NewSubscriptionPlanClient(cfg),
 		UsageCleanupTask:              NewUsageCleanupTaskClient(cfg),
 		UsageLog:                      NewUsageLogClient(cfg),
@@ -362,13 +458,18 @@ func (c *Client) Close() error {
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
 		c.APIKey, c.Account, c.AccountGroup, c.Announcement, c.AnnouncementRead,
-		c.ChannelMonitor, c.ChannelMonitorDailyRollup, c.ChannelMonitorHistory,
-		c.ChannelMonitorRequestTemplate, c.ErrorPassthroughRule, c.Group,
-		c.IdempotencyRecord, c.PaygOrder, c.PaymentAuditLog, c.PaymentOrder,
-		c.PaymentProviderInstance, c.PromoCode, c.PromoCodeUsage, c.Proxy,
-		c.RedeemCode, c.ReferralReward, c.SecuritySecret, c.Setting,
-		c.SubscriptionPlan, c.UsageCleanupTask, c.UsageLog, c.User, c.UserAllowedGroup,
-		c.UserAttributeDefinition, c.UserAttributeValue, c.UserSubscription,
+		c.BalanceBucket, c.BillingAdjustment, c.BillingRecomputeJob,
+		c.BillingStatement, c.ChannelMonitor, c.ChannelMonitorDailyRollup,
+		c.ChannelMonitorHistory, c.ChannelMonitorRequestTemplate,
+		c.CommissionWithdrawal, c.CommissionWithdrawalLog, c.ErrorPassthroughRule,
+		c.GatewayPlugin, c.Group, c.GuardrailRule, c.IdempotencyRecord, c.Invoice,
+		c.InvoiceSequence, c.PaygOrder, c.PaymentAuditLog, c.PaymentCoupon,
+		c.PaymentOrder, c.PaymentProviderInstance, c.PostpaidAccount, c.PromoCode,
+		c.PromoCodeUsage, c.Proxy, c.ProxyPool, c.RedeemCode, c.ReferralCommission,
+		c.ReferralReward, c.RequestTransformRule, c.SecuritySecret, c.Setting,
+		c.SubscriptionAutoRenewal, c.SubscriptionPlan, c.UsageCleanupTask, c.UsageLog,
+		c.User, c.UserAllowedGroup, c.UserAttributeDefinition, c.UserAttributeValue,
+		c.UserSubscription,
 	} {
 		n.Use(hooks...)
 	}
@@ -379,13 +480,18 @@ func (c *Client) Use(hooks ...Hook) {
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
 		c.APIKey, c.Account, c.AccountGroup, c.Announcement, c.AnnouncementRead,
-		c.ChannelMonitor, c.ChannelMonitorDailyRollup, c.ChannelMonitorHistory,
-		c.ChannelMonitorRequestTemplate, c.ErrorPassthroughRule, c.Group,
-		c.IdempotencyRecord, c.PaygOrder, c.PaymentAuditLog, c.PaymentOrder,
-		c.PaymentProviderInstance, c.PromoCode, c.PromoCodeUsage, c.Proxy,
-		c.RedeemCode, c.ReferralReward, c.SecuritySecret, c.Setting,
-		c.SubscriptionPlan, c.UsageCleanupTask, c.UsageLog, c.User, c.UserAllowedGroup,
-		c.UserAttributeDefinition, c.UserAttributeValue, c.UserSubscription,
+		c.BalanceBucket, c.BillingAdjustment, c.BillingRecomputeJob,
+		c.BillingStatement, c.ChannelMonitor, c.ChannelMonitorDailyRollup,
+		c.ChannelMonitorHistory, c.ChannelMonitorRequestTemplate,
+		c.CommissionWithdrawal, c.CommissionWithdrawalLog, c.ErrorPassthroughRule,
+		c.GatewayPlugin, c.Group, c.GuardrailRule, c.IdempotencyRecord, c.Invoice,
+		c.InvoiceSequence, c.PaygOrder, c.PaymentAuditLog, c.PaymentCoupon,
+		c.PaymentOrder, c.PaymentProviderInstance, c.PostpaidAccount, c.PromoCode,
+		c.PromoCodeUsage, c.Proxy, c.ProxyPool, c.RedeemCode, c.ReferralCommission,
+		c.ReferralReward, c.RequestTransformRule, c.SecuritySecret, c.Setting,
+		c.SubscriptionAutoRenewal, c.SubscriptionPlan, c.UsageCleanupTask, c.UsageLog,
+		c.User, c.UserAllowedGroup, c.UserAttributeDefinition, c.UserAttributeValue,
+		c.UserSubscription,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -404,6 +510,14 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.Announcement.mutate(ctx, m)
 	case *AnnouncementReadMutation:
 		return c.AnnouncementRead.mutate(ctx, m)
+	case *BalanceBucketMutation:
+		return c.BalanceBucket.mutate(ctx, m)
+	case *BillingAdjustmentMutation:
+		return c.BillingAdjustment.mutate(ctx, m)
+	case *BillingRecomputeJobMutation:
+		return c.BillingRecomputeJob.mutate(ctx, m)
+	case *BillingStatementMutation:
+		return c.BillingStatement.mutate(ctx, m)
 	case *ChannelMonitorMutation:
 		return c.ChannelMonitor.mutate(ctx, m)
 	case *ChannelMonitorDailyRollupMutation:
@@ -412,34 +526,58 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.ChannelMonitorHistory.mutate(ctx, m)
 	case *ChannelMonitorRequestTemplateMutation:
 		return c.ChannelMonitorRequestTemplate.mutate(ctx, m)
+	case *CommissionWithdrawalMutation:
+		return c.CommissionWithdrawal.mutate(ctx, m)
+	case *CommissionWithdrawalLogMutation:
+		return c.CommissionWithdrawalLog.mutate(ctx, m)
 	case *ErrorPassthroughRuleMutation:
 		return c.ErrorPassthroughRule.mutate(ctx, m)
+	case *GatewayPluginMutation:
+		return c.GatewayPlugin.mutate(ctx, m)
 	case *GroupMutation:
 		return c.Group.mutate(ctx, m)
+	case *GuardrailRuleMutation:
+		return c.GuardrailRule.mutate(ctx, m)
 	case *IdempotencyRecordMutation:
 		return c.IdempotencyRecord.mutate(ctx, m)
+	case *InvoiceMutation:
+		return c.Invoice.mutate(ctx, m)
+	case *InvoiceSequenceMutation:
+		return c.InvoiceSequence.mutate(ctx, m)
 	case *PaygOrderMutation:
 		return c.PaygOrder.mutate(ctx, m)
 	case *PaymentAuditLogMutation:
 		return c.PaymentAuditLog.mutate(ctx, m)
+	case *PaymentCouponMutation:
+		return c.PaymentCoupon.mutate(ctx, m)
 	case *PaymentOrderMutation:
 		return c.PaymentOrder.mutate(ctx, m)
 	case *PaymentProviderInstanceMutation:
 		return c.PaymentProviderInstance.mutate(ctx, m)
+	case *PostpaidAccountMutation:
+		return c.PostpaidAccount.mutate(ctx, m)
 	case *PromoCodeMutation:
 		return c.PromoCode.mutate(ctx, m)
 	case *PromoCodeUsageMutation:
 		return c.PromoCodeUsage.mutate(ctx, m)
 	case *ProxyMutation:
 		return c.Proxy.mutate(ctx, m)
+	case *ProxyPoolMutation:
+		return c.ProxyPool.mutate(ctx, m)
 	case *RedeemCodeMutation:
 		return c.RedeemCode.mutate(ctx, m)
+	case *ReferralCommissionMutation:
+		return c.ReferralCommission.mutate(ctx, m)
 	case *ReferralRewardMutation:
 		return c.ReferralReward.mutate(ctx, m)
+	case *RequestTransformRuleMutation:
+		return c.RequestTransformRule.mutate(ctx, m)
 	case *SecuritySecretMutation:
 		return c.SecuritySecret.mutate(ctx, m)
 	case *SettingMutation:
 		return c.Setting.mutate(ctx, m)
+	case *SubscriptionAutoRenewalMutation:
+		return c.SubscriptionAutoRenewal.mutate(ctx, m)
 	case *SubscriptionPlanMutation:
 		return c.SubscriptionPlan.mutate(ctx, m)
 	case *UsageCleanupTaskMutation:
@@ -1273,6 +1411,538 @@ func (c *AnnouncementReadClient) mutate(ctx context.Context, m *AnnouncementRead
 	}
 }
 
+// BalanceBucketClient is a client for the BalanceBucket schema.
+type BalanceBucketClient struct {
+	config
+}
+
+// NewBalanceBucketClient returns a client for the BalanceBucket from the given config.
+func NewBalanceBucketClient(c config) *BalanceBucketClient {
+	return &BalanceBucketClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `balancebucket.Hooks(f(g(h())))`.
+func (c *BalanceBucketClient) Use(hooks ...Hook) {
+	c.hooks.BalanceBucket = append(c.hooks.BalanceBucket, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `balancebucket.Intercept(f(g(h())))`.
+func (c *BalanceBucketClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BalanceBucket = append(c.inters.BalanceBucket, interceptors...)
+}
+
+// Create returns a builder for creating a BalanceBucket entity.
+func (c *BalanceBucketClient) Create() *BalanceBucketCreate {
+	mutation := newBalanceBucketMutation(c.config, OpCreate)
+	return &BalanceBucketCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BalanceBucket entities.
+func (c *BalanceBucketClient) CreateBulk(builders ...*BalanceBucketCreate) *BalanceBucketCreateBulk {
+	return &BalanceBucketCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BalanceBucketClient) MapCreateBulk(slice any, setFunc func(*BalanceBucketCreate, int)) *BalanceBucketCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BalanceBucketCreateBulk{err: fmt.Errorf("calling to BalanceBucketClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BalanceBucketCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BalanceBucketCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BalanceBucket.
+func (c *BalanceBucketClient) Update() *BalanceBucketUpdate {
+	mutation := newBalanceBucketMutation(c.config, OpUpdate)
+	return &BalanceBucketUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BalanceBucketClient) UpdateOne(_m *BalanceBucket) *BalanceBucketUpdateOne {
+	mutation := newBalanceBucketMutation(c.config, OpUpdateOne, withBalanceBucket(_m))
+	return &BalanceBucketUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BalanceBucketClient) UpdateOneID(id int64) *BalanceBucketUpdateOne {
+	mutation := newBalanceBucketMutation(c.config, OpUpdateOne, withBalanceBucketID(id))
+	return &BalanceBucketUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BalanceBucket.
+func (c *BalanceBucketClient) Delete() *BalanceBucketDelete {
+	mutation := newBalanceBucketMutation(c.config, OpDelete)
+	return &BalanceBucketDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BalanceBucketClient) DeleteOne(_m *BalanceBucket) *BalanceBucketDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BalanceBucketClient) DeleteOneID(id int64) *BalanceBucketDeleteOne {
+	builder := c.Delete().Where(balancebucket.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BalanceBucketDeleteOne{builder}
+}
+
+// Query returns a query builder for BalanceBucket.
+func (c *BalanceBucketClient) Query() *BalanceBucketQuery {
+	return &BalanceBucketQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBalanceBucket},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BalanceBucket entity by its id.
+func (c *BalanceBucketClient) Get(ctx context.Context, id int64) (*BalanceBucket, error) {
+	return c.Query().Where(balancebucket.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BalanceBucketClient) GetX(ctx context.Context, id int64) *BalanceBucket {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BalanceBucketClient) Hooks() []Hook {
+	return c.hooks.BalanceBucket
+}
+
+// Interceptors returns the client interceptors.
+func (c *BalanceBucketClient) Interceptors() []Interceptor {
+	return c.inters.BalanceBucket
+}
+
+func (c *BalanceBucketClient) mutate(ctx context.Context, m *BalanceBucketMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BalanceBucketCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BalanceBucketUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BalanceBucketUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BalanceBucketDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown BalanceBucket mutation op: %q", m.Op())
+	}
+}
+
+// BillingAdjustmentClient is a client for the BillingAdjustment schema.
+type BillingAdjustmentClient struct {
+	config
+}
+
+// NewBillingAdjustmentClient returns a client for the BillingAdjustment from the given config.
+func NewBillingAdjustmentClient(c config) *BillingAdjustmentClient {
+	return &BillingAdjustmentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `billingadjustment.Hooks(f(g(h())))`.
+func (c *BillingAdjustmentClient) Use(hooks ...Hook) {
+	c.hooks.BillingAdjustment = append(c.hooks.BillingAdjustment, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `billingadjustment.Intercept(f(g(h())))`.
+func (c *BillingAdjustmentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BillingAdjustment = append(c.inters.BillingAdjustment, interceptors...)
+}
+
+// Create returns a builder for creating a BillingAdjustment entity.
+func (c *BillingAdjustmentClient) Create() *BillingAdjustmentCreate {
+	mutation := newBillingAdjustmentMutation(c.config, OpCreate)
+	return &BillingAdjustmentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BillingAdjustment entities.
+func (c *BillingAdjustmentClient) CreateBulk(builders ...*BillingAdjustmentCreate) *BillingAdjustmentCreateBulk {
+	return &BillingAdjustmentCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BillingAdjustmentClient) MapCreateBulk(slice any, setFunc func(*BillingAdjustmentCreate, int)) *BillingAdjustmentCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BillingAdjustmentCreateBulk{err: fmt.Errorf("calling to BillingAdjustmentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BillingAdjustmentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BillingAdjustmentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BillingAdjustment.
+func (c *BillingAdjustmentClient) Update() *BillingAdjustmentUpdate {
+	mutation := newBillingAdjustmentMutation(c.config, OpUpdate)
+	return &BillingAdjustmentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BillingAdjustmentClient) UpdateOne(_m *BillingAdjustment) *BillingAdjustmentUpdateOne {
+	mutation := newBillingAdjustmentMutation(c.config, OpUpdateOne, withBillingAdjustment(_m))
+	return &BillingAdjustmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BillingAdjustmentClient) UpdateOneID(id int64) *BillingAdjustmentUpdateOne {
+	mutation := newBillingAdjustmentMutation(c.config, OpUpdateOne, withBillingAdjustmentID(id))
+	return &BillingAdjustmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BillingAdjustment.
+func (c *BillingAdjustmentClient) Delete() *BillingAdjustmentDelete {
+	mutation := newBillingAdjustmentMutation(c.config, OpDelete)
+	return &BillingAdjustmentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BillingAdjustmentClient) DeleteOne(_m *BillingAdjustment) *BillingAdjustmentDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BillingAdjustmentClient) DeleteOneID(id int64) *BillingAdjustmentDeleteOne {
+	builder := c.Delete().Where(billingadjustment.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BillingAdjustmentDeleteOne{builder}
+}
+
+// Query returns a query builder for BillingAdjustment.
+func (c *BillingAdjustmentClient) Query() *BillingAdjustmentQuery {
+	return &BillingAdjustmentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBillingAdjustment},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BillingAdjustment entity by its id.
+func (c *BillingAdjustmentClient) Get(ctx context.Context, id int64) (*BillingAdjustment, error) {
+	return c.Query().Where(billingadjustment.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BillingAdjustmentClient) GetX(ctx context.Context, id int64) *BillingAdjustment {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BillingAdjustmentClient) Hooks() []Hook {
+	return c.hooks.BillingAdjustment
+}
+
+// Interceptors returns the client interceptors.
+func (c *BillingAdjustmentClient) Interceptors() []Interceptor {
+	return c.inters.BillingAdjustment
+}
+
+func (c *BillingAdjustmentClient) mutate(ctx context.Context, m *BillingAdjustmentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BillingAdjustmentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BillingAdjustmentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BillingAdjustmentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BillingAdjustmentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown BillingAdjustment mutation op: %q", m.Op())
+	}
+}
+
+// BillingRecomputeJobClient is a client for the BillingRecomputeJob schema.
+type BillingRecomputeJobClient struct {
+	config
+}
+
+// NewBillingRecomputeJobClient returns a client for the BillingRecomputeJob from the given config.
+func NewBillingRecomputeJobClient(c config) *BillingRecomputeJobClient {
+	return &BillingRecomputeJobClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `billingrecomputejob.Hooks(f(g(h())))`.
+func (c *BillingRecomputeJobClient) Use(hooks ...Hook) {
+	c.hooks.BillingRecomputeJob = append(c.hooks.BillingRecomputeJob, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `billingrecomputejob.Intercept(f(g(h())))`.
+func (c *BillingRecomputeJobClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BillingRecomputeJob = append(c.inters.BillingRecomputeJob, interceptors...)
+}
+
+// Create returns a builder for creating a BillingRecomputeJob entity.
+func (c *BillingRecomputeJobClient) Create() *BillingRecomputeJobCreate {
+	mutation := newBillingRecomputeJobMutation(c.config, OpCreate)
+	return &BillingRecomputeJobCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BillingRecomputeJob entities.
+func (c *BillingRecomputeJobClient) CreateBulk(builders ...*BillingRecomputeJobCreate) *BillingRecomputeJobCreateBulk {
+	return &BillingRecomputeJobCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BillingRecomputeJobClient) MapCreateBulk(slice any, setFunc func(*BillingRecomputeJobCreate, int)) *BillingRecomputeJobCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BillingRecomputeJobCreateBulk{err: fmt.Errorf("calling to BillingRecomputeJobClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BillingRecomputeJobCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BillingRecomputeJobCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BillingRecomputeJob.
+func (c *BillingRecomputeJobClient) Update() *BillingRecomputeJobUpdate {
+	mutation := newBillingRecomputeJobMutation(c.config, OpUpdate)
+	return &BillingRecomputeJobUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BillingRecomputeJobClient) UpdateOne(_m *BillingRecomputeJob) *BillingRecomputeJobUpdateOne {
+	mutation := newBillingRecomputeJobMutation(c.config, OpUpdateOne, withBillingRecomputeJob(_m))
+	return &BillingRecomputeJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BillingRecomputeJobClient) UpdateOneID(id int64) *BillingRecomputeJobUpdateOne {
+	mutation := newBillingRecomputeJobMutation(c.config, OpUpdateOne, withBillingRecomputeJobID(id))
+	return &BillingRecomputeJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BillingRecomputeJob.
+func (c *BillingRecomputeJobClient) Delete() *BillingRecomputeJobDelete {
+	mutation := newBillingRecomputeJobMutation(c.config, OpDelete)
+	return &BillingRecomputeJobDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BillingRecomputeJobClient) DeleteOne(_m *BillingRecomputeJob) *BillingRecomputeJobDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BillingRecomputeJobClient) DeleteOneID(id int64) *BillingRecomputeJobDeleteOne {
+	builder := c.Delete().Where(billingrecomputejob.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BillingRecomputeJobDeleteOne{builder}
+}
+
+// Query returns a query builder for BillingRecomputeJob.
+func (c *BillingRecomputeJobClient) Query() *BillingRecomputeJobQuery {
+	return &BillingRecomputeJobQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBillingRecomputeJob},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BillingRecomputeJob entity by its id.
+func (c *BillingRecomputeJobClient) Get(ctx context.Context, id int64) (*BillingRecomputeJob, error) {
+	return c.Query().Where(billingrecomputejob.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BillingRecomputeJobClient) GetX(ctx context.Context, id int64) *BillingRecomputeJob {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BillingRecomputeJobClient) Hooks() []Hook {
+	return c.hooks.BillingRecomputeJob
+}
+
+// Interceptors returns the client interceptors.
+func (c *BillingRecomputeJobClient) Interceptors() []Interceptor {
+	return c.inters.BillingRecomputeJob
+}
+
+func (c *BillingRecomputeJobClient) mutate(ctx context.Context, m *BillingRecomputeJobMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BillingRecomputeJobCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BillingRecomputeJobUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BillingRecomputeJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BillingRecomputeJobDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown BillingRecomputeJob mutation op: %q", m.Op())
+	}
+}
+
+// BillingStatementClient is a client for the BillingStatement schema.
+type BillingStatementClient struct {
+	config
+}
+
+// NewBillingStatementClient returns a client for the BillingStatement from the given config.
+func NewBillingStatementClient(c config) *BillingStatementClient {
+	return &BillingStatementClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `billingstatement.Hooks(f(g(h())))`.
+func (c *BillingStatementClient) Use(hooks ...Hook) {
+	c.hooks.BillingStatement = append(c.hooks.BillingStatement, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `billingstatement.Intercept(f(g(h())))`.
+func (c *BillingStatementClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BillingStatement = append(c.inters.BillingStatement, interceptors...)
+}
+
+// Create returns a builder for creating a BillingStatement entity.
+func (c *BillingStatementClient) Create() *BillingStatementCreate {
+	mutation := newBillingStatementMutation(c.config, OpCreate)
+	return &BillingStatementCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BillingStatement entities.
+func (c *BillingStatementClient) CreateBulk(builders ...*BillingStatementCreate) *BillingStatementCreateBulk {
+	return &BillingStatementCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BillingStatementClient) MapCreateBulk(slice any, setFunc func(*BillingStatementCreate, int)) *BillingStatementCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BillingStatementCreateBulk{err: fmt.Errorf("calling to BillingStatementClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BillingStatementCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BillingStatementCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BillingStatement.
+func (c *BillingStatementClient) Update() *BillingStatementUpdate {
+	mutation := newBillingStatementMutation(c.config, OpUpdate)
+	return &BillingStatementUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BillingStatementClient) UpdateOne(_m *BillingStatement) *BillingStatementUpdateOne {
+	mutation := newBillingStatementMutation(c.config, OpUpdateOne, withBillingStatement(_m))
+	return &BillingStatementUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BillingStatementClient) UpdateOneID(id int64) *BillingStatementUpdateOne {
+	mutation := newBillingStatementMutation(c.config, OpUpdateOne, withBillingStatementID(id))
+	return &BillingStatementUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BillingStatement.
+func (c *BillingStatementClient) Delete() *BillingStatementDelete {
+	mutation := newBillingStatementMutation(c.config, OpDelete)
+	return &BillingStatementDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BillingStatementClient) DeleteOne(_m *BillingStatement) *BillingStatementDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BillingStatementClient) DeleteOneID(id int64) *BillingStatementDeleteOne {
+	builder := c.Delete().Where(billingstatement.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BillingStatementDeleteOne{builder}
+}
+
+// Query returns a query builder for BillingStatement.
+func (c *BillingStatementClient) Query() *BillingStatementQuery {
+	return &BillingStatementQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBillingStatement},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BillingStatement entity by its id.
+func (c *BillingStatementClient) Get(ctx context.Context, id int64) (*BillingStatement, error) {
+	return c.Query().Where(billingstatement.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BillingStatementClient) GetX(ctx context.Context, id int64) *BillingStatement {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BillingStatementClient) Hooks() []Hook {
+	return c.hooks.BillingStatement
+}
+
+// Interceptors returns the client interceptors.
+func (c *BillingStatementClient) Interceptors() []Interceptor {
+	return c.inters.BillingStatement
+}
+
+func (c *BillingStatementClient) mutate(ctx context.Context, m *BillingStatementMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BillingStatementCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BillingStatementUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BillingStatementUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BillingStatementDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown BillingStatement mutation op: %q", m.Op())
+	}
+}
+
 // ChannelMonitorClient is a client for the ChannelMonitor schema.
 type ChannelMonitorClient struct {
 	config
@@ -1901,6 +2571,272 @@ func (c *ChannelMonitorRequestTemplateClient) mutate(ctx context.Context, m *Cha
 	}
 }
 
+// CommissionWithdrawalClient is a client for the CommissionWithdrawal schema.
+type CommissionWithdrawalClient struct {
+	config
+}
+
+// NewCommissionWithdrawalClient returns a client for the CommissionWithdrawal from the given config.
+func NewCommissionWithdrawalClient(c config) *CommissionWithdrawalClient {
+	return &CommissionWithdrawalClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `commissionwithdrawal.Hooks(f(g(h())))`.
+func (c *CommissionWithdrawalClient) Use(hooks ...Hook) {
+	c.hooks.CommissionWithdrawal = append(c.hooks.CommissionWithdrawal, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `commissionwithdrawal.Intercept(f(g(h())))`.
+func (c *CommissionWithdrawalClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CommissionWithdrawal = append(c.inters.CommissionWithdrawal, interceptors...)
+}
+
+// Create returns a builder for creating a CommissionWithdrawal entity.
+func (c *CommissionWithdrawalClient) Create() *CommissionWithdrawalCreate {
+	mutation := newCommissionWithdrawalMutation(c.config, OpCreate)
+	return &CommissionWithdrawalCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CommissionWithdrawal entities.
+func (c *CommissionWithdrawalClient) CreateBulk(builders ...*CommissionWithdrawalCreate) *CommissionWithdrawalCreateBulk {
+	return &CommissionWithdrawalCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CommissionWithdrawalClient) MapCreateBulk(slice any, setFunc func(*CommissionWithdrawalCreate, int)) *CommissionWithdrawalCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CommissionWithdrawalCreateBulk{err: fmt.Errorf("calling to CommissionWithdrawalClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CommissionWithdrawalCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CommissionWithdrawalCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CommissionWithdrawal.
+func (c *CommissionWithdrawalClient) Update() *CommissionWithdrawalUpdate {
+	mutation := newCommissionWithdrawalMutation(c.config, OpUpdate)
+	return &CommissionWithdrawalUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CommissionWithdrawalClient) UpdateOne(_m *CommissionWithdrawal) *CommissionWithdrawalUpdateOne {
+	mutation := newCommissionWithdrawalMutation(c.config, OpUpdateOne, withCommissionWithdrawal(_m))
+	return &CommissionWithdrawalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CommissionWithdrawalClient) UpdateOneID(id int64) *CommissionWithdrawalUpdateOne {
+	mutation := newCommissionWithdrawalMutation(c.config, OpUpdateOne, withCommissionWithdrawalID(id))
+	return &CommissionWithdrawalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CommissionWithdrawal.
+func (c *CommissionWithdrawalClient) Delete() *CommissionWithdrawalDelete {
+	mutation := newCommissionWithdrawalMutation(c.config, OpDelete)
+	return &CommissionWithdrawalDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CommissionWithdrawalClient) DeleteOne(_m *CommissionWithdrawal) *CommissionWithdrawalDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CommissionWithdrawalClient) DeleteOneID(id int64) *CommissionWithdrawalDeleteOne {
+	builder := c.Delete().Where(commissionwithdrawal.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CommissionWithdrawalDeleteOne{builder}
+}
+
+// Query returns a query builder for CommissionWithdrawal.
+func (c *CommissionWithdrawalClient) Query() *CommissionWithdrawalQuery {
+	return &CommissionWithdrawalQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCommissionWithdrawal},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CommissionWithdrawal entity by its id.
+func (c *CommissionWithdrawalClient) Get(ctx context.Context, id int64) (*CommissionWithdrawal, error) {
+	return c.Query().Where(commissionwithdrawal.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CommissionWithdrawalClient) GetX(ctx context.Context, id int64) *CommissionWithdrawal {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *CommissionWithdrawalClient) Hooks() []Hook {
+	return c.hooks.CommissionWithdrawal
+}
+
+// Interceptors returns the client interceptors.
+func (c *CommissionWithdrawalClient) Interceptors() []Interceptor {
+	return c.inters.CommissionWithdrawal
+}
+
+func (c *CommissionWithdrawalClient) mutate(ctx context.Context, m *CommissionWithdrawalMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CommissionWithdrawalCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CommissionWithdrawalUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CommissionWithdrawalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CommissionWithdrawalDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CommissionWithdrawal mutation op: %q", m.Op())
+	}
+}
+
+// CommissionWithdrawalLogClient is a client for the CommissionWithdrawalLog schema.
+type CommissionWithdrawalLogClient struct {
+	config
+}
+
+// NewCommissionWithdrawalLogClient returns a client for the CommissionWithdrawalLog from the given config.
+func NewCommissionWithdrawalLogClient(c config) *CommissionWithdrawalLogClient {
+	return &CommissionWithdrawalLogClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `commissionwithdrawallog.Hooks(f(g(h())))`.
+func (c *CommissionWithdrawalLogClient) Use(hooks ...Hook) {
+	c.hooks.CommissionWithdrawalLog = append(c.hooks.CommissionWithdrawalLog, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `commissionwithdrawallog.Intercept(f(g(h())))`.
+func (c *CommissionWithdrawalLogClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CommissionWithdrawalLog = append(c.inters.CommissionWithdrawalLog, interceptors...)
+}
+
+// Create returns a builder for creating a CommissionWithdrawalLog entity.
+func (c *CommissionWithdrawalLogClient) Create() *CommissionWithdrawalLogCreate {
+	mutation := newCommissionWithdrawalLogMutation(c.config, OpCreate)
+	return &CommissionWithdrawalLogCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CommissionWithdrawalLog entities.
+func (c *CommissionWithdrawalLogClient) CreateBulk(builders ...*CommissionWithdrawalLogCreate) *CommissionWithdrawalLogCreateBulk {
+	return &CommissionWithdrawalLogCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CommissionWithdrawalLogClient) MapCreateBulk(slice any, setFunc func(*CommissionWithdrawalLogCreate, int)) *CommissionWithdrawalLogCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CommissionWithdrawalLogCreateBulk{err: fmt.Errorf("calling to CommissionWithdrawalLogClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CommissionWithdrawalLogCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CommissionWithdrawalLogCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CommissionWithdrawalLog.
+func (c *CommissionWithdrawalLogClient) Update() *CommissionWithdrawalLogUpdate {
+	mutation := newCommissionWithdrawalLogMutation(c.config, OpUpdate)
+	return &CommissionWithdrawalLogUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CommissionWithdrawalLogClient) UpdateOne(_m *CommissionWithdrawalLog) *CommissionWithdrawalLogUpdateOne {
+	mutation := newCommissionWithdrawalLogMutation(c.config, OpUpdateOne, withCommissionWithdrawalLog(_m))
+	return &CommissionWithdrawalLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CommissionWithdrawalLogClient) UpdateOneID(id int64) *CommissionWithdrawalLogUpdateOne {
+	mutation := newCommissionWithdrawalLogMutation(c.config, OpUpdateOne, withCommissionWithdrawalLogID(id))
+	return &CommissionWithdrawalLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CommissionWithdrawalLog.
+func (c *CommissionWithdrawalLogClient) Delete() *CommissionWithdrawalLogDelete {
+	mutation := newCommissionWithdrawalLogMutation(c.config, OpDelete)
+	return &CommissionWithdrawalLogDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CommissionWithdrawalLogClient) DeleteOne(_m *CommissionWithdrawalLog) *CommissionWithdrawalLogDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CommissionWithdrawalLogClient) DeleteOneID(id int64) *CommissionWithdrawalLogDeleteOne {
+	builder := c.Delete().Where(commissionwithdrawallog.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CommissionWithdrawalLogDeleteOne{builder}
+}
+
+// Query returns a query builder for CommissionWithdrawalLog.
+func (c *CommissionWithdrawalLogClient) Query() *CommissionWithdrawalLogQuery {
+	return &CommissionWithdrawalLogQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCommissionWithdrawalLog},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CommissionWithdrawalLog entity by its id.
+func (c *CommissionWithdrawalLogClient) Get(ctx context.Context, id int64) (*CommissionWithdrawalLog, error) {
+	return c.Query().Where(commissionwithdrawallog.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CommissionWithdrawalLogClient) GetX(ctx context.Context, id int64) *CommissionWithdrawalLog {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *CommissionWithdrawalLogClient) Hooks() []Hook {
+	return c.hooks.CommissionWithdrawalLog
+}
+
+// Interceptors returns the client interceptors.
+func (c *CommissionWithdrawalLogClient) Interceptors() []Interceptor {
+	return c.inters.CommissionWithdrawalLog
+}
+
+func (c *CommissionWithdrawalLogClient) mutate(ctx context.Context, m *CommissionWithdrawalLogMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CommissionWithdrawalLogCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CommissionWithdrawalLogUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CommissionWithdrawalLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CommissionWithdrawalLogDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CommissionWithdrawalLog mutation op: %q", m.Op())
+	}
+}
+
 // ErrorPassthroughRuleClient is a client for the ErrorPassthroughRule schema.
 type ErrorPassthroughRuleClient struct {
 	config
@@ -1967,41 +2903,174 @@ func (c *ErrorPassthroughRuleClient) UpdateOneID(id int64) *ErrorPassthroughRule
 	return &ErrorPassthroughRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for ErrorPassthroughRule.
-func (c *ErrorPassthroughRuleClient) Delete() *ErrorPassthroughRuleDelete {
-	mutation := newErrorPassthroughRuleMutation(c.config, OpDelete)
-	return &ErrorPassthroughRuleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ErrorPassthroughRule.
+func (c *ErrorPassthroughRuleClient) Delete() *ErrorPassthroughRuleDelete {
+	mutation := newErrorPassthroughRuleMutation(c.config, OpDelete)
+	return &ErrorPassthroughRuleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ErrorPassthroughRuleClient) DeleteOne(_m *ErrorPassthroughRule) *ErrorPassthroughRuleDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ErrorPassthroughRuleClient) DeleteOneID(id int64) *ErrorPassthroughRuleDeleteOne {
+	builder := c.Delete().Where(errorpassthroughrule.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ErrorPassthroughRuleDeleteOne{builder}
+}
+
+// Query returns a query builder for ErrorPassthroughRule.
+func (c *ErrorPassthroughRuleClient) Query() *ErrorPassthroughRuleQuery {
+	return &ErrorPassthroughRuleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeErrorPassthroughRule},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ErrorPassthroughRule entity by its id.
+func (c *ErrorPassthroughRuleClient) Get(ctx context.Context, id int64) (*ErrorPassthroughRule, error) {
+	return c.Query().Where(errorpassthroughrule.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ErrorPassthroughRuleClient) GetX(ctx context.Context, id int64) *ErrorPassthroughRule {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ErrorPassthroughRuleClient) Hooks() []Hook {
+	return c.hooks.ErrorPassthroughRule
+}
+
+// Interceptors returns the client interceptors.
+func (c *ErrorPassthroughRuleClient) Interceptors() []Interceptor {
+	return c.inters.ErrorPassthroughRule
+}
+
+func (c *ErrorPassthroughRuleClient) mutate(ctx context.Context, m *ErrorPassthroughRuleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ErrorPassthroughRuleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ErrorPassthroughRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ErrorPassthroughRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ErrorPassthroughRuleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ErrorPassthroughRule mutation op: %q", m.Op())
+	}
+}
+
+// GatewayPluginClient is a client for the GatewayPlugin schema.
+type GatewayPluginClient struct {
+	config
+}
+
+// NewGatewayPluginClient returns a client for the GatewayPlugin from the given config.
+func NewGatewayPluginClient(c config) *GatewayPluginClient {
+	return &GatewayPluginClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `gatewayplugin.Hooks(f(g(h())))`.
+func (c *GatewayPluginClient) Use(hooks ...Hook) {
+	c.hooks.GatewayPlugin = append(c.hooks.GatewayPlugin, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `gatewayplugin.Intercept(f(g(h())))`.
+func (c *GatewayPluginClient) Intercept(interceptors ...Interceptor) {
+	c.inters.GatewayPlugin = append(c.inters.GatewayPlugin, interceptors...)
+}
+
+// Create returns a builder for creating a GatewayPlugin entity.
+func (c *GatewayPluginClient) Create() *GatewayPluginCreate {
+	mutation := newGatewayPluginMutation(c.config, OpCreate)
+	return &GatewayPluginCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of GatewayPlugin entities.
+func (c *GatewayPluginClient) CreateBulk(builders ...*GatewayPluginCreate) *GatewayPluginCreateBulk {
+	return &GatewayPluginCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *GatewayPluginClient) MapCreateBulk(slice any, setFunc func(*GatewayPluginCreate, int)) *GatewayPluginCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &GatewayPluginCreateBulk{err: fmt.Errorf("calling to GatewayPluginClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*GatewayPluginCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &GatewayPluginCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for GatewayPlugin.
+func (c *GatewayPluginClient) Update() *GatewayPluginUpdate {
+	mutation := newGatewayPluginMutation(c.config, OpUpdate)
+	return &GatewayPluginUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *GatewayPluginClient) UpdateOne(_m *GatewayPlugin) *GatewayPluginUpdateOne {
+	mutation := newGatewayPluginMutation(c.config, OpUpdateOne, withGatewayPlugin(_m))
+	return &GatewayPluginUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *GatewayPluginClient) UpdateOneID(id int64) *GatewayPluginUpdateOne {
+	mutation := newGatewayPluginMutation(c.config, OpUpdateOne, withGatewayPluginID(id))
+	return &GatewayPluginUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for GatewayPlugin.
+func (c *GatewayPluginClient) Delete() *GatewayPluginDelete {
+	mutation := newGatewayPluginMutation(c.config, OpDelete)
+	return &GatewayPluginDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ErrorPassthroughRuleClient) DeleteOne(_m *ErrorPassthroughRule) *ErrorPassthroughRuleDeleteOne {
+func (c *GatewayPluginClient) DeleteOne(_m *GatewayPlugin) *GatewayPluginDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ErrorPassthroughRuleClient) DeleteOneID(id int64) *ErrorPassthroughRuleDeleteOne {
-	builder := c.Delete().Where(errorpassthroughrule.ID(id))
+func (c *GatewayPluginClient) DeleteOneID(id int64) *GatewayPluginDeleteOne {
+	builder := c.Delete().Where(gatewayplugin.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ErrorPassthroughRuleDeleteOne{builder}
+	return &GatewayPluginDeleteOne{builder}
 }
 
-// Query returns a query builder for ErrorPassthroughRule.
-func (c *ErrorPassthroughRuleClient) Query() *ErrorPassthroughRuleQuery {
-	return &ErrorPassthroughRuleQuery{
+// Query returns a query builder for GatewayPlugin.
+func (c *GatewayPluginClient) Query() *GatewayPluginQuery {
+	return &GatewayPluginQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeErrorPassthroughRule},
+		ctx:    &QueryContext{Type: TypeGatewayPlugin},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a ErrorPassthroughRule entity by its id.
-func (c *ErrorPassthroughRuleClient) Get(ctx context.Context, id int64) (*ErrorPassthroughRule, error) {
-	return c.Query().Where(errorpassthroughrule.ID(id)).Only(ctx)
+// Get returns a GatewayPlugin entity by its id.
+func (c *GatewayPluginClient) Get(ctx context.Context, id int64) (*GatewayPlugin, error) {
+	return c.Query().Where(gatewayplugin.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ErrorPassthroughRuleClient) GetX(ctx context.Context, id int64) *ErrorPassthroughRule {
+func (c *GatewayPluginClient) GetX(ctx context.Context, id int64) *GatewayPlugin {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2010,27 +3079,27 @@ func (c *ErrorPassthroughRuleClient) GetX(ctx context.Context, id int64) *ErrorP
 }
 
 // Hooks returns the client hooks.
-func (c *ErrorPassthroughRuleClient) Hooks() []Hook {
-	return c.hooks.ErrorPassthroughRule
+func (c *GatewayPluginClient) Hooks() []Hook {
+	return c.hooks.GatewayPlugin
 }
 
 // Interceptors returns the client interceptors.
-func (c *ErrorPassthroughRuleClient) Interceptors() []Interceptor {
-	return c.inters.ErrorPassthroughRule
+func (c *GatewayPluginClient) Interceptors() []Interceptor {
+	return c.inters.GatewayPlugin
 }
 
-func (c *ErrorPassthroughRuleClient) mutate(ctx context.Context, m *ErrorPassthroughRuleMutation) (Value, error) {
+func (c *GatewayPluginClient) mutate(ctx context.Context, m *GatewayPluginMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ErrorPassthroughRuleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&GatewayPluginCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ErrorPassthroughRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&GatewayPluginUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ErrorPassthroughRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&GatewayPluginUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ErrorPassthroughRuleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&GatewayPluginDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown ErrorPassthroughRule mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown GatewayPlugin mutation op: %q", m.Op())
 	}
 }
 
@@ -2297,6 +3366,139 @@ func (c *GroupClient) mutate(ctx context.Context, m *GroupMutation) (Value, erro
 	}
 }
 
+// GuardrailRuleClient is a client for the GuardrailRule schema.
+type GuardrailRuleClient struct {
+	config
+}
+
+// NewGuardrailRuleClient returns a client for the GuardrailRule from the given config.
+func NewGuardrailRuleClient(c config) *GuardrailRuleClient {
+	return &GuardrailRuleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `guardrailrule.Hooks(f(g(h())))`.
+func (c *GuardrailRuleClient) Use(hooks ...Hook) {
+	c.hooks.GuardrailRule = append(c.hooks.GuardrailRule, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `guardrailrule.Intercept(f(g(h())))`.
+func (c *GuardrailRuleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.GuardrailRule = append(c.inters.GuardrailRule, interceptors...)
+}
+
+// Create returns a builder for creating a GuardrailRule entity.
+func (c *GuardrailRuleClient) Create() *GuardrailRuleCreate {
+	mutation := newGuardrailRuleMutation(c.config, OpCreate)
+	return &GuardrailRuleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of GuardrailRule entities.
+func (c *GuardrailRuleClient) CreateBulk(builders ...*GuardrailRuleCreate) *GuardrailRuleCreateBulk {
+	return &GuardrailRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *GuardrailRuleClient) MapCreateBulk(slice any, setFunc func(*GuardrailRuleCreate, int)) *GuardrailRuleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &GuardrailRuleCreateBulk{err: fmt.Errorf("calling to GuardrailRuleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*GuardrailRuleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &GuardrailRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for GuardrailRule.
+func (c *GuardrailRuleClient) Update() *GuardrailRuleUpdate {
+	mutation := newGuardrailRuleMutation(c.config, OpUpdate)
+	return &GuardrailRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *GuardrailRuleClient) UpdateOne(_m *GuardrailRule) *GuardrailRuleUpdateOne {
+	mutation := newGuardrailRuleMutation(c.config, OpUpdateOne, withGuardrailRule(_m))
+	return &GuardrailRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *GuardrailRuleClient) UpdateOneID(id int64) *GuardrailRuleUpdateOne {
+	mutation := newGuardrailRuleMutation(c.config, OpUpdateOne, withGuardrailRuleID(id))
+	return &GuardrailRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for GuardrailRule.
+func (c *GuardrailRuleClient) Delete() *GuardrailRuleDelete {
+	mutation := newGuardrailRuleMutation(c.config, OpDelete)
+	return &GuardrailRuleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *GuardrailRuleClient) DeleteOne(_m *GuardrailRule) *GuardrailRuleDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *GuardrailRuleClient) DeleteOneID(id int64) *GuardrailRuleDeleteOne {
+	builder := c.Delete().Where(guardrailrule.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &GuardrailRuleDeleteOne{builder}
+}
+
+// Query returns a query builder for GuardrailRule.
+func (c *GuardrailRuleClient) Query() *GuardrailRuleQuery {
+	return &GuardrailRuleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeGuardrailRule},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a GuardrailRule entity by its id.
+func (c *GuardrailRuleClient) Get(ctx context.Context, id int64) (*GuardrailRule, error) {
+	return c.Query().Where(guardrailrule.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *GuardrailRuleClient) GetX(ctx context.Context, id int64) *GuardrailRule {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *GuardrailRuleClient) Hooks() []Hook {
+	return c.hooks.GuardrailRule
+}
+
+// Interceptors returns the client interceptors.
+func (c *GuardrailRuleClient) Interceptors() []Interceptor {
+	return c.inters.GuardrailRule
+}
+
+func (c *GuardrailRuleClient) mutate(ctx context.Context, m *GuardrailRuleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&GuardrailRuleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&GuardrailRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&GuardrailRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&GuardrailRuleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown GuardrailRule mutation op: %q", m.Op())
+	}
+}
+
 // IdempotencyRecordClient is a client for the IdempotencyRecord schema.
 type IdempotencyRecordClient struct {
 	config
@@ -2420,13 +3622,279 @@ func (c *IdempotencyRecordClient) mutate(ctx context.Context, m *IdempotencyReco
 	case OpCreate:
 		return (&IdempotencyRecordCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&IdempotencyRecordUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&IdempotencyRecordUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&IdempotencyRecordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&IdempotencyRecordDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown IdempotencyRecord mutation op: %q", m.Op())
+	}
+}
+
+// InvoiceClient is a client for the Invoice schema.
+type InvoiceClient struct {
+	config
+}
+
+// NewInvoiceClient returns a client for the Invoice from the given config.
+func NewInvoiceClient(c config) *InvoiceClient {
+	return &InvoiceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `invoice.Hooks(f(g(h())))`.
+func (c *InvoiceClient) Use(hooks ...Hook) {
+	c.hooks.Invoice = append(c.hooks.Invoice, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `invoice.Intercept(f(g(h())))`.
+func (c *InvoiceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Invoice = append(c.inters.Invoice, interceptors...)
+}
+
+// Create returns a builder for creating a Invoice entity.
+func (c *InvoiceClient) Create() *InvoiceCreate {
+	mutation := newInvoiceMutation(c.config, OpCreate)
+	return &InvoiceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Invoice entities.
+func (c *InvoiceClient) CreateBulk(builders ...*InvoiceCreate) *InvoiceCreateBulk {
+	return &InvoiceCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *InvoiceClient) MapCreateBulk(slice any, setFunc func(*InvoiceCreate, int)) *InvoiceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &InvoiceCreateBulk{err: fmt.Errorf("calling to InvoiceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*InvoiceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &InvoiceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Invoice.
+func (c *InvoiceClient) Update() *InvoiceUpdate {
+	mutation := newInvoiceMutation(c.config, OpUpdate)
+	return &InvoiceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *InvoiceClient) UpdateOne(_m *Invoice) *InvoiceUpdateOne {
+	mutation := newInvoiceMutation(c.config, OpUpdateOne, withInvoice(_m))
+	return &InvoiceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *InvoiceClient) UpdateOneID(id int64) *InvoiceUpdateOne {
+	mutation := newInvoiceMutation(c.config, OpUpdateOne, withInvoiceID(id))
+	return &InvoiceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Invoice.
+func (c *InvoiceClient) Delete() *InvoiceDelete {
+	mutation := newInvoiceMutation(c.config, OpDelete)
+	return &InvoiceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *InvoiceClient) DeleteOne(_m *Invoice) *InvoiceDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *InvoiceClient) DeleteOneID(id int64) *InvoiceDeleteOne {
+	builder := c.Delete().Where(invoice.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &InvoiceDeleteOne{builder}
+}
+
+// Query returns a query builder for Invoice.
+func (c *InvoiceClient) Query() *InvoiceQuery {
+	return &InvoiceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeInvoice},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Invoice entity by its id.
+func (c *InvoiceClient) Get(ctx context.Context, id int64) (*Invoice, error) {
+	return c.Query().Where(invoice.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *InvoiceClient) GetX(ctx context.Context, id int64) *Invoice {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *InvoiceClient) Hooks() []Hook {
+	return c.hooks.Invoice
+}
+
+// Interceptors returns the client interceptors.
+func (c *InvoiceClient) Interceptors() []Interceptor {
+	return c.inters.Invoice
+}
+
+func (c *InvoiceClient) mutate(ctx context.Context, m *InvoiceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&InvoiceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&InvoiceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&InvoiceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&InvoiceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Invoice mutation op: %q", m.Op())
+	}
+}
+
+// InvoiceSequenceClient is a client for the InvoiceSequence schema.
+type InvoiceSequenceClient struct {
+	config
+}
+
+// NewInvoiceSequenceClient returns a client for the InvoiceSequence from the given config.
+func NewInvoiceSequenceClient(c config) *InvoiceSequenceClient {
+	return &InvoiceSequenceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `invoicesequence.Hooks(f(g(h())))`.
+func (c *InvoiceSequenceClient) Use(hooks ...Hook) {
+	c.hooks.InvoiceSequence = append(c.hooks.InvoiceSequence, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `invoicesequence.Intercept(f(g(h())))`.
+func (c *InvoiceSequenceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.InvoiceSequence = append(c.inters.InvoiceSequence, interceptors...)
+}
+
+// Create returns a builder for creating a InvoiceSequence entity.
+func (c *InvoiceSequenceClient) Create() *InvoiceSequenceCreate {
+	mutation := newInvoiceSequenceMutation(c.config, OpCreate)
+	return &InvoiceSequenceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of InvoiceSequence entities.
+func (c *InvoiceSequenceClient) CreateBulk(builders ...*InvoiceSequenceCreate) *InvoiceSequenceCreateBulk {
+	return &InvoiceSequenceCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *InvoiceSequenceClient) MapCreateBulk(slice any, setFunc func(*InvoiceSequenceCreate, int)) *InvoiceSequenceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &InvoiceSequenceCreateBulk{err: fmt.Errorf("calling to InvoiceSequenceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*InvoiceSequenceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &InvoiceSequenceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for InvoiceSequence.
+func (c *InvoiceSequenceClient) Update() *InvoiceSequenceUpdate {
+	mutation := newInvoiceSequenceMutation(c.config, OpUpdate)
+	return &InvoiceSequenceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *InvoiceSequenceClient) UpdateOne(_m *InvoiceSequence) *InvoiceSequenceUpdateOne {
+	mutation := newInvoiceSequenceMutation(c.config, OpUpdateOne, withInvoiceSequence(_m))
+	return &InvoiceSequenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *InvoiceSequenceClient) UpdateOneID(id int64) *InvoiceSequenceUpdateOne {
+	mutation := newInvoiceSequenceMutation(c.config, OpUpdateOne, withInvoiceSequenceID(id))
+	return &InvoiceSequenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for InvoiceSequence.
+func (c *InvoiceSequenceClient) Delete() *InvoiceSequenceDelete {
+	mutation := newInvoiceSequenceMutation(c.config, OpDelete)
+	return &InvoiceSequenceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *InvoiceSequenceClient) DeleteOne(_m *InvoiceSequence) *InvoiceSequenceDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *InvoiceSequenceClient) DeleteOneID(id int64) *InvoiceSequenceDeleteOne {
+	builder := c.Delete().Where(invoicesequence.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &InvoiceSequenceDeleteOne{builder}
+}
+
+// Query returns a query builder for InvoiceSequence.
+func (c *InvoiceSequenceClient) Query() *InvoiceSequenceQuery {
+	return &InvoiceSequenceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeInvoiceSequence},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a InvoiceSequence entity by its id.
+func (c *InvoiceSequenceClient) Get(ctx context.Context, id int64) (*InvoiceSequence, error) {
+	return c.Query().Where(invoicesequence.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *InvoiceSequenceClient) GetX(ctx context.Context, id int64) *InvoiceSequence {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *InvoiceSequenceClient) Hooks() []Hook {
+	return c.hooks.InvoiceSequence
+}
+
+// Interceptors returns the client interceptors.
+func (c *InvoiceSequenceClient) Interceptors() []Interceptor {
+	return c.inters.InvoiceSequence
+}
+
+func (c *InvoiceSequenceClient) mutate(ctx context.Context, m *InvoiceSequenceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&InvoiceSequenceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&InvoiceSequenceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&IdempotencyRecordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&InvoiceSequenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&IdempotencyRecordDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&InvoiceSequenceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown IdempotencyRecord mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown InvoiceSequence mutation op: %q", m.Op())
 	}
 }
 
@@ -2712,6 +4180,139 @@ func (c *PaymentAuditLogClient) mutate(ctx context.Context, m *PaymentAuditLogMu
 	}
 }
 
+// PaymentCouponClient is a client for the PaymentCoupon schema.
+type PaymentCouponClient struct {
+	config
+}
+
+// NewPaymentCouponClient returns a client for the PaymentCoupon from the given config.
+func NewPaymentCouponClient(c config) *PaymentCouponClient {
+	return &PaymentCouponClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `paymentcoupon.Hooks(f(g(h())))`.
+func (c *PaymentCouponClient) Use(hooks ...Hook) {
+	c.hooks.PaymentCoupon = append(c.hooks.PaymentCoupon, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `paymentcoupon.Intercept(f(g(h())))`.
+func (c *PaymentCouponClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PaymentCoupon = append(c.inters.PaymentCoupon, interceptors...)
+}
+
+// Create returns a builder for creating a PaymentCoupon entity.
+func (c *PaymentCouponClient) Create() *PaymentCouponCreate {
+	mutation := newPaymentCouponMutation(c.config, OpCreate)
+	return &PaymentCouponCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PaymentCoupon entities.
+func (c *PaymentCouponClient) CreateBulk(builders ...*PaymentCouponCreate) *PaymentCouponCreateBulk {
+	return &PaymentCouponCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PaymentCouponClient) MapCreateBulk(slice any, setFunc func(*PaymentCouponCreate, int)) *PaymentCouponCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PaymentCouponCreateBulk{err: fmt.Errorf("calling to PaymentCouponClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PaymentCouponCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PaymentCouponCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PaymentCoupon.
+func (c *PaymentCouponClient) Update() *PaymentCouponUpdate {
+	mutation := newPaymentCouponMutation(c.config, OpUpdate)
+	return &PaymentCouponUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PaymentCouponClient) UpdateOne(_m *PaymentCoupon) *PaymentCouponUpdateOne {
+	mutation := newPaymentCouponMutation(c.config, OpUpdateOne, withPaymentCoupon(_m))
+	return &PaymentCouponUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PaymentCouponClient) UpdateOneID(id int64) *PaymentCouponUpdateOne {
+	mutation := newPaymentCouponMutation(c.config, OpUpdateOne, withPaymentCouponID(id))
+	return &PaymentCouponUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PaymentCoupon.
+func (c *PaymentCouponClient) Delete() *PaymentCouponDelete {
+	mutation := newPaymentCouponMutation(c.config, OpDelete)
+	return &PaymentCouponDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PaymentCouponClient) DeleteOne(_m *PaymentCoupon) *PaymentCouponDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PaymentCouponClient) DeleteOneID(id int64) *PaymentCouponDeleteOne {
+	builder := c.Delete().Where(paymentcoupon.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PaymentCouponDeleteOne{builder}
+}
+
+// Query returns a query builder for PaymentCoupon.
+func (c *PaymentCouponClient) Query() *PaymentCouponQuery {
+	return &PaymentCouponQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePaymentCoupon},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PaymentCoupon entity by its id.
+func (c *PaymentCouponClient) Get(ctx context.Context, id int64) (*PaymentCoupon, error) {
+	return c.Query().Where(paymentcoupon.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PaymentCouponClient) GetX(ctx context.Context, id int64) *PaymentCoupon {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *PaymentCouponClient) Hooks() []Hook {
+	return c.hooks.PaymentCoupon
+}
+
+// Interceptors returns the client interceptors.
+func (c *PaymentCouponClient) Interceptors() []Interceptor {
+	return c.inters.PaymentCoupon
+}
+
+func (c *PaymentCouponClient) mutate(ctx context.Context, m *PaymentCouponMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PaymentCouponCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PaymentCouponUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PaymentCouponUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PaymentCouponDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PaymentCoupon mutation op: %q", m.Op())
+	}
+}
+
 // PaymentOrderClient is a client for the PaymentOrder schema.
 type PaymentOrderClient struct {
 	config
@@ -2994,6 +4595,139 @@ func (c *PaymentProviderInstanceClient) mutate(ctx context.Context, m *PaymentPr
 	}
 }
 
+// PostpaidAccountClient is a client for the PostpaidAccount schema.
+type PostpaidAccountClient struct {
+	config
+}
+
+// NewPostpaidAccountClient returns a client for the PostpaidAccount from the given config.
+func NewPostpaidAccountClient(c config) *PostpaidAccountClient {
+	return &PostpaidAccountClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `postpaidaccount.Hooks(f(g(h())))`.
+func (c *PostpaidAccountClient) Use(hooks ...Hook) {
+	c.hooks.PostpaidAccount = append(c.hooks.PostpaidAccount, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `postpaidaccount.Intercept(f(g(h())))`.
+func (c *PostpaidAccountClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PostpaidAccount = append(c.inters.PostpaidAccount, interceptors...)
+}
+
+// Create returns a builder for creating a PostpaidAccount entity.
+func (c *PostpaidAccountClient) Create() *PostpaidAccountCreate {
+	mutation := newPostpaidAccountMutation(c.config, OpCreate)
+	return &PostpaidAccountCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PostpaidAccount entities.
+func (c *PostpaidAccountClient) CreateBulk(builders ...*PostpaidAccountCreate) *PostpaidAccountCreateBulk {
+	return &PostpaidAccountCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PostpaidAccountClient) MapCreateBulk(slice any, setFunc func(*PostpaidAccountCreate, int)) *PostpaidAccountCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PostpaidAccountCreateBulk{err: fmt.Errorf("calling to PostpaidAccountClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PostpaidAccountCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PostpaidAccountCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PostpaidAccount.
+func (c *PostpaidAccountClient) Update() *PostpaidAccountUpdate {
+	mutation := newPostpaidAccountMutation(c.config, OpUpdate)
+	return &PostpaidAccountUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PostpaidAccountClient) UpdateOne(_m *PostpaidAccount) *PostpaidAccountUpdateOne {
+	mutation := newPostpaidAccountMutation(c.config, OpUpdateOne, withPostpaidAccount(_m))
+	return &PostpaidAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PostpaidAccountClient) UpdateOneID(id int64) *PostpaidAccountUpdateOne {
+	mutation := newPostpaidAccountMutation(c.config, OpUpdateOne, withPostpaidAccountID(id))
+	return &PostpaidAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PostpaidAccount.
+func (c *PostpaidAccountClient) Delete() *PostpaidAccountDelete {
+	mutation := newPostpaidAccountMutation(c.config, OpDelete)
+	return &PostpaidAccountDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PostpaidAccountClient) DeleteOne(_m *PostpaidAccount) *PostpaidAccountDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PostpaidAccountClient) DeleteOneID(id int64) *PostpaidAccountDeleteOne {
+	builder := c.Delete().Where(postpaidaccount.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PostpaidAccountDeleteOne{builder}
+}
+
+// Query returns a query builder for PostpaidAccount.
+func (c *PostpaidAccountClient) Query() *PostpaidAccountQuery {
+	return &PostpaidAccountQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePostpaidAccount},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PostpaidAccount entity by its id.
+func (c *PostpaidAccountClient) Get(ctx context.Context, id int64) (*PostpaidAccount, error) {
+	return c.Query().Where(postpaidaccount.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PostpaidAccountClient) GetX(ctx context.Context, id int64) *PostpaidAccount {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *PostpaidAccountClient) Hooks() []Hook {
+	return c.hooks.PostpaidAccount
+}
+
+// Interceptors returns the client interceptors.
+func (c *PostpaidAccountClient) Interceptors() []Interceptor {
+	return c.inters.PostpaidAccount
+}
+
+func (c *PostpaidAccountClient) mutate(ctx context.Context, m *PostpaidAccountMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PostpaidAccountCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PostpaidAccountUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PostpaidAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PostpaidAccountDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PostpaidAccount mutation op: %q", m.Op())
+	}
+}
+
 // PromoCodeClient is a client for the PromoCode schema.
 type PromoCodeClient struct {
 	config
@@ -3408,7 +5142,158 @@ func (c *ProxyClient) Get(ctx context.Context, id int64) (*Proxy, error) {
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ProxyClient) GetX(ctx context.Context, id int64) *Proxy {
+func (c *ProxyClient) GetX(ctx context.Context, id int64) *Proxy {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryAccounts queries the accounts edge of a Proxy.
+func (c *ProxyClient) QueryAccounts(_m *Proxy) *AccountQuery {
+	query := (&AccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(proxy.Table, proxy.FieldID, id),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, proxy.AccountsTable, proxy.AccountsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ProxyClient) Hooks() []Hook {
+	hooks := c.hooks.Proxy
+	return append(hooks[:len(hooks):len(hooks)], proxy.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *ProxyClient) Interceptors() []Interceptor {
+	inters := c.inters.Proxy
+	return append(inters[:len(inters):len(inters)], proxy.Interceptors[:]...)
+}
+
+func (c *ProxyClient) mutate(ctx context.Context, m *ProxyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ProxyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ProxyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ProxyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ProxyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Proxy mutation op: %q", m.Op())
+	}
+}
+
+// ProxyPoolClient is a client for the ProxyPool schema.
+type ProxyPoolClient struct {
+	config
+}
+
+// NewProxyPoolClient returns a client for the ProxyPool from the given config.
+func NewProxyPoolClient(c config) *ProxyPoolClient {
+	return &ProxyPoolClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `proxypool.Hooks(f(g(h())))`.
+func (c *ProxyPoolClient) Use(hooks ...Hook) {
+	c.hooks.ProxyPool = append(c.hooks.ProxyPool, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `proxypool.Intercept(f(g(h())))`.
+func (c *ProxyPoolClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ProxyPool = append(c.inters.ProxyPool, interceptors...)
+}
+
+// Create returns a builder for creating a ProxyPool entity.
+func (c *ProxyPoolClient) Create() *ProxyPoolCreate {
+	mutation := newProxyPoolMutation(c.config, OpCreate)
+	return &ProxyPoolCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ProxyPool entities.
+func (c *ProxyPoolClient) CreateBulk(builders ...*ProxyPoolCreate) *ProxyPoolCreateBulk {
+	return &ProxyPoolCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ProxyPoolClient) MapCreateBulk(slice any, setFunc func(*ProxyPoolCreate, int)) *ProxyPoolCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ProxyPoolCreateBulk{err: fmt.Errorf("calling to ProxyPoolClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ProxyPoolCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ProxyPoolCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ProxyPool.
+func (c *ProxyPoolClient) Update() *ProxyPoolUpdate {
+	mutation := newProxyPoolMutation(c.config, OpUpdate)
+	return &ProxyPoolUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ProxyPoolClient) UpdateOne(_m *ProxyPool) *ProxyPoolUpdateOne {
+	mutation := newProxyPoolMutation(c.config, OpUpdateOne, withProxyPool(_m))
+	return &ProxyPoolUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ProxyPoolClient) UpdateOneID(id int64) *ProxyPoolUpdateOne {
+	mutation := newProxyPoolMutation(c.config, OpUpdateOne, withProxyPoolID(id))
+	return &ProxyPoolUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ProxyPool.
+func (c *ProxyPoolClient) Delete() *ProxyPoolDelete {
+	mutation := newProxyPoolMutation(c.config, OpDelete)
+	return &ProxyPoolDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ProxyPoolClient) DeleteOne(_m *ProxyPool) *ProxyPoolDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ProxyPoolClient) DeleteOneID(id int64) *ProxyPoolDeleteOne {
+	builder := c.Delete().Where(proxypool.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ProxyPoolDeleteOne{builder}
+}
+
+// Query returns a query builder for ProxyPool.
+func (c *ProxyPoolClient) Query() *ProxyPoolQuery {
+	return &ProxyPoolQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeProxyPool},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ProxyPool entity by its id.
+func (c *ProxyPoolClient) Get(ctx context.Context, id int64) (*ProxyPool, error) {
+	return c.Query().Where(proxypool.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ProxyPoolClient) GetX(ctx context.Context, id int64) *ProxyPool {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -3416,46 +5301,28 @@ func (c *ProxyClient) GetX(ctx context.Context, id int64) *Proxy {
 	return obj
 }
 
-// QueryAccounts queries the accounts edge of a Proxy.
-func (c *ProxyClient) QueryAccounts(_m *Proxy) *AccountQuery {
-	query := (&AccountClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(proxy.Table, proxy.FieldID, id),
-			sqlgraph.To(account.Table, account.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, true, proxy.AccountsTable, proxy.AccountsColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *ProxyClient) Hooks() []Hook {
-	hooks := c.hooks.Proxy
-	return append(hooks[:len(hooks):len(hooks)], proxy.Hooks[:]...)
+func (c *ProxyPoolClient) Hooks() []Hook {
+	return c.hooks.ProxyPool
 }
 
 // Interceptors returns the client interceptors.
-func (c *ProxyClient) Interceptors() []Interceptor {
-	inters := c.inters.Proxy
-	return append(inters[:len(inters):len(inters)], proxy.Interceptors[:]...)
+func (c *ProxyPoolClient) Interceptors() []Interceptor {
+	return c.inters.ProxyPool
 }
 
-func (c *ProxyClient) mutate(ctx context.Context, m *ProxyMutation) (Value, error) {
+func (c *ProxyPoolClient) mutate(ctx context.Context, m *ProxyPoolMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ProxyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ProxyPoolCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ProxyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ProxyPoolUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ProxyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ProxyPoolUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ProxyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ProxyPoolDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Proxy mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ProxyPool mutation op: %q", m.Op())
 	}
 }
 
@@ -3624,6 +5491,139 @@ func (c *RedeemCodeClient) mutate(ctx context.Context, m *RedeemCodeMutation) (V
 	}
 }
 
+// ReferralCommissionClient is a client for the ReferralCommission schema.
+type ReferralCommissionClient struct {
+	config
+}
+
+// NewReferralCommissionClient returns a client for the ReferralCommission from the given config.
+func NewReferralCommissionClient(c config) *ReferralCommissionClient {
+	return &ReferralCommissionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `referralcommission.Hooks(f(g(h())))`.
+func (c *ReferralCommissionClient) Use(hooks ...Hook) {
+	c.hooks.ReferralCommission = append(c.hooks.ReferralCommission, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `referralcommission.Intercept(f(g(h())))`.
+func (c *ReferralCommissionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ReferralCommission = append(c.inters.ReferralCommission, interceptors...)
+}
+
+// Create returns a builder for creating a ReferralCommission entity.
+func (c *ReferralCommissionClient) Create() *ReferralCommissionCreate {
+	mutation := newReferralCommissionMutation(c.config, OpCreate)
+	return &ReferralCommissionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ReferralCommission entities.
+func (c *ReferralCommissionClient) CreateBulk(builders ...*ReferralCommissionCreate) *ReferralCommissionCreateBulk {
+	return &ReferralCommissionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ReferralCommissionClient) MapCreateBulk(slice any, setFunc func(*ReferralCommissionCreate, int)) *ReferralCommissionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ReferralCommissionCreateBulk{err: fmt.Errorf("calling to ReferralCommissionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ReferralCommissionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ReferralCommissionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ReferralCommission.
+func (c *ReferralCommissionClient) Update() *ReferralCommissionUpdate {
+	mutation := newReferralCommissionMutation(c.config, OpUpdate)
+	return &ReferralCommissionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ReferralCommissionClient) UpdateOne(_m *ReferralCommission) *ReferralCommissionUpdateOne {
+	mutation := newReferralCommissionMutation(c.config, OpUpdateOne, withReferralCommission(_m))
+	return &ReferralCommissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ReferralCommissionClient) UpdateOneID(id int64) *ReferralCommissionUpdateOne {
+	mutation := newReferralCommissionMutation(c.config, OpUpdateOne, withReferralCommissionID(id))
+	return &ReferralCommissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ReferralCommission.
+func (c *ReferralCommissionClient) Delete() *ReferralCommissionDelete {
+	mutation := newReferralCommissionMutation(c.config, OpDelete)
+	return &ReferralCommissionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ReferralCommissionClient) DeleteOne(_m *ReferralCommission) *ReferralCommissionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ReferralCommissionClient) DeleteOneID(id int64) *ReferralCommissionDeleteOne {
+	builder := c.Delete().Where(referralcommission.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ReferralCommissionDeleteOne{builder}
+}
+
+// Query returns a query builder for ReferralCommission.
+func (c *ReferralCommissionClient) Query() *ReferralCommissionQuery {
+	return &ReferralCommissionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeReferralCommission},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ReferralCommission entity by its id.
+func (c *ReferralCommissionClient) Get(ctx context.Context, id int64) (*ReferralCommission, error) {
+	return c.Query().Where(referralcommission.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ReferralCommissionClient) GetX(ctx context.Context, id int64) *ReferralCommission {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ReferralCommissionClient) Hooks() []Hook {
+	return c.hooks.ReferralCommission
+}
+
+// Interceptors returns the client interceptors.
+func (c *ReferralCommissionClient) Interceptors() []Interceptor {
+	return c.inters.ReferralCommission
+}
+
+func (c *ReferralCommissionClient) mutate(ctx context.Context, m *ReferralCommissionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ReferralCommissionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ReferralCommissionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ReferralCommissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ReferralCommissionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ReferralCommission mutation op: %q", m.Op())
+	}
+}
+
 // ReferralRewardClient is a client for the ReferralReward schema.
 type ReferralRewardClient struct {
 	config
@@ -3789,6 +5789,139 @@ func (c *ReferralRewardClient) mutate(ctx context.Context, m *ReferralRewardMuta
 	}
 }
 
+// RequestTransformRuleClient is a client for the RequestTransformRule schema.
+type RequestTransformRuleClient struct {
+	config
+}
+
+// NewRequestTransformRuleClient returns a client for the RequestTransformRule from the given config.
+func NewRequestTransformRuleClient(c config) *RequestTransformRuleClient {
+	return &RequestTransformRuleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `requesttransformrule.Hooks(f(g(h())))`.
+func (c *RequestTransformRuleClient) Use(hooks ...Hook) {
+	c.hooks.RequestTransformRule = append(c.hooks.RequestTransformRule, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `requesttransformrule.Intercept(f(g(h())))`.
+func (c *RequestTransformRuleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RequestTransformRule = append(c.inters.RequestTransformRule, interceptors...)
+}
+
+// Create returns a builder for creating a RequestTransformRule entity.
+func (c *RequestTransformRuleClient) Create() *RequestTransformRuleCreate {
+	mutation := newRequestTransformRuleMutation(c.config, OpCreate)
+	return &RequestTransformRuleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RequestTransformRule entities.
+func (c *RequestTransformRuleClient) CreateBulk(builders ...*RequestTransformRuleCreate) *RequestTransformRuleCreateBulk {
+	return &RequestTransformRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RequestTransformRuleClient) MapCreateBulk(slice any, setFunc func(*RequestTransformRuleCreate, int)) *RequestTransformRuleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RequestTransformRuleCreateBulk{err: fmt.Errorf("calling to RequestTransformRuleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RequestTransformRuleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RequestTransformRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RequestTransformRule.
+func (c *RequestTransformRuleClient) Update() *RequestTransformRuleUpdate {
+	mutation := newRequestTransformRuleMutation(c.config, OpUpdate)
+	return &RequestTransformRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RequestTransformRuleClient) UpdateOne(_m *RequestTransformRule) *RequestTransformRuleUpdateOne {
+	mutation := newRequestTransformRuleMutation(c.config, OpUpdateOne, withRequestTransformRule(_m))
+	return &RequestTransformRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RequestTransformRuleClient) UpdateOneID(id int64) *RequestTransformRuleUpdateOne {
+	mutation := newRequestTransformRuleMutation(c.config, OpUpdateOne, withRequestTransformRuleID(id))
+	return &RequestTransformRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RequestTransformRule.
+func (c *RequestTransformRuleClient) Delete() *RequestTransformRuleDelete {
+	mutation := newRequestTransformRuleMutation(c.config, OpDelete)
+	return &RequestTransformRuleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RequestTransformRuleClient) DeleteOne(_m *RequestTransformRule) *RequestTransformRuleDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RequestTransformRuleClient) DeleteOneID(id int64) *RequestTransformRuleDeleteOne {
+	builder := c.Delete().Where(requesttransformrule.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RequestTransformRuleDeleteOne{builder}
+}
+
+// Query returns a query builder for RequestTransformRule.
+func (c *RequestTransformRuleClient) Query() *RequestTransformRuleQuery {
+	return &RequestTransformRuleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRequestTransformRule},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RequestTransformRule entity by its id.
+func (c *RequestTransformRuleClient) Get(ctx context.Context, id int64) (*RequestTransformRule, error) {
+	return c.Query().Where(requesttransformrule.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RequestTransformRuleClient) GetX(ctx context.Context, id int64) *RequestTransformRule {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *RequestTransformRuleClient) Hooks() []Hook {
+	return c.hooks.RequestTransformRule
+}
+
+// Interceptors returns the client interceptors.
+func (c *RequestTransformRuleClient) Interceptors() []Interceptor {
+	return c.inters.RequestTransformRule
+}
+
+func (c *RequestTransformRuleClient) mutate(ctx context.Context, m *RequestTransformRuleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RequestTransformRuleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RequestTransformRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RequestTransformRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RequestTransformRuleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RequestTransformRule mutation op: %q", m.Op())
+	}
+}
+
 // SecuritySecretClient is a client for the SecuritySecret schema.
 type SecuritySecretClient struct {
 	config
@@ -4055,6 +6188,139 @@ func (c *SettingClient) mutate(ctx context.Context, m *SettingMutation) (Value,
 	}
 }
 
+// SubscriptionAutoRenewalClient is a client for the SubscriptionAutoRenewal schema.
+type SubscriptionAutoRenewalClient struct {
+	config
+}
+
+// NewSubscriptionAutoRenewalClient returns a client for the SubscriptionAutoRenewal from the given config.
+func NewSubscriptionAutoRenewalClient(c config) *SubscriptionAutoRenewalClient {
+	return &SubscriptionAutoRenewalClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `subscriptionautorenewal.Hooks(f(g(h())))`.
+func (c *SubscriptionAutoRenewalClient) Use(hooks ...Hook) {
+	c.hooks.SubscriptionAutoRenewal = append(c.hooks.SubscriptionAutoRenewal, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `subscriptionautorenewal.Intercept(f(g(h())))`.
+func (c *SubscriptionAutoRenewalClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SubscriptionAutoRenewal = append(c.inters.SubscriptionAutoRenewal, interceptors...)
+}
+
+// Create returns a builder for creating a SubscriptionAutoRenewal entity.
+func (c *SubscriptionAutoRenewalClient) Create() *SubscriptionAutoRenewalCreate {
+	mutation := newSubscriptionAutoRenewalMutation(c.config, OpCreate)
+	return &SubscriptionAutoRenewalCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SubscriptionAutoRenewal entities.
+func (c *SubscriptionAutoRenewalClient) CreateBulk(builders ...*SubscriptionAutoRenewalCreate) *SubscriptionAutoRenewalCreateBulk {
+	return &SubscriptionAutoRenewalCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SubscriptionAutoRenewalClient) MapCreateBulk(slice any, setFunc func(*SubscriptionAutoRenewalCreate, int)) *SubscriptionAutoRenewalCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SubscriptionAutoRenewalCreateBulk{err: fmt.Errorf("calling to SubscriptionAutoRenewalClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SubscriptionAutoRenewalCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SubscriptionAutoRenewalCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SubscriptionAutoRenewal.
+func (c *SubscriptionAutoRenewalClient) Update() *SubscriptionAutoRenewalUpdate {
+	mutation := newSubscriptionAutoRenewalMutation(c.config, OpUpdate)
+	return &SubscriptionAutoRenewalUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SubscriptionAutoRenewalClient) UpdateOne(_m *SubscriptionAutoRenewal) *SubscriptionAutoRenewalUpdateOne {
+	mutation := newSubscriptionAutoRenewalMutation(c.config, OpUpdateOne, withSubscriptionAutoRenewal(_m))
+	return &SubscriptionAutoRenewalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SubscriptionAutoRenewalClient) UpdateOneID(id int64) *SubscriptionAutoRenewalUpdateOne {
+	mutation := newSubscriptionAutoRenewalMutation(c.config, OpUpdateOne, withSubscriptionAutoRenewalID(id))
+	return &SubscriptionAutoRenewalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SubscriptionAutoRenewal.
+func (c *SubscriptionAutoRenewalClient) Delete() *SubscriptionAutoRenewalDelete {
+	mutation := newSubscriptionAutoRenewalMutation(c.config, OpDelete)
+	return &SubscriptionAutoRenewalDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SubscriptionAutoRenewalClient) DeleteOne(_m *SubscriptionAutoRenewal) *SubscriptionAutoRenewalDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SubscriptionAutoRenewalClient) DeleteOneID(id int64) *SubscriptionAutoRenewalDeleteOne {
+	builder := c.Delete().Where(subscriptionautorenewal.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SubscriptionAutoRenewalDeleteOne{builder}
+}
+
+// Query returns a query builder for SubscriptionAutoRenewal.
+func (c *SubscriptionAutoRenewalClient) Query() *SubscriptionAutoRenewalQuery {
+	return &SubscriptionAutoRenewalQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSubscriptionAutoRenewal},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SubscriptionAutoRenewal entity by its id.
+func (c *SubscriptionAutoRenewalClient) Get(ctx context.Context, id int64) (*SubscriptionAutoRenewal, error) {
+	return c.Query().Where(subscriptionautorenewal.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SubscriptionAutoRenewalClient) GetX(ctx context.Context, id int64) *SubscriptionAutoRenewal {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SubscriptionAutoRenewalClient) Hooks() []Hook {
+	return c.hooks.SubscriptionAutoRenewal
+}
+
+// Interceptors returns the client interceptors.
+func (c *SubscriptionAutoRenewalClient) Interceptors() []Interceptor {
+	return c.inters.SubscriptionAutoRenewal
+}
+
+func (c *SubscriptionAutoRenewalClient) mutate(ctx context.Context, m *SubscriptionAutoRenewalMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SubscriptionAutoRenewalCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SubscriptionAutoRenewalUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SubscriptionAutoRenewalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SubscriptionAutoRenewalDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SubscriptionAutoRenewal mutation op: %q", m.Op())
+	}
+}
+
 // SubscriptionPlanClient is a client for the SubscriptionPlan schema.
 type SubscriptionPlanClient struct {
 	config
@@ -5527,22 +7793,32 @@ func (c *UserSubscriptionClient) mutate(ctx context.Context, m *UserSubscription
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		APIKey, Account, AccountGroup, Announcement, AnnouncementRead, ChannelMonitor,
+		APIKey, Account, AccountGroup, Announcement, AnnouncementRead, BalanceBucket,
+		BillingAdjustment, BillingRecomputeJob, BillingStatement, ChannelMonitor,
 		ChannelMonitorDailyRollup, ChannelMonitorHistory,
-		ChannelMonitorRequestTemplate, ErrorPassthroughRule, Group, IdempotencyRecord,
-		PaygOrder, PaymentAuditLog, PaymentOrder, PaymentProviderInstance, PromoCode,
-		PromoCodeUsage, Proxy, RedeemCode, ReferralReward, SecuritySecret, Setting,
-		SubscriptionPlan, UsageCleanupTask, UsageLog, User, UserAllowedGroup,
-		UserAttributeDefinition, UserAttributeValue, UserSubscription []ent.Hook
+		ChannelMonitorRequestTemplate, CommissionWithdrawal, CommissionWithdrawalLog,
+		ErrorPassthroughRule, GatewayPlugin, Group, GuardrailRule, IdempotencyRecord,
+		Invoice, InvoiceSequence, PaygOrder, PaymentAuditLog, PaymentCoupon,
+		PaymentOrder, PaymentProviderInstance, PostpaidAccount, PromoCode,
+		PromoCodeUsage, Proxy, ProxyPool, RedeemCode, ReferralCommission,
+		ReferralReward, RequestTransformRule, SecuritySecret, Setting,
+		SubscriptionAutoRenewal, SubscriptionPlan, UsageCleanupTask, UsageLog, User,
+		UserAllowedGroup, UserAttributeDefinition, UserAttributeValue,
+		UserSubscription []ent.Hook
 	}
 	inters struct {
-		APIKey, Account, AccountGroup, Announcement, AnnouncementRead, ChannelMonitor,
+		APIKey, Account, AccountGroup, Announcement, AnnouncementRead, BalanceBucket,
+		BillingAdjustment, BillingRecomputeJob, BillingStatement, ChannelMonitor,
 		ChannelMonitorDailyRollup, ChannelMonitorHistory,
-		ChannelMonitorRequestTemplate, ErrorPassthroughRule, Group, IdempotencyRecord,
-		PaygOrder, PaymentAuditLog, PaymentOrder, PaymentProviderInstance, PromoCode,
-		PromoCodeUsage, Proxy, RedeemCode, ReferralReward, SecuritySecret, Setting,
-		SubscriptionPlan, UsageCleanupTask, UsageLog, User, UserAllowedGroup,
-		UserAttributeDefinition, UserAttributeValue, UserSubscription []ent.Interceptor
+		ChannelMonitorRequestTemplate, CommissionWithdrawal, CommissionWithdrawalLog,
+		ErrorPassthroughRule, GatewayPlugin, Group, GuardrailRule, IdempotencyRecord,
+		Invoice, InvoiceSequence, PaygOrder, PaymentAuditLog, PaymentCoupon,
+		PaymentOrder, PaymentProviderInstance, PostpaidAccount, PromoCode,
+		PromoCodeUsage, Proxy, ProxyPool, RedeemCode, ReferralCommission,
+		ReferralReward, RequestTransformRule, SecuritySecret, Setting,
+		SubscriptionAutoRenewal, SubscriptionPlan, UsageCleanupTask, UsageLog, User,
+		UserAllowedGroup, UserAttributeDefinition, UserAttributeValue,
+		UserSubscription []ent.Interceptor
 	}
 )
 
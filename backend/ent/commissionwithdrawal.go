@@ -0,0 +1,249 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Wei-Shaw/sub2api/ent/commissionwithdrawal"
+)
+
+// CommissionWithdrawal is the model entity for the CommissionWithdrawal schema.
+type CommissionWithdrawal struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int64 `json:"user_id,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount float64 `json:"amount,omitempty"`
+	// PayoutMethod holds the value of the "payout_method" field.
+	PayoutMethod string `json:"payout_method,omitempty"`
+	// PayoutAccount holds the value of the "payout_account" field.
+	PayoutAccount string `json:"payout_account,omitempty"`
+	// PayoutName holds the value of the "payout_name" field.
+	PayoutName string `json:"payout_name,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// ReviewerID holds the value of the "reviewer_id" field.
+	ReviewerID *int64 `json:"reviewer_id,omitempty"`
+	// ReviewNote holds the value of the "review_note" field.
+	ReviewNote string `json:"review_note,omitempty"`
+	// PayoutReference holds the value of the "payout_reference" field.
+	PayoutReference string `json:"payout_reference,omitempty"`
+	// ReviewedAt holds the value of the "reviewed_at" field.
+	ReviewedAt *time.Time `json:"reviewed_at,omitempty"`
+	// PaidAt holds the value of the "paid_at" field.
+	PaidAt *time.Time `json:"paid_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CommissionWithdrawal) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case commissionwithdrawal.FieldAmount:
+			values[i] = new(sql.NullFloat64)
+		case commissionwithdrawal.FieldID, commissionwithdrawal.FieldUserID, commissionwithdrawal.FieldReviewerID:
+			values[i] = new(sql.NullInt64)
+		case commissionwithdrawal.FieldPayoutMethod, commissionwithdrawal.FieldPayoutAccount, commissionwithdrawal.FieldPayoutName, commissionwithdrawal.FieldStatus, commissionwithdrawal.FieldReviewNote, commissionwithdrawal.FieldPayoutReference:
+			values[i] = new(sql.NullString)
+		case commissionwithdrawal.FieldReviewedAt, commissionwithdrawal.FieldPaidAt, commissionwithdrawal.FieldCreatedAt, commissionwithdrawal.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CommissionWithdrawal fields.
+func (_m *CommissionWithdrawal) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case commissionwithdrawal.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int64(value.Int64)
+		case commissionwithdrawal.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = value.Int64
+			}
+		case commissionwithdrawal.FieldAmount:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value.Valid {
+				_m.Amount = value.Float64
+			}
+		case commissionwithdrawal.FieldPayoutMethod:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field payout_method", values[i])
+			} else if value.Valid {
+				_m.PayoutMethod = value.String
+			}
+		case commissionwithdrawal.FieldPayoutAccount:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field payout_account", values[i])
+			} else if value.Valid {
+				_m.PayoutAccount = value.String
+			}
+		case commissionwithdrawal.FieldPayoutName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field payout_name", values[i])
+			} else if value.Valid {
+				_m.PayoutName = value.String
+			}
+		case commissionwithdrawal.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = value.String
+			}
+		case commissionwithdrawal.FieldReviewerID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewer_id", values[i])
+			} else if value.Valid {
+				_m.ReviewerID = new(int64)
+				*_m.ReviewerID = value.Int64
+			}
+		case commissionwithdrawal.FieldReviewNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field review_note", values[i])
+			} else if value.Valid {
+				_m.ReviewNote = value.String
+			}
+		case commissionwithdrawal.FieldPayoutReference:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field payout_reference", values[i])
+			} else if value.Valid {
+				_m.PayoutReference = value.String
+			}
+		case commissionwithdrawal.FieldReviewedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewed_at", values[i])
+			} else if value.Valid {
+				_m.ReviewedAt = new(time.Time)
+				*_m.ReviewedAt = value.Time
+			}
+		case commissionwithdrawal.FieldPaidAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field paid_at", values[i])
+			} else if value.Valid {
+				_m.PaidAt = new(time.Time)
+				*_m.PaidAt = value.Time
+			}
+		case commissionwithdrawal.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case commissionwithdrawal.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CommissionWithdrawal.
+// This includes values selected through modifiers, order, etc.
+func (_m *CommissionWithdrawal) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this CommissionWithdrawal.
+// Note that you need to call CommissionWithdrawal.Unwrap() before calling this method if this CommissionWithdrawal
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *CommissionWithdrawal) Update() *CommissionWithdrawalUpdateOne {
+	return NewCommissionWithdrawalClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the CommissionWithdrawal entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *CommissionWithdrawal) Unwrap() *CommissionWithdrawal {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CommissionWithdrawal is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *CommissionWithdrawal) String() string {
+	var builder strings.Builder
+	builder.WriteString("CommissionWithdrawal(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("payout_method=")
+	builder.WriteString(_m.PayoutMethod)
+	builder.WriteString(", ")
+	builder.WriteString("payout_account=")
+	builder.WriteString(_m.PayoutAccount)
+	builder.WriteString(", ")
+	builder.WriteString("payout_name=")
+	builder.WriteString(_m.PayoutName)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(_m.Status)
+	builder.WriteString(", ")
+	if v := _m.ReviewerID; v != nil {
+		builder.WriteString("reviewer_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("review_note=")
+	builder.WriteString(_m.ReviewNote)
+	builder.WriteString(", ")
+	builder.WriteString("payout_reference=")
+	builder.WriteString(_m.PayoutReference)
+	builder.WriteString(", ")
+	if v := _m.ReviewedAt; v != nil {
+		builder.WriteString("reviewed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.PaidAt; v != nil {
+		builder.WriteString("paid_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CommissionWithdrawals is a parsable slice of CommissionWithdrawal.
+type CommissionWithdrawals []*CommissionWithdrawal
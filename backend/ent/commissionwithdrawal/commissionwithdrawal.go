@@ -0,0 +1,166 @@
+// Code generated by ent, DO NOT EDIT.
+
+package commissionwithdrawal
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the commissionwithdrawal type in the database.
+	Label = "commission_withdrawal"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldAmount holds the string denoting the amount field in the database.
+	FieldAmount = "amount"
+	// FieldPayoutMethod holds the string denoting the payout_method field in the database.
+	FieldPayoutMethod = "payout_method"
+	// FieldPayoutAccount holds the string denoting the payout_account field in the database.
+	FieldPayoutAccount = "payout_account"
+	// FieldPayoutName holds the string denoting the payout_name field in the database.
+	FieldPayoutName = "payout_name"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldReviewerID holds the string denoting the reviewer_id field in the database.
+	FieldReviewerID = "reviewer_id"
+	// FieldReviewNote holds the string denoting the review_note field in the database.
+	FieldReviewNote = "review_note"
+	// FieldPayoutReference holds the string denoting the payout_reference field in the database.
+	FieldPayoutReference = "payout_reference"
+	// FieldReviewedAt holds the string denoting the reviewed_at field in the database.
+	FieldReviewedAt = "reviewed_at"
+	// FieldPaidAt holds the string denoting the paid_at field in the database.
+	FieldPaidAt = "paid_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the commissionwithdrawal in the database.
+	Table = "commission_withdrawals"
+)
+
+// Columns holds all SQL columns for commissionwithdrawal fields.
+var Columns = []string{
+	FieldID,
+	FieldUserID,
+	FieldAmount,
+	FieldPayoutMethod,
+	FieldPayoutAccount,
+	FieldPayoutName,
+	FieldStatus,
+	FieldReviewerID,
+	FieldReviewNote,
+	FieldPayoutReference,
+	FieldReviewedAt,
+	FieldPaidAt,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// PayoutMethodValidator is a validator for the "payout_method" field. It is called by the builders before save.
+	PayoutMethodValidator func(string) error
+	// PayoutAccountValidator is a validator for the "payout_account" field. It is called by the builders before save.
+	PayoutAccountValidator func(string) error
+	// PayoutNameValidator is a validator for the "payout_name" field. It is called by the builders before save.
+	PayoutNameValidator func(string) error
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+	// StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	StatusValidator func(string) error
+	// PayoutReferenceValidator is a validator for the "payout_reference" field. It is called by the builders before save.
+	PayoutReferenceValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the CommissionWithdrawal queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByAmount orders the results by the amount field.
+func ByAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAmount, opts...).ToFunc()
+}
+
+// ByPayoutMethod orders the results by the payout_method field.
+func ByPayoutMethod(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPayoutMethod, opts...).ToFunc()
+}
+
+// ByPayoutAccount orders the results by the payout_account field.
+func ByPayoutAccount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPayoutAccount, opts...).ToFunc()
+}
+
+// ByPayoutName orders the results by the payout_name field.
+func ByPayoutName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPayoutName, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByReviewerID orders the results by the reviewer_id field.
+func ByReviewerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReviewerID, opts...).ToFunc()
+}
+
+// ByReviewNote orders the results by the review_note field.
+func ByReviewNote(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReviewNote, opts...).ToFunc()
+}
+
+// ByPayoutReference orders the results by the payout_reference field.
+func ByPayoutReference(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPayoutReference, opts...).ToFunc()
+}
+
+// ByReviewedAt orders the results by the reviewed_at field.
+func ByReviewedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReviewedAt, opts...).ToFunc()
+}
+
+// ByPaidAt orders the results by the paid_at field.
+func ByPaidAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPaidAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
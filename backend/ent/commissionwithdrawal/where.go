@@ -0,0 +1,865 @@
+// Code generated by ent, DO NOT EDIT.
+
+package commissionwithdrawal
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Wei-Shaw/sub2api/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int64) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldEQ(FieldUserID, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v float64) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldEQ(FieldAmount, v))
+}
+
+// PayoutMethod applies equality check predicate on the "payout_method" field. It's identical to PayoutMethodEQ.
+func PayoutMethod(v string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldEQ(FieldPayoutMethod, v))
+}
+
+// PayoutAccount applies equality check predicate on the "payout_account" field. It's identical to PayoutAccountEQ.
+func PayoutAccount(v string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldEQ(FieldPayoutAccount, v))
+}
+
+// PayoutName applies equality check predicate on the "payout_name" field. It's identical to PayoutNameEQ.
+func PayoutName(v string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldEQ(FieldPayoutName, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldEQ(FieldStatus, v))
+}
+
+// ReviewerID applies equality check predicate on the "reviewer_id" field. It's identical to ReviewerIDEQ.
+func ReviewerID(v int64) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldEQ(FieldReviewerID, v))
+}
+
+// ReviewNote applies equality check predicate on the "review_note" field. It's identical to ReviewNoteEQ.
+func ReviewNote(v string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldEQ(FieldReviewNote, v))
+}
+
+// PayoutReference applies equality check predicate on the "payout_reference" field. It's identical to PayoutReferenceEQ.
+func PayoutReference(v string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldEQ(FieldPayoutReference, v))
+}
+
+// ReviewedAt applies equality check predicate on the "reviewed_at" field. It's identical to ReviewedAtEQ.
+func ReviewedAt(v time.Time) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldEQ(FieldReviewedAt, v))
+}
+
+// PaidAt applies equality check predicate on the "paid_at" field. It's identical to PaidAtEQ.
+func PaidAt(v time.Time) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldEQ(FieldPaidAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int64) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int64) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int64) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int64) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v int64) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v int64) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v int64) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v int64) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldLTE(FieldUserID, v))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v float64) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v float64) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...float64) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...float64) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v float64) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v float64) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v float64) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v float64) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldLTE(FieldAmount, v))
+}
+
+// PayoutMethodEQ applies the EQ predicate on the "payout_method" field.
+func PayoutMethodEQ(v string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldEQ(FieldPayoutMethod, v))
+}
+
+// PayoutMethodNEQ applies the NEQ predicate on the "payout_method" field.
+func PayoutMethodNEQ(v string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldNEQ(FieldPayoutMethod, v))
+}
+
+// PayoutMethodIn applies the In predicate on the "payout_method" field.
+func PayoutMethodIn(vs ...string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldIn(FieldPayoutMethod, vs...))
+}
+
+// PayoutMethodNotIn applies the NotIn predicate on the "payout_method" field.
+func PayoutMethodNotIn(vs ...string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldNotIn(FieldPayoutMethod, vs...))
+}
+
+// PayoutMethodGT applies the GT predicate on the "payout_method" field.
+func PayoutMethodGT(v string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldGT(FieldPayoutMethod, v))
+}
+
+// PayoutMethodGTE applies the GTE predicate on the "payout_method" field.
+func PayoutMethodGTE(v string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldGTE(FieldPayoutMethod, v))
+}
+
+// PayoutMethodLT applies the LT predicate on the "payout_method" field.
+func PayoutMethodLT(v string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldLT(FieldPayoutMethod, v))
+}
+
+// PayoutMethodLTE applies the LTE predicate on the "payout_method" field.
+func PayoutMethodLTE(v string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldLTE(FieldPayoutMethod, v))
+}
+
+// PayoutMethodContains applies the Contains predicate on the "payout_method" field.
+func PayoutMethodContains(v string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldContains(FieldPayoutMethod, v))
+}
+
+// PayoutMethodHasPrefix applies the HasPrefix predicate on the "payout_method" field.
+func PayoutMethodHasPrefix(v string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldHasPrefix(FieldPayoutMethod, v))
+}
+
+// PayoutMethodHasSuffix applies the HasSuffix predicate on the "payout_method" field.
+func PayoutMethodHasSuffix(v string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldHasSuffix(FieldPayoutMethod, v))
+}
+
+// PayoutMethodEqualFold applies the EqualFold predicate on the "payout_method" field.
+func PayoutMethodEqualFold(v string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldEqualFold(FieldPayoutMethod, v))
+}
+
+// PayoutMethodContainsFold applies the ContainsFold predicate on the "payout_method" field.
+func PayoutMethodContainsFold(v string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldContainsFold(FieldPayoutMethod, v))
+}
+
+// PayoutAccountEQ applies the EQ predicate on the "payout_account" field.
+func PayoutAccountEQ(v string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldEQ(FieldPayoutAccount, v))
+}
+
+// PayoutAccountNEQ applies the NEQ predicate on the "payout_account" field.
+func PayoutAccountNEQ(v string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldNEQ(FieldPayoutAccount, v))
+}
+
+// PayoutAccountIn applies the In predicate on the "payout_account" field.
+func PayoutAccountIn(vs ...string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldIn(FieldPayoutAccount, vs...))
+}
+
+// PayoutAccountNotIn applies the NotIn predicate on the "payout_account" field.
+func PayoutAccountNotIn(vs ...string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldNotIn(FieldPayoutAccount, vs...))
+}
+
+// PayoutAccountGT applies the GT predicate on the "payout_account" field.
+func PayoutAccountGT(v string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldGT(FieldPayoutAccount, v))
+}
+
+// PayoutAccountGTE applies the GTE predicate on the "payout_account" field.
+func PayoutAccountGTE(v string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldGTE(FieldPayoutAccount, v))
+}
+
+// PayoutAccountLT applies the LT predicate on the "payout_account" field.
+func PayoutAccountLT(v string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldLT(FieldPayoutAccount, v))
+}
+
+// PayoutAccountLTE applies the LTE predicate on the "payout_account" field.
+func PayoutAccountLTE(v string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldLTE(FieldPayoutAccount, v))
+}
+
+// PayoutAccountContains applies the Contains predicate on the "payout_account" field.
+func PayoutAccountContains(v string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldContains(FieldPayoutAccount, v))
+}
+
+// PayoutAccountHasPrefix applies the HasPrefix predicate on the "payout_account" field.
+func PayoutAccountHasPrefix(v string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldHasPrefix(FieldPayoutAccount, v))
+}
+
+// PayoutAccountHasSuffix applies the HasSuffix predicate on the "payout_account" field.
+func PayoutAccountHasSuffix(v string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldHasSuffix(FieldPayoutAccount, v))
+}
+
+// PayoutAccountEqualFold applies the EqualFold predicate on the "payout_account" field.
+func PayoutAccountEqualFold(v string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldEqualFold(FieldPayoutAccount, v))
+}
+
+// PayoutAccountContainsFold applies the ContainsFold predicate on the "payout_account" field.
+func PayoutAccountContainsFold(v string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldContainsFold(FieldPayoutAccount, v))
+}
+
+// PayoutNameEQ applies the EQ predicate on the "payout_name" field.
+func PayoutNameEQ(v string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldEQ(FieldPayoutName, v))
+}
+
+// PayoutNameNEQ applies the NEQ predicate on the "payout_name" field.
+func PayoutNameNEQ(v string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldNEQ(FieldPayoutName, v))
+}
+
+// PayoutNameIn applies the In predicate on the "payout_name" field.
+func PayoutNameIn(vs ...string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldIn(FieldPayoutName, vs...))
+}
+
+// PayoutNameNotIn applies the NotIn predicate on the "payout_name" field.
+func PayoutNameNotIn(vs ...string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldNotIn(FieldPayoutName, vs...))
+}
+
+// PayoutNameGT applies the GT predicate on the "payout_name" field.
+func PayoutNameGT(v string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldGT(FieldPayoutName, v))
+}
+
+// PayoutNameGTE applies the GTE predicate on the "payout_name" field.
+func PayoutNameGTE(v string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldGTE(FieldPayoutName, v))
+}
+
+// PayoutNameLT applies the LT predicate on the "payout_name" field.
+func PayoutNameLT(v string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldLT(FieldPayoutName, v))
+}
+
+// PayoutNameLTE applies the LTE predicate on the "payout_name" field.
+func PayoutNameLTE(v string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldLTE(FieldPayoutName, v))
+}
+
+// PayoutNameContains applies the Contains predicate on the "payout_name" field.
+func PayoutNameContains(v string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldContains(FieldPayoutName, v))
+}
+
+// PayoutNameHasPrefix applies the HasPrefix predicate on the "payout_name" field.
+func PayoutNameHasPrefix(v string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldHasPrefix(FieldPayoutName, v))
+}
+
+// PayoutNameHasSuffix applies the HasSuffix predicate on the "payout_name" field.
+func PayoutNameHasSuffix(v string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldHasSuffix(FieldPayoutName, v))
+}
+
+// PayoutNameIsNil applies the IsNil predicate on the "payout_name" field.
+func PayoutNameIsNil() predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldIsNull(FieldPayoutName))
+}
+
+// PayoutNameNotNil applies the NotNil predicate on the "payout_name" field.
+func PayoutNameNotNil() predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldNotNull(FieldPayoutName))
+}
+
+// PayoutNameEqualFold applies the EqualFold predicate on the "payout_name" field.
+func PayoutNameEqualFold(v string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldEqualFold(FieldPayoutName, v))
+}
+
+// PayoutNameContainsFold applies the ContainsFold predicate on the "payout_name" field.
+func PayoutNameContainsFold(v string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldContainsFold(FieldPayoutName, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// ReviewerIDEQ applies the EQ predicate on the "reviewer_id" field.
+func ReviewerIDEQ(v int64) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldEQ(FieldReviewerID, v))
+}
+
+// ReviewerIDNEQ applies the NEQ predicate on the "reviewer_id" field.
+func ReviewerIDNEQ(v int64) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldNEQ(FieldReviewerID, v))
+}
+
+// ReviewerIDIn applies the In predicate on the "reviewer_id" field.
+func ReviewerIDIn(vs ...int64) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldIn(FieldReviewerID, vs...))
+}
+
+// ReviewerIDNotIn applies the NotIn predicate on the "reviewer_id" field.
+func ReviewerIDNotIn(vs ...int64) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldNotIn(FieldReviewerID, vs...))
+}
+
+// ReviewerIDGT applies the GT predicate on the "reviewer_id" field.
+func ReviewerIDGT(v int64) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldGT(FieldReviewerID, v))
+}
+
+// ReviewerIDGTE applies the GTE predicate on the "reviewer_id" field.
+func ReviewerIDGTE(v int64) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldGTE(FieldReviewerID, v))
+}
+
+// ReviewerIDLT applies the LT predicate on the "reviewer_id" field.
+func ReviewerIDLT(v int64) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldLT(FieldReviewerID, v))
+}
+
+// ReviewerIDLTE applies the LTE predicate on the "reviewer_id" field.
+func ReviewerIDLTE(v int64) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldLTE(FieldReviewerID, v))
+}
+
+// ReviewerIDIsNil applies the IsNil predicate on the "reviewer_id" field.
+func ReviewerIDIsNil() predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldIsNull(FieldReviewerID))
+}
+
+// ReviewerIDNotNil applies the NotNil predicate on the "reviewer_id" field.
+func ReviewerIDNotNil() predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldNotNull(FieldReviewerID))
+}
+
+// ReviewNoteEQ applies the EQ predicate on the "review_note" field.
+func ReviewNoteEQ(v string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldEQ(FieldReviewNote, v))
+}
+
+// ReviewNoteNEQ applies the NEQ predicate on the "review_note" field.
+func ReviewNoteNEQ(v string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldNEQ(FieldReviewNote, v))
+}
+
+// ReviewNoteIn applies the In predicate on the "review_note" field.
+func ReviewNoteIn(vs ...string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldIn(FieldReviewNote, vs...))
+}
+
+// ReviewNoteNotIn applies the NotIn predicate on the "review_note" field.
+func ReviewNoteNotIn(vs ...string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldNotIn(FieldReviewNote, vs...))
+}
+
+// ReviewNoteGT applies the GT predicate on the "review_note" field.
+func ReviewNoteGT(v string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldGT(FieldReviewNote, v))
+}
+
+// ReviewNoteGTE applies the GTE predicate on the "review_note" field.
+func ReviewNoteGTE(v string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldGTE(FieldReviewNote, v))
+}
+
+// ReviewNoteLT applies the LT predicate on the "review_note" field.
+func ReviewNoteLT(v string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldLT(FieldReviewNote, v))
+}
+
+// ReviewNoteLTE applies the LTE predicate on the "review_note" field.
+func ReviewNoteLTE(v string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldLTE(FieldReviewNote, v))
+}
+
+// ReviewNoteContains applies the Contains predicate on the "review_note" field.
+func ReviewNoteContains(v string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldContains(FieldReviewNote, v))
+}
+
+// ReviewNoteHasPrefix applies the HasPrefix predicate on the "review_note" field.
+func ReviewNoteHasPrefix(v string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldHasPrefix(FieldReviewNote, v))
+}
+
+// ReviewNoteHasSuffix applies the HasSuffix predicate on the "review_note" field.
+func ReviewNoteHasSuffix(v string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldHasSuffix(FieldReviewNote, v))
+}
+
+// ReviewNoteIsNil applies the IsNil predicate on the "review_note" field.
+func ReviewNoteIsNil() predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldIsNull(FieldReviewNote))
+}
+
+// ReviewNoteNotNil applies the NotNil predicate on the "review_note" field.
+func ReviewNoteNotNil() predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldNotNull(FieldReviewNote))
+}
+
+// ReviewNoteEqualFold applies the EqualFold predicate on the "review_note" field.
+func ReviewNoteEqualFold(v string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldEqualFold(FieldReviewNote, v))
+}
+
+// ReviewNoteContainsFold applies the ContainsFold predicate on the "review_note" field.
+func ReviewNoteContainsFold(v string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldContainsFold(FieldReviewNote, v))
+}
+
+// PayoutReferenceEQ applies the EQ predicate on the "payout_reference" field.
+func PayoutReferenceEQ(v string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldEQ(FieldPayoutReference, v))
+}
+
+// PayoutReferenceNEQ applies the NEQ predicate on the "payout_reference" field.
+func PayoutReferenceNEQ(v string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldNEQ(FieldPayoutReference, v))
+}
+
+// PayoutReferenceIn applies the In predicate on the "payout_reference" field.
+func PayoutReferenceIn(vs ...string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldIn(FieldPayoutReference, vs...))
+}
+
+// PayoutReferenceNotIn applies the NotIn predicate on the "payout_reference" field.
+func PayoutReferenceNotIn(vs ...string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldNotIn(FieldPayoutReference, vs...))
+}
+
+// PayoutReferenceGT applies the GT predicate on the "payout_reference" field.
+func PayoutReferenceGT(v string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldGT(FieldPayoutReference, v))
+}
+
+// PayoutReferenceGTE applies the GTE predicate on the "payout_reference" field.
+func PayoutReferenceGTE(v string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldGTE(FieldPayoutReference, v))
+}
+
+// PayoutReferenceLT applies the LT predicate on the "payout_reference" field.
+func PayoutReferenceLT(v string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldLT(FieldPayoutReference, v))
+}
+
+// PayoutReferenceLTE applies the LTE predicate on the "payout_reference" field.
+func PayoutReferenceLTE(v string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldLTE(FieldPayoutReference, v))
+}
+
+// PayoutReferenceContains applies the Contains predicate on the "payout_reference" field.
+func PayoutReferenceContains(v string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldContains(FieldPayoutReference, v))
+}
+
+// PayoutReferenceHasPrefix applies the HasPrefix predicate on the "payout_reference" field.
+func PayoutReferenceHasPrefix(v string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldHasPrefix(FieldPayoutReference, v))
+}
+
+// PayoutReferenceHasSuffix applies the HasSuffix predicate on the "payout_reference" field.
+func PayoutReferenceHasSuffix(v string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldHasSuffix(FieldPayoutReference, v))
+}
+
+// PayoutReferenceIsNil applies the IsNil predicate on the "payout_reference" field.
+func PayoutReferenceIsNil() predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldIsNull(FieldPayoutReference))
+}
+
+// PayoutReferenceNotNil applies the NotNil predicate on the "payout_reference" field.
+func PayoutReferenceNotNil() predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldNotNull(FieldPayoutReference))
+}
+
+// PayoutReferenceEqualFold applies the EqualFold predicate on the "payout_reference" field.
+func PayoutReferenceEqualFold(v string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldEqualFold(FieldPayoutReference, v))
+}
+
+// PayoutReferenceContainsFold applies the ContainsFold predicate on the "payout_reference" field.
+func PayoutReferenceContainsFold(v string) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldContainsFold(FieldPayoutReference, v))
+}
+
+// ReviewedAtEQ applies the EQ predicate on the "reviewed_at" field.
+func ReviewedAtEQ(v time.Time) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldEQ(FieldReviewedAt, v))
+}
+
+// ReviewedAtNEQ applies the NEQ predicate on the "reviewed_at" field.
+func ReviewedAtNEQ(v time.Time) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldNEQ(FieldReviewedAt, v))
+}
+
+// ReviewedAtIn applies the In predicate on the "reviewed_at" field.
+func ReviewedAtIn(vs ...time.Time) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldIn(FieldReviewedAt, vs...))
+}
+
+// ReviewedAtNotIn applies the NotIn predicate on the "reviewed_at" field.
+func ReviewedAtNotIn(vs ...time.Time) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldNotIn(FieldReviewedAt, vs...))
+}
+
+// ReviewedAtGT applies the GT predicate on the "reviewed_at" field.
+func ReviewedAtGT(v time.Time) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldGT(FieldReviewedAt, v))
+}
+
+// ReviewedAtGTE applies the GTE predicate on the "reviewed_at" field.
+func ReviewedAtGTE(v time.Time) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldGTE(FieldReviewedAt, v))
+}
+
+// ReviewedAtLT applies the LT predicate on the "reviewed_at" field.
+func ReviewedAtLT(v time.Time) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldLT(FieldReviewedAt, v))
+}
+
+// ReviewedAtLTE applies the LTE predicate on the "reviewed_at" field.
+func ReviewedAtLTE(v time.Time) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldLTE(FieldReviewedAt, v))
+}
+
+// ReviewedAtIsNil applies the IsNil predicate on the "reviewed_at" field.
+func ReviewedAtIsNil() predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldIsNull(FieldReviewedAt))
+}
+
+// ReviewedAtNotNil applies the NotNil predicate on the "reviewed_at" field.
+func ReviewedAtNotNil() predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldNotNull(FieldReviewedAt))
+}
+
+// PaidAtEQ applies the EQ predicate on the "paid_at" field.
+func PaidAtEQ(v time.Time) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldEQ(FieldPaidAt, v))
+}
+
+// PaidAtNEQ applies the NEQ predicate on the "paid_at" field.
+func PaidAtNEQ(v time.Time) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldNEQ(FieldPaidAt, v))
+}
+
+// PaidAtIn applies the In predicate on the "paid_at" field.
+func PaidAtIn(vs ...time.Time) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldIn(FieldPaidAt, vs...))
+}
+
+// PaidAtNotIn applies the NotIn predicate on the "paid_at" field.
+func PaidAtNotIn(vs ...time.Time) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldNotIn(FieldPaidAt, vs...))
+}
+
+// PaidAtGT applies the GT predicate on the "paid_at" field.
+func PaidAtGT(v time.Time) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldGT(FieldPaidAt, v))
+}
+
+// PaidAtGTE applies the GTE predicate on the "paid_at" field.
+func PaidAtGTE(v time.Time) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldGTE(FieldPaidAt, v))
+}
+
+// PaidAtLT applies the LT predicate on the "paid_at" field.
+func PaidAtLT(v time.Time) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldLT(FieldPaidAt, v))
+}
+
+// PaidAtLTE applies the LTE predicate on the "paid_at" field.
+func PaidAtLTE(v time.Time) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldLTE(FieldPaidAt, v))
+}
+
+// PaidAtIsNil applies the IsNil predicate on the "paid_at" field.
+func PaidAtIsNil() predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldIsNull(FieldPaidAt))
+}
+
+// PaidAtNotNil applies the NotNil predicate on the "paid_at" field.
+func PaidAtNotNil() predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldNotNull(FieldPaidAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CommissionWithdrawal) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CommissionWithdrawal) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CommissionWithdrawal) predicate.CommissionWithdrawal {
+	return predicate.CommissionWithdrawal(sql.NotPredicates(p))
+}
@@ -0,0 +1,1340 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Wei-Shaw/sub2api/ent/commissionwithdrawal"
+)
+
+// CommissionWithdrawalCreate is the builder for creating a CommissionWithdrawal entity.
+type CommissionWithdrawalCreate struct {
+	config
+	mutation *CommissionWithdrawalMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *CommissionWithdrawalCreate) SetUserID(v int64) *CommissionWithdrawalCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetAmount sets the "amount" field.
+func (_c *CommissionWithdrawalCreate) SetAmount(v float64) *CommissionWithdrawalCreate {
+	_c.mutation.SetAmount(v)
+	return _c
+}
+
+// SetPayoutMethod sets the "payout_method" field.
+func (_c *CommissionWithdrawalCreate) SetPayoutMethod(v string) *CommissionWithdrawalCreate {
+	_c.mutation.SetPayoutMethod(v)
+	return _c
+}
+
+// SetPayoutAccount sets the "payout_account" field.
+func (_c *CommissionWithdrawalCreate) SetPayoutAccount(v string) *CommissionWithdrawalCreate {
+	_c.mutation.SetPayoutAccount(v)
+	return _c
+}
+
+// SetPayoutName sets the "payout_name" field.
+func (_c *CommissionWithdrawalCreate) SetPayoutName(v string) *CommissionWithdrawalCreate {
+	_c.mutation.SetPayoutName(v)
+	return _c
+}
+
+// SetNillablePayoutName sets the "payout_name" field if the given value is not nil.
+func (_c *CommissionWithdrawalCreate) SetNillablePayoutName(v *string) *CommissionWithdrawalCreate {
+	if v != nil {
+		_c.SetPayoutName(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *CommissionWithdrawalCreate) SetStatus(v string) *CommissionWithdrawalCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *CommissionWithdrawalCreate) SetNillableStatus(v *string) *CommissionWithdrawalCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (_c *CommissionWithdrawalCreate) SetReviewerID(v int64) *CommissionWithdrawalCreate {
+	_c.mutation.SetReviewerID(v)
+	return _c
+}
+
+// SetNillableReviewerID sets the "reviewer_id" field if the given value is not nil.
+func (_c *CommissionWithdrawalCreate) SetNillableReviewerID(v *int64) *CommissionWithdrawalCreate {
+	if v != nil {
+		_c.SetReviewerID(*v)
+	}
+	return _c
+}
+
+// SetReviewNote sets the "review_note" field.
+func (_c *CommissionWithdrawalCreate) SetReviewNote(v string) *CommissionWithdrawalCreate {
+	_c.mutation.SetReviewNote(v)
+	return _c
+}
+
+// SetNillableReviewNote sets the "review_note" field if the given value is not nil.
+func (_c *CommissionWithdrawalCreate) SetNillableReviewNote(v *string) *CommissionWithdrawalCreate {
+	if v != nil {
+		_c.SetReviewNote(*v)
+	}
+	return _c
+}
+
+// SetPayoutReference sets the "payout_reference" field.
+func (_c *CommissionWithdrawalCreate) SetPayoutReference(v string) *CommissionWithdrawalCreate {
+	_c.mutation.SetPayoutReference(v)
+	return _c
+}
+
+// SetNillablePayoutReference sets the "payout_reference" field if the given value is not nil.
+func (_c *CommissionWithdrawalCreate) SetNillablePayoutReference(v *string) *CommissionWithdrawalCreate {
+	if v != nil {
+		_c.SetPayoutReference(*v)
+	}
+	return _c
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (_c *CommissionWithdrawalCreate) SetReviewedAt(v time.Time) *CommissionWithdrawalCreate {
+	_c.mutation.SetReviewedAt(v)
+	return _c
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (_c *CommissionWithdrawalCreate) SetNillableReviewedAt(v *time.Time) *CommissionWithdrawalCreate {
+	if v != nil {
+		_c.SetReviewedAt(*v)
+	}
+	return _c
+}
+
+// SetPaidAt sets the "paid_at" field.
+func (_c *CommissionWithdrawalCreate) SetPaidAt(v time.Time) *CommissionWithdrawalCreate {
+	_c.mutation.SetPaidAt(v)
+	return _c
+}
+
+// SetNillablePaidAt sets the "paid_at" field if the given value is not nil.
+func (_c *CommissionWithdrawalCreate) SetNillablePaidAt(v *time.Time) *CommissionWithdrawalCreate {
+	if v != nil {
+		_c.SetPaidAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *CommissionWithdrawalCreate) SetCreatedAt(v time.Time) *CommissionWithdrawalCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *CommissionWithdrawalCreate) SetNillableCreatedAt(v *time.Time) *CommissionWithdrawalCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *CommissionWithdrawalCreate) SetUpdatedAt(v time.Time) *CommissionWithdrawalCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *CommissionWithdrawalCreate) SetNillableUpdatedAt(v *time.Time) *CommissionWithdrawalCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the CommissionWithdrawalMutation object of the builder.
+func (_c *CommissionWithdrawalCreate) Mutation() *CommissionWithdrawalMutation {
+	return _c.mutation
+}
+
+// Save creates the CommissionWithdrawal in the database.
+func (_c *CommissionWithdrawalCreate) Save(ctx context.Context) (*CommissionWithdrawal, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *CommissionWithdrawalCreate) SaveX(ctx context.Context) *CommissionWithdrawal {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CommissionWithdrawalCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CommissionWithdrawalCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *CommissionWithdrawalCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := commissionwithdrawal.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := commissionwithdrawal.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := commissionwithdrawal.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *CommissionWithdrawalCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "CommissionWithdrawal.user_id"`)}
+	}
+	if _, ok := _c.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "CommissionWithdrawal.amount"`)}
+	}
+	if _, ok := _c.mutation.PayoutMethod(); !ok {
+		return &ValidationError{Name: "payout_method", err: errors.New(`ent: missing required field "CommissionWithdrawal.payout_method"`)}
+	}
+	if v, ok := _c.mutation.PayoutMethod(); ok {
+		if err := commissionwithdrawal.PayoutMethodValidator(v); err != nil {
+			return &ValidationError{Name: "payout_method", err: fmt.Errorf(`ent: validator failed for field "CommissionWithdrawal.payout_method": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PayoutAccount(); !ok {
+		return &ValidationError{Name: "payout_account", err: errors.New(`ent: missing required field "CommissionWithdrawal.payout_account"`)}
+	}
+	if v, ok := _c.mutation.PayoutAccount(); ok {
+		if err := commissionwithdrawal.PayoutAccountValidator(v); err != nil {
+			return &ValidationError{Name: "payout_account", err: fmt.Errorf(`ent: validator failed for field "CommissionWithdrawal.payout_account": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.PayoutName(); ok {
+		if err := commissionwithdrawal.PayoutNameValidator(v); err != nil {
+			return &ValidationError{Name: "payout_name", err: fmt.Errorf(`ent: validator failed for field "CommissionWithdrawal.payout_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "CommissionWithdrawal.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := commissionwithdrawal.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "CommissionWithdrawal.status": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.PayoutReference(); ok {
+		if err := commissionwithdrawal.PayoutReferenceValidator(v); err != nil {
+			return &ValidationError{Name: "payout_reference", err: fmt.Errorf(`ent: validator failed for field "CommissionWithdrawal.payout_reference": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "CommissionWithdrawal.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "CommissionWithdrawal.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *CommissionWithdrawalCreate) sqlSave(ctx context.Context) (*CommissionWithdrawal, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int64(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *CommissionWithdrawalCreate) createSpec() (*CommissionWithdrawal, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CommissionWithdrawal{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(commissionwithdrawal.Table, sqlgraph.NewFieldSpec(commissionwithdrawal.FieldID, field.TypeInt64))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(commissionwithdrawal.FieldUserID, field.TypeInt64, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.Amount(); ok {
+		_spec.SetField(commissionwithdrawal.FieldAmount, field.TypeFloat64, value)
+		_node.Amount = value
+	}
+	if value, ok := _c.mutation.PayoutMethod(); ok {
+		_spec.SetField(commissionwithdrawal.FieldPayoutMethod, field.TypeString, value)
+		_node.PayoutMethod = value
+	}
+	if value, ok := _c.mutation.PayoutAccount(); ok {
+		_spec.SetField(commissionwithdrawal.FieldPayoutAccount, field.TypeString, value)
+		_node.PayoutAccount = value
+	}
+	if value, ok := _c.mutation.PayoutName(); ok {
+		_spec.SetField(commissionwithdrawal.FieldPayoutName, field.TypeString, value)
+		_node.PayoutName = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(commissionwithdrawal.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.ReviewerID(); ok {
+		_spec.SetField(commissionwithdrawal.FieldReviewerID, field.TypeInt64, value)
+		_node.ReviewerID = &value
+	}
+	if value, ok := _c.mutation.ReviewNote(); ok {
+		_spec.SetField(commissionwithdrawal.FieldReviewNote, field.TypeString, value)
+		_node.ReviewNote = value
+	}
+	if value, ok := _c.mutation.PayoutReference(); ok {
+		_spec.SetField(commissionwithdrawal.FieldPayoutReference, field.TypeString, value)
+		_node.PayoutReference = value
+	}
+	if value, ok := _c.mutation.ReviewedAt(); ok {
+		_spec.SetField(commissionwithdrawal.FieldReviewedAt, field.TypeTime, value)
+		_node.ReviewedAt = &value
+	}
+	if value, ok := _c.mutation.PaidAt(); ok {
+		_spec.SetField(commissionwithdrawal.FieldPaidAt, field.TypeTime, value)
+		_node.PaidAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(commissionwithdrawal.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(commissionwithdrawal.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CommissionWithdrawal.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CommissionWithdrawalUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *CommissionWithdrawalCreate) OnConflict(opts ...sql.ConflictOption) *CommissionWithdrawalUpsertOne {
+	_c.conflict = opts
+	return &CommissionWithdrawalUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CommissionWithdrawal.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *CommissionWithdrawalCreate) OnConflictColumns(columns ...string) *CommissionWithdrawalUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &CommissionWithdrawalUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// CommissionWithdrawalUpsertOne is the builder for "upsert"-ing
+	//  one CommissionWithdrawal node.
+	CommissionWithdrawalUpsertOne struct {
+		create *CommissionWithdrawalCreate
+	}
+
+	// CommissionWithdrawalUpsert is the "OnConflict" setter.
+	CommissionWithdrawalUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUserID sets the "user_id" field.
+func (u *CommissionWithdrawalUpsert) SetUserID(v int64) *CommissionWithdrawalUpsert {
+	u.Set(commissionwithdrawal.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *CommissionWithdrawalUpsert) UpdateUserID() *CommissionWithdrawalUpsert {
+	u.SetExcluded(commissionwithdrawal.FieldUserID)
+	return u
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *CommissionWithdrawalUpsert) AddUserID(v int64) *CommissionWithdrawalUpsert {
+	u.Add(commissionwithdrawal.FieldUserID, v)
+	return u
+}
+
+// SetAmount sets the "amount" field.
+func (u *CommissionWithdrawalUpsert) SetAmount(v float64) *CommissionWithdrawalUpsert {
+	u.Set(commissionwithdrawal.FieldAmount, v)
+	return u
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *CommissionWithdrawalUpsert) UpdateAmount() *CommissionWithdrawalUpsert {
+	u.SetExcluded(commissionwithdrawal.FieldAmount)
+	return u
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *CommissionWithdrawalUpsert) AddAmount(v float64) *CommissionWithdrawalUpsert {
+	u.Add(commissionwithdrawal.FieldAmount, v)
+	return u
+}
+
+// SetPayoutMethod sets the "payout_method" field.
+func (u *CommissionWithdrawalUpsert) SetPayoutMethod(v string) *CommissionWithdrawalUpsert {
+	u.Set(commissionwithdrawal.FieldPayoutMethod, v)
+	return u
+}
+
+// UpdatePayoutMethod sets the "payout_method" field to the value that was provided on create.
+func (u *CommissionWithdrawalUpsert) UpdatePayoutMethod() *CommissionWithdrawalUpsert {
+	u.SetExcluded(commissionwithdrawal.FieldPayoutMethod)
+	return u
+}
+
+// SetPayoutAccount sets the "payout_account" field.
+func (u *CommissionWithdrawalUpsert) SetPayoutAccount(v string) *CommissionWithdrawalUpsert {
+	u.Set(commissionwithdrawal.FieldPayoutAccount, v)
+	return u
+}
+
+// UpdatePayoutAccount sets the "payout_account" field to the value that was provided on create.
+func (u *CommissionWithdrawalUpsert) UpdatePayoutAccount() *CommissionWithdrawalUpsert {
+	u.SetExcluded(commissionwithdrawal.FieldPayoutAccount)
+	return u
+}
+
+// SetPayoutName sets the "payout_name" field.
+func (u *CommissionWithdrawalUpsert) SetPayoutName(v string) *CommissionWithdrawalUpsert {
+	u.Set(commissionwithdrawal.FieldPayoutName, v)
+	return u
+}
+
+// UpdatePayoutName sets the "payout_name" field to the value that was provided on create.
+func (u *CommissionWithdrawalUpsert) UpdatePayoutName() *CommissionWithdrawalUpsert {
+	u.SetExcluded(commissionwithdrawal.FieldPayoutName)
+	return u
+}
+
+// ClearPayoutName clears the value of the "payout_name" field.
+func (u *CommissionWithdrawalUpsert) ClearPayoutName() *CommissionWithdrawalUpsert {
+	u.SetNull(commissionwithdrawal.FieldPayoutName)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *CommissionWithdrawalUpsert) SetStatus(v string) *CommissionWithdrawalUpsert {
+	u.Set(commissionwithdrawal.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *CommissionWithdrawalUpsert) UpdateStatus() *CommissionWithdrawalUpsert {
+	u.SetExcluded(commissionwithdrawal.FieldStatus)
+	return u
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (u *CommissionWithdrawalUpsert) SetReviewerID(v int64) *CommissionWithdrawalUpsert {
+	u.Set(commissionwithdrawal.FieldReviewerID, v)
+	return u
+}
+
+// UpdateReviewerID sets the "reviewer_id" field to the value that was provided on create.
+func (u *CommissionWithdrawalUpsert) UpdateReviewerID() *CommissionWithdrawalUpsert {
+	u.SetExcluded(commissionwithdrawal.FieldReviewerID)
+	return u
+}
+
+// AddReviewerID adds v to the "reviewer_id" field.
+func (u *CommissionWithdrawalUpsert) AddReviewerID(v int64) *CommissionWithdrawalUpsert {
+	u.Add(commissionwithdrawal.FieldReviewerID, v)
+	return u
+}
+
+// ClearReviewerID clears the value of the "reviewer_id" field.
+func (u *CommissionWithdrawalUpsert) ClearReviewerID() *CommissionWithdrawalUpsert {
+	u.SetNull(commissionwithdrawal.FieldReviewerID)
+	return u
+}
+
+// SetReviewNote sets the "review_note" field.
+func (u *CommissionWithdrawalUpsert) SetReviewNote(v string) *CommissionWithdrawalUpsert {
+	u.Set(commissionwithdrawal.FieldReviewNote, v)
+	return u
+}
+
+// UpdateReviewNote sets the "review_note" field to the value that was provided on create.
+func (u *CommissionWithdrawalUpsert) UpdateReviewNote() *CommissionWithdrawalUpsert {
+	u.SetExcluded(commissionwithdrawal.FieldReviewNote)
+	return u
+}
+
+// ClearReviewNote clears the value of the "review_note" field.
+func (u *CommissionWithdrawalUpsert) ClearReviewNote() *CommissionWithdrawalUpsert {
+	u.SetNull(commissionwithdrawal.FieldReviewNote)
+	return u
+}
+
+// SetPayoutReference sets the "payout_reference" field.
+func (u *CommissionWithdrawalUpsert) SetPayoutReference(v string) *CommissionWithdrawalUpsert {
+	u.Set(commissionwithdrawal.FieldPayoutReference, v)
+	return u
+}
+
+// UpdatePayoutReference sets the "payout_reference" field to the value that was provided on create.
+func (u *CommissionWithdrawalUpsert) UpdatePayoutReference() *CommissionWithdrawalUpsert {
+	u.SetExcluded(commissionwithdrawal.FieldPayoutReference)
+	return u
+}
+
+// ClearPayoutReference clears the value of the "payout_reference" field.
+func (u *CommissionWithdrawalUpsert) ClearPayoutReference() *CommissionWithdrawalUpsert {
+	u.SetNull(commissionwithdrawal.FieldPayoutReference)
+	return u
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (u *CommissionWithdrawalUpsert) SetReviewedAt(v time.Time) *CommissionWithdrawalUpsert {
+	u.Set(commissionwithdrawal.FieldReviewedAt, v)
+	return u
+}
+
+// UpdateReviewedAt sets the "reviewed_at" field to the value that was provided on create.
+func (u *CommissionWithdrawalUpsert) UpdateReviewedAt() *CommissionWithdrawalUpsert {
+	u.SetExcluded(commissionwithdrawal.FieldReviewedAt)
+	return u
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (u *CommissionWithdrawalUpsert) ClearReviewedAt() *CommissionWithdrawalUpsert {
+	u.SetNull(commissionwithdrawal.FieldReviewedAt)
+	return u
+}
+
+// SetPaidAt sets the "paid_at" field.
+func (u *CommissionWithdrawalUpsert) SetPaidAt(v time.Time) *CommissionWithdrawalUpsert {
+	u.Set(commissionwithdrawal.FieldPaidAt, v)
+	return u
+}
+
+// UpdatePaidAt sets the "paid_at" field to the value that was provided on create.
+func (u *CommissionWithdrawalUpsert) UpdatePaidAt() *CommissionWithdrawalUpsert {
+	u.SetExcluded(commissionwithdrawal.FieldPaidAt)
+	return u
+}
+
+// ClearPaidAt clears the value of the "paid_at" field.
+func (u *CommissionWithdrawalUpsert) ClearPaidAt() *CommissionWithdrawalUpsert {
+	u.SetNull(commissionwithdrawal.FieldPaidAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CommissionWithdrawalUpsert) SetUpdatedAt(v time.Time) *CommissionWithdrawalUpsert {
+	u.Set(commissionwithdrawal.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CommissionWithdrawalUpsert) UpdateUpdatedAt() *CommissionWithdrawalUpsert {
+	u.SetExcluded(commissionwithdrawal.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.CommissionWithdrawal.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *CommissionWithdrawalUpsertOne) UpdateNewValues() *CommissionWithdrawalUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(commissionwithdrawal.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CommissionWithdrawal.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *CommissionWithdrawalUpsertOne) Ignore() *CommissionWithdrawalUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CommissionWithdrawalUpsertOne) DoNothing() *CommissionWithdrawalUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CommissionWithdrawalCreate.OnConflict
+// documentation for more info.
+func (u *CommissionWithdrawalUpsertOne) Update(set func(*CommissionWithdrawalUpsert)) *CommissionWithdrawalUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CommissionWithdrawalUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *CommissionWithdrawalUpsertOne) SetUserID(v int64) *CommissionWithdrawalUpsertOne {
+	return u.Update(func(s *CommissionWithdrawalUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *CommissionWithdrawalUpsertOne) AddUserID(v int64) *CommissionWithdrawalUpsertOne {
+	return u.Update(func(s *CommissionWithdrawalUpsert) {
+		s.AddUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *CommissionWithdrawalUpsertOne) UpdateUserID() *CommissionWithdrawalUpsertOne {
+	return u.Update(func(s *CommissionWithdrawalUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *CommissionWithdrawalUpsertOne) SetAmount(v float64) *CommissionWithdrawalUpsertOne {
+	return u.Update(func(s *CommissionWithdrawalUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *CommissionWithdrawalUpsertOne) AddAmount(v float64) *CommissionWithdrawalUpsertOne {
+	return u.Update(func(s *CommissionWithdrawalUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *CommissionWithdrawalUpsertOne) UpdateAmount() *CommissionWithdrawalUpsertOne {
+	return u.Update(func(s *CommissionWithdrawalUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetPayoutMethod sets the "payout_method" field.
+func (u *CommissionWithdrawalUpsertOne) SetPayoutMethod(v string) *CommissionWithdrawalUpsertOne {
+	return u.Update(func(s *CommissionWithdrawalUpsert) {
+		s.SetPayoutMethod(v)
+	})
+}
+
+// UpdatePayoutMethod sets the "payout_method" field to the value that was provided on create.
+func (u *CommissionWithdrawalUpsertOne) UpdatePayoutMethod() *CommissionWithdrawalUpsertOne {
+	return u.Update(func(s *CommissionWithdrawalUpsert) {
+		s.UpdatePayoutMethod()
+	})
+}
+
+// SetPayoutAccount sets the "payout_account" field.
+func (u *CommissionWithdrawalUpsertOne) SetPayoutAccount(v string) *CommissionWithdrawalUpsertOne {
+	return u.Update(func(s *CommissionWithdrawalUpsert) {
+		s.SetPayoutAccount(v)
+	})
+}
+
+// UpdatePayoutAccount sets the "payout_account" field to the value that was provided on create.
+func (u *CommissionWithdrawalUpsertOne) UpdatePayoutAccount() *CommissionWithdrawalUpsertOne {
+	return u.Update(func(s *CommissionWithdrawalUpsert) {
+		s.UpdatePayoutAccount()
+	})
+}
+
+// SetPayoutName sets the "payout_name" field.
+func (u *CommissionWithdrawalUpsertOne) SetPayoutName(v string) *CommissionWithdrawalUpsertOne {
+	return u.Update(func(s *CommissionWithdrawalUpsert) {
+		s.SetPayoutName(v)
+	})
+}
+
+// UpdatePayoutName sets the "payout_name" field to the value that was provided on create.
+func (u *CommissionWithdrawalUpsertOne) UpdatePayoutName() *CommissionWithdrawalUpsertOne {
+	return u.Update(func(s *CommissionWithdrawalUpsert) {
+		s.UpdatePayoutName()
+	})
+}
+
+// ClearPayoutName clears the value of the "payout_name" field.
+func (u *CommissionWithdrawalUpsertOne) ClearPayoutName() *CommissionWithdrawalUpsertOne {
+	return u.Update(func(s *CommissionWithdrawalUpsert) {
+		s.ClearPayoutName()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *CommissionWithdrawalUpsertOne) SetStatus(v string) *CommissionWithdrawalUpsertOne {
+	return u.Update(func(s *CommissionWithdrawalUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *CommissionWithdrawalUpsertOne) UpdateStatus() *CommissionWithdrawalUpsertOne {
+	return u.Update(func(s *CommissionWithdrawalUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (u *CommissionWithdrawalUpsertOne) SetReviewerID(v int64) *CommissionWithdrawalUpsertOne {
+	return u.Update(func(s *CommissionWithdrawalUpsert) {
+		s.SetReviewerID(v)
+	})
+}
+
+// AddReviewerID adds v to the "reviewer_id" field.
+func (u *CommissionWithdrawalUpsertOne) AddReviewerID(v int64) *CommissionWithdrawalUpsertOne {
+	return u.Update(func(s *CommissionWithdrawalUpsert) {
+		s.AddReviewerID(v)
+	})
+}
+
+// UpdateReviewerID sets the "reviewer_id" field to the value that was provided on create.
+func (u *CommissionWithdrawalUpsertOne) UpdateReviewerID() *CommissionWithdrawalUpsertOne {
+	return u.Update(func(s *CommissionWithdrawalUpsert) {
+		s.UpdateReviewerID()
+	})
+}
+
+// ClearReviewerID clears the value of the "reviewer_id" field.
+func (u *CommissionWithdrawalUpsertOne) ClearReviewerID() *CommissionWithdrawalUpsertOne {
+	return u.Update(func(s *CommissionWithdrawalUpsert) {
+		s.ClearReviewerID()
+	})
+}
+
+// SetReviewNote sets the "review_note" field.
+func (u *CommissionWithdrawalUpsertOne) SetReviewNote(v string) *CommissionWithdrawalUpsertOne {
+	return u.Update(func(s *CommissionWithdrawalUpsert) {
+		s.SetReviewNote(v)
+	})
+}
+
+// UpdateReviewNote sets the "review_note" field to the value that was provided on create.
+func (u *CommissionWithdrawalUpsertOne) UpdateReviewNote() *CommissionWithdrawalUpsertOne {
+	return u.Update(func(s *CommissionWithdrawalUpsert) {
+		s.UpdateReviewNote()
+	})
+}
+
+// ClearReviewNote clears the value of the "review_note" field.
+func (u *CommissionWithdrawalUpsertOne) ClearReviewNote() *CommissionWithdrawalUpsertOne {
+	return u.Update(func(s *CommissionWithdrawalUpsert) {
+		s.ClearReviewNote()
+	})
+}
+
+// SetPayoutReference sets the "payout_reference" field.
+func (u *CommissionWithdrawalUpsertOne) SetPayoutReference(v string) *CommissionWithdrawalUpsertOne {
+	return u.Update(func(s *CommissionWithdrawalUpsert) {
+		s.SetPayoutReference(v)
+	})
+}
+
+// UpdatePayoutReference sets the "payout_reference" field to the value that was provided on create.
+func (u *CommissionWithdrawalUpsertOne) UpdatePayoutReference() *CommissionWithdrawalUpsertOne {
+	return u.Update(func(s *CommissionWithdrawalUpsert) {
+		s.UpdatePayoutReference()
+	})
+}
+
+// ClearPayoutReference clears the value of the "payout_reference" field.
+func (u *CommissionWithdrawalUpsertOne) ClearPayoutReference() *CommissionWithdrawalUpsertOne {
+	return u.Update(func(s *CommissionWithdrawalUpsert) {
+		s.ClearPayoutReference()
+	})
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (u *CommissionWithdrawalUpsertOne) SetReviewedAt(v time.Time) *CommissionWithdrawalUpsertOne {
+	return u.Update(func(s *CommissionWithdrawalUpsert) {
+		s.SetReviewedAt(v)
+	})
+}
+
+// UpdateReviewedAt sets the "reviewed_at" field to the value that was provided on create.
+func (u *CommissionWithdrawalUpsertOne) UpdateReviewedAt() *CommissionWithdrawalUpsertOne {
+	return u.Update(func(s *CommissionWithdrawalUpsert) {
+		s.UpdateReviewedAt()
+	})
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (u *CommissionWithdrawalUpsertOne) ClearReviewedAt() *CommissionWithdrawalUpsertOne {
+	return u.Update(func(s *CommissionWithdrawalUpsert) {
+		s.ClearReviewedAt()
+	})
+}
+
+// SetPaidAt sets the "paid_at" field.
+func (u *CommissionWithdrawalUpsertOne) SetPaidAt(v time.Time) *CommissionWithdrawalUpsertOne {
+	return u.Update(func(s *CommissionWithdrawalUpsert) {
+		s.SetPaidAt(v)
+	})
+}
+
+// UpdatePaidAt sets the "paid_at" field to the value that was provided on create.
+func (u *CommissionWithdrawalUpsertOne) UpdatePaidAt() *CommissionWithdrawalUpsertOne {
+	return u.Update(func(s *CommissionWithdrawalUpsert) {
+		s.UpdatePaidAt()
+	})
+}
+
+// ClearPaidAt clears the value of the "paid_at" field.
+func (u *CommissionWithdrawalUpsertOne) ClearPaidAt() *CommissionWithdrawalUpsertOne {
+	return u.Update(func(s *CommissionWithdrawalUpsert) {
+		s.ClearPaidAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CommissionWithdrawalUpsertOne) SetUpdatedAt(v time.Time) *CommissionWithdrawalUpsertOne {
+	return u.Update(func(s *CommissionWithdrawalUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CommissionWithdrawalUpsertOne) UpdateUpdatedAt() *CommissionWithdrawalUpsertOne {
+	return u.Update(func(s *CommissionWithdrawalUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *CommissionWithdrawalUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CommissionWithdrawalCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CommissionWithdrawalUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *CommissionWithdrawalUpsertOne) ID(ctx context.Context) (id int64, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *CommissionWithdrawalUpsertOne) IDX(ctx context.Context) int64 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// CommissionWithdrawalCreateBulk is the builder for creating many CommissionWithdrawal entities in bulk.
+type CommissionWithdrawalCreateBulk struct {
+	config
+	err      error
+	builders []*CommissionWithdrawalCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the CommissionWithdrawal entities in the database.
+func (_c *CommissionWithdrawalCreateBulk) Save(ctx context.Context) ([]*CommissionWithdrawal, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*CommissionWithdrawal, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CommissionWithdrawalMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *CommissionWithdrawalCreateBulk) SaveX(ctx context.Context) []*CommissionWithdrawal {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CommissionWithdrawalCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CommissionWithdrawalCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CommissionWithdrawal.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CommissionWithdrawalUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *CommissionWithdrawalCreateBulk) OnConflict(opts ...sql.ConflictOption) *CommissionWithdrawalUpsertBulk {
+	_c.conflict = opts
+	return &CommissionWithdrawalUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CommissionWithdrawal.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *CommissionWithdrawalCreateBulk) OnConflictColumns(columns ...string) *CommissionWithdrawalUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &CommissionWithdrawalUpsertBulk{
+		create: _c,
+	}
+}
+
+// CommissionWithdrawalUpsertBulk is the builder for "upsert"-ing
+// a bulk of CommissionWithdrawal nodes.
+type CommissionWithdrawalUpsertBulk struct {
+	create *CommissionWithdrawalCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.CommissionWithdrawal.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *CommissionWithdrawalUpsertBulk) UpdateNewValues() *CommissionWithdrawalUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(commissionwithdrawal.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CommissionWithdrawal.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *CommissionWithdrawalUpsertBulk) Ignore() *CommissionWithdrawalUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CommissionWithdrawalUpsertBulk) DoNothing() *CommissionWithdrawalUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CommissionWithdrawalCreateBulk.OnConflict
+// documentation for more info.
+func (u *CommissionWithdrawalUpsertBulk) Update(set func(*CommissionWithdrawalUpsert)) *CommissionWithdrawalUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CommissionWithdrawalUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *CommissionWithdrawalUpsertBulk) SetUserID(v int64) *CommissionWithdrawalUpsertBulk {
+	return u.Update(func(s *CommissionWithdrawalUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *CommissionWithdrawalUpsertBulk) AddUserID(v int64) *CommissionWithdrawalUpsertBulk {
+	return u.Update(func(s *CommissionWithdrawalUpsert) {
+		s.AddUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *CommissionWithdrawalUpsertBulk) UpdateUserID() *CommissionWithdrawalUpsertBulk {
+	return u.Update(func(s *CommissionWithdrawalUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *CommissionWithdrawalUpsertBulk) SetAmount(v float64) *CommissionWithdrawalUpsertBulk {
+	return u.Update(func(s *CommissionWithdrawalUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *CommissionWithdrawalUpsertBulk) AddAmount(v float64) *CommissionWithdrawalUpsertBulk {
+	return u.Update(func(s *CommissionWithdrawalUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *CommissionWithdrawalUpsertBulk) UpdateAmount() *CommissionWithdrawalUpsertBulk {
+	return u.Update(func(s *CommissionWithdrawalUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetPayoutMethod sets the "payout_method" field.
+func (u *CommissionWithdrawalUpsertBulk) SetPayoutMethod(v string) *CommissionWithdrawalUpsertBulk {
+	return u.Update(func(s *CommissionWithdrawalUpsert) {
+		s.SetPayoutMethod(v)
+	})
+}
+
+// UpdatePayoutMethod sets the "payout_method" field to the value that was provided on create.
+func (u *CommissionWithdrawalUpsertBulk) UpdatePayoutMethod() *CommissionWithdrawalUpsertBulk {
+	return u.Update(func(s *CommissionWithdrawalUpsert) {
+		s.UpdatePayoutMethod()
+	})
+}
+
+// SetPayoutAccount sets the "payout_account" field.
+func (u *CommissionWithdrawalUpsertBulk) SetPayoutAccount(v string) *CommissionWithdrawalUpsertBulk {
+	return u.Update(func(s *CommissionWithdrawalUpsert) {
+		s.SetPayoutAccount(v)
+	})
+}
+
+// UpdatePayoutAccount sets the "payout_account" field to the value that was provided on create.
+func (u *CommissionWithdrawalUpsertBulk) UpdatePayoutAccount() *CommissionWithdrawalUpsertBulk {
+	return u.Update(func(s *CommissionWithdrawalUpsert) {
+		s.UpdatePayoutAccount()
+	})
+}
+
+// SetPayoutName sets the "payout_name" field.
+func (u *CommissionWithdrawalUpsertBulk) SetPayoutName(v string) *CommissionWithdrawalUpsertBulk {
+	return u.Update(func(s *CommissionWithdrawalUpsert) {
+		s.SetPayoutName(v)
+	})
+}
+
+// UpdatePayoutName sets the "payout_name" field to the value that was provided on create.
+func (u *CommissionWithdrawalUpsertBulk) UpdatePayoutName() *CommissionWithdrawalUpsertBulk {
+	return u.Update(func(s *CommissionWithdrawalUpsert) {
+		s.UpdatePayoutName()
+	})
+}
+
+// ClearPayoutName clears the value of the "payout_name" field.
+func (u *CommissionWithdrawalUpsertBulk) ClearPayoutName() *CommissionWithdrawalUpsertBulk {
+	return u.Update(func(s *CommissionWithdrawalUpsert) {
+		s.ClearPayoutName()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *CommissionWithdrawalUpsertBulk) SetStatus(v string) *CommissionWithdrawalUpsertBulk {
+	return u.Update(func(s *CommissionWithdrawalUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *CommissionWithdrawalUpsertBulk) UpdateStatus() *CommissionWithdrawalUpsertBulk {
+	return u.Update(func(s *CommissionWithdrawalUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (u *CommissionWithdrawalUpsertBulk) SetReviewerID(v int64) *CommissionWithdrawalUpsertBulk {
+	return u.Update(func(s *CommissionWithdrawalUpsert) {
+		s.SetReviewerID(v)
+	})
+}
+
+// AddReviewerID adds v to the "reviewer_id" field.
+func (u *CommissionWithdrawalUpsertBulk) AddReviewerID(v int64) *CommissionWithdrawalUpsertBulk {
+	return u.Update(func(s *CommissionWithdrawalUpsert) {
+		s.AddReviewerID(v)
+	})
+}
+
+// UpdateReviewerID sets the "reviewer_id" field to the value that was provided on create.
+func (u *CommissionWithdrawalUpsertBulk) UpdateReviewerID() *CommissionWithdrawalUpsertBulk {
+	return u.Update(func(s *CommissionWithdrawalUpsert) {
+		s.UpdateReviewerID()
+	})
+}
+
+// ClearReviewerID clears the value of the "reviewer_id" field.
+func (u *CommissionWithdrawalUpsertBulk) ClearReviewerID() *CommissionWithdrawalUpsertBulk {
+	return u.Update(func(s *CommissionWithdrawalUpsert) {
+		s.ClearReviewerID()
+	})
+}
+
+// SetReviewNote sets the "review_note" field.
+func (u *CommissionWithdrawalUpsertBulk) SetReviewNote(v string) *CommissionWithdrawalUpsertBulk {
+	return u.Update(func(s *CommissionWithdrawalUpsert) {
+		s.SetReviewNote(v)
+	})
+}
+
+// UpdateReviewNote sets the "review_note" field to the value that was provided on create.
+func (u *CommissionWithdrawalUpsertBulk) UpdateReviewNote() *CommissionWithdrawalUpsertBulk {
+	return u.Update(func(s *CommissionWithdrawalUpsert) {
+		s.UpdateReviewNote()
+	})
+}
+
+// ClearReviewNote clears the value of the "review_note" field.
+func (u *CommissionWithdrawalUpsertBulk) ClearReviewNote() *CommissionWithdrawalUpsertBulk {
+	return u.Update(func(s *CommissionWithdrawalUpsert) {
+		s.ClearReviewNote()
+	})
+}
+
+// SetPayoutReference sets the "payout_reference" field.
+func (u *CommissionWithdrawalUpsertBulk) SetPayoutReference(v string) *CommissionWithdrawalUpsertBulk {
+	return u.Update(func(s *CommissionWithdrawalUpsert) {
+		s.SetPayoutReference(v)
+	})
+}
+
+// UpdatePayoutReference sets the "payout_reference" field to the value that was provided on create.
+func (u *CommissionWithdrawalUpsertBulk) UpdatePayoutReference() *CommissionWithdrawalUpsertBulk {
+	return u.Update(func(s *CommissionWithdrawalUpsert) {
+		s.UpdatePayoutReference()
+	})
+}
+
+// ClearPayoutReference clears the value of the "payout_reference" field.
+func (u *CommissionWithdrawalUpsertBulk) ClearPayoutReference() *CommissionWithdrawalUpsertBulk {
+	return u.Update(func(s *CommissionWithdrawalUpsert) {
+		s.ClearPayoutReference()
+	})
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (u *CommissionWithdrawalUpsertBulk) SetReviewedAt(v time.Time) *CommissionWithdrawalUpsertBulk {
+	return u.Update(func(s *CommissionWithdrawalUpsert) {
+		s.SetReviewedAt(v)
+	})
+}
+
+// UpdateReviewedAt sets the "reviewed_at" field to the value that was provided on create.
+func (u *CommissionWithdrawalUpsertBulk) UpdateReviewedAt() *CommissionWithdrawalUpsertBulk {
+	return u.Update(func(s *CommissionWithdrawalUpsert) {
+		s.UpdateReviewedAt()
+	})
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (u *CommissionWithdrawalUpsertBulk) ClearReviewedAt() *CommissionWithdrawalUpsertBulk {
+	return u.Update(func(s *CommissionWithdrawalUpsert) {
+		s.ClearReviewedAt()
+	})
+}
+
+// SetPaidAt sets the "paid_at" field.
+func (u *CommissionWithdrawalUpsertBulk) SetPaidAt(v time.Time) *CommissionWithdrawalUpsertBulk {
+	return u.Update(func(s *CommissionWithdrawalUpsert) {
+		s.SetPaidAt(v)
+	})
+}
+
+// UpdatePaidAt sets the "paid_at" field to the value that was provided on create.
+func (u *CommissionWithdrawalUpsertBulk) UpdatePaidAt() *CommissionWithdrawalUpsertBulk {
+	return u.Update(func(s *CommissionWithdrawalUpsert) {
+		s.UpdatePaidAt()
+	})
+}
+
+// ClearPaidAt clears the value of the "paid_at" field.
+func (u *CommissionWithdrawalUpsertBulk) ClearPaidAt() *CommissionWithdrawalUpsertBulk {
+	return u.Update(func(s *CommissionWithdrawalUpsert) {
+		s.ClearPaidAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CommissionWithdrawalUpsertBulk) SetUpdatedAt(v time.Time) *CommissionWithdrawalUpsertBulk {
+	return u.Update(func(s *CommissionWithdrawalUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CommissionWithdrawalUpsertBulk) UpdateUpdatedAt() *CommissionWithdrawalUpsertBulk {
+	return u.Update(func(s *CommissionWithdrawalUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *CommissionWithdrawalUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the CommissionWithdrawalCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CommissionWithdrawalCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CommissionWithdrawalUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
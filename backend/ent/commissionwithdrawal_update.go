@@ -0,0 +1,820 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Wei-Shaw/sub2api/ent/commissionwithdrawal"
+	"github.com/Wei-Shaw/sub2api/ent/predicate"
+)
+
+// CommissionWithdrawalUpdate is the builder for updating CommissionWithdrawal entities.
+type CommissionWithdrawalUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CommissionWithdrawalMutation
+}
+
+// Where appends a list predicates to the CommissionWithdrawalUpdate builder.
+func (_u *CommissionWithdrawalUpdate) Where(ps ...predicate.CommissionWithdrawal) *CommissionWithdrawalUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *CommissionWithdrawalUpdate) SetUserID(v int64) *CommissionWithdrawalUpdate {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *CommissionWithdrawalUpdate) SetNillableUserID(v *int64) *CommissionWithdrawalUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *CommissionWithdrawalUpdate) AddUserID(v int64) *CommissionWithdrawalUpdate {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *CommissionWithdrawalUpdate) SetAmount(v float64) *CommissionWithdrawalUpdate {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *CommissionWithdrawalUpdate) SetNillableAmount(v *float64) *CommissionWithdrawalUpdate {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *CommissionWithdrawalUpdate) AddAmount(v float64) *CommissionWithdrawalUpdate {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetPayoutMethod sets the "payout_method" field.
+func (_u *CommissionWithdrawalUpdate) SetPayoutMethod(v string) *CommissionWithdrawalUpdate {
+	_u.mutation.SetPayoutMethod(v)
+	return _u
+}
+
+// SetNillablePayoutMethod sets the "payout_method" field if the given value is not nil.
+func (_u *CommissionWithdrawalUpdate) SetNillablePayoutMethod(v *string) *CommissionWithdrawalUpdate {
+	if v != nil {
+		_u.SetPayoutMethod(*v)
+	}
+	return _u
+}
+
+// SetPayoutAccount sets the "payout_account" field.
+func (_u *CommissionWithdrawalUpdate) SetPayoutAccount(v string) *CommissionWithdrawalUpdate {
+	_u.mutation.SetPayoutAccount(v)
+	return _u
+}
+
+// SetNillablePayoutAccount sets the "payout_account" field if the given value is not nil.
+func (_u *CommissionWithdrawalUpdate) SetNillablePayoutAccount(v *string) *CommissionWithdrawalUpdate {
+	if v != nil {
+		_u.SetPayoutAccount(*v)
+	}
+	return _u
+}
+
+// SetPayoutName sets the "payout_name" field.
+func (_u *CommissionWithdrawalUpdate) SetPayoutName(v string) *CommissionWithdrawalUpdate {
+	_u.mutation.SetPayoutName(v)
+	return _u
+}
+
+// SetNillablePayoutName sets the "payout_name" field if the given value is not nil.
+func (_u *CommissionWithdrawalUpdate) SetNillablePayoutName(v *string) *CommissionWithdrawalUpdate {
+	if v != nil {
+		_u.SetPayoutName(*v)
+	}
+	return _u
+}
+
+// ClearPayoutName clears the value of the "payout_name" field.
+func (_u *CommissionWithdrawalUpdate) ClearPayoutName() *CommissionWithdrawalUpdate {
+	_u.mutation.ClearPayoutName()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *CommissionWithdrawalUpdate) SetStatus(v string) *CommissionWithdrawalUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *CommissionWithdrawalUpdate) SetNillableStatus(v *string) *CommissionWithdrawalUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (_u *CommissionWithdrawalUpdate) SetReviewerID(v int64) *CommissionWithdrawalUpdate {
+	_u.mutation.ResetReviewerID()
+	_u.mutation.SetReviewerID(v)
+	return _u
+}
+
+// SetNillableReviewerID sets the "reviewer_id" field if the given value is not nil.
+func (_u *CommissionWithdrawalUpdate) SetNillableReviewerID(v *int64) *CommissionWithdrawalUpdate {
+	if v != nil {
+		_u.SetReviewerID(*v)
+	}
+	return _u
+}
+
+// AddReviewerID adds value to the "reviewer_id" field.
+func (_u *CommissionWithdrawalUpdate) AddReviewerID(v int64) *CommissionWithdrawalUpdate {
+	_u.mutation.AddReviewerID(v)
+	return _u
+}
+
+// ClearReviewerID clears the value of the "reviewer_id" field.
+func (_u *CommissionWithdrawalUpdate) ClearReviewerID() *CommissionWithdrawalUpdate {
+	_u.mutation.ClearReviewerID()
+	return _u
+}
+
+// SetReviewNote sets the "review_note" field.
+func (_u *CommissionWithdrawalUpdate) SetReviewNote(v string) *CommissionWithdrawalUpdate {
+	_u.mutation.SetReviewNote(v)
+	return _u
+}
+
+// SetNillableReviewNote sets the "review_note" field if the given value is not nil.
+func (_u *CommissionWithdrawalUpdate) SetNillableReviewNote(v *string) *CommissionWithdrawalUpdate {
+	if v != nil {
+		_u.SetReviewNote(*v)
+	}
+	return _u
+}
+
+// ClearReviewNote clears the value of the "review_note" field.
+func (_u *CommissionWithdrawalUpdate) ClearReviewNote() *CommissionWithdrawalUpdate {
+	_u.mutation.ClearReviewNote()
+	return _u
+}
+
+// SetPayoutReference sets the "payout_reference" field.
+func (_u *CommissionWithdrawalUpdate) SetPayoutReference(v string) *CommissionWithdrawalUpdate {
+	_u.mutation.SetPayoutReference(v)
+	return _u
+}
+
+// SetNillablePayoutReference sets the "payout_reference" field if the given value is not nil.
+func (_u *CommissionWithdrawalUpdate) SetNillablePayoutReference(v *string) *CommissionWithdrawalUpdate {
+	if v != nil {
+		_u.SetPayoutReference(*v)
+	}
+	return _u
+}
+
+// ClearPayoutReference clears the value of the "payout_reference" field.
+func (_u *CommissionWithdrawalUpdate) ClearPayoutReference() *CommissionWithdrawalUpdate {
+	_u.mutation.ClearPayoutReference()
+	return _u
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (_u *CommissionWithdrawalUpdate) SetReviewedAt(v time.Time) *CommissionWithdrawalUpdate {
+	_u.mutation.SetReviewedAt(v)
+	return _u
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (_u *CommissionWithdrawalUpdate) SetNillableReviewedAt(v *time.Time) *CommissionWithdrawalUpdate {
+	if v != nil {
+		_u.SetReviewedAt(*v)
+	}
+	return _u
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (_u *CommissionWithdrawalUpdate) ClearReviewedAt() *CommissionWithdrawalUpdate {
+	_u.mutation.ClearReviewedAt()
+	return _u
+}
+
+// SetPaidAt sets the "paid_at" field.
+func (_u *CommissionWithdrawalUpdate) SetPaidAt(v time.Time) *CommissionWithdrawalUpdate {
+	_u.mutation.SetPaidAt(v)
+	return _u
+}
+
+// SetNillablePaidAt sets the "paid_at" field if the given value is not nil.
+func (_u *CommissionWithdrawalUpdate) SetNillablePaidAt(v *time.Time) *CommissionWithdrawalUpdate {
+	if v != nil {
+		_u.SetPaidAt(*v)
+	}
+	return _u
+}
+
+// ClearPaidAt clears the value of the "paid_at" field.
+func (_u *CommissionWithdrawalUpdate) ClearPaidAt() *CommissionWithdrawalUpdate {
+	_u.mutation.ClearPaidAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *CommissionWithdrawalUpdate) SetUpdatedAt(v time.Time) *CommissionWithdrawalUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the CommissionWithdrawalMutation object of the builder.
+func (_u *CommissionWithdrawalUpdate) Mutation() *CommissionWithdrawalMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *CommissionWithdrawalUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CommissionWithdrawalUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *CommissionWithdrawalUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CommissionWithdrawalUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *CommissionWithdrawalUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := commissionwithdrawal.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CommissionWithdrawalUpdate) check() error {
+	if v, ok := _u.mutation.PayoutMethod(); ok {
+		if err := commissionwithdrawal.PayoutMethodValidator(v); err != nil {
+			return &ValidationError{Name: "payout_method", err: fmt.Errorf(`ent: validator failed for field "CommissionWithdrawal.payout_method": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PayoutAccount(); ok {
+		if err := commissionwithdrawal.PayoutAccountValidator(v); err != nil {
+			return &ValidationError{Name: "payout_account", err: fmt.Errorf(`ent: validator failed for field "CommissionWithdrawal.payout_account": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PayoutName(); ok {
+		if err := commissionwithdrawal.PayoutNameValidator(v); err != nil {
+			return &ValidationError{Name: "payout_name", err: fmt.Errorf(`ent: validator failed for field "CommissionWithdrawal.payout_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := commissionwithdrawal.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "CommissionWithdrawal.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PayoutReference(); ok {
+		if err := commissionwithdrawal.PayoutReferenceValidator(v); err != nil {
+			return &ValidationError{Name: "payout_reference", err: fmt.Errorf(`ent: validator failed for field "CommissionWithdrawal.payout_reference": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *CommissionWithdrawalUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(commissionwithdrawal.Table, commissionwithdrawal.Columns, sqlgraph.NewFieldSpec(commissionwithdrawal.FieldID, field.TypeInt64))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(commissionwithdrawal.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(commissionwithdrawal.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(commissionwithdrawal.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(commissionwithdrawal.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.PayoutMethod(); ok {
+		_spec.SetField(commissionwithdrawal.FieldPayoutMethod, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PayoutAccount(); ok {
+		_spec.SetField(commissionwithdrawal.FieldPayoutAccount, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PayoutName(); ok {
+		_spec.SetField(commissionwithdrawal.FieldPayoutName, field.TypeString, value)
+	}
+	if _u.mutation.PayoutNameCleared() {
+		_spec.ClearField(commissionwithdrawal.FieldPayoutName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(commissionwithdrawal.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ReviewerID(); ok {
+		_spec.SetField(commissionwithdrawal.FieldReviewerID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedReviewerID(); ok {
+		_spec.AddField(commissionwithdrawal.FieldReviewerID, field.TypeInt64, value)
+	}
+	if _u.mutation.ReviewerIDCleared() {
+		_spec.ClearField(commissionwithdrawal.FieldReviewerID, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.ReviewNote(); ok {
+		_spec.SetField(commissionwithdrawal.FieldReviewNote, field.TypeString, value)
+	}
+	if _u.mutation.ReviewNoteCleared() {
+		_spec.ClearField(commissionwithdrawal.FieldReviewNote, field.TypeString)
+	}
+	if value, ok := _u.mutation.PayoutReference(); ok {
+		_spec.SetField(commissionwithdrawal.FieldPayoutReference, field.TypeString, value)
+	}
+	if _u.mutation.PayoutReferenceCleared() {
+		_spec.ClearField(commissionwithdrawal.FieldPayoutReference, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReviewedAt(); ok {
+		_spec.SetField(commissionwithdrawal.FieldReviewedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReviewedAtCleared() {
+		_spec.ClearField(commissionwithdrawal.FieldReviewedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.PaidAt(); ok {
+		_spec.SetField(commissionwithdrawal.FieldPaidAt, field.TypeTime, value)
+	}
+	if _u.mutation.PaidAtCleared() {
+		_spec.ClearField(commissionwithdrawal.FieldPaidAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(commissionwithdrawal.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{commissionwithdrawal.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// CommissionWithdrawalUpdateOne is the builder for updating a single CommissionWithdrawal entity.
+type CommissionWithdrawalUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CommissionWithdrawalMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *CommissionWithdrawalUpdateOne) SetUserID(v int64) *CommissionWithdrawalUpdateOne {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *CommissionWithdrawalUpdateOne) SetNillableUserID(v *int64) *CommissionWithdrawalUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *CommissionWithdrawalUpdateOne) AddUserID(v int64) *CommissionWithdrawalUpdateOne {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *CommissionWithdrawalUpdateOne) SetAmount(v float64) *CommissionWithdrawalUpdateOne {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *CommissionWithdrawalUpdateOne) SetNillableAmount(v *float64) *CommissionWithdrawalUpdateOne {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *CommissionWithdrawalUpdateOne) AddAmount(v float64) *CommissionWithdrawalUpdateOne {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetPayoutMethod sets the "payout_method" field.
+func (_u *CommissionWithdrawalUpdateOne) SetPayoutMethod(v string) *CommissionWithdrawalUpdateOne {
+	_u.mutation.SetPayoutMethod(v)
+	return _u
+}
+
+// SetNillablePayoutMethod sets the "payout_method" field if the given value is not nil.
+func (_u *CommissionWithdrawalUpdateOne) SetNillablePayoutMethod(v *string) *CommissionWithdrawalUpdateOne {
+	if v != nil {
+		_u.SetPayoutMethod(*v)
+	}
+	return _u
+}
+
+// SetPayoutAccount sets the "payout_account" field.
+func (_u *CommissionWithdrawalUpdateOne) SetPayoutAccount(v string) *CommissionWithdrawalUpdateOne {
+	_u.mutation.SetPayoutAccount(v)
+	return _u
+}
+
+// SetNillablePayoutAccount sets the "payout_account" field if the given value is not nil.
+func (_u *CommissionWithdrawalUpdateOne) SetNillablePayoutAccount(v *string) *CommissionWithdrawalUpdateOne {
+	if v != nil {
+		_u.SetPayoutAccount(*v)
+	}
+	return _u
+}
+
+// SetPayoutName sets the "payout_name" field.
+func (_u *CommissionWithdrawalUpdateOne) SetPayoutName(v string) *CommissionWithdrawalUpdateOne {
+	_u.mutation.SetPayoutName(v)
+	return _u
+}
+
+// SetNillablePayoutName sets the "payout_name" field if the given value is not nil.
+func (_u *CommissionWithdrawalUpdateOne) SetNillablePayoutName(v *string) *CommissionWithdrawalUpdateOne {
+	if v != nil {
+		_u.SetPayoutName(*v)
+	}
+	return _u
+}
+
+// ClearPayoutName clears the value of the "payout_name" field.
+func (_u *CommissionWithdrawalUpdateOne) ClearPayoutName() *CommissionWithdrawalUpdateOne {
+	_u.mutation.ClearPayoutName()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *CommissionWithdrawalUpdateOne) SetStatus(v string) *CommissionWithdrawalUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *CommissionWithdrawalUpdateOne) SetNillableStatus(v *string) *CommissionWithdrawalUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (_u *CommissionWithdrawalUpdateOne) SetReviewerID(v int64) *CommissionWithdrawalUpdateOne {
+	_u.mutation.ResetReviewerID()
+	_u.mutation.SetReviewerID(v)
+	return _u
+}
+
+// SetNillableReviewerID sets the "reviewer_id" field if the given value is not nil.
+func (_u *CommissionWithdrawalUpdateOne) SetNillableReviewerID(v *int64) *CommissionWithdrawalUpdateOne {
+	if v != nil {
+		_u.SetReviewerID(*v)
+	}
+	return _u
+}
+
+// AddReviewerID adds value to the "reviewer_id" field.
+func (_u *CommissionWithdrawalUpdateOne) AddReviewerID(v int64) *CommissionWithdrawalUpdateOne {
+	_u.mutation.AddReviewerID(v)
+	return _u
+}
+
+// ClearReviewerID clears the value of the "reviewer_id" field.
+func (_u *CommissionWithdrawalUpdateOne) ClearReviewerID() *CommissionWithdrawalUpdateOne {
+	_u.mutation.ClearReviewerID()
+	return _u
+}
+
+// SetReviewNote sets the "review_note" field.
+func (_u *CommissionWithdrawalUpdateOne) SetReviewNote(v string) *CommissionWithdrawalUpdateOne {
+	_u.mutation.SetReviewNote(v)
+	return _u
+}
+
+// SetNillableReviewNote sets the "review_note" field if the given value is not nil.
+func (_u *CommissionWithdrawalUpdateOne) SetNillableReviewNote(v *string) *CommissionWithdrawalUpdateOne {
+	if v != nil {
+		_u.SetReviewNote(*v)
+	}
+	return _u
+}
+
+// ClearReviewNote clears the value of the "review_note" field.
+func (_u *CommissionWithdrawalUpdateOne) ClearReviewNote() *CommissionWithdrawalUpdateOne {
+	_u.mutation.ClearReviewNote()
+	return _u
+}
+
+// SetPayoutReference sets the "payout_reference" field.
+func (_u *CommissionWithdrawalUpdateOne) SetPayoutReference(v string) *CommissionWithdrawalUpdateOne {
+	_u.mutation.SetPayoutReference(v)
+	return _u
+}
+
+// SetNillablePayoutReference sets the "payout_reference" field if the given value is not nil.
+func (_u *CommissionWithdrawalUpdateOne) SetNillablePayoutReference(v *string) *CommissionWithdrawalUpdateOne {
+	if v != nil {
+		_u.SetPayoutReference(*v)
+	}
+	return _u
+}
+
+// ClearPayoutReference clears the value of the "payout_reference" field.
+func (_u *CommissionWithdrawalUpdateOne) ClearPayoutReference() *CommissionWithdrawalUpdateOne {
+	_u.mutation.ClearPayoutReference()
+	return _u
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (_u *CommissionWithdrawalUpdateOne) SetReviewedAt(v time.Time) *CommissionWithdrawalUpdateOne {
+	_u.mutation.SetReviewedAt(v)
+	return _u
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (_u *CommissionWithdrawalUpdateOne) SetNillableReviewedAt(v *time.Time) *CommissionWithdrawalUpdateOne {
+	if v != nil {
+		_u.SetReviewedAt(*v)
+	}
+	return _u
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (_u *CommissionWithdrawalUpdateOne) ClearReviewedAt() *CommissionWithdrawalUpdateOne {
+	_u.mutation.ClearReviewedAt()
+	return _u
+}
+
+// SetPaidAt sets the "paid_at" field.
+func (_u *CommissionWithdrawalUpdateOne) SetPaidAt(v time.Time) *CommissionWithdrawalUpdateOne {
+	_u.mutation.SetPaidAt(v)
+	return _u
+}
+
+// SetNillablePaidAt sets the "paid_at" field if the given value is not nil.
+func (_u *CommissionWithdrawalUpdateOne) SetNillablePaidAt(v *time.Time) *CommissionWithdrawalUpdateOne {
+	if v != nil {
+		_u.SetPaidAt(*v)
+	}
+	return _u
+}
+
+// ClearPaidAt clears the value of the "paid_at" field.
+func (_u *CommissionWithdrawalUpdateOne) ClearPaidAt() *CommissionWithdrawalUpdateOne {
+	_u.mutation.ClearPaidAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *CommissionWithdrawalUpdateOne) SetUpdatedAt(v time.Time) *CommissionWithdrawalUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the CommissionWithdrawalMutation object of the builder.
+func (_u *CommissionWithdrawalUpdateOne) Mutation() *CommissionWithdrawalMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the CommissionWithdrawalUpdate builder.
+func (_u *CommissionWithdrawalUpdateOne) Where(ps ...predicate.CommissionWithdrawal) *CommissionWithdrawalUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *CommissionWithdrawalUpdateOne) Select(field string, fields ...string) *CommissionWithdrawalUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated CommissionWithdrawal entity.
+func (_u *CommissionWithdrawalUpdateOne) Save(ctx context.Context) (*CommissionWithdrawal, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CommissionWithdrawalUpdateOne) SaveX(ctx context.Context) *CommissionWithdrawal {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *CommissionWithdrawalUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CommissionWithdrawalUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *CommissionWithdrawalUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := commissionwithdrawal.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CommissionWithdrawalUpdateOne) check() error {
+	if v, ok := _u.mutation.PayoutMethod(); ok {
+		if err := commissionwithdrawal.PayoutMethodValidator(v); err != nil {
+			return &ValidationError{Name: "payout_method", err: fmt.Errorf(`ent: validator failed for field "CommissionWithdrawal.payout_method": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PayoutAccount(); ok {
+		if err := commissionwithdrawal.PayoutAccountValidator(v); err != nil {
+			return &ValidationError{Name: "payout_account", err: fmt.Errorf(`ent: validator failed for field "CommissionWithdrawal.payout_account": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PayoutName(); ok {
+		if err := commissionwithdrawal.PayoutNameValidator(v); err != nil {
+			return &ValidationError{Name: "payout_name", err: fmt.Errorf(`ent: validator failed for field "CommissionWithdrawal.payout_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := commissionwithdrawal.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "CommissionWithdrawal.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PayoutReference(); ok {
+		if err := commissionwithdrawal.PayoutReferenceValidator(v); err != nil {
+			return &ValidationError{Name: "payout_reference", err: fmt.Errorf(`ent: validator failed for field "CommissionWithdrawal.payout_reference": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *CommissionWithdrawalUpdateOne) sqlSave(ctx context.Context) (_node *CommissionWithdrawal, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(commissionwithdrawal.Table, commissionwithdrawal.Columns, sqlgraph.NewFieldSpec(commissionwithdrawal.FieldID, field.TypeInt64))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CommissionWithdrawal.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, commissionwithdrawal.FieldID)
+		for _, f := range fields {
+			if !commissionwithdrawal.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != commissionwithdrawal.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(commissionwithdrawal.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(commissionwithdrawal.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(commissionwithdrawal.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(commissionwithdrawal.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.PayoutMethod(); ok {
+		_spec.SetField(commissionwithdrawal.FieldPayoutMethod, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PayoutAccount(); ok {
+		_spec.SetField(commissionwithdrawal.FieldPayoutAccount, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PayoutName(); ok {
+		_spec.SetField(commissionwithdrawal.FieldPayoutName, field.TypeString, value)
+	}
+	if _u.mutation.PayoutNameCleared() {
+		_spec.ClearField(commissionwithdrawal.FieldPayoutName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(commissionwithdrawal.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ReviewerID(); ok {
+		_spec.SetField(commissionwithdrawal.FieldReviewerID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedReviewerID(); ok {
+		_spec.AddField(commissionwithdrawal.FieldReviewerID, field.TypeInt64, value)
+	}
+	if _u.mutation.ReviewerIDCleared() {
+		_spec.ClearField(commissionwithdrawal.FieldReviewerID, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.ReviewNote(); ok {
+		_spec.SetField(commissionwithdrawal.FieldReviewNote, field.TypeString, value)
+	}
+	if _u.mutation.ReviewNoteCleared() {
+		_spec.ClearField(commissionwithdrawal.FieldReviewNote, field.TypeString)
+	}
+	if value, ok := _u.mutation.PayoutReference(); ok {
+		_spec.SetField(commissionwithdrawal.FieldPayoutReference, field.TypeString, value)
+	}
+	if _u.mutation.PayoutReferenceCleared() {
+		_spec.ClearField(commissionwithdrawal.FieldPayoutReference, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReviewedAt(); ok {
+		_spec.SetField(commissionwithdrawal.FieldReviewedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReviewedAtCleared() {
+		_spec.ClearField(commissionwithdrawal.FieldReviewedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.PaidAt(); ok {
+		_spec.SetField(commissionwithdrawal.FieldPaidAt, field.TypeTime, value)
+	}
+	if _u.mutation.PaidAtCleared() {
+		_spec.ClearField(commissionwithdrawal.FieldPaidAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(commissionwithdrawal.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &CommissionWithdrawal{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{commissionwithdrawal.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
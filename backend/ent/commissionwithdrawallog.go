@@ -0,0 +1,183 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Wei-Shaw/sub2api/ent/commissionwithdrawallog"
+)
+
+// CommissionWithdrawalLog is the model entity for the CommissionWithdrawalLog schema.
+type CommissionWithdrawalLog struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// WithdrawalID holds the value of the "withdrawal_id" field.
+	WithdrawalID int64 `json:"withdrawal_id,omitempty"`
+	// Action holds the value of the "action" field.
+	Action string `json:"action,omitempty"`
+	// FromStatus holds the value of the "from_status" field.
+	FromStatus string `json:"from_status,omitempty"`
+	// ToStatus holds the value of the "to_status" field.
+	ToStatus string `json:"to_status,omitempty"`
+	// OperatorID holds the value of the "operator_id" field.
+	OperatorID int64 `json:"operator_id,omitempty"`
+	// OperatorRole holds the value of the "operator_role" field.
+	OperatorRole string `json:"operator_role,omitempty"`
+	// Note holds the value of the "note" field.
+	Note string `json:"note,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CommissionWithdrawalLog) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case commissionwithdrawallog.FieldID, commissionwithdrawallog.FieldWithdrawalID, commissionwithdrawallog.FieldOperatorID:
+			values[i] = new(sql.NullInt64)
+		case commissionwithdrawallog.FieldAction, commissionwithdrawallog.FieldFromStatus, commissionwithdrawallog.FieldToStatus, commissionwithdrawallog.FieldOperatorRole, commissionwithdrawallog.FieldNote:
+			values[i] = new(sql.NullString)
+		case commissionwithdrawallog.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CommissionWithdrawalLog fields.
+func (_m *CommissionWithdrawalLog) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case commissionwithdrawallog.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int64(value.Int64)
+		case commissionwithdrawallog.FieldWithdrawalID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field withdrawal_id", values[i])
+			} else if value.Valid {
+				_m.WithdrawalID = value.Int64
+			}
+		case commissionwithdrawallog.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				_m.Action = value.String
+			}
+		case commissionwithdrawallog.FieldFromStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field from_status", values[i])
+			} else if value.Valid {
+				_m.FromStatus = value.String
+			}
+		case commissionwithdrawallog.FieldToStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field to_status", values[i])
+			} else if value.Valid {
+				_m.ToStatus = value.String
+			}
+		case commissionwithdrawallog.FieldOperatorID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field operator_id", values[i])
+			} else if value.Valid {
+				_m.OperatorID = value.Int64
+			}
+		case commissionwithdrawallog.FieldOperatorRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field operator_role", values[i])
+			} else if value.Valid {
+				_m.OperatorRole = value.String
+			}
+		case commissionwithdrawallog.FieldNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field note", values[i])
+			} else if value.Valid {
+				_m.Note = value.String
+			}
+		case commissionwithdrawallog.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CommissionWithdrawalLog.
+// This includes values selected through modifiers, order, etc.
+func (_m *CommissionWithdrawalLog) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this CommissionWithdrawalLog.
+// Note that you need to call CommissionWithdrawalLog.Unwrap() before calling this method if this CommissionWithdrawalLog
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *CommissionWithdrawalLog) Update() *CommissionWithdrawalLogUpdateOne {
+	return NewCommissionWithdrawalLogClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the CommissionWithdrawalLog entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *CommissionWithdrawalLog) Unwrap() *CommissionWithdrawalLog {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CommissionWithdrawalLog is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *CommissionWithdrawalLog) String() string {
+	var builder strings.Builder
+	builder.WriteString("CommissionWithdrawalLog(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("withdrawal_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WithdrawalID))
+	builder.WriteString(", ")
+	builder.WriteString("action=")
+	builder.WriteString(_m.Action)
+	builder.WriteString(", ")
+	builder.WriteString("from_status=")
+	builder.WriteString(_m.FromStatus)
+	builder.WriteString(", ")
+	builder.WriteString("to_status=")
+	builder.WriteString(_m.ToStatus)
+	builder.WriteString(", ")
+	builder.WriteString("operator_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OperatorID))
+	builder.WriteString(", ")
+	builder.WriteString("operator_role=")
+	builder.WriteString(_m.OperatorRole)
+	builder.WriteString(", ")
+	builder.WriteString("note=")
+	builder.WriteString(_m.Note)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CommissionWithdrawalLogs is a parsable slice of CommissionWithdrawalLog.
+type CommissionWithdrawalLogs []*CommissionWithdrawalLog
@@ -0,0 +1,118 @@
+// Code generated by ent, DO NOT EDIT.
+
+package commissionwithdrawallog
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the commissionwithdrawallog type in the database.
+	Label = "commission_withdrawal_log"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldWithdrawalID holds the string denoting the withdrawal_id field in the database.
+	FieldWithdrawalID = "withdrawal_id"
+	// FieldAction holds the string denoting the action field in the database.
+	FieldAction = "action"
+	// FieldFromStatus holds the string denoting the from_status field in the database.
+	FieldFromStatus = "from_status"
+	// FieldToStatus holds the string denoting the to_status field in the database.
+	FieldToStatus = "to_status"
+	// FieldOperatorID holds the string denoting the operator_id field in the database.
+	FieldOperatorID = "operator_id"
+	// FieldOperatorRole holds the string denoting the operator_role field in the database.
+	FieldOperatorRole = "operator_role"
+	// FieldNote holds the string denoting the note field in the database.
+	FieldNote = "note"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the commissionwithdrawallog in the database.
+	Table = "commission_withdrawal_logs"
+)
+
+// Columns holds all SQL columns for commissionwithdrawallog fields.
+var Columns = []string{
+	FieldID,
+	FieldWithdrawalID,
+	FieldAction,
+	FieldFromStatus,
+	FieldToStatus,
+	FieldOperatorID,
+	FieldOperatorRole,
+	FieldNote,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ActionValidator is a validator for the "action" field. It is called by the builders before save.
+	ActionValidator func(string) error
+	// FromStatusValidator is a validator for the "from_status" field. It is called by the builders before save.
+	FromStatusValidator func(string) error
+	// ToStatusValidator is a validator for the "to_status" field. It is called by the builders before save.
+	ToStatusValidator func(string) error
+	// OperatorRoleValidator is a validator for the "operator_role" field. It is called by the builders before save.
+	OperatorRoleValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the CommissionWithdrawalLog queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByWithdrawalID orders the results by the withdrawal_id field.
+func ByWithdrawalID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWithdrawalID, opts...).ToFunc()
+}
+
+// ByAction orders the results by the action field.
+func ByAction(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAction, opts...).ToFunc()
+}
+
+// ByFromStatus orders the results by the from_status field.
+func ByFromStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFromStatus, opts...).ToFunc()
+}
+
+// ByToStatus orders the results by the to_status field.
+func ByToStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldToStatus, opts...).ToFunc()
+}
+
+// ByOperatorID orders the results by the operator_id field.
+func ByOperatorID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOperatorID, opts...).ToFunc()
+}
+
+// ByOperatorRole orders the results by the operator_role field.
+func ByOperatorRole(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOperatorRole, opts...).ToFunc()
+}
+
+// ByNote orders the results by the note field.
+func ByNote(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNote, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,575 @@
+// Code generated by ent, DO NOT EDIT.
+
+package commissionwithdrawallog
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Wei-Shaw/sub2api/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldLTE(FieldID, id))
+}
+
+// WithdrawalID applies equality check predicate on the "withdrawal_id" field. It's identical to WithdrawalIDEQ.
+func WithdrawalID(v int64) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldEQ(FieldWithdrawalID, v))
+}
+
+// Action applies equality check predicate on the "action" field. It's identical to ActionEQ.
+func Action(v string) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldEQ(FieldAction, v))
+}
+
+// FromStatus applies equality check predicate on the "from_status" field. It's identical to FromStatusEQ.
+func FromStatus(v string) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldEQ(FieldFromStatus, v))
+}
+
+// ToStatus applies equality check predicate on the "to_status" field. It's identical to ToStatusEQ.
+func ToStatus(v string) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldEQ(FieldToStatus, v))
+}
+
+// OperatorID applies equality check predicate on the "operator_id" field. It's identical to OperatorIDEQ.
+func OperatorID(v int64) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldEQ(FieldOperatorID, v))
+}
+
+// OperatorRole applies equality check predicate on the "operator_role" field. It's identical to OperatorRoleEQ.
+func OperatorRole(v string) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldEQ(FieldOperatorRole, v))
+}
+
+// Note applies equality check predicate on the "note" field. It's identical to NoteEQ.
+func Note(v string) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldEQ(FieldNote, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// WithdrawalIDEQ applies the EQ predicate on the "withdrawal_id" field.
+func WithdrawalIDEQ(v int64) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldEQ(FieldWithdrawalID, v))
+}
+
+// WithdrawalIDNEQ applies the NEQ predicate on the "withdrawal_id" field.
+func WithdrawalIDNEQ(v int64) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldNEQ(FieldWithdrawalID, v))
+}
+
+// WithdrawalIDIn applies the In predicate on the "withdrawal_id" field.
+func WithdrawalIDIn(vs ...int64) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldIn(FieldWithdrawalID, vs...))
+}
+
+// WithdrawalIDNotIn applies the NotIn predicate on the "withdrawal_id" field.
+func WithdrawalIDNotIn(vs ...int64) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldNotIn(FieldWithdrawalID, vs...))
+}
+
+// WithdrawalIDGT applies the GT predicate on the "withdrawal_id" field.
+func WithdrawalIDGT(v int64) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldGT(FieldWithdrawalID, v))
+}
+
+// WithdrawalIDGTE applies the GTE predicate on the "withdrawal_id" field.
+func WithdrawalIDGTE(v int64) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldGTE(FieldWithdrawalID, v))
+}
+
+// WithdrawalIDLT applies the LT predicate on the "withdrawal_id" field.
+func WithdrawalIDLT(v int64) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldLT(FieldWithdrawalID, v))
+}
+
+// WithdrawalIDLTE applies the LTE predicate on the "withdrawal_id" field.
+func WithdrawalIDLTE(v int64) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldLTE(FieldWithdrawalID, v))
+}
+
+// ActionEQ applies the EQ predicate on the "action" field.
+func ActionEQ(v string) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldEQ(FieldAction, v))
+}
+
+// ActionNEQ applies the NEQ predicate on the "action" field.
+func ActionNEQ(v string) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldNEQ(FieldAction, v))
+}
+
+// ActionIn applies the In predicate on the "action" field.
+func ActionIn(vs ...string) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldIn(FieldAction, vs...))
+}
+
+// ActionNotIn applies the NotIn predicate on the "action" field.
+func ActionNotIn(vs ...string) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldNotIn(FieldAction, vs...))
+}
+
+// ActionGT applies the GT predicate on the "action" field.
+func ActionGT(v string) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldGT(FieldAction, v))
+}
+
+// ActionGTE applies the GTE predicate on the "action" field.
+func ActionGTE(v string) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldGTE(FieldAction, v))
+}
+
+// ActionLT applies the LT predicate on the "action" field.
+func ActionLT(v string) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldLT(FieldAction, v))
+}
+
+// ActionLTE applies the LTE predicate on the "action" field.
+func ActionLTE(v string) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldLTE(FieldAction, v))
+}
+
+// ActionContains applies the Contains predicate on the "action" field.
+func ActionContains(v string) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldContains(FieldAction, v))
+}
+
+// ActionHasPrefix applies the HasPrefix predicate on the "action" field.
+func ActionHasPrefix(v string) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldHasPrefix(FieldAction, v))
+}
+
+// ActionHasSuffix applies the HasSuffix predicate on the "action" field.
+func ActionHasSuffix(v string) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldHasSuffix(FieldAction, v))
+}
+
+// ActionEqualFold applies the EqualFold predicate on the "action" field.
+func ActionEqualFold(v string) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldEqualFold(FieldAction, v))
+}
+
+// ActionContainsFold applies the ContainsFold predicate on the "action" field.
+func ActionContainsFold(v string) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldContainsFold(FieldAction, v))
+}
+
+// FromStatusEQ applies the EQ predicate on the "from_status" field.
+func FromStatusEQ(v string) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldEQ(FieldFromStatus, v))
+}
+
+// FromStatusNEQ applies the NEQ predicate on the "from_status" field.
+func FromStatusNEQ(v string) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldNEQ(FieldFromStatus, v))
+}
+
+// FromStatusIn applies the In predicate on the "from_status" field.
+func FromStatusIn(vs ...string) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldIn(FieldFromStatus, vs...))
+}
+
+// FromStatusNotIn applies the NotIn predicate on the "from_status" field.
+func FromStatusNotIn(vs ...string) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldNotIn(FieldFromStatus, vs...))
+}
+
+// FromStatusGT applies the GT predicate on the "from_status" field.
+func FromStatusGT(v string) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldGT(FieldFromStatus, v))
+}
+
+// FromStatusGTE applies the GTE predicate on the "from_status" field.
+func FromStatusGTE(v string) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldGTE(FieldFromStatus, v))
+}
+
+// FromStatusLT applies the LT predicate on the "from_status" field.
+func FromStatusLT(v string) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldLT(FieldFromStatus, v))
+}
+
+// FromStatusLTE applies the LTE predicate on the "from_status" field.
+func FromStatusLTE(v string) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldLTE(FieldFromStatus, v))
+}
+
+// FromStatusContains applies the Contains predicate on the "from_status" field.
+func FromStatusContains(v string) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldContains(FieldFromStatus, v))
+}
+
+// FromStatusHasPrefix applies the HasPrefix predicate on the "from_status" field.
+func FromStatusHasPrefix(v string) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldHasPrefix(FieldFromStatus, v))
+}
+
+// FromStatusHasSuffix applies the HasSuffix predicate on the "from_status" field.
+func FromStatusHasSuffix(v string) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldHasSuffix(FieldFromStatus, v))
+}
+
+// FromStatusIsNil applies the IsNil predicate on the "from_status" field.
+func FromStatusIsNil() predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldIsNull(FieldFromStatus))
+}
+
+// FromStatusNotNil applies the NotNil predicate on the "from_status" field.
+func FromStatusNotNil() predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldNotNull(FieldFromStatus))
+}
+
+// FromStatusEqualFold applies the EqualFold predicate on the "from_status" field.
+func FromStatusEqualFold(v string) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldEqualFold(FieldFromStatus, v))
+}
+
+// FromStatusContainsFold applies the ContainsFold predicate on the "from_status" field.
+func FromStatusContainsFold(v string) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldContainsFold(FieldFromStatus, v))
+}
+
+// ToStatusEQ applies the EQ predicate on the "to_status" field.
+func ToStatusEQ(v string) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldEQ(FieldToStatus, v))
+}
+
+// ToStatusNEQ applies the NEQ predicate on the "to_status" field.
+func ToStatusNEQ(v string) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldNEQ(FieldToStatus, v))
+}
+
+// ToStatusIn applies the In predicate on the "to_status" field.
+func ToStatusIn(vs ...string) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldIn(FieldToStatus, vs...))
+}
+
+// ToStatusNotIn applies the NotIn predicate on the "to_status" field.
+func ToStatusNotIn(vs ...string) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldNotIn(FieldToStatus, vs...))
+}
+
+// ToStatusGT applies the GT predicate on the "to_status" field.
+func ToStatusGT(v string) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldGT(FieldToStatus, v))
+}
+
+// ToStatusGTE applies the GTE predicate on the "to_status" field.
+func ToStatusGTE(v string) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldGTE(FieldToStatus, v))
+}
+
+// ToStatusLT applies the LT predicate on the "to_status" field.
+func ToStatusLT(v string) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldLT(FieldToStatus, v))
+}
+
+// ToStatusLTE applies the LTE predicate on the "to_status" field.
+func ToStatusLTE(v string) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldLTE(FieldToStatus, v))
+}
+
+// ToStatusContains applies the Contains predicate on the "to_status" field.
+func ToStatusContains(v string) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldContains(FieldToStatus, v))
+}
+
+// ToStatusHasPrefix applies the HasPrefix predicate on the "to_status" field.
+func ToStatusHasPrefix(v string) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldHasPrefix(FieldToStatus, v))
+}
+
+// ToStatusHasSuffix applies the HasSuffix predicate on the "to_status" field.
+func ToStatusHasSuffix(v string) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldHasSuffix(FieldToStatus, v))
+}
+
+// ToStatusEqualFold applies the EqualFold predicate on the "to_status" field.
+func ToStatusEqualFold(v string) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldEqualFold(FieldToStatus, v))
+}
+
+// ToStatusContainsFold applies the ContainsFold predicate on the "to_status" field.
+func ToStatusContainsFold(v string) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldContainsFold(FieldToStatus, v))
+}
+
+// OperatorIDEQ applies the EQ predicate on the "operator_id" field.
+func OperatorIDEQ(v int64) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldEQ(FieldOperatorID, v))
+}
+
+// OperatorIDNEQ applies the NEQ predicate on the "operator_id" field.
+func OperatorIDNEQ(v int64) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldNEQ(FieldOperatorID, v))
+}
+
+// OperatorIDIn applies the In predicate on the "operator_id" field.
+func OperatorIDIn(vs ...int64) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldIn(FieldOperatorID, vs...))
+}
+
+// OperatorIDNotIn applies the NotIn predicate on the "operator_id" field.
+func OperatorIDNotIn(vs ...int64) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldNotIn(FieldOperatorID, vs...))
+}
+
+// OperatorIDGT applies the GT predicate on the "operator_id" field.
+func OperatorIDGT(v int64) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldGT(FieldOperatorID, v))
+}
+
+// OperatorIDGTE applies the GTE predicate on the "operator_id" field.
+func OperatorIDGTE(v int64) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldGTE(FieldOperatorID, v))
+}
+
+// OperatorIDLT applies the LT predicate on the "operator_id" field.
+func OperatorIDLT(v int64) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldLT(FieldOperatorID, v))
+}
+
+// OperatorIDLTE applies the LTE predicate on the "operator_id" field.
+func OperatorIDLTE(v int64) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldLTE(FieldOperatorID, v))
+}
+
+// OperatorRoleEQ applies the EQ predicate on the "operator_role" field.
+func OperatorRoleEQ(v string) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldEQ(FieldOperatorRole, v))
+}
+
+// OperatorRoleNEQ applies the NEQ predicate on the "operator_role" field.
+func OperatorRoleNEQ(v string) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldNEQ(FieldOperatorRole, v))
+}
+
+// OperatorRoleIn applies the In predicate on the "operator_role" field.
+func OperatorRoleIn(vs ...string) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldIn(FieldOperatorRole, vs...))
+}
+
+// OperatorRoleNotIn applies the NotIn predicate on the "operator_role" field.
+func OperatorRoleNotIn(vs ...string) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldNotIn(FieldOperatorRole, vs...))
+}
+
+// OperatorRoleGT applies the GT predicate on the "operator_role" field.
+func OperatorRoleGT(v string) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldGT(FieldOperatorRole, v))
+}
+
+// OperatorRoleGTE applies the GTE predicate on the "operator_role" field.
+func OperatorRoleGTE(v string) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldGTE(FieldOperatorRole, v))
+}
+
+// OperatorRoleLT applies the LT predicate on the "operator_role" field.
+func OperatorRoleLT(v string) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldLT(FieldOperatorRole, v))
+}
+
+// OperatorRoleLTE applies the LTE predicate on the "operator_role" field.
+func OperatorRoleLTE(v string) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldLTE(FieldOperatorRole, v))
+}
+
+// OperatorRoleContains applies the Contains predicate on the "operator_role" field.
+func OperatorRoleContains(v string) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldContains(FieldOperatorRole, v))
+}
+
+// OperatorRoleHasPrefix applies the HasPrefix predicate on the "operator_role" field.
+func OperatorRoleHasPrefix(v string) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldHasPrefix(FieldOperatorRole, v))
+}
+
+// OperatorRoleHasSuffix applies the HasSuffix predicate on the "operator_role" field.
+func OperatorRoleHasSuffix(v string) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldHasSuffix(FieldOperatorRole, v))
+}
+
+// OperatorRoleEqualFold applies the EqualFold predicate on the "operator_role" field.
+func OperatorRoleEqualFold(v string) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldEqualFold(FieldOperatorRole, v))
+}
+
+// OperatorRoleContainsFold applies the ContainsFold predicate on the "operator_role" field.
+func OperatorRoleContainsFold(v string) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldContainsFold(FieldOperatorRole, v))
+}
+
+// NoteEQ applies the EQ predicate on the "note" field.
+func NoteEQ(v string) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldEQ(FieldNote, v))
+}
+
+// NoteNEQ applies the NEQ predicate on the "note" field.
+func NoteNEQ(v string) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldNEQ(FieldNote, v))
+}
+
+// NoteIn applies the In predicate on the "note" field.
+func NoteIn(vs ...string) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldIn(FieldNote, vs...))
+}
+
+// NoteNotIn applies the NotIn predicate on the "note" field.
+func NoteNotIn(vs ...string) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldNotIn(FieldNote, vs...))
+}
+
+// NoteGT applies the GT predicate on the "note" field.
+func NoteGT(v string) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldGT(FieldNote, v))
+}
+
+// NoteGTE applies the GTE predicate on the "note" field.
+func NoteGTE(v string) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldGTE(FieldNote, v))
+}
+
+// NoteLT applies the LT predicate on the "note" field.
+func NoteLT(v string) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldLT(FieldNote, v))
+}
+
+// NoteLTE applies the LTE predicate on the "note" field.
+func NoteLTE(v string) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldLTE(FieldNote, v))
+}
+
+// NoteContains applies the Contains predicate on the "note" field.
+func NoteContains(v string) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldContains(FieldNote, v))
+}
+
+// NoteHasPrefix applies the HasPrefix predicate on the "note" field.
+func NoteHasPrefix(v string) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldHasPrefix(FieldNote, v))
+}
+
+// NoteHasSuffix applies the HasSuffix predicate on the "note" field.
+func NoteHasSuffix(v string) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldHasSuffix(FieldNote, v))
+}
+
+// NoteIsNil applies the IsNil predicate on the "note" field.
+func NoteIsNil() predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldIsNull(FieldNote))
+}
+
+// NoteNotNil applies the NotNil predicate on the "note" field.
+func NoteNotNil() predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldNotNull(FieldNote))
+}
+
+// NoteEqualFold applies the EqualFold predicate on the "note" field.
+func NoteEqualFold(v string) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldEqualFold(FieldNote, v))
+}
+
+// NoteContainsFold applies the ContainsFold predicate on the "note" field.
+func NoteContainsFold(v string) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldContainsFold(FieldNote, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CommissionWithdrawalLog) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CommissionWithdrawalLog) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CommissionWithdrawalLog) predicate.CommissionWithdrawalLog {
+	return predicate.CommissionWithdrawalLog(sql.NotPredicates(p))
+}
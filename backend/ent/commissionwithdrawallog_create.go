@@ -0,0 +1,926 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Wei-Shaw/sub2api/ent/commissionwithdrawallog"
+)
+
+// CommissionWithdrawalLogCreate is the builder for creating a CommissionWithdrawalLog entity.
+type CommissionWithdrawalLogCreate struct {
+	config
+	mutation *CommissionWithdrawalLogMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetWithdrawalID sets the "withdrawal_id" field.
+func (_c *CommissionWithdrawalLogCreate) SetWithdrawalID(v int64) *CommissionWithdrawalLogCreate {
+	_c.mutation.SetWithdrawalID(v)
+	return _c
+}
+
+// SetAction sets the "action" field.
+func (_c *CommissionWithdrawalLogCreate) SetAction(v string) *CommissionWithdrawalLogCreate {
+	_c.mutation.SetAction(v)
+	return _c
+}
+
+// SetFromStatus sets the "from_status" field.
+func (_c *CommissionWithdrawalLogCreate) SetFromStatus(v string) *CommissionWithdrawalLogCreate {
+	_c.mutation.SetFromStatus(v)
+	return _c
+}
+
+// SetNillableFromStatus sets the "from_status" field if the given value is not nil.
+func (_c *CommissionWithdrawalLogCreate) SetNillableFromStatus(v *string) *CommissionWithdrawalLogCreate {
+	if v != nil {
+		_c.SetFromStatus(*v)
+	}
+	return _c
+}
+
+// SetToStatus sets the "to_status" field.
+func (_c *CommissionWithdrawalLogCreate) SetToStatus(v string) *CommissionWithdrawalLogCreate {
+	_c.mutation.SetToStatus(v)
+	return _c
+}
+
+// SetOperatorID sets the "operator_id" field.
+func (_c *CommissionWithdrawalLogCreate) SetOperatorID(v int64) *CommissionWithdrawalLogCreate {
+	_c.mutation.SetOperatorID(v)
+	return _c
+}
+
+// SetOperatorRole sets the "operator_role" field.
+func (_c *CommissionWithdrawalLogCreate) SetOperatorRole(v string) *CommissionWithdrawalLogCreate {
+	_c.mutation.SetOperatorRole(v)
+	return _c
+}
+
+// SetNote sets the "note" field.
+func (_c *CommissionWithdrawalLogCreate) SetNote(v string) *CommissionWithdrawalLogCreate {
+	_c.mutation.SetNote(v)
+	return _c
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_c *CommissionWithdrawalLogCreate) SetNillableNote(v *string) *CommissionWithdrawalLogCreate {
+	if v != nil {
+		_c.SetNote(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *CommissionWithdrawalLogCreate) SetCreatedAt(v time.Time) *CommissionWithdrawalLogCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *CommissionWithdrawalLogCreate) SetNillableCreatedAt(v *time.Time) *CommissionWithdrawalLogCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the CommissionWithdrawalLogMutation object of the builder.
+func (_c *CommissionWithdrawalLogCreate) Mutation() *CommissionWithdrawalLogMutation {
+	return _c.mutation
+}
+
+// Save creates the CommissionWithdrawalLog in the database.
+func (_c *CommissionWithdrawalLogCreate) Save(ctx context.Context) (*CommissionWithdrawalLog, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *CommissionWithdrawalLogCreate) SaveX(ctx context.Context) *CommissionWithdrawalLog {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CommissionWithdrawalLogCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CommissionWithdrawalLogCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *CommissionWithdrawalLogCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := commissionwithdrawallog.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *CommissionWithdrawalLogCreate) check() error {
+	if _, ok := _c.mutation.WithdrawalID(); !ok {
+		return &ValidationError{Name: "withdrawal_id", err: errors.New(`ent: missing required field "CommissionWithdrawalLog.withdrawal_id"`)}
+	}
+	if _, ok := _c.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "CommissionWithdrawalLog.action"`)}
+	}
+	if v, ok := _c.mutation.Action(); ok {
+		if err := commissionwithdrawallog.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "CommissionWithdrawalLog.action": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.FromStatus(); ok {
+		if err := commissionwithdrawallog.FromStatusValidator(v); err != nil {
+			return &ValidationError{Name: "from_status", err: fmt.Errorf(`ent: validator failed for field "CommissionWithdrawalLog.from_status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ToStatus(); !ok {
+		return &ValidationError{Name: "to_status", err: errors.New(`ent: missing required field "CommissionWithdrawalLog.to_status"`)}
+	}
+	if v, ok := _c.mutation.ToStatus(); ok {
+		if err := commissionwithdrawallog.ToStatusValidator(v); err != nil {
+			return &ValidationError{Name: "to_status", err: fmt.Errorf(`ent: validator failed for field "CommissionWithdrawalLog.to_status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.OperatorID(); !ok {
+		return &ValidationError{Name: "operator_id", err: errors.New(`ent: missing required field "CommissionWithdrawalLog.operator_id"`)}
+	}
+	if _, ok := _c.mutation.OperatorRole(); !ok {
+		return &ValidationError{Name: "operator_role", err: errors.New(`ent: missing required field "CommissionWithdrawalLog.operator_role"`)}
+	}
+	if v, ok := _c.mutation.OperatorRole(); ok {
+		if err := commissionwithdrawallog.OperatorRoleValidator(v); err != nil {
+			return &ValidationError{Name: "operator_role", err: fmt.Errorf(`ent: validator failed for field "CommissionWithdrawalLog.operator_role": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "CommissionWithdrawalLog.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *CommissionWithdrawalLogCreate) sqlSave(ctx context.Context) (*CommissionWithdrawalLog, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int64(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *CommissionWithdrawalLogCreate) createSpec() (*CommissionWithdrawalLog, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CommissionWithdrawalLog{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(commissionwithdrawallog.Table, sqlgraph.NewFieldSpec(commissionwithdrawallog.FieldID, field.TypeInt64))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.WithdrawalID(); ok {
+		_spec.SetField(commissionwithdrawallog.FieldWithdrawalID, field.TypeInt64, value)
+		_node.WithdrawalID = value
+	}
+	if value, ok := _c.mutation.Action(); ok {
+		_spec.SetField(commissionwithdrawallog.FieldAction, field.TypeString, value)
+		_node.Action = value
+	}
+	if value, ok := _c.mutation.FromStatus(); ok {
+		_spec.SetField(commissionwithdrawallog.FieldFromStatus, field.TypeString, value)
+		_node.FromStatus = value
+	}
+	if value, ok := _c.mutation.ToStatus(); ok {
+		_spec.SetField(commissionwithdrawallog.FieldToStatus, field.TypeString, value)
+		_node.ToStatus = value
+	}
+	if value, ok := _c.mutation.OperatorID(); ok {
+		_spec.SetField(commissionwithdrawallog.FieldOperatorID, field.TypeInt64, value)
+		_node.OperatorID = value
+	}
+	if value, ok := _c.mutation.OperatorRole(); ok {
+		_spec.SetField(commissionwithdrawallog.FieldOperatorRole, field.TypeString, value)
+		_node.OperatorRole = value
+	}
+	if value, ok := _c.mutation.Note(); ok {
+		_spec.SetField(commissionwithdrawallog.FieldNote, field.TypeString, value)
+		_node.Note = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(commissionwithdrawallog.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CommissionWithdrawalLog.Create().
+//		SetWithdrawalID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CommissionWithdrawalLogUpsert) {
+//			SetWithdrawalID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *CommissionWithdrawalLogCreate) OnConflict(opts ...sql.ConflictOption) *CommissionWithdrawalLogUpsertOne {
+	_c.conflict = opts
+	return &CommissionWithdrawalLogUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CommissionWithdrawalLog.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *CommissionWithdrawalLogCreate) OnConflictColumns(columns ...string) *CommissionWithdrawalLogUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &CommissionWithdrawalLogUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// CommissionWithdrawalLogUpsertOne is the builder for "upsert"-ing
+	//  one CommissionWithdrawalLog node.
+	CommissionWithdrawalLogUpsertOne struct {
+		create *CommissionWithdrawalLogCreate
+	}
+
+	// CommissionWithdrawalLogUpsert is the "OnConflict" setter.
+	CommissionWithdrawalLogUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetWithdrawalID sets the "withdrawal_id" field.
+func (u *CommissionWithdrawalLogUpsert) SetWithdrawalID(v int64) *CommissionWithdrawalLogUpsert {
+	u.Set(commissionwithdrawallog.FieldWithdrawalID, v)
+	return u
+}
+
+// UpdateWithdrawalID sets the "withdrawal_id" field to the value that was provided on create.
+func (u *CommissionWithdrawalLogUpsert) UpdateWithdrawalID() *CommissionWithdrawalLogUpsert {
+	u.SetExcluded(commissionwithdrawallog.FieldWithdrawalID)
+	return u
+}
+
+// AddWithdrawalID adds v to the "withdrawal_id" field.
+func (u *CommissionWithdrawalLogUpsert) AddWithdrawalID(v int64) *CommissionWithdrawalLogUpsert {
+	u.Add(commissionwithdrawallog.FieldWithdrawalID, v)
+	return u
+}
+
+// SetAction sets the "action" field.
+func (u *CommissionWithdrawalLogUpsert) SetAction(v string) *CommissionWithdrawalLogUpsert {
+	u.Set(commissionwithdrawallog.FieldAction, v)
+	return u
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *CommissionWithdrawalLogUpsert) UpdateAction() *CommissionWithdrawalLogUpsert {
+	u.SetExcluded(commissionwithdrawallog.FieldAction)
+	return u
+}
+
+// SetFromStatus sets the "from_status" field.
+func (u *CommissionWithdrawalLogUpsert) SetFromStatus(v string) *CommissionWithdrawalLogUpsert {
+	u.Set(commissionwithdrawallog.FieldFromStatus, v)
+	return u
+}
+
+// UpdateFromStatus sets the "from_status" field to the value that was provided on create.
+func (u *CommissionWithdrawalLogUpsert) UpdateFromStatus() *CommissionWithdrawalLogUpsert {
+	u.SetExcluded(commissionwithdrawallog.FieldFromStatus)
+	return u
+}
+
+// ClearFromStatus clears the value of the "from_status" field.
+func (u *CommissionWithdrawalLogUpsert) ClearFromStatus() *CommissionWithdrawalLogUpsert {
+	u.SetNull(commissionwithdrawallog.FieldFromStatus)
+	return u
+}
+
+// SetToStatus sets the "to_status" field.
+func (u *CommissionWithdrawalLogUpsert) SetToStatus(v string) *CommissionWithdrawalLogUpsert {
+	u.Set(commissionwithdrawallog.FieldToStatus, v)
+	return u
+}
+
+// UpdateToStatus sets the "to_status" field to the value that was provided on create.
+func (u *CommissionWithdrawalLogUpsert) UpdateToStatus() *CommissionWithdrawalLogUpsert {
+	u.SetExcluded(commissionwithdrawallog.FieldToStatus)
+	return u
+}
+
+// SetOperatorID sets the "operator_id" field.
+func (u *CommissionWithdrawalLogUpsert) SetOperatorID(v int64) *CommissionWithdrawalLogUpsert {
+	u.Set(commissionwithdrawallog.FieldOperatorID, v)
+	return u
+}
+
+// UpdateOperatorID sets the "operator_id" field to the value that was provided on create.
+func (u *CommissionWithdrawalLogUpsert) UpdateOperatorID() *CommissionWithdrawalLogUpsert {
+	u.SetExcluded(commissionwithdrawallog.FieldOperatorID)
+	return u
+}
+
+// AddOperatorID adds v to the "operator_id" field.
+func (u *CommissionWithdrawalLogUpsert) AddOperatorID(v int64) *CommissionWithdrawalLogUpsert {
+	u.Add(commissionwithdrawallog.FieldOperatorID, v)
+	return u
+}
+
+// SetOperatorRole sets the "operator_role" field.
+func (u *CommissionWithdrawalLogUpsert) SetOperatorRole(v string) *CommissionWithdrawalLogUpsert {
+	u.Set(commissionwithdrawallog.FieldOperatorRole, v)
+	return u
+}
+
+// UpdateOperatorRole sets the "operator_role" field to the value that was provided on create.
+func (u *CommissionWithdrawalLogUpsert) UpdateOperatorRole() *CommissionWithdrawalLogUpsert {
+	u.SetExcluded(commissionwithdrawallog.FieldOperatorRole)
+	return u
+}
+
+// SetNote sets the "note" field.
+func (u *CommissionWithdrawalLogUpsert) SetNote(v string) *CommissionWithdrawalLogUpsert {
+	u.Set(commissionwithdrawallog.FieldNote, v)
+	return u
+}
+
+// UpdateNote sets the "note" field to the value that was provided on create.
+func (u *CommissionWithdrawalLogUpsert) UpdateNote() *CommissionWithdrawalLogUpsert {
+	u.SetExcluded(commissionwithdrawallog.FieldNote)
+	return u
+}
+
+// ClearNote clears the value of the "note" field.
+func (u *CommissionWithdrawalLogUpsert) ClearNote() *CommissionWithdrawalLogUpsert {
+	u.SetNull(commissionwithdrawallog.FieldNote)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.CommissionWithdrawalLog.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *CommissionWithdrawalLogUpsertOne) UpdateNewValues() *CommissionWithdrawalLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(commissionwithdrawallog.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CommissionWithdrawalLog.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *CommissionWithdrawalLogUpsertOne) Ignore() *CommissionWithdrawalLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CommissionWithdrawalLogUpsertOne) DoNothing() *CommissionWithdrawalLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CommissionWithdrawalLogCreate.OnConflict
+// documentation for more info.
+func (u *CommissionWithdrawalLogUpsertOne) Update(set func(*CommissionWithdrawalLogUpsert)) *CommissionWithdrawalLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CommissionWithdrawalLogUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetWithdrawalID sets the "withdrawal_id" field.
+func (u *CommissionWithdrawalLogUpsertOne) SetWithdrawalID(v int64) *CommissionWithdrawalLogUpsertOne {
+	return u.Update(func(s *CommissionWithdrawalLogUpsert) {
+		s.SetWithdrawalID(v)
+	})
+}
+
+// AddWithdrawalID adds v to the "withdrawal_id" field.
+func (u *CommissionWithdrawalLogUpsertOne) AddWithdrawalID(v int64) *CommissionWithdrawalLogUpsertOne {
+	return u.Update(func(s *CommissionWithdrawalLogUpsert) {
+		s.AddWithdrawalID(v)
+	})
+}
+
+// UpdateWithdrawalID sets the "withdrawal_id" field to the value that was provided on create.
+func (u *CommissionWithdrawalLogUpsertOne) UpdateWithdrawalID() *CommissionWithdrawalLogUpsertOne {
+	return u.Update(func(s *CommissionWithdrawalLogUpsert) {
+		s.UpdateWithdrawalID()
+	})
+}
+
+// SetAction sets the "action" field.
+func (u *CommissionWithdrawalLogUpsertOne) SetAction(v string) *CommissionWithdrawalLogUpsertOne {
+	return u.Update(func(s *CommissionWithdrawalLogUpsert) {
+		s.SetAction(v)
+	})
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *CommissionWithdrawalLogUpsertOne) UpdateAction() *CommissionWithdrawalLogUpsertOne {
+	return u.Update(func(s *CommissionWithdrawalLogUpsert) {
+		s.UpdateAction()
+	})
+}
+
+// SetFromStatus sets the "from_status" field.
+func (u *CommissionWithdrawalLogUpsertOne) SetFromStatus(v string) *CommissionWithdrawalLogUpsertOne {
+	return u.Update(func(s *CommissionWithdrawalLogUpsert) {
+		s.SetFromStatus(v)
+	})
+}
+
+// UpdateFromStatus sets the "from_status" field to the value that was provided on create.
+func (u *CommissionWithdrawalLogUpsertOne) UpdateFromStatus() *CommissionWithdrawalLogUpsertOne {
+	return u.Update(func(s *CommissionWithdrawalLogUpsert) {
+		s.UpdateFromStatus()
+	})
+}
+
+// ClearFromStatus clears the value of the "from_status" field.
+func (u *CommissionWithdrawalLogUpsertOne) ClearFromStatus() *CommissionWithdrawalLogUpsertOne {
+	return u.Update(func(s *CommissionWithdrawalLogUpsert) {
+		s.ClearFromStatus()
+	})
+}
+
+// SetToStatus sets the "to_status" field.
+func (u *CommissionWithdrawalLogUpsertOne) SetToStatus(v string) *CommissionWithdrawalLogUpsertOne {
+	return u.Update(func(s *CommissionWithdrawalLogUpsert) {
+		s.SetToStatus(v)
+	})
+}
+
+// UpdateToStatus sets the "to_status" field to the value that was provided on create.
+func (u *CommissionWithdrawalLogUpsertOne) UpdateToStatus() *CommissionWithdrawalLogUpsertOne {
+	return u.Update(func(s *CommissionWithdrawalLogUpsert) {
+		s.UpdateToStatus()
+	})
+}
+
+// SetOperatorID sets the "operator_id" field.
+func (u *CommissionWithdrawalLogUpsertOne) SetOperatorID(v int64) *CommissionWithdrawalLogUpsertOne {
+	return u.Update(func(s *CommissionWithdrawalLogUpsert) {
+		s.SetOperatorID(v)
+	})
+}
+
+// AddOperatorID adds v to the "operator_id" field.
+func (u *CommissionWithdrawalLogUpsertOne) AddOperatorID(v int64) *CommissionWithdrawalLogUpsertOne {
+	return u.Update(func(s *CommissionWithdrawalLogUpsert) {
+		s.AddOperatorID(v)
+	})
+}
+
+// UpdateOperatorID sets the "operator_id" field to the value that was provided on create.
+func (u *CommissionWithdrawalLogUpsertOne) UpdateOperatorID() *CommissionWithdrawalLogUpsertOne {
+	return u.Update(func(s *CommissionWithdrawalLogUpsert) {
+		s.UpdateOperatorID()
+	})
+}
+
+// SetOperatorRole sets the "operator_role" field.
+func (u *CommissionWithdrawalLogUpsertOne) SetOperatorRole(v string) *CommissionWithdrawalLogUpsertOne {
+	return u.Update(func(s *CommissionWithdrawalLogUpsert) {
+		s.SetOperatorRole(v)
+	})
+}
+
+// UpdateOperatorRole sets the "operator_role" field to the value that was provided on create.
+func (u *CommissionWithdrawalLogUpsertOne) UpdateOperatorRole() *CommissionWithdrawalLogUpsertOne {
+	return u.Update(func(s *CommissionWithdrawalLogUpsert) {
+		s.UpdateOperatorRole()
+	})
+}
+
+// SetNote sets the "note" field.
+func (u *CommissionWithdrawalLogUpsertOne) SetNote(v string) *CommissionWithdrawalLogUpsertOne {
+	return u.Update(func(s *CommissionWithdrawalLogUpsert) {
+		s.SetNote(v)
+	})
+}
+
+// UpdateNote sets the "note" field to the value that was provided on create.
+func (u *CommissionWithdrawalLogUpsertOne) UpdateNote() *CommissionWithdrawalLogUpsertOne {
+	return u.Update(func(s *CommissionWithdrawalLogUpsert) {
+		s.UpdateNote()
+	})
+}
+
+// ClearNote clears the value of the "note" field.
+func (u *CommissionWithdrawalLogUpsertOne) ClearNote() *CommissionWithdrawalLogUpsertOne {
+	return u.Update(func(s *CommissionWithdrawalLogUpsert) {
+		s.ClearNote()
+	})
+}
+
+// Exec executes the query.
+func (u *CommissionWithdrawalLogUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CommissionWithdrawalLogCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CommissionWithdrawalLogUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *CommissionWithdrawalLogUpsertOne) ID(ctx context.Context) (id int64, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *CommissionWithdrawalLogUpsertOne) IDX(ctx context.Context) int64 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// CommissionWithdrawalLogCreateBulk is the builder for creating many CommissionWithdrawalLog entities in bulk.
+type CommissionWithdrawalLogCreateBulk struct {
+	config
+	err      error
+	builders []*CommissionWithdrawalLogCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the CommissionWithdrawalLog entities in the database.
+func (_c *CommissionWithdrawalLogCreateBulk) Save(ctx context.Context) ([]*CommissionWithdrawalLog, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*CommissionWithdrawalLog, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CommissionWithdrawalLogMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *CommissionWithdrawalLogCreateBulk) SaveX(ctx context.Context) []*CommissionWithdrawalLog {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CommissionWithdrawalLogCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CommissionWithdrawalLogCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CommissionWithdrawalLog.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CommissionWithdrawalLogUpsert) {
+//			SetWithdrawalID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *CommissionWithdrawalLogCreateBulk) OnConflict(opts ...sql.ConflictOption) *CommissionWithdrawalLogUpsertBulk {
+	_c.conflict = opts
+	return &CommissionWithdrawalLogUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CommissionWithdrawalLog.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *CommissionWithdrawalLogCreateBulk) OnConflictColumns(columns ...string) *CommissionWithdrawalLogUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &CommissionWithdrawalLogUpsertBulk{
+		create: _c,
+	}
+}
+
+// CommissionWithdrawalLogUpsertBulk is the builder for "upsert"-ing
+// a bulk of CommissionWithdrawalLog nodes.
+type CommissionWithdrawalLogUpsertBulk struct {
+	create *CommissionWithdrawalLogCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.CommissionWithdrawalLog.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *CommissionWithdrawalLogUpsertBulk) UpdateNewValues() *CommissionWithdrawalLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(commissionwithdrawallog.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CommissionWithdrawalLog.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *CommissionWithdrawalLogUpsertBulk) Ignore() *CommissionWithdrawalLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CommissionWithdrawalLogUpsertBulk) DoNothing() *CommissionWithdrawalLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CommissionWithdrawalLogCreateBulk.OnConflict
+// documentation for more info.
+func (u *CommissionWithdrawalLogUpsertBulk) Update(set func(*CommissionWithdrawalLogUpsert)) *CommissionWithdrawalLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CommissionWithdrawalLogUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetWithdrawalID sets the "withdrawal_id" field.
+func (u *CommissionWithdrawalLogUpsertBulk) SetWithdrawalID(v int64) *CommissionWithdrawalLogUpsertBulk {
+	return u.Update(func(s *CommissionWithdrawalLogUpsert) {
+		s.SetWithdrawalID(v)
+	})
+}
+
+// AddWithdrawalID adds v to the "withdrawal_id" field.
+func (u *CommissionWithdrawalLogUpsertBulk) AddWithdrawalID(v int64) *CommissionWithdrawalLogUpsertBulk {
+	return u.Update(func(s *CommissionWithdrawalLogUpsert) {
+		s.AddWithdrawalID(v)
+	})
+}
+
+// UpdateWithdrawalID sets the "withdrawal_id" field to the value that was provided on create.
+func (u *CommissionWithdrawalLogUpsertBulk) UpdateWithdrawalID() *CommissionWithdrawalLogUpsertBulk {
+	return u.Update(func(s *CommissionWithdrawalLogUpsert) {
+		s.UpdateWithdrawalID()
+	})
+}
+
+// SetAction sets the "action" field.
+func (u *CommissionWithdrawalLogUpsertBulk) SetAction(v string) *CommissionWithdrawalLogUpsertBulk {
+	return u.Update(func(s *CommissionWithdrawalLogUpsert) {
+		s.SetAction(v)
+	})
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *CommissionWithdrawalLogUpsertBulk) UpdateAction() *CommissionWithdrawalLogUpsertBulk {
+	return u.Update(func(s *CommissionWithdrawalLogUpsert) {
+		s.UpdateAction()
+	})
+}
+
+// SetFromStatus sets the "from_status" field.
+func (u *CommissionWithdrawalLogUpsertBulk) SetFromStatus(v string) *CommissionWithdrawalLogUpsertBulk {
+	return u.Update(func(s *CommissionWithdrawalLogUpsert) {
+		s.SetFromStatus(v)
+	})
+}
+
+// UpdateFromStatus sets the "from_status" field to the value that was provided on create.
+func (u *CommissionWithdrawalLogUpsertBulk) UpdateFromStatus() *CommissionWithdrawalLogUpsertBulk {
+	return u.Update(func(s *CommissionWithdrawalLogUpsert) {
+		s.UpdateFromStatus()
+	})
+}
+
+// ClearFromStatus clears the value of the "from_status" field.
+func (u *CommissionWithdrawalLogUpsertBulk) ClearFromStatus() *CommissionWithdrawalLogUpsertBulk {
+	return u.Update(func(s *CommissionWithdrawalLogUpsert) {
+		s.ClearFromStatus()
+	})
+}
+
+// SetToStatus sets the "to_status" field.
+func (u *CommissionWithdrawalLogUpsertBulk) SetToStatus(v string) *CommissionWithdrawalLogUpsertBulk {
+	return u.Update(func(s *CommissionWithdrawalLogUpsert) {
+		s.SetToStatus(v)
+	})
+}
+
+// UpdateToStatus sets the "to_status" field to the value that was provided on create.
+func (u *CommissionWithdrawalLogUpsertBulk) UpdateToStatus() *CommissionWithdrawalLogUpsertBulk {
+	return u.Update(func(s *CommissionWithdrawalLogUpsert) {
+		s.UpdateToStatus()
+	})
+}
+
+// SetOperatorID sets the "operator_id" field.
+func (u *CommissionWithdrawalLogUpsertBulk) SetOperatorID(v int64) *CommissionWithdrawalLogUpsertBulk {
+	return u.Update(func(s *CommissionWithdrawalLogUpsert) {
+		s.SetOperatorID(v)
+	})
+}
+
+// AddOperatorID adds v to the "operator_id" field.
+func (u *CommissionWithdrawalLogUpsertBulk) AddOperatorID(v int64) *CommissionWithdrawalLogUpsertBulk {
+	return u.Update(func(s *CommissionWithdrawalLogUpsert) {
+		s.AddOperatorID(v)
+	})
+}
+
+// UpdateOperatorID sets the "operator_id" field to the value that was provided on create.
+func (u *CommissionWithdrawalLogUpsertBulk) UpdateOperatorID() *CommissionWithdrawalLogUpsertBulk {
+	return u.Update(func(s *CommissionWithdrawalLogUpsert) {
+		s.UpdateOperatorID()
+	})
+}
+
+// SetOperatorRole sets the "operator_role" field.
+func (u *CommissionWithdrawalLogUpsertBulk) SetOperatorRole(v string) *CommissionWithdrawalLogUpsertBulk {
+	return u.Update(func(s *CommissionWithdrawalLogUpsert) {
+		s.SetOperatorRole(v)
+	})
+}
+
+// UpdateOperatorRole sets the "operator_role" field to the value that was provided on create.
+func (u *CommissionWithdrawalLogUpsertBulk) UpdateOperatorRole() *CommissionWithdrawalLogUpsertBulk {
+	return u.Update(func(s *CommissionWithdrawalLogUpsert) {
+		s.UpdateOperatorRole()
+	})
+}
+
+// SetNote sets the "note" field.
+func (u *CommissionWithdrawalLogUpsertBulk) SetNote(v string) *CommissionWithdrawalLogUpsertBulk {
+	return u.Update(func(s *CommissionWithdrawalLogUpsert) {
+		s.SetNote(v)
+	})
+}
+
+// UpdateNote sets the "note" field to the value that was provided on create.
+func (u *CommissionWithdrawalLogUpsertBulk) UpdateNote() *CommissionWithdrawalLogUpsertBulk {
+	return u.Update(func(s *CommissionWithdrawalLogUpsert) {
+		s.UpdateNote()
+	})
+}
+
+// ClearNote clears the value of the "note" field.
+func (u *CommissionWithdrawalLogUpsertBulk) ClearNote() *CommissionWithdrawalLogUpsertBulk {
+	return u.Update(func(s *CommissionWithdrawalLogUpsert) {
+		s.ClearNote()
+	})
+}
+
+// Exec executes the query.
+func (u *CommissionWithdrawalLogUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the CommissionWithdrawalLogCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CommissionWithdrawalLogCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CommissionWithdrawalLogUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Wei-Shaw/sub2api/ent/commissionwithdrawallog"
+	"github.com/Wei-Shaw/sub2api/ent/predicate"
+)
+
+// CommissionWithdrawalLogDelete is the builder for deleting a CommissionWithdrawalLog entity.
+type CommissionWithdrawalLogDelete struct {
+	config
+	hooks    []Hook
+	mutation *CommissionWithdrawalLogMutation
+}
+
+// Where appends a list predicates to the CommissionWithdrawalLogDelete builder.
+func (_d *CommissionWithdrawalLogDelete) Where(ps ...predicate.CommissionWithdrawalLog) *CommissionWithdrawalLogDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *CommissionWithdrawalLogDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *CommissionWithdrawalLogDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *CommissionWithdrawalLogDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(commissionwithdrawallog.Table, sqlgraph.NewFieldSpec(commissionwithdrawallog.FieldID, field.TypeInt64))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// CommissionWithdrawalLogDeleteOne is the builder for deleting a single CommissionWithdrawalLog entity.
+type CommissionWithdrawalLogDeleteOne struct {
+	_d *CommissionWithdrawalLogDelete
+}
+
+// Where appends a list predicates to the CommissionWithdrawalLogDelete builder.
+func (_d *CommissionWithdrawalLogDeleteOne) Where(ps ...predicate.CommissionWithdrawalLog) *CommissionWithdrawalLogDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *CommissionWithdrawalLogDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{commissionwithdrawallog.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *CommissionWithdrawalLogDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
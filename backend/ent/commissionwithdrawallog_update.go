@@ -0,0 +1,545 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Wei-Shaw/sub2api/ent/commissionwithdrawallog"
+	"github.com/Wei-Shaw/sub2api/ent/predicate"
+)
+
+// CommissionWithdrawalLogUpdate is the builder for updating CommissionWithdrawalLog entities.
+type CommissionWithdrawalLogUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CommissionWithdrawalLogMutation
+}
+
+// Where appends a list predicates to the CommissionWithdrawalLogUpdate builder.
+func (_u *CommissionWithdrawalLogUpdate) Where(ps ...predicate.CommissionWithdrawalLog) *CommissionWithdrawalLogUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetWithdrawalID sets the "withdrawal_id" field.
+func (_u *CommissionWithdrawalLogUpdate) SetWithdrawalID(v int64) *CommissionWithdrawalLogUpdate {
+	_u.mutation.ResetWithdrawalID()
+	_u.mutation.SetWithdrawalID(v)
+	return _u
+}
+
+// SetNillableWithdrawalID sets the "withdrawal_id" field if the given value is not nil.
+func (_u *CommissionWithdrawalLogUpdate) SetNillableWithdrawalID(v *int64) *CommissionWithdrawalLogUpdate {
+	if v != nil {
+		_u.SetWithdrawalID(*v)
+	}
+	return _u
+}
+
+// AddWithdrawalID adds value to the "withdrawal_id" field.
+func (_u *CommissionWithdrawalLogUpdate) AddWithdrawalID(v int64) *CommissionWithdrawalLogUpdate {
+	_u.mutation.AddWithdrawalID(v)
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *CommissionWithdrawalLogUpdate) SetAction(v string) *CommissionWithdrawalLogUpdate {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *CommissionWithdrawalLogUpdate) SetNillableAction(v *string) *CommissionWithdrawalLogUpdate {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// SetFromStatus sets the "from_status" field.
+func (_u *CommissionWithdrawalLogUpdate) SetFromStatus(v string) *CommissionWithdrawalLogUpdate {
+	_u.mutation.SetFromStatus(v)
+	return _u
+}
+
+// SetNillableFromStatus sets the "from_status" field if the given value is not nil.
+func (_u *CommissionWithdrawalLogUpdate) SetNillableFromStatus(v *string) *CommissionWithdrawalLogUpdate {
+	if v != nil {
+		_u.SetFromStatus(*v)
+	}
+	return _u
+}
+
+// ClearFromStatus clears the value of the "from_status" field.
+func (_u *CommissionWithdrawalLogUpdate) ClearFromStatus() *CommissionWithdrawalLogUpdate {
+	_u.mutation.ClearFromStatus()
+	return _u
+}
+
+// SetToStatus sets the "to_status" field.
+func (_u *CommissionWithdrawalLogUpdate) SetToStatus(v string) *CommissionWithdrawalLogUpdate {
+	_u.mutation.SetToStatus(v)
+	return _u
+}
+
+// SetNillableToStatus sets the "to_status" field if the given value is not nil.
+func (_u *CommissionWithdrawalLogUpdate) SetNillableToStatus(v *string) *CommissionWithdrawalLogUpdate {
+	if v != nil {
+		_u.SetToStatus(*v)
+	}
+	return _u
+}
+
+// SetOperatorID sets the "operator_id" field.
+func (_u *CommissionWithdrawalLogUpdate) SetOperatorID(v int64) *CommissionWithdrawalLogUpdate {
+	_u.mutation.ResetOperatorID()
+	_u.mutation.SetOperatorID(v)
+	return _u
+}
+
+// SetNillableOperatorID sets the "operator_id" field if the given value is not nil.
+func (_u *CommissionWithdrawalLogUpdate) SetNillableOperatorID(v *int64) *CommissionWithdrawalLogUpdate {
+	if v != nil {
+		_u.SetOperatorID(*v)
+	}
+	return _u
+}
+
+// AddOperatorID adds value to the "operator_id" field.
+func (_u *CommissionWithdrawalLogUpdate) AddOperatorID(v int64) *CommissionWithdrawalLogUpdate {
+	_u.mutation.AddOperatorID(v)
+	return _u
+}
+
+// SetOperatorRole sets the "operator_role" field.
+func (_u *CommissionWithdrawalLogUpdate) SetOperatorRole(v string) *CommissionWithdrawalLogUpdate {
+	_u.mutation.SetOperatorRole(v)
+	return _u
+}
+
+// SetNillableOperatorRole sets the "operator_role" field if the given value is not nil.
+func (_u *CommissionWithdrawalLogUpdate) SetNillableOperatorRole(v *string) *CommissionWithdrawalLogUpdate {
+	if v != nil {
+		_u.SetOperatorRole(*v)
+	}
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *CommissionWithdrawalLogUpdate) SetNote(v string) *CommissionWithdrawalLogUpdate {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *CommissionWithdrawalLogUpdate) SetNillableNote(v *string) *CommissionWithdrawalLogUpdate {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// ClearNote clears the value of the "note" field.
+func (_u *CommissionWithdrawalLogUpdate) ClearNote() *CommissionWithdrawalLogUpdate {
+	_u.mutation.ClearNote()
+	return _u
+}
+
+// Mutation returns the CommissionWithdrawalLogMutation object of the builder.
+func (_u *CommissionWithdrawalLogUpdate) Mutation() *CommissionWithdrawalLogMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *CommissionWithdrawalLogUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CommissionWithdrawalLogUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *CommissionWithdrawalLogUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CommissionWithdrawalLogUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CommissionWithdrawalLogUpdate) check() error {
+	if v, ok := _u.mutation.Action(); ok {
+		if err := commissionwithdrawallog.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "CommissionWithdrawalLog.action": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.FromStatus(); ok {
+		if err := commissionwithdrawallog.FromStatusValidator(v); err != nil {
+			return &ValidationError{Name: "from_status", err: fmt.Errorf(`ent: validator failed for field "CommissionWithdrawalLog.from_status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ToStatus(); ok {
+		if err := commissionwithdrawallog.ToStatusValidator(v); err != nil {
+			return &ValidationError{Name: "to_status", err: fmt.Errorf(`ent: validator failed for field "CommissionWithdrawalLog.to_status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.OperatorRole(); ok {
+		if err := commissionwithdrawallog.OperatorRoleValidator(v); err != nil {
+			return &ValidationError{Name: "operator_role", err: fmt.Errorf(`ent: validator failed for field "CommissionWithdrawalLog.operator_role": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *CommissionWithdrawalLogUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(commissionwithdrawallog.Table, commissionwithdrawallog.Columns, sqlgraph.NewFieldSpec(commissionwithdrawallog.FieldID, field.TypeInt64))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.WithdrawalID(); ok {
+		_spec.SetField(commissionwithdrawallog.FieldWithdrawalID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedWithdrawalID(); ok {
+		_spec.AddField(commissionwithdrawallog.FieldWithdrawalID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(commissionwithdrawallog.FieldAction, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.FromStatus(); ok {
+		_spec.SetField(commissionwithdrawallog.FieldFromStatus, field.TypeString, value)
+	}
+	if _u.mutation.FromStatusCleared() {
+		_spec.ClearField(commissionwithdrawallog.FieldFromStatus, field.TypeString)
+	}
+	if value, ok := _u.mutation.ToStatus(); ok {
+		_spec.SetField(commissionwithdrawallog.FieldToStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.OperatorID(); ok {
+		_spec.SetField(commissionwithdrawallog.FieldOperatorID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedOperatorID(); ok {
+		_spec.AddField(commissionwithdrawallog.FieldOperatorID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.OperatorRole(); ok {
+		_spec.SetField(commissionwithdrawallog.FieldOperatorRole, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(commissionwithdrawallog.FieldNote, field.TypeString, value)
+	}
+	if _u.mutation.NoteCleared() {
+		_spec.ClearField(commissionwithdrawallog.FieldNote, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{commissionwithdrawallog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// CommissionWithdrawalLogUpdateOne is the builder for updating a single CommissionWithdrawalLog entity.
+type CommissionWithdrawalLogUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CommissionWithdrawalLogMutation
+}
+
+// SetWithdrawalID sets the "withdrawal_id" field.
+func (_u *CommissionWithdrawalLogUpdateOne) SetWithdrawalID(v int64) *CommissionWithdrawalLogUpdateOne {
+	_u.mutation.ResetWithdrawalID()
+	_u.mutation.SetWithdrawalID(v)
+	return _u
+}
+
+// SetNillableWithdrawalID sets the "withdrawal_id" field if the given value is not nil.
+func (_u *CommissionWithdrawalLogUpdateOne) SetNillableWithdrawalID(v *int64) *CommissionWithdrawalLogUpdateOne {
+	if v != nil {
+		_u.SetWithdrawalID(*v)
+	}
+	return _u
+}
+
+// AddWithdrawalID adds value to the "withdrawal_id" field.
+func (_u *CommissionWithdrawalLogUpdateOne) AddWithdrawalID(v int64) *CommissionWithdrawalLogUpdateOne {
+	_u.mutation.AddWithdrawalID(v)
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *CommissionWithdrawalLogUpdateOne) SetAction(v string) *CommissionWithdrawalLogUpdateOne {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *CommissionWithdrawalLogUpdateOne) SetNillableAction(v *string) *CommissionWithdrawalLogUpdateOne {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// SetFromStatus sets the "from_status" field.
+func (_u *CommissionWithdrawalLogUpdateOne) SetFromStatus(v string) *CommissionWithdrawalLogUpdateOne {
+	_u.mutation.SetFromStatus(v)
+	return _u
+}
+
+// SetNillableFromStatus sets the "from_status" field if the given value is not nil.
+func (_u *CommissionWithdrawalLogUpdateOne) SetNillableFromStatus(v *string) *CommissionWithdrawalLogUpdateOne {
+	if v != nil {
+		_u.SetFromStatus(*v)
+	}
+	return _u
+}
+
+// ClearFromStatus clears the value of the "from_status" field.
+func (_u *CommissionWithdrawalLogUpdateOne) ClearFromStatus() *CommissionWithdrawalLogUpdateOne {
+	_u.mutation.ClearFromStatus()
+	return _u
+}
+
+// SetToStatus sets the "to_status" field.
+func (_u *CommissionWithdrawalLogUpdateOne) SetToStatus(v string) *CommissionWithdrawalLogUpdateOne {
+	_u.mutation.SetToStatus(v)
+	return _u
+}
+
+// SetNillableToStatus sets the "to_status" field if the given value is not nil.
+func (_u *CommissionWithdrawalLogUpdateOne) SetNillableToStatus(v *string) *CommissionWithdrawalLogUpdateOne {
+	if v != nil {
+		_u.SetToStatus(*v)
+	}
+	return _u
+}
+
+// SetOperatorID sets the "operator_id" field.
+func (_u *CommissionWithdrawalLogUpdateOne) SetOperatorID(v int64) *CommissionWithdrawalLogUpdateOne {
+	_u.mutation.ResetOperatorID()
+	_u.mutation.SetOperatorID(v)
+	return _u
+}
+
+// SetNillableOperatorID sets the "operator_id" field if the given value is not nil.
+func (_u *CommissionWithdrawalLogUpdateOne) SetNillableOperatorID(v *int64) *CommissionWithdrawalLogUpdateOne {
+	if v != nil {
+		_u.SetOperatorID(*v)
+	}
+	return _u
+}
+
+// AddOperatorID adds value to the "operator_id" field.
+func (_u *CommissionWithdrawalLogUpdateOne) AddOperatorID(v int64) *CommissionWithdrawalLogUpdateOne {
+	_u.mutation.AddOperatorID(v)
+	return _u
+}
+
+// SetOperatorRole sets the "operator_role" field.
+func (_u *CommissionWithdrawalLogUpdateOne) SetOperatorRole(v string) *CommissionWithdrawalLogUpdateOne {
+	_u.mutation.SetOperatorRole(v)
+	return _u
+}
+
+// SetNillableOperatorRole sets the "operator_role" field if the given value is not nil.
+func (_u *CommissionWithdrawalLogUpdateOne) SetNillableOperatorRole(v *string) *CommissionWithdrawalLogUpdateOne {
+	if v != nil {
+		_u.SetOperatorRole(*v)
+	}
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *CommissionWithdrawalLogUpdateOne) SetNote(v string) *CommissionWithdrawalLogUpdateOne {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *CommissionWithdrawalLogUpdateOne) SetNillableNote(v *string) *CommissionWithdrawalLogUpdateOne {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// ClearNote clears the value of the "note" field.
+func (_u *CommissionWithdrawalLogUpdateOne) ClearNote() *CommissionWithdrawalLogUpdateOne {
+	_u.mutation.ClearNote()
+	return _u
+}
+
+// Mutation returns the CommissionWithdrawalLogMutation object of the builder.
+func (_u *CommissionWithdrawalLogUpdateOne) Mutation() *CommissionWithdrawalLogMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the CommissionWithdrawalLogUpdate builder.
+func (_u *CommissionWithdrawalLogUpdateOne) Where(ps ...predicate.CommissionWithdrawalLog) *CommissionWithdrawalLogUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *CommissionWithdrawalLogUpdateOne) Select(field string, fields ...string) *CommissionWithdrawalLogUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated CommissionWithdrawalLog entity.
+func (_u *CommissionWithdrawalLogUpdateOne) Save(ctx context.Context) (*CommissionWithdrawalLog, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CommissionWithdrawalLogUpdateOne) SaveX(ctx context.Context) *CommissionWithdrawalLog {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *CommissionWithdrawalLogUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CommissionWithdrawalLogUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CommissionWithdrawalLogUpdateOne) check() error {
+	if v, ok := _u.mutation.Action(); ok {
+		if err := commissionwithdrawallog.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "CommissionWithdrawalLog.action": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.FromStatus(); ok {
+		if err := commissionwithdrawallog.FromStatusValidator(v); err != nil {
+			return &ValidationError{Name: "from_status", err: fmt.Errorf(`ent: validator failed for field "CommissionWithdrawalLog.from_status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ToStatus(); ok {
+		if err := commissionwithdrawallog.ToStatusValidator(v); err != nil {
+			return &ValidationError{Name: "to_status", err: fmt.Errorf(`ent: validator failed for field "CommissionWithdrawalLog.to_status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.OperatorRole(); ok {
+		if err := commissionwithdrawallog.OperatorRoleValidator(v); err != nil {
+			return &ValidationError{Name: "operator_role", err: fmt.Errorf(`ent: validator failed for field "CommissionWithdrawalLog.operator_role": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *CommissionWithdrawalLogUpdateOne) sqlSave(ctx context.Context) (_node *CommissionWithdrawalLog, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(commissionwithdrawallog.Table, commissionwithdrawallog.Columns, sqlgraph.NewFieldSpec(commissionwithdrawallog.FieldID, field.TypeInt64))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CommissionWithdrawalLog.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, commissionwithdrawallog.FieldID)
+		for _, f := range fields {
+			if !commissionwithdrawallog.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != commissionwithdrawallog.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.WithdrawalID(); ok {
+		_spec.SetField(commissionwithdrawallog.FieldWithdrawalID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedWithdrawalID(); ok {
+		_spec.AddField(commissionwithdrawallog.FieldWithdrawalID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(commissionwithdrawallog.FieldAction, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.FromStatus(); ok {
+		_spec.SetField(commissionwithdrawallog.FieldFromStatus, field.TypeString, value)
+	}
+	if _u.mutation.FromStatusCleared() {
+		_spec.ClearField(commissionwithdrawallog.FieldFromStatus, field.TypeString)
+	}
+	if value, ok := _u.mutation.ToStatus(); ok {
+		_spec.SetField(commissionwithdrawallog.FieldToStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.OperatorID(); ok {
+		_spec.SetField(commissionwithdrawallog.FieldOperatorID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedOperatorID(); ok {
+		_spec.AddField(commissionwithdrawallog.FieldOperatorID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.OperatorRole(); ok {
+		_spec.SetField(commissionwithdrawallog.FieldOperatorRole, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(commissionwithdrawallog.FieldNote, field.TypeString, value)
+	}
+	if _u.mutation.NoteCleared() {
+		_spec.ClearField(commissionwithdrawallog.FieldNote, field.TypeString)
+	}
+	_node = &CommissionWithdrawalLog{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{commissionwithdrawallog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,250 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Wei-Shaw/sub2api/ent/gatewayplugin"
+)
+
+// GatewayPlugin is the model entity for the GatewayPlugin schema.
+type GatewayPlugin struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Enabled holds the value of the "enabled" field.
+	Enabled bool `json:"enabled,omitempty"`
+	// Priority holds the value of the "priority" field.
+	Priority int `json:"priority,omitempty"`
+	// GroupIds holds the value of the "group_ids" field.
+	GroupIds []int64 `json:"group_ids,omitempty"`
+	// FailureMode holds the value of the "failure_mode" field.
+	FailureMode string `json:"failure_mode,omitempty"`
+	// TimeoutMs holds the value of the "timeout_ms" field.
+	TimeoutMs int `json:"timeout_ms,omitempty"`
+	// MemoryLimitMB holds the value of the "memory_limit_mb" field.
+	MemoryLimitMB int `json:"memory_limit_mb,omitempty"`
+	// Hooks holds the value of the "hooks" field.
+	Hooks []string `json:"hooks,omitempty"`
+	// Module holds the value of the "module" field.
+	Module []byte `json:"module,omitempty"`
+	// ModuleSha256 holds the value of the "module_sha256" field.
+	ModuleSha256 string `json:"module_sha256,omitempty"`
+	// Description holds the value of the "description" field.
+	Description  *string `json:"description,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*GatewayPlugin) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case gatewayplugin.FieldGroupIds, gatewayplugin.FieldHooks, gatewayplugin.FieldModule:
+			values[i] = new([]byte)
+		case gatewayplugin.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case gatewayplugin.FieldID, gatewayplugin.FieldPriority, gatewayplugin.FieldTimeoutMs, gatewayplugin.FieldMemoryLimitMB:
+			values[i] = new(sql.NullInt64)
+		case gatewayplugin.FieldName, gatewayplugin.FieldFailureMode, gatewayplugin.FieldModuleSha256, gatewayplugin.FieldDescription:
+			values[i] = new(sql.NullString)
+		case gatewayplugin.FieldCreatedAt, gatewayplugin.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the GatewayPlugin fields.
+func (_m *GatewayPlugin) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case gatewayplugin.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int64(value.Int64)
+		case gatewayplugin.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case gatewayplugin.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case gatewayplugin.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case gatewayplugin.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				_m.Enabled = value.Bool
+			}
+		case gatewayplugin.FieldPriority:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field priority", values[i])
+			} else if value.Valid {
+				_m.Priority = int(value.Int64)
+			}
+		case gatewayplugin.FieldGroupIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field group_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.GroupIds); err != nil {
+					return fmt.Errorf("unmarshal field group_ids: %w", err)
+				}
+			}
+		case gatewayplugin.FieldFailureMode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field failure_mode", values[i])
+			} else if value.Valid {
+				_m.FailureMode = value.String
+			}
+		case gatewayplugin.FieldTimeoutMs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field timeout_ms", values[i])
+			} else if value.Valid {
+				_m.TimeoutMs = int(value.Int64)
+			}
+		case gatewayplugin.FieldMemoryLimitMB:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field memory_limit_mb", values[i])
+			} else if value.Valid {
+				_m.MemoryLimitMB = int(value.Int64)
+			}
+		case gatewayplugin.FieldHooks:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field hooks", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Hooks); err != nil {
+					return fmt.Errorf("unmarshal field hooks: %w", err)
+				}
+			}
+		case gatewayplugin.FieldModule:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field module", values[i])
+			} else if value != nil {
+				_m.Module = *value
+			}
+		case gatewayplugin.FieldModuleSha256:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field module_sha256", values[i])
+			} else if value.Valid {
+				_m.ModuleSha256 = value.String
+			}
+		case gatewayplugin.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = new(string)
+				*_m.Description = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the GatewayPlugin.
+// This includes values selected through modifiers, order, etc.
+func (_m *GatewayPlugin) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this GatewayPlugin.
+// Note that you need to call GatewayPlugin.Unwrap() before calling this method if this GatewayPlugin
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *GatewayPlugin) Update() *GatewayPluginUpdateOne {
+	return NewGatewayPluginClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the GatewayPlugin entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *GatewayPlugin) Unwrap() *GatewayPlugin {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: GatewayPlugin is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *GatewayPlugin) String() string {
+	var builder strings.Builder
+	builder.WriteString("GatewayPlugin(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Enabled))
+	builder.WriteString(", ")
+	builder.WriteString("priority=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Priority))
+	builder.WriteString(", ")
+	builder.WriteString("group_ids=")
+	builder.WriteString(fmt.Sprintf("%v", _m.GroupIds))
+	builder.WriteString(", ")
+	builder.WriteString("failure_mode=")
+	builder.WriteString(_m.FailureMode)
+	builder.WriteString(", ")
+	builder.WriteString("timeout_ms=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TimeoutMs))
+	builder.WriteString(", ")
+	builder.WriteString("memory_limit_mb=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MemoryLimitMB))
+	builder.WriteString(", ")
+	builder.WriteString("hooks=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Hooks))
+	builder.WriteString(", ")
+	builder.WriteString("module=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Module))
+	builder.WriteString(", ")
+	builder.WriteString("module_sha256=")
+	builder.WriteString(_m.ModuleSha256)
+	builder.WriteString(", ")
+	if v := _m.Description; v != nil {
+		builder.WriteString("description=")
+		builder.WriteString(*v)
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// GatewayPlugins is a parsable slice of GatewayPlugin.
+type GatewayPlugins []*GatewayPlugin
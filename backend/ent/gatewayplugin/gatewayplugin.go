@@ -0,0 +1,155 @@
+// Code generated by ent, DO NOT EDIT.
+
+package gatewayplugin
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the gatewayplugin type in the database.
+	Label = "gateway_plugin"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldEnabled holds the string denoting the enabled field in the database.
+	FieldEnabled = "enabled"
+	// FieldPriority holds the string denoting the priority field in the database.
+	FieldPriority = "priority"
+	// FieldGroupIds holds the string denoting the group_ids field in the database.
+	FieldGroupIds = "group_ids"
+	// FieldFailureMode holds the string denoting the failure_mode field in the database.
+	FieldFailureMode = "failure_mode"
+	// FieldTimeoutMs holds the string denoting the timeout_ms field in the database.
+	FieldTimeoutMs = "timeout_ms"
+	// FieldMemoryLimitMB holds the string denoting the memory_limit_mb field in the database.
+	FieldMemoryLimitMB = "memory_limit_mb"
+	// FieldHooks holds the string denoting the hooks field in the database.
+	FieldHooks = "hooks"
+	// FieldModule holds the string denoting the module field in the database.
+	FieldModule = "module"
+	// FieldModuleSha256 holds the string denoting the module_sha256 field in the database.
+	FieldModuleSha256 = "module_sha256"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// Table holds the table name of the gatewayplugin in the database.
+	Table = "gateway_plugins"
+)
+
+// Columns holds all SQL columns for gatewayplugin fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldName,
+	FieldEnabled,
+	FieldPriority,
+	FieldGroupIds,
+	FieldFailureMode,
+	FieldTimeoutMs,
+	FieldMemoryLimitMB,
+	FieldHooks,
+	FieldModule,
+	FieldModuleSha256,
+	FieldDescription,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultEnabled holds the default value on creation for the "enabled" field.
+	DefaultEnabled bool
+	// DefaultPriority holds the default value on creation for the "priority" field.
+	DefaultPriority int
+	// DefaultFailureMode holds the default value on creation for the "failure_mode" field.
+	DefaultFailureMode string
+	// FailureModeValidator is a validator for the "failure_mode" field. It is called by the builders before save.
+	FailureModeValidator func(string) error
+	// DefaultTimeoutMs holds the default value on creation for the "timeout_ms" field.
+	DefaultTimeoutMs int
+	// DefaultMemoryLimitMB holds the default value on creation for the "memory_limit_mb" field.
+	DefaultMemoryLimitMB int
+	// ModuleSha256Validator is a validator for the "module_sha256" field. It is called by the builders before save.
+	ModuleSha256Validator func(string) error
+)
+
+// OrderOption defines the ordering options for the GatewayPlugin queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByEnabled orders the results by the enabled field.
+func ByEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnabled, opts...).ToFunc()
+}
+
+// ByPriority orders the results by the priority field.
+func ByPriority(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPriority, opts...).ToFunc()
+}
+
+// ByFailureMode orders the results by the failure_mode field.
+func ByFailureMode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFailureMode, opts...).ToFunc()
+}
+
+// ByTimeoutMs orders the results by the timeout_ms field.
+func ByTimeoutMs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimeoutMs, opts...).ToFunc()
+}
+
+// ByMemoryLimitMB orders the results by the memory_limit_mb field.
+func ByMemoryLimitMB(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMemoryLimitMB, opts...).ToFunc()
+}
+
+// ByModuleSha256 orders the results by the module_sha256 field.
+func ByModuleSha256(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldModuleSha256, opts...).ToFunc()
+}
+
+// ByDescription orders the results by the description field.
+func ByDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescription, opts...).ToFunc()
+}
@@ -0,0 +1,665 @@
+// Code generated by ent, DO NOT EDIT.
+
+package gatewayplugin
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Wei-Shaw/sub2api/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldEQ(FieldName, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldEQ(FieldEnabled, v))
+}
+
+// Priority applies equality check predicate on the "priority" field. It's identical to PriorityEQ.
+func Priority(v int) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldEQ(FieldPriority, v))
+}
+
+// FailureMode applies equality check predicate on the "failure_mode" field. It's identical to FailureModeEQ.
+func FailureMode(v string) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldEQ(FieldFailureMode, v))
+}
+
+// TimeoutMs applies equality check predicate on the "timeout_ms" field. It's identical to TimeoutMsEQ.
+func TimeoutMs(v int) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldEQ(FieldTimeoutMs, v))
+}
+
+// MemoryLimitMB applies equality check predicate on the "memory_limit_mb" field. It's identical to MemoryLimitMBEQ.
+func MemoryLimitMB(v int) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldEQ(FieldMemoryLimitMB, v))
+}
+
+// Module applies equality check predicate on the "module" field. It's identical to ModuleEQ.
+func Module(v []byte) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldEQ(FieldModule, v))
+}
+
+// ModuleSha256 applies equality check predicate on the "module_sha256" field. It's identical to ModuleSha256EQ.
+func ModuleSha256(v string) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldEQ(FieldModuleSha256, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldEQ(FieldDescription, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldContainsFold(FieldName, v))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// PriorityEQ applies the EQ predicate on the "priority" field.
+func PriorityEQ(v int) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldEQ(FieldPriority, v))
+}
+
+// PriorityNEQ applies the NEQ predicate on the "priority" field.
+func PriorityNEQ(v int) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldNEQ(FieldPriority, v))
+}
+
+// PriorityIn applies the In predicate on the "priority" field.
+func PriorityIn(vs ...int) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldIn(FieldPriority, vs...))
+}
+
+// PriorityNotIn applies the NotIn predicate on the "priority" field.
+func PriorityNotIn(vs ...int) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldNotIn(FieldPriority, vs...))
+}
+
+// PriorityGT applies the GT predicate on the "priority" field.
+func PriorityGT(v int) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldGT(FieldPriority, v))
+}
+
+// PriorityGTE applies the GTE predicate on the "priority" field.
+func PriorityGTE(v int) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldGTE(FieldPriority, v))
+}
+
+// PriorityLT applies the LT predicate on the "priority" field.
+func PriorityLT(v int) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldLT(FieldPriority, v))
+}
+
+// PriorityLTE applies the LTE predicate on the "priority" field.
+func PriorityLTE(v int) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldLTE(FieldPriority, v))
+}
+
+// GroupIdsIsNil applies the IsNil predicate on the "group_ids" field.
+func GroupIdsIsNil() predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldIsNull(FieldGroupIds))
+}
+
+// GroupIdsNotNil applies the NotNil predicate on the "group_ids" field.
+func GroupIdsNotNil() predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldNotNull(FieldGroupIds))
+}
+
+// FailureModeEQ applies the EQ predicate on the "failure_mode" field.
+func FailureModeEQ(v string) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldEQ(FieldFailureMode, v))
+}
+
+// FailureModeNEQ applies the NEQ predicate on the "failure_mode" field.
+func FailureModeNEQ(v string) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldNEQ(FieldFailureMode, v))
+}
+
+// FailureModeIn applies the In predicate on the "failure_mode" field.
+func FailureModeIn(vs ...string) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldIn(FieldFailureMode, vs...))
+}
+
+// FailureModeNotIn applies the NotIn predicate on the "failure_mode" field.
+func FailureModeNotIn(vs ...string) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldNotIn(FieldFailureMode, vs...))
+}
+
+// FailureModeGT applies the GT predicate on the "failure_mode" field.
+func FailureModeGT(v string) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldGT(FieldFailureMode, v))
+}
+
+// FailureModeGTE applies the GTE predicate on the "failure_mode" field.
+func FailureModeGTE(v string) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldGTE(FieldFailureMode, v))
+}
+
+// FailureModeLT applies the LT predicate on the "failure_mode" field.
+func FailureModeLT(v string) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldLT(FieldFailureMode, v))
+}
+
+// FailureModeLTE applies the LTE predicate on the "failure_mode" field.
+func FailureModeLTE(v string) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldLTE(FieldFailureMode, v))
+}
+
+// FailureModeContains applies the Contains predicate on the "failure_mode" field.
+func FailureModeContains(v string) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldContains(FieldFailureMode, v))
+}
+
+// FailureModeHasPrefix applies the HasPrefix predicate on the "failure_mode" field.
+func FailureModeHasPrefix(v string) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldHasPrefix(FieldFailureMode, v))
+}
+
+// FailureModeHasSuffix applies the HasSuffix predicate on the "failure_mode" field.
+func FailureModeHasSuffix(v string) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldHasSuffix(FieldFailureMode, v))
+}
+
+// FailureModeEqualFold applies the EqualFold predicate on the "failure_mode" field.
+func FailureModeEqualFold(v string) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldEqualFold(FieldFailureMode, v))
+}
+
+// FailureModeContainsFold applies the ContainsFold predicate on the "failure_mode" field.
+func FailureModeContainsFold(v string) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldContainsFold(FieldFailureMode, v))
+}
+
+// TimeoutMsEQ applies the EQ predicate on the "timeout_ms" field.
+func TimeoutMsEQ(v int) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldEQ(FieldTimeoutMs, v))
+}
+
+// TimeoutMsNEQ applies the NEQ predicate on the "timeout_ms" field.
+func TimeoutMsNEQ(v int) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldNEQ(FieldTimeoutMs, v))
+}
+
+// TimeoutMsIn applies the In predicate on the "timeout_ms" field.
+func TimeoutMsIn(vs ...int) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldIn(FieldTimeoutMs, vs...))
+}
+
+// TimeoutMsNotIn applies the NotIn predicate on the "timeout_ms" field.
+func TimeoutMsNotIn(vs ...int) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldNotIn(FieldTimeoutMs, vs...))
+}
+
+// TimeoutMsGT applies the GT predicate on the "timeout_ms" field.
+func TimeoutMsGT(v int) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldGT(FieldTimeoutMs, v))
+}
+
+// TimeoutMsGTE applies the GTE predicate on the "timeout_ms" field.
+func TimeoutMsGTE(v int) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldGTE(FieldTimeoutMs, v))
+}
+
+// TimeoutMsLT applies the LT predicate on the "timeout_ms" field.
+func TimeoutMsLT(v int) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldLT(FieldTimeoutMs, v))
+}
+
+// TimeoutMsLTE applies the LTE predicate on the "timeout_ms" field.
+func TimeoutMsLTE(v int) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldLTE(FieldTimeoutMs, v))
+}
+
+// MemoryLimitMBEQ applies the EQ predicate on the "memory_limit_mb" field.
+func MemoryLimitMBEQ(v int) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldEQ(FieldMemoryLimitMB, v))
+}
+
+// MemoryLimitMBNEQ applies the NEQ predicate on the "memory_limit_mb" field.
+func MemoryLimitMBNEQ(v int) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldNEQ(FieldMemoryLimitMB, v))
+}
+
+// MemoryLimitMBIn applies the In predicate on the "memory_limit_mb" field.
+func MemoryLimitMBIn(vs ...int) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldIn(FieldMemoryLimitMB, vs...))
+}
+
+// MemoryLimitMBNotIn applies the NotIn predicate on the "memory_limit_mb" field.
+func MemoryLimitMBNotIn(vs ...int) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldNotIn(FieldMemoryLimitMB, vs...))
+}
+
+// MemoryLimitMBGT applies the GT predicate on the "memory_limit_mb" field.
+func MemoryLimitMBGT(v int) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldGT(FieldMemoryLimitMB, v))
+}
+
+// MemoryLimitMBGTE applies the GTE predicate on the "memory_limit_mb" field.
+func MemoryLimitMBGTE(v int) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldGTE(FieldMemoryLimitMB, v))
+}
+
+// MemoryLimitMBLT applies the LT predicate on the "memory_limit_mb" field.
+func MemoryLimitMBLT(v int) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldLT(FieldMemoryLimitMB, v))
+}
+
+// MemoryLimitMBLTE applies the LTE predicate on the "memory_limit_mb" field.
+func MemoryLimitMBLTE(v int) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldLTE(FieldMemoryLimitMB, v))
+}
+
+// HooksIsNil applies the IsNil predicate on the "hooks" field.
+func HooksIsNil() predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldIsNull(FieldHooks))
+}
+
+// HooksNotNil applies the NotNil predicate on the "hooks" field.
+func HooksNotNil() predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldNotNull(FieldHooks))
+}
+
+// ModuleEQ applies the EQ predicate on the "module" field.
+func ModuleEQ(v []byte) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldEQ(FieldModule, v))
+}
+
+// ModuleNEQ applies the NEQ predicate on the "module" field.
+func ModuleNEQ(v []byte) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldNEQ(FieldModule, v))
+}
+
+// ModuleIn applies the In predicate on the "module" field.
+func ModuleIn(vs ...[]byte) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldIn(FieldModule, vs...))
+}
+
+// ModuleNotIn applies the NotIn predicate on the "module" field.
+func ModuleNotIn(vs ...[]byte) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldNotIn(FieldModule, vs...))
+}
+
+// ModuleGT applies the GT predicate on the "module" field.
+func ModuleGT(v []byte) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldGT(FieldModule, v))
+}
+
+// ModuleGTE applies the GTE predicate on the "module" field.
+func ModuleGTE(v []byte) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldGTE(FieldModule, v))
+}
+
+// ModuleLT applies the LT predicate on the "module" field.
+func ModuleLT(v []byte) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldLT(FieldModule, v))
+}
+
+// ModuleLTE applies the LTE predicate on the "module" field.
+func ModuleLTE(v []byte) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldLTE(FieldModule, v))
+}
+
+// ModuleSha256EQ applies the EQ predicate on the "module_sha256" field.
+func ModuleSha256EQ(v string) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldEQ(FieldModuleSha256, v))
+}
+
+// ModuleSha256NEQ applies the NEQ predicate on the "module_sha256" field.
+func ModuleSha256NEQ(v string) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldNEQ(FieldModuleSha256, v))
+}
+
+// ModuleSha256In applies the In predicate on the "module_sha256" field.
+func ModuleSha256In(vs ...string) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldIn(FieldModuleSha256, vs...))
+}
+
+// ModuleSha256NotIn applies the NotIn predicate on the "module_sha256" field.
+func ModuleSha256NotIn(vs ...string) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldNotIn(FieldModuleSha256, vs...))
+}
+
+// ModuleSha256GT applies the GT predicate on the "module_sha256" field.
+func ModuleSha256GT(v string) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldGT(FieldModuleSha256, v))
+}
+
+// ModuleSha256GTE applies the GTE predicate on the "module_sha256" field.
+func ModuleSha256GTE(v string) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldGTE(FieldModuleSha256, v))
+}
+
+// ModuleSha256LT applies the LT predicate on the "module_sha256" field.
+func ModuleSha256LT(v string) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldLT(FieldModuleSha256, v))
+}
+
+// ModuleSha256LTE applies the LTE predicate on the "module_sha256" field.
+func ModuleSha256LTE(v string) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldLTE(FieldModuleSha256, v))
+}
+
+// ModuleSha256Contains applies the Contains predicate on the "module_sha256" field.
+func ModuleSha256Contains(v string) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldContains(FieldModuleSha256, v))
+}
+
+// ModuleSha256HasPrefix applies the HasPrefix predicate on the "module_sha256" field.
+func ModuleSha256HasPrefix(v string) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldHasPrefix(FieldModuleSha256, v))
+}
+
+// ModuleSha256HasSuffix applies the HasSuffix predicate on the "module_sha256" field.
+func ModuleSha256HasSuffix(v string) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldHasSuffix(FieldModuleSha256, v))
+}
+
+// ModuleSha256EqualFold applies the EqualFold predicate on the "module_sha256" field.
+func ModuleSha256EqualFold(v string) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldEqualFold(FieldModuleSha256, v))
+}
+
+// ModuleSha256ContainsFold applies the ContainsFold predicate on the "module_sha256" field.
+func ModuleSha256ContainsFold(v string) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldContainsFold(FieldModuleSha256, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.GatewayPlugin) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.GatewayPlugin) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.GatewayPlugin) predicate.GatewayPlugin {
+	return predicate.GatewayPlugin(sql.NotPredicates(p))
+}
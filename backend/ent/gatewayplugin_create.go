@@ -0,0 +1,1287 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Wei-Shaw/sub2api/ent/gatewayplugin"
+)
+
+// GatewayPluginCreate is the builder for creating a GatewayPlugin entity.
+type GatewayPluginCreate struct {
+	config
+	mutation *GatewayPluginMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *GatewayPluginCreate) SetCreatedAt(v time.Time) *GatewayPluginCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *GatewayPluginCreate) SetNillableCreatedAt(v *time.Time) *GatewayPluginCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *GatewayPluginCreate) SetUpdatedAt(v time.Time) *GatewayPluginCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *GatewayPluginCreate) SetNillableUpdatedAt(v *time.Time) *GatewayPluginCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *GatewayPluginCreate) SetName(v string) *GatewayPluginCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetEnabled sets the "enabled" field.
+func (_c *GatewayPluginCreate) SetEnabled(v bool) *GatewayPluginCreate {
+	_c.mutation.SetEnabled(v)
+	return _c
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_c *GatewayPluginCreate) SetNillableEnabled(v *bool) *GatewayPluginCreate {
+	if v != nil {
+		_c.SetEnabled(*v)
+	}
+	return _c
+}
+
+// SetPriority sets the "priority" field.
+func (_c *GatewayPluginCreate) SetPriority(v int) *GatewayPluginCreate {
+	_c.mutation.SetPriority(v)
+	return _c
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_c *GatewayPluginCreate) SetNillablePriority(v *int) *GatewayPluginCreate {
+	if v != nil {
+		_c.SetPriority(*v)
+	}
+	return _c
+}
+
+// SetGroupIds sets the "group_ids" field.
+func (_c *GatewayPluginCreate) SetGroupIds(v []int64) *GatewayPluginCreate {
+	_c.mutation.SetGroupIds(v)
+	return _c
+}
+
+// SetFailureMode sets the "failure_mode" field.
+func (_c *GatewayPluginCreate) SetFailureMode(v string) *GatewayPluginCreate {
+	_c.mutation.SetFailureMode(v)
+	return _c
+}
+
+// SetNillableFailureMode sets the "failure_mode" field if the given value is not nil.
+func (_c *GatewayPluginCreate) SetNillableFailureMode(v *string) *GatewayPluginCreate {
+	if v != nil {
+		_c.SetFailureMode(*v)
+	}
+	return _c
+}
+
+// SetTimeoutMs sets the "timeout_ms" field.
+func (_c *GatewayPluginCreate) SetTimeoutMs(v int) *GatewayPluginCreate {
+	_c.mutation.SetTimeoutMs(v)
+	return _c
+}
+
+// SetNillableTimeoutMs sets the "timeout_ms" field if the given value is not nil.
+func (_c *GatewayPluginCreate) SetNillableTimeoutMs(v *int) *GatewayPluginCreate {
+	if v != nil {
+		_c.SetTimeoutMs(*v)
+	}
+	return _c
+}
+
+// SetMemoryLimitMB sets the "memory_limit_mb" field.
+func (_c *GatewayPluginCreate) SetMemoryLimitMB(v int) *GatewayPluginCreate {
+	_c.mutation.SetMemoryLimitMB(v)
+	return _c
+}
+
+// SetNillableMemoryLimitMB sets the "memory_limit_mb" field if the given value is not nil.
+func (_c *GatewayPluginCreate) SetNillableMemoryLimitMB(v *int) *GatewayPluginCreate {
+	if v != nil {
+		_c.SetMemoryLimitMB(*v)
+	}
+	return _c
+}
+
+// SetHooks sets the "hooks" field.
+func (_c *GatewayPluginCreate) SetHooks(v []string) *GatewayPluginCreate {
+	_c.mutation.SetHooks(v)
+	return _c
+}
+
+// SetModule sets the "module" field.
+func (_c *GatewayPluginCreate) SetModule(v []byte) *GatewayPluginCreate {
+	_c.mutation.SetModule(v)
+	return _c
+}
+
+// SetModuleSha256 sets the "module_sha256" field.
+func (_c *GatewayPluginCreate) SetModuleSha256(v string) *GatewayPluginCreate {
+	_c.mutation.SetModuleSha256(v)
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *GatewayPluginCreate) SetDescription(v string) *GatewayPluginCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *GatewayPluginCreate) SetNillableDescription(v *string) *GatewayPluginCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// Mutation returns the GatewayPluginMutation object of the builder.
+func (_c *GatewayPluginCreate) Mutation() *GatewayPluginMutation {
+	return _c.mutation
+}
+
+// Save creates the GatewayPlugin in the database.
+func (_c *GatewayPluginCreate) Save(ctx context.Context) (*GatewayPlugin, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *GatewayPluginCreate) SaveX(ctx context.Context) *GatewayPlugin {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *GatewayPluginCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *GatewayPluginCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *GatewayPluginCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := gatewayplugin.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := gatewayplugin.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		v := gatewayplugin.DefaultEnabled
+		_c.mutation.SetEnabled(v)
+	}
+	if _, ok := _c.mutation.Priority(); !ok {
+		v := gatewayplugin.DefaultPriority
+		_c.mutation.SetPriority(v)
+	}
+	if _, ok := _c.mutation.FailureMode(); !ok {
+		v := gatewayplugin.DefaultFailureMode
+		_c.mutation.SetFailureMode(v)
+	}
+	if _, ok := _c.mutation.TimeoutMs(); !ok {
+		v := gatewayplugin.DefaultTimeoutMs
+		_c.mutation.SetTimeoutMs(v)
+	}
+	if _, ok := _c.mutation.MemoryLimitMB(); !ok {
+		v := gatewayplugin.DefaultMemoryLimitMB
+		_c.mutation.SetMemoryLimitMB(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *GatewayPluginCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "GatewayPlugin.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "GatewayPlugin.updated_at"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "GatewayPlugin.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := gatewayplugin.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "GatewayPlugin.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "GatewayPlugin.enabled"`)}
+	}
+	if _, ok := _c.mutation.Priority(); !ok {
+		return &ValidationError{Name: "priority", err: errors.New(`ent: missing required field "GatewayPlugin.priority"`)}
+	}
+	if _, ok := _c.mutation.FailureMode(); !ok {
+		return &ValidationError{Name: "failure_mode", err: errors.New(`ent: missing required field "GatewayPlugin.failure_mode"`)}
+	}
+	if v, ok := _c.mutation.FailureMode(); ok {
+		if err := gatewayplugin.FailureModeValidator(v); err != nil {
+			return &ValidationError{Name: "failure_mode", err: fmt.Errorf(`ent: validator failed for field "GatewayPlugin.failure_mode": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TimeoutMs(); !ok {
+		return &ValidationError{Name: "timeout_ms", err: errors.New(`ent: missing required field "GatewayPlugin.timeout_ms"`)}
+	}
+	if _, ok := _c.mutation.MemoryLimitMB(); !ok {
+		return &ValidationError{Name: "memory_limit_mb", err: errors.New(`ent: missing required field "GatewayPlugin.memory_limit_mb"`)}
+	}
+	if _, ok := _c.mutation.Module(); !ok {
+		return &ValidationError{Name: "module", err: errors.New(`ent: missing required field "GatewayPlugin.module"`)}
+	}
+	if _, ok := _c.mutation.ModuleSha256(); !ok {
+		return &ValidationError{Name: "module_sha256", err: errors.New(`ent: missing required field "GatewayPlugin.module_sha256"`)}
+	}
+	if v, ok := _c.mutation.ModuleSha256(); ok {
+		if err := gatewayplugin.ModuleSha256Validator(v); err != nil {
+			return &ValidationError{Name: "module_sha256", err: fmt.Errorf(`ent: validator failed for field "GatewayPlugin.module_sha256": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *GatewayPluginCreate) sqlSave(ctx context.Context) (*GatewayPlugin, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int64(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *GatewayPluginCreate) createSpec() (*GatewayPlugin, *sqlgraph.CreateSpec) {
+	var (
+		_node = &GatewayPlugin{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(gatewayplugin.Table, sqlgraph.NewFieldSpec(gatewayplugin.FieldID, field.TypeInt64))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(gatewayplugin.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(gatewayplugin.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(gatewayplugin.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Enabled(); ok {
+		_spec.SetField(gatewayplugin.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := _c.mutation.Priority(); ok {
+		_spec.SetField(gatewayplugin.FieldPriority, field.TypeInt, value)
+		_node.Priority = value
+	}
+	if value, ok := _c.mutation.GroupIds(); ok {
+		_spec.SetField(gatewayplugin.FieldGroupIds, field.TypeJSON, value)
+		_node.GroupIds = value
+	}
+	if value, ok := _c.mutation.FailureMode(); ok {
+		_spec.SetField(gatewayplugin.FieldFailureMode, field.TypeString, value)
+		_node.FailureMode = value
+	}
+	if value, ok := _c.mutation.TimeoutMs(); ok {
+		_spec.SetField(gatewayplugin.FieldTimeoutMs, field.TypeInt, value)
+		_node.TimeoutMs = value
+	}
+	if value, ok := _c.mutation.MemoryLimitMB(); ok {
+		_spec.SetField(gatewayplugin.FieldMemoryLimitMB, field.TypeInt, value)
+		_node.MemoryLimitMB = value
+	}
+	if value, ok := _c.mutation.Hooks(); ok {
+		_spec.SetField(gatewayplugin.FieldHooks, field.TypeJSON, value)
+		_node.Hooks = value
+	}
+	if value, ok := _c.mutation.Module(); ok {
+		_spec.SetField(gatewayplugin.FieldModule, field.TypeBytes, value)
+		_node.Module = value
+	}
+	if value, ok := _c.mutation.ModuleSha256(); ok {
+		_spec.SetField(gatewayplugin.FieldModuleSha256, field.TypeString, value)
+		_node.ModuleSha256 = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(gatewayplugin.FieldDescription, field.TypeString, value)
+		_node.Description = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.GatewayPlugin.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.GatewayPluginUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *GatewayPluginCreate) OnConflict(opts ...sql.ConflictOption) *GatewayPluginUpsertOne {
+	_c.conflict = opts
+	return &GatewayPluginUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.GatewayPlugin.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *GatewayPluginCreate) OnConflictColumns(columns ...string) *GatewayPluginUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &GatewayPluginUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// GatewayPluginUpsertOne is the builder for "upsert"-ing
+	//  one GatewayPlugin node.
+	GatewayPluginUpsertOne struct {
+		create *GatewayPluginCreate
+	}
+
+	// GatewayPluginUpsert is the "OnConflict" setter.
+	GatewayPluginUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *GatewayPluginUpsert) SetUpdatedAt(v time.Time) *GatewayPluginUpsert {
+	u.Set(gatewayplugin.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *GatewayPluginUpsert) UpdateUpdatedAt() *GatewayPluginUpsert {
+	u.SetExcluded(gatewayplugin.FieldUpdatedAt)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *GatewayPluginUpsert) SetName(v string) *GatewayPluginUpsert {
+	u.Set(gatewayplugin.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *GatewayPluginUpsert) UpdateName() *GatewayPluginUpsert {
+	u.SetExcluded(gatewayplugin.FieldName)
+	return u
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *GatewayPluginUpsert) SetEnabled(v bool) *GatewayPluginUpsert {
+	u.Set(gatewayplugin.FieldEnabled, v)
+	return u
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *GatewayPluginUpsert) UpdateEnabled() *GatewayPluginUpsert {
+	u.SetExcluded(gatewayplugin.FieldEnabled)
+	return u
+}
+
+// SetPriority sets the "priority" field.
+func (u *GatewayPluginUpsert) SetPriority(v int) *GatewayPluginUpsert {
+	u.Set(gatewayplugin.FieldPriority, v)
+	return u
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *GatewayPluginUpsert) UpdatePriority() *GatewayPluginUpsert {
+	u.SetExcluded(gatewayplugin.FieldPriority)
+	return u
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *GatewayPluginUpsert) AddPriority(v int) *GatewayPluginUpsert {
+	u.Add(gatewayplugin.FieldPriority, v)
+	return u
+}
+
+// SetGroupIds sets the "group_ids" field.
+func (u *GatewayPluginUpsert) SetGroupIds(v []int64) *GatewayPluginUpsert {
+	u.Set(gatewayplugin.FieldGroupIds, v)
+	return u
+}
+
+// UpdateGroupIds sets the "group_ids" field to the value that was provided on create.
+func (u *GatewayPluginUpsert) UpdateGroupIds() *GatewayPluginUpsert {
+	u.SetExcluded(gatewayplugin.FieldGroupIds)
+	return u
+}
+
+// ClearGroupIds clears the value of the "group_ids" field.
+func (u *GatewayPluginUpsert) ClearGroupIds() *GatewayPluginUpsert {
+	u.SetNull(gatewayplugin.FieldGroupIds)
+	return u
+}
+
+// SetFailureMode sets the "failure_mode" field.
+func (u *GatewayPluginUpsert) SetFailureMode(v string) *GatewayPluginUpsert {
+	u.Set(gatewayplugin.FieldFailureMode, v)
+	return u
+}
+
+// UpdateFailureMode sets the "failure_mode" field to the value that was provided on create.
+func (u *GatewayPluginUpsert) UpdateFailureMode() *GatewayPluginUpsert {
+	u.SetExcluded(gatewayplugin.FieldFailureMode)
+	return u
+}
+
+// SetTimeoutMs sets the "timeout_ms" field.
+func (u *GatewayPluginUpsert) SetTimeoutMs(v int) *GatewayPluginUpsert {
+	u.Set(gatewayplugin.FieldTimeoutMs, v)
+	return u
+}
+
+// UpdateTimeoutMs sets the "timeout_ms" field to the value that was provided on create.
+func (u *GatewayPluginUpsert) UpdateTimeoutMs() *GatewayPluginUpsert {
+	u.SetExcluded(gatewayplugin.FieldTimeoutMs)
+	return u
+}
+
+// AddTimeoutMs adds v to the "timeout_ms" field.
+func (u *GatewayPluginUpsert) AddTimeoutMs(v int) *GatewayPluginUpsert {
+	u.Add(gatewayplugin.FieldTimeoutMs, v)
+	return u
+}
+
+// SetMemoryLimitMB sets the "memory_limit_mb" field.
+func (u *GatewayPluginUpsert) SetMemoryLimitMB(v int) *GatewayPluginUpsert {
+	u.Set(gatewayplugin.FieldMemoryLimitMB, v)
+	return u
+}
+
+// UpdateMemoryLimitMB sets the "memory_limit_mb" field to the value that was provided on create.
+func (u *GatewayPluginUpsert) UpdateMemoryLimitMB() *GatewayPluginUpsert {
+	u.SetExcluded(gatewayplugin.FieldMemoryLimitMB)
+	return u
+}
+
+// AddMemoryLimitMB adds v to the "memory_limit_mb" field.
+func (u *GatewayPluginUpsert) AddMemoryLimitMB(v int) *GatewayPluginUpsert {
+	u.Add(gatewayplugin.FieldMemoryLimitMB, v)
+	return u
+}
+
+// SetHooks sets the "hooks" field.
+func (u *GatewayPluginUpsert) SetHooks(v []string) *GatewayPluginUpsert {
+	u.Set(gatewayplugin.FieldHooks, v)
+	return u
+}
+
+// UpdateHooks sets the "hooks" field to the value that was provided on create.
+func (u *GatewayPluginUpsert) UpdateHooks() *GatewayPluginUpsert {
+	u.SetExcluded(gatewayplugin.FieldHooks)
+	return u
+}
+
+// ClearHooks clears the value of the "hooks" field.
+func (u *GatewayPluginUpsert) ClearHooks() *GatewayPluginUpsert {
+	u.SetNull(gatewayplugin.FieldHooks)
+	return u
+}
+
+// SetModule sets the "module" field.
+func (u *GatewayPluginUpsert) SetModule(v []byte) *GatewayPluginUpsert {
+	u.Set(gatewayplugin.FieldModule, v)
+	return u
+}
+
+// UpdateModule sets the "module" field to the value that was provided on create.
+func (u *GatewayPluginUpsert) UpdateModule() *GatewayPluginUpsert {
+	u.SetExcluded(gatewayplugin.FieldModule)
+	return u
+}
+
+// SetModuleSha256 sets the "module_sha256" field.
+func (u *GatewayPluginUpsert) SetModuleSha256(v string) *GatewayPluginUpsert {
+	u.Set(gatewayplugin.FieldModuleSha256, v)
+	return u
+}
+
+// UpdateModuleSha256 sets the "module_sha256" field to the value that was provided on create.
+func (u *GatewayPluginUpsert) UpdateModuleSha256() *GatewayPluginUpsert {
+	u.SetExcluded(gatewayplugin.FieldModuleSha256)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *GatewayPluginUpsert) SetDescription(v string) *GatewayPluginUpsert {
+	u.Set(gatewayplugin.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *GatewayPluginUpsert) UpdateDescription() *GatewayPluginUpsert {
+	u.SetExcluded(gatewayplugin.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *GatewayPluginUpsert) ClearDescription() *GatewayPluginUpsert {
+	u.SetNull(gatewayplugin.FieldDescription)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.GatewayPlugin.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *GatewayPluginUpsertOne) UpdateNewValues() *GatewayPluginUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(gatewayplugin.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.GatewayPlugin.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *GatewayPluginUpsertOne) Ignore() *GatewayPluginUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *GatewayPluginUpsertOne) DoNothing() *GatewayPluginUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the GatewayPluginCreate.OnConflict
+// documentation for more info.
+func (u *GatewayPluginUpsertOne) Update(set func(*GatewayPluginUpsert)) *GatewayPluginUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&GatewayPluginUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *GatewayPluginUpsertOne) SetUpdatedAt(v time.Time) *GatewayPluginUpsertOne {
+	return u.Update(func(s *GatewayPluginUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *GatewayPluginUpsertOne) UpdateUpdatedAt() *GatewayPluginUpsertOne {
+	return u.Update(func(s *GatewayPluginUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *GatewayPluginUpsertOne) SetName(v string) *GatewayPluginUpsertOne {
+	return u.Update(func(s *GatewayPluginUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *GatewayPluginUpsertOne) UpdateName() *GatewayPluginUpsertOne {
+	return u.Update(func(s *GatewayPluginUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *GatewayPluginUpsertOne) SetEnabled(v bool) *GatewayPluginUpsertOne {
+	return u.Update(func(s *GatewayPluginUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *GatewayPluginUpsertOne) UpdateEnabled() *GatewayPluginUpsertOne {
+	return u.Update(func(s *GatewayPluginUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetPriority sets the "priority" field.
+func (u *GatewayPluginUpsertOne) SetPriority(v int) *GatewayPluginUpsertOne {
+	return u.Update(func(s *GatewayPluginUpsert) {
+		s.SetPriority(v)
+	})
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *GatewayPluginUpsertOne) AddPriority(v int) *GatewayPluginUpsertOne {
+	return u.Update(func(s *GatewayPluginUpsert) {
+		s.AddPriority(v)
+	})
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *GatewayPluginUpsertOne) UpdatePriority() *GatewayPluginUpsertOne {
+	return u.Update(func(s *GatewayPluginUpsert) {
+		s.UpdatePriority()
+	})
+}
+
+// SetGroupIds sets the "group_ids" field.
+func (u *GatewayPluginUpsertOne) SetGroupIds(v []int64) *GatewayPluginUpsertOne {
+	return u.Update(func(s *GatewayPluginUpsert) {
+		s.SetGroupIds(v)
+	})
+}
+
+// UpdateGroupIds sets the "group_ids" field to the value that was provided on create.
+func (u *GatewayPluginUpsertOne) UpdateGroupIds() *GatewayPluginUpsertOne {
+	return u.Update(func(s *GatewayPluginUpsert) {
+		s.UpdateGroupIds()
+	})
+}
+
+// ClearGroupIds clears the value of the "group_ids" field.
+func (u *GatewayPluginUpsertOne) ClearGroupIds() *GatewayPluginUpsertOne {
+	return u.Update(func(s *GatewayPluginUpsert) {
+		s.ClearGroupIds()
+	})
+}
+
+// SetFailureMode sets the "failure_mode" field.
+func (u *GatewayPluginUpsertOne) SetFailureMode(v string) *GatewayPluginUpsertOne {
+	return u.Update(func(s *GatewayPluginUpsert) {
+		s.SetFailureMode(v)
+	})
+}
+
+// UpdateFailureMode sets the "failure_mode" field to the value that was provided on create.
+func (u *GatewayPluginUpsertOne) UpdateFailureMode() *GatewayPluginUpsertOne {
+	return u.Update(func(s *GatewayPluginUpsert) {
+		s.UpdateFailureMode()
+	})
+}
+
+// SetTimeoutMs sets the "timeout_ms" field.
+func (u *GatewayPluginUpsertOne) SetTimeoutMs(v int) *GatewayPluginUpsertOne {
+	return u.Update(func(s *GatewayPluginUpsert) {
+		s.SetTimeoutMs(v)
+	})
+}
+
+// AddTimeoutMs adds v to the "timeout_ms" field.
+func (u *GatewayPluginUpsertOne) AddTimeoutMs(v int) *GatewayPluginUpsertOne {
+	return u.Update(func(s *GatewayPluginUpsert) {
+		s.AddTimeoutMs(v)
+	})
+}
+
+// UpdateTimeoutMs sets the "timeout_ms" field to the value that was provided on create.
+func (u *GatewayPluginUpsertOne) UpdateTimeoutMs() *GatewayPluginUpsertOne {
+	return u.Update(func(s *GatewayPluginUpsert) {
+		s.UpdateTimeoutMs()
+	})
+}
+
+// SetMemoryLimitMB sets the "memory_limit_mb" field.
+func (u *GatewayPluginUpsertOne) SetMemoryLimitMB(v int) *GatewayPluginUpsertOne {
+	return u.Update(func(s *GatewayPluginUpsert) {
+		s.SetMemoryLimitMB(v)
+	})
+}
+
+// AddMemoryLimitMB adds v to the "memory_limit_mb" field.
+func (u *GatewayPluginUpsertOne) AddMemoryLimitMB(v int) *GatewayPluginUpsertOne {
+	return u.Update(func(s *GatewayPluginUpsert) {
+		s.AddMemoryLimitMB(v)
+	})
+}
+
+// UpdateMemoryLimitMB sets the "memory_limit_mb" field to the value that was provided on create.
+func (u *GatewayPluginUpsertOne) UpdateMemoryLimitMB() *GatewayPluginUpsertOne {
+	return u.Update(func(s *GatewayPluginUpsert) {
+		s.UpdateMemoryLimitMB()
+	})
+}
+
+// SetHooks sets the "hooks" field.
+func (u *GatewayPluginUpsertOne) SetHooks(v []string) *GatewayPluginUpsertOne {
+	return u.Update(func(s *GatewayPluginUpsert) {
+		s.SetHooks(v)
+	})
+}
+
+// UpdateHooks sets the "hooks" field to the value that was provided on create.
+func (u *GatewayPluginUpsertOne) UpdateHooks() *GatewayPluginUpsertOne {
+	return u.Update(func(s *GatewayPluginUpsert) {
+		s.UpdateHooks()
+	})
+}
+
+// ClearHooks clears the value of the "hooks" field.
+func (u *GatewayPluginUpsertOne) ClearHooks() *GatewayPluginUpsertOne {
+	return u.Update(func(s *GatewayPluginUpsert) {
+		s.ClearHooks()
+	})
+}
+
+// SetModule sets the "module" field.
+func (u *GatewayPluginUpsertOne) SetModule(v []byte) *GatewayPluginUpsertOne {
+	return u.Update(func(s *GatewayPluginUpsert) {
+		s.SetModule(v)
+	})
+}
+
+// UpdateModule sets the "module" field to the value that was provided on create.
+func (u *GatewayPluginUpsertOne) UpdateModule() *GatewayPluginUpsertOne {
+	return u.Update(func(s *GatewayPluginUpsert) {
+		s.UpdateModule()
+	})
+}
+
+// SetModuleSha256 sets the "module_sha256" field.
+func (u *GatewayPluginUpsertOne) SetModuleSha256(v string) *GatewayPluginUpsertOne {
+	return u.Update(func(s *GatewayPluginUpsert) {
+		s.SetModuleSha256(v)
+	})
+}
+
+// UpdateModuleSha256 sets the "module_sha256" field to the value that was provided on create.
+func (u *GatewayPluginUpsertOne) UpdateModuleSha256() *GatewayPluginUpsertOne {
+	return u.Update(func(s *GatewayPluginUpsert) {
+		s.UpdateModuleSha256()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *GatewayPluginUpsertOne) SetDescription(v string) *GatewayPluginUpsertOne {
+	return u.Update(func(s *GatewayPluginUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *GatewayPluginUpsertOne) UpdateDescription() *GatewayPluginUpsertOne {
+	return u.Update(func(s *GatewayPluginUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *GatewayPluginUpsertOne) ClearDescription() *GatewayPluginUpsertOne {
+	return u.Update(func(s *GatewayPluginUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// Exec executes the query.
+func (u *GatewayPluginUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for GatewayPluginCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *GatewayPluginUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *GatewayPluginUpsertOne) ID(ctx context.Context) (id int64, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *GatewayPluginUpsertOne) IDX(ctx context.Context) int64 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// GatewayPluginCreateBulk is the builder for creating many GatewayPlugin entities in bulk.
+type GatewayPluginCreateBulk struct {
+	config
+	err      error
+	builders []*GatewayPluginCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the GatewayPlugin entities in the database.
+func (_c *GatewayPluginCreateBulk) Save(ctx context.Context) ([]*GatewayPlugin, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*GatewayPlugin, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*GatewayPluginMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *GatewayPluginCreateBulk) SaveX(ctx context.Context) []*GatewayPlugin {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *GatewayPluginCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *GatewayPluginCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.GatewayPlugin.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.GatewayPluginUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *GatewayPluginCreateBulk) OnConflict(opts ...sql.ConflictOption) *GatewayPluginUpsertBulk {
+	_c.conflict = opts
+	return &GatewayPluginUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.GatewayPlugin.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *GatewayPluginCreateBulk) OnConflictColumns(columns ...string) *GatewayPluginUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &GatewayPluginUpsertBulk{
+		create: _c,
+	}
+}
+
+// GatewayPluginUpsertBulk is the builder for "upsert"-ing
+// a bulk of GatewayPlugin nodes.
+type GatewayPluginUpsertBulk struct {
+	create *GatewayPluginCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.GatewayPlugin.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *GatewayPluginUpsertBulk) UpdateNewValues() *GatewayPluginUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(gatewayplugin.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.GatewayPlugin.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *GatewayPluginUpsertBulk) Ignore() *GatewayPluginUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *GatewayPluginUpsertBulk) DoNothing() *GatewayPluginUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the GatewayPluginCreateBulk.OnConflict
+// documentation for more info.
+func (u *GatewayPluginUpsertBulk) Update(set func(*GatewayPluginUpsert)) *GatewayPluginUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&GatewayPluginUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *GatewayPluginUpsertBulk) SetUpdatedAt(v time.Time) *GatewayPluginUpsertBulk {
+	return u.Update(func(s *GatewayPluginUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *GatewayPluginUpsertBulk) UpdateUpdatedAt() *GatewayPluginUpsertBulk {
+	return u.Update(func(s *GatewayPluginUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *GatewayPluginUpsertBulk) SetName(v string) *GatewayPluginUpsertBulk {
+	return u.Update(func(s *GatewayPluginUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *GatewayPluginUpsertBulk) UpdateName() *GatewayPluginUpsertBulk {
+	return u.Update(func(s *GatewayPluginUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *GatewayPluginUpsertBulk) SetEnabled(v bool) *GatewayPluginUpsertBulk {
+	return u.Update(func(s *GatewayPluginUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *GatewayPluginUpsertBulk) UpdateEnabled() *GatewayPluginUpsertBulk {
+	return u.Update(func(s *GatewayPluginUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetPriority sets the "priority" field.
+func (u *GatewayPluginUpsertBulk) SetPriority(v int) *GatewayPluginUpsertBulk {
+	return u.Update(func(s *GatewayPluginUpsert) {
+		s.SetPriority(v)
+	})
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *GatewayPluginUpsertBulk) AddPriority(v int) *GatewayPluginUpsertBulk {
+	return u.Update(func(s *GatewayPluginUpsert) {
+		s.AddPriority(v)
+	})
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *GatewayPluginUpsertBulk) UpdatePriority() *GatewayPluginUpsertBulk {
+	return u.Update(func(s *GatewayPluginUpsert) {
+		s.UpdatePriority()
+	})
+}
+
+// SetGroupIds sets the "group_ids" field.
+func (u *GatewayPluginUpsertBulk) SetGroupIds(v []int64) *GatewayPluginUpsertBulk {
+	return u.Update(func(s *GatewayPluginUpsert) {
+		s.SetGroupIds(v)
+	})
+}
+
+// UpdateGroupIds sets the "group_ids" field to the value that was provided on create.
+func (u *GatewayPluginUpsertBulk) UpdateGroupIds() *GatewayPluginUpsertBulk {
+	return u.Update(func(s *GatewayPluginUpsert) {
+		s.UpdateGroupIds()
+	})
+}
+
+// ClearGroupIds clears the value of the "group_ids" field.
+func (u *GatewayPluginUpsertBulk) ClearGroupIds() *GatewayPluginUpsertBulk {
+	return u.Update(func(s *GatewayPluginUpsert) {
+		s.ClearGroupIds()
+	})
+}
+
+// SetFailureMode sets the "failure_mode" field.
+func (u *GatewayPluginUpsertBulk) SetFailureMode(v string) *GatewayPluginUpsertBulk {
+	return u.Update(func(s *GatewayPluginUpsert) {
+		s.SetFailureMode(v)
+	})
+}
+
+// UpdateFailureMode sets the "failure_mode" field to the value that was provided on create.
+func (u *GatewayPluginUpsertBulk) UpdateFailureMode() *GatewayPluginUpsertBulk {
+	return u.Update(func(s *GatewayPluginUpsert) {
+		s.UpdateFailureMode()
+	})
+}
+
+// SetTimeoutMs sets the "timeout_ms" field.
+func (u *GatewayPluginUpsertBulk) SetTimeoutMs(v int) *GatewayPluginUpsertBulk {
+	return u.Update(func(s *GatewayPluginUpsert) {
+		s.SetTimeoutMs(v)
+	})
+}
+
+// AddTimeoutMs adds v to the "timeout_ms" field.
+func (u *GatewayPluginUpsertBulk) AddTimeoutMs(v int) *GatewayPluginUpsertBulk {
+	return u.Update(func(s *GatewayPluginUpsert) {
+		s.AddTimeoutMs(v)
+	})
+}
+
+// UpdateTimeoutMs sets the "timeout_ms" field to the value that was provided on create.
+func (u *GatewayPluginUpsertBulk) UpdateTimeoutMs() *GatewayPluginUpsertBulk {
+	return u.Update(func(s *GatewayPluginUpsert) {
+		s.UpdateTimeoutMs()
+	})
+}
+
+// SetMemoryLimitMB sets the "memory_limit_mb" field.
+func (u *GatewayPluginUpsertBulk) SetMemoryLimitMB(v int) *GatewayPluginUpsertBulk {
+	return u.Update(func(s *GatewayPluginUpsert) {
+		s.SetMemoryLimitMB(v)
+	})
+}
+
+// AddMemoryLimitMB adds v to the "memory_limit_mb" field.
+func (u *GatewayPluginUpsertBulk) AddMemoryLimitMB(v int) *GatewayPluginUpsertBulk {
+	return u.Update(func(s *GatewayPluginUpsert) {
+		s.AddMemoryLimitMB(v)
+	})
+}
+
+// UpdateMemoryLimitMB sets the "memory_limit_mb" field to the value that was provided on create.
+func (u *GatewayPluginUpsertBulk) UpdateMemoryLimitMB() *GatewayPluginUpsertBulk {
+	return u.Update(func(s *GatewayPluginUpsert) {
+		s.UpdateMemoryLimitMB()
+	})
+}
+
+// SetHooks sets the "hooks" field.
+func (u *GatewayPluginUpsertBulk) SetHooks(v []string) *GatewayPluginUpsertBulk {
+	return u.Update(func(s *GatewayPluginUpsert) {
+		s.SetHooks(v)
+	})
+}
+
+// UpdateHooks sets the "hooks" field to the value that was provided on create.
+func (u *GatewayPluginUpsertBulk) UpdateHooks() *GatewayPluginUpsertBulk {
+	return u.Update(func(s *GatewayPluginUpsert) {
+		s.UpdateHooks()
+	})
+}
+
+// ClearHooks clears the value of the "hooks" field.
+func (u *GatewayPluginUpsertBulk) ClearHooks() *GatewayPluginUpsertBulk {
+	return u.Update(func(s *GatewayPluginUpsert) {
+		s.ClearHooks()
+	})
+}
+
+// SetModule sets the "module" field.
+func (u *GatewayPluginUpsertBulk) SetModule(v []byte) *GatewayPluginUpsertBulk {
+	return u.Update(func(s *GatewayPluginUpsert) {
+		s.SetModule(v)
+	})
+}
+
+// UpdateModule sets the "module" field to the value that was provided on create.
+func (u *GatewayPluginUpsertBulk) UpdateModule() *GatewayPluginUpsertBulk {
+	return u.Update(func(s *GatewayPluginUpsert) {
+		s.UpdateModule()
+	})
+}
+
+// SetModuleSha256 sets the "module_sha256" field.
+func (u *GatewayPluginUpsertBulk) SetModuleSha256(v string) *GatewayPluginUpsertBulk {
+	return u.Update(func(s *GatewayPluginUpsert) {
+		s.SetModuleSha256(v)
+	})
+}
+
+// UpdateModuleSha256 sets the "module_sha256" field to the value that was provided on create.
+func (u *GatewayPluginUpsertBulk) UpdateModuleSha256() *GatewayPluginUpsertBulk {
+	return u.Update(func(s *GatewayPluginUpsert) {
+		s.UpdateModuleSha256()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *GatewayPluginUpsertBulk) SetDescription(v string) *GatewayPluginUpsertBulk {
+	return u.Update(func(s *GatewayPluginUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *GatewayPluginUpsertBulk) UpdateDescription() *GatewayPluginUpsertBulk {
+	return u.Update(func(s *GatewayPluginUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *GatewayPluginUpsertBulk) ClearDescription() *GatewayPluginUpsertBulk {
+	return u.Update(func(s *GatewayPluginUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// Exec executes the query.
+func (u *GatewayPluginUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the GatewayPluginCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for GatewayPluginCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *GatewayPluginUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
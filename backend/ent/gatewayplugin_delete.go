@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Wei-Shaw/sub2api/ent/gatewayplugin"
+	"github.com/Wei-Shaw/sub2api/ent/predicate"
+)
+
+// GatewayPluginDelete is the builder for deleting a GatewayPlugin entity.
+type GatewayPluginDelete struct {
+	config
+	hooks    []Hook
+	mutation *GatewayPluginMutation
+}
+
+// Where appends a list predicates to the GatewayPluginDelete builder.
+func (_d *GatewayPluginDelete) Where(ps ...predicate.GatewayPlugin) *GatewayPluginDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *GatewayPluginDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *GatewayPluginDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *GatewayPluginDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(gatewayplugin.Table, sqlgraph.NewFieldSpec(gatewayplugin.FieldID, field.TypeInt64))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// GatewayPluginDeleteOne is the builder for deleting a single GatewayPlugin entity.
+type GatewayPluginDeleteOne struct {
+	_d *GatewayPluginDelete
+}
+
+// Where appends a list predicates to the GatewayPluginDelete builder.
+func (_d *GatewayPluginDeleteOne) Where(ps ...predicate.GatewayPlugin) *GatewayPluginDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *GatewayPluginDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{gatewayplugin.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *GatewayPluginDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,743 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/Wei-Shaw/sub2api/ent/gatewayplugin"
+	"github.com/Wei-Shaw/sub2api/ent/predicate"
+)
+
+// GatewayPluginUpdate is the builder for updating GatewayPlugin entities.
+type GatewayPluginUpdate struct {
+	config
+	hooks    []Hook
+	mutation *GatewayPluginMutation
+}
+
+// Where appends a list predicates to the GatewayPluginUpdate builder.
+func (_u *GatewayPluginUpdate) Where(ps ...predicate.GatewayPlugin) *GatewayPluginUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *GatewayPluginUpdate) SetUpdatedAt(v time.Time) *GatewayPluginUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *GatewayPluginUpdate) SetName(v string) *GatewayPluginUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *GatewayPluginUpdate) SetNillableName(v *string) *GatewayPluginUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *GatewayPluginUpdate) SetEnabled(v bool) *GatewayPluginUpdate {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *GatewayPluginUpdate) SetNillableEnabled(v *bool) *GatewayPluginUpdate {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetPriority sets the "priority" field.
+func (_u *GatewayPluginUpdate) SetPriority(v int) *GatewayPluginUpdate {
+	_u.mutation.ResetPriority()
+	_u.mutation.SetPriority(v)
+	return _u
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_u *GatewayPluginUpdate) SetNillablePriority(v *int) *GatewayPluginUpdate {
+	if v != nil {
+		_u.SetPriority(*v)
+	}
+	return _u
+}
+
+// AddPriority adds value to the "priority" field.
+func (_u *GatewayPluginUpdate) AddPriority(v int) *GatewayPluginUpdate {
+	_u.mutation.AddPriority(v)
+	return _u
+}
+
+// SetGroupIds sets the "group_ids" field.
+func (_u *GatewayPluginUpdate) SetGroupIds(v []int64) *GatewayPluginUpdate {
+	_u.mutation.SetGroupIds(v)
+	return _u
+}
+
+// AppendGroupIds appends value to the "group_ids" field.
+func (_u *GatewayPluginUpdate) AppendGroupIds(v []int64) *GatewayPluginUpdate {
+	_u.mutation.AppendGroupIds(v)
+	return _u
+}
+
+// ClearGroupIds clears the value of the "group_ids" field.
+func (_u *GatewayPluginUpdate) ClearGroupIds() *GatewayPluginUpdate {
+	_u.mutation.ClearGroupIds()
+	return _u
+}
+
+// SetFailureMode sets the "failure_mode" field.
+func (_u *GatewayPluginUpdate) SetFailureMode(v string) *GatewayPluginUpdate {
+	_u.mutation.SetFailureMode(v)
+	return _u
+}
+
+// SetNillableFailureMode sets the "failure_mode" field if the given value is not nil.
+func (_u *GatewayPluginUpdate) SetNillableFailureMode(v *string) *GatewayPluginUpdate {
+	if v != nil {
+		_u.SetFailureMode(*v)
+	}
+	return _u
+}
+
+// SetTimeoutMs sets the "timeout_ms" field.
+func (_u *GatewayPluginUpdate) SetTimeoutMs(v int) *GatewayPluginUpdate {
+	_u.mutation.ResetTimeoutMs()
+	_u.mutation.SetTimeoutMs(v)
+	return _u
+}
+
+// SetNillableTimeoutMs sets the "timeout_ms" field if the given value is not nil.
+func (_u *GatewayPluginUpdate) SetNillableTimeoutMs(v *int) *GatewayPluginUpdate {
+	if v != nil {
+		_u.SetTimeoutMs(*v)
+	}
+	return _u
+}
+
+// AddTimeoutMs adds value to the "timeout_ms" field.
+func (_u *GatewayPluginUpdate) AddTimeoutMs(v int) *GatewayPluginUpdate {
+	_u.mutation.AddTimeoutMs(v)
+	return _u
+}
+
+// SetMemoryLimitMB sets the "memory_limit_mb" field.
+func (_u *GatewayPluginUpdate) SetMemoryLimitMB(v int) *GatewayPluginUpdate {
+	_u.mutation.ResetMemoryLimitMB()
+	_u.mutation.SetMemoryLimitMB(v)
+	return _u
+}
+
+// SetNillableMemoryLimitMB sets the "memory_limit_mb" field if the given value is not nil.
+func (_u *GatewayPluginUpdate) SetNillableMemoryLimitMB(v *int) *GatewayPluginUpdate {
+	if v != nil {
+		_u.SetMemoryLimitMB(*v)
+	}
+	return _u
+}
+
+// AddMemoryLimitMB adds value to the "memory_limit_mb" field.
+func (_u *GatewayPluginUpdate) AddMemoryLimitMB(v int) *GatewayPluginUpdate {
+	_u.mutation.AddMemoryLimitMB(v)
+	return _u
+}
+
+// SetHooks sets the "hooks" field.
+func (_u *GatewayPluginUpdate) SetHooks(v []string) *GatewayPluginUpdate {
+	_u.mutation.SetHooks(v)
+	return _u
+}
+
+// AppendHooks appends value to the "hooks" field.
+func (_u *GatewayPluginUpdate) AppendHooks(v []string) *GatewayPluginUpdate {
+	_u.mutation.AppendHooks(v)
+	return _u
+}
+
+// ClearHooks clears the value of the "hooks" field.
+func (_u *GatewayPluginUpdate) ClearHooks() *GatewayPluginUpdate {
+	_u.mutation.ClearHooks()
+	return _u
+}
+
+// SetModule sets the "module" field.
+func (_u *GatewayPluginUpdate) SetModule(v []byte) *GatewayPluginUpdate {
+	_u.mutation.SetModule(v)
+	return _u
+}
+
+// SetModuleSha256 sets the "module_sha256" field.
+func (_u *GatewayPluginUpdate) SetModuleSha256(v string) *GatewayPluginUpdate {
+	_u.mutation.SetModuleSha256(v)
+	return _u
+}
+
+// SetNillableModuleSha256 sets the "module_sha256" field if the given value is not nil.
+func (_u *GatewayPluginUpdate) SetNillableModuleSha256(v *string) *GatewayPluginUpdate {
+	if v != nil {
+		_u.SetModuleSha256(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *GatewayPluginUpdate) SetDescription(v string) *GatewayPluginUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *GatewayPluginUpdate) SetNillableDescription(v *string) *GatewayPluginUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *GatewayPluginUpdate) ClearDescription() *GatewayPluginUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// Mutation returns the GatewayPluginMutation object of the builder.
+func (_u *GatewayPluginUpdate) Mutation() *GatewayPluginMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *GatewayPluginUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *GatewayPluginUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *GatewayPluginUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *GatewayPluginUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *GatewayPluginUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := gatewayplugin.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *GatewayPluginUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := gatewayplugin.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "GatewayPlugin.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.FailureMode(); ok {
+		if err := gatewayplugin.FailureModeValidator(v); err != nil {
+			return &ValidationError{Name: "failure_mode", err: fmt.Errorf(`ent: validator failed for field "GatewayPlugin.failure_mode": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ModuleSha256(); ok {
+		if err := gatewayplugin.ModuleSha256Validator(v); err != nil {
+			return &ValidationError{Name: "module_sha256", err: fmt.Errorf(`ent: validator failed for field "GatewayPlugin.module_sha256": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *GatewayPluginUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(gatewayplugin.Table, gatewayplugin.Columns, sqlgraph.NewFieldSpec(gatewayplugin.FieldID, field.TypeInt64))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(gatewayplugin.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(gatewayplugin.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(gatewayplugin.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Priority(); ok {
+		_spec.SetField(gatewayplugin.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPriority(); ok {
+		_spec.AddField(gatewayplugin.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.GroupIds(); ok {
+		_spec.SetField(gatewayplugin.FieldGroupIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedGroupIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, gatewayplugin.FieldGroupIds, value)
+		})
+	}
+	if _u.mutation.GroupIdsCleared() {
+		_spec.ClearField(gatewayplugin.FieldGroupIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.FailureMode(); ok {
+		_spec.SetField(gatewayplugin.FieldFailureMode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TimeoutMs(); ok {
+		_spec.SetField(gatewayplugin.FieldTimeoutMs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTimeoutMs(); ok {
+		_spec.AddField(gatewayplugin.FieldTimeoutMs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MemoryLimitMB(); ok {
+		_spec.SetField(gatewayplugin.FieldMemoryLimitMB, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMemoryLimitMB(); ok {
+		_spec.AddField(gatewayplugin.FieldMemoryLimitMB, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Hooks(); ok {
+		_spec.SetField(gatewayplugin.FieldHooks, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedHooks(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, gatewayplugin.FieldHooks, value)
+		})
+	}
+	if _u.mutation.HooksCleared() {
+		_spec.ClearField(gatewayplugin.FieldHooks, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Module(); ok {
+		_spec.SetField(gatewayplugin.FieldModule, field.TypeBytes, value)
+	}
+	if value, ok := _u.mutation.ModuleSha256(); ok {
+		_spec.SetField(gatewayplugin.FieldModuleSha256, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(gatewayplugin.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(gatewayplugin.FieldDescription, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{gatewayplugin.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// GatewayPluginUpdateOne is the builder for updating a single GatewayPlugin entity.
+type GatewayPluginUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *GatewayPluginMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *GatewayPluginUpdateOne) SetUpdatedAt(v time.Time) *GatewayPluginUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *GatewayPluginUpdateOne) SetName(v string) *GatewayPluginUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *GatewayPluginUpdateOne) SetNillableName(v *string) *GatewayPluginUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *GatewayPluginUpdateOne) SetEnabled(v bool) *GatewayPluginUpdateOne {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *GatewayPluginUpdateOne) SetNillableEnabled(v *bool) *GatewayPluginUpdateOne {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetPriority sets the "priority" field.
+func (_u *GatewayPluginUpdateOne) SetPriority(v int) *GatewayPluginUpdateOne {
+	_u.mutation.ResetPriority()
+	_u.mutation.SetPriority(v)
+	return _u
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_u *GatewayPluginUpdateOne) SetNillablePriority(v *int) *GatewayPluginUpdateOne {
+	if v != nil {
+		_u.SetPriority(*v)
+	}
+	return _u
+}
+
+// AddPriority adds value to the "priority" field.
+func (_u *GatewayPluginUpdateOne) AddPriority(v int) *GatewayPluginUpdateOne {
+	_u.mutation.AddPriority(v)
+	return _u
+}
+
+// SetGroupIds sets the "group_ids" field.
+func (_u *GatewayPluginUpdateOne) SetGroupIds(v []int64) *GatewayPluginUpdateOne {
+	_u.mutation.SetGroupIds(v)
+	return _u
+}
+
+// AppendGroupIds appends value to the "group_ids" field.
+func (_u *GatewayPluginUpdateOne) AppendGroupIds(v []int64) *GatewayPluginUpdateOne {
+	_u.mutation.AppendGroupIds(v)
+	return _u
+}
+
+// ClearGroupIds clears the value of the "group_ids" field.
+func (_u *GatewayPluginUpdateOne) ClearGroupIds() *GatewayPluginUpdateOne {
+	_u.mutation.ClearGroupIds()
+	return _u
+}
+
+// SetFailureMode sets the "failure_mode" field.
+func (_u *GatewayPluginUpdateOne) SetFailureMode(v string) *GatewayPluginUpdateOne {
+	_u.mutation.SetFailureMode(v)
+	return _u
+}
+
+// SetNillableFailureMode sets the "failure_mode" field if the given value is not nil.
+func (_u *GatewayPluginUpdateOne) SetNillableFailureMode(v *string) *GatewayPluginUpdateOne {
+	if v != nil {
+		_u.SetFailureMode(*v)
+	}
+	return _u
+}
+
+// SetTimeoutMs sets the "timeout_ms" field.
+func (_u *GatewayPluginUpdateOne) SetTimeoutMs(v int) *GatewayPluginUpdateOne {
+	_u.mutation.ResetTimeoutMs()
+	_u.mutation.SetTimeoutMs(v)
+	return _u
+}
+
+// SetNillableTimeoutMs sets the "timeout_ms" field if the given value is not nil.
+func (_u *GatewayPluginUpdateOne) SetNillableTimeoutMs(v *int) *GatewayPluginUpdateOne {
+	if v != nil {
+		_u.SetTimeoutMs(*v)
+	}
+	return _u
+}
+
+// AddTimeoutMs adds value to the "timeout_ms" field.
+func (_u *GatewayPluginUpdateOne) AddTimeoutMs(v int) *GatewayPluginUpdateOne {
+	_u.mutation.AddTimeoutMs(v)
+	return _u
+}
+
+// SetMemoryLimitMB sets the "memory_limit_mb" field.
+func (_u *GatewayPluginUpdateOne) SetMemoryLimitMB(v int) *GatewayPluginUpdateOne {
+	_u.mutation.ResetMemoryLimitMB()
+	_u.mutation.SetMemoryLimitMB(v)
+	return _u
+}
+
+// SetNillableMemoryLimitMB sets the "memory_limit_mb" field if the given value is not nil.
+func (_u *GatewayPluginUpdateOne) SetNillableMemoryLimitMB(v *int) *GatewayPluginUpdateOne {
+	if v != nil {
+		_u.SetMemoryLimitMB(*v)
+	}
+	return _u
+}
+
+// AddMemoryLimitMB adds value to the "memory_limit_mb" field.
+func (_u *GatewayPluginUpdateOne) AddMemoryLimitMB(v int) *GatewayPluginUpdateOne {
+	_u.mutation.AddMemoryLimitMB(v)
+	return _u
+}
+
+// SetHooks sets the "hooks" field.
+func (_u *GatewayPluginUpdateOne) SetHooks(v []string) *GatewayPluginUpdateOne {
+	_u.mutation.SetHooks(v)
+	return _u
+}
+
+// AppendHooks appends value to the "hooks" field.
+func (_u *GatewayPluginUpdateOne) AppendHooks(v []string) *GatewayPluginUpdateOne {
+	_u.mutation.AppendHooks(v)
+	return _u
+}
+
+// ClearHooks clears the value of the "hooks" field.
+func (_u *GatewayPluginUpdateOne) ClearHooks() *GatewayPluginUpdateOne {
+	_u.mutation.ClearHooks()
+	return _u
+}
+
+// SetModule sets the "module" field.
+func (_u *GatewayPluginUpdateOne) SetModule(v []byte) *GatewayPluginUpdateOne {
+	_u.mutation.SetModule(v)
+	return _u
+}
+
+// SetModuleSha256 sets the "module_sha256" field.
+func (_u *GatewayPluginUpdateOne) SetModuleSha256(v string) *GatewayPluginUpdateOne {
+	_u.mutation.SetModuleSha256(v)
+	return _u
+}
+
+// SetNillableModuleSha256 sets the "module_sha256" field if the given value is not nil.
+func (_u *GatewayPluginUpdateOne) SetNillableModuleSha256(v *string) *GatewayPluginUpdateOne {
+	if v != nil {
+		_u.SetModuleSha256(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *GatewayPluginUpdateOne) SetDescription(v string) *GatewayPluginUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *GatewayPluginUpdateOne) SetNillableDescription(v *string) *GatewayPluginUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *GatewayPluginUpdateOne) ClearDescription() *GatewayPluginUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// Mutation returns the GatewayPluginMutation object of the builder.
+func (_u *GatewayPluginUpdateOne) Mutation() *GatewayPluginMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the GatewayPluginUpdate builder.
+func (_u *GatewayPluginUpdateOne) Where(ps ...predicate.GatewayPlugin) *GatewayPluginUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *GatewayPluginUpdateOne) Select(field string, fields ...string) *GatewayPluginUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated GatewayPlugin entity.
+func (_u *GatewayPluginUpdateOne) Save(ctx context.Context) (*GatewayPlugin, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *GatewayPluginUpdateOne) SaveX(ctx context.Context) *GatewayPlugin {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *GatewayPluginUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *GatewayPluginUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *GatewayPluginUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := gatewayplugin.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *GatewayPluginUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := gatewayplugin.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "GatewayPlugin.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.FailureMode(); ok {
+		if err := gatewayplugin.FailureModeValidator(v); err != nil {
+			return &ValidationError{Name: "failure_mode", err: fmt.Errorf(`ent: validator failed for field "GatewayPlugin.failure_mode": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ModuleSha256(); ok {
+		if err := gatewayplugin.ModuleSha256Validator(v); err != nil {
+			return &ValidationError{Name: "module_sha256", err: fmt.Errorf(`ent: validator failed for field "GatewayPlugin.module_sha256": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *GatewayPluginUpdateOne) sqlSave(ctx context.Context) (_node *GatewayPlugin, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(gatewayplugin.Table, gatewayplugin.Columns, sqlgraph.NewFieldSpec(gatewayplugin.FieldID, field.TypeInt64))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "GatewayPlugin.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, gatewayplugin.FieldID)
+		for _, f := range fields {
+			if !gatewayplugin.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != gatewayplugin.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(gatewayplugin.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(gatewayplugin.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(gatewayplugin.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Priority(); ok {
+		_spec.SetField(gatewayplugin.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPriority(); ok {
+		_spec.AddField(gatewayplugin.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.GroupIds(); ok {
+		_spec.SetField(gatewayplugin.FieldGroupIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedGroupIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, gatewayplugin.FieldGroupIds, value)
+		})
+	}
+	if _u.mutation.GroupIdsCleared() {
+		_spec.ClearField(gatewayplugin.FieldGroupIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.FailureMode(); ok {
+		_spec.SetField(gatewayplugin.FieldFailureMode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TimeoutMs(); ok {
+		_spec.SetField(gatewayplugin.FieldTimeoutMs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTimeoutMs(); ok {
+		_spec.AddField(gatewayplugin.FieldTimeoutMs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MemoryLimitMB(); ok {
+		_spec.SetField(gatewayplugin.FieldMemoryLimitMB, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMemoryLimitMB(); ok {
+		_spec.AddField(gatewayplugin.FieldMemoryLimitMB, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Hooks(); ok {
+		_spec.SetField(gatewayplugin.FieldHooks, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedHooks(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, gatewayplugin.FieldHooks, value)
+		})
+	}
+	if _u.mutation.HooksCleared() {
+		_spec.ClearField(gatewayplugin.FieldHooks, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Module(); ok {
+		_spec.SetField(gatewayplugin.FieldModule, field.TypeBytes, value)
+	}
+	if value, ok := _u.mutation.ModuleSha256(); ok {
+		_spec.SetField(gatewayplugin.FieldModuleSha256, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(gatewayplugin.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(gatewayplugin.FieldDescription, field.TypeString)
+	}
+	_node = &GatewayPlugin{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{gatewayplugin.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/Wei-Shaw/sub2api/ent/group"
+	"github.com/Wei-Shaw/sub2api/internal/model"
 )
 
 // Group is the model entity for the Group schema.
@@ -88,6 +89,24 @@ type Group struct {
 	DefaultMappedModel string `json:"default_mapped_model,omitempty"`
 	// 启用后，stream=false 的 JSON 响应统一返回 application/json Content-Type
 	ForceApplicationJSONForNonStream bool `json:"force_application_json_for_non_stream,omitempty"`
+	// 账号调度策略：priority_lru/weighted_round_robin/least_outstanding/ewma/p2c，为空使用默认策略
+	SchedulingStrategy string `json:"scheduling_strategy,omitempty"`
+	// 流式请求对冲：首字节超时后在第二个账号上并发发起同一请求，取先响应者
+	HedgeEnabled bool `json:"hedge_enabled,omitempty"`
+	// 对冲触发阈值（毫秒），0 表示使用所选账号的 P90 首字延迟
+	HedgeDelayMs int `json:"hedge_delay_ms,omitempty"`
+	// 模型流量拆分规则：按会话哈希分配目标模型/账号，可选影子流量
+	TrafficSplitRules []model.TrafficSplitRule `json:"traffic_split_rules,omitempty"`
+	// 模型降级链：过载或无可调度账号时按顺序改用降级模型重试
+	ModelFallbackChains []model.ModelFallbackChain `json:"model_fallback_chains,omitempty"`
+	// 用量阶梯定价：按用户当月在该分组的累计消费叠加折扣倍数
+	VolumeTiers []model.VolumeTier `json:"volume_tiers,omitempty"`
+	// 可用时段 cron 表达式：命中的分钟内分组才可调度，为空表示全天可用
+	AvailabilitySchedule *string `json:"availability_schedule,omitempty"`
+	// 维护模式：开启后网关直接返回维护提示
+	MaintenanceMode bool `json:"maintenance_mode,omitempty"`
+	// 维护提示信息，为空时使用默认提示
+	MaintenanceMessage *string `json:"maintenance_message,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the GroupQuery when eager-loading is set.
 	Edges        GroupEdges `json:"edges"`
@@ -194,15 +213,15 @@ func (*Group) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case group.FieldModelRouting, group.FieldSupportedModelScopes:
+		case group.FieldModelRouting, group.FieldSupportedModelScopes, group.FieldTrafficSplitRules, group.FieldModelFallbackChains, group.FieldVolumeTiers:
 			values[i] = new([]byte)
-		case group.FieldIsExclusive, group.FieldClaudeCodeOnly, group.FieldClaudePromptCachingEnabled, group.FieldClaudeUnrequested1hCacheAs5m, group.FieldThinkingSignatureCompatEnabled, group.FieldClaudeToolUseRepairEnabled, group.FieldClaudeToolArgumentsRepairEnabled, group.FieldModelRoutingEnabled, group.FieldMcpXMLInject, group.FieldAllowMessagesDispatch, group.FieldRequireOauthOnly, group.FieldRequirePrivacySet, group.FieldForceApplicationJSONForNonStream:
+		case group.FieldIsExclusive, group.FieldClaudeCodeOnly, group.FieldClaudePromptCachingEnabled, group.FieldClaudeUnrequested1hCacheAs5m, group.FieldThinkingSignatureCompatEnabled, group.FieldClaudeToolUseRepairEnabled, group.FieldClaudeToolArgumentsRepairEnabled, group.FieldModelRoutingEnabled, group.FieldMcpXMLInject, group.FieldAllowMessagesDispatch, group.FieldRequireOauthOnly, group.FieldRequirePrivacySet, group.FieldForceApplicationJSONForNonStream, group.FieldHedgeEnabled, group.FieldMaintenanceMode:
 			values[i] = new(sql.NullBool)
 		case group.FieldRateMultiplier, group.FieldDailyLimitUsd, group.FieldWeeklyLimitUsd, group.FieldMonthlyLimitUsd, group.FieldImagePrice1k, group.FieldImagePrice2k, group.FieldImagePrice4k:
 			values[i] = new(sql.NullFloat64)
-		case group.FieldID, group.FieldDefaultValidityDays, group.FieldFallbackGroupID, group.FieldFallbackGroupIDOnInvalidRequest, group.FieldSortOrder:
+		case group.FieldID, group.FieldDefaultValidityDays, group.FieldFallbackGroupID, group.FieldFallbackGroupIDOnInvalidRequest, group.FieldSortOrder, group.FieldHedgeDelayMs:
 			values[i] = new(sql.NullInt64)
-		case group.FieldName, group.FieldDescription, group.FieldStatus, group.FieldPlatform, group.FieldSubscriptionType, group.FieldDefaultMappedModel:
+		case group.FieldName, group.FieldDescription, group.FieldStatus, group.FieldPlatform, group.FieldSubscriptionType, group.FieldDefaultMappedModel, group.FieldSchedulingStrategy, group.FieldAvailabilitySchedule, group.FieldMaintenanceMessage:
 			values[i] = new(sql.NullString)
 		case group.FieldCreatedAt, group.FieldUpdatedAt, group.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
@@ -451,6 +470,68 @@ func (_m *Group) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.ForceApplicationJSONForNonStream = value.Bool
 			}
+		case group.FieldSchedulingStrategy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field scheduling_strategy", values[i])
+			} else if value.Valid {
+				_m.SchedulingStrategy = value.String
+			}
+		case group.FieldHedgeEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field hedge_enabled", values[i])
+			} else if value.Valid {
+				_m.HedgeEnabled = value.Bool
+			}
+		case group.FieldHedgeDelayMs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field hedge_delay_ms", values[i])
+			} else if value.Valid {
+				_m.HedgeDelayMs = int(value.Int64)
+			}
+		case group.FieldTrafficSplitRules:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field traffic_split_rules", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.TrafficSplitRules); err != nil {
+					return fmt.Errorf("unmarshal field traffic_split_rules: %w", err)
+				}
+			}
+		case group.FieldModelFallbackChains:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field model_fallback_chains", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.ModelFallbackChains); err != nil {
+					return fmt.Errorf("unmarshal field model_fallback_chains: %w", err)
+				}
+			}
+		case group.FieldVolumeTiers:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field volume_tiers", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.VolumeTiers); err != nil {
+					return fmt.Errorf("unmarshal field volume_tiers: %w", err)
+				}
+			}
+		case group.FieldAvailabilitySchedule:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field availability_schedule", values[i])
+			} else if value.Valid {
+				_m.AvailabilitySchedule = new(string)
+				*_m.AvailabilitySchedule = value.String
+			}
+		case group.FieldMaintenanceMode:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field maintenance_mode", values[i])
+			} else if value.Valid {
+				_m.MaintenanceMode = value.Bool
+			}
+		case group.FieldMaintenanceMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field maintenance_message", values[i])
+			} else if value.Valid {
+				_m.MaintenanceMessage = new(string)
+				*_m.MaintenanceMessage = value.String
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -651,6 +732,37 @@ func (_m *Group) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("force_application_json_for_non_stream=")
 	builder.WriteString(fmt.Sprintf("%v", _m.ForceApplicationJSONForNonStream))
+	builder.WriteString(", ")
+	builder.WriteString("scheduling_strategy=")
+	builder.WriteString(_m.SchedulingStrategy)
+	builder.WriteString(", ")
+	builder.WriteString("hedge_enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.HedgeEnabled))
+	builder.WriteString(", ")
+	builder.WriteString("hedge_delay_ms=")
+	builder.WriteString(fmt.Sprintf("%v", _m.HedgeDelayMs))
+	builder.WriteString(", ")
+	builder.WriteString("traffic_split_rules=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TrafficSplitRules))
+	builder.WriteString(", ")
+	builder.WriteString("model_fallback_chains=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ModelFallbackChains))
+	builder.WriteString(", ")
+	builder.WriteString("volume_tiers=")
+	builder.WriteString(fmt.Sprintf("%v", _m.VolumeTiers))
+	builder.WriteString(", ")
+	if v := _m.AvailabilitySchedule; v != nil {
+		builder.WriteString("availability_schedule=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("maintenance_mode=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MaintenanceMode))
+	builder.WriteString(", ")
+	if v := _m.MaintenanceMessage; v != nil {
+		builder.WriteString("maintenance_message=")
+		builder.WriteString(*v)
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -85,6 +85,24 @@ const (
 	FieldDefaultMappedModel = "default_mapped_model"
 	// FieldForceApplicationJSONForNonStream holds the string denoting the force_application_json_for_non_stream field in the database.
 	FieldForceApplicationJSONForNonStream = "force_application_json_for_non_stream"
+	// FieldSchedulingStrategy holds the string denoting the scheduling_strategy field in the database.
+	FieldSchedulingStrategy = "scheduling_strategy"
+	// FieldHedgeEnabled holds the string denoting the hedge_enabled field in the database.
+	FieldHedgeEnabled = "hedge_enabled"
+	// FieldHedgeDelayMs holds the string denoting the hedge_delay_ms field in the database.
+	FieldHedgeDelayMs = "hedge_delay_ms"
+	// FieldTrafficSplitRules holds the string denoting the traffic_split_rules field in the database.
+	FieldTrafficSplitRules = "traffic_split_rules"
+	// FieldModelFallbackChains holds the string denoting the model_fallback_chains field in the database.
+	FieldModelFallbackChains = "model_fallback_chains"
+	// FieldVolumeTiers holds the string denoting the volume_tiers field in the database.
+	FieldVolumeTiers = "volume_tiers"
+	// FieldAvailabilitySchedule holds the string denoting the availability_schedule field in the database.
+	FieldAvailabilitySchedule = "availability_schedule"
+	// FieldMaintenanceMode holds the string denoting the maintenance_mode field in the database.
+	FieldMaintenanceMode = "maintenance_mode"
+	// FieldMaintenanceMessage holds the string denoting the maintenance_message field in the database.
+	FieldMaintenanceMessage = "maintenance_message"
 	// EdgeAPIKeys holds the string denoting the api_keys edge name in mutations.
 	EdgeAPIKeys = "api_keys"
 	// EdgeRedeemCodes holds the string denoting the redeem_codes edge name in mutations.
@@ -195,6 +213,15 @@ var Columns = []string{
 	FieldRequirePrivacySet,
 	FieldDefaultMappedModel,
 	FieldForceApplicationJSONForNonStream,
+	FieldSchedulingStrategy,
+	FieldHedgeEnabled,
+	FieldHedgeDelayMs,
+	FieldTrafficSplitRules,
+	FieldModelFallbackChains,
+	FieldVolumeTiers,
+	FieldAvailabilitySchedule,
+	FieldMaintenanceMode,
+	FieldMaintenanceMessage,
 }
 
 var (
@@ -282,6 +309,18 @@ var (
 	DefaultMappedModelValidator func(string) error
 	// DefaultForceApplicationJSONForNonStream holds the default value on creation for the "force_application_json_for_non_stream" field.
 	DefaultForceApplicationJSONForNonStream bool
+	// DefaultSchedulingStrategy holds the default value on creation for the "scheduling_strategy" field.
+	DefaultSchedulingStrategy string
+	// SchedulingStrategyValidator is a validator for the "scheduling_strategy" field. It is called by the builders before save.
+	SchedulingStrategyValidator func(string) error
+	// DefaultHedgeEnabled holds the default value on creation for the "hedge_enabled" field.
+	DefaultHedgeEnabled bool
+	// DefaultHedgeDelayMs holds the default value on creation for the "hedge_delay_ms" field.
+	DefaultHedgeDelayMs int
+	// AvailabilityScheduleValidator is a validator for the "availability_schedule" field. It is called by the builders before save.
+	AvailabilityScheduleValidator func(string) error
+	// DefaultMaintenanceMode holds the default value on creation for the "maintenance_mode" field.
+	DefaultMaintenanceMode bool
 )
 
 // OrderOption defines the ordering options for the Group queries.
@@ -457,6 +496,36 @@ func ByForceApplicationJSONForNonStream(opts ...sql.OrderTermOption) OrderOption
 	return sql.OrderByField(FieldForceApplicationJSONForNonStream, opts...).ToFunc()
 }
 
+// BySchedulingStrategy orders the results by the scheduling_strategy field.
+func BySchedulingStrategy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSchedulingStrategy, opts...).ToFunc()
+}
+
+// ByHedgeEnabled orders the results by the hedge_enabled field.
+func ByHedgeEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHedgeEnabled, opts...).ToFunc()
+}
+
+// ByHedgeDelayMs orders the results by the hedge_delay_ms field.
+func ByHedgeDelayMs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHedgeDelayMs, opts...).ToFunc()
+}
+
+// ByAvailabilitySchedule orders the results by the availability_schedule field.
+func ByAvailabilitySchedule(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAvailabilitySchedule, opts...).ToFunc()
+}
+
+// ByMaintenanceMode orders the results by the maintenance_mode field.
+func ByMaintenanceMode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaintenanceMode, opts...).ToFunc()
+}
+
+// ByMaintenanceMessage orders the results by the maintenance_message field.
+func ByMaintenanceMessage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaintenanceMessage, opts...).ToFunc()
+}
+
 // ByAPIKeysCount orders the results by api_keys count.
 func ByAPIKeysCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -220,6 +220,36 @@ func ForceApplicationJSONForNonStream(v bool) predicate.Group {
 	return predicate.Group(sql.FieldEQ(FieldForceApplicationJSONForNonStream, v))
 }
 
+// SchedulingStrategy applies equality check predicate on the "scheduling_strategy" field. It's identical to SchedulingStrategyEQ.
+func SchedulingStrategy(v string) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldSchedulingStrategy, v))
+}
+
+// HedgeEnabled applies equality check predicate on the "hedge_enabled" field. It's identical to HedgeEnabledEQ.
+func HedgeEnabled(v bool) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldHedgeEnabled, v))
+}
+
+// HedgeDelayMs applies equality check predicate on the "hedge_delay_ms" field. It's identical to HedgeDelayMsEQ.
+func HedgeDelayMs(v int) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldHedgeDelayMs, v))
+}
+
+// AvailabilitySchedule applies equality check predicate on the "availability_schedule" field. It's identical to AvailabilityScheduleEQ.
+func AvailabilitySchedule(v string) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldAvailabilitySchedule, v))
+}
+
+// MaintenanceMode applies equality check predicate on the "maintenance_mode" field. It's identical to MaintenanceModeEQ.
+func MaintenanceMode(v bool) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldMaintenanceMode, v))
+}
+
+// MaintenanceMessage applies equality check predicate on the "maintenance_message" field. It's identical to MaintenanceMessageEQ.
+func MaintenanceMessage(v string) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldMaintenanceMessage, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Group {
 	return predicate.Group(sql.FieldEQ(FieldCreatedAt, v))
@@ -1410,6 +1440,311 @@ func ForceApplicationJSONForNonStreamNEQ(v bool) predicate.Group {
 	return predicate.Group(sql.FieldNEQ(FieldForceApplicationJSONForNonStream, v))
 }
 
+// SchedulingStrategyEQ applies the EQ predicate on the "scheduling_strategy" field.
+func SchedulingStrategyEQ(v string) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldSchedulingStrategy, v))
+}
+
+// SchedulingStrategyNEQ applies the NEQ predicate on the "scheduling_strategy" field.
+func SchedulingStrategyNEQ(v string) predicate.Group {
+	return predicate.Group(sql.FieldNEQ(FieldSchedulingStrategy, v))
+}
+
+// SchedulingStrategyIn applies the In predicate on the "scheduling_strategy" field.
+func SchedulingStrategyIn(vs ...string) predicate.Group {
+	return predicate.Group(sql.FieldIn(FieldSchedulingStrategy, vs...))
+}
+
+// SchedulingStrategyNotIn applies the NotIn predicate on the "scheduling_strategy" field.
+func SchedulingStrategyNotIn(vs ...string) predicate.Group {
+	return predicate.Group(sql.FieldNotIn(FieldSchedulingStrategy, vs...))
+}
+
+// SchedulingStrategyGT applies the GT predicate on the "scheduling_strategy" field.
+func SchedulingStrategyGT(v string) predicate.Group {
+	return predicate.Group(sql.FieldGT(FieldSchedulingStrategy, v))
+}
+
+// SchedulingStrategyGTE applies the GTE predicate on the "scheduling_strategy" field.
+func SchedulingStrategyGTE(v string) predicate.Group {
+	return predicate.Group(sql.FieldGTE(FieldSchedulingStrategy, v))
+}
+
+// SchedulingStrategyLT applies the LT predicate on the "scheduling_strategy" field.
+func SchedulingStrategyLT(v string) predicate.Group {
+	return predicate.Group(sql.FieldLT(FieldSchedulingStrategy, v))
+}
+
+// SchedulingStrategyLTE applies the LTE predicate on the "scheduling_strategy" field.
+func SchedulingStrategyLTE(v string) predicate.Group {
+	return predicate.Group(sql.FieldLTE(FieldSchedulingStrategy, v))
+}
+
+// SchedulingStrategyContains applies the Contains predicate on the "scheduling_strategy" field.
+func SchedulingStrategyContains(v string) predicate.Group {
+	return predicate.Group(sql.FieldContains(FieldSchedulingStrategy, v))
+}
+
+// SchedulingStrategyHasPrefix applies the HasPrefix predicate on the "scheduling_strategy" field.
+func SchedulingStrategyHasPrefix(v string) predicate.Group {
+	return predicate.Group(sql.FieldHasPrefix(FieldSchedulingStrategy, v))
+}
+
+// SchedulingStrategyHasSuffix applies the HasSuffix predicate on the "scheduling_strategy" field.
+func SchedulingStrategyHasSuffix(v string) predicate.Group {
+	return predicate.Group(sql.FieldHasSuffix(FieldSchedulingStrategy, v))
+}
+
+// SchedulingStrategyEqualFold applies the EqualFold predicate on the "scheduling_strategy" field.
+func SchedulingStrategyEqualFold(v string) predicate.Group {
+	return predicate.Group(sql.FieldEqualFold(FieldSchedulingStrategy, v))
+}
+
+// SchedulingStrategyContainsFold applies the ContainsFold predicate on the "scheduling_strategy" field.
+func SchedulingStrategyContainsFold(v string) predicate.Group {
+	return predicate.Group(sql.FieldContainsFold(FieldSchedulingStrategy, v))
+}
+
+// HedgeEnabledEQ applies the EQ predicate on the "hedge_enabled" field.
+func HedgeEnabledEQ(v bool) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldHedgeEnabled, v))
+}
+
+// HedgeEnabledNEQ applies the NEQ predicate on the "hedge_enabled" field.
+func HedgeEnabledNEQ(v bool) predicate.Group {
+	return predicate.Group(sql.FieldNEQ(FieldHedgeEnabled, v))
+}
+
+// HedgeDelayMsEQ applies the EQ predicate on the "hedge_delay_ms" field.
+func HedgeDelayMsEQ(v int) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldHedgeDelayMs, v))
+}
+
+// HedgeDelayMsNEQ applies the NEQ predicate on the "hedge_delay_ms" field.
+func HedgeDelayMsNEQ(v int) predicate.Group {
+	return predicate.Group(sql.FieldNEQ(FieldHedgeDelayMs, v))
+}
+
+// HedgeDelayMsIn applies the In predicate on the "hedge_delay_ms" field.
+func HedgeDelayMsIn(vs ...int) predicate.Group {
+	return predicate.Group(sql.FieldIn(FieldHedgeDelayMs, vs...))
+}
+
+// HedgeDelayMsNotIn applies the NotIn predicate on the "hedge_delay_ms" field.
+func HedgeDelayMsNotIn(vs ...int) predicate.Group {
+	return predicate.Group(sql.FieldNotIn(FieldHedgeDelayMs, vs...))
+}
+
+// HedgeDelayMsGT applies the GT predicate on the "hedge_delay_ms" field.
+func HedgeDelayMsGT(v int) predicate.Group {
+	return predicate.Group(sql.FieldGT(FieldHedgeDelayMs, v))
+}
+
+// HedgeDelayMsGTE applies the GTE predicate on the "hedge_delay_ms" field.
+func HedgeDelayMsGTE(v int) predicate.Group {
+	return predicate.Group(sql.FieldGTE(FieldHedgeDelayMs, v))
+}
+
+// HedgeDelayMsLT applies the LT predicate on the "hedge_delay_ms" field.
+func HedgeDelayMsLT(v int) predicate.Group {
+	return predicate.Group(sql.FieldLT(FieldHedgeDelayMs, v))
+}
+
+// HedgeDelayMsLTE applies the LTE predicate on the "hedge_delay_ms" field.
+func HedgeDelayMsLTE(v int) predicate.Group {
+	return predicate.Group(sql.FieldLTE(FieldHedgeDelayMs, v))
+}
+
+// TrafficSplitRulesIsNil applies the IsNil predicate on the "traffic_split_rules" field.
+func TrafficSplitRulesIsNil() predicate.Group {
+	return predicate.Group(sql.FieldIsNull(FieldTrafficSplitRules))
+}
+
+// TrafficSplitRulesNotNil applies the NotNil predicate on the "traffic_split_rules" field.
+func TrafficSplitRulesNotNil() predicate.Group {
+	return predicate.Group(sql.FieldNotNull(FieldTrafficSplitRules))
+}
+
+// ModelFallbackChainsIsNil applies the IsNil predicate on the "model_fallback_chains" field.
+func ModelFallbackChainsIsNil() predicate.Group {
+	return predicate.Group(sql.FieldIsNull(FieldModelFallbackChains))
+}
+
+// ModelFallbackChainsNotNil applies the NotNil predicate on the "model_fallback_chains" field.
+func ModelFallbackChainsNotNil() predicate.Group {
+	return predicate.Group(sql.FieldNotNull(FieldModelFallbackChains))
+}
+
+// VolumeTiersIsNil applies the IsNil predicate on the "volume_tiers" field.
+func VolumeTiersIsNil() predicate.Group {
+	return predicate.Group(sql.FieldIsNull(FieldVolumeTiers))
+}
+
+// VolumeTiersNotNil applies the NotNil predicate on the "volume_tiers" field.
+func VolumeTiersNotNil() predicate.Group {
+	return predicate.Group(sql.FieldNotNull(FieldVolumeTiers))
+}
+
+// AvailabilityScheduleEQ applies the EQ predicate on the "availability_schedule" field.
+func AvailabilityScheduleEQ(v string) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldAvailabilitySchedule, v))
+}
+
+// AvailabilityScheduleNEQ applies the NEQ predicate on the "availability_schedule" field.
+func AvailabilityScheduleNEQ(v string) predicate.Group {
+	return predicate.Group(sql.FieldNEQ(FieldAvailabilitySchedule, v))
+}
+
+// AvailabilityScheduleIn applies the In predicate on the "availability_schedule" field.
+func AvailabilityScheduleIn(vs ...string) predicate.Group {
+	return predicate.Group(sql.FieldIn(FieldAvailabilitySchedule, vs...))
+}
+
+// AvailabilityScheduleNotIn applies the NotIn predicate on the "availability_schedule" field.
+func AvailabilityScheduleNotIn(vs ...string) predicate.Group {
+	return predicate.Group(sql.FieldNotIn(FieldAvailabilitySchedule, vs...))
+}
+
+// AvailabilityScheduleGT applies the GT predicate on the "availability_schedule" field.
+func AvailabilityScheduleGT(v string) predicate.Group {
+	return predicate.Group(sql.FieldGT(FieldAvailabilitySchedule, v))
+}
+
+// AvailabilityScheduleGTE applies the GTE predicate on the "availability_schedule" field.
+func AvailabilityScheduleGTE(v string) predicate.Group {
+	return predicate.Group(sql.FieldGTE(FieldAvailabilitySchedule, v))
+}
+
+// AvailabilityScheduleLT applies the LT predicate on the "availability_schedule" field.
+func AvailabilityScheduleLT(v string) predicate.Group {
+	return predicate.Group(sql.FieldLT(FieldAvailabilitySchedule, v))
+}
+
+// AvailabilityScheduleLTE applies the LTE predicate on the "availability_schedule" field.
+func AvailabilityScheduleLTE(v string) predicate.Group {
+	return predicate.Group(sql.FieldLTE(FieldAvailabilitySchedule, v))
+}
+
+// AvailabilityScheduleContains applies the Contains predicate on the "availability_schedule" field.
+func AvailabilityScheduleContains(v string) predicate.Group {
+	return predicate.Group(sql.FieldContains(FieldAvailabilitySchedule, v))
+}
+
+// AvailabilityScheduleHasPrefix applies the HasPrefix predicate on the "availability_schedule" field.
+func AvailabilityScheduleHasPrefix(v string) predicate.Group {
+	return predicate.Group(sql.FieldHasPrefix(FieldAvailabilitySchedule, v))
+}
+
+// AvailabilityScheduleHasSuffix applies the HasSuffix predicate on the "availability_schedule" field.
+func AvailabilityScheduleHasSuffix(v string) predicate.Group {
+	return predicate.Group(sql.FieldHasSuffix(FieldAvailabilitySchedule, v))
+}
+
+// AvailabilityScheduleIsNil applies the IsNil predicate on the "availability_schedule" field.
+func AvailabilityScheduleIsNil() predicate.Group {
+	return predicate.Group(sql.FieldIsNull(FieldAvailabilitySchedule))
+}
+
+// AvailabilityScheduleNotNil applies the NotNil predicate on the "availability_schedule" field.
+func AvailabilityScheduleNotNil() predicate.Group {
+	return predicate.Group(sql.FieldNotNull(FieldAvailabilitySchedule))
+}
+
+// AvailabilityScheduleEqualFold applies the EqualFold predicate on the "availability_schedule" field.
+func AvailabilityScheduleEqualFold(v string) predicate.Group {
+	return predicate.Group(sql.FieldEqualFold(FieldAvailabilitySchedule, v))
+}
+
+// AvailabilityScheduleContainsFold applies the ContainsFold predicate on the "availability_schedule" field.
+func AvailabilityScheduleContainsFold(v string) predicate.Group {
+	return predicate.Group(sql.FieldContainsFold(FieldAvailabilitySchedule, v))
+}
+
+// MaintenanceModeEQ applies the EQ predicate on the "maintenance_mode" field.
+func MaintenanceModeEQ(v bool) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldMaintenanceMode, v))
+}
+
+// MaintenanceModeNEQ applies the NEQ predicate on the "maintenance_mode" field.
+func MaintenanceModeNEQ(v bool) predicate.Group {
+	return predicate.Group(sql.FieldNEQ(FieldMaintenanceMode, v))
+}
+
+// MaintenanceMessageEQ applies the EQ predicate on the "maintenance_message" field.
+func MaintenanceMessageEQ(v string) predicate.Group {
+	return predicate.Group(sql.FieldEQ(FieldMaintenanceMessage, v))
+}
+
+// MaintenanceMessageNEQ applies the NEQ predicate on the "maintenance_message" field.
+func MaintenanceMessageNEQ(v string) predicate.Group {
+	return predicate.Group(sql.FieldNEQ(FieldMaintenanceMessage, v))
+}
+
+// MaintenanceMessageIn applies the In predicate on the "maintenance_message" field.
+func MaintenanceMessageIn(vs ...string) predicate.Group {
+	return predicate.Group(sql.FieldIn(FieldMaintenanceMessage, vs...))
+}
+
+// MaintenanceMessageNotIn applies the NotIn predicate on the "maintenance_message" field.
+func MaintenanceMessageNotIn(vs ...string) predicate.Group {
+	return predicate.Group(sql.FieldNotIn(FieldMaintenanceMessage, vs...))
+}
+
+// MaintenanceMessageGT applies the GT predicate on the "maintenance_message" field.
+func MaintenanceMessageGT(v string) predicate.Group {
+	return predicate.Group(sql.FieldGT(FieldMaintenanceMessage, v))
+}
+
+// MaintenanceMessageGTE applies the GTE predicate on the "maintenance_message" field.
+func MaintenanceMessageGTE(v string) predicate.Group {
+	return predicate.Group(sql.FieldGTE(FieldMaintenanceMessage, v))
+}
+
+// MaintenanceMessageLT applies the LT predicate on the "maintenance_message" field.
+func MaintenanceMessageLT(v string) predicate.Group {
+	return predicate.Group(sql.FieldLT(FieldMaintenanceMessage, v))
+}
+
+// MaintenanceMessageLTE applies the LTE predicate on the "maintenance_message" field.
+func MaintenanceMessageLTE(v string) predicate.Group {
+	return predicate.Group(sql.FieldLTE(FieldMaintenanceMessage, v))
+}
+
+// MaintenanceMessageContains applies the Contains predicate on the "maintenance_message" field.
+func MaintenanceMessageContains(v string) predicate.Group {
+	return predicate.Group(sql.FieldContains(FieldMaintenanceMessage, v))
+}
+
+// MaintenanceMessageHasPrefix applies the HasPrefix predicate on the "maintenance_message" field.
+func MaintenanceMessageHasPrefix(v string) predicate.Group {
+	return predicate.Group(sql.FieldHasPrefix(FieldMaintenanceMessage, v))
+}
+
+// MaintenanceMessageHasSuffix applies the HasSuffix predicate on the "maintenance_message" field.
+func MaintenanceMessageHasSuffix(v string) predicate.Group {
+	return predicate.Group(sql.FieldHasSuffix(FieldMaintenanceMessage, v))
+}
+
+// MaintenanceMessageIsNil applies the IsNil predicate on the "maintenance_message" field.
+func MaintenanceMessageIsNil() predicate.Group {
+	return predicate.Group(sql.FieldIsNull(FieldMaintenanceMessage))
+}
+
+// MaintenanceMessageNotNil applies the NotNil predicate on the "maintenance_message" field.
+func MaintenanceMessageNotNil() predicate.Group {
+	return predicate.Group(sql.FieldNotNull(FieldMaintenanceMessage))
+}
+
+// MaintenanceMessageEqualFold applies the EqualFold predicate on the "maintenance_message" field.
+func MaintenanceMessageEqualFold(v string) predicate.Group {
+	return predicate.Group(sql.FieldEqualFold(FieldMaintenanceMessage, v))
+}
+
+// MaintenanceMessageContainsFold applies the ContainsFold predicate on the "maintenance_message" field.
+func MaintenanceMessageContainsFold(v string) predicate.Group {
+	return predicate.Group(sql.FieldContainsFold(FieldMaintenanceMessage, v))
+}
+
 // HasAPIKeys applies the HasEdge predicate on the "api_keys" edge.
 func HasAPIKeys() predicate.Group {
 	return predicate.Group(func(s *sql.Selector) {
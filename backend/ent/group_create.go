@@ -18,6 +18,7 @@ import (
 	"github.com/Wei-Shaw/sub2api/ent/usagelog"
 	"github.com/Wei-Shaw/sub2api/ent/user"
 	"github.com/Wei-Shaw/sub2api/ent/usersubscription"
+	"github.com/Wei-Shaw/sub2api/internal/model"
 )
 
 // GroupCreate is the builder for creating a Group entity.
@@ -494,6 +495,108 @@ func (_c *GroupCreate) SetNillableForceApplicationJSONForNonStream(v *bool) *Gro
 	return _c
 }
 
+// SetSchedulingStrategy sets the "scheduling_strategy" field.
+func (_c *GroupCreate) SetSchedulingStrategy(v string) *GroupCreate {
+	_c.mutation.SetSchedulingStrategy(v)
+	return _c
+}
+
+// SetNillableSchedulingStrategy sets the "scheduling_strategy" field if the given value is not nil.
+func (_c *GroupCreate) SetNillableSchedulingStrategy(v *string) *GroupCreate {
+	if v != nil {
+		_c.SetSchedulingStrategy(*v)
+	}
+	return _c
+}
+
+// SetHedgeEnabled sets the "hedge_enabled" field.
+func (_c *GroupCreate) SetHedgeEnabled(v bool) *GroupCreate {
+	_c.mutation.SetHedgeEnabled(v)
+	return _c
+}
+
+// SetNillableHedgeEnabled sets the "hedge_enabled" field if the given value is not nil.
+func (_c *GroupCreate) SetNillableHedgeEnabled(v *bool) *GroupCreate {
+	if v != nil {
+		_c.SetHedgeEnabled(*v)
+	}
+	return _c
+}
+
+// SetHedgeDelayMs sets the "hedge_delay_ms" field.
+func (_c *GroupCreate) SetHedgeDelayMs(v int) *GroupCreate {
+	_c.mutation.SetHedgeDelayMs(v)
+	return _c
+}
+
+// SetNillableHedgeDelayMs sets the "hedge_delay_ms" field if the given value is not nil.
+func (_c *GroupCreate) SetNillableHedgeDelayMs(v *int) *GroupCreate {
+	if v != nil {
+		_c.SetHedgeDelayMs(*v)
+	}
+	return _c
+}
+
+// SetTrafficSplitRules sets the "traffic_split_rules" field.
+func (_c *GroupCreate) SetTrafficSplitRules(v []model.TrafficSplitRule) *GroupCreate {
+	_c.mutation.SetTrafficSplitRules(v)
+	return _c
+}
+
+// SetModelFallbackChains sets the "model_fallback_chains" field.
+func (_c *GroupCreate) SetModelFallbackChains(v []model.ModelFallbackChain) *GroupCreate {
+	_c.mutation.SetModelFallbackChains(v)
+	return _c
+}
+
+// SetVolumeTiers sets the "volume_tiers" field.
+func (_c *GroupCreate) SetVolumeTiers(v []model.VolumeTier) *GroupCreate {
+	_c.mutation.SetVolumeTiers(v)
+	return _c
+}
+
+// SetAvailabilitySchedule sets the "availability_schedule" field.
+func (_c *GroupCreate) SetAvailabilitySchedule(v string) *GroupCreate {
+	_c.mutation.SetAvailabilitySchedule(v)
+	return _c
+}
+
+// SetNillableAvailabilitySchedule sets the "availability_schedule" field if the given value is not nil.
+func (_c *GroupCreate) SetNillableAvailabilitySchedule(v *string) *GroupCreate {
+	if v != nil {
+		_c.SetAvailabilitySchedule(*v)
+	}
+	return _c
+}
+
+// SetMaintenanceMode sets the "maintenance_mode" field.
+func (_c *GroupCreate) SetMaintenanceMode(v bool) *GroupCreate {
+	_c.mutation.SetMaintenanceMode(v)
+	return _c
+}
+
+// SetNillableMaintenanceMode sets the "maintenance_mode" field if the given value is not nil.
+func (_c *GroupCreate) SetNillableMaintenanceMode(v *bool) *GroupCreate {
+	if v != nil {
+		_c.SetMaintenanceMode(*v)
+	}
+	return _c
+}
+
+// SetMaintenanceMessage sets the "maintenance_message" field.
+func (_c *GroupCreate) SetMaintenanceMessage(v string) *GroupCreate {
+	_c.mutation.SetMaintenanceMessage(v)
+	return _c
+}
+
+// SetNillableMaintenanceMessage sets the "maintenance_message" field if the given value is not nil.
+func (_c *GroupCreate) SetNillableMaintenanceMessage(v *string) *GroupCreate {
+	if v != nil {
+		_c.SetMaintenanceMessage(*v)
+	}
+	return _c
+}
+
 // AddAPIKeyIDs adds the "api_keys" edge to the APIKey entity by IDs.
 func (_c *GroupCreate) AddAPIKeyIDs(ids ...int64) *GroupCreate {
 	_c.mutation.AddAPIKeyIDs(ids...)
@@ -719,6 +822,22 @@ func (_c *GroupCreate) defaults() error {
 		v := group.DefaultForceApplicationJSONForNonStream
 		_c.mutation.SetForceApplicationJSONForNonStream(v)
 	}
+	if _, ok := _c.mutation.SchedulingStrategy(); !ok {
+		v := group.DefaultSchedulingStrategy
+		_c.mutation.SetSchedulingStrategy(v)
+	}
+	if _, ok := _c.mutation.HedgeEnabled(); !ok {
+		v := group.DefaultHedgeEnabled
+		_c.mutation.SetHedgeEnabled(v)
+	}
+	if _, ok := _c.mutation.HedgeDelayMs(); !ok {
+		v := group.DefaultHedgeDelayMs
+		_c.mutation.SetHedgeDelayMs(v)
+	}
+	if _, ok := _c.mutation.MaintenanceMode(); !ok {
+		v := group.DefaultMaintenanceMode
+		_c.mutation.SetMaintenanceMode(v)
+	}
 	return nil
 }
 
@@ -821,6 +940,28 @@ func (_c *GroupCreate) check() error {
 	if _, ok := _c.mutation.ForceApplicationJSONForNonStream(); !ok {
 		return &ValidationError{Name: "force_application_json_for_non_stream", err: errors.New(`ent: missing required field "Group.force_application_json_for_non_stream"`)}
 	}
+	if _, ok := _c.mutation.SchedulingStrategy(); !ok {
+		return &ValidationError{Name: "scheduling_strategy", err: errors.New(`ent: missing required field "Group.scheduling_strategy"`)}
+	}
+	if v, ok := _c.mutation.SchedulingStrategy(); ok {
+		if err := group.SchedulingStrategyValidator(v); err != nil {
+			return &ValidationError{Name: "scheduling_strategy", err: fmt.Errorf(`ent: validator failed for field "Group.scheduling_strategy": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.HedgeEnabled(); !ok {
+		return &ValidationError{Name: "hedge_enabled", err: errors.New(`ent: missing required field "Group.hedge_enabled"`)}
+	}
+	if _, ok := _c.mutation.HedgeDelayMs(); !ok {
+		return &ValidationError{Name: "hedge_delay_ms", err: errors.New(`ent: missing required field "Group.hedge_delay_ms"`)}
+	}
+	if v, ok := _c.mutation.AvailabilitySchedule(); ok {
+		if err := group.AvailabilityScheduleValidator(v); err != nil {
+			return &ValidationError{Name: "availability_schedule", err: fmt.Errorf(`ent: validator failed for field "Group.availability_schedule": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.MaintenanceMode(); !ok {
+		return &ValidationError{Name: "maintenance_mode", err: errors.New(`ent: missing required field "Group.maintenance_mode"`)}
+	}
 	return nil
 }
 
@@ -988,6 +1129,42 @@ func (_c *GroupCreate) createSpec() (*Group, *sqlgraph.CreateSpec) {
 		_spec.SetField(group.FieldForceApplicationJSONForNonStream, field.TypeBool, value)
 		_node.ForceApplicationJSONForNonStream = value
 	}
+	if value, ok := _c.mutation.SchedulingStrategy(); ok {
+		_spec.SetField(group.FieldSchedulingStrategy, field.TypeString, value)
+		_node.SchedulingStrategy = value
+	}
+	if value, ok := _c.mutation.HedgeEnabled(); ok {
+		_spec.SetField(group.FieldHedgeEnabled, field.TypeBool, value)
+		_node.HedgeEnabled = value
+	}
+	if value, ok := _c.mutation.HedgeDelayMs(); ok {
+		_spec.SetField(group.FieldHedgeDelayMs, field.TypeInt, value)
+		_node.HedgeDelayMs = value
+	}
+	if value, ok := _c.mutation.TrafficSplitRules(); ok {
+		_spec.SetField(group.FieldTrafficSplitRules, field.TypeJSON, value)
+		_node.TrafficSplitRules = value
+	}
+	if value, ok := _c.mutation.ModelFallbackChains(); ok {
+		_spec.SetField(group.FieldModelFallbackChains, field.TypeJSON, value)
+		_node.ModelFallbackChains = value
+	}
+	if value, ok := _c.mutation.VolumeTiers(); ok {
+		_spec.SetField(group.FieldVolumeTiers, field.TypeJSON, value)
+		_node.VolumeTiers = value
+	}
+	if value, ok := _c.mutation.AvailabilitySchedule(); ok {
+		_spec.SetField(group.FieldAvailabilitySchedule, field.TypeString, value)
+		_node.AvailabilitySchedule = &value
+	}
+	if value, ok := _c.mutation.MaintenanceMode(); ok {
+		_spec.SetField(group.FieldMaintenanceMode, field.TypeBool, value)
+		_node.MaintenanceMode = value
+	}
+	if value, ok := _c.mutation.MaintenanceMessage(); ok {
+		_spec.SetField(group.FieldMaintenanceMessage, field.TypeString, value)
+		_node.MaintenanceMessage = &value
+	}
 	if nodes := _c.mutation.APIKeysIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1684,6 +1861,150 @@ func (u *GroupUpsert) UpdateForceApplicationJSONForNonStream() *GroupUpsert {
 	return u
 }
 
+// SetSchedulingStrategy sets the "scheduling_strategy" field.
+func (u *GroupUpsert) SetSchedulingStrategy(v string) *GroupUpsert {
+	u.Set(group.FieldSchedulingStrategy, v)
+	return u
+}
+
+// UpdateSchedulingStrategy sets the "scheduling_strategy" field to the value that was provided on create.
+func (u *GroupUpsert) UpdateSchedulingStrategy() *GroupUpsert {
+	u.SetExcluded(group.FieldSchedulingStrategy)
+	return u
+}
+
+// SetHedgeEnabled sets the "hedge_enabled" field.
+func (u *GroupUpsert) SetHedgeEnabled(v bool) *GroupUpsert {
+	u.Set(group.FieldHedgeEnabled, v)
+	return u
+}
+
+// UpdateHedgeEnabled sets the "hedge_enabled" field to the value that was provided on create.
+func (u *GroupUpsert) UpdateHedgeEnabled() *GroupUpsert {
+	u.SetExcluded(group.FieldHedgeEnabled)
+	return u
+}
+
+// SetHedgeDelayMs sets the "hedge_delay_ms" field.
+func (u *GroupUpsert) SetHedgeDelayMs(v int) *GroupUpsert {
+	u.Set(group.FieldHedgeDelayMs, v)
+	return u
+}
+
+// UpdateHedgeDelayMs sets the "hedge_delay_ms" field to the value that was provided on create.
+func (u *GroupUpsert) UpdateHedgeDelayMs() *GroupUpsert {
+	u.SetExcluded(group.FieldHedgeDelayMs)
+	return u
+}
+
+// AddHedgeDelayMs adds v to the "hedge_delay_ms" field.
+func (u *GroupUpsert) AddHedgeDelayMs(v int) *GroupUpsert {
+	u.Add(group.FieldHedgeDelayMs, v)
+	return u
+}
+
+// SetTrafficSplitRules sets the "traffic_split_rules" field.
+func (u *GroupUpsert) SetTrafficSplitRules(v []model.TrafficSplitRule) *GroupUpsert {
+	u.Set(group.FieldTrafficSplitRules, v)
+	return u
+}
+
+// UpdateTrafficSplitRules sets the "traffic_split_rules" field to the value that was provided on create.
+func (u *GroupUpsert) UpdateTrafficSplitRules() *GroupUpsert {
+	u.SetExcluded(group.FieldTrafficSplitRules)
+	return u
+}
+
+// ClearTrafficSplitRules clears the value of the "traffic_split_rules" field.
+func (u *GroupUpsert) ClearTrafficSplitRules() *GroupUpsert {
+	u.SetNull(group.FieldTrafficSplitRules)
+	return u
+}
+
+// SetModelFallbackChains sets the "model_fallback_chains" field.
+func (u *GroupUpsert) SetModelFallbackChains(v []model.ModelFallbackChain) *GroupUpsert {
+	u.Set(group.FieldModelFallbackChains, v)
+	return u
+}
+
+// UpdateModelFallbackChains sets the "model_fallback_chains" field to the value that was provided on create.
+func (u *GroupUpsert) UpdateModelFallbackChains() *GroupUpsert {
+	u.SetExcluded(group.FieldModelFallbackChains)
+	return u
+}
+
+// ClearModelFallbackChains clears the value of the "model_fallback_chains" field.
+func (u *GroupUpsert) ClearModelFallbackChains() *GroupUpsert {
+	u.SetNull(group.FieldModelFallbackChains)
+	return u
+}
+
+// SetVolumeTiers sets the "volume_tiers" field.
+func (u *GroupUpsert) SetVolumeTiers(v []model.VolumeTier) *GroupUpsert {
+	u.Set(group.FieldVolumeTiers, v)
+	return u
+}
+
+// UpdateVolumeTiers sets the "volume_tiers" field to the value that was provided on create.
+func (u *GroupUpsert) UpdateVolumeTiers() *GroupUpsert {
+	u.SetExcluded(group.FieldVolumeTiers)
+	return u
+}
+
+// ClearVolumeTiers clears the value of the "volume_tiers" field.
+func (u *GroupUpsert) ClearVolumeTiers() *GroupUpsert {
+	u.SetNull(group.FieldVolumeTiers)
+	return u
+}
+
+// SetAvailabilitySchedule sets the "availability_schedule" field.
+func (u *GroupUpsert) SetAvailabilitySchedule(v string) *GroupUpsert {
+	u.Set(group.FieldAvailabilitySchedule, v)
+	return u
+}
+
+// UpdateAvailabilitySchedule sets the "availability_schedule" field to the value that was provided on create.
+func (u *GroupUpsert) UpdateAvailabilitySchedule() *GroupUpsert {
+	u.SetExcluded(group.FieldAvailabilitySchedule)
+	return u
+}
+
+// ClearAvailabilitySchedule clears the value of the "availability_schedule" field.
+func (u *GroupUpsert) ClearAvailabilitySchedule() *GroupUpsert {
+	u.SetNull(group.FieldAvailabilitySchedule)
+	return u
+}
+
+// SetMaintenanceMode sets the "maintenance_mode" field.
+func (u *GroupUpsert) SetMaintenanceMode(v bool) *GroupUpsert {
+	u.Set(group.FieldMaintenanceMode, v)
+	return u
+}
+
+// UpdateMaintenanceMode sets the "maintenance_mode" field to the value that was provided on create.
+func (u *GroupUpsert) UpdateMaintenanceMode() *GroupUpsert {
+	u.SetExcluded(group.FieldMaintenanceMode)
+	return u
+}
+
+// SetMaintenanceMessage sets the "maintenance_message" field.
+func (u *GroupUpsert) SetMaintenanceMessage(v string) *GroupUpsert {
+	u.Set(group.FieldMaintenanceMessage, v)
+	return u
+}
+
+// UpdateMaintenanceMessage sets the "maintenance_message" field to the value that was provided on create.
+func (u *GroupUpsert) UpdateMaintenanceMessage() *GroupUpsert {
+	u.SetExcluded(group.FieldMaintenanceMessage)
+	return u
+}
+
+// ClearMaintenanceMessage clears the value of the "maintenance_message" field.
+func (u *GroupUpsert) ClearMaintenanceMessage() *GroupUpsert {
+	u.SetNull(group.FieldMaintenanceMessage)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -2359,6 +2680,174 @@ func (u *GroupUpsertOne) UpdateForceApplicationJSONForNonStream() *GroupUpsertOn
 	})
 }
 
+// SetSchedulingStrategy sets the "scheduling_strategy" field.
+func (u *GroupUpsertOne) SetSchedulingStrategy(v string) *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetSchedulingStrategy(v)
+	})
+}
+
+// UpdateSchedulingStrategy sets the "scheduling_strategy" field to the value that was provided on create.
+func (u *GroupUpsertOne) UpdateSchedulingStrategy() *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdateSchedulingStrategy()
+	})
+}
+
+// SetHedgeEnabled sets the "hedge_enabled" field.
+func (u *GroupUpsertOne) SetHedgeEnabled(v bool) *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetHedgeEnabled(v)
+	})
+}
+
+// UpdateHedgeEnabled sets the "hedge_enabled" field to the value that was provided on create.
+func (u *GroupUpsertOne) UpdateHedgeEnabled() *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdateHedgeEnabled()
+	})
+}
+
+// SetHedgeDelayMs sets the "hedge_delay_ms" field.
+func (u *GroupUpsertOne) SetHedgeDelayMs(v int) *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetHedgeDelayMs(v)
+	})
+}
+
+// AddHedgeDelayMs adds v to the "hedge_delay_ms" field.
+func (u *GroupUpsertOne) AddHedgeDelayMs(v int) *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.AddHedgeDelayMs(v)
+	})
+}
+
+// UpdateHedgeDelayMs sets the "hedge_delay_ms" field to the value that was provided on create.
+func (u *GroupUpsertOne) UpdateHedgeDelayMs() *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdateHedgeDelayMs()
+	})
+}
+
+// SetTrafficSplitRules sets the "traffic_split_rules" field.
+func (u *GroupUpsertOne) SetTrafficSplitRules(v []model.TrafficSplitRule) *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetTrafficSplitRules(v)
+	})
+}
+
+// UpdateTrafficSplitRules sets the "traffic_split_rules" field to the value that was provided on create.
+func (u *GroupUpsertOne) UpdateTrafficSplitRules() *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdateTrafficSplitRules()
+	})
+}
+
+// ClearTrafficSplitRules clears the value of the "traffic_split_rules" field.
+func (u *GroupUpsertOne) ClearTrafficSplitRules() *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.ClearTrafficSplitRules()
+	})
+}
+
+// SetModelFallbackChains sets the "model_fallback_chains" field.
+func (u *GroupUpsertOne) SetModelFallbackChains(v []model.ModelFallbackChain) *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetModelFallbackChains(v)
+	})
+}
+
+// UpdateModelFallbackChains sets the "model_fallback_chains" field to the value that was provided on create.
+func (u *GroupUpsertOne) UpdateModelFallbackChains() *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdateModelFallbackChains()
+	})
+}
+
+// ClearModelFallbackChains clears the value of the "model_fallback_chains" field.
+func (u *GroupUpsertOne) ClearModelFallbackChains() *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.ClearModelFallbackChains()
+	})
+}
+
+// SetVolumeTiers sets the "volume_tiers" field.
+func (u *GroupUpsertOne) SetVolumeTiers(v []model.VolumeTier) *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetVolumeTiers(v)
+	})
+}
+
+// UpdateVolumeTiers sets the "volume_tiers" field to the value that was provided on create.
+func (u *GroupUpsertOne) UpdateVolumeTiers() *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdateVolumeTiers()
+	})
+}
+
+// ClearVolumeTiers clears the value of the "volume_tiers" field.
+func (u *GroupUpsertOne) ClearVolumeTiers() *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.ClearVolumeTiers()
+	})
+}
+
+// SetAvailabilitySchedule sets the "availability_schedule" field.
+func (u *GroupUpsertOne) SetAvailabilitySchedule(v string) *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetAvailabilitySchedule(v)
+	})
+}
+
+// UpdateAvailabilitySchedule sets the "availability_schedule" field to the value that was provided on create.
+func (u *GroupUpsertOne) UpdateAvailabilitySchedule() *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdateAvailabilitySchedule()
+	})
+}
+
+// ClearAvailabilitySchedule clears the value of the "availability_schedule" field.
+func (u *GroupUpsertOne) ClearAvailabilitySchedule() *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.ClearAvailabilitySchedule()
+	})
+}
+
+// SetMaintenanceMode sets the "maintenance_mode" field.
+func (u *GroupUpsertOne) SetMaintenanceMode(v bool) *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetMaintenanceMode(v)
+	})
+}
+
+// UpdateMaintenanceMode sets the "maintenance_mode" field to the value that was provided on create.
+func (u *GroupUpsertOne) UpdateMaintenanceMode() *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdateMaintenanceMode()
+	})
+}
+
+// SetMaintenanceMessage sets the "maintenance_message" field.
+func (u *GroupUpsertOne) SetMaintenanceMessage(v string) *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetMaintenanceMessage(v)
+	})
+}
+
+// UpdateMaintenanceMessage sets the "maintenance_message" field to the value that was provided on create.
+func (u *GroupUpsertOne) UpdateMaintenanceMessage() *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdateMaintenanceMessage()
+	})
+}
+
+// ClearMaintenanceMessage clears the value of the "maintenance_message" field.
+func (u *GroupUpsertOne) ClearMaintenanceMessage() *GroupUpsertOne {
+	return u.Update(func(s *GroupUpsert) {
+		s.ClearMaintenanceMessage()
+	})
+}
+
 // Exec executes the query.
 func (u *GroupUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -3200,6 +3689,174 @@ func (u *GroupUpsertBulk) UpdateForceApplicationJSONForNonStream() *GroupUpsertB
 	})
 }
 
+// SetSchedulingStrategy sets the "scheduling_strategy" field.
+func (u *GroupUpsertBulk) SetSchedulingStrategy(v string) *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetSchedulingStrategy(v)
+	})
+}
+
+// UpdateSchedulingStrategy sets the "scheduling_strategy" field to the value that was provided on create.
+func (u *GroupUpsertBulk) UpdateSchedulingStrategy() *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdateSchedulingStrategy()
+	})
+}
+
+// SetHedgeEnabled sets the "hedge_enabled" field.
+func (u *GroupUpsertBulk) SetHedgeEnabled(v bool) *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetHedgeEnabled(v)
+	})
+}
+
+// UpdateHedgeEnabled sets the "hedge_enabled" field to the value that was provided on create.
+func (u *GroupUpsertBulk) UpdateHedgeEnabled() *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdateHedgeEnabled()
+	})
+}
+
+// SetHedgeDelayMs sets the "hedge_delay_ms" field.
+func (u *GroupUpsertBulk) SetHedgeDelayMs(v int) *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetHedgeDelayMs(v)
+	})
+}
+
+// AddHedgeDelayMs adds v to the "hedge_delay_ms" field.
+func (u *GroupUpsertBulk) AddHedgeDelayMs(v int) *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.AddHedgeDelayMs(v)
+	})
+}
+
+// UpdateHedgeDelayMs sets the "hedge_delay_ms" field to the value that was provided on create.
+func (u *GroupUpsertBulk) UpdateHedgeDelayMs() *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdateHedgeDelayMs()
+	})
+}
+
+// SetTrafficSplitRules sets the "traffic_split_rules" field.
+func (u *GroupUpsertBulk) SetTrafficSplitRules(v []model.TrafficSplitRule) *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetTrafficSplitRules(v)
+	})
+}
+
+// UpdateTrafficSplitRules sets the "traffic_split_rules" field to the value that was provided on create.
+func (u *GroupUpsertBulk) UpdateTrafficSplitRules() *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdateTrafficSplitRules()
+	})
+}
+
+// ClearTrafficSplitRules clears the value of the "traffic_split_rules" field.
+func (u *GroupUpsertBulk) ClearTrafficSplitRules() *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.ClearTrafficSplitRules()
+	})
+}
+
+// SetModelFallbackChains sets the "model_fallback_chains" field.
+func (u *GroupUpsertBulk) SetModelFallbackChains(v []model.ModelFallbackChain) *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetModelFallbackChains(v)
+	})
+}
+
+// UpdateModelFallbackChains sets the "model_fallback_chains" field to the value that was provided on create.
+func (u *GroupUpsertBulk) UpdateModelFallbackChains() *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdateModelFallbackChains()
+	})
+}
+
+// ClearModelFallbackChains clears the value of the "model_fallback_chains" field.
+func (u *GroupUpsertBulk) ClearModelFallbackChains() *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.ClearModelFallbackChains()
+	})
+}
+
+// SetVolumeTiers sets the "volume_tiers" field.
+func (u *GroupUpsertBulk) SetVolumeTiers(v []model.VolumeTier) *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetVolumeTiers(v)
+	})
+}
+
+// UpdateVolumeTiers sets the "volume_tiers" field to the value that was provided on create.
+func (u *GroupUpsertBulk) UpdateVolumeTiers() *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdateVolumeTiers()
+	})
+}
+
+// ClearVolumeTiers clears the value of the "volume_tiers" field.
+func (u *GroupUpsertBulk) ClearVolumeTiers() *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.ClearVolumeTiers()
+	})
+}
+
+// SetAvailabilitySchedule sets the "availability_schedule" field.
+func (u *GroupUpsertBulk) SetAvailabilitySchedule(v string) *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetAvailabilitySchedule(v)
+	})
+}
+
+// UpdateAvailabilitySchedule sets the "availability_schedule" field to the value that was provided on create.
+func (u *GroupUpsertBulk) UpdateAvailabilitySchedule() *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdateAvailabilitySchedule()
+	})
+}
+
+// ClearAvailabilitySchedule clears the value of the "availability_schedule" field.
+func (u *GroupUpsertBulk) ClearAvailabilitySchedule() *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.ClearAvailabilitySchedule()
+	})
+}
+
+// SetMaintenanceMode sets the "maintenance_mode" field.
+func (u *GroupUpsertBulk) SetMaintenanceMode(v bool) *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetMaintenanceMode(v)
+	})
+}
+
+// UpdateMaintenanceMode sets the "maintenance_mode" field to the value that was provided on create.
+func (u *GroupUpsertBulk) UpdateMaintenanceMode() *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdateMaintenanceMode()
+	})
+}
+
+// SetMaintenanceMessage sets the "maintenance_message" field.
+func (u *GroupUpsertBulk) SetMaintenanceMessage(v string) *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.SetMaintenanceMessage(v)
+	})
+}
+
+// UpdateMaintenanceMessage sets the "maintenance_message" field to the value that was provided on create.
+func (u *GroupUpsertBulk) UpdateMaintenanceMessage() *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.UpdateMaintenanceMessage()
+	})
+}
+
+// ClearMaintenanceMessage clears the value of the "maintenance_message" field.
+func (u *GroupUpsertBulk) ClearMaintenanceMessage() *GroupUpsertBulk {
+	return u.Update(func(s *GroupUpsert) {
+		s.ClearMaintenanceMessage()
+	})
+}
+
 // Exec executes the query.
 func (u *GroupUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
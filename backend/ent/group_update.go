@@ -20,6 +20,7 @@ import (
 	"github.com/Wei-Shaw/sub2api/ent/usagelog"
 	"github.com/Wei-Shaw/sub2api/ent/user"
 	"github.com/Wei-Shaw/sub2api/ent/usersubscription"
+	"github.com/Wei-Shaw/sub2api/internal/model"
 )
 
 // GroupUpdate is the builder for updating Group entities.
@@ -636,6 +637,163 @@ func (_u *GroupUpdate) SetNillableForceApplicationJSONForNonStream(v *bool) *Gro
 	return _u
 }
 
+// SetSchedulingStrategy sets the "scheduling_strategy" field.
+func (_u *GroupUpdate) SetSchedulingStrategy(v string) *GroupUpdate {
+	_u.mutation.SetSchedulingStrategy(v)
+	return _u
+}
+
+// SetNillableSchedulingStrategy sets the "scheduling_strategy" field if the given value is not nil.
+func (_u *GroupUpdate) SetNillableSchedulingStrategy(v *string) *GroupUpdate {
+	if v != nil {
+		_u.SetSchedulingStrategy(*v)
+	}
+	return _u
+}
+
+// SetHedgeEnabled sets the "hedge_enabled" field.
+func (_u *GroupUpdate) SetHedgeEnabled(v bool) *GroupUpdate {
+	_u.mutation.SetHedgeEnabled(v)
+	return _u
+}
+
+// SetNillableHedgeEnabled sets the "hedge_enabled" field if the given value is not nil.
+func (_u *GroupUpdate) SetNillableHedgeEnabled(v *bool) *GroupUpdate {
+	if v != nil {
+		_u.SetHedgeEnabled(*v)
+	}
+	return _u
+}
+
+// SetHedgeDelayMs sets the "hedge_delay_ms" field.
+func (_u *GroupUpdate) SetHedgeDelayMs(v int) *GroupUpdate {
+	_u.mutation.ResetHedgeDelayMs()
+	_u.mutation.SetHedgeDelayMs(v)
+	return _u
+}
+
+// SetNillableHedgeDelayMs sets the "hedge_delay_ms" field if the given value is not nil.
+func (_u *GroupUpdate) SetNillableHedgeDelayMs(v *int) *GroupUpdate {
+	if v != nil {
+		_u.SetHedgeDelayMs(*v)
+	}
+	return _u
+}
+
+// AddHedgeDelayMs adds value to the "hedge_delay_ms" field.
+func (_u *GroupUpdate) AddHedgeDelayMs(v int) *GroupUpdate {
+	_u.mutation.AddHedgeDelayMs(v)
+	return _u
+}
+
+// SetTrafficSplitRules sets the "traffic_split_rules" field.
+func (_u *GroupUpdate) SetTrafficSplitRules(v []model.TrafficSplitRule) *GroupUpdate {
+	_u.mutation.SetTrafficSplitRules(v)
+	return _u
+}
+
+// AppendTrafficSplitRules appends value to the "traffic_split_rules" field.
+func (_u *GroupUpdate) AppendTrafficSplitRules(v []model.TrafficSplitRule) *GroupUpdate {
+	_u.mutation.AppendTrafficSplitRules(v)
+	return _u
+}
+
+// ClearTrafficSplitRules clears the value of the "traffic_split_rules" field.
+func (_u *GroupUpdate) ClearTrafficSplitRules() *GroupUpdate {
+	_u.mutation.ClearTrafficSplitRules()
+	return _u
+}
+
+// SetModelFallbackChains sets the "model_fallback_chains" field.
+func (_u *GroupUpdate) SetModelFallbackChains(v []model.ModelFallbackChain) *GroupUpdate {
+	_u.mutation.SetModelFallbackChains(v)
+	return _u
+}
+
+// AppendModelFallbackChains appends value to the "model_fallback_chains" field.
+func (_u *GroupUpdate) AppendModelFallbackChains(v []model.ModelFallbackChain) *GroupUpdate {
+	_u.mutation.AppendModelFallbackChains(v)
+	return _u
+}
+
+// ClearModelFallbackChains clears the value of the "model_fallback_chains" field.
+func (_u *GroupUpdate) ClearModelFallbackChains() *GroupUpdate {
+	_u.mutation.ClearModelFallbackChains()
+	return _u
+}
+
+// SetVolumeTiers sets the "volume_tiers" field.
+func (_u *GroupUpdate) SetVolumeTiers(v []model.VolumeTier) *GroupUpdate {
+	_u.mutation.SetVolumeTiers(v)
+	return _u
+}
+
+// AppendVolumeTiers appends value to the "volume_tiers" field.
+func (_u *GroupUpdate) AppendVolumeTiers(v []model.VolumeTier) *GroupUpdate {
+	_u.mutation.AppendVolumeTiers(v)
+	return _u
+}
+
+// ClearVolumeTiers clears the value of the "volume_tiers" field.
+func (_u *GroupUpdate) ClearVolumeTiers() *GroupUpdate {
+	_u.mutation.ClearVolumeTiers()
+	return _u
+}
+
+// SetAvailabilitySchedule sets the "availability_schedule" field.
+func (_u *GroupUpdate) SetAvailabilitySchedule(v string) *GroupUpdate {
+	_u.mutation.SetAvailabilitySchedule(v)
+	return _u
+}
+
+// SetNillableAvailabilitySchedule sets the "availability_schedule" field if the given value is not nil.
+func (_u *GroupUpdate) SetNillableAvailabilitySchedule(v *string) *GroupUpdate {
+	if v != nil {
+		_u.SetAvailabilitySchedule(*v)
+	}
+	return _u
+}
+
+// ClearAvailabilitySchedule clears the value of the "availability_schedule" field.
+func (_u *GroupUpdate) ClearAvailabilitySchedule() *GroupUpdate {
+	_u.mutation.ClearAvailabilitySchedule()
+	return _u
+}
+
+// SetMaintenanceMode sets the "maintenance_mode" field.
+func (_u *GroupUpdate) SetMaintenanceMode(v bool) *GroupUpdate {
+	_u.mutation.SetMaintenanceMode(v)
+	return _u
+}
+
+// SetNillableMaintenanceMode sets the "maintenance_mode" field if the given value is not nil.
+func (_u *GroupUpdate) SetNillableMaintenanceMode(v *bool) *GroupUpdate {
+	if v != nil {
+		_u.SetMaintenanceMode(*v)
+	}
+	return _u
+}
+
+// SetMaintenanceMessage sets the "maintenance_message" field.
+func (_u *GroupUpdate) SetMaintenanceMessage(v string) *GroupUpdate {
+	_u.mutation.SetMaintenanceMessage(v)
+	return _u
+}
+
+// SetNillableMaintenanceMessage sets the "maintenance_message" field if the given value is not nil.
+func (_u *GroupUpdate) SetNillableMaintenanceMessage(v *string) *GroupUpdate {
+	if v != nil {
+		_u.SetMaintenanceMessage(*v)
+	}
+	return _u
+}
+
+// ClearMaintenanceMessage clears the value of the "maintenance_message" field.
+func (_u *GroupUpdate) ClearMaintenanceMessage() *GroupUpdate {
+	_u.mutation.ClearMaintenanceMessage()
+	return _u
+}
+
 // AddAPIKeyIDs adds the "api_keys" edge to the APIKey entity by IDs.
 func (_u *GroupUpdate) AddAPIKeyIDs(ids ...int64) *GroupUpdate {
 	_u.mutation.AddAPIKeyIDs(ids...)
@@ -926,6 +1084,16 @@ func (_u *GroupUpdate) check() error {
 			return &ValidationError{Name: "default_mapped_model", err: fmt.Errorf(`ent: validator failed for field "Group.default_mapped_model": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.SchedulingStrategy(); ok {
+		if err := group.SchedulingStrategyValidator(v); err != nil {
+			return &ValidationError{Name: "scheduling_strategy", err: fmt.Errorf(`ent: validator failed for field "Group.scheduling_strategy": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AvailabilitySchedule(); ok {
+		if err := group.AvailabilityScheduleValidator(v); err != nil {
+			return &ValidationError{Name: "availability_schedule", err: fmt.Errorf(`ent: validator failed for field "Group.availability_schedule": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -1114,6 +1282,66 @@ func (_u *GroupUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.ForceApplicationJSONForNonStream(); ok {
 		_spec.SetField(group.FieldForceApplicationJSONForNonStream, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.SchedulingStrategy(); ok {
+		_spec.SetField(group.FieldSchedulingStrategy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.HedgeEnabled(); ok {
+		_spec.SetField(group.FieldHedgeEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.HedgeDelayMs(); ok {
+		_spec.SetField(group.FieldHedgeDelayMs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedHedgeDelayMs(); ok {
+		_spec.AddField(group.FieldHedgeDelayMs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TrafficSplitRules(); ok {
+		_spec.SetField(group.FieldTrafficSplitRules, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTrafficSplitRules(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, group.FieldTrafficSplitRules, value)
+		})
+	}
+	if _u.mutation.TrafficSplitRulesCleared() {
+		_spec.ClearField(group.FieldTrafficSplitRules, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ModelFallbackChains(); ok {
+		_spec.SetField(group.FieldModelFallbackChains, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedModelFallbackChains(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, group.FieldModelFallbackChains, value)
+		})
+	}
+	if _u.mutation.ModelFallbackChainsCleared() {
+		_spec.ClearField(group.FieldModelFallbackChains, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.VolumeTiers(); ok {
+		_spec.SetField(group.FieldVolumeTiers, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedVolumeTiers(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, group.FieldVolumeTiers, value)
+		})
+	}
+	if _u.mutation.VolumeTiersCleared() {
+		_spec.ClearField(group.FieldVolumeTiers, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AvailabilitySchedule(); ok {
+		_spec.SetField(group.FieldAvailabilitySchedule, field.TypeString, value)
+	}
+	if _u.mutation.AvailabilityScheduleCleared() {
+		_spec.ClearField(group.FieldAvailabilitySchedule, field.TypeString)
+	}
+	if value, ok := _u.mutation.MaintenanceMode(); ok {
+		_spec.SetField(group.FieldMaintenanceMode, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.MaintenanceMessage(); ok {
+		_spec.SetField(group.FieldMaintenanceMessage, field.TypeString, value)
+	}
+	if _u.mutation.MaintenanceMessageCleared() {
+		_spec.ClearField(group.FieldMaintenanceMessage, field.TypeString)
+	}
 	if _u.mutation.APIKeysCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -2029,6 +2257,163 @@ func (_u *GroupUpdateOne) SetNillableForceApplicationJSONForNonStream(v *bool) *
 	return _u
 }
 
+// SetSchedulingStrategy sets the "scheduling_strategy" field.
+func (_u *GroupUpdateOne) SetSchedulingStrategy(v string) *GroupUpdateOne {
+	_u.mutation.SetSchedulingStrategy(v)
+	return _u
+}
+
+// SetNillableSchedulingStrategy sets the "scheduling_strategy" field if the given value is not nil.
+func (_u *GroupUpdateOne) SetNillableSchedulingStrategy(v *string) *GroupUpdateOne {
+	if v != nil {
+		_u.SetSchedulingStrategy(*v)
+	}
+	return _u
+}
+
+// SetHedgeEnabled sets the "hedge_enabled" field.
+func (_u *GroupUpdateOne) SetHedgeEnabled(v bool) *GroupUpdateOne {
+	_u.mutation.SetHedgeEnabled(v)
+	return _u
+}
+
+// SetNillableHedgeEnabled sets the "hedge_enabled" field if the given value is not nil.
+func (_u *GroupUpdateOne) SetNillableHedgeEnabled(v *bool) *GroupUpdateOne {
+	if v != nil {
+		_u.SetHedgeEnabled(*v)
+	}
+	return _u
+}
+
+// SetHedgeDelayMs sets the "hedge_delay_ms" field.
+func (_u *GroupUpdateOne) SetHedgeDelayMs(v int) *GroupUpdateOne {
+	_u.mutation.ResetHedgeDelayMs()
+	_u.mutation.SetHedgeDelayMs(v)
+	return _u
+}
+
+// SetNillableHedgeDelayMs sets the "hedge_delay_ms" field if the given value is not nil.
+func (_u *GroupUpdateOne) SetNillableHedgeDelayMs(v *int) *GroupUpdateOne {
+	if v != nil {
+		_u.SetHedgeDelayMs(*v)
+	}
+	return _u
+}
+
+// AddHedgeDelayMs adds value to the "hedge_delay_ms" field.
+func (_u *GroupUpdateOne) AddHedgeDelayMs(v int) *GroupUpdateOne {
+	_u.mutation.AddHedgeDelayMs(v)
+	return _u
+}
+
+// SetTrafficSplitRules sets the "traffic_split_rules" field.
+func (_u *GroupUpdateOne) SetTrafficSplitRules(v []model.TrafficSplitRule) *GroupUpdateOne {
+	_u.mutation.SetTrafficSplitRules(v)
+	return _u
+}
+
+// AppendTrafficSplitRules appends value to the "traffic_split_rules" field.
+func (_u *GroupUpdateOne) AppendTrafficSplitRules(v []model.TrafficSplitRule) *GroupUpdateOne {
+	_u.mutation.AppendTrafficSplitRules(v)
+	return _u
+}
+
+// ClearTrafficSplitRules clears the value of the "traffic_split_rules" field.
+func (_u *GroupUpdateOne) ClearTrafficSplitRules() *GroupUpdateOne {
+	_u.mutation.ClearTrafficSplitRules()
+	return _u
+}
+
+// SetModelFallbackChains sets the "model_fallback_chains" field.
+func (_u *GroupUpdateOne) SetModelFallbackChains(v []model.ModelFallbackChain) *GroupUpdateOne {
+	_u.mutation.SetModelFallbackChains(v)
+	return _u
+}
+
+// AppendModelFallbackChains appends value to the "model_fallback_chains" field.
+func (_u *GroupUpdateOne) AppendModelFallbackChains(v []model.ModelFallbackChain) *GroupUpdateOne {
+	_u.mutation.AppendModelFallbackChains(v)
+	return _u
+}
+
+// ClearModelFallbackChains clears the value of the "model_fallback_chains" field.
+func (_u *GroupUpdateOne) ClearModelFallbackChains() *GroupUpdateOne {
+	_u.mutation.ClearModelFallbackChains()
+	return _u
+}
+
+// SetVolumeTiers sets the "volume_tiers" field.
+func (_u *GroupUpdateOne) SetVolumeTiers(v []model.VolumeTier) *GroupUpdateOne {
+	_u.mutation.SetVolumeTiers(v)
+	return _u
+}
+
+// AppendVolumeTiers appends value to the "volume_tiers" field.
+func (_u *GroupUpdateOne) AppendVolumeTiers(v []model.VolumeTier) *GroupUpdateOne {
+	_u.mutation.AppendVolumeTiers(v)
+	return _u
+}
+
+// ClearVolumeTiers clears the value of the "volume_tiers" field.
+func (_u *GroupUpdateOne) ClearVolumeTiers() *GroupUpdateOne {
+	_u.mutation.ClearVolumeTiers()
+	return _u
+}
+
+// SetAvailabilitySchedule sets the "availability_schedule" field.
+func (_u *GroupUpdateOne) SetAvailabilitySchedule(v string) *GroupUpdateOne {
+	_u.mutation.SetAvailabilitySchedule(v)
+	return _u
+}
+
+// SetNillableAvailabilitySchedule sets the "availability_schedule" field if the given value is not nil.
+func (_u *GroupUpdateOne) SetNillableAvailabilitySchedule(v *string) *GroupUpdateOne {
+	if v != nil {
+		_u.SetAvailabilitySchedule(*v)
+	}
+	return _u
+}
+
+// ClearAvailabilitySchedule clears the value of the "availability_schedule" field.
+func (_u *GroupUpdateOne) ClearAvailabilitySchedule() *GroupUpdateOne {
+	_u.mutation.ClearAvailabilitySchedule()
+	return _u
+}
+
+// SetMaintenanceMode sets the "maintenance_mode" field.
+func (_u *GroupUpdateOne) SetMaintenanceMode(v bool) *GroupUpdateOne {
+	_u.mutation.SetMaintenanceMode(v)
+	return _u
+}
+
+// SetNillableMaintenanceMode sets the "maintenance_mode" field if the given value is not nil.
+func (_u *GroupUpdateOne) SetNillableMaintenanceMode(v *bool) *GroupUpdateOne {
+	if v != nil {
+		_u.SetMaintenanceMode(*v)
+	}
+	return _u
+}
+
+// SetMaintenanceMessage sets the "maintenance_message" field.
+func (_u *GroupUpdateOne) SetMaintenanceMessage(v string) *GroupUpdateOne {
+	_u.mutation.SetMaintenanceMessage(v)
+	return _u
+}
+
+// SetNillableMaintenanceMessage sets the "maintenance_message" field if the given value is not nil.
+func (_u *GroupUpdateOne) SetNillableMaintenanceMessage(v *string) *GroupUpdateOne {
+	if v != nil {
+		_u.SetMaintenanceMessage(*v)
+	}
+	return _u
+}
+
+// ClearMaintenanceMessage clears the value of the "maintenance_message" field.
+func (_u *GroupUpdateOne) ClearMaintenanceMessage() *GroupUpdateOne {
+	_u.mutation.ClearMaintenanceMessage()
+	return _u
+}
+
 // AddAPIKeyIDs adds the "api_keys" edge to the APIKey entity by IDs.
 func (_u *GroupUpdateOne) AddAPIKeyIDs(ids ...int64) *GroupUpdateOne {
 	_u.mutation.AddAPIKeyIDs(ids...)
@@ -2332,6 +2717,16 @@ func (_u *GroupUpdateOne) check() error {
 			return &ValidationError{Name: "default_mapped_model", err: fmt.Errorf(`ent: validator failed for field "Group.default_mapped_model": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.SchedulingStrategy(); ok {
+		if err := group.SchedulingStrategyValidator(v); err != nil {
+			return &ValidationError{Name: "scheduling_strategy", err: fmt.Errorf(`ent: validator failed for field "Group.scheduling_strategy": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AvailabilitySchedule(); ok {
+		if err := group.AvailabilityScheduleValidator(v); err != nil {
+			return &ValidationError{Name: "availability_schedule", err: fmt.Errorf(`ent: validator failed for field "Group.availability_schedule": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -2537,6 +2932,66 @@ func (_u *GroupUpdateOne) sqlSave(ctx context.Context) (_node *Group, err error)
 	if value, ok := _u.mutation.ForceApplicationJSONForNonStream(); ok {
 		_spec.SetField(group.FieldForceApplicationJSONForNonStream, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.SchedulingStrategy(); ok {
+		_spec.SetField(group.FieldSchedulingStrategy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.HedgeEnabled(); ok {
+		_spec.SetField(group.FieldHedgeEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.HedgeDelayMs(); ok {
+		_spec.SetField(group.FieldHedgeDelayMs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedHedgeDelayMs(); ok {
+		_spec.AddField(group.FieldHedgeDelayMs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TrafficSplitRules(); ok {
+		_spec.SetField(group.FieldTrafficSplitRules, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTrafficSplitRules(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, group.FieldTrafficSplitRules, value)
+		})
+	}
+	if _u.mutation.TrafficSplitRulesCleared() {
+		_spec.ClearField(group.FieldTrafficSplitRules, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ModelFallbackChains(); ok {
+		_spec.SetField(group.FieldModelFallbackChains, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedModelFallbackChains(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, group.FieldModelFallbackChains, value)
+		})
+	}
+	if _u.mutation.ModelFallbackChainsCleared() {
+		_spec.ClearField(group.FieldModelFallbackChains, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.VolumeTiers(); ok {
+		_spec.SetField(group.FieldVolumeTiers, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedVolumeTiers(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, group.FieldVolumeTiers, value)
+		})
+	}
+	if _u.mutation.VolumeTiersCleared() {
+		_spec.ClearField(group.FieldVolumeTiers, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AvailabilitySchedule(); ok {
+		_spec.SetField(group.FieldAvailabilitySchedule, field.TypeString, value)
+	}
+	if _u.mutation.AvailabilityScheduleCleared() {
+		_spec.ClearField(group.FieldAvailabilitySchedule, field.TypeString)
+	}
+	if value, ok := _u.mutation.MaintenanceMode(); ok {
+		_spec.SetField(group.FieldMaintenanceMode, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.MaintenanceMessage(); ok {
+		_spec.SetField(group.FieldMaintenanceMessage, field.TypeString, value)
+	}
+	if _u.mutation.MaintenanceMessageCleared() {
+		_spec.ClearField(group.FieldMaintenanceMessage, field.TypeString)
+	}
 	if _u.mutation.APIKeysCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -0,0 +1,1211 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Wei-Shaw/sub2api/ent/guardrailrule"
+)
+
+// GuardrailRuleCreate is the builder for creating a GuardrailRule entity.
+type GuardrailRuleCreate struct {
+	config
+	mutation *GuardrailRuleMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *GuardrailRuleCreate) SetCreatedAt(v time.Time) *GuardrailRuleCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *GuardrailRuleCreate) SetNillableCreatedAt(v *time.Time) *GuardrailRuleCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *GuardrailRuleCreate) SetUpdatedAt(v time.Time) *GuardrailRuleCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *GuardrailRuleCreate) SetNillableUpdatedAt(v *time.Time) *GuardrailRuleCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *GuardrailRuleCreate) SetName(v string) *GuardrailRuleCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetEnabled sets the "enabled" field.
+func (_c *GuardrailRuleCreate) SetEnabled(v bool) *GuardrailRuleCreate {
+	_c.mutation.SetEnabled(v)
+	return _c
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_c *GuardrailRuleCreate) SetNillableEnabled(v *bool) *GuardrailRuleCreate {
+	if v != nil {
+		_c.SetEnabled(*v)
+	}
+	return _c
+}
+
+// SetPriority sets the "priority" field.
+func (_c *GuardrailRuleCreate) SetPriority(v int) *GuardrailRuleCreate {
+	_c.mutation.SetPriority(v)
+	return _c
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_c *GuardrailRuleCreate) SetNillablePriority(v *int) *GuardrailRuleCreate {
+	if v != nil {
+		_c.SetPriority(*v)
+	}
+	return _c
+}
+
+// SetPlatforms sets the "platforms" field.
+func (_c *GuardrailRuleCreate) SetPlatforms(v []string) *GuardrailRuleCreate {
+	_c.mutation.SetPlatforms(v)
+	return _c
+}
+
+// SetGroupIds sets the "group_ids" field.
+func (_c *GuardrailRuleCreate) SetGroupIds(v []int64) *GuardrailRuleCreate {
+	_c.mutation.SetGroupIds(v)
+	return _c
+}
+
+// SetType sets the "type" field.
+func (_c *GuardrailRuleCreate) SetType(v string) *GuardrailRuleCreate {
+	_c.mutation.SetType(v)
+	return _c
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_c *GuardrailRuleCreate) SetNillableType(v *string) *GuardrailRuleCreate {
+	if v != nil {
+		_c.SetType(*v)
+	}
+	return _c
+}
+
+// SetPatterns sets the "patterns" field.
+func (_c *GuardrailRuleCreate) SetPatterns(v []string) *GuardrailRuleCreate {
+	_c.mutation.SetPatterns(v)
+	return _c
+}
+
+// SetCaseSensitive sets the "case_sensitive" field.
+func (_c *GuardrailRuleCreate) SetCaseSensitive(v bool) *GuardrailRuleCreate {
+	_c.mutation.SetCaseSensitive(v)
+	return _c
+}
+
+// SetNillableCaseSensitive sets the "case_sensitive" field if the given value is not nil.
+func (_c *GuardrailRuleCreate) SetNillableCaseSensitive(v *bool) *GuardrailRuleCreate {
+	if v != nil {
+		_c.SetCaseSensitive(*v)
+	}
+	return _c
+}
+
+// SetAction sets the "action" field.
+func (_c *GuardrailRuleCreate) SetAction(v string) *GuardrailRuleCreate {
+	_c.mutation.SetAction(v)
+	return _c
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_c *GuardrailRuleCreate) SetNillableAction(v *string) *GuardrailRuleCreate {
+	if v != nil {
+		_c.SetAction(*v)
+	}
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *GuardrailRuleCreate) SetDescription(v string) *GuardrailRuleCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *GuardrailRuleCreate) SetNillableDescription(v *string) *GuardrailRuleCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// Mutation returns the GuardrailRuleMutation object of the builder.
+func (_c *GuardrailRuleCreate) Mutation() *GuardrailRuleMutation {
+	return _c.mutation
+}
+
+// Save creates the GuardrailRule in the database.
+func (_c *GuardrailRuleCreate) Save(ctx context.Context) (*GuardrailRule, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *GuardrailRuleCreate) SaveX(ctx context.Context) *GuardrailRule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *GuardrailRuleCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *GuardrailRuleCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *GuardrailRuleCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := guardrailrule.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := guardrailrule.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		v := guardrailrule.DefaultEnabled
+		_c.mutation.SetEnabled(v)
+	}
+	if _, ok := _c.mutation.Priority(); !ok {
+		v := guardrailrule.DefaultPriority
+		_c.mutation.SetPriority(v)
+	}
+	if _, ok := _c.mutation.GetType(); !ok {
+		v := guardrailrule.DefaultType
+		_c.mutation.SetType(v)
+	}
+	if _, ok := _c.mutation.CaseSensitive(); !ok {
+		v := guardrailrule.DefaultCaseSensitive
+		_c.mutation.SetCaseSensitive(v)
+	}
+	if _, ok := _c.mutation.Action(); !ok {
+		v := guardrailrule.DefaultAction
+		_c.mutation.SetAction(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *GuardrailRuleCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "GuardrailRule.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "GuardrailRule.updated_at"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "GuardrailRule.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := guardrailrule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "GuardrailRule.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "GuardrailRule.enabled"`)}
+	}
+	if _, ok := _c.mutation.Priority(); !ok {
+		return &ValidationError{Name: "priority", err: errors.New(`ent: missing required field "GuardrailRule.priority"`)}
+	}
+	if _, ok := _c.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "GuardrailRule.type"`)}
+	}
+	if v, ok := _c.mutation.GetType(); ok {
+		if err := guardrailrule.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "GuardrailRule.type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CaseSensitive(); !ok {
+		return &ValidationError{Name: "case_sensitive", err: errors.New(`ent: missing required field "GuardrailRule.case_sensitive"`)}
+	}
+	if _, ok := _c.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "GuardrailRule.action"`)}
+	}
+	if v, ok := _c.mutation.Action(); ok {
+		if err := guardrailrule.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "GuardrailRule.action": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *GuardrailRuleCreate) sqlSave(ctx context.Context) (*GuardrailRule, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int64(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *GuardrailRuleCreate) createSpec() (*GuardrailRule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &GuardrailRule{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(guardrailrule.Table, sqlgraph.NewFieldSpec(guardrailrule.FieldID, field.TypeInt64))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(guardrailrule.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(guardrailrule.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(guardrailrule.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Enabled(); ok {
+		_spec.SetField(guardrailrule.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := _c.mutation.Priority(); ok {
+		_spec.SetField(guardrailrule.FieldPriority, field.TypeInt, value)
+		_node.Priority = value
+	}
+	if value, ok := _c.mutation.Platforms(); ok {
+		_spec.SetField(guardrailrule.FieldPlatforms, field.TypeJSON, value)
+		_node.Platforms = value
+	}
+	if value, ok := _c.mutation.GroupIds(); ok {
+		_spec.SetField(guardrailrule.FieldGroupIds, field.TypeJSON, value)
+		_node.GroupIds = value
+	}
+	if value, ok := _c.mutation.GetType(); ok {
+		_spec.SetField(guardrailrule.FieldType, field.TypeString, value)
+		_node.Type = value
+	}
+	if value, ok := _c.mutation.Patterns(); ok {
+		_spec.SetField(guardrailrule.FieldPatterns, field.TypeJSON, value)
+		_node.Patterns = value
+	}
+	if value, ok := _c.mutation.CaseSensitive(); ok {
+		_spec.SetField(guardrailrule.FieldCaseSensitive, field.TypeBool, value)
+		_node.CaseSensitive = value
+	}
+	if value, ok := _c.mutation.Action(); ok {
+		_spec.SetField(guardrailrule.FieldAction, field.TypeString, value)
+		_node.Action = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(guardrailrule.FieldDescription, field.TypeString, value)
+		_node.Description = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.GuardrailRule.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.GuardrailRuleUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *GuardrailRuleCreate) OnConflict(opts ...sql.ConflictOption) *GuardrailRuleUpsertOne {
+	_c.conflict = opts
+	return &GuardrailRuleUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.GuardrailRule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *GuardrailRuleCreate) OnConflictColumns(columns ...string) *GuardrailRuleUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &GuardrailRuleUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// GuardrailRuleUpsertOne is the builder for "upsert"-ing
+	//  one GuardrailRule node.
+	GuardrailRuleUpsertOne struct {
+		create *GuardrailRuleCreate
+	}
+
+	// GuardrailRuleUpsert is the "OnConflict" setter.
+	GuardrailRuleUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *GuardrailRuleUpsert) SetUpdatedAt(v time.Time) *GuardrailRuleUpsert {
+	u.Set(guardrailrule.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *GuardrailRuleUpsert) UpdateUpdatedAt() *GuardrailRuleUpsert {
+	u.SetExcluded(guardrailrule.FieldUpdatedAt)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *GuardrailRuleUpsert) SetName(v string) *GuardrailRuleUpsert {
+	u.Set(guardrailrule.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *GuardrailRuleUpsert) UpdateName() *GuardrailRuleUpsert {
+	u.SetExcluded(guardrailrule.FieldName)
+	return u
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *GuardrailRuleUpsert) SetEnabled(v bool) *GuardrailRuleUpsert {
+	u.Set(guardrailrule.FieldEnabled, v)
+	return u
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *GuardrailRuleUpsert) UpdateEnabled() *GuardrailRuleUpsert {
+	u.SetExcluded(guardrailrule.FieldEnabled)
+	return u
+}
+
+// SetPriority sets the "priority" field.
+func (u *GuardrailRuleUpsert) SetPriority(v int) *GuardrailRuleUpsert {
+	u.Set(guardrailrule.FieldPriority, v)
+	return u
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *GuardrailRuleUpsert) UpdatePriority() *GuardrailRuleUpsert {
+	u.SetExcluded(guardrailrule.FieldPriority)
+	return u
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *GuardrailRuleUpsert) AddPriority(v int) *GuardrailRuleUpsert {
+	u.Add(guardrailrule.FieldPriority, v)
+	return u
+}
+
+// SetPlatforms sets the "platforms" field.
+func (u *GuardrailRuleUpsert) SetPlatforms(v []string) *GuardrailRuleUpsert {
+	u.Set(guardrailrule.FieldPlatforms, v)
+	return u
+}
+
+// UpdatePlatforms sets the "platforms" field to the value that was provided on create.
+func (u *GuardrailRuleUpsert) UpdatePlatforms() *GuardrailRuleUpsert {
+	u.SetExcluded(guardrailrule.FieldPlatforms)
+	return u
+}
+
+// ClearPlatforms clears the value of the "platforms" field.
+func (u *GuardrailRuleUpsert) ClearPlatforms() *GuardrailRuleUpsert {
+	u.SetNull(guardrailrule.FieldPlatforms)
+	return u
+}
+
+// SetGroupIds sets the "group_ids" field.
+func (u *GuardrailRuleUpsert) SetGroupIds(v []int64) *GuardrailRuleUpsert {
+	u.Set(guardrailrule.FieldGroupIds, v)
+	return u
+}
+
+// UpdateGroupIds sets the "group_ids" field to the value that was provided on create.
+func (u *GuardrailRuleUpsert) UpdateGroupIds() *GuardrailRuleUpsert {
+	u.SetExcluded(guardrailrule.FieldGroupIds)
+	return u
+}
+
+// ClearGroupIds clears the value of the "group_ids" field.
+func (u *GuardrailRuleUpsert) ClearGroupIds() *GuardrailRuleUpsert {
+	u.SetNull(guardrailrule.FieldGroupIds)
+	return u
+}
+
+// SetType sets the "type" field.
+func (u *GuardrailRuleUpsert) SetType(v string) *GuardrailRuleUpsert {
+	u.Set(guardrailrule.FieldType, v)
+	return u
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *GuardrailRuleUpsert) UpdateType() *GuardrailRuleUpsert {
+	u.SetExcluded(guardrailrule.FieldType)
+	return u
+}
+
+// SetPatterns sets the "patterns" field.
+func (u *GuardrailRuleUpsert) SetPatterns(v []string) *GuardrailRuleUpsert {
+	u.Set(guardrailrule.FieldPatterns, v)
+	return u
+}
+
+// UpdatePatterns sets the "patterns" field to the value that was provided on create.
+func (u *GuardrailRuleUpsert) UpdatePatterns() *GuardrailRuleUpsert {
+	u.SetExcluded(guardrailrule.FieldPatterns)
+	return u
+}
+
+// ClearPatterns clears the value of the "patterns" field.
+func (u *GuardrailRuleUpsert) ClearPatterns() *GuardrailRuleUpsert {
+	u.SetNull(guardrailrule.FieldPatterns)
+	return u
+}
+
+// SetCaseSensitive sets the "case_sensitive" field.
+func (u *GuardrailRuleUpsert) SetCaseSensitive(v bool) *GuardrailRuleUpsert {
+	u.Set(guardrailrule.FieldCaseSensitive, v)
+	return u
+}
+
+// UpdateCaseSensitive sets the "case_sensitive" field to the value that was provided on create.
+func (u *GuardrailRuleUpsert) UpdateCaseSensitive() *GuardrailRuleUpsert {
+	u.SetExcluded(guardrailrule.FieldCaseSensitive)
+	return u
+}
+
+// SetAction sets the "action" field.
+func (u *GuardrailRuleUpsert) SetAction(v string) *GuardrailRuleUpsert {
+	u.Set(guardrailrule.FieldAction, v)
+	return u
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *GuardrailRuleUpsert) UpdateAction() *GuardrailRuleUpsert {
+	u.SetExcluded(guardrailrule.FieldAction)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *GuardrailRuleUpsert) SetDescription(v string) *GuardrailRuleUpsert {
+	u.Set(guardrailrule.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *GuardrailRuleUpsert) UpdateDescription() *GuardrailRuleUpsert {
+	u.SetExcluded(guardrailrule.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *GuardrailRuleUpsert) ClearDescription() *GuardrailRuleUpsert {
+	u.SetNull(guardrailrule.FieldDescription)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.GuardrailRule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *GuardrailRuleUpsertOne) UpdateNewValues() *GuardrailRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(guardrailrule.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.GuardrailRule.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *GuardrailRuleUpsertOne) Ignore() *GuardrailRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *GuardrailRuleUpsertOne) DoNothing() *GuardrailRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the GuardrailRuleCreate.OnConflict
+// documentation for more info.
+func (u *GuardrailRuleUpsertOne) Update(set func(*GuardrailRuleUpsert)) *GuardrailRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&GuardrailRuleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *GuardrailRuleUpsertOne) SetUpdatedAt(v time.Time) *GuardrailRuleUpsertOne {
+	return u.Update(func(s *GuardrailRuleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *GuardrailRuleUpsertOne) UpdateUpdatedAt() *GuardrailRuleUpsertOne {
+	return u.Update(func(s *GuardrailRuleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *GuardrailRuleUpsertOne) SetName(v string) *GuardrailRuleUpsertOne {
+	return u.Update(func(s *GuardrailRuleUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *GuardrailRuleUpsertOne) UpdateName() *GuardrailRuleUpsertOne {
+	return u.Update(func(s *GuardrailRuleUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *GuardrailRuleUpsertOne) SetEnabled(v bool) *GuardrailRuleUpsertOne {
+	return u.Update(func(s *GuardrailRuleUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *GuardrailRuleUpsertOne) UpdateEnabled() *GuardrailRuleUpsertOne {
+	return u.Update(func(s *GuardrailRuleUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetPriority sets the "priority" field.
+func (u *GuardrailRuleUpsertOne) SetPriority(v int) *GuardrailRuleUpsertOne {
+	return u.Update(func(s *GuardrailRuleUpsert) {
+		s.SetPriority(v)
+	})
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *GuardrailRuleUpsertOne) AddPriority(v int) *GuardrailRuleUpsertOne {
+	return u.Update(func(s *GuardrailRuleUpsert) {
+		s.AddPriority(v)
+	})
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *GuardrailRuleUpsertOne) UpdatePriority() *GuardrailRuleUpsertOne {
+	return u.Update(func(s *GuardrailRuleUpsert) {
+		s.UpdatePriority()
+	})
+}
+
+// SetPlatforms sets the "platforms" field.
+func (u *GuardrailRuleUpsertOne) SetPlatforms(v []string) *GuardrailRuleUpsertOne {
+	return u.Update(func(s *GuardrailRuleUpsert) {
+		s.SetPlatforms(v)
+	})
+}
+
+// UpdatePlatforms sets the "platforms" field to the value that was provided on create.
+func (u *GuardrailRuleUpsertOne) UpdatePlatforms() *GuardrailRuleUpsertOne {
+	return u.Update(func(s *GuardrailRuleUpsert) {
+		s.UpdatePlatforms()
+	})
+}
+
+// ClearPlatforms clears the value of the "platforms" field.
+func (u *GuardrailRuleUpsertOne) ClearPlatforms() *GuardrailRuleUpsertOne {
+	return u.Update(func(s *GuardrailRuleUpsert) {
+		s.ClearPlatforms()
+	})
+}
+
+// SetGroupIds sets the "group_ids" field.
+func (u *GuardrailRuleUpsertOne) SetGroupIds(v []int64) *GuardrailRuleUpsertOne {
+	return u.Update(func(s *GuardrailRuleUpsert) {
+		s.SetGroupIds(v)
+	})
+}
+
+// UpdateGroupIds sets the "group_ids" field to the value that was provided on create.
+func (u *GuardrailRuleUpsertOne) UpdateGroupIds() *GuardrailRuleUpsertOne {
+	return u.Update(func(s *GuardrailRuleUpsert) {
+		s.UpdateGroupIds()
+	})
+}
+
+// ClearGroupIds clears the value of the "group_ids" field.
+func (u *GuardrailRuleUpsertOne) ClearGroupIds() *GuardrailRuleUpsertOne {
+	return u.Update(func(s *GuardrailRuleUpsert) {
+		s.ClearGroupIds()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *GuardrailRuleUpsertOne) SetType(v string) *GuardrailRuleUpsertOne {
+	return u.Update(func(s *GuardrailRuleUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *GuardrailRuleUpsertOne) UpdateType() *GuardrailRuleUpsertOne {
+	return u.Update(func(s *GuardrailRuleUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetPatterns sets the "patterns" field.
+func (u *GuardrailRuleUpsertOne) SetPatterns(v []string) *GuardrailRuleUpsertOne {
+	return u.Update(func(s *GuardrailRuleUpsert) {
+		s.SetPatterns(v)
+	})
+}
+
+// UpdatePatterns sets the "patterns" field to the value that was provided on create.
+func (u *GuardrailRuleUpsertOne) UpdatePatterns() *GuardrailRuleUpsertOne {
+	return u.Update(func(s *GuardrailRuleUpsert) {
+		s.UpdatePatterns()
+	})
+}
+
+// ClearPatterns clears the value of the "patterns" field.
+func (u *GuardrailRuleUpsertOne) ClearPatterns() *GuardrailRuleUpsertOne {
+	return u.Update(func(s *GuardrailRuleUpsert) {
+		s.ClearPatterns()
+	})
+}
+
+// SetCaseSensitive sets the "case_sensitive" field.
+func (u *GuardrailRuleUpsertOne) SetCaseSensitive(v bool) *GuardrailRuleUpsertOne {
+	return u.Update(func(s *GuardrailRuleUpsert) {
+		s.SetCaseSensitive(v)
+	})
+}
+
+// UpdateCaseSensitive sets the "case_sensitive" field to the value that was provided on create.
+func (u *GuardrailRuleUpsertOne) UpdateCaseSensitive() *GuardrailRuleUpsertOne {
+	return u.Update(func(s *GuardrailRuleUpsert) {
+		s.UpdateCaseSensitive()
+	})
+}
+
+// SetAction sets the "action" field.
+func (u *GuardrailRuleUpsertOne) SetAction(v string) *GuardrailRuleUpsertOne {
+	return u.Update(func(s *GuardrailRuleUpsert) {
+		s.SetAction(v)
+	})
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *GuardrailRuleUpsertOne) UpdateAction() *GuardrailRuleUpsertOne {
+	return u.Update(func(s *GuardrailRuleUpsert) {
+		s.UpdateAction()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *GuardrailRuleUpsertOne) SetDescription(v string) *GuardrailRuleUpsertOne {
+	return u.Update(func(s *GuardrailRuleUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *GuardrailRuleUpsertOne) UpdateDescription() *GuardrailRuleUpsertOne {
+	return u.Update(func(s *GuardrailRuleUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *GuardrailRuleUpsertOne) ClearDescription() *GuardrailRuleUpsertOne {
+	return u.Update(func(s *GuardrailRuleUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// Exec executes the query.
+func (u *GuardrailRuleUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for GuardrailRuleCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *GuardrailRuleUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *GuardrailRuleUpsertOne) ID(ctx context.Context) (id int64, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *GuardrailRuleUpsertOne) IDX(ctx context.Context) int64 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// GuardrailRuleCreateBulk is the builder for creating many GuardrailRule entities in bulk.
+type GuardrailRuleCreateBulk struct {
+	config
+	err      error
+	builders []*GuardrailRuleCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the GuardrailRule entities in the database.
+func (_c *GuardrailRuleCreateBulk) Save(ctx context.Context) ([]*GuardrailRule, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*GuardrailRule, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*GuardrailRuleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *GuardrailRuleCreateBulk) SaveX(ctx context.Context) []*GuardrailRule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *GuardrailRuleCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *GuardrailRuleCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.GuardrailRule.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.GuardrailRuleUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *GuardrailRuleCreateBulk) OnConflict(opts ...sql.ConflictOption) *GuardrailRuleUpsertBulk {
+	_c.conflict = opts
+	return &GuardrailRuleUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.GuardrailRule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *GuardrailRuleCreateBulk) OnConflictColumns(columns ...string) *GuardrailRuleUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &GuardrailRuleUpsertBulk{
+		create: _c,
+	}
+}
+
+// GuardrailRuleUpsertBulk is the builder for "upsert"-ing
+// a bulk of GuardrailRule nodes.
+type GuardrailRuleUpsertBulk struct {
+	create *GuardrailRuleCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.GuardrailRule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *GuardrailRuleUpsertBulk) UpdateNewValues() *GuardrailRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(guardrailrule.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.GuardrailRule.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *GuardrailRuleUpsertBulk) Ignore() *GuardrailRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *GuardrailRuleUpsertBulk) DoNothing() *GuardrailRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the GuardrailRuleCreateBulk.OnConflict
+// documentation for more info.
+func (u *GuardrailRuleUpsertBulk) Update(set func(*GuardrailRuleUpsert)) *GuardrailRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&GuardrailRuleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *GuardrailRuleUpsertBulk) SetUpdatedAt(v time.Time) *GuardrailRuleUpsertBulk {
+	return u.Update(func(s *GuardrailRuleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *GuardrailRuleUpsertBulk) UpdateUpdatedAt() *GuardrailRuleUpsertBulk {
+	return u.Update(func(s *GuardrailRuleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *GuardrailRuleUpsertBulk) SetName(v string) *GuardrailRuleUpsertBulk {
+	return u.Update(func(s *GuardrailRuleUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *GuardrailRuleUpsertBulk) UpdateName() *GuardrailRuleUpsertBulk {
+	return u.Update(func(s *GuardrailRuleUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *GuardrailRuleUpsertBulk) SetEnabled(v bool) *GuardrailRuleUpsertBulk {
+	return u.Update(func(s *GuardrailRuleUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *GuardrailRuleUpsertBulk) UpdateEnabled() *GuardrailRuleUpsertBulk {
+	return u.Update(func(s *GuardrailRuleUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetPriority sets the "priority" field.
+func (u *GuardrailRuleUpsertBulk) SetPriority(v int) *GuardrailRuleUpsertBulk {
+	return u.Update(func(s *GuardrailRuleUpsert) {
+		s.SetPriority(v)
+	})
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *GuardrailRuleUpsertBulk) AddPriority(v int) *GuardrailRuleUpsertBulk {
+	return u.Update(func(s *GuardrailRuleUpsert) {
+		s.AddPriority(v)
+	})
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *GuardrailRuleUpsertBulk) UpdatePriority() *GuardrailRuleUpsertBulk {
+	return u.Update(func(s *GuardrailRuleUpsert) {
+		s.UpdatePriority()
+	})
+}
+
+// SetPlatforms sets the "platforms" field.
+func (u *GuardrailRuleUpsertBulk) SetPlatforms(v []string) *GuardrailRuleUpsertBulk {
+	return u.Update(func(s *GuardrailRuleUpsert) {
+		s.SetPlatforms(v)
+	})
+}
+
+// UpdatePlatforms sets the "platforms" field to the value that was provided on create.
+func (u *GuardrailRuleUpsertBulk) UpdatePlatforms() *GuardrailRuleUpsertBulk {
+	return u.Update(func(s *GuardrailRuleUpsert) {
+		s.UpdatePlatforms()
+	})
+}
+
+// ClearPlatforms clears the value of the "platforms" field.
+func (u *GuardrailRuleUpsertBulk) ClearPlatforms() *GuardrailRuleUpsertBulk {
+	return u.Update(func(s *GuardrailRuleUpsert) {
+		s.ClearPlatforms()
+	})
+}
+
+// SetGroupIds sets the "group_ids" field.
+func (u *GuardrailRuleUpsertBulk) SetGroupIds(v []int64) *GuardrailRuleUpsertBulk {
+	return u.Update(func(s *GuardrailRuleUpsert) {
+		s.SetGroupIds(v)
+	})
+}
+
+// UpdateGroupIds sets the "group_ids" field to the value that was provided on create.
+func (u *GuardrailRuleUpsertBulk) UpdateGroupIds() *GuardrailRuleUpsertBulk {
+	return u.Update(func(s *GuardrailRuleUpsert) {
+		s.UpdateGroupIds()
+	})
+}
+
+// ClearGroupIds clears the value of the "group_ids" field.
+func (u *GuardrailRuleUpsertBulk) ClearGroupIds() *GuardrailRuleUpsertBulk {
+	return u.Update(func(s *GuardrailRuleUpsert) {
+		s.ClearGroupIds()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *GuardrailRuleUpsertBulk) SetType(v string) *GuardrailRuleUpsertBulk {
+	return u.Update(func(s *GuardrailRuleUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *GuardrailRuleUpsertBulk) UpdateType() *GuardrailRuleUpsertBulk {
+	return u.Update(func(s *GuardrailRuleUpsert) {
+		s.UpdateType()
+	})
+}
+
+// SetPatterns sets the "patterns" field.
+func (u *GuardrailRuleUpsertBulk) SetPatterns(v []string) *GuardrailRuleUpsertBulk {
+	return u.Update(func(s *GuardrailRuleUpsert) {
+		s.SetPatterns(v)
+	})
+}
+
+// UpdatePatterns sets the "patterns" field to the value that was provided on create.
+func (u *GuardrailRuleUpsertBulk) UpdatePatterns() *GuardrailRuleUpsertBulk {
+	return u.Update(func(s *GuardrailRuleUpsert) {
+		s.UpdatePatterns()
+	})
+}
+
+// ClearPatterns clears the value of the "patterns" field.
+func (u *GuardrailRuleUpsertBulk) ClearPatterns() *GuardrailRuleUpsertBulk {
+	return u.Update(func(s *GuardrailRuleUpsert) {
+		s.ClearPatterns()
+	})
+}
+
+// SetCaseSensitive sets the "case_sensitive" field.
+func (u *GuardrailRuleUpsertBulk) SetCaseSensitive(v bool) *GuardrailRuleUpsertBulk {
+	return u.Update(func(s *GuardrailRuleUpsert) {
+		s.SetCaseSensitive(v)
+	})
+}
+
+// UpdateCaseSensitive sets the "case_sensitive" field to the value that was provided on create.
+func (u *GuardrailRuleUpsertBulk) UpdateCaseSensitive() *GuardrailRuleUpsertBulk {
+	return u.Update(func(s *GuardrailRuleUpsert) {
+		s.UpdateCaseSensitive()
+	})
+}
+
+// SetAction sets the "action" field.
+func (u *GuardrailRuleUpsertBulk) SetAction(v string) *GuardrailRuleUpsertBulk {
+	return u.Update(func(s *GuardrailRuleUpsert) {
+		s.SetAction(v)
+	})
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *GuardrailRuleUpsertBulk) UpdateAction() *GuardrailRuleUpsertBulk {
+	return u.Update(func(s *GuardrailRuleUpsert) {
+		s.UpdateAction()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *GuardrailRuleUpsertBulk) SetDescription(v string) *GuardrailRuleUpsertBulk {
+	return u.Update(func(s *GuardrailRuleUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *GuardrailRuleUpsertBulk) UpdateDescription() *GuardrailRuleUpsertBulk {
+	return u.Update(func(s *GuardrailRuleUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *GuardrailRuleUpsertBulk) ClearDescription() *GuardrailRuleUpsertBulk {
+	return u.Update(func(s *GuardrailRuleUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// Exec executes the query.
+func (u *GuardrailRuleUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the GuardrailRuleCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for GuardrailRuleCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *GuardrailRuleUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
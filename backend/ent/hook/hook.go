@@ -69,6 +69,54 @@ func (f AnnouncementReadFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.V
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AnnouncementReadMutation", m)
 }
 
+// The BalanceBucketFunc type is an adapter to allow the use of ordinary
+// function as BalanceBucket mutator.
+type BalanceBucketFunc func(context.Context, *ent.BalanceBucketMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BalanceBucketFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.BalanceBucketMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BalanceBucketMutation", m)
+}
+
+// The BillingAdjustmentFunc type is an adapter to allow the use of ordinary
+// function as BillingAdjustment mutator.
+type BillingAdjustmentFunc func(context.Context, *ent.BillingAdjustmentMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BillingAdjustmentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.BillingAdjustmentMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BillingAdjustmentMutation", m)
+}
+
+// The BillingRecomputeJobFunc type is an adapter to allow the use of ordinary
+// function as BillingRecomputeJob mutator.
+type BillingRecomputeJobFunc func(context.Context, *ent.BillingRecomputeJobMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BillingRecomputeJobFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.BillingRecomputeJobMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BillingRecomputeJobMutation", m)
+}
+
+// The BillingStatementFunc type is an adapter to allow the use of ordinary
+// function as BillingStatement mutator.
+type BillingStatementFunc func(context.Context, *ent.BillingStatementMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BillingStatementFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.BillingStatementMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BillingStatementMutation", m)
+}
+
 // The ChannelMonitorFunc type is an adapter to allow the use of ordinary
 // function as ChannelMonitor mutator.
 type ChannelMonitorFunc func(context.Context, *ent.ChannelMonitorMutation) (ent.Value, error)
@@ -117,6 +165,30 @@ func (f ChannelMonitorRequestTemplateFunc) Mutate(ctx context.Context, m ent.Mut
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ChannelMonitorRequestTemplateMutation", m)
 }
 
+// The CommissionWithdrawalFunc type is an adapter to allow the use of ordinary
+// function as CommissionWithdrawal mutator.
+type CommissionWithdrawalFunc func(context.Context, *ent.CommissionWithdrawalMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CommissionWithdrawalFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CommissionWithdrawalMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CommissionWithdrawalMutation", m)
+}
+
+// The CommissionWithdrawalLogFunc type is an adapter to allow the use of ordinary
+// function as CommissionWithdrawalLog mutator.
+type CommissionWithdrawalLogFunc func(context.Context, *ent.CommissionWithdrawalLogMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CommissionWithdrawalLogFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CommissionWithdrawalLogMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CommissionWithdrawalLogMutation", m)
+}
+
 // The ErrorPassthroughRuleFunc type is an adapter to allow the use of ordinary
 // function as ErrorPassthroughRule mutator.
 type ErrorPassthroughRuleFunc func(context.Context, *ent.ErrorPassthroughRuleMutation) (ent.Value, error)
@@ -129,6 +201,18 @@ func (f ErrorPassthroughRuleFunc) Mutate(ctx context.Context, m ent.Mutation) (e
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ErrorPassthroughRuleMutation", m)
 }
 
+// The GatewayPluginFunc type is an adapter to allow the use of ordinary
+// function as GatewayPlugin mutator.
+type GatewayPluginFunc func(context.Context, *ent.GatewayPluginMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f GatewayPluginFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.GatewayPluginMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.GatewayPluginMutation", m)
+}
+
 // The GroupFunc type is an adapter to allow the use of ordinary
 // function as Group mutator.
 type GroupFunc func(context.Context, *ent.GroupMutation) (ent.Value, error)
@@ -141,6 +225,18 @@ func (f GroupFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.GroupMutation", m)
 }
 
+// The GuardrailRuleFunc type is an adapter to allow the use of ordinary
+// function as GuardrailRule mutator.
+type GuardrailRuleFunc func(context.Context, *ent.GuardrailRuleMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f GuardrailRuleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.GuardrailRuleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.GuardrailRuleMutation", m)
+}
+
 // The IdempotencyRecordFunc type is an adapter to allow the use of ordinary
 // function as IdempotencyRecord mutator.
 type IdempotencyRecordFunc func(context.Context, *ent.IdempotencyRecordMutation) (ent.Value, error)
@@ -153,6 +249,30 @@ func (f IdempotencyRecordFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.IdempotencyRecordMutation", m)
 }
 
+// The InvoiceFunc type is an adapter to allow the use of ordinary
+// function as Invoice mutator.
+type InvoiceFunc func(context.Context, *ent.InvoiceMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f InvoiceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.InvoiceMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.InvoiceMutation", m)
+}
+
+// The InvoiceSequenceFunc type is an adapter to allow the use of ordinary
+// function as InvoiceSequence mutator.
+type InvoiceSequenceFunc func(context.Context, *ent.InvoiceSequenceMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f InvoiceSequenceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.InvoiceSequenceMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.InvoiceSequenceMutation", m)
+}
+
 // The PaygOrderFunc type is an adapter to allow the use of ordinary
 // function as PaygOrder mutator.
 type PaygOrderFunc func(context.Context, *ent.PaygOrderMutation) (ent.Value, error)
@@ -177,6 +297,18 @@ func (f PaymentAuditLogFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Va
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PaymentAuditLogMutation", m)
 }
 
+// The PaymentCouponFunc type is an adapter to allow the use of ordinary
+// function as PaymentCoupon mutator.
+type PaymentCouponFunc func(context.Context, *ent.PaymentCouponMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PaymentCouponFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PaymentCouponMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PaymentCouponMutation", m)
+}
+
 // The PaymentOrderFunc type is an adapter to allow the use of ordinary
 // function as PaymentOrder mutator.
 type PaymentOrderFunc func(context.Context, *ent.PaymentOrderMutation) (ent.Value, error)
@@ -201,6 +333,18 @@ func (f PaymentProviderInstanceFunc) Mutate(ctx context.Context, m ent.Mutation)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PaymentProviderInstanceMutation", m)
 }
 
+// The PostpaidAccountFunc type is an adapter to allow the use of ordinary
+// function as PostpaidAccount mutator.
+type PostpaidAccountFunc func(context.Context, *ent.PostpaidAccountMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PostpaidAccountFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PostpaidAccountMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PostpaidAccountMutation", m)
+}
+
 // The PromoCodeFunc type is an adapter to allow the use of ordinary
 // function as PromoCode mutator.
 type PromoCodeFunc func(context.Context, *ent.PromoCodeMutation) (ent.Value, error)
@@ -237,6 +381,18 @@ func (f ProxyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ProxyMutation", m)
 }
 
+// The ProxyPoolFunc type is an adapter to allow the use of ordinary
+// function as ProxyPool mutator.
+type ProxyPoolFunc func(context.Context, *ent.ProxyPoolMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ProxyPoolFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ProxyPoolMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ProxyPoolMutation", m)
+}
+
 // The RedeemCodeFunc type is an adapter to allow the use of ordinary
 // function as RedeemCode mutator.
 type RedeemCodeFunc func(context.Context, *ent.RedeemCodeMutation) (ent.Value, error)
@@ -249,6 +405,18 @@ func (f RedeemCodeFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RedeemCodeMutation", m)
 }
 
+// The ReferralCommissionFunc type is an adapter to allow the use of ordinary
+// function as ReferralCommission mutator.
+type ReferralCommissionFunc func(context.Context, *ent.ReferralCommissionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ReferralCommissionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ReferralCommissionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ReferralCommissionMutation", m)
+}
+
 // The ReferralRewardFunc type is an adapter to allow the use of ordinary
 // function as ReferralReward mutator.
 type ReferralRewardFunc func(context.Context, *ent.ReferralRewardMutation) (ent.Value, error)
@@ -261,6 +429,18 @@ func (f ReferralRewardFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Val
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ReferralRewardMutation", m)
 }
 
+// The RequestTransformRuleFunc type is an adapter to allow the use of ordinary
+// function as RequestTransformRule mutator.
+type RequestTransformRuleFunc func(context.Context, *ent.RequestTransformRuleMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RequestTransformRuleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RequestTransformRuleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RequestTransformRuleMutation", m)
+}
+
 // The SecuritySecretFunc type is an adapter to allow the use of ordinary
 // function as SecuritySecret mutator.
 type SecuritySecretFunc func(context.Context, *ent.SecuritySecretMutation) (ent.Value, error)
@@ -285,6 +465,18 @@ func (f SettingFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, err
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SettingMutation", m)
 }
 
+// The SubscriptionAutoRenewalFunc type is an adapter to allow the use of ordinary
+// function as SubscriptionAutoRenewal mutator.
+type SubscriptionAutoRenewalFunc func(context.Context, *ent.SubscriptionAutoRenewalMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SubscriptionAutoRenewalFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SubscriptionAutoRenewalMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SubscriptionAutoRenewalMutation", m)
+}
+
 // The SubscriptionPlanFunc type is an adapter to allow the use of ordinary
 // function as SubscriptionPlan mutator.
 type SubscriptionPlanFunc func(context.Context, *ent.SubscriptionPlanMutation) (ent.Value, error)
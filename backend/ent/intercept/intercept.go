@@ -13,25 +13,41 @@ import (
 	"github.com/Wei-Shaw/sub2api/ent/announcement"
 	"github.com/Wei-Shaw/sub2api/ent/announcementread"
 	"github.com/Wei-Shaw/sub2api/ent/apikey"
+	"github.com/Wei-Shaw/sub2api/ent/balancebucket"
+	"github.com/Wei-Shaw/sub2api/ent/billingadjustment"
+	"github.com/Wei-Shaw/sub2api/ent/billingrecomputejob"
+	"github.com/Wei-Shaw/sub2api/ent/billingstatement"
 	"github.com/Wei-Shaw/sub2api/ent/channelmonitor"
 	"github.com/Wei-Shaw/sub2api/ent/channelmonitordailyrollup"
 	"github.com/Wei-Shaw/sub2api/ent/channelmonitorhistory"
 	"github.com/Wei-Shaw/sub2api/ent/channelmonitorrequesttemplate"
+	"github.com/Wei-Shaw/sub2api/ent/commissionwithdrawal"
+	"github.com/Wei-Shaw/sub2api/ent/commissionwithdrawallog"
 	"github.com/Wei-Shaw/sub2api/ent/errorpassthroughrule"
+	"github.com/Wei-Shaw/sub2api/ent/gatewayplugin"
 	"github.com/Wei-Shaw/sub2api/ent/group"
+	"github.com/Wei-Shaw/sub2api/ent/guardrailrule"
 	"github.com/Wei-Shaw/sub2api/ent/idempotencyrecord"
+	"github.com/Wei-Shaw/sub2api/ent/invoice"
+	"github.com/Wei-Shaw/sub2api/ent/invoicesequence"
 	"github.com/Wei-Shaw/sub2api/ent/paygorder"
 	"github.com/Wei-Shaw/sub2api/ent/paymentauditlog"
+	"github.com/Wei-Shaw/sub2api/ent/paymentcoupon"
 	"github.com/Wei-Shaw/sub2api/ent/paymentorder"
 	"github.com/Wei-Shaw/sub2api/ent/paymentproviderinstance"
+	"github.com/Wei-Shaw/sub2api/ent/postpaidaccount"
 	"github.com/Wei-Shaw/sub2api/ent/predicate"
 	"github.com/Wei-Shaw/sub2api/ent/promocode"
 	"github.com/Wei-Shaw/sub2api/ent/promocodeusage"
 	"github.com/Wei-Shaw/sub2api/ent/proxy"
+	"github.com/Wei-Shaw/sub2api/ent/proxypool"
 	"github.com/Wei-Shaw/sub2api/ent/redeemcode"
+	"github.com/Wei-Shaw/sub2api/ent/referralcommission"
 	"github.com/Wei-Shaw/sub2api/ent/referralreward"
+	"github.com/Wei-Shaw/sub2api/ent/requesttransformrule"
 	"github.com/Wei-Shaw/sub2api/ent/securitysecret"
 	"github.com/Wei-Shaw/sub2api/ent/setting"
+	"github.com/Wei-Shaw/sub2api/ent/subscriptionautorenewal"
 	"github.com/Wei-Shaw/sub2api/ent/subscriptionplan"
 	"github.com/Wei-Shaw/sub2api/ent/usagecleanuptask"
 	"github.com/Wei-Shaw/sub2api/ent/usagelog"
@@ -233,6 +249,114 @@ func (f TraverseAnnouncementRead) Traverse(ctx context.Context, q ent.Query) err
 	return fmt.Errorf("unexpected query type %T. expect *ent.AnnouncementReadQuery", q)
 }
 
+// The BalanceBucketFunc type is an adapter to allow the use of ordinary function as a Querier.
+type BalanceBucketFunc func(context.Context, *ent.BalanceBucketQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f BalanceBucketFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.BalanceBucketQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.BalanceBucketQuery", q)
+}
+
+// The TraverseBalanceBucket type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseBalanceBucket func(context.Context, *ent.BalanceBucketQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseBalanceBucket) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseBalanceBucket) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.BalanceBucketQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.BalanceBucketQuery", q)
+}
+
+// The BillingAdjustmentFunc type is an adapter to allow the use of ordinary function as a Querier.
+type BillingAdjustmentFunc func(context.Context, *ent.BillingAdjustmentQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f BillingAdjustmentFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.BillingAdjustmentQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.BillingAdjustmentQuery", q)
+}
+
+// The TraverseBillingAdjustment type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseBillingAdjustment func(context.Context, *ent.BillingAdjustmentQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseBillingAdjustment) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseBillingAdjustment) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.BillingAdjustmentQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.BillingAdjustmentQuery", q)
+}
+
+// The BillingRecomputeJobFunc type is an adapter to allow the use of ordinary function as a Querier.
+type BillingRecomputeJobFunc func(context.Context, *ent.BillingRecomputeJobQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f BillingRecomputeJobFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.BillingRecomputeJobQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.BillingRecomputeJobQuery", q)
+}
+
+// The TraverseBillingRecomputeJob type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseBillingRecomputeJob func(context.Context, *ent.BillingRecomputeJobQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseBillingRecomputeJob) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseBillingRecomputeJob) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.BillingRecomputeJobQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.BillingRecomputeJobQuery", q)
+}
+
+// The BillingStatementFunc type is an adapter to allow the use of ordinary function as a Querier.
+type BillingStatementFunc func(context.Context, *ent.BillingStatementQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f BillingStatementFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.BillingStatementQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.BillingStatementQuery", q)
+}
+
+// The TraverseBillingStatement type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseBillingStatement func(context.Context, *ent.BillingStatementQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseBillingStatement) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseBillingStatement) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.BillingStatementQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.BillingStatementQuery", q)
+}
+
 // The ChannelMonitorFunc type is an adapter to allow the use of ordinary function as a Querier.
 type ChannelMonitorFunc func(context.Context, *ent.ChannelMonitorQuery) (ent.Value, error)
 
@@ -341,6 +465,60 @@ func (f TraverseChannelMonitorRequestTemplate) Traverse(ctx context.Context, q e
 	return fmt.Errorf("unexpected query type %T. expect *ent.ChannelMonitorRequestTemplateQuery", q)
 }
 
+// The CommissionWithdrawalFunc type is an adapter to allow the use of ordinary function as a Querier.
+type CommissionWithdrawalFunc func(context.Context, *ent.CommissionWithdrawalQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f CommissionWithdrawalFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.CommissionWithdrawalQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.CommissionWithdrawalQuery", q)
+}
+
+// The TraverseCommissionWithdrawal type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseCommissionWithdrawal func(context.Context, *ent.CommissionWithdrawalQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseCommissionWithdrawal) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseCommissionWithdrawal) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.CommissionWithdrawalQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.CommissionWithdrawalQuery", q)
+}
+
+// The CommissionWithdrawalLogFunc type is an adapter to allow the use of ordinary function as a Querier.
+type CommissionWithdrawalLogFunc func(context.Context, *ent.CommissionWithdrawalLogQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f CommissionWithdrawalLogFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.CommissionWithdrawalLogQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.CommissionWithdrawalLogQuery", q)
+}
+
+// The TraverseCommissionWithdrawalLog type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseCommissionWithdrawalLog func(context.Context, *ent.CommissionWithdrawalLogQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseCommissionWithdrawalLog) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseCommissionWithdrawalLog) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.CommissionWithdrawalLogQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.CommissionWithdrawalLogQuery", q)
+}
+
 // The ErrorPassthroughRuleFunc type is an adapter to allow the use of ordinary function as a Querier.
 type ErrorPassthroughRuleFunc func(context.Context, *ent.ErrorPassthroughRuleQuery) (ent.Value, error)
 
@@ -368,6 +546,33 @@ func (f TraverseErrorPassthroughRule) Traverse(ctx context.Context, q ent.Query)
 	return fmt.Errorf("unexpected query type %T. expect *ent.ErrorPassthroughRuleQuery", q)
 }
 
+// The GatewayPluginFunc type is an adapter to allow the use of ordinary function as a Querier.
+type GatewayPluginFunc func(context.Context, *ent.GatewayPluginQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f GatewayPluginFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.GatewayPluginQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.GatewayPluginQuery", q)
+}
+
+// The TraverseGatewayPlugin type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseGatewayPlugin func(context.Context, *ent.GatewayPluginQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseGatewayPlugin) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseGatewayPlugin) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.GatewayPluginQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.GatewayPluginQuery", q)
+}
+
 // The GroupFunc type is an adapter to allow the use of ordinary function as a Querier.
 type GroupFunc func(context.Context, *ent.GroupQuery) (ent.Value, error)
 
@@ -395,6 +600,33 @@ func (f TraverseGroup) Traverse(ctx context.Context, q ent.Query) error {
 	return fmt.Errorf("unexpected query type %T. expect *ent.GroupQuery", q)
 }
 
+// The GuardrailRuleFunc type is an adapter to allow the use of ordinary function as a Querier.
+type GuardrailRuleFunc func(context.Context, *ent.GuardrailRuleQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f GuardrailRuleFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.GuardrailRuleQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.GuardrailRuleQuery", q)
+}
+
+// The TraverseGuardrailRule type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseGuardrailRule func(context.Context, *ent.GuardrailRuleQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseGuardrailRule) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseGuardrailRule) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.GuardrailRuleQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.GuardrailRuleQuery", q)
+}
+
 // The IdempotencyRecordFunc type is an adapter to allow the use of ordinary function as a Querier.
 type IdempotencyRecordFunc func(context.Context, *ent.IdempotencyRecordQuery) (ent.Value, error)
 
@@ -422,6 +654,60 @@ func (f TraverseIdempotencyRecord) Traverse(ctx context.Context, q ent.Query) er
 	return fmt.Errorf("unexpected query type %T. expect *ent.IdempotencyRecordQuery", q)
 }
 
+// The InvoiceFunc type is an adapter to allow the use of ordinary function as a Querier.
+type InvoiceFunc func(context.Context, *ent.InvoiceQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f InvoiceFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.InvoiceQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.InvoiceQuery", q)
+}
+
+// The TraverseInvoice type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseInvoice func(context.Context, *ent.InvoiceQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseInvoice) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseInvoice) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.InvoiceQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.InvoiceQuery", q)
+}
+
+// The InvoiceSequenceFunc type is an adapter to allow the use of ordinary function as a Querier.
+type InvoiceSequenceFunc func(context.Context, *ent.InvoiceSequenceQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f InvoiceSequenceFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.InvoiceSequenceQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.InvoiceSequenceQuery", q)
+}
+
+// The TraverseInvoiceSequence type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseInvoiceSequence func(context.Context, *ent.InvoiceSequenceQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseInvoiceSequence) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseInvoiceSequence) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.InvoiceSequenceQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.InvoiceSequenceQuery", q)
+}
+
 // The PaygOrderFunc type is an adapter to allow the use of ordinary function as a Querier.
 type PaygOrderFunc func(context.Context, *ent.PaygOrderQuery) (ent.Value, error)
 
@@ -476,6 +762,33 @@ func (f TraversePaymentAuditLog) Traverse(ctx context.Context, q ent.Query) erro
 	return fmt.Errorf("unexpected query type %T. expect *ent.PaymentAuditLogQuery", q)
 }
 
+// The PaymentCouponFunc type is an adapter to allow the use of ordinary function as a Querier.
+type PaymentCouponFunc func(context.Context, *ent.PaymentCouponQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f PaymentCouponFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.PaymentCouponQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.PaymentCouponQuery", q)
+}
+
+// The TraversePaymentCoupon type is an adapter to allow the use of ordinary function as Traverser.
+type TraversePaymentCoupon func(context.Context, *ent.PaymentCouponQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraversePaymentCoupon) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraversePaymentCoupon) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.PaymentCouponQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.PaymentCouponQuery", q)
+}
+
 // The PaymentOrderFunc type is an adapter to allow the use of ordinary function as a Querier.
 type PaymentOrderFunc func(context.Context, *ent.PaymentOrderQuery) (ent.Value, error)
 
@@ -530,6 +843,33 @@ func (f TraversePaymentProviderInstance) Traverse(ctx context.Context, q ent.Que
 	return fmt.Errorf("unexpected query type %T. expect *ent.PaymentProviderInstanceQuery", q)
 }
 
+// The PostpaidAccountFunc type is an adapter to allow the use of ordinary function as a Querier.
+type PostpaidAccountFunc func(context.Context, *ent.PostpaidAccountQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f PostpaidAccountFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.PostpaidAccountQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.PostpaidAccountQuery", q)
+}
+
+// The TraversePostpaidAccount type is an adapter to allow the use of ordinary function as Traverser.
+type TraversePostpaidAccount func(context.Context, *ent.PostpaidAccountQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraversePostpaidAccount) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraversePostpaidAccount) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.PostpaidAccountQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.PostpaidAccountQuery", q)
+}
+
 // The PromoCodeFunc type is an adapter to allow the use of ordinary function as a Querier.
 type PromoCodeFunc func(context.Context, *ent.PromoCodeQuery) (ent.Value, error)
 
@@ -611,6 +951,33 @@ func (f TraverseProxy) Traverse(ctx context.Context, q ent.Query) error {
 	return fmt.Errorf("unexpected query type %T. expect *ent.ProxyQuery", q)
 }
 
+// The ProxyPoolFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ProxyPoolFunc func(context.Context, *ent.ProxyPoolQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f ProxyPoolFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.ProxyPoolQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.ProxyPoolQuery", q)
+}
+
+// The TraverseProxyPool type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseProxyPool func(context.Context, *ent.ProxyPoolQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseProxyPool) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseProxyPool) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ProxyPoolQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.ProxyPoolQuery", q)
+}
+
 // The RedeemCodeFunc type is an adapter to allow the use of ordinary function as a Querier.
 type RedeemCodeFunc func(context.Context, *ent.RedeemCodeQuery) (ent.Value, error)
 
@@ -638,6 +1005,33 @@ func (f TraverseRedeemCode) Traverse(ctx context.Context, q ent.Query) error {
 	return fmt.Errorf("unexpected query type %T. expect *ent.RedeemCodeQuery", q)
 }
 
+// The ReferralCommissionFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ReferralCommissionFunc func(context.Context, *ent.ReferralCommissionQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f ReferralCommissionFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.ReferralCommissionQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.ReferralCommissionQuery", q)
+}
+
+// The TraverseReferralCommission type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseReferralCommission func(context.Context, *ent.ReferralCommissionQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseReferralCommission) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseReferralCommission) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ReferralCommissionQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.ReferralCommissionQuery", q)
+}
+
 // The ReferralRewardFunc type is an adapter to allow the use of ordinary function as a Querier.
 type ReferralRewardFunc func(context.Context, *ent.ReferralRewardQuery) (ent.Value, error)
 
@@ -665,6 +1059,33 @@ func (f TraverseReferralReward) Traverse(ctx context.Context, q ent.Query) error
 	return fmt.Errorf("unexpected query type %T. expect *ent.ReferralRewardQuery", q)
 }
 
+// The RequestTransformRuleFunc type is an adapter to allow the use of ordinary function as a Querier.
+type RequestTransformRuleFunc func(context.Context, *ent.RequestTransformRuleQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f RequestTransformRuleFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.RequestTransformRuleQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.RequestTransformRuleQuery", q)
+}
+
+// The TraverseRequestTransformRule type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseRequestTransformRule func(context.Context, *ent.RequestTransformRuleQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseRequestTransformRule) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseRequestTransformRule) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.RequestTransformRuleQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.RequestTransformRuleQuery", q)
+}
+
 // The SecuritySecretFunc type is an adapter to allow the use of ordinary function as a Querier.
 type SecuritySecretFunc func(context.Context, *ent.SecuritySecretQuery) (ent.Value, error)
 
@@ -719,6 +1140,33 @@ func (f TraverseSetting) Traverse(ctx context.Context, q ent.Query) error {
 	return fmt.Errorf("unexpected query type %T. expect *ent.SettingQuery", q)
 }
 
+// The SubscriptionAutoRenewalFunc type is an adapter to allow the use of ordinary function as a Querier.
+type SubscriptionAutoRenewalFunc func(context.Context, *ent.SubscriptionAutoRenewalQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f SubscriptionAutoRenewalFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.SubscriptionAutoRenewalQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.SubscriptionAutoRenewalQuery", q)
+}
+
+// The TraverseSubscriptionAutoRenewal type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseSubscriptionAutoRenewal func(context.Context, *ent.SubscriptionAutoRenewalQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseSubscriptionAutoRenewal) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseSubscriptionAutoRenewal) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.SubscriptionAutoRenewalQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.SubscriptionAutoRenewalQuery", q)
+}
+
 // The SubscriptionPlanFunc type is an adapter to allow the use of ordinary function as a Querier.
 type SubscriptionPlanFunc func(context.Context, *ent.SubscriptionPlanQuery) (ent.Value, error)
 
@@ -948,6 +1396,14 @@ func NewQuery(q ent.Query) (Query, error) {
 		return &query[*ent.AnnouncementQuery, predicate.Announcement, announcement.OrderOption]{typ: ent.TypeAnnouncement, tq: q}, nil
 	case *ent.AnnouncementReadQuery:
 		return &query[*ent.AnnouncementReadQuery, predicate.AnnouncementRead, announcementread.OrderOption]{typ: ent.TypeAnnouncementRead, tq: q}, nil
+	case *ent.BalanceBucketQuery:
+		return &query[*ent.BalanceBucketQuery, predicate.BalanceBucket, balancebucket.OrderOption]{typ: ent.TypeBalanceBucket, tq: q}, nil
+	case *ent.BillingAdjustmentQuery:
+		return &query[*ent.BillingAdjustmentQuery, predicate.BillingAdjustment, billingadjustment.OrderOption]{typ: ent.TypeBillingAdjustment, tq: q}, nil
+	case *ent.BillingRecomputeJobQuery:
+		return &query[*ent.BillingRecomputeJobQuery, predicate.BillingRecomputeJob, billingrecomputejob.OrderOption]{typ: ent.TypeBillingRecomputeJob, tq: q}, nil
+	case *ent.BillingStatementQuery:
+		return &query[*ent.BillingStatementQuery, predicate.BillingStatement, billingstatement.OrderOption]{typ: ent.TypeBillingStatement, tq: q}, nil
 	case *ent.ChannelMonitorQuery:
 		return &query[*ent.ChannelMonitorQuery, predicate.ChannelMonitor, channelmonitor.OrderOption]{typ: ent.TypeChannelMonitor, tq: q}, nil
 	case *ent.ChannelMonitorDailyRollupQuery:
@@ -956,34 +1412,58 @@ func NewQuery(q ent.Query) (Query, error) {
 		return &query[*ent.ChannelMonitorHistoryQuery, predicate.ChannelMonitorHistory, channelmonitorhistory.OrderOption]{typ: ent.TypeChannelMonitorHistory, tq: q}, nil
 	case *ent.ChannelMonitorRequestTemplateQuery:
 		return &query[*ent.ChannelMonitorRequestTemplateQuery, predicate.ChannelMonitorRequestTemplate, channelmonitorrequesttemplate.OrderOption]{typ: ent.TypeChannelMonitorRequestTemplate, tq: q}, nil
+	case *ent.CommissionWithdrawalQuery:
+		return &query[*ent.CommissionWithdrawalQuery, predicate.CommissionWithdrawal, commissionwithdrawal.OrderOption]{typ: ent.TypeCommissionWithdrawal, tq: q}, nil
+	case *ent.CommissionWithdrawalLogQuery:
+		return &query[*ent.CommissionWithdrawalLogQuery, predicate.CommissionWithdrawalLog, commissionwithdrawallog.OrderOption]{typ: ent.TypeCommissionWithdrawalLog, tq: q}, nil
 	case *ent.ErrorPassthroughRuleQuery:
 		return &query[*ent.ErrorPassthroughRuleQuery, predicate.ErrorPassthroughRule, errorpassthroughrule.OrderOption]{typ: ent.TypeErrorPassthroughRule, tq: q}, nil
+	case *ent.GatewayPluginQuery:
+		return &query[*ent.GatewayPluginQuery, predicate.GatewayPlugin, gatewayplugin.OrderOption]{typ: ent.TypeGatewayPlugin, tq: q}, nil
 	case *ent.GroupQuery:
 		return &query[*ent.GroupQuery, predicate.Group, group.OrderOption]{typ: ent.TypeGroup, tq: q}, nil
+	case *ent.GuardrailRuleQuery:
+		return &query[*ent.GuardrailRuleQuery, predicate.GuardrailRule, guardrailrule.OrderOption]{typ: ent.TypeGuardrailRule, tq: q}, nil
 	case *ent.IdempotencyRecordQuery:
 		return &query[*ent.IdempotencyRecordQuery, predicate.IdempotencyRecord, idempotencyrecord.OrderOption]{typ: ent.TypeIdempotencyRecord, tq: q}, nil
+	case *ent.InvoiceQuery:
+		return &query[*ent.InvoiceQuery, predicate.Invoice, invoice.OrderOption]{typ: ent.TypeInvoice, tq: q}, nil
+	case *ent.InvoiceSequenceQuery:
+		return &query[*ent.InvoiceSequenceQuery, predicate.InvoiceSequence, invoicesequence.OrderOption]{typ: ent.TypeInvoiceSequence, tq: q}, nil
 	case *ent.PaygOrderQuery:
 		return &query[*ent.PaygOrderQuery, predicate.PaygOrder, paygorder.OrderOption]{typ: ent.TypePaygOrder, tq: q}, nil
 	case *ent.PaymentAuditLogQuery:
 		return &query[*ent.PaymentAuditLogQuery, predicate.PaymentAuditLog, paymentauditlog.OrderOption]{typ: ent.TypePaymentAuditLog, tq: q}, nil
+	case *ent.PaymentCouponQuery:
+		return &query[*ent.PaymentCouponQuery, predicate.PaymentCoupon, paymentcoupon.OrderOption]{typ: ent.TypePaymentCoupon, tq: q}, nil
 	case *ent.PaymentOrderQuery:
 		return &query[*ent.PaymentOrderQuery, predicate.PaymentOrder, paymentorder.OrderOption]{typ: ent.TypePaymentOrder, tq: q}, nil
 	case *ent.PaymentProviderInstanceQuery:
 		return &query[*ent.PaymentProviderInstanceQuery, predicate.PaymentProviderInstance, paymentproviderinstance.OrderOption]{typ: ent.TypePaymentProviderInstance, tq: q}, nil
+	case *ent.PostpaidAccountQuery:
+		return &query[*ent.PostpaidAccountQuery, predicate.PostpaidAccount, postpaidaccount.OrderOption]{typ: ent.TypePostpaidAccount, tq: q}, nil
 	case *ent.PromoCodeQuery:
 		return &query[*ent.PromoCodeQuery, predicate.PromoCode, promocode.OrderOption]{typ: ent.TypePromoCode, tq: q}, nil
 	case *ent.PromoCodeUsageQuery:
 		return &query[*ent.PromoCodeUsageQuery, predicate.PromoCodeUsage, promocodeusage.OrderOption]{typ: ent.TypePromoCodeUsage, tq: q}, nil
 	case *ent.ProxyQuery:
 		return &query[*ent.ProxyQuery, predicate.Proxy, proxy.OrderOption]{typ: ent.TypeProxy, tq: q}, nil
+	case *ent.ProxyPoolQuery:
+		return &query[*ent.ProxyPoolQuery, predicate.ProxyPool, proxypool.OrderOption]{typ: ent.TypeProxyPool, tq: q}, nil
 	case *ent.RedeemCodeQuery:
 		return &query[*ent.RedeemCodeQuery, predicate.RedeemCode, redeemcode.OrderOption]{typ: ent.TypeRedeemCode, tq: q}, nil
+	case *ent.ReferralCommissionQuery:
+		return &query[*ent.ReferralCommissionQuery, predicate.ReferralCommission, referralcommission.OrderOption]{typ: ent.TypeReferralCommission, tq: q}, nil
 	case *ent.ReferralRewardQuery:
 		return &query[*ent.ReferralRewardQuery, predicate.ReferralReward, referralreward.OrderOption]{typ: ent.TypeReferralReward, tq: q}, nil
+	case *ent.RequestTransformRuleQuery:
+		return &query[*ent.RequestTransformRuleQuery, predicate.RequestTransformRule, requesttransformrule.OrderOption]{typ: ent.TypeRequestTransformRule, tq: q}, nil
 	case *ent.SecuritySecretQuery:
 		return &query[*ent.SecuritySecretQuery, predicate.SecuritySecret, securitysecret.OrderOption]{typ: ent.TypeSecuritySecret, tq: q}, nil
 	case *ent.SettingQuery:
 		return &query[*ent.SettingQuery, predicate.Setting, setting.OrderOption]{typ: ent.TypeSetting, tq: q}, nil
+	case *ent.SubscriptionAutoRenewalQuery:
+		return &query[*ent.SubscriptionAutoRenewalQuery, predicate.SubscriptionAutoRenewal, subscriptionautorenewal.OrderOption]{typ: ent.TypeSubscriptionAutoRenewal, tq: q}, nil
 	case *ent.SubscriptionPlanQuery:
 		return &query[*ent.SubscriptionPlanQuery, predicate.SubscriptionPlan, subscriptionplan.OrderOption]{typ: ent.TypeSubscriptionPlan, tq: q}, nil
 	case *ent.UsageCleanupTaskQuery:
@@ -0,0 +1,384 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Wei-Shaw/sub2api/ent/invoice"
+	"github.com/Wei-Shaw/sub2api/internal/model"
+)
+
+// Invoice is the model entity for the Invoice schema.
+type Invoice struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// InvoiceNo holds the value of the "invoice_no" field.
+	InvoiceNo string `json:"invoice_no,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind string `json:"kind,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int64 `json:"user_id,omitempty"`
+	// SourceType holds the value of the "source_type" field.
+	SourceType string `json:"source_type,omitempty"`
+	// SourceID holds the value of the "source_id" field.
+	SourceID int64 `json:"source_id,omitempty"`
+	// SourceNo holds the value of the "source_no" field.
+	SourceNo string `json:"source_no,omitempty"`
+	// OriginalInvoiceID holds the value of the "original_invoice_id" field.
+	OriginalInvoiceID *int64 `json:"original_invoice_id,omitempty"`
+	// Currency holds the value of the "currency" field.
+	Currency string `json:"currency,omitempty"`
+	// Subtotal holds the value of the "subtotal" field.
+	Subtotal float64 `json:"subtotal,omitempty"`
+	// DiscountAmount holds the value of the "discount_amount" field.
+	DiscountAmount float64 `json:"discount_amount,omitempty"`
+	// FeeAmount holds the value of the "fee_amount" field.
+	FeeAmount float64 `json:"fee_amount,omitempty"`
+	// Total holds the value of the "total" field.
+	Total float64 `json:"total,omitempty"`
+	// Lines holds the value of the "lines" field.
+	Lines []model.InvoiceLine `json:"lines,omitempty"`
+	// PaymentMethod holds the value of the "payment_method" field.
+	PaymentMethod string `json:"payment_method,omitempty"`
+	// BuyerEmail holds the value of the "buyer_email" field.
+	BuyerEmail string `json:"buyer_email,omitempty"`
+	// BuyerName holds the value of the "buyer_name" field.
+	BuyerName string `json:"buyer_name,omitempty"`
+	// BuyerTaxID holds the value of the "buyer_tax_id" field.
+	BuyerTaxID string `json:"buyer_tax_id,omitempty"`
+	// BuyerAddress holds the value of the "buyer_address" field.
+	BuyerAddress string `json:"buyer_address,omitempty"`
+	// SellerName holds the value of the "seller_name" field.
+	SellerName string `json:"seller_name,omitempty"`
+	// SellerTaxID holds the value of the "seller_tax_id" field.
+	SellerTaxID string `json:"seller_tax_id,omitempty"`
+	// SellerAddress holds the value of the "seller_address" field.
+	SellerAddress string `json:"seller_address,omitempty"`
+	// Notes holds the value of the "notes" field.
+	Notes string `json:"notes,omitempty"`
+	// IssuedAt holds the value of the "issued_at" field.
+	IssuedAt time.Time `json:"issued_at,omitempty"`
+	// EmailedAt holds the value of the "emailed_at" field.
+	EmailedAt *time.Time `json:"emailed_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Invoice) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case invoice.FieldLines:
+			values[i] = new([]byte)
+		case invoice.FieldSubtotal, invoice.FieldDiscountAmount, invoice.FieldFeeAmount, invoice.FieldTotal:
+			values[i] = new(sql.NullFloat64)
+		case invoice.FieldID, invoice.FieldUserID, invoice.FieldSourceID, invoice.FieldOriginalInvoiceID:
+			values[i] = new(sql.NullInt64)
+		case invoice.FieldInvoiceNo, invoice.FieldKind, invoice.FieldSourceType, invoice.FieldSourceNo, invoice.FieldCurrency, invoice.FieldPaymentMethod, invoice.FieldBuyerEmail, invoice.FieldBuyerName, invoice.FieldBuyerTaxID, invoice.FieldBuyerAddress, invoice.FieldSellerName, invoice.FieldSellerTaxID, invoice.FieldSellerAddress, invoice.FieldNotes:
+			values[i] = new(sql.NullString)
+		case invoice.FieldIssuedAt, invoice.FieldEmailedAt, invoice.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Invoice fields.
+func (_m *Invoice) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case invoice.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int64(value.Int64)
+		case invoice.FieldInvoiceNo:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field invoice_no", values[i])
+			} else if value.Valid {
+				_m.InvoiceNo = value.String
+			}
+		case invoice.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				_m.Kind = value.String
+			}
+		case invoice.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = value.Int64
+			}
+		case invoice.FieldSourceType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source_type", values[i])
+			} else if value.Valid {
+				_m.SourceType = value.String
+			}
+		case invoice.FieldSourceID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field source_id", values[i])
+			} else if value.Valid {
+				_m.SourceID = value.Int64
+			}
+		case invoice.FieldSourceNo:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source_no", values[i])
+			} else if value.Valid {
+				_m.SourceNo = value.String
+			}
+		case invoice.FieldOriginalInvoiceID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field original_invoice_id", values[i])
+			} else if value.Valid {
+				_m.OriginalInvoiceID = new(int64)
+				*_m.OriginalInvoiceID = value.Int64
+			}
+		case invoice.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				_m.Currency = value.String
+			}
+		case invoice.FieldSubtotal:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field subtotal", values[i])
+			} else if value.Valid {
+				_m.Subtotal = value.Float64
+			}
+		case invoice.FieldDiscountAmount:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field discount_amount", values[i])
+			} else if value.Valid {
+				_m.DiscountAmount = value.Float64
+			}
+		case invoice.FieldFeeAmount:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field fee_amount", values[i])
+			} else if value.Valid {
+				_m.FeeAmount = value.Float64
+			}
+		case invoice.FieldTotal:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field total", values[i])
+			} else if value.Valid {
+				_m.Total = value.Float64
+			}
+		case invoice.FieldLines:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field lines", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Lines); err != nil {
+					return fmt.Errorf("unmarshal field lines: %w", err)
+				}
+			}
+		case invoice.FieldPaymentMethod:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field payment_method", values[i])
+			} else if value.Valid {
+				_m.PaymentMethod = value.String
+			}
+		case invoice.FieldBuyerEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field buyer_email", values[i])
+			} else if value.Valid {
+				_m.BuyerEmail = value.String
+			}
+		case invoice.FieldBuyerName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field buyer_name", values[i])
+			} else if value.Valid {
+				_m.BuyerName = value.String
+			}
+		case invoice.FieldBuyerTaxID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field buyer_tax_id", values[i])
+			} else if value.Valid {
+				_m.BuyerTaxID = value.String
+			}
+		case invoice.FieldBuyerAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field buyer_address", values[i])
+			} else if value.Valid {
+				_m.BuyerAddress = value.String
+			}
+		case invoice.FieldSellerName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field seller_name", values[i])
+			} else if value.Valid {
+				_m.SellerName = value.String
+			}
+		case invoice.FieldSellerTaxID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field seller_tax_id", values[i])
+			} else if value.Valid {
+				_m.SellerTaxID = value.String
+			}
+		case invoice.FieldSellerAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field seller_address", values[i])
+			} else if value.Valid {
+				_m.SellerAddress = value.String
+			}
+		case invoice.FieldNotes:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field notes", values[i])
+			} else if value.Valid {
+				_m.Notes = value.String
+			}
+		case invoice.FieldIssuedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field issued_at", values[i])
+			} else if value.Valid {
+				_m.IssuedAt = value.Time
+			}
+		case invoice.FieldEmailedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field emailed_at", values[i])
+			} else if value.Valid {
+				_m.EmailedAt = new(time.Time)
+				*_m.EmailedAt = value.Time
+			}
+		case invoice.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Invoice.
+// This includes values selected through modifiers, order, etc.
+func (_m *Invoice) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Invoice.
+// Note that you need to call Invoice.Unwrap() before calling this method if this Invoice
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Invoice) Update() *InvoiceUpdateOne {
+	return NewInvoiceClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Invoice entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Invoice) Unwrap() *Invoice {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Invoice is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Invoice) String() string {
+	var builder strings.Builder
+	builder.WriteString("Invoice(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("invoice_no=")
+	builder.WriteString(_m.InvoiceNo)
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(_m.Kind)
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("source_type=")
+	builder.WriteString(_m.SourceType)
+	builder.WriteString(", ")
+	builder.WriteString("source_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SourceID))
+	builder.WriteString(", ")
+	builder.WriteString("source_no=")
+	builder.WriteString(_m.SourceNo)
+	builder.WriteString(", ")
+	if v := _m.OriginalInvoiceID; v != nil {
+		builder.WriteString("original_invoice_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(_m.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("subtotal=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Subtotal))
+	builder.WriteString(", ")
+	builder.WriteString("discount_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DiscountAmount))
+	builder.WriteString(", ")
+	builder.WriteString("fee_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FeeAmount))
+	builder.WriteString(", ")
+	builder.WriteString("total=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Total))
+	builder.WriteString(", ")
+	builder.WriteString("lines=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Lines))
+	builder.WriteString(", ")
+	builder.WriteString("payment_method=")
+	builder.WriteString(_m.PaymentMethod)
+	builder.WriteString(", ")
+	builder.WriteString("buyer_email=")
+	builder.WriteString(_m.BuyerEmail)
+	builder.WriteString(", ")
+	builder.WriteString("buyer_name=")
+	builder.WriteString(_m.BuyerName)
+	builder.WriteString(", ")
+	builder.WriteString("buyer_tax_id=")
+	builder.WriteString(_m.BuyerTaxID)
+	builder.WriteString(", ")
+	builder.WriteString("buyer_address=")
+	builder.WriteString(_m.BuyerAddress)
+	builder.WriteString(", ")
+	builder.WriteString("seller_name=")
+	builder.WriteString(_m.SellerName)
+	builder.WriteString(", ")
+	builder.WriteString("seller_tax_id=")
+	builder.WriteString(_m.SellerTaxID)
+	builder.WriteString(", ")
+	builder.WriteString("seller_address=")
+	builder.WriteString(_m.SellerAddress)
+	builder.WriteString(", ")
+	builder.WriteString("notes=")
+	builder.WriteString(_m.Notes)
+	builder.WriteString(", ")
+	builder.WriteString("issued_at=")
+	builder.WriteString(_m.IssuedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.EmailedAt; v != nil {
+		builder.WriteString("emailed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Invoices is a parsable slice of Invoice.
+type Invoices []*Invoice
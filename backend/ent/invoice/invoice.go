@@ -0,0 +1,291 @@
+// Code generated by ent, DO NOT EDIT.
+
+package invoice
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the invoice type in the database.
+	Label = "invoice"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldInvoiceNo holds the string denoting the invoice_no field in the database.
+	FieldInvoiceNo = "invoice_no"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldSourceType holds the string denoting the source_type field in the database.
+	FieldSourceType = "source_type"
+	// FieldSourceID holds the string denoting the source_id field in the database.
+	FieldSourceID = "source_id"
+	// FieldSourceNo holds the string denoting the source_no field in the database.
+	FieldSourceNo = "source_no"
+	// FieldOriginalInvoiceID holds the string denoting the original_invoice_id field in the database.
+	FieldOriginalInvoiceID = "original_invoice_id"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
+	// FieldSubtotal holds the string denoting the subtotal field in the database.
+	FieldSubtotal = "subtotal"
+	// FieldDiscountAmount holds the string denoting the discount_amount field in the database.
+	FieldDiscountAmount = "discount_amount"
+	// FieldFeeAmount holds the string denoting the fee_amount field in the database.
+	FieldFeeAmount = "fee_amount"
+	// FieldTotal holds the string denoting the total field in the database.
+	FieldTotal = "total"
+	// FieldLines holds the string denoting the lines field in the database.
+	FieldLines = "lines"
+	// FieldPaymentMethod holds the string denoting the payment_method field in the database.
+	FieldPaymentMethod = "payment_method"
+	// FieldBuyerEmail holds the string denoting the buyer_email field in the database.
+	FieldBuyerEmail = "buyer_email"
+	// FieldBuyerName holds the string denoting the buyer_name field in the database.
+	FieldBuyerName = "buyer_name"
+	// FieldBuyerTaxID holds the string denoting the buyer_tax_id field in the database.
+	FieldBuyerTaxID = "buyer_tax_id"
+	// FieldBuyerAddress holds the string denoting the buyer_address field in the database.
+	FieldBuyerAddress = "buyer_address"
+	// FieldSellerName holds the string denoting the seller_name field in the database.
+	FieldSellerName = "seller_name"
+	// FieldSellerTaxID holds the string denoting the seller_tax_id field in the database.
+	FieldSellerTaxID = "seller_tax_id"
+	// FieldSellerAddress holds the string denoting the seller_address field in the database.
+	FieldSellerAddress = "seller_address"
+	// FieldNotes holds the string denoting the notes field in the database.
+	FieldNotes = "notes"
+	// FieldIssuedAt holds the string denoting the issued_at field in the database.
+	FieldIssuedAt = "issued_at"
+	// FieldEmailedAt holds the string denoting the emailed_at field in the database.
+	FieldEmailedAt = "emailed_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the invoice in the database.
+	Table = "invoices"
+)
+
+// Columns holds all SQL columns for invoice fields.
+var Columns = []string{
+	FieldID,
+	FieldInvoiceNo,
+	FieldKind,
+	FieldUserID,
+	FieldSourceType,
+	FieldSourceID,
+	FieldSourceNo,
+	FieldOriginalInvoiceID,
+	FieldCurrency,
+	FieldSubtotal,
+	FieldDiscountAmount,
+	FieldFeeAmount,
+	FieldTotal,
+	FieldLines,
+	FieldPaymentMethod,
+	FieldBuyerEmail,
+	FieldBuyerName,
+	FieldBuyerTaxID,
+	FieldBuyerAddress,
+	FieldSellerName,
+	FieldSellerTaxID,
+	FieldSellerAddress,
+	FieldNotes,
+	FieldIssuedAt,
+	FieldEmailedAt,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// InvoiceNoValidator is a validator for the "invoice_no" field. It is called by the builders before save.
+	InvoiceNoValidator func(string) error
+	// DefaultKind holds the default value on creation for the "kind" field.
+	DefaultKind string
+	// KindValidator is a validator for the "kind" field. It is called by the builders before save.
+	KindValidator func(string) error
+	// SourceTypeValidator is a validator for the "source_type" field. It is called by the builders before save.
+	SourceTypeValidator func(string) error
+	// DefaultSourceNo holds the default value on creation for the "source_no" field.
+	DefaultSourceNo string
+	// SourceNoValidator is a validator for the "source_no" field. It is called by the builders before save.
+	SourceNoValidator func(string) error
+	// DefaultCurrency holds the default value on creation for the "currency" field.
+	DefaultCurrency string
+	// CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	CurrencyValidator func(string) error
+	// DefaultDiscountAmount holds the default value on creation for the "discount_amount" field.
+	DefaultDiscountAmount float64
+	// DefaultFeeAmount holds the default value on creation for the "fee_amount" field.
+	DefaultFeeAmount float64
+	// DefaultPaymentMethod holds the default value on creation for the "payment_method" field.
+	DefaultPaymentMethod string
+	// PaymentMethodValidator is a validator for the "payment_method" field. It is called by the builders before save.
+	PaymentMethodValidator func(string) error
+	// DefaultBuyerEmail holds the default value on creation for the "buyer_email" field.
+	DefaultBuyerEmail string
+	// BuyerEmailValidator is a validator for the "buyer_email" field. It is called by the builders before save.
+	BuyerEmailValidator func(string) error
+	// DefaultBuyerName holds the default value on creation for the "buyer_name" field.
+	DefaultBuyerName string
+	// BuyerNameValidator is a validator for the "buyer_name" field. It is called by the builders before save.
+	BuyerNameValidator func(string) error
+	// DefaultBuyerTaxID holds the default value on creation for the "buyer_tax_id" field.
+	DefaultBuyerTaxID string
+	// BuyerTaxIDValidator is a validator for the "buyer_tax_id" field. It is called by the builders before save.
+	BuyerTaxIDValidator func(string) error
+	// DefaultBuyerAddress holds the default value on creation for the "buyer_address" field.
+	DefaultBuyerAddress string
+	// DefaultSellerName holds the default value on creation for the "seller_name" field.
+	DefaultSellerName string
+	// SellerNameValidator is a validator for the "seller_name" field. It is called by the builders before save.
+	SellerNameValidator func(string) error
+	// DefaultSellerTaxID holds the default value on creation for the "seller_tax_id" field.
+	DefaultSellerTaxID string
+	// SellerTaxIDValidator is a validator for the "seller_tax_id" field. It is called by the builders before save.
+	SellerTaxIDValidator func(string) error
+	// DefaultSellerAddress holds the default value on creation for the "seller_address" field.
+	DefaultSellerAddress string
+	// DefaultNotes holds the default value on creation for the "notes" field.
+	DefaultNotes string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the Invoice queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByInvoiceNo orders the results by the invoice_no field.
+func ByInvoiceNo(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInvoiceNo, opts...).ToFunc()
+}
+
+// ByKind orders the results by the kind field.
+func ByKind(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKind, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// BySourceType orders the results by the source_type field.
+func BySourceType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSourceType, opts...).ToFunc()
+}
+
+// BySourceID orders the results by the source_id field.
+func BySourceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSourceID, opts...).ToFunc()
+}
+
+// BySourceNo orders the results by the source_no field.
+func BySourceNo(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSourceNo, opts...).ToFunc()
+}
+
+// ByOriginalInvoiceID orders the results by the original_invoice_id field.
+func ByOriginalInvoiceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOriginalInvoiceID, opts...).ToFunc()
+}
+
+// ByCurrency orders the results by the currency field.
+func ByCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrency, opts...).ToFunc()
+}
+
+// BySubtotal orders the results by the subtotal field.
+func BySubtotal(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubtotal, opts...).ToFunc()
+}
+
+// ByDiscountAmount orders the results by the discount_amount field.
+func ByDiscountAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDiscountAmount, opts...).ToFunc()
+}
+
+// ByFeeAmount orders the results by the fee_amount field.
+func ByFeeAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFeeAmount, opts...).ToFunc()
+}
+
+// ByTotal orders the results by the total field.
+func ByTotal(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotal, opts...).ToFunc()
+}
+
+// ByPaymentMethod orders the results by the payment_method field.
+func ByPaymentMethod(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPaymentMethod, opts...).ToFunc()
+}
+
+// ByBuyerEmail orders the results by the buyer_email field.
+func ByBuyerEmail(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBuyerEmail, opts...).ToFunc()
+}
+
+// ByBuyerName orders the results by the buyer_name field.
+func ByBuyerName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBuyerName, opts...).ToFunc()
+}
+
+// ByBuyerTaxID orders the results by the buyer_tax_id field.
+func ByBuyerTaxID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBuyerTaxID, opts...).ToFunc()
+}
+
+// ByBuyerAddress orders the results by the buyer_address field.
+func ByBuyerAddress(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBuyerAddress, opts...).ToFunc()
+}
+
+// BySellerName orders the results by the seller_name field.
+func BySellerName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSellerName, opts...).ToFunc()
+}
+
+// BySellerTaxID orders the results by the seller_tax_id field.
+func BySellerTaxID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSellerTaxID, opts...).ToFunc()
+}
+
+// BySellerAddress orders the results by the seller_address field.
+func BySellerAddress(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSellerAddress, opts...).ToFunc()
+}
+
+// ByNotes orders the results by the notes field.
+func ByNotes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNotes, opts...).ToFunc()
+}
+
+// ByIssuedAt orders the results by the issued_at field.
+func ByIssuedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIssuedAt, opts...).ToFunc()
+}
+
+// ByEmailedAt orders the results by the emailed_at field.
+func ByEmailedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmailedAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,1520 @@
+// Code generated by ent, DO NOT EDIT.
+
+package invoice
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Wei-Shaw/sub2api/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldID, id))
+}
+
+// InvoiceNo applies equality check predicate on the "invoice_no" field. It's identical to InvoiceNoEQ.
+func InvoiceNo(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldInvoiceNo, v))
+}
+
+// Kind applies equality check predicate on the "kind" field. It's identical to KindEQ.
+func Kind(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldKind, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldUserID, v))
+}
+
+// SourceType applies equality check predicate on the "source_type" field. It's identical to SourceTypeEQ.
+func SourceType(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldSourceType, v))
+}
+
+// SourceID applies equality check predicate on the "source_id" field. It's identical to SourceIDEQ.
+func SourceID(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldSourceID, v))
+}
+
+// SourceNo applies equality check predicate on the "source_no" field. It's identical to SourceNoEQ.
+func SourceNo(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldSourceNo, v))
+}
+
+// OriginalInvoiceID applies equality check predicate on the "original_invoice_id" field. It's identical to OriginalInvoiceIDEQ.
+func OriginalInvoiceID(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldOriginalInvoiceID, v))
+}
+
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldCurrency, v))
+}
+
+// Subtotal applies equality check predicate on the "subtotal" field. It's identical to SubtotalEQ.
+func Subtotal(v float64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldSubtotal, v))
+}
+
+// DiscountAmount applies equality check predicate on the "discount_amount" field. It's identical to DiscountAmountEQ.
+func DiscountAmount(v float64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldDiscountAmount, v))
+}
+
+// FeeAmount applies equality check predicate on the "fee_amount" field. It's identical to FeeAmountEQ.
+func FeeAmount(v float64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldFeeAmount, v))
+}
+
+// Total applies equality check predicate on the "total" field. It's identical to TotalEQ.
+func Total(v float64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldTotal, v))
+}
+
+// PaymentMethod applies equality check predicate on the "payment_method" field. It's identical to PaymentMethodEQ.
+func PaymentMethod(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldPaymentMethod, v))
+}
+
+// BuyerEmail applies equality check predicate on the "buyer_email" field. It's identical to BuyerEmailEQ.
+func BuyerEmail(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldBuyerEmail, v))
+}
+
+// BuyerName applies equality check predicate on the "buyer_name" field. It's identical to BuyerNameEQ.
+func BuyerName(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldBuyerName, v))
+}
+
+// BuyerTaxID applies equality check predicate on the "buyer_tax_id" field. It's identical to BuyerTaxIDEQ.
+func BuyerTaxID(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldBuyerTaxID, v))
+}
+
+// BuyerAddress applies equality check predicate on the "buyer_address" field. It's identical to BuyerAddressEQ.
+func BuyerAddress(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldBuyerAddress, v))
+}
+
+// SellerName applies equality check predicate on the "seller_name" field. It's identical to SellerNameEQ.
+func SellerName(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldSellerName, v))
+}
+
+// SellerTaxID applies equality check predicate on the "seller_tax_id" field. It's identical to SellerTaxIDEQ.
+func SellerTaxID(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldSellerTaxID, v))
+}
+
+// SellerAddress applies equality check predicate on the "seller_address" field. It's identical to SellerAddressEQ.
+func SellerAddress(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldSellerAddress, v))
+}
+
+// Notes applies equality check predicate on the "notes" field. It's identical to NotesEQ.
+func Notes(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldNotes, v))
+}
+
+// IssuedAt applies equality check predicate on the "issued_at" field. It's identical to IssuedAtEQ.
+func IssuedAt(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldIssuedAt, v))
+}
+
+// EmailedAt applies equality check predicate on the "emailed_at" field. It's identical to EmailedAtEQ.
+func EmailedAt(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldEmailedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// InvoiceNoEQ applies the EQ predicate on the "invoice_no" field.
+func InvoiceNoEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldInvoiceNo, v))
+}
+
+// InvoiceNoNEQ applies the NEQ predicate on the "invoice_no" field.
+func InvoiceNoNEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldInvoiceNo, v))
+}
+
+// InvoiceNoIn applies the In predicate on the "invoice_no" field.
+func InvoiceNoIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldInvoiceNo, vs...))
+}
+
+// InvoiceNoNotIn applies the NotIn predicate on the "invoice_no" field.
+func InvoiceNoNotIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldInvoiceNo, vs...))
+}
+
+// InvoiceNoGT applies the GT predicate on the "invoice_no" field.
+func InvoiceNoGT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldInvoiceNo, v))
+}
+
+// InvoiceNoGTE applies the GTE predicate on the "invoice_no" field.
+func InvoiceNoGTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldInvoiceNo, v))
+}
+
+// InvoiceNoLT applies the LT predicate on the "invoice_no" field.
+func InvoiceNoLT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldInvoiceNo, v))
+}
+
+// InvoiceNoLTE applies the LTE predicate on the "invoice_no" field.
+func InvoiceNoLTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldInvoiceNo, v))
+}
+
+// InvoiceNoContains applies the Contains predicate on the "invoice_no" field.
+func InvoiceNoContains(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContains(FieldInvoiceNo, v))
+}
+
+// InvoiceNoHasPrefix applies the HasPrefix predicate on the "invoice_no" field.
+func InvoiceNoHasPrefix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasPrefix(FieldInvoiceNo, v))
+}
+
+// InvoiceNoHasSuffix applies the HasSuffix predicate on the "invoice_no" field.
+func InvoiceNoHasSuffix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasSuffix(FieldInvoiceNo, v))
+}
+
+// InvoiceNoEqualFold applies the EqualFold predicate on the "invoice_no" field.
+func InvoiceNoEqualFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEqualFold(FieldInvoiceNo, v))
+}
+
+// InvoiceNoContainsFold applies the ContainsFold predicate on the "invoice_no" field.
+func InvoiceNoContainsFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContainsFold(FieldInvoiceNo, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// KindGT applies the GT predicate on the "kind" field.
+func KindGT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldKind, v))
+}
+
+// KindGTE applies the GTE predicate on the "kind" field.
+func KindGTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldKind, v))
+}
+
+// KindLT applies the LT predicate on the "kind" field.
+func KindLT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldKind, v))
+}
+
+// KindLTE applies the LTE predicate on the "kind" field.
+func KindLTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldKind, v))
+}
+
+// KindContains applies the Contains predicate on the "kind" field.
+func KindContains(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContains(FieldKind, v))
+}
+
+// KindHasPrefix applies the HasPrefix predicate on the "kind" field.
+func KindHasPrefix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasPrefix(FieldKind, v))
+}
+
+// KindHasSuffix applies the HasSuffix predicate on the "kind" field.
+func KindHasSuffix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasSuffix(FieldKind, v))
+}
+
+// KindEqualFold applies the EqualFold predicate on the "kind" field.
+func KindEqualFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEqualFold(FieldKind, v))
+}
+
+// KindContainsFold applies the ContainsFold predicate on the "kind" field.
+func KindContainsFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContainsFold(FieldKind, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldUserID, v))
+}
+
+// SourceTypeEQ applies the EQ predicate on the "source_type" field.
+func SourceTypeEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldSourceType, v))
+}
+
+// SourceTypeNEQ applies the NEQ predicate on the "source_type" field.
+func SourceTypeNEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldSourceType, v))
+}
+
+// SourceTypeIn applies the In predicate on the "source_type" field.
+func SourceTypeIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldSourceType, vs...))
+}
+
+// SourceTypeNotIn applies the NotIn predicate on the "source_type" field.
+func SourceTypeNotIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldSourceType, vs...))
+}
+
+// SourceTypeGT applies the GT predicate on the "source_type" field.
+func SourceTypeGT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldSourceType, v))
+}
+
+// SourceTypeGTE applies the GTE predicate on the "source_type" field.
+func SourceTypeGTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldSourceType, v))
+}
+
+// SourceTypeLT applies the LT predicate on the "source_type" field.
+func SourceTypeLT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldSourceType, v))
+}
+
+// SourceTypeLTE applies the LTE predicate on the "source_type" field.
+func SourceTypeLTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldSourceType, v))
+}
+
+// SourceTypeContains applies the Contains predicate on the "source_type" field.
+func SourceTypeContains(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContains(FieldSourceType, v))
+}
+
+// SourceTypeHasPrefix applies the HasPrefix predicate on the "source_type" field.
+func SourceTypeHasPrefix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasPrefix(FieldSourceType, v))
+}
+
+// SourceTypeHasSuffix applies the HasSuffix predicate on the "source_type" field.
+func SourceTypeHasSuffix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasSuffix(FieldSourceType, v))
+}
+
+// SourceTypeEqualFold applies the EqualFold predicate on the "source_type" field.
+func SourceTypeEqualFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEqualFold(FieldSourceType, v))
+}
+
+// SourceTypeContainsFold applies the ContainsFold predicate on the "source_type" field.
+func SourceTypeContainsFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContainsFold(FieldSourceType, v))
+}
+
+// SourceIDEQ applies the EQ predicate on the "source_id" field.
+func SourceIDEQ(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldSourceID, v))
+}
+
+// SourceIDNEQ applies the NEQ predicate on the "source_id" field.
+func SourceIDNEQ(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldSourceID, v))
+}
+
+// SourceIDIn applies the In predicate on the "source_id" field.
+func SourceIDIn(vs ...int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldSourceID, vs...))
+}
+
+// SourceIDNotIn applies the NotIn predicate on the "source_id" field.
+func SourceIDNotIn(vs ...int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldSourceID, vs...))
+}
+
+// SourceIDGT applies the GT predicate on the "source_id" field.
+func SourceIDGT(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldSourceID, v))
+}
+
+// SourceIDGTE applies the GTE predicate on the "source_id" field.
+func SourceIDGTE(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldSourceID, v))
+}
+
+// SourceIDLT applies the LT predicate on the "source_id" field.
+func SourceIDLT(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldSourceID, v))
+}
+
+// SourceIDLTE applies the LTE predicate on the "source_id" field.
+func SourceIDLTE(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldSourceID, v))
+}
+
+// SourceNoEQ applies the EQ predicate on the "source_no" field.
+func SourceNoEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldSourceNo, v))
+}
+
+// SourceNoNEQ applies the NEQ predicate on the "source_no" field.
+func SourceNoNEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldSourceNo, v))
+}
+
+// SourceNoIn applies the In predicate on the "source_no" field.
+func SourceNoIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldSourceNo, vs...))
+}
+
+// SourceNoNotIn applies the NotIn predicate on the "source_no" field.
+func SourceNoNotIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldSourceNo, vs...))
+}
+
+// SourceNoGT applies the GT predicate on the "source_no" field.
+func SourceNoGT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldSourceNo, v))
+}
+
+// SourceNoGTE applies the GTE predicate on the "source_no" field.
+func SourceNoGTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldSourceNo, v))
+}
+
+// SourceNoLT applies the LT predicate on the "source_no" field.
+func SourceNoLT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldSourceNo, v))
+}
+
+// SourceNoLTE applies the LTE predicate on the "source_no" field.
+func SourceNoLTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldSourceNo, v))
+}
+
+// SourceNoContains applies the Contains predicate on the "source_no" field.
+func SourceNoContains(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContains(FieldSourceNo, v))
+}
+
+// SourceNoHasPrefix applies the HasPrefix predicate on the "source_no" field.
+func SourceNoHasPrefix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasPrefix(FieldSourceNo, v))
+}
+
+// SourceNoHasSuffix applies the HasSuffix predicate on the "source_no" field.
+func SourceNoHasSuffix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasSuffix(FieldSourceNo, v))
+}
+
+// SourceNoEqualFold applies the EqualFold predicate on the "source_no" field.
+func SourceNoEqualFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEqualFold(FieldSourceNo, v))
+}
+
+// SourceNoContainsFold applies the ContainsFold predicate on the "source_no" field.
+func SourceNoContainsFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContainsFold(FieldSourceNo, v))
+}
+
+// OriginalInvoiceIDEQ applies the EQ predicate on the "original_invoice_id" field.
+func OriginalInvoiceIDEQ(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldOriginalInvoiceID, v))
+}
+
+// OriginalInvoiceIDNEQ applies the NEQ predicate on the "original_invoice_id" field.
+func OriginalInvoiceIDNEQ(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldOriginalInvoiceID, v))
+}
+
+// OriginalInvoiceIDIn applies the In predicate on the "original_invoice_id" field.
+func OriginalInvoiceIDIn(vs ...int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldOriginalInvoiceID, vs...))
+}
+
+// OriginalInvoiceIDNotIn applies the NotIn predicate on the "original_invoice_id" field.
+func OriginalInvoiceIDNotIn(vs ...int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldOriginalInvoiceID, vs...))
+}
+
+// OriginalInvoiceIDGT applies the GT predicate on the "original_invoice_id" field.
+func OriginalInvoiceIDGT(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldOriginalInvoiceID, v))
+}
+
+// OriginalInvoiceIDGTE applies the GTE predicate on the "original_invoice_id" field.
+func OriginalInvoiceIDGTE(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldOriginalInvoiceID, v))
+}
+
+// OriginalInvoiceIDLT applies the LT predicate on the "original_invoice_id" field.
+func OriginalInvoiceIDLT(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldOriginalInvoiceID, v))
+}
+
+// OriginalInvoiceIDLTE applies the LTE predicate on the "original_invoice_id" field.
+func OriginalInvoiceIDLTE(v int64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldOriginalInvoiceID, v))
+}
+
+// OriginalInvoiceIDIsNil applies the IsNil predicate on the "original_invoice_id" field.
+func OriginalInvoiceIDIsNil() predicate.Invoice {
+	return predicate.Invoice(sql.FieldIsNull(FieldOriginalInvoiceID))
+}
+
+// OriginalInvoiceIDNotNil applies the NotNil predicate on the "original_invoice_id" field.
+func OriginalInvoiceIDNotNil() predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotNull(FieldOriginalInvoiceID))
+}
+
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContainsFold(FieldCurrency, v))
+}
+
+// SubtotalEQ applies the EQ predicate on the "subtotal" field.
+func SubtotalEQ(v float64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldSubtotal, v))
+}
+
+// SubtotalNEQ applies the NEQ predicate on the "subtotal" field.
+func SubtotalNEQ(v float64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldSubtotal, v))
+}
+
+// SubtotalIn applies the In predicate on the "subtotal" field.
+func SubtotalIn(vs ...float64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldSubtotal, vs...))
+}
+
+// SubtotalNotIn applies the NotIn predicate on the "subtotal" field.
+func SubtotalNotIn(vs ...float64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldSubtotal, vs...))
+}
+
+// SubtotalGT applies the GT predicate on the "subtotal" field.
+func SubtotalGT(v float64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldSubtotal, v))
+}
+
+// SubtotalGTE applies the GTE predicate on the "subtotal" field.
+func SubtotalGTE(v float64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldSubtotal, v))
+}
+
+// SubtotalLT applies the LT predicate on the "subtotal" field.
+func SubtotalLT(v float64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldSubtotal, v))
+}
+
+// SubtotalLTE applies the LTE predicate on the "subtotal" field.
+func SubtotalLTE(v float64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldSubtotal, v))
+}
+
+// DiscountAmountEQ applies the EQ predicate on the "discount_amount" field.
+func DiscountAmountEQ(v float64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldDiscountAmount, v))
+}
+
+// DiscountAmountNEQ applies the NEQ predicate on the "discount_amount" field.
+func DiscountAmountNEQ(v float64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldDiscountAmount, v))
+}
+
+// DiscountAmountIn applies the In predicate on the "discount_amount" field.
+func DiscountAmountIn(vs ...float64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldDiscountAmount, vs...))
+}
+
+// DiscountAmountNotIn applies the NotIn predicate on the "discount_amount" field.
+func DiscountAmountNotIn(vs ...float64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldDiscountAmount, vs...))
+}
+
+// DiscountAmountGT applies the GT predicate on the "discount_amount" field.
+func DiscountAmountGT(v float64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldDiscountAmount, v))
+}
+
+// DiscountAmountGTE applies the GTE predicate on the "discount_amount" field.
+func DiscountAmountGTE(v float64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldDiscountAmount, v))
+}
+
+// DiscountAmountLT applies the LT predicate on the "discount_amount" field.
+func DiscountAmountLT(v float64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldDiscountAmount, v))
+}
+
+// DiscountAmountLTE applies the LTE predicate on the "discount_amount" field.
+func DiscountAmountLTE(v float64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldDiscountAmount, v))
+}
+
+// FeeAmountEQ applies the EQ predicate on the "fee_amount" field.
+func FeeAmountEQ(v float64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldFeeAmount, v))
+}
+
+// FeeAmountNEQ applies the NEQ predicate on the "fee_amount" field.
+func FeeAmountNEQ(v float64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldFeeAmount, v))
+}
+
+// FeeAmountIn applies the In predicate on the "fee_amount" field.
+func FeeAmountIn(vs ...float64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldFeeAmount, vs...))
+}
+
+// FeeAmountNotIn applies the NotIn predicate on the "fee_amount" field.
+func FeeAmountNotIn(vs ...float64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldFeeAmount, vs...))
+}
+
+// FeeAmountGT applies the GT predicate on the "fee_amount" field.
+func FeeAmountGT(v float64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldFeeAmount, v))
+}
+
+// FeeAmountGTE applies the GTE predicate on the "fee_amount" field.
+func FeeAmountGTE(v float64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldFeeAmount, v))
+}
+
+// FeeAmountLT applies the LT predicate on the "fee_amount" field.
+func FeeAmountLT(v float64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldFeeAmount, v))
+}
+
+// FeeAmountLTE applies the LTE predicate on the "fee_amount" field.
+func FeeAmountLTE(v float64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldFeeAmount, v))
+}
+
+// TotalEQ applies the EQ predicate on the "total" field.
+func TotalEQ(v float64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldTotal, v))
+}
+
+// TotalNEQ applies the NEQ predicate on the "total" field.
+func TotalNEQ(v float64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldTotal, v))
+}
+
+// TotalIn applies the In predicate on the "total" field.
+func TotalIn(vs ...float64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldTotal, vs...))
+}
+
+// TotalNotIn applies the NotIn predicate on the "total" field.
+func TotalNotIn(vs ...float64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldTotal, vs...))
+}
+
+// TotalGT applies the GT predicate on the "total" field.
+func TotalGT(v float64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldTotal, v))
+}
+
+// TotalGTE applies the GTE predicate on the "total" field.
+func TotalGTE(v float64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldTotal, v))
+}
+
+// TotalLT applies the LT predicate on the "total" field.
+func TotalLT(v float64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldTotal, v))
+}
+
+// TotalLTE applies the LTE predicate on the "total" field.
+func TotalLTE(v float64) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldTotal, v))
+}
+
+// PaymentMethodEQ applies the EQ predicate on the "payment_method" field.
+func PaymentMethodEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldPaymentMethod, v))
+}
+
+// PaymentMethodNEQ applies the NEQ predicate on the "payment_method" field.
+func PaymentMethodNEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldPaymentMethod, v))
+}
+
+// PaymentMethodIn applies the In predicate on the "payment_method" field.
+func PaymentMethodIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldPaymentMethod, vs...))
+}
+
+// PaymentMethodNotIn applies the NotIn predicate on the "payment_method" field.
+func PaymentMethodNotIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldPaymentMethod, vs...))
+}
+
+// PaymentMethodGT applies the GT predicate on the "payment_method" field.
+func PaymentMethodGT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldPaymentMethod, v))
+}
+
+// PaymentMethodGTE applies the GTE predicate on the "payment_method" field.
+func PaymentMethodGTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldPaymentMethod, v))
+}
+
+// PaymentMethodLT applies the LT predicate on the "payment_method" field.
+func PaymentMethodLT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldPaymentMethod, v))
+}
+
+// PaymentMethodLTE applies the LTE predicate on the "payment_method" field.
+func PaymentMethodLTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldPaymentMethod, v))
+}
+
+// PaymentMethodContains applies the Contains predicate on the "payment_method" field.
+func PaymentMethodContains(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContains(FieldPaymentMethod, v))
+}
+
+// PaymentMethodHasPrefix applies the HasPrefix predicate on the "payment_method" field.
+func PaymentMethodHasPrefix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasPrefix(FieldPaymentMethod, v))
+}
+
+// PaymentMethodHasSuffix applies the HasSuffix predicate on the "payment_method" field.
+func PaymentMethodHasSuffix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasSuffix(FieldPaymentMethod, v))
+}
+
+// PaymentMethodEqualFold applies the EqualFold predicate on the "payment_method" field.
+func PaymentMethodEqualFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEqualFold(FieldPaymentMethod, v))
+}
+
+// PaymentMethodContainsFold applies the ContainsFold predicate on the "payment_method" field.
+func PaymentMethodContainsFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContainsFold(FieldPaymentMethod, v))
+}
+
+// BuyerEmailEQ applies the EQ predicate on the "buyer_email" field.
+func BuyerEmailEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldBuyerEmail, v))
+}
+
+// BuyerEmailNEQ applies the NEQ predicate on the "buyer_email" field.
+func BuyerEmailNEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldBuyerEmail, v))
+}
+
+// BuyerEmailIn applies the In predicate on the "buyer_email" field.
+func BuyerEmailIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldBuyerEmail, vs...))
+}
+
+// BuyerEmailNotIn applies the NotIn predicate on the "buyer_email" field.
+func BuyerEmailNotIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldBuyerEmail, vs...))
+}
+
+// BuyerEmailGT applies the GT predicate on the "buyer_email" field.
+func BuyerEmailGT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldBuyerEmail, v))
+}
+
+// BuyerEmailGTE applies the GTE predicate on the "buyer_email" field.
+func BuyerEmailGTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldBuyerEmail, v))
+}
+
+// BuyerEmailLT applies the LT predicate on the "buyer_email" field.
+func BuyerEmailLT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldBuyerEmail, v))
+}
+
+// BuyerEmailLTE applies the LTE predicate on the "buyer_email" field.
+func BuyerEmailLTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldBuyerEmail, v))
+}
+
+// BuyerEmailContains applies the Contains predicate on the "buyer_email" field.
+func BuyerEmailContains(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContains(FieldBuyerEmail, v))
+}
+
+// BuyerEmailHasPrefix applies the HasPrefix predicate on the "buyer_email" field.
+func BuyerEmailHasPrefix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasPrefix(FieldBuyerEmail, v))
+}
+
+// BuyerEmailHasSuffix applies the HasSuffix predicate on the "buyer_email" field.
+func BuyerEmailHasSuffix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasSuffix(FieldBuyerEmail, v))
+}
+
+// BuyerEmailEqualFold applies the EqualFold predicate on the "buyer_email" field.
+func BuyerEmailEqualFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEqualFold(FieldBuyerEmail, v))
+}
+
+// BuyerEmailContainsFold applies the ContainsFold predicate on the "buyer_email" field.
+func BuyerEmailContainsFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContainsFold(FieldBuyerEmail, v))
+}
+
+// BuyerNameEQ applies the EQ predicate on the "buyer_name" field.
+func BuyerNameEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldBuyerName, v))
+}
+
+// BuyerNameNEQ applies the NEQ predicate on the "buyer_name" field.
+func BuyerNameNEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldBuyerName, v))
+}
+
+// BuyerNameIn applies the In predicate on the "buyer_name" field.
+func BuyerNameIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldBuyerName, vs...))
+}
+
+// BuyerNameNotIn applies the NotIn predicate on the "buyer_name" field.
+func BuyerNameNotIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldBuyerName, vs...))
+}
+
+// BuyerNameGT applies the GT predicate on the "buyer_name" field.
+func BuyerNameGT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldBuyerName, v))
+}
+
+// BuyerNameGTE applies the GTE predicate on the "buyer_name" field.
+func BuyerNameGTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldBuyerName, v))
+}
+
+// BuyerNameLT applies the LT predicate on the "buyer_name" field.
+func BuyerNameLT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldBuyerName, v))
+}
+
+// BuyerNameLTE applies the LTE predicate on the "buyer_name" field.
+func BuyerNameLTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldBuyerName, v))
+}
+
+// BuyerNameContains applies the Contains predicate on the "buyer_name" field.
+func BuyerNameContains(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContains(FieldBuyerName, v))
+}
+
+// BuyerNameHasPrefix applies the HasPrefix predicate on the "buyer_name" field.
+func BuyerNameHasPrefix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasPrefix(FieldBuyerName, v))
+}
+
+// BuyerNameHasSuffix applies the HasSuffix predicate on the "buyer_name" field.
+func BuyerNameHasSuffix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasSuffix(FieldBuyerName, v))
+}
+
+// BuyerNameEqualFold applies the EqualFold predicate on the "buyer_name" field.
+func BuyerNameEqualFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEqualFold(FieldBuyerName, v))
+}
+
+// BuyerNameContainsFold applies the ContainsFold predicate on the "buyer_name" field.
+func BuyerNameContainsFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContainsFold(FieldBuyerName, v))
+}
+
+// BuyerTaxIDEQ applies the EQ predicate on the "buyer_tax_id" field.
+func BuyerTaxIDEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldBuyerTaxID, v))
+}
+
+// BuyerTaxIDNEQ applies the NEQ predicate on the "buyer_tax_id" field.
+func BuyerTaxIDNEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldBuyerTaxID, v))
+}
+
+// BuyerTaxIDIn applies the In predicate on the "buyer_tax_id" field.
+func BuyerTaxIDIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldBuyerTaxID, vs...))
+}
+
+// BuyerTaxIDNotIn applies the NotIn predicate on the "buyer_tax_id" field.
+func BuyerTaxIDNotIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldBuyerTaxID, vs...))
+}
+
+// BuyerTaxIDGT applies the GT predicate on the "buyer_tax_id" field.
+func BuyerTaxIDGT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldBuyerTaxID, v))
+}
+
+// BuyerTaxIDGTE applies the GTE predicate on the "buyer_tax_id" field.
+func BuyerTaxIDGTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldBuyerTaxID, v))
+}
+
+// BuyerTaxIDLT applies the LT predicate on the "buyer_tax_id" field.
+func BuyerTaxIDLT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldBuyerTaxID, v))
+}
+
+// BuyerTaxIDLTE applies the LTE predicate on the "buyer_tax_id" field.
+func BuyerTaxIDLTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldBuyerTaxID, v))
+}
+
+// BuyerTaxIDContains applies the Contains predicate on the "buyer_tax_id" field.
+func BuyerTaxIDContains(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContains(FieldBuyerTaxID, v))
+}
+
+// BuyerTaxIDHasPrefix applies the HasPrefix predicate on the "buyer_tax_id" field.
+func BuyerTaxIDHasPrefix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasPrefix(FieldBuyerTaxID, v))
+}
+
+// BuyerTaxIDHasSuffix applies the HasSuffix predicate on the "buyer_tax_id" field.
+func BuyerTaxIDHasSuffix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasSuffix(FieldBuyerTaxID, v))
+}
+
+// BuyerTaxIDEqualFold applies the EqualFold predicate on the "buyer_tax_id" field.
+func BuyerTaxIDEqualFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEqualFold(FieldBuyerTaxID, v))
+}
+
+// BuyerTaxIDContainsFold applies the ContainsFold predicate on the "buyer_tax_id" field.
+func BuyerTaxIDContainsFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContainsFold(FieldBuyerTaxID, v))
+}
+
+// BuyerAddressEQ applies the EQ predicate on the "buyer_address" field.
+func BuyerAddressEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldBuyerAddress, v))
+}
+
+// BuyerAddressNEQ applies the NEQ predicate on the "buyer_address" field.
+func BuyerAddressNEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldBuyerAddress, v))
+}
+
+// BuyerAddressIn applies the In predicate on the "buyer_address" field.
+func BuyerAddressIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldBuyerAddress, vs...))
+}
+
+// BuyerAddressNotIn applies the NotIn predicate on the "buyer_address" field.
+func BuyerAddressNotIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldBuyerAddress, vs...))
+}
+
+// BuyerAddressGT applies the GT predicate on the "buyer_address" field.
+func BuyerAddressGT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldBuyerAddress, v))
+}
+
+// BuyerAddressGTE applies the GTE predicate on the "buyer_address" field.
+func BuyerAddressGTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldBuyerAddress, v))
+}
+
+// BuyerAddressLT applies the LT predicate on the "buyer_address" field.
+func BuyerAddressLT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldBuyerAddress, v))
+}
+
+// BuyerAddressLTE applies the LTE predicate on the "buyer_address" field.
+func BuyerAddressLTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldBuyerAddress, v))
+}
+
+// BuyerAddressContains applies the Contains predicate on the "buyer_address" field.
+func BuyerAddressContains(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContains(FieldBuyerAddress, v))
+}
+
+// BuyerAddressHasPrefix applies the HasPrefix predicate on the "buyer_address" field.
+func BuyerAddressHasPrefix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasPrefix(FieldBuyerAddress, v))
+}
+
+// BuyerAddressHasSuffix applies the HasSuffix predicate on the "buyer_address" field.
+func BuyerAddressHasSuffix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasSuffix(FieldBuyerAddress, v))
+}
+
+// BuyerAddressEqualFold applies the EqualFold predicate on the "buyer_address" field.
+func BuyerAddressEqualFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEqualFold(FieldBuyerAddress, v))
+}
+
+// BuyerAddressContainsFold applies the ContainsFold predicate on the "buyer_address" field.
+func BuyerAddressContainsFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContainsFold(FieldBuyerAddress, v))
+}
+
+// SellerNameEQ applies the EQ predicate on the "seller_name" field.
+func SellerNameEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldSellerName, v))
+}
+
+// SellerNameNEQ applies the NEQ predicate on the "seller_name" field.
+func SellerNameNEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldSellerName, v))
+}
+
+// SellerNameIn applies the In predicate on the "seller_name" field.
+func SellerNameIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldSellerName, vs...))
+}
+
+// SellerNameNotIn applies the NotIn predicate on the "seller_name" field.
+func SellerNameNotIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldSellerName, vs...))
+}
+
+// SellerNameGT applies the GT predicate on the "seller_name" field.
+func SellerNameGT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldSellerName, v))
+}
+
+// SellerNameGTE applies the GTE predicate on the "seller_name" field.
+func SellerNameGTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldSellerName, v))
+}
+
+// SellerNameLT applies the LT predicate on the "seller_name" field.
+func SellerNameLT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldSellerName, v))
+}
+
+// SellerNameLTE applies the LTE predicate on the "seller_name" field.
+func SellerNameLTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldSellerName, v))
+}
+
+// SellerNameContains applies the Contains predicate on the "seller_name" field.
+func SellerNameContains(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContains(FieldSellerName, v))
+}
+
+// SellerNameHasPrefix applies the HasPrefix predicate on the "seller_name" field.
+func SellerNameHasPrefix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasPrefix(FieldSellerName, v))
+}
+
+// SellerNameHasSuffix applies the HasSuffix predicate on the "seller_name" field.
+func SellerNameHasSuffix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasSuffix(FieldSellerName, v))
+}
+
+// SellerNameEqualFold applies the EqualFold predicate on the "seller_name" field.
+func SellerNameEqualFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEqualFold(FieldSellerName, v))
+}
+
+// SellerNameContainsFold applies the ContainsFold predicate on the "seller_name" field.
+func SellerNameContainsFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContainsFold(FieldSellerName, v))
+}
+
+// SellerTaxIDEQ applies the EQ predicate on the "seller_tax_id" field.
+func SellerTaxIDEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldSellerTaxID, v))
+}
+
+// SellerTaxIDNEQ applies the NEQ predicate on the "seller_tax_id" field.
+func SellerTaxIDNEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldSellerTaxID, v))
+}
+
+// SellerTaxIDIn applies the In predicate on the "seller_tax_id" field.
+func SellerTaxIDIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldSellerTaxID, vs...))
+}
+
+// SellerTaxIDNotIn applies the NotIn predicate on the "seller_tax_id" field.
+func SellerTaxIDNotIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldSellerTaxID, vs...))
+}
+
+// SellerTaxIDGT applies the GT predicate on the "seller_tax_id" field.
+func SellerTaxIDGT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldSellerTaxID, v))
+}
+
+// SellerTaxIDGTE applies the GTE predicate on the "seller_tax_id" field.
+func SellerTaxIDGTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldSellerTaxID, v))
+}
+
+// SellerTaxIDLT applies the LT predicate on the "seller_tax_id" field.
+func SellerTaxIDLT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldSellerTaxID, v))
+}
+
+// SellerTaxIDLTE applies the LTE predicate on the "seller_tax_id" field.
+func SellerTaxIDLTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldSellerTaxID, v))
+}
+
+// SellerTaxIDContains applies the Contains predicate on the "seller_tax_id" field.
+func SellerTaxIDContains(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContains(FieldSellerTaxID, v))
+}
+
+// SellerTaxIDHasPrefix applies the HasPrefix predicate on the "seller_tax_id" field.
+func SellerTaxIDHasPrefix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasPrefix(FieldSellerTaxID, v))
+}
+
+// SellerTaxIDHasSuffix applies the HasSuffix predicate on the "seller_tax_id" field.
+func SellerTaxIDHasSuffix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasSuffix(FieldSellerTaxID, v))
+}
+
+// SellerTaxIDEqualFold applies the EqualFold predicate on the "seller_tax_id" field.
+func SellerTaxIDEqualFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEqualFold(FieldSellerTaxID, v))
+}
+
+// SellerTaxIDContainsFold applies the ContainsFold predicate on the "seller_tax_id" field.
+func SellerTaxIDContainsFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContainsFold(FieldSellerTaxID, v))
+}
+
+// SellerAddressEQ applies the EQ predicate on the "seller_address" field.
+func SellerAddressEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldSellerAddress, v))
+}
+
+// SellerAddressNEQ applies the NEQ predicate on the "seller_address" field.
+func SellerAddressNEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldSellerAddress, v))
+}
+
+// SellerAddressIn applies the In predicate on the "seller_address" field.
+func SellerAddressIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldSellerAddress, vs...))
+}
+
+// SellerAddressNotIn applies the NotIn predicate on the "seller_address" field.
+func SellerAddressNotIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldSellerAddress, vs...))
+}
+
+// SellerAddressGT applies the GT predicate on the "seller_address" field.
+func SellerAddressGT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldSellerAddress, v))
+}
+
+// SellerAddressGTE applies the GTE predicate on the "seller_address" field.
+func SellerAddressGTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldSellerAddress, v))
+}
+
+// SellerAddressLT applies the LT predicate on the "seller_address" field.
+func SellerAddressLT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldSellerAddress, v))
+}
+
+// SellerAddressLTE applies the LTE predicate on the "seller_address" field.
+func SellerAddressLTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldSellerAddress, v))
+}
+
+// SellerAddressContains applies the Contains predicate on the "seller_address" field.
+func SellerAddressContains(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContains(FieldSellerAddress, v))
+}
+
+// SellerAddressHasPrefix applies the HasPrefix predicate on the "seller_address" field.
+func SellerAddressHasPrefix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasPrefix(FieldSellerAddress, v))
+}
+
+// SellerAddressHasSuffix applies the HasSuffix predicate on the "seller_address" field.
+func SellerAddressHasSuffix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasSuffix(FieldSellerAddress, v))
+}
+
+// SellerAddressEqualFold applies the EqualFold predicate on the "seller_address" field.
+func SellerAddressEqualFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEqualFold(FieldSellerAddress, v))
+}
+
+// SellerAddressContainsFold applies the ContainsFold predicate on the "seller_address" field.
+func SellerAddressContainsFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContainsFold(FieldSellerAddress, v))
+}
+
+// NotesEQ applies the EQ predicate on the "notes" field.
+func NotesEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldNotes, v))
+}
+
+// NotesNEQ applies the NEQ predicate on the "notes" field.
+func NotesNEQ(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldNotes, v))
+}
+
+// NotesIn applies the In predicate on the "notes" field.
+func NotesIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldNotes, vs...))
+}
+
+// NotesNotIn applies the NotIn predicate on the "notes" field.
+func NotesNotIn(vs ...string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldNotes, vs...))
+}
+
+// NotesGT applies the GT predicate on the "notes" field.
+func NotesGT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldNotes, v))
+}
+
+// NotesGTE applies the GTE predicate on the "notes" field.
+func NotesGTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldNotes, v))
+}
+
+// NotesLT applies the LT predicate on the "notes" field.
+func NotesLT(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldNotes, v))
+}
+
+// NotesLTE applies the LTE predicate on the "notes" field.
+func NotesLTE(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldNotes, v))
+}
+
+// NotesContains applies the Contains predicate on the "notes" field.
+func NotesContains(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContains(FieldNotes, v))
+}
+
+// NotesHasPrefix applies the HasPrefix predicate on the "notes" field.
+func NotesHasPrefix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasPrefix(FieldNotes, v))
+}
+
+// NotesHasSuffix applies the HasSuffix predicate on the "notes" field.
+func NotesHasSuffix(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldHasSuffix(FieldNotes, v))
+}
+
+// NotesEqualFold applies the EqualFold predicate on the "notes" field.
+func NotesEqualFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEqualFold(FieldNotes, v))
+}
+
+// NotesContainsFold applies the ContainsFold predicate on the "notes" field.
+func NotesContainsFold(v string) predicate.Invoice {
+	return predicate.Invoice(sql.FieldContainsFold(FieldNotes, v))
+}
+
+// IssuedAtEQ applies the EQ predicate on the "issued_at" field.
+func IssuedAtEQ(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldIssuedAt, v))
+}
+
+// IssuedAtNEQ applies the NEQ predicate on the "issued_at" field.
+func IssuedAtNEQ(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldIssuedAt, v))
+}
+
+// IssuedAtIn applies the In predicate on the "issued_at" field.
+func IssuedAtIn(vs ...time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldIssuedAt, vs...))
+}
+
+// IssuedAtNotIn applies the NotIn predicate on the "issued_at" field.
+func IssuedAtNotIn(vs ...time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldIssuedAt, vs...))
+}
+
+// IssuedAtGT applies the GT predicate on the "issued_at" field.
+func IssuedAtGT(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldIssuedAt, v))
+}
+
+// IssuedAtGTE applies the GTE predicate on the "issued_at" field.
+func IssuedAtGTE(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldIssuedAt, v))
+}
+
+// IssuedAtLT applies the LT predicate on the "issued_at" field.
+func IssuedAtLT(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldIssuedAt, v))
+}
+
+// IssuedAtLTE applies the LTE predicate on the "issued_at" field.
+func IssuedAtLTE(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldIssuedAt, v))
+}
+
+// EmailedAtEQ applies the EQ predicate on the "emailed_at" field.
+func EmailedAtEQ(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldEmailedAt, v))
+}
+
+// EmailedAtNEQ applies the NEQ predicate on the "emailed_at" field.
+func EmailedAtNEQ(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldEmailedAt, v))
+}
+
+// EmailedAtIn applies the In predicate on the "emailed_at" field.
+func EmailedAtIn(vs ...time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldEmailedAt, vs...))
+}
+
+// EmailedAtNotIn applies the NotIn predicate on the "emailed_at" field.
+func EmailedAtNotIn(vs ...time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldEmailedAt, vs...))
+}
+
+// EmailedAtGT applies the GT predicate on the "emailed_at" field.
+func EmailedAtGT(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldEmailedAt, v))
+}
+
+// EmailedAtGTE applies the GTE predicate on the "emailed_at" field.
+func EmailedAtGTE(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldEmailedAt, v))
+}
+
+// EmailedAtLT applies the LT predicate on the "emailed_at" field.
+func EmailedAtLT(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldEmailedAt, v))
+}
+
+// EmailedAtLTE applies the LTE predicate on the "emailed_at" field.
+func EmailedAtLTE(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldEmailedAt, v))
+}
+
+// EmailedAtIsNil applies the IsNil predicate on the "emailed_at" field.
+func EmailedAtIsNil() predicate.Invoice {
+	return predicate.Invoice(sql.FieldIsNull(FieldEmailedAt))
+}
+
+// EmailedAtNotNil applies the NotNil predicate on the "emailed_at" field.
+func EmailedAtNotNil() predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotNull(FieldEmailedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Invoice {
+	return predicate.Invoice(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Invoice) predicate.Invoice {
+	return predicate.Invoice(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Invoice) predicate.Invoice {
+	return predicate.Invoice(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Invoice) predicate.Invoice {
+	return predicate.Invoice(sql.NotPredicates(p))
+}
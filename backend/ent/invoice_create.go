@@ -0,0 +1,2131 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Wei-Shaw/sub2api/ent/invoice"
+	"github.com/Wei-Shaw/sub2api/internal/model"
+)
+
+// InvoiceCreate is the builder for creating a Invoice entity.
+type InvoiceCreate struct {
+	config
+	mutation *InvoiceMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetInvoiceNo sets the "invoice_no" field.
+func (_c *InvoiceCreate) SetInvoiceNo(v string) *InvoiceCreate {
+	_c.mutation.SetInvoiceNo(v)
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *InvoiceCreate) SetKind(v string) *InvoiceCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_c *InvoiceCreate) SetNillableKind(v *string) *InvoiceCreate {
+	if v != nil {
+		_c.SetKind(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *InvoiceCreate) SetUserID(v int64) *InvoiceCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetSourceType sets the "source_type" field.
+func (_c *InvoiceCreate) SetSourceType(v string) *InvoiceCreate {
+	_c.mutation.SetSourceType(v)
+	return _c
+}
+
+// SetSourceID sets the "source_id" field.
+func (_c *InvoiceCreate) SetSourceID(v int64) *InvoiceCreate {
+	_c.mutation.SetSourceID(v)
+	return _c
+}
+
+// SetSourceNo sets the "source_no" field.
+func (_c *InvoiceCreate) SetSourceNo(v string) *InvoiceCreate {
+	_c.mutation.SetSourceNo(v)
+	return _c
+}
+
+// SetNillableSourceNo sets the "source_no" field if the given value is not nil.
+func (_c *InvoiceCreate) SetNillableSourceNo(v *string) *InvoiceCreate {
+	if v != nil {
+		_c.SetSourceNo(*v)
+	}
+	return _c
+}
+
+// SetOriginalInvoiceID sets the "original_invoice_id" field.
+func (_c *InvoiceCreate) SetOriginalInvoiceID(v int64) *InvoiceCreate {
+	_c.mutation.SetOriginalInvoiceID(v)
+	return _c
+}
+
+// SetNillableOriginalInvoiceID sets the "original_invoice_id" field if the given value is not nil.
+func (_c *InvoiceCreate) SetNillableOriginalInvoiceID(v *int64) *InvoiceCreate {
+	if v != nil {
+		_c.SetOriginalInvoiceID(*v)
+	}
+	return _c
+}
+
+// SetCurrency sets the "currency" field.
+func (_c *InvoiceCreate) SetCurrency(v string) *InvoiceCreate {
+	_c.mutation.SetCurrency(v)
+	return _c
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_c *InvoiceCreate) SetNillableCurrency(v *string) *InvoiceCreate {
+	if v != nil {
+		_c.SetCurrency(*v)
+	}
+	return _c
+}
+
+// SetSubtotal sets the "subtotal" field.
+func (_c *InvoiceCreate) SetSubtotal(v float64) *InvoiceCreate {
+	_c.mutation.SetSubtotal(v)
+	return _c
+}
+
+// SetDiscountAmount sets the "discount_amount" field.
+func (_c *InvoiceCreate) SetDiscountAmount(v float64) *InvoiceCreate {
+	_c.mutation.SetDiscountAmount(v)
+	return _c
+}
+
+// SetNillableDiscountAmount sets the "discount_amount" field if the given value is not nil.
+func (_c *InvoiceCreate) SetNillableDiscountAmount(v *float64) *InvoiceCreate {
+	if v != nil {
+		_c.SetDiscountAmount(*v)
+	}
+	return _c
+}
+
+// SetFeeAmount sets the "fee_amount" field.
+func (_c *InvoiceCreate) SetFeeAmount(v float64) *InvoiceCreate {
+	_c.mutation.SetFeeAmount(v)
+	return _c
+}
+
+// SetNillableFeeAmount sets the "fee_amount" field if the given value is not nil.
+func (_c *InvoiceCreate) SetNillableFeeAmount(v *float64) *InvoiceCreate {
+	if v != nil {
+		_c.SetFeeAmount(*v)
+	}
+	return _c
+}
+
+// SetTotal sets the "total" field.
+func (_c *InvoiceCreate) SetTotal(v float64) *InvoiceCreate {
+	_c.mutation.SetTotal(v)
+	return _c
+}
+
+// SetLines sets the "lines" field.
+func (_c *InvoiceCreate) SetLines(v []model.InvoiceLine) *InvoiceCreate {
+	_c.mutation.SetLines(v)
+	return _c
+}
+
+// SetPaymentMethod sets the "payment_method" field.
+func (_c *InvoiceCreate) SetPaymentMethod(v string) *InvoiceCreate {
+	_c.mutation.SetPaymentMethod(v)
+	return _c
+}
+
+// SetNillablePaymentMethod sets the "payment_method" field if the given value is not nil.
+func (_c *InvoiceCreate) SetNillablePaymentMethod(v *string) *InvoiceCreate {
+	if v != nil {
+		_c.SetPaymentMethod(*v)
+	}
+	return _c
+}
+
+// SetBuyerEmail sets the "buyer_email" field.
+func (_c *InvoiceCreate) SetBuyerEmail(v string) *InvoiceCreate {
+	_c.mutation.SetBuyerEmail(v)
+	return _c
+}
+
+// SetNillableBuyerEmail sets the "buyer_email" field if the given value is not nil.
+func (_c *InvoiceCreate) SetNillableBuyerEmail(v *string) *InvoiceCreate {
+	if v != nil {
+		_c.SetBuyerEmail(*v)
+	}
+	return _c
+}
+
+// SetBuyerName sets the "buyer_name" field.
+func (_c *InvoiceCreate) SetBuyerName(v string) *InvoiceCreate {
+	_c.mutation.SetBuyerName(v)
+	return _c
+}
+
+// SetNillableBuyerName sets the "buyer_name" field if the given value is not nil.
+func (_c *InvoiceCreate) SetNillableBuyerName(v *string) *InvoiceCreate {
+	if v != nil {
+		_c.SetBuyerName(*v)
+	}
+	return _c
+}
+
+// SetBuyerTaxID sets the "buyer_tax_id" field.
+func (_c *InvoiceCreate) SetBuyerTaxID(v string) *InvoiceCreate {
+	_c.mutation.SetBuyerTaxID(v)
+	return _c
+}
+
+// SetNillableBuyerTaxID sets the "buyer_tax_id" field if the given value is not nil.
+func (_c *InvoiceCreate) SetNillableBuyerTaxID(v *string) *InvoiceCreate {
+	if v != nil {
+		_c.SetBuyerTaxID(*v)
+	}
+	return _c
+}
+
+// SetBuyerAddress sets the "buyer_address" field.
+func (_c *InvoiceCreate) SetBuyerAddress(v string) *InvoiceCreate {
+	_c.mutation.SetBuyerAddress(v)
+	return _c
+}
+
+// SetNillableBuyerAddress sets the "buyer_address" field if the given value is not nil.
+func (_c *InvoiceCreate) SetNillableBuyerAddress(v *string) *InvoiceCreate {
+	if v != nil {
+		_c.SetBuyerAddress(*v)
+	}
+	return _c
+}
+
+// SetSellerName sets the "seller_name" field.
+func (_c *InvoiceCreate) SetSellerName(v string) *InvoiceCreate {
+	_c.mutation.SetSellerName(v)
+	return _c
+}
+
+// SetNillableSellerName sets the "seller_name" field if the given value is not nil.
+func (_c *InvoiceCreate) SetNillableSellerName(v *string) *InvoiceCreate {
+	if v != nil {
+		_c.SetSellerName(*v)
+	}
+	return _c
+}
+
+// SetSellerTaxID sets the "seller_tax_id" field.
+func (_c *InvoiceCreate) SetSellerTaxID(v string) *InvoiceCreate {
+	_c.mutation.SetSellerTaxID(v)
+	return _c
+}
+
+// SetNillableSellerTaxID sets the "seller_tax_id" field if the given value is not nil.
+func (_c *InvoiceCreate) SetNillableSellerTaxID(v *string) *InvoiceCreate {
+	if v != nil {
+		_c.SetSellerTaxID(*v)
+	}
+	return _c
+}
+
+// SetSellerAddress sets the "seller_address" field.
+func (_c *InvoiceCreate) SetSellerAddress(v string) *InvoiceCreate {
+	_c.mutation.SetSellerAddress(v)
+	return _c
+}
+
+// SetNillableSellerAddress sets the "seller_address" field if the given value is not nil.
+func (_c *InvoiceCreate) SetNillableSellerAddress(v *string) *InvoiceCreate {
+	if v != nil {
+		_c.SetSellerAddress(*v)
+	}
+	return _c
+}
+
+// SetNotes sets the "notes" field.
+func (_c *InvoiceCreate) SetNotes(v string) *InvoiceCreate {
+	_c.mutation.SetNotes(v)
+	return _c
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (_c *InvoiceCreate) SetNillableNotes(v *string) *InvoiceCreate {
+	if v != nil {
+		_c.SetNotes(*v)
+	}
+	return _c
+}
+
+// SetIssuedAt sets the "issued_at" field.
+func (_c *InvoiceCreate) SetIssuedAt(v time.Time) *InvoiceCreate {
+	_c.mutation.SetIssuedAt(v)
+	return _c
+}
+
+// SetEmailedAt sets the "emailed_at" field.
+func (_c *InvoiceCreate) SetEmailedAt(v time.Time) *InvoiceCreate {
+	_c.mutation.SetEmailedAt(v)
+	return _c
+}
+
+// SetNillableEmailedAt sets the "emailed_at" field if the given value is not nil.
+func (_c *InvoiceCreate) SetNillableEmailedAt(v *time.Time) *InvoiceCreate {
+	if v != nil {
+		_c.SetEmailedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *InvoiceCreate) SetCreatedAt(v time.Time) *InvoiceCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *InvoiceCreate) SetNillableCreatedAt(v *time.Time) *InvoiceCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the InvoiceMutation object of the builder.
+func (_c *InvoiceCreate) Mutation() *InvoiceMutation {
+	return _c.mutation
+}
+
+// Save creates the Invoice in the database.
+func (_c *InvoiceCreate) Save(ctx context.Context) (*Invoice, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *InvoiceCreate) SaveX(ctx context.Context) *Invoice {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *InvoiceCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *InvoiceCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *InvoiceCreate) defaults() {
+	if _, ok := _c.mutation.Kind(); !ok {
+		v := invoice.DefaultKind
+		_c.mutation.SetKind(v)
+	}
+	if _, ok := _c.mutation.SourceNo(); !ok {
+		v := invoice.DefaultSourceNo
+		_c.mutation.SetSourceNo(v)
+	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		v := invoice.DefaultCurrency
+		_c.mutation.SetCurrency(v)
+	}
+	if _, ok := _c.mutation.DiscountAmount(); !ok {
+		v := invoice.DefaultDiscountAmount
+		_c.mutation.SetDiscountAmount(v)
+	}
+	if _, ok := _c.mutation.FeeAmount(); !ok {
+		v := invoice.DefaultFeeAmount
+		_c.mutation.SetFeeAmount(v)
+	}
+	if _, ok := _c.mutation.PaymentMethod(); !ok {
+		v := invoice.DefaultPaymentMethod
+		_c.mutation.SetPaymentMethod(v)
+	}
+	if _, ok := _c.mutation.BuyerEmail(); !ok {
+		v := invoice.DefaultBuyerEmail
+		_c.mutation.SetBuyerEmail(v)
+	}
+	if _, ok := _c.mutation.BuyerName(); !ok {
+		v := invoice.DefaultBuyerName
+		_c.mutation.SetBuyerName(v)
+	}
+	if _, ok := _c.mutation.BuyerTaxID(); !ok {
+		v := invoice.DefaultBuyerTaxID
+		_c.mutation.SetBuyerTaxID(v)
+	}
+	if _, ok := _c.mutation.BuyerAddress(); !ok {
+		v := invoice.DefaultBuyerAddress
+		_c.mutation.SetBuyerAddress(v)
+	}
+	if _, ok := _c.mutation.SellerName(); !ok {
+		v := invoice.DefaultSellerName
+		_c.mutation.SetSellerName(v)
+	}
+	if _, ok := _c.mutation.SellerTaxID(); !ok {
+		v := invoice.DefaultSellerTaxID
+		_c.mutation.SetSellerTaxID(v)
+	}
+	if _, ok := _c.mutation.SellerAddress(); !ok {
+		v := invoice.DefaultSellerAddress
+		_c.mutation.SetSellerAddress(v)
+	}
+	if _, ok := _c.mutation.Notes(); !ok {
+		v := invoice.DefaultNotes
+		_c.mutation.SetNotes(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := invoice.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *InvoiceCreate) check() error {
+	if _, ok := _c.mutation.InvoiceNo(); !ok {
+		return &ValidationError{Name: "invoice_no", err: errors.New(`ent: missing required field "Invoice.invoice_no"`)}
+	}
+	if v, ok := _c.mutation.InvoiceNo(); ok {
+		if err := invoice.InvoiceNoValidator(v); err != nil {
+			return &ValidationError{Name: "invoice_no", err: fmt.Errorf(`ent: validator failed for field "Invoice.invoice_no": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "Invoice.kind"`)}
+	}
+	if v, ok := _c.mutation.Kind(); ok {
+		if err := invoice.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Invoice.kind": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Invoice.user_id"`)}
+	}
+	if _, ok := _c.mutation.SourceType(); !ok {
+		return &ValidationError{Name: "source_type", err: errors.New(`ent: missing required field "Invoice.source_type"`)}
+	}
+	if v, ok := _c.mutation.SourceType(); ok {
+		if err := invoice.SourceTypeValidator(v); err != nil {
+			return &ValidationError{Name: "source_type", err: fmt.Errorf(`ent: validator failed for field "Invoice.source_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SourceID(); !ok {
+		return &ValidationError{Name: "source_id", err: errors.New(`ent: missing required field "Invoice.source_id"`)}
+	}
+	if _, ok := _c.mutation.SourceNo(); !ok {
+		return &ValidationError{Name: "source_no", err: errors.New(`ent: missing required field "Invoice.source_no"`)}
+	}
+	if v, ok := _c.mutation.SourceNo(); ok {
+		if err := invoice.SourceNoValidator(v); err != nil {
+			return &ValidationError{Name: "source_no", err: fmt.Errorf(`ent: validator failed for field "Invoice.source_no": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "Invoice.currency"`)}
+	}
+	if v, ok := _c.mutation.Currency(); ok {
+		if err := invoice.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "Invoice.currency": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Subtotal(); !ok {
+		return &ValidationError{Name: "subtotal", err: errors.New(`ent: missing required field "Invoice.subtotal"`)}
+	}
+	if _, ok := _c.mutation.DiscountAmount(); !ok {
+		return &ValidationError{Name: "discount_amount", err: errors.New(`ent: missing required field "Invoice.discount_amount"`)}
+	}
+	if _, ok := _c.mutation.FeeAmount(); !ok {
+		return &ValidationError{Name: "fee_amount", err: errors.New(`ent: missing required field "Invoice.fee_amount"`)}
+	}
+	if _, ok := _c.mutation.Total(); !ok {
+		return &ValidationError{Name: "total", err: errors.New(`ent: missing required field "Invoice.total"`)}
+	}
+	if _, ok := _c.mutation.Lines(); !ok {
+		return &ValidationError{Name: "lines", err: errors.New(`ent: missing required field "Invoice.lines"`)}
+	}
+	if _, ok := _c.mutation.PaymentMethod(); !ok {
+		return &ValidationError{Name: "payment_method", err: errors.New(`ent: missing required field "Invoice.payment_method"`)}
+	}
+	if v, ok := _c.mutation.PaymentMethod(); ok {
+		if err := invoice.PaymentMethodValidator(v); err != nil {
+			return &ValidationError{Name: "payment_method", err: fmt.Errorf(`ent: validator failed for field "Invoice.payment_method": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.BuyerEmail(); !ok {
+		return &ValidationError{Name: "buyer_email", err: errors.New(`ent: missing required field "Invoice.buyer_email"`)}
+	}
+	if v, ok := _c.mutation.BuyerEmail(); ok {
+		if err := invoice.BuyerEmailValidator(v); err != nil {
+			return &ValidationError{Name: "buyer_email", err: fmt.Errorf(`ent: validator failed for field "Invoice.buyer_email": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.BuyerName(); !ok {
+		return &ValidationError{Name: "buyer_name", err: errors.New(`ent: missing required field "Invoice.buyer_name"`)}
+	}
+	if v, ok := _c.mutation.BuyerName(); ok {
+		if err := invoice.BuyerNameValidator(v); err != nil {
+			return &ValidationError{Name: "buyer_name", err: fmt.Errorf(`ent: validator failed for field "Invoice.buyer_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.BuyerTaxID(); !ok {
+		return &ValidationError{Name: "buyer_tax_id", err: errors.New(`ent: missing required field "Invoice.buyer_tax_id"`)}
+	}
+	if v, ok := _c.mutation.BuyerTaxID(); ok {
+		if err := invoice.BuyerTaxIDValidator(v); err != nil {
+			return &ValidationError{Name: "buyer_tax_id", err: fmt.Errorf(`ent: validator failed for field "Invoice.buyer_tax_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.BuyerAddress(); !ok {
+		return &ValidationError{Name: "buyer_address", err: errors.New(`ent: missing required field "Invoice.buyer_address"`)}
+	}
+	if _, ok := _c.mutation.SellerName(); !ok {
+		return &ValidationError{Name: "seller_name", err: errors.New(`ent: missing required field "Invoice.seller_name"`)}
+	}
+	if v, ok := _c.mutation.SellerName(); ok {
+		if err := invoice.SellerNameValidator(v); err != nil {
+			return &ValidationError{Name: "seller_name", err: fmt.Errorf(`ent: validator failed for field "Invoice.seller_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SellerTaxID(); !ok {
+		return &ValidationError{Name: "seller_tax_id", err: errors.New(`ent: missing required field "Invoice.seller_tax_id"`)}
+	}
+	if v, ok := _c.mutation.SellerTaxID(); ok {
+		if err := invoice.SellerTaxIDValidator(v); err != nil {
+			return &ValidationError{Name: "seller_tax_id", err: fmt.Errorf(`ent: validator failed for field "Invoice.seller_tax_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SellerAddress(); !ok {
+		return &ValidationError{Name: "seller_address", err: errors.New(`ent: missing required field "Invoice.seller_address"`)}
+	}
+	if _, ok := _c.mutation.Notes(); !ok {
+		return &ValidationError{Name: "notes", err: errors.New(`ent: missing required field "Invoice.notes"`)}
+	}
+	if _, ok := _c.mutation.IssuedAt(); !ok {
+		return &ValidationError{Name: "issued_at", err: errors.New(`ent: missing required field "Invoice.issued_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Invoice.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *InvoiceCreate) sqlSave(ctx context.Context) (*Invoice, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int64(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *InvoiceCreate) createSpec() (*Invoice, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Invoice{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(invoice.Table, sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt64))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.InvoiceNo(); ok {
+		_spec.SetField(invoice.FieldInvoiceNo, field.TypeString, value)
+		_node.InvoiceNo = value
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(invoice.FieldKind, field.TypeString, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(invoice.FieldUserID, field.TypeInt64, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.SourceType(); ok {
+		_spec.SetField(invoice.FieldSourceType, field.TypeString, value)
+		_node.SourceType = value
+	}
+	if value, ok := _c.mutation.SourceID(); ok {
+		_spec.SetField(invoice.FieldSourceID, field.TypeInt64, value)
+		_node.SourceID = value
+	}
+	if value, ok := _c.mutation.SourceNo(); ok {
+		_spec.SetField(invoice.FieldSourceNo, field.TypeString, value)
+		_node.SourceNo = value
+	}
+	if value, ok := _c.mutation.OriginalInvoiceID(); ok {
+		_spec.SetField(invoice.FieldOriginalInvoiceID, field.TypeInt64, value)
+		_node.OriginalInvoiceID = &value
+	}
+	if value, ok := _c.mutation.Currency(); ok {
+		_spec.SetField(invoice.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := _c.mutation.Subtotal(); ok {
+		_spec.SetField(invoice.FieldSubtotal, field.TypeFloat64, value)
+		_node.Subtotal = value
+	}
+	if value, ok := _c.mutation.DiscountAmount(); ok {
+		_spec.SetField(invoice.FieldDiscountAmount, field.TypeFloat64, value)
+		_node.DiscountAmount = value
+	}
+	if value, ok := _c.mutation.FeeAmount(); ok {
+		_spec.SetField(invoice.FieldFeeAmount, field.TypeFloat64, value)
+		_node.FeeAmount = value
+	}
+	if value, ok := _c.mutation.Total(); ok {
+		_spec.SetField(invoice.FieldTotal, field.TypeFloat64, value)
+		_node.Total = value
+	}
+	if value, ok := _c.mutation.Lines(); ok {
+		_spec.SetField(invoice.FieldLines, field.TypeJSON, value)
+		_node.Lines = value
+	}
+	if value, ok := _c.mutation.PaymentMethod(); ok {
+		_spec.SetField(invoice.FieldPaymentMethod, field.TypeString, value)
+		_node.PaymentMethod = value
+	}
+	if value, ok := _c.mutation.BuyerEmail(); ok {
+		_spec.SetField(invoice.FieldBuyerEmail, field.TypeString, value)
+		_node.BuyerEmail = value
+	}
+	if value, ok := _c.mutation.BuyerName(); ok {
+		_spec.SetField(invoice.FieldBuyerName, field.TypeString, value)
+		_node.BuyerName = value
+	}
+	if value, ok := _c.mutation.BuyerTaxID(); ok {
+		_spec.SetField(invoice.FieldBuyerTaxID, field.TypeString, value)
+		_node.BuyerTaxID = value
+	}
+	if value, ok := _c.mutation.BuyerAddress(); ok {
+		_spec.SetField(invoice.FieldBuyerAddress, field.TypeString, value)
+		_node.BuyerAddress = value
+	}
+	if value, ok := _c.mutation.SellerName(); ok {
+		_spec.SetField(invoice.FieldSellerName, field.TypeString, value)
+		_node.SellerName = value
+	}
+	if value, ok := _c.mutation.SellerTaxID(); ok {
+		_spec.SetField(invoice.FieldSellerTaxID, field.TypeString, value)
+		_node.SellerTaxID = value
+	}
+	if value, ok := _c.mutation.SellerAddress(); ok {
+		_spec.SetField(invoice.FieldSellerAddress, field.TypeString, value)
+		_node.SellerAddress = value
+	}
+	if value, ok := _c.mutation.Notes(); ok {
+		_spec.SetField(invoice.FieldNotes, field.TypeString, value)
+		_node.Notes = value
+	}
+	if value, ok := _c.mutation.IssuedAt(); ok {
+		_spec.SetField(invoice.FieldIssuedAt, field.TypeTime, value)
+		_node.IssuedAt = value
+	}
+	if value, ok := _c.mutation.EmailedAt(); ok {
+		_spec.SetField(invoice.FieldEmailedAt, field.TypeTime, value)
+		_node.EmailedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(invoice.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Invoice.Create().
+//		SetInvoiceNo(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.InvoiceUpsert) {
+//			SetInvoiceNo(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *InvoiceCreate) OnConflict(opts ...sql.ConflictOption) *InvoiceUpsertOne {
+	_c.conflict = opts
+	return &InvoiceUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Invoice.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *InvoiceCreate) OnConflictColumns(columns ...string) *InvoiceUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &InvoiceUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// InvoiceUpsertOne is the builder for "upsert"-ing
+	//  one Invoice node.
+	InvoiceUpsertOne struct {
+		create *InvoiceCreate
+	}
+
+	// InvoiceUpsert is the "OnConflict" setter.
+	InvoiceUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetInvoiceNo sets the "invoice_no" field.
+func (u *InvoiceUpsert) SetInvoiceNo(v string) *InvoiceUpsert {
+	u.Set(invoice.FieldInvoiceNo, v)
+	return u
+}
+
+// UpdateInvoiceNo sets the "invoice_no" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdateInvoiceNo() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldInvoiceNo)
+	return u
+}
+
+// SetKind sets the "kind" field.
+func (u *InvoiceUpsert) SetKind(v string) *InvoiceUpsert {
+	u.Set(invoice.FieldKind, v)
+	return u
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdateKind() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldKind)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *InvoiceUpsert) SetUserID(v int64) *InvoiceUpsert {
+	u.Set(invoice.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdateUserID() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldUserID)
+	return u
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *InvoiceUpsert) AddUserID(v int64) *InvoiceUpsert {
+	u.Add(invoice.FieldUserID, v)
+	return u
+}
+
+// SetSourceType sets the "source_type" field.
+func (u *InvoiceUpsert) SetSourceType(v string) *InvoiceUpsert {
+	u.Set(invoice.FieldSourceType, v)
+	return u
+}
+
+// UpdateSourceType sets the "source_type" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdateSourceType() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldSourceType)
+	return u
+}
+
+// SetSourceID sets the "source_id" field.
+func (u *InvoiceUpsert) SetSourceID(v int64) *InvoiceUpsert {
+	u.Set(invoice.FieldSourceID, v)
+	return u
+}
+
+// UpdateSourceID sets the "source_id" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdateSourceID() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldSourceID)
+	return u
+}
+
+// AddSourceID adds v to the "source_id" field.
+func (u *InvoiceUpsert) AddSourceID(v int64) *InvoiceUpsert {
+	u.Add(invoice.FieldSourceID, v)
+	return u
+}
+
+// SetSourceNo sets the "source_no" field.
+func (u *InvoiceUpsert) SetSourceNo(v string) *InvoiceUpsert {
+	u.Set(invoice.FieldSourceNo, v)
+	return u
+}
+
+// UpdateSourceNo sets the "source_no" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdateSourceNo() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldSourceNo)
+	return u
+}
+
+// SetOriginalInvoiceID sets the "original_invoice_id" field.
+func (u *InvoiceUpsert) SetOriginalInvoiceID(v int64) *InvoiceUpsert {
+	u.Set(invoice.FieldOriginalInvoiceID, v)
+	return u
+}
+
+// UpdateOriginalInvoiceID sets the "original_invoice_id" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdateOriginalInvoiceID() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldOriginalInvoiceID)
+	return u
+}
+
+// AddOriginalInvoiceID adds v to the "original_invoice_id" field.
+func (u *InvoiceUpsert) AddOriginalInvoiceID(v int64) *InvoiceUpsert {
+	u.Add(invoice.FieldOriginalInvoiceID, v)
+	return u
+}
+
+// ClearOriginalInvoiceID clears the value of the "original_invoice_id" field.
+func (u *InvoiceUpsert) ClearOriginalInvoiceID() *InvoiceUpsert {
+	u.SetNull(invoice.FieldOriginalInvoiceID)
+	return u
+}
+
+// SetCurrency sets the "currency" field.
+func (u *InvoiceUpsert) SetCurrency(v string) *InvoiceUpsert {
+	u.Set(invoice.FieldCurrency, v)
+	return u
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdateCurrency() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldCurrency)
+	return u
+}
+
+// SetSubtotal sets the "subtotal" field.
+func (u *InvoiceUpsert) SetSubtotal(v float64) *InvoiceUpsert {
+	u.Set(invoice.FieldSubtotal, v)
+	return u
+}
+
+// UpdateSubtotal sets the "subtotal" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdateSubtotal() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldSubtotal)
+	return u
+}
+
+// AddSubtotal adds v to the "subtotal" field.
+func (u *InvoiceUpsert) AddSubtotal(v float64) *InvoiceUpsert {
+	u.Add(invoice.FieldSubtotal, v)
+	return u
+}
+
+// SetDiscountAmount sets the "discount_amount" field.
+func (u *InvoiceUpsert) SetDiscountAmount(v float64) *InvoiceUpsert {
+	u.Set(invoice.FieldDiscountAmount, v)
+	return u
+}
+
+// UpdateDiscountAmount sets the "discount_amount" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdateDiscountAmount() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldDiscountAmount)
+	return u
+}
+
+// AddDiscountAmount adds v to the "discount_amount" field.
+func (u *InvoiceUpsert) AddDiscountAmount(v float64) *InvoiceUpsert {
+	u.Add(invoice.FieldDiscountAmount, v)
+	return u
+}
+
+// SetFeeAmount sets the "fee_amount" field.
+func (u *InvoiceUpsert) SetFeeAmount(v float64) *InvoiceUpsert {
+	u.Set(invoice.FieldFeeAmount, v)
+	return u
+}
+
+// UpdateFeeAmount sets the "fee_amount" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdateFeeAmount() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldFeeAmount)
+	return u
+}
+
+// AddFeeAmount adds v to the "fee_amount" field.
+func (u *InvoiceUpsert) AddFeeAmount(v float64) *InvoiceUpsert {
+	u.Add(invoice.FieldFeeAmount, v)
+	return u
+}
+
+// SetTotal sets the "total" field.
+func (u *InvoiceUpsert) SetTotal(v float64) *InvoiceUpsert {
+	u.Set(invoice.FieldTotal, v)
+	return u
+}
+
+// UpdateTotal sets the "total" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdateTotal() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldTotal)
+	return u
+}
+
+// AddTotal adds v to the "total" field.
+func (u *InvoiceUpsert) AddTotal(v float64) *InvoiceUpsert {
+	u.Add(invoice.FieldTotal, v)
+	return u
+}
+
+// SetLines sets the "lines" field.
+func (u *InvoiceUpsert) SetLines(v []model.InvoiceLine) *InvoiceUpsert {
+	u.Set(invoice.FieldLines, v)
+	return u
+}
+
+// UpdateLines sets the "lines" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdateLines() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldLines)
+	return u
+}
+
+// SetPaymentMethod sets the "payment_method" field.
+func (u *InvoiceUpsert) SetPaymentMethod(v string) *InvoiceUpsert {
+	u.Set(invoice.FieldPaymentMethod, v)
+	return u
+}
+
+// UpdatePaymentMethod sets the "payment_method" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdatePaymentMethod() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldPaymentMethod)
+	return u
+}
+
+// SetBuyerEmail sets the "buyer_email" field.
+func (u *InvoiceUpsert) SetBuyerEmail(v string) *InvoiceUpsert {
+	u.Set(invoice.FieldBuyerEmail, v)
+	return u
+}
+
+// UpdateBuyerEmail sets the "buyer_email" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdateBuyerEmail() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldBuyerEmail)
+	return u
+}
+
+// SetBuyerName sets the "buyer_name" field.
+func (u *InvoiceUpsert) SetBuyerName(v string) *InvoiceUpsert {
+	u.Set(invoice.FieldBuyerName, v)
+	return u
+}
+
+// UpdateBuyerName sets the "buyer_name" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdateBuyerName() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldBuyerName)
+	return u
+}
+
+// SetBuyerTaxID sets the "buyer_tax_id" field.
+func (u *InvoiceUpsert) SetBuyerTaxID(v string) *InvoiceUpsert {
+	u.Set(invoice.FieldBuyerTaxID, v)
+	return u
+}
+
+// UpdateBuyerTaxID sets the "buyer_tax_id" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdateBuyerTaxID() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldBuyerTaxID)
+	return u
+}
+
+// SetBuyerAddress sets the "buyer_address" field.
+func (u *InvoiceUpsert) SetBuyerAddress(v string) *InvoiceUpsert {
+	u.Set(invoice.FieldBuyerAddress, v)
+	return u
+}
+
+// UpdateBuyerAddress sets the "buyer_address" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdateBuyerAddress() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldBuyerAddress)
+	return u
+}
+
+// SetSellerName sets the "seller_name" field.
+func (u *InvoiceUpsert) SetSellerName(v string) *InvoiceUpsert {
+	u.Set(invoice.FieldSellerName, v)
+	return u
+}
+
+// UpdateSellerName sets the "seller_name" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdateSellerName() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldSellerName)
+	return u
+}
+
+// SetSellerTaxID sets the "seller_tax_id" field.
+func (u *InvoiceUpsert) SetSellerTaxID(v string) *InvoiceUpsert {
+	u.Set(invoice.FieldSellerTaxID, v)
+	return u
+}
+
+// UpdateSellerTaxID sets the "seller_tax_id" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdateSellerTaxID() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldSellerTaxID)
+	return u
+}
+
+// SetSellerAddress sets the "seller_address" field.
+func (u *InvoiceUpsert) SetSellerAddress(v string) *InvoiceUpsert {
+	u.Set(invoice.FieldSellerAddress, v)
+	return u
+}
+
+// UpdateSellerAddress sets the "seller_address" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdateSellerAddress() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldSellerAddress)
+	return u
+}
+
+// SetNotes sets the "notes" field.
+func (u *InvoiceUpsert) SetNotes(v string) *InvoiceUpsert {
+	u.Set(invoice.FieldNotes, v)
+	return u
+}
+
+// UpdateNotes sets the "notes" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdateNotes() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldNotes)
+	return u
+}
+
+// SetIssuedAt sets the "issued_at" field.
+func (u *InvoiceUpsert) SetIssuedAt(v time.Time) *InvoiceUpsert {
+	u.Set(invoice.FieldIssuedAt, v)
+	return u
+}
+
+// UpdateIssuedAt sets the "issued_at" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdateIssuedAt() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldIssuedAt)
+	return u
+}
+
+// SetEmailedAt sets the "emailed_at" field.
+func (u *InvoiceUpsert) SetEmailedAt(v time.Time) *InvoiceUpsert {
+	u.Set(invoice.FieldEmailedAt, v)
+	return u
+}
+
+// UpdateEmailedAt sets the "emailed_at" field to the value that was provided on create.
+func (u *InvoiceUpsert) UpdateEmailedAt() *InvoiceUpsert {
+	u.SetExcluded(invoice.FieldEmailedAt)
+	return u
+}
+
+// ClearEmailedAt clears the value of the "emailed_at" field.
+func (u *InvoiceUpsert) ClearEmailedAt() *InvoiceUpsert {
+	u.SetNull(invoice.FieldEmailedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Invoice.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *InvoiceUpsertOne) UpdateNewValues() *InvoiceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(invoice.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Invoice.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *InvoiceUpsertOne) Ignore() *InvoiceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *InvoiceUpsertOne) DoNothing() *InvoiceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the InvoiceCreate.OnConflict
+// documentation for more info.
+func (u *InvoiceUpsertOne) Update(set func(*InvoiceUpsert)) *InvoiceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&InvoiceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetInvoiceNo sets the "invoice_no" field.
+func (u *InvoiceUpsertOne) SetInvoiceNo(v string) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetInvoiceNo(v)
+	})
+}
+
+// UpdateInvoiceNo sets the "invoice_no" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdateInvoiceNo() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateInvoiceNo()
+	})
+}
+
+// SetKind sets the "kind" field.
+func (u *InvoiceUpsertOne) SetKind(v string) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdateKind() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *InvoiceUpsertOne) SetUserID(v int64) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *InvoiceUpsertOne) AddUserID(v int64) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.AddUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdateUserID() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetSourceType sets the "source_type" field.
+func (u *InvoiceUpsertOne) SetSourceType(v string) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetSourceType(v)
+	})
+}
+
+// UpdateSourceType sets the "source_type" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdateSourceType() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateSourceType()
+	})
+}
+
+// SetSourceID sets the "source_id" field.
+func (u *InvoiceUpsertOne) SetSourceID(v int64) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetSourceID(v)
+	})
+}
+
+// AddSourceID adds v to the "source_id" field.
+func (u *InvoiceUpsertOne) AddSourceID(v int64) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.AddSourceID(v)
+	})
+}
+
+// UpdateSourceID sets the "source_id" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdateSourceID() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateSourceID()
+	})
+}
+
+// SetSourceNo sets the "source_no" field.
+func (u *InvoiceUpsertOne) SetSourceNo(v string) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetSourceNo(v)
+	})
+}
+
+// UpdateSourceNo sets the "source_no" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdateSourceNo() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateSourceNo()
+	})
+}
+
+// SetOriginalInvoiceID sets the "original_invoice_id" field.
+func (u *InvoiceUpsertOne) SetOriginalInvoiceID(v int64) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetOriginalInvoiceID(v)
+	})
+}
+
+// AddOriginalInvoiceID adds v to the "original_invoice_id" field.
+func (u *InvoiceUpsertOne) AddOriginalInvoiceID(v int64) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.AddOriginalInvoiceID(v)
+	})
+}
+
+// UpdateOriginalInvoiceID sets the "original_invoice_id" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdateOriginalInvoiceID() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateOriginalInvoiceID()
+	})
+}
+
+// ClearOriginalInvoiceID clears the value of the "original_invoice_id" field.
+func (u *InvoiceUpsertOne) ClearOriginalInvoiceID() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.ClearOriginalInvoiceID()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *InvoiceUpsertOne) SetCurrency(v string) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdateCurrency() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetSubtotal sets the "subtotal" field.
+func (u *InvoiceUpsertOne) SetSubtotal(v float64) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetSubtotal(v)
+	})
+}
+
+// AddSubtotal adds v to the "subtotal" field.
+func (u *InvoiceUpsertOne) AddSubtotal(v float64) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.AddSubtotal(v)
+	})
+}
+
+// UpdateSubtotal sets the "subtotal" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdateSubtotal() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateSubtotal()
+	})
+}
+
+// SetDiscountAmount sets the "discount_amount" field.
+func (u *InvoiceUpsertOne) SetDiscountAmount(v float64) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetDiscountAmount(v)
+	})
+}
+
+// AddDiscountAmount adds v to the "discount_amount" field.
+func (u *InvoiceUpsertOne) AddDiscountAmount(v float64) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.AddDiscountAmount(v)
+	})
+}
+
+// UpdateDiscountAmount sets the "discount_amount" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdateDiscountAmount() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateDiscountAmount()
+	})
+}
+
+// SetFeeAmount sets the "fee_amount" field.
+func (u *InvoiceUpsertOne) SetFeeAmount(v float64) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetFeeAmount(v)
+	})
+}
+
+// AddFeeAmount adds v to the "fee_amount" field.
+func (u *InvoiceUpsertOne) AddFeeAmount(v float64) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.AddFeeAmount(v)
+	})
+}
+
+// UpdateFeeAmount sets the "fee_amount" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdateFeeAmount() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateFeeAmount()
+	})
+}
+
+// SetTotal sets the "total" field.
+func (u *InvoiceUpsertOne) SetTotal(v float64) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetTotal(v)
+	})
+}
+
+// AddTotal adds v to the "total" field.
+func (u *InvoiceUpsertOne) AddTotal(v float64) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.AddTotal(v)
+	})
+}
+
+// UpdateTotal sets the "total" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdateTotal() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateTotal()
+	})
+}
+
+// SetLines sets the "lines" field.
+func (u *InvoiceUpsertOne) SetLines(v []model.InvoiceLine) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetLines(v)
+	})
+}
+
+// UpdateLines sets the "lines" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdateLines() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateLines()
+	})
+}
+
+// SetPaymentMethod sets the "payment_method" field.
+func (u *InvoiceUpsertOne) SetPaymentMethod(v string) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetPaymentMethod(v)
+	})
+}
+
+// UpdatePaymentMethod sets the "payment_method" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdatePaymentMethod() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdatePaymentMethod()
+	})
+}
+
+// SetBuyerEmail sets the "buyer_email" field.
+func (u *InvoiceUpsertOne) SetBuyerEmail(v string) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetBuyerEmail(v)
+	})
+}
+
+// UpdateBuyerEmail sets the "buyer_email" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdateBuyerEmail() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateBuyerEmail()
+	})
+}
+
+// SetBuyerName sets the "buyer_name" field.
+func (u *InvoiceUpsertOne) SetBuyerName(v string) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetBuyerName(v)
+	})
+}
+
+// UpdateBuyerName sets the "buyer_name" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdateBuyerName() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateBuyerName()
+	})
+}
+
+// SetBuyerTaxID sets the "buyer_tax_id" field.
+func (u *InvoiceUpsertOne) SetBuyerTaxID(v string) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetBuyerTaxID(v)
+	})
+}
+
+// UpdateBuyerTaxID sets the "buyer_tax_id" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdateBuyerTaxID() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateBuyerTaxID()
+	})
+}
+
+// SetBuyerAddress sets the "buyer_address" field.
+func (u *InvoiceUpsertOne) SetBuyerAddress(v string) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetBuyerAddress(v)
+	})
+}
+
+// UpdateBuyerAddress sets the "buyer_address" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdateBuyerAddress() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateBuyerAddress()
+	})
+}
+
+// SetSellerName sets the "seller_name" field.
+func (u *InvoiceUpsertOne) SetSellerName(v string) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetSellerName(v)
+	})
+}
+
+// UpdateSellerName sets the "seller_name" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdateSellerName() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateSellerName()
+	})
+}
+
+// SetSellerTaxID sets the "seller_tax_id" field.
+func (u *InvoiceUpsertOne) SetSellerTaxID(v string) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetSellerTaxID(v)
+	})
+}
+
+// UpdateSellerTaxID sets the "seller_tax_id" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdateSellerTaxID() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateSellerTaxID()
+	})
+}
+
+// SetSellerAddress sets the "seller_address" field.
+func (u *InvoiceUpsertOne) SetSellerAddress(v string) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetSellerAddress(v)
+	})
+}
+
+// UpdateSellerAddress sets the "seller_address" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdateSellerAddress() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateSellerAddress()
+	})
+}
+
+// SetNotes sets the "notes" field.
+func (u *InvoiceUpsertOne) SetNotes(v string) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetNotes(v)
+	})
+}
+
+// UpdateNotes sets the "notes" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdateNotes() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateNotes()
+	})
+}
+
+// SetIssuedAt sets the "issued_at" field.
+func (u *InvoiceUpsertOne) SetIssuedAt(v time.Time) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetIssuedAt(v)
+	})
+}
+
+// UpdateIssuedAt sets the "issued_at" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdateIssuedAt() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateIssuedAt()
+	})
+}
+
+// SetEmailedAt sets the "emailed_at" field.
+func (u *InvoiceUpsertOne) SetEmailedAt(v time.Time) *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetEmailedAt(v)
+	})
+}
+
+// UpdateEmailedAt sets the "emailed_at" field to the value that was provided on create.
+func (u *InvoiceUpsertOne) UpdateEmailedAt() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateEmailedAt()
+	})
+}
+
+// ClearEmailedAt clears the value of the "emailed_at" field.
+func (u *InvoiceUpsertOne) ClearEmailedAt() *InvoiceUpsertOne {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.ClearEmailedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *InvoiceUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for InvoiceCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *InvoiceUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *InvoiceUpsertOne) ID(ctx context.Context) (id int64, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *InvoiceUpsertOne) IDX(ctx context.Context) int64 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// InvoiceCreateBulk is the builder for creating many Invoice entities in bulk.
+type InvoiceCreateBulk struct {
+	config
+	err      error
+	builders []*InvoiceCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Invoice entities in the database.
+func (_c *InvoiceCreateBulk) Save(ctx context.Context) ([]*Invoice, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Invoice, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*InvoiceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *InvoiceCreateBulk) SaveX(ctx context.Context) []*Invoice {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *InvoiceCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *InvoiceCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Invoice.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.InvoiceUpsert) {
+//			SetInvoiceNo(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *InvoiceCreateBulk) OnConflict(opts ...sql.ConflictOption) *InvoiceUpsertBulk {
+	_c.conflict = opts
+	return &InvoiceUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Invoice.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *InvoiceCreateBulk) OnConflictColumns(columns ...string) *InvoiceUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &InvoiceUpsertBulk{
+		create: _c,
+	}
+}
+
+// InvoiceUpsertBulk is the builder for "upsert"-ing
+// a bulk of Invoice nodes.
+type InvoiceUpsertBulk struct {
+	create *InvoiceCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Invoice.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *InvoiceUpsertBulk) UpdateNewValues() *InvoiceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(invoice.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Invoice.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *InvoiceUpsertBulk) Ignore() *InvoiceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *InvoiceUpsertBulk) DoNothing() *InvoiceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the InvoiceCreateBulk.OnConflict
+// documentation for more info.
+func (u *InvoiceUpsertBulk) Update(set func(*InvoiceUpsert)) *InvoiceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&InvoiceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetInvoiceNo sets the "invoice_no" field.
+func (u *InvoiceUpsertBulk) SetInvoiceNo(v string) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetInvoiceNo(v)
+	})
+}
+
+// UpdateInvoiceNo sets the "invoice_no" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdateInvoiceNo() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateInvoiceNo()
+	})
+}
+
+// SetKind sets the "kind" field.
+func (u *InvoiceUpsertBulk) SetKind(v string) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetKind(v)
+	})
+}
+
+// UpdateKind sets the "kind" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdateKind() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateKind()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *InvoiceUpsertBulk) SetUserID(v int64) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *InvoiceUpsertBulk) AddUserID(v int64) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.AddUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdateUserID() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetSourceType sets the "source_type" field.
+func (u *InvoiceUpsertBulk) SetSourceType(v string) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetSourceType(v)
+	})
+}
+
+// UpdateSourceType sets the "source_type" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdateSourceType() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateSourceType()
+	})
+}
+
+// SetSourceID sets the "source_id" field.
+func (u *InvoiceUpsertBulk) SetSourceID(v int64) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetSourceID(v)
+	})
+}
+
+// AddSourceID adds v to the "source_id" field.
+func (u *InvoiceUpsertBulk) AddSourceID(v int64) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.AddSourceID(v)
+	})
+}
+
+// UpdateSourceID sets the "source_id" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdateSourceID() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateSourceID()
+	})
+}
+
+// SetSourceNo sets the "source_no" field.
+func (u *InvoiceUpsertBulk) SetSourceNo(v string) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetSourceNo(v)
+	})
+}
+
+// UpdateSourceNo sets the "source_no" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdateSourceNo() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateSourceNo()
+	})
+}
+
+// SetOriginalInvoiceID sets the "original_invoice_id" field.
+func (u *InvoiceUpsertBulk) SetOriginalInvoiceID(v int64) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetOriginalInvoiceID(v)
+	})
+}
+
+// AddOriginalInvoiceID adds v to the "original_invoice_id" field.
+func (u *InvoiceUpsertBulk) AddOriginalInvoiceID(v int64) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.AddOriginalInvoiceID(v)
+	})
+}
+
+// UpdateOriginalInvoiceID sets the "original_invoice_id" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdateOriginalInvoiceID() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateOriginalInvoiceID()
+	})
+}
+
+// ClearOriginalInvoiceID clears the value of the "original_invoice_id" field.
+func (u *InvoiceUpsertBulk) ClearOriginalInvoiceID() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.ClearOriginalInvoiceID()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *InvoiceUpsertBulk) SetCurrency(v string) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdateCurrency() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetSubtotal sets the "subtotal" field.
+func (u *InvoiceUpsertBulk) SetSubtotal(v float64) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetSubtotal(v)
+	})
+}
+
+// AddSubtotal adds v to the "subtotal" field.
+func (u *InvoiceUpsertBulk) AddSubtotal(v float64) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.AddSubtotal(v)
+	})
+}
+
+// UpdateSubtotal sets the "subtotal" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdateSubtotal() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateSubtotal()
+	})
+}
+
+// SetDiscountAmount sets the "discount_amount" field.
+func (u *InvoiceUpsertBulk) SetDiscountAmount(v float64) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetDiscountAmount(v)
+	})
+}
+
+// AddDiscountAmount adds v to the "discount_amount" field.
+func (u *InvoiceUpsertBulk) AddDiscountAmount(v float64) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.AddDiscountAmount(v)
+	})
+}
+
+// UpdateDiscountAmount sets the "discount_amount" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdateDiscountAmount() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateDiscountAmount()
+	})
+}
+
+// SetFeeAmount sets the "fee_amount" field.
+func (u *InvoiceUpsertBulk) SetFeeAmount(v float64) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetFeeAmount(v)
+	})
+}
+
+// AddFeeAmount adds v to the "fee_amount" field.
+func (u *InvoiceUpsertBulk) AddFeeAmount(v float64) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.AddFeeAmount(v)
+	})
+}
+
+// UpdateFeeAmount sets the "fee_amount" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdateFeeAmount() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateFeeAmount()
+	})
+}
+
+// SetTotal sets the "total" field.
+func (u *InvoiceUpsertBulk) SetTotal(v float64) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetTotal(v)
+	})
+}
+
+// AddTotal adds v to the "total" field.
+func (u *InvoiceUpsertBulk) AddTotal(v float64) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.AddTotal(v)
+	})
+}
+
+// UpdateTotal sets the "total" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdateTotal() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateTotal()
+	})
+}
+
+// SetLines sets the "lines" field.
+func (u *InvoiceUpsertBulk) SetLines(v []model.InvoiceLine) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetLines(v)
+	})
+}
+
+// UpdateLines sets the "lines" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdateLines() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateLines()
+	})
+}
+
+// SetPaymentMethod sets the "payment_method" field.
+func (u *InvoiceUpsertBulk) SetPaymentMethod(v string) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetPaymentMethod(v)
+	})
+}
+
+// UpdatePaymentMethod sets the "payment_method" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdatePaymentMethod() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdatePaymentMethod()
+	})
+}
+
+// SetBuyerEmail sets the "buyer_email" field.
+func (u *InvoiceUpsertBulk) SetBuyerEmail(v string) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetBuyerEmail(v)
+	})
+}
+
+// UpdateBuyerEmail sets the "buyer_email" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdateBuyerEmail() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateBuyerEmail()
+	})
+}
+
+// SetBuyerName sets the "buyer_name" field.
+func (u *InvoiceUpsertBulk) SetBuyerName(v string) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetBuyerName(v)
+	})
+}
+
+// UpdateBuyerName sets the "buyer_name" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdateBuyerName() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateBuyerName()
+	})
+}
+
+// SetBuyerTaxID sets the "buyer_tax_id" field.
+func (u *InvoiceUpsertBulk) SetBuyerTaxID(v string) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetBuyerTaxID(v)
+	})
+}
+
+// UpdateBuyerTaxID sets the "buyer_tax_id" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdateBuyerTaxID() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateBuyerTaxID()
+	})
+}
+
+// SetBuyerAddress sets the "buyer_address" field.
+func (u *InvoiceUpsertBulk) SetBuyerAddress(v string) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetBuyerAddress(v)
+	})
+}
+
+// UpdateBuyerAddress sets the "buyer_address" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdateBuyerAddress() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateBuyerAddress()
+	})
+}
+
+// SetSellerName sets the "seller_name" field.
+func (u *InvoiceUpsertBulk) SetSellerName(v string) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetSellerName(v)
+	})
+}
+
+// UpdateSellerName sets the "seller_name" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdateSellerName() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateSellerName()
+	})
+}
+
+// SetSellerTaxID sets the "seller_tax_id" field.
+func (u *InvoiceUpsertBulk) SetSellerTaxID(v string) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetSellerTaxID(v)
+	})
+}
+
+// UpdateSellerTaxID sets the "seller_tax_id" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdateSellerTaxID() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateSellerTaxID()
+	})
+}
+
+// SetSellerAddress sets the "seller_address" field.
+func (u *InvoiceUpsertBulk) SetSellerAddress(v string) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetSellerAddress(v)
+	})
+}
+
+// UpdateSellerAddress sets the "seller_address" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdateSellerAddress() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateSellerAddress()
+	})
+}
+
+// SetNotes sets the "notes" field.
+func (u *InvoiceUpsertBulk) SetNotes(v string) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetNotes(v)
+	})
+}
+
+// UpdateNotes sets the "notes" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdateNotes() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateNotes()
+	})
+}
+
+// SetIssuedAt sets the "issued_at" field.
+func (u *InvoiceUpsertBulk) SetIssuedAt(v time.Time) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetIssuedAt(v)
+	})
+}
+
+// UpdateIssuedAt sets the "issued_at" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdateIssuedAt() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateIssuedAt()
+	})
+}
+
+// SetEmailedAt sets the "emailed_at" field.
+func (u *InvoiceUpsertBulk) SetEmailedAt(v time.Time) *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.SetEmailedAt(v)
+	})
+}
+
+// UpdateEmailedAt sets the "emailed_at" field to the value that was provided on create.
+func (u *InvoiceUpsertBulk) UpdateEmailedAt() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.UpdateEmailedAt()
+	})
+}
+
+// ClearEmailedAt clears the value of the "emailed_at" field.
+func (u *InvoiceUpsertBulk) ClearEmailedAt() *InvoiceUpsertBulk {
+	return u.Update(func(s *InvoiceUpsert) {
+		s.ClearEmailedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *InvoiceUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the InvoiceCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for InvoiceCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *InvoiceUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
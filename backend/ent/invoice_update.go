@@ -0,0 +1,1302 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/Wei-Shaw/sub2api/ent/invoice"
+	"github.com/Wei-Shaw/sub2api/ent/predicate"
+	"github.com/Wei-Shaw/sub2api/internal/model"
+)
+
+// InvoiceUpdate is the builder for updating Invoice entities.
+type InvoiceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *InvoiceMutation
+}
+
+// Where appends a list predicates to the InvoiceUpdate builder.
+func (_u *InvoiceUpdate) Where(ps ...predicate.Invoice) *InvoiceUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetInvoiceNo sets the "invoice_no" field.
+func (_u *InvoiceUpdate) SetInvoiceNo(v string) *InvoiceUpdate {
+	_u.mutation.SetInvoiceNo(v)
+	return _u
+}
+
+// SetNillableInvoiceNo sets the "invoice_no" field if the given value is not nil.
+func (_u *InvoiceUpdate) SetNillableInvoiceNo(v *string) *InvoiceUpdate {
+	if v != nil {
+		_u.SetInvoiceNo(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *InvoiceUpdate) SetKind(v string) *InvoiceUpdate {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *InvoiceUpdate) SetNillableKind(v *string) *InvoiceUpdate {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *InvoiceUpdate) SetUserID(v int64) *InvoiceUpdate {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *InvoiceUpdate) SetNillableUserID(v *int64) *InvoiceUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *InvoiceUpdate) AddUserID(v int64) *InvoiceUpdate {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetSourceType sets the "source_type" field.
+func (_u *InvoiceUpdate) SetSourceType(v string) *InvoiceUpdate {
+	_u.mutation.SetSourceType(v)
+	return _u
+}
+
+// SetNillableSourceType sets the "source_type" field if the given value is not nil.
+func (_u *InvoiceUpdate) SetNillableSourceType(v *string) *InvoiceUpdate {
+	if v != nil {
+		_u.SetSourceType(*v)
+	}
+	return _u
+}
+
+// SetSourceID sets the "source_id" field.
+func (_u *InvoiceUpdate) SetSourceID(v int64) *InvoiceUpdate {
+	_u.mutation.ResetSourceID()
+	_u.mutation.SetSourceID(v)
+	return _u
+}
+
+// SetNillableSourceID sets the "source_id" field if the given value is not nil.
+func (_u *InvoiceUpdate) SetNillableSourceID(v *int64) *InvoiceUpdate {
+	if v != nil {
+		_u.SetSourceID(*v)
+	}
+	return _u
+}
+
+// AddSourceID adds value to the "source_id" field.
+func (_u *InvoiceUpdate) AddSourceID(v int64) *InvoiceUpdate {
+	_u.mutation.AddSourceID(v)
+	return _u
+}
+
+// SetSourceNo sets the "source_no" field.
+func (_u *InvoiceUpdate) SetSourceNo(v string) *InvoiceUpdate {
+	_u.mutation.SetSourceNo(v)
+	return _u
+}
+
+// SetNillableSourceNo sets the "source_no" field if the given value is not nil.
+func (_u *InvoiceUpdate) SetNillableSourceNo(v *string) *InvoiceUpdate {
+	if v != nil {
+		_u.SetSourceNo(*v)
+	}
+	return _u
+}
+
+// SetOriginalInvoiceID sets the "original_invoice_id" field.
+func (_u *InvoiceUpdate) SetOriginalInvoiceID(v int64) *InvoiceUpdate {
+	_u.mutation.ResetOriginalInvoiceID()
+	_u.mutation.SetOriginalInvoiceID(v)
+	return _u
+}
+
+// SetNillableOriginalInvoiceID sets the "original_invoice_id" field if the given value is not nil.
+func (_u *InvoiceUpdate) SetNillableOriginalInvoiceID(v *int64) *InvoiceUpdate {
+	if v != nil {
+		_u.SetOriginalInvoiceID(*v)
+	}
+	return _u
+}
+
+// AddOriginalInvoiceID adds value to the "original_invoice_id" field.
+func (_u *InvoiceUpdate) AddOriginalInvoiceID(v int64) *InvoiceUpdate {
+	_u.mutation.AddOriginalInvoiceID(v)
+	return _u
+}
+
+// ClearOriginalInvoiceID clears the value of the "original_invoice_id" field.
+func (_u *InvoiceUpdate) ClearOriginalInvoiceID() *InvoiceUpdate {
+	_u.mutation.ClearOriginalInvoiceID()
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *InvoiceUpdate) SetCurrency(v string) *InvoiceUpdate {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *InvoiceUpdate) SetNillableCurrency(v *string) *InvoiceUpdate {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetSubtotal sets the "subtotal" field.
+func (_u *InvoiceUpdate) SetSubtotal(v float64) *InvoiceUpdate {
+	_u.mutation.ResetSubtotal()
+	_u.mutation.SetSubtotal(v)
+	return _u
+}
+
+// SetNillableSubtotal sets the "subtotal" field if the given value is not nil.
+func (_u *InvoiceUpdate) SetNillableSubtotal(v *float64) *InvoiceUpdate {
+	if v != nil {
+		_u.SetSubtotal(*v)
+	}
+	return _u
+}
+
+// AddSubtotal adds value to the "subtotal" field.
+func (_u *InvoiceUpdate) AddSubtotal(v float64) *InvoiceUpdate {
+	_u.mutation.AddSubtotal(v)
+	return _u
+}
+
+// SetDiscountAmount sets the "discount_amount" field.
+func (_u *InvoiceUpdate) SetDiscountAmount(v float64) *InvoiceUpdate {
+	_u.mutation.ResetDiscountAmount()
+	_u.mutation.SetDiscountAmount(v)
+	return _u
+}
+
+// SetNillableDiscountAmount sets the "discount_amount" field if the given value is not nil.
+func (_u *InvoiceUpdate) SetNillableDiscountAmount(v *float64) *InvoiceUpdate {
+	if v != nil {
+		_u.SetDiscountAmount(*v)
+	}
+	return _u
+}
+
+// AddDiscountAmount adds value to the "discount_amount" field.
+func (_u *InvoiceUpdate) AddDiscountAmount(v float64) *InvoiceUpdate {
+	_u.mutation.AddDiscountAmount(v)
+	return _u
+}
+
+// SetFeeAmount sets the "fee_amount" field.
+func (_u *InvoiceUpdate) SetFeeAmount(v float64) *InvoiceUpdate {
+	_u.mutation.ResetFeeAmount()
+	_u.mutation.SetFeeAmount(v)
+	return _u
+}
+
+// SetNillableFeeAmount sets the "fee_amount" field if the given value is not nil.
+func (_u *InvoiceUpdate) SetNillableFeeAmount(v *float64) *InvoiceUpdate {
+	if v != nil {
+		_u.SetFeeAmount(*v)
+	}
+	return _u
+}
+
+// AddFeeAmount adds value to the "fee_amount" field.
+func (_u *InvoiceUpdate) AddFeeAmount(v float64) *InvoiceUpdate {
+	_u.mutation.AddFeeAmount(v)
+	return _u
+}
+
+// SetTotal sets the "total" field.
+func (_u *InvoiceUpdate) SetTotal(v float64) *InvoiceUpdate {
+	_u.mutation.ResetTotal()
+	_u.mutation.SetTotal(v)
+	return _u
+}
+
+// SetNillableTotal sets the "total" field if the given value is not nil.
+func (_u *InvoiceUpdate) SetNillableTotal(v *float64) *InvoiceUpdate {
+	if v != nil {
+		_u.SetTotal(*v)
+	}
+	return _u
+}
+
+// AddTotal adds value to the "total" field.
+func (_u *InvoiceUpdate) AddTotal(v float64) *InvoiceUpdate {
+	_u.mutation.AddTotal(v)
+	return _u
+}
+
+// SetLines sets the "lines" field.
+func (_u *InvoiceUpdate) SetLines(v []model.InvoiceLine) *InvoiceUpdate {
+	_u.mutation.SetLines(v)
+	return _u
+}
+
+// AppendLines appends value to the "lines" field.
+func (_u *InvoiceUpdate) AppendLines(v []model.InvoiceLine) *InvoiceUpdate {
+	_u.mutation.AppendLines(v)
+	return _u
+}
+
+// SetPaymentMethod sets the "payment_method" field.
+func (_u *InvoiceUpdate) SetPaymentMethod(v string) *InvoiceUpdate {
+	_u.mutation.SetPaymentMethod(v)
+	return _u
+}
+
+// SetNillablePaymentMethod sets the "payment_method" field if the given value is not nil.
+func (_u *InvoiceUpdate) SetNillablePaymentMethod(v *string) *InvoiceUpdate {
+	if v != nil {
+		_u.SetPaymentMethod(*v)
+	}
+	return _u
+}
+
+// SetBuyerEmail sets the "buyer_email" field.
+func (_u *InvoiceUpdate) SetBuyerEmail(v string) *InvoiceUpdate {
+	_u.mutation.SetBuyerEmail(v)
+	return _u
+}
+
+// SetNillableBuyerEmail sets the "buyer_email" field if the given value is not nil.
+func (_u *InvoiceUpdate) SetNillableBuyerEmail(v *string) *InvoiceUpdate {
+	if v != nil {
+		_u.SetBuyerEmail(*v)
+	}
+	return _u
+}
+
+// SetBuyerName sets the "buyer_name" field.
+func (_u *InvoiceUpdate) SetBuyerName(v string) *InvoiceUpdate {
+	_u.mutation.SetBuyerName(v)
+	return _u
+}
+
+// SetNillableBuyerName sets the "buyer_name" field if the given value is not nil.
+func (_u *InvoiceUpdate) SetNillableBuyerName(v *string) *InvoiceUpdate {
+	if v != nil {
+		_u.SetBuyerName(*v)
+	}
+	return _u
+}
+
+// SetBuyerTaxID sets the "buyer_tax_id" field.
+func (_u *InvoiceUpdate) SetBuyerTaxID(v string) *InvoiceUpdate {
+	_u.mutation.SetBuyerTaxID(v)
+	return _u
+}
+
+// SetNillableBuyerTaxID sets the "buyer_tax_id" field if the given value is not nil.
+func (_u *InvoiceUpdate) SetNillableBuyerTaxID(v *string) *InvoiceUpdate {
+	if v != nil {
+		_u.SetBuyerTaxID(*v)
+	}
+	return _u
+}
+
+// SetBuyerAddress sets the "buyer_address" field.
+func (_u *InvoiceUpdate) SetBuyerAddress(v string) *InvoiceUpdate {
+	_u.mutation.SetBuyerAddress(v)
+	return _u
+}
+
+// SetNillableBuyerAddress sets the "buyer_address" field if the given value is not nil.
+func (_u *InvoiceUpdate) SetNillableBuyerAddress(v *string) *InvoiceUpdate {
+	if v != nil {
+		_u.SetBuyerAddress(*v)
+	}
+	return _u
+}
+
+// SetSellerName sets the "seller_name" field.
+func (_u *InvoiceUpdate) SetSellerName(v string) *InvoiceUpdate {
+	_u.mutation.SetSellerName(v)
+	return _u
+}
+
+// SetNillableSellerName sets the "seller_name" field if the given value is not nil.
+func (_u *InvoiceUpdate) SetNillableSellerName(v *string) *InvoiceUpdate {
+	if v != nil {
+		_u.SetSellerName(*v)
+	}
+	return _u
+}
+
+// SetSellerTaxID sets the "seller_tax_id" field.
+func (_u *InvoiceUpdate) SetSellerTaxID(v string) *InvoiceUpdate {
+	_u.mutation.SetSellerTaxID(v)
+	return _u
+}
+
+// SetNillableSellerTaxID sets the "seller_tax_id" field if the given value is not nil.
+func (_u *InvoiceUpdate) SetNillableSellerTaxID(v *string) *InvoiceUpdate {
+	if v != nil {
+		_u.SetSellerTaxID(*v)
+	}
+	return _u
+}
+
+// SetSellerAddress sets the "seller_address" field.
+func (_u *InvoiceUpdate) SetSellerAddress(v string) *InvoiceUpdate {
+	_u.mutation.SetSellerAddress(v)
+	return _u
+}
+
+// SetNillableSellerAddress sets the "seller_address" field if the given value is not nil.
+func (_u *InvoiceUpdate) SetNillableSellerAddress(v *string) *InvoiceUpdate {
+	if v != nil {
+		_u.SetSellerAddress(*v)
+	}
+	return _u
+}
+
+// SetNotes sets the "notes" field.
+func (_u *InvoiceUpdate) SetNotes(v string) *InvoiceUpdate {
+	_u.mutation.SetNotes(v)
+	return _u
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (_u *InvoiceUpdate) SetNillableNotes(v *string) *InvoiceUpdate {
+	if v != nil {
+		_u.SetNotes(*v)
+	}
+	return _u
+}
+
+// SetIssuedAt sets the "issued_at" field.
+func (_u *InvoiceUpdate) SetIssuedAt(v time.Time) *InvoiceUpdate {
+	_u.mutation.SetIssuedAt(v)
+	return _u
+}
+
+// SetNillableIssuedAt sets the "issued_at" field if the given value is not nil.
+func (_u *InvoiceUpdate) SetNillableIssuedAt(v *time.Time) *InvoiceUpdate {
+	if v != nil {
+		_u.SetIssuedAt(*v)
+	}
+	return _u
+}
+
+// SetEmailedAt sets the "emailed_at" field.
+func (_u *InvoiceUpdate) SetEmailedAt(v time.Time) *InvoiceUpdate {
+	_u.mutation.SetEmailedAt(v)
+	return _u
+}
+
+// SetNillableEmailedAt sets the "emailed_at" field if the given value is not nil.
+func (_u *InvoiceUpdate) SetNillableEmailedAt(v *time.Time) *InvoiceUpdate {
+	if v != nil {
+		_u.SetEmailedAt(*v)
+	}
+	return _u
+}
+
+// ClearEmailedAt clears the value of the "emailed_at" field.
+func (_u *InvoiceUpdate) ClearEmailedAt() *InvoiceUpdate {
+	_u.mutation.ClearEmailedAt()
+	return _u
+}
+
+// Mutation returns the InvoiceMutation object of the builder.
+func (_u *InvoiceUpdate) Mutation() *InvoiceMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *InvoiceUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *InvoiceUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *InvoiceUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *InvoiceUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *InvoiceUpdate) check() error {
+	if v, ok := _u.mutation.InvoiceNo(); ok {
+		if err := invoice.InvoiceNoValidator(v); err != nil {
+			return &ValidationError{Name: "invoice_no", err: fmt.Errorf(`ent: validator failed for field "Invoice.invoice_no": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := invoice.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Invoice.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SourceType(); ok {
+		if err := invoice.SourceTypeValidator(v); err != nil {
+			return &ValidationError{Name: "source_type", err: fmt.Errorf(`ent: validator failed for field "Invoice.source_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SourceNo(); ok {
+		if err := invoice.SourceNoValidator(v); err != nil {
+			return &ValidationError{Name: "source_no", err: fmt.Errorf(`ent: validator failed for field "Invoice.source_no": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Currency(); ok {
+		if err := invoice.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "Invoice.currency": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PaymentMethod(); ok {
+		if err := invoice.PaymentMethodValidator(v); err != nil {
+			return &ValidationError{Name: "payment_method", err: fmt.Errorf(`ent: validator failed for field "Invoice.payment_method": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BuyerEmail(); ok {
+		if err := invoice.BuyerEmailValidator(v); err != nil {
+			return &ValidationError{Name: "buyer_email", err: fmt.Errorf(`ent: validator failed for field "Invoice.buyer_email": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BuyerName(); ok {
+		if err := invoice.BuyerNameValidator(v); err != nil {
+			return &ValidationError{Name: "buyer_name", err: fmt.Errorf(`ent: validator failed for field "Invoice.buyer_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BuyerTaxID(); ok {
+		if err := invoice.BuyerTaxIDValidator(v); err != nil {
+			return &ValidationError{Name: "buyer_tax_id", err: fmt.Errorf(`ent: validator failed for field "Invoice.buyer_tax_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SellerName(); ok {
+		if err := invoice.SellerNameValidator(v); err != nil {
+			return &ValidationError{Name: "seller_name", err: fmt.Errorf(`ent: validator failed for field "Invoice.seller_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SellerTaxID(); ok {
+		if err := invoice.SellerTaxIDValidator(v); err != nil {
+			return &ValidationError{Name: "seller_tax_id", err: fmt.Errorf(`ent: validator failed for field "Invoice.seller_tax_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *InvoiceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(invoice.Table, invoice.Columns, sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt64))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.InvoiceNo(); ok {
+		_spec.SetField(invoice.FieldInvoiceNo, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(invoice.FieldKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(invoice.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(invoice.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.SourceType(); ok {
+		_spec.SetField(invoice.FieldSourceType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SourceID(); ok {
+		_spec.SetField(invoice.FieldSourceID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSourceID(); ok {
+		_spec.AddField(invoice.FieldSourceID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.SourceNo(); ok {
+		_spec.SetField(invoice.FieldSourceNo, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.OriginalInvoiceID(); ok {
+		_spec.SetField(invoice.FieldOriginalInvoiceID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedOriginalInvoiceID(); ok {
+		_spec.AddField(invoice.FieldOriginalInvoiceID, field.TypeInt64, value)
+	}
+	if _u.mutation.OriginalInvoiceIDCleared() {
+		_spec.ClearField(invoice.FieldOriginalInvoiceID, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(invoice.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Subtotal(); ok {
+		_spec.SetField(invoice.FieldSubtotal, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedSubtotal(); ok {
+		_spec.AddField(invoice.FieldSubtotal, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.DiscountAmount(); ok {
+		_spec.SetField(invoice.FieldDiscountAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedDiscountAmount(); ok {
+		_spec.AddField(invoice.FieldDiscountAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.FeeAmount(); ok {
+		_spec.SetField(invoice.FieldFeeAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedFeeAmount(); ok {
+		_spec.AddField(invoice.FieldFeeAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Total(); ok {
+		_spec.SetField(invoice.FieldTotal, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedTotal(); ok {
+		_spec.AddField(invoice.FieldTotal, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Lines(); ok {
+		_spec.SetField(invoice.FieldLines, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLines(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, invoice.FieldLines, value)
+		})
+	}
+	if value, ok := _u.mutation.PaymentMethod(); ok {
+		_spec.SetField(invoice.FieldPaymentMethod, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BuyerEmail(); ok {
+		_spec.SetField(invoice.FieldBuyerEmail, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BuyerName(); ok {
+		_spec.SetField(invoice.FieldBuyerName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BuyerTaxID(); ok {
+		_spec.SetField(invoice.FieldBuyerTaxID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BuyerAddress(); ok {
+		_spec.SetField(invoice.FieldBuyerAddress, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SellerName(); ok {
+		_spec.SetField(invoice.FieldSellerName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SellerTaxID(); ok {
+		_spec.SetField(invoice.FieldSellerTaxID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SellerAddress(); ok {
+		_spec.SetField(invoice.FieldSellerAddress, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Notes(); ok {
+		_spec.SetField(invoice.FieldNotes, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.IssuedAt(); ok {
+		_spec.SetField(invoice.FieldIssuedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.EmailedAt(); ok {
+		_spec.SetField(invoice.FieldEmailedAt, field.TypeTime, value)
+	}
+	if _u.mutation.EmailedAtCleared() {
+		_spec.ClearField(invoice.FieldEmailedAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invoice.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// InvoiceUpdateOne is the builder for updating a single Invoice entity.
+type InvoiceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *InvoiceMutation
+}
+
+// SetInvoiceNo sets the "invoice_no" field.
+func (_u *InvoiceUpdateOne) SetInvoiceNo(v string) *InvoiceUpdateOne {
+	_u.mutation.SetInvoiceNo(v)
+	return _u
+}
+
+// SetNillableInvoiceNo sets the "invoice_no" field if the given value is not nil.
+func (_u *InvoiceUpdateOne) SetNillableInvoiceNo(v *string) *InvoiceUpdateOne {
+	if v != nil {
+		_u.SetInvoiceNo(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *InvoiceUpdateOne) SetKind(v string) *InvoiceUpdateOne {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *InvoiceUpdateOne) SetNillableKind(v *string) *InvoiceUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *InvoiceUpdateOne) SetUserID(v int64) *InvoiceUpdateOne {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *InvoiceUpdateOne) SetNillableUserID(v *int64) *InvoiceUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *InvoiceUpdateOne) AddUserID(v int64) *InvoiceUpdateOne {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetSourceType sets the "source_type" field.
+func (_u *InvoiceUpdateOne) SetSourceType(v string) *InvoiceUpdateOne {
+	_u.mutation.SetSourceType(v)
+	return _u
+}
+
+// SetNillableSourceType sets the "source_type" field if the given value is not nil.
+func (_u *InvoiceUpdateOne) SetNillableSourceType(v *string) *InvoiceUpdateOne {
+	if v != nil {
+		_u.SetSourceType(*v)
+	}
+	return _u
+}
+
+// SetSourceID sets the "source_id" field.
+func (_u *InvoiceUpdateOne) SetSourceID(v int64) *InvoiceUpdateOne {
+	_u.mutation.ResetSourceID()
+	_u.mutation.SetSourceID(v)
+	return _u
+}
+
+// SetNillableSourceID sets the "source_id" field if the given value is not nil.
+func (_u *InvoiceUpdateOne) SetNillableSourceID(v *int64) *InvoiceUpdateOne {
+	if v != nil {
+		_u.SetSourceID(*v)
+	}
+	return _u
+}
+
+// AddSourceID adds value to the "source_id" field.
+func (_u *InvoiceUpdateOne) AddSourceID(v int64) *InvoiceUpdateOne {
+	_u.mutation.AddSourceID(v)
+	return _u
+}
+
+// SetSourceNo sets the "source_no" field.
+func (_u *InvoiceUpdateOne) SetSourceNo(v string) *InvoiceUpdateOne {
+	_u.mutation.SetSourceNo(v)
+	return _u
+}
+
+// SetNillableSourceNo sets the "source_no" field if the given value is not nil.
+func (_u *InvoiceUpdateOne) SetNillableSourceNo(v *string) *InvoiceUpdateOne {
+	if v != nil {
+		_u.SetSourceNo(*v)
+	}
+	return _u
+}
+
+// SetOriginalInvoiceID sets the "original_invoice_id" field.
+func (_u *InvoiceUpdateOne) SetOriginalInvoiceID(v int64) *InvoiceUpdateOne {
+	_u.mutation.ResetOriginalInvoiceID()
+	_u.mutation.SetOriginalInvoiceID(v)
+	return _u
+}
+
+// SetNillableOriginalInvoiceID sets the "original_invoice_id" field if the given value is not nil.
+func (_u *InvoiceUpdateOne) SetNillableOriginalInvoiceID(v *int64) *InvoiceUpdateOne {
+	if v != nil {
+		_u.SetOriginalInvoiceID(*v)
+	}
+	return _u
+}
+
+// AddOriginalInvoiceID adds value to the "original_invoice_id" field.
+func (_u *InvoiceUpdateOne) AddOriginalInvoiceID(v int64) *InvoiceUpdateOne {
+	_u.mutation.AddOriginalInvoiceID(v)
+	return _u
+}
+
+// ClearOriginalInvoiceID clears the value of the "original_invoice_id" field.
+func (_u *InvoiceUpdateOne) ClearOriginalInvoiceID() *InvoiceUpdateOne {
+	_u.mutation.ClearOriginalInvoiceID()
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *InvoiceUpdateOne) SetCurrency(v string) *InvoiceUpdateOne {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *InvoiceUpdateOne) SetNillableCurrency(v *string) *InvoiceUpdateOne {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetSubtotal sets the "subtotal" field.
+func (_u *InvoiceUpdateOne) SetSubtotal(v float64) *InvoiceUpdateOne {
+	_u.mutation.ResetSubtotal()
+	_u.mutation.SetSubtotal(v)
+	return _u
+}
+
+// SetNillableSubtotal sets the "subtotal" field if the given value is not nil.
+func (_u *InvoiceUpdateOne) SetNillableSubtotal(v *float64) *InvoiceUpdateOne {
+	if v != nil {
+		_u.SetSubtotal(*v)
+	}
+	return _u
+}
+
+// AddSubtotal adds value to the "subtotal" field.
+func (_u *InvoiceUpdateOne) AddSubtotal(v float64) *InvoiceUpdateOne {
+	_u.mutation.AddSubtotal(v)
+	return _u
+}
+
+// SetDiscountAmount sets the "discount_amount" field.
+func (_u *InvoiceUpdateOne) SetDiscountAmount(v float64) *InvoiceUpdateOne {
+	_u.mutation.ResetDiscountAmount()
+	_u.mutation.SetDiscountAmount(v)
+	return _u
+}
+
+// SetNillableDiscountAmount sets the "discount_amount" field if the given value is not nil.
+func (_u *InvoiceUpdateOne) SetNillableDiscountAmount(v *float64) *InvoiceUpdateOne {
+	if v != nil {
+		_u.SetDiscountAmount(*v)
+	}
+	return _u
+}
+
+// AddDiscountAmount adds value to the "discount_amount" field.
+func (_u *InvoiceUpdateOne) AddDiscountAmount(v float64) *InvoiceUpdateOne {
+	_u.mutation.AddDiscountAmount(v)
+	return _u
+}
+
+// SetFeeAmount sets the "fee_amount" field.
+func (_u *InvoiceUpdateOne) SetFeeAmount(v float64) *InvoiceUpdateOne {
+	_u.mutation.ResetFeeAmount()
+	_u.mutation.SetFeeAmount(v)
+	return _u
+}
+
+// SetNillableFeeAmount sets the "fee_amount" field if the given value is not nil.
+func (_u *InvoiceUpdateOne) SetNillableFeeAmount(v *float64) *InvoiceUpdateOne {
+	if v != nil {
+		_u.SetFeeAmount(*v)
+	}
+	return _u
+}
+
+// AddFeeAmount adds value to the "fee_amount" field.
+func (_u *InvoiceUpdateOne) AddFeeAmount(v float64) *InvoiceUpdateOne {
+	_u.mutation.AddFeeAmount(v)
+	return _u
+}
+
+// SetTotal sets the "total" field.
+func (_u *InvoiceUpdateOne) SetTotal(v float64) *InvoiceUpdateOne {
+	_u.mutation.ResetTotal()
+	_u.mutation.SetTotal(v)
+	return _u
+}
+
+// SetNillableTotal sets the "total" field if the given value is not nil.
+func (_u *InvoiceUpdateOne) SetNillableTotal(v *float64) *InvoiceUpdateOne {
+	if v != nil {
+		_u.SetTotal(*v)
+	}
+	return _u
+}
+
+// AddTotal adds value to the "total" field.
+func (_u *InvoiceUpdateOne) AddTotal(v float64) *InvoiceUpdateOne {
+	_u.mutation.AddTotal(v)
+	return _u
+}
+
+// SetLines sets the "lines" field.
+func (_u *InvoiceUpdateOne) SetLines(v []model.InvoiceLine) *InvoiceUpdateOne {
+	_u.mutation.SetLines(v)
+	return _u
+}
+
+// AppendLines appends value to the "lines" field.
+func (_u *InvoiceUpdateOne) AppendLines(v []model.InvoiceLine) *InvoiceUpdateOne {
+	_u.mutation.AppendLines(v)
+	return _u
+}
+
+// SetPaymentMethod sets the "payment_method" field.
+func (_u *InvoiceUpdateOne) SetPaymentMethod(v string) *InvoiceUpdateOne {
+	_u.mutation.SetPaymentMethod(v)
+	return _u
+}
+
+// SetNillablePaymentMethod sets the "payment_method" field if the given value is not nil.
+func (_u *InvoiceUpdateOne) SetNillablePaymentMethod(v *string) *InvoiceUpdateOne {
+	if v != nil {
+		_u.SetPaymentMethod(*v)
+	}
+	return _u
+}
+
+// SetBuyerEmail sets the "buyer_email" field.
+func (_u *InvoiceUpdateOne) SetBuyerEmail(v string) *InvoiceUpdateOne {
+	_u.mutation.SetBuyerEmail(v)
+	return _u
+}
+
+// SetNillableBuyerEmail sets the "buyer_email" field if the given value is not nil.
+func (_u *InvoiceUpdateOne) SetNillableBuyerEmail(v *string) *InvoiceUpdateOne {
+	if v != nil {
+		_u.SetBuyerEmail(*v)
+	}
+	return _u
+}
+
+// SetBuyerName sets the "buyer_name" field.
+func (_u *InvoiceUpdateOne) SetBuyerName(v string) *InvoiceUpdateOne {
+	_u.mutation.SetBuyerName(v)
+	return _u
+}
+
+// SetNillableBuyerName sets the "buyer_name" field if the given value is not nil.
+func (_u *InvoiceUpdateOne) SetNillableBuyerName(v *string) *InvoiceUpdateOne {
+	if v != nil {
+		_u.SetBuyerName(*v)
+	}
+	return _u
+}
+
+// SetBuyerTaxID sets the "buyer_tax_id" field.
+func (_u *InvoiceUpdateOne) SetBuyerTaxID(v string) *InvoiceUpdateOne {
+	_u.mutation.SetBuyerTaxID(v)
+	return _u
+}
+
+// SetNillableBuyerTaxID sets the "buyer_tax_id" field if the given value is not nil.
+func (_u *InvoiceUpdateOne) SetNillableBuyerTaxID(v *string) *InvoiceUpdateOne {
+	if v != nil {
+		_u.SetBuyerTaxID(*v)
+	}
+	return _u
+}
+
+// SetBuyerAddress sets the "buyer_address" field.
+func (_u *InvoiceUpdateOne) SetBuyerAddress(v string) *InvoiceUpdateOne {
+	_u.mutation.SetBuyerAddress(v)
+	return _u
+}
+
+// SetNillableBuyerAddress sets the "buyer_address" field if the given value is not nil.
+func (_u *InvoiceUpdateOne) SetNillableBuyerAddress(v *string) *InvoiceUpdateOne {
+	if v != nil {
+		_u.SetBuyerAddress(*v)
+	}
+	return _u
+}
+
+// SetSellerName sets the "seller_name" field.
+func (_u *InvoiceUpdateOne) SetSellerName(v string) *InvoiceUpdateOne {
+	_u.mutation.SetSellerName(v)
+	return _u
+}
+
+// SetNillableSellerName sets the "seller_name" field if the given value is not nil.
+func (_u *InvoiceUpdateOne) SetNillableSellerName(v *string) *InvoiceUpdateOne {
+	if v != nil {
+		_u.SetSellerName(*v)
+	}
+	return _u
+}
+
+// SetSellerTaxID sets the "seller_tax_id" field.
+func (_u *InvoiceUpdateOne) SetSellerTaxID(v string) *InvoiceUpdateOne {
+	_u.mutation.SetSellerTaxID(v)
+	return _u
+}
+
+// SetNillableSellerTaxID sets the "seller_tax_id" field if the given value is not nil.
+func (_u *InvoiceUpdateOne) SetNillableSellerTaxID(v *string) *InvoiceUpdateOne {
+	if v != nil {
+		_u.SetSellerTaxID(*v)
+	}
+	return _u
+}
+
+// SetSellerAddress sets the "seller_address" field.
+func (_u *InvoiceUpdateOne) SetSellerAddress(v string) *InvoiceUpdateOne {
+	_u.mutation.SetSellerAddress(v)
+	return _u
+}
+
+// SetNillableSellerAddress sets the "seller_address" field if the given value is not nil.
+func (_u *InvoiceUpdateOne) SetNillableSellerAddress(v *string) *InvoiceUpdateOne {
+	if v != nil {
+		_u.SetSellerAddress(*v)
+	}
+	return _u
+}
+
+// SetNotes sets the "notes" field.
+func (_u *InvoiceUpdateOne) SetNotes(v string) *InvoiceUpdateOne {
+	_u.mutation.SetNotes(v)
+	return _u
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (_u *InvoiceUpdateOne) SetNillableNotes(v *string) *InvoiceUpdateOne {
+	if v != nil {
+		_u.SetNotes(*v)
+	}
+	return _u
+}
+
+// SetIssuedAt sets the "issued_at" field.
+func (_u *InvoiceUpdateOne) SetIssuedAt(v time.Time) *InvoiceUpdateOne {
+	_u.mutation.SetIssuedAt(v)
+	return _u
+}
+
+// SetNillableIssuedAt sets the "issued_at" field if the given value is not nil.
+func (_u *InvoiceUpdateOne) SetNillableIssuedAt(v *time.Time) *InvoiceUpdateOne {
+	if v != nil {
+		_u.SetIssuedAt(*v)
+	}
+	return _u
+}
+
+// SetEmailedAt sets the "emailed_at" field.
+func (_u *InvoiceUpdateOne) SetEmailedAt(v time.Time) *InvoiceUpdateOne {
+	_u.mutation.SetEmailedAt(v)
+	return _u
+}
+
+// SetNillableEmailedAt sets the "emailed_at" field if the given value is not nil.
+func (_u *InvoiceUpdateOne) SetNillableEmailedAt(v *time.Time) *InvoiceUpdateOne {
+	if v != nil {
+		_u.SetEmailedAt(*v)
+	}
+	return _u
+}
+
+// ClearEmailedAt clears the value of the "emailed_at" field.
+func (_u *InvoiceUpdateOne) ClearEmailedAt() *InvoiceUpdateOne {
+	_u.mutation.ClearEmailedAt()
+	return _u
+}
+
+// Mutation returns the InvoiceMutation object of the builder.
+func (_u *InvoiceUpdateOne) Mutation() *InvoiceMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the InvoiceUpdate builder.
+func (_u *InvoiceUpdateOne) Where(ps ...predicate.Invoice) *InvoiceUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *InvoiceUpdateOne) Select(field string, fields ...string) *InvoiceUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Invoice entity.
+func (_u *InvoiceUpdateOne) Save(ctx context.Context) (*Invoice, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *InvoiceUpdateOne) SaveX(ctx context.Context) *Invoice {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *InvoiceUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *InvoiceUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *InvoiceUpdateOne) check() error {
+	if v, ok := _u.mutation.InvoiceNo(); ok {
+		if err := invoice.InvoiceNoValidator(v); err != nil {
+			return &ValidationError{Name: "invoice_no", err: fmt.Errorf(`ent: validator failed for field "Invoice.invoice_no": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := invoice.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Invoice.kind": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SourceType(); ok {
+		if err := invoice.SourceTypeValidator(v); err != nil {
+			return &ValidationError{Name: "source_type", err: fmt.Errorf(`ent: validator failed for field "Invoice.source_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SourceNo(); ok {
+		if err := invoice.SourceNoValidator(v); err != nil {
+			return &ValidationError{Name: "source_no", err: fmt.Errorf(`ent: validator failed for field "Invoice.source_no": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Currency(); ok {
+		if err := invoice.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "Invoice.currency": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PaymentMethod(); ok {
+		if err := invoice.PaymentMethodValidator(v); err != nil {
+			return &ValidationError{Name: "payment_method", err: fmt.Errorf(`ent: validator failed for field "Invoice.payment_method": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BuyerEmail(); ok {
+		if err := invoice.BuyerEmailValidator(v); err != nil {
+			return &ValidationError{Name: "buyer_email", err: fmt.Errorf(`ent: validator failed for field "Invoice.buyer_email": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BuyerName(); ok {
+		if err := invoice.BuyerNameValidator(v); err != nil {
+			return &ValidationError{Name: "buyer_name", err: fmt.Errorf(`ent: validator failed for field "Invoice.buyer_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BuyerTaxID(); ok {
+		if err := invoice.BuyerTaxIDValidator(v); err != nil {
+			return &ValidationError{Name: "buyer_tax_id", err: fmt.Errorf(`ent: validator failed for field "Invoice.buyer_tax_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SellerName(); ok {
+		if err := invoice.SellerNameValidator(v); err != nil {
+			return &ValidationError{Name: "seller_name", err: fmt.Errorf(`ent: validator failed for field "Invoice.seller_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SellerTaxID(); ok {
+		if err := invoice.SellerTaxIDValidator(v); err != nil {
+			return &ValidationError{Name: "seller_tax_id", err: fmt.Errorf(`ent: validator failed for field "Invoice.seller_tax_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *InvoiceUpdateOne) sqlSave(ctx context.Context) (_node *Invoice, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(invoice.Table, invoice.Columns, sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt64))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Invoice.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, invoice.FieldID)
+		for _, f := range fields {
+			if !invoice.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != invoice.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.InvoiceNo(); ok {
+		_spec.SetField(invoice.FieldInvoiceNo, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(invoice.FieldKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(invoice.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(invoice.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.SourceType(); ok {
+		_spec.SetField(invoice.FieldSourceType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SourceID(); ok {
+		_spec.SetField(invoice.FieldSourceID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSourceID(); ok {
+		_spec.AddField(invoice.FieldSourceID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.SourceNo(); ok {
+		_spec.SetField(invoice.FieldSourceNo, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.OriginalInvoiceID(); ok {
+		_spec.SetField(invoice.FieldOriginalInvoiceID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedOriginalInvoiceID(); ok {
+		_spec.AddField(invoice.FieldOriginalInvoiceID, field.TypeInt64, value)
+	}
+	if _u.mutation.OriginalInvoiceIDCleared() {
+		_spec.ClearField(invoice.FieldOriginalInvoiceID, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(invoice.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Subtotal(); ok {
+		_spec.SetField(invoice.FieldSubtotal, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedSubtotal(); ok {
+		_spec.AddField(invoice.FieldSubtotal, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.DiscountAmount(); ok {
+		_spec.SetField(invoice.FieldDiscountAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedDiscountAmount(); ok {
+		_spec.AddField(invoice.FieldDiscountAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.FeeAmount(); ok {
+		_spec.SetField(invoice.FieldFeeAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedFeeAmount(); ok {
+		_spec.AddField(invoice.FieldFeeAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Total(); ok {
+		_spec.SetField(invoice.FieldTotal, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedTotal(); ok {
+		_spec.AddField(invoice.FieldTotal, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Lines(); ok {
+		_spec.SetField(invoice.FieldLines, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLines(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, invoice.FieldLines, value)
+		})
+	}
+	if value, ok := _u.mutation.PaymentMethod(); ok {
+		_spec.SetField(invoice.FieldPaymentMethod, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BuyerEmail(); ok {
+		_spec.SetField(invoice.FieldBuyerEmail, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BuyerName(); ok {
+		_spec.SetField(invoice.FieldBuyerName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BuyerTaxID(); ok {
+		_spec.SetField(invoice.FieldBuyerTaxID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BuyerAddress(); ok {
+		_spec.SetField(invoice.FieldBuyerAddress, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SellerName(); ok {
+		_spec.SetField(invoice.FieldSellerName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SellerTaxID(); ok {
+		_spec.SetField(invoice.FieldSellerTaxID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SellerAddress(); ok {
+		_spec.SetField(invoice.FieldSellerAddress, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Notes(); ok {
+		_spec.SetField(invoice.FieldNotes, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.IssuedAt(); ok {
+		_spec.SetField(invoice.FieldIssuedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.EmailedAt(); ok {
+		_spec.SetField(invoice.FieldEmailedAt, field.TypeTime, value)
+	}
+	if _u.mutation.EmailedAtCleared() {
+		_spec.ClearField(invoice.FieldEmailedAt, field.TypeTime)
+	}
+	_node = &Invoice{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invoice.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
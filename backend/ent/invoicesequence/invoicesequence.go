@@ -0,0 +1,62 @@
+// Code generated by ent, DO NOT EDIT.
+
+package invoicesequence
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the invoicesequence type in the database.
+	Label = "invoice_sequence"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldSeries holds the string denoting the series field in the database.
+	FieldSeries = "series"
+	// FieldLastNumber holds the string denoting the last_number field in the database.
+	FieldLastNumber = "last_number"
+	// Table holds the table name of the invoicesequence in the database.
+	Table = "invoice_sequences"
+)
+
+// Columns holds all SQL columns for invoicesequence fields.
+var Columns = []string{
+	FieldID,
+	FieldSeries,
+	FieldLastNumber,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// SeriesValidator is a validator for the "series" field. It is called by the builders before save.
+	SeriesValidator func(string) error
+	// DefaultLastNumber holds the default value on creation for the "last_number" field.
+	DefaultLastNumber int64
+)
+
+// OrderOption defines the ordering options for the InvoiceSequence queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// BySeries orders the results by the series field.
+func BySeries(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSeries, opts...).ToFunc()
+}
+
+// ByLastNumber orders the results by the last_number field.
+func ByLastNumber(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastNumber, opts...).ToFunc()
+}
@@ -0,0 +1,183 @@
+// Code generated by ent, DO NOT EDIT.
+
+package invoicesequence
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"github.com/Wei-Shaw/sub2api/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.InvoiceSequence {
+	return predicate.InvoiceSequence(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.InvoiceSequence {
+	return predicate.InvoiceSequence(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.InvoiceSequence {
+	return predicate.InvoiceSequence(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.InvoiceSequence {
+	return predicate.InvoiceSequence(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.InvoiceSequence {
+	return predicate.InvoiceSequence(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.InvoiceSequence {
+	return predicate.InvoiceSequence(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.InvoiceSequence {
+	return predicate.InvoiceSequence(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.InvoiceSequence {
+	return predicate.InvoiceSequence(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.InvoiceSequence {
+	return predicate.InvoiceSequence(sql.FieldLTE(FieldID, id))
+}
+
+// Series applies equality check predicate on the "series" field. It's identical to SeriesEQ.
+func Series(v string) predicate.InvoiceSequence {
+	return predicate.InvoiceSequence(sql.FieldEQ(FieldSeries, v))
+}
+
+// LastNumber applies equality check predicate on the "last_number" field. It's identical to LastNumberEQ.
+func LastNumber(v int64) predicate.InvoiceSequence {
+	return predicate.InvoiceSequence(sql.FieldEQ(FieldLastNumber, v))
+}
+
+// SeriesEQ applies the EQ predicate on the "series" field.
+func SeriesEQ(v string) predicate.InvoiceSequence {
+	return predicate.InvoiceSequence(sql.FieldEQ(FieldSeries, v))
+}
+
+// SeriesNEQ applies the NEQ predicate on the "series" field.
+func SeriesNEQ(v string) predicate.InvoiceSequence {
+	return predicate.InvoiceSequence(sql.FieldNEQ(FieldSeries, v))
+}
+
+// SeriesIn applies the In predicate on the "series" field.
+func SeriesIn(vs ...string) predicate.InvoiceSequence {
+	return predicate.InvoiceSequence(sql.FieldIn(FieldSeries, vs...))
+}
+
+// SeriesNotIn applies the NotIn predicate on the "series" field.
+func SeriesNotIn(vs ...string) predicate.InvoiceSequence {
+	return predicate.InvoiceSequence(sql.FieldNotIn(FieldSeries, vs...))
+}
+
+// SeriesGT applies the GT predicate on the "series" field.
+func SeriesGT(v string) predicate.InvoiceSequence {
+	return predicate.InvoiceSequence(sql.FieldGT(FieldSeries, v))
+}
+
+// SeriesGTE applies the GTE predicate on the "series" field.
+func SeriesGTE(v string) predicate.InvoiceSequence {
+	return predicate.InvoiceSequence(sql.FieldGTE(FieldSeries, v))
+}
+
+// SeriesLT applies the LT predicate on the "series" field.
+func SeriesLT(v string) predicate.InvoiceSequence {
+	return predicate.InvoiceSequence(sql.FieldLT(FieldSeries, v))
+}
+
+// SeriesLTE applies the LTE predicate on the "series" field.
+func SeriesLTE(v string) predicate.InvoiceSequence {
+	return predicate.InvoiceSequence(sql.FieldLTE(FieldSeries, v))
+}
+
+// SeriesContains applies the Contains predicate on the "series" field.
+func SeriesContains(v string) predicate.InvoiceSequence {
+	return predicate.InvoiceSequence(sql.FieldContains(FieldSeries, v))
+}
+
+// SeriesHasPrefix applies the HasPrefix predicate on the "series" field.
+func SeriesHasPrefix(v string) predicate.InvoiceSequence {
+	return predicate.InvoiceSequence(sql.FieldHasPrefix(FieldSeries, v))
+}
+
+// SeriesHasSuffix applies the HasSuffix predicate on the "series" field.
+func SeriesHasSuffix(v string) predicate.InvoiceSequence {
+	return predicate.InvoiceSequence(sql.FieldHasSuffix(FieldSeries, v))
+}
+
+// SeriesEqualFold applies the EqualFold predicate on the "series" field.
+func SeriesEqualFold(v string) predicate.InvoiceSequence {
+	return predicate.InvoiceSequence(sql.FieldEqualFold(FieldSeries, v))
+}
+
+// SeriesContainsFold applies the ContainsFold predicate on the "series" field.
+func SeriesContainsFold(v string) predicate.InvoiceSequence {
+	return predicate.InvoiceSequence(sql.FieldContainsFold(FieldSeries, v))
+}
+
+// LastNumberEQ applies the EQ predicate on the "last_number" field.
+func LastNumberEQ(v int64) predicate.InvoiceSequence {
+	return predicate.InvoiceSequence(sql.FieldEQ(FieldLastNumber, v))
+}
+
+// LastNumberNEQ applies the NEQ predicate on the "last_number" field.
+func LastNumberNEQ(v int64) predicate.InvoiceSequence {
+	return predicate.InvoiceSequence(sql.FieldNEQ(FieldLastNumber, v))
+}
+
+// LastNumberIn applies the In predicate on the "last_number" field.
+func LastNumberIn(vs ...int64) predicate.InvoiceSequence {
+	return predicate.InvoiceSequence(sql.FieldIn(FieldLastNumber, vs...))
+}
+
+// LastNumberNotIn applies the NotIn predicate on the "last_number" field.
+func LastNumberNotIn(vs ...int64) predicate.InvoiceSequence {
+	return predicate.InvoiceSequence(sql.FieldNotIn(FieldLastNumber, vs...))
+}
+
+// LastNumberGT applies the GT predicate on the "last_number" field.
+func LastNumberGT(v int64) predicate.InvoiceSequence {
+	return predicate.InvoiceSequence(sql.FieldGT(FieldLastNumber, v))
+}
+
+// LastNumberGTE applies the GTE predicate on the "last_number" field.
+func LastNumberGTE(v int64) predicate.InvoiceSequence {
+	return predicate.InvoiceSequence(sql.FieldGTE(FieldLastNumber, v))
+}
+
+// LastNumberLT applies the LT predicate on the "last_number" field.
+func LastNumberLT(v int64) predicate.InvoiceSequence {
+	return predicate.InvoiceSequence(sql.FieldLT(FieldLastNumber, v))
+}
+
+// LastNumberLTE applies the LTE predicate on the "last_number" field.
+func LastNumberLTE(v int64) predicate.InvoiceSequence {
+	return predicate.InvoiceSequence(sql.FieldLTE(FieldLastNumber, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.InvoiceSequence) predicate.InvoiceSequence {
+	return predicate.InvoiceSequence(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.InvoiceSequence) predicate.InvoiceSequence {
+	return predicate.InvoiceSequence(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.InvoiceSequence) predicate.InvoiceSequence {
+	return predicate.InvoiceSequence(sql.NotPredicates(p))
+}
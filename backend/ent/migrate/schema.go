@@ -33,6 +33,7 @@ var (
 		{Name: "window_5h_start", Type: field.TypeTime, Nullable: true},
 		{Name: "window_1d_start", Type: field.TypeTime, Nullable: true},
 		{Name: "window_7d_start", Type: field.TypeTime, Nullable: true},
+		{Name: "priority_class", Type: field.TypeString, Size: 20, Default: ""},
 		{Name: "group_id", Type: field.TypeInt64, Nullable: true},
 		{Name: "user_id", Type: field.TypeInt64},
 	}
@@ -44,13 +45,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "api_keys_groups_api_keys",
-				Columns:    []*schema.Column{APIKeysColumns[22]},
+				Columns:    []*schema.Column{APIKeysColumns[23]},
 				RefColumns: []*schema.Column{GroupsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "api_keys_users_api_keys",
-				Columns:    []*schema.Column{APIKeysColumns[23]},
+				Columns:    []*schema.Column{APIKeysColumns[24]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -59,12 +60,12 @@ var (
 			{
 				Name:    "apikey_user_id",
 				Unique:  false,
-				Columns: []*schema.Column{APIKeysColumns[23]},
+				Columns: []*schema.Column{APIKeysColumns[24]},
 			},
 			{
 				Name:    "apikey_group_id",
 				Unique:  false,
-				Columns: []*schema.Column{APIKeysColumns[22]},
+				Columns: []*schema.Column{APIKeysColumns[23]},
 			},
 			{
 				Name:    "apikey_status",
@@ -105,6 +106,7 @@ var (
 		{Name: "type", Type: field.TypeString, Size: 20},
 		{Name: "credentials", Type: field.TypeJSON, SchemaType: map[string]string{"postgres": "jsonb"}},
 		{Name: "extra", Type: field.TypeJSON, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "proxy_pool_id", Type: field.TypeInt64, Nullable: true},
 		{Name: "concurrency", Type: field.TypeInt, Default: 3},
 		{Name: "load_factor", Type: field.TypeInt, Nullable: true},
 		{Name: "priority", Type: field.TypeInt, Default: 50},
@@ -123,6 +125,7 @@ var (
 		{Name: "session_window_start", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "timestamptz"}},
 		{Name: "session_window_end", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "timestamptz"}},
 		{Name: "session_window_status", Type: field.TypeString, Nullable: true, Size: 20},
+		{Name: "availability_schedule", Type: field.TypeString, Nullable: true, Size: 200},
 		{Name: "proxy_id", Type: field.TypeInt64, Nullable: true},
 	}
 	// AccountsTable holds the schema information for the "accounts" table.
@@ -133,7 +136,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "accounts_proxies_proxy",
-				Columns:    []*schema.Column{AccountsColumns[28]},
+				Columns:    []*schema.Column{AccountsColumns[30]},
 				RefColumns: []*schema.Column{ProxiesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -152,52 +155,57 @@ var (
 			{
 				Name:    "account_status",
 				Unique:  false,
-				Columns: []*schema.Column{AccountsColumns[14]},
+				Columns: []*schema.Column{AccountsColumns[15]},
 			},
 			{
 				Name:    "account_proxy_id",
 				Unique:  false,
-				Columns: []*schema.Column{AccountsColumns[28]},
+				Columns: []*schema.Column{AccountsColumns[30]},
+			},
+			{
+				Name:    "account_proxy_pool_id",
+				Unique:  false,
+				Columns: []*schema.Column{AccountsColumns[10]},
 			},
 			{
 				Name:    "account_priority",
 				Unique:  false,
-				Columns: []*schema.Column{AccountsColumns[12]},
+				Columns: []*schema.Column{AccountsColumns[13]},
 			},
 			{
 				Name:    "account_last_used_at",
 				Unique:  false,
-				Columns: []*schema.Column{AccountsColumns[16]},
+				Columns: []*schema.Column{AccountsColumns[17]},
 			},
 			{
 				Name:    "account_schedulable",
 				Unique:  false,
-				Columns: []*schema.Column{AccountsColumns[19]},
+				Columns: []*schema.Column{AccountsColumns[20]},
 			},
 			{
 				Name:    "account_rate_limited_at",
 				Unique:  false,
-				Columns: []*schema.Column{AccountsColumns[20]},
+				Columns: []*schema.Column{AccountsColumns[21]},
 			},
 			{
 				Name:    "account_rate_limit_reset_at",
 				Unique:  false,
-				Columns: []*schema.Column{AccountsColumns[21]},
+				Columns: []*schema.Column{AccountsColumns[22]},
 			},
 			{
 				Name:    "account_overload_until",
 				Unique:  false,
-				Columns: []*schema.Column{AccountsColumns[22]},
+				Columns: []*schema.Column{AccountsColumns[23]},
 			},
 			{
 				Name:    "account_platform_priority",
 				Unique:  false,
-				Columns: []*schema.Column{AccountsColumns[6], AccountsColumns[12]},
+				Columns: []*schema.Column{AccountsColumns[6], AccountsColumns[13]},
 			},
 			{
 				Name:    "account_priority_status",
 				Unique:  false,
-				Columns: []*schema.Column{AccountsColumns[12], AccountsColumns[14]},
+				Columns: []*schema.Column{AccountsColumns[13], AccountsColumns[15]},
 			},
 			{
 				Name:    "account_deleted_at",
@@ -338,6 +346,139 @@ var (
 			},
 		},
 	}
+	// BalanceBucketsColumns holds the columns for the "balance_buckets" table.
+	BalanceBucketsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "user_id", Type: field.TypeInt64},
+		{Name: "source", Type: field.TypeString, Size: 20},
+		{Name: "source_ref", Type: field.TypeString, Size: 128, Default: ""},
+		{Name: "amount", Type: field.TypeFloat64, SchemaType: map[string]string{"postgres": "decimal(20,8)"}},
+		{Name: "remaining", Type: field.TypeFloat64, SchemaType: map[string]string{"postgres": "decimal(20,8)"}},
+		{Name: "priority", Type: field.TypeInt, Default: 100},
+		{Name: "status", Type: field.TypeString, Size: 20, Default: "active"},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "expired_amount", Type: field.TypeFloat64, Default: 0, SchemaType: map[string]string{"postgres": "decimal(20,8)"}},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+	}
+	// BalanceBucketsTable holds the schema information for the "balance_buckets" table.
+	BalanceBucketsTable = &schema.Table{
+		Name:       "balance_buckets",
+		Columns:    BalanceBucketsColumns,
+		PrimaryKey: []*schema.Column{BalanceBucketsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "balancebucket_user_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{BalanceBucketsColumns[1], BalanceBucketsColumns[7]},
+			},
+			{
+				Name:    "balancebucket_status_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{BalanceBucketsColumns[7], BalanceBucketsColumns[8]},
+			},
+		},
+	}
+	// BillingAdjustmentsColumns holds the columns for the "billing_adjustments" table.
+	BillingAdjustmentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "job_id", Type: field.TypeInt64},
+		{Name: "user_id", Type: field.TypeInt64},
+		{Name: "amount", Type: field.TypeFloat64, SchemaType: map[string]string{"postgres": "decimal(20,8)"}},
+		{Name: "balance_before", Type: field.TypeFloat64, SchemaType: map[string]string{"postgres": "decimal(20,8)"}},
+		{Name: "balance_after", Type: field.TypeFloat64, SchemaType: map[string]string{"postgres": "decimal(20,8)"}},
+		{Name: "usage_rows", Type: field.TypeInt64, Default: 0},
+		{Name: "operator_id", Type: field.TypeInt64},
+		{Name: "note", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+	}
+	// BillingAdjustmentsTable holds the schema information for the "billing_adjustments" table.
+	BillingAdjustmentsTable = &schema.Table{
+		Name:       "billing_adjustments",
+		Columns:    BillingAdjustmentsColumns,
+		PrimaryKey: []*schema.Column{BillingAdjustmentsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "billingadjustment_job_id_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{BillingAdjustmentsColumns[1], BillingAdjustmentsColumns[2]},
+			},
+			{
+				Name:    "billingadjustment_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{BillingAdjustmentsColumns[2]},
+			},
+		},
+	}
+	// BillingRecomputeJobsColumns holds the columns for the "billing_recompute_jobs" table.
+	BillingRecomputeJobsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "status", Type: field.TypeString, Size: 20, Default: "pending"},
+		{Name: "mode", Type: field.TypeString, Size: 20},
+		{Name: "params", Type: field.TypeJSON, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "report", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "scanned_rows", Type: field.TypeInt64, Default: 0},
+		{Name: "changed_rows", Type: field.TypeInt64, Default: 0},
+		{Name: "original_cost", Type: field.TypeFloat64, Default: 0, SchemaType: map[string]string{"postgres": "decimal(20,8)"}},
+		{Name: "recomputed_cost", Type: field.TypeFloat64, Default: 0, SchemaType: map[string]string{"postgres": "decimal(20,8)"}},
+		{Name: "balance_diff", Type: field.TypeFloat64, Default: 0, SchemaType: map[string]string{"postgres": "decimal(20,8)"}},
+		{Name: "error_message", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "created_by", Type: field.TypeInt64},
+		{Name: "applied_by", Type: field.TypeInt64, Nullable: true},
+		{Name: "started_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "finished_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "applied_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+	}
+	// BillingRecomputeJobsTable holds the schema information for the "billing_recompute_jobs" table.
+	BillingRecomputeJobsTable = &schema.Table{
+		Name:       "billing_recompute_jobs",
+		Columns:    BillingRecomputeJobsColumns,
+		PrimaryKey: []*schema.Column{BillingRecomputeJobsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "billingrecomputejob_status_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{BillingRecomputeJobsColumns[1], BillingRecomputeJobsColumns[16]},
+			},
+		},
+	}
+	// BillingStatementsColumns holds the columns for the "billing_statements" table.
+	BillingStatementsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "user_id", Type: field.TypeInt64},
+		{Name: "period_start", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "period_end", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "amount", Type: field.TypeFloat64, Default: 0, SchemaType: map[string]string{"postgres": "decimal(20,8)"}},
+		{Name: "request_count", Type: field.TypeInt64, Default: 0},
+		{Name: "status", Type: field.TypeString, Size: 20, Default: "unpaid"},
+		{Name: "due_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "reminder_sent_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "overdue_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "paid_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "payment_note", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+	}
+	// BillingStatementsTable holds the schema information for the "billing_statements" table.
+	BillingStatementsTable = &schema.Table{
+		Name:       "billing_statements",
+		Columns:    BillingStatementsColumns,
+		PrimaryKey: []*schema.Column{BillingStatementsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "billingstatement_user_id_period_start",
+				Unique:  true,
+				Columns: []*schema.Column{BillingStatementsColumns[1], BillingStatementsColumns[2]},
+			},
+			{
+				Name:    "billingstatement_status_due_at",
+				Unique:  false,
+				Columns: []*schema.Column{BillingStatementsColumns[6], BillingStatementsColumns[7]},
+			},
+		},
+	}
 	// ChannelMonitorsColumns holds the columns for the "channel_monitors" table.
 	ChannelMonitorsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt64, Increment: true},
@@ -513,6 +654,66 @@ var (
 			},
 		},
 	}
+	// CommissionWithdrawalsColumns holds the columns for the "commission_withdrawals" table.
+	CommissionWithdrawalsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "user_id", Type: field.TypeInt64},
+		{Name: "amount", Type: field.TypeFloat64, SchemaType: map[string]string{"postgres": "decimal(20,8)"}},
+		{Name: "payout_method", Type: field.TypeString, Size: 20},
+		{Name: "payout_account", Type: field.TypeString, Size: 255},
+		{Name: "payout_name", Type: field.TypeString, Nullable: true, Size: 100},
+		{Name: "status", Type: field.TypeString, Size: 20, Default: "pending"},
+		{Name: "reviewer_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "review_note", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "payout_reference", Type: field.TypeString, Nullable: true, Size: 128},
+		{Name: "reviewed_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "paid_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+	}
+	// CommissionWithdrawalsTable holds the schema information for the "commission_withdrawals" table.
+	CommissionWithdrawalsTable = &schema.Table{
+		Name:       "commission_withdrawals",
+		Columns:    CommissionWithdrawalsColumns,
+		PrimaryKey: []*schema.Column{CommissionWithdrawalsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "commissionwithdrawal_user_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{CommissionWithdrawalsColumns[1], CommissionWithdrawalsColumns[6]},
+			},
+			{
+				Name:    "commissionwithdrawal_status_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{CommissionWithdrawalsColumns[6], CommissionWithdrawalsColumns[12]},
+			},
+		},
+	}
+	// CommissionWithdrawalLogsColumns holds the columns for the "commission_withdrawal_logs" table.
+	CommissionWithdrawalLogsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "withdrawal_id", Type: field.TypeInt64},
+		{Name: "action", Type: field.TypeString, Size: 20},
+		{Name: "from_status", Type: field.TypeString, Nullable: true, Size: 20},
+		{Name: "to_status", Type: field.TypeString, Size: 20},
+		{Name: "operator_id", Type: field.TypeInt64},
+		{Name: "operator_role", Type: field.TypeString, Size: 20},
+		{Name: "note", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+	}
+	// CommissionWithdrawalLogsTable holds the schema information for the "commission_withdrawal_logs" table.
+	CommissionWithdrawalLogsTable = &schema.Table{
+		Name:       "commission_withdrawal_logs",
+		Columns:    CommissionWithdrawalLogsColumns,
+		PrimaryKey: []*schema.Column{CommissionWithdrawalLogsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "commissionwithdrawallog_withdrawal_id",
+				Unique:  false,
+				Columns: []*schema.Column{CommissionWithdrawalLogsColumns[1]},
+			},
+		},
+	}
 	// ErrorPassthroughRulesColumns holds the columns for the "error_passthrough_rules" table.
 	ErrorPassthroughRulesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt64, Increment: true},
@@ -550,6 +751,41 @@ var (
 			},
 		},
 	}
+	// GatewayPluginsColumns holds the columns for the "gateway_plugins" table.
+	GatewayPluginsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "name", Type: field.TypeString, Size: 100},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "priority", Type: field.TypeInt, Default: 0},
+		{Name: "group_ids", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "failure_mode", Type: field.TypeString, Size: 10, Default: "open"},
+		{Name: "timeout_ms", Type: field.TypeInt, Default: 50},
+		{Name: "memory_limit_mb", Type: field.TypeInt, Default: 16},
+		{Name: "hooks", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "module", Type: field.TypeBytes, SchemaType: map[string]string{"postgres": "bytea"}},
+		{Name: "module_sha256", Type: field.TypeString, Size: 64},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+	}
+	// GatewayPluginsTable holds the schema information for the "gateway_plugins" table.
+	GatewayPluginsTable = &schema.Table{
+		Name:       "gateway_plugins",
+		Columns:    GatewayPluginsColumns,
+		PrimaryKey: []*schema.Column{GatewayPluginsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "gatewayplugin_enabled",
+				Unique:  false,
+				Columns: []*schema.Column{GatewayPluginsColumns[4]},
+			},
+			{
+				Name:    "gatewayplugin_priority",
+				Unique:  false,
+				Columns: []*schema.Column{GatewayPluginsColumns[5]},
+			},
+		},
+	}
 	// GroupsColumns holds the columns for the "groups" table.
 	GroupsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt64, Increment: true},
@@ -588,6 +824,15 @@ var (
 		{Name: "require_privacy_set", Type: field.TypeBool, Default: false},
 		{Name: "default_mapped_model", Type: field.TypeString, Size: 100, Default: ""},
 		{Name: "force_application_json_for_non_stream", Type: field.TypeBool, Default: false},
+		{Name: "scheduling_strategy", Type: field.TypeString, Size: 50, Default: ""},
+		{Name: "hedge_enabled", Type: field.TypeBool, Default: false},
+		{Name: "hedge_delay_ms", Type: field.TypeInt, Default: 0},
+		{Name: "traffic_split_rules", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "model_fallback_chains", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "volume_tiers", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "availability_schedule", Type: field.TypeString, Nullable: true, Size: 200},
+		{Name: "maintenance_mode", Type: field.TypeBool, Default: false},
+		{Name: "maintenance_message", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
 	}
 	// GroupsTable holds the schema information for the "groups" table.
 	GroupsTable = &schema.Table{
@@ -627,6 +872,40 @@ var (
 			},
 		},
 	}
+	// GuardrailRulesColumns holds the columns for the "guardrail_rules" table.
+	GuardrailRulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "name", Type: field.TypeString, Size: 100},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "priority", Type: field.TypeInt, Default: 0},
+		{Name: "platforms", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "group_ids", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "type", Type: field.TypeString, Size: 20, Default: "keyword"},
+		{Name: "patterns", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "case_sensitive", Type: field.TypeBool, Default: false},
+		{Name: "action", Type: field.TypeString, Size: 20, Default: "flag"},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+	}
+	// GuardrailRulesTable holds the schema information for the "guardrail_rules" table.
+	GuardrailRulesTable = &schema.Table{
+		Name:       "guardrail_rules",
+		Columns:    GuardrailRulesColumns,
+		PrimaryKey: []*schema.Column{GuardrailRulesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "guardrailrule_enabled",
+				Unique:  false,
+				Columns: []*schema.Column{GuardrailRulesColumns[4]},
+			},
+			{
+				Name:    "guardrailrule_priority",
+				Unique:  false,
+				Columns: []*schema.Column{GuardrailRulesColumns[5]},
+			},
+		},
+	}
 	// IdempotencyRecordsColumns holds the columns for the "idempotency_records" table.
 	IdempotencyRecordsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt64, Increment: true},
@@ -665,6 +944,70 @@ var (
 			},
 		},
 	}
+	// InvoicesColumns holds the columns for the "invoices" table.
+	InvoicesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "invoice_no", Type: field.TypeString, Unique: true, Size: 32},
+		{Name: "kind", Type: field.TypeString, Size: 20, Default: "invoice"},
+		{Name: "user_id", Type: field.TypeInt64},
+		{Name: "source_type", Type: field.TypeString, Size: 20},
+		{Name: "source_id", Type: field.TypeInt64},
+		{Name: "source_no", Type: field.TypeString, Size: 64, Default: ""},
+		{Name: "original_invoice_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "currency", Type: field.TypeString, Size: 10, Default: "CNY"},
+		{Name: "subtotal", Type: field.TypeFloat64, SchemaType: map[string]string{"postgres": "decimal(20,2)"}},
+		{Name: "discount_amount", Type: field.TypeFloat64, Default: 0, SchemaType: map[string]string{"postgres": "decimal(20,2)"}},
+		{Name: "fee_amount", Type: field.TypeFloat64, Default: 0, SchemaType: map[string]string{"postgres": "decimal(20,2)"}},
+		{Name: "total", Type: field.TypeFloat64, SchemaType: map[string]string{"postgres": "decimal(20,2)"}},
+		{Name: "lines", Type: field.TypeJSON, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "payment_method", Type: field.TypeString, Size: 30, Default: ""},
+		{Name: "buyer_email", Type: field.TypeString, Size: 255, Default: ""},
+		{Name: "buyer_name", Type: field.TypeString, Size: 255, Default: ""},
+		{Name: "buyer_tax_id", Type: field.TypeString, Size: 64, Default: ""},
+		{Name: "buyer_address", Type: field.TypeString, Default: "", SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "seller_name", Type: field.TypeString, Size: 255, Default: ""},
+		{Name: "seller_tax_id", Type: field.TypeString, Size: 64, Default: ""},
+		{Name: "seller_address", Type: field.TypeString, Default: "", SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "notes", Type: field.TypeString, Default: "", SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "issued_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "emailed_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+	}
+	// InvoicesTable holds the schema information for the "invoices" table.
+	InvoicesTable = &schema.Table{
+		Name:       "invoices",
+		Columns:    InvoicesColumns,
+		PrimaryKey: []*schema.Column{InvoicesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "invoice_source_type_source_id_kind",
+				Unique:  true,
+				Columns: []*schema.Column{InvoicesColumns[4], InvoicesColumns[5], InvoicesColumns[2]},
+			},
+			{
+				Name:    "invoice_user_id_issued_at",
+				Unique:  false,
+				Columns: []*schema.Column{InvoicesColumns[3], InvoicesColumns[23]},
+			},
+			{
+				Name:    "invoice_issued_at",
+				Unique:  false,
+				Columns: []*schema.Column{InvoicesColumns[23]},
+			},
+		},
+	}
+	// InvoiceSequencesColumns holds the columns for the "invoice_sequences" table.
+	InvoiceSequencesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "series", Type: field.TypeString, Unique: true, Size: 20},
+		{Name: "last_number", Type: field.TypeInt64, Default: 0},
+	}
+	// InvoiceSequencesTable holds the schema information for the "invoice_sequences" table.
+	InvoiceSequencesTable = &schema.Table{
+		Name:       "invoice_sequences",
+		Columns:    InvoiceSequencesColumns,
+		PrimaryKey: []*schema.Column{InvoiceSequencesColumns[0]},
+	}
 	// PaygOrdersColumns holds the columns for the "payg_orders" table.
 	PaygOrdersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt64, Increment: true},
@@ -729,6 +1072,43 @@ var (
 			},
 		},
 	}
+	// PaymentCouponsColumns holds the columns for the "payment_coupons" table.
+	PaymentCouponsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "code", Type: field.TypeString, Unique: true, Size: 32},
+		{Name: "name", Type: field.TypeString, Size: 100, Default: ""},
+		{Name: "discount_type", Type: field.TypeString, Size: 20, Default: "percent"},
+		{Name: "discount_value", Type: field.TypeFloat64, SchemaType: map[string]string{"postgres": "decimal(20,2)"}},
+		{Name: "min_amount", Type: field.TypeFloat64, Default: 0, SchemaType: map[string]string{"postgres": "decimal(20,2)"}},
+		{Name: "applies_to", Type: field.TypeString, Size: 20, Default: "all"},
+		{Name: "plan_ids", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "max_uses", Type: field.TypeInt, Default: 0},
+		{Name: "per_user_limit", Type: field.TypeInt, Default: 1},
+		{Name: "status", Type: field.TypeString, Size: 20, Default: "active"},
+		{Name: "starts_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "notes", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+	}
+	// PaymentCouponsTable holds the schema information for the "payment_coupons" table.
+	PaymentCouponsTable = &schema.Table{
+		Name:       "payment_coupons",
+		Columns:    PaymentCouponsColumns,
+		PrimaryKey: []*schema.Column{PaymentCouponsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "paymentcoupon_status",
+				Unique:  false,
+				Columns: []*schema.Column{PaymentCouponsColumns[10]},
+			},
+			{
+				Name:    "paymentcoupon_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{PaymentCouponsColumns[12]},
+			},
+		},
+	}
 	// PaymentOrdersColumns holds the columns for the "payment_orders" table.
 	PaymentOrdersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt64, Increment: true},
@@ -739,6 +1119,9 @@ var (
 		{Name: "pay_amount", Type: field.TypeFloat64, SchemaType: map[string]string{"postgres": "decimal(20,2)"}},
 		{Name: "fee_rate", Type: field.TypeFloat64, Default: 0, SchemaType: map[string]string{"postgres": "decimal(10,4)"}},
 		{Name: "recharge_code", Type: field.TypeString, Size: 64},
+		{Name: "coupon_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "coupon_code", Type: field.TypeString, Nullable: true, Size: 32},
+		{Name: "discount_amount", Type: field.TypeFloat64, Default: 0, SchemaType: map[string]string{"postgres": "decimal(20,2)"}},
 		{Name: "out_trade_no", Type: field.TypeString, Size: 64, Default: ""},
 		{Name: "payment_type", Type: field.TypeString, Size: 30},
 		{Name: "payment_trade_no", Type: field.TypeString, Size: 128},
@@ -778,7 +1161,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "payment_orders_users_payment_orders",
-				Columns:    []*schema.Column{PaymentOrdersColumns[37]},
+				Columns:    []*schema.Column{PaymentOrdersColumns[40]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -787,42 +1170,55 @@ var (
 			{
 				Name:    "paymentorder_out_trade_no",
 				Unique:  false,
-				Columns: []*schema.Column{PaymentOrdersColumns[8]},
+				Columns: []*schema.Column{PaymentOrdersColumns[11]},
 			},
 			{
 				Name:    "paymentorder_user_id",
 				Unique:  false,
-				Columns: []*schema.Column{PaymentOrdersColumns[37]},
+				Columns: []*schema.Column{PaymentOrdersColumns[40]},
 			},
 			{
 				Name:    "paymentorder_status",
 				Unique:  false,
-				Columns: []*schema.Column{PaymentOrdersColumns[19]},
+				Columns: []*schema.Column{PaymentOrdersColumns[22]},
 			},
 			{
 				Name:    "paymentorder_expires_at",
 				Unique:  false,
-				Columns: []*schema.Column{PaymentOrdersColumns[27]},
+				Columns: []*schema.Column{PaymentOrdersColumns[30]},
 			},
 			{
 				Name:    "paymentorder_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{PaymentOrdersColumns[35]},
+				Columns: []*schema.Column{PaymentOrdersColumns[38]},
 			},
 			{
 				Name:    "paymentorder_paid_at",
 				Unique:  false,
-				Columns: []*schema.Column{PaymentOrdersColumns[28]},
+				Columns: []*schema.Column{PaymentOrdersColumns[31]},
 			},
 			{
 				Name:    "paymentorder_payment_type_paid_at",
 				Unique:  false,
-				Columns: []*schema.Column{PaymentOrdersColumns[9], PaymentOrdersColumns[28]},
+				Columns: []*schema.Column{PaymentOrdersColumns[12], PaymentOrdersColumns[31]},
 			},
 			{
 				Name:    "paymentorder_order_type",
 				Unique:  false,
-				Columns: []*schema.Column{PaymentOrdersColumns[14]},
+				Columns: []*schema.Column{PaymentOrdersColumns[17]},
+			},
+			{
+				Name:    "paymentorder_coupon_id_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{PaymentOrdersColumns[8], PaymentOrdersColumns[40]},
+			},
+			{
+				Name:    "idx_payment_orders_chain_trade_no",
+				Unique:  true,
+				Columns: []*schema.Column{PaymentOrdersColumns[13]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "payment_type = 'usdt' AND payment_trade_no <> ''",
+				},
 			},
 		},
 	}
@@ -860,6 +1256,37 @@ var (
 			},
 		},
 	}
+	// PostpaidAccountsColumns holds the columns for the "postpaid_accounts" table.
+	PostpaidAccountsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "user_id", Type: field.TypeInt64},
+		{Name: "credit_limit", Type: field.TypeFloat64, Default: 0, SchemaType: map[string]string{"postgres": "decimal(20,8)"}},
+		{Name: "payment_terms_days", Type: field.TypeInt, Default: 15},
+		{Name: "status", Type: field.TypeString, Size: 20, Default: "active"},
+		{Name: "billing_start", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "suspended_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "notes", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+	}
+	// PostpaidAccountsTable holds the schema information for the "postpaid_accounts" table.
+	PostpaidAccountsTable = &schema.Table{
+		Name:       "postpaid_accounts",
+		Columns:    PostpaidAccountsColumns,
+		PrimaryKey: []*schema.Column{PostpaidAccountsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "postpaidaccount_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{PostpaidAccountsColumns[1]},
+			},
+			{
+				Name:    "postpaidaccount_status",
+				Unique:  false,
+				Columns: []*schema.Column{PostpaidAccountsColumns[4]},
+			},
+		},
+	}
 	// PromoCodesColumns holds the columns for the "promo_codes" table.
 	PromoCodesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt64, Increment: true},
@@ -968,6 +1395,29 @@ var (
 			},
 		},
 	}
+	// ProxyPoolsColumns holds the columns for the "proxy_pools" table.
+	ProxyPoolsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "name", Type: field.TypeString, Size: 100},
+		{Name: "status", Type: field.TypeString, Size: 20, Default: "active"},
+		{Name: "members", Type: field.TypeJSON, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+	}
+	// ProxyPoolsTable holds the schema information for the "proxy_pools" table.
+	ProxyPoolsTable = &schema.Table{
+		Name:       "proxy_pools",
+		Columns:    ProxyPoolsColumns,
+		PrimaryKey: []*schema.Column{ProxyPoolsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "proxypool_status",
+				Unique:  false,
+				Columns: []*schema.Column{ProxyPoolsColumns[4]},
+			},
+		},
+	}
 	// RedeemCodesColumns holds the columns for the "redeem_codes" table.
 	RedeemCodesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt64, Increment: true},
@@ -1019,6 +1469,42 @@ var (
 			},
 		},
 	}
+	// ReferralCommissionsColumns holds the columns for the "referral_commissions" table.
+	ReferralCommissionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "referrer_id", Type: field.TypeInt64},
+		{Name: "referee_id", Type: field.TypeInt64},
+		{Name: "reward_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "source_type", Type: field.TypeString, Size: 20},
+		{Name: "source_id", Type: field.TypeInt64},
+		{Name: "source_amount", Type: field.TypeFloat64, Default: 0, SchemaType: map[string]string{"postgres": "decimal(20,8)"}},
+		{Name: "amount", Type: field.TypeFloat64, SchemaType: map[string]string{"postgres": "decimal(20,8)"}},
+		{Name: "clawed_back_amount", Type: field.TypeFloat64, Default: 0, SchemaType: map[string]string{"postgres": "decimal(20,8)"}},
+		{Name: "status", Type: field.TypeString, Size: 20, Default: "active"},
+		{Name: "available_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "clawed_back_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "clawback_reason", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "text"}},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+	}
+	// ReferralCommissionsTable holds the schema information for the "referral_commissions" table.
+	ReferralCommissionsTable = &schema.Table{
+		Name:       "referral_commissions",
+		Columns:    ReferralCommissionsColumns,
+		PrimaryKey: []*schema.Column{ReferralCommissionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "referralcommission_referrer_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{ReferralCommissionsColumns[1], ReferralCommissionsColumns[9]},
+			},
+			{
+				Name:    "referralcommission_source_type_source_id",
+				Unique:  false,
+				Columns: []*schema.Column{ReferralCommissionsColumns[4], ReferralCommissionsColumns[5]},
+			},
+		},
+	}
 	// ReferralRewardsColumns holds the columns for the "referral_rewards" table.
 	ReferralRewardsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt64, Increment: true},
@@ -1074,6 +1560,40 @@ var (
 			},
 		},
 	}
+	// RequestTransformRulesColumns holds the columns for the "request_transform_rules" table.
+	RequestTransformRulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "name", Type: field.TypeString, Size: 100},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "priority", Type: field.TypeInt, Default: 0},
+		{Name: "platforms", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "group_ids", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "account_ids", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "model_patterns", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "body_operations", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "header_operations", Type: field.TypeJSON, Nullable: true, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+	}
+	// RequestTransformRulesTable holds the schema information for the "request_transform_rules" table.
+	RequestTransformRulesTable = &schema.Table{
+		Name:       "request_transform_rules",
+		Columns:    RequestTransformRulesColumns,
+		PrimaryKey: []*schema.Column{RequestTransformRulesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "requesttransformrule_enabled",
+				Unique:  false,
+				Columns: []*schema.Column{RequestTransformRulesColumns[4]},
+			},
+			{
+				Name:    "requesttransformrule_priority",
+				Unique:  false,
+				Columns: []*schema.Column{RequestTransformRulesColumns[5]},
+			},
+		},
+	}
 	// SecuritySecretsColumns holds the columns for the "security_secrets" table.
 	SecuritySecretsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt64, Increment: true},
@@ -1101,6 +1621,47 @@ var (
 		Columns:    SettingsColumns,
 		PrimaryKey: []*schema.Column{SettingsColumns[0]},
 	}
+	// SubscriptionAutoRenewalsColumns holds the columns for the "subscription_auto_renewals" table.
+	SubscriptionAutoRenewalsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "user_id", Type: field.TypeInt64},
+		{Name: "plan_id", Type: field.TypeInt64},
+		{Name: "group_id", Type: field.TypeInt64},
+		{Name: "subscription_days", Type: field.TypeInt},
+		{Name: "amount", Type: field.TypeFloat64, SchemaType: map[string]string{"postgres": "decimal(20,2)"}},
+		{Name: "payment_type", Type: field.TypeString, Size: 30},
+		{Name: "provider_instance_id", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "provider_subscription_id", Type: field.TypeString, Size: 128},
+		{Name: "initial_order_id", Type: field.TypeInt64},
+		{Name: "status", Type: field.TypeString, Size: 20, Default: "incomplete"},
+		{Name: "current_period_end", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "canceled_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
+	}
+	// SubscriptionAutoRenewalsTable holds the schema information for the "subscription_auto_renewals" table.
+	SubscriptionAutoRenewalsTable = &schema.Table{
+		Name:       "subscription_auto_renewals",
+		Columns:    SubscriptionAutoRenewalsColumns,
+		PrimaryKey: []*schema.Column{SubscriptionAutoRenewalsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "subscriptionautorenewal_provider_subscription_id",
+				Unique:  true,
+				Columns: []*schema.Column{SubscriptionAutoRenewalsColumns[8]},
+			},
+			{
+				Name:    "subscriptionautorenewal_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{SubscriptionAutoRenewalsColumns[1]},
+			},
+			{
+				Name:    "subscriptionautorenewal_status",
+				Unique:  false,
+				Columns: []*schema.Column{SubscriptionAutoRenewalsColumns[10]},
+			},
+		},
+	}
 	// SubscriptionPlansColumns holds the columns for the "subscription_plans" table.
 	SubscriptionPlansColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt64, Increment: true},
@@ -1115,6 +1676,7 @@ var (
 		{Name: "product_name", Type: field.TypeString, Size: 100, Default: ""},
 		{Name: "for_sale", Type: field.TypeBool, Default: true},
 		{Name: "sort_order", Type: field.TypeInt, Default: 0},
+		{Name: "stripe_price_id", Type: field.TypeString, Size: 100, Default: ""},
 		{Name: "created_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
 		{Name: "updated_at", Type: field.TypeTime, SchemaType: map[string]string{"postgres": "timestamptz"}},
 	}
@@ -1570,24 +2132,40 @@ var (
 		AccountGroupsTable,
 		AnnouncementsTable,
 		AnnouncementReadsTable,
+		BalanceBucketsTable,
+		BillingAdjustmentsTable,
+		BillingRecomputeJobsTable,
+		BillingStatementsTable,
 		ChannelMonitorsTable,
 		ChannelMonitorDailyRollupsTable,
 		ChannelMonitorHistoriesTable,
 		ChannelMonitorRequestTemplatesTable,
+		CommissionWithdrawalsTable,
+		CommissionWithdrawalLogsTable,
 		ErrorPassthroughRulesTable,
+		GatewayPluginsTable,
 		GroupsTable,
+		GuardrailRulesTable,
 		IdempotencyRecordsTable,
+		InvoicesTable,
+		InvoiceSequencesTable,
 		PaygOrdersTable,
 		PaymentAuditLogsTable,
+		PaymentCouponsTable,
 		PaymentOrdersTable,
 		PaymentProviderInstancesTable,
+		PostpaidAccountsTable,
 		PromoCodesTable,
 		PromoCodeUsagesTable,
 		ProxiesTable,
+		ProxyPoolsTable,
 		RedeemCodesTable,
+		ReferralCommissionsTable,
 		ReferralRewardsTable,
+		RequestTransformRulesTable,
 		SecuritySecretsTable,
 		SettingsTable,
+		SubscriptionAutoRenewalsTable,
 		SubscriptionPlansTable,
 		UsageCleanupTasksTable,
 		UsageLogsTable,
@@ -1622,6 +2200,18 @@ func init() {
 	AnnouncementReadsTable.Annotation = &entsql.Annotation{
 		Table: "announcement_reads",
 	}
+	BalanceBucketsTable.Annotation = &entsql.Annotation{
+		Table: "balance_buckets",
+	}
+	BillingAdjustmentsTable.Annotation = &entsql.Annotation{
+		Table: "billing_adjustments",
+	}
+	BillingRecomputeJobsTable.Annotation = &entsql.Annotation{
+		Table: "billing_recompute_jobs",
+	}
+	BillingStatementsTable.Annotation = &entsql.Annotation{
+		Table: "billing_statements",
+	}
 	ChannelMonitorsTable.ForeignKeys[0].RefTable = ChannelMonitorRequestTemplatesTable
 	ChannelMonitorsTable.Annotation = &entsql.Annotation{
 		Table: "channel_monitors",
@@ -1637,15 +2227,33 @@ func init() {
 	ChannelMonitorRequestTemplatesTable.Annotation = &entsql.Annotation{
 		Table: "channel_monitor_request_templates",
 	}
+	CommissionWithdrawalsTable.Annotation = &entsql.Annotation{
+		Table: "commission_withdrawals",
+	}
+	CommissionWithdrawalLogsTable.Annotation = &entsql.Annotation{
+		Table: "commission_withdrawal_logs",
+	}
 	ErrorPassthroughRulesTable.Annotation = &entsql.Annotation{
 		Table: "error_passthrough_rules",
 	}
+	GatewayPluginsTable.Annotation = &entsql.Annotation{
+		Table: "gateway_plugins",
+	}
 	GroupsTable.Annotation = &entsql.Annotation{
 		Table: "groups",
 	}
+	GuardrailRulesTable.Annotation = &entsql.Annotation{
+		Table: "guardrail_rules",
+	}
 	IdempotencyRecordsTable.Annotation = &entsql.Annotation{
 		Table: "idempotency_records",
 	}
+	InvoicesTable.Annotation = &entsql.Annotation{
+		Table: "invoices",
+	}
+	InvoiceSequencesTable.Annotation = &entsql.Annotation{
+		Table: "invoice_sequences",
+	}
 	PaygOrdersTable.ForeignKeys[0].RefTable = UsersTable
 	PaygOrdersTable.Annotation = &entsql.Annotation{
 		Table: "payg_orders",
@@ -1653,6 +2261,9 @@ func init() {
 	PaymentAuditLogsTable.Annotation = &entsql.Annotation{
 		Table: "payment_audit_logs",
 	}
+	PaymentCouponsTable.Annotation = &entsql.Annotation{
+		Table: "payment_coupons",
+	}
 	PaymentOrdersTable.ForeignKeys[0].RefTable = UsersTable
 	PaymentOrdersTable.Annotation = &entsql.Annotation{
 		Table: "payment_orders",
@@ -1660,6 +2271,9 @@ func init() {
 	PaymentProviderInstancesTable.Annotation = &entsql.Annotation{
 		Table: "payment_provider_instances",
 	}
+	PostpaidAccountsTable.Annotation = &entsql.Annotation{
+		Table: "postpaid_accounts",
+	}
 	PromoCodesTable.Annotation = &entsql.Annotation{
 		Table: "promo_codes",
 	}
@@ -1671,22 +2285,34 @@ func init() {
 	ProxiesTable.Annotation = &entsql.Annotation{
 		Table: "proxies",
 	}
+	ProxyPoolsTable.Annotation = &entsql.Annotation{
+		Table: "proxy_pools",
+	}
 	RedeemCodesTable.ForeignKeys[0].RefTable = GroupsTable
 	RedeemCodesTable.ForeignKeys[1].RefTable = UsersTable
 	RedeemCodesTable.Annotation = &entsql.Annotation{
 		Table: "redeem_codes",
 	}
+	ReferralCommissionsTable.Annotation = &entsql.Annotation{
+		Table: "referral_commissions",
+	}
 	ReferralRewardsTable.ForeignKeys[0].RefTable = UsersTable
 	ReferralRewardsTable.ForeignKeys[1].RefTable = UsersTable
 	ReferralRewardsTable.Annotation = &entsql.Annotation{
 		Table: "referral_rewards",
 	}
+	RequestTransformRulesTable.Annotation = &entsql.Annotation{
+		Table: "request_transform_rules",
+	}
 	SecuritySecretsTable.Annotation = &entsql.Annotation{
 		Table: "security_secrets",
 	}
 	SettingsTable.Annotation = &entsql.Annotation{
 		Table: "settings",
 	}
+	SubscriptionAutoRenewalsTable.Annotation = &entsql.Annotation{
+		Table: "subscription_auto_renewals",
+	}
 	SubscriptionPlansTable.Annotation = &entsql.Annotation{
 		Table: "subscription_plans",
 	}
@@ -0,0 +1,276 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Wei-Shaw/sub2api/ent/paymentcoupon"
+)
+
+// PaymentCoupon is the model entity for the PaymentCoupon schema.
+type PaymentCoupon struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// Code holds the value of the "code" field.
+	Code string `json:"code,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// DiscountType holds the value of the "discount_type" field.
+	DiscountType string `json:"discount_type,omitempty"`
+	// DiscountValue holds the value of the "discount_value" field.
+	DiscountValue float64 `json:"discount_value,omitempty"`
+	// MinAmount holds the value of the "min_amount" field.
+	MinAmount float64 `json:"min_amount,omitempty"`
+	// AppliesTo holds the value of the "applies_to" field.
+	AppliesTo string `json:"applies_to,omitempty"`
+	// PlanIds holds the value of the "plan_ids" field.
+	PlanIds []int64 `json:"plan_ids,omitempty"`
+	// MaxUses holds the value of the "max_uses" field.
+	MaxUses int `json:"max_uses,omitempty"`
+	// PerUserLimit holds the value of the "per_user_limit" field.
+	PerUserLimit int `json:"per_user_limit,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// StartsAt holds the value of the "starts_at" field.
+	StartsAt *time.Time `json:"starts_at,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// Notes holds the value of the "notes" field.
+	Notes *string `json:"notes,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PaymentCoupon) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case paymentcoupon.FieldPlanIds:
+			values[i] = new([]byte)
+		case paymentcoupon.FieldDiscountValue, paymentcoupon.FieldMinAmount:
+			values[i] = new(sql.NullFloat64)
+		case paymentcoupon.FieldID, paymentcoupon.FieldMaxUses, paymentcoupon.FieldPerUserLimit:
+			values[i] = new(sql.NullInt64)
+		case paymentcoupon.FieldCode, paymentcoupon.FieldName, paymentcoupon.FieldDiscountType, paymentcoupon.FieldAppliesTo, paymentcoupon.FieldStatus, paymentcoupon.FieldNotes:
+			values[i] = new(sql.NullString)
+		case paymentcoupon.FieldStartsAt, paymentcoupon.FieldExpiresAt, paymentcoupon.FieldCreatedAt, paymentcoupon.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PaymentCoupon fields.
+func (_m *PaymentCoupon) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case paymentcoupon.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int64(value.Int64)
+		case paymentcoupon.FieldCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code", values[i])
+			} else if value.Valid {
+				_m.Code = value.String
+			}
+		case paymentcoupon.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case paymentcoupon.FieldDiscountType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field discount_type", values[i])
+			} else if value.Valid {
+				_m.DiscountType = value.String
+			}
+		case paymentcoupon.FieldDiscountValue:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field discount_value", values[i])
+			} else if value.Valid {
+				_m.DiscountValue = value.Float64
+			}
+		case paymentcoupon.FieldMinAmount:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field min_amount", values[i])
+			} else if value.Valid {
+				_m.MinAmount = value.Float64
+			}
+		case paymentcoupon.FieldAppliesTo:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field applies_to", values[i])
+			} else if value.Valid {
+				_m.AppliesTo = value.String
+			}
+		case paymentcoupon.FieldPlanIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field plan_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.PlanIds); err != nil {
+					return fmt.Errorf("unmarshal field plan_ids: %w", err)
+				}
+			}
+		case paymentcoupon.FieldMaxUses:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_uses", values[i])
+			} else if value.Valid {
+				_m.MaxUses = int(value.Int64)
+			}
+		case paymentcoupon.FieldPerUserLimit:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field per_user_limit", values[i])
+			} else if value.Valid {
+				_m.PerUserLimit = int(value.Int64)
+			}
+		case paymentcoupon.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = value.String
+			}
+		case paymentcoupon.FieldStartsAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field starts_at", values[i])
+			} else if value.Valid {
+				_m.StartsAt = new(time.Time)
+				*_m.StartsAt = value.Time
+			}
+		case paymentcoupon.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = new(time.Time)
+				*_m.ExpiresAt = value.Time
+			}
+		case paymentcoupon.FieldNotes:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field notes", values[i])
+			} else if value.Valid {
+				_m.Notes = new(string)
+				*_m.Notes = value.String
+			}
+		case paymentcoupon.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case paymentcoupon.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PaymentCoupon.
+// This includes values selected through modifiers, order, etc.
+func (_m *PaymentCoupon) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this PaymentCoupon.
+// Note that you need to call PaymentCoupon.Unwrap() before calling this method if this PaymentCoupon
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *PaymentCoupon) Update() *PaymentCouponUpdateOne {
+	return NewPaymentCouponClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the PaymentCoupon entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *PaymentCoupon) Unwrap() *PaymentCoupon {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PaymentCoupon is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *PaymentCoupon) String() string {
+	var builder strings.Builder
+	builder.WriteString("PaymentCoupon(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("code=")
+	builder.WriteString(_m.Code)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("discount_type=")
+	builder.WriteString(_m.DiscountType)
+	builder.WriteString(", ")
+	builder.WriteString("discount_value=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DiscountValue))
+	builder.WriteString(", ")
+	builder.WriteString("min_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MinAmount))
+	builder.WriteString(", ")
+	builder.WriteString("applies_to=")
+	builder.WriteString(_m.AppliesTo)
+	builder.WriteString(", ")
+	builder.WriteString("plan_ids=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PlanIds))
+	builder.WriteString(", ")
+	builder.WriteString("max_uses=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MaxUses))
+	builder.WriteString(", ")
+	builder.WriteString("per_user_limit=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PerUserLimit))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(_m.Status)
+	builder.WriteString(", ")
+	if v := _m.StartsAt; v != nil {
+		builder.WriteString("starts_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.Notes; v != nil {
+		builder.WriteString("notes=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PaymentCoupons is a parsable slice of PaymentCoupon.
+type PaymentCoupons []*PaymentCoupon
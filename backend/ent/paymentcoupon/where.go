@@ -0,0 +1,890 @@
+// Code generated by ent, DO NOT EDIT.
+
+package paymentcoupon
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Wei-Shaw/sub2api/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldLTE(FieldID, id))
+}
+
+// Code applies equality check predicate on the "code" field. It's identical to CodeEQ.
+func Code(v string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldEQ(FieldCode, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldEQ(FieldName, v))
+}
+
+// DiscountType applies equality check predicate on the "discount_type" field. It's identical to DiscountTypeEQ.
+func DiscountType(v string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldEQ(FieldDiscountType, v))
+}
+
+// DiscountValue applies equality check predicate on the "discount_value" field. It's identical to DiscountValueEQ.
+func DiscountValue(v float64) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldEQ(FieldDiscountValue, v))
+}
+
+// MinAmount applies equality check predicate on the "min_amount" field. It's identical to MinAmountEQ.
+func MinAmount(v float64) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldEQ(FieldMinAmount, v))
+}
+
+// AppliesTo applies equality check predicate on the "applies_to" field. It's identical to AppliesToEQ.
+func AppliesTo(v string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldEQ(FieldAppliesTo, v))
+}
+
+// MaxUses applies equality check predicate on the "max_uses" field. It's identical to MaxUsesEQ.
+func MaxUses(v int) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldEQ(FieldMaxUses, v))
+}
+
+// PerUserLimit applies equality check predicate on the "per_user_limit" field. It's identical to PerUserLimitEQ.
+func PerUserLimit(v int) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldEQ(FieldPerUserLimit, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldEQ(FieldStatus, v))
+}
+
+// StartsAt applies equality check predicate on the "starts_at" field. It's identical to StartsAtEQ.
+func StartsAt(v time.Time) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldEQ(FieldStartsAt, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// Notes applies equality check predicate on the "notes" field. It's identical to NotesEQ.
+func Notes(v string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldEQ(FieldNotes, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// CodeEQ applies the EQ predicate on the "code" field.
+func CodeEQ(v string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldEQ(FieldCode, v))
+}
+
+// CodeNEQ applies the NEQ predicate on the "code" field.
+func CodeNEQ(v string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldNEQ(FieldCode, v))
+}
+
+// CodeIn applies the In predicate on the "code" field.
+func CodeIn(vs ...string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldIn(FieldCode, vs...))
+}
+
+// CodeNotIn applies the NotIn predicate on the "code" field.
+func CodeNotIn(vs ...string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldNotIn(FieldCode, vs...))
+}
+
+// CodeGT applies the GT predicate on the "code" field.
+func CodeGT(v string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldGT(FieldCode, v))
+}
+
+// CodeGTE applies the GTE predicate on the "code" field.
+func CodeGTE(v string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldGTE(FieldCode, v))
+}
+
+// CodeLT applies the LT predicate on the "code" field.
+func CodeLT(v string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldLT(FieldCode, v))
+}
+
+// CodeLTE applies the LTE predicate on the "code" field.
+func CodeLTE(v string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldLTE(FieldCode, v))
+}
+
+// CodeContains applies the Contains predicate on the "code" field.
+func CodeContains(v string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldContains(FieldCode, v))
+}
+
+// CodeHasPrefix applies the HasPrefix predicate on the "code" field.
+func CodeHasPrefix(v string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldHasPrefix(FieldCode, v))
+}
+
+// CodeHasSuffix applies the HasSuffix predicate on the "code" field.
+func CodeHasSuffix(v string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldHasSuffix(FieldCode, v))
+}
+
+// CodeEqualFold applies the EqualFold predicate on the "code" field.
+func CodeEqualFold(v string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldEqualFold(FieldCode, v))
+}
+
+// CodeContainsFold applies the ContainsFold predicate on the "code" field.
+func CodeContainsFold(v string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldContainsFold(FieldCode, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldContainsFold(FieldName, v))
+}
+
+// DiscountTypeEQ applies the EQ predicate on the "discount_type" field.
+func DiscountTypeEQ(v string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldEQ(FieldDiscountType, v))
+}
+
+// DiscountTypeNEQ applies the NEQ predicate on the "discount_type" field.
+func DiscountTypeNEQ(v string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldNEQ(FieldDiscountType, v))
+}
+
+// DiscountTypeIn applies the In predicate on the "discount_type" field.
+func DiscountTypeIn(vs ...string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldIn(FieldDiscountType, vs...))
+}
+
+// DiscountTypeNotIn applies the NotIn predicate on the "discount_type" field.
+func DiscountTypeNotIn(vs ...string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldNotIn(FieldDiscountType, vs...))
+}
+
+// DiscountTypeGT applies the GT predicate on the "discount_type" field.
+func DiscountTypeGT(v string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldGT(FieldDiscountType, v))
+}
+
+// DiscountTypeGTE applies the GTE predicate on the "discount_type" field.
+func DiscountTypeGTE(v string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldGTE(FieldDiscountType, v))
+}
+
+// DiscountTypeLT applies the LT predicate on the "discount_type" field.
+func DiscountTypeLT(v string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldLT(FieldDiscountType, v))
+}
+
+// DiscountTypeLTE applies the LTE predicate on the "discount_type" field.
+func DiscountTypeLTE(v string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldLTE(FieldDiscountType, v))
+}
+
+// DiscountTypeContains applies the Contains predicate on the "discount_type" field.
+func DiscountTypeContains(v string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldContains(FieldDiscountType, v))
+}
+
+// DiscountTypeHasPrefix applies the HasPrefix predicate on the "discount_type" field.
+func DiscountTypeHasPrefix(v string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldHasPrefix(FieldDiscountType, v))
+}
+
+// DiscountTypeHasSuffix applies the HasSuffix predicate on the "discount_type" field.
+func DiscountTypeHasSuffix(v string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldHasSuffix(FieldDiscountType, v))
+}
+
+// DiscountTypeEqualFold applies the EqualFold predicate on the "discount_type" field.
+func DiscountTypeEqualFold(v string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldEqualFold(FieldDiscountType, v))
+}
+
+// DiscountTypeContainsFold applies the ContainsFold predicate on the "discount_type" field.
+func DiscountTypeContainsFold(v string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldContainsFold(FieldDiscountType, v))
+}
+
+// DiscountValueEQ applies the EQ predicate on the "discount_value" field.
+func DiscountValueEQ(v float64) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldEQ(FieldDiscountValue, v))
+}
+
+// DiscountValueNEQ applies the NEQ predicate on the "discount_value" field.
+func DiscountValueNEQ(v float64) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldNEQ(FieldDiscountValue, v))
+}
+
+// DiscountValueIn applies the In predicate on the "discount_value" field.
+func DiscountValueIn(vs ...float64) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldIn(FieldDiscountValue, vs...))
+}
+
+// DiscountValueNotIn applies the NotIn predicate on the "discount_value" field.
+func DiscountValueNotIn(vs ...float64) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldNotIn(FieldDiscountValue, vs...))
+}
+
+// DiscountValueGT applies the GT predicate on the "discount_value" field.
+func DiscountValueGT(v float64) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldGT(FieldDiscountValue, v))
+}
+
+// DiscountValueGTE applies the GTE predicate on the "discount_value" field.
+func DiscountValueGTE(v float64) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldGTE(FieldDiscountValue, v))
+}
+
+// DiscountValueLT applies the LT predicate on the "discount_value" field.
+func DiscountValueLT(v float64) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldLT(FieldDiscountValue, v))
+}
+
+// DiscountValueLTE applies the LTE predicate on the "discount_value" field.
+func DiscountValueLTE(v float64) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldLTE(FieldDiscountValue, v))
+}
+
+// MinAmountEQ applies the EQ predicate on the "min_amount" field.
+func MinAmountEQ(v float64) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldEQ(FieldMinAmount, v))
+}
+
+// MinAmountNEQ applies the NEQ predicate on the "min_amount" field.
+func MinAmountNEQ(v float64) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldNEQ(FieldMinAmount, v))
+}
+
+// MinAmountIn applies the In predicate on the "min_amount" field.
+func MinAmountIn(vs ...float64) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldIn(FieldMinAmount, vs...))
+}
+
+// MinAmountNotIn applies the NotIn predicate on the "min_amount" field.
+func MinAmountNotIn(vs ...float64) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldNotIn(FieldMinAmount, vs...))
+}
+
+// MinAmountGT applies the GT predicate on the "min_amount" field.
+func MinAmountGT(v float64) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldGT(FieldMinAmount, v))
+}
+
+// MinAmountGTE applies the GTE predicate on the "min_amount" field.
+func MinAmountGTE(v float64) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldGTE(FieldMinAmount, v))
+}
+
+// MinAmountLT applies the LT predicate on the "min_amount" field.
+func MinAmountLT(v float64) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldLT(FieldMinAmount, v))
+}
+
+// MinAmountLTE applies the LTE predicate on the "min_amount" field.
+func MinAmountLTE(v float64) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldLTE(FieldMinAmount, v))
+}
+
+// AppliesToEQ applies the EQ predicate on the "applies_to" field.
+func AppliesToEQ(v string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldEQ(FieldAppliesTo, v))
+}
+
+// AppliesToNEQ applies the NEQ predicate on the "applies_to" field.
+func AppliesToNEQ(v string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldNEQ(FieldAppliesTo, v))
+}
+
+// AppliesToIn applies the In predicate on the "applies_to" field.
+func AppliesToIn(vs ...string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldIn(FieldAppliesTo, vs...))
+}
+
+// AppliesToNotIn applies the NotIn predicate on the "applies_to" field.
+func AppliesToNotIn(vs ...string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldNotIn(FieldAppliesTo, vs...))
+}
+
+// AppliesToGT applies the GT predicate on the "applies_to" field.
+func AppliesToGT(v string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldGT(FieldAppliesTo, v))
+}
+
+// AppliesToGTE applies the GTE predicate on the "applies_to" field.
+func AppliesToGTE(v string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldGTE(FieldAppliesTo, v))
+}
+
+// AppliesToLT applies the LT predicate on the "applies_to" field.
+func AppliesToLT(v string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldLT(FieldAppliesTo, v))
+}
+
+// AppliesToLTE applies the LTE predicate on the "applies_to" field.
+func AppliesToLTE(v string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldLTE(FieldAppliesTo, v))
+}
+
+// AppliesToContains applies the Contains predicate on the "applies_to" field.
+func AppliesToContains(v string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldContains(FieldAppliesTo, v))
+}
+
+// AppliesToHasPrefix applies the HasPrefix predicate on the "applies_to" field.
+func AppliesToHasPrefix(v string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldHasPrefix(FieldAppliesTo, v))
+}
+
+// AppliesToHasSuffix applies the HasSuffix predicate on the "applies_to" field.
+func AppliesToHasSuffix(v string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldHasSuffix(FieldAppliesTo, v))
+}
+
+// AppliesToEqualFold applies the EqualFold predicate on the "applies_to" field.
+func AppliesToEqualFold(v string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldEqualFold(FieldAppliesTo, v))
+}
+
+// AppliesToContainsFold applies the ContainsFold predicate on the "applies_to" field.
+func AppliesToContainsFold(v string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldContainsFold(FieldAppliesTo, v))
+}
+
+// PlanIdsIsNil applies the IsNil predicate on the "plan_ids" field.
+func PlanIdsIsNil() predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldIsNull(FieldPlanIds))
+}
+
+// PlanIdsNotNil applies the NotNil predicate on the "plan_ids" field.
+func PlanIdsNotNil() predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldNotNull(FieldPlanIds))
+}
+
+// MaxUsesEQ applies the EQ predicate on the "max_uses" field.
+func MaxUsesEQ(v int) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldEQ(FieldMaxUses, v))
+}
+
+// MaxUsesNEQ applies the NEQ predicate on the "max_uses" field.
+func MaxUsesNEQ(v int) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldNEQ(FieldMaxUses, v))
+}
+
+// MaxUsesIn applies the In predicate on the "max_uses" field.
+func MaxUsesIn(vs ...int) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldIn(FieldMaxUses, vs...))
+}
+
+// MaxUsesNotIn applies the NotIn predicate on the "max_uses" field.
+func MaxUsesNotIn(vs ...int) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldNotIn(FieldMaxUses, vs...))
+}
+
+// MaxUsesGT applies the GT predicate on the "max_uses" field.
+func MaxUsesGT(v int) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldGT(FieldMaxUses, v))
+}
+
+// MaxUsesGTE applies the GTE predicate on the "max_uses" field.
+func MaxUsesGTE(v int) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldGTE(FieldMaxUses, v))
+}
+
+// MaxUsesLT applies the LT predicate on the "max_uses" field.
+func MaxUsesLT(v int) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldLT(FieldMaxUses, v))
+}
+
+// MaxUsesLTE applies the LTE predicate on the "max_uses" field.
+func MaxUsesLTE(v int) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldLTE(FieldMaxUses, v))
+}
+
+// PerUserLimitEQ applies the EQ predicate on the "per_user_limit" field.
+func PerUserLimitEQ(v int) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldEQ(FieldPerUserLimit, v))
+}
+
+// PerUserLimitNEQ applies the NEQ predicate on the "per_user_limit" field.
+func PerUserLimitNEQ(v int) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldNEQ(FieldPerUserLimit, v))
+}
+
+// PerUserLimitIn applies the In predicate on the "per_user_limit" field.
+func PerUserLimitIn(vs ...int) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldIn(FieldPerUserLimit, vs...))
+}
+
+// PerUserLimitNotIn applies the NotIn predicate on the "per_user_limit" field.
+func PerUserLimitNotIn(vs ...int) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldNotIn(FieldPerUserLimit, vs...))
+}
+
+// PerUserLimitGT applies the GT predicate on the "per_user_limit" field.
+func PerUserLimitGT(v int) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldGT(FieldPerUserLimit, v))
+}
+
+// PerUserLimitGTE applies the GTE predicate on the "per_user_limit" field.
+func PerUserLimitGTE(v int) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldGTE(FieldPerUserLimit, v))
+}
+
+// PerUserLimitLT applies the LT predicate on the "per_user_limit" field.
+func PerUserLimitLT(v int) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldLT(FieldPerUserLimit, v))
+}
+
+// PerUserLimitLTE applies the LTE predicate on the "per_user_limit" field.
+func PerUserLimitLTE(v int) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldLTE(FieldPerUserLimit, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// StartsAtEQ applies the EQ predicate on the "starts_at" field.
+func StartsAtEQ(v time.Time) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldEQ(FieldStartsAt, v))
+}
+
+// StartsAtNEQ applies the NEQ predicate on the "starts_at" field.
+func StartsAtNEQ(v time.Time) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldNEQ(FieldStartsAt, v))
+}
+
+// StartsAtIn applies the In predicate on the "starts_at" field.
+func StartsAtIn(vs ...time.Time) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldIn(FieldStartsAt, vs...))
+}
+
+// StartsAtNotIn applies the NotIn predicate on the "starts_at" field.
+func StartsAtNotIn(vs ...time.Time) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldNotIn(FieldStartsAt, vs...))
+}
+
+// StartsAtGT applies the GT predicate on the "starts_at" field.
+func StartsAtGT(v time.Time) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldGT(FieldStartsAt, v))
+}
+
+// StartsAtGTE applies the GTE predicate on the "starts_at" field.
+func StartsAtGTE(v time.Time) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldGTE(FieldStartsAt, v))
+}
+
+// StartsAtLT applies the LT predicate on the "starts_at" field.
+func StartsAtLT(v time.Time) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldLT(FieldStartsAt, v))
+}
+
+// StartsAtLTE applies the LTE predicate on the "starts_at" field.
+func StartsAtLTE(v time.Time) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldLTE(FieldStartsAt, v))
+}
+
+// StartsAtIsNil applies the IsNil predicate on the "starts_at" field.
+func StartsAtIsNil() predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldIsNull(FieldStartsAt))
+}
+
+// StartsAtNotNil applies the NotNil predicate on the "starts_at" field.
+func StartsAtNotNil() predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldNotNull(FieldStartsAt))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtIsNil applies the IsNil predicate on the "expires_at" field.
+func ExpiresAtIsNil() predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldIsNull(FieldExpiresAt))
+}
+
+// ExpiresAtNotNil applies the NotNil predicate on the "expires_at" field.
+func ExpiresAtNotNil() predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldNotNull(FieldExpiresAt))
+}
+
+// NotesEQ applies the EQ predicate on the "notes" field.
+func NotesEQ(v string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldEQ(FieldNotes, v))
+}
+
+// NotesNEQ applies the NEQ predicate on the "notes" field.
+func NotesNEQ(v string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldNEQ(FieldNotes, v))
+}
+
+// NotesIn applies the In predicate on the "notes" field.
+func NotesIn(vs ...string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldIn(FieldNotes, vs...))
+}
+
+// NotesNotIn applies the NotIn predicate on the "notes" field.
+func NotesNotIn(vs ...string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldNotIn(FieldNotes, vs...))
+}
+
+// NotesGT applies the GT predicate on the "notes" field.
+func NotesGT(v string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldGT(FieldNotes, v))
+}
+
+// NotesGTE applies the GTE predicate on the "notes" field.
+func NotesGTE(v string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldGTE(FieldNotes, v))
+}
+
+// NotesLT applies the LT predicate on the "notes" field.
+func NotesLT(v string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldLT(FieldNotes, v))
+}
+
+// NotesLTE applies the LTE predicate on the "notes" field.
+func NotesLTE(v string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldLTE(FieldNotes, v))
+}
+
+// NotesContains applies the Contains predicate on the "notes" field.
+func NotesContains(v string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldContains(FieldNotes, v))
+}
+
+// NotesHasPrefix applies the HasPrefix predicate on the "notes" field.
+func NotesHasPrefix(v string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldHasPrefix(FieldNotes, v))
+}
+
+// NotesHasSuffix applies the HasSuffix predicate on the "notes" field.
+func NotesHasSuffix(v string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldHasSuffix(FieldNotes, v))
+}
+
+// NotesIsNil applies the IsNil predicate on the "notes" field.
+func NotesIsNil() predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldIsNull(FieldNotes))
+}
+
+// NotesNotNil applies the NotNil predicate on the "notes" field.
+func NotesNotNil() predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldNotNull(FieldNotes))
+}
+
+// NotesEqualFold applies the EqualFold predicate on the "notes" field.
+func NotesEqualFold(v string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldEqualFold(FieldNotes, v))
+}
+
+// NotesContainsFold applies the ContainsFold predicate on the "notes" field.
+func NotesContainsFold(v string) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldContainsFold(FieldNotes, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PaymentCoupon) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PaymentCoupon) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PaymentCoupon) predicate.PaymentCoupon {
+	return predicate.PaymentCoupon(sql.NotPredicates(p))
+}
@@ -0,0 +1,1480 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Wei-Shaw/sub2api/ent/paymentcoupon"
+)
+
+// PaymentCouponCreate is the builder for creating a PaymentCoupon entity.
+type PaymentCouponCreate struct {
+	config
+	mutation *PaymentCouponMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCode sets the "code" field.
+func (_c *PaymentCouponCreate) SetCode(v string) *PaymentCouponCreate {
+	_c.mutation.SetCode(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *PaymentCouponCreate) SetName(v string) *PaymentCouponCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_c *PaymentCouponCreate) SetNillableName(v *string) *PaymentCouponCreate {
+	if v != nil {
+		_c.SetName(*v)
+	}
+	return _c
+}
+
+// SetDiscountType sets the "discount_type" field.
+func (_c *PaymentCouponCreate) SetDiscountType(v string) *PaymentCouponCreate {
+	_c.mutation.SetDiscountType(v)
+	return _c
+}
+
+// SetNillableDiscountType sets the "discount_type" field if the given value is not nil.
+func (_c *PaymentCouponCreate) SetNillableDiscountType(v *string) *PaymentCouponCreate {
+	if v != nil {
+		_c.SetDiscountType(*v)
+	}
+	return _c
+}
+
+// SetDiscountValue sets the "discount_value" field.
+func (_c *PaymentCouponCreate) SetDiscountValue(v float64) *PaymentCouponCreate {
+	_c.mutation.SetDiscountValue(v)
+	return _c
+}
+
+// SetMinAmount sets the "min_amount" field.
+func (_c *PaymentCouponCreate) SetMinAmount(v float64) *PaymentCouponCreate {
+	_c.mutation.SetMinAmount(v)
+	return _c
+}
+
+// SetNillableMinAmount sets the "min_amount" field if the given value is not nil.
+func (_c *PaymentCouponCreate) SetNillableMinAmount(v *float64) *PaymentCouponCreate {
+	if v != nil {
+		_c.SetMinAmount(*v)
+	}
+	return _c
+}
+
+// SetAppliesTo sets the "applies_to" field.
+func (_c *PaymentCouponCreate) SetAppliesTo(v string) *PaymentCouponCreate {
+	_c.mutation.SetAppliesTo(v)
+	return _c
+}
+
+// SetNillableAppliesTo sets the "applies_to" field if the given value is not nil.
+func (_c *PaymentCouponCreate) SetNillableAppliesTo(v *string) *PaymentCouponCreate {
+	if v != nil {
+		_c.SetAppliesTo(*v)
+	}
+	return _c
+}
+
+// SetPlanIds sets the "plan_ids" field.
+func (_c *PaymentCouponCreate) SetPlanIds(v []int64) *PaymentCouponCreate {
+	_c.mutation.SetPlanIds(v)
+	return _c
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (_c *PaymentCouponCreate) SetMaxUses(v int) *PaymentCouponCreate {
+	_c.mutation.SetMaxUses(v)
+	return _c
+}
+
+// SetNillableMaxUses sets the "max_uses" field if the given value is not nil.
+func (_c *PaymentCouponCreate) SetNillableMaxUses(v *int) *PaymentCouponCreate {
+	if v != nil {
+		_c.SetMaxUses(*v)
+	}
+	return _c
+}
+
+// SetPerUserLimit sets the "per_user_limit" field.
+func (_c *PaymentCouponCreate) SetPerUserLimit(v int) *PaymentCouponCreate {
+	_c.mutation.SetPerUserLimit(v)
+	return _c
+}
+
+// SetNillablePerUserLimit sets the "per_user_limit" field if the given value is not nil.
+func (_c *PaymentCouponCreate) SetNillablePerUserLimit(v *int) *PaymentCouponCreate {
+	if v != nil {
+		_c.SetPerUserLimit(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *PaymentCouponCreate) SetStatus(v string) *PaymentCouponCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *PaymentCouponCreate) SetNillableStatus(v *string) *PaymentCouponCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (_c *PaymentCouponCreate) SetStartsAt(v time.Time) *PaymentCouponCreate {
+	_c.mutation.SetStartsAt(v)
+	return _c
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (_c *PaymentCouponCreate) SetNillableStartsAt(v *time.Time) *PaymentCouponCreate {
+	if v != nil {
+		_c.SetStartsAt(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *PaymentCouponCreate) SetExpiresAt(v time.Time) *PaymentCouponCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_c *PaymentCouponCreate) SetNillableExpiresAt(v *time.Time) *PaymentCouponCreate {
+	if v != nil {
+		_c.SetExpiresAt(*v)
+	}
+	return _c
+}
+
+// SetNotes sets the "notes" field.
+func (_c *PaymentCouponCreate) SetNotes(v string) *PaymentCouponCreate {
+	_c.mutation.SetNotes(v)
+	return _c
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (_c *PaymentCouponCreate) SetNillableNotes(v *string) *PaymentCouponCreate {
+	if v != nil {
+		_c.SetNotes(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PaymentCouponCreate) SetCreatedAt(v time.Time) *PaymentCouponCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PaymentCouponCreate) SetNillableCreatedAt(v *time.Time) *PaymentCouponCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *PaymentCouponCreate) SetUpdatedAt(v time.Time) *PaymentCouponCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *PaymentCouponCreate) SetNillableUpdatedAt(v *time.Time) *PaymentCouponCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the PaymentCouponMutation object of the builder.
+func (_c *PaymentCouponCreate) Mutation() *PaymentCouponMutation {
+	return _c.mutation
+}
+
+// Save creates the PaymentCoupon in the database.
+func (_c *PaymentCouponCreate) Save(ctx context.Context) (*PaymentCoupon, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PaymentCouponCreate) SaveX(ctx context.Context) *PaymentCoupon {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PaymentCouponCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PaymentCouponCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PaymentCouponCreate) defaults() {
+	if _, ok := _c.mutation.Name(); !ok {
+		v := paymentcoupon.DefaultName
+		_c.mutation.SetName(v)
+	}
+	if _, ok := _c.mutation.DiscountType(); !ok {
+		v := paymentcoupon.DefaultDiscountType
+		_c.mutation.SetDiscountType(v)
+	}
+	if _, ok := _c.mutation.MinAmount(); !ok {
+		v := paymentcoupon.DefaultMinAmount
+		_c.mutation.SetMinAmount(v)
+	}
+	if _, ok := _c.mutation.AppliesTo(); !ok {
+		v := paymentcoupon.DefaultAppliesTo
+		_c.mutation.SetAppliesTo(v)
+	}
+	if _, ok := _c.mutation.MaxUses(); !ok {
+		v := paymentcoupon.DefaultMaxUses
+		_c.mutation.SetMaxUses(v)
+	}
+	if _, ok := _c.mutation.PerUserLimit(); !ok {
+		v := paymentcoupon.DefaultPerUserLimit
+		_c.mutation.SetPerUserLimit(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := paymentcoupon.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := paymentcoupon.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := paymentcoupon.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PaymentCouponCreate) check() error {
+	if _, ok := _c.mutation.Code(); !ok {
+		return &ValidationError{Name: "code", err: errors.New(`ent: missing required field "PaymentCoupon.code"`)}
+	}
+	if v, ok := _c.mutation.Code(); ok {
+		if err := paymentcoupon.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "PaymentCoupon.code": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "PaymentCoupon.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := paymentcoupon.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "PaymentCoupon.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DiscountType(); !ok {
+		return &ValidationError{Name: "discount_type", err: errors.New(`ent: missing required field "PaymentCoupon.discount_type"`)}
+	}
+	if v, ok := _c.mutation.DiscountType(); ok {
+		if err := paymentcoupon.DiscountTypeValidator(v); err != nil {
+			return &ValidationError{Name: "discount_type", err: fmt.Errorf(`ent: validator failed for field "PaymentCoupon.discount_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DiscountValue(); !ok {
+		return &ValidationError{Name: "discount_value", err: errors.New(`ent: missing required field "PaymentCoupon.discount_value"`)}
+	}
+	if _, ok := _c.mutation.MinAmount(); !ok {
+		return &ValidationError{Name: "min_amount", err: errors.New(`ent: missing required field "PaymentCoupon.min_amount"`)}
+	}
+	if _, ok := _c.mutation.AppliesTo(); !ok {
+		return &ValidationError{Name: "applies_to", err: errors.New(`ent: missing required field "PaymentCoupon.applies_to"`)}
+	}
+	if v, ok := _c.mutation.AppliesTo(); ok {
+		if err := paymentcoupon.AppliesToValidator(v); err != nil {
+			return &ValidationError{Name: "applies_to", err: fmt.Errorf(`ent: validator failed for field "PaymentCoupon.applies_to": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.MaxUses(); !ok {
+		return &ValidationError{Name: "max_uses", err: errors.New(`ent: missing required field "PaymentCoupon.max_uses"`)}
+	}
+	if _, ok := _c.mutation.PerUserLimit(); !ok {
+		return &ValidationError{Name: "per_user_limit", err: errors.New(`ent: missing required field "PaymentCoupon.per_user_limit"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "PaymentCoupon.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := paymentcoupon.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "PaymentCoupon.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PaymentCoupon.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "PaymentCoupon.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *PaymentCouponCreate) sqlSave(ctx context.Context) (*PaymentCoupon, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int64(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PaymentCouponCreate) createSpec() (*PaymentCoupon, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PaymentCoupon{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(paymentcoupon.Table, sqlgraph.NewFieldSpec(paymentcoupon.FieldID, field.TypeInt64))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.Code(); ok {
+		_spec.SetField(paymentcoupon.FieldCode, field.TypeString, value)
+		_node.Code = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(paymentcoupon.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.DiscountType(); ok {
+		_spec.SetField(paymentcoupon.FieldDiscountType, field.TypeString, value)
+		_node.DiscountType = value
+	}
+	if value, ok := _c.mutation.DiscountValue(); ok {
+		_spec.SetField(paymentcoupon.FieldDiscountValue, field.TypeFloat64, value)
+		_node.DiscountValue = value
+	}
+	if value, ok := _c.mutation.MinAmount(); ok {
+		_spec.SetField(paymentcoupon.FieldMinAmount, field.TypeFloat64, value)
+		_node.MinAmount = value
+	}
+	if value, ok := _c.mutation.AppliesTo(); ok {
+		_spec.SetField(paymentcoupon.FieldAppliesTo, field.TypeString, value)
+		_node.AppliesTo = value
+	}
+	if value, ok := _c.mutation.PlanIds(); ok {
+		_spec.SetField(paymentcoupon.FieldPlanIds, field.TypeJSON, value)
+		_node.PlanIds = value
+	}
+	if value, ok := _c.mutation.MaxUses(); ok {
+		_spec.SetField(paymentcoupon.FieldMaxUses, field.TypeInt, value)
+		_node.MaxUses = value
+	}
+	if value, ok := _c.mutation.PerUserLimit(); ok {
+		_spec.SetField(paymentcoupon.FieldPerUserLimit, field.TypeInt, value)
+		_node.PerUserLimit = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(paymentcoupon.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.StartsAt(); ok {
+		_spec.SetField(paymentcoupon.FieldStartsAt, field.TypeTime, value)
+		_node.StartsAt = &value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(paymentcoupon.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := _c.mutation.Notes(); ok {
+		_spec.SetField(paymentcoupon.FieldNotes, field.TypeString, value)
+		_node.Notes = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(paymentcoupon.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(paymentcoupon.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PaymentCoupon.Create().
+//		SetCode(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PaymentCouponUpsert) {
+//			SetCode(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PaymentCouponCreate) OnConflict(opts ...sql.ConflictOption) *PaymentCouponUpsertOne {
+	_c.conflict = opts
+	return &PaymentCouponUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PaymentCoupon.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PaymentCouponCreate) OnConflictColumns(columns ...string) *PaymentCouponUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PaymentCouponUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// PaymentCouponUpsertOne is the builder for "upsert"-ing
+	//  one PaymentCoupon node.
+	PaymentCouponUpsertOne struct {
+		create *PaymentCouponCreate
+	}
+
+	// PaymentCouponUpsert is the "OnConflict" setter.
+	PaymentCouponUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCode sets the "code" field.
+func (u *PaymentCouponUpsert) SetCode(v string) *PaymentCouponUpsert {
+	u.Set(paymentcoupon.FieldCode, v)
+	return u
+}
+
+// UpdateCode sets the "code" field to the value that was provided on create.
+func (u *PaymentCouponUpsert) UpdateCode() *PaymentCouponUpsert {
+	u.SetExcluded(paymentcoupon.FieldCode)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *PaymentCouponUpsert) SetName(v string) *PaymentCouponUpsert {
+	u.Set(paymentcoupon.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *PaymentCouponUpsert) UpdateName() *PaymentCouponUpsert {
+	u.SetExcluded(paymentcoupon.FieldName)
+	return u
+}
+
+// SetDiscountType sets the "discount_type" field.
+func (u *PaymentCouponUpsert) SetDiscountType(v string) *PaymentCouponUpsert {
+	u.Set(paymentcoupon.FieldDiscountType, v)
+	return u
+}
+
+// UpdateDiscountType sets the "discount_type" field to the value that was provided on create.
+func (u *PaymentCouponUpsert) UpdateDiscountType() *PaymentCouponUpsert {
+	u.SetExcluded(paymentcoupon.FieldDiscountType)
+	return u
+}
+
+// SetDiscountValue sets the "discount_value" field.
+func (u *PaymentCouponUpsert) SetDiscountValue(v float64) *PaymentCouponUpsert {
+	u.Set(paymentcoupon.FieldDiscountValue, v)
+	return u
+}
+
+// UpdateDiscountValue sets the "discount_value" field to the value that was provided on create.
+func (u *PaymentCouponUpsert) UpdateDiscountValue() *PaymentCouponUpsert {
+	u.SetExcluded(paymentcoupon.FieldDiscountValue)
+	return u
+}
+
+// AddDiscountValue adds v to the "discount_value" field.
+func (u *PaymentCouponUpsert) AddDiscountValue(v float64) *PaymentCouponUpsert {
+	u.Add(paymentcoupon.FieldDiscountValue, v)
+	return u
+}
+
+// SetMinAmount sets the "min_amount" field.
+func (u *PaymentCouponUpsert) SetMinAmount(v float64) *PaymentCouponUpsert {
+	u.Set(paymentcoupon.FieldMinAmount, v)
+	return u
+}
+
+// UpdateMinAmount sets the "min_amount" field to the value that was provided on create.
+func (u *PaymentCouponUpsert) UpdateMinAmount() *PaymentCouponUpsert {
+	u.SetExcluded(paymentcoupon.FieldMinAmount)
+	return u
+}
+
+// AddMinAmount adds v to the "min_amount" field.
+func (u *PaymentCouponUpsert) AddMinAmount(v float64) *PaymentCouponUpsert {
+	u.Add(paymentcoupon.FieldMinAmount, v)
+	return u
+}
+
+// SetAppliesTo sets the "applies_to" field.
+func (u *PaymentCouponUpsert) SetAppliesTo(v string) *PaymentCouponUpsert {
+	u.Set(paymentcoupon.FieldAppliesTo, v)
+	return u
+}
+
+// UpdateAppliesTo sets the "applies_to" field to the value that was provided on create.
+func (u *PaymentCouponUpsert) UpdateAppliesTo() *PaymentCouponUpsert {
+	u.SetExcluded(paymentcoupon.FieldAppliesTo)
+	return u
+}
+
+// SetPlanIds sets the "plan_ids" field.
+func (u *PaymentCouponUpsert) SetPlanIds(v []int64) *PaymentCouponUpsert {
+	u.Set(paymentcoupon.FieldPlanIds, v)
+	return u
+}
+
+// UpdatePlanIds sets the "plan_ids" field to the value that was provided on create.
+func (u *PaymentCouponUpsert) UpdatePlanIds() *PaymentCouponUpsert {
+	u.SetExcluded(paymentcoupon.FieldPlanIds)
+	return u
+}
+
+// ClearPlanIds clears the value of the "plan_ids" field.
+func (u *PaymentCouponUpsert) ClearPlanIds() *PaymentCouponUpsert {
+	u.SetNull(paymentcoupon.FieldPlanIds)
+	return u
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (u *PaymentCouponUpsert) SetMaxUses(v int) *PaymentCouponUpsert {
+	u.Set(paymentcoupon.FieldMaxUses, v)
+	return u
+}
+
+// UpdateMaxUses sets the "max_uses" field to the value that was provided on create.
+func (u *PaymentCouponUpsert) UpdateMaxUses() *PaymentCouponUpsert {
+	u.SetExcluded(paymentcoupon.FieldMaxUses)
+	return u
+}
+
+// AddMaxUses adds v to the "max_uses" field.
+func (u *PaymentCouponUpsert) AddMaxUses(v int) *PaymentCouponUpsert {
+	u.Add(paymentcoupon.FieldMaxUses, v)
+	return u
+}
+
+// SetPerUserLimit sets the "per_user_limit" field.
+func (u *PaymentCouponUpsert) SetPerUserLimit(v int) *PaymentCouponUpsert {
+	u.Set(paymentcoupon.FieldPerUserLimit, v)
+	return u
+}
+
+// UpdatePerUserLimit sets the "per_user_limit" field to the value that was provided on create.
+func (u *PaymentCouponUpsert) UpdatePerUserLimit() *PaymentCouponUpsert {
+	u.SetExcluded(paymentcoupon.FieldPerUserLimit)
+	return u
+}
+
+// AddPerUserLimit adds v to the "per_user_limit" field.
+func (u *PaymentCouponUpsert) AddPerUserLimit(v int) *PaymentCouponUpsert {
+	u.Add(paymentcoupon.FieldPerUserLimit, v)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *PaymentCouponUpsert) SetStatus(v string) *PaymentCouponUpsert {
+	u.Set(paymentcoupon.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *PaymentCouponUpsert) UpdateStatus() *PaymentCouponUpsert {
+	u.SetExcluded(paymentcoupon.FieldStatus)
+	return u
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (u *PaymentCouponUpsert) SetStartsAt(v time.Time) *PaymentCouponUpsert {
+	u.Set(paymentcoupon.FieldStartsAt, v)
+	return u
+}
+
+// UpdateStartsAt sets the "starts_at" field to the value that was provided on create.
+func (u *PaymentCouponUpsert) UpdateStartsAt() *PaymentCouponUpsert {
+	u.SetExcluded(paymentcoupon.FieldStartsAt)
+	return u
+}
+
+// ClearStartsAt clears the value of the "starts_at" field.
+func (u *PaymentCouponUpsert) ClearStartsAt() *PaymentCouponUpsert {
+	u.SetNull(paymentcoupon.FieldStartsAt)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *PaymentCouponUpsert) SetExpiresAt(v time.Time) *PaymentCouponUpsert {
+	u.Set(paymentcoupon.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *PaymentCouponUpsert) UpdateExpiresAt() *PaymentCouponUpsert {
+	u.SetExcluded(paymentcoupon.FieldExpiresAt)
+	return u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *PaymentCouponUpsert) ClearExpiresAt() *PaymentCouponUpsert {
+	u.SetNull(paymentcoupon.FieldExpiresAt)
+	return u
+}
+
+// SetNotes sets the "notes" field.
+func (u *PaymentCouponUpsert) SetNotes(v string) *PaymentCouponUpsert {
+	u.Set(paymentcoupon.FieldNotes, v)
+	return u
+}
+
+// UpdateNotes sets the "notes" field to the value that was provided on create.
+func (u *PaymentCouponUpsert) UpdateNotes() *PaymentCouponUpsert {
+	u.SetExcluded(paymentcoupon.FieldNotes)
+	return u
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (u *PaymentCouponUpsert) ClearNotes() *PaymentCouponUpsert {
+	u.SetNull(paymentcoupon.FieldNotes)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PaymentCouponUpsert) SetUpdatedAt(v time.Time) *PaymentCouponUpsert {
+	u.Set(paymentcoupon.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PaymentCouponUpsert) UpdateUpdatedAt() *PaymentCouponUpsert {
+	u.SetExcluded(paymentcoupon.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.PaymentCoupon.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *PaymentCouponUpsertOne) UpdateNewValues() *PaymentCouponUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(paymentcoupon.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PaymentCoupon.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PaymentCouponUpsertOne) Ignore() *PaymentCouponUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PaymentCouponUpsertOne) DoNothing() *PaymentCouponUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PaymentCouponCreate.OnConflict
+// documentation for more info.
+func (u *PaymentCouponUpsertOne) Update(set func(*PaymentCouponUpsert)) *PaymentCouponUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PaymentCouponUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCode sets the "code" field.
+func (u *PaymentCouponUpsertOne) SetCode(v string) *PaymentCouponUpsertOne {
+	return u.Update(func(s *PaymentCouponUpsert) {
+		s.SetCode(v)
+	})
+}
+
+// UpdateCode sets the "code" field to the value that was provided on create.
+func (u *PaymentCouponUpsertOne) UpdateCode() *PaymentCouponUpsertOne {
+	return u.Update(func(s *PaymentCouponUpsert) {
+		s.UpdateCode()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *PaymentCouponUpsertOne) SetName(v string) *PaymentCouponUpsertOne {
+	return u.Update(func(s *PaymentCouponUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *PaymentCouponUpsertOne) UpdateName() *PaymentCouponUpsertOne {
+	return u.Update(func(s *PaymentCouponUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDiscountType sets the "discount_type" field.
+func (u *PaymentCouponUpsertOne) SetDiscountType(v string) *PaymentCouponUpsertOne {
+	return u.Update(func(s *PaymentCouponUpsert) {
+		s.SetDiscountType(v)
+	})
+}
+
+// UpdateDiscountType sets the "discount_type" field to the value that was provided on create.
+func (u *PaymentCouponUpsertOne) UpdateDiscountType() *PaymentCouponUpsertOne {
+	return u.Update(func(s *PaymentCouponUpsert) {
+		s.UpdateDiscountType()
+	})
+}
+
+// SetDiscountValue sets the "discount_value" field.
+func (u *PaymentCouponUpsertOne) SetDiscountValue(v float64) *PaymentCouponUpsertOne {
+	return u.Update(func(s *PaymentCouponUpsert) {
+		s.SetDiscountValue(v)
+	})
+}
+
+// AddDiscountValue adds v to the "discount_value" field.
+func (u *PaymentCouponUpsertOne) AddDiscountValue(v float64) *PaymentCouponUpsertOne {
+	return u.Update(func(s *PaymentCouponUpsert) {
+		s.AddDiscountValue(v)
+	})
+}
+
+// UpdateDiscountValue sets the "discount_value" field to the value that was provided on create.
+func (u *PaymentCouponUpsertOne) UpdateDiscountValue() *PaymentCouponUpsertOne {
+	return u.Update(func(s *PaymentCouponUpsert) {
+		s.UpdateDiscountValue()
+	})
+}
+
+// SetMinAmount sets the "min_amount" field.
+func (u *PaymentCouponUpsertOne) SetMinAmount(v float64) *PaymentCouponUpsertOne {
+	return u.Update(func(s *PaymentCouponUpsert) {
+		s.SetMinAmount(v)
+	})
+}
+
+// AddMinAmount adds v to the "min_amount" field.
+func (u *PaymentCouponUpsertOne) AddMinAmount(v float64) *PaymentCouponUpsertOne {
+	return u.Update(func(s *PaymentCouponUpsert) {
+		s.AddMinAmount(v)
+	})
+}
+
+// UpdateMinAmount sets the "min_amount" field to the value that was provided on create.
+func (u *PaymentCouponUpsertOne) UpdateMinAmount() *PaymentCouponUpsertOne {
+	return u.Update(func(s *PaymentCouponUpsert) {
+		s.UpdateMinAmount()
+	})
+}
+
+// SetAppliesTo sets the "applies_to" field.
+func (u *PaymentCouponUpsertOne) SetAppliesTo(v string) *PaymentCouponUpsertOne {
+	return u.Update(func(s *PaymentCouponUpsert) {
+		s.SetAppliesTo(v)
+	})
+}
+
+// UpdateAppliesTo sets the "applies_to" field to the value that was provided on create.
+func (u *PaymentCouponUpsertOne) UpdateAppliesTo() *PaymentCouponUpsertOne {
+	return u.Update(func(s *PaymentCouponUpsert) {
+		s.UpdateAppliesTo()
+	})
+}
+
+// SetPlanIds sets the "plan_ids" field.
+func (u *PaymentCouponUpsertOne) SetPlanIds(v []int64) *PaymentCouponUpsertOne {
+	return u.Update(func(s *PaymentCouponUpsert) {
+		s.SetPlanIds(v)
+	})
+}
+
+// UpdatePlanIds sets the "plan_ids" field to the value that was provided on create.
+func (u *PaymentCouponUpsertOne) UpdatePlanIds() *PaymentCouponUpsertOne {
+	return u.Update(func(s *PaymentCouponUpsert) {
+		s.UpdatePlanIds()
+	})
+}
+
+// ClearPlanIds clears the value of the "plan_ids" field.
+func (u *PaymentCouponUpsertOne) ClearPlanIds() *PaymentCouponUpsertOne {
+	return u.Update(func(s *PaymentCouponUpsert) {
+		s.ClearPlanIds()
+	})
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (u *PaymentCouponUpsertOne) SetMaxUses(v int) *PaymentCouponUpsertOne {
+	return u.Update(func(s *PaymentCouponUpsert) {
+		s.SetMaxUses(v)
+	})
+}
+
+// AddMaxUses adds v to the "max_uses" field.
+func (u *PaymentCouponUpsertOne) AddMaxUses(v int) *PaymentCouponUpsertOne {
+	return u.Update(func(s *PaymentCouponUpsert) {
+		s.AddMaxUses(v)
+	})
+}
+
+// UpdateMaxUses sets the "max_uses" field to the value that was provided on create.
+func (u *PaymentCouponUpsertOne) UpdateMaxUses() *PaymentCouponUpsertOne {
+	return u.Update(func(s *PaymentCouponUpsert) {
+		s.UpdateMaxUses()
+	})
+}
+
+// SetPerUserLimit sets the "per_user_limit" field.
+func (u *PaymentCouponUpsertOne) SetPerUserLimit(v int) *PaymentCouponUpsertOne {
+	return u.Update(func(s *PaymentCouponUpsert) {
+		s.SetPerUserLimit(v)
+	})
+}
+
+// AddPerUserLimit adds v to the "per_user_limit" field.
+func (u *PaymentCouponUpsertOne) AddPerUserLimit(v int) *PaymentCouponUpsertOne {
+	return u.Update(func(s *PaymentCouponUpsert) {
+		s.AddPerUserLimit(v)
+	})
+}
+
+// UpdatePerUserLimit sets the "per_user_limit" field to the value that was provided on create.
+func (u *PaymentCouponUpsertOne) UpdatePerUserLimit() *PaymentCouponUpsertOne {
+	return u.Update(func(s *PaymentCouponUpsert) {
+		s.UpdatePerUserLimit()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *PaymentCouponUpsertOne) SetStatus(v string) *PaymentCouponUpsertOne {
+	return u.Update(func(s *PaymentCouponUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *PaymentCouponUpsertOne) UpdateStatus() *PaymentCouponUpsertOne {
+	return u.Update(func(s *PaymentCouponUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (u *PaymentCouponUpsertOne) SetStartsAt(v time.Time) *PaymentCouponUpsertOne {
+	return u.Update(func(s *PaymentCouponUpsert) {
+		s.SetStartsAt(v)
+	})
+}
+
+// UpdateStartsAt sets the "starts_at" field to the value that was provided on create.
+func (u *PaymentCouponUpsertOne) UpdateStartsAt() *PaymentCouponUpsertOne {
+	return u.Update(func(s *PaymentCouponUpsert) {
+		s.UpdateStartsAt()
+	})
+}
+
+// ClearStartsAt clears the value of the "starts_at" field.
+func (u *PaymentCouponUpsertOne) ClearStartsAt() *PaymentCouponUpsertOne {
+	return u.Update(func(s *PaymentCouponUpsert) {
+		s.ClearStartsAt()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *PaymentCouponUpsertOne) SetExpiresAt(v time.Time) *PaymentCouponUpsertOne {
+	return u.Update(func(s *PaymentCouponUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *PaymentCouponUpsertOne) UpdateExpiresAt() *PaymentCouponUpsertOne {
+	return u.Update(func(s *PaymentCouponUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *PaymentCouponUpsertOne) ClearExpiresAt() *PaymentCouponUpsertOne {
+	return u.Update(func(s *PaymentCouponUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetNotes sets the "notes" field.
+func (u *PaymentCouponUpsertOne) SetNotes(v string) *PaymentCouponUpsertOne {
+	return u.Update(func(s *PaymentCouponUpsert) {
+		s.SetNotes(v)
+	})
+}
+
+// UpdateNotes sets the "notes" field to the value that was provided on create.
+func (u *PaymentCouponUpsertOne) UpdateNotes() *PaymentCouponUpsertOne {
+	return u.Update(func(s *PaymentCouponUpsert) {
+		s.UpdateNotes()
+	})
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (u *PaymentCouponUpsertOne) ClearNotes() *PaymentCouponUpsertOne {
+	return u.Update(func(s *PaymentCouponUpsert) {
+		s.ClearNotes()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PaymentCouponUpsertOne) SetUpdatedAt(v time.Time) *PaymentCouponUpsertOne {
+	return u.Update(func(s *PaymentCouponUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PaymentCouponUpsertOne) UpdateUpdatedAt() *PaymentCouponUpsertOne {
+	return u.Update(func(s *PaymentCouponUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PaymentCouponUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PaymentCouponCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PaymentCouponUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PaymentCouponUpsertOne) ID(ctx context.Context) (id int64, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PaymentCouponUpsertOne) IDX(ctx context.Context) int64 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PaymentCouponCreateBulk is the builder for creating many PaymentCoupon entities in bulk.
+type PaymentCouponCreateBulk struct {
+	config
+	err      error
+	builders []*PaymentCouponCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the PaymentCoupon entities in the database.
+func (_c *PaymentCouponCreateBulk) Save(ctx context.Context) ([]*PaymentCoupon, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PaymentCoupon, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PaymentCouponMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PaymentCouponCreateBulk) SaveX(ctx context.Context) []*PaymentCoupon {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PaymentCouponCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PaymentCouponCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PaymentCoupon.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PaymentCouponUpsert) {
+//			SetCode(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PaymentCouponCreateBulk) OnConflict(opts ...sql.ConflictOption) *PaymentCouponUpsertBulk {
+	_c.conflict = opts
+	return &PaymentCouponUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PaymentCoupon.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PaymentCouponCreateBulk) OnConflictColumns(columns ...string) *PaymentCouponUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PaymentCouponUpsertBulk{
+		create: _c,
+	}
+}
+
+// PaymentCouponUpsertBulk is the builder for "upsert"-ing
+// a bulk of PaymentCoupon nodes.
+type PaymentCouponUpsertBulk struct {
+	create *PaymentCouponCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PaymentCoupon.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *PaymentCouponUpsertBulk) UpdateNewValues() *PaymentCouponUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(paymentcoupon.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PaymentCoupon.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PaymentCouponUpsertBulk) Ignore() *PaymentCouponUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PaymentCouponUpsertBulk) DoNothing() *PaymentCouponUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PaymentCouponCreateBulk.OnConflict
+// documentation for more info.
+func (u *PaymentCouponUpsertBulk) Update(set func(*PaymentCouponUpsert)) *PaymentCouponUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PaymentCouponUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCode sets the "code" field.
+func (u *PaymentCouponUpsertBulk) SetCode(v string) *PaymentCouponUpsertBulk {
+	return u.Update(func(s *PaymentCouponUpsert) {
+		s.SetCode(v)
+	})
+}
+
+// UpdateCode sets the "code" field to the value that was provided on create.
+func (u *PaymentCouponUpsertBulk) UpdateCode() *PaymentCouponUpsertBulk {
+	return u.Update(func(s *PaymentCouponUpsert) {
+		s.UpdateCode()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *PaymentCouponUpsertBulk) SetName(v string) *PaymentCouponUpsertBulk {
+	return u.Update(func(s *PaymentCouponUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *PaymentCouponUpsertBulk) UpdateName() *PaymentCouponUpsertBulk {
+	return u.Update(func(s *PaymentCouponUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDiscountType sets the "discount_type" field.
+func (u *PaymentCouponUpsertBulk) SetDiscountType(v string) *PaymentCouponUpsertBulk {
+	return u.Update(func(s *PaymentCouponUpsert) {
+		s.SetDiscountType(v)
+	})
+}
+
+// UpdateDiscountType sets the "discount_type" field to the value that was provided on create.
+func (u *PaymentCouponUpsertBulk) UpdateDiscountType() *PaymentCouponUpsertBulk {
+	return u.Update(func(s *PaymentCouponUpsert) {
+		s.UpdateDiscountType()
+	})
+}
+
+// SetDiscountValue sets the "discount_value" field.
+func (u *PaymentCouponUpsertBulk) SetDiscountValue(v float64) *PaymentCouponUpsertBulk {
+	return u.Update(func(s *PaymentCouponUpsert) {
+		s.SetDiscountValue(v)
+	})
+}
+
+// AddDiscountValue adds v to the "discount_value" field.
+func (u *PaymentCouponUpsertBulk) AddDiscountValue(v float64) *PaymentCouponUpsertBulk {
+	return u.Update(func(s *PaymentCouponUpsert) {
+		s.AddDiscountValue(v)
+	})
+}
+
+// UpdateDiscountValue sets the "discount_value" field to the value that was provided on create.
+func (u *PaymentCouponUpsertBulk) UpdateDiscountValue() *PaymentCouponUpsertBulk {
+	return u.Update(func(s *PaymentCouponUpsert) {
+		s.UpdateDiscountValue()
+	})
+}
+
+// SetMinAmount sets the "min_amount" field.
+func (u *PaymentCouponUpsertBulk) SetMinAmount(v float64) *PaymentCouponUpsertBulk {
+	return u.Update(func(s *PaymentCouponUpsert) {
+		s.SetMinAmount(v)
+	})
+}
+
+// AddMinAmount adds v to the "min_amount" field.
+func (u *PaymentCouponUpsertBulk) AddMinAmount(v float64) *PaymentCouponUpsertBulk {
+	return u.Update(func(s *PaymentCouponUpsert) {
+		s.AddMinAmount(v)
+	})
+}
+
+// UpdateMinAmount sets the "min_amount" field to the value that was provided on create.
+func (u *PaymentCouponUpsertBulk) UpdateMinAmount() *PaymentCouponUpsertBulk {
+	return u.Update(func(s *PaymentCouponUpsert) {
+		s.UpdateMinAmount()
+	})
+}
+
+// SetAppliesTo sets the "applies_to" field.
+func (u *PaymentCouponUpsertBulk) SetAppliesTo(v string) *PaymentCouponUpsertBulk {
+	return u.Update(func(s *PaymentCouponUpsert) {
+		s.SetAppliesTo(v)
+	})
+}
+
+// UpdateAppliesTo sets the "applies_to" field to the value that was provided on create.
+func (u *PaymentCouponUpsertBulk) UpdateAppliesTo() *PaymentCouponUpsertBulk {
+	return u.Update(func(s *PaymentCouponUpsert) {
+		s.UpdateAppliesTo()
+	})
+}
+
+// SetPlanIds sets the "plan_ids" field.
+func (u *PaymentCouponUpsertBulk) SetPlanIds(v []int64) *PaymentCouponUpsertBulk {
+	return u.Update(func(s *PaymentCouponUpsert) {
+		s.SetPlanIds(v)
+	})
+}
+
+// UpdatePlanIds sets the "plan_ids" field to the value that was provided on create.
+func (u *PaymentCouponUpsertBulk) UpdatePlanIds() *PaymentCouponUpsertBulk {
+	return u.Update(func(s *PaymentCouponUpsert) {
+		s.UpdatePlanIds()
+	})
+}
+
+// ClearPlanIds clears the value of the "plan_ids" field.
+func (u *PaymentCouponUpsertBulk) ClearPlanIds() *PaymentCouponUpsertBulk {
+	return u.Update(func(s *PaymentCouponUpsert) {
+		s.ClearPlanIds()
+	})
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (u *PaymentCouponUpsertBulk) SetMaxUses(v int) *PaymentCouponUpsertBulk {
+	return u.Update(func(s *PaymentCouponUpsert) {
+		s.SetMaxUses(v)
+	})
+}
+
+// AddMaxUses adds v to the "max_uses" field.
+func (u *PaymentCouponUpsertBulk) AddMaxUses(v int) *PaymentCouponUpsertBulk {
+	return u.Update(func(s *PaymentCouponUpsert) {
+		s.AddMaxUses(v)
+	})
+}
+
+// UpdateMaxUses sets the "max_uses" field to the value that was provided on create.
+func (u *PaymentCouponUpsertBulk) UpdateMaxUses() *PaymentCouponUpsertBulk {
+	return u.Update(func(s *PaymentCouponUpsert) {
+		s.UpdateMaxUses()
+	})
+}
+
+// SetPerUserLimit sets the "per_user_limit" field.
+func (u *PaymentCouponUpsertBulk) SetPerUserLimit(v int) *PaymentCouponUpsertBulk {
+	return u.Update(func(s *PaymentCouponUpsert) {
+		s.SetPerUserLimit(v)
+	})
+}
+
+// AddPerUserLimit adds v to the "per_user_limit" field.
+func (u *PaymentCouponUpsertBulk) AddPerUserLimit(v int) *PaymentCouponUpsertBulk {
+	return u.Update(func(s *PaymentCouponUpsert) {
+		s.AddPerUserLimit(v)
+	})
+}
+
+// UpdatePerUserLimit sets the "per_user_limit" field to the value that was provided on create.
+func (u *PaymentCouponUpsertBulk) UpdatePerUserLimit() *PaymentCouponUpsertBulk {
+	return u.Update(func(s *PaymentCouponUpsert) {
+		s.UpdatePerUserLimit()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *PaymentCouponUpsertBulk) SetStatus(v string) *PaymentCouponUpsertBulk {
+	return u.Update(func(s *PaymentCouponUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *PaymentCouponUpsertBulk) UpdateStatus() *PaymentCouponUpsertBulk {
+	return u.Update(func(s *PaymentCouponUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (u *PaymentCouponUpsertBulk) SetStartsAt(v time.Time) *PaymentCouponUpsertBulk {
+	return u.Update(func(s *PaymentCouponUpsert) {
+		s.SetStartsAt(v)
+	})
+}
+
+// UpdateStartsAt sets the "starts_at" field to the value that was provided on create.
+func (u *PaymentCouponUpsertBulk) UpdateStartsAt() *PaymentCouponUpsertBulk {
+	return u.Update(func(s *PaymentCouponUpsert) {
+		s.UpdateStartsAt()
+	})
+}
+
+// ClearStartsAt clears the value of the "starts_at" field.
+func (u *PaymentCouponUpsertBulk) ClearStartsAt() *PaymentCouponUpsertBulk {
+	return u.Update(func(s *PaymentCouponUpsert) {
+		s.ClearStartsAt()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *PaymentCouponUpsertBulk) SetExpiresAt(v time.Time) *PaymentCouponUpsertBulk {
+	return u.Update(func(s *PaymentCouponUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *PaymentCouponUpsertBulk) UpdateExpiresAt() *PaymentCouponUpsertBulk {
+	return u.Update(func(s *PaymentCouponUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *PaymentCouponUpsertBulk) ClearExpiresAt() *PaymentCouponUpsertBulk {
+	return u.Update(func(s *PaymentCouponUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetNotes sets the "notes" field.
+func (u *PaymentCouponUpsertBulk) SetNotes(v string) *PaymentCouponUpsertBulk {
+	return u.Update(func(s *PaymentCouponUpsert) {
+		s.SetNotes(v)
+	})
+}
+
+// UpdateNotes sets the "notes" field to the value that was provided on create.
+func (u *PaymentCouponUpsertBulk) UpdateNotes() *PaymentCouponUpsertBulk {
+	return u.Update(func(s *PaymentCouponUpsert) {
+		s.UpdateNotes()
+	})
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (u *PaymentCouponUpsertBulk) ClearNotes() *PaymentCouponUpsertBulk {
+	return u.Update(func(s *PaymentCouponUpsert) {
+		s.ClearNotes()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PaymentCouponUpsertBulk) SetUpdatedAt(v time.Time) *PaymentCouponUpsertBulk {
+	return u.Update(func(s *PaymentCouponUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PaymentCouponUpsertBulk) UpdateUpdatedAt() *PaymentCouponUpsertBulk {
+	return u.Update(func(s *PaymentCouponUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PaymentCouponUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PaymentCouponCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PaymentCouponCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PaymentCouponUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
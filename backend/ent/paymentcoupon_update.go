@@ -0,0 +1,879 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/Wei-Shaw/sub2api/ent/paymentcoupon"
+	"github.com/Wei-Shaw/sub2api/ent/predicate"
+)
+
+// PaymentCouponUpdate is the builder for updating PaymentCoupon entities.
+type PaymentCouponUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PaymentCouponMutation
+}
+
+// Where appends a list predicates to the PaymentCouponUpdate builder.
+func (_u *PaymentCouponUpdate) Where(ps ...predicate.PaymentCoupon) *PaymentCouponUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCode sets the "code" field.
+func (_u *PaymentCouponUpdate) SetCode(v string) *PaymentCouponUpdate {
+	_u.mutation.SetCode(v)
+	return _u
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (_u *PaymentCouponUpdate) SetNillableCode(v *string) *PaymentCouponUpdate {
+	if v != nil {
+		_u.SetCode(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *PaymentCouponUpdate) SetName(v string) *PaymentCouponUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *PaymentCouponUpdate) SetNillableName(v *string) *PaymentCouponUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDiscountType sets the "discount_type" field.
+func (_u *PaymentCouponUpdate) SetDiscountType(v string) *PaymentCouponUpdate {
+	_u.mutation.SetDiscountType(v)
+	return _u
+}
+
+// SetNillableDiscountType sets the "discount_type" field if the given value is not nil.
+func (_u *PaymentCouponUpdate) SetNillableDiscountType(v *string) *PaymentCouponUpdate {
+	if v != nil {
+		_u.SetDiscountType(*v)
+	}
+	return _u
+}
+
+// SetDiscountValue sets the "discount_value" field.
+func (_u *PaymentCouponUpdate) SetDiscountValue(v float64) *PaymentCouponUpdate {
+	_u.mutation.ResetDiscountValue()
+	_u.mutation.SetDiscountValue(v)
+	return _u
+}
+
+// SetNillableDiscountValue sets the "discount_value" field if the given value is not nil.
+func (_u *PaymentCouponUpdate) SetNillableDiscountValue(v *float64) *PaymentCouponUpdate {
+	if v != nil {
+		_u.SetDiscountValue(*v)
+	}
+	return _u
+}
+
+// AddDiscountValue adds value to the "discount_value" field.
+func (_u *PaymentCouponUpdate) AddDiscountValue(v float64) *PaymentCouponUpdate {
+	_u.mutation.AddDiscountValue(v)
+	return _u
+}
+
+// SetMinAmount sets the "min_amount" field.
+func (_u *PaymentCouponUpdate) SetMinAmount(v float64) *PaymentCouponUpdate {
+	_u.mutation.ResetMinAmount()
+	_u.mutation.SetMinAmount(v)
+	return _u
+}
+
+// SetNillableMinAmount sets the "min_amount" field if the given value is not nil.
+func (_u *PaymentCouponUpdate) SetNillableMinAmount(v *float64) *PaymentCouponUpdate {
+	if v != nil {
+		_u.SetMinAmount(*v)
+	}
+	return _u
+}
+
+// AddMinAmount adds value to the "min_amount" field.
+func (_u *PaymentCouponUpdate) AddMinAmount(v float64) *PaymentCouponUpdate {
+	_u.mutation.AddMinAmount(v)
+	return _u
+}
+
+// SetAppliesTo sets the "applies_to" field.
+func (_u *PaymentCouponUpdate) SetAppliesTo(v string) *PaymentCouponUpdate {
+	_u.mutation.SetAppliesTo(v)
+	return _u
+}
+
+// SetNillableAppliesTo sets the "applies_to" field if the given value is not nil.
+func (_u *PaymentCouponUpdate) SetNillableAppliesTo(v *string) *PaymentCouponUpdate {
+	if v != nil {
+		_u.SetAppliesTo(*v)
+	}
+	return _u
+}
+
+// SetPlanIds sets the "plan_ids" field.
+func (_u *PaymentCouponUpdate) SetPlanIds(v []int64) *PaymentCouponUpdate {
+	_u.mutation.SetPlanIds(v)
+	return _u
+}
+
+// AppendPlanIds appends value to the "plan_ids" field.
+func (_u *PaymentCouponUpdate) AppendPlanIds(v []int64) *PaymentCouponUpdate {
+	_u.mutation.AppendPlanIds(v)
+	return _u
+}
+
+// ClearPlanIds clears the value of the "plan_ids" field.
+func (_u *PaymentCouponUpdate) ClearPlanIds() *PaymentCouponUpdate {
+	_u.mutation.ClearPlanIds()
+	return _u
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (_u *PaymentCouponUpdate) SetMaxUses(v int) *PaymentCouponUpdate {
+	_u.mutation.ResetMaxUses()
+	_u.mutation.SetMaxUses(v)
+	return _u
+}
+
+// SetNillableMaxUses sets the "max_uses" field if the given value is not nil.
+func (_u *PaymentCouponUpdate) SetNillableMaxUses(v *int) *PaymentCouponUpdate {
+	if v != nil {
+		_u.SetMaxUses(*v)
+	}
+	return _u
+}
+
+// AddMaxUses adds value to the "max_uses" field.
+func (_u *PaymentCouponUpdate) AddMaxUses(v int) *PaymentCouponUpdate {
+	_u.mutation.AddMaxUses(v)
+	return _u
+}
+
+// SetPerUserLimit sets the "per_user_limit" field.
+func (_u *PaymentCouponUpdate) SetPerUserLimit(v int) *PaymentCouponUpdate {
+	_u.mutation.ResetPerUserLimit()
+	_u.mutation.SetPerUserLimit(v)
+	return _u
+}
+
+// SetNillablePerUserLimit sets the "per_user_limit" field if the given value is not nil.
+func (_u *PaymentCouponUpdate) SetNillablePerUserLimit(v *int) *PaymentCouponUpdate {
+	if v != nil {
+		_u.SetPerUserLimit(*v)
+	}
+	return _u
+}
+
+// AddPerUserLimit adds value to the "per_user_limit" field.
+func (_u *PaymentCouponUpdate) AddPerUserLimit(v int) *PaymentCouponUpdate {
+	_u.mutation.AddPerUserLimit(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *PaymentCouponUpdate) SetStatus(v string) *PaymentCouponUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *PaymentCouponUpdate) SetNillableStatus(v *string) *PaymentCouponUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (_u *PaymentCouponUpdate) SetStartsAt(v time.Time) *PaymentCouponUpdate {
+	_u.mutation.SetStartsAt(v)
+	return _u
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (_u *PaymentCouponUpdate) SetNillableStartsAt(v *time.Time) *PaymentCouponUpdate {
+	if v != nil {
+		_u.SetStartsAt(*v)
+	}
+	return _u
+}
+
+// ClearStartsAt clears the value of the "starts_at" field.
+func (_u *PaymentCouponUpdate) ClearStartsAt() *PaymentCouponUpdate {
+	_u.mutation.ClearStartsAt()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *PaymentCouponUpdate) SetExpiresAt(v time.Time) *PaymentCouponUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *PaymentCouponUpdate) SetNillableExpiresAt(v *time.Time) *PaymentCouponUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *PaymentCouponUpdate) ClearExpiresAt() *PaymentCouponUpdate {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// SetNotes sets the "notes" field.
+func (_u *PaymentCouponUpdate) SetNotes(v string) *PaymentCouponUpdate {
+	_u.mutation.SetNotes(v)
+	return _u
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (_u *PaymentCouponUpdate) SetNillableNotes(v *string) *PaymentCouponUpdate {
+	if v != nil {
+		_u.SetNotes(*v)
+	}
+	return _u
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (_u *PaymentCouponUpdate) ClearNotes() *PaymentCouponUpdate {
+	_u.mutation.ClearNotes()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PaymentCouponUpdate) SetUpdatedAt(v time.Time) *PaymentCouponUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the PaymentCouponMutation object of the builder.
+func (_u *PaymentCouponUpdate) Mutation() *PaymentCouponMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PaymentCouponUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PaymentCouponUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PaymentCouponUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PaymentCouponUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PaymentCouponUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := paymentcoupon.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PaymentCouponUpdate) check() error {
+	if v, ok := _u.mutation.Code(); ok {
+		if err := paymentcoupon.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "PaymentCoupon.code": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := paymentcoupon.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "PaymentCoupon.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DiscountType(); ok {
+		if err := paymentcoupon.DiscountTypeValidator(v); err != nil {
+			return &ValidationError{Name: "discount_type", err: fmt.Errorf(`ent: validator failed for field "PaymentCoupon.discount_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AppliesTo(); ok {
+		if err := paymentcoupon.AppliesToValidator(v); err != nil {
+			return &ValidationError{Name: "applies_to", err: fmt.Errorf(`ent: validator failed for field "PaymentCoupon.applies_to": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := paymentcoupon.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "PaymentCoupon.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PaymentCouponUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(paymentcoupon.Table, paymentcoupon.Columns, sqlgraph.NewFieldSpec(paymentcoupon.FieldID, field.TypeInt64))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Code(); ok {
+		_spec.SetField(paymentcoupon.FieldCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(paymentcoupon.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DiscountType(); ok {
+		_spec.SetField(paymentcoupon.FieldDiscountType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DiscountValue(); ok {
+		_spec.SetField(paymentcoupon.FieldDiscountValue, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedDiscountValue(); ok {
+		_spec.AddField(paymentcoupon.FieldDiscountValue, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.MinAmount(); ok {
+		_spec.SetField(paymentcoupon.FieldMinAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedMinAmount(); ok {
+		_spec.AddField(paymentcoupon.FieldMinAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AppliesTo(); ok {
+		_spec.SetField(paymentcoupon.FieldAppliesTo, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PlanIds(); ok {
+		_spec.SetField(paymentcoupon.FieldPlanIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPlanIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, paymentcoupon.FieldPlanIds, value)
+		})
+	}
+	if _u.mutation.PlanIdsCleared() {
+		_spec.ClearField(paymentcoupon.FieldPlanIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.MaxUses(); ok {
+		_spec.SetField(paymentcoupon.FieldMaxUses, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxUses(); ok {
+		_spec.AddField(paymentcoupon.FieldMaxUses, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PerUserLimit(); ok {
+		_spec.SetField(paymentcoupon.FieldPerUserLimit, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPerUserLimit(); ok {
+		_spec.AddField(paymentcoupon.FieldPerUserLimit, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(paymentcoupon.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.StartsAt(); ok {
+		_spec.SetField(paymentcoupon.FieldStartsAt, field.TypeTime, value)
+	}
+	if _u.mutation.StartsAtCleared() {
+		_spec.ClearField(paymentcoupon.FieldStartsAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(paymentcoupon.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(paymentcoupon.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Notes(); ok {
+		_spec.SetField(paymentcoupon.FieldNotes, field.TypeString, value)
+	}
+	if _u.mutation.NotesCleared() {
+		_spec.ClearField(paymentcoupon.FieldNotes, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(paymentcoupon.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{paymentcoupon.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PaymentCouponUpdateOne is the builder for updating a single PaymentCoupon entity.
+type PaymentCouponUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PaymentCouponMutation
+}
+
+// SetCode sets the "code" field.
+func (_u *PaymentCouponUpdateOne) SetCode(v string) *PaymentCouponUpdateOne {
+	_u.mutation.SetCode(v)
+	return _u
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (_u *PaymentCouponUpdateOne) SetNillableCode(v *string) *PaymentCouponUpdateOne {
+	if v != nil {
+		_u.SetCode(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *PaymentCouponUpdateOne) SetName(v string) *PaymentCouponUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *PaymentCouponUpdateOne) SetNillableName(v *string) *PaymentCouponUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDiscountType sets the "discount_type" field.
+func (_u *PaymentCouponUpdateOne) SetDiscountType(v string) *PaymentCouponUpdateOne {
+	_u.mutation.SetDiscountType(v)
+	return _u
+}
+
+// SetNillableDiscountType sets the "discount_type" field if the given value is not nil.
+func (_u *PaymentCouponUpdateOne) SetNillableDiscountType(v *string) *PaymentCouponUpdateOne {
+	if v != nil {
+		_u.SetDiscountType(*v)
+	}
+	return _u
+}
+
+// SetDiscountValue sets the "discount_value" field.
+func (_u *PaymentCouponUpdateOne) SetDiscountValue(v float64) *PaymentCouponUpdateOne {
+	_u.mutation.ResetDiscountValue()
+	_u.mutation.SetDiscountValue(v)
+	return _u
+}
+
+// SetNillableDiscountValue sets the "discount_value" field if the given value is not nil.
+func (_u *PaymentCouponUpdateOne) SetNillableDiscountValue(v *float64) *PaymentCouponUpdateOne {
+	if v != nil {
+		_u.SetDiscountValue(*v)
+	}
+	return _u
+}
+
+// AddDiscountValue adds value to the "discount_value" field.
+func (_u *PaymentCouponUpdateOne) AddDiscountValue(v float64) *PaymentCouponUpdateOne {
+	_u.mutation.AddDiscountValue(v)
+	return _u
+}
+
+// SetMinAmount sets the "min_amount" field.
+func (_u *PaymentCouponUpdateOne) SetMinAmount(v float64) *PaymentCouponUpdateOne {
+	_u.mutation.ResetMinAmount()
+	_u.mutation.SetMinAmount(v)
+	return _u
+}
+
+// SetNillableMinAmount sets the "min_amount" field if the given value is not nil.
+func (_u *PaymentCouponUpdateOne) SetNillableMinAmount(v *float64) *PaymentCouponUpdateOne {
+	if v != nil {
+		_u.SetMinAmount(*v)
+	}
+	return _u
+}
+
+// AddMinAmount adds value to the "min_amount" field.
+func (_u *PaymentCouponUpdateOne) AddMinAmount(v float64) *PaymentCouponUpdateOne {
+	_u.mutation.AddMinAmount(v)
+	return _u
+}
+
+// SetAppliesTo sets the "applies_to" field.
+func (_u *PaymentCouponUpdateOne) SetAppliesTo(v string) *PaymentCouponUpdateOne {
+	_u.mutation.SetAppliesTo(v)
+	return _u
+}
+
+// SetNillableAppliesTo sets the "applies_to" field if the given value is not nil.
+func (_u *PaymentCouponUpdateOne) SetNillableAppliesTo(v *string) *PaymentCouponUpdateOne {
+	if v != nil {
+		_u.SetAppliesTo(*v)
+	}
+	return _u
+}
+
+// SetPlanIds sets the "plan_ids" field.
+func (_u *PaymentCouponUpdateOne) SetPlanIds(v []int64) *PaymentCouponUpdateOne {
+	_u.mutation.SetPlanIds(v)
+	return _u
+}
+
+// AppendPlanIds appends value to the "plan_ids" field.
+func (_u *PaymentCouponUpdateOne) AppendPlanIds(v []int64) *PaymentCouponUpdateOne {
+	_u.mutation.AppendPlanIds(v)
+	return _u
+}
+
+// ClearPlanIds clears the value of the "plan_ids" field.
+func (_u *PaymentCouponUpdateOne) ClearPlanIds() *PaymentCouponUpdateOne {
+	_u.mutation.ClearPlanIds()
+	return _u
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (_u *PaymentCouponUpdateOne) SetMaxUses(v int) *PaymentCouponUpdateOne {
+	_u.mutation.ResetMaxUses()
+	_u.mutation.SetMaxUses(v)
+	return _u
+}
+
+// SetNillableMaxUses sets the "max_uses" field if the given value is not nil.
+func (_u *PaymentCouponUpdateOne) SetNillableMaxUses(v *int) *PaymentCouponUpdateOne {
+	if v != nil {
+		_u.SetMaxUses(*v)
+	}
+	return _u
+}
+
+// AddMaxUses adds value to the "max_uses" field.
+func (_u *PaymentCouponUpdateOne) AddMaxUses(v int) *PaymentCouponUpdateOne {
+	_u.mutation.AddMaxUses(v)
+	return _u
+}
+
+// SetPerUserLimit sets the "per_user_limit" field.
+func (_u *PaymentCouponUpdateOne) SetPerUserLimit(v int) *PaymentCouponUpdateOne {
+	_u.mutation.ResetPerUserLimit()
+	_u.mutation.SetPerUserLimit(v)
+	return _u
+}
+
+// SetNillablePerUserLimit sets the "per_user_limit" field if the given value is not nil.
+func (_u *PaymentCouponUpdateOne) SetNillablePerUserLimit(v *int) *PaymentCouponUpdateOne {
+	if v != nil {
+		_u.SetPerUserLimit(*v)
+	}
+	return _u
+}
+
+// AddPerUserLimit adds value to the "per_user_limit" field.
+func (_u *PaymentCouponUpdateOne) AddPerUserLimit(v int) *PaymentCouponUpdateOne {
+	_u.mutation.AddPerUserLimit(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *PaymentCouponUpdateOne) SetStatus(v string) *PaymentCouponUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *PaymentCouponUpdateOne) SetNillableStatus(v *string) *PaymentCouponUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (_u *PaymentCouponUpdateOne) SetStartsAt(v time.Time) *PaymentCouponUpdateOne {
+	_u.mutation.SetStartsAt(v)
+	return _u
+}
+
+// SetNillableStartsAt sets the "starts_at" field if the given value is not nil.
+func (_u *PaymentCouponUpdateOne) SetNillableStartsAt(v *time.Time) *PaymentCouponUpdateOne {
+	if v != nil {
+		_u.SetStartsAt(*v)
+	}
+	return _u
+}
+
+// ClearStartsAt clears the value of the "starts_at" field.
+func (_u *PaymentCouponUpdateOne) ClearStartsAt() *PaymentCouponUpdateOne {
+	_u.mutation.ClearStartsAt()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *PaymentCouponUpdateOne) SetExpiresAt(v time.Time) *PaymentCouponUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *PaymentCouponUpdateOne) SetNillableExpiresAt(v *time.Time) *PaymentCouponUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *PaymentCouponUpdateOne) ClearExpiresAt() *PaymentCouponUpdateOne {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// SetNotes sets the "notes" field.
+func (_u *PaymentCouponUpdateOne) SetNotes(v string) *PaymentCouponUpdateOne {
+	_u.mutation.SetNotes(v)
+	return _u
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (_u *PaymentCouponUpdateOne) SetNillableNotes(v *string) *PaymentCouponUpdateOne {
+	if v != nil {
+		_u.SetNotes(*v)
+	}
+	return _u
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (_u *PaymentCouponUpdateOne) ClearNotes() *PaymentCouponUpdateOne {
+	_u.mutation.ClearNotes()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PaymentCouponUpdateOne) SetUpdatedAt(v time.Time) *PaymentCouponUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the PaymentCouponMutation object of the builder.
+func (_u *PaymentCouponUpdateOne) Mutation() *PaymentCouponMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the PaymentCouponUpdate builder.
+func (_u *PaymentCouponUpdateOne) Where(ps ...predicate.PaymentCoupon) *PaymentCouponUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PaymentCouponUpdateOne) Select(field string, fields ...string) *PaymentCouponUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PaymentCoupon entity.
+func (_u *PaymentCouponUpdateOne) Save(ctx context.Context) (*PaymentCoupon, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PaymentCouponUpdateOne) SaveX(ctx context.Context) *PaymentCoupon {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PaymentCouponUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PaymentCouponUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PaymentCouponUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := paymentcoupon.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PaymentCouponUpdateOne) check() error {
+	if v, ok := _u.mutation.Code(); ok {
+		if err := paymentcoupon.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "PaymentCoupon.code": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := paymentcoupon.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "PaymentCoupon.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DiscountType(); ok {
+		if err := paymentcoupon.DiscountTypeValidator(v); err != nil {
+			return &ValidationError{Name: "discount_type", err: fmt.Errorf(`ent: validator failed for field "PaymentCoupon.discount_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AppliesTo(); ok {
+		if err := paymentcoupon.AppliesToValidator(v); err != nil {
+			return &ValidationError{Name: "applies_to", err: fmt.Errorf(`ent: validator failed for field "PaymentCoupon.applies_to": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := paymentcoupon.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "PaymentCoupon.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PaymentCouponUpdateOne) sqlSave(ctx context.Context) (_node *PaymentCoupon, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(paymentcoupon.Table, paymentcoupon.Columns, sqlgraph.NewFieldSpec(paymentcoupon.FieldID, field.TypeInt64))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PaymentCoupon.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, paymentcoupon.FieldID)
+		for _, f := range fields {
+			if !paymentcoupon.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != paymentcoupon.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Code(); ok {
+		_spec.SetField(paymentcoupon.FieldCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(paymentcoupon.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DiscountType(); ok {
+		_spec.SetField(paymentcoupon.FieldDiscountType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DiscountValue(); ok {
+		_spec.SetField(paymentcoupon.FieldDiscountValue, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedDiscountValue(); ok {
+		_spec.AddField(paymentcoupon.FieldDiscountValue, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.MinAmount(); ok {
+		_spec.SetField(paymentcoupon.FieldMinAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedMinAmount(); ok {
+		_spec.AddField(paymentcoupon.FieldMinAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AppliesTo(); ok {
+		_spec.SetField(paymentcoupon.FieldAppliesTo, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PlanIds(); ok {
+		_spec.SetField(paymentcoupon.FieldPlanIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPlanIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, paymentcoupon.FieldPlanIds, value)
+		})
+	}
+	if _u.mutation.PlanIdsCleared() {
+		_spec.ClearField(paymentcoupon.FieldPlanIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.MaxUses(); ok {
+		_spec.SetField(paymentcoupon.FieldMaxUses, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxUses(); ok {
+		_spec.AddField(paymentcoupon.FieldMaxUses, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PerUserLimit(); ok {
+		_spec.SetField(paymentcoupon.FieldPerUserLimit, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPerUserLimit(); ok {
+		_spec.AddField(paymentcoupon.FieldPerUserLimit, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(paymentcoupon.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.StartsAt(); ok {
+		_spec.SetField(paymentcoupon.FieldStartsAt, field.TypeTime, value)
+	}
+	if _u.mutation.StartsAtCleared() {
+		_spec.ClearField(paymentcoupon.FieldStartsAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(paymentcoupon.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(paymentcoupon.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Notes(); ok {
+		_spec.SetField(paymentcoupon.FieldNotes, field.TypeString, value)
+	}
+	if _u.mutation.NotesCleared() {
+		_spec.ClearField(paymentcoupon.FieldNotes, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(paymentcoupon.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &PaymentCoupon{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{paymentcoupon.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
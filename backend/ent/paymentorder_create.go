@@ -87,6 +87,48 @@ func (_c *PaymentOrderCreate) SetRechargeCode(v string) *PaymentOrderCreate {
 	return _c
 }
 
+// SetCouponID sets the "coupon_id" field.
+func (_c *PaymentOrderCreate) SetCouponID(v int64) *PaymentOrderCreate {
+	_c.mutation.SetCouponID(v)
+	return _c
+}
+
+// SetNillableCouponID sets the "coupon_id" field if the given value is not nil.
+func (_c *PaymentOrderCreate) SetNillableCouponID(v *int64) *PaymentOrderCreate {
+	if v != nil {
+		_c.SetCouponID(*v)
+	}
+	return _c
+}
+
+// SetCouponCode sets the "coupon_code" field.
+func (_c *PaymentOrderCreate) SetCouponCode(v string) *PaymentOrderCreate {
+	_c.mutation.SetCouponCode(v)
+	return _c
+}
+
+// SetNillableCouponCode sets the "coupon_code" field if the given value is not nil.
+func (_c *PaymentOrderCreate) SetNillableCouponCode(v *string) *PaymentOrderCreate {
+	if v != nil {
+		_c.SetCouponCode(*v)
+	}
+	return _c
+}
+
+// SetDiscountAmount sets the "discount_amount" field.
+func (_c *PaymentOrderCreate) SetDiscountAmount(v float64) *PaymentOrderCreate {
+	_c.mutation.SetDiscountAmount(v)
+	return _c
+}
+
+// SetNillableDiscountAmount sets the "discount_amount" field if the given value is not nil.
+func (_c *PaymentOrderCreate) SetNillableDiscountAmount(v *float64) *PaymentOrderCreate {
+	if v != nil {
+		_c.SetDiscountAmount(*v)
+	}
+	return _c
+}
+
 // SetOutTradeNo sets the "out_trade_no" field.
 func (_c *PaymentOrderCreate) SetOutTradeNo(v string) *PaymentOrderCreate {
 	_c.mutation.SetOutTradeNo(v)
@@ -497,6 +539,10 @@ func (_c *PaymentOrderCreate) defaults() {
 		v := paymentorder.DefaultFeeRate
 		_c.mutation.SetFeeRate(v)
 	}
+	if _, ok := _c.mutation.DiscountAmount(); !ok {
+		v := paymentorder.DefaultDiscountAmount
+		_c.mutation.SetDiscountAmount(v)
+	}
 	if _, ok := _c.mutation.OutTradeNo(); !ok {
 		v := paymentorder.DefaultOutTradeNo
 		_c.mutation.SetOutTradeNo(v)
@@ -565,6 +611,14 @@ func (_c *PaymentOrderCreate) check() error {
 			return &ValidationError{Name: "recharge_code", err: fmt.Errorf(`ent: validator failed for field "PaymentOrder.recharge_code": %w`, err)}
 		}
 	}
+	if v, ok := _c.mutation.CouponCode(); ok {
+		if err := paymentorder.CouponCodeValidator(v); err != nil {
+			return &ValidationError{Name: "coupon_code", err: fmt.Errorf(`ent: validator failed for field "PaymentOrder.coupon_code": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DiscountAmount(); !ok {
+		return &ValidationError{Name: "discount_amount", err: errors.New(`ent: missing required field "PaymentOrder.discount_amount"`)}
+	}
 	if _, ok := _c.mutation.OutTradeNo(); !ok {
 		return &ValidationError{Name: "out_trade_no", err: errors.New(`ent: missing required field "PaymentOrder.out_trade_no"`)}
 	}
@@ -704,6 +758,18 @@ func (_c *PaymentOrderCreate) createSpec() (*PaymentOrder, *sqlgraph.CreateSpec)
 		_spec.SetField(paymentorder.FieldRechargeCode, field.TypeString, value)
 		_node.RechargeCode = value
 	}
+	if value, ok := _c.mutation.CouponID(); ok {
+		_spec.SetField(paymentorder.FieldCouponID, field.TypeInt64, value)
+		_node.CouponID = &value
+	}
+	if value, ok := _c.mutation.CouponCode(); ok {
+		_spec.SetField(paymentorder.FieldCouponCode, field.TypeString, value)
+		_node.CouponCode = &value
+	}
+	if value, ok := _c.mutation.DiscountAmount(); ok {
+		_spec.SetField(paymentorder.FieldDiscountAmount, field.TypeFloat64, value)
+		_node.DiscountAmount = value
+	}
 	if value, ok := _c.mutation.OutTradeNo(); ok {
 		_spec.SetField(paymentorder.FieldOutTradeNo, field.TypeString, value)
 		_node.OutTradeNo = value
@@ -1009,6 +1075,66 @@ func (u *PaymentOrderUpsert) UpdateRechargeCode() *PaymentOrderUpsert {
 	return u
 }
 
+// SetCouponID sets the "coupon_id" field.
+func (u *PaymentOrderUpsert) SetCouponID(v int64) *PaymentOrderUpsert {
+	u.Set(paymentorder.FieldCouponID, v)
+	return u
+}
+
+// UpdateCouponID sets the "coupon_id" field to the value that was provided on create.
+func (u *PaymentOrderUpsert) UpdateCouponID() *PaymentOrderUpsert {
+	u.SetExcluded(paymentorder.FieldCouponID)
+	return u
+}
+
+// AddCouponID adds v to the "coupon_id" field.
+func (u *PaymentOrderUpsert) AddCouponID(v int64) *PaymentOrderUpsert {
+	u.Add(paymentorder.FieldCouponID, v)
+	return u
+}
+
+// ClearCouponID clears the value of the "coupon_id" field.
+func (u *PaymentOrderUpsert) ClearCouponID() *PaymentOrderUpsert {
+	u.SetNull(paymentorder.FieldCouponID)
+	return u
+}
+
+// SetCouponCode sets the "coupon_code" field.
+func (u *PaymentOrderUpsert) SetCouponCode(v string) *PaymentOrderUpsert {
+	u.Set(paymentorder.FieldCouponCode, v)
+	return u
+}
+
+// UpdateCouponCode sets the "coupon_code" field to the value that was provided on create.
+func (u *PaymentOrderUpsert) UpdateCouponCode() *PaymentOrderUpsert {
+	u.SetExcluded(paymentorder.FieldCouponCode)
+	return u
+}
+
+// ClearCouponCode clears the value of the "coupon_code" field.
+func (u *PaymentOrderUpsert) ClearCouponCode() *PaymentOrderUpsert {
+	u.SetNull(paymentorder.FieldCouponCode)
+	return u
+}
+
+// SetDiscountAmount sets the "discount_amount" field.
+func (u *PaymentOrderUpsert) SetDiscountAmount(v float64) *PaymentOrderUpsert {
+	u.Set(paymentorder.FieldDiscountAmount, v)
+	return u
+}
+
+// UpdateDiscountAmount sets the "discount_amount" field to the value that was provided on create.
+func (u *PaymentOrderUpsert) UpdateDiscountAmount() *PaymentOrderUpsert {
+	u.SetExcluded(paymentorder.FieldDiscountAmount)
+	return u
+}
+
+// AddDiscountAmount adds v to the "discount_amount" field.
+func (u *PaymentOrderUpsert) AddDiscountAmount(v float64) *PaymentOrderUpsert {
+	u.Add(paymentorder.FieldDiscountAmount, v)
+	return u
+}
+
 // SetOutTradeNo sets the "out_trade_no" field.
 func (u *PaymentOrderUpsert) SetOutTradeNo(v string) *PaymentOrderUpsert {
 	u.Set(paymentorder.FieldOutTradeNo, v)
@@ -1656,6 +1782,76 @@ func (u *PaymentOrderUpsertOne) UpdateRechargeCode() *PaymentOrderUpsertOne {
 	})
 }
 
+// SetCouponID sets the "coupon_id" field.
+func (u *PaymentOrderUpsertOne) SetCouponID(v int64) *PaymentOrderUpsertOne {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.SetCouponID(v)
+	})
+}
+
+// AddCouponID adds v to the "coupon_id" field.
+func (u *PaymentOrderUpsertOne) AddCouponID(v int64) *PaymentOrderUpsertOne {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.AddCouponID(v)
+	})
+}
+
+// UpdateCouponID sets the "coupon_id" field to the value that was provided on create.
+func (u *PaymentOrderUpsertOne) UpdateCouponID() *PaymentOrderUpsertOne {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.UpdateCouponID()
+	})
+}
+
+// ClearCouponID clears the value of the "coupon_id" field.
+func (u *PaymentOrderUpsertOne) ClearCouponID() *PaymentOrderUpsertOne {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.ClearCouponID()
+	})
+}
+
+// SetCouponCode sets the "coupon_code" field.
+func (u *PaymentOrderUpsertOne) SetCouponCode(v string) *PaymentOrderUpsertOne {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.SetCouponCode(v)
+	})
+}
+
+// UpdateCouponCode sets the "coupon_code" field to the value that was provided on create.
+func (u *PaymentOrderUpsertOne) UpdateCouponCode() *PaymentOrderUpsertOne {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.UpdateCouponCode()
+	})
+}
+
+// ClearCouponCode clears the value of the "coupon_code" field.
+func (u *PaymentOrderUpsertOne) ClearCouponCode() *PaymentOrderUpsertOne {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.ClearCouponCode()
+	})
+}
+
+// SetDiscountAmount sets the "discount_amount" field.
+func (u *PaymentOrderUpsertOne) SetDiscountAmount(v float64) *PaymentOrderUpsertOne {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.SetDiscountAmount(v)
+	})
+}
+
+// AddDiscountAmount adds v to the "discount_amount" field.
+func (u *PaymentOrderUpsertOne) AddDiscountAmount(v float64) *PaymentOrderUpsertOne {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.AddDiscountAmount(v)
+	})
+}
+
+// UpdateDiscountAmount sets the "discount_amount" field to the value that was provided on create.
+func (u *PaymentOrderUpsertOne) UpdateDiscountAmount() *PaymentOrderUpsertOne {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.UpdateDiscountAmount()
+	})
+}
+
 // SetOutTradeNo sets the "out_trade_no" field.
 func (u *PaymentOrderUpsertOne) SetOutTradeNo(v string) *PaymentOrderUpsertOne {
 	return u.Update(func(s *PaymentOrderUpsert) {
@@ -2546,6 +2742,76 @@ func (u *PaymentOrderUpsertBulk) UpdateRechargeCode() *PaymentOrderUpsertBulk {
 	})
 }
 
+// SetCouponID sets the "coupon_id" field.
+func (u *PaymentOrderUpsertBulk) SetCouponID(v int64) *PaymentOrderUpsertBulk {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.SetCouponID(v)
+	})
+}
+
+// AddCouponID adds v to the "coupon_id" field.
+func (u *PaymentOrderUpsertBulk) AddCouponID(v int64) *PaymentOrderUpsertBulk {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.AddCouponID(v)
+	})
+}
+
+// UpdateCouponID sets the "coupon_id" field to the value that was provided on create.
+func (u *PaymentOrderUpsertBulk) UpdateCouponID() *PaymentOrderUpsertBulk {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.UpdateCouponID()
+	})
+}
+
+// ClearCouponID clears the value of the "coupon_id" field.
+func (u *PaymentOrderUpsertBulk) ClearCouponID() *PaymentOrderUpsertBulk {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.ClearCouponID()
+	})
+}
+
+// SetCouponCode sets the "coupon_code" field.
+func (u *PaymentOrderUpsertBulk) SetCouponCode(v string) *PaymentOrderUpsertBulk {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.SetCouponCode(v)
+	})
+}
+
+// UpdateCouponCode sets the "coupon_code" field to the value that was provided on create.
+func (u *PaymentOrderUpsertBulk) UpdateCouponCode() *PaymentOrderUpsertBulk {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.UpdateCouponCode()
+	})
+}
+
+// ClearCouponCode clears the value of the "coupon_code" field.
+func (u *PaymentOrderUpsertBulk) ClearCouponCode() *PaymentOrderUpsertBulk {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.ClearCouponCode()
+	})
+}
+
+// SetDiscountAmount sets the "discount_amount" field.
+func (u *PaymentOrderUpsertBulk) SetDiscountAmount(v float64) *PaymentOrderUpsertBulk {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.SetDiscountAmount(v)
+	})
+}
+
+// AddDiscountAmount adds v to the "discount_amount" field.
+func (u *PaymentOrderUpsertBulk) AddDiscountAmount(v float64) *PaymentOrderUpsertBulk {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.AddDiscountAmount(v)
+	})
+}
+
+// UpdateDiscountAmount sets the "discount_amount" field to the value that was provided on create.
+func (u *PaymentOrderUpsertBulk) UpdateDiscountAmount() *PaymentOrderUpsertBulk {
+	return u.Update(func(s *PaymentOrderUpsert) {
+		s.UpdateDiscountAmount()
+	})
+}
+
 // SetOutTradeNo sets the "out_trade_no" field.
 func (u *PaymentOrderUpsertBulk) SetOutTradeNo(v string) *PaymentOrderUpsertBulk {
 	return u.Update(func(s *PaymentOrderUpsert) {
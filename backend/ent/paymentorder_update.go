@@ -168,6 +168,74 @@ func (_u *PaymentOrderUpdate) SetNillableRechargeCode(v *string) *PaymentOrderUp
 	return _u
 }
 
+// SetCouponID sets the "coupon_id" field.
+func (_u *PaymentOrderUpdate) SetCouponID(v int64) *PaymentOrderUpdate {
+	_u.mutation.ResetCouponID()
+	_u.mutation.SetCouponID(v)
+	return _u
+}
+
+// SetNillableCouponID sets the "coupon_id" field if the given value is not nil.
+func (_u *PaymentOrderUpdate) SetNillableCouponID(v *int64) *PaymentOrderUpdate {
+	if v != nil {
+		_u.SetCouponID(*v)
+	}
+	return _u
+}
+
+// AddCouponID adds value to the "coupon_id" field.
+func (_u *PaymentOrderUpdate) AddCouponID(v int64) *PaymentOrderUpdate {
+	_u.mutation.AddCouponID(v)
+	return _u
+}
+
+// ClearCouponID clears the value of the "coupon_id" field.
+func (_u *PaymentOrderUpdate) ClearCouponID() *PaymentOrderUpdate {
+	_u.mutation.ClearCouponID()
+	return _u
+}
+
+// SetCouponCode sets the "coupon_code" field.
+func (_u *PaymentOrderUpdate) SetCouponCode(v string) *PaymentOrderUpdate {
+	_u.mutation.SetCouponCode(v)
+	return _u
+}
+
+// SetNillableCouponCode sets the "coupon_code" field if the given value is not nil.
+func (_u *PaymentOrderUpdate) SetNillableCouponCode(v *string) *PaymentOrderUpdate {
+	if v != nil {
+		_u.SetCouponCode(*v)
+	}
+	return _u
+}
+
+// ClearCouponCode clears the value of the "coupon_code" field.
+func (_u *PaymentOrderUpdate) ClearCouponCode() *PaymentOrderUpdate {
+	_u.mutation.ClearCouponCode()
+	return _u
+}
+
+// SetDiscountAmount sets the "discount_amount" field.
+func (_u *PaymentOrderUpdate) SetDiscountAmount(v float64) *PaymentOrderUpdate {
+	_u.mutation.ResetDiscountAmount()
+	_u.mutation.SetDiscountAmount(v)
+	return _u
+}
+
+// SetNillableDiscountAmount sets the "discount_amount" field if the given value is not nil.
+func (_u *PaymentOrderUpdate) SetNillableDiscountAmount(v *float64) *PaymentOrderUpdate {
+	if v != nil {
+		_u.SetDiscountAmount(*v)
+	}
+	return _u
+}
+
+// AddDiscountAmount adds value to the "discount_amount" field.
+func (_u *PaymentOrderUpdate) AddDiscountAmount(v float64) *PaymentOrderUpdate {
+	_u.mutation.AddDiscountAmount(v)
+	return _u
+}
+
 // SetOutTradeNo sets the "out_trade_no" field.
 func (_u *PaymentOrderUpdate) SetOutTradeNo(v string) *PaymentOrderUpdate {
 	_u.mutation.SetOutTradeNo(v)
@@ -751,6 +819,11 @@ func (_u *PaymentOrderUpdate) check() error {
 			return &ValidationError{Name: "recharge_code", err: fmt.Errorf(`ent: validator failed for field "PaymentOrder.recharge_code": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.CouponCode(); ok {
+		if err := paymentorder.CouponCodeValidator(v); err != nil {
+			return &ValidationError{Name: "coupon_code", err: fmt.Errorf(`ent: validator failed for field "PaymentOrder.coupon_code": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.OutTradeNo(); ok {
 		if err := paymentorder.OutTradeNoValidator(v); err != nil {
 			return &ValidationError{Name: "out_trade_no", err: fmt.Errorf(`ent: validator failed for field "PaymentOrder.out_trade_no": %w`, err)}
@@ -847,6 +920,27 @@ func (_u *PaymentOrderUpdate) sqlSave(ctx context.Context) (_node int, err error
 	if value, ok := _u.mutation.RechargeCode(); ok {
 		_spec.SetField(paymentorder.FieldRechargeCode, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.CouponID(); ok {
+		_spec.SetField(paymentorder.FieldCouponID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedCouponID(); ok {
+		_spec.AddField(paymentorder.FieldCouponID, field.TypeInt64, value)
+	}
+	if _u.mutation.CouponIDCleared() {
+		_spec.ClearField(paymentorder.FieldCouponID, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.CouponCode(); ok {
+		_spec.SetField(paymentorder.FieldCouponCode, field.TypeString, value)
+	}
+	if _u.mutation.CouponCodeCleared() {
+		_spec.ClearField(paymentorder.FieldCouponCode, field.TypeString)
+	}
+	if value, ok := _u.mutation.DiscountAmount(); ok {
+		_spec.SetField(paymentorder.FieldDiscountAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedDiscountAmount(); ok {
+		_spec.AddField(paymentorder.FieldDiscountAmount, field.TypeFloat64, value)
+	}
 	if value, ok := _u.mutation.OutTradeNo(); ok {
 		_spec.SetField(paymentorder.FieldOutTradeNo, field.TypeString, value)
 	}
@@ -1182,6 +1276,74 @@ func (_u *PaymentOrderUpdateOne) SetNillableRechargeCode(v *string) *PaymentOrde
 	return _u
 }
 
+// SetCouponID sets the "coupon_id" field.
+func (_u *PaymentOrderUpdateOne) SetCouponID(v int64) *PaymentOrderUpdateOne {
+	_u.mutation.ResetCouponID()
+	_u.mutation.SetCouponID(v)
+	return _u
+}
+
+// SetNillableCouponID sets the "coupon_id" field if the given value is not nil.
+func (_u *PaymentOrderUpdateOne) SetNillableCouponID(v *int64) *PaymentOrderUpdateOne {
+	if v != nil {
+		_u.SetCouponID(*v)
+	}
+	return _u
+}
+
+// AddCouponID adds value to the "coupon_id" field.
+func (_u *PaymentOrderUpdateOne) AddCouponID(v int64) *PaymentOrderUpdateOne {
+	_u.mutation.AddCouponID(v)
+	return _u
+}
+
+// ClearCouponID clears the value of the "coupon_id" field.
+func (_u *PaymentOrderUpdateOne) ClearCouponID() *PaymentOrderUpdateOne {
+	_u.mutation.ClearCouponID()
+	return _u
+}
+
+// SetCouponCode sets the "coupon_code" field.
+func (_u *PaymentOrderUpdateOne) SetCouponCode(v string) *PaymentOrderUpdateOne {
+	_u.mutation.SetCouponCode(v)
+	return _u
+}
+
+// SetNillableCouponCode sets the "coupon_code" field if the given value is not nil.
+func (_u *PaymentOrderUpdateOne) SetNillableCouponCode(v *string) *PaymentOrderUpdateOne {
+	if v != nil {
+		_u.SetCouponCode(*v)
+	}
+	return _u
+}
+
+// ClearCouponCode clears the value of the "coupon_code" field.
+func (_u *PaymentOrderUpdateOne) ClearCouponCode() *PaymentOrderUpdateOne {
+	_u.mutation.ClearCouponCode()
+	return _u
+}
+
+// SetDiscountAmount sets the "discount_amount" field.
+func (_u *PaymentOrderUpdateOne) SetDiscountAmount(v float64) *PaymentOrderUpdateOne {
+	_u.mutation.ResetDiscountAmount()
+	_u.mutation.SetDiscountAmount(v)
+	return _u
+}
+
+// SetNillableDiscountAmount sets the "discount_amount" field if the given value is not nil.
+func (_u *PaymentOrderUpdateOne) SetNillableDiscountAmount(v *float64) *PaymentOrderUpdateOne {
+	if v != nil {
+		_u.SetDiscountAmount(*v)
+	}
+	return _u
+}
+
+// AddDiscountAmount adds value to the "discount_amount" field.
+func (_u *PaymentOrderUpdateOne) AddDiscountAmount(v float64) *PaymentOrderUpdateOne {
+	_u.mutation.AddDiscountAmount(v)
+	return _u
+}
+
 // SetOutTradeNo sets the "out_trade_no" field.
 func (_u *PaymentOrderUpdateOne) SetOutTradeNo(v string) *PaymentOrderUpdateOne {
 	_u.mutation.SetOutTradeNo(v)
@@ -1778,6 +1940,11 @@ func (_u *PaymentOrderUpdateOne) check() error {
 			return &ValidationError{Name: "recharge_code", err: fmt.Errorf(`ent: validator failed for field "PaymentOrder.recharge_code": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.CouponCode(); ok {
+		if err := paymentorder.CouponCodeValidator(v); err != nil {
+			return &ValidationError{Name: "coupon_code", err: fmt.Errorf(`ent: validator failed for field "PaymentOrder.coupon_code": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.OutTradeNo(); ok {
 		if err := paymentorder.OutTradeNoValidator(v); err != nil {
 			return &ValidationError{Name: "out_trade_no", err: fmt.Errorf(`ent: validator failed for field "PaymentOrder.out_trade_no": %w`, err)}
@@ -1891,6 +2058,27 @@ func (_u *PaymentOrderUpdateOne) sqlSave(ctx context.Context) (_node *PaymentOrd
 	if value, ok := _u.mutation.RechargeCode(); ok {
 		_spec.SetField(paymentorder.FieldRechargeCode, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.CouponID(); ok {
+		_spec.SetField(paymentorder.FieldCouponID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedCouponID(); ok {
+		_spec.AddField(paymentorder.FieldCouponID, field.TypeInt64, value)
+	}
+	if _u.mutation.CouponIDCleared() {
+		_spec.ClearField(paymentorder.FieldCouponID, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.CouponCode(); ok {
+		_spec.SetField(paymentorder.FieldCouponCode, field.TypeString, value)
+	}
+	if _u.mutation.CouponCodeCleared() {
+		_spec.ClearField(paymentorder.FieldCouponCode, field.TypeString)
+	}
+	if value, ok := _u.mutation.DiscountAmount(); ok {
+		_spec.SetField(paymentorder.FieldDiscountAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedDiscountAmount(); ok {
+		_spec.AddField(paymentorder.FieldDiscountAmount, field.TypeFloat64, value)
+	}
 	if value, ok := _u.mutation.OutTradeNo(); ok {
 		_spec.SetField(paymentorder.FieldOutTradeNo, field.TypeString, value)
 	}
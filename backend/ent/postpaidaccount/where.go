@@ -0,0 +1,545 @@
+// Code generated by ent, DO NOT EDIT.
+
+package postpaidaccount
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Wei-Shaw/sub2api/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int64) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldEQ(FieldUserID, v))
+}
+
+// CreditLimit applies equality check predicate on the "credit_limit" field. It's identical to CreditLimitEQ.
+func CreditLimit(v float64) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldEQ(FieldCreditLimit, v))
+}
+
+// PaymentTermsDays applies equality check predicate on the "payment_terms_days" field. It's identical to PaymentTermsDaysEQ.
+func PaymentTermsDays(v int) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldEQ(FieldPaymentTermsDays, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldEQ(FieldStatus, v))
+}
+
+// BillingStart applies equality check predicate on the "billing_start" field. It's identical to BillingStartEQ.
+func BillingStart(v time.Time) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldEQ(FieldBillingStart, v))
+}
+
+// SuspendedAt applies equality check predicate on the "suspended_at" field. It's identical to SuspendedAtEQ.
+func SuspendedAt(v time.Time) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldEQ(FieldSuspendedAt, v))
+}
+
+// Notes applies equality check predicate on the "notes" field. It's identical to NotesEQ.
+func Notes(v string) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldEQ(FieldNotes, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int64) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int64) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int64) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int64) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v int64) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v int64) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v int64) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v int64) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldLTE(FieldUserID, v))
+}
+
+// CreditLimitEQ applies the EQ predicate on the "credit_limit" field.
+func CreditLimitEQ(v float64) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldEQ(FieldCreditLimit, v))
+}
+
+// CreditLimitNEQ applies the NEQ predicate on the "credit_limit" field.
+func CreditLimitNEQ(v float64) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldNEQ(FieldCreditLimit, v))
+}
+
+// CreditLimitIn applies the In predicate on the "credit_limit" field.
+func CreditLimitIn(vs ...float64) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldIn(FieldCreditLimit, vs...))
+}
+
+// CreditLimitNotIn applies the NotIn predicate on the "credit_limit" field.
+func CreditLimitNotIn(vs ...float64) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldNotIn(FieldCreditLimit, vs...))
+}
+
+// CreditLimitGT applies the GT predicate on the "credit_limit" field.
+func CreditLimitGT(v float64) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldGT(FieldCreditLimit, v))
+}
+
+// CreditLimitGTE applies the GTE predicate on the "credit_limit" field.
+func CreditLimitGTE(v float64) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldGTE(FieldCreditLimit, v))
+}
+
+// CreditLimitLT applies the LT predicate on the "credit_limit" field.
+func CreditLimitLT(v float64) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldLT(FieldCreditLimit, v))
+}
+
+// CreditLimitLTE applies the LTE predicate on the "credit_limit" field.
+func CreditLimitLTE(v float64) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldLTE(FieldCreditLimit, v))
+}
+
+// PaymentTermsDaysEQ applies the EQ predicate on the "payment_terms_days" field.
+func PaymentTermsDaysEQ(v int) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldEQ(FieldPaymentTermsDays, v))
+}
+
+// PaymentTermsDaysNEQ applies the NEQ predicate on the "payment_terms_days" field.
+func PaymentTermsDaysNEQ(v int) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldNEQ(FieldPaymentTermsDays, v))
+}
+
+// PaymentTermsDaysIn applies the In predicate on the "payment_terms_days" field.
+func PaymentTermsDaysIn(vs ...int) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldIn(FieldPaymentTermsDays, vs...))
+}
+
+// PaymentTermsDaysNotIn applies the NotIn predicate on the "payment_terms_days" field.
+func PaymentTermsDaysNotIn(vs ...int) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldNotIn(FieldPaymentTermsDays, vs...))
+}
+
+// PaymentTermsDaysGT applies the GT predicate on the "payment_terms_days" field.
+func PaymentTermsDaysGT(v int) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldGT(FieldPaymentTermsDays, v))
+}
+
+// PaymentTermsDaysGTE applies the GTE predicate on the "payment_terms_days" field.
+func PaymentTermsDaysGTE(v int) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldGTE(FieldPaymentTermsDays, v))
+}
+
+// PaymentTermsDaysLT applies the LT predicate on the "payment_terms_days" field.
+func PaymentTermsDaysLT(v int) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldLT(FieldPaymentTermsDays, v))
+}
+
+// PaymentTermsDaysLTE applies the LTE predicate on the "payment_terms_days" field.
+func PaymentTermsDaysLTE(v int) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldLTE(FieldPaymentTermsDays, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// BillingStartEQ applies the EQ predicate on the "billing_start" field.
+func BillingStartEQ(v time.Time) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldEQ(FieldBillingStart, v))
+}
+
+// BillingStartNEQ applies the NEQ predicate on the "billing_start" field.
+func BillingStartNEQ(v time.Time) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldNEQ(FieldBillingStart, v))
+}
+
+// BillingStartIn applies the In predicate on the "billing_start" field.
+func BillingStartIn(vs ...time.Time) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldIn(FieldBillingStart, vs...))
+}
+
+// BillingStartNotIn applies the NotIn predicate on the "billing_start" field.
+func BillingStartNotIn(vs ...time.Time) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldNotIn(FieldBillingStart, vs...))
+}
+
+// BillingStartGT applies the GT predicate on the "billing_start" field.
+func BillingStartGT(v time.Time) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldGT(FieldBillingStart, v))
+}
+
+// BillingStartGTE applies the GTE predicate on the "billing_start" field.
+func BillingStartGTE(v time.Time) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldGTE(FieldBillingStart, v))
+}
+
+// BillingStartLT applies the LT predicate on the "billing_start" field.
+func BillingStartLT(v time.Time) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldLT(FieldBillingStart, v))
+}
+
+// BillingStartLTE applies the LTE predicate on the "billing_start" field.
+func BillingStartLTE(v time.Time) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldLTE(FieldBillingStart, v))
+}
+
+// SuspendedAtEQ applies the EQ predicate on the "suspended_at" field.
+func SuspendedAtEQ(v time.Time) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldEQ(FieldSuspendedAt, v))
+}
+
+// SuspendedAtNEQ applies the NEQ predicate on the "suspended_at" field.
+func SuspendedAtNEQ(v time.Time) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldNEQ(FieldSuspendedAt, v))
+}
+
+// SuspendedAtIn applies the In predicate on the "suspended_at" field.
+func SuspendedAtIn(vs ...time.Time) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldIn(FieldSuspendedAt, vs...))
+}
+
+// SuspendedAtNotIn applies the NotIn predicate on the "suspended_at" field.
+func SuspendedAtNotIn(vs ...time.Time) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldNotIn(FieldSuspendedAt, vs...))
+}
+
+// SuspendedAtGT applies the GT predicate on the "suspended_at" field.
+func SuspendedAtGT(v time.Time) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldGT(FieldSuspendedAt, v))
+}
+
+// SuspendedAtGTE applies the GTE predicate on the "suspended_at" field.
+func SuspendedAtGTE(v time.Time) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldGTE(FieldSuspendedAt, v))
+}
+
+// SuspendedAtLT applies the LT predicate on the "suspended_at" field.
+func SuspendedAtLT(v time.Time) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldLT(FieldSuspendedAt, v))
+}
+
+// SuspendedAtLTE applies the LTE predicate on the "suspended_at" field.
+func SuspendedAtLTE(v time.Time) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldLTE(FieldSuspendedAt, v))
+}
+
+// SuspendedAtIsNil applies the IsNil predicate on the "suspended_at" field.
+func SuspendedAtIsNil() predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldIsNull(FieldSuspendedAt))
+}
+
+// SuspendedAtNotNil applies the NotNil predicate on the "suspended_at" field.
+func SuspendedAtNotNil() predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldNotNull(FieldSuspendedAt))
+}
+
+// NotesEQ applies the EQ predicate on the "notes" field.
+func NotesEQ(v string) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldEQ(FieldNotes, v))
+}
+
+// NotesNEQ applies the NEQ predicate on the "notes" field.
+func NotesNEQ(v string) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldNEQ(FieldNotes, v))
+}
+
+// NotesIn applies the In predicate on the "notes" field.
+func NotesIn(vs ...string) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldIn(FieldNotes, vs...))
+}
+
+// NotesNotIn applies the NotIn predicate on the "notes" field.
+func NotesNotIn(vs ...string) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldNotIn(FieldNotes, vs...))
+}
+
+// NotesGT applies the GT predicate on the "notes" field.
+func NotesGT(v string) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldGT(FieldNotes, v))
+}
+
+// NotesGTE applies the GTE predicate on the "notes" field.
+func NotesGTE(v string) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldGTE(FieldNotes, v))
+}
+
+// NotesLT applies the LT predicate on the "notes" field.
+func NotesLT(v string) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldLT(FieldNotes, v))
+}
+
+// NotesLTE applies the LTE predicate on the "notes" field.
+func NotesLTE(v string) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldLTE(FieldNotes, v))
+}
+
+// NotesContains applies the Contains predicate on the "notes" field.
+func NotesContains(v string) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldContains(FieldNotes, v))
+}
+
+// NotesHasPrefix applies the HasPrefix predicate on the "notes" field.
+func NotesHasPrefix(v string) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldHasPrefix(FieldNotes, v))
+}
+
+// NotesHasSuffix applies the HasSuffix predicate on the "notes" field.
+func NotesHasSuffix(v string) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldHasSuffix(FieldNotes, v))
+}
+
+// NotesIsNil applies the IsNil predicate on the "notes" field.
+func NotesIsNil() predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldIsNull(FieldNotes))
+}
+
+// NotesNotNil applies the NotNil predicate on the "notes" field.
+func NotesNotNil() predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldNotNull(FieldNotes))
+}
+
+// NotesEqualFold applies the EqualFold predicate on the "notes" field.
+func NotesEqualFold(v string) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldEqualFold(FieldNotes, v))
+}
+
+// NotesContainsFold applies the ContainsFold predicate on the "notes" field.
+func NotesContainsFold(v string) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldContainsFold(FieldNotes, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PostpaidAccount) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PostpaidAccount) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PostpaidAccount) predicate.PostpaidAccount {
+	return predicate.PostpaidAccount(sql.NotPredicates(p))
+}
@@ -0,0 +1,1032 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Wei-Shaw/sub2api/ent/postpaidaccount"
+)
+
+// PostpaidAccountCreate is the builder for creating a PostpaidAccount entity.
+type PostpaidAccountCreate struct {
+	config
+	mutation *PostpaidAccountMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *PostpaidAccountCreate) SetUserID(v int64) *PostpaidAccountCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetCreditLimit sets the "credit_limit" field.
+func (_c *PostpaidAccountCreate) SetCreditLimit(v float64) *PostpaidAccountCreate {
+	_c.mutation.SetCreditLimit(v)
+	return _c
+}
+
+// SetNillableCreditLimit sets the "credit_limit" field if the given value is not nil.
+func (_c *PostpaidAccountCreate) SetNillableCreditLimit(v *float64) *PostpaidAccountCreate {
+	if v != nil {
+		_c.SetCreditLimit(*v)
+	}
+	return _c
+}
+
+// SetPaymentTermsDays sets the "payment_terms_days" field.
+func (_c *PostpaidAccountCreate) SetPaymentTermsDays(v int) *PostpaidAccountCreate {
+	_c.mutation.SetPaymentTermsDays(v)
+	return _c
+}
+
+// SetNillablePaymentTermsDays sets the "payment_terms_days" field if the given value is not nil.
+func (_c *PostpaidAccountCreate) SetNillablePaymentTermsDays(v *int) *PostpaidAccountCreate {
+	if v != nil {
+		_c.SetPaymentTermsDays(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *PostpaidAccountCreate) SetStatus(v string) *PostpaidAccountCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *PostpaidAccountCreate) SetNillableStatus(v *string) *PostpaidAccountCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetBillingStart sets the "billing_start" field.
+func (_c *PostpaidAccountCreate) SetBillingStart(v time.Time) *PostpaidAccountCreate {
+	_c.mutation.SetBillingStart(v)
+	return _c
+}
+
+// SetSuspendedAt sets the "suspended_at" field.
+func (_c *PostpaidAccountCreate) SetSuspendedAt(v time.Time) *PostpaidAccountCreate {
+	_c.mutation.SetSuspendedAt(v)
+	return _c
+}
+
+// SetNillableSuspendedAt sets the "suspended_at" field if the given value is not nil.
+func (_c *PostpaidAccountCreate) SetNillableSuspendedAt(v *time.Time) *PostpaidAccountCreate {
+	if v != nil {
+		_c.SetSuspendedAt(*v)
+	}
+	return _c
+}
+
+// SetNotes sets the "notes" field.
+func (_c *PostpaidAccountCreate) SetNotes(v string) *PostpaidAccountCreate {
+	_c.mutation.SetNotes(v)
+	return _c
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (_c *PostpaidAccountCreate) SetNillableNotes(v *string) *PostpaidAccountCreate {
+	if v != nil {
+		_c.SetNotes(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PostpaidAccountCreate) SetCreatedAt(v time.Time) *PostpaidAccountCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PostpaidAccountCreate) SetNillableCreatedAt(v *time.Time) *PostpaidAccountCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *PostpaidAccountCreate) SetUpdatedAt(v time.Time) *PostpaidAccountCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *PostpaidAccountCreate) SetNillableUpdatedAt(v *time.Time) *PostpaidAccountCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the PostpaidAccountMutation object of the builder.
+func (_c *PostpaidAccountCreate) Mutation() *PostpaidAccountMutation {
+	return _c.mutation
+}
+
+// Save creates the PostpaidAccount in the database.
+func (_c *PostpaidAccountCreate) Save(ctx context.Context) (*PostpaidAccount, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PostpaidAccountCreate) SaveX(ctx context.Context) *PostpaidAccount {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PostpaidAccountCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PostpaidAccountCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PostpaidAccountCreate) defaults() {
+	if _, ok := _c.mutation.CreditLimit(); !ok {
+		v := postpaidaccount.DefaultCreditLimit
+		_c.mutation.SetCreditLimit(v)
+	}
+	if _, ok := _c.mutation.PaymentTermsDays(); !ok {
+		v := postpaidaccount.DefaultPaymentTermsDays
+		_c.mutation.SetPaymentTermsDays(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := postpaidaccount.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := postpaidaccount.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := postpaidaccount.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PostpaidAccountCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "PostpaidAccount.user_id"`)}
+	}
+	if _, ok := _c.mutation.CreditLimit(); !ok {
+		return &ValidationError{Name: "credit_limit", err: errors.New(`ent: missing required field "PostpaidAccount.credit_limit"`)}
+	}
+	if _, ok := _c.mutation.PaymentTermsDays(); !ok {
+		return &ValidationError{Name: "payment_terms_days", err: errors.New(`ent: missing required field "PostpaidAccount.payment_terms_days"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "PostpaidAccount.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := postpaidaccount.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "PostpaidAccount.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.BillingStart(); !ok {
+		return &ValidationError{Name: "billing_start", err: errors.New(`ent: missing required field "PostpaidAccount.billing_start"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PostpaidAccount.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "PostpaidAccount.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *PostpaidAccountCreate) sqlSave(ctx context.Context) (*PostpaidAccount, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int64(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PostpaidAccountCreate) createSpec() (*PostpaidAccount, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PostpaidAccount{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(postpaidaccount.Table, sqlgraph.NewFieldSpec(postpaidaccount.FieldID, field.TypeInt64))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(postpaidaccount.FieldUserID, field.TypeInt64, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.CreditLimit(); ok {
+		_spec.SetField(postpaidaccount.FieldCreditLimit, field.TypeFloat64, value)
+		_node.CreditLimit = value
+	}
+	if value, ok := _c.mutation.PaymentTermsDays(); ok {
+		_spec.SetField(postpaidaccount.FieldPaymentTermsDays, field.TypeInt, value)
+		_node.PaymentTermsDays = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(postpaidaccount.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.BillingStart(); ok {
+		_spec.SetField(postpaidaccount.FieldBillingStart, field.TypeTime, value)
+		_node.BillingStart = value
+	}
+	if value, ok := _c.mutation.SuspendedAt(); ok {
+		_spec.SetField(postpaidaccount.FieldSuspendedAt, field.TypeTime, value)
+		_node.SuspendedAt = &value
+	}
+	if value, ok := _c.mutation.Notes(); ok {
+		_spec.SetField(postpaidaccount.FieldNotes, field.TypeString, value)
+		_node.Notes = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(postpaidaccount.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(postpaidaccount.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PostpaidAccount.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PostpaidAccountUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PostpaidAccountCreate) OnConflict(opts ...sql.ConflictOption) *PostpaidAccountUpsertOne {
+	_c.conflict = opts
+	return &PostpaidAccountUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PostpaidAccount.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PostpaidAccountCreate) OnConflictColumns(columns ...string) *PostpaidAccountUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PostpaidAccountUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// PostpaidAccountUpsertOne is the builder for "upsert"-ing
+	//  one PostpaidAccount node.
+	PostpaidAccountUpsertOne struct {
+		create *PostpaidAccountCreate
+	}
+
+	// PostpaidAccountUpsert is the "OnConflict" setter.
+	PostpaidAccountUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUserID sets the "user_id" field.
+func (u *PostpaidAccountUpsert) SetUserID(v int64) *PostpaidAccountUpsert {
+	u.Set(postpaidaccount.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *PostpaidAccountUpsert) UpdateUserID() *PostpaidAccountUpsert {
+	u.SetExcluded(postpaidaccount.FieldUserID)
+	return u
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *PostpaidAccountUpsert) AddUserID(v int64) *PostpaidAccountUpsert {
+	u.Add(postpaidaccount.FieldUserID, v)
+	return u
+}
+
+// SetCreditLimit sets the "credit_limit" field.
+func (u *PostpaidAccountUpsert) SetCreditLimit(v float64) *PostpaidAccountUpsert {
+	u.Set(postpaidaccount.FieldCreditLimit, v)
+	return u
+}
+
+// UpdateCreditLimit sets the "credit_limit" field to the value that was provided on create.
+func (u *PostpaidAccountUpsert) UpdateCreditLimit() *PostpaidAccountUpsert {
+	u.SetExcluded(postpaidaccount.FieldCreditLimit)
+	return u
+}
+
+// AddCreditLimit adds v to the "credit_limit" field.
+func (u *PostpaidAccountUpsert) AddCreditLimit(v float64) *PostpaidAccountUpsert {
+	u.Add(postpaidaccount.FieldCreditLimit, v)
+	return u
+}
+
+// SetPaymentTermsDays sets the "payment_terms_days" field.
+func (u *PostpaidAccountUpsert) SetPaymentTermsDays(v int) *PostpaidAccountUpsert {
+	u.Set(postpaidaccount.FieldPaymentTermsDays, v)
+	return u
+}
+
+// UpdatePaymentTermsDays sets the "payment_terms_days" field to the value that was provided on create.
+func (u *PostpaidAccountUpsert) UpdatePaymentTermsDays() *PostpaidAccountUpsert {
+	u.SetExcluded(postpaidaccount.FieldPaymentTermsDays)
+	return u
+}
+
+// AddPaymentTermsDays adds v to the "payment_terms_days" field.
+func (u *PostpaidAccountUpsert) AddPaymentTermsDays(v int) *PostpaidAccountUpsert {
+	u.Add(postpaidaccount.FieldPaymentTermsDays, v)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *PostpaidAccountUpsert) SetStatus(v string) *PostpaidAccountUpsert {
+	u.Set(postpaidaccount.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *PostpaidAccountUpsert) UpdateStatus() *PostpaidAccountUpsert {
+	u.SetExcluded(postpaidaccount.FieldStatus)
+	return u
+}
+
+// SetBillingStart sets the "billing_start" field.
+func (u *PostpaidAccountUpsert) SetBillingStart(v time.Time) *PostpaidAccountUpsert {
+	u.Set(postpaidaccount.FieldBillingStart, v)
+	return u
+}
+
+// UpdateBillingStart sets the "billing_start" field to the value that was provided on create.
+func (u *PostpaidAccountUpsert) UpdateBillingStart() *PostpaidAccountUpsert {
+	u.SetExcluded(postpaidaccount.FieldBillingStart)
+	return u
+}
+
+// SetSuspendedAt sets the "suspended_at" field.
+func (u *PostpaidAccountUpsert) SetSuspendedAt(v time.Time) *PostpaidAccountUpsert {
+	u.Set(postpaidaccount.FieldSuspendedAt, v)
+	return u
+}
+
+// UpdateSuspendedAt sets the "suspended_at" field to the value that was provided on create.
+func (u *PostpaidAccountUpsert) UpdateSuspendedAt() *PostpaidAccountUpsert {
+	u.SetExcluded(postpaidaccount.FieldSuspendedAt)
+	return u
+}
+
+// ClearSuspendedAt clears the value of the "suspended_at" field.
+func (u *PostpaidAccountUpsert) ClearSuspendedAt() *PostpaidAccountUpsert {
+	u.SetNull(postpaidaccount.FieldSuspendedAt)
+	return u
+}
+
+// SetNotes sets the "notes" field.
+func (u *PostpaidAccountUpsert) SetNotes(v string) *PostpaidAccountUpsert {
+	u.Set(postpaidaccount.FieldNotes, v)
+	return u
+}
+
+// UpdateNotes sets the "notes" field to the value that was provided on create.
+func (u *PostpaidAccountUpsert) UpdateNotes() *PostpaidAccountUpsert {
+	u.SetExcluded(postpaidaccount.FieldNotes)
+	return u
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (u *PostpaidAccountUpsert) ClearNotes() *PostpaidAccountUpsert {
+	u.SetNull(postpaidaccount.FieldNotes)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PostpaidAccountUpsert) SetUpdatedAt(v time.Time) *PostpaidAccountUpsert {
+	u.Set(postpaidaccount.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PostpaidAccountUpsert) UpdateUpdatedAt() *PostpaidAccountUpsert {
+	u.SetExcluded(postpaidaccount.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.PostpaidAccount.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *PostpaidAccountUpsertOne) UpdateNewValues() *PostpaidAccountUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(postpaidaccount.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PostpaidAccount.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PostpaidAccountUpsertOne) Ignore() *PostpaidAccountUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PostpaidAccountUpsertOne) DoNothing() *PostpaidAccountUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PostpaidAccountCreate.OnConflict
+// documentation for more info.
+func (u *PostpaidAccountUpsertOne) Update(set func(*PostpaidAccountUpsert)) *PostpaidAccountUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PostpaidAccountUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *PostpaidAccountUpsertOne) SetUserID(v int64) *PostpaidAccountUpsertOne {
+	return u.Update(func(s *PostpaidAccountUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *PostpaidAccountUpsertOne) AddUserID(v int64) *PostpaidAccountUpsertOne {
+	return u.Update(func(s *PostpaidAccountUpsert) {
+		s.AddUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *PostpaidAccountUpsertOne) UpdateUserID() *PostpaidAccountUpsertOne {
+	return u.Update(func(s *PostpaidAccountUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetCreditLimit sets the "credit_limit" field.
+func (u *PostpaidAccountUpsertOne) SetCreditLimit(v float64) *PostpaidAccountUpsertOne {
+	return u.Update(func(s *PostpaidAccountUpsert) {
+		s.SetCreditLimit(v)
+	})
+}
+
+// AddCreditLimit adds v to the "credit_limit" field.
+func (u *PostpaidAccountUpsertOne) AddCreditLimit(v float64) *PostpaidAccountUpsertOne {
+	return u.Update(func(s *PostpaidAccountUpsert) {
+		s.AddCreditLimit(v)
+	})
+}
+
+// UpdateCreditLimit sets the "credit_limit" field to the value that was provided on create.
+func (u *PostpaidAccountUpsertOne) UpdateCreditLimit() *PostpaidAccountUpsertOne {
+	return u.Update(func(s *PostpaidAccountUpsert) {
+		s.UpdateCreditLimit()
+	})
+}
+
+// SetPaymentTermsDays sets the "payment_terms_days" field.
+func (u *PostpaidAccountUpsertOne) SetPaymentTermsDays(v int) *PostpaidAccountUpsertOne {
+	return u.Update(func(s *PostpaidAccountUpsert) {
+		s.SetPaymentTermsDays(v)
+	})
+}
+
+// AddPaymentTermsDays adds v to the "payment_terms_days" field.
+func (u *PostpaidAccountUpsertOne) AddPaymentTermsDays(v int) *PostpaidAccountUpsertOne {
+	return u.Update(func(s *PostpaidAccountUpsert) {
+		s.AddPaymentTermsDays(v)
+	})
+}
+
+// UpdatePaymentTermsDays sets the "payment_terms_days" field to the value that was provided on create.
+func (u *PostpaidAccountUpsertOne) UpdatePaymentTermsDays() *PostpaidAccountUpsertOne {
+	return u.Update(func(s *PostpaidAccountUpsert) {
+		s.UpdatePaymentTermsDays()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *PostpaidAccountUpsertOne) SetStatus(v string) *PostpaidAccountUpsertOne {
+	return u.Update(func(s *PostpaidAccountUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *PostpaidAccountUpsertOne) UpdateStatus() *PostpaidAccountUpsertOne {
+	return u.Update(func(s *PostpaidAccountUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetBillingStart sets the "billing_start" field.
+func (u *PostpaidAccountUpsertOne) SetBillingStart(v time.Time) *PostpaidAccountUpsertOne {
+	return u.Update(func(s *PostpaidAccountUpsert) {
+		s.SetBillingStart(v)
+	})
+}
+
+// UpdateBillingStart sets the "billing_start" field to the value that was provided on create.
+func (u *PostpaidAccountUpsertOne) UpdateBillingStart() *PostpaidAccountUpsertOne {
+	return u.Update(func(s *PostpaidAccountUpsert) {
+		s.UpdateBillingStart()
+	})
+}
+
+// SetSuspendedAt sets the "suspended_at" field.
+func (u *PostpaidAccountUpsertOne) SetSuspendedAt(v time.Time) *PostpaidAccountUpsertOne {
+	return u.Update(func(s *PostpaidAccountUpsert) {
+		s.SetSuspendedAt(v)
+	})
+}
+
+// UpdateSuspendedAt sets the "suspended_at" field to the value that was provided on create.
+func (u *PostpaidAccountUpsertOne) UpdateSuspendedAt() *PostpaidAccountUpsertOne {
+	return u.Update(func(s *PostpaidAccountUpsert) {
+		s.UpdateSuspendedAt()
+	})
+}
+
+// ClearSuspendedAt clears the value of the "suspended_at" field.
+func (u *PostpaidAccountUpsertOne) ClearSuspendedAt() *PostpaidAccountUpsertOne {
+	return u.Update(func(s *PostpaidAccountUpsert) {
+		s.ClearSuspendedAt()
+	})
+}
+
+// SetNotes sets the "notes" field.
+func (u *PostpaidAccountUpsertOne) SetNotes(v string) *PostpaidAccountUpsertOne {
+	return u.Update(func(s *PostpaidAccountUpsert) {
+		s.SetNotes(v)
+	})
+}
+
+// UpdateNotes sets the "notes" field to the value that was provided on create.
+func (u *PostpaidAccountUpsertOne) UpdateNotes() *PostpaidAccountUpsertOne {
+	return u.Update(func(s *PostpaidAccountUpsert) {
+		s.UpdateNotes()
+	})
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (u *PostpaidAccountUpsertOne) ClearNotes() *PostpaidAccountUpsertOne {
+	return u.Update(func(s *PostpaidAccountUpsert) {
+		s.ClearNotes()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PostpaidAccountUpsertOne) SetUpdatedAt(v time.Time) *PostpaidAccountUpsertOne {
+	return u.Update(func(s *PostpaidAccountUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PostpaidAccountUpsertOne) UpdateUpdatedAt() *PostpaidAccountUpsertOne {
+	return u.Update(func(s *PostpaidAccountUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PostpaidAccountUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PostpaidAccountCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PostpaidAccountUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PostpaidAccountUpsertOne) ID(ctx context.Context) (id int64, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PostpaidAccountUpsertOne) IDX(ctx context.Context) int64 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PostpaidAccountCreateBulk is the builder for creating many PostpaidAccount entities in bulk.
+type PostpaidAccountCreateBulk struct {
+	config
+	err      error
+	builders []*PostpaidAccountCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the PostpaidAccount entities in the database.
+func (_c *PostpaidAccountCreateBulk) Save(ctx context.Context) ([]*PostpaidAccount, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PostpaidAccount, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PostpaidAccountMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PostpaidAccountCreateBulk) SaveX(ctx context.Context) []*PostpaidAccount {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PostpaidAccountCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PostpaidAccountCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PostpaidAccount.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PostpaidAccountUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PostpaidAccountCreateBulk) OnConflict(opts ...sql.ConflictOption) *PostpaidAccountUpsertBulk {
+	_c.conflict = opts
+	return &PostpaidAccountUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PostpaidAccount.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PostpaidAccountCreateBulk) OnConflictColumns(columns ...string) *PostpaidAccountUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PostpaidAccountUpsertBulk{
+		create: _c,
+	}
+}
+
+// PostpaidAccountUpsertBulk is the builder for "upsert"-ing
+// a bulk of PostpaidAccount nodes.
+type PostpaidAccountUpsertBulk struct {
+	create *PostpaidAccountCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PostpaidAccount.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *PostpaidAccountUpsertBulk) UpdateNewValues() *PostpaidAccountUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(postpaidaccount.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PostpaidAccount.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PostpaidAccountUpsertBulk) Ignore() *PostpaidAccountUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PostpaidAccountUpsertBulk) DoNothing() *PostpaidAccountUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PostpaidAccountCreateBulk.OnConflict
+// documentation for more info.
+func (u *PostpaidAccountUpsertBulk) Update(set func(*PostpaidAccountUpsert)) *PostpaidAccountUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PostpaidAccountUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *PostpaidAccountUpsertBulk) SetUserID(v int64) *PostpaidAccountUpsertBulk {
+	return u.Update(func(s *PostpaidAccountUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *PostpaidAccountUpsertBulk) AddUserID(v int64) *PostpaidAccountUpsertBulk {
+	return u.Update(func(s *PostpaidAccountUpsert) {
+		s.AddUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *PostpaidAccountUpsertBulk) UpdateUserID() *PostpaidAccountUpsertBulk {
+	return u.Update(func(s *PostpaidAccountUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetCreditLimit sets the "credit_limit" field.
+func (u *PostpaidAccountUpsertBulk) SetCreditLimit(v float64) *PostpaidAccountUpsertBulk {
+	return u.Update(func(s *PostpaidAccountUpsert) {
+		s.SetCreditLimit(v)
+	})
+}
+
+// AddCreditLimit adds v to the "credit_limit" field.
+func (u *PostpaidAccountUpsertBulk) AddCreditLimit(v float64) *PostpaidAccountUpsertBulk {
+	return u.Update(func(s *PostpaidAccountUpsert) {
+		s.AddCreditLimit(v)
+	})
+}
+
+// UpdateCreditLimit sets the "credit_limit" field to the value that was provided on create.
+func (u *PostpaidAccountUpsertBulk) UpdateCreditLimit() *PostpaidAccountUpsertBulk {
+	return u.Update(func(s *PostpaidAccountUpsert) {
+		s.UpdateCreditLimit()
+	})
+}
+
+// SetPaymentTermsDays sets the "payment_terms_days" field.
+func (u *PostpaidAccountUpsertBulk) SetPaymentTermsDays(v int) *PostpaidAccountUpsertBulk {
+	return u.Update(func(s *PostpaidAccountUpsert) {
+		s.SetPaymentTermsDays(v)
+	})
+}
+
+// AddPaymentTermsDays adds v to the "payment_terms_days" field.
+func (u *PostpaidAccountUpsertBulk) AddPaymentTermsDays(v int) *PostpaidAccountUpsertBulk {
+	return u.Update(func(s *PostpaidAccountUpsert) {
+		s.AddPaymentTermsDays(v)
+	})
+}
+
+// UpdatePaymentTermsDays sets the "payment_terms_days" field to the value that was provided on create.
+func (u *PostpaidAccountUpsertBulk) UpdatePaymentTermsDays() *PostpaidAccountUpsertBulk {
+	return u.Update(func(s *PostpaidAccountUpsert) {
+		s.UpdatePaymentTermsDays()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *PostpaidAccountUpsertBulk) SetStatus(v string) *PostpaidAccountUpsertBulk {
+	return u.Update(func(s *PostpaidAccountUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *PostpaidAccountUpsertBulk) UpdateStatus() *PostpaidAccountUpsertBulk {
+	return u.Update(func(s *PostpaidAccountUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetBillingStart sets the "billing_start" field.
+func (u *PostpaidAccountUpsertBulk) SetBillingStart(v time.Time) *PostpaidAccountUpsertBulk {
+	return u.Update(func(s *PostpaidAccountUpsert) {
+		s.SetBillingStart(v)
+	})
+}
+
+// UpdateBillingStart sets the "billing_start" field to the value that was provided on create.
+func (u *PostpaidAccountUpsertBulk) UpdateBillingStart() *PostpaidAccountUpsertBulk {
+	return u.Update(func(s *PostpaidAccountUpsert) {
+		s.UpdateBillingStart()
+	})
+}
+
+// SetSuspendedAt sets the "suspended_at" field.
+func (u *PostpaidAccountUpsertBulk) SetSuspendedAt(v time.Time) *PostpaidAccountUpsertBulk {
+	return u.Update(func(s *PostpaidAccountUpsert) {
+		s.SetSuspendedAt(v)
+	})
+}
+
+// UpdateSuspendedAt sets the "suspended_at" field to the value that was provided on create.
+func (u *PostpaidAccountUpsertBulk) UpdateSuspendedAt() *PostpaidAccountUpsertBulk {
+	return u.Update(func(s *PostpaidAccountUpsert) {
+		s.UpdateSuspendedAt()
+	})
+}
+
+// ClearSuspendedAt clears the value of the "suspended_at" field.
+func (u *PostpaidAccountUpsertBulk) ClearSuspendedAt() *PostpaidAccountUpsertBulk {
+	return u.Update(func(s *PostpaidAccountUpsert) {
+		s.ClearSuspendedAt()
+	})
+}
+
+// SetNotes sets the "notes" field.
+func (u *PostpaidAccountUpsertBulk) SetNotes(v string) *PostpaidAccountUpsertBulk {
+	return u.Update(func(s *PostpaidAccountUpsert) {
+		s.SetNotes(v)
+	})
+}
+
+// UpdateNotes sets the "notes" field to the value that was provided on create.
+func (u *PostpaidAccountUpsertBulk) UpdateNotes() *PostpaidAccountUpsertBulk {
+	return u.Update(func(s *PostpaidAccountUpsert) {
+		s.UpdateNotes()
+	})
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (u *PostpaidAccountUpsertBulk) ClearNotes() *PostpaidAccountUpsertBulk {
+	return u.Update(func(s *PostpaidAccountUpsert) {
+		s.ClearNotes()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *PostpaidAccountUpsertBulk) SetUpdatedAt(v time.Time) *PostpaidAccountUpsertBulk {
+	return u.Update(func(s *PostpaidAccountUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *PostpaidAccountUpsertBulk) UpdateUpdatedAt() *PostpaidAccountUpsertBulk {
+	return u.Update(func(s *PostpaidAccountUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PostpaidAccountUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PostpaidAccountCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PostpaidAccountCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PostpaidAccountUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
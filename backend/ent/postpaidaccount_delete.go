@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Wei-Shaw/sub2api/ent/postpaidaccount"
+	"github.com/Wei-Shaw/sub2api/ent/predicate"
+)
+
+// PostpaidAccountDelete is the builder for deleting a PostpaidAccount entity.
+type PostpaidAccountDelete struct {
+	config
+	hooks    []Hook
+	mutation *PostpaidAccountMutation
+}
+
+// Where appends a list predicates to the PostpaidAccountDelete builder.
+func (_d *PostpaidAccountDelete) Where(ps ...predicate.PostpaidAccount) *PostpaidAccountDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *PostpaidAccountDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *PostpaidAccountDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *PostpaidAccountDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(postpaidaccount.Table, sqlgraph.NewFieldSpec(postpaidaccount.FieldID, field.TypeInt64))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// PostpaidAccountDeleteOne is the builder for deleting a single PostpaidAccount entity.
+type PostpaidAccountDeleteOne struct {
+	_d *PostpaidAccountDelete
+}
+
+// Where appends a list predicates to the PostpaidAccountDelete builder.
+func (_d *PostpaidAccountDeleteOne) Where(ps ...predicate.PostpaidAccount) *PostpaidAccountDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *PostpaidAccountDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{postpaidaccount.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *PostpaidAccountDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,572 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Wei-Shaw/sub2api/ent/postpaidaccount"
+	"github.com/Wei-Shaw/sub2api/ent/predicate"
+)
+
+// PostpaidAccountUpdate is the builder for updating PostpaidAccount entities.
+type PostpaidAccountUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PostpaidAccountMutation
+}
+
+// Where appends a list predicates to the PostpaidAccountUpdate builder.
+func (_u *PostpaidAccountUpdate) Where(ps ...predicate.PostpaidAccount) *PostpaidAccountUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *PostpaidAccountUpdate) SetUserID(v int64) *PostpaidAccountUpdate {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *PostpaidAccountUpdate) SetNillableUserID(v *int64) *PostpaidAccountUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *PostpaidAccountUpdate) AddUserID(v int64) *PostpaidAccountUpdate {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetCreditLimit sets the "credit_limit" field.
+func (_u *PostpaidAccountUpdate) SetCreditLimit(v float64) *PostpaidAccountUpdate {
+	_u.mutation.ResetCreditLimit()
+	_u.mutation.SetCreditLimit(v)
+	return _u
+}
+
+// SetNillableCreditLimit sets the "credit_limit" field if the given value is not nil.
+func (_u *PostpaidAccountUpdate) SetNillableCreditLimit(v *float64) *PostpaidAccountUpdate {
+	if v != nil {
+		_u.SetCreditLimit(*v)
+	}
+	return _u
+}
+
+// AddCreditLimit adds value to the "credit_limit" field.
+func (_u *PostpaidAccountUpdate) AddCreditLimit(v float64) *PostpaidAccountUpdate {
+	_u.mutation.AddCreditLimit(v)
+	return _u
+}
+
+// SetPaymentTermsDays sets the "payment_terms_days" field.
+func (_u *PostpaidAccountUpdate) SetPaymentTermsDays(v int) *PostpaidAccountUpdate {
+	_u.mutation.ResetPaymentTermsDays()
+	_u.mutation.SetPaymentTermsDays(v)
+	return _u
+}
+
+// SetNillablePaymentTermsDays sets the "payment_terms_days" field if the given value is not nil.
+func (_u *PostpaidAccountUpdate) SetNillablePaymentTermsDays(v *int) *PostpaidAccountUpdate {
+	if v != nil {
+		_u.SetPaymentTermsDays(*v)
+	}
+	return _u
+}
+
+// AddPaymentTermsDays adds value to the "payment_terms_days" field.
+func (_u *PostpaidAccountUpdate) AddPaymentTermsDays(v int) *PostpaidAccountUpdate {
+	_u.mutation.AddPaymentTermsDays(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *PostpaidAccountUpdate) SetStatus(v string) *PostpaidAccountUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *PostpaidAccountUpdate) SetNillableStatus(v *string) *PostpaidAccountUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetBillingStart sets the "billing_start" field.
+func (_u *PostpaidAccountUpdate) SetBillingStart(v time.Time) *PostpaidAccountUpdate {
+	_u.mutation.SetBillingStart(v)
+	return _u
+}
+
+// SetNillableBillingStart sets the "billing_start" field if the given value is not nil.
+func (_u *PostpaidAccountUpdate) SetNillableBillingStart(v *time.Time) *PostpaidAccountUpdate {
+	if v != nil {
+		_u.SetBillingStart(*v)
+	}
+	return _u
+}
+
+// SetSuspendedAt sets the "suspended_at" field.
+func (_u *PostpaidAccountUpdate) SetSuspendedAt(v time.Time) *PostpaidAccountUpdate {
+	_u.mutation.SetSuspendedAt(v)
+	return _u
+}
+
+// SetNillableSuspendedAt sets the "suspended_at" field if the given value is not nil.
+func (_u *PostpaidAccountUpdate) SetNillableSuspendedAt(v *time.Time) *PostpaidAccountUpdate {
+	if v != nil {
+		_u.SetSuspendedAt(*v)
+	}
+	return _u
+}
+
+// ClearSuspendedAt clears the value of the "suspended_at" field.
+func (_u *PostpaidAccountUpdate) ClearSuspendedAt() *PostpaidAccountUpdate {
+	_u.mutation.ClearSuspendedAt()
+	return _u
+}
+
+// SetNotes sets the "notes" field.
+func (_u *PostpaidAccountUpdate) SetNotes(v string) *PostpaidAccountUpdate {
+	_u.mutation.SetNotes(v)
+	return _u
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (_u *PostpaidAccountUpdate) SetNillableNotes(v *string) *PostpaidAccountUpdate {
+	if v != nil {
+		_u.SetNotes(*v)
+	}
+	return _u
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (_u *PostpaidAccountUpdate) ClearNotes() *PostpaidAccountUpdate {
+	_u.mutation.ClearNotes()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PostpaidAccountUpdate) SetUpdatedAt(v time.Time) *PostpaidAccountUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the PostpaidAccountMutation object of the builder.
+func (_u *PostpaidAccountUpdate) Mutation() *PostpaidAccountMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PostpaidAccountUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PostpaidAccountUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PostpaidAccountUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PostpaidAccountUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PostpaidAccountUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := postpaidaccount.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PostpaidAccountUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := postpaidaccount.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "PostpaidAccount.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PostpaidAccountUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(postpaidaccount.Table, postpaidaccount.Columns, sqlgraph.NewFieldSpec(postpaidaccount.FieldID, field.TypeInt64))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(postpaidaccount.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(postpaidaccount.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.CreditLimit(); ok {
+		_spec.SetField(postpaidaccount.FieldCreditLimit, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedCreditLimit(); ok {
+		_spec.AddField(postpaidaccount.FieldCreditLimit, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.PaymentTermsDays(); ok {
+		_spec.SetField(postpaidaccount.FieldPaymentTermsDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPaymentTermsDays(); ok {
+		_spec.AddField(postpaidaccount.FieldPaymentTermsDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(postpaidaccount.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BillingStart(); ok {
+		_spec.SetField(postpaidaccount.FieldBillingStart, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.SuspendedAt(); ok {
+		_spec.SetField(postpaidaccount.FieldSuspendedAt, field.TypeTime, value)
+	}
+	if _u.mutation.SuspendedAtCleared() {
+		_spec.ClearField(postpaidaccount.FieldSuspendedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Notes(); ok {
+		_spec.SetField(postpaidaccount.FieldNotes, field.TypeString, value)
+	}
+	if _u.mutation.NotesCleared() {
+		_spec.ClearField(postpaidaccount.FieldNotes, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(postpaidaccount.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{postpaidaccount.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PostpaidAccountUpdateOne is the builder for updating a single PostpaidAccount entity.
+type PostpaidAccountUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PostpaidAccountMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *PostpaidAccountUpdateOne) SetUserID(v int64) *PostpaidAccountUpdateOne {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *PostpaidAccountUpdateOne) SetNillableUserID(v *int64) *PostpaidAccountUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *PostpaidAccountUpdateOne) AddUserID(v int64) *PostpaidAccountUpdateOne {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetCreditLimit sets the "credit_limit" field.
+func (_u *PostpaidAccountUpdateOne) SetCreditLimit(v float64) *PostpaidAccountUpdateOne {
+	_u.mutation.ResetCreditLimit()
+	_u.mutation.SetCreditLimit(v)
+	return _u
+}
+
+// SetNillableCreditLimit sets the "credit_limit" field if the given value is not nil.
+func (_u *PostpaidAccountUpdateOne) SetNillableCreditLimit(v *float64) *PostpaidAccountUpdateOne {
+	if v != nil {
+		_u.SetCreditLimit(*v)
+	}
+	return _u
+}
+
+// AddCreditLimit adds value to the "credit_limit" field.
+func (_u *PostpaidAccountUpdateOne) AddCreditLimit(v float64) *PostpaidAccountUpdateOne {
+	_u.mutation.AddCreditLimit(v)
+	return _u
+}
+
+// SetPaymentTermsDays sets the "payment_terms_days" field.
+func (_u *PostpaidAccountUpdateOne) SetPaymentTermsDays(v int) *PostpaidAccountUpdateOne {
+	_u.mutation.ResetPaymentTermsDays()
+	_u.mutation.SetPaymentTermsDays(v)
+	return _u
+}
+
+// SetNillablePaymentTermsDays sets the "payment_terms_days" field if the given value is not nil.
+func (_u *PostpaidAccountUpdateOne) SetNillablePaymentTermsDays(v *int) *PostpaidAccountUpdateOne {
+	if v != nil {
+		_u.SetPaymentTermsDays(*v)
+	}
+	return _u
+}
+
+// AddPaymentTermsDays adds value to the "payment_terms_days" field.
+func (_u *PostpaidAccountUpdateOne) AddPaymentTermsDays(v int) *PostpaidAccountUpdateOne {
+	_u.mutation.AddPaymentTermsDays(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *PostpaidAccountUpdateOne) SetStatus(v string) *PostpaidAccountUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *PostpaidAccountUpdateOne) SetNillableStatus(v *string) *PostpaidAccountUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetBillingStart sets the "billing_start" field.
+func (_u *PostpaidAccountUpdateOne) SetBillingStart(v time.Time) *PostpaidAccountUpdateOne {
+	_u.mutation.SetBillingStart(v)
+	return _u
+}
+
+// SetNillableBillingStart sets the "billing_start" field if the given value is not nil.
+func (_u *PostpaidAccountUpdateOne) SetNillableBillingStart(v *time.Time) *PostpaidAccountUpdateOne {
+	if v != nil {
+		_u.SetBillingStart(*v)
+	}
+	return _u
+}
+
+// SetSuspendedAt sets the "suspended_at" field.
+func (_u *PostpaidAccountUpdateOne) SetSuspendedAt(v time.Time) *PostpaidAccountUpdateOne {
+	_u.mutation.SetSuspendedAt(v)
+	return _u
+}
+
+// SetNillableSuspendedAt sets the "suspended_at" field if the given value is not nil.
+func (_u *PostpaidAccountUpdateOne) SetNillableSuspendedAt(v *time.Time) *PostpaidAccountUpdateOne {
+	if v != nil {
+		_u.SetSuspendedAt(*v)
+	}
+	return _u
+}
+
+// ClearSuspendedAt clears the value of the "suspended_at" field.
+func (_u *PostpaidAccountUpdateOne) ClearSuspendedAt() *PostpaidAccountUpdateOne {
+	_u.mutation.ClearSuspendedAt()
+	return _u
+}
+
+// SetNotes sets the "notes" field.
+func (_u *PostpaidAccountUpdateOne) SetNotes(v string) *PostpaidAccountUpdateOne {
+	_u.mutation.SetNotes(v)
+	return _u
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (_u *PostpaidAccountUpdateOne) SetNillableNotes(v *string) *PostpaidAccountUpdateOne {
+	if v != nil {
+		_u.SetNotes(*v)
+	}
+	return _u
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (_u *PostpaidAccountUpdateOne) ClearNotes() *PostpaidAccountUpdateOne {
+	_u.mutation.ClearNotes()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PostpaidAccountUpdateOne) SetUpdatedAt(v time.Time) *PostpaidAccountUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the PostpaidAccountMutation object of the builder.
+func (_u *PostpaidAccountUpdateOne) Mutation() *PostpaidAccountMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the PostpaidAccountUpdate builder.
+func (_u *PostpaidAccountUpdateOne) Where(ps ...predicate.PostpaidAccount) *PostpaidAccountUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PostpaidAccountUpdateOne) Select(field string, fields ...string) *PostpaidAccountUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PostpaidAccount entity.
+func (_u *PostpaidAccountUpdateOne) Save(ctx context.Context) (*PostpaidAccount, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PostpaidAccountUpdateOne) SaveX(ctx context.Context) *PostpaidAccount {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PostpaidAccountUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PostpaidAccountUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PostpaidAccountUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := postpaidaccount.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PostpaidAccountUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := postpaidaccount.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "PostpaidAccount.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PostpaidAccountUpdateOne) sqlSave(ctx context.Context) (_node *PostpaidAccount, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(postpaidaccount.Table, postpaidaccount.Columns, sqlgraph.NewFieldSpec(postpaidaccount.FieldID, field.TypeInt64))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PostpaidAccount.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, postpaidaccount.FieldID)
+		for _, f := range fields {
+			if !postpaidaccount.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != postpaidaccount.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(postpaidaccount.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(postpaidaccount.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.CreditLimit(); ok {
+		_spec.SetField(postpaidaccount.FieldCreditLimit, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedCreditLimit(); ok {
+		_spec.AddField(postpaidaccount.FieldCreditLimit, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.PaymentTermsDays(); ok {
+		_spec.SetField(postpaidaccount.FieldPaymentTermsDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPaymentTermsDays(); ok {
+		_spec.AddField(postpaidaccount.FieldPaymentTermsDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(postpaidaccount.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BillingStart(); ok {
+		_spec.SetField(postpaidaccount.FieldBillingStart, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.SuspendedAt(); ok {
+		_spec.SetField(postpaidaccount.FieldSuspendedAt, field.TypeTime, value)
+	}
+	if _u.mutation.SuspendedAtCleared() {
+		_spec.ClearField(postpaidaccount.FieldSuspendedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Notes(); ok {
+		_spec.SetField(postpaidaccount.FieldNotes, field.TypeString, value)
+	}
+	if _u.mutation.NotesCleared() {
+		_spec.ClearField(postpaidaccount.FieldNotes, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(postpaidaccount.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &PostpaidAccount{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{postpaidaccount.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
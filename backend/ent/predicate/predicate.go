@@ -21,6 +21,18 @@ type Announcement func(*sql.Selector)
 // AnnouncementRead is the predicate function for announcementread builders.
 type AnnouncementRead func(*sql.Selector)
 
+// BalanceBucket is the predicate function for balancebucket builders.
+type BalanceBucket func(*sql.Selector)
+
+// BillingAdjustment is the predicate function for billingadjustment builders.
+type BillingAdjustment func(*sql.Selector)
+
+// BillingRecomputeJob is the predicate function for billingrecomputejob builders.
+type BillingRecomputeJob func(*sql.Selector)
+
+// BillingStatement is the predicate function for billingstatement builders.
+type BillingStatement func(*sql.Selector)
+
 // ChannelMonitor is the predicate function for channelmonitor builders.
 type ChannelMonitor func(*sql.Selector)
 
@@ -33,27 +45,51 @@ type ChannelMonitorHistory func(*sql.Selector)
 // ChannelMonitorRequestTemplate is the predicate function for channelmonitorrequesttemplate builders.
 type ChannelMonitorRequestTemplate func(*sql.Selector)
 
+// CommissionWithdrawal is the predicate function for commissionwithdrawal builders.
+type CommissionWithdrawal func(*sql.Selector)
+
+// CommissionWithdrawalLog is the predicate function for commissionwithdrawallog builders.
+type CommissionWithdrawalLog func(*sql.Selector)
+
 // ErrorPassthroughRule is the predicate function for errorpassthroughrule builders.
 type ErrorPassthroughRule func(*sql.Selector)
 
+// GatewayPlugin is the predicate function for gatewayplugin builders.
+type GatewayPlugin func(*sql.Selector)
+
 // Group is the predicate function for group builders.
 type Group func(*sql.Selector)
 
+// GuardrailRule is the predicate function for guardrailrule builders.
+type GuardrailRule func(*sql.Selector)
+
 // IdempotencyRecord is the predicate function for idempotencyrecord builders.
 type IdempotencyRecord func(*sql.Selector)
 
+// Invoice is the predicate function for invoice builders.
+type Invoice func(*sql.Selector)
+
+// InvoiceSequence is the predicate function for invoicesequence builders.
+type InvoiceSequence func(*sql.Selector)
+
 // PaygOrder is the predicate function for paygorder builders.
 type PaygOrder func(*sql.Selector)
 
 // PaymentAuditLog is the predicate function for paymentauditlog builders.
 type PaymentAuditLog func(*sql.Selector)
 
+// PaymentCoupon is the predicate function for paymentcoupon builders.
+type PaymentCoupon func(*sql.Selector)
+
 // PaymentOrder is the predicate function for paymentorder builders.
 type PaymentOrder func(*sql.Selector)
 
 // PaymentProviderInstance is the predicate function for paymentproviderinstance builders.
 type PaymentProviderInstance func(*sql.Selector)
 
+// PostpaidAccount is the predicate function for postpaidaccount builders.
+type PostpaidAccount func(*sql.Selector)
+
 // PromoCode is the predicate function for promocode builders.
 type PromoCode func(*sql.Selector)
 
@@ -63,18 +99,30 @@ type PromoCodeUsage func(*sql.Selector)
 // Proxy is the predicate function for proxy builders.
 type Proxy func(*sql.Selector)
 
+// ProxyPool is the predicate function for proxypool builders.
+type ProxyPool func(*sql.Selector)
+
 // RedeemCode is the predicate function for redeemcode builders.
 type RedeemCode func(*sql.Selector)
 
+// ReferralCommission is the predicate function for referralcommission builders.
+type ReferralCommission func(*sql.Selector)
+
 // ReferralReward is the predicate function for referralreward builders.
 type ReferralReward func(*sql.Selector)
 
+// RequestTransformRule is the predicate function for requesttransformrule builders.
+type RequestTransformRule func(*sql.Selector)
+
 // SecuritySecret is the predicate function for securitysecret builders.
 type SecuritySecret func(*sql.Selector)
 
 // Setting is the predicate function for setting builders.
 type Setting func(*sql.Selector)
 
+// SubscriptionAutoRenewal is the predicate function for subscriptionautorenewal builders.
+type SubscriptionAutoRenewal func(*sql.Selector)
+
 // SubscriptionPlan is the predicate function for subscriptionplan builders.
 type SubscriptionPlan func(*sql.Selector)
 
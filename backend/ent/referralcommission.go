@@ -0,0 +1,257 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Wei-Shaw/sub2api/ent/referralcommission"
+)
+
+// ReferralCommission is the model entity for the ReferralCommission schema.
+type ReferralCommission struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// ReferrerID holds the value of the "referrer_id" field.
+	ReferrerID int64 `json:"referrer_id,omitempty"`
+	// RefereeID holds the value of the "referee_id" field.
+	RefereeID int64 `json:"referee_id,omitempty"`
+	// RewardID holds the value of the "reward_id" field.
+	RewardID *int64 `json:"reward_id,omitempty"`
+	// SourceType holds the value of the "source_type" field.
+	SourceType string `json:"source_type,omitempty"`
+	// SourceID holds the value of the "source_id" field.
+	SourceID int64 `json:"source_id,omitempty"`
+	// SourceAmount holds the value of the "source_amount" field.
+	SourceAmount float64 `json:"source_amount,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount float64 `json:"amount,omitempty"`
+	// ClawedBackAmount holds the value of the "clawed_back_amount" field.
+	ClawedBackAmount float64 `json:"clawed_back_amount,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// AvailableAt holds the value of the "available_at" field.
+	AvailableAt time.Time `json:"available_at,omitempty"`
+	// ClawedBackAt holds the value of the "clawed_back_at" field.
+	ClawedBackAt *time.Time `json:"clawed_back_at,omitempty"`
+	// ClawbackReason holds the value of the "clawback_reason" field.
+	ClawbackReason string `json:"clawback_reason,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ReferralCommission) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case referralcommission.FieldSourceAmount, referralcommission.FieldAmount, referralcommission.FieldClawedBackAmount:
+			values[i] = new(sql.NullFloat64)
+		case referralcommission.FieldID, referralcommission.FieldReferrerID, referralcommission.FieldRefereeID, referralcommission.FieldRewardID, referralcommission.FieldSourceID:
+			values[i] = new(sql.NullInt64)
+		case referralcommission.FieldSourceType, referralcommission.FieldStatus, referralcommission.FieldClawbackReason:
+			values[i] = new(sql.NullString)
+		case referralcommission.FieldAvailableAt, referralcommission.FieldClawedBackAt, referralcommission.FieldCreatedAt, referralcommission.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ReferralCommission fields.
+func (_m *ReferralCommission) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case referralcommission.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int64(value.Int64)
+		case referralcommission.FieldReferrerID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field referrer_id", values[i])
+			} else if value.Valid {
+				_m.ReferrerID = value.Int64
+			}
+		case referralcommission.FieldRefereeID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field referee_id", values[i])
+			} else if value.Valid {
+				_m.RefereeID = value.Int64
+			}
+		case referralcommission.FieldRewardID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field reward_id", values[i])
+			} else if value.Valid {
+				_m.RewardID = new(int64)
+				*_m.RewardID = value.Int64
+			}
+		case referralcommission.FieldSourceType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source_type", values[i])
+			} else if value.Valid {
+				_m.SourceType = value.String
+			}
+		case referralcommission.FieldSourceID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field source_id", values[i])
+			} else if value.Valid {
+				_m.SourceID = value.Int64
+			}
+		case referralcommission.FieldSourceAmount:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field source_amount", values[i])
+			} else if value.Valid {
+				_m.SourceAmount = value.Float64
+			}
+		case referralcommission.FieldAmount:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value.Valid {
+				_m.Amount = value.Float64
+			}
+		case referralcommission.FieldClawedBackAmount:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field clawed_back_amount", values[i])
+			} else if value.Valid {
+				_m.ClawedBackAmount = value.Float64
+			}
+		case referralcommission.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = value.String
+			}
+		case referralcommission.FieldAvailableAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field available_at", values[i])
+			} else if value.Valid {
+				_m.AvailableAt = value.Time
+			}
+		case referralcommission.FieldClawedBackAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field clawed_back_at", values[i])
+			} else if value.Valid {
+				_m.ClawedBackAt = new(time.Time)
+				*_m.ClawedBackAt = value.Time
+			}
+		case referralcommission.FieldClawbackReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field clawback_reason", values[i])
+			} else if value.Valid {
+				_m.ClawbackReason = value.String
+			}
+		case referralcommission.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case referralcommission.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ReferralCommission.
+// This includes values selected through modifiers, order, etc.
+func (_m *ReferralCommission) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ReferralCommission.
+// Note that you need to call ReferralCommission.Unwrap() before calling this method if this ReferralCommission
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ReferralCommission) Update() *ReferralCommissionUpdateOne {
+	return NewReferralCommissionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ReferralCommission entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ReferralCommission) Unwrap() *ReferralCommission {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ReferralCommission is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ReferralCommission) String() string {
+	var builder strings.Builder
+	builder.WriteString("ReferralCommission(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("referrer_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ReferrerID))
+	builder.WriteString(", ")
+	builder.WriteString("referee_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RefereeID))
+	builder.WriteString(", ")
+	if v := _m.RewardID; v != nil {
+		builder.WriteString("reward_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("source_type=")
+	builder.WriteString(_m.SourceType)
+	builder.WriteString(", ")
+	builder.WriteString("source_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SourceID))
+	builder.WriteString(", ")
+	builder.WriteString("source_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SourceAmount))
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("clawed_back_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ClawedBackAmount))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(_m.Status)
+	builder.WriteString(", ")
+	builder.WriteString("available_at=")
+	builder.WriteString(_m.AvailableAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.ClawedBackAt; v != nil {
+		builder.WriteString("clawed_back_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("clawback_reason=")
+	builder.WriteString(_m.ClawbackReason)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ReferralCommissions is a parsable slice of ReferralCommission.
+type ReferralCommissions []*ReferralCommission
@@ -0,0 +1,172 @@
+// Code generated by ent, DO NOT EDIT.
+
+package referralcommission
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the referralcommission type in the database.
+	Label = "referral_commission"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldReferrerID holds the string denoting the referrer_id field in the database.
+	FieldReferrerID = "referrer_id"
+	// FieldRefereeID holds the string denoting the referee_id field in the database.
+	FieldRefereeID = "referee_id"
+	// FieldRewardID holds the string denoting the reward_id field in the database.
+	FieldRewardID = "reward_id"
+	// FieldSourceType holds the string denoting the source_type field in the database.
+	FieldSourceType = "source_type"
+	// FieldSourceID holds the string denoting the source_id field in the database.
+	FieldSourceID = "source_id"
+	// FieldSourceAmount holds the string denoting the source_amount field in the database.
+	FieldSourceAmount = "source_amount"
+	// FieldAmount holds the string denoting the amount field in the database.
+	FieldAmount = "amount"
+	// FieldClawedBackAmount holds the string denoting the clawed_back_amount field in the database.
+	FieldClawedBackAmount = "clawed_back_amount"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldAvailableAt holds the string denoting the available_at field in the database.
+	FieldAvailableAt = "available_at"
+	// FieldClawedBackAt holds the string denoting the clawed_back_at field in the database.
+	FieldClawedBackAt = "clawed_back_at"
+	// FieldClawbackReason holds the string denoting the clawback_reason field in the database.
+	FieldClawbackReason = "clawback_reason"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the referralcommission in the database.
+	Table = "referral_commissions"
+)
+
+// Columns holds all SQL columns for referralcommission fields.
+var Columns = []string{
+	FieldID,
+	FieldReferrerID,
+	FieldRefereeID,
+	FieldRewardID,
+	FieldSourceType,
+	FieldSourceID,
+	FieldSourceAmount,
+	FieldAmount,
+	FieldClawedBackAmount,
+	FieldStatus,
+	FieldAvailableAt,
+	FieldClawedBackAt,
+	FieldClawbackReason,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// SourceTypeValidator is a validator for the "source_type" field. It is called by the builders before save.
+	SourceTypeValidator func(string) error
+	// DefaultSourceAmount holds the default value on creation for the "source_amount" field.
+	DefaultSourceAmount float64
+	// DefaultClawedBackAmount holds the default value on creation for the "clawed_back_amount" field.
+	DefaultClawedBackAmount float64
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+	// StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	StatusValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the ReferralCommission queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByReferrerID orders the results by the referrer_id field.
+func ByReferrerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReferrerID, opts...).ToFunc()
+}
+
+// ByRefereeID orders the results by the referee_id field.
+func ByRefereeID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRefereeID, opts...).ToFunc()
+}
+
+// ByRewardID orders the results by the reward_id field.
+func ByRewardID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRewardID, opts...).ToFunc()
+}
+
+// BySourceType orders the results by the source_type field.
+func BySourceType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSourceType, opts...).ToFunc()
+}
+
+// BySourceID orders the results by the source_id field.
+func BySourceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSourceID, opts...).ToFunc()
+}
+
+// BySourceAmount orders the results by the source_amount field.
+func BySourceAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSourceAmount, opts...).ToFunc()
+}
+
+// ByAmount orders the results by the amount field.
+func ByAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAmount, opts...).ToFunc()
+}
+
+// ByClawedBackAmount orders the results by the clawed_back_amount field.
+func ByClawedBackAmount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClawedBackAmount, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByAvailableAt orders the results by the available_at field.
+func ByAvailableAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAvailableAt, opts...).ToFunc()
+}
+
+// ByClawedBackAt orders the results by the clawed_back_at field.
+func ByClawedBackAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClawedBackAt, opts...).ToFunc()
+}
+
+// ByClawbackReason orders the results by the clawback_reason field.
+func ByClawbackReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClawbackReason, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,805 @@
+// Code generated by ent, DO NOT EDIT.
+
+package referralcommission
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Wei-Shaw/sub2api/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldLTE(FieldID, id))
+}
+
+// ReferrerID applies equality check predicate on the "referrer_id" field. It's identical to ReferrerIDEQ.
+func ReferrerID(v int64) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldEQ(FieldReferrerID, v))
+}
+
+// RefereeID applies equality check predicate on the "referee_id" field. It's identical to RefereeIDEQ.
+func RefereeID(v int64) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldEQ(FieldRefereeID, v))
+}
+
+// RewardID applies equality check predicate on the "reward_id" field. It's identical to RewardIDEQ.
+func RewardID(v int64) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldEQ(FieldRewardID, v))
+}
+
+// SourceType applies equality check predicate on the "source_type" field. It's identical to SourceTypeEQ.
+func SourceType(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldEQ(FieldSourceType, v))
+}
+
+// SourceID applies equality check predicate on the "source_id" field. It's identical to SourceIDEQ.
+func SourceID(v int64) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldEQ(FieldSourceID, v))
+}
+
+// SourceAmount applies equality check predicate on the "source_amount" field. It's identical to SourceAmountEQ.
+func SourceAmount(v float64) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldEQ(FieldSourceAmount, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v float64) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldEQ(FieldAmount, v))
+}
+
+// ClawedBackAmount applies equality check predicate on the "clawed_back_amount" field. It's identical to ClawedBackAmountEQ.
+func ClawedBackAmount(v float64) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldEQ(FieldClawedBackAmount, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldEQ(FieldStatus, v))
+}
+
+// AvailableAt applies equality check predicate on the "available_at" field. It's identical to AvailableAtEQ.
+func AvailableAt(v time.Time) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldEQ(FieldAvailableAt, v))
+}
+
+// ClawedBackAt applies equality check predicate on the "clawed_back_at" field. It's identical to ClawedBackAtEQ.
+func ClawedBackAt(v time.Time) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldEQ(FieldClawedBackAt, v))
+}
+
+// ClawbackReason applies equality check predicate on the "clawback_reason" field. It's identical to ClawbackReasonEQ.
+func ClawbackReason(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldEQ(FieldClawbackReason, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// ReferrerIDEQ applies the EQ predicate on the "referrer_id" field.
+func ReferrerIDEQ(v int64) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldEQ(FieldReferrerID, v))
+}
+
+// ReferrerIDNEQ applies the NEQ predicate on the "referrer_id" field.
+func ReferrerIDNEQ(v int64) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldNEQ(FieldReferrerID, v))
+}
+
+// ReferrerIDIn applies the In predicate on the "referrer_id" field.
+func ReferrerIDIn(vs ...int64) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldIn(FieldReferrerID, vs...))
+}
+
+// ReferrerIDNotIn applies the NotIn predicate on the "referrer_id" field.
+func ReferrerIDNotIn(vs ...int64) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldNotIn(FieldReferrerID, vs...))
+}
+
+// ReferrerIDGT applies the GT predicate on the "referrer_id" field.
+func ReferrerIDGT(v int64) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldGT(FieldReferrerID, v))
+}
+
+// ReferrerIDGTE applies the GTE predicate on the "referrer_id" field.
+func ReferrerIDGTE(v int64) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldGTE(FieldReferrerID, v))
+}
+
+// ReferrerIDLT applies the LT predicate on the "referrer_id" field.
+func ReferrerIDLT(v int64) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldLT(FieldReferrerID, v))
+}
+
+// ReferrerIDLTE applies the LTE predicate on the "referrer_id" field.
+func ReferrerIDLTE(v int64) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldLTE(FieldReferrerID, v))
+}
+
+// RefereeIDEQ applies the EQ predicate on the "referee_id" field.
+func RefereeIDEQ(v int64) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldEQ(FieldRefereeID, v))
+}
+
+// RefereeIDNEQ applies the NEQ predicate on the "referee_id" field.
+func RefereeIDNEQ(v int64) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldNEQ(FieldRefereeID, v))
+}
+
+// RefereeIDIn applies the In predicate on the "referee_id" field.
+func RefereeIDIn(vs ...int64) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldIn(FieldRefereeID, vs...))
+}
+
+// RefereeIDNotIn applies the NotIn predicate on the "referee_id" field.
+func RefereeIDNotIn(vs ...int64) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldNotIn(FieldRefereeID, vs...))
+}
+
+// RefereeIDGT applies the GT predicate on the "referee_id" field.
+func RefereeIDGT(v int64) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldGT(FieldRefereeID, v))
+}
+
+// RefereeIDGTE applies the GTE predicate on the "referee_id" field.
+func RefereeIDGTE(v int64) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldGTE(FieldRefereeID, v))
+}
+
+// RefereeIDLT applies the LT predicate on the "referee_id" field.
+func RefereeIDLT(v int64) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldLT(FieldRefereeID, v))
+}
+
+// RefereeIDLTE applies the LTE predicate on the "referee_id" field.
+func RefereeIDLTE(v int64) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldLTE(FieldRefereeID, v))
+}
+
+// RewardIDEQ applies the EQ predicate on the "reward_id" field.
+func RewardIDEQ(v int64) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldEQ(FieldRewardID, v))
+}
+
+// RewardIDNEQ applies the NEQ predicate on the "reward_id" field.
+func RewardIDNEQ(v int64) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldNEQ(FieldRewardID, v))
+}
+
+// RewardIDIn applies the In predicate on the "reward_id" field.
+func RewardIDIn(vs ...int64) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldIn(FieldRewardID, vs...))
+}
+
+// RewardIDNotIn applies the NotIn predicate on the "reward_id" field.
+func RewardIDNotIn(vs ...int64) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldNotIn(FieldRewardID, vs...))
+}
+
+// RewardIDGT applies the GT predicate on the "reward_id" field.
+func RewardIDGT(v int64) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldGT(FieldRewardID, v))
+}
+
+// RewardIDGTE applies the GTE predicate on the "reward_id" field.
+func RewardIDGTE(v int64) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldGTE(FieldRewardID, v))
+}
+
+// RewardIDLT applies the LT predicate on the "reward_id" field.
+func RewardIDLT(v int64) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldLT(FieldRewardID, v))
+}
+
+// RewardIDLTE applies the LTE predicate on the "reward_id" field.
+func RewardIDLTE(v int64) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldLTE(FieldRewardID, v))
+}
+
+// RewardIDIsNil applies the IsNil predicate on the "reward_id" field.
+func RewardIDIsNil() predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldIsNull(FieldRewardID))
+}
+
+// RewardIDNotNil applies the NotNil predicate on the "reward_id" field.
+func RewardIDNotNil() predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldNotNull(FieldRewardID))
+}
+
+// SourceTypeEQ applies the EQ predicate on the "source_type" field.
+func SourceTypeEQ(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldEQ(FieldSourceType, v))
+}
+
+// SourceTypeNEQ applies the NEQ predicate on the "source_type" field.
+func SourceTypeNEQ(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldNEQ(FieldSourceType, v))
+}
+
+// SourceTypeIn applies the In predicate on the "source_type" field.
+func SourceTypeIn(vs ...string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldIn(FieldSourceType, vs...))
+}
+
+// SourceTypeNotIn applies the NotIn predicate on the "source_type" field.
+func SourceTypeNotIn(vs ...string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldNotIn(FieldSourceType, vs...))
+}
+
+// SourceTypeGT applies the GT predicate on the "source_type" field.
+func SourceTypeGT(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldGT(FieldSourceType, v))
+}
+
+// SourceTypeGTE applies the GTE predicate on the "source_type" field.
+func SourceTypeGTE(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldGTE(FieldSourceType, v))
+}
+
+// SourceTypeLT applies the LT predicate on the "source_type" field.
+func SourceTypeLT(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldLT(FieldSourceType, v))
+}
+
+// SourceTypeLTE applies the LTE predicate on the "source_type" field.
+func SourceTypeLTE(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldLTE(FieldSourceType, v))
+}
+
+// SourceTypeContains applies the Contains predicate on the "source_type" field.
+func SourceTypeContains(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldContains(FieldSourceType, v))
+}
+
+// SourceTypeHasPrefix applies the HasPrefix predicate on the "source_type" field.
+func SourceTypeHasPrefix(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldHasPrefix(FieldSourceType, v))
+}
+
+// SourceTypeHasSuffix applies the HasSuffix predicate on the "source_type" field.
+func SourceTypeHasSuffix(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldHasSuffix(FieldSourceType, v))
+}
+
+// SourceTypeEqualFold applies the EqualFold predicate on the "source_type" field.
+func SourceTypeEqualFold(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldEqualFold(FieldSourceType, v))
+}
+
+// SourceTypeContainsFold applies the ContainsFold predicate on the "source_type" field.
+func SourceTypeContainsFold(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldContainsFold(FieldSourceType, v))
+}
+
+// SourceIDEQ applies the EQ predicate on the "source_id" field.
+func SourceIDEQ(v int64) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldEQ(FieldSourceID, v))
+}
+
+// SourceIDNEQ applies the NEQ predicate on the "source_id" field.
+func SourceIDNEQ(v int64) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldNEQ(FieldSourceID, v))
+}
+
+// SourceIDIn applies the In predicate on the "source_id" field.
+func SourceIDIn(vs ...int64) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldIn(FieldSourceID, vs...))
+}
+
+// SourceIDNotIn applies the NotIn predicate on the "source_id" field.
+func SourceIDNotIn(vs ...int64) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldNotIn(FieldSourceID, vs...))
+}
+
+// SourceIDGT applies the GT predicate on the "source_id" field.
+func SourceIDGT(v int64) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldGT(FieldSourceID, v))
+}
+
+// SourceIDGTE applies the GTE predicate on the "source_id" field.
+func SourceIDGTE(v int64) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldGTE(FieldSourceID, v))
+}
+
+// SourceIDLT applies the LT predicate on the "source_id" field.
+func SourceIDLT(v int64) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldLT(FieldSourceID, v))
+}
+
+// SourceIDLTE applies the LTE predicate on the "source_id" field.
+func SourceIDLTE(v int64) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldLTE(FieldSourceID, v))
+}
+
+// SourceAmountEQ applies the EQ predicate on the "source_amount" field.
+func SourceAmountEQ(v float64) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldEQ(FieldSourceAmount, v))
+}
+
+// SourceAmountNEQ applies the NEQ predicate on the "source_amount" field.
+func SourceAmountNEQ(v float64) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldNEQ(FieldSourceAmount, v))
+}
+
+// SourceAmountIn applies the In predicate on the "source_amount" field.
+func SourceAmountIn(vs ...float64) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldIn(FieldSourceAmount, vs...))
+}
+
+// SourceAmountNotIn applies the NotIn predicate on the "source_amount" field.
+func SourceAmountNotIn(vs ...float64) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldNotIn(FieldSourceAmount, vs...))
+}
+
+// SourceAmountGT applies the GT predicate on the "source_amount" field.
+func SourceAmountGT(v float64) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldGT(FieldSourceAmount, v))
+}
+
+// SourceAmountGTE applies the GTE predicate on the "source_amount" field.
+func SourceAmountGTE(v float64) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldGTE(FieldSourceAmount, v))
+}
+
+// SourceAmountLT applies the LT predicate on the "source_amount" field.
+func SourceAmountLT(v float64) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldLT(FieldSourceAmount, v))
+}
+
+// SourceAmountLTE applies the LTE predicate on the "source_amount" field.
+func SourceAmountLTE(v float64) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldLTE(FieldSourceAmount, v))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v float64) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v float64) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...float64) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...float64) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v float64) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v float64) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v float64) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v float64) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldLTE(FieldAmount, v))
+}
+
+// ClawedBackAmountEQ applies the EQ predicate on the "clawed_back_amount" field.
+func ClawedBackAmountEQ(v float64) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldEQ(FieldClawedBackAmount, v))
+}
+
+// ClawedBackAmountNEQ applies the NEQ predicate on the "clawed_back_amount" field.
+func ClawedBackAmountNEQ(v float64) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldNEQ(FieldClawedBackAmount, v))
+}
+
+// ClawedBackAmountIn applies the In predicate on the "clawed_back_amount" field.
+func ClawedBackAmountIn(vs ...float64) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldIn(FieldClawedBackAmount, vs...))
+}
+
+// ClawedBackAmountNotIn applies the NotIn predicate on the "clawed_back_amount" field.
+func ClawedBackAmountNotIn(vs ...float64) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldNotIn(FieldClawedBackAmount, vs...))
+}
+
+// ClawedBackAmountGT applies the GT predicate on the "clawed_back_amount" field.
+func ClawedBackAmountGT(v float64) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldGT(FieldClawedBackAmount, v))
+}
+
+// ClawedBackAmountGTE applies the GTE predicate on the "clawed_back_amount" field.
+func ClawedBackAmountGTE(v float64) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldGTE(FieldClawedBackAmount, v))
+}
+
+// ClawedBackAmountLT applies the LT predicate on the "clawed_back_amount" field.
+func ClawedBackAmountLT(v float64) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldLT(FieldClawedBackAmount, v))
+}
+
+// ClawedBackAmountLTE applies the LTE predicate on the "clawed_back_amount" field.
+func ClawedBackAmountLTE(v float64) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldLTE(FieldClawedBackAmount, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// AvailableAtEQ applies the EQ predicate on the "available_at" field.
+func AvailableAtEQ(v time.Time) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldEQ(FieldAvailableAt, v))
+}
+
+// AvailableAtNEQ applies the NEQ predicate on the "available_at" field.
+func AvailableAtNEQ(v time.Time) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldNEQ(FieldAvailableAt, v))
+}
+
+// AvailableAtIn applies the In predicate on the "available_at" field.
+func AvailableAtIn(vs ...time.Time) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldIn(FieldAvailableAt, vs...))
+}
+
+// AvailableAtNotIn applies the NotIn predicate on the "available_at" field.
+func AvailableAtNotIn(vs ...time.Time) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldNotIn(FieldAvailableAt, vs...))
+}
+
+// AvailableAtGT applies the GT predicate on the "available_at" field.
+func AvailableAtGT(v time.Time) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldGT(FieldAvailableAt, v))
+}
+
+// AvailableAtGTE applies the GTE predicate on the "available_at" field.
+func AvailableAtGTE(v time.Time) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldGTE(FieldAvailableAt, v))
+}
+
+// AvailableAtLT applies the LT predicate on the "available_at" field.
+func AvailableAtLT(v time.Time) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldLT(FieldAvailableAt, v))
+}
+
+// AvailableAtLTE applies the LTE predicate on the "available_at" field.
+func AvailableAtLTE(v time.Time) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldLTE(FieldAvailableAt, v))
+}
+
+// ClawedBackAtEQ applies the EQ predicate on the "clawed_back_at" field.
+func ClawedBackAtEQ(v time.Time) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldEQ(FieldClawedBackAt, v))
+}
+
+// ClawedBackAtNEQ applies the NEQ predicate on the "clawed_back_at" field.
+func ClawedBackAtNEQ(v time.Time) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldNEQ(FieldClawedBackAt, v))
+}
+
+// ClawedBackAtIn applies the In predicate on the "clawed_back_at" field.
+func ClawedBackAtIn(vs ...time.Time) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldIn(FieldClawedBackAt, vs...))
+}
+
+// ClawedBackAtNotIn applies the NotIn predicate on the "clawed_back_at" field.
+func ClawedBackAtNotIn(vs ...time.Time) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldNotIn(FieldClawedBackAt, vs...))
+}
+
+// ClawedBackAtGT applies the GT predicate on the "clawed_back_at" field.
+func ClawedBackAtGT(v time.Time) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldGT(FieldClawedBackAt, v))
+}
+
+// ClawedBackAtGTE applies the GTE predicate on the "clawed_back_at" field.
+func ClawedBackAtGTE(v time.Time) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldGTE(FieldClawedBackAt, v))
+}
+
+// ClawedBackAtLT applies the LT predicate on the "clawed_back_at" field.
+func ClawedBackAtLT(v time.Time) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldLT(FieldClawedBackAt, v))
+}
+
+// ClawedBackAtLTE applies the LTE predicate on the "clawed_back_at" field.
+func ClawedBackAtLTE(v time.Time) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldLTE(FieldClawedBackAt, v))
+}
+
+// ClawedBackAtIsNil applies the IsNil predicate on the "clawed_back_at" field.
+func ClawedBackAtIsNil() predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldIsNull(FieldClawedBackAt))
+}
+
+// ClawedBackAtNotNil applies the NotNil predicate on the "clawed_back_at" field.
+func ClawedBackAtNotNil() predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldNotNull(FieldClawedBackAt))
+}
+
+// ClawbackReasonEQ applies the EQ predicate on the "clawback_reason" field.
+func ClawbackReasonEQ(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldEQ(FieldClawbackReason, v))
+}
+
+// ClawbackReasonNEQ applies the NEQ predicate on the "clawback_reason" field.
+func ClawbackReasonNEQ(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldNEQ(FieldClawbackReason, v))
+}
+
+// ClawbackReasonIn applies the In predicate on the "clawback_reason" field.
+func ClawbackReasonIn(vs ...string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldIn(FieldClawbackReason, vs...))
+}
+
+// ClawbackReasonNotIn applies the NotIn predicate on the "clawback_reason" field.
+func ClawbackReasonNotIn(vs ...string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldNotIn(FieldClawbackReason, vs...))
+}
+
+// ClawbackReasonGT applies the GT predicate on the "clawback_reason" field.
+func ClawbackReasonGT(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldGT(FieldClawbackReason, v))
+}
+
+// ClawbackReasonGTE applies the GTE predicate on the "clawback_reason" field.
+func ClawbackReasonGTE(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldGTE(FieldClawbackReason, v))
+}
+
+// ClawbackReasonLT applies the LT predicate on the "clawback_reason" field.
+func ClawbackReasonLT(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldLT(FieldClawbackReason, v))
+}
+
+// ClawbackReasonLTE applies the LTE predicate on the "clawback_reason" field.
+func ClawbackReasonLTE(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldLTE(FieldClawbackReason, v))
+}
+
+// ClawbackReasonContains applies the Contains predicate on the "clawback_reason" field.
+func ClawbackReasonContains(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldContains(FieldClawbackReason, v))
+}
+
+// ClawbackReasonHasPrefix applies the HasPrefix predicate on the "clawback_reason" field.
+func ClawbackReasonHasPrefix(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldHasPrefix(FieldClawbackReason, v))
+}
+
+// ClawbackReasonHasSuffix applies the HasSuffix predicate on the "clawback_reason" field.
+func ClawbackReasonHasSuffix(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldHasSuffix(FieldClawbackReason, v))
+}
+
+// ClawbackReasonIsNil applies the IsNil predicate on the "clawback_reason" field.
+func ClawbackReasonIsNil() predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldIsNull(FieldClawbackReason))
+}
+
+// ClawbackReasonNotNil applies the NotNil predicate on the "clawback_reason" field.
+func ClawbackReasonNotNil() predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldNotNull(FieldClawbackReason))
+}
+
+// ClawbackReasonEqualFold applies the EqualFold predicate on the "clawback_reason" field.
+func ClawbackReasonEqualFold(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldEqualFold(FieldClawbackReason, v))
+}
+
+// ClawbackReasonContainsFold applies the ContainsFold predicate on the "clawback_reason" field.
+func ClawbackReasonContainsFold(v string) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldContainsFold(FieldClawbackReason, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ReferralCommission) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ReferralCommission) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ReferralCommission) predicate.ReferralCommission {
+	return predicate.ReferralCommission(sql.NotPredicates(p))
+}
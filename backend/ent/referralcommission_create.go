@@ -0,0 +1,1407 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Wei-Shaw/sub2api/ent/referralcommission"
+)
+
+// ReferralCommissionCreate is the builder for creating a ReferralCommission entity.
+type ReferralCommissionCreate struct {
+	config
+	mutation *ReferralCommissionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetReferrerID sets the "referrer_id" field.
+func (_c *ReferralCommissionCreate) SetReferrerID(v int64) *ReferralCommissionCreate {
+	_c.mutation.SetReferrerID(v)
+	return _c
+}
+
+// SetRefereeID sets the "referee_id" field.
+func (_c *ReferralCommissionCreate) SetRefereeID(v int64) *ReferralCommissionCreate {
+	_c.mutation.SetRefereeID(v)
+	return _c
+}
+
+// SetRewardID sets the "reward_id" field.
+func (_c *ReferralCommissionCreate) SetRewardID(v int64) *ReferralCommissionCreate {
+	_c.mutation.SetRewardID(v)
+	return _c
+}
+
+// SetNillableRewardID sets the "reward_id" field if the given value is not nil.
+func (_c *ReferralCommissionCreate) SetNillableRewardID(v *int64) *ReferralCommissionCreate {
+	if v != nil {
+		_c.SetRewardID(*v)
+	}
+	return _c
+}
+
+// SetSourceType sets the "source_type" field.
+func (_c *ReferralCommissionCreate) SetSourceType(v string) *ReferralCommissionCreate {
+	_c.mutation.SetSourceType(v)
+	return _c
+}
+
+// SetSourceID sets the "source_id" field.
+func (_c *ReferralCommissionCreate) SetSourceID(v int64) *ReferralCommissionCreate {
+	_c.mutation.SetSourceID(v)
+	return _c
+}
+
+// SetSourceAmount sets the "source_amount" field.
+func (_c *ReferralCommissionCreate) SetSourceAmount(v float64) *ReferralCommissionCreate {
+	_c.mutation.SetSourceAmount(v)
+	return _c
+}
+
+// SetNillableSourceAmount sets the "source_amount" field if the given value is not nil.
+func (_c *ReferralCommissionCreate) SetNillableSourceAmount(v *float64) *ReferralCommissionCreate {
+	if v != nil {
+		_c.SetSourceAmount(*v)
+	}
+	return _c
+}
+
+// SetAmount sets the "amount" field.
+func (_c *ReferralCommissionCreate) SetAmount(v float64) *ReferralCommissionCreate {
+	_c.mutation.SetAmount(v)
+	return _c
+}
+
+// SetClawedBackAmount sets the "clawed_back_amount" field.
+func (_c *ReferralCommissionCreate) SetClawedBackAmount(v float64) *ReferralCommissionCreate {
+	_c.mutation.SetClawedBackAmount(v)
+	return _c
+}
+
+// SetNillableClawedBackAmount sets the "clawed_back_amount" field if the given value is not nil.
+func (_c *ReferralCommissionCreate) SetNillableClawedBackAmount(v *float64) *ReferralCommissionCreate {
+	if v != nil {
+		_c.SetClawedBackAmount(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *ReferralCommissionCreate) SetStatus(v string) *ReferralCommissionCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *ReferralCommissionCreate) SetNillableStatus(v *string) *ReferralCommissionCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetAvailableAt sets the "available_at" field.
+func (_c *ReferralCommissionCreate) SetAvailableAt(v time.Time) *ReferralCommissionCreate {
+	_c.mutation.SetAvailableAt(v)
+	return _c
+}
+
+// SetClawedBackAt sets the "clawed_back_at" field.
+func (_c *ReferralCommissionCreate) SetClawedBackAt(v time.Time) *ReferralCommissionCreate {
+	_c.mutation.SetClawedBackAt(v)
+	return _c
+}
+
+// SetNillableClawedBackAt sets the "clawed_back_at" field if the given value is not nil.
+func (_c *ReferralCommissionCreate) SetNillableClawedBackAt(v *time.Time) *ReferralCommissionCreate {
+	if v != nil {
+		_c.SetClawedBackAt(*v)
+	}
+	return _c
+}
+
+// SetClawbackReason sets the "clawback_reason" field.
+func (_c *ReferralCommissionCreate) SetClawbackReason(v string) *ReferralCommissionCreate {
+	_c.mutation.SetClawbackReason(v)
+	return _c
+}
+
+// SetNillableClawbackReason sets the "clawback_reason" field if the given value is not nil.
+func (_c *ReferralCommissionCreate) SetNillableClawbackReason(v *string) *ReferralCommissionCreate {
+	if v != nil {
+		_c.SetClawbackReason(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ReferralCommissionCreate) SetCreatedAt(v time.Time) *ReferralCommissionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ReferralCommissionCreate) SetNillableCreatedAt(v *time.Time) *ReferralCommissionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ReferralCommissionCreate) SetUpdatedAt(v time.Time) *ReferralCommissionCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ReferralCommissionCreate) SetNillableUpdatedAt(v *time.Time) *ReferralCommissionCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the ReferralCommissionMutation object of the builder.
+func (_c *ReferralCommissionCreate) Mutation() *ReferralCommissionMutation {
+	return _c.mutation
+}
+
+// Save creates the ReferralCommission in the database.
+func (_c *ReferralCommissionCreate) Save(ctx context.Context) (*ReferralCommission, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ReferralCommissionCreate) SaveX(ctx context.Context) *ReferralCommission {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ReferralCommissionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ReferralCommissionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ReferralCommissionCreate) defaults() {
+	if _, ok := _c.mutation.SourceAmount(); !ok {
+		v := referralcommission.DefaultSourceAmount
+		_c.mutation.SetSourceAmount(v)
+	}
+	if _, ok := _c.mutation.ClawedBackAmount(); !ok {
+		v := referralcommission.DefaultClawedBackAmount
+		_c.mutation.SetClawedBackAmount(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := referralcommission.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := referralcommission.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := referralcommission.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ReferralCommissionCreate) check() error {
+	if _, ok := _c.mutation.ReferrerID(); !ok {
+		return &ValidationError{Name: "referrer_id", err: errors.New(`ent: missing required field "ReferralCommission.referrer_id"`)}
+	}
+	if _, ok := _c.mutation.RefereeID(); !ok {
+		return &ValidationError{Name: "referee_id", err: errors.New(`ent: missing required field "ReferralCommission.referee_id"`)}
+	}
+	if _, ok := _c.mutation.SourceType(); !ok {
+		return &ValidationError{Name: "source_type", err: errors.New(`ent: missing required field "ReferralCommission.source_type"`)}
+	}
+	if v, ok := _c.mutation.SourceType(); ok {
+		if err := referralcommission.SourceTypeValidator(v); err != nil {
+			return &ValidationError{Name: "source_type", err: fmt.Errorf(`ent: validator failed for field "ReferralCommission.source_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SourceID(); !ok {
+		return &ValidationError{Name: "source_id", err: errors.New(`ent: missing required field "ReferralCommission.source_id"`)}
+	}
+	if _, ok := _c.mutation.SourceAmount(); !ok {
+		return &ValidationError{Name: "source_amount", err: errors.New(`ent: missing required field "ReferralCommission.source_amount"`)}
+	}
+	if _, ok := _c.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "ReferralCommission.amount"`)}
+	}
+	if _, ok := _c.mutation.ClawedBackAmount(); !ok {
+		return &ValidationError{Name: "clawed_back_amount", err: errors.New(`ent: missing required field "ReferralCommission.clawed_back_amount"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ReferralCommission.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := referralcommission.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ReferralCommission.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AvailableAt(); !ok {
+		return &ValidationError{Name: "available_at", err: errors.New(`ent: missing required field "ReferralCommission.available_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ReferralCommission.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ReferralCommission.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *ReferralCommissionCreate) sqlSave(ctx context.Context) (*ReferralCommission, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int64(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ReferralCommissionCreate) createSpec() (*ReferralCommission, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ReferralCommission{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(referralcommission.Table, sqlgraph.NewFieldSpec(referralcommission.FieldID, field.TypeInt64))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.ReferrerID(); ok {
+		_spec.SetField(referralcommission.FieldReferrerID, field.TypeInt64, value)
+		_node.ReferrerID = value
+	}
+	if value, ok := _c.mutation.RefereeID(); ok {
+		_spec.SetField(referralcommission.FieldRefereeID, field.TypeInt64, value)
+		_node.RefereeID = value
+	}
+	if value, ok := _c.mutation.RewardID(); ok {
+		_spec.SetField(referralcommission.FieldRewardID, field.TypeInt64, value)
+		_node.RewardID = &value
+	}
+	if value, ok := _c.mutation.SourceType(); ok {
+		_spec.SetField(referralcommission.FieldSourceType, field.TypeString, value)
+		_node.SourceType = value
+	}
+	if value, ok := _c.mutation.SourceID(); ok {
+		_spec.SetField(referralcommission.FieldSourceID, field.TypeInt64, value)
+		_node.SourceID = value
+	}
+	if value, ok := _c.mutation.SourceAmount(); ok {
+		_spec.SetField(referralcommission.FieldSourceAmount, field.TypeFloat64, value)
+		_node.SourceAmount = value
+	}
+	if value, ok := _c.mutation.Amount(); ok {
+		_spec.SetField(referralcommission.FieldAmount, field.TypeFloat64, value)
+		_node.Amount = value
+	}
+	if value, ok := _c.mutation.ClawedBackAmount(); ok {
+		_spec.SetField(referralcommission.FieldClawedBackAmount, field.TypeFloat64, value)
+		_node.ClawedBackAmount = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(referralcommission.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.AvailableAt(); ok {
+		_spec.SetField(referralcommission.FieldAvailableAt, field.TypeTime, value)
+		_node.AvailableAt = value
+	}
+	if value, ok := _c.mutation.ClawedBackAt(); ok {
+		_spec.SetField(referralcommission.FieldClawedBackAt, field.TypeTime, value)
+		_node.ClawedBackAt = &value
+	}
+	if value, ok := _c.mutation.ClawbackReason(); ok {
+		_spec.SetField(referralcommission.FieldClawbackReason, field.TypeString, value)
+		_node.ClawbackReason = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(referralcommission.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(referralcommission.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ReferralCommission.Create().
+//		SetReferrerID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReferralCommissionUpsert) {
+//			SetReferrerID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ReferralCommissionCreate) OnConflict(opts ...sql.ConflictOption) *ReferralCommissionUpsertOne {
+	_c.conflict = opts
+	return &ReferralCommissionUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ReferralCommission.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ReferralCommissionCreate) OnConflictColumns(columns ...string) *ReferralCommissionUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ReferralCommissionUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ReferralCommissionUpsertOne is the builder for "upsert"-ing
+	//  one ReferralCommission node.
+	ReferralCommissionUpsertOne struct {
+		create *ReferralCommissionCreate
+	}
+
+	// ReferralCommissionUpsert is the "OnConflict" setter.
+	ReferralCommissionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetReferrerID sets the "referrer_id" field.
+func (u *ReferralCommissionUpsert) SetReferrerID(v int64) *ReferralCommissionUpsert {
+	u.Set(referralcommission.FieldReferrerID, v)
+	return u
+}
+
+// UpdateReferrerID sets the "referrer_id" field to the value that was provided on create.
+func (u *ReferralCommissionUpsert) UpdateReferrerID() *ReferralCommissionUpsert {
+	u.SetExcluded(referralcommission.FieldReferrerID)
+	return u
+}
+
+// AddReferrerID adds v to the "referrer_id" field.
+func (u *ReferralCommissionUpsert) AddReferrerID(v int64) *ReferralCommissionUpsert {
+	u.Add(referralcommission.FieldReferrerID, v)
+	return u
+}
+
+// SetRefereeID sets the "referee_id" field.
+func (u *ReferralCommissionUpsert) SetRefereeID(v int64) *ReferralCommissionUpsert {
+	u.Set(referralcommission.FieldRefereeID, v)
+	return u
+}
+
+// UpdateRefereeID sets the "referee_id" field to the value that was provided on create.
+func (u *ReferralCommissionUpsert) UpdateRefereeID() *ReferralCommissionUpsert {
+	u.SetExcluded(referralcommission.FieldRefereeID)
+	return u
+}
+
+// AddRefereeID adds v to the "referee_id" field.
+func (u *ReferralCommissionUpsert) AddRefereeID(v int64) *ReferralCommissionUpsert {
+	u.Add(referralcommission.FieldRefereeID, v)
+	return u
+}
+
+// SetRewardID sets the "reward_id" field.
+func (u *ReferralCommissionUpsert) SetRewardID(v int64) *ReferralCommissionUpsert {
+	u.Set(referralcommission.FieldRewardID, v)
+	return u
+}
+
+// UpdateRewardID sets the "reward_id" field to the value that was provided on create.
+func (u *ReferralCommissionUpsert) UpdateRewardID() *ReferralCommissionUpsert {
+	u.SetExcluded(referralcommission.FieldRewardID)
+	return u
+}
+
+// AddRewardID adds v to the "reward_id" field.
+func (u *ReferralCommissionUpsert) AddRewardID(v int64) *ReferralCommissionUpsert {
+	u.Add(referralcommission.FieldRewardID, v)
+	return u
+}
+
+// ClearRewardID clears the value of the "reward_id" field.
+func (u *ReferralCommissionUpsert) ClearRewardID() *ReferralCommissionUpsert {
+	u.SetNull(referralcommission.FieldRewardID)
+	return u
+}
+
+// SetSourceType sets the "source_type" field.
+func (u *ReferralCommissionUpsert) SetSourceType(v string) *ReferralCommissionUpsert {
+	u.Set(referralcommission.FieldSourceType, v)
+	return u
+}
+
+// UpdateSourceType sets the "source_type" field to the value that was provided on create.
+func (u *ReferralCommissionUpsert) UpdateSourceType() *ReferralCommissionUpsert {
+	u.SetExcluded(referralcommission.FieldSourceType)
+	return u
+}
+
+// SetSourceID sets the "source_id" field.
+func (u *ReferralCommissionUpsert) SetSourceID(v int64) *ReferralCommissionUpsert {
+	u.Set(referralcommission.FieldSourceID, v)
+	return u
+}
+
+// UpdateSourceID sets the "source_id" field to the value that was provided on create.
+func (u *ReferralCommissionUpsert) UpdateSourceID() *ReferralCommissionUpsert {
+	u.SetExcluded(referralcommission.FieldSourceID)
+	return u
+}
+
+// AddSourceID adds v to the "source_id" field.
+func (u *ReferralCommissionUpsert) AddSourceID(v int64) *ReferralCommissionUpsert {
+	u.Add(referralcommission.FieldSourceID, v)
+	return u
+}
+
+// SetSourceAmount sets the "source_amount" field.
+func (u *ReferralCommissionUpsert) SetSourceAmount(v float64) *ReferralCommissionUpsert {
+	u.Set(referralcommission.FieldSourceAmount, v)
+	return u
+}
+
+// UpdateSourceAmount sets the "source_amount" field to the value that was provided on create.
+func (u *ReferralCommissionUpsert) UpdateSourceAmount() *ReferralCommissionUpsert {
+	u.SetExcluded(referralcommission.FieldSourceAmount)
+	return u
+}
+
+// AddSourceAmount adds v to the "source_amount" field.
+func (u *ReferralCommissionUpsert) AddSourceAmount(v float64) *ReferralCommissionUpsert {
+	u.Add(referralcommission.FieldSourceAmount, v)
+	return u
+}
+
+// SetAmount sets the "amount" field.
+func (u *ReferralCommissionUpsert) SetAmount(v float64) *ReferralCommissionUpsert {
+	u.Set(referralcommission.FieldAmount, v)
+	return u
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *ReferralCommissionUpsert) UpdateAmount() *ReferralCommissionUpsert {
+	u.SetExcluded(referralcommission.FieldAmount)
+	return u
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *ReferralCommissionUpsert) AddAmount(v float64) *ReferralCommissionUpsert {
+	u.Add(referralcommission.FieldAmount, v)
+	return u
+}
+
+// SetClawedBackAmount sets the "clawed_back_amount" field.
+func (u *ReferralCommissionUpsert) SetClawedBackAmount(v float64) *ReferralCommissionUpsert {
+	u.Set(referralcommission.FieldClawedBackAmount, v)
+	return u
+}
+
+// UpdateClawedBackAmount sets the "clawed_back_amount" field to the value that was provided on create.
+func (u *ReferralCommissionUpsert) UpdateClawedBackAmount() *ReferralCommissionUpsert {
+	u.SetExcluded(referralcommission.FieldClawedBackAmount)
+	return u
+}
+
+// AddClawedBackAmount adds v to the "clawed_back_amount" field.
+func (u *ReferralCommissionUpsert) AddClawedBackAmount(v float64) *ReferralCommissionUpsert {
+	u.Add(referralcommission.FieldClawedBackAmount, v)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *ReferralCommissionUpsert) SetStatus(v string) *ReferralCommissionUpsert {
+	u.Set(referralcommission.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ReferralCommissionUpsert) UpdateStatus() *ReferralCommissionUpsert {
+	u.SetExcluded(referralcommission.FieldStatus)
+	return u
+}
+
+// SetAvailableAt sets the "available_at" field.
+func (u *ReferralCommissionUpsert) SetAvailableAt(v time.Time) *ReferralCommissionUpsert {
+	u.Set(referralcommission.FieldAvailableAt, v)
+	return u
+}
+
+// UpdateAvailableAt sets the "available_at" field to the value that was provided on create.
+func (u *ReferralCommissionUpsert) UpdateAvailableAt() *ReferralCommissionUpsert {
+	u.SetExcluded(referralcommission.FieldAvailableAt)
+	return u
+}
+
+// SetClawedBackAt sets the "clawed_back_at" field.
+func (u *ReferralCommissionUpsert) SetClawedBackAt(v time.Time) *ReferralCommissionUpsert {
+	u.Set(referralcommission.FieldClawedBackAt, v)
+	return u
+}
+
+// UpdateClawedBackAt sets the "clawed_back_at" field to the value that was provided on create.
+func (u *ReferralCommissionUpsert) UpdateClawedBackAt() *ReferralCommissionUpsert {
+	u.SetExcluded(referralcommission.FieldClawedBackAt)
+	return u
+}
+
+// ClearClawedBackAt clears the value of the "clawed_back_at" field.
+func (u *ReferralCommissionUpsert) ClearClawedBackAt() *ReferralCommissionUpsert {
+	u.SetNull(referralcommission.FieldClawedBackAt)
+	return u
+}
+
+// SetClawbackReason sets the "clawback_reason" field.
+func (u *ReferralCommissionUpsert) SetClawbackReason(v string) *ReferralCommissionUpsert {
+	u.Set(referralcommission.FieldClawbackReason, v)
+	return u
+}
+
+// UpdateClawbackReason sets the "clawback_reason" field to the value that was provided on create.
+func (u *ReferralCommissionUpsert) UpdateClawbackReason() *ReferralCommissionUpsert {
+	u.SetExcluded(referralcommission.FieldClawbackReason)
+	return u
+}
+
+// ClearClawbackReason clears the value of the "clawback_reason" field.
+func (u *ReferralCommissionUpsert) ClearClawbackReason() *ReferralCommissionUpsert {
+	u.SetNull(referralcommission.FieldClawbackReason)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReferralCommissionUpsert) SetUpdatedAt(v time.Time) *ReferralCommissionUpsert {
+	u.Set(referralcommission.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReferralCommissionUpsert) UpdateUpdatedAt() *ReferralCommissionUpsert {
+	u.SetExcluded(referralcommission.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.ReferralCommission.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ReferralCommissionUpsertOne) UpdateNewValues() *ReferralCommissionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(referralcommission.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ReferralCommission.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ReferralCommissionUpsertOne) Ignore() *ReferralCommissionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReferralCommissionUpsertOne) DoNothing() *ReferralCommissionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReferralCommissionCreate.OnConflict
+// documentation for more info.
+func (u *ReferralCommissionUpsertOne) Update(set func(*ReferralCommissionUpsert)) *ReferralCommissionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReferralCommissionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetReferrerID sets the "referrer_id" field.
+func (u *ReferralCommissionUpsertOne) SetReferrerID(v int64) *ReferralCommissionUpsertOne {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.SetReferrerID(v)
+	})
+}
+
+// AddReferrerID adds v to the "referrer_id" field.
+func (u *ReferralCommissionUpsertOne) AddReferrerID(v int64) *ReferralCommissionUpsertOne {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.AddReferrerID(v)
+	})
+}
+
+// UpdateReferrerID sets the "referrer_id" field to the value that was provided on create.
+func (u *ReferralCommissionUpsertOne) UpdateReferrerID() *ReferralCommissionUpsertOne {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.UpdateReferrerID()
+	})
+}
+
+// SetRefereeID sets the "referee_id" field.
+func (u *ReferralCommissionUpsertOne) SetRefereeID(v int64) *ReferralCommissionUpsertOne {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.SetRefereeID(v)
+	})
+}
+
+// AddRefereeID adds v to the "referee_id" field.
+func (u *ReferralCommissionUpsertOne) AddRefereeID(v int64) *ReferralCommissionUpsertOne {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.AddRefereeID(v)
+	})
+}
+
+// UpdateRefereeID sets the "referee_id" field to the value that was provided on create.
+func (u *ReferralCommissionUpsertOne) UpdateRefereeID() *ReferralCommissionUpsertOne {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.UpdateRefereeID()
+	})
+}
+
+// SetRewardID sets the "reward_id" field.
+func (u *ReferralCommissionUpsertOne) SetRewardID(v int64) *ReferralCommissionUpsertOne {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.SetRewardID(v)
+	})
+}
+
+// AddRewardID adds v to the "reward_id" field.
+func (u *ReferralCommissionUpsertOne) AddRewardID(v int64) *ReferralCommissionUpsertOne {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.AddRewardID(v)
+	})
+}
+
+// UpdateRewardID sets the "reward_id" field to the value that was provided on create.
+func (u *ReferralCommissionUpsertOne) UpdateRewardID() *ReferralCommissionUpsertOne {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.UpdateRewardID()
+	})
+}
+
+// ClearRewardID clears the value of the "reward_id" field.
+func (u *ReferralCommissionUpsertOne) ClearRewardID() *ReferralCommissionUpsertOne {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.ClearRewardID()
+	})
+}
+
+// SetSourceType sets the "source_type" field.
+func (u *ReferralCommissionUpsertOne) SetSourceType(v string) *ReferralCommissionUpsertOne {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.SetSourceType(v)
+	})
+}
+
+// UpdateSourceType sets the "source_type" field to the value that was provided on create.
+func (u *ReferralCommissionUpsertOne) UpdateSourceType() *ReferralCommissionUpsertOne {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.UpdateSourceType()
+	})
+}
+
+// SetSourceID sets the "source_id" field.
+func (u *ReferralCommissionUpsertOne) SetSourceID(v int64) *ReferralCommissionUpsertOne {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.SetSourceID(v)
+	})
+}
+
+// AddSourceID adds v to the "source_id" field.
+func (u *ReferralCommissionUpsertOne) AddSourceID(v int64) *ReferralCommissionUpsertOne {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.AddSourceID(v)
+	})
+}
+
+// UpdateSourceID sets the "source_id" field to the value that was provided on create.
+func (u *ReferralCommissionUpsertOne) UpdateSourceID() *ReferralCommissionUpsertOne {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.UpdateSourceID()
+	})
+}
+
+// SetSourceAmount sets the "source_amount" field.
+func (u *ReferralCommissionUpsertOne) SetSourceAmount(v float64) *ReferralCommissionUpsertOne {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.SetSourceAmount(v)
+	})
+}
+
+// AddSourceAmount adds v to the "source_amount" field.
+func (u *ReferralCommissionUpsertOne) AddSourceAmount(v float64) *ReferralCommissionUpsertOne {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.AddSourceAmount(v)
+	})
+}
+
+// UpdateSourceAmount sets the "source_amount" field to the value that was provided on create.
+func (u *ReferralCommissionUpsertOne) UpdateSourceAmount() *ReferralCommissionUpsertOne {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.UpdateSourceAmount()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *ReferralCommissionUpsertOne) SetAmount(v float64) *ReferralCommissionUpsertOne {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *ReferralCommissionUpsertOne) AddAmount(v float64) *ReferralCommissionUpsertOne {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *ReferralCommissionUpsertOne) UpdateAmount() *ReferralCommissionUpsertOne {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetClawedBackAmount sets the "clawed_back_amount" field.
+func (u *ReferralCommissionUpsertOne) SetClawedBackAmount(v float64) *ReferralCommissionUpsertOne {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.SetClawedBackAmount(v)
+	})
+}
+
+// AddClawedBackAmount adds v to the "clawed_back_amount" field.
+func (u *ReferralCommissionUpsertOne) AddClawedBackAmount(v float64) *ReferralCommissionUpsertOne {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.AddClawedBackAmount(v)
+	})
+}
+
+// UpdateClawedBackAmount sets the "clawed_back_amount" field to the value that was provided on create.
+func (u *ReferralCommissionUpsertOne) UpdateClawedBackAmount() *ReferralCommissionUpsertOne {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.UpdateClawedBackAmount()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ReferralCommissionUpsertOne) SetStatus(v string) *ReferralCommissionUpsertOne {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ReferralCommissionUpsertOne) UpdateStatus() *ReferralCommissionUpsertOne {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetAvailableAt sets the "available_at" field.
+func (u *ReferralCommissionUpsertOne) SetAvailableAt(v time.Time) *ReferralCommissionUpsertOne {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.SetAvailableAt(v)
+	})
+}
+
+// UpdateAvailableAt sets the "available_at" field to the value that was provided on create.
+func (u *ReferralCommissionUpsertOne) UpdateAvailableAt() *ReferralCommissionUpsertOne {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.UpdateAvailableAt()
+	})
+}
+
+// SetClawedBackAt sets the "clawed_back_at" field.
+func (u *ReferralCommissionUpsertOne) SetClawedBackAt(v time.Time) *ReferralCommissionUpsertOne {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.SetClawedBackAt(v)
+	})
+}
+
+// UpdateClawedBackAt sets the "clawed_back_at" field to the value that was provided on create.
+func (u *ReferralCommissionUpsertOne) UpdateClawedBackAt() *ReferralCommissionUpsertOne {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.UpdateClawedBackAt()
+	})
+}
+
+// ClearClawedBackAt clears the value of the "clawed_back_at" field.
+func (u *ReferralCommissionUpsertOne) ClearClawedBackAt() *ReferralCommissionUpsertOne {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.ClearClawedBackAt()
+	})
+}
+
+// SetClawbackReason sets the "clawback_reason" field.
+func (u *ReferralCommissionUpsertOne) SetClawbackReason(v string) *ReferralCommissionUpsertOne {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.SetClawbackReason(v)
+	})
+}
+
+// UpdateClawbackReason sets the "clawback_reason" field to the value that was provided on create.
+func (u *ReferralCommissionUpsertOne) UpdateClawbackReason() *ReferralCommissionUpsertOne {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.UpdateClawbackReason()
+	})
+}
+
+// ClearClawbackReason clears the value of the "clawback_reason" field.
+func (u *ReferralCommissionUpsertOne) ClearClawbackReason() *ReferralCommissionUpsertOne {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.ClearClawbackReason()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReferralCommissionUpsertOne) SetUpdatedAt(v time.Time) *ReferralCommissionUpsertOne {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReferralCommissionUpsertOne) UpdateUpdatedAt() *ReferralCommissionUpsertOne {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ReferralCommissionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReferralCommissionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReferralCommissionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ReferralCommissionUpsertOne) ID(ctx context.Context) (id int64, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ReferralCommissionUpsertOne) IDX(ctx context.Context) int64 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ReferralCommissionCreateBulk is the builder for creating many ReferralCommission entities in bulk.
+type ReferralCommissionCreateBulk struct {
+	config
+	err      error
+	builders []*ReferralCommissionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ReferralCommission entities in the database.
+func (_c *ReferralCommissionCreateBulk) Save(ctx context.Context) ([]*ReferralCommission, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ReferralCommission, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReferralCommissionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ReferralCommissionCreateBulk) SaveX(ctx context.Context) []*ReferralCommission {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ReferralCommissionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ReferralCommissionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ReferralCommission.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ReferralCommissionUpsert) {
+//			SetReferrerID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ReferralCommissionCreateBulk) OnConflict(opts ...sql.ConflictOption) *ReferralCommissionUpsertBulk {
+	_c.conflict = opts
+	return &ReferralCommissionUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ReferralCommission.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ReferralCommissionCreateBulk) OnConflictColumns(columns ...string) *ReferralCommissionUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ReferralCommissionUpsertBulk{
+		create: _c,
+	}
+}
+
+// ReferralCommissionUpsertBulk is the builder for "upsert"-ing
+// a bulk of ReferralCommission nodes.
+type ReferralCommissionUpsertBulk struct {
+	create *ReferralCommissionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ReferralCommission.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ReferralCommissionUpsertBulk) UpdateNewValues() *ReferralCommissionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(referralcommission.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ReferralCommission.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ReferralCommissionUpsertBulk) Ignore() *ReferralCommissionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ReferralCommissionUpsertBulk) DoNothing() *ReferralCommissionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ReferralCommissionCreateBulk.OnConflict
+// documentation for more info.
+func (u *ReferralCommissionUpsertBulk) Update(set func(*ReferralCommissionUpsert)) *ReferralCommissionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ReferralCommissionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetReferrerID sets the "referrer_id" field.
+func (u *ReferralCommissionUpsertBulk) SetReferrerID(v int64) *ReferralCommissionUpsertBulk {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.SetReferrerID(v)
+	})
+}
+
+// AddReferrerID adds v to the "referrer_id" field.
+func (u *ReferralCommissionUpsertBulk) AddReferrerID(v int64) *ReferralCommissionUpsertBulk {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.AddReferrerID(v)
+	})
+}
+
+// UpdateReferrerID sets the "referrer_id" field to the value that was provided on create.
+func (u *ReferralCommissionUpsertBulk) UpdateReferrerID() *ReferralCommissionUpsertBulk {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.UpdateReferrerID()
+	})
+}
+
+// SetRefereeID sets the "referee_id" field.
+func (u *ReferralCommissionUpsertBulk) SetRefereeID(v int64) *ReferralCommissionUpsertBulk {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.SetRefereeID(v)
+	})
+}
+
+// AddRefereeID adds v to the "referee_id" field.
+func (u *ReferralCommissionUpsertBulk) AddRefereeID(v int64) *ReferralCommissionUpsertBulk {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.AddRefereeID(v)
+	})
+}
+
+// UpdateRefereeID sets the "referee_id" field to the value that was provided on create.
+func (u *ReferralCommissionUpsertBulk) UpdateRefereeID() *ReferralCommissionUpsertBulk {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.UpdateRefereeID()
+	})
+}
+
+// SetRewardID sets the "reward_id" field.
+func (u *ReferralCommissionUpsertBulk) SetRewardID(v int64) *ReferralCommissionUpsertBulk {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.SetRewardID(v)
+	})
+}
+
+// AddRewardID adds v to the "reward_id" field.
+func (u *ReferralCommissionUpsertBulk) AddRewardID(v int64) *ReferralCommissionUpsertBulk {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.AddRewardID(v)
+	})
+}
+
+// UpdateRewardID sets the "reward_id" field to the value that was provided on create.
+func (u *ReferralCommissionUpsertBulk) UpdateRewardID() *ReferralCommissionUpsertBulk {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.UpdateRewardID()
+	})
+}
+
+// ClearRewardID clears the value of the "reward_id" field.
+func (u *ReferralCommissionUpsertBulk) ClearRewardID() *ReferralCommissionUpsertBulk {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.ClearRewardID()
+	})
+}
+
+// SetSourceType sets the "source_type" field.
+func (u *ReferralCommissionUpsertBulk) SetSourceType(v string) *ReferralCommissionUpsertBulk {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.SetSourceType(v)
+	})
+}
+
+// UpdateSourceType sets the "source_type" field to the value that was provided on create.
+func (u *ReferralCommissionUpsertBulk) UpdateSourceType() *ReferralCommissionUpsertBulk {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.UpdateSourceType()
+	})
+}
+
+// SetSourceID sets the "source_id" field.
+func (u *ReferralCommissionUpsertBulk) SetSourceID(v int64) *ReferralCommissionUpsertBulk {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.SetSourceID(v)
+	})
+}
+
+// AddSourceID adds v to the "source_id" field.
+func (u *ReferralCommissionUpsertBulk) AddSourceID(v int64) *ReferralCommissionUpsertBulk {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.AddSourceID(v)
+	})
+}
+
+// UpdateSourceID sets the "source_id" field to the value that was provided on create.
+func (u *ReferralCommissionUpsertBulk) UpdateSourceID() *ReferralCommissionUpsertBulk {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.UpdateSourceID()
+	})
+}
+
+// SetSourceAmount sets the "source_amount" field.
+func (u *ReferralCommissionUpsertBulk) SetSourceAmount(v float64) *ReferralCommissionUpsertBulk {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.SetSourceAmount(v)
+	})
+}
+
+// AddSourceAmount adds v to the "source_amount" field.
+func (u *ReferralCommissionUpsertBulk) AddSourceAmount(v float64) *ReferralCommissionUpsertBulk {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.AddSourceAmount(v)
+	})
+}
+
+// UpdateSourceAmount sets the "source_amount" field to the value that was provided on create.
+func (u *ReferralCommissionUpsertBulk) UpdateSourceAmount() *ReferralCommissionUpsertBulk {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.UpdateSourceAmount()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *ReferralCommissionUpsertBulk) SetAmount(v float64) *ReferralCommissionUpsertBulk {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *ReferralCommissionUpsertBulk) AddAmount(v float64) *ReferralCommissionUpsertBulk {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *ReferralCommissionUpsertBulk) UpdateAmount() *ReferralCommissionUpsertBulk {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetClawedBackAmount sets the "clawed_back_amount" field.
+func (u *ReferralCommissionUpsertBulk) SetClawedBackAmount(v float64) *ReferralCommissionUpsertBulk {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.SetClawedBackAmount(v)
+	})
+}
+
+// AddClawedBackAmount adds v to the "clawed_back_amount" field.
+func (u *ReferralCommissionUpsertBulk) AddClawedBackAmount(v float64) *ReferralCommissionUpsertBulk {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.AddClawedBackAmount(v)
+	})
+}
+
+// UpdateClawedBackAmount sets the "clawed_back_amount" field to the value that was provided on create.
+func (u *ReferralCommissionUpsertBulk) UpdateClawedBackAmount() *ReferralCommissionUpsertBulk {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.UpdateClawedBackAmount()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *ReferralCommissionUpsertBulk) SetStatus(v string) *ReferralCommissionUpsertBulk {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ReferralCommissionUpsertBulk) UpdateStatus() *ReferralCommissionUpsertBulk {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetAvailableAt sets the "available_at" field.
+func (u *ReferralCommissionUpsertBulk) SetAvailableAt(v time.Time) *ReferralCommissionUpsertBulk {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.SetAvailableAt(v)
+	})
+}
+
+// UpdateAvailableAt sets the "available_at" field to the value that was provided on create.
+func (u *ReferralCommissionUpsertBulk) UpdateAvailableAt() *ReferralCommissionUpsertBulk {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.UpdateAvailableAt()
+	})
+}
+
+// SetClawedBackAt sets the "clawed_back_at" field.
+func (u *ReferralCommissionUpsertBulk) SetClawedBackAt(v time.Time) *ReferralCommissionUpsertBulk {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.SetClawedBackAt(v)
+	})
+}
+
+// UpdateClawedBackAt sets the "clawed_back_at" field to the value that was provided on create.
+func (u *ReferralCommissionUpsertBulk) UpdateClawedBackAt() *ReferralCommissionUpsertBulk {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.UpdateClawedBackAt()
+	})
+}
+
+// ClearClawedBackAt clears the value of the "clawed_back_at" field.
+func (u *ReferralCommissionUpsertBulk) ClearClawedBackAt() *ReferralCommissionUpsertBulk {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.ClearClawedBackAt()
+	})
+}
+
+// SetClawbackReason sets the "clawback_reason" field.
+func (u *ReferralCommissionUpsertBulk) SetClawbackReason(v string) *ReferralCommissionUpsertBulk {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.SetClawbackReason(v)
+	})
+}
+
+// UpdateClawbackReason sets the "clawback_reason" field to the value that was provided on create.
+func (u *ReferralCommissionUpsertBulk) UpdateClawbackReason() *ReferralCommissionUpsertBulk {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.UpdateClawbackReason()
+	})
+}
+
+// ClearClawbackReason clears the value of the "clawback_reason" field.
+func (u *ReferralCommissionUpsertBulk) ClearClawbackReason() *ReferralCommissionUpsertBulk {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.ClearClawbackReason()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ReferralCommissionUpsertBulk) SetUpdatedAt(v time.Time) *ReferralCommissionUpsertBulk {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ReferralCommissionUpsertBulk) UpdateUpdatedAt() *ReferralCommissionUpsertBulk {
+	return u.Update(func(s *ReferralCommissionUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ReferralCommissionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ReferralCommissionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ReferralCommissionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ReferralCommissionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
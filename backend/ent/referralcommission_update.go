@@ -0,0 +1,850 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Wei-Shaw/sub2api/ent/predicate"
+	"github.com/Wei-Shaw/sub2api/ent/referralcommission"
+)
+
+// ReferralCommissionUpdate is the builder for updating ReferralCommission entities.
+type ReferralCommissionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ReferralCommissionMutation
+}
+
+// Where appends a list predicates to the ReferralCommissionUpdate builder.
+func (_u *ReferralCommissionUpdate) Where(ps ...predicate.ReferralCommission) *ReferralCommissionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetReferrerID sets the "referrer_id" field.
+func (_u *ReferralCommissionUpdate) SetReferrerID(v int64) *ReferralCommissionUpdate {
+	_u.mutation.ResetReferrerID()
+	_u.mutation.SetReferrerID(v)
+	return _u
+}
+
+// SetNillableReferrerID sets the "referrer_id" field if the given value is not nil.
+func (_u *ReferralCommissionUpdate) SetNillableReferrerID(v *int64) *ReferralCommissionUpdate {
+	if v != nil {
+		_u.SetReferrerID(*v)
+	}
+	return _u
+}
+
+// AddReferrerID adds value to the "referrer_id" field.
+func (_u *ReferralCommissionUpdate) AddReferrerID(v int64) *ReferralCommissionUpdate {
+	_u.mutation.AddReferrerID(v)
+	return _u
+}
+
+// SetRefereeID sets the "referee_id" field.
+func (_u *ReferralCommissionUpdate) SetRefereeID(v int64) *ReferralCommissionUpdate {
+	_u.mutation.ResetRefereeID()
+	_u.mutation.SetRefereeID(v)
+	return _u
+}
+
+// SetNillableRefereeID sets the "referee_id" field if the given value is not nil.
+func (_u *ReferralCommissionUpdate) SetNillableRefereeID(v *int64) *ReferralCommissionUpdate {
+	if v != nil {
+		_u.SetRefereeID(*v)
+	}
+	return _u
+}
+
+// AddRefereeID adds value to the "referee_id" field.
+func (_u *ReferralCommissionUpdate) AddRefereeID(v int64) *ReferralCommissionUpdate {
+	_u.mutation.AddRefereeID(v)
+	return _u
+}
+
+// SetRewardID sets the "reward_id" field.
+func (_u *ReferralCommissionUpdate) SetRewardID(v int64) *ReferralCommissionUpdate {
+	_u.mutation.ResetRewardID()
+	_u.mutation.SetRewardID(v)
+	return _u
+}
+
+// SetNillableRewardID sets the "reward_id" field if the given value is not nil.
+func (_u *ReferralCommissionUpdate) SetNillableRewardID(v *int64) *ReferralCommissionUpdate {
+	if v != nil {
+		_u.SetRewardID(*v)
+	}
+	return _u
+}
+
+// AddRewardID adds value to the "reward_id" field.
+func (_u *ReferralCommissionUpdate) AddRewardID(v int64) *ReferralCommissionUpdate {
+	_u.mutation.AddRewardID(v)
+	return _u
+}
+
+// ClearRewardID clears the value of the "reward_id" field.
+func (_u *ReferralCommissionUpdate) ClearRewardID() *ReferralCommissionUpdate {
+	_u.mutation.ClearRewardID()
+	return _u
+}
+
+// SetSourceType sets the "source_type" field.
+func (_u *ReferralCommissionUpdate) SetSourceType(v string) *ReferralCommissionUpdate {
+	_u.mutation.SetSourceType(v)
+	return _u
+}
+
+// SetNillableSourceType sets the "source_type" field if the given value is not nil.
+func (_u *ReferralCommissionUpdate) SetNillableSourceType(v *string) *ReferralCommissionUpdate {
+	if v != nil {
+		_u.SetSourceType(*v)
+	}
+	return _u
+}
+
+// SetSourceID sets the "source_id" field.
+func (_u *ReferralCommissionUpdate) SetSourceID(v int64) *ReferralCommissionUpdate {
+	_u.mutation.ResetSourceID()
+	_u.mutation.SetSourceID(v)
+	return _u
+}
+
+// SetNillableSourceID sets the "source_id" field if the given value is not nil.
+func (_u *ReferralCommissionUpdate) SetNillableSourceID(v *int64) *ReferralCommissionUpdate {
+	if v != nil {
+		_u.SetSourceID(*v)
+	}
+	return _u
+}
+
+// AddSourceID adds value to the "source_id" field.
+func (_u *ReferralCommissionUpdate) AddSourceID(v int64) *ReferralCommissionUpdate {
+	_u.mutation.AddSourceID(v)
+	return _u
+}
+
+// SetSourceAmount sets the "source_amount" field.
+func (_u *ReferralCommissionUpdate) SetSourceAmount(v float64) *ReferralCommissionUpdate {
+	_u.mutation.ResetSourceAmount()
+	_u.mutation.SetSourceAmount(v)
+	return _u
+}
+
+// SetNillableSourceAmount sets the "source_amount" field if the given value is not nil.
+func (_u *ReferralCommissionUpdate) SetNillableSourceAmount(v *float64) *ReferralCommissionUpdate {
+	if v != nil {
+		_u.SetSourceAmount(*v)
+	}
+	return _u
+}
+
+// AddSourceAmount adds value to the "source_amount" field.
+func (_u *ReferralCommissionUpdate) AddSourceAmount(v float64) *ReferralCommissionUpdate {
+	_u.mutation.AddSourceAmount(v)
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *ReferralCommissionUpdate) SetAmount(v float64) *ReferralCommissionUpdate {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *ReferralCommissionUpdate) SetNillableAmount(v *float64) *ReferralCommissionUpdate {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *ReferralCommissionUpdate) AddAmount(v float64) *ReferralCommissionUpdate {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetClawedBackAmount sets the "clawed_back_amount" field.
+func (_u *ReferralCommissionUpdate) SetClawedBackAmount(v float64) *ReferralCommissionUpdate {
+	_u.mutation.ResetClawedBackAmount()
+	_u.mutation.SetClawedBackAmount(v)
+	return _u
+}
+
+// SetNillableClawedBackAmount sets the "clawed_back_amount" field if the given value is not nil.
+func (_u *ReferralCommissionUpdate) SetNillableClawedBackAmount(v *float64) *ReferralCommissionUpdate {
+	if v != nil {
+		_u.SetClawedBackAmount(*v)
+	}
+	return _u
+}
+
+// AddClawedBackAmount adds value to the "clawed_back_amount" field.
+func (_u *ReferralCommissionUpdate) AddClawedBackAmount(v float64) *ReferralCommissionUpdate {
+	_u.mutation.AddClawedBackAmount(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ReferralCommissionUpdate) SetStatus(v string) *ReferralCommissionUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ReferralCommissionUpdate) SetNillableStatus(v *string) *ReferralCommissionUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetAvailableAt sets the "available_at" field.
+func (_u *ReferralCommissionUpdate) SetAvailableAt(v time.Time) *ReferralCommissionUpdate {
+	_u.mutation.SetAvailableAt(v)
+	return _u
+}
+
+// SetNillableAvailableAt sets the "available_at" field if the given value is not nil.
+func (_u *ReferralCommissionUpdate) SetNillableAvailableAt(v *time.Time) *ReferralCommissionUpdate {
+	if v != nil {
+		_u.SetAvailableAt(*v)
+	}
+	return _u
+}
+
+// SetClawedBackAt sets the "clawed_back_at" field.
+func (_u *ReferralCommissionUpdate) SetClawedBackAt(v time.Time) *ReferralCommissionUpdate {
+	_u.mutation.SetClawedBackAt(v)
+	return _u
+}
+
+// SetNillableClawedBackAt sets the "clawed_back_at" field if the given value is not nil.
+func (_u *ReferralCommissionUpdate) SetNillableClawedBackAt(v *time.Time) *ReferralCommissionUpdate {
+	if v != nil {
+		_u.SetClawedBackAt(*v)
+	}
+	return _u
+}
+
+// ClearClawedBackAt clears the value of the "clawed_back_at" field.
+func (_u *ReferralCommissionUpdate) ClearClawedBackAt() *ReferralCommissionUpdate {
+	_u.mutation.ClearClawedBackAt()
+	return _u
+}
+
+// SetClawbackReason sets the "clawback_reason" field.
+func (_u *ReferralCommissionUpdate) SetClawbackReason(v string) *ReferralCommissionUpdate {
+	_u.mutation.SetClawbackReason(v)
+	return _u
+}
+
+// SetNillableClawbackReason sets the "clawback_reason" field if the given value is not nil.
+func (_u *ReferralCommissionUpdate) SetNillableClawbackReason(v *string) *ReferralCommissionUpdate {
+	if v != nil {
+		_u.SetClawbackReason(*v)
+	}
+	return _u
+}
+
+// ClearClawbackReason clears the value of the "clawback_reason" field.
+func (_u *ReferralCommissionUpdate) ClearClawbackReason() *ReferralCommissionUpdate {
+	_u.mutation.ClearClawbackReason()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ReferralCommissionUpdate) SetUpdatedAt(v time.Time) *ReferralCommissionUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the ReferralCommissionMutation object of the builder.
+func (_u *ReferralCommissionUpdate) Mutation() *ReferralCommissionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ReferralCommissionUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ReferralCommissionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ReferralCommissionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ReferralCommissionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ReferralCommissionUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := referralcommission.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ReferralCommissionUpdate) check() error {
+	if v, ok := _u.mutation.SourceType(); ok {
+		if err := referralcommission.SourceTypeValidator(v); err != nil {
+			return &ValidationError{Name: "source_type", err: fmt.Errorf(`ent: validator failed for field "ReferralCommission.source_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := referralcommission.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ReferralCommission.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ReferralCommissionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(referralcommission.Table, referralcommission.Columns, sqlgraph.NewFieldSpec(referralcommission.FieldID, field.TypeInt64))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ReferrerID(); ok {
+		_spec.SetField(referralcommission.FieldReferrerID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedReferrerID(); ok {
+		_spec.AddField(referralcommission.FieldReferrerID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.RefereeID(); ok {
+		_spec.SetField(referralcommission.FieldRefereeID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedRefereeID(); ok {
+		_spec.AddField(referralcommission.FieldRefereeID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.RewardID(); ok {
+		_spec.SetField(referralcommission.FieldRewardID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedRewardID(); ok {
+		_spec.AddField(referralcommission.FieldRewardID, field.TypeInt64, value)
+	}
+	if _u.mutation.RewardIDCleared() {
+		_spec.ClearField(referralcommission.FieldRewardID, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.SourceType(); ok {
+		_spec.SetField(referralcommission.FieldSourceType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SourceID(); ok {
+		_spec.SetField(referralcommission.FieldSourceID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSourceID(); ok {
+		_spec.AddField(referralcommission.FieldSourceID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.SourceAmount(); ok {
+		_spec.SetField(referralcommission.FieldSourceAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedSourceAmount(); ok {
+		_spec.AddField(referralcommission.FieldSourceAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(referralcommission.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(referralcommission.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.ClawedBackAmount(); ok {
+		_spec.SetField(referralcommission.FieldClawedBackAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedClawedBackAmount(); ok {
+		_spec.AddField(referralcommission.FieldClawedBackAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(referralcommission.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AvailableAt(); ok {
+		_spec.SetField(referralcommission.FieldAvailableAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ClawedBackAt(); ok {
+		_spec.SetField(referralcommission.FieldClawedBackAt, field.TypeTime, value)
+	}
+	if _u.mutation.ClawedBackAtCleared() {
+		_spec.ClearField(referralcommission.FieldClawedBackAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ClawbackReason(); ok {
+		_spec.SetField(referralcommission.FieldClawbackReason, field.TypeString, value)
+	}
+	if _u.mutation.ClawbackReasonCleared() {
+		_spec.ClearField(referralcommission.FieldClawbackReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(referralcommission.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{referralcommission.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ReferralCommissionUpdateOne is the builder for updating a single ReferralCommission entity.
+type ReferralCommissionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ReferralCommissionMutation
+}
+
+// SetReferrerID sets the "referrer_id" field.
+func (_u *ReferralCommissionUpdateOne) SetReferrerID(v int64) *ReferralCommissionUpdateOne {
+	_u.mutation.ResetReferrerID()
+	_u.mutation.SetReferrerID(v)
+	return _u
+}
+
+// SetNillableReferrerID sets the "referrer_id" field if the given value is not nil.
+func (_u *ReferralCommissionUpdateOne) SetNillableReferrerID(v *int64) *ReferralCommissionUpdateOne {
+	if v != nil {
+		_u.SetReferrerID(*v)
+	}
+	return _u
+}
+
+// AddReferrerID adds value to the "referrer_id" field.
+func (_u *ReferralCommissionUpdateOne) AddReferrerID(v int64) *ReferralCommissionUpdateOne {
+	_u.mutation.AddReferrerID(v)
+	return _u
+}
+
+// SetRefereeID sets the "referee_id" field.
+func (_u *ReferralCommissionUpdateOne) SetRefereeID(v int64) *ReferralCommissionUpdateOne {
+	_u.mutation.ResetRefereeID()
+	_u.mutation.SetRefereeID(v)
+	return _u
+}
+
+// SetNillableRefereeID sets the "referee_id" field if the given value is not nil.
+func (_u *ReferralCommissionUpdateOne) SetNillableRefereeID(v *int64) *ReferralCommissionUpdateOne {
+	if v != nil {
+		_u.SetRefereeID(*v)
+	}
+	return _u
+}
+
+// AddRefereeID adds value to the "referee_id" field.
+func (_u *ReferralCommissionUpdateOne) AddRefereeID(v int64) *ReferralCommissionUpdateOne {
+	_u.mutation.AddRefereeID(v)
+	return _u
+}
+
+// SetRewardID sets the "reward_id" field.
+func (_u *ReferralCommissionUpdateOne) SetRewardID(v int64) *ReferralCommissionUpdateOne {
+	_u.mutation.ResetRewardID()
+	_u.mutation.SetRewardID(v)
+	return _u
+}
+
+// SetNillableRewardID sets the "reward_id" field if the given value is not nil.
+func (_u *ReferralCommissionUpdateOne) SetNillableRewardID(v *int64) *ReferralCommissionUpdateOne {
+	if v != nil {
+		_u.SetRewardID(*v)
+	}
+	return _u
+}
+
+// AddRewardID adds value to the "reward_id" field.
+func (_u *ReferralCommissionUpdateOne) AddRewardID(v int64) *ReferralCommissionUpdateOne {
+	_u.mutation.AddRewardID(v)
+	return _u
+}
+
+// ClearRewardID clears the value of the "reward_id" field.
+func (_u *ReferralCommissionUpdateOne) ClearRewardID() *ReferralCommissionUpdateOne {
+	_u.mutation.ClearRewardID()
+	return _u
+}
+
+// SetSourceType sets the "source_type" field.
+func (_u *ReferralCommissionUpdateOne) SetSourceType(v string) *ReferralCommissionUpdateOne {
+	_u.mutation.SetSourceType(v)
+	return _u
+}
+
+// SetNillableSourceType sets the "source_type" field if the given value is not nil.
+func (_u *ReferralCommissionUpdateOne) SetNillableSourceType(v *string) *ReferralCommissionUpdateOne {
+	if v != nil {
+		_u.SetSourceType(*v)
+	}
+	return _u
+}
+
+// SetSourceID sets the "source_id" field.
+func (_u *ReferralCommissionUpdateOne) SetSourceID(v int64) *ReferralCommissionUpdateOne {
+	_u.mutation.ResetSourceID()
+	_u.mutation.SetSourceID(v)
+	return _u
+}
+
+// SetNillableSourceID sets the "source_id" field if the given value is not nil.
+func (_u *ReferralCommissionUpdateOne) SetNillableSourceID(v *int64) *ReferralCommissionUpdateOne {
+	if v != nil {
+		_u.SetSourceID(*v)
+	}
+	return _u
+}
+
+// AddSourceID adds value to the "source_id" field.
+func (_u *ReferralCommissionUpdateOne) AddSourceID(v int64) *ReferralCommissionUpdateOne {
+	_u.mutation.AddSourceID(v)
+	return _u
+}
+
+// SetSourceAmount sets the "source_amount" field.
+func (_u *ReferralCommissionUpdateOne) SetSourceAmount(v float64) *ReferralCommissionUpdateOne {
+	_u.mutation.ResetSourceAmount()
+	_u.mutation.SetSourceAmount(v)
+	return _u
+}
+
+// SetNillableSourceAmount sets the "source_amount" field if the given value is not nil.
+func (_u *ReferralCommissionUpdateOne) SetNillableSourceAmount(v *float64) *ReferralCommissionUpdateOne {
+	if v != nil {
+		_u.SetSourceAmount(*v)
+	}
+	return _u
+}
+
+// AddSourceAmount adds value to the "source_amount" field.
+func (_u *ReferralCommissionUpdateOne) AddSourceAmount(v float64) *ReferralCommissionUpdateOne {
+	_u.mutation.AddSourceAmount(v)
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *ReferralCommissionUpdateOne) SetAmount(v float64) *ReferralCommissionUpdateOne {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *ReferralCommissionUpdateOne) SetNillableAmount(v *float64) *ReferralCommissionUpdateOne {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *ReferralCommissionUpdateOne) AddAmount(v float64) *ReferralCommissionUpdateOne {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetClawedBackAmount sets the "clawed_back_amount" field.
+func (_u *ReferralCommissionUpdateOne) SetClawedBackAmount(v float64) *ReferralCommissionUpdateOne {
+	_u.mutation.ResetClawedBackAmount()
+	_u.mutation.SetClawedBackAmount(v)
+	return _u
+}
+
+// SetNillableClawedBackAmount sets the "clawed_back_amount" field if the given value is not nil.
+func (_u *ReferralCommissionUpdateOne) SetNillableClawedBackAmount(v *float64) *ReferralCommissionUpdateOne {
+	if v != nil {
+		_u.SetClawedBackAmount(*v)
+	}
+	return _u
+}
+
+// AddClawedBackAmount adds value to the "clawed_back_amount" field.
+func (_u *ReferralCommissionUpdateOne) AddClawedBackAmount(v float64) *ReferralCommissionUpdateOne {
+	_u.mutation.AddClawedBackAmount(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ReferralCommissionUpdateOne) SetStatus(v string) *ReferralCommissionUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ReferralCommissionUpdateOne) SetNillableStatus(v *string) *ReferralCommissionUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetAvailableAt sets the "available_at" field.
+func (_u *ReferralCommissionUpdateOne) SetAvailableAt(v time.Time) *ReferralCommissionUpdateOne {
+	_u.mutation.SetAvailableAt(v)
+	return _u
+}
+
+// SetNillableAvailableAt sets the "available_at" field if the given value is not nil.
+func (_u *ReferralCommissionUpdateOne) SetNillableAvailableAt(v *time.Time) *ReferralCommissionUpdateOne {
+	if v != nil {
+		_u.SetAvailableAt(*v)
+	}
+	return _u
+}
+
+// SetClawedBackAt sets the "clawed_back_at" field.
+func (_u *ReferralCommissionUpdateOne) SetClawedBackAt(v time.Time) *ReferralCommissionUpdateOne {
+	_u.mutation.SetClawedBackAt(v)
+	return _u
+}
+
+// SetNillableClawedBackAt sets the "clawed_back_at" field if the given value is not nil.
+func (_u *ReferralCommissionUpdateOne) SetNillableClawedBackAt(v *time.Time) *ReferralCommissionUpdateOne {
+	if v != nil {
+		_u.SetClawedBackAt(*v)
+	}
+	return _u
+}
+
+// ClearClawedBackAt clears the value of the "clawed_back_at" field.
+func (_u *ReferralCommissionUpdateOne) ClearClawedBackAt() *ReferralCommissionUpdateOne {
+	_u.mutation.ClearClawedBackAt()
+	return _u
+}
+
+// SetClawbackReason sets the "clawback_reason" field.
+func (_u *ReferralCommissionUpdateOne) SetClawbackReason(v string) *ReferralCommissionUpdateOne {
+	_u.mutation.SetClawbackReason(v)
+	return _u
+}
+
+// SetNillableClawbackReason sets the "clawback_reason" field if the given value is not nil.
+func (_u *ReferralCommissionUpdateOne) SetNillableClawbackReason(v *string) *ReferralCommissionUpdateOne {
+	if v != nil {
+		_u.SetClawbackReason(*v)
+	}
+	return _u
+}
+
+// ClearClawbackReason clears the value of the "clawback_reason" field.
+func (_u *ReferralCommissionUpdateOne) ClearClawbackReason() *ReferralCommissionUpdateOne {
+	_u.mutation.ClearClawbackReason()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ReferralCommissionUpdateOne) SetUpdatedAt(v time.Time) *ReferralCommissionUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the ReferralCommissionMutation object of the builder.
+func (_u *ReferralCommissionUpdateOne) Mutation() *ReferralCommissionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ReferralCommissionUpdate builder.
+func (_u *ReferralCommissionUpdateOne) Where(ps ...predicate.ReferralCommission) *ReferralCommissionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ReferralCommissionUpdateOne) Select(field string, fields ...string) *ReferralCommissionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ReferralCommission entity.
+func (_u *ReferralCommissionUpdateOne) Save(ctx context.Context) (*ReferralCommission, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ReferralCommissionUpdateOne) SaveX(ctx context.Context) *ReferralCommission {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ReferralCommissionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ReferralCommissionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ReferralCommissionUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := referralcommission.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ReferralCommissionUpdateOne) check() error {
+	if v, ok := _u.mutation.SourceType(); ok {
+		if err := referralcommission.SourceTypeValidator(v); err != nil {
+			return &ValidationError{Name: "source_type", err: fmt.Errorf(`ent: validator failed for field "ReferralCommission.source_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := referralcommission.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ReferralCommission.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ReferralCommissionUpdateOne) sqlSave(ctx context.Context) (_node *ReferralCommission, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(referralcommission.Table, referralcommission.Columns, sqlgraph.NewFieldSpec(referralcommission.FieldID, field.TypeInt64))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ReferralCommission.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, referralcommission.FieldID)
+		for _, f := range fields {
+			if !referralcommission.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != referralcommission.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ReferrerID(); ok {
+		_spec.SetField(referralcommission.FieldReferrerID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedReferrerID(); ok {
+		_spec.AddField(referralcommission.FieldReferrerID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.RefereeID(); ok {
+		_spec.SetField(referralcommission.FieldRefereeID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedRefereeID(); ok {
+		_spec.AddField(referralcommission.FieldRefereeID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.RewardID(); ok {
+		_spec.SetField(referralcommission.FieldRewardID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedRewardID(); ok {
+		_spec.AddField(referralcommission.FieldRewardID, field.TypeInt64, value)
+	}
+	if _u.mutation.RewardIDCleared() {
+		_spec.ClearField(referralcommission.FieldRewardID, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.SourceType(); ok {
+		_spec.SetField(referralcommission.FieldSourceType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SourceID(); ok {
+		_spec.SetField(referralcommission.FieldSourceID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSourceID(); ok {
+		_spec.AddField(referralcommission.FieldSourceID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.SourceAmount(); ok {
+		_spec.SetField(referralcommission.FieldSourceAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedSourceAmount(); ok {
+		_spec.AddField(referralcommission.FieldSourceAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(referralcommission.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(referralcommission.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.ClawedBackAmount(); ok {
+		_spec.SetField(referralcommission.FieldClawedBackAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedClawedBackAmount(); ok {
+		_spec.AddField(referralcommission.FieldClawedBackAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(referralcommission.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AvailableAt(); ok {
+		_spec.SetField(referralcommission.FieldAvailableAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ClawedBackAt(); ok {
+		_spec.SetField(referralcommission.FieldClawedBackAt, field.TypeTime, value)
+	}
+	if _u.mutation.ClawedBackAtCleared() {
+		_spec.ClearField(referralcommission.FieldClawedBackAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ClawbackReason(); ok {
+		_spec.SetField(referralcommission.FieldClawbackReason, field.TypeString, value)
+	}
+	if _u.mutation.ClawbackReasonCleared() {
+		_spec.ClearField(referralcommission.FieldClawbackReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(referralcommission.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &ReferralCommission{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{referralcommission.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
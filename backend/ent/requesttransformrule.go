@@ -0,0 +1,248 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/Wei-Shaw/sub2api/ent/requesttransformrule"
+	"github.com/Wei-Shaw/sub2api/internal/model"
+)
+
+// RequestTransformRule is the model entity for the RequestTransformRule schema.
+type RequestTransformRule struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Enabled holds the value of the "enabled" field.
+	Enabled bool `json:"enabled,omitempty"`
+	// Priority holds the value of the "priority" field.
+	Priority int `json:"priority,omitempty"`
+	// Platforms holds the value of the "platforms" field.
+	Platforms []string `json:"platforms,omitempty"`
+	// GroupIds holds the value of the "group_ids" field.
+	GroupIds []int64 `json:"group_ids,omitempty"`
+	// AccountIds holds the value of the "account_ids" field.
+	AccountIds []int64 `json:"account_ids,omitempty"`
+	// ModelPatterns holds the value of the "model_patterns" field.
+	ModelPatterns []string `json:"model_patterns,omitempty"`
+	// BodyOperations holds the value of the "body_operations" field.
+	BodyOperations []model.RequestBodyOperation `json:"body_operations,omitempty"`
+	// HeaderOperations holds the value of the "header_operations" field.
+	HeaderOperations []model.RequestHeaderOperation `json:"header_operations,omitempty"`
+	// Description holds the value of the "description" field.
+	Description  *string `json:"description,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RequestTransformRule) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case requesttransformrule.FieldPlatforms, requesttransformrule.FieldGroupIds, requesttransformrule.FieldAccountIds, requesttransformrule.FieldModelPatterns, requesttransformrule.FieldBodyOperations, requesttransformrule.FieldHeaderOperations:
+			values[i] = new([]byte)
+		case requesttransformrule.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case requesttransformrule.FieldID, requesttransformrule.FieldPriority:
+			values[i] = new(sql.NullInt64)
+		case requesttransformrule.FieldName, requesttransformrule.FieldDescription:
+			values[i] = new(sql.NullString)
+		case requesttransformrule.FieldCreatedAt, requesttransformrule.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RequestTransformRule fields.
+func (_m *RequestTransformRule) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case requesttransformrule.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int64(value.Int64)
+		case requesttransformrule.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case requesttransformrule.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case requesttransformrule.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case requesttransformrule.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				_m.Enabled = value.Bool
+			}
+		case requesttransformrule.FieldPriority:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field priority", values[i])
+			} else if value.Valid {
+				_m.Priority = int(value.Int64)
+			}
+		case requesttransformrule.FieldPlatforms:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field platforms", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Platforms); err != nil {
+					return fmt.Errorf("unmarshal field platforms: %w", err)
+				}
+			}
+		case requesttransformrule.FieldGroupIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field group_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.GroupIds); err != nil {
+					return fmt.Errorf("unmarshal field group_ids: %w", err)
+				}
+			}
+		case requesttransformrule.FieldAccountIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field account_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.AccountIds); err != nil {
+					return fmt.Errorf("unmarshal field account_ids: %w", err)
+				}
+			}
+		case requesttransformrule.FieldModelPatterns:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field model_patterns", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.ModelPatterns); err != nil {
+					return fmt.Errorf("unmarshal field model_patterns: %w", err)
+				}
+			}
+		case requesttransformrule.FieldBodyOperations:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field body_operations", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.BodyOperations); err != nil {
+					return fmt.Errorf("unmarshal field body_operations: %w", err)
+				}
+			}
+		case requesttransformrule.FieldHeaderOperations:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field header_operations", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.HeaderOperations); err != nil {
+					return fmt.Errorf("unmarshal field header_operations: %w", err)
+				}
+			}
+		case requesttransformrule.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = new(string)
+				*_m.Description = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RequestTransformRule.
+// This includes values selected through modifiers, order, etc.
+func (_m *RequestTransformRule) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this RequestTransformRule.
+// Note that you need to call RequestTransformRule.Unwrap() before calling this method if this RequestTransformRule
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *RequestTransformRule) Update() *RequestTransformRuleUpdateOne {
+	return NewRequestTransformRuleClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the RequestTransformRule entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *RequestTransformRule) Unwrap() *RequestTransformRule {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RequestTransformRule is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *RequestTransformRule) String() string {
+	var builder strings.Builder
+	builder.WriteString("RequestTransformRule(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Enabled))
+	builder.WriteString(", ")
+	builder.WriteString("priority=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Priority))
+	builder.WriteString(", ")
+	builder.WriteString("platforms=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Platforms))
+	builder.WriteString(", ")
+	builder.WriteString("group_ids=")
+	builder.WriteString(fmt.Sprintf("%v", _m.GroupIds))
+	builder.WriteString(", ")
+	builder.WriteString("account_ids=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AccountIds))
+	builder.WriteString(", ")
+	builder.WriteString("model_patterns=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ModelPatterns))
+	builder.WriteString(", ")
+	builder.WriteString("body_operations=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BodyOperations))
+	builder.WriteString(", ")
+	builder.WriteString("header_operations=")
+	builder.WriteString(fmt.Sprintf("%v", _m.HeaderOperations))
+	builder.WriteString(", ")
+	if v := _m.Description; v != nil {
+		builder.WriteString("description=")
+		builder.WriteString(*v)
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RequestTransformRules is a parsable slice of RequestTransformRule.
+type RequestTransformRules []*RequestTransformRule
@@ -0,0 +1,430 @@
+// Code generated by ent, DO NOT EDIT.
+
+package requesttransformrule
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Wei-Shaw/sub2api/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldEQ(FieldName, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldEQ(FieldEnabled, v))
+}
+
+// Priority applies equality check predicate on the "priority" field. It's identical to PriorityEQ.
+func Priority(v int) predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldEQ(FieldPriority, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldEQ(FieldDescription, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldContainsFold(FieldName, v))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// PriorityEQ applies the EQ predicate on the "priority" field.
+func PriorityEQ(v int) predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldEQ(FieldPriority, v))
+}
+
+// PriorityNEQ applies the NEQ predicate on the "priority" field.
+func PriorityNEQ(v int) predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldNEQ(FieldPriority, v))
+}
+
+// PriorityIn applies the In predicate on the "priority" field.
+func PriorityIn(vs ...int) predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldIn(FieldPriority, vs...))
+}
+
+// PriorityNotIn applies the NotIn predicate on the "priority" field.
+func PriorityNotIn(vs ...int) predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldNotIn(FieldPriority, vs...))
+}
+
+// PriorityGT applies the GT predicate on the "priority" field.
+func PriorityGT(v int) predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldGT(FieldPriority, v))
+}
+
+// PriorityGTE applies the GTE predicate on the "priority" field.
+func PriorityGTE(v int) predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldGTE(FieldPriority, v))
+}
+
+// PriorityLT applies the LT predicate on the "priority" field.
+func PriorityLT(v int) predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldLT(FieldPriority, v))
+}
+
+// PriorityLTE applies the LTE predicate on the "priority" field.
+func PriorityLTE(v int) predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldLTE(FieldPriority, v))
+}
+
+// PlatformsIsNil applies the IsNil predicate on the "platforms" field.
+func PlatformsIsNil() predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldIsNull(FieldPlatforms))
+}
+
+// PlatformsNotNil applies the NotNil predicate on the "platforms" field.
+func PlatformsNotNil() predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldNotNull(FieldPlatforms))
+}
+
+// GroupIdsIsNil applies the IsNil predicate on the "group_ids" field.
+func GroupIdsIsNil() predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldIsNull(FieldGroupIds))
+}
+
+// GroupIdsNotNil applies the NotNil predicate on the "group_ids" field.
+func GroupIdsNotNil() predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldNotNull(FieldGroupIds))
+}
+
+// AccountIdsIsNil applies the IsNil predicate on the "account_ids" field.
+func AccountIdsIsNil() predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldIsNull(FieldAccountIds))
+}
+
+// AccountIdsNotNil applies the NotNil predicate on the "account_ids" field.
+func AccountIdsNotNil() predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldNotNull(FieldAccountIds))
+}
+
+// ModelPatternsIsNil applies the IsNil predicate on the "model_patterns" field.
+func ModelPatternsIsNil() predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldIsNull(FieldModelPatterns))
+}
+
+// ModelPatternsNotNil applies the NotNil predicate on the "model_patterns" field.
+func ModelPatternsNotNil() predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldNotNull(FieldModelPatterns))
+}
+
+// BodyOperationsIsNil applies the IsNil predicate on the "body_operations" field.
+func BodyOperationsIsNil() predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldIsNull(FieldBodyOperations))
+}
+
+// BodyOperationsNotNil applies the NotNil predicate on the "body_operations" field.
+func BodyOperationsNotNil() predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldNotNull(FieldBodyOperations))
+}
+
+// HeaderOperationsIsNil applies the IsNil predicate on the "header_operations" field.
+func HeaderOperationsIsNil() predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldIsNull(FieldHeaderOperations))
+}
+
+// HeaderOperationsNotNil applies the NotNil predicate on the "header_operations" field.
+func HeaderOperationsNotNil() predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldNotNull(FieldHeaderOperations))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RequestTransformRule) predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RequestTransformRule) predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RequestTransformRule) predicate.RequestTransformRule {
+	return predicate.RequestTransformRule(sql.NotPredicates(p))
+}
@@ -0,0 +1,1217 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Wei-Shaw/sub2api/ent/requesttransformrule"
+	"github.com/Wei-Shaw/sub2api/internal/model"
+)
+
+// RequestTransformRuleCreate is the builder for creating a RequestTransformRule entity.
+type RequestTransformRuleCreate struct {
+	config
+	mutation *RequestTransformRuleMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *RequestTransformRuleCreate) SetCreatedAt(v time.Time) *RequestTransformRuleCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *RequestTransformRuleCreate) SetNillableCreatedAt(v *time.Time) *RequestTransformRuleCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *RequestTransformRuleCreate) SetUpdatedAt(v time.Time) *RequestTransformRuleCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *RequestTransformRuleCreate) SetNillableUpdatedAt(v *time.Time) *RequestTransformRuleCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *RequestTransformRuleCreate) SetName(v string) *RequestTransformRuleCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetEnabled sets the "enabled" field.
+func (_c *RequestTransformRuleCreate) SetEnabled(v bool) *RequestTransformRuleCreate {
+	_c.mutation.SetEnabled(v)
+	return _c
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_c *RequestTransformRuleCreate) SetNillableEnabled(v *bool) *RequestTransformRuleCreate {
+	if v != nil {
+		_c.SetEnabled(*v)
+	}
+	return _c
+}
+
+// SetPriority sets the "priority" field.
+func (_c *RequestTransformRuleCreate) SetPriority(v int) *RequestTransformRuleCreate {
+	_c.mutation.SetPriority(v)
+	return _c
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_c *RequestTransformRuleCreate) SetNillablePriority(v *int) *RequestTransformRuleCreate {
+	if v != nil {
+		_c.SetPriority(*v)
+	}
+	return _c
+}
+
+// SetPlatforms sets the "platforms" field.
+func (_c *RequestTransformRuleCreate) SetPlatforms(v []string) *RequestTransformRuleCreate {
+	_c.mutation.SetPlatforms(v)
+	return _c
+}
+
+// SetGroupIds sets the "group_ids" field.
+func (_c *RequestTransformRuleCreate) SetGroupIds(v []int64) *RequestTransformRuleCreate {
+	_c.mutation.SetGroupIds(v)
+	return _c
+}
+
+// SetAccountIds sets the "account_ids" field.
+func (_c *RequestTransformRuleCreate) SetAccountIds(v []int64) *RequestTransformRuleCreate {
+	_c.mutation.SetAccountIds(v)
+	return _c
+}
+
+// SetModelPatterns sets the "model_patterns" field.
+func (_c *RequestTransformRuleCreate) SetModelPatterns(v []string) *RequestTransformRuleCreate {
+	_c.mutation.SetModelPatterns(v)
+	return _c
+}
+
+// SetBodyOperations sets the "body_operations" field.
+func (_c *RequestTransformRuleCreate) SetBodyOperations(v []model.RequestBodyOperation) *RequestTransformRuleCreate {
+	_c.mutation.SetBodyOperations(v)
+	return _c
+}
+
+// SetHeaderOperations sets the "header_operations" field.
+func (_c *RequestTransformRuleCreate) SetHeaderOperations(v []model.RequestHeaderOperation) *RequestTransformRuleCreate {
+	_c.mutation.SetHeaderOperations(v)
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *RequestTransformRuleCreate) SetDescription(v string) *RequestTransformRuleCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *RequestTransformRuleCreate) SetNillableDescription(v *string) *RequestTransformRuleCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// Mutation returns the RequestTransformRuleMutation object of the builder.
+func (_c *RequestTransformRuleCreate) Mutation() *RequestTransformRuleMutation {
+	return _c.mutation
+}
+
+// Save creates the RequestTransformRule in the database.
+func (_c *RequestTransformRuleCreate) Save(ctx context.Context) (*RequestTransformRule, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *RequestTransformRuleCreate) SaveX(ctx context.Context) *RequestTransformRule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RequestTransformRuleCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RequestTransformRuleCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *RequestTransformRuleCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := requesttransformrule.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := requesttransformrule.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		v := requesttransformrule.DefaultEnabled
+		_c.mutation.SetEnabled(v)
+	}
+	if _, ok := _c.mutation.Priority(); !ok {
+		v := requesttransformrule.DefaultPriority
+		_c.mutation.SetPriority(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *RequestTransformRuleCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "RequestTransformRule.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "RequestTransformRule.updated_at"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "RequestTransformRule.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := requesttransformrule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "RequestTransformRule.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "RequestTransformRule.enabled"`)}
+	}
+	if _, ok := _c.mutation.Priority(); !ok {
+		return &ValidationError{Name: "priority", err: errors.New(`ent: missing required field "RequestTransformRule.priority"`)}
+	}
+	return nil
+}
+
+func (_c *RequestTransformRuleCreate) sqlSave(ctx context.Context) (*RequestTransformRule, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int64(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *RequestTransformRuleCreate) createSpec() (*RequestTransformRule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RequestTransformRule{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(requesttransformrule.Table, sqlgraph.NewFieldSpec(requesttransformrule.FieldID, field.TypeInt64))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(requesttransformrule.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(requesttransformrule.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(requesttransformrule.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Enabled(); ok {
+		_spec.SetField(requesttransformrule.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := _c.mutation.Priority(); ok {
+		_spec.SetField(requesttransformrule.FieldPriority, field.TypeInt, value)
+		_node.Priority = value
+	}
+	if value, ok := _c.mutation.Platforms(); ok {
+		_spec.SetField(requesttransformrule.FieldPlatforms, field.TypeJSON, value)
+		_node.Platforms = value
+	}
+	if value, ok := _c.mutation.GroupIds(); ok {
+		_spec.SetField(requesttransformrule.FieldGroupIds, field.TypeJSON, value)
+		_node.GroupIds = value
+	}
+	if value, ok := _c.mutation.AccountIds(); ok {
+		_spec.SetField(requesttransformrule.FieldAccountIds, field.TypeJSON, value)
+		_node.AccountIds = value
+	}
+	if value, ok := _c.mutation.ModelPatterns(); ok {
+		_spec.SetField(requesttransformrule.FieldModelPatterns, field.TypeJSON, value)
+		_node.ModelPatterns = value
+	}
+	if value, ok := _c.mutation.BodyOperations(); ok {
+		_spec.SetField(requesttransformrule.FieldBodyOperations, field.TypeJSON, value)
+		_node.BodyOperations = value
+	}
+	if value, ok := _c.mutation.HeaderOperations(); ok {
+		_spec.SetField(requesttransformrule.FieldHeaderOperations, field.TypeJSON, value)
+		_node.HeaderOperations = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(requesttransformrule.FieldDescription, field.TypeString, value)
+		_node.Description = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RequestTransformRule.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RequestTransformRuleUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *RequestTransformRuleCreate) OnConflict(opts ...sql.ConflictOption) *RequestTransformRuleUpsertOne {
+	_c.conflict = opts
+	return &RequestTransformRuleUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RequestTransformRule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *RequestTransformRuleCreate) OnConflictColumns(columns ...string) *RequestTransformRuleUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &RequestTransformRuleUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// RequestTransformRuleUpsertOne is the builder for "upsert"-ing
+	//  one RequestTransformRule node.
+	RequestTransformRuleUpsertOne struct {
+		create *RequestTransformRuleCreate
+	}
+
+	// RequestTransformRuleUpsert is the "OnConflict" setter.
+	RequestTransformRuleUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *RequestTransformRuleUpsert) SetUpdatedAt(v time.Time) *RequestTransformRuleUpsert {
+	u.Set(requesttransformrule.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *RequestTransformRuleUpsert) UpdateUpdatedAt() *RequestTransformRuleUpsert {
+	u.SetExcluded(requesttransformrule.FieldUpdatedAt)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *RequestTransformRuleUpsert) SetName(v string) *RequestTransformRuleUpsert {
+	u.Set(requesttransformrule.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *RequestTransformRuleUpsert) UpdateName() *RequestTransformRuleUpsert {
+	u.SetExcluded(requesttransformrule.FieldName)
+	return u
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *RequestTransformRuleUpsert) SetEnabled(v bool) *RequestTransformRuleUpsert {
+	u.Set(requesttransformrule.FieldEnabled, v)
+	return u
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *RequestTransformRuleUpsert) UpdateEnabled() *RequestTransformRuleUpsert {
+	u.SetExcluded(requesttransformrule.FieldEnabled)
+	return u
+}
+
+// SetPriority sets the "priority" field.
+func (u *RequestTransformRuleUpsert) SetPriority(v int) *RequestTransformRuleUpsert {
+	u.Set(requesttransformrule.FieldPriority, v)
+	return u
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *RequestTransformRuleUpsert) UpdatePriority() *RequestTransformRuleUpsert {
+	u.SetExcluded(requesttransformrule.FieldPriority)
+	return u
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *RequestTransformRuleUpsert) AddPriority(v int) *RequestTransformRuleUpsert {
+	u.Add(requesttransformrule.FieldPriority, v)
+	return u
+}
+
+// SetPlatforms sets the "platforms" field.
+func (u *RequestTransformRuleUpsert) SetPlatforms(v []string) *RequestTransformRuleUpsert {
+	u.Set(requesttransformrule.FieldPlatforms, v)
+	return u
+}
+
+// UpdatePlatforms sets the "platforms" field to the value that was provided on create.
+func (u *RequestTransformRuleUpsert) UpdatePlatforms() *RequestTransformRuleUpsert {
+	u.SetExcluded(requesttransformrule.FieldPlatforms)
+	return u
+}
+
+// ClearPlatforms clears the value of the "platforms" field.
+func (u *RequestTransformRuleUpsert) ClearPlatforms() *RequestTransformRuleUpsert {
+	u.SetNull(requesttransformrule.FieldPlatforms)
+	return u
+}
+
+// SetGroupIds sets the "group_ids" field.
+func (u *RequestTransformRuleUpsert) SetGroupIds(v []int64) *RequestTransformRuleUpsert {
+	u.Set(requesttransformrule.FieldGroupIds, v)
+	return u
+}
+
+// UpdateGroupIds sets the "group_ids" field to the value that was provided on create.
+func (u *RequestTransformRuleUpsert) UpdateGroupIds() *RequestTransformRuleUpsert {
+	u.SetExcluded(requesttransformrule.FieldGroupIds)
+	return u
+}
+
+// ClearGroupIds clears the value of the "group_ids" field.
+func (u *RequestTransformRuleUpsert) ClearGroupIds() *RequestTransformRuleUpsert {
+	u.SetNull(requesttransformrule.FieldGroupIds)
+	return u
+}
+
+// SetAccountIds sets the "account_ids" field.
+func (u *RequestTransformRuleUpsert) SetAccountIds(v []int64) *RequestTransformRuleUpsert {
+	u.Set(requesttransformrule.FieldAccountIds, v)
+	return u
+}
+
+// UpdateAccountIds sets the "account_ids" field to the value that was provided on create.
+func (u *RequestTransformRuleUpsert) UpdateAccountIds() *RequestTransformRuleUpsert {
+	u.SetExcluded(requesttransformrule.FieldAccountIds)
+	return u
+}
+
+// ClearAccountIds clears the value of the "account_ids" field.
+func (u *RequestTransformRuleUpsert) ClearAccountIds() *RequestTransformRuleUpsert {
+	u.SetNull(requesttransformrule.FieldAccountIds)
+	return u
+}
+
+// SetModelPatterns sets the "model_patterns" field.
+func (u *RequestTransformRuleUpsert) SetModelPatterns(v []string) *RequestTransformRuleUpsert {
+	u.Set(requesttransformrule.FieldModelPatterns, v)
+	return u
+}
+
+// UpdateModelPatterns sets the "model_patterns" field to the value that was provided on create.
+func (u *RequestTransformRuleUpsert) UpdateModelPatterns() *RequestTransformRuleUpsert {
+	u.SetExcluded(requesttransformrule.FieldModelPatterns)
+	return u
+}
+
+// ClearModelPatterns clears the value of the "model_patterns" field.
+func (u *RequestTransformRuleUpsert) ClearModelPatterns() *RequestTransformRuleUpsert {
+	u.SetNull(requesttransformrule.FieldModelPatterns)
+	return u
+}
+
+// SetBodyOperations sets the "body_operations" field.
+func (u *RequestTransformRuleUpsert) SetBodyOperations(v []model.RequestBodyOperation) *RequestTransformRuleUpsert {
+	u.Set(requesttransformrule.FieldBodyOperations, v)
+	return u
+}
+
+// UpdateBodyOperations sets the "body_operations" field to the value that was provided on create.
+func (u *RequestTransformRuleUpsert) UpdateBodyOperations() *RequestTransformRuleUpsert {
+	u.SetExcluded(requesttransformrule.FieldBodyOperations)
+	return u
+}
+
+// ClearBodyOperations clears the value of the "body_operations" field.
+func (u *RequestTransformRuleUpsert) ClearBodyOperations() *RequestTransformRuleUpsert {
+	u.SetNull(requesttransformrule.FieldBodyOperations)
+	return u
+}
+
+// SetHeaderOperations sets the "header_operations" field.
+func (u *RequestTransformRuleUpsert) SetHeaderOperations(v []model.RequestHeaderOperation) *RequestTransformRuleUpsert {
+	u.Set(requesttransformrule.FieldHeaderOperations, v)
+	return u
+}
+
+// UpdateHeaderOperations sets the "header_operations" field to the value that was provided on create.
+func (u *RequestTransformRuleUpsert) UpdateHeaderOperations() *RequestTransformRuleUpsert {
+	u.SetExcluded(requesttransformrule.FieldHeaderOperations)
+	return u
+}
+
+// ClearHeaderOperations clears the value of the "header_operations" field.
+func (u *RequestTransformRuleUpsert) ClearHeaderOperations() *RequestTransformRuleUpsert {
+	u.SetNull(requesttransformrule.FieldHeaderOperations)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *RequestTransformRuleUpsert) SetDescription(v string) *RequestTransformRuleUpsert {
+	u.Set(requesttransformrule.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *RequestTransformRuleUpsert) UpdateDescription() *RequestTransformRuleUpsert {
+	u.SetExcluded(requesttransformrule.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *RequestTransformRuleUpsert) ClearDescription() *RequestTransformRuleUpsert {
+	u.SetNull(requesttransformrule.FieldDescription)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.RequestTransformRule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *RequestTransformRuleUpsertOne) UpdateNewValues() *RequestTransformRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(requesttransformrule.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RequestTransformRule.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *RequestTransformRuleUpsertOne) Ignore() *RequestTransformRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RequestTransformRuleUpsertOne) DoNothing() *RequestTransformRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RequestTransformRuleCreate.OnConflict
+// documentation for more info.
+func (u *RequestTransformRuleUpsertOne) Update(set func(*RequestTransformRuleUpsert)) *RequestTransformRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RequestTransformRuleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *RequestTransformRuleUpsertOne) SetUpdatedAt(v time.Time) *RequestTransformRuleUpsertOne {
+	return u.Update(func(s *RequestTransformRuleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *RequestTransformRuleUpsertOne) UpdateUpdatedAt() *RequestTransformRuleUpsertOne {
+	return u.Update(func(s *RequestTransformRuleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *RequestTransformRuleUpsertOne) SetName(v string) *RequestTransformRuleUpsertOne {
+	return u.Update(func(s *RequestTransformRuleUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *RequestTransformRuleUpsertOne) UpdateName() *RequestTransformRuleUpsertOne {
+	return u.Update(func(s *RequestTransformRuleUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *RequestTransformRuleUpsertOne) SetEnabled(v bool) *RequestTransformRuleUpsertOne {
+	return u.Update(func(s *RequestTransformRuleUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *RequestTransformRuleUpsertOne) UpdateEnabled() *RequestTransformRuleUpsertOne {
+	return u.Update(func(s *RequestTransformRuleUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetPriority sets the "priority" field.
+func (u *RequestTransformRuleUpsertOne) SetPriority(v int) *RequestTransformRuleUpsertOne {
+	return u.Update(func(s *RequestTransformRuleUpsert) {
+		s.SetPriority(v)
+	})
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *RequestTransformRuleUpsertOne) AddPriority(v int) *RequestTransformRuleUpsertOne {
+	return u.Update(func(s *RequestTransformRuleUpsert) {
+		s.AddPriority(v)
+	})
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *RequestTransformRuleUpsertOne) UpdatePriority() *RequestTransformRuleUpsertOne {
+	return u.Update(func(s *RequestTransformRuleUpsert) {
+		s.UpdatePriority()
+	})
+}
+
+// SetPlatforms sets the "platforms" field.
+func (u *RequestTransformRuleUpsertOne) SetPlatforms(v []string) *RequestTransformRuleUpsertOne {
+	return u.Update(func(s *RequestTransformRuleUpsert) {
+		s.SetPlatforms(v)
+	})
+}
+
+// UpdatePlatforms sets the "platforms" field to the value that was provided on create.
+func (u *RequestTransformRuleUpsertOne) UpdatePlatforms() *RequestTransformRuleUpsertOne {
+	return u.Update(func(s *RequestTransformRuleUpsert) {
+		s.UpdatePlatforms()
+	})
+}
+
+// ClearPlatforms clears the value of the "platforms" field.
+func (u *RequestTransformRuleUpsertOne) ClearPlatforms() *RequestTransformRuleUpsertOne {
+	return u.Update(func(s *RequestTransformRuleUpsert) {
+		s.ClearPlatforms()
+	})
+}
+
+// SetGroupIds sets the "group_ids" field.
+func (u *RequestTransformRuleUpsertOne) SetGroupIds(v []int64) *RequestTransformRuleUpsertOne {
+	return u.Update(func(s *RequestTransformRuleUpsert) {
+		s.SetGroupIds(v)
+	})
+}
+
+// UpdateGroupIds sets the "group_ids" field to the value that was provided on create.
+func (u *RequestTransformRuleUpsertOne) UpdateGroupIds() *RequestTransformRuleUpsertOne {
+	return u.Update(func(s *RequestTransformRuleUpsert) {
+		s.UpdateGroupIds()
+	})
+}
+
+// ClearGroupIds clears the value of the "group_ids" field.
+func (u *RequestTransformRuleUpsertOne) ClearGroupIds() *RequestTransformRuleUpsertOne {
+	return u.Update(func(s *RequestTransformRuleUpsert) {
+		s.ClearGroupIds()
+	})
+}
+
+// SetAccountIds sets the "account_ids" field.
+func (u *RequestTransformRuleUpsertOne) SetAccountIds(v []int64) *RequestTransformRuleUpsertOne {
+	return u.Update(func(s *RequestTransformRuleUpsert) {
+		s.SetAccountIds(v)
+	})
+}
+
+// UpdateAccountIds sets the "account_ids" field to the value that was provided on create.
+func (u *RequestTransformRuleUpsertOne) UpdateAccountIds() *RequestTransformRuleUpsertOne {
+	return u.Update(func(s *RequestTransformRuleUpsert) {
+		s.UpdateAccountIds()
+	})
+}
+
+// ClearAccountIds clears the value of the "account_ids" field.
+func (u *RequestTransformRuleUpsertOne) ClearAccountIds() *RequestTransformRuleUpsertOne {
+	return u.Update(func(s *RequestTransformRuleUpsert) {
+		s.ClearAccountIds()
+	})
+}
+
+// SetModelPatterns sets the "model_patterns" field.
+func (u *RequestTransformRuleUpsertOne) SetModelPatterns(v []string) *RequestTransformRuleUpsertOne {
+	return u.Update(func(s *RequestTransformRuleUpsert) {
+		s.SetModelPatterns(v)
+	})
+}
+
+// UpdateModelPatterns sets the "model_patterns" field to the value that was provided on create.
+func (u *RequestTransformRuleUpsertOne) UpdateModelPatterns() *RequestTransformRuleUpsertOne {
+	return u.Update(func(s *RequestTransformRuleUpsert) {
+		s.UpdateModelPatterns()
+	})
+}
+
+// ClearModelPatterns clears the value of the "model_patterns" field.
+func (u *RequestTransformRuleUpsertOne) ClearModelPatterns() *RequestTransformRuleUpsertOne {
+	return u.Update(func(s *RequestTransformRuleUpsert) {
+		s.ClearModelPatterns()
+	})
+}
+
+// SetBodyOperations sets the "body_operations" field.
+func (u *RequestTransformRuleUpsertOne) SetBodyOperations(v []model.RequestBodyOperation) *RequestTransformRuleUpsertOne {
+	return u.Update(func(s *RequestTransformRuleUpsert) {
+		s.SetBodyOperations(v)
+	})
+}
+
+// UpdateBodyOperations sets the "body_operations" field to the value that was provided on create.
+func (u *RequestTransformRuleUpsertOne) UpdateBodyOperations() *RequestTransformRuleUpsertOne {
+	return u.Update(func(s *RequestTransformRuleUpsert) {
+		s.UpdateBodyOperations()
+	})
+}
+
+// ClearBodyOperations clears the value of the "body_operations" field.
+func (u *RequestTransformRuleUpsertOne) ClearBodyOperations() *RequestTransformRuleUpsertOne {
+	return u.Update(func(s *RequestTransformRuleUpsert) {
+		s.ClearBodyOperations()
+	})
+}
+
+// SetHeaderOperations sets the "header_operations" field.
+func (u *RequestTransformRuleUpsertOne) SetHeaderOperations(v []model.RequestHeaderOperation) *RequestTransformRuleUpsertOne {
+	return u.Update(func(s *RequestTransformRuleUpsert) {
+		s.SetHeaderOperations(v)
+	})
+}
+
+// UpdateHeaderOperations sets the "header_operations" field to the value that was provided on create.
+func (u *RequestTransformRuleUpsertOne) UpdateHeaderOperations() *RequestTransformRuleUpsertOne {
+	return u.Update(func(s *RequestTransformRuleUpsert) {
+		s.UpdateHeaderOperations()
+	})
+}
+
+// ClearHeaderOperations clears the value of the "header_operations" field.
+func (u *RequestTransformRuleUpsertOne) ClearHeaderOperations() *RequestTransformRuleUpsertOne {
+	return u.Update(func(s *RequestTransformRuleUpsert) {
+		s.ClearHeaderOperations()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *RequestTransformRuleUpsertOne) SetDescription(v string) *RequestTransformRuleUpsertOne {
+	return u.Update(func(s *RequestTransformRuleUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *RequestTransformRuleUpsertOne) UpdateDescription() *RequestTransformRuleUpsertOne {
+	return u.Update(func(s *RequestTransformRuleUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *RequestTransformRuleUpsertOne) ClearDescription() *RequestTransformRuleUpsertOne {
+	return u.Update(func(s *RequestTransformRuleUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// Exec executes the query.
+func (u *RequestTransformRuleUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RequestTransformRuleCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RequestTransformRuleUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *RequestTransformRuleUpsertOne) ID(ctx context.Context) (id int64, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *RequestTransformRuleUpsertOne) IDX(ctx context.Context) int64 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// RequestTransformRuleCreateBulk is the builder for creating many RequestTransformRule entities in bulk.
+type RequestTransformRuleCreateBulk struct {
+	config
+	err      error
+	builders []*RequestTransformRuleCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the RequestTransformRule entities in the database.
+func (_c *RequestTransformRuleCreateBulk) Save(ctx context.Context) ([]*RequestTransformRule, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*RequestTransformRule, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RequestTransformRuleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *RequestTransformRuleCreateBulk) SaveX(ctx context.Context) []*RequestTransformRule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RequestTransformRuleCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RequestTransformRuleCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.RequestTransformRule.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.RequestTransformRuleUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *RequestTransformRuleCreateBulk) OnConflict(opts ...sql.ConflictOption) *RequestTransformRuleUpsertBulk {
+	_c.conflict = opts
+	return &RequestTransformRuleUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.RequestTransformRule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *RequestTransformRuleCreateBulk) OnConflictColumns(columns ...string) *RequestTransformRuleUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &RequestTransformRuleUpsertBulk{
+		create: _c,
+	}
+}
+
+// RequestTransformRuleUpsertBulk is the builder for "upsert"-ing
+// a bulk of RequestTransformRule nodes.
+type RequestTransformRuleUpsertBulk struct {
+	create *RequestTransformRuleCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.RequestTransformRule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *RequestTransformRuleUpsertBulk) UpdateNewValues() *RequestTransformRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(requesttransformrule.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.RequestTransformRule.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *RequestTransformRuleUpsertBulk) Ignore() *RequestTransformRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *RequestTransformRuleUpsertBulk) DoNothing() *RequestTransformRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the RequestTransformRuleCreateBulk.OnConflict
+// documentation for more info.
+func (u *RequestTransformRuleUpsertBulk) Update(set func(*RequestTransformRuleUpsert)) *RequestTransformRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&RequestTransformRuleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *RequestTransformRuleUpsertBulk) SetUpdatedAt(v time.Time) *RequestTransformRuleUpsertBulk {
+	return u.Update(func(s *RequestTransformRuleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *RequestTransformRuleUpsertBulk) UpdateUpdatedAt() *RequestTransformRuleUpsertBulk {
+	return u.Update(func(s *RequestTransformRuleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *RequestTransformRuleUpsertBulk) SetName(v string) *RequestTransformRuleUpsertBulk {
+	return u.Update(func(s *RequestTransformRuleUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *RequestTransformRuleUpsertBulk) UpdateName() *RequestTransformRuleUpsertBulk {
+	return u.Update(func(s *RequestTransformRuleUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *RequestTransformRuleUpsertBulk) SetEnabled(v bool) *RequestTransformRuleUpsertBulk {
+	return u.Update(func(s *RequestTransformRuleUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *RequestTransformRuleUpsertBulk) UpdateEnabled() *RequestTransformRuleUpsertBulk {
+	return u.Update(func(s *RequestTransformRuleUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetPriority sets the "priority" field.
+func (u *RequestTransformRuleUpsertBulk) SetPriority(v int) *RequestTransformRuleUpsertBulk {
+	return u.Update(func(s *RequestTransformRuleUpsert) {
+		s.SetPriority(v)
+	})
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *RequestTransformRuleUpsertBulk) AddPriority(v int) *RequestTransformRuleUpsertBulk {
+	return u.Update(func(s *RequestTransformRuleUpsert) {
+		s.AddPriority(v)
+	})
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *RequestTransformRuleUpsertBulk) UpdatePriority() *RequestTransformRuleUpsertBulk {
+	return u.Update(func(s *RequestTransformRuleUpsert) {
+		s.UpdatePriority()
+	})
+}
+
+// SetPlatforms sets the "platforms" field.
+func (u *RequestTransformRuleUpsertBulk) SetPlatforms(v []string) *RequestTransformRuleUpsertBulk {
+	return u.Update(func(s *RequestTransformRuleUpsert) {
+		s.SetPlatforms(v)
+	})
+}
+
+// UpdatePlatforms sets the "platforms" field to the value that was provided on create.
+func (u *RequestTransformRuleUpsertBulk) UpdatePlatforms() *RequestTransformRuleUpsertBulk {
+	return u.Update(func(s *RequestTransformRuleUpsert) {
+		s.UpdatePlatforms()
+	})
+}
+
+// ClearPlatforms clears the value of the "platforms" field.
+func (u *RequestTransformRuleUpsertBulk) ClearPlatforms() *RequestTransformRuleUpsertBulk {
+	return u.Update(func(s *RequestTransformRuleUpsert) {
+		s.ClearPlatforms()
+	})
+}
+
+// SetGroupIds sets the "group_ids" field.
+func (u *RequestTransformRuleUpsertBulk) SetGroupIds(v []int64) *RequestTransformRuleUpsertBulk {
+	return u.Update(func(s *RequestTransformRuleUpsert) {
+		s.SetGroupIds(v)
+	})
+}
+
+// UpdateGroupIds sets the "group_ids" field to the value that was provided on create.
+func (u *RequestTransformRuleUpsertBulk) UpdateGroupIds() *RequestTransformRuleUpsertBulk {
+	return u.Update(func(s *RequestTransformRuleUpsert) {
+		s.UpdateGroupIds()
+	})
+}
+
+// ClearGroupIds clears the value of the "group_ids" field.
+func (u *RequestTransformRuleUpsertBulk) ClearGroupIds() *RequestTransformRuleUpsertBulk {
+	return u.Update(func(s *RequestTransformRuleUpsert) {
+		s.ClearGroupIds()
+	})
+}
+
+// SetAccountIds sets the "account_ids" field.
+func (u *RequestTransformRuleUpsertBulk) SetAccountIds(v []int64) *RequestTransformRuleUpsertBulk {
+	return u.Update(func(s *RequestTransformRuleUpsert) {
+		s.SetAccountIds(v)
+	})
+}
+
+// UpdateAccountIds sets the "account_ids" field to the value that was provided on create.
+func (u *RequestTransformRuleUpsertBulk) UpdateAccountIds() *RequestTransformRuleUpsertBulk {
+	return u.Update(func(s *RequestTransformRuleUpsert) {
+		s.UpdateAccountIds()
+	})
+}
+
+// ClearAccountIds clears the value of the "account_ids" field.
+func (u *RequestTransformRuleUpsertBulk) ClearAccountIds() *RequestTransformRuleUpsertBulk {
+	return u.Update(func(s *RequestTransformRuleUpsert) {
+		s.ClearAccountIds()
+	})
+}
+
+// SetModelPatterns sets the "model_patterns" field.
+func (u *RequestTransformRuleUpsertBulk) SetModelPatterns(v []string) *RequestTransformRuleUpsertBulk {
+	return u.Update(func(s *RequestTransformRuleUpsert) {
+		s.SetModelPatterns(v)
+	})
+}
+
+// UpdateModelPatterns sets the "model_patterns" field to the value that was provided on create.
+func (u *RequestTransformRuleUpsertBulk) UpdateModelPatterns() *RequestTransformRuleUpsertBulk {
+	return u.Update(func(s *RequestTransformRuleUpsert) {
+		s.UpdateModelPatterns()
+	})
+}
+
+// ClearModelPatterns clears the value of the "model_patterns" field.
+func (u *RequestTransformRuleUpsertBulk) ClearModelPatterns() *RequestTransformRuleUpsertBulk {
+	return u.Update(func(s *RequestTransformRuleUpsert) {
+		s.ClearModelPatterns()
+	})
+}
+
+// SetBodyOperations sets the "body_operations" field.
+func (u *RequestTransformRuleUpsertBulk) SetBodyOperations(v []model.RequestBodyOperation) *RequestTransformRuleUpsertBulk {
+	return u.Update(func(s *RequestTransformRuleUpsert) {
+		s.SetBodyOperations(v)
+	})
+}
+
+// UpdateBodyOperations sets the "body_operations" field to the value that was provided on create.
+func (u *RequestTransformRuleUpsertBulk) UpdateBodyOperations() *RequestTransformRuleUpsertBulk {
+	return u.Update(func(s *RequestTransformRuleUpsert) {
+		s.UpdateBodyOperations()
+	})
+}
+
+// ClearBodyOperations clears the value of the "body_operations" field.
+func (u *RequestTransformRuleUpsertBulk) ClearBodyOperations() *RequestTransformRuleUpsertBulk {
+	return u.Update(func(s *RequestTransformRuleUpsert) {
+		s.ClearBodyOperations()
+	})
+}
+
+// SetHeaderOperations sets the "header_operations" field.
+func (u *RequestTransformRuleUpsertBulk) SetHeaderOperations(v []model.RequestHeaderOperation) *RequestTransformRuleUpsertBulk {
+	return u.Update(func(s *RequestTransformRuleUpsert) {
+		s.SetHeaderOperations(v)
+	})
+}
+
+// UpdateHeaderOperations sets the "header_operations" field to the value that was provided on create.
+func (u *RequestTransformRuleUpsertBulk) UpdateHeaderOperations() *RequestTransformRuleUpsertBulk {
+	return u.Update(func(s *RequestTransformRuleUpsert) {
+		s.UpdateHeaderOperations()
+	})
+}
+
+// ClearHeaderOperations clears the value of the "header_operations" field.
+func (u *RequestTransformRuleUpsertBulk) ClearHeaderOperations() *RequestTransformRuleUpsertBulk {
+	return u.Update(func(s *RequestTransformRuleUpsert) {
+		s.ClearHeaderOperations()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *RequestTransformRuleUpsertBulk) SetDescription(v string) *RequestTransformRuleUpsertBulk {
+	return u.Update(func(s *RequestTransformRuleUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *RequestTransformRuleUpsertBulk) UpdateDescription() *RequestTransformRuleUpsertBulk {
+	return u.Update(func(s *RequestTransformRuleUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *RequestTransformRuleUpsertBulk) ClearDescription() *RequestTransformRuleUpsertBulk {
+	return u.Update(func(s *RequestTransformRuleUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// Exec executes the query.
+func (u *RequestTransformRuleUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the RequestTransformRuleCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for RequestTransformRuleCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *RequestTransformRuleUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
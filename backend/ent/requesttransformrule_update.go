@@ -0,0 +1,762 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/Wei-Shaw/sub2api/ent/predicate"
+	"github.com/Wei-Shaw/sub2api/ent/requesttransformrule"
+	"github.com/Wei-Shaw/sub2api/internal/model"
+)
+
+// RequestTransformRuleUpdate is the builder for updating RequestTransformRule entities.
+type RequestTransformRuleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RequestTransformRuleMutation
+}
+
+// Where appends a list predicates to the RequestTransformRuleUpdate builder.
+func (_u *RequestTransformRuleUpdate) Where(ps ...predicate.RequestTransformRule) *RequestTransformRuleUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *RequestTransformRuleUpdate) SetUpdatedAt(v time.Time) *RequestTransformRuleUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *RequestTransformRuleUpdate) SetName(v string) *RequestTransformRuleUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *RequestTransformRuleUpdate) SetNillableName(v *string) *RequestTransformRuleUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *RequestTransformRuleUpdate) SetEnabled(v bool) *RequestTransformRuleUpdate {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *RequestTransformRuleUpdate) SetNillableEnabled(v *bool) *RequestTransformRuleUpdate {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetPriority sets the "priority" field.
+func (_u *RequestTransformRuleUpdate) SetPriority(v int) *RequestTransformRuleUpdate {
+	_u.mutation.ResetPriority()
+	_u.mutation.SetPriority(v)
+	return _u
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_u *RequestTransformRuleUpdate) SetNillablePriority(v *int) *RequestTransformRuleUpdate {
+	if v != nil {
+		_u.SetPriority(*v)
+	}
+	return _u
+}
+
+// AddPriority adds value to the "priority" field.
+func (_u *RequestTransformRuleUpdate) AddPriority(v int) *RequestTransformRuleUpdate {
+	_u.mutation.AddPriority(v)
+	return _u
+}
+
+// SetPlatforms sets the "platforms" field.
+func (_u *RequestTransformRuleUpdate) SetPlatforms(v []string) *RequestTransformRuleUpdate {
+	_u.mutation.SetPlatforms(v)
+	return _u
+}
+
+// AppendPlatforms appends value to the "platforms" field.
+func (_u *RequestTransformRuleUpdate) AppendPlatforms(v []string) *RequestTransformRuleUpdate {
+	_u.mutation.AppendPlatforms(v)
+	return _u
+}
+
+// ClearPlatforms clears the value of the "platforms" field.
+func (_u *RequestTransformRuleUpdate) ClearPlatforms() *RequestTransformRuleUpdate {
+	_u.mutation.ClearPlatforms()
+	return _u
+}
+
+// SetGroupIds sets the "group_ids" field.
+func (_u *RequestTransformRuleUpdate) SetGroupIds(v []int64) *RequestTransformRuleUpdate {
+	_u.mutation.SetGroupIds(v)
+	return _u
+}
+
+// AppendGroupIds appends value to the "group_ids" field.
+func (_u *RequestTransformRuleUpdate) AppendGroupIds(v []int64) *RequestTransformRuleUpdate {
+	_u.mutation.AppendGroupIds(v)
+	return _u
+}
+
+// ClearGroupIds clears the value of the "group_ids" field.
+func (_u *RequestTransformRuleUpdate) ClearGroupIds() *RequestTransformRuleUpdate {
+	_u.mutation.ClearGroupIds()
+	return _u
+}
+
+// SetAccountIds sets the "account_ids" field.
+func (_u *RequestTransformRuleUpdate) SetAccountIds(v []int64) *RequestTransformRuleUpdate {
+	_u.mutation.SetAccountIds(v)
+	return _u
+}
+
+// AppendAccountIds appends value to the "account_ids" field.
+func (_u *RequestTransformRuleUpdate) AppendAccountIds(v []int64) *RequestTransformRuleUpdate {
+	_u.mutation.AppendAccountIds(v)
+	return _u
+}
+
+// ClearAccountIds clears the value of the "account_ids" field.
+func (_u *RequestTransformRuleUpdate) ClearAccountIds() *RequestTransformRuleUpdate {
+	_u.mutation.ClearAccountIds()
+	return _u
+}
+
+// SetModelPatterns sets the "model_patterns" field.
+func (_u *RequestTransformRuleUpdate) SetModelPatterns(v []string) *RequestTransformRuleUpdate {
+	_u.mutation.SetModelPatterns(v)
+	return _u
+}
+
+// AppendModelPatterns appends value to the "model_patterns" field.
+func (_u *RequestTransformRuleUpdate) AppendModelPatterns(v []string) *RequestTransformRuleUpdate {
+	_u.mutation.AppendModelPatterns(v)
+	return _u
+}
+
+// ClearModelPatterns clears the value of the "model_patterns" field.
+func (_u *RequestTransformRuleUpdate) ClearModelPatterns() *RequestTransformRuleUpdate {
+	_u.mutation.ClearModelPatterns()
+	return _u
+}
+
+// SetBodyOperations sets the "body_operations" field.
+func (_u *RequestTransformRuleUpdate) SetBodyOperations(v []model.RequestBodyOperation) *RequestTransformRuleUpdate {
+	_u.mutation.SetBodyOperations(v)
+	return _u
+}
+
+// AppendBodyOperations appends value to the "body_operations" field.
+func (_u *RequestTransformRuleUpdate) AppendBodyOperations(v []model.RequestBodyOperation) *RequestTransformRuleUpdate {
+	_u.mutation.AppendBodyOperations(v)
+	return _u
+}
+
+// ClearBodyOperations clears the value of the "body_operations" field.
+func (_u *RequestTransformRuleUpdate) ClearBodyOperations() *RequestTransformRuleUpdate {
+	_u.mutation.ClearBodyOperations()
+	return _u
+}
+
+// SetHeaderOperations sets the "header_operations" field.
+func (_u *RequestTransformRuleUpdate) SetHeaderOperations(v []model.RequestHeaderOperation) *RequestTransformRuleUpdate {
+	_u.mutation.SetHeaderOperations(v)
+	return _u
+}
+
+// AppendHeaderOperations appends value to the "header_operations" field.
+func (_u *RequestTransformRuleUpdate) AppendHeaderOperations(v []model.RequestHeaderOperation) *RequestTransformRuleUpdate {
+	_u.mutation.AppendHeaderOperations(v)
+	return _u
+}
+
+// ClearHeaderOperations clears the value of the "header_operations" field.
+func (_u *RequestTransformRuleUpdate) ClearHeaderOperations() *RequestTransformRuleUpdate {
+	_u.mutation.ClearHeaderOperations()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *RequestTransformRuleUpdate) SetDescription(v string) *RequestTransformRuleUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *RequestTransformRuleUpdate) SetNillableDescription(v *string) *RequestTransformRuleUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *RequestTransformRuleUpdate) ClearDescription() *RequestTransformRuleUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// Mutation returns the RequestTransformRuleMutation object of the builder.
+func (_u *RequestTransformRuleUpdate) Mutation() *RequestTransformRuleMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *RequestTransformRuleUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RequestTransformRuleUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *RequestTransformRuleUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RequestTransformRuleUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *RequestTransformRuleUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := requesttransformrule.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RequestTransformRuleUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := requesttransformrule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "RequestTransformRule.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *RequestTransformRuleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(requesttransformrule.Table, requesttransformrule.Columns, sqlgraph.NewFieldSpec(requesttransformrule.FieldID, field.TypeInt64))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(requesttransformrule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(requesttransformrule.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(requesttransformrule.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Priority(); ok {
+		_spec.SetField(requesttransformrule.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPriority(); ok {
+		_spec.AddField(requesttransformrule.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Platforms(); ok {
+		_spec.SetField(requesttransformrule.FieldPlatforms, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPlatforms(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, requesttransformrule.FieldPlatforms, value)
+		})
+	}
+	if _u.mutation.PlatformsCleared() {
+		_spec.ClearField(requesttransformrule.FieldPlatforms, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.GroupIds(); ok {
+		_spec.SetField(requesttransformrule.FieldGroupIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedGroupIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, requesttransformrule.FieldGroupIds, value)
+		})
+	}
+	if _u.mutation.GroupIdsCleared() {
+		_spec.ClearField(requesttransformrule.FieldGroupIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccountIds(); ok {
+		_spec.SetField(requesttransformrule.FieldAccountIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAccountIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, requesttransformrule.FieldAccountIds, value)
+		})
+	}
+	if _u.mutation.AccountIdsCleared() {
+		_spec.ClearField(requesttransformrule.FieldAccountIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ModelPatterns(); ok {
+		_spec.SetField(requesttransformrule.FieldModelPatterns, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedModelPatterns(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, requesttransformrule.FieldModelPatterns, value)
+		})
+	}
+	if _u.mutation.ModelPatternsCleared() {
+		_spec.ClearField(requesttransformrule.FieldModelPatterns, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.BodyOperations(); ok {
+		_spec.SetField(requesttransformrule.FieldBodyOperations, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedBodyOperations(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, requesttransformrule.FieldBodyOperations, value)
+		})
+	}
+	if _u.mutation.BodyOperationsCleared() {
+		_spec.ClearField(requesttransformrule.FieldBodyOperations, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.HeaderOperations(); ok {
+		_spec.SetField(requesttransformrule.FieldHeaderOperations, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedHeaderOperations(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, requesttransformrule.FieldHeaderOperations, value)
+		})
+	}
+	if _u.mutation.HeaderOperationsCleared() {
+		_spec.ClearField(requesttransformrule.FieldHeaderOperations, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(requesttransformrule.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(requesttransformrule.FieldDescription, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{requesttransformrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// RequestTransformRuleUpdateOne is the builder for updating a single RequestTransformRule entity.
+type RequestTransformRuleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RequestTransformRuleMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *RequestTransformRuleUpdateOne) SetUpdatedAt(v time.Time) *RequestTransformRuleUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *RequestTransformRuleUpdateOne) SetName(v string) *RequestTransformRuleUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *RequestTransformRuleUpdateOne) SetNillableName(v *string) *RequestTransformRuleUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *RequestTransformRuleUpdateOne) SetEnabled(v bool) *RequestTransformRuleUpdateOne {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *RequestTransformRuleUpdateOne) SetNillableEnabled(v *bool) *RequestTransformRuleUpdateOne {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetPriority sets the "priority" field.
+func (_u *RequestTransformRuleUpdateOne) SetPriority(v int) *RequestTransformRuleUpdateOne {
+	_u.mutation.ResetPriority()
+	_u.mutation.SetPriority(v)
+	return _u
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_u *RequestTransformRuleUpdateOne) SetNillablePriority(v *int) *RequestTransformRuleUpdateOne {
+	if v != nil {
+		_u.SetPriority(*v)
+	}
+	return _u
+}
+
+// AddPriority adds value to the "priority" field.
+func (_u *RequestTransformRuleUpdateOne) AddPriority(v int) *RequestTransformRuleUpdateOne {
+	_u.mutation.AddPriority(v)
+	return _u
+}
+
+// SetPlatforms sets the "platforms" field.
+func (_u *RequestTransformRuleUpdateOne) SetPlatforms(v []string) *RequestTransformRuleUpdateOne {
+	_u.mutation.SetPlatforms(v)
+	return _u
+}
+
+// AppendPlatforms appends value to the "platforms" field.
+func (_u *RequestTransformRuleUpdateOne) AppendPlatforms(v []string) *RequestTransformRuleUpdateOne {
+	_u.mutation.AppendPlatforms(v)
+	return _u
+}
+
+// ClearPlatforms clears the value of the "platforms" field.
+func (_u *RequestTransformRuleUpdateOne) ClearPlatforms() *RequestTransformRuleUpdateOne {
+	_u.mutation.ClearPlatforms()
+	return _u
+}
+
+// SetGroupIds sets the "group_ids" field.
+func (_u *RequestTransformRuleUpdateOne) SetGroupIds(v []int64) *RequestTransformRuleUpdateOne {
+	_u.mutation.SetGroupIds(v)
+	return _u
+}
+
+// AppendGroupIds appends value to the "group_ids" field.
+func (_u *RequestTransformRuleUpdateOne) AppendGroupIds(v []int64) *RequestTransformRuleUpdateOne {
+	_u.mutation.AppendGroupIds(v)
+	return _u
+}
+
+// ClearGroupIds clears the value of the "group_ids" field.
+func (_u *RequestTransformRuleUpdateOne) ClearGroupIds() *RequestTransformRuleUpdateOne {
+	_u.mutation.ClearGroupIds()
+	return _u
+}
+
+// SetAccountIds sets the "account_ids" field.
+func (_u *RequestTransformRuleUpdateOne) SetAccountIds(v []int64) *RequestTransformRuleUpdateOne {
+	_u.mutation.SetAccountIds(v)
+	return _u
+}
+
+// AppendAccountIds appends value to the "account_ids" field.
+func (_u *RequestTransformRuleUpdateOne) AppendAccountIds(v []int64) *RequestTransformRuleUpdateOne {
+	_u.mutation.AppendAccountIds(v)
+	return _u
+}
+
+// ClearAccountIds clears the value of the "account_ids" field.
+func (_u *RequestTransformRuleUpdateOne) ClearAccountIds() *RequestTransformRuleUpdateOne {
+	_u.mutation.ClearAccountIds()
+	return _u
+}
+
+// SetModelPatterns sets the "model_patterns" field.
+func (_u *RequestTransformRuleUpdateOne) SetModelPatterns(v []string) *RequestTransformRuleUpdateOne {
+	_u.mutation.SetModelPatterns(v)
+	return _u
+}
+
+// AppendModelPatterns appends value to the "model_patterns" field.
+func (_u *RequestTransformRuleUpdateOne) AppendModelPatterns(v []string) *RequestTransformRuleUpdateOne {
+	_u.mutation.AppendModelPatterns(v)
+	return _u
+}
+
+// ClearModelPatterns clears the value of the "model_patterns" field.
+func (_u *RequestTransformRuleUpdateOne) ClearModelPatterns() *RequestTransformRuleUpdateOne {
+	_u.mutation.ClearModelPatterns()
+	return _u
+}
+
+// SetBodyOperations sets the "body_operations" field.
+func (_u *RequestTransformRuleUpdateOne) SetBodyOperations(v []model.RequestBodyOperation) *RequestTransformRuleUpdateOne {
+	_u.mutation.SetBodyOperations(v)
+	return _u
+}
+
+// AppendBodyOperations appends value to the "body_operations" field.
+func (_u *RequestTransformRuleUpdateOne) AppendBodyOperations(v []model.RequestBodyOperation) *RequestTransformRuleUpdateOne {
+	_u.mutation.AppendBodyOperations(v)
+	return _u
+}
+
+// ClearBodyOperations clears the value of the "body_operations" field.
+func (_u *RequestTransformRuleUpdateOne) ClearBodyOperations() *RequestTransformRuleUpdateOne {
+	_u.mutation.ClearBodyOperations()
+	return _u
+}
+
+// SetHeaderOperations sets the "header_operations" field.
+func (_u *RequestTransformRuleUpdateOne) SetHeaderOperations(v []model.RequestHeaderOperation) *RequestTransformRuleUpdateOne {
+	_u.mutation.SetHeaderOperations(v)
+	return _u
+}
+
+// AppendHeaderOperations appends value to the "header_operations" field.
+func (_u *RequestTransformRuleUpdateOne) AppendHeaderOperations(v []model.RequestHeaderOperation) *RequestTransformRuleUpdateOne {
+	_u.mutation.AppendHeaderOperations(v)
+	return _u
+}
+
+// ClearHeaderOperations clears the value of the "header_operations" field.
+func (_u *RequestTransformRuleUpdateOne) ClearHeaderOperations() *RequestTransformRuleUpdateOne {
+	_u.mutation.ClearHeaderOperations()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *RequestTransformRuleUpdateOne) SetDescription(v string) *RequestTransformRuleUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *RequestTransformRuleUpdateOne) SetNillableDescription(v *string) *RequestTransformRuleUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *RequestTransformRuleUpdateOne) ClearDescription() *RequestTransformRuleUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// Mutation returns the RequestTransformRuleMutation object of the builder.
+func (_u *RequestTransformRuleUpdateOne) Mutation() *RequestTransformRuleMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the RequestTransformRuleUpdate builder.
+func (_u *RequestTransformRuleUpdateOne) Where(ps ...predicate.RequestTransformRule) *RequestTransformRuleUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *RequestTransformRuleUpdateOne) Select(field string, fields ...string) *RequestTransformRuleUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated RequestTransformRule entity.
+func (_u *RequestTransformRuleUpdateOne) Save(ctx context.Context) (*RequestTransformRule, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RequestTransformRuleUpdateOne) SaveX(ctx context.Context) *RequestTransformRule {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *RequestTransformRuleUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RequestTransformRuleUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *RequestTransformRuleUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := requesttransformrule.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RequestTransformRuleUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := requesttransformrule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "RequestTransformRule.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *RequestTransformRuleUpdateOne) sqlSave(ctx context.Context) (_node *RequestTransformRule, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(requesttransformrule.Table, requesttransformrule.Columns, sqlgraph.NewFieldSpec(requesttransformrule.FieldID, field.TypeInt64))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RequestTransformRule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, requesttransformrule.FieldID)
+		for _, f := range fields {
+			if !requesttransformrule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != requesttransformrule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(requesttransformrule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(requesttransformrule.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(requesttransformrule.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Priority(); ok {
+		_spec.SetField(requesttransformrule.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPriority(); ok {
+		_spec.AddField(requesttransformrule.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Platforms(); ok {
+		_spec.SetField(requesttransformrule.FieldPlatforms, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPlatforms(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, requesttransformrule.FieldPlatforms, value)
+		})
+	}
+	if _u.mutation.PlatformsCleared() {
+		_spec.ClearField(requesttransformrule.FieldPlatforms, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.GroupIds(); ok {
+		_spec.SetField(requesttransformrule.FieldGroupIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedGroupIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, requesttransformrule.FieldGroupIds, value)
+		})
+	}
+	if _u.mutation.GroupIdsCleared() {
+		_spec.ClearField(requesttransformrule.FieldGroupIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AccountIds(); ok {
+		_spec.SetField(requesttransformrule.FieldAccountIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAccountIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, requesttransformrule.FieldAccountIds, value)
+		})
+	}
+	if _u.mutation.AccountIdsCleared() {
+		_spec.ClearField(requesttransformrule.FieldAccountIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ModelPatterns(); ok {
+		_spec.SetField(requesttransformrule.FieldModelPatterns, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedModelPatterns(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, requesttransformrule.FieldModelPatterns, value)
+		})
+	}
+	if _u.mutation.ModelPatternsCleared() {
+		_spec.ClearField(requesttransformrule.FieldModelPatterns, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.BodyOperations(); ok {
+		_spec.SetField(requesttransformrule.FieldBodyOperations, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedBodyOperations(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, requesttransformrule.FieldBodyOperations, value)
+		})
+	}
+	if _u.mutation.BodyOperationsCleared() {
+		_spec.ClearField(requesttransformrule.FieldBodyOperations, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.HeaderOperations(); ok {
+		_spec.SetField(requesttransformrule.FieldHeaderOperations, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedHeaderOperations(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, requesttransformrule.FieldHeaderOperations, value)
+		})
+	}
+	if _u.mutation.HeaderOperationsCleared() {
+		_spec.ClearField(requesttransformrule.FieldHeaderOperations, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(requesttransformrule.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(requesttransformrule.FieldDescription, field.TypeString)
+	}
+	_node = &RequestTransformRule{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{requesttransformrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -10,25 +10,41 @@ import (
 	"github.com/Wei-Shaw/sub2api/ent/announcement"
 	"github.com/Wei-Shaw/sub2api/ent/announcementread"
 	"github.com/Wei-Shaw/sub2api/ent/apikey"
+	"github.com/Wei-Shaw/sub2api/ent/balancebucket"
+	"github.com/Wei-Shaw/sub2api/ent/billingadjustment"
+	"github.com/Wei-Shaw/sub2api/ent/billingrecomputejob"
+	"github.com/Wei-Shaw/sub2api/ent/billingstatement"
 	"github.com/Wei-Shaw/sub2api/ent/channelmonitor"
 	"github.com/Wei-Shaw/sub2api/ent/channelmonitordailyrollup"
 	"github.com/Wei-Shaw/sub2api/ent/channelmonitorhistory"
 	"github.com/Wei-Shaw/sub2api/ent/channelmonitorrequesttemplate"
+	"github.com/Wei-Shaw/sub2api/ent/commissionwithdrawal"
+	"github.com/Wei-Shaw/sub2api/ent/commissionwithdrawallog"
 	"github.com/Wei-Shaw/sub2api/ent/errorpassthroughrule"
+	"github.com/Wei-Shaw/sub2api/ent/gatewayplugin"
 	"github.com/Wei-Shaw/sub2api/ent/group"
+	"github.com/Wei-Shaw/sub2api/ent/guardrailrule"
 	"github.com/Wei-Shaw/sub2api/ent/idempotencyrecord"
+	"github.com/Wei-Shaw/sub2api/ent/invoice"
+	"github.com/Wei-Shaw/sub2api/ent/invoicesequence"
 	"github.com/Wei-Shaw/sub2api/ent/paygorder"
 	"github.com/Wei-Shaw/sub2api/ent/paymentauditlog"
+	"github.com/Wei-Shaw/sub2api/ent/paymentcoupon"
 	"github.com/Wei-Shaw/sub2api/ent/paymentorder"
 	"github.com/Wei-Shaw/sub2api/ent/paymentproviderinstance"
+	"github.com/Wei-Shaw/sub2api/ent/postpaidaccount"
 	"github.com/Wei-Shaw/sub2api/ent/promocode"
 	"github.com/Wei-Shaw/sub2api/ent/promocodeusage"
 	"github.com/Wei-Shaw/sub2api/ent/proxy"
+	"github.com/Wei-Shaw/sub2api/ent/proxypool"
 	"github.com/Wei-Shaw/sub2api/ent/redeemcode"
+	"github.com/Wei-Shaw/sub2api/ent/referralcommission"
 	"github.com/Wei-Shaw/sub2api/ent/referralreward"
+	"github.com/Wei-Shaw/sub2api/ent/requesttransformrule"
 	"github.com/Wei-Shaw/sub2api/ent/schema"
 	"github.com/Wei-Shaw/sub2api/ent/securitysecret"
 	"github.com/Wei-Shaw/sub2api/ent/setting"
+	"github.com/Wei-Shaw/sub2api/ent/subscriptionautorenewal"
 	"github.com/Wei-Shaw/sub2api/ent/subscriptionplan"
 	"github.com/Wei-Shaw/sub2api/ent/usagecleanuptask"
 	"github.com/Wei-Shaw/sub2api/ent/usagelog"
@@ -37,6 +53,7 @@ import (
 	"github.com/Wei-Shaw/sub2api/ent/userattributedefinition"
 	"github.com/Wei-Shaw/sub2api/ent/userattributevalue"
 	"github.com/Wei-Shaw/sub2api/ent/usersubscription"
+	"github.com/Wei-Shaw/sub2api/internal/model"
 )
 
 // The init function reads all schema descriptors with runtime code
@@ -136,6 +153,12 @@ func init() {
 	apikeyDescUsage7d := apikeyFields[16].Descriptor()
 	// apikey.DefaultUsage7d holds the default value on creation for the usage_7d field.
 	apikey.DefaultUsage7d = apikeyDescUsage7d.Default.(float64)
+	// apikeyDescPriorityClass is the schema descriptor for priority_class field.
+	apikeyDescPriorityClass := apikeyFields[20].Descriptor()
+	// apikey.DefaultPriorityClass holds the default value on creation for the priority_class field.
+	apikey.DefaultPriorityClass = apikeyDescPriorityClass.Default.(string)
+	// apikey.PriorityClassValidator is a validator for the "priority_class" field. It is called by the builders before save.
+	apikey.PriorityClassValidator = apikeyDescPriorityClass.Validators[0].(func(string) error)
 	accountMixin := schema.Account{}.Mixin()
 	accountMixinHooks1 := accountMixin[1].Hooks()
 	account.Hooks[0] = accountMixinHooks1[0]
@@ -218,35 +241,39 @@ func init() {
 	// account.DefaultExtra holds the default value on creation for the extra field.
 	account.DefaultExtra = accountDescExtra.Default.(func() map[string]interface{})
 	// accountDescConcurrency is the schema descriptor for concurrency field.
-	accountDescConcurrency := accountFields[7].Descriptor()
+	accountDescConcurrency := accountFields[8].Descriptor()
 	// account.DefaultConcurrency holds the default value on creation for the concurrency field.
 	account.DefaultConcurrency = accountDescConcurrency.Default.(int)
 	// accountDescPriority is the schema descriptor for priority field.
-	accountDescPriority := accountFields[9].Descriptor()
+	accountDescPriority := accountFields[10].Descriptor()
 	// account.DefaultPriority holds the default value on creation for the priority field.
 	account.DefaultPriority = accountDescPriority.Default.(int)
 	// accountDescRateMultiplier is the schema descriptor for rate_multiplier field.
-	accountDescRateMultiplier := accountFields[10].Descriptor()
+	accountDescRateMultiplier := accountFields[11].Descriptor()
 	// account.DefaultRateMultiplier holds the default value on creation for the rate_multiplier field.
 	account.DefaultRateMultiplier = accountDescRateMultiplier.Default.(float64)
 	// accountDescStatus is the schema descriptor for status field.
-	accountDescStatus := accountFields[11].Descriptor()
+	accountDescStatus := accountFields[12].Descriptor()
 	// account.DefaultStatus holds the default value on creation for the status field.
 	account.DefaultStatus = accountDescStatus.Default.(string)
 	// account.StatusValidator is a validator for the "status" field. It is called by the builders before save.
 	account.StatusValidator = accountDescStatus.Validators[0].(func(string) error)
 	// accountDescAutoPauseOnExpired is the schema descriptor for auto_pause_on_expired field.
-	accountDescAutoPauseOnExpired := accountFields[15].Descriptor()
+	accountDescAutoPauseOnExpired := accountFields[16].Descriptor()
 	// account.DefaultAutoPauseOnExpired holds the default value on creation for the auto_pause_on_expired field.
 	account.DefaultAutoPauseOnExpired = accountDescAutoPauseOnExpired.Default.(bool)
 	// accountDescSchedulable is the schema descriptor for schedulable field.
-	accountDescSchedulable := accountFields[16].Descriptor()
+	accountDescSchedulable := accountFields[17].Descriptor()
 	// account.DefaultSchedulable holds the default value on creation for the schedulable field.
 	account.DefaultSchedulable = accountDescSchedulable.Default.(bool)
 	// accountDescSessionWindowStatus is the schema descriptor for session_window_status field.
-	accountDescSessionWindowStatus := accountFields[24].Descriptor()
+	accountDescSessionWindowStatus := accountFields[25].Descriptor()
 	// account.SessionWindowStatusValidator is a validator for the "session_window_status" field. It is called by the builders before save.
 	account.SessionWindowStatusValidator = accountDescSessionWindowStatus.Validators[0].(func(string) error)
+	// accountDescAvailabilitySchedule is the schema descriptor for availability_schedule field.
+	accountDescAvailabilitySchedule := accountFields[26].Descriptor()
+	// account.AvailabilityScheduleValidator is a validator for the "availability_schedule" field. It is called by the builders before save.
+	account.AvailabilityScheduleValidator = accountDescAvailabilitySchedule.Validators[0].(func(string) error)
 	accountgroupFields := schema.AccountGroup{}.Fields()
 	_ = accountgroupFields
 	// accountgroupDescPriority is the schema descriptor for priority field.
@@ -313,6 +340,120 @@ func init() {
 	announcementreadDescCreatedAt := announcementreadFields[3].Descriptor()
 	// announcementread.DefaultCreatedAt holds the default value on creation for the created_at field.
 	announcementread.DefaultCreatedAt = announcementreadDescCreatedAt.Default.(func() time.Time)
+	balancebucketFields := schema.BalanceBucket{}.Fields()
+	_ = balancebucketFields
+	// balancebucketDescSource is the schema descriptor for source field.
+	balancebucketDescSource := balancebucketFields[1].Descriptor()
+	// balancebucket.SourceValidator is a validator for the "source" field. It is called by the builders before save.
+	balancebucket.SourceValidator = balancebucketDescSource.Validators[0].(func(string) error)
+	// balancebucketDescSourceRef is the schema descriptor for source_ref field.
+	balancebucketDescSourceRef := balancebucketFields[2].Descriptor()
+	// balancebucket.DefaultSourceRef holds the default value on creation for the source_ref field.
+	balancebucket.DefaultSourceRef = balancebucketDescSourceRef.Default.(string)
+	// balancebucket.SourceRefValidator is a validator for the "source_ref" field. It is called by the builders before save.
+	balancebucket.SourceRefValidator = balancebucketDescSourceRef.Validators[0].(func(string) error)
+	// balancebucketDescPriority is the schema descriptor for priority field.
+	balancebucketDescPriority := balancebucketFields[5].Descriptor()
+	// balancebucket.DefaultPriority holds the default value on creation for the priority field.
+	balancebucket.DefaultPriority = balancebucketDescPriority.Default.(int)
+	// balancebucketDescStatus is the schema descriptor for status field.
+	balancebucketDescStatus := balancebucketFields[6].Descriptor()
+	// balancebucket.DefaultStatus holds the default value on creation for the status field.
+	balancebucket.DefaultStatus = balancebucketDescStatus.Default.(string)
+	// balancebucket.StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	balancebucket.StatusValidator = balancebucketDescStatus.Validators[0].(func(string) error)
+	// balancebucketDescExpiredAmount is the schema descriptor for expired_amount field.
+	balancebucketDescExpiredAmount := balancebucketFields[8].Descriptor()
+	// balancebucket.DefaultExpiredAmount holds the default value on creation for the expired_amount field.
+	balancebucket.DefaultExpiredAmount = balancebucketDescExpiredAmount.Default.(float64)
+	// balancebucketDescCreatedAt is the schema descriptor for created_at field.
+	balancebucketDescCreatedAt := balancebucketFields[9].Descriptor()
+	// balancebucket.DefaultCreatedAt holds the default value on creation for the created_at field.
+	balancebucket.DefaultCreatedAt = balancebucketDescCreatedAt.Default.(func() time.Time)
+	// balancebucketDescUpdatedAt is the schema descriptor for updated_at field.
+	balancebucketDescUpdatedAt := balancebucketFields[10].Descriptor()
+	// balancebucket.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	balancebucket.DefaultUpdatedAt = balancebucketDescUpdatedAt.Default.(func() time.Time)
+	// balancebucket.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	balancebucket.UpdateDefaultUpdatedAt = balancebucketDescUpdatedAt.UpdateDefault.(func() time.Time)
+	billingadjustmentFields := schema.BillingAdjustment{}.Fields()
+	_ = billingadjustmentFields
+	// billingadjustmentDescUsageRows is the schema descriptor for usage_rows field.
+	billingadjustmentDescUsageRows := billingadjustmentFields[5].Descriptor()
+	// billingadjustment.DefaultUsageRows holds the default value on creation for the usage_rows field.
+	billingadjustment.DefaultUsageRows = billingadjustmentDescUsageRows.Default.(int64)
+	// billingadjustmentDescCreatedAt is the schema descriptor for created_at field.
+	billingadjustmentDescCreatedAt := billingadjustmentFields[8].Descriptor()
+	// billingadjustment.DefaultCreatedAt holds the default value on creation for the created_at field.
+	billingadjustment.DefaultCreatedAt = billingadjustmentDescCreatedAt.Default.(func() time.Time)
+	billingrecomputejobFields := schema.BillingRecomputeJob{}.Fields()
+	_ = billingrecomputejobFields
+	// billingrecomputejobDescStatus is the schema descriptor for status field.
+	billingrecomputejobDescStatus := billingrecomputejobFields[0].Descriptor()
+	// billingrecomputejob.DefaultStatus holds the default value on creation for the status field.
+	billingrecomputejob.DefaultStatus = billingrecomputejobDescStatus.Default.(string)
+	// billingrecomputejob.StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	billingrecomputejob.StatusValidator = billingrecomputejobDescStatus.Validators[0].(func(string) error)
+	// billingrecomputejobDescMode is the schema descriptor for mode field.
+	billingrecomputejobDescMode := billingrecomputejobFields[1].Descriptor()
+	// billingrecomputejob.ModeValidator is a validator for the "mode" field. It is called by the builders before save.
+	billingrecomputejob.ModeValidator = billingrecomputejobDescMode.Validators[0].(func(string) error)
+	// billingrecomputejobDescScannedRows is the schema descriptor for scanned_rows field.
+	billingrecomputejobDescScannedRows := billingrecomputejobFields[4].Descriptor()
+	// billingrecomputejob.DefaultScannedRows holds the default value on creation for the scanned_rows field.
+	billingrecomputejob.DefaultScannedRows = billingrecomputejobDescScannedRows.Default.(int64)
+	// billingrecomputejobDescChangedRows is the schema descriptor for changed_rows field.
+	billingrecomputejobDescChangedRows := billingrecomputejobFields[5].Descriptor()
+	// billingrecomputejob.DefaultChangedRows holds the default value on creation for the changed_rows field.
+	billingrecomputejob.DefaultChangedRows = billingrecomputejobDescChangedRows.Default.(int64)
+	// billingrecomputejobDescOriginalCost is the schema descriptor for original_cost field.
+	billingrecomputejobDescOriginalCost := billingrecomputejobFields[6].Descriptor()
+	// billingrecomputejob.DefaultOriginalCost holds the default value on creation for the original_cost field.
+	billingrecomputejob.DefaultOriginalCost = billingrecomputejobDescOriginalCost.Default.(float64)
+	// billingrecomputejobDescRecomputedCost is the schema descriptor for recomputed_cost field.
+	billingrecomputejobDescRecomputedCost := billingrecomputejobFields[7].Descriptor()
+	// billingrecomputejob.DefaultRecomputedCost holds the default value on creation for the recomputed_cost field.
+	billingrecomputejob.DefaultRecomputedCost = billingrecomputejobDescRecomputedCost.Default.(float64)
+	// billingrecomputejobDescBalanceDiff is the schema descriptor for balance_diff field.
+	billingrecomputejobDescBalanceDiff := billingrecomputejobFields[8].Descriptor()
+	// billingrecomputejob.DefaultBalanceDiff holds the default value on creation for the balance_diff field.
+	billingrecomputejob.DefaultBalanceDiff = billingrecomputejobDescBalanceDiff.Default.(float64)
+	// billingrecomputejobDescCreatedAt is the schema descriptor for created_at field.
+	billingrecomputejobDescCreatedAt := billingrecomputejobFields[15].Descriptor()
+	// billingrecomputejob.DefaultCreatedAt holds the default value on creation for the created_at field.
+	billingrecomputejob.DefaultCreatedAt = billingrecomputejobDescCreatedAt.Default.(func() time.Time)
+	// billingrecomputejobDescUpdatedAt is the schema descriptor for updated_at field.
+	billingrecomputejobDescUpdatedAt := billingrecomputejobFields[16].Descriptor()
+	// billingrecomputejob.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	billingrecomputejob.DefaultUpdatedAt = billingrecomputejobDescUpdatedAt.Default.(func() time.Time)
+	// billingrecomputejob.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	billingrecomputejob.UpdateDefaultUpdatedAt = billingrecomputejobDescUpdatedAt.UpdateDefault.(func() time.Time)
+	billingstatementFields := schema.BillingStatement{}.Fields()
+	_ = billingstatementFields
+	// billingstatementDescAmount is the schema descriptor for amount field.
+	billingstatementDescAmount := billingstatementFields[3].Descriptor()
+	// billingstatement.DefaultAmount holds the default value on creation for the amount field.
+	billingstatement.DefaultAmount = billingstatementDescAmount.Default.(float64)
+	// billingstatementDescRequestCount is the schema descriptor for request_count field.
+	billingstatementDescRequestCount := billingstatementFields[4].Descriptor()
+	// billingstatement.DefaultRequestCount holds the default value on creation for the request_count field.
+	billingstatement.DefaultRequestCount = billingstatementDescRequestCount.Default.(int64)
+	// billingstatementDescStatus is the schema descriptor for status field.
+	billingstatementDescStatus := billingstatementFields[5].Descriptor()
+	// billingstatement.DefaultStatus holds the default value on creation for the status field.
+	billingstatement.DefaultStatus = billingstatementDescStatus.Default.(string)
+	// billingstatement.StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	billingstatement.StatusValidator = billingstatementDescStatus.Validators[0].(func(string) error)
+	// billingstatementDescCreatedAt is the schema descriptor for created_at field.
+	billingstatementDescCreatedAt := billingstatementFields[11].Descriptor()
+	// billingstatement.DefaultCreatedAt holds the default value on creation for the created_at field.
+	billingstatement.DefaultCreatedAt = billingstatementDescCreatedAt.Default.(func() time.Time)
+	// billingstatementDescUpdatedAt is the schema descriptor for updated_at field.
+	billingstatementDescUpdatedAt := billingstatementFields[12].Descriptor()
+	// billingstatement.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	billingstatement.DefaultUpdatedAt = billingstatementDescUpdatedAt.Default.(func() time.Time)
+	// billingstatement.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	billingstatement.UpdateDefaultUpdatedAt = billingstatementDescUpdatedAt.UpdateDefault.(func() time.Time)
 	channelmonitorMixin := schema.ChannelMonitor{}.Mixin()
 	channelmonitorMixinFields0 := channelmonitorMixin[0].Fields()
 	_ = channelmonitorMixinFields0
@@ -571,6 +712,62 @@ func init() {
 	channelmonitorrequesttemplate.DefaultBodyOverrideMode = channelmonitorrequesttemplateDescBodyOverrideMode.Default.(string)
 	// channelmonitorrequesttemplate.BodyOverrideModeValidator is a validator for the "body_override_mode" field. It is called by the builders before save.
 	channelmonitorrequesttemplate.BodyOverrideModeValidator = channelmonitorrequesttemplateDescBodyOverrideMode.Validators[0].(func(string) error)
+	commissionwithdrawalFields := schema.CommissionWithdrawal{}.Fields()
+	_ = commissionwithdrawalFields
+	// commissionwithdrawalDescPayoutMethod is the schema descriptor for payout_method field.
+	commissionwithdrawalDescPayoutMethod := commissionwithdrawalFields[2].Descriptor()
+	// commissionwithdrawal.PayoutMethodValidator is a validator for the "payout_method" field. It is called by the builders before save.
+	commissionwithdrawal.PayoutMethodValidator = commissionwithdrawalDescPayoutMethod.Validators[0].(func(string) error)
+	// commissionwithdrawalDescPayoutAccount is the schema descriptor for payout_account field.
+	commissionwithdrawalDescPayoutAccount := commissionwithdrawalFields[3].Descriptor()
+	// commissionwithdrawal.PayoutAccountValidator is a validator for the "payout_account" field. It is called by the builders before save.
+	commissionwithdrawal.PayoutAccountValidator = commissionwithdrawalDescPayoutAccount.Validators[0].(func(string) error)
+	// commissionwithdrawalDescPayoutName is the schema descriptor for payout_name field.
+	commissionwithdrawalDescPayoutName := commissionwithdrawalFields[4].Descriptor()
+	// commissionwithdrawal.PayoutNameValidator is a validator for the "payout_name" field. It is called by the builders before save.
+	commissionwithdrawal.PayoutNameValidator = commissionwithdrawalDescPayoutName.Validators[0].(func(string) error)
+	// commissionwithdrawalDescStatus is the schema descriptor for status field.
+	commissionwithdrawalDescStatus := commissionwithdrawalFields[5].Descriptor()
+	// commissionwithdrawal.DefaultStatus holds the default value on creation for the status field.
+	commissionwithdrawal.DefaultStatus = commissionwithdrawalDescStatus.Default.(string)
+	// commissionwithdrawal.StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	commissionwithdrawal.StatusValidator = commissionwithdrawalDescStatus.Validators[0].(func(string) error)
+	// commissionwithdrawalDescPayoutReference is the schema descriptor for payout_reference field.
+	commissionwithdrawalDescPayoutReference := commissionwithdrawalFields[8].Descriptor()
+	// commissionwithdrawal.PayoutReferenceValidator is a validator for the "payout_reference" field. It is called by the builders before save.
+	commissionwithdrawal.PayoutReferenceValidator = commissionwithdrawalDescPayoutReference.Validators[0].(func(string) error)
+	// commissionwithdrawalDescCreatedAt is the schema descriptor for created_at field.
+	commissionwithdrawalDescCreatedAt := commissionwithdrawalFields[11].Descriptor()
+	// commissionwithdrawal.DefaultCreatedAt holds the default value on creation for the created_at field.
+	commissionwithdrawal.DefaultCreatedAt = commissionwithdrawalDescCreatedAt.Default.(func() time.Time)
+	// commissionwithdrawalDescUpdatedAt is the schema descriptor for updated_at field.
+	commissionwithdrawalDescUpdatedAt := commissionwithdrawalFields[12].Descriptor()
+	// commissionwithdrawal.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	commissionwithdrawal.DefaultUpdatedAt = commissionwithdrawalDescUpdatedAt.Default.(func() time.Time)
+	// commissionwithdrawal.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	commissionwithdrawal.UpdateDefaultUpdatedAt = commissionwithdrawalDescUpdatedAt.UpdateDefault.(func() time.Time)
+	commissionwithdrawallogFields := schema.CommissionWithdrawalLog{}.Fields()
+	_ = commissionwithdrawallogFields
+	// commissionwithdrawallogDescAction is the schema descriptor for action field.
+	commissionwithdrawallogDescAction := commissionwithdrawallogFields[1].Descriptor()
+	// commissionwithdrawallog.ActionValidator is a validator for the "action" field. It is called by the builders before save.
+	commissionwithdrawallog.ActionValidator = commissionwithdrawallogDescAction.Validators[0].(func(string) error)
+	// commissionwithdrawallogDescFromStatus is the schema descriptor for from_status field.
+	commissionwithdrawallogDescFromStatus := commissionwithdrawallogFields[2].Descriptor()
+	// commissionwithdrawallog.FromStatusValidator is a validator for the "from_status" field. It is called by the builders before save.
+	commissionwithdrawallog.FromStatusValidator = commissionwithdrawallogDescFromStatus.Validators[0].(func(string) error)
+	// commissionwithdrawallogDescToStatus is the schema descriptor for to_status field.
+	commissionwithdrawallogDescToStatus := commissionwithdrawallogFields[3].Descriptor()
+	// commissionwithdrawallog.ToStatusValidator is a validator for the "to_status" field. It is called by the builders before save.
+	commissionwithdrawallog.ToStatusValidator = commissionwithdrawallogDescToStatus.Validators[0].(func(string) error)
+	// commissionwithdrawallogDescOperatorRole is the schema descriptor for operator_role field.
+	commissionwithdrawallogDescOperatorRole := commissionwithdrawallogFields[5].Descriptor()
+	// commissionwithdrawallog.OperatorRoleValidator is a validator for the "operator_role" field. It is called by the builders before save.
+	commissionwithdrawallog.OperatorRoleValidator = commissionwithdrawallogDescOperatorRole.Validators[0].(func(string) error)
+	// commissionwithdrawallogDescCreatedAt is the schema descriptor for created_at field.
+	commissionwithdrawallogDescCreatedAt := commissionwithdrawallogFields[7].Descriptor()
+	// commissionwithdrawallog.DefaultCreatedAt holds the default value on creation for the created_at field.
+	commissionwithdrawallog.DefaultCreatedAt = commissionwithdrawallogDescCreatedAt.Default.(func() time.Time)
 	errorpassthroughruleMixin := schema.ErrorPassthroughRule{}.Mixin()
 	errorpassthroughruleMixinFields0 := errorpassthroughruleMixin[0].Fields()
 	_ = errorpassthroughruleMixinFields0
@@ -630,6 +827,65 @@ func init() {
 	errorpassthroughruleDescSkipMonitoring := errorpassthroughruleFields[11].Descriptor()
 	// errorpassthroughrule.DefaultSkipMonitoring holds the default value on creation for the skip_monitoring field.
 	errorpassthroughrule.DefaultSkipMonitoring = errorpassthroughruleDescSkipMonitoring.Default.(bool)
+	gatewaypluginMixin := schema.GatewayPlugin{}.Mixin()
+	gatewaypluginMixinFields0 := gatewaypluginMixin[0].Fields()
+	_ = gatewaypluginMixinFields0
+	gatewaypluginFields := schema.GatewayPlugin{}.Fields()
+	_ = gatewaypluginFields
+	// gatewaypluginDescCreatedAt is the schema descriptor for created_at field.
+	gatewaypluginDescCreatedAt := gatewaypluginMixinFields0[0].Descriptor()
+	// gatewayplugin.DefaultCreatedAt holds the default value on creation for the created_at field.
+	gatewayplugin.DefaultCreatedAt = gatewaypluginDescCreatedAt.Default.(func() time.Time)
+	// gatewaypluginDescUpdatedAt is the schema descriptor for updated_at field.
+	gatewaypluginDescUpdatedAt := gatewaypluginMixinFields0[1].Descriptor()
+	// gatewayplugin.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	gatewayplugin.DefaultUpdatedAt = gatewaypluginDescUpdatedAt.Default.(func() time.Time)
+	// gatewayplugin.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	gatewayplugin.UpdateDefaultUpdatedAt = gatewaypluginDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// gatewaypluginDescName is the schema descriptor for name field.
+	gatewaypluginDescName := gatewaypluginFields[0].Descriptor()
+	// gatewayplugin.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	gatewayplugin.NameValidator = func() func(string) error {
+		validators := gatewaypluginDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// gatewaypluginDescEnabled is the schema descriptor for enabled field.
+	gatewaypluginDescEnabled := gatewaypluginFields[1].Descriptor()
+	// gatewayplugin.DefaultEnabled holds the default value on creation for the enabled field.
+	gatewayplugin.DefaultEnabled = gatewaypluginDescEnabled.Default.(bool)
+	// gatewaypluginDescPriority is the schema descriptor for priority field.
+	gatewaypluginDescPriority := gatewaypluginFields[2].Descriptor()
+	// gatewayplugin.DefaultPriority holds the default value on creation for the priority field.
+	gatewayplugin.DefaultPriority = gatewaypluginDescPriority.Default.(int)
+	// gatewaypluginDescFailureMode is the schema descriptor for failure_mode field.
+	gatewaypluginDescFailureMode := gatewaypluginFields[4].Descriptor()
+	// gatewayplugin.DefaultFailureMode holds the default value on creation for the failure_mode field.
+	gatewayplugin.DefaultFailureMode = gatewaypluginDescFailureMode.Default.(string)
+	// gatewayplugin.FailureModeValidator is a validator for the "failure_mode" field. It is called by the builders before save.
+	gatewayplugin.FailureModeValidator = gatewaypluginDescFailureMode.Validators[0].(func(string) error)
+	// gatewaypluginDescTimeoutMs is the schema descriptor for timeout_ms field.
+	gatewaypluginDescTimeoutMs := gatewaypluginFields[5].Descriptor()
+	// gatewayplugin.DefaultTimeoutMs holds the default value on creation for the timeout_ms field.
+	gatewayplugin.DefaultTimeoutMs = gatewaypluginDescTimeoutMs.Default.(int)
+	// gatewaypluginDescMemoryLimitMB is the schema descriptor for memory_limit_mb field.
+	gatewaypluginDescMemoryLimitMB := gatewaypluginFields[6].Descriptor()
+	// gatewayplugin.DefaultMemoryLimitMB holds the default value on creation for the memory_limit_mb field.
+	gatewayplugin.DefaultMemoryLimitMB = gatewaypluginDescMemoryLimitMB.Default.(int)
+	// gatewaypluginDescModuleSha256 is the schema descriptor for module_sha256 field.
+	gatewaypluginDescModuleSha256 := gatewaypluginFields[9].Descriptor()
+	// gatewayplugin.ModuleSha256Validator is a validator for the "module_sha256" field. It is called by the builders before save.
+	gatewayplugin.ModuleSha256Validator = gatewaypluginDescModuleSha256.Validators[0].(func(string) error)
 	groupMixin := schema.Group{}.Mixin()
 	groupMixinHooks1 := groupMixin[1].Hooks()
 	group.Hooks[0] = groupMixinHooks1[0]
@@ -759,6 +1015,85 @@ func init() {
 	groupDescForceApplicationJSONForNonStream := groupFields[31].Descriptor()
 	// group.DefaultForceApplicationJSONForNonStream holds the default value on creation for the force_application_json_for_non_stream field.
 	group.DefaultForceApplicationJSONForNonStream = groupDescForceApplicationJSONForNonStream.Default.(bool)
+	// groupDescSchedulingStrategy is the schema descriptor for scheduling_strategy field.
+	groupDescSchedulingStrategy := groupFields[32].Descriptor()
+	// group.DefaultSchedulingStrategy holds the default value on creation for the scheduling_strategy field.
+	group.DefaultSchedulingStrategy = groupDescSchedulingStrategy.Default.(string)
+	// group.SchedulingStrategyValidator is a validator for the "scheduling_strategy" field. It is called by the builders before save.
+	group.SchedulingStrategyValidator = groupDescSchedulingStrategy.Validators[0].(func(string) error)
+	// groupDescHedgeEnabled is the schema descriptor for hedge_enabled field.
+	groupDescHedgeEnabled := groupFields[33].Descriptor()
+	// group.DefaultHedgeEnabled holds the default value on creation for the hedge_enabled field.
+	group.DefaultHedgeEnabled = groupDescHedgeEnabled.Default.(bool)
+	// groupDescHedgeDelayMs is the schema descriptor for hedge_delay_ms field.
+	groupDescHedgeDelayMs := groupFields[34].Descriptor()
+	// group.DefaultHedgeDelayMs holds the default value on creation for the hedge_delay_ms field.
+	group.DefaultHedgeDelayMs = groupDescHedgeDelayMs.Default.(int)
+	// groupDescAvailabilitySchedule is the schema descriptor for availability_schedule field.
+	groupDescAvailabilitySchedule := groupFields[38].Descriptor()
+	// group.AvailabilityScheduleValidator is a validator for the "availability_schedule" field. It is called by the builders before save.
+	group.AvailabilityScheduleValidator = groupDescAvailabilitySchedule.Validators[0].(func(string) error)
+	// groupDescMaintenanceMode is the schema descriptor for maintenance_mode field.
+	groupDescMaintenanceMode := groupFields[39].Descriptor()
+	// group.DefaultMaintenanceMode holds the default value on creation for the maintenance_mode field.
+	group.DefaultMaintenanceMode = groupDescMaintenanceMode.Default.(bool)
+	guardrailruleMixin := schema.GuardrailRule{}.Mixin()
+	guardrailruleMixinFields0 := guardrailruleMixin[0].Fields()
+	_ = guardrailruleMixinFields0
+	guardrailruleFields := schema.GuardrailRule{}.Fields()
+	_ = guardrailruleFields
+	// guardrailruleDescCreatedAt is the schema descriptor for created_at field.
+	guardrailruleDescCreatedAt := guardrailruleMixinFields0[0].Descriptor()
+	// guardrailrule.DefaultCreatedAt holds the default value on creation for the created_at field.
+	guardrailrule.DefaultCreatedAt = guardrailruleDescCreatedAt.Default.(func() time.Time)
+	// guardrailruleDescUpdatedAt is the schema descriptor for updated_at field.
+	guardrailruleDescUpdatedAt := guardrailruleMixinFields0[1].Descriptor()
+	// guardrailrule.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	guardrailrule.DefaultUpdatedAt = guardrailruleDescUpdatedAt.Default.(func() time.Time)
+	// guardrailrule.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	guardrailrule.UpdateDefaultUpdatedAt = guardrailruleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// guardrailruleDescName is the schema descriptor for name field.
+	guardrailruleDescName := guardrailruleFields[0].Descriptor()
+	// guardrailrule.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	guardrailrule.NameValidator = func() func(string) error {
+		validators := guardrailruleDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// guardrailruleDescEnabled is the schema descriptor for enabled field.
+	guardrailruleDescEnabled := guardrailruleFields[1].Descriptor()
+	// guardrailrule.DefaultEnabled holds the default value on creation for the enabled field.
+	guardrailrule.DefaultEnabled = guardrailruleDescEnabled.Default.(bool)
+	// guardrailruleDescPriority is the schema descriptor for priority field.
+	guardrailruleDescPriority := guardrailruleFields[2].Descriptor()
+	// guardrailrule.DefaultPriority holds the default value on creation for the priority field.
+	guardrailrule.DefaultPriority = guardrailruleDescPriority.Default.(int)
+	// guardrailruleDescType is the schema descriptor for type field.
+	guardrailruleDescType := guardrailruleFields[5].Descriptor()
+	// guardrailrule.DefaultType holds the default value on creation for the type field.
+	guardrailrule.DefaultType = guardrailruleDescType.Default.(string)
+	// guardrailrule.TypeValidator is a validator for the "type" field. It is called by the builders before save.
+	guardrailrule.TypeValidator = guardrailruleDescType.Validators[0].(func(string) error)
+	// guardrailruleDescCaseSensitive is the schema descriptor for case_sensitive field.
+	guardrailruleDescCaseSensitive := guardrailruleFields[7].Descriptor()
+	// guardrailrule.DefaultCaseSensitive holds the default value on creation for the case_sensitive field.
+	guardrailrule.DefaultCaseSensitive = guardrailruleDescCaseSensitive.Default.(bool)
+	// guardrailruleDescAction is the schema descriptor for action field.
+	guardrailruleDescAction := guardrailruleFields[8].Descriptor()
+	// guardrailrule.DefaultAction holds the default value on creation for the action field.
+	guardrailrule.DefaultAction = guardrailruleDescAction.Default.(string)
+	// guardrailrule.ActionValidator is a validator for the "action" field. It is called by the builders before save.
+	guardrailrule.ActionValidator = guardrailruleDescAction.Validators[0].(func(string) error)
 	idempotencyrecordMixin := schema.IdempotencyRecord{}.Mixin()
 	idempotencyrecordMixinFields0 := idempotencyrecordMixin[0].Fields()
 	_ = idempotencyrecordMixinFields0
@@ -794,6 +1129,104 @@ func init() {
 	idempotencyrecordDescErrorReason := idempotencyrecordFields[6].Descriptor()
 	// idempotencyrecord.ErrorReasonValidator is a validator for the "error_reason" field. It is called by the builders before save.
 	idempotencyrecord.ErrorReasonValidator = idempotencyrecordDescErrorReason.Validators[0].(func(string) error)
+	invoiceFields := schema.Invoice{}.Fields()
+	_ = invoiceFields
+	// invoiceDescInvoiceNo is the schema descriptor for invoice_no field.
+	invoiceDescInvoiceNo := invoiceFields[0].Descriptor()
+	// invoice.InvoiceNoValidator is a validator for the "invoice_no" field. It is called by the builders before save.
+	invoice.InvoiceNoValidator = invoiceDescInvoiceNo.Validators[0].(func(string) error)
+	// invoiceDescKind is the schema descriptor for kind field.
+	invoiceDescKind := invoiceFields[1].Descriptor()
+	// invoice.DefaultKind holds the default value on creation for the kind field.
+	invoice.DefaultKind = invoiceDescKind.Default.(string)
+	// invoice.KindValidator is a validator for the "kind" field. It is called by the builders before save.
+	invoice.KindValidator = invoiceDescKind.Validators[0].(func(string) error)
+	// invoiceDescSourceType is the schema descriptor for source_type field.
+	invoiceDescSourceType := invoiceFields[3].Descriptor()
+	// invoice.SourceTypeValidator is a validator for the "source_type" field. It is called by the builders before save.
+	invoice.SourceTypeValidator = invoiceDescSourceType.Validators[0].(func(string) error)
+	// invoiceDescSourceNo is the schema descriptor for source_no field.
+	invoiceDescSourceNo := invoiceFields[5].Descriptor()
+	// invoice.DefaultSourceNo holds the default value on creation for the source_no field.
+	invoice.DefaultSourceNo = invoiceDescSourceNo.Default.(string)
+	// invoice.SourceNoValidator is a validator for the "source_no" field. It is called by the builders before save.
+	invoice.SourceNoValidator = invoiceDescSourceNo.Validators[0].(func(string) error)
+	// invoiceDescCurrency is the schema descriptor for currency field.
+	invoiceDescCurrency := invoiceFields[7].Descriptor()
+	// invoice.DefaultCurrency holds the default value on creation for the currency field.
+	invoice.DefaultCurrency = invoiceDescCurrency.Default.(string)
+	// invoice.CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	invoice.CurrencyValidator = invoiceDescCurrency.Validators[0].(func(string) error)
+	// invoiceDescDiscountAmount is the schema descriptor for discount_amount field.
+	invoiceDescDiscountAmount := invoiceFields[9].Descriptor()
+	// invoice.DefaultDiscountAmount holds the default value on creation for the discount_amount field.
+	invoice.DefaultDiscountAmount = invoiceDescDiscountAmount.Default.(float64)
+	// invoiceDescFeeAmount is the schema descriptor for fee_amount field.
+	invoiceDescFeeAmount := invoiceFields[10].Descriptor()
+	// invoice.DefaultFeeAmount holds the default value on creation for the fee_amount field.
+	invoice.DefaultFeeAmount = invoiceDescFeeAmount.Default.(float64)
+	// invoiceDescPaymentMethod is the schema descriptor for payment_method field.
+	invoiceDescPaymentMethod := invoiceFields[13].Descriptor()
+	// invoice.DefaultPaymentMethod holds the default value on creation for the payment_method field.
+	invoice.DefaultPaymentMethod = invoiceDescPaymentMethod.Default.(string)
+	// invoice.PaymentMethodValidator is a validator for the "payment_method" field. It is called by the builders before save.
+	invoice.PaymentMethodValidator = invoiceDescPaymentMethod.Validators[0].(func(string) error)
+	// invoiceDescBuyerEmail is the schema descriptor for buyer_email field.
+	invoiceDescBuyerEmail := invoiceFields[14].Descriptor()
+	// invoice.DefaultBuyerEmail holds the default value on creation for the buyer_email field.
+	invoice.DefaultBuyerEmail = invoiceDescBuyerEmail.Default.(string)
+	// invoice.BuyerEmailValidator is a validator for the "buyer_email" field. It is called by the builders before save.
+	invoice.BuyerEmailValidator = invoiceDescBuyerEmail.Validators[0].(func(string) error)
+	// invoiceDescBuyerName is the schema descriptor for buyer_name field.
+	invoiceDescBuyerName := invoiceFields[15].Descriptor()
+	// invoice.DefaultBuyerName holds the default value on creation for the buyer_name field.
+	invoice.DefaultBuyerName = invoiceDescBuyerName.Default.(string)
+	// invoice.BuyerNameValidator is a validator for the "buyer_name" field. It is called by the builders before save.
+	invoice.BuyerNameValidator = invoiceDescBuyerName.Validators[0].(func(string) error)
+	// invoiceDescBuyerTaxID is the schema descriptor for buyer_tax_id field.
+	invoiceDescBuyerTaxID := invoiceFields[16].Descriptor()
+	// invoice.DefaultBuyerTaxID holds the default value on creation for the buyer_tax_id field.
+	invoice.DefaultBuyerTaxID = invoiceDescBuyerTaxID.Default.(string)
+	// invoice.BuyerTaxIDValidator is a validator for the "buyer_tax_id" field. It is called by the builders before save.
+	invoice.BuyerTaxIDValidator = invoiceDescBuyerTaxID.Validators[0].(func(string) error)
+	// invoiceDescBuyerAddress is the schema descriptor for buyer_address field.
+	invoiceDescBuyerAddress := invoiceFields[17].Descriptor()
+	// invoice.DefaultBuyerAddress holds the default value on creation for the buyer_address field.
+	invoice.DefaultBuyerAddress = invoiceDescBuyerAddress.Default.(string)
+	// invoiceDescSellerName is the schema descriptor for seller_name field.
+	invoiceDescSellerName := invoiceFields[18].Descriptor()
+	// invoice.DefaultSellerName holds the default value on creation for the seller_name field.
+	invoice.DefaultSellerName = invoiceDescSellerName.Default.(string)
+	// invoice.SellerNameValidator is a validator for the "seller_name" field. It is called by the builders before save.
+	invoice.SellerNameValidator = invoiceDescSellerName.Validators[0].(func(string) error)
+	// invoiceDescSellerTaxID is the schema descriptor for seller_tax_id field.
+	invoiceDescSellerTaxID := invoiceFields[19].Descriptor()
+	// invoice.DefaultSellerTaxID holds the default value on creation for the seller_tax_id field.
+	invoice.DefaultSellerTaxID = invoiceDescSellerTaxID.Default.(string)
+	// invoice.SellerTaxIDValidator is a validator for the "seller_tax_id" field. It is called by the builders before save.
+	invoice.SellerTaxIDValidator = invoiceDescSellerTaxID.Validators[0].(func(string) error)
+	// invoiceDescSellerAddress is the schema descriptor for seller_address field.
+	invoiceDescSellerAddress := invoiceFields[20].Descriptor()
+	// invoice.DefaultSellerAddress holds the default value on creation for the seller_address field.
+	invoice.DefaultSellerAddress = invoiceDescSellerAddress.Default.(string)
+	// invoiceDescNotes is the schema descriptor for notes field.
+	invoiceDescNotes := invoiceFields[21].Descriptor()
+	// invoice.DefaultNotes holds the default value on creation for the notes field.
+	invoice.DefaultNotes = invoiceDescNotes.Default.(string)
+	// invoiceDescCreatedAt is the schema descriptor for created_at field.
+	invoiceDescCreatedAt := invoiceFields[24].Descriptor()
+	// invoice.DefaultCreatedAt holds the default value on creation for the created_at field.
+	invoice.DefaultCreatedAt = invoiceDescCreatedAt.Default.(func() time.Time)
+	invoicesequenceFields := schema.InvoiceSequence{}.Fields()
+	_ = invoicesequenceFields
+	// invoicesequenceDescSeries is the schema descriptor for series field.
+	invoicesequenceDescSeries := invoicesequenceFields[0].Descriptor()
+	// invoicesequence.SeriesValidator is a validator for the "series" field. It is called by the builders before save.
+	invoicesequence.SeriesValidator = invoicesequenceDescSeries.Validators[0].(func(string) error)
+	// invoicesequenceDescLastNumber is the schema descriptor for last_number field.
+	invoicesequenceDescLastNumber := invoicesequenceFields[1].Descriptor()
+	// invoicesequence.DefaultLastNumber holds the default value on creation for the last_number field.
+	invoicesequence.DefaultLastNumber = invoicesequenceDescLastNumber.Default.(int64)
 	paygorderMixin := schema.PaygOrder{}.Mixin()
 	paygorderMixinFields0 := paygorderMixin[0].Fields()
 	_ = paygorderMixinFields0
@@ -859,6 +1292,72 @@ func init() {
 	paymentauditlogDescCreatedAt := paymentauditlogFields[4].Descriptor()
 	// paymentauditlog.DefaultCreatedAt holds the default value on creation for the created_at field.
 	paymentauditlog.DefaultCreatedAt = paymentauditlogDescCreatedAt.Default.(func() time.Time)
+	paymentcouponFields := schema.PaymentCoupon{}.Fields()
+	_ = paymentcouponFields
+	// paymentcouponDescCode is the schema descriptor for code field.
+	paymentcouponDescCode := paymentcouponFields[0].Descriptor()
+	// paymentcoupon.CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	paymentcoupon.CodeValidator = func() func(string) error {
+		validators := paymentcouponDescCode.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(code string) error {
+			for _, fn := range fns {
+				if err := fn(code); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// paymentcouponDescName is the schema descriptor for name field.
+	paymentcouponDescName := paymentcouponFields[1].Descriptor()
+	// paymentcoupon.DefaultName holds the default value on creation for the name field.
+	paymentcoupon.DefaultName = paymentcouponDescName.Default.(string)
+	// paymentcoupon.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	paymentcoupon.NameValidator = paymentcouponDescName.Validators[0].(func(string) error)
+	// paymentcouponDescDiscountType is the schema descriptor for discount_type field.
+	paymentcouponDescDiscountType := paymentcouponFields[2].Descriptor()
+	// paymentcoupon.DefaultDiscountType holds the default value on creation for the discount_type field.
+	paymentcoupon.DefaultDiscountType = paymentcouponDescDiscountType.Default.(string)
+	// paymentcoupon.DiscountTypeValidator is a validator for the "discount_type" field. It is called by the builders before save.
+	paymentcoupon.DiscountTypeValidator = paymentcouponDescDiscountType.Validators[0].(func(string) error)
+	// paymentcouponDescMinAmount is the schema descriptor for min_amount field.
+	paymentcouponDescMinAmount := paymentcouponFields[4].Descriptor()
+	// paymentcoupon.DefaultMinAmount holds the default value on creation for the min_amount field.
+	paymentcoupon.DefaultMinAmount = paymentcouponDescMinAmount.Default.(float64)
+	// paymentcouponDescAppliesTo is the schema descriptor for applies_to field.
+	paymentcouponDescAppliesTo := paymentcouponFields[5].Descriptor()
+	// paymentcoupon.DefaultAppliesTo holds the default value on creation for the applies_to field.
+	paymentcoupon.DefaultAppliesTo = paymentcouponDescAppliesTo.Default.(string)
+	// paymentcoupon.AppliesToValidator is a validator for the "applies_to" field. It is called by the builders before save.
+	paymentcoupon.AppliesToValidator = paymentcouponDescAppliesTo.Validators[0].(func(string) error)
+	// paymentcouponDescMaxUses is the schema descriptor for max_uses field.
+	paymentcouponDescMaxUses := paymentcouponFields[7].Descriptor()
+	// paymentcoupon.DefaultMaxUses holds the default value on creation for the max_uses field.
+	paymentcoupon.DefaultMaxUses = paymentcouponDescMaxUses.Default.(int)
+	// paymentcouponDescPerUserLimit is the schema descriptor for per_user_limit field.
+	paymentcouponDescPerUserLimit := paymentcouponFields[8].Descriptor()
+	// paymentcoupon.DefaultPerUserLimit holds the default value on creation for the per_user_limit field.
+	paymentcoupon.DefaultPerUserLimit = paymentcouponDescPerUserLimit.Default.(int)
+	// paymentcouponDescStatus is the schema descriptor for status field.
+	paymentcouponDescStatus := paymentcouponFields[9].Descriptor()
+	// paymentcoupon.DefaultStatus holds the default value on creation for the status field.
+	paymentcoupon.DefaultStatus = paymentcouponDescStatus.Default.(string)
+	// paymentcoupon.StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	paymentcoupon.StatusValidator = paymentcouponDescStatus.Validators[0].(func(string) error)
+	// paymentcouponDescCreatedAt is the schema descriptor for created_at field.
+	paymentcouponDescCreatedAt := paymentcouponFields[13].Descriptor()
+	// paymentcoupon.DefaultCreatedAt holds the default value on creation for the created_at field.
+	paymentcoupon.DefaultCreatedAt = paymentcouponDescCreatedAt.Default.(func() time.Time)
+	// paymentcouponDescUpdatedAt is the schema descriptor for updated_at field.
+	paymentcouponDescUpdatedAt := paymentcouponFields[14].Descriptor()
+	// paymentcoupon.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	paymentcoupon.DefaultUpdatedAt = paymentcouponDescUpdatedAt.Default.(func() time.Time)
+	// paymentcoupon.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	paymentcoupon.UpdateDefaultUpdatedAt = paymentcouponDescUpdatedAt.UpdateDefault.(func() time.Time)
 	paymentorderFields := schema.PaymentOrder{}.Fields()
 	_ = paymentorderFields
 	// paymentorderDescUserEmail is the schema descriptor for user_email field.
@@ -877,62 +1376,70 @@ func init() {
 	paymentorderDescRechargeCode := paymentorderFields[7].Descriptor()
 	// paymentorder.RechargeCodeValidator is a validator for the "recharge_code" field. It is called by the builders before save.
 	paymentorder.RechargeCodeValidator = paymentorderDescRechargeCode.Validators[0].(func(string) error)
+	// paymentorderDescCouponCode is the schema descriptor for coupon_code field.
+	paymentorderDescCouponCode := paymentorderFields[9].Descriptor()
+	// paymentorder.CouponCodeValidator is a validator for the "coupon_code" field. It is called by the builders before save.
+	paymentorder.CouponCodeValidator = paymentorderDescCouponCode.Validators[0].(func(string) error)
+	// paymentorderDescDiscountAmount is the schema descriptor for discount_amount field.
+	paymentorderDescDiscountAmount := paymentorderFields[10].Descriptor()
+	// paymentorder.DefaultDiscountAmount holds the default value on creation for the discount_amount field.
+	paymentorder.DefaultDiscountAmount = paymentorderDescDiscountAmount.Default.(float64)
 	// paymentorderDescOutTradeNo is the schema descriptor for out_trade_no field.
-	paymentorderDescOutTradeNo := paymentorderFields[8].Descriptor()
+	paymentorderDescOutTradeNo := paymentorderFields[11].Descriptor()
 	// paymentorder.DefaultOutTradeNo holds the default value on creation for the out_trade_no field.
 	paymentorder.DefaultOutTradeNo = paymentorderDescOutTradeNo.Default.(string)
 	// paymentorder.OutTradeNoValidator is a validator for the "out_trade_no" field. It is called by the builders before save.
 	paymentorder.OutTradeNoValidator = paymentorderDescOutTradeNo.Validators[0].(func(string) error)
 	// paymentorderDescPaymentType is the schema descriptor for payment_type field.
-	paymentorderDescPaymentType := paymentorderFields[9].Descriptor()
+	paymentorderDescPaymentType := paymentorderFields[12].Descriptor()
 	// paymentorder.PaymentTypeValidator is a validator for the "payment_type" field. It is called by the builders before save.
 	paymentorder.PaymentTypeValidator = paymentorderDescPaymentType.Validators[0].(func(string) error)
 	// paymentorderDescPaymentTradeNo is the schema descriptor for payment_trade_no field.
-	paymentorderDescPaymentTradeNo := paymentorderFields[10].Descriptor()
+	paymentorderDescPaymentTradeNo := paymentorderFields[13].Descriptor()
 	// paymentorder.PaymentTradeNoValidator is a validator for the "payment_trade_no" field. It is called by the builders before save.
 	paymentorder.PaymentTradeNoValidator = paymentorderDescPaymentTradeNo.Validators[0].(func(string) error)
 	// paymentorderDescOrderType is the schema descriptor for order_type field.
-	paymentorderDescOrderType := paymentorderFields[14].Descriptor()
+	paymentorderDescOrderType := paymentorderFields[17].Descriptor()
 	// paymentorder.DefaultOrderType holds the default value on creation for the order_type field.
 	paymentorder.DefaultOrderType = paymentorderDescOrderType.Default.(string)
 	// paymentorder.OrderTypeValidator is a validator for the "order_type" field. It is called by the builders before save.
 	paymentorder.OrderTypeValidator = paymentorderDescOrderType.Validators[0].(func(string) error)
 	// paymentorderDescProviderInstanceID is the schema descriptor for provider_instance_id field.
-	paymentorderDescProviderInstanceID := paymentorderFields[18].Descriptor()
+	paymentorderDescProviderInstanceID := paymentorderFields[21].Descriptor()
 	// paymentorder.ProviderInstanceIDValidator is a validator for the "provider_instance_id" field. It is called by the builders before save.
 	paymentorder.ProviderInstanceIDValidator = paymentorderDescProviderInstanceID.Validators[0].(func(string) error)
 	// paymentorderDescStatus is the schema descriptor for status field.
-	paymentorderDescStatus := paymentorderFields[19].Descriptor()
+	paymentorderDescStatus := paymentorderFields[22].Descriptor()
 	// paymentorder.DefaultStatus holds the default value on creation for the status field.
 	paymentorder.DefaultStatus = paymentorderDescStatus.Default.(string)
 	// paymentorder.StatusValidator is a validator for the "status" field. It is called by the builders before save.
 	paymentorder.StatusValidator = paymentorderDescStatus.Validators[0].(func(string) error)
 	// paymentorderDescRefundAmount is the schema descriptor for refund_amount field.
-	paymentorderDescRefundAmount := paymentorderFields[20].Descriptor()
+	paymentorderDescRefundAmount := paymentorderFields[23].Descriptor()
 	// paymentorder.DefaultRefundAmount holds the default value on creation for the refund_amount field.
 	paymentorder.DefaultRefundAmount = paymentorderDescRefundAmount.Default.(float64)
 	// paymentorderDescForceRefund is the schema descriptor for force_refund field.
-	paymentorderDescForceRefund := paymentorderFields[23].Descriptor()
+	paymentorderDescForceRefund := paymentorderFields[26].Descriptor()
 	// paymentorder.DefaultForceRefund holds the default value on creation for the force_refund field.
 	paymentorder.DefaultForceRefund = paymentorderDescForceRefund.Default.(bool)
 	// paymentorderDescRefundRequestedBy is the schema descriptor for refund_requested_by field.
-	paymentorderDescRefundRequestedBy := paymentorderFields[26].Descriptor()
+	paymentorderDescRefundRequestedBy := paymentorderFields[29].Descriptor()
 	// paymentorder.RefundRequestedByValidator is a validator for the "refund_requested_by" field. It is called by the builders before save.
 	paymentorder.RefundRequestedByValidator = paymentorderDescRefundRequestedBy.Validators[0].(func(string) error)
 	// paymentorderDescClientIP is the schema descriptor for client_ip field.
-	paymentorderDescClientIP := paymentorderFields[32].Descriptor()
+	paymentorderDescClientIP := paymentorderFields[35].Descriptor()
 	// paymentorder.ClientIPValidator is a validator for the "client_ip" field. It is called by the builders before save.
 	paymentorder.ClientIPValidator = paymentorderDescClientIP.Validators[0].(func(string) error)
 	// paymentorderDescSrcHost is the schema descriptor for src_host field.
-	paymentorderDescSrcHost := paymentorderFields[33].Descriptor()
+	paymentorderDescSrcHost := paymentorderFields[36].Descriptor()
 	// paymentorder.SrcHostValidator is a validator for the "src_host" field. It is called by the builders before save.
 	paymentorder.SrcHostValidator = paymentorderDescSrcHost.Validators[0].(func(string) error)
 	// paymentorderDescCreatedAt is the schema descriptor for created_at field.
-	paymentorderDescCreatedAt := paymentorderFields[35].Descriptor()
+	paymentorderDescCreatedAt := paymentorderFields[38].Descriptor()
 	// paymentorder.DefaultCreatedAt holds the default value on creation for the created_at field.
 	paymentorder.DefaultCreatedAt = paymentorderDescCreatedAt.Default.(func() time.Time)
 	// paymentorderDescUpdatedAt is the schema descriptor for updated_at field.
-	paymentorderDescUpdatedAt := paymentorderFields[36].Descriptor()
+	paymentorderDescUpdatedAt := paymentorderFields[39].Descriptor()
 	// paymentorder.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	paymentorder.DefaultUpdatedAt = paymentorderDescUpdatedAt.Default.(func() time.Time)
 	// paymentorder.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -1005,6 +1512,32 @@ func init() {
 	paymentproviderinstance.DefaultUpdatedAt = paymentproviderinstanceDescUpdatedAt.Default.(func() time.Time)
 	// paymentproviderinstance.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	paymentproviderinstance.UpdateDefaultUpdatedAt = paymentproviderinstanceDescUpdatedAt.UpdateDefault.(func() time.Time)
+	postpaidaccountFields := schema.PostpaidAccount{}.Fields()
+	_ = postpaidaccountFields
+	// postpaidaccountDescCreditLimit is the schema descriptor for credit_limit field.
+	postpaidaccountDescCreditLimit := postpaidaccountFields[1].Descriptor()
+	// postpaidaccount.DefaultCreditLimit holds the default value on creation for the credit_limit field.
+	postpaidaccount.DefaultCreditLimit = postpaidaccountDescCreditLimit.Default.(float64)
+	// postpaidaccountDescPaymentTermsDays is the schema descriptor for payment_terms_days field.
+	postpaidaccountDescPaymentTermsDays := postpaidaccountFields[2].Descriptor()
+	// postpaidaccount.DefaultPaymentTermsDays holds the default value on creation for the payment_terms_days field.
+	postpaidaccount.DefaultPaymentTermsDays = postpaidaccountDescPaymentTermsDays.Default.(int)
+	// postpaidaccountDescStatus is the schema descriptor for status field.
+	postpaidaccountDescStatus := postpaidaccountFields[3].Descriptor()
+	// postpaidaccount.DefaultStatus holds the default value on creation for the status field.
+	postpaidaccount.DefaultStatus = postpaidaccountDescStatus.Default.(string)
+	// postpaidaccount.StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	postpaidaccount.StatusValidator = postpaidaccountDescStatus.Validators[0].(func(string) error)
+	// postpaidaccountDescCreatedAt is the schema descriptor for created_at field.
+	postpaidaccountDescCreatedAt := postpaidaccountFields[7].Descriptor()
+	// postpaidaccount.DefaultCreatedAt holds the default value on creation for the created_at field.
+	postpaidaccount.DefaultCreatedAt = postpaidaccountDescCreatedAt.Default.(func() time.Time)
+	// postpaidaccountDescUpdatedAt is the schema descriptor for updated_at field.
+	postpaidaccountDescUpdatedAt := postpaidaccountFields[8].Descriptor()
+	// postpaidaccount.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	postpaidaccount.DefaultUpdatedAt = postpaidaccountDescUpdatedAt.Default.(func() time.Time)
+	// postpaidaccount.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	postpaidaccount.UpdateDefaultUpdatedAt = postpaidaccountDescUpdatedAt.UpdateDefault.(func() time.Time)
 	promocodeFields := schema.PromoCode{}.Fields()
 	_ = promocodeFields
 	// promocodeDescCode is the schema descriptor for code field.
@@ -1146,6 +1679,49 @@ func init() {
 	proxy.DefaultStatus = proxyDescStatus.Default.(string)
 	// proxy.StatusValidator is a validator for the "status" field. It is called by the builders before save.
 	proxy.StatusValidator = proxyDescStatus.Validators[0].(func(string) error)
+	proxypoolMixin := schema.ProxyPool{}.Mixin()
+	proxypoolMixinFields0 := proxypoolMixin[0].Fields()
+	_ = proxypoolMixinFields0
+	proxypoolFields := schema.ProxyPool{}.Fields()
+	_ = proxypoolFields
+	// proxypoolDescCreatedAt is the schema descriptor for created_at field.
+	proxypoolDescCreatedAt := proxypoolMixinFields0[0].Descriptor()
+	// proxypool.DefaultCreatedAt holds the default value on creation for the created_at field.
+	proxypool.DefaultCreatedAt = proxypoolDescCreatedAt.Default.(func() time.Time)
+	// proxypoolDescUpdatedAt is the schema descriptor for updated_at field.
+	proxypoolDescUpdatedAt := proxypoolMixinFields0[1].Descriptor()
+	// proxypool.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	proxypool.DefaultUpdatedAt = proxypoolDescUpdatedAt.Default.(func() time.Time)
+	// proxypool.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	proxypool.UpdateDefaultUpdatedAt = proxypoolDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// proxypoolDescName is the schema descriptor for name field.
+	proxypoolDescName := proxypoolFields[0].Descriptor()
+	// proxypool.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	proxypool.NameValidator = func() func(string) error {
+		validators := proxypoolDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// proxypoolDescStatus is the schema descriptor for status field.
+	proxypoolDescStatus := proxypoolFields[1].Descriptor()
+	// proxypool.DefaultStatus holds the default value on creation for the status field.
+	proxypool.DefaultStatus = proxypoolDescStatus.Default.(string)
+	// proxypool.StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	proxypool.StatusValidator = proxypoolDescStatus.Validators[0].(func(string) error)
+	// proxypoolDescMembers is the schema descriptor for members field.
+	proxypoolDescMembers := proxypoolFields[2].Descriptor()
+	// proxypool.DefaultMembers holds the default value on creation for the members field.
+	proxypool.DefaultMembers = proxypoolDescMembers.Default.(func() []model.ProxyPoolMember)
 	redeemcodeFields := schema.RedeemCode{}.Fields()
 	_ = redeemcodeFields
 	// redeemcodeDescCode is the schema descriptor for code field.
@@ -1190,6 +1766,36 @@ func init() {
 	redeemcodeDescValidityDays := redeemcodeFields[9].Descriptor()
 	// redeemcode.DefaultValidityDays holds the default value on creation for the validity_days field.
 	redeemcode.DefaultValidityDays = redeemcodeDescValidityDays.Default.(int)
+	referralcommissionFields := schema.ReferralCommission{}.Fields()
+	_ = referralcommissionFields
+	// referralcommissionDescSourceType is the schema descriptor for source_type field.
+	referralcommissionDescSourceType := referralcommissionFields[3].Descriptor()
+	// referralcommission.SourceTypeValidator is a validator for the "source_type" field. It is called by the builders before save.
+	referralcommission.SourceTypeValidator = referralcommissionDescSourceType.Validators[0].(func(string) error)
+	// referralcommissionDescSourceAmount is the schema descriptor for source_amount field.
+	referralcommissionDescSourceAmount := referralcommissionFields[5].Descriptor()
+	// referralcommission.DefaultSourceAmount holds the default value on creation for the source_amount field.
+	referralcommission.DefaultSourceAmount = referralcommissionDescSourceAmount.Default.(float64)
+	// referralcommissionDescClawedBackAmount is the schema descriptor for clawed_back_amount field.
+	referralcommissionDescClawedBackAmount := referralcommissionFields[7].Descriptor()
+	// referralcommission.DefaultClawedBackAmount holds the default value on creation for the clawed_back_amount field.
+	referralcommission.DefaultClawedBackAmount = referralcommissionDescClawedBackAmount.Default.(float64)
+	// referralcommissionDescStatus is the schema descriptor for status field.
+	referralcommissionDescStatus := referralcommissionFields[8].Descriptor()
+	// referralcommission.DefaultStatus holds the default value on creation for the status field.
+	referralcommission.DefaultStatus = referralcommissionDescStatus.Default.(string)
+	// referralcommission.StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	referralcommission.StatusValidator = referralcommissionDescStatus.Validators[0].(func(string) error)
+	// referralcommissionDescCreatedAt is the schema descriptor for created_at field.
+	referralcommissionDescCreatedAt := referralcommissionFields[12].Descriptor()
+	// referralcommission.DefaultCreatedAt holds the default value on creation for the created_at field.
+	referralcommission.DefaultCreatedAt = referralcommissionDescCreatedAt.Default.(func() time.Time)
+	// referralcommissionDescUpdatedAt is the schema descriptor for updated_at field.
+	referralcommissionDescUpdatedAt := referralcommissionFields[13].Descriptor()
+	// referralcommission.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	referralcommission.DefaultUpdatedAt = referralcommissionDescUpdatedAt.Default.(func() time.Time)
+	// referralcommission.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	referralcommission.UpdateDefaultUpdatedAt = referralcommissionDescUpdatedAt.UpdateDefault.(func() time.Time)
 	referralrewardFields := schema.ReferralReward{}.Fields()
 	_ = referralrewardFields
 	// referralrewardDescRewardType is the schema descriptor for reward_type field.
@@ -1208,6 +1814,47 @@ func init() {
 	referralrewardDescCreatedAt := referralrewardFields[8].Descriptor()
 	// referralreward.DefaultCreatedAt holds the default value on creation for the created_at field.
 	referralreward.DefaultCreatedAt = referralrewardDescCreatedAt.Default.(func() time.Time)
+	requesttransformruleMixin := schema.RequestTransformRule{}.Mixin()
+	requesttransformruleMixinFields0 := requesttransformruleMixin[0].Fields()
+	_ = requesttransformruleMixinFields0
+	requesttransformruleFields := schema.RequestTransformRule{}.Fields()
+	_ = requesttransformruleFields
+	// requesttransformruleDescCreatedAt is the schema descriptor for created_at field.
+	requesttransformruleDescCreatedAt := requesttransformruleMixinFields0[0].Descriptor()
+	// requesttransformrule.DefaultCreatedAt holds the default value on creation for the created_at field.
+	requesttransformrule.DefaultCreatedAt = requesttransformruleDescCreatedAt.Default.(func() time.Time)
+	// requesttransformruleDescUpdatedAt is the schema descriptor for updated_at field.
+	requesttransformruleDescUpdatedAt := requesttransformruleMixinFields0[1].Descriptor()
+	// requesttransformrule.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	requesttransformrule.DefaultUpdatedAt = requesttransformruleDescUpdatedAt.Default.(func() time.Time)
+	// requesttransformrule.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	requesttransformrule.UpdateDefaultUpdatedAt = requesttransformruleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// requesttransformruleDescName is the schema descriptor for name field.
+	requesttransformruleDescName := requesttransformruleFields[0].Descriptor()
+	// requesttransformrule.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	requesttransformrule.NameValidator = func() func(string) error {
+		validators := requesttransformruleDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// requesttransformruleDescEnabled is the schema descriptor for enabled field.
+	requesttransformruleDescEnabled := requesttransformruleFields[1].Descriptor()
+	// requesttransformrule.DefaultEnabled holds the default value on creation for the enabled field.
+	requesttransformrule.DefaultEnabled = requesttransformruleDescEnabled.Default.(bool)
+	// requesttransformruleDescPriority is the schema descriptor for priority field.
+	requesttransformruleDescPriority := requesttransformruleFields[2].Descriptor()
+	// requesttransformrule.DefaultPriority holds the default value on creation for the priority field.
+	requesttransformrule.DefaultPriority = requesttransformruleDescPriority.Default.(int)
 	securitysecretMixin := schema.SecuritySecret{}.Mixin()
 	securitysecretMixinFields0 := securitysecretMixin[0].Fields()
 	_ = securitysecretMixinFields0
@@ -1271,6 +1918,36 @@ func init() {
 	setting.DefaultUpdatedAt = settingDescUpdatedAt.Default.(func() time.Time)
 	// setting.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	setting.UpdateDefaultUpdatedAt = settingDescUpdatedAt.UpdateDefault.(func() time.Time)
+	subscriptionautorenewalFields := schema.SubscriptionAutoRenewal{}.Fields()
+	_ = subscriptionautorenewalFields
+	// subscriptionautorenewalDescPaymentType is the schema descriptor for payment_type field.
+	subscriptionautorenewalDescPaymentType := subscriptionautorenewalFields[5].Descriptor()
+	// subscriptionautorenewal.PaymentTypeValidator is a validator for the "payment_type" field. It is called by the builders before save.
+	subscriptionautorenewal.PaymentTypeValidator = subscriptionautorenewalDescPaymentType.Validators[0].(func(string) error)
+	// subscriptionautorenewalDescProviderInstanceID is the schema descriptor for provider_instance_id field.
+	subscriptionautorenewalDescProviderInstanceID := subscriptionautorenewalFields[6].Descriptor()
+	// subscriptionautorenewal.ProviderInstanceIDValidator is a validator for the "provider_instance_id" field. It is called by the builders before save.
+	subscriptionautorenewal.ProviderInstanceIDValidator = subscriptionautorenewalDescProviderInstanceID.Validators[0].(func(string) error)
+	// subscriptionautorenewalDescProviderSubscriptionID is the schema descriptor for provider_subscription_id field.
+	subscriptionautorenewalDescProviderSubscriptionID := subscriptionautorenewalFields[7].Descriptor()
+	// subscriptionautorenewal.ProviderSubscriptionIDValidator is a validator for the "provider_subscription_id" field. It is called by the builders before save.
+	subscriptionautorenewal.ProviderSubscriptionIDValidator = subscriptionautorenewalDescProviderSubscriptionID.Validators[0].(func(string) error)
+	// subscriptionautorenewalDescStatus is the schema descriptor for status field.
+	subscriptionautorenewalDescStatus := subscriptionautorenewalFields[9].Descriptor()
+	// subscriptionautorenewal.DefaultStatus holds the default value on creation for the status field.
+	subscriptionautorenewal.DefaultStatus = subscriptionautorenewalDescStatus.Default.(string)
+	// subscriptionautorenewal.StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	subscriptionautorenewal.StatusValidator = subscriptionautorenewalDescStatus.Validators[0].(func(string) error)
+	// subscriptionautorenewalDescCreatedAt is the schema descriptor for created_at field.
+	subscriptionautorenewalDescCreatedAt := subscriptionautorenewalFields[12].Descriptor()
+	// subscriptionautorenewal.DefaultCreatedAt holds the default value on creation for the created_at field.
+	subscriptionautorenewal.DefaultCreatedAt = subscriptionautorenewalDescCreatedAt.Default.(func() time.Time)
+	// subscriptionautorenewalDescUpdatedAt is the schema descriptor for updated_at field.
+	subscriptionautorenewalDescUpdatedAt := subscriptionautorenewalFields[13].Descriptor()
+	// subscriptionautorenewal.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	subscriptionautorenewal.DefaultUpdatedAt = subscriptionautorenewalDescUpdatedAt.Default.(func() time.Time)
+	// subscriptionautorenewal.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	subscriptionautorenewal.UpdateDefaultUpdatedAt = subscriptionautorenewalDescUpdatedAt.UpdateDefault.(func() time.Time)
 	subscriptionplanFields := schema.SubscriptionPlan{}.Fields()
 	_ = subscriptionplanFields
 	// subscriptionplanDescName is the schema descriptor for name field.
@@ -1323,12 +2000,18 @@ func init() {
 	subscriptionplanDescSortOrder := subscriptionplanFields[10].Descriptor()
 	// subscriptionplan.DefaultSortOrder holds the default value on creation for the sort_order field.
 	subscriptionplan.DefaultSortOrder = subscriptionplanDescSortOrder.Default.(int)
+	// subscriptionplanDescStripePriceID is the schema descriptor for stripe_price_id field.
+	subscriptionplanDescStripePriceID := subscriptionplanFields[11].Descriptor()
+	// subscriptionplan.DefaultStripePriceID holds the default value on creation for the stripe_price_id field.
+	subscriptionplan.DefaultStripePriceID = subscriptionplanDescStripePriceID.Default.(string)
+	// subscriptionplan.StripePriceIDValidator is a validator for the "stripe_price_id" field. It is called by the builders before save.
+	subscriptionplan.StripePriceIDValidator = subscriptionplanDescStripePriceID.Validators[0].(func(string) error)
 	// subscriptionplanDescCreatedAt is the schema descriptor for created_at field.
-	subscriptionplanDescCreatedAt := subscriptionplanFields[11].Descriptor()
+	subscriptionplanDescCreatedAt := subscriptionplanFields[12].Descriptor()
 	// subscriptionplan.DefaultCreatedAt holds the default value on creation for the created_at field.
 	subscriptionplan.DefaultCreatedAt = subscriptionplanDescCreatedAt.Default.(func() time.Time)
 	// subscriptionplanDescUpdatedAt is the schema descriptor for updated_at field.
-	subscriptionplanDescUpdatedAt := subscriptionplanFields[12].Descriptor()
+	subscriptionplanDescUpdatedAt := subscriptionplanFields[13].Descriptor()
 	// subscriptionplan.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	subscriptionplan.DefaultUpdatedAt = subscriptionplanDescUpdatedAt.Default.(func() time.Time)
 	// subscriptionplan.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -92,6 +92,12 @@ func (Account) Fields() []ent.Field {
 			Optional().
 			Nillable(),
 
+		// proxy_pool_id: 关联的代理池 ID（可选）
+		// 设置后优先使用池内健康成员作为出口，池不可用时回退到 proxy_id
+		field.Int64("proxy_pool_id").
+			Optional().
+			Nillable(),
+
 		// concurrency: 账户最大并发请求数
 		// 用于限制同一时间对该账户发起的请求数量
 		field.Int("concurrency").
@@ -193,6 +199,14 @@ func (Account) Fields() []ent.Field {
 			Optional().
 			Nillable().
 			MaxLen(20),
+
+		// availability_schedule: 可用时段（cron 表达式，命中的分钟内才参与调度）
+		// 支持 CRON_TZ= 前缀指定时区；为空表示全天可用
+		field.String("availability_schedule").
+			Optional().
+			Nillable().
+			MaxLen(200).
+			Comment("Availability schedule cron expression (matched minutes are schedulable)."),
 	}
 }
 
@@ -222,6 +236,7 @@ func (Account) Indexes() []ent.Index {
 		index.Fields("type"),                // 按认证类型筛选
 		index.Fields("status"),              // 按状态筛选
 		index.Fields("proxy_id"),            // 按代理筛选
+		index.Fields("proxy_pool_id"),       // 按代理池筛选
 		index.Fields("priority"),            // 按优先级排序
 		index.Fields("last_used_at"),        // 按最后使用时间排序
 		index.Fields("schedulable"),         // 筛选可调度账户
@@ -115,6 +115,10 @@ func (APIKey) Fields() []ent.Field {
 			Optional().
 			Nillable().
 			Comment("Start time of the current 7d rate limit window"),
+		field.String("priority_class").
+			MaxLen(20).
+			Default("").
+			Comment("Request priority class: interactive/standard/batch (empty = standard)"),
 	}
 }
 
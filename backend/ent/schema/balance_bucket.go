@@ -0,0 +1,80 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/timezone"
+)
+
+// BalanceBucket holds the schema definition for the BalanceBucket entity.
+//
+// 余额分桶：users.balance 仍是余额总数（鉴权与缓存只看它），
+// 分桶记录这笔余额的来源构成（充值 / 兑换码 / 优惠码 / 邀请奖励 / 管理员调整），
+// 消费时按 priority 升序、到期时间升序依次扣减，赠送类额度优先消耗；
+// 到期未用完的部分由定时任务从 users.balance 中扣除；退款只回收付费桶。
+//
+// 删除策略：不删除，耗尽 / 过期 / 退款后仅更新状态，作为余额明细保留。
+type BalanceBucket struct {
+	ent.Schema
+}
+
+func (BalanceBucket) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "balance_buckets"},
+	}
+}
+
+func (BalanceBucket) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("user_id"),
+		// 来源：paid / redeem / promo / referral / admin / legacy
+		field.String("source").
+			MaxLen(20),
+		// 来源关联标识（订单号、兑换码、优惠码等）
+		field.String("source_ref").
+			MaxLen(128).
+			Default(""),
+		// 入账金额（USD）
+		field.Float("amount").
+			SchemaType(map[string]string{dialect.Postgres: "decimal(20,8)"}),
+		// 剩余可用金额（USD）
+		field.Float("remaining").
+			SchemaType(map[string]string{dialect.Postgres: "decimal(20,8)"}),
+		// 消费优先级，数值越小越先扣减
+		field.Int("priority").
+			Default(100),
+		// 状态：active / exhausted / expired / refunded
+		field.String("status").
+			MaxLen(20).
+			Default("active"),
+		// 到期时间，为空表示永不过期
+		field.Time("expires_at").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "timestamptz"}),
+		// 过期时被回收的金额
+		field.Float("expired_amount").
+			SchemaType(map[string]string{dialect.Postgres: "decimal(20,8)"}).
+			Default(0),
+
+		field.Time("created_at").
+			Immutable().
+			Default(timezone.BeijingNow).
+			SchemaType(map[string]string{dialect.Postgres: "timestamptz"}),
+		field.Time("updated_at").
+			Default(timezone.BeijingNow).
+			UpdateDefault(timezone.BeijingNow).
+			SchemaType(map[string]string{dialect.Postgres: "timestamptz"}),
+	}
+}
+
+func (BalanceBucket) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "status"),
+		index.Fields("status", "expires_at"),
+	}
+}
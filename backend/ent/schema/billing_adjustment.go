@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/timezone"
+)
+
+// BillingAdjustment holds the schema definition for the BillingAdjustment entity.
+//
+// 计费重算的余额修正记录：每个重算任务对每个用户最多修正一次（job_id + user_id 唯一），
+// 重复执行时已修正的用户会被跳过。
+//
+// 删除策略：不删除（审计记录）
+type BillingAdjustment struct {
+	ent.Schema
+}
+
+func (BillingAdjustment) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "billing_adjustments"},
+	}
+}
+
+func (BillingAdjustment) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("job_id"),
+		field.Int64("user_id"),
+		// 余额变动：正数为退还多收费用，负数为补扣少收费用
+		field.Float("amount").
+			SchemaType(map[string]string{dialect.Postgres: "decimal(20,8)"}),
+		field.Float("balance_before").
+			SchemaType(map[string]string{dialect.Postgres: "decimal(20,8)"}),
+		field.Float("balance_after").
+			SchemaType(map[string]string{dialect.Postgres: "decimal(20,8)"}),
+		// 涉及的余额计费请求数
+		field.Int64("usage_rows").
+			Default(0),
+		field.Int64("operator_id"),
+		field.String("note").
+			Optional().
+			SchemaType(map[string]string{dialect.Postgres: "text"}),
+		field.Time("created_at").
+			Immutable().
+			Default(timezone.BeijingNow).
+			SchemaType(map[string]string{dialect.Postgres: "timestamptz"}),
+	}
+}
+
+func (BillingAdjustment) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("job_id", "user_id").Unique(),
+		index.Fields("user_id"),
+	}
+}
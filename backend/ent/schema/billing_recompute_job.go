@@ -0,0 +1,97 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/Wei-Shaw/sub2api/internal/model"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/timezone"
+)
+
+// BillingRecomputeJob holds the schema definition for the BillingRecomputeJob entity.
+//
+// 计费重算任务：将时间范围内的 usage_logs 按当前价格或候选价格/倍率重新计费，
+// 生成按用户 / 分组 / 模型汇总的差异报告（report）。
+// 管理员确认后可对余额计费的差额执行余额补扣 / 退还，明细记录在 billing_adjustments 中。
+//
+// 状态：pending → running → succeeded → applying → applied，或 failed
+//
+// 删除策略：不删除
+type BillingRecomputeJob struct {
+	ent.Schema
+}
+
+func (BillingRecomputeJob) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "billing_recompute_jobs"},
+	}
+}
+
+func (BillingRecomputeJob) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("status").
+			MaxLen(20).
+			Default("pending"),
+		// 模式：current（当前价格重放）/ candidate（候选价格或倍率模拟）
+		field.String("mode").
+			MaxLen(20),
+		// 重算参数：时间范围、筛选条件、候选价格与倍率
+		field.JSON("params", model.BillingRecomputeParams{}).
+			SchemaType(map[string]string{dialect.Postgres: "jsonb"}),
+		// 差异报告：汇总及按用户 / 分组 / 模型的明细
+		field.JSON("report", &model.BillingRecomputeReport{}).
+			Optional().
+			SchemaType(map[string]string{dialect.Postgres: "jsonb"}),
+		field.Int64("scanned_rows").
+			Default(0),
+		field.Int64("changed_rows").
+			Default(0),
+		field.Float("original_cost").
+			SchemaType(map[string]string{dialect.Postgres: "decimal(20,8)"}).
+			Default(0),
+		field.Float("recomputed_cost").
+			SchemaType(map[string]string{dialect.Postgres: "decimal(20,8)"}).
+			Default(0),
+		// 余额计费部分的差额合计（重算 - 原始），正数表示少收
+		field.Float("balance_diff").
+			SchemaType(map[string]string{dialect.Postgres: "decimal(20,8)"}).
+			Default(0),
+		field.String("error_message").
+			Optional().
+			SchemaType(map[string]string{dialect.Postgres: "text"}),
+		field.Int64("created_by"),
+		field.Int64("applied_by").
+			Optional().
+			Nillable(),
+		field.Time("started_at").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "timestamptz"}),
+		field.Time("finished_at").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "timestamptz"}),
+		field.Time("applied_at").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "timestamptz"}),
+
+		field.Time("created_at").
+			Immutable().
+			Default(timezone.BeijingNow).
+			SchemaType(map[string]string{dialect.Postgres: "timestamptz"}),
+		field.Time("updated_at").
+			Default(timezone.BeijingNow).
+			UpdateDefault(timezone.BeijingNow).
+			SchemaType(map[string]string{dialect.Postgres: "timestamptz"}),
+	}
+}
+
+func (BillingRecomputeJob) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "created_at"),
+	}
+}
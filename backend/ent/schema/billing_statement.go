@@ -0,0 +1,81 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/timezone"
+)
+
+// BillingStatement holds the schema definition for the BillingStatement entity.
+//
+// 后付费月度账单：汇总账期 [period_start, period_end) 内余额计费的 usage_logs.actual_cost。
+// 生命周期：unpaid → paid；到期未结清时 unpaid → overdue，结清后 overdue → paid。
+//
+// 删除策略：硬删除（账单为财务记录，正常流程不删除）
+type BillingStatement struct {
+	ent.Schema
+}
+
+func (BillingStatement) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "billing_statements"},
+	}
+}
+
+func (BillingStatement) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("user_id"),
+		field.Time("period_start").
+			SchemaType(map[string]string{dialect.Postgres: "timestamptz"}),
+		field.Time("period_end").
+			SchemaType(map[string]string{dialect.Postgres: "timestamptz"}),
+		// 账期内实际扣费合计（USD）
+		field.Float("amount").
+			SchemaType(map[string]string{dialect.Postgres: "decimal(20,8)"}).
+			Default(0),
+		field.Int64("request_count").
+			Default(0),
+		// 状态：unpaid / overdue / paid
+		field.String("status").
+			MaxLen(20).
+			Default("unpaid"),
+		field.Time("due_at").
+			SchemaType(map[string]string{dialect.Postgres: "timestamptz"}),
+		field.Time("reminder_sent_at").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "timestamptz"}),
+		field.Time("overdue_at").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "timestamptz"}),
+		field.Time("paid_at").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "timestamptz"}),
+		// 结清备注（如银行流水号）
+		field.String("payment_note").
+			Optional().
+			SchemaType(map[string]string{dialect.Postgres: "text"}),
+
+		field.Time("created_at").
+			Immutable().
+			Default(timezone.BeijingNow).
+			SchemaType(map[string]string{dialect.Postgres: "timestamptz"}),
+		field.Time("updated_at").
+			Default(timezone.BeijingNow).
+			UpdateDefault(timezone.BeijingNow).
+			SchemaType(map[string]string{dialect.Postgres: "timestamptz"}),
+	}
+}
+
+func (BillingStatement) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "period_start").Unique(),
+		index.Fields("status", "due_at"),
+	}
+}
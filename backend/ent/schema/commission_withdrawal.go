@@ -0,0 +1,86 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/timezone"
+)
+
+// CommissionWithdrawal holds the schema definition for the CommissionWithdrawal entity.
+//
+// 佣金提现申请：pending → approved → paid，或 pending/approved → rejected，
+// 用户可撤销 pending 状态的申请（cancelled）。pending / approved / paid 的金额
+// 从可提现佣金中扣除，rejected / cancelled 的金额退回。
+// 每次状态变化记录在 commission_withdrawal_logs 中。
+//
+// 删除策略：不删除
+type CommissionWithdrawal struct {
+	ent.Schema
+}
+
+func (CommissionWithdrawal) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "commission_withdrawals"},
+	}
+}
+
+func (CommissionWithdrawal) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("user_id"),
+		field.Float("amount").
+			SchemaType(map[string]string{dialect.Postgres: "decimal(20,8)"}),
+		// 收款方式：alipay / wechat / bank / usdt / paypal
+		field.String("payout_method").
+			MaxLen(20),
+		// 收款账号（账号 / 钱包地址 / 卡号等）
+		field.String("payout_account").
+			MaxLen(255),
+		// 收款人姓名 / 开户行等补充信息
+		field.String("payout_name").
+			Optional().
+			MaxLen(100),
+		// 状态：pending / approved / rejected / paid / cancelled
+		field.String("status").
+			MaxLen(20).
+			Default("pending"),
+		// 审核管理员
+		field.Int64("reviewer_id").
+			Optional().
+			Nillable(),
+		field.String("review_note").
+			Optional().
+			SchemaType(map[string]string{dialect.Postgres: "text"}),
+		// 打款流水号
+		field.String("payout_reference").
+			Optional().
+			MaxLen(128),
+		field.Time("reviewed_at").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "timestamptz"}),
+		field.Time("paid_at").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "timestamptz"}),
+
+		field.Time("created_at").
+			Immutable().
+			Default(timezone.BeijingNow).
+			SchemaType(map[string]string{dialect.Postgres: "timestamptz"}),
+		field.Time("updated_at").
+			Default(timezone.BeijingNow).
+			UpdateDefault(timezone.BeijingNow).
+			SchemaType(map[string]string{dialect.Postgres: "timestamptz"}),
+	}
+}
+
+func (CommissionWithdrawal) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "status"),
+		index.Fields("status", "created_at"),
+	}
+}
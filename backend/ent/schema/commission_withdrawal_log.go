@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/timezone"
+)
+
+// CommissionWithdrawalLog holds the schema definition for the CommissionWithdrawalLog entity.
+//
+// 提现申请审计日志：记录每次状态变化的操作人与备注。
+//
+// 删除策略：不删除（仅追加）
+type CommissionWithdrawalLog struct {
+	ent.Schema
+}
+
+func (CommissionWithdrawalLog) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "commission_withdrawal_logs"},
+	}
+}
+
+func (CommissionWithdrawalLog) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("withdrawal_id"),
+		// 动作：create / approve / reject / pay / cancel
+		field.String("action").
+			MaxLen(20),
+		field.String("from_status").
+			Optional().
+			MaxLen(20),
+		field.String("to_status").
+			MaxLen(20),
+		field.Int64("operator_id"),
+		// 操作人角色：user / admin
+		field.String("operator_role").
+			MaxLen(20),
+		field.String("note").
+			Optional().
+			SchemaType(map[string]string{dialect.Postgres: "text"}),
+		field.Time("created_at").
+			Immutable().
+			Default(timezone.BeijingNow).
+			SchemaType(map[string]string{dialect.Postgres: "timestamptz"}),
+	}
+}
+
+func (CommissionWithdrawalLog) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("withdrawal_id"),
+	}
+}
@@ -0,0 +1,99 @@
+package schema
+
+import (
+	"github.com/Wei-Shaw/sub2api/ent/schema/mixins"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// GatewayPlugin 定义网关 WASM 插件的 schema。
+//
+// 插件以 WebAssembly 模块形式上传，按分组挂载，可实现以下钩子：
+//   - on_request：改写请求体/请求头或拒绝请求
+//   - on_route：选择分组/模型
+//   - on_response_chunk：改写响应分片
+//   - on_usage：观察用量记录
+type GatewayPlugin struct {
+	ent.Schema
+}
+
+// Annotations 返回 schema 的注解配置。
+func (GatewayPlugin) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "gateway_plugins"},
+	}
+}
+
+// Mixin 返回该 schema 使用的混入组件。
+func (GatewayPlugin) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.TimeMixin{},
+	}
+}
+
+// Fields 定义插件实体的所有字段。
+func (GatewayPlugin) Fields() []ent.Field {
+	return []ent.Field{
+		// name: 插件名称
+		field.String("name").
+			MaxLen(100).
+			NotEmpty(),
+
+		// enabled: 是否启用
+		field.Bool("enabled").
+			Default(true),
+
+		// priority: 执行优先级，数值越小越先执行
+		field.Int("priority").
+			Default(0),
+
+		// group_ids: 挂载的分组 ID 列表，空列表表示所有分组
+		field.JSON("group_ids", []int64{}).
+			Optional().
+			SchemaType(map[string]string{dialect.Postgres: "jsonb"}),
+
+		// failure_mode: 出错时的处理方式（open/closed）
+		field.String("failure_mode").
+			MaxLen(10).
+			Default("open"),
+
+		// timeout_ms: 单次钩子调用超时（毫秒）
+		field.Int("timeout_ms").
+			Default(50),
+
+		// memory_limit_mb: 单个实例的内存上限（MB）
+		field.Int("memory_limit_mb").
+			Default(16),
+
+		// hooks: 模块导出的钩子列表
+		field.JSON("hooks", []string{}).
+			Optional().
+			SchemaType(map[string]string{dialect.Postgres: "jsonb"}),
+
+		// module: WASM 模块二进制
+		field.Bytes("module").
+			SchemaType(map[string]string{dialect.Postgres: "bytea"}),
+
+		// module_sha256: 模块摘要
+		field.String("module_sha256").
+			MaxLen(64),
+
+		// description: 插件描述
+		field.Text("description").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Indexes 定义数据库索引。
+func (GatewayPlugin) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("enabled"),
+		index.Fields("priority"),
+	}
+}
@@ -3,6 +3,7 @@ package schema
 import (
 	"github.com/Wei-Shaw/sub2api/ent/schema/mixins"
 	"github.com/Wei-Shaw/sub2api/internal/domain"
+	"github.com/Wei-Shaw/sub2api/internal/model"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
@@ -159,6 +160,43 @@ func (Group) Fields() []ent.Field {
 		field.Bool("force_application_json_for_non_stream").
 			Default(false).
 			Comment("启用后，stream=false 的 JSON 响应统一返回 application/json Content-Type"),
+		field.String("scheduling_strategy").
+			MaxLen(50).
+			Default("").
+			Comment("账号调度策略：priority_lru/weighted_round_robin/least_outstanding/ewma/p2c，为空使用默认策略"),
+		field.Bool("hedge_enabled").
+			Default(false).
+			Comment("流式请求对冲：首字节超时后在第二个账号上并发发起同一请求，取先响应者"),
+		field.Int("hedge_delay_ms").
+			Default(0).
+			Comment("对冲触发阈值（毫秒），0 表示使用所选账号的 P90 首字延迟"),
+		field.JSON("traffic_split_rules", []model.TrafficSplitRule{}).
+			Optional().
+			SchemaType(map[string]string{dialect.Postgres: "jsonb"}).
+			Comment("模型流量拆分规则：按会话哈希分配目标模型/账号，可选影子流量"),
+		field.JSON("model_fallback_chains", []model.ModelFallbackChain{}).
+			Optional().
+			SchemaType(map[string]string{dialect.Postgres: "jsonb"}).
+			Comment("模型降级链：过载或无可调度账号时按顺序改用降级模型重试"),
+		field.JSON("volume_tiers", []model.VolumeTier{}).
+			Optional().
+			SchemaType(map[string]string{dialect.Postgres: "jsonb"}).
+			Comment("用量阶梯定价：按用户当月在该分组的累计消费叠加折扣倍数"),
+
+		// 可用时段与维护模式
+		field.String("availability_schedule").
+			Optional().
+			Nillable().
+			MaxLen(200).
+			Comment("可用时段 cron 表达式：命中的分钟内分组才可调度，为空表示全天可用"),
+		field.Bool("maintenance_mode").
+			Default(false).
+			Comment("维护模式：开启后网关直接返回维护提示"),
+		field.String("maintenance_message").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "text"}).
+			Comment("维护提示信息，为空时使用默认提示"),
 	}
 }
 
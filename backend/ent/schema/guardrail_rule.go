@@ -0,0 +1,96 @@
+package schema
+
+import (
+	"github.com/Wei-Shaw/sub2api/ent/schema/mixins"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// GuardrailRule 定义内容护栏规则的 schema。
+//
+// 护栏规则在请求转发到上游前扫描消息文本：
+//   - 作用范围：平台 / 分组（均为空表示不限制）
+//   - 匹配类型：regex（正则）/ keyword（关键词）/ builtin（内置检测器）
+//   - 命中动作：block（拦截）/ redact（脱敏）/ flag（仅标记）
+type GuardrailRule struct {
+	ent.Schema
+}
+
+// Annotations 返回 schema 的注解配置。
+func (GuardrailRule) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "guardrail_rules"},
+	}
+}
+
+// Mixin 返回该 schema 使用的混入组件。
+func (GuardrailRule) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.TimeMixin{},
+	}
+}
+
+// Fields 定义护栏规则实体的所有字段。
+func (GuardrailRule) Fields() []ent.Field {
+	return []ent.Field{
+		// name: 规则名称
+		field.String("name").
+			MaxLen(100).
+			NotEmpty(),
+
+		// enabled: 是否启用该规则
+		field.Bool("enabled").
+			Default(true),
+
+		// priority: 规则优先级，数值越小越先评估
+		field.Int("priority").
+			Default(0),
+
+		// platforms: 适用平台列表，空列表表示适用于所有平台
+		field.JSON("platforms", []string{}).
+			Optional().
+			SchemaType(map[string]string{dialect.Postgres: "jsonb"}),
+
+		// group_ids: 适用分组 ID 列表，空列表表示所有分组
+		field.JSON("group_ids", []int64{}).
+			Optional().
+			SchemaType(map[string]string{dialect.Postgres: "jsonb"}),
+
+		// type: 匹配类型（regex / keyword / builtin）
+		field.String("type").
+			MaxLen(20).
+			Default("keyword"),
+
+		// patterns: 正则表达式、关键词或内置检测器名称列表
+		field.JSON("patterns", []string{}).
+			Optional().
+			SchemaType(map[string]string{dialect.Postgres: "jsonb"}),
+
+		// case_sensitive: 关键词与正则是否区分大小写
+		field.Bool("case_sensitive").
+			Default(false),
+
+		// action: 命中动作（block / redact / flag）
+		field.String("action").
+			MaxLen(20).
+			Default("flag"),
+
+		// description: 规则描述
+		field.Text("description").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Indexes 定义数据库索引。
+func (GuardrailRule) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("enabled"),
+		index.Fields("priority"),
+	}
+}
@@ -0,0 +1,122 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/Wei-Shaw/sub2api/internal/model"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/timezone"
+)
+
+// Invoice holds the schema definition for the Invoice entity.
+//
+// 发票/红字发票（credit note）：为已完成的 PaymentOrder 与 PAYG 订单开具连续编号的发票，
+// 退款时开具红字发票冲销。开票时快照买卖双方信息，之后修改用户资料或站点设置不影响已开发票。
+//
+// 删除策略：不删除
+// 发票编号必须连续且不可复用，记录只追加。
+type Invoice struct {
+	ent.Schema
+}
+
+func (Invoice) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "invoices"},
+	}
+}
+
+func (Invoice) Fields() []ent.Field {
+	return []ent.Field{
+		// 发票号，如 INV-000001 / CN-000001
+		field.String("invoice_no").
+			MaxLen(32).
+			Unique(),
+		// 类型：invoice / credit_note
+		field.String("kind").
+			MaxLen(20).
+			Default("invoice"),
+		field.Int64("user_id"),
+		// 来源订单：payment_order / payg_order
+		field.String("source_type").
+			MaxLen(20),
+		field.Int64("source_id"),
+		field.String("source_no").
+			MaxLen(64).
+			Default(""),
+		// 红字发票冲销的原发票
+		field.Int64("original_invoice_id").
+			Optional().
+			Nillable(),
+
+		// 金额（红字发票为正数，按 kind 区分方向）
+		field.String("currency").
+			MaxLen(10).
+			Default("CNY"),
+		field.Float("subtotal").
+			SchemaType(map[string]string{dialect.Postgres: "decimal(20,2)"}),
+		field.Float("discount_amount").
+			SchemaType(map[string]string{dialect.Postgres: "decimal(20,2)"}).
+			Default(0),
+		field.Float("fee_amount").
+			SchemaType(map[string]string{dialect.Postgres: "decimal(20,2)"}).
+			Default(0),
+		field.Float("total").
+			SchemaType(map[string]string{dialect.Postgres: "decimal(20,2)"}),
+		field.JSON("lines", []model.InvoiceLine{}).
+			SchemaType(map[string]string{dialect.Postgres: "jsonb"}),
+		field.String("payment_method").
+			MaxLen(30).
+			Default(""),
+
+		// 买方信息（开票时快照）
+		field.String("buyer_email").
+			MaxLen(255).
+			Default(""),
+		field.String("buyer_name").
+			MaxLen(255).
+			Default(""),
+		field.String("buyer_tax_id").
+			MaxLen(64).
+			Default(""),
+		field.String("buyer_address").
+			SchemaType(map[string]string{dialect.Postgres: "text"}).
+			Default(""),
+
+		// 卖方信息（开票时快照）
+		field.String("seller_name").
+			MaxLen(255).
+			Default(""),
+		field.String("seller_tax_id").
+			MaxLen(64).
+			Default(""),
+		field.String("seller_address").
+			SchemaType(map[string]string{dialect.Postgres: "text"}).
+			Default(""),
+
+		field.String("notes").
+			SchemaType(map[string]string{dialect.Postgres: "text"}).
+			Default(""),
+		field.Time("issued_at").
+			SchemaType(map[string]string{dialect.Postgres: "timestamptz"}),
+		field.Time("emailed_at").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "timestamptz"}),
+		field.Time("created_at").
+			Immutable().
+			Default(timezone.BeijingNow).
+			SchemaType(map[string]string{dialect.Postgres: "timestamptz"}),
+	}
+}
+
+func (Invoice) Indexes() []ent.Index {
+	return []ent.Index{
+		// 每个订单最多一张发票、一张红字发票
+		index.Fields("source_type", "source_id", "kind").Unique(),
+		index.Fields("user_id", "issued_at"),
+		index.Fields("issued_at"),
+	}
+}
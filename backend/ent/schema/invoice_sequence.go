@@ -0,0 +1,32 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+)
+
+// InvoiceSequence holds the schema definition for the InvoiceSequence entity.
+//
+// 发票编号计数器：每个系列（invoice / credit_note）一行，开票时在事务内加行锁递增，
+// 保证编号连续无空号。
+type InvoiceSequence struct {
+	ent.Schema
+}
+
+func (InvoiceSequence) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "invoice_sequences"},
+	}
+}
+
+func (InvoiceSequence) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("series").
+			MaxLen(20).
+			Unique(),
+		field.Int64("last_number").
+			Default(0),
+	}
+}
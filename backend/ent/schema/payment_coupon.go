@@ -0,0 +1,101 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/timezone"
+)
+
+// PaymentCoupon holds the schema definition for the PaymentCoupon entity.
+//
+// 结账优惠券：下单时按百分比或固定金额减免实付金额，可限定适用范围（充值/套餐）、
+// 最低金额、总使用次数、每用户使用次数与有效期。
+// 与注册优惠码（PromoCode）不同，优惠券不发放余额，只影响订单的 pay_amount。
+//
+// 删除策略：硬删除
+// 使用记录通过 PaymentOrder.coupon_id 关联，订单上同时冗余了 coupon_code 与 discount_amount，
+// 删除优惠券不影响历史订单与退款计算。
+type PaymentCoupon struct {
+	ent.Schema
+}
+
+func (PaymentCoupon) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "payment_coupons"},
+	}
+}
+
+func (PaymentCoupon) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("code").
+			MaxLen(32).
+			NotEmpty().
+			Unique(),
+		field.String("name").
+			MaxLen(100).
+			Default(""),
+
+		// 折扣类型：percent（按百分比减免）/ fixed（减免固定金额）
+		field.String("discount_type").
+			MaxLen(20).
+			Default("percent"),
+		// percent 时为百分比（0-100），fixed 时为减免金额
+		field.Float("discount_value").
+			SchemaType(map[string]string{dialect.Postgres: "decimal(20,2)"}),
+		// 订单原价达到该金额才可使用，0 表示不限制
+		field.Float("min_amount").
+			SchemaType(map[string]string{dialect.Postgres: "decimal(20,2)"}).
+			Default(0),
+
+		// 适用范围：all / balance（仅充值）/ subscription（仅套餐）
+		field.String("applies_to").
+			MaxLen(20).
+			Default("all"),
+		// 适用的套餐 ID 列表，空列表表示所有套餐
+		field.JSON("plan_ids", []int64{}).
+			Optional().
+			SchemaType(map[string]string{dialect.Postgres: "jsonb"}),
+
+		// 使用限制，0 表示不限制
+		field.Int("max_uses").
+			Default(0),
+		field.Int("per_user_limit").
+			Default(1),
+
+		field.String("status").
+			MaxLen(20).
+			Default("active"),
+		field.Time("starts_at").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "timestamptz"}),
+		field.Time("expires_at").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "timestamptz"}),
+		field.String("notes").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "text"}),
+
+		field.Time("created_at").
+			Immutable().
+			Default(timezone.BeijingNow).
+			SchemaType(map[string]string{dialect.Postgres: "timestamptz"}),
+		field.Time("updated_at").
+			Default(timezone.BeijingNow).
+			UpdateDefault(timezone.BeijingNow).
+			SchemaType(map[string]string{dialect.Postgres: "timestamptz"}),
+	}
+}
+
+func (PaymentCoupon) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status"),
+		index.Fields("expires_at"),
+	}
+}
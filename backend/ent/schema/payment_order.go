@@ -52,6 +52,18 @@ func (PaymentOrder) Fields() []ent.Field {
 		field.String("recharge_code").
 			MaxLen(64),
 
+		// 结账优惠券（pay_amount 已扣除 discount_amount）
+		field.Int64("coupon_id").
+			Optional().
+			Nillable(),
+		field.String("coupon_code").
+			Optional().
+			Nillable().
+			MaxLen(32),
+		field.Float("discount_amount").
+			SchemaType(map[string]string{dialect.Postgres: "decimal(20,2)"}).
+			Default(0),
+
 		// 支付信息
 		field.String("out_trade_no").
 			MaxLen(64).
@@ -185,5 +197,11 @@ func (PaymentOrder) Indexes() []ent.Index {
 		index.Fields("paid_at"),
 		index.Fields("payment_type", "paid_at"),
 		index.Fields("order_type"),
+		index.Fields("coupon_id", "user_id"),
+		// 链上支付的交易哈希只能被一个订单认领
+		index.Fields("payment_trade_no").
+			Unique().
+			StorageKey("idx_payment_orders_chain_trade_no").
+			Annotations(entsql.IndexWhere("payment_type = 'usdt' AND payment_trade_no <> ''")),
 	}
 }
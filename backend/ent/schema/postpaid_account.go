@@ -0,0 +1,72 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/timezone"
+)
+
+// PostpaidAccount holds the schema definition for the PostpaidAccount entity.
+//
+// 后付费（企业）账户：开通后用户余额允许透支至 -credit_limit，
+// 每个自然月结束后按 usage_logs.actual_cost 汇总生成账单（BillingStatement），
+// 账单逾期未结清时自动暂停该用户的全部 API Key。
+//
+// 删除策略：硬删除
+// 删除即恢复为预付费模式，历史账单保留在 billing_statements 中。
+type PostpaidAccount struct {
+	ent.Schema
+}
+
+func (PostpaidAccount) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "postpaid_accounts"},
+	}
+}
+
+func (PostpaidAccount) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("user_id"),
+		// 信用额度（USD），余额最低可透支至 -credit_limit
+		field.Float("credit_limit").
+			SchemaType(map[string]string{dialect.Postgres: "decimal(20,8)"}).
+			Default(0),
+		// 账期：账单生成后多少天到期
+		field.Int("payment_terms_days").
+			Default(15),
+		// 状态：active / suspended（账单逾期）
+		field.String("status").
+			MaxLen(20).
+			Default("active"),
+		// 计费起点，首期账单从该时间开始统计
+		field.Time("billing_start").
+			SchemaType(map[string]string{dialect.Postgres: "timestamptz"}),
+		field.Time("suspended_at").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "timestamptz"}),
+		field.String("notes").
+			Optional().
+			SchemaType(map[string]string{dialect.Postgres: "text"}),
+
+		field.Time("created_at").
+			Immutable().
+			Default(timezone.BeijingNow).
+			SchemaType(map[string]string{dialect.Postgres: "timestamptz"}),
+		field.Time("updated_at").
+			Default(timezone.BeijingNow).
+			UpdateDefault(timezone.BeijingNow).
+			SchemaType(map[string]string{dialect.Postgres: "timestamptz"}),
+	}
+}
+
+func (PostpaidAccount) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id").Unique(),
+		index.Fields("status"),
+	}
+}
@@ -0,0 +1,68 @@
+package schema
+
+import (
+	"github.com/Wei-Shaw/sub2api/ent/schema/mixins"
+	"github.com/Wei-Shaw/sub2api/internal/model"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ProxyPool 定义代理池的 schema。
+//
+// 代理池是带权重的代理集合，账号可引用代理池代替单个代理：
+//   - 出口代理从池内健康成员中按权重粘性选择（同一账号尽量保持同一出口 IP）
+//   - 成员由周期探测与转发时的连接错误判定健康状态，不健康时自动切换
+type ProxyPool struct {
+	ent.Schema
+}
+
+// Annotations 返回 schema 的注解配置。
+func (ProxyPool) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "proxy_pools"},
+	}
+}
+
+// Mixin 返回该 schema 使用的混入组件。
+func (ProxyPool) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.TimeMixin{},
+	}
+}
+
+// Fields 定义代理池实体的所有字段。
+func (ProxyPool) Fields() []ent.Field {
+	return []ent.Field{
+		// name: 代理池名称
+		field.String("name").
+			MaxLen(100).
+			NotEmpty(),
+
+		// status: active / inactive，停用的代理池不参与出口选择（回退到账号自身的代理）
+		field.String("status").
+			MaxLen(20).
+			Default("active"),
+
+		// members: 池成员（代理 ID 与权重）
+		field.JSON("members", []model.ProxyPoolMember{}).
+			Default(func() []model.ProxyPoolMember { return []model.ProxyPoolMember{} }).
+			SchemaType(map[string]string{dialect.Postgres: "jsonb"}),
+
+		// description: 代理池描述
+		field.Text("description").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Indexes 定义数据库索引。
+func (ProxyPool) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status"),
+	}
+}
@@ -0,0 +1,83 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/timezone"
+)
+
+// ReferralCommission holds the schema definition for the ReferralCommission entity.
+//
+// 佣金账户流水：开启佣金提现后，返利不再计入可消费余额，而是记入此表。
+// 每笔佣金在 available_at 之前处于冻结期，期内来源订单退款会按退款比例追回；
+// 冻结期结束后计入可提现金额（见 CommissionWithdrawal）。
+//
+// 删除策略：不删除
+// 佣金流水是提现对账依据，追回时仅更新 clawed_back_amount / status。
+type ReferralCommission struct {
+	ent.Schema
+}
+
+func (ReferralCommission) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "referral_commissions"},
+	}
+}
+
+func (ReferralCommission) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("referrer_id"),
+		field.Int64("referee_id"),
+		// 对应的 referral_rewards 记录
+		field.Int64("reward_id").
+			Optional().
+			Nillable(),
+		// 来源：redeem_code / payg_order
+		field.String("source_type").
+			MaxLen(20),
+		field.Int64("source_id"),
+		field.Float("source_amount").
+			SchemaType(map[string]string{dialect.Postgres: "decimal(20,8)"}).
+			Default(0),
+		field.Float("amount").
+			SchemaType(map[string]string{dialect.Postgres: "decimal(20,8)"}),
+		// 已追回金额，部分退款时小于 amount
+		field.Float("clawed_back_amount").
+			SchemaType(map[string]string{dialect.Postgres: "decimal(20,8)"}).
+			Default(0),
+		// 状态：active / clawed_back（已全额追回）
+		field.String("status").
+			MaxLen(20).
+			Default("active"),
+		// 冻结期结束时间，之后计入可提现金额且不再追回
+		field.Time("available_at").
+			SchemaType(map[string]string{dialect.Postgres: "timestamptz"}),
+		field.Time("clawed_back_at").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "timestamptz"}),
+		field.String("clawback_reason").
+			Optional().
+			SchemaType(map[string]string{dialect.Postgres: "text"}),
+
+		field.Time("created_at").
+			Immutable().
+			Default(timezone.BeijingNow).
+			SchemaType(map[string]string{dialect.Postgres: "timestamptz"}),
+		field.Time("updated_at").
+			Default(timezone.BeijingNow).
+			UpdateDefault(timezone.BeijingNow).
+			SchemaType(map[string]string{dialect.Postgres: "timestamptz"}),
+	}
+}
+
+func (ReferralCommission) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("referrer_id", "status"),
+		index.Fields("source_type", "source_id"),
+	}
+}
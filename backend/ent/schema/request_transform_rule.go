@@ -0,0 +1,99 @@
+package schema
+
+import (
+	"github.com/Wei-Shaw/sub2api/ent/schema/mixins"
+	"github.com/Wei-Shaw/sub2api/internal/model"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// RequestTransformRule 定义请求改写规则的 schema。
+//
+// 请求改写规则用于在转发到上游前声明式地修改请求：
+//   - 作用范围：平台 / 分组 / 账号 / 模型通配符（均为空表示不限制）
+//   - 请求体操作：set / delete / rename / default（JSON 路径）
+//   - 请求头操作：set / remove
+//   - 按优先级顺序依次应用所有命中的规则
+type RequestTransformRule struct {
+	ent.Schema
+}
+
+// Annotations 返回 schema 的注解配置。
+func (RequestTransformRule) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "request_transform_rules"},
+	}
+}
+
+// Mixin 返回该 schema 使用的混入组件。
+func (RequestTransformRule) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixins.TimeMixin{},
+	}
+}
+
+// Fields 定义请求改写规则实体的所有字段。
+func (RequestTransformRule) Fields() []ent.Field {
+	return []ent.Field{
+		// name: 规则名称
+		field.String("name").
+			MaxLen(100).
+			NotEmpty(),
+
+		// enabled: 是否启用该规则
+		field.Bool("enabled").
+			Default(true),
+
+		// priority: 规则优先级，数值越小越先应用
+		field.Int("priority").
+			Default(0),
+
+		// platforms: 适用平台列表，空列表表示适用于所有平台
+		field.JSON("platforms", []string{}).
+			Optional().
+			SchemaType(map[string]string{dialect.Postgres: "jsonb"}),
+
+		// group_ids: 适用分组 ID 列表，空列表表示所有分组
+		field.JSON("group_ids", []int64{}).
+			Optional().
+			SchemaType(map[string]string{dialect.Postgres: "jsonb"}),
+
+		// account_ids: 适用账号 ID 列表，空列表表示所有账号
+		field.JSON("account_ids", []int64{}).
+			Optional().
+			SchemaType(map[string]string{dialect.Postgres: "jsonb"}),
+
+		// model_patterns: 适用模型列表，支持末尾 * 通配符，空列表表示所有模型
+		field.JSON("model_patterns", []string{}).
+			Optional().
+			SchemaType(map[string]string{dialect.Postgres: "jsonb"}),
+
+		// body_operations: 请求体 JSON 操作列表（按顺序执行）
+		field.JSON("body_operations", []model.RequestBodyOperation{}).
+			Optional().
+			SchemaType(map[string]string{dialect.Postgres: "jsonb"}),
+
+		// header_operations: 上游请求头操作列表
+		field.JSON("header_operations", []model.RequestHeaderOperation{}).
+			Optional().
+			SchemaType(map[string]string{dialect.Postgres: "jsonb"}),
+
+		// description: 规则描述
+		field.Text("description").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Indexes 定义数据库索引。
+func (RequestTransformRule) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("enabled"),
+		index.Fields("priority"),
+	}
+}
@@ -0,0 +1,81 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/timezone"
+)
+
+// SubscriptionAutoRenewal holds the schema definition for the SubscriptionAutoRenewal entity.
+//
+// 自动续费协议：用户购买套餐时选择自动续费，在支付渠道（Stripe Billing）创建周期性订阅，
+// 每期扣款成功后生成续费订单并延长 UserSubscription，扣款失败时暂停订阅。
+//
+// 删除策略：硬删除
+// 协议通过 status 字段追踪生命周期（取消后保留记录），续费明细记录在 PaymentOrder 中。
+type SubscriptionAutoRenewal struct {
+	ent.Schema
+}
+
+func (SubscriptionAutoRenewal) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "subscription_auto_renewals"},
+	}
+}
+
+func (SubscriptionAutoRenewal) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("user_id"),
+		field.Int64("plan_id"),
+		field.Int64("group_id"),
+		field.Int("subscription_days"),
+		// 首期订单金额（冗余存储，续费订单沿用）
+		field.Float("amount").
+			SchemaType(map[string]string{dialect.Postgres: "decimal(20,2)"}),
+		field.String("payment_type").
+			MaxLen(30),
+		field.String("provider_instance_id").
+			Optional().
+			Nillable().
+			MaxLen(64),
+		// 支付渠道侧的订阅 ID（如 Stripe sub_xxx）
+		field.String("provider_subscription_id").
+			MaxLen(128),
+		// 创建自动续费的首期订单
+		field.Int64("initial_order_id"),
+
+		// 状态：incomplete / active / past_due / canceled
+		field.String("status").
+			MaxLen(20).
+			Default("incomplete"),
+		field.Time("current_period_end").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "timestamptz"}),
+		field.Time("canceled_at").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{dialect.Postgres: "timestamptz"}),
+
+		field.Time("created_at").
+			Immutable().
+			Default(timezone.BeijingNow).
+			SchemaType(map[string]string{dialect.Postgres: "timestamptz"}),
+		field.Time("updated_at").
+			Default(timezone.BeijingNow).
+			UpdateDefault(timezone.BeijingNow).
+			SchemaType(map[string]string{dialect.Postgres: "timestamptz"}),
+	}
+}
+
+func (SubscriptionAutoRenewal) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("provider_subscription_id").Unique(),
+		index.Fields("user_id"),
+		index.Fields("status"),
+	}
+}
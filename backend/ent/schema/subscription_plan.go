@@ -58,6 +58,10 @@ func (SubscriptionPlan) Fields() []ent.Field {
 			Default(true),
 		field.Int("sort_order").
 			Default(0),
+		// Stripe Price（recurring）ID，配置后该套餐支持自动续费
+		field.String("stripe_price_id").
+			MaxLen(100).
+			Default(""),
 		field.Time("created_at").
 			Immutable().
 			Default(time.Now).
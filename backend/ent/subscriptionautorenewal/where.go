@@ -0,0 +1,830 @@
+// Code generated by ent, DO NOT EDIT.
+
+package subscriptionautorenewal
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/Wei-Shaw/sub2api/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int64) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldEQ(FieldUserID, v))
+}
+
+// PlanID applies equality check predicate on the "plan_id" field. It's identical to PlanIDEQ.
+func PlanID(v int64) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldEQ(FieldPlanID, v))
+}
+
+// GroupID applies equality check predicate on the "group_id" field. It's identical to GroupIDEQ.
+func GroupID(v int64) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldEQ(FieldGroupID, v))
+}
+
+// SubscriptionDays applies equality check predicate on the "subscription_days" field. It's identical to SubscriptionDaysEQ.
+func SubscriptionDays(v int) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldEQ(FieldSubscriptionDays, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v float64) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldEQ(FieldAmount, v))
+}
+
+// PaymentType applies equality check predicate on the "payment_type" field. It's identical to PaymentTypeEQ.
+func PaymentType(v string) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldEQ(FieldPaymentType, v))
+}
+
+// ProviderInstanceID applies equality check predicate on the "provider_instance_id" field. It's identical to ProviderInstanceIDEQ.
+func ProviderInstanceID(v string) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldEQ(FieldProviderInstanceID, v))
+}
+
+// ProviderSubscriptionID applies equality check predicate on the "provider_subscription_id" field. It's identical to ProviderSubscriptionIDEQ.
+func ProviderSubscriptionID(v string) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldEQ(FieldProviderSubscriptionID, v))
+}
+
+// InitialOrderID applies equality check predicate on the "initial_order_id" field. It's identical to InitialOrderIDEQ.
+func InitialOrderID(v int64) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldEQ(FieldInitialOrderID, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldEQ(FieldStatus, v))
+}
+
+// CurrentPeriodEnd applies equality check predicate on the "current_period_end" field. It's identical to CurrentPeriodEndEQ.
+func CurrentPeriodEnd(v time.Time) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldEQ(FieldCurrentPeriodEnd, v))
+}
+
+// CanceledAt applies equality check predicate on the "canceled_at" field. It's identical to CanceledAtEQ.
+func CanceledAt(v time.Time) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldEQ(FieldCanceledAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int64) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int64) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int64) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int64) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v int64) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v int64) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v int64) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v int64) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldLTE(FieldUserID, v))
+}
+
+// PlanIDEQ applies the EQ predicate on the "plan_id" field.
+func PlanIDEQ(v int64) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldEQ(FieldPlanID, v))
+}
+
+// PlanIDNEQ applies the NEQ predicate on the "plan_id" field.
+func PlanIDNEQ(v int64) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldNEQ(FieldPlanID, v))
+}
+
+// PlanIDIn applies the In predicate on the "plan_id" field.
+func PlanIDIn(vs ...int64) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldIn(FieldPlanID, vs...))
+}
+
+// PlanIDNotIn applies the NotIn predicate on the "plan_id" field.
+func PlanIDNotIn(vs ...int64) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldNotIn(FieldPlanID, vs...))
+}
+
+// PlanIDGT applies the GT predicate on the "plan_id" field.
+func PlanIDGT(v int64) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldGT(FieldPlanID, v))
+}
+
+// PlanIDGTE applies the GTE predicate on the "plan_id" field.
+func PlanIDGTE(v int64) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldGTE(FieldPlanID, v))
+}
+
+// PlanIDLT applies the LT predicate on the "plan_id" field.
+func PlanIDLT(v int64) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldLT(FieldPlanID, v))
+}
+
+// PlanIDLTE applies the LTE predicate on the "plan_id" field.
+func PlanIDLTE(v int64) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldLTE(FieldPlanID, v))
+}
+
+// GroupIDEQ applies the EQ predicate on the "group_id" field.
+func GroupIDEQ(v int64) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldEQ(FieldGroupID, v))
+}
+
+// GroupIDNEQ applies the NEQ predicate on the "group_id" field.
+func GroupIDNEQ(v int64) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldNEQ(FieldGroupID, v))
+}
+
+// GroupIDIn applies the In predicate on the "group_id" field.
+func GroupIDIn(vs ...int64) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldIn(FieldGroupID, vs...))
+}
+
+// GroupIDNotIn applies the NotIn predicate on the "group_id" field.
+func GroupIDNotIn(vs ...int64) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldNotIn(FieldGroupID, vs...))
+}
+
+// GroupIDGT applies the GT predicate on the "group_id" field.
+func GroupIDGT(v int64) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldGT(FieldGroupID, v))
+}
+
+// GroupIDGTE applies the GTE predicate on the "group_id" field.
+func GroupIDGTE(v int64) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldGTE(FieldGroupID, v))
+}
+
+// GroupIDLT applies the LT predicate on the "group_id" field.
+func GroupIDLT(v int64) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldLT(FieldGroupID, v))
+}
+
+// GroupIDLTE applies the LTE predicate on the "group_id" field.
+func GroupIDLTE(v int64) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldLTE(FieldGroupID, v))
+}
+
+// SubscriptionDaysEQ applies the EQ predicate on the "subscription_days" field.
+func SubscriptionDaysEQ(v int) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldEQ(FieldSubscriptionDays, v))
+}
+
+// SubscriptionDaysNEQ applies the NEQ predicate on the "subscription_days" field.
+func SubscriptionDaysNEQ(v int) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldNEQ(FieldSubscriptionDays, v))
+}
+
+// SubscriptionDaysIn applies the In predicate on the "subscription_days" field.
+func SubscriptionDaysIn(vs ...int) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldIn(FieldSubscriptionDays, vs...))
+}
+
+// SubscriptionDaysNotIn applies the NotIn predicate on the "subscription_days" field.
+func SubscriptionDaysNotIn(vs ...int) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldNotIn(FieldSubscriptionDays, vs...))
+}
+
+// SubscriptionDaysGT applies the GT predicate on the "subscription_days" field.
+func SubscriptionDaysGT(v int) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldGT(FieldSubscriptionDays, v))
+}
+
+// SubscriptionDaysGTE applies the GTE predicate on the "subscription_days" field.
+func SubscriptionDaysGTE(v int) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldGTE(FieldSubscriptionDays, v))
+}
+
+// SubscriptionDaysLT applies the LT predicate on the "subscription_days" field.
+func SubscriptionDaysLT(v int) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldLT(FieldSubscriptionDays, v))
+}
+
+// SubscriptionDaysLTE applies the LTE predicate on the "subscription_days" field.
+func SubscriptionDaysLTE(v int) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldLTE(FieldSubscriptionDays, v))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v float64) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v float64) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...float64) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...float64) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v float64) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v float64) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v float64) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v float64) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldLTE(FieldAmount, v))
+}
+
+// PaymentTypeEQ applies the EQ predicate on the "payment_type" field.
+func PaymentTypeEQ(v string) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldEQ(FieldPaymentType, v))
+}
+
+// PaymentTypeNEQ applies the NEQ predicate on the "payment_type" field.
+func PaymentTypeNEQ(v string) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldNEQ(FieldPaymentType, v))
+}
+
+// PaymentTypeIn applies the In predicate on the "payment_type" field.
+func PaymentTypeIn(vs ...string) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldIn(FieldPaymentType, vs...))
+}
+
+// PaymentTypeNotIn applies the NotIn predicate on the "payment_type" field.
+func PaymentTypeNotIn(vs ...string) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldNotIn(FieldPaymentType, vs...))
+}
+
+// PaymentTypeGT applies the GT predicate on the "payment_type" field.
+func PaymentTypeGT(v string) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldGT(FieldPaymentType, v))
+}
+
+// PaymentTypeGTE applies the GTE predicate on the "payment_type" field.
+func PaymentTypeGTE(v string) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldGTE(FieldPaymentType, v))
+}
+
+// PaymentTypeLT applies the LT predicate on the "payment_type" field.
+func PaymentTypeLT(v string) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldLT(FieldPaymentType, v))
+}
+
+// PaymentTypeLTE applies the LTE predicate on the "payment_type" field.
+func PaymentTypeLTE(v string) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldLTE(FieldPaymentType, v))
+}
+
+// PaymentTypeContains applies the Contains predicate on the "payment_type" field.
+func PaymentTypeContains(v string) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldContains(FieldPaymentType, v))
+}
+
+// PaymentTypeHasPrefix applies the HasPrefix predicate on the "payment_type" field.
+func PaymentTypeHasPrefix(v string) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldHasPrefix(FieldPaymentType, v))
+}
+
+// PaymentTypeHasSuffix applies the HasSuffix predicate on the "payment_type" field.
+func PaymentTypeHasSuffix(v string) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldHasSuffix(FieldPaymentType, v))
+}
+
+// PaymentTypeEqualFold applies the EqualFold predicate on the "payment_type" field.
+func PaymentTypeEqualFold(v string) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldEqualFold(FieldPaymentType, v))
+}
+
+// PaymentTypeContainsFold applies the ContainsFold predicate on the "payment_type" field.
+func PaymentTypeContainsFold(v string) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldContainsFold(FieldPaymentType, v))
+}
+
+// ProviderInstanceIDEQ applies the EQ predicate on the "provider_instance_id" field.
+func ProviderInstanceIDEQ(v string) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldEQ(FieldProviderInstanceID, v))
+}
+
+// ProviderInstanceIDNEQ applies the NEQ predicate on the "provider_instance_id" field.
+func ProviderInstanceIDNEQ(v string) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldNEQ(FieldProviderInstanceID, v))
+}
+
+// ProviderInstanceIDIn applies the In predicate on the "provider_instance_id" field.
+func ProviderInstanceIDIn(vs ...string) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldIn(FieldProviderInstanceID, vs...))
+}
+
+// ProviderInstanceIDNotIn applies the NotIn predicate on the "provider_instance_id" field.
+func ProviderInstanceIDNotIn(vs ...string) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldNotIn(FieldProviderInstanceID, vs...))
+}
+
+// ProviderInstanceIDGT applies the GT predicate on the "provider_instance_id" field.
+func ProviderInstanceIDGT(v string) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldGT(FieldProviderInstanceID, v))
+}
+
+// ProviderInstanceIDGTE applies the GTE predicate on the "provider_instance_id" field.
+func ProviderInstanceIDGTE(v string) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldGTE(FieldProviderInstanceID, v))
+}
+
+// ProviderInstanceIDLT applies the LT predicate on the "provider_instance_id" field.
+func ProviderInstanceIDLT(v string) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldLT(FieldProviderInstanceID, v))
+}
+
+// ProviderInstanceIDLTE applies the LTE predicate on the "provider_instance_id" field.
+func ProviderInstanceIDLTE(v string) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldLTE(FieldProviderInstanceID, v))
+}
+
+// ProviderInstanceIDContains applies the Contains predicate on the "provider_instance_id" field.
+func ProviderInstanceIDContains(v string) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldContains(FieldProviderInstanceID, v))
+}
+
+// ProviderInstanceIDHasPrefix applies the HasPrefix predicate on the "provider_instance_id" field.
+func ProviderInstanceIDHasPrefix(v string) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldHasPrefix(FieldProviderInstanceID, v))
+}
+
+// ProviderInstanceIDHasSuffix applies the HasSuffix predicate on the "provider_instance_id" field.
+func ProviderInstanceIDHasSuffix(v string) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldHasSuffix(FieldProviderInstanceID, v))
+}
+
+// ProviderInstanceIDIsNil applies the IsNil predicate on the "provider_instance_id" field.
+func ProviderInstanceIDIsNil() predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldIsNull(FieldProviderInstanceID))
+}
+
+// ProviderInstanceIDNotNil applies the NotNil predicate on the "provider_instance_id" field.
+func ProviderInstanceIDNotNil() predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldNotNull(FieldProviderInstanceID))
+}
+
+// ProviderInstanceIDEqualFold applies the EqualFold predicate on the "provider_instance_id" field.
+func ProviderInstanceIDEqualFold(v string) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldEqualFold(FieldProviderInstanceID, v))
+}
+
+// ProviderInstanceIDContainsFold applies the ContainsFold predicate on the "provider_instance_id" field.
+func ProviderInstanceIDContainsFold(v string) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldContainsFold(FieldProviderInstanceID, v))
+}
+
+// ProviderSubscriptionIDEQ applies the EQ predicate on the "provider_subscription_id" field.
+func ProviderSubscriptionIDEQ(v string) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldEQ(FieldProviderSubscriptionID, v))
+}
+
+// ProviderSubscriptionIDNEQ applies the NEQ predicate on the "provider_subscription_id" field.
+func ProviderSubscriptionIDNEQ(v string) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldNEQ(FieldProviderSubscriptionID, v))
+}
+
+// ProviderSubscriptionIDIn applies the In predicate on the "provider_subscription_id" field.
+func ProviderSubscriptionIDIn(vs ...string) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldIn(FieldProviderSubscriptionID, vs...))
+}
+
+// ProviderSubscriptionIDNotIn applies the NotIn predicate on the "provider_subscription_id" field.
+func ProviderSubscriptionIDNotIn(vs ...string) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldNotIn(FieldProviderSubscriptionID, vs...))
+}
+
+// ProviderSubscriptionIDGT applies the GT predicate on the "provider_subscription_id" field.
+func ProviderSubscriptionIDGT(v string) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldGT(FieldProviderSubscriptionID, v))
+}
+
+// ProviderSubscriptionIDGTE applies the GTE predicate on the "provider_subscription_id" field.
+func ProviderSubscriptionIDGTE(v string) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldGTE(FieldProviderSubscriptionID, v))
+}
+
+// ProviderSubscriptionIDLT applies the LT predicate on the "provider_subscription_id" field.
+func ProviderSubscriptionIDLT(v string) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldLT(FieldProviderSubscriptionID, v))
+}
+
+// ProviderSubscriptionIDLTE applies the LTE predicate on the "provider_subscription_id" field.
+func ProviderSubscriptionIDLTE(v string) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldLTE(FieldProviderSubscriptionID, v))
+}
+
+// ProviderSubscriptionIDContains applies the Contains predicate on the "provider_subscription_id" field.
+func ProviderSubscriptionIDContains(v string) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldContains(FieldProviderSubscriptionID, v))
+}
+
+// ProviderSubscriptionIDHasPrefix applies the HasPrefix predicate on the "provider_subscription_id" field.
+func ProviderSubscriptionIDHasPrefix(v string) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldHasPrefix(FieldProviderSubscriptionID, v))
+}
+
+// ProviderSubscriptionIDHasSuffix applies the HasSuffix predicate on the "provider_subscription_id" field.
+func ProviderSubscriptionIDHasSuffix(v string) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldHasSuffix(FieldProviderSubscriptionID, v))
+}
+
+// ProviderSubscriptionIDEqualFold applies the EqualFold predicate on the "provider_subscription_id" field.
+func ProviderSubscriptionIDEqualFold(v string) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldEqualFold(FieldProviderSubscriptionID, v))
+}
+
+// ProviderSubscriptionIDContainsFold applies the ContainsFold predicate on the "provider_subscription_id" field.
+func ProviderSubscriptionIDContainsFold(v string) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldContainsFold(FieldProviderSubscriptionID, v))
+}
+
+// InitialOrderIDEQ applies the EQ predicate on the "initial_order_id" field.
+func InitialOrderIDEQ(v int64) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldEQ(FieldInitialOrderID, v))
+}
+
+// InitialOrderIDNEQ applies the NEQ predicate on the "initial_order_id" field.
+func InitialOrderIDNEQ(v int64) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldNEQ(FieldInitialOrderID, v))
+}
+
+// InitialOrderIDIn applies the In predicate on the "initial_order_id" field.
+func InitialOrderIDIn(vs ...int64) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldIn(FieldInitialOrderID, vs...))
+}
+
+// InitialOrderIDNotIn applies the NotIn predicate on the "initial_order_id" field.
+func InitialOrderIDNotIn(vs ...int64) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldNotIn(FieldInitialOrderID, vs...))
+}
+
+// InitialOrderIDGT applies the GT predicate on the "initial_order_id" field.
+func InitialOrderIDGT(v int64) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldGT(FieldInitialOrderID, v))
+}
+
+// InitialOrderIDGTE applies the GTE predicate on the "initial_order_id" field.
+func InitialOrderIDGTE(v int64) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldGTE(FieldInitialOrderID, v))
+}
+
+// InitialOrderIDLT applies the LT predicate on the "initial_order_id" field.
+func InitialOrderIDLT(v int64) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldLT(FieldInitialOrderID, v))
+}
+
+// InitialOrderIDLTE applies the LTE predicate on the "initial_order_id" field.
+func InitialOrderIDLTE(v int64) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldLTE(FieldInitialOrderID, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// CurrentPeriodEndEQ applies the EQ predicate on the "current_period_end" field.
+func CurrentPeriodEndEQ(v time.Time) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldEQ(FieldCurrentPeriodEnd, v))
+}
+
+// CurrentPeriodEndNEQ applies the NEQ predicate on the "current_period_end" field.
+func CurrentPeriodEndNEQ(v time.Time) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldNEQ(FieldCurrentPeriodEnd, v))
+}
+
+// CurrentPeriodEndIn applies the In predicate on the "current_period_end" field.
+func CurrentPeriodEndIn(vs ...time.Time) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldIn(FieldCurrentPeriodEnd, vs...))
+}
+
+// CurrentPeriodEndNotIn applies the NotIn predicate on the "current_period_end" field.
+func CurrentPeriodEndNotIn(vs ...time.Time) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldNotIn(FieldCurrentPeriodEnd, vs...))
+}
+
+// CurrentPeriodEndGT applies the GT predicate on the "current_period_end" field.
+func CurrentPeriodEndGT(v time.Time) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldGT(FieldCurrentPeriodEnd, v))
+}
+
+// CurrentPeriodEndGTE applies the GTE predicate on the "current_period_end" field.
+func CurrentPeriodEndGTE(v time.Time) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldGTE(FieldCurrentPeriodEnd, v))
+}
+
+// CurrentPeriodEndLT applies the LT predicate on the "current_period_end" field.
+func CurrentPeriodEndLT(v time.Time) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldLT(FieldCurrentPeriodEnd, v))
+}
+
+// CurrentPeriodEndLTE applies the LTE predicate on the "current_period_end" field.
+func CurrentPeriodEndLTE(v time.Time) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldLTE(FieldCurrentPeriodEnd, v))
+}
+
+// CurrentPeriodEndIsNil applies the IsNil predicate on the "current_period_end" field.
+func CurrentPeriodEndIsNil() predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldIsNull(FieldCurrentPeriodEnd))
+}
+
+// CurrentPeriodEndNotNil applies the NotNil predicate on the "current_period_end" field.
+func CurrentPeriodEndNotNil() predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldNotNull(FieldCurrentPeriodEnd))
+}
+
+// CanceledAtEQ applies the EQ predicate on the "canceled_at" field.
+func CanceledAtEQ(v time.Time) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldEQ(FieldCanceledAt, v))
+}
+
+// CanceledAtNEQ applies the NEQ predicate on the "canceled_at" field.
+func CanceledAtNEQ(v time.Time) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldNEQ(FieldCanceledAt, v))
+}
+
+// CanceledAtIn applies the In predicate on the "canceled_at" field.
+func CanceledAtIn(vs ...time.Time) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldIn(FieldCanceledAt, vs...))
+}
+
+// CanceledAtNotIn applies the NotIn predicate on the "canceled_at" field.
+func CanceledAtNotIn(vs ...time.Time) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldNotIn(FieldCanceledAt, vs...))
+}
+
+// CanceledAtGT applies the GT predicate on the "canceled_at" field.
+func CanceledAtGT(v time.Time) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldGT(FieldCanceledAt, v))
+}
+
+// CanceledAtGTE applies the GTE predicate on the "canceled_at" field.
+func CanceledAtGTE(v time.Time) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldGTE(FieldCanceledAt, v))
+}
+
+// CanceledAtLT applies the LT predicate on the "canceled_at" field.
+func CanceledAtLT(v time.Time) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldLT(FieldCanceledAt, v))
+}
+
+// CanceledAtLTE applies the LTE predicate on the "canceled_at" field.
+func CanceledAtLTE(v time.Time) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldLTE(FieldCanceledAt, v))
+}
+
+// CanceledAtIsNil applies the IsNil predicate on the "canceled_at" field.
+func CanceledAtIsNil() predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldIsNull(FieldCanceledAt))
+}
+
+// CanceledAtNotNil applies the NotNil predicate on the "canceled_at" field.
+func CanceledAtNotNil() predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldNotNull(FieldCanceledAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SubscriptionAutoRenewal) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SubscriptionAutoRenewal) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SubscriptionAutoRenewal) predicate.SubscriptionAutoRenewal {
+	return predicate.SubscriptionAutoRenewal(sql.NotPredicates(p))
+}
@@ -0,0 +1,1373 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Wei-Shaw/sub2api/ent/subscriptionautorenewal"
+)
+
+// SubscriptionAutoRenewalCreate is the builder for creating a SubscriptionAutoRenewal entity.
+type SubscriptionAutoRenewalCreate struct {
+	config
+	mutation *SubscriptionAutoRenewalMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *SubscriptionAutoRenewalCreate) SetUserID(v int64) *SubscriptionAutoRenewalCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetPlanID sets the "plan_id" field.
+func (_c *SubscriptionAutoRenewalCreate) SetPlanID(v int64) *SubscriptionAutoRenewalCreate {
+	_c.mutation.SetPlanID(v)
+	return _c
+}
+
+// SetGroupID sets the "group_id" field.
+func (_c *SubscriptionAutoRenewalCreate) SetGroupID(v int64) *SubscriptionAutoRenewalCreate {
+	_c.mutation.SetGroupID(v)
+	return _c
+}
+
+// SetSubscriptionDays sets the "subscription_days" field.
+func (_c *SubscriptionAutoRenewalCreate) SetSubscriptionDays(v int) *SubscriptionAutoRenewalCreate {
+	_c.mutation.SetSubscriptionDays(v)
+	return _c
+}
+
+// SetAmount sets the "amount" field.
+func (_c *SubscriptionAutoRenewalCreate) SetAmount(v float64) *SubscriptionAutoRenewalCreate {
+	_c.mutation.SetAmount(v)
+	return _c
+}
+
+// SetPaymentType sets the "payment_type" field.
+func (_c *SubscriptionAutoRenewalCreate) SetPaymentType(v string) *SubscriptionAutoRenewalCreate {
+	_c.mutation.SetPaymentType(v)
+	return _c
+}
+
+// SetProviderInstanceID sets the "provider_instance_id" field.
+func (_c *SubscriptionAutoRenewalCreate) SetProviderInstanceID(v string) *SubscriptionAutoRenewalCreate {
+	_c.mutation.SetProviderInstanceID(v)
+	return _c
+}
+
+// SetNillableProviderInstanceID sets the "provider_instance_id" field if the given value is not nil.
+func (_c *SubscriptionAutoRenewalCreate) SetNillableProviderInstanceID(v *string) *SubscriptionAutoRenewalCreate {
+	if v != nil {
+		_c.SetProviderInstanceID(*v)
+	}
+	return _c
+}
+
+// SetProviderSubscriptionID sets the "provider_subscription_id" field.
+func (_c *SubscriptionAutoRenewalCreate) SetProviderSubscriptionID(v string) *SubscriptionAutoRenewalCreate {
+	_c.mutation.SetProviderSubscriptionID(v)
+	return _c
+}
+
+// SetInitialOrderID sets the "initial_order_id" field.
+func (_c *SubscriptionAutoRenewalCreate) SetInitialOrderID(v int64) *SubscriptionAutoRenewalCreate {
+	_c.mutation.SetInitialOrderID(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *SubscriptionAutoRenewalCreate) SetStatus(v string) *SubscriptionAutoRenewalCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *SubscriptionAutoRenewalCreate) SetNillableStatus(v *string) *SubscriptionAutoRenewalCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetCurrentPeriodEnd sets the "current_period_end" field.
+func (_c *SubscriptionAutoRenewalCreate) SetCurrentPeriodEnd(v time.Time) *SubscriptionAutoRenewalCreate {
+	_c.mutation.SetCurrentPeriodEnd(v)
+	return _c
+}
+
+// SetNillableCurrentPeriodEnd sets the "current_period_end" field if the given value is not nil.
+func (_c *SubscriptionAutoRenewalCreate) SetNillableCurrentPeriodEnd(v *time.Time) *SubscriptionAutoRenewalCreate {
+	if v != nil {
+		_c.SetCurrentPeriodEnd(*v)
+	}
+	return _c
+}
+
+// SetCanceledAt sets the "canceled_at" field.
+func (_c *SubscriptionAutoRenewalCreate) SetCanceledAt(v time.Time) *SubscriptionAutoRenewalCreate {
+	_c.mutation.SetCanceledAt(v)
+	return _c
+}
+
+// SetNillableCanceledAt sets the "canceled_at" field if the given value is not nil.
+func (_c *SubscriptionAutoRenewalCreate) SetNillableCanceledAt(v *time.Time) *SubscriptionAutoRenewalCreate {
+	if v != nil {
+		_c.SetCanceledAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *SubscriptionAutoRenewalCreate) SetCreatedAt(v time.Time) *SubscriptionAutoRenewalCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *SubscriptionAutoRenewalCreate) SetNillableCreatedAt(v *time.Time) *SubscriptionAutoRenewalCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *SubscriptionAutoRenewalCreate) SetUpdatedAt(v time.Time) *SubscriptionAutoRenewalCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *SubscriptionAutoRenewalCreate) SetNillableUpdatedAt(v *time.Time) *SubscriptionAutoRenewalCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the SubscriptionAutoRenewalMutation object of the builder.
+func (_c *SubscriptionAutoRenewalCreate) Mutation() *SubscriptionAutoRenewalMutation {
+	return _c.mutation
+}
+
+// Save creates the SubscriptionAutoRenewal in the database.
+func (_c *SubscriptionAutoRenewalCreate) Save(ctx context.Context) (*SubscriptionAutoRenewal, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SubscriptionAutoRenewalCreate) SaveX(ctx context.Context) *SubscriptionAutoRenewal {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SubscriptionAutoRenewalCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SubscriptionAutoRenewalCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *SubscriptionAutoRenewalCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := subscriptionautorenewal.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := subscriptionautorenewal.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := subscriptionautorenewal.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SubscriptionAutoRenewalCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "SubscriptionAutoRenewal.user_id"`)}
+	}
+	if _, ok := _c.mutation.PlanID(); !ok {
+		return &ValidationError{Name: "plan_id", err: errors.New(`ent: missing required field "SubscriptionAutoRenewal.plan_id"`)}
+	}
+	if _, ok := _c.mutation.GroupID(); !ok {
+		return &ValidationError{Name: "group_id", err: errors.New(`ent: missing required field "SubscriptionAutoRenewal.group_id"`)}
+	}
+	if _, ok := _c.mutation.SubscriptionDays(); !ok {
+		return &ValidationError{Name: "subscription_days", err: errors.New(`ent: missing required field "SubscriptionAutoRenewal.subscription_days"`)}
+	}
+	if _, ok := _c.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "SubscriptionAutoRenewal.amount"`)}
+	}
+	if _, ok := _c.mutation.PaymentType(); !ok {
+		return &ValidationError{Name: "payment_type", err: errors.New(`ent: missing required field "SubscriptionAutoRenewal.payment_type"`)}
+	}
+	if v, ok := _c.mutation.PaymentType(); ok {
+		if err := subscriptionautorenewal.PaymentTypeValidator(v); err != nil {
+			return &ValidationError{Name: "payment_type", err: fmt.Errorf(`ent: validator failed for field "SubscriptionAutoRenewal.payment_type": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ProviderInstanceID(); ok {
+		if err := subscriptionautorenewal.ProviderInstanceIDValidator(v); err != nil {
+			return &ValidationError{Name: "provider_instance_id", err: fmt.Errorf(`ent: validator failed for field "SubscriptionAutoRenewal.provider_instance_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ProviderSubscriptionID(); !ok {
+		return &ValidationError{Name: "provider_subscription_id", err: errors.New(`ent: missing required field "SubscriptionAutoRenewal.provider_subscription_id"`)}
+	}
+	if v, ok := _c.mutation.ProviderSubscriptionID(); ok {
+		if err := subscriptionautorenewal.ProviderSubscriptionIDValidator(v); err != nil {
+			return &ValidationError{Name: "provider_subscription_id", err: fmt.Errorf(`ent: validator failed for field "SubscriptionAutoRenewal.provider_subscription_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.InitialOrderID(); !ok {
+		return &ValidationError{Name: "initial_order_id", err: errors.New(`ent: missing required field "SubscriptionAutoRenewal.initial_order_id"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "SubscriptionAutoRenewal.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := subscriptionautorenewal.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "SubscriptionAutoRenewal.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SubscriptionAutoRenewal.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "SubscriptionAutoRenewal.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *SubscriptionAutoRenewalCreate) sqlSave(ctx context.Context) (*SubscriptionAutoRenewal, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int64(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SubscriptionAutoRenewalCreate) createSpec() (*SubscriptionAutoRenewal, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SubscriptionAutoRenewal{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(subscriptionautorenewal.Table, sqlgraph.NewFieldSpec(subscriptionautorenewal.FieldID, field.TypeInt64))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(subscriptionautorenewal.FieldUserID, field.TypeInt64, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.PlanID(); ok {
+		_spec.SetField(subscriptionautorenewal.FieldPlanID, field.TypeInt64, value)
+		_node.PlanID = value
+	}
+	if value, ok := _c.mutation.GroupID(); ok {
+		_spec.SetField(subscriptionautorenewal.FieldGroupID, field.TypeInt64, value)
+		_node.GroupID = value
+	}
+	if value, ok := _c.mutation.SubscriptionDays(); ok {
+		_spec.SetField(subscriptionautorenewal.FieldSubscriptionDays, field.TypeInt, value)
+		_node.SubscriptionDays = value
+	}
+	if value, ok := _c.mutation.Amount(); ok {
+		_spec.SetField(subscriptionautorenewal.FieldAmount, field.TypeFloat64, value)
+		_node.Amount = value
+	}
+	if value, ok := _c.mutation.PaymentType(); ok {
+		_spec.SetField(subscriptionautorenewal.FieldPaymentType, field.TypeString, value)
+		_node.PaymentType = value
+	}
+	if value, ok := _c.mutation.ProviderInstanceID(); ok {
+		_spec.SetField(subscriptionautorenewal.FieldProviderInstanceID, field.TypeString, value)
+		_node.ProviderInstanceID = &value
+	}
+	if value, ok := _c.mutation.ProviderSubscriptionID(); ok {
+		_spec.SetField(subscriptionautorenewal.FieldProviderSubscriptionID, field.TypeString, value)
+		_node.ProviderSubscriptionID = value
+	}
+	if value, ok := _c.mutation.InitialOrderID(); ok {
+		_spec.SetField(subscriptionautorenewal.FieldInitialOrderID, field.TypeInt64, value)
+		_node.InitialOrderID = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(subscriptionautorenewal.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.CurrentPeriodEnd(); ok {
+		_spec.SetField(subscriptionautorenewal.FieldCurrentPeriodEnd, field.TypeTime, value)
+		_node.CurrentPeriodEnd = &value
+	}
+	if value, ok := _c.mutation.CanceledAt(); ok {
+		_spec.SetField(subscriptionautorenewal.FieldCanceledAt, field.TypeTime, value)
+		_node.CanceledAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(subscriptionautorenewal.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(subscriptionautorenewal.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SubscriptionAutoRenewal.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SubscriptionAutoRenewalUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *SubscriptionAutoRenewalCreate) OnConflict(opts ...sql.ConflictOption) *SubscriptionAutoRenewalUpsertOne {
+	_c.conflict = opts
+	return &SubscriptionAutoRenewalUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SubscriptionAutoRenewal.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *SubscriptionAutoRenewalCreate) OnConflictColumns(columns ...string) *SubscriptionAutoRenewalUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &SubscriptionAutoRenewalUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// SubscriptionAutoRenewalUpsertOne is the builder for "upsert"-ing
+	//  one SubscriptionAutoRenewal node.
+	SubscriptionAutoRenewalUpsertOne struct {
+		create *SubscriptionAutoRenewalCreate
+	}
+
+	// SubscriptionAutoRenewalUpsert is the "OnConflict" setter.
+	SubscriptionAutoRenewalUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUserID sets the "user_id" field.
+func (u *SubscriptionAutoRenewalUpsert) SetUserID(v int64) *SubscriptionAutoRenewalUpsert {
+	u.Set(subscriptionautorenewal.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *SubscriptionAutoRenewalUpsert) UpdateUserID() *SubscriptionAutoRenewalUpsert {
+	u.SetExcluded(subscriptionautorenewal.FieldUserID)
+	return u
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *SubscriptionAutoRenewalUpsert) AddUserID(v int64) *SubscriptionAutoRenewalUpsert {
+	u.Add(subscriptionautorenewal.FieldUserID, v)
+	return u
+}
+
+// SetPlanID sets the "plan_id" field.
+func (u *SubscriptionAutoRenewalUpsert) SetPlanID(v int64) *SubscriptionAutoRenewalUpsert {
+	u.Set(subscriptionautorenewal.FieldPlanID, v)
+	return u
+}
+
+// UpdatePlanID sets the "plan_id" field to the value that was provided on create.
+func (u *SubscriptionAutoRenewalUpsert) UpdatePlanID() *SubscriptionAutoRenewalUpsert {
+	u.SetExcluded(subscriptionautorenewal.FieldPlanID)
+	return u
+}
+
+// AddPlanID adds v to the "plan_id" field.
+func (u *SubscriptionAutoRenewalUpsert) AddPlanID(v int64) *SubscriptionAutoRenewalUpsert {
+	u.Add(subscriptionautorenewal.FieldPlanID, v)
+	return u
+}
+
+// SetGroupID sets the "group_id" field.
+func (u *SubscriptionAutoRenewalUpsert) SetGroupID(v int64) *SubscriptionAutoRenewalUpsert {
+	u.Set(subscriptionautorenewal.FieldGroupID, v)
+	return u
+}
+
+// UpdateGroupID sets the "group_id" field to the value that was provided on create.
+func (u *SubscriptionAutoRenewalUpsert) UpdateGroupID() *SubscriptionAutoRenewalUpsert {
+	u.SetExcluded(subscriptionautorenewal.FieldGroupID)
+	return u
+}
+
+// AddGroupID adds v to the "group_id" field.
+func (u *SubscriptionAutoRenewalUpsert) AddGroupID(v int64) *SubscriptionAutoRenewalUpsert {
+	u.Add(subscriptionautorenewal.FieldGroupID, v)
+	return u
+}
+
+// SetSubscriptionDays sets the "subscription_days" field.
+func (u *SubscriptionAutoRenewalUpsert) SetSubscriptionDays(v int) *SubscriptionAutoRenewalUpsert {
+	u.Set(subscriptionautorenewal.FieldSubscriptionDays, v)
+	return u
+}
+
+// UpdateSubscriptionDays sets the "subscription_days" field to the value that was provided on create.
+func (u *SubscriptionAutoRenewalUpsert) UpdateSubscriptionDays() *SubscriptionAutoRenewalUpsert {
+	u.SetExcluded(subscriptionautorenewal.FieldSubscriptionDays)
+	return u
+}
+
+// AddSubscriptionDays adds v to the "subscription_days" field.
+func (u *SubscriptionAutoRenewalUpsert) AddSubscriptionDays(v int) *SubscriptionAutoRenewalUpsert {
+	u.Add(subscriptionautorenewal.FieldSubscriptionDays, v)
+	return u
+}
+
+// SetAmount sets the "amount" field.
+func (u *SubscriptionAutoRenewalUpsert) SetAmount(v float64) *SubscriptionAutoRenewalUpsert {
+	u.Set(subscriptionautorenewal.FieldAmount, v)
+	return u
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *SubscriptionAutoRenewalUpsert) UpdateAmount() *SubscriptionAutoRenewalUpsert {
+	u.SetExcluded(subscriptionautorenewal.FieldAmount)
+	return u
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *SubscriptionAutoRenewalUpsert) AddAmount(v float64) *SubscriptionAutoRenewalUpsert {
+	u.Add(subscriptionautorenewal.FieldAmount, v)
+	return u
+}
+
+// SetPaymentType sets the "payment_type" field.
+func (u *SubscriptionAutoRenewalUpsert) SetPaymentType(v string) *SubscriptionAutoRenewalUpsert {
+	u.Set(subscriptionautorenewal.FieldPaymentType, v)
+	return u
+}
+
+// UpdatePaymentType sets the "payment_type" field to the value that was provided on create.
+func (u *SubscriptionAutoRenewalUpsert) UpdatePaymentType() *SubscriptionAutoRenewalUpsert {
+	u.SetExcluded(subscriptionautorenewal.FieldPaymentType)
+	return u
+}
+
+// SetProviderInstanceID sets the "provider_instance_id" field.
+func (u *SubscriptionAutoRenewalUpsert) SetProviderInstanceID(v string) *SubscriptionAutoRenewalUpsert {
+	u.Set(subscriptionautorenewal.FieldProviderInstanceID, v)
+	return u
+}
+
+// UpdateProviderInstanceID sets the "provider_instance_id" field to the value that was provided on create.
+func (u *SubscriptionAutoRenewalUpsert) UpdateProviderInstanceID() *SubscriptionAutoRenewalUpsert {
+	u.SetExcluded(subscriptionautorenewal.FieldProviderInstanceID)
+	return u
+}
+
+// ClearProviderInstanceID clears the value of the "provider_instance_id" field.
+func (u *SubscriptionAutoRenewalUpsert) ClearProviderInstanceID() *SubscriptionAutoRenewalUpsert {
+	u.SetNull(subscriptionautorenewal.FieldProviderInstanceID)
+	return u
+}
+
+// SetProviderSubscriptionID sets the "provider_subscription_id" field.
+func (u *SubscriptionAutoRenewalUpsert) SetProviderSubscriptionID(v string) *SubscriptionAutoRenewalUpsert {
+	u.Set(subscriptionautorenewal.FieldProviderSubscriptionID, v)
+	return u
+}
+
+// UpdateProviderSubscriptionID sets the "provider_subscription_id" field to the value that was provided on create.
+func (u *SubscriptionAutoRenewalUpsert) UpdateProviderSubscriptionID() *SubscriptionAutoRenewalUpsert {
+	u.SetExcluded(subscriptionautorenewal.FieldProviderSubscriptionID)
+	return u
+}
+
+// SetInitialOrderID sets the "initial_order_id" field.
+func (u *SubscriptionAutoRenewalUpsert) SetInitialOrderID(v int64) *SubscriptionAutoRenewalUpsert {
+	u.Set(subscriptionautorenewal.FieldInitialOrderID, v)
+	return u
+}
+
+// UpdateInitialOrderID sets the "initial_order_id" field to the value that was provided on create.
+func (u *SubscriptionAutoRenewalUpsert) UpdateInitialOrderID() *SubscriptionAutoRenewalUpsert {
+	u.SetExcluded(subscriptionautorenewal.FieldInitialOrderID)
+	return u
+}
+
+// AddInitialOrderID adds v to the "initial_order_id" field.
+func (u *SubscriptionAutoRenewalUpsert) AddInitialOrderID(v int64) *SubscriptionAutoRenewalUpsert {
+	u.Add(subscriptionautorenewal.FieldInitialOrderID, v)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *SubscriptionAutoRenewalUpsert) SetStatus(v string) *SubscriptionAutoRenewalUpsert {
+	u.Set(subscriptionautorenewal.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *SubscriptionAutoRenewalUpsert) UpdateStatus() *SubscriptionAutoRenewalUpsert {
+	u.SetExcluded(subscriptionautorenewal.FieldStatus)
+	return u
+}
+
+// SetCurrentPeriodEnd sets the "current_period_end" field.
+func (u *SubscriptionAutoRenewalUpsert) SetCurrentPeriodEnd(v time.Time) *SubscriptionAutoRenewalUpsert {
+	u.Set(subscriptionautorenewal.FieldCurrentPeriodEnd, v)
+	return u
+}
+
+// UpdateCurrentPeriodEnd sets the "current_period_end" field to the value that was provided on create.
+func (u *SubscriptionAutoRenewalUpsert) UpdateCurrentPeriodEnd() *SubscriptionAutoRenewalUpsert {
+	u.SetExcluded(subscriptionautorenewal.FieldCurrentPeriodEnd)
+	return u
+}
+
+// ClearCurrentPeriodEnd clears the value of the "current_period_end" field.
+func (u *SubscriptionAutoRenewalUpsert) ClearCurrentPeriodEnd() *SubscriptionAutoRenewalUpsert {
+	u.SetNull(subscriptionautorenewal.FieldCurrentPeriodEnd)
+	return u
+}
+
+// SetCanceledAt sets the "canceled_at" field.
+func (u *SubscriptionAutoRenewalUpsert) SetCanceledAt(v time.Time) *SubscriptionAutoRenewalUpsert {
+	u.Set(subscriptionautorenewal.FieldCanceledAt, v)
+	return u
+}
+
+// UpdateCanceledAt sets the "canceled_at" field to the value that was provided on create.
+func (u *SubscriptionAutoRenewalUpsert) UpdateCanceledAt() *SubscriptionAutoRenewalUpsert {
+	u.SetExcluded(subscriptionautorenewal.FieldCanceledAt)
+	return u
+}
+
+// ClearCanceledAt clears the value of the "canceled_at" field.
+func (u *SubscriptionAutoRenewalUpsert) ClearCanceledAt() *SubscriptionAutoRenewalUpsert {
+	u.SetNull(subscriptionautorenewal.FieldCanceledAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SubscriptionAutoRenewalUpsert) SetUpdatedAt(v time.Time) *SubscriptionAutoRenewalUpsert {
+	u.Set(subscriptionautorenewal.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SubscriptionAutoRenewalUpsert) UpdateUpdatedAt() *SubscriptionAutoRenewalUpsert {
+	u.SetExcluded(subscriptionautorenewal.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.SubscriptionAutoRenewal.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *SubscriptionAutoRenewalUpsertOne) UpdateNewValues() *SubscriptionAutoRenewalUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(subscriptionautorenewal.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SubscriptionAutoRenewal.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *SubscriptionAutoRenewalUpsertOne) Ignore() *SubscriptionAutoRenewalUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SubscriptionAutoRenewalUpsertOne) DoNothing() *SubscriptionAutoRenewalUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SubscriptionAutoRenewalCreate.OnConflict
+// documentation for more info.
+func (u *SubscriptionAutoRenewalUpsertOne) Update(set func(*SubscriptionAutoRenewalUpsert)) *SubscriptionAutoRenewalUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SubscriptionAutoRenewalUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *SubscriptionAutoRenewalUpsertOne) SetUserID(v int64) *SubscriptionAutoRenewalUpsertOne {
+	return u.Update(func(s *SubscriptionAutoRenewalUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *SubscriptionAutoRenewalUpsertOne) AddUserID(v int64) *SubscriptionAutoRenewalUpsertOne {
+	return u.Update(func(s *SubscriptionAutoRenewalUpsert) {
+		s.AddUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *SubscriptionAutoRenewalUpsertOne) UpdateUserID() *SubscriptionAutoRenewalUpsertOne {
+	return u.Update(func(s *SubscriptionAutoRenewalUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetPlanID sets the "plan_id" field.
+func (u *SubscriptionAutoRenewalUpsertOne) SetPlanID(v int64) *SubscriptionAutoRenewalUpsertOne {
+	return u.Update(func(s *SubscriptionAutoRenewalUpsert) {
+		s.SetPlanID(v)
+	})
+}
+
+// AddPlanID adds v to the "plan_id" field.
+func (u *SubscriptionAutoRenewalUpsertOne) AddPlanID(v int64) *SubscriptionAutoRenewalUpsertOne {
+	return u.Update(func(s *SubscriptionAutoRenewalUpsert) {
+		s.AddPlanID(v)
+	})
+}
+
+// UpdatePlanID sets the "plan_id" field to the value that was provided on create.
+func (u *SubscriptionAutoRenewalUpsertOne) UpdatePlanID() *SubscriptionAutoRenewalUpsertOne {
+	return u.Update(func(s *SubscriptionAutoRenewalUpsert) {
+		s.UpdatePlanID()
+	})
+}
+
+// SetGroupID sets the "group_id" field.
+func (u *SubscriptionAutoRenewalUpsertOne) SetGroupID(v int64) *SubscriptionAutoRenewalUpsertOne {
+	return u.Update(func(s *SubscriptionAutoRenewalUpsert) {
+		s.SetGroupID(v)
+	})
+}
+
+// AddGroupID adds v to the "group_id" field.
+func (u *SubscriptionAutoRenewalUpsertOne) AddGroupID(v int64) *SubscriptionAutoRenewalUpsertOne {
+	return u.Update(func(s *SubscriptionAutoRenewalUpsert) {
+		s.AddGroupID(v)
+	})
+}
+
+// UpdateGroupID sets the "group_id" field to the value that was provided on create.
+func (u *SubscriptionAutoRenewalUpsertOne) UpdateGroupID() *SubscriptionAutoRenewalUpsertOne {
+	return u.Update(func(s *SubscriptionAutoRenewalUpsert) {
+		s.UpdateGroupID()
+	})
+}
+
+// SetSubscriptionDays sets the "subscription_days" field.
+func (u *SubscriptionAutoRenewalUpsertOne) SetSubscriptionDays(v int) *SubscriptionAutoRenewalUpsertOne {
+	return u.Update(func(s *SubscriptionAutoRenewalUpsert) {
+		s.SetSubscriptionDays(v)
+	})
+}
+
+// AddSubscriptionDays adds v to the "subscription_days" field.
+func (u *SubscriptionAutoRenewalUpsertOne) AddSubscriptionDays(v int) *SubscriptionAutoRenewalUpsertOne {
+	return u.Update(func(s *SubscriptionAutoRenewalUpsert) {
+		s.AddSubscriptionDays(v)
+	})
+}
+
+// UpdateSubscriptionDays sets the "subscription_days" field to the value that was provided on create.
+func (u *SubscriptionAutoRenewalUpsertOne) UpdateSubscriptionDays() *SubscriptionAutoRenewalUpsertOne {
+	return u.Update(func(s *SubscriptionAutoRenewalUpsert) {
+		s.UpdateSubscriptionDays()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *SubscriptionAutoRenewalUpsertOne) SetAmount(v float64) *SubscriptionAutoRenewalUpsertOne {
+	return u.Update(func(s *SubscriptionAutoRenewalUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *SubscriptionAutoRenewalUpsertOne) AddAmount(v float64) *SubscriptionAutoRenewalUpsertOne {
+	return u.Update(func(s *SubscriptionAutoRenewalUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *SubscriptionAutoRenewalUpsertOne) UpdateAmount() *SubscriptionAutoRenewalUpsertOne {
+	return u.Update(func(s *SubscriptionAutoRenewalUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetPaymentType sets the "payment_type" field.
+func (u *SubscriptionAutoRenewalUpsertOne) SetPaymentType(v string) *SubscriptionAutoRenewalUpsertOne {
+	return u.Update(func(s *SubscriptionAutoRenewalUpsert) {
+		s.SetPaymentType(v)
+	})
+}
+
+// UpdatePaymentType sets the "payment_type" field to the value that was provided on create.
+func (u *SubscriptionAutoRenewalUpsertOne) UpdatePaymentType() *SubscriptionAutoRenewalUpsertOne {
+	return u.Update(func(s *SubscriptionAutoRenewalUpsert) {
+		s.UpdatePaymentType()
+	})
+}
+
+// SetProviderInstanceID sets the "provider_instance_id" field.
+func (u *SubscriptionAutoRenewalUpsertOne) SetProviderInstanceID(v string) *SubscriptionAutoRenewalUpsertOne {
+	return u.Update(func(s *SubscriptionAutoRenewalUpsert) {
+		s.SetProviderInstanceID(v)
+	})
+}
+
+// UpdateProviderInstanceID sets the "provider_instance_id" field to the value that was provided on create.
+func (u *SubscriptionAutoRenewalUpsertOne) UpdateProviderInstanceID() *SubscriptionAutoRenewalUpsertOne {
+	return u.Update(func(s *SubscriptionAutoRenewalUpsert) {
+		s.UpdateProviderInstanceID()
+	})
+}
+
+// ClearProviderInstanceID clears the value of the "provider_instance_id" field.
+func (u *SubscriptionAutoRenewalUpsertOne) ClearProviderInstanceID() *SubscriptionAutoRenewalUpsertOne {
+	return u.Update(func(s *SubscriptionAutoRenewalUpsert) {
+		s.ClearProviderInstanceID()
+	})
+}
+
+// SetProviderSubscriptionID sets the "provider_subscription_id" field.
+func (u *SubscriptionAutoRenewalUpsertOne) SetProviderSubscriptionID(v string) *SubscriptionAutoRenewalUpsertOne {
+	return u.Update(func(s *SubscriptionAutoRenewalUpsert) {
+		s.SetProviderSubscriptionID(v)
+	})
+}
+
+// UpdateProviderSubscriptionID sets the "provider_subscription_id" field to the value that was provided on create.
+func (u *SubscriptionAutoRenewalUpsertOne) UpdateProviderSubscriptionID() *SubscriptionAutoRenewalUpsertOne {
+	return u.Update(func(s *SubscriptionAutoRenewalUpsert) {
+		s.UpdateProviderSubscriptionID()
+	})
+}
+
+// SetInitialOrderID sets the "initial_order_id" field.
+func (u *SubscriptionAutoRenewalUpsertOne) SetInitialOrderID(v int64) *SubscriptionAutoRenewalUpsertOne {
+	return u.Update(func(s *SubscriptionAutoRenewalUpsert) {
+		s.SetInitialOrderID(v)
+	})
+}
+
+// AddInitialOrderID adds v to the "initial_order_id" field.
+func (u *SubscriptionAutoRenewalUpsertOne) AddInitialOrderID(v int64) *SubscriptionAutoRenewalUpsertOne {
+	return u.Update(func(s *SubscriptionAutoRenewalUpsert) {
+		s.AddInitialOrderID(v)
+	})
+}
+
+// UpdateInitialOrderID sets the "initial_order_id" field to the value that was provided on create.
+func (u *SubscriptionAutoRenewalUpsertOne) UpdateInitialOrderID() *SubscriptionAutoRenewalUpsertOne {
+	return u.Update(func(s *SubscriptionAutoRenewalUpsert) {
+		s.UpdateInitialOrderID()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *SubscriptionAutoRenewalUpsertOne) SetStatus(v string) *SubscriptionAutoRenewalUpsertOne {
+	return u.Update(func(s *SubscriptionAutoRenewalUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *SubscriptionAutoRenewalUpsertOne) UpdateStatus() *SubscriptionAutoRenewalUpsertOne {
+	return u.Update(func(s *SubscriptionAutoRenewalUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetCurrentPeriodEnd sets the "current_period_end" field.
+func (u *SubscriptionAutoRenewalUpsertOne) SetCurrentPeriodEnd(v time.Time) *SubscriptionAutoRenewalUpsertOne {
+	return u.Update(func(s *SubscriptionAutoRenewalUpsert) {
+		s.SetCurrentPeriodEnd(v)
+	})
+}
+
+// UpdateCurrentPeriodEnd sets the "current_period_end" field to the value that was provided on create.
+func (u *SubscriptionAutoRenewalUpsertOne) UpdateCurrentPeriodEnd() *SubscriptionAutoRenewalUpsertOne {
+	return u.Update(func(s *SubscriptionAutoRenewalUpsert) {
+		s.UpdateCurrentPeriodEnd()
+	})
+}
+
+// ClearCurrentPeriodEnd clears the value of the "current_period_end" field.
+func (u *SubscriptionAutoRenewalUpsertOne) ClearCurrentPeriodEnd() *SubscriptionAutoRenewalUpsertOne {
+	return u.Update(func(s *SubscriptionAutoRenewalUpsert) {
+		s.ClearCurrentPeriodEnd()
+	})
+}
+
+// SetCanceledAt sets the "canceled_at" field.
+func (u *SubscriptionAutoRenewalUpsertOne) SetCanceledAt(v time.Time) *SubscriptionAutoRenewalUpsertOne {
+	return u.Update(func(s *SubscriptionAutoRenewalUpsert) {
+		s.SetCanceledAt(v)
+	})
+}
+
+// UpdateCanceledAt sets the "canceled_at" field to the value that was provided on create.
+func (u *SubscriptionAutoRenewalUpsertOne) UpdateCanceledAt() *SubscriptionAutoRenewalUpsertOne {
+	return u.Update(func(s *SubscriptionAutoRenewalUpsert) {
+		s.UpdateCanceledAt()
+	})
+}
+
+// ClearCanceledAt clears the value of the "canceled_at" field.
+func (u *SubscriptionAutoRenewalUpsertOne) ClearCanceledAt() *SubscriptionAutoRenewalUpsertOne {
+	return u.Update(func(s *SubscriptionAutoRenewalUpsert) {
+		s.ClearCanceledAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SubscriptionAutoRenewalUpsertOne) SetUpdatedAt(v time.Time) *SubscriptionAutoRenewalUpsertOne {
+	return u.Update(func(s *SubscriptionAutoRenewalUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SubscriptionAutoRenewalUpsertOne) UpdateUpdatedAt() *SubscriptionAutoRenewalUpsertOne {
+	return u.Update(func(s *SubscriptionAutoRenewalUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *SubscriptionAutoRenewalUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SubscriptionAutoRenewalCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SubscriptionAutoRenewalUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *SubscriptionAutoRenewalUpsertOne) ID(ctx context.Context) (id int64, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *SubscriptionAutoRenewalUpsertOne) IDX(ctx context.Context) int64 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// SubscriptionAutoRenewalCreateBulk is the builder for creating many SubscriptionAutoRenewal entities in bulk.
+type SubscriptionAutoRenewalCreateBulk struct {
+	config
+	err      error
+	builders []*SubscriptionAutoRenewalCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the SubscriptionAutoRenewal entities in the database.
+func (_c *SubscriptionAutoRenewalCreateBulk) Save(ctx context.Context) ([]*SubscriptionAutoRenewal, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*SubscriptionAutoRenewal, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SubscriptionAutoRenewalMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SubscriptionAutoRenewalCreateBulk) SaveX(ctx context.Context) []*SubscriptionAutoRenewal {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SubscriptionAutoRenewalCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SubscriptionAutoRenewalCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SubscriptionAutoRenewal.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SubscriptionAutoRenewalUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *SubscriptionAutoRenewalCreateBulk) OnConflict(opts ...sql.ConflictOption) *SubscriptionAutoRenewalUpsertBulk {
+	_c.conflict = opts
+	return &SubscriptionAutoRenewalUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SubscriptionAutoRenewal.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *SubscriptionAutoRenewalCreateBulk) OnConflictColumns(columns ...string) *SubscriptionAutoRenewalUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &SubscriptionAutoRenewalUpsertBulk{
+		create: _c,
+	}
+}
+
+// SubscriptionAutoRenewalUpsertBulk is the builder for "upsert"-ing
+// a bulk of SubscriptionAutoRenewal nodes.
+type SubscriptionAutoRenewalUpsertBulk struct {
+	create *SubscriptionAutoRenewalCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.SubscriptionAutoRenewal.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *SubscriptionAutoRenewalUpsertBulk) UpdateNewValues() *SubscriptionAutoRenewalUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(subscriptionautorenewal.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SubscriptionAutoRenewal.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *SubscriptionAutoRenewalUpsertBulk) Ignore() *SubscriptionAutoRenewalUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SubscriptionAutoRenewalUpsertBulk) DoNothing() *SubscriptionAutoRenewalUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SubscriptionAutoRenewalCreateBulk.OnConflict
+// documentation for more info.
+func (u *SubscriptionAutoRenewalUpsertBulk) Update(set func(*SubscriptionAutoRenewalUpsert)) *SubscriptionAutoRenewalUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SubscriptionAutoRenewalUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *SubscriptionAutoRenewalUpsertBulk) SetUserID(v int64) *SubscriptionAutoRenewalUpsertBulk {
+	return u.Update(func(s *SubscriptionAutoRenewalUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *SubscriptionAutoRenewalUpsertBulk) AddUserID(v int64) *SubscriptionAutoRenewalUpsertBulk {
+	return u.Update(func(s *SubscriptionAutoRenewalUpsert) {
+		s.AddUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *SubscriptionAutoRenewalUpsertBulk) UpdateUserID() *SubscriptionAutoRenewalUpsertBulk {
+	return u.Update(func(s *SubscriptionAutoRenewalUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetPlanID sets the "plan_id" field.
+func (u *SubscriptionAutoRenewalUpsertBulk) SetPlanID(v int64) *SubscriptionAutoRenewalUpsertBulk {
+	return u.Update(func(s *SubscriptionAutoRenewalUpsert) {
+		s.SetPlanID(v)
+	})
+}
+
+// AddPlanID adds v to the "plan_id" field.
+func (u *SubscriptionAutoRenewalUpsertBulk) AddPlanID(v int64) *SubscriptionAutoRenewalUpsertBulk {
+	return u.Update(func(s *SubscriptionAutoRenewalUpsert) {
+		s.AddPlanID(v)
+	})
+}
+
+// UpdatePlanID sets the "plan_id" field to the value that was provided on create.
+func (u *SubscriptionAutoRenewalUpsertBulk) UpdatePlanID() *SubscriptionAutoRenewalUpsertBulk {
+	return u.Update(func(s *SubscriptionAutoRenewalUpsert) {
+		s.UpdatePlanID()
+	})
+}
+
+// SetGroupID sets the "group_id" field.
+func (u *SubscriptionAutoRenewalUpsertBulk) SetGroupID(v int64) *SubscriptionAutoRenewalUpsertBulk {
+	return u.Update(func(s *SubscriptionAutoRenewalUpsert) {
+		s.SetGroupID(v)
+	})
+}
+
+// AddGroupID adds v to the "group_id" field.
+func (u *SubscriptionAutoRenewalUpsertBulk) AddGroupID(v int64) *SubscriptionAutoRenewalUpsertBulk {
+	return u.Update(func(s *SubscriptionAutoRenewalUpsert) {
+		s.AddGroupID(v)
+	})
+}
+
+// UpdateGroupID sets the "group_id" field to the value that was provided on create.
+func (u *SubscriptionAutoRenewalUpsertBulk) UpdateGroupID() *SubscriptionAutoRenewalUpsertBulk {
+	return u.Update(func(s *SubscriptionAutoRenewalUpsert) {
+		s.UpdateGroupID()
+	})
+}
+
+// SetSubscriptionDays sets the "subscription_days" field.
+func (u *SubscriptionAutoRenewalUpsertBulk) SetSubscriptionDays(v int) *SubscriptionAutoRenewalUpsertBulk {
+	return u.Update(func(s *SubscriptionAutoRenewalUpsert) {
+		s.SetSubscriptionDays(v)
+	})
+}
+
+// AddSubscriptionDays adds v to the "subscription_days" field.
+func (u *SubscriptionAutoRenewalUpsertBulk) AddSubscriptionDays(v int) *SubscriptionAutoRenewalUpsertBulk {
+	return u.Update(func(s *SubscriptionAutoRenewalUpsert) {
+		s.AddSubscriptionDays(v)
+	})
+}
+
+// UpdateSubscriptionDays sets the "subscription_days" field to the value that was provided on create.
+func (u *SubscriptionAutoRenewalUpsertBulk) UpdateSubscriptionDays() *SubscriptionAutoRenewalUpsertBulk {
+	return u.Update(func(s *SubscriptionAutoRenewalUpsert) {
+		s.UpdateSubscriptionDays()
+	})
+}
+
+// SetAmount sets the "amount" field.
+func (u *SubscriptionAutoRenewalUpsertBulk) SetAmount(v float64) *SubscriptionAutoRenewalUpsertBulk {
+	return u.Update(func(s *SubscriptionAutoRenewalUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// AddAmount adds v to the "amount" field.
+func (u *SubscriptionAutoRenewalUpsertBulk) AddAmount(v float64) *SubscriptionAutoRenewalUpsertBulk {
+	return u.Update(func(s *SubscriptionAutoRenewalUpsert) {
+		s.AddAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *SubscriptionAutoRenewalUpsertBulk) UpdateAmount() *SubscriptionAutoRenewalUpsertBulk {
+	return u.Update(func(s *SubscriptionAutoRenewalUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// SetPaymentType sets the "payment_type" field.
+func (u *SubscriptionAutoRenewalUpsertBulk) SetPaymentType(v string) *SubscriptionAutoRenewalUpsertBulk {
+	return u.Update(func(s *SubscriptionAutoRenewalUpsert) {
+		s.SetPaymentType(v)
+	})
+}
+
+// UpdatePaymentType sets the "payment_type" field to the value that was provided on create.
+func (u *SubscriptionAutoRenewalUpsertBulk) UpdatePaymentType() *SubscriptionAutoRenewalUpsertBulk {
+	return u.Update(func(s *SubscriptionAutoRenewalUpsert) {
+		s.UpdatePaymentType()
+	})
+}
+
+// SetProviderInstanceID sets the "provider_instance_id" field.
+func (u *SubscriptionAutoRenewalUpsertBulk) SetProviderInstanceID(v string) *SubscriptionAutoRenewalUpsertBulk {
+	return u.Update(func(s *SubscriptionAutoRenewalUpsert) {
+		s.SetProviderInstanceID(v)
+	})
+}
+
+// UpdateProviderInstanceID sets the "provider_instance_id" field to the value that was provided on create.
+func (u *SubscriptionAutoRenewalUpsertBulk) UpdateProviderInstanceID() *SubscriptionAutoRenewalUpsertBulk {
+	return u.Update(func(s *SubscriptionAutoRenewalUpsert) {
+		s.UpdateProviderInstanceID()
+	})
+}
+
+// ClearProviderInstanceID clears the value of the "provider_instance_id" field.
+func (u *SubscriptionAutoRenewalUpsertBulk) ClearProviderInstanceID() *SubscriptionAutoRenewalUpsertBulk {
+	return u.Update(func(s *SubscriptionAutoRenewalUpsert) {
+		s.ClearProviderInstanceID()
+	})
+}
+
+// SetProviderSubscriptionID sets the "provider_subscription_id" field.
+func (u *SubscriptionAutoRenewalUpsertBulk) SetProviderSubscriptionID(v string) *SubscriptionAutoRenewalUpsertBulk {
+	return u.Update(func(s *SubscriptionAutoRenewalUpsert) {
+		s.SetProviderSubscriptionID(v)
+	})
+}
+
+// UpdateProviderSubscriptionID sets the "provider_subscription_id" field to the value that was provided on create.
+func (u *SubscriptionAutoRenewalUpsertBulk) UpdateProviderSubscriptionID() *SubscriptionAutoRenewalUpsertBulk {
+	return u.Update(func(s *SubscriptionAutoRenewalUpsert) {
+		s.UpdateProviderSubscriptionID()
+	})
+}
+
+// SetInitialOrderID sets the "initial_order_id" field.
+func (u *SubscriptionAutoRenewalUpsertBulk) SetInitialOrderID(v int64) *SubscriptionAutoRenewalUpsertBulk {
+	return u.Update(func(s *SubscriptionAutoRenewalUpsert) {
+		s.SetInitialOrderID(v)
+	})
+}
+
+// AddInitialOrderID adds v to the "initial_order_id" field.
+func (u *SubscriptionAutoRenewalUpsertBulk) AddInitialOrderID(v int64) *SubscriptionAutoRenewalUpsertBulk {
+	return u.Update(func(s *SubscriptionAutoRenewalUpsert) {
+		s.AddInitialOrderID(v)
+	})
+}
+
+// UpdateInitialOrderID sets the "initial_order_id" field to the value that was provided on create.
+func (u *SubscriptionAutoRenewalUpsertBulk) UpdateInitialOrderID() *SubscriptionAutoRenewalUpsertBulk {
+	return u.Update(func(s *SubscriptionAutoRenewalUpsert) {
+		s.UpdateInitialOrderID()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *SubscriptionAutoRenewalUpsertBulk) SetStatus(v string) *SubscriptionAutoRenewalUpsertBulk {
+	return u.Update(func(s *SubscriptionAutoRenewalUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *SubscriptionAutoRenewalUpsertBulk) UpdateStatus() *SubscriptionAutoRenewalUpsertBulk {
+	return u.Update(func(s *SubscriptionAutoRenewalUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetCurrentPeriodEnd sets the "current_period_end" field.
+func (u *SubscriptionAutoRenewalUpsertBulk) SetCurrentPeriodEnd(v time.Time) *SubscriptionAutoRenewalUpsertBulk {
+	return u.Update(func(s *SubscriptionAutoRenewalUpsert) {
+		s.SetCurrentPeriodEnd(v)
+	})
+}
+
+// UpdateCurrentPeriodEnd sets the "current_period_end" field to the value that was provided on create.
+func (u *SubscriptionAutoRenewalUpsertBulk) UpdateCurrentPeriodEnd() *SubscriptionAutoRenewalUpsertBulk {
+	return u.Update(func(s *SubscriptionAutoRenewalUpsert) {
+		s.UpdateCurrentPeriodEnd()
+	})
+}
+
+// ClearCurrentPeriodEnd clears the value of the "current_period_end" field.
+func (u *SubscriptionAutoRenewalUpsertBulk) ClearCurrentPeriodEnd() *SubscriptionAutoRenewalUpsertBulk {
+	return u.Update(func(s *SubscriptionAutoRenewalUpsert) {
+		s.ClearCurrentPeriodEnd()
+	})
+}
+
+// SetCanceledAt sets the "canceled_at" field.
+func (u *SubscriptionAutoRenewalUpsertBulk) SetCanceledAt(v time.Time) *SubscriptionAutoRenewalUpsertBulk {
+	return u.Update(func(s *SubscriptionAutoRenewalUpsert) {
+		s.SetCanceledAt(v)
+	})
+}
+
+// UpdateCanceledAt sets the "canceled_at" field to the value that was provided on create.
+func (u *SubscriptionAutoRenewalUpsertBulk) UpdateCanceledAt() *SubscriptionAutoRenewalUpsertBulk {
+	return u.Update(func(s *SubscriptionAutoRenewalUpsert) {
+		s.UpdateCanceledAt()
+	})
+}
+
+// ClearCanceledAt clears the value of the "canceled_at" field.
+func (u *SubscriptionAutoRenewalUpsertBulk) ClearCanceledAt() *SubscriptionAutoRenewalUpsertBulk {
+	return u.Update(func(s *SubscriptionAutoRenewalUpsert) {
+		s.ClearCanceledAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SubscriptionAutoRenewalUpsertBulk) SetUpdatedAt(v time.Time) *SubscriptionAutoRenewalUpsertBulk {
+	return u.Update(func(s *SubscriptionAutoRenewalUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SubscriptionAutoRenewalUpsertBulk) UpdateUpdatedAt() *SubscriptionAutoRenewalUpsertBulk {
+	return u.Update(func(s *SubscriptionAutoRenewalUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *SubscriptionAutoRenewalUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the SubscriptionAutoRenewalCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SubscriptionAutoRenewalCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SubscriptionAutoRenewalUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
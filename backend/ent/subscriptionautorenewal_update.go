@@ -0,0 +1,850 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/Wei-Shaw/sub2api/ent/predicate"
+	"github.com/Wei-Shaw/sub2api/ent/subscriptionautorenewal"
+)
+
+// SubscriptionAutoRenewalUpdate is the builder for updating SubscriptionAutoRenewal entities.
+type SubscriptionAutoRenewalUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SubscriptionAutoRenewalMutation
+}
+
+// Where appends a list predicates to the SubscriptionAutoRenewalUpdate builder.
+func (_u *SubscriptionAutoRenewalUpdate) Where(ps ...predicate.SubscriptionAutoRenewal) *SubscriptionAutoRenewalUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *SubscriptionAutoRenewalUpdate) SetUserID(v int64) *SubscriptionAutoRenewalUpdate {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *SubscriptionAutoRenewalUpdate) SetNillableUserID(v *int64) *SubscriptionAutoRenewalUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *SubscriptionAutoRenewalUpdate) AddUserID(v int64) *SubscriptionAutoRenewalUpdate {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetPlanID sets the "plan_id" field.
+func (_u *SubscriptionAutoRenewalUpdate) SetPlanID(v int64) *SubscriptionAutoRenewalUpdate {
+	_u.mutation.ResetPlanID()
+	_u.mutation.SetPlanID(v)
+	return _u
+}
+
+// SetNillablePlanID sets the "plan_id" field if the given value is not nil.
+func (_u *SubscriptionAutoRenewalUpdate) SetNillablePlanID(v *int64) *SubscriptionAutoRenewalUpdate {
+	if v != nil {
+		_u.SetPlanID(*v)
+	}
+	return _u
+}
+
+// AddPlanID adds value to the "plan_id" field.
+func (_u *SubscriptionAutoRenewalUpdate) AddPlanID(v int64) *SubscriptionAutoRenewalUpdate {
+	_u.mutation.AddPlanID(v)
+	return _u
+}
+
+// SetGroupID sets the "group_id" field.
+func (_u *SubscriptionAutoRenewalUpdate) SetGroupID(v int64) *SubscriptionAutoRenewalUpdate {
+	_u.mutation.ResetGroupID()
+	_u.mutation.SetGroupID(v)
+	return _u
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (_u *SubscriptionAutoRenewalUpdate) SetNillableGroupID(v *int64) *SubscriptionAutoRenewalUpdate {
+	if v != nil {
+		_u.SetGroupID(*v)
+	}
+	return _u
+}
+
+// AddGroupID adds value to the "group_id" field.
+func (_u *SubscriptionAutoRenewalUpdate) AddGroupID(v int64) *SubscriptionAutoRenewalUpdate {
+	_u.mutation.AddGroupID(v)
+	return _u
+}
+
+// SetSubscriptionDays sets the "subscription_days" field.
+func (_u *SubscriptionAutoRenewalUpdate) SetSubscriptionDays(v int) *SubscriptionAutoRenewalUpdate {
+	_u.mutation.ResetSubscriptionDays()
+	_u.mutation.SetSubscriptionDays(v)
+	return _u
+}
+
+// SetNillableSubscriptionDays sets the "subscription_days" field if the given value is not nil.
+func (_u *SubscriptionAutoRenewalUpdate) SetNillableSubscriptionDays(v *int) *SubscriptionAutoRenewalUpdate {
+	if v != nil {
+		_u.SetSubscriptionDays(*v)
+	}
+	return _u
+}
+
+// AddSubscriptionDays adds value to the "subscription_days" field.
+func (_u *SubscriptionAutoRenewalUpdate) AddSubscriptionDays(v int) *SubscriptionAutoRenewalUpdate {
+	_u.mutation.AddSubscriptionDays(v)
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *SubscriptionAutoRenewalUpdate) SetAmount(v float64) *SubscriptionAutoRenewalUpdate {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *SubscriptionAutoRenewalUpdate) SetNillableAmount(v *float64) *SubscriptionAutoRenewalUpdate {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *SubscriptionAutoRenewalUpdate) AddAmount(v float64) *SubscriptionAutoRenewalUpdate {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetPaymentType sets the "payment_type" field.
+func (_u *SubscriptionAutoRenewalUpdate) SetPaymentType(v string) *SubscriptionAutoRenewalUpdate {
+	_u.mutation.SetPaymentType(v)
+	return _u
+}
+
+// SetNillablePaymentType sets the "payment_type" field if the given value is not nil.
+func (_u *SubscriptionAutoRenewalUpdate) SetNillablePaymentType(v *string) *SubscriptionAutoRenewalUpdate {
+	if v != nil {
+		_u.SetPaymentType(*v)
+	}
+	return _u
+}
+
+// SetProviderInstanceID sets the "provider_instance_id" field.
+func (_u *SubscriptionAutoRenewalUpdate) SetProviderInstanceID(v string) *SubscriptionAutoRenewalUpdate {
+	_u.mutation.SetProviderInstanceID(v)
+	return _u
+}
+
+// SetNillableProviderInstanceID sets the "provider_instance_id" field if the given value is not nil.
+func (_u *SubscriptionAutoRenewalUpdate) SetNillableProviderInstanceID(v *string) *SubscriptionAutoRenewalUpdate {
+	if v != nil {
+		_u.SetProviderInstanceID(*v)
+	}
+	return _u
+}
+
+// ClearProviderInstanceID clears the value of the "provider_instance_id" field.
+func (_u *SubscriptionAutoRenewalUpdate) ClearProviderInstanceID() *SubscriptionAutoRenewalUpdate {
+	_u.mutation.ClearProviderInstanceID()
+	return _u
+}
+
+// SetProviderSubscriptionID sets the "provider_subscription_id" field.
+func (_u *SubscriptionAutoRenewalUpdate) SetProviderSubscriptionID(v string) *SubscriptionAutoRenewalUpdate {
+	_u.mutation.SetProviderSubscriptionID(v)
+	return _u
+}
+
+// SetNillableProviderSubscriptionID sets the "provider_subscription_id" field if the given value is not nil.
+func (_u *SubscriptionAutoRenewalUpdate) SetNillableProviderSubscriptionID(v *string) *SubscriptionAutoRenewalUpdate {
+	if v != nil {
+		_u.SetProviderSubscriptionID(*v)
+	}
+	return _u
+}
+
+// SetInitialOrderID sets the "initial_order_id" field.
+func (_u *SubscriptionAutoRenewalUpdate) SetInitialOrderID(v int64) *SubscriptionAutoRenewalUpdate {
+	_u.mutation.ResetInitialOrderID()
+	_u.mutation.SetInitialOrderID(v)
+	return _u
+}
+
+// SetNillableInitialOrderID sets the "initial_order_id" field if the given value is not nil.
+func (_u *SubscriptionAutoRenewalUpdate) SetNillableInitialOrderID(v *int64) *SubscriptionAutoRenewalUpdate {
+	if v != nil {
+		_u.SetInitialOrderID(*v)
+	}
+	return _u
+}
+
+// AddInitialOrderID adds value to the "initial_order_id" field.
+func (_u *SubscriptionAutoRenewalUpdate) AddInitialOrderID(v int64) *SubscriptionAutoRenewalUpdate {
+	_u.mutation.AddInitialOrderID(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *SubscriptionAutoRenewalUpdate) SetStatus(v string) *SubscriptionAutoRenewalUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *SubscriptionAutoRenewalUpdate) SetNillableStatus(v *string) *SubscriptionAutoRenewalUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetCurrentPeriodEnd sets the "current_period_end" field.
+func (_u *SubscriptionAutoRenewalUpdate) SetCurrentPeriodEnd(v time.Time) *SubscriptionAutoRenewalUpdate {
+	_u.mutation.SetCurrentPeriodEnd(v)
+	return _u
+}
+
+// SetNillableCurrentPeriodEnd sets the "current_period_end" field if the given value is not nil.
+func (_u *SubscriptionAutoRenewalUpdate) SetNillableCurrentPeriodEnd(v *time.Time) *SubscriptionAutoRenewalUpdate {
+	if v != nil {
+		_u.SetCurrentPeriodEnd(*v)
+	}
+	return _u
+}
+
+// ClearCurrentPeriodEnd clears the value of the "current_period_end" field.
+func (_u *SubscriptionAutoRenewalUpdate) ClearCurrentPeriodEnd() *SubscriptionAutoRenewalUpdate {
+	_u.mutation.ClearCurrentPeriodEnd()
+	return _u
+}
+
+// SetCanceledAt sets the "canceled_at" field.
+func (_u *SubscriptionAutoRenewalUpdate) SetCanceledAt(v time.Time) *SubscriptionAutoRenewalUpdate {
+	_u.mutation.SetCanceledAt(v)
+	return _u
+}
+
+// SetNillableCanceledAt sets the "canceled_at" field if the given value is not nil.
+func (_u *SubscriptionAutoRenewalUpdate) SetNillableCanceledAt(v *time.Time) *SubscriptionAutoRenewalUpdate {
+	if v != nil {
+		_u.SetCanceledAt(*v)
+	}
+	return _u
+}
+
+// ClearCanceledAt clears the value of the "canceled_at" field.
+func (_u *SubscriptionAutoRenewalUpdate) ClearCanceledAt() *SubscriptionAutoRenewalUpdate {
+	_u.mutation.ClearCanceledAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SubscriptionAutoRenewalUpdate) SetUpdatedAt(v time.Time) *SubscriptionAutoRenewalUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the SubscriptionAutoRenewalMutation object of the builder.
+func (_u *SubscriptionAutoRenewalUpdate) Mutation() *SubscriptionAutoRenewalMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SubscriptionAutoRenewalUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SubscriptionAutoRenewalUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SubscriptionAutoRenewalUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SubscriptionAutoRenewalUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SubscriptionAutoRenewalUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := subscriptionautorenewal.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SubscriptionAutoRenewalUpdate) check() error {
+	if v, ok := _u.mutation.PaymentType(); ok {
+		if err := subscriptionautorenewal.PaymentTypeValidator(v); err != nil {
+			return &ValidationError{Name: "payment_type", err: fmt.Errorf(`ent: validator failed for field "SubscriptionAutoRenewal.payment_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ProviderInstanceID(); ok {
+		if err := subscriptionautorenewal.ProviderInstanceIDValidator(v); err != nil {
+			return &ValidationError{Name: "provider_instance_id", err: fmt.Errorf(`ent: validator failed for field "SubscriptionAutoRenewal.provider_instance_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ProviderSubscriptionID(); ok {
+		if err := subscriptionautorenewal.ProviderSubscriptionIDValidator(v); err != nil {
+			return &ValidationError{Name: "provider_subscription_id", err: fmt.Errorf(`ent: validator failed for field "SubscriptionAutoRenewal.provider_subscription_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := subscriptionautorenewal.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "SubscriptionAutoRenewal.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *SubscriptionAutoRenewalUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(subscriptionautorenewal.Table, subscriptionautorenewal.Columns, sqlgraph.NewFieldSpec(subscriptionautorenewal.FieldID, field.TypeInt64))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(subscriptionautorenewal.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(subscriptionautorenewal.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.PlanID(); ok {
+		_spec.SetField(subscriptionautorenewal.FieldPlanID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPlanID(); ok {
+		_spec.AddField(subscriptionautorenewal.FieldPlanID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.GroupID(); ok {
+		_spec.SetField(subscriptionautorenewal.FieldGroupID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedGroupID(); ok {
+		_spec.AddField(subscriptionautorenewal.FieldGroupID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.SubscriptionDays(); ok {
+		_spec.SetField(subscriptionautorenewal.FieldSubscriptionDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSubscriptionDays(); ok {
+		_spec.AddField(subscriptionautorenewal.FieldSubscriptionDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(subscriptionautorenewal.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(subscriptionautorenewal.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.PaymentType(); ok {
+		_spec.SetField(subscriptionautorenewal.FieldPaymentType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ProviderInstanceID(); ok {
+		_spec.SetField(subscriptionautorenewal.FieldProviderInstanceID, field.TypeString, value)
+	}
+	if _u.mutation.ProviderInstanceIDCleared() {
+		_spec.ClearField(subscriptionautorenewal.FieldProviderInstanceID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ProviderSubscriptionID(); ok {
+		_spec.SetField(subscriptionautorenewal.FieldProviderSubscriptionID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.InitialOrderID(); ok {
+		_spec.SetField(subscriptionautorenewal.FieldInitialOrderID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedInitialOrderID(); ok {
+		_spec.AddField(subscriptionautorenewal.FieldInitialOrderID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(subscriptionautorenewal.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CurrentPeriodEnd(); ok {
+		_spec.SetField(subscriptionautorenewal.FieldCurrentPeriodEnd, field.TypeTime, value)
+	}
+	if _u.mutation.CurrentPeriodEndCleared() {
+		_spec.ClearField(subscriptionautorenewal.FieldCurrentPeriodEnd, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CanceledAt(); ok {
+		_spec.SetField(subscriptionautorenewal.FieldCanceledAt, field.TypeTime, value)
+	}
+	if _u.mutation.CanceledAtCleared() {
+		_spec.ClearField(subscriptionautorenewal.FieldCanceledAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(subscriptionautorenewal.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{subscriptionautorenewal.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SubscriptionAutoRenewalUpdateOne is the builder for updating a single SubscriptionAutoRenewal entity.
+type SubscriptionAutoRenewalUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SubscriptionAutoRenewalMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *SubscriptionAutoRenewalUpdateOne) SetUserID(v int64) *SubscriptionAutoRenewalUpdateOne {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *SubscriptionAutoRenewalUpdateOne) SetNillableUserID(v *int64) *SubscriptionAutoRenewalUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *SubscriptionAutoRenewalUpdateOne) AddUserID(v int64) *SubscriptionAutoRenewalUpdateOne {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetPlanID sets the "plan_id" field.
+func (_u *SubscriptionAutoRenewalUpdateOne) SetPlanID(v int64) *SubscriptionAutoRenewalUpdateOne {
+	_u.mutation.ResetPlanID()
+	_u.mutation.SetPlanID(v)
+	return _u
+}
+
+// SetNillablePlanID sets the "plan_id" field if the given value is not nil.
+func (_u *SubscriptionAutoRenewalUpdateOne) SetNillablePlanID(v *int64) *SubscriptionAutoRenewalUpdateOne {
+	if v != nil {
+		_u.SetPlanID(*v)
+	}
+	return _u
+}
+
+// AddPlanID adds value to the "plan_id" field.
+func (_u *SubscriptionAutoRenewalUpdateOne) AddPlanID(v int64) *SubscriptionAutoRenewalUpdateOne {
+	_u.mutation.AddPlanID(v)
+	return _u
+}
+
+// SetGroupID sets the "group_id" field.
+func (_u *SubscriptionAutoRenewalUpdateOne) SetGroupID(v int64) *SubscriptionAutoRenewalUpdateOne {
+	_u.mutation.ResetGroupID()
+	_u.mutation.SetGroupID(v)
+	return _u
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (_u *SubscriptionAutoRenewalUpdateOne) SetNillableGroupID(v *int64) *SubscriptionAutoRenewalUpdateOne {
+	if v != nil {
+		_u.SetGroupID(*v)
+	}
+	return _u
+}
+
+// AddGroupID adds value to the "group_id" field.
+func (_u *SubscriptionAutoRenewalUpdateOne) AddGroupID(v int64) *SubscriptionAutoRenewalUpdateOne {
+	_u.mutation.AddGroupID(v)
+	return _u
+}
+
+// SetSubscriptionDays sets the "subscription_days" field.
+func (_u *SubscriptionAutoRenewalUpdateOne) SetSubscriptionDays(v int) *SubscriptionAutoRenewalUpdateOne {
+	_u.mutation.ResetSubscriptionDays()
+	_u.mutation.SetSubscriptionDays(v)
+	return _u
+}
+
+// SetNillableSubscriptionDays sets the "subscription_days" field if the given value is not nil.
+func (_u *SubscriptionAutoRenewalUpdateOne) SetNillableSubscriptionDays(v *int) *SubscriptionAutoRenewalUpdateOne {
+	if v != nil {
+		_u.SetSubscriptionDays(*v)
+	}
+	return _u
+}
+
+// AddSubscriptionDays adds value to the "subscription_days" field.
+func (_u *SubscriptionAutoRenewalUpdateOne) AddSubscriptionDays(v int) *SubscriptionAutoRenewalUpdateOne {
+	_u.mutation.AddSubscriptionDays(v)
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *SubscriptionAutoRenewalUpdateOne) SetAmount(v float64) *SubscriptionAutoRenewalUpdateOne {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *SubscriptionAutoRenewalUpdateOne) SetNillableAmount(v *float64) *SubscriptionAutoRenewalUpdateOne {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *SubscriptionAutoRenewalUpdateOne) AddAmount(v float64) *SubscriptionAutoRenewalUpdateOne {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetPaymentType sets the "payment_type" field.
+func (_u *SubscriptionAutoRenewalUpdateOne) SetPaymentType(v string) *SubscriptionAutoRenewalUpdateOne {
+	_u.mutation.SetPaymentType(v)
+	return _u
+}
+
+// SetNillablePaymentType sets the "payment_type" field if the given value is not nil.
+func (_u *SubscriptionAutoRenewalUpdateOne) SetNillablePaymentType(v *string) *SubscriptionAutoRenewalUpdateOne {
+	if v != nil {
+		_u.SetPaymentType(*v)
+	}
+	return _u
+}
+
+// SetProviderInstanceID sets the "provider_instance_id" field.
+func (_u *SubscriptionAutoRenewalUpdateOne) SetProviderInstanceID(v string) *SubscriptionAutoRenewalUpdateOne {
+	_u.mutation.SetProviderInstanceID(v)
+	return _u
+}
+
+// SetNillableProviderInstanceID sets the "provider_instance_id" field if the given value is not nil.
+func (_u *SubscriptionAutoRenewalUpdateOne) SetNillableProviderInstanceID(v *string) *SubscriptionAutoRenewalUpdateOne {
+	if v != nil {
+		_u.SetProviderInstanceID(*v)
+	}
+	return _u
+}
+
+// ClearProviderInstanceID clears the value of the "provider_instance_id" field.
+func (_u *SubscriptionAutoRenewalUpdateOne) ClearProviderInstanceID() *SubscriptionAutoRenewalUpdateOne {
+	_u.mutation.ClearProviderInstanceID()
+	return _u
+}
+
+// SetProviderSubscriptionID sets the "provider_subscription_id" field.
+func (_u *SubscriptionAutoRenewalUpdateOne) SetProviderSubscriptionID(v string) *SubscriptionAutoRenewalUpdateOne {
+	_u.mutation.SetProviderSubscriptionID(v)
+	return _u
+}
+
+// SetNillableProviderSubscriptionID sets the "provider_subscription_id" field if the given value is not nil.
+func (_u *SubscriptionAutoRenewalUpdateOne) SetNillableProviderSubscriptionID(v *string) *SubscriptionAutoRenewalUpdateOne {
+	if v != nil {
+		_u.SetProviderSubscriptionID(*v)
+	}
+	return _u
+}
+
+// SetInitialOrderID sets the "initial_order_id" field.
+func (_u *SubscriptionAutoRenewalUpdateOne) SetInitialOrderID(v int64) *SubscriptionAutoRenewalUpdateOne {
+	_u.mutation.ResetInitialOrderID()
+	_u.mutation.SetInitialOrderID(v)
+	return _u
+}
+
+// SetNillableInitialOrderID sets the "initial_order_id" field if the given value is not nil.
+func (_u *SubscriptionAutoRenewalUpdateOne) SetNillableInitialOrderID(v *int64) *SubscriptionAutoRenewalUpdateOne {
+	if v != nil {
+		_u.SetInitialOrderID(*v)
+	}
+	return _u
+}
+
+// AddInitialOrderID adds value to the "initial_order_id" field.
+func (_u *SubscriptionAutoRenewalUpdateOne) AddInitialOrderID(v int64) *SubscriptionAutoRenewalUpdateOne {
+	_u.mutation.AddInitialOrderID(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *SubscriptionAutoRenewalUpdateOne) SetStatus(v string) *SubscriptionAutoRenewalUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *SubscriptionAutoRenewalUpdateOne) SetNillableStatus(v *string) *SubscriptionAutoRenewalUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetCurrentPeriodEnd sets the "current_period_end" field.
+func (_u *SubscriptionAutoRenewalUpdateOne) SetCurrentPeriodEnd(v time.Time) *SubscriptionAutoRenewalUpdateOne {
+	_u.mutation.SetCurrentPeriodEnd(v)
+	return _u
+}
+
+// SetNillableCurrentPeriodEnd sets the "current_period_end" field if the given value is not nil.
+func (_u *SubscriptionAutoRenewalUpdateOne) SetNillableCurrentPeriodEnd(v *time.Time) *SubscriptionAutoRenewalUpdateOne {
+	if v != nil {
+		_u.SetCurrentPeriodEnd(*v)
+	}
+	return _u
+}
+
+// ClearCurrentPeriodEnd clears the value of the "current_period_end" field.
+func (_u *SubscriptionAutoRenewalUpdateOne) ClearCurrentPeriodEnd() *SubscriptionAutoRenewalUpdateOne {
+	_u.mutation.ClearCurrentPeriodEnd()
+	return _u
+}
+
+// SetCanceledAt sets the "canceled_at" field.
+func (_u *SubscriptionAutoRenewalUpdateOne) SetCanceledAt(v time.Time) *SubscriptionAutoRenewalUpdateOne {
+	_u.mutation.SetCanceledAt(v)
+	return _u
+}
+
+// SetNillableCanceledAt sets the "canceled_at" field if the given value is not nil.
+func (_u *SubscriptionAutoRenewalUpdateOne) SetNillableCanceledAt(v *time.Time) *SubscriptionAutoRenewalUpdateOne {
+	if v != nil {
+		_u.SetCanceledAt(*v)
+	}
+	return _u
+}
+
+// ClearCanceledAt clears the value of the "canceled_at" field.
+func (_u *SubscriptionAutoRenewalUpdateOne) ClearCanceledAt() *SubscriptionAutoRenewalUpdateOne {
+	_u.mutation.ClearCanceledAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SubscriptionAutoRenewalUpdateOne) SetUpdatedAt(v time.Time) *SubscriptionAutoRenewalUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the SubscriptionAutoRenewalMutation object of the builder.
+func (_u *SubscriptionAutoRenewalUpdateOne) Mutation() *SubscriptionAutoRenewalMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the SubscriptionAutoRenewalUpdate builder.
+func (_u *SubscriptionAutoRenewalUpdateOne) Where(ps ...predicate.SubscriptionAutoRenewal) *SubscriptionAutoRenewalUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SubscriptionAutoRenewalUpdateOne) Select(field string, fields ...string) *SubscriptionAutoRenewalUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated SubscriptionAutoRenewal entity.
+func (_u *SubscriptionAutoRenewalUpdateOne) Save(ctx context.Context) (*SubscriptionAutoRenewal, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SubscriptionAutoRenewalUpdateOne) SaveX(ctx context.Context) *SubscriptionAutoRenewal {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SubscriptionAutoRenewalUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SubscriptionAutoRenewalUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SubscriptionAutoRenewalUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := subscriptionautorenewal.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SubscriptionAutoRenewalUpdateOne) check() error {
+	if v, ok := _u.mutation.PaymentType(); ok {
+		if err := subscriptionautorenewal.PaymentTypeValidator(v); err != nil {
+			return &ValidationError{Name: "payment_type", err: fmt.Errorf(`ent: validator failed for field "SubscriptionAutoRenewal.payment_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ProviderInstanceID(); ok {
+		if err := subscriptionautorenewal.ProviderInstanceIDValidator(v); err != nil {
+			return &ValidationError{Name: "provider_instance_id", err: fmt.Errorf(`ent: validator failed for field "SubscriptionAutoRenewal.provider_instance_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ProviderSubscriptionID(); ok {
+		if err := subscriptionautorenewal.ProviderSubscriptionIDValidator(v); err != nil {
+			return &ValidationError{Name: "provider_subscription_id", err: fmt.Errorf(`ent: validator failed for field "SubscriptionAutoRenewal.provider_subscription_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := subscriptionautorenewal.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "SubscriptionAutoRenewal.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *SubscriptionAutoRenewalUpdateOne) sqlSave(ctx context.Context) (_node *SubscriptionAutoRenewal, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(subscriptionautorenewal.Table, subscriptionautorenewal.Columns, sqlgraph.NewFieldSpec(subscriptionautorenewal.FieldID, field.TypeInt64))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SubscriptionAutoRenewal.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, subscriptionautorenewal.FieldID)
+		for _, f := range fields {
+			if !subscriptionautorenewal.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != subscriptionautorenewal.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(subscriptionautorenewal.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(subscriptionautorenewal.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.PlanID(); ok {
+		_spec.SetField(subscriptionautorenewal.FieldPlanID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedPlanID(); ok {
+		_spec.AddField(subscriptionautorenewal.FieldPlanID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.GroupID(); ok {
+		_spec.SetField(subscriptionautorenewal.FieldGroupID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedGroupID(); ok {
+		_spec.AddField(subscriptionautorenewal.FieldGroupID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.SubscriptionDays(); ok {
+		_spec.SetField(subscriptionautorenewal.FieldSubscriptionDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSubscriptionDays(); ok {
+		_spec.AddField(subscriptionautorenewal.FieldSubscriptionDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(subscriptionautorenewal.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(subscriptionautorenewal.FieldAmount, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.PaymentType(); ok {
+		_spec.SetField(subscriptionautorenewal.FieldPaymentType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ProviderInstanceID(); ok {
+		_spec.SetField(subscriptionautorenewal.FieldProviderInstanceID, field.TypeString, value)
+	}
+	if _u.mutation.ProviderInstanceIDCleared() {
+		_spec.ClearField(subscriptionautorenewal.FieldProviderInstanceID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ProviderSubscriptionID(); ok {
+		_spec.SetField(subscriptionautorenewal.FieldProviderSubscriptionID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.InitialOrderID(); ok {
+		_spec.SetField(subscriptionautorenewal.FieldInitialOrderID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedInitialOrderID(); ok {
+		_spec.AddField(subscriptionautorenewal.FieldInitialOrderID, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(subscriptionautorenewal.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CurrentPeriodEnd(); ok {
+		_spec.SetField(subscriptionautorenewal.FieldCurrentPeriodEnd, field.TypeTime, value)
+	}
+	if _u.mutation.CurrentPeriodEndCleared() {
+		_spec.ClearField(subscriptionautorenewal.FieldCurrentPeriodEnd, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CanceledAt(); ok {
+		_spec.SetField(subscriptionautorenewal.FieldCanceledAt, field.TypeTime, value)
+	}
+	if _u.mutation.CanceledAtCleared() {
+		_spec.ClearField(subscriptionautorenewal.FieldCanceledAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(subscriptionautorenewal.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &SubscriptionAutoRenewal{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{subscriptionautorenewal.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
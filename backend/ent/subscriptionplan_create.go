@@ -152,6 +152,20 @@ func (_c *SubscriptionPlanCreate) SetNillableSortOrder(v *int) *SubscriptionPlan
 	return _c
 }
 
+// SetStripePriceID sets the "stripe_price_id" field.
+func (_c *SubscriptionPlanCreate) SetStripePriceID(v string) *SubscriptionPlanCreate {
+	_c.mutation.SetStripePriceID(v)
+	return _c
+}
+
+// SetNillableStripePriceID sets the "stripe_price_id" field if the given value is not nil.
+func (_c *SubscriptionPlanCreate) SetNillableStripePriceID(v *string) *SubscriptionPlanCreate {
+	if v != nil {
+		_c.SetStripePriceID(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *SubscriptionPlanCreate) SetCreatedAt(v time.Time) *SubscriptionPlanCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -243,6 +257,10 @@ func (_c *SubscriptionPlanCreate) defaults() {
 		v := subscriptionplan.DefaultSortOrder
 		_c.mutation.SetSortOrder(v)
 	}
+	if _, ok := _c.mutation.StripePriceID(); !ok {
+		v := subscriptionplan.DefaultStripePriceID
+		_c.mutation.SetStripePriceID(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := subscriptionplan.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -300,6 +318,14 @@ func (_c *SubscriptionPlanCreate) check() error {
 	if _, ok := _c.mutation.SortOrder(); !ok {
 		return &ValidationError{Name: "sort_order", err: errors.New(`ent: missing required field "SubscriptionPlan.sort_order"`)}
 	}
+	if _, ok := _c.mutation.StripePriceID(); !ok {
+		return &ValidationError{Name: "stripe_price_id", err: errors.New(`ent: missing required field "SubscriptionPlan.stripe_price_id"`)}
+	}
+	if v, ok := _c.mutation.StripePriceID(); ok {
+		if err := subscriptionplan.StripePriceIDValidator(v); err != nil {
+			return &ValidationError{Name: "stripe_price_id", err: fmt.Errorf(`ent: validator failed for field "SubscriptionPlan.stripe_price_id": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SubscriptionPlan.created_at"`)}
 	}
@@ -377,6 +403,10 @@ func (_c *SubscriptionPlanCreate) createSpec() (*SubscriptionPlan, *sqlgraph.Cre
 		_spec.SetField(subscriptionplan.FieldSortOrder, field.TypeInt, value)
 		_node.SortOrder = value
 	}
+	if value, ok := _c.mutation.StripePriceID(); ok {
+		_spec.SetField(subscriptionplan.FieldStripePriceID, field.TypeString, value)
+		_node.StripePriceID = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(subscriptionplan.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -605,6 +635,18 @@ func (u *SubscriptionPlanUpsert) AddSortOrder(v int) *SubscriptionPlanUpsert {
 	return u
 }
 
+// SetStripePriceID sets the "stripe_price_id" field.
+func (u *SubscriptionPlanUpsert) SetStripePriceID(v string) *SubscriptionPlanUpsert {
+	u.Set(subscriptionplan.FieldStripePriceID, v)
+	return u
+}
+
+// UpdateStripePriceID sets the "stripe_price_id" field to the value that was provided on create.
+func (u *SubscriptionPlanUpsert) UpdateStripePriceID() *SubscriptionPlanUpsert {
+	u.SetExcluded(subscriptionplan.FieldStripePriceID)
+	return u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *SubscriptionPlanUpsert) SetUpdatedAt(v time.Time) *SubscriptionPlanUpsert {
 	u.Set(subscriptionplan.FieldUpdatedAt, v)
@@ -858,6 +900,20 @@ func (u *SubscriptionPlanUpsertOne) UpdateSortOrder() *SubscriptionPlanUpsertOne
 	})
 }
 
+// SetStripePriceID sets the "stripe_price_id" field.
+func (u *SubscriptionPlanUpsertOne) SetStripePriceID(v string) *SubscriptionPlanUpsertOne {
+	return u.Update(func(s *SubscriptionPlanUpsert) {
+		s.SetStripePriceID(v)
+	})
+}
+
+// UpdateStripePriceID sets the "stripe_price_id" field to the value that was provided on create.
+func (u *SubscriptionPlanUpsertOne) UpdateStripePriceID() *SubscriptionPlanUpsertOne {
+	return u.Update(func(s *SubscriptionPlanUpsert) {
+		s.UpdateStripePriceID()
+	})
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *SubscriptionPlanUpsertOne) SetUpdatedAt(v time.Time) *SubscriptionPlanUpsertOne {
 	return u.Update(func(s *SubscriptionPlanUpsert) {
@@ -1279,6 +1335,20 @@ func (u *SubscriptionPlanUpsertBulk) UpdateSortOrder() *SubscriptionPlanUpsertBu
 	})
 }
 
+// SetStripePriceID sets the "stripe_price_id" field.
+func (u *SubscriptionPlanUpsertBulk) SetStripePriceID(v string) *SubscriptionPlanUpsertBulk {
+	return u.Update(func(s *SubscriptionPlanUpsert) {
+		s.SetStripePriceID(v)
+	})
+}
+
+// UpdateStripePriceID sets the "stripe_price_id" field to the value that was provided on create.
+func (u *SubscriptionPlanUpsertBulk) UpdateStripePriceID() *SubscriptionPlanUpsertBulk {
+	return u.Update(func(s *SubscriptionPlanUpsert) {
+		s.UpdateStripePriceID()
+	})
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *SubscriptionPlanUpsertBulk) SetUpdatedAt(v time.Time) *SubscriptionPlanUpsertBulk {
 	return u.Update(func(s *SubscriptionPlanUpsert) {
@@ -24,6 +24,14 @@ type Tx struct {
 	Announcement *AnnouncementClient
 	// AnnouncementRead is the client for interacting with the AnnouncementRead builders.
 	AnnouncementRead *AnnouncementReadClient
+	// BalanceBucket is the client for interacting with the BalanceBucket builders.
+	BalanceBucket *BalanceBucketClient
+	// BillingAdjustment is the client for interacting with the BillingAdjustment builders.
+	BillingAdjustment *BillingAdjustmentClient
+	// BillingRecomputeJob is the client for interacting with the BillingRecomputeJob builders.
+	BillingRecomputeJob *BillingRecomputeJobClient
+	// BillingStatement is the client for interacting with the BillingStatement builders.
+	BillingStatement *BillingStatementClient
 	// ChannelMonitor is the client for interacting with the ChannelMonitor builders.
 	ChannelMonitor *ChannelMonitorClient
 	// ChannelMonitorDailyRollup is the client for interacting with the ChannelMonitorDailyRollup builders.
@@ -32,34 +40,58 @@ type Tx struct {
 	ChannelMonitorHistory *ChannelMonitorHistoryClient
 	// ChannelMonitorRequestTemplate is the client for interacting with the ChannelMonitorRequestTemplate builders.
 	ChannelMonitorRequestTemplate *ChannelMonitorRequestTemplateClient
+	// CommissionWithdrawal is the client for interacting with the CommissionWithdrawal builders.
+	CommissionWithdrawal *CommissionWithdrawalClient
+	// CommissionWithdrawalLog is the client for interacting with the CommissionWithdrawalLog builders.
+	CommissionWithdrawalLog *CommissionWithdrawalLogClient
 	// ErrorPassthroughRule is the client for interacting with the ErrorPassthroughRule builders.
 	ErrorPassthroughRule *ErrorPassthroughRuleClient
+	// GatewayPlugin is the client for interacting with the GatewayPlugin builders.
+	GatewayPlugin *GatewayPluginClient
 	// Group is the client for interacting with the Group builders.
 	Group *GroupClient
+	// GuardrailRule is the client for interacting with the GuardrailRule builders.
+	GuardrailRule *GuardrailRuleClient
 	// IdempotencyRecord is the client for interacting with the IdempotencyRecord builders.
 	IdempotencyRecord *IdempotencyRecordClient
+	// Invoice is the client for interacting with the Invoice builders.
+	Invoice *InvoiceClient
+	// InvoiceSequence is the client for interacting with the InvoiceSequence builders.
+	InvoiceSequence *InvoiceSequenceClient
 	// PaygOrder is the client for interacting with the PaygOrder builders.
 	PaygOrder *PaygOrderClient
 	// PaymentAuditLog is the client for interacting with the PaymentAuditLog builders.
 	PaymentAuditLog *PaymentAuditLogClient
+	// PaymentCoupon is the client for interacting with the PaymentCoupon builders.
+	PaymentCoupon *PaymentCouponClient
 	// PaymentOrder is the client for interacting with the PaymentOrder builders.
 	PaymentOrder *PaymentOrderClient
 	// PaymentProviderInstance is the client for interacting with the PaymentProviderInstance builders.
 	PaymentProviderInstance *PaymentProviderInstanceClient
+	// PostpaidAccount is the client for interacting with the PostpaidAccount builders.
+	PostpaidAccount *PostpaidAccountClient
 	// PromoCode is the client for interacting with the PromoCode builders.
 	PromoCode *PromoCodeClient
 	// PromoCodeUsage is the client for interacting with the PromoCodeUsage builders.
 	PromoCodeUsage *PromoCodeUsageClient
 	// Proxy is the client for interacting with the Proxy builders.
 	Proxy *ProxyClient
+	// ProxyPool is the client for interacting with the ProxyPool builders.
+	ProxyPool *ProxyPoolClient
 	// RedeemCode is the client for interacting with the RedeemCode builders.
 	RedeemCode *RedeemCodeClient
+	// ReferralCommission is the client for interacting with the ReferralCommission builders.
+	ReferralCommission *ReferralCommissionClient
 	// ReferralReward is the client for interacting with the ReferralReward builders.
 	ReferralReward *ReferralRewardClient
+	// RequestTransformRule is the client for interacting with the RequestTransformRule builders.
+	RequestTransformRule *RequestTransformRuleClient
 	// SecuritySecret is the client for interacting with the SecuritySecret builders.
 	SecuritySecret *SecuritySecretClient
 	// Setting is the client for interacting with the Setting builders.
 	Setting *SettingClient
+	// SubscriptionAutoRenewal is the client for interacting with the SubscriptionAutoRenewal builders.
+	SubscriptionAutoRenewal *SubscriptionAutoRenewalClient
 	// SubscriptionPlan is the client for interacting with the SubscriptionPlan builders.
 	SubscriptionPlan *SubscriptionPlanClient
 	// UsageCleanupTask is the client for interacting with the UsageCleanupTask builders.
@@ -212,24 +244,40 @@ func (tx *Tx) init() {
 	tx.AccountGroup = NewAccountGroupClient(tx.config)
 	tx.Announcement = NewAnnouncementClient(tx.config)
 	tx.AnnouncementRead = NewAnnouncementReadClient(tx.config)
+	tx.BalanceBucket = NewBalanceBucketClient(tx.config)
+	tx.BillingAdjustment = NewBillingAdjustmentClient(tx.config)
+	tx.BillingRecomputeJob = NewBillingRecomputeJobClient(tx.config)
+	tx.BillingStatement = NewBillingStatementClient(tx.config)
 	tx.ChannelMonitor = NewChannelMonitorClient(tx.config)
 	tx.ChannelMonitorDailyRollup = NewChannelMonitorDailyRollupClient(tx.config)
 	tx.ChannelMonitorHistory = NewChannelMonitorHistoryClient(tx.config)
 	tx.ChannelMonitorRequestTemplate = NewChannelMonitorRequestTemplateClient(tx.config)
+	tx.CommissionWithdrawal = NewCommissionWithdrawalClient(tx.config)
+	tx.CommissionWithdrawalLog = NewCommissionWithdrawalLogClient(tx.config)
 	tx.ErrorPassthroughRule = NewErrorPassthroughRuleClient(tx.config)
+	tx.GatewayPlugin = NewGatewayPluginClient(tx.config)
 	tx.Group = NewGroupClient(tx.config)
+	tx.GuardrailRule = NewGuardrailRuleClient(tx.config)
 	tx.IdempotencyRecord = NewIdempotencyRecordClient(tx.config)
+	tx.Invoice = NewInvoiceClient(tx.config)
+	tx.InvoiceSequence = NewInvoiceSequenceClient(tx.config)
 	tx.PaygOrder = NewPaygOrderClient(tx.config)
 	tx.PaymentAuditLog = NewPaymentAuditLogClient(tx.config)
+	tx.PaymentCoupon = NewPaymentCouponClient(tx.config)
 	tx.PaymentOrder = NewPaymentOrderClient(tx.config)
 	tx.PaymentProviderInstance = NewPaymentProviderInstanceClient(tx.config)
+	tx.PostpaidAccount = NewPostpaidAccountClient(tx.config)
 	tx.PromoCode = NewPromoCodeClient(tx.config)
 	tx.PromoCodeUsage = NewPromoCodeUsageClient(tx.config)
 	tx.Proxy = NewProxyClient(tx.config)
+	tx.ProxyPool = NewProxyPoolClient(tx.config)
 	tx.RedeemCode = NewRedeemCodeClient(tx.config)
+	tx.ReferralCommission = NewReferralCommissionClient(tx.config)
 	tx.ReferralReward = NewReferralRewardClient(tx.config)
+	tx.RequestTransformRule = NewRequestTransformRuleClient(tx.config)
 	tx.SecuritySecret = NewSecuritySecretClient(tx.config)
 	tx.Setting = NewSettingClient(tx.config)
+	tx.SubscriptionAutoRenewal = NewSubscriptionAutoRenewalClient(tx.config)
 	tx.SubscriptionPlan = NewSubscriptionPlanClient(tx.config)
 	tx.UsageCleanupTask = NewUsageCleanupTaskClient(tx.config)
 	tx.UsageLog = NewUsageLogClient(tx.config)
@@ -394,6 +394,83 @@ type GatewayConfig struct {
 	// UserMessageQueue: 用户消息串行队列配置
 	// 对 role:"user" 的真实用户消息实施账号级串行化 + RPM 自适应延迟
 	UserMessageQueue UserMessageQueueConfig `mapstructure:"user_message_queue"`
+
+	// FairQueue: 账号槽位等待的分组级公平排队（按用户加权公平排队）
+	FairQueue GatewayFairQueueConfig `mapstructure:"fair_queue"`
+	// PriorityClasses: 请求优先级分类（interactive/standard/batch）
+	PriorityClasses GatewayPriorityClassConfig `mapstructure:"priority_classes"`
+
+	// ProxyPool: 代理池健康探测与故障切换
+	ProxyPool GatewayProxyPoolConfig `mapstructure:"proxy_pool"`
+
+	// AccountCircuitBreaker: 账号级熔断器（closed/open/half-open）
+	AccountCircuitBreaker GatewayAccountCircuitBreakerConfig `mapstructure:"account_circuit_breaker"`
+}
+
+// GatewayAccountCircuitBreakerConfig 账号级熔断器配置
+// 按滚动窗口内的失败率（错误与超时/慢调用）熔断账号；熔断期满进入半开状态，
+// 限量放行真实请求或合成探测请求，连续成功后账号重新参与调度。熔断状态保存在进程内。
+type GatewayAccountCircuitBreakerConfig struct {
+	// Enabled: 是否启用账号熔断（默认关闭）
+	Enabled bool `mapstructure:"enabled"`
+	// WindowSeconds: 失败率统计的滚动窗口（秒）
+	WindowSeconds int `mapstructure:"window_seconds"`
+	// MinRequests: 窗口内最少请求数，达到后才计算失败率
+	MinRequests int `mapstructure:"min_requests"`
+	// ErrorRateThreshold: 熔断失败率阈值（0-1）
+	ErrorRateThreshold float64 `mapstructure:"error_rate_threshold"`
+	// SlowCallThresholdMs: 首字延迟超过该值视为超时（计入失败），0 表示不统计慢调用
+	SlowCallThresholdMs int `mapstructure:"slow_call_threshold_ms"`
+	// OpenSeconds: 首次熔断时长（秒），半开探测失败后按倍数递增
+	OpenSeconds int `mapstructure:"open_seconds"`
+	// MaxOpenSeconds: 熔断时长上限（秒）
+	MaxOpenSeconds int `mapstructure:"max_open_seconds"`
+	// HalfOpenSuccesses: 半开状态下需要连续成功的探测次数
+	HalfOpenSuccesses int `mapstructure:"half_open_successes"`
+	// HalfOpenAdmitIntervalSeconds: 半开状态下放行真实请求的最小间隔（秒）
+	HalfOpenAdmitIntervalSeconds int `mapstructure:"half_open_admit_interval_seconds"`
+	// SyntheticProbeAfterSeconds: 半开状态下超过该时长没有探测结果时发起合成探测（账号测试），0 表示不发起
+	SyntheticProbeAfterSeconds int `mapstructure:"synthetic_probe_after_seconds"`
+}
+
+// GatewayProxyPoolConfig 代理池配置
+// 账号引用代理池时，出口代理从池内健康成员中按权重粘性选择；
+// 成员连续连接失败或探测失败后自动切换到其他健康成员。
+type GatewayProxyPoolConfig struct {
+	// ProbeIntervalSeconds: 池成员健康/延迟探测间隔（秒），<=0 表示不做周期探测
+	ProbeIntervalSeconds int `mapstructure:"probe_interval_seconds"`
+	// ProbeTimeoutSeconds: 单个成员探测超时（秒）
+	ProbeTimeoutSeconds int `mapstructure:"probe_timeout_seconds"`
+	// FailureThreshold: 转发时连续连接失败多少次后将成员标记为不健康
+	FailureThreshold int `mapstructure:"failure_threshold"`
+}
+
+// GatewayFairQueueConfig 分组级公平排队配置
+// 账号并发打满时，同一分组内等待同一账号的请求按用户加权公平排队（WFQ），
+// 避免单个用户大量并行请求抢占全部槽位；队列存储在 Redis，多实例共享。
+type GatewayFairQueueConfig struct {
+	// Enabled: 是否启用公平排队（默认关闭，沿用先到先得的轮询抢占）
+	Enabled bool `mapstructure:"enabled"`
+	// WeightAttributeKey: 读取用户权重的用户属性 key（属性值为正数），为空时所有用户使用默认权重
+	WeightAttributeKey string `mapstructure:"weight_attribute_key"`
+	// DefaultWeight: 未配置属性或属性值非法时的默认权重
+	DefaultWeight float64 `mapstructure:"default_weight"`
+	// WeightCacheTTLSeconds: 用户权重本地缓存 TTL（秒）
+	WeightCacheTTLSeconds int `mapstructure:"weight_cache_ttl_seconds"`
+}
+
+// GatewayPriorityClassConfig 请求优先级分类配置
+// API Key 声明优先级分类（可通过请求头进一步降级）；等待队列按分类从高到低服务，
+// batch 请求只使用负载低于阈值的账号，且不参与粘性会话等待。
+type GatewayPriorityClassConfig struct {
+	// Enabled: 是否启用优先级分类（启用后等待队列按分类排序，即使未启用公平排队）
+	Enabled bool `mapstructure:"enabled"`
+	// HeaderName: 声明优先级分类的请求头，只能降低 API Key 的分类（为空则不读取请求头）
+	HeaderName string `mapstructure:"header_name"`
+	// BatchMaxLoadRate: batch 请求可使用账号的负载率上限（百分比，0 表示不限制）
+	BatchMaxLoadRate int `mapstructure:"batch_max_load_rate"`
+	// StatsWindowSeconds: 各分类排队延迟统计的滑动窗口（秒）
+	StatsWindowSeconds int `mapstructure:"stats_window_seconds"`
 }
 
 // UserMessageQueueConfig 用户消息串行队列配置
@@ -631,6 +708,9 @@ type GatewaySchedulingConfig struct {
 	// 全量重建周期配置
 	// 全量重建周期（秒），0 表示禁用
 	FullRebuildIntervalSeconds int `mapstructure:"full_rebuild_interval_seconds"`
+
+	// 5h 窗口消耗预测：预计在窗口结束前耗尽的账号在同优先级内降权
+	WindowForecastEnabled bool `mapstructure:"window_forecast_enabled"`
 }
 
 func (s *ServerConfig) Address() string {
@@ -1336,6 +1416,7 @@ func setDefaults() {
 	viper.SetDefault("gateway.scheduling.outbox_lag_rebuild_failures", 3)
 	viper.SetDefault("gateway.scheduling.outbox_backlog_rebuild_rows", 10000)
 	viper.SetDefault("gateway.scheduling.full_rebuild_interval_seconds", 300)
+	viper.SetDefault("gateway.scheduling.window_forecast_enabled", false)
 	viper.SetDefault("gateway.usage_record.worker_count", 128)
 	viper.SetDefault("gateway.usage_record.queue_size", 16384)
 	viper.SetDefault("gateway.usage_record.task_timeout_seconds", 5)
@@ -1360,6 +1441,33 @@ func setDefaults() {
 	viper.SetDefault("gateway.user_message_queue.min_delay_ms", 200)
 	viper.SetDefault("gateway.user_message_queue.max_delay_ms", 2000)
 	viper.SetDefault("gateway.user_message_queue.cleanup_interval_seconds", 60)
+	// 分组公平排队默认值
+	viper.SetDefault("gateway.fair_queue.enabled", false)
+	viper.SetDefault("gateway.fair_queue.weight_attribute_key", "queue_weight")
+	viper.SetDefault("gateway.fair_queue.default_weight", 1.0)
+	viper.SetDefault("gateway.fair_queue.weight_cache_ttl_seconds", 60)
+	// 请求优先级分类默认值
+	viper.SetDefault("gateway.priority_classes.enabled", false)
+	viper.SetDefault("gateway.priority_classes.header_name", "X-Priority-Class")
+	viper.SetDefault("gateway.priority_classes.batch_max_load_rate", 70)
+	viper.SetDefault("gateway.priority_classes.stats_window_seconds", 300)
+
+	// 代理池默认值
+	viper.SetDefault("gateway.proxy_pool.probe_interval_seconds", 60)
+	viper.SetDefault("gateway.proxy_pool.probe_timeout_seconds", 10)
+	viper.SetDefault("gateway.proxy_pool.failure_threshold", 2)
+
+	// 账号熔断器默认值
+	viper.SetDefault("gateway.account_circuit_breaker.enabled", false)
+	viper.SetDefault("gateway.account_circuit_breaker.window_seconds", 60)
+	viper.SetDefault("gateway.account_circuit_breaker.min_requests", 10)
+	viper.SetDefault("gateway.account_circuit_breaker.error_rate_threshold", 0.5)
+	viper.SetDefault("gateway.account_circuit_breaker.slow_call_threshold_ms", 60000)
+	viper.SetDefault("gateway.account_circuit_breaker.open_seconds", 30)
+	viper.SetDefault("gateway.account_circuit_breaker.max_open_seconds", 300)
+	viper.SetDefault("gateway.account_circuit_breaker.half_open_successes", 2)
+	viper.SetDefault("gateway.account_circuit_breaker.half_open_admit_interval_seconds", 5)
+	viper.SetDefault("gateway.account_circuit_breaker.synthetic_probe_after_seconds", 15)
 
 	viper.SetDefault("gateway.tls_fingerprint.enabled", true)
 	viper.SetDefault("concurrency.ping_interval", 10)
@@ -43,18 +43,19 @@ type DataProxy struct {
 }
 
 type DataAccount struct {
-	Name               string         `json:"name"`
-	Notes              *string        `json:"notes,omitempty"`
-	Platform           string         `json:"platform"`
-	Type               string         `json:"type"`
-	Credentials        map[string]any `json:"credentials"`
-	Extra              map[string]any `json:"extra,omitempty"`
-	ProxyKey           *string        `json:"proxy_key,omitempty"`
-	Concurrency        int            `json:"concurrency"`
-	Priority           int            `json:"priority"`
-	RateMultiplier     *float64       `json:"rate_multiplier,omitempty"`
-	ExpiresAt          *int64         `json:"expires_at,omitempty"`
-	AutoPauseOnExpired *bool          `json:"auto_pause_on_expired,omitempty"`
+	Name                 string         `json:"name"`
+	Notes                *string        `json:"notes,omitempty"`
+	Platform             string         `json:"platform"`
+	Type                 string         `json:"type"`
+	Credentials          map[string]any `json:"credentials"`
+	Extra                map[string]any `json:"extra,omitempty"`
+	ProxyKey             *string        `json:"proxy_key,omitempty"`
+	Concurrency          int            `json:"concurrency"`
+	Priority             int            `json:"priority"`
+	RateMultiplier       *float64       `json:"rate_multiplier,omitempty"`
+	ExpiresAt            *int64         `json:"expires_at,omitempty"`
+	AutoPauseOnExpired   *bool          `json:"auto_pause_on_expired,omitempty"`
+	AvailabilitySchedule string         `json:"availability_schedule,omitempty"`
 }
 
 type DataImportRequest struct {
@@ -147,18 +148,19 @@ func (h *AccountHandler) ExportData(c *gin.Context) {
 			expiresAt = &v
 		}
 		dataAccounts = append(dataAccounts, DataAccount{
-			Name:               acc.Name,
-			Notes:              acc.Notes,
-			Platform:           acc.Platform,
-			Type:               acc.Type,
-			Credentials:        acc.Credentials,
-			Extra:              acc.Extra,
-			ProxyKey:           proxyKey,
-			Concurrency:        acc.Concurrency,
-			Priority:           acc.Priority,
-			RateMultiplier:     acc.RateMultiplier,
-			ExpiresAt:          expiresAt,
-			AutoPauseOnExpired: &acc.AutoPauseOnExpired,
+			Name:                 acc.Name,
+			Notes:                acc.Notes,
+			Platform:             acc.Platform,
+			Type:                 acc.Type,
+			Credentials:          acc.Credentials,
+			Extra:                acc.Extra,
+			ProxyKey:             proxyKey,
+			Concurrency:          acc.Concurrency,
+			Priority:             acc.Priority,
+			RateMultiplier:       acc.RateMultiplier,
+			ExpiresAt:            expiresAt,
+			AutoPauseOnExpired:   &acc.AutoPauseOnExpired,
+			AvailabilitySchedule: acc.AvailabilitySchedule,
 		})
 	}
 
@@ -311,6 +313,7 @@ func (h *AccountHandler) importData(ctx context.Context, req DataImportRequest)
 			GroupIDs:             nil,
 			ExpiresAt:            item.ExpiresAt,
 			AutoPauseOnExpired:   item.AutoPauseOnExpired,
+			AvailabilitySchedule: item.AvailabilitySchedule,
 			SkipDefaultGroupBind: skipDefaultGroupBind,
 		}
 
@@ -2,11 +2,14 @@ package admin
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/Wei-Shaw/sub2api/internal/service"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/require"
 )
@@ -16,7 +19,7 @@ func setupAdminRouter() (*gin.Engine, *stubAdminService) {
 	router := gin.New()
 	adminSvc := newStubAdminService()
 
-	userHandler := NewUserHandler(adminSvc, nil)
+	userHandler := NewUserHandler(adminSvc, nil, nil, nil)
 	groupHandler := NewGroupHandler(adminSvc, nil, nil)
 	proxyHandler := NewProxyHandler(adminSvc)
 	redeemHandler := NewRedeemHandler(adminSvc, nil)
@@ -266,3 +269,51 @@ func TestRedeemHandlerEndpoints(t *testing.T) {
 	router.ServeHTTP(rec, req)
 	require.Equal(t, http.StatusOK, rec.Code)
 }
+
+type balanceBucketRepoStub struct {
+	service.BalanceBucketRepository
+	params pagination.PaginationParams
+	status string
+}
+
+func (s *balanceBucketRepoStub) ListByUser(ctx context.Context, userID int64, params pagination.PaginationParams, status string) ([]service.BalanceBucket, *pagination.PaginationResult, error) {
+	s.params = params
+	s.status = status
+	return []service.BalanceBucket{}, &pagination.PaginationResult{Total: 42, Page: params.Page, PageSize: params.PageSize}, nil
+}
+
+func TestUserHandlerGetBalanceHistory_PaginatesBucketsSeparately(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	repo := &balanceBucketRepoStub{}
+	userHandler := NewUserHandler(newStubAdminService(), nil, nil, service.NewBalanceBucketService(repo, nil, nil, nil))
+	router := gin.New()
+	router.GET("/api/v1/admin/users/:id/balance-history", userHandler.GetBalanceHistory)
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/users/1/balance-history?page=3&page_size=15&bucket_page=2&bucket_page_size=50&bucket_status=active", nil)
+	router.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, pagination.PaginationParams{Page: 2, PageSize: 50}, repo.params)
+	require.Equal(t, "active", repo.status)
+
+	var resp struct {
+		Data struct {
+			Page           int   `json:"page"`
+			BucketPage     int   `json:"bucket_page"`
+			BucketPageSize int   `json:"bucket_page_size"`
+			BucketTotal    int64 `json:"bucket_total"`
+		} `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	require.Equal(t, 3, resp.Data.Page)
+	require.Equal(t, 2, resp.Data.BucketPage)
+	require.Equal(t, 50, resp.Data.BucketPageSize)
+	require.Equal(t, int64(42), resp.Data.BucketTotal)
+
+	// 未传 bucket 分页参数时使用默认值，不跟随兑换记录分页
+	rec = httptest.NewRecorder()
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/admin/users/1/balance-history?page=3&page_size=15", nil)
+	router.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, pagination.PaginationParams{Page: 1, PageSize: 20}, repo.params)
+}
@@ -0,0 +1,162 @@
+package admin
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/model"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/response"
+	middleware2 "github.com/Wei-Shaw/sub2api/internal/server/middleware"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+// BillingRecomputeHandler handles billing recompute jobs: replaying usage logs with current or
+// candidate pricing and applying the resulting balance corrections.
+type BillingRecomputeHandler struct {
+	recomputeService *service.BillingRecomputeService
+}
+
+// NewBillingRecomputeHandler creates a new admin BillingRecomputeHandler.
+func NewBillingRecomputeHandler(recomputeService *service.BillingRecomputeService) *BillingRecomputeHandler {
+	return &BillingRecomputeHandler{recomputeService: recomputeService}
+}
+
+// CreateBillingRecomputeJobRequest is the request body for starting a recompute job.
+// Leaving pricing and multipliers empty replays the range with current pricing.
+type CreateBillingRecomputeJobRequest struct {
+	StartTime            time.Time                              `json:"start_time" binding:"required"`
+	EndTime              time.Time                              `json:"end_time" binding:"required"`
+	UserID               int64                                  `json:"user_id"`
+	GroupID              int64                                  `json:"group_id"`
+	Model                string                                 `json:"model"`
+	Pricing              map[string]model.CandidateModelPricing `json:"pricing"`
+	RateMultiplier       *float64                               `json:"rate_multiplier"`
+	GroupRateMultipliers map[int64]float64                      `json:"group_rate_multipliers"`
+}
+
+// ApplyBillingRecomputeJobRequest confirms applying a job's balance diff.
+type ApplyBillingRecomputeJobRequest struct {
+	Confirm             bool    `json:"confirm"`
+	ExpectedBalanceDiff float64 `json:"expected_balance_diff"`
+	RefundOnly          bool    `json:"refund_only"`
+	MinAmount           float64 `json:"min_amount"`
+	Note                string  `json:"note"`
+}
+
+func parseRecomputeJobIDParam(c *gin.Context) (int64, bool) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || id <= 0 {
+		response.BadRequest(c, "Invalid job ID")
+		return 0, false
+	}
+	return id, true
+}
+
+// ListJobs lists recompute jobs, optionally filtered by status.
+// GET /api/v1/admin/billing/recompute/jobs
+func (h *BillingRecomputeHandler) ListJobs(c *gin.Context) {
+	page, pageSize := response.ParsePagination(c)
+	items, total, err := h.recomputeService.ListJobs(c.Request.Context(), service.BillingRecomputeJobListParams{
+		Status:   c.Query("status"),
+		Page:     page,
+		PageSize: pageSize,
+	})
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Paginated(c, items, int64(total), page, pageSize)
+}
+
+// CreateJob starts a recompute job; the report is available once the job has succeeded.
+// POST /api/v1/admin/billing/recompute/jobs
+func (h *BillingRecomputeHandler) CreateJob(c *gin.Context) {
+	var req CreateBillingRecomputeJobRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+	subject, ok := middleware2.GetAuthSubjectFromContext(c)
+	if !ok {
+		response.Unauthorized(c, "User not found in context")
+		return
+	}
+	job, err := h.recomputeService.CreateJob(c.Request.Context(), subject.UserID, model.BillingRecomputeParams{
+		StartTime:            req.StartTime,
+		EndTime:              req.EndTime,
+		UserID:               req.UserID,
+		GroupID:              req.GroupID,
+		Model:                req.Model,
+		Pricing:              req.Pricing,
+		RateMultiplier:       req.RateMultiplier,
+		GroupRateMultipliers: req.GroupRateMultipliers,
+	})
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, job)
+}
+
+// GetJob returns a recompute job with its diff report.
+// GET /api/v1/admin/billing/recompute/jobs/:id
+func (h *BillingRecomputeHandler) GetJob(c *gin.Context) {
+	id, ok := parseRecomputeJobIDParam(c)
+	if !ok {
+		return
+	}
+	job, err := h.recomputeService.GetJob(c.Request.Context(), id)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, job)
+}
+
+// ApplyJob applies the balance diff of a succeeded job. confirm must be true and
+// expected_balance_diff must match the report.
+// POST /api/v1/admin/billing/recompute/jobs/:id/apply
+func (h *BillingRecomputeHandler) ApplyJob(c *gin.Context) {
+	id, ok := parseRecomputeJobIDParam(c)
+	if !ok {
+		return
+	}
+	var req ApplyBillingRecomputeJobRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+	subject, ok := middleware2.GetAuthSubjectFromContext(c)
+	if !ok {
+		response.Unauthorized(c, "User not found in context")
+		return
+	}
+	job, err := h.recomputeService.ApplyJob(c.Request.Context(), id, subject.UserID, service.BillingRecomputeApplyInput{
+		Confirm:             req.Confirm,
+		ExpectedBalanceDiff: req.ExpectedBalanceDiff,
+		RefundOnly:          req.RefundOnly,
+		MinAmount:           req.MinAmount,
+		Note:                req.Note,
+	})
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, job)
+}
+
+// ListAdjustments returns the balance adjustments applied by a job.
+// GET /api/v1/admin/billing/recompute/jobs/:id/adjustments
+func (h *BillingRecomputeHandler) ListAdjustments(c *gin.Context) {
+	id, ok := parseRecomputeJobIDParam(c)
+	if !ok {
+		return
+	}
+	items, err := h.recomputeService.ListAdjustments(c.Request.Context(), id)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, items)
+}
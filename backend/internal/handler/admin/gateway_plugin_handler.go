@@ -0,0 +1,227 @@
+package admin
+
+import (
+	"encoding/base64"
+	"strconv"
+
+	"github.com/Wei-Shaw/sub2api/internal/model"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/response"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+// GatewayPluginHandler 处理网关 WASM 插件的 HTTP 请求
+type GatewayPluginHandler struct {
+	service *service.GatewayPluginService
+}
+
+// NewGatewayPluginHandler 创建网关插件处理器
+func NewGatewayPluginHandler(service *service.GatewayPluginService) *GatewayPluginHandler {
+	return &GatewayPluginHandler{service: service}
+}
+
+// CreateGatewayPluginRequest 创建插件请求（模块以 base64 编码上传）
+type CreateGatewayPluginRequest struct {
+	Name          string  `json:"name" binding:"required"`
+	Enabled       *bool   `json:"enabled"`
+	Priority      int     `json:"priority"`
+	GroupIDs      []int64 `json:"group_ids"`
+	FailureMode   string  `json:"failure_mode"`
+	TimeoutMs     int     `json:"timeout_ms"`
+	MemoryLimitMB int     `json:"memory_limit_mb"`
+	ModuleBase64  string  `json:"module_base64" binding:"required"`
+	Description   *string `json:"description"`
+}
+
+// UpdateGatewayPluginRequest 更新插件请求（部分更新，所有字段可选）
+type UpdateGatewayPluginRequest struct {
+	Name          *string `json:"name"`
+	Enabled       *bool   `json:"enabled"`
+	Priority      *int    `json:"priority"`
+	GroupIDs      []int64 `json:"group_ids"`
+	FailureMode   *string `json:"failure_mode"`
+	TimeoutMs     *int    `json:"timeout_ms"`
+	MemoryLimitMB *int    `json:"memory_limit_mb"`
+	ModuleBase64  *string `json:"module_base64"`
+	Description   *string `json:"description"`
+}
+
+// List 获取所有插件
+// GET /api/v1/admin/gateway-plugins
+func (h *GatewayPluginHandler) List(c *gin.Context) {
+	plugins, err := h.service.List(c.Request.Context())
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, plugins)
+}
+
+// GetByID 根据 ID 获取插件
+// GET /api/v1/admin/gateway-plugins/:id
+func (h *GatewayPluginHandler) GetByID(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid plugin ID")
+		return
+	}
+
+	plugin, err := h.service.GetByID(c.Request.Context(), id)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	if plugin == nil {
+		response.NotFound(c, "Plugin not found")
+		return
+	}
+
+	response.Success(c, plugin)
+}
+
+// Create 上传并创建插件
+// POST /api/v1/admin/gateway-plugins
+func (h *GatewayPluginHandler) Create(c *gin.Context) {
+	var req CreateGatewayPluginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+
+	module, err := base64.StdEncoding.DecodeString(req.ModuleBase64)
+	if err != nil {
+		response.BadRequest(c, "module_base64 is not valid base64")
+		return
+	}
+
+	plugin := &model.GatewayPlugin{
+		Name:          req.Name,
+		Enabled:       true,
+		Priority:      req.Priority,
+		GroupIDs:      req.GroupIDs,
+		FailureMode:   req.FailureMode,
+		TimeoutMs:     req.TimeoutMs,
+		MemoryLimitMB: req.MemoryLimitMB,
+		Module:        module,
+		Description:   req.Description,
+	}
+
+	// 设置默认值
+	if req.Enabled != nil {
+		plugin.Enabled = *req.Enabled
+	}
+	if plugin.FailureMode == "" {
+		plugin.FailureMode = model.PluginFailureModeOpen
+	}
+	if plugin.TimeoutMs == 0 {
+		plugin.TimeoutMs = model.PluginDefaultTimeoutMs
+	}
+	if plugin.MemoryLimitMB == 0 {
+		plugin.MemoryLimitMB = model.PluginDefaultMemoryLimitMB
+	}
+	if plugin.GroupIDs == nil {
+		plugin.GroupIDs = []int64{}
+	}
+
+	created, err := h.service.Create(c.Request.Context(), plugin)
+	if err != nil {
+		if _, ok := err.(*model.ValidationError); ok {
+			response.BadRequest(c, err.Error())
+			return
+		}
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, created)
+}
+
+// Update 更新插件（支持部分更新，可替换模块）
+// PUT /api/v1/admin/gateway-plugins/:id
+func (h *GatewayPluginHandler) Update(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid plugin ID")
+		return
+	}
+
+	var req UpdateGatewayPluginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+
+	existing, err := h.service.GetByID(c.Request.Context(), id)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	if existing == nil {
+		response.NotFound(c, "Plugin not found")
+		return
+	}
+
+	// 部分更新：只更新请求中提供的字段
+	plugin := *existing
+	if req.Name != nil {
+		plugin.Name = *req.Name
+	}
+	if req.Enabled != nil {
+		plugin.Enabled = *req.Enabled
+	}
+	if req.Priority != nil {
+		plugin.Priority = *req.Priority
+	}
+	if req.GroupIDs != nil {
+		plugin.GroupIDs = req.GroupIDs
+	}
+	if req.FailureMode != nil {
+		plugin.FailureMode = *req.FailureMode
+	}
+	if req.TimeoutMs != nil {
+		plugin.TimeoutMs = *req.TimeoutMs
+	}
+	if req.MemoryLimitMB != nil {
+		plugin.MemoryLimitMB = *req.MemoryLimitMB
+	}
+	if req.ModuleBase64 != nil {
+		module, err := base64.StdEncoding.DecodeString(*req.ModuleBase64)
+		if err != nil {
+			response.BadRequest(c, "module_base64 is not valid base64")
+			return
+		}
+		plugin.Module = module
+	}
+	if req.Description != nil {
+		plugin.Description = req.Description
+	}
+
+	updated, err := h.service.Update(c.Request.Context(), &plugin)
+	if err != nil {
+		if _, ok := err.(*model.ValidationError); ok {
+			response.BadRequest(c, err.Error())
+			return
+		}
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, updated)
+}
+
+// Delete 删除插件
+// DELETE /api/v1/admin/gateway-plugins/:id
+func (h *GatewayPluginHandler) Delete(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid plugin ID")
+		return
+	}
+
+	if err := h.service.Delete(c.Request.Context(), id); err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, gin.H{"message": "Plugin deleted successfully"})
+}
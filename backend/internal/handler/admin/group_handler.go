@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/Wei-Shaw/sub2api/internal/handler/dto"
+	"github.com/Wei-Shaw/sub2api/internal/model"
 	"github.com/Wei-Shaw/sub2api/internal/pkg/response"
 	"github.com/Wei-Shaw/sub2api/internal/pkg/timezone"
 	"github.com/Wei-Shaw/sub2api/internal/service"
@@ -116,6 +118,21 @@ type CreateGroupRequest struct {
 	DefaultMappedModel    string `json:"default_mapped_model"`
 	// 非流式响应头控制
 	ForceApplicationJSONForNonStream bool `json:"force_application_json_for_non_stream"`
+	// 账号调度策略：priority_lru/weighted_round_robin/least_outstanding/ewma/p2c
+	SchedulingStrategy string `json:"scheduling_strategy"`
+	// 流式请求对冲（hedge_delay_ms 为 0 时使用主账号 P90 首字延迟）
+	HedgeEnabled bool `json:"hedge_enabled"`
+	HedgeDelayMs int  `json:"hedge_delay_ms"`
+	// 模型流量拆分规则（按会话哈希分配目标模型/账号，可选影子流量）
+	TrafficSplitRules []model.TrafficSplitRule `json:"traffic_split_rules"`
+	// 模型降级链（过载或无可调度账号时按顺序改用降级模型）
+	ModelFallbackChains []model.ModelFallbackChain `json:"model_fallback_chains"`
+	// 用量阶梯定价（按用户当月累计消费叠加折扣倍数）
+	VolumeTiers []model.VolumeTier `json:"volume_tiers"`
+	// 可用时段 cron 表达式（命中的分钟内可调度，支持 CRON_TZ= 前缀）与维护模式
+	AvailabilitySchedule string `json:"availability_schedule"`
+	MaintenanceMode      bool   `json:"maintenance_mode"`
+	MaintenanceMessage   string `json:"maintenance_message"`
 	// 从指定分组复制账号（创建后自动绑定）
 	CopyAccountsFromGroupIDs []int64 `json:"copy_accounts_from_group_ids"`
 }
@@ -157,6 +174,21 @@ type UpdateGroupRequest struct {
 	DefaultMappedModel    *string `json:"default_mapped_model"`
 	// 非流式响应头控制
 	ForceApplicationJSONForNonStream *bool `json:"force_application_json_for_non_stream"`
+	// 账号调度策略（空字符串表示恢复默认策略）
+	SchedulingStrategy *string `json:"scheduling_strategy"`
+	// 流式请求对冲
+	HedgeEnabled *bool `json:"hedge_enabled"`
+	HedgeDelayMs *int  `json:"hedge_delay_ms"`
+	// 模型流量拆分规则（空数组表示清除）
+	TrafficSplitRules *[]model.TrafficSplitRule `json:"traffic_split_rules"`
+	// 模型降级链（空数组表示清除）
+	ModelFallbackChains *[]model.ModelFallbackChain `json:"model_fallback_chains"`
+	// 用量阶梯定价（空数组表示清除）
+	VolumeTiers *[]model.VolumeTier `json:"volume_tiers"`
+	// 可用时段 cron 表达式（空字符串表示全天可用）与维护模式
+	AvailabilitySchedule *string `json:"availability_schedule"`
+	MaintenanceMode      *bool   `json:"maintenance_mode"`
+	MaintenanceMessage   *string `json:"maintenance_message"`
 	// 从指定分组复制账号（同步操作：先清空当前分组的账号绑定，再绑定源分组的账号）
 	CopyAccountsFromGroupIDs []int64 `json:"copy_accounts_from_group_ids"`
 }
@@ -277,6 +309,15 @@ func (h *GroupHandler) Create(c *gin.Context) {
 		RequirePrivacySet:                req.RequirePrivacySet,
 		DefaultMappedModel:               req.DefaultMappedModel,
 		ForceApplicationJSONForNonStream: req.ForceApplicationJSONForNonStream,
+		SchedulingStrategy:               req.SchedulingStrategy,
+		HedgeEnabled:                     req.HedgeEnabled,
+		HedgeDelayMs:                     req.HedgeDelayMs,
+		TrafficSplitRules:                req.TrafficSplitRules,
+		ModelFallbackChains:              req.ModelFallbackChains,
+		VolumeTiers:                      req.VolumeTiers,
+		AvailabilitySchedule:             req.AvailabilitySchedule,
+		MaintenanceMode:                  req.MaintenanceMode,
+		MaintenanceMessage:               req.MaintenanceMessage,
 		CopyAccountsFromGroupIDs:         req.CopyAccountsFromGroupIDs,
 	})
 	if err != nil {
@@ -333,6 +374,15 @@ func (h *GroupHandler) Update(c *gin.Context) {
 		RequirePrivacySet:                req.RequirePrivacySet,
 		DefaultMappedModel:               req.DefaultMappedModel,
 		ForceApplicationJSONForNonStream: req.ForceApplicationJSONForNonStream,
+		SchedulingStrategy:               req.SchedulingStrategy,
+		HedgeEnabled:                     req.HedgeEnabled,
+		HedgeDelayMs:                     req.HedgeDelayMs,
+		TrafficSplitRules:                req.TrafficSplitRules,
+		ModelFallbackChains:              req.ModelFallbackChains,
+		VolumeTiers:                      req.VolumeTiers,
+		AvailabilitySchedule:             req.AvailabilitySchedule,
+		MaintenanceMode:                  req.MaintenanceMode,
+		MaintenanceMessage:               req.MaintenanceMessage,
 		CopyAccountsFromGroupIDs:         req.CopyAccountsFromGroupIDs,
 	})
 	if err != nil {
@@ -407,6 +457,29 @@ func (h *GroupHandler) GetCapacitySummary(c *gin.Context) {
 	response.Success(c, results)
 }
 
+// GetTrafficSplitReport returns per-label usage comparison for a group's traffic split rules.
+// GET /api/v1/admin/groups/:id/traffic-split-report?start_date=2024-01-01&end_date=2024-01-07&timezone=Asia/Shanghai
+func (h *GroupHandler) GetTrafficSplitReport(c *gin.Context) {
+	groupID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid group ID")
+		return
+	}
+
+	startTime, endTime := parseTimeRange(c)
+	items, err := h.dashboardService.GetTrafficSplitReport(c.Request.Context(), groupID, startTime, endTime)
+	if err != nil {
+		response.Error(c, 500, "Failed to get traffic split report")
+		return
+	}
+
+	response.Success(c, gin.H{
+		"items":      items,
+		"start_date": startTime.Format("2006-01-02"),
+		"end_date":   endTime.Add(-24 * time.Hour).Format("2006-01-02"),
+	})
+}
+
 // GetGroupAPIKeys handles getting API keys in a group
 // GET /api/v1/admin/groups/:id/api-keys
 func (h *GroupHandler) GetGroupAPIKeys(c *gin.Context) {
@@ -0,0 +1,304 @@
+package admin
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/Wei-Shaw/sub2api/internal/model"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/response"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+// GuardrailHandler 处理内容护栏规则的 HTTP 请求
+type GuardrailHandler struct {
+	service *service.GuardrailService
+}
+
+// NewGuardrailHandler 创建内容护栏规则处理器
+func NewGuardrailHandler(service *service.GuardrailService) *GuardrailHandler {
+	return &GuardrailHandler{service: service}
+}
+
+// CreateGuardrailRuleRequest 创建规则请求
+type CreateGuardrailRuleRequest struct {
+	Name          string   `json:"name" binding:"required"`
+	Enabled       *bool    `json:"enabled"`
+	Priority      int      `json:"priority"`
+	Platforms     []string `json:"platforms"`
+	GroupIDs      []int64  `json:"group_ids"`
+	Type          string   `json:"type" binding:"required"`
+	Patterns      []string `json:"patterns"`
+	CaseSensitive bool     `json:"case_sensitive"`
+	Action        string   `json:"action" binding:"required"`
+	Description   *string  `json:"description"`
+}
+
+// UpdateGuardrailRuleRequest 更新规则请求（部分更新，所有字段可选）
+type UpdateGuardrailRuleRequest struct {
+	Name          *string  `json:"name"`
+	Enabled       *bool    `json:"enabled"`
+	Priority      *int     `json:"priority"`
+	Platforms     []string `json:"platforms"`
+	GroupIDs      []int64  `json:"group_ids"`
+	Type          *string  `json:"type"`
+	Patterns      []string `json:"patterns"`
+	CaseSensitive *bool    `json:"case_sensitive"`
+	Action        *string  `json:"action"`
+	Description   *string  `json:"description"`
+}
+
+// TestGuardrailRuleRequest 预览扫描结果请求
+// Rule 为空时使用当前生效的全部规则；非空时仅试运行该（未保存的）规则
+type TestGuardrailRuleRequest struct {
+	Platform string               `json:"platform"`
+	GroupID  int64                `json:"group_id"`
+	Body     json.RawMessage      `json:"body" binding:"required"`
+	Rule     *model.GuardrailRule `json:"rule"`
+}
+
+// TestGuardrailRuleResponse 预览扫描结果
+type TestGuardrailRuleResponse struct {
+	Action  string                   `json:"action"`
+	Body    json.RawMessage          `json:"body"`
+	Matches []service.GuardrailMatch `json:"matches"`
+}
+
+// List 获取所有规则
+// GET /api/v1/admin/guardrail-rules
+func (h *GuardrailHandler) List(c *gin.Context) {
+	rules, err := h.service.List(c.Request.Context())
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, rules)
+}
+
+// GetByID 根据 ID 获取规则
+// GET /api/v1/admin/guardrail-rules/:id
+func (h *GuardrailHandler) GetByID(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid rule ID")
+		return
+	}
+
+	rule, err := h.service.GetByID(c.Request.Context(), id)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	if rule == nil {
+		response.NotFound(c, "Rule not found")
+		return
+	}
+
+	response.Success(c, rule)
+}
+
+// Create 创建规则
+// POST /api/v1/admin/guardrail-rules
+func (h *GuardrailHandler) Create(c *gin.Context) {
+	var req CreateGuardrailRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+
+	rule := &model.GuardrailRule{
+		Name:          req.Name,
+		Enabled:       true,
+		Priority:      req.Priority,
+		Platforms:     req.Platforms,
+		GroupIDs:      req.GroupIDs,
+		Type:          req.Type,
+		Patterns:      req.Patterns,
+		CaseSensitive: req.CaseSensitive,
+		Action:        req.Action,
+		Description:   req.Description,
+	}
+	if req.Enabled != nil {
+		rule.Enabled = *req.Enabled
+	}
+	normalizeGuardrailRule(rule)
+
+	created, err := h.service.Create(c.Request.Context(), rule)
+	if err != nil {
+		if _, ok := err.(*model.ValidationError); ok {
+			response.BadRequest(c, err.Error())
+			return
+		}
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, created)
+}
+
+// Update 更新规则（支持部分更新）
+// PUT /api/v1/admin/guardrail-rules/:id
+func (h *GuardrailHandler) Update(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid rule ID")
+		return
+	}
+
+	var req UpdateGuardrailRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+
+	existing, err := h.service.GetByID(c.Request.Context(), id)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	if existing == nil {
+		response.NotFound(c, "Rule not found")
+		return
+	}
+
+	// 部分更新：只更新请求中提供的字段
+	rule := *existing
+	rule.ID = id
+	if req.Name != nil {
+		rule.Name = *req.Name
+	}
+	if req.Enabled != nil {
+		rule.Enabled = *req.Enabled
+	}
+	if req.Priority != nil {
+		rule.Priority = *req.Priority
+	}
+	if req.Platforms != nil {
+		rule.Platforms = req.Platforms
+	}
+	if req.GroupIDs != nil {
+		rule.GroupIDs = req.GroupIDs
+	}
+	if req.Type != nil {
+		rule.Type = *req.Type
+	}
+	if req.Patterns != nil {
+		rule.Patterns = req.Patterns
+	}
+	if req.CaseSensitive != nil {
+		rule.CaseSensitive = *req.CaseSensitive
+	}
+	if req.Action != nil {
+		rule.Action = *req.Action
+	}
+	if req.Description != nil {
+		rule.Description = req.Description
+	}
+	normalizeGuardrailRule(&rule)
+
+	updated, err := h.service.Update(c.Request.Context(), &rule)
+	if err != nil {
+		if _, ok := err.(*model.ValidationError); ok {
+			response.BadRequest(c, err.Error())
+			return
+		}
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, updated)
+}
+
+// Delete 删除规则
+// DELETE /api/v1/admin/guardrail-rules/:id
+func (h *GuardrailHandler) Delete(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid rule ID")
+		return
+	}
+
+	if err := h.service.Delete(c.Request.Context(), id); err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, gin.H{"message": "Rule deleted successfully"})
+}
+
+// Test 对样例请求体预览扫描与脱敏结果
+// POST /api/v1/admin/guardrail-rules/test
+func (h *GuardrailHandler) Test(c *gin.Context) {
+	var req TestGuardrailRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+
+	if req.Rule != nil {
+		normalizeGuardrailRule(req.Rule)
+	}
+	scope := service.GuardrailScope{Platform: req.Platform, GroupID: req.GroupID}
+
+	result, err := h.service.Preview(scope, req.Body, req.Rule)
+	if err != nil {
+		if _, ok := err.(*model.ValidationError); ok {
+			response.BadRequest(c, err.Error())
+			return
+		}
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, TestGuardrailRuleResponse{
+		Action:  result.Action,
+		Body:    json.RawMessage(result.Body),
+		Matches: result.Matches,
+	})
+}
+
+// ListViolations 按违规次数倒序列出用户
+// GET /api/v1/admin/guardrail-rules/violations?limit=20
+func (h *GuardrailHandler) ListViolations(c *gin.Context) {
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	if limit <= 0 || limit > 200 {
+		limit = 20
+	}
+
+	items, err := h.service.ListViolators(c.Request.Context(), limit)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, items)
+}
+
+// GetUserViolations 获取单个用户的违规统计
+// GET /api/v1/admin/guardrail-rules/violations/:user_id
+func (h *GuardrailHandler) GetUserViolations(c *gin.Context) {
+	userID, err := strconv.ParseInt(c.Param("user_id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid user ID")
+		return
+	}
+
+	stats, err := h.service.GetUserViolations(c.Request.Context(), userID)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, stats)
+}
+
+// normalizeGuardrailRule 确保切片不为 nil
+func normalizeGuardrailRule(rule *model.GuardrailRule) {
+	if rule.Platforms == nil {
+		rule.Platforms = []string{}
+	}
+	if rule.GroupIDs == nil {
+		rule.GroupIDs = []int64{}
+	}
+	if rule.Patterns == nil {
+		rule.Patterns = []string{}
+	}
+}
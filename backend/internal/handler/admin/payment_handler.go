@@ -1,6 +1,7 @@
 package admin
 
 import (
+	"net/http"
 	"strconv"
 
 	"github.com/Wei-Shaw/sub2api/internal/pkg/response"
@@ -13,13 +14,15 @@ import (
 type PaymentHandler struct {
 	paymentService *service.PaymentService
 	configService  *service.PaymentConfigService
+	invoiceService *service.InvoiceService
 }
 
 // NewPaymentHandler creates a new admin PaymentHandler.
-func NewPaymentHandler(paymentService *service.PaymentService, configService *service.PaymentConfigService) *PaymentHandler {
+func NewPaymentHandler(paymentService *service.PaymentService, configService *service.PaymentConfigService, invoiceService *service.InvoiceService) *PaymentHandler {
 	return &PaymentHandler{
 		paymentService: paymentService,
 		configService:  configService,
+		invoiceService: invoiceService,
 	}
 }
 
@@ -114,6 +117,30 @@ func (h *PaymentHandler) RetryFulfillment(c *gin.Context) {
 	response.Success(c, gin.H{"message": "fulfillment retried"})
 }
 
+// AdminSettleChainTransferRequest is the request body for settling an on-chain order manually.
+type AdminSettleChainTransferRequest struct {
+	TxHash string `json:"tx_hash" binding:"required"`
+}
+
+// SettleChainTransfer settles an on-chain order with a transfer recorded as an amount mismatch.
+// POST /api/v1/admin/payment/orders/:id/settle-transfer
+func (h *PaymentHandler) SettleChainTransfer(c *gin.Context) {
+	orderID, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+	var req AdminSettleChainTransferRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+	if err := h.paymentService.SettleChainTransfer(c.Request.Context(), orderID, req.TxHash); err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, gin.H{"message": "order settled"})
+}
+
 // AdminProcessRefundRequest is the request body for admin refund processing.
 type AdminProcessRefundRequest struct {
 	Amount        float64 `json:"amount"`
@@ -217,6 +244,157 @@ func (h *PaymentHandler) DeletePlan(c *gin.Context) {
 	response.Success(c, gin.H{"message": "deleted"})
 }
 
+// --- Checkout Coupons ---
+
+// ListCoupons returns all checkout coupons with their usage counts.
+// GET /api/v1/admin/payment/coupons
+func (h *PaymentHandler) ListCoupons(c *gin.Context) {
+	coupons, err := h.configService.ListCoupons(c.Request.Context())
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, coupons)
+}
+
+// CreateCoupon creates a new checkout coupon.
+// POST /api/v1/admin/payment/coupons
+func (h *PaymentHandler) CreateCoupon(c *gin.Context) {
+	var req service.CreateCouponRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+	coupon, err := h.configService.CreateCoupon(c.Request.Context(), req)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Created(c, coupon)
+}
+
+// UpdateCoupon updates an existing checkout coupon.
+// PUT /api/v1/admin/payment/coupons/:id
+func (h *PaymentHandler) UpdateCoupon(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+	var req service.UpdateCouponRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+	coupon, err := h.configService.UpdateCoupon(c.Request.Context(), id, req)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, coupon)
+}
+
+// DeleteCoupon deletes a checkout coupon.
+// DELETE /api/v1/admin/payment/coupons/:id
+func (h *PaymentHandler) DeleteCoupon(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+	if err := h.configService.DeleteCoupon(c.Request.Context(), id); err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, gin.H{"message": "deleted"})
+}
+
+// --- Invoices ---
+
+// ListInvoices returns a paginated list of invoices and credit notes.
+// GET /api/v1/admin/payment/invoices
+func (h *PaymentHandler) ListInvoices(c *gin.Context) {
+	page, pageSize := response.ParsePagination(c)
+	var userID int64
+	if uid := c.Query("user_id"); uid != "" {
+		if v, err := strconv.ParseInt(uid, 10, 64); err == nil {
+			userID = v
+		}
+	}
+	invoices, total, err := h.invoiceService.ListInvoices(c.Request.Context(), service.InvoiceListParams{
+		UserID:     userID,
+		Kind:       c.Query("kind"),
+		SourceType: c.Query("source_type"),
+		Page:       page,
+		PageSize:   pageSize,
+	})
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Paginated(c, invoices, int64(total), page, pageSize)
+}
+
+// IssueInvoiceRequest is the request body for issuing an invoice manually.
+type IssueInvoiceRequest struct {
+	SourceType string `json:"source_type" binding:"required"`
+	SourceID   int64  `json:"source_id" binding:"required"`
+}
+
+// IssueInvoice issues the invoice of an order (idempotent), e.g. for orders completed before invoicing existed.
+// POST /api/v1/admin/payment/invoices
+func (h *PaymentHandler) IssueInvoice(c *gin.Context) {
+	var req IssueInvoiceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+	inv, err := h.invoiceService.AdminIssueInvoice(c.Request.Context(), req.SourceType, req.SourceID)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, inv)
+}
+
+// DownloadInvoice renders any invoice as PDF (default) or HTML.
+// GET /api/v1/admin/payment/invoices/:id/download?format=pdf|html
+func (h *PaymentHandler) DownloadInvoice(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+	inv, err := h.invoiceService.GetInvoice(c.Request.Context(), id, 0)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	rendered, err := h.invoiceService.RenderInvoice(c.Request.Context(), inv, c.Query("format"))
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	c.Header("Content-Disposition", "attachment; filename="+rendered.Filename)
+	c.Data(http.StatusOK, rendered.ContentType, rendered.Data)
+}
+
+// ResendInvoice emails an invoice to its buyer again.
+// POST /api/v1/admin/payment/invoices/:id/email
+func (h *PaymentHandler) ResendInvoice(c *gin.Context) {
+	id, ok := parseIDParam(c, "id")
+	if !ok {
+		return
+	}
+	inv, err := h.invoiceService.GetInvoice(c.Request.Context(), id, 0)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	if err := h.invoiceService.SendInvoiceEmail(c.Request.Context(), inv); err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, gin.H{"message": "sent"})
+}
+
 // --- Provider Instances ---
 
 // ListProviders returns all payment provider instances.
@@ -0,0 +1,161 @@
+package admin
+
+import (
+	"strconv"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/response"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+// PostpaidHandler handles admin management of postpaid accounts and billing statements.
+type PostpaidHandler struct {
+	postpaidService *service.PostpaidService
+}
+
+// NewPostpaidHandler creates a new admin PostpaidHandler.
+func NewPostpaidHandler(postpaidService *service.PostpaidService) *PostpaidHandler {
+	return &PostpaidHandler{postpaidService: postpaidService}
+}
+
+// SavePostpaidAccountRequest is the request body for enabling or updating postpaid billing.
+type SavePostpaidAccountRequest struct {
+	CreditLimit      float64 `json:"credit_limit" binding:"min=0"`
+	PaymentTermsDays int     `json:"payment_terms_days" binding:"omitempty,min=1,max=90"`
+	Notes            string  `json:"notes"`
+}
+
+// MarkStatementPaidRequest is the request body for settling a billing statement.
+type MarkStatementPaidRequest struct {
+	Note string `json:"note"`
+}
+
+func parseUserIDParam(c *gin.Context) (int64, bool) {
+	userID, err := strconv.ParseInt(c.Param("user_id"), 10, 64)
+	if err != nil || userID <= 0 {
+		response.BadRequest(c, "Invalid user ID")
+		return 0, false
+	}
+	return userID, true
+}
+
+// ListAccounts lists postpaid accounts.
+// GET /api/v1/admin/postpaid/accounts
+func (h *PostpaidHandler) ListAccounts(c *gin.Context) {
+	page, pageSize := response.ParsePagination(c)
+	accounts, total, err := h.postpaidService.ListAccounts(c.Request.Context(), page, pageSize)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Paginated(c, accounts, int64(total), page, pageSize)
+}
+
+// GetAccount returns the postpaid account of a user.
+// GET /api/v1/admin/postpaid/accounts/:user_id
+func (h *PostpaidHandler) GetAccount(c *gin.Context) {
+	userID, ok := parseUserIDParam(c)
+	if !ok {
+		return
+	}
+	account, err := h.postpaidService.GetAccount(c.Request.Context(), userID)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, account)
+}
+
+// SaveAccount switches a user to postpaid billing or updates its terms.
+// PUT /api/v1/admin/postpaid/accounts/:user_id
+func (h *PostpaidHandler) SaveAccount(c *gin.Context) {
+	userID, ok := parseUserIDParam(c)
+	if !ok {
+		return
+	}
+	var req SavePostpaidAccountRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+	account, err := h.postpaidService.SaveAccount(c.Request.Context(), userID, service.PostpaidAccountInput{
+		CreditLimit:      req.CreditLimit,
+		PaymentTermsDays: req.PaymentTermsDays,
+		Notes:            req.Notes,
+	})
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, account)
+}
+
+// DeleteAccount switches a user back to prepaid billing.
+// DELETE /api/v1/admin/postpaid/accounts/:user_id
+func (h *PostpaidHandler) DeleteAccount(c *gin.Context) {
+	userID, ok := parseUserIDParam(c)
+	if !ok {
+		return
+	}
+	if err := h.postpaidService.DeleteAccount(c.Request.Context(), userID); err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, gin.H{"message": "Postpaid billing disabled"})
+}
+
+// ListStatements lists billing statements, optionally filtered by user_id and status.
+// GET /api/v1/admin/postpaid/statements
+func (h *PostpaidHandler) ListStatements(c *gin.Context) {
+	page, pageSize := response.ParsePagination(c)
+	var userID int64
+	if uid := c.Query("user_id"); uid != "" {
+		if v, err := strconv.ParseInt(uid, 10, 64); err == nil {
+			userID = v
+		}
+	}
+	statements, total, err := h.postpaidService.ListStatements(c.Request.Context(), service.BillingStatementListParams{
+		UserID:   userID,
+		Status:   c.Query("status"),
+		Page:     page,
+		PageSize: pageSize,
+	})
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Paginated(c, statements, int64(total), page, pageSize)
+}
+
+// MarkStatementPaid settles a billing statement and credits its amount back to the balance.
+// POST /api/v1/admin/postpaid/statements/:id/paid
+func (h *PostpaidHandler) MarkStatementPaid(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid statement ID")
+		return
+	}
+	var req MarkStatementPaidRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			response.BadRequest(c, "Invalid request: "+err.Error())
+			return
+		}
+	}
+	statement, err := h.postpaidService.MarkStatementPaid(c.Request.Context(), id, req.Note)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, statement)
+}
+
+// RunBillingCycle runs the billing cycle now instead of waiting for the next scheduled run.
+// POST /api/v1/admin/postpaid/run
+func (h *PostpaidHandler) RunBillingCycle(c *gin.Context) {
+	if err := h.postpaidService.RunBillingCycle(c.Request.Context()); err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, gin.H{"message": "Billing cycle completed"})
+}
@@ -0,0 +1,147 @@
+package admin
+
+import (
+	"strconv"
+
+	"github.com/Wei-Shaw/sub2api/internal/model"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/response"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+// ProxyPoolHandler 处理代理池管理的 HTTP 请求
+type ProxyPoolHandler struct {
+	service *service.ProxyPoolService
+}
+
+// NewProxyPoolHandler 创建代理池处理器
+func NewProxyPoolHandler(service *service.ProxyPoolService) *ProxyPoolHandler {
+	return &ProxyPoolHandler{service: service}
+}
+
+// CreateProxyPoolRequest 创建代理池请求
+type CreateProxyPoolRequest struct {
+	Name        string                  `json:"name" binding:"required,max=100"`
+	Status      string                  `json:"status" binding:"omitempty,oneof=active inactive"`
+	Members     []model.ProxyPoolMember `json:"members"`
+	Description *string                 `json:"description"`
+}
+
+// UpdateProxyPoolRequest 更新代理池请求（部分更新，所有字段可选）
+type UpdateProxyPoolRequest struct {
+	Name        *string                 `json:"name" binding:"omitempty,min=1,max=100"`
+	Status      *string                 `json:"status" binding:"omitempty,oneof=active inactive"`
+	Members     []model.ProxyPoolMember `json:"members"`
+	Description *string                 `json:"description"`
+}
+
+// List 获取所有代理池及成员状态
+// GET /api/v1/admin/proxy-pools
+func (h *ProxyPoolHandler) List(c *gin.Context) {
+	pools, err := h.service.List(c.Request.Context())
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, pools)
+}
+
+// GetByID 获取代理池详情
+// GET /api/v1/admin/proxy-pools/:id
+func (h *ProxyPoolHandler) GetByID(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid proxy pool ID")
+		return
+	}
+
+	pool, err := h.service.GetByID(c.Request.Context(), id)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, pool)
+}
+
+// Create 创建代理池
+// POST /api/v1/admin/proxy-pools
+func (h *ProxyPoolHandler) Create(c *gin.Context) {
+	var req CreateProxyPoolRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+
+	pool, err := h.service.Create(c.Request.Context(), &service.CreateProxyPoolInput{
+		Name:        req.Name,
+		Status:      req.Status,
+		Members:     req.Members,
+		Description: req.Description,
+	})
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, pool)
+}
+
+// Update 更新代理池
+// PUT /api/v1/admin/proxy-pools/:id
+func (h *ProxyPoolHandler) Update(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid proxy pool ID")
+		return
+	}
+
+	var req UpdateProxyPoolRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+
+	pool, err := h.service.Update(c.Request.Context(), id, &service.UpdateProxyPoolInput{
+		Name:        req.Name,
+		Status:      req.Status,
+		Members:     req.Members,
+		Description: req.Description,
+	})
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, pool)
+}
+
+// Delete 删除代理池
+// DELETE /api/v1/admin/proxy-pools/:id
+func (h *ProxyPoolHandler) Delete(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid proxy pool ID")
+		return
+	}
+
+	if err := h.service.Delete(c.Request.Context(), id); err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, gin.H{"message": "Proxy pool deleted successfully"})
+}
+
+// Probe 立即探测代理池所有成员
+// POST /api/v1/admin/proxy-pools/:id/probe
+func (h *ProxyPoolHandler) Probe(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid proxy pool ID")
+		return
+	}
+
+	pool, err := h.service.Probe(c.Request.Context(), id)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, pool)
+}
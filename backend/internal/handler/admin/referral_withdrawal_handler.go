@@ -0,0 +1,161 @@
+package admin
+
+import (
+	"strconv"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/response"
+	middleware2 "github.com/Wei-Shaw/sub2api/internal/server/middleware"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+// ReferralWithdrawalHandler handles admin review and payout of referral commission withdrawals.
+type ReferralWithdrawalHandler struct {
+	commissionService *service.ReferralCommissionService
+}
+
+// NewReferralWithdrawalHandler creates a new admin ReferralWithdrawalHandler.
+func NewReferralWithdrawalHandler(commissionService *service.ReferralCommissionService) *ReferralWithdrawalHandler {
+	return &ReferralWithdrawalHandler{commissionService: commissionService}
+}
+
+// ReviewWithdrawalRequest is the request body for approving or rejecting a withdrawal.
+type ReviewWithdrawalRequest struct {
+	Note string `json:"note"`
+}
+
+// MarkWithdrawalPaidRequest is the request body for recording a withdrawal payout.
+type MarkWithdrawalPaidRequest struct {
+	PayoutReference string `json:"payout_reference"`
+	Note            string `json:"note"`
+}
+
+func parseWithdrawalIDParam(c *gin.Context) (int64, bool) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil || id <= 0 {
+		response.BadRequest(c, "Invalid withdrawal ID")
+		return 0, false
+	}
+	return id, true
+}
+
+// bindOptionalJSON binds the request body when one was sent.
+func bindOptionalJSON(c *gin.Context, req any) bool {
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(req); err != nil {
+			response.BadRequest(c, "Invalid request: "+err.Error())
+			return false
+		}
+	}
+	return true
+}
+
+// List lists withdrawal requests, optionally filtered by user_id and status.
+// GET /api/v1/admin/referral/withdrawals
+func (h *ReferralWithdrawalHandler) List(c *gin.Context) {
+	page, pageSize := response.ParsePagination(c)
+	var userID int64
+	if uid := c.Query("user_id"); uid != "" {
+		if v, err := strconv.ParseInt(uid, 10, 64); err == nil {
+			userID = v
+		}
+	}
+	items, total, err := h.commissionService.ListWithdrawals(c.Request.Context(), service.CommissionWithdrawalListParams{
+		UserID:   userID,
+		Status:   c.Query("status"),
+		Page:     page,
+		PageSize: pageSize,
+	})
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Paginated(c, items, int64(total), page, pageSize)
+}
+
+// Approve approves a pending withdrawal request.
+// POST /api/v1/admin/referral/withdrawals/:id/approve
+func (h *ReferralWithdrawalHandler) Approve(c *gin.Context) {
+	id, ok := parseWithdrawalIDParam(c)
+	if !ok {
+		return
+	}
+	var req ReviewWithdrawalRequest
+	if !bindOptionalJSON(c, &req) {
+		return
+	}
+	subject, ok := middleware2.GetAuthSubjectFromContext(c)
+	if !ok {
+		response.Unauthorized(c, "User not found in context")
+		return
+	}
+	withdrawal, err := h.commissionService.ApproveWithdrawal(c.Request.Context(), id, subject.UserID, req.Note)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, withdrawal)
+}
+
+// Reject rejects a pending or approved withdrawal request; note is the required reason.
+// POST /api/v1/admin/referral/withdrawals/:id/reject
+func (h *ReferralWithdrawalHandler) Reject(c *gin.Context) {
+	id, ok := parseWithdrawalIDParam(c)
+	if !ok {
+		return
+	}
+	var req ReviewWithdrawalRequest
+	if !bindOptionalJSON(c, &req) {
+		return
+	}
+	subject, ok := middleware2.GetAuthSubjectFromContext(c)
+	if !ok {
+		response.Unauthorized(c, "User not found in context")
+		return
+	}
+	withdrawal, err := h.commissionService.RejectWithdrawal(c.Request.Context(), id, subject.UserID, req.Note)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, withdrawal)
+}
+
+// MarkPaid records the payout of an approved withdrawal request.
+// POST /api/v1/admin/referral/withdrawals/:id/paid
+func (h *ReferralWithdrawalHandler) MarkPaid(c *gin.Context) {
+	id, ok := parseWithdrawalIDParam(c)
+	if !ok {
+		return
+	}
+	var req MarkWithdrawalPaidRequest
+	if !bindOptionalJSON(c, &req) {
+		return
+	}
+	subject, ok := middleware2.GetAuthSubjectFromContext(c)
+	if !ok {
+		response.Unauthorized(c, "User not found in context")
+		return
+	}
+	withdrawal, err := h.commissionService.MarkWithdrawalPaid(c.Request.Context(), id, subject.UserID, req.PayoutReference, req.Note)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, withdrawal)
+}
+
+// ListLogs returns the audit trail of a withdrawal request.
+// GET /api/v1/admin/referral/withdrawals/:id/logs
+func (h *ReferralWithdrawalHandler) ListLogs(c *gin.Context) {
+	id, ok := parseWithdrawalIDParam(c)
+	if !ok {
+		return
+	}
+	logs, err := h.commissionService.ListWithdrawalLogs(c.Request.Context(), id)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, logs)
+}
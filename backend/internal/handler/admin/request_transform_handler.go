@@ -0,0 +1,286 @@
+package admin
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/Wei-Shaw/sub2api/internal/model"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/response"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/gin-gonic/gin"
+	"github.com/tidwall/gjson"
+)
+
+// RequestTransformHandler 处理请求改写规则的 HTTP 请求
+type RequestTransformHandler struct {
+	service *service.RequestTransformService
+}
+
+// NewRequestTransformHandler 创建请求改写规则处理器
+func NewRequestTransformHandler(service *service.RequestTransformService) *RequestTransformHandler {
+	return &RequestTransformHandler{service: service}
+}
+
+// CreateRequestTransformRuleRequest 创建规则请求
+type CreateRequestTransformRuleRequest struct {
+	Name             string                         `json:"name" binding:"required"`
+	Enabled          *bool                          `json:"enabled"`
+	Priority         int                            `json:"priority"`
+	Platforms        []string                       `json:"platforms"`
+	GroupIDs         []int64                        `json:"group_ids"`
+	AccountIDs       []int64                        `json:"account_ids"`
+	ModelPatterns    []string                       `json:"model_patterns"`
+	BodyOperations   []model.RequestBodyOperation   `json:"body_operations"`
+	HeaderOperations []model.RequestHeaderOperation `json:"header_operations"`
+	Description      *string                        `json:"description"`
+}
+
+// UpdateRequestTransformRuleRequest 更新规则请求（部分更新，所有字段可选）
+type UpdateRequestTransformRuleRequest struct {
+	Name             *string                        `json:"name"`
+	Enabled          *bool                          `json:"enabled"`
+	Priority         *int                           `json:"priority"`
+	Platforms        []string                       `json:"platforms"`
+	GroupIDs         []int64                        `json:"group_ids"`
+	AccountIDs       []int64                        `json:"account_ids"`
+	ModelPatterns    []string                       `json:"model_patterns"`
+	BodyOperations   []model.RequestBodyOperation   `json:"body_operations"`
+	HeaderOperations []model.RequestHeaderOperation `json:"header_operations"`
+	Description      *string                        `json:"description"`
+}
+
+// TestRequestTransformRuleRequest 预览改写结果请求
+// Rule 为空时使用当前生效的全部规则；非空时仅试运行该（未保存的）规则
+type TestRequestTransformRuleRequest struct {
+	Platform  string                      `json:"platform"`
+	GroupID   int64                       `json:"group_id"`
+	AccountID int64                       `json:"account_id"`
+	Model     string                      `json:"model"`
+	Body      json.RawMessage             `json:"body" binding:"required"`
+	Headers   map[string]string           `json:"headers"`
+	Rule      *model.RequestTransformRule `json:"rule"`
+}
+
+// List 获取所有规则
+// GET /api/v1/admin/request-transform-rules
+func (h *RequestTransformHandler) List(c *gin.Context) {
+	rules, err := h.service.List(c.Request.Context())
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, rules)
+}
+
+// GetByID 根据 ID 获取规则
+// GET /api/v1/admin/request-transform-rules/:id
+func (h *RequestTransformHandler) GetByID(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid rule ID")
+		return
+	}
+
+	rule, err := h.service.GetByID(c.Request.Context(), id)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	if rule == nil {
+		response.NotFound(c, "Rule not found")
+		return
+	}
+
+	response.Success(c, rule)
+}
+
+// Create 创建规则
+// POST /api/v1/admin/request-transform-rules
+func (h *RequestTransformHandler) Create(c *gin.Context) {
+	var req CreateRequestTransformRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+
+	rule := &model.RequestTransformRule{
+		Name:             req.Name,
+		Enabled:          true,
+		Priority:         req.Priority,
+		Platforms:        req.Platforms,
+		GroupIDs:         req.GroupIDs,
+		AccountIDs:       req.AccountIDs,
+		ModelPatterns:    req.ModelPatterns,
+		BodyOperations:   req.BodyOperations,
+		HeaderOperations: req.HeaderOperations,
+		Description:      req.Description,
+	}
+	if req.Enabled != nil {
+		rule.Enabled = *req.Enabled
+	}
+	normalizeRequestTransformRule(rule)
+
+	created, err := h.service.Create(c.Request.Context(), rule)
+	if err != nil {
+		if _, ok := err.(*model.ValidationError); ok {
+			response.BadRequest(c, err.Error())
+			return
+		}
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, created)
+}
+
+// Update 更新规则（支持部分更新）
+// PUT /api/v1/admin/request-transform-rules/:id
+func (h *RequestTransformHandler) Update(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid rule ID")
+		return
+	}
+
+	var req UpdateRequestTransformRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+
+	existing, err := h.service.GetByID(c.Request.Context(), id)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	if existing == nil {
+		response.NotFound(c, "Rule not found")
+		return
+	}
+
+	// 部分更新：只更新请求中提供的字段
+	rule := *existing
+	rule.ID = id
+	if req.Name != nil {
+		rule.Name = *req.Name
+	}
+	if req.Enabled != nil {
+		rule.Enabled = *req.Enabled
+	}
+	if req.Priority != nil {
+		rule.Priority = *req.Priority
+	}
+	if req.Platforms != nil {
+		rule.Platforms = req.Platforms
+	}
+	if req.GroupIDs != nil {
+		rule.GroupIDs = req.GroupIDs
+	}
+	if req.AccountIDs != nil {
+		rule.AccountIDs = req.AccountIDs
+	}
+	if req.ModelPatterns != nil {
+		rule.ModelPatterns = req.ModelPatterns
+	}
+	if req.BodyOperations != nil {
+		rule.BodyOperations = req.BodyOperations
+	}
+	if req.HeaderOperations != nil {
+		rule.HeaderOperations = req.HeaderOperations
+	}
+	if req.Description != nil {
+		rule.Description = req.Description
+	}
+	normalizeRequestTransformRule(&rule)
+
+	updated, err := h.service.Update(c.Request.Context(), &rule)
+	if err != nil {
+		if _, ok := err.(*model.ValidationError); ok {
+			response.BadRequest(c, err.Error())
+			return
+		}
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, updated)
+}
+
+// Delete 删除规则
+// DELETE /api/v1/admin/request-transform-rules/:id
+func (h *RequestTransformHandler) Delete(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid rule ID")
+		return
+	}
+
+	if err := h.service.Delete(c.Request.Context(), id); err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, gin.H{"message": "Rule deleted successfully"})
+}
+
+// Test 对样例请求预览改写后的请求体与请求头
+// POST /api/v1/admin/request-transform-rules/test
+func (h *RequestTransformHandler) Test(c *gin.Context) {
+	var req TestRequestTransformRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+
+	body := []byte(req.Body)
+	// body 允许以 JSON 字符串形式提交非 JSON 的原始请求体
+	if parsed := gjson.ParseBytes(body); parsed.Type == gjson.String {
+		body = []byte(parsed.String())
+	}
+	scope := service.RequestTransformScope{
+		Platform:  req.Platform,
+		GroupID:   req.GroupID,
+		AccountID: req.AccountID,
+		Model:     req.Model,
+	}
+	if scope.Model == "" {
+		scope.Model = gjson.GetBytes(body, "model").String()
+	}
+	if req.Rule != nil {
+		normalizeRequestTransformRule(req.Rule)
+	}
+
+	result, err := h.service.Preview(scope, body, req.Rule)
+	if err != nil {
+		if _, ok := err.(*model.ValidationError); ok {
+			response.BadRequest(c, err.Error())
+			return
+		}
+		response.ErrorFrom(c, err)
+		return
+	}
+
+	response.Success(c, result.BuildPreview(req.Headers))
+}
+
+// normalizeRequestTransformRule 确保切片不为 nil
+func normalizeRequestTransformRule(rule *model.RequestTransformRule) {
+	if rule.Platforms == nil {
+		rule.Platforms = []string{}
+	}
+	if rule.GroupIDs == nil {
+		rule.GroupIDs = []int64{}
+	}
+	if rule.AccountIDs == nil {
+		rule.AccountIDs = []int64{}
+	}
+	if rule.ModelPatterns == nil {
+		rule.ModelPatterns = []string{}
+	}
+	if rule.BodyOperations == nil {
+		rule.BodyOperations = []model.RequestBodyOperation{}
+	}
+	if rule.HeaderOperations == nil {
+		rule.HeaderOperations = []model.RequestHeaderOperation{}
+	}
+}
@@ -22,13 +22,17 @@ type UserWithConcurrency struct {
 type UserHandler struct {
 	adminService       service.AdminService
 	concurrencyService *service.ConcurrencyService
+	authService        *service.AuthService
+	balanceBuckets     *service.BalanceBucketService
 }
 
 // NewUserHandler creates a new admin user handler
-func NewUserHandler(adminService service.AdminService, concurrencyService *service.ConcurrencyService) *UserHandler {
+func NewUserHandler(adminService service.AdminService, concurrencyService *service.ConcurrencyService, authService *service.AuthService, balanceBuckets *service.BalanceBucketService) *UserHandler {
 	return &UserHandler{
 		adminService:       adminService,
 		concurrencyService: concurrencyService,
+		authService:        authService,
+		balanceBuckets:     balanceBuckets,
 	}
 }
 
@@ -377,6 +381,8 @@ func (h *UserHandler) GetUserUsage(c *gin.Context) {
 // GET /api/v1/admin/users/:id/balance-history
 // Query params:
 //   - type: filter by record type (balance, admin_balance, concurrency, admin_concurrency, subscription)
+//   - bucket_status: filter balance buckets by status (active, exhausted, expired, refunded)
+//   - bucket_page, bucket_page_size: pagination of balance buckets, independent of page/page_size
 func (h *UserHandler) GetBalanceHistory(c *gin.Context) {
 	userID, err := strconv.ParseInt(c.Param("id"), 10, 64)
 	if err != nil {
@@ -399,21 +405,45 @@ func (h *UserHandler) GetBalanceHistory(c *gin.Context) {
 		out = append(out, *dto.RedeemCodeFromServiceAdmin(&codes[i]))
 	}
 
+	// 余额分桶明细：独立分页，展示每笔入账的来源、剩余与到期时间
+	bucketPage, bucketPageSize := parseBucketPagination(c)
+	buckets, bucketTotal, err := h.balanceBuckets.ListByUser(c.Request.Context(), userID, bucketPage, bucketPageSize, c.Query("bucket_status"))
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+
 	// Custom response with total_recharged alongside pagination
 	pages := int((total + int64(pageSize) - 1) / int64(pageSize))
 	if pages < 1 {
 		pages = 1
 	}
 	response.Success(c, gin.H{
-		"items":           out,
-		"total":           total,
-		"page":            page,
-		"page_size":       pageSize,
-		"pages":           pages,
-		"total_recharged": totalRecharged,
+		"items":            out,
+		"total":            total,
+		"page":             page,
+		"page_size":        pageSize,
+		"pages":            pages,
+		"total_recharged":  totalRecharged,
+		"buckets":          dto.BalanceBucketsFromService(buckets),
+		"bucket_total":     bucketTotal,
+		"bucket_page":      bucketPage,
+		"bucket_page_size": bucketPageSize,
 	})
 }
 
+// parseBucketPagination 解析余额分桶的分页参数 bucket_page/bucket_page_size，取值规则与 response.ParsePagination 一致
+func parseBucketPagination(c *gin.Context) (page, pageSize int) {
+	page, pageSize = 1, 20
+	if v, err := strconv.Atoi(c.Query("bucket_page")); err == nil && v > 0 {
+		page = v
+	}
+	if v, err := strconv.Atoi(c.Query("bucket_page_size")); err == nil && v > 0 && v <= 1000 {
+		pageSize = v
+	}
+	return page, pageSize
+}
+
 // ReplaceGroupRequest represents the request to replace a user's exclusive group
 type ReplaceGroupRequest struct {
 	OldGroupID int64 `json:"old_group_id" binding:"required,gt=0"`
@@ -445,3 +475,52 @@ func (h *UserHandler) ReplaceGroup(c *gin.Context) {
 		"migrated_keys": result.MigratedKeys,
 	})
 }
+
+// ListSessions lists a user's active login sessions
+// GET /api/v1/admin/users/:id/sessions
+func (h *UserHandler) ListSessions(c *gin.Context) {
+	userID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid user ID")
+		return
+	}
+
+	sessions, err := h.authService.ListUserSessions(c.Request.Context(), userID, "")
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, sessions)
+}
+
+// RevokeSession revokes a single login session of a user
+// DELETE /api/v1/admin/users/:id/sessions/:session_id
+func (h *UserHandler) RevokeSession(c *gin.Context) {
+	userID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid user ID")
+		return
+	}
+
+	if err := h.authService.RevokeUserSession(c.Request.Context(), userID, c.Param("session_id")); err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, gin.H{"message": "Session revoked"})
+}
+
+// RevokeAllSessions revokes all login sessions of a user
+// DELETE /api/v1/admin/users/:id/sessions
+func (h *UserHandler) RevokeAllSessions(c *gin.Context) {
+	userID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid user ID")
+		return
+	}
+
+	if err := h.authService.RevokeAllUserSessions(c.Request.Context(), userID); err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, gin.H{"message": "All sessions revoked"})
+}
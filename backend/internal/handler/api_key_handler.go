@@ -19,12 +19,14 @@ import (
 // APIKeyHandler handles API key-related requests
 type APIKeyHandler struct {
 	apiKeyService *service.APIKeyService
+	volumeTiers   *service.VolumeTierService
 }
 
 // NewAPIKeyHandler creates a new APIKeyHandler
-func NewAPIKeyHandler(apiKeyService *service.APIKeyService) *APIKeyHandler {
+func NewAPIKeyHandler(apiKeyService *service.APIKeyService, volumeTiers *service.VolumeTierService) *APIKeyHandler {
 	return &APIKeyHandler{
 		apiKeyService: apiKeyService,
+		volumeTiers:   volumeTiers,
 	}
 }
 
@@ -42,6 +44,8 @@ type CreateAPIKeyRequest struct {
 	RateLimit5h *float64 `json:"rate_limit_5h"`
 	RateLimit1d *float64 `json:"rate_limit_1d"`
 	RateLimit7d *float64 `json:"rate_limit_7d"`
+
+	PriorityClass string `json:"priority_class" binding:"omitempty,oneof=interactive standard batch"` // 请求优先级分类
 }
 
 // UpdateAPIKeyRequest represents the update API key request payload
@@ -60,6 +64,8 @@ type UpdateAPIKeyRequest struct {
 	RateLimit1d         *float64 `json:"rate_limit_1d"`
 	RateLimit7d         *float64 `json:"rate_limit_7d"`
 	ResetRateLimitUsage *bool    `json:"reset_rate_limit_usage"` // 重置限速用量
+
+	PriorityClass *string `json:"priority_class" binding:"omitempty,oneof=interactive standard batch"` // 请求优先级分类（nil = 不修改）
 }
 
 type BatchUpdateAPIKeyGroupRequest struct {
@@ -160,6 +166,7 @@ func (h *APIKeyHandler) Create(c *gin.Context) {
 		IPWhitelist:   req.IPWhitelist,
 		IPBlacklist:   req.IPBlacklist,
 		ExpiresInDays: req.ExpiresInDays,
+		PriorityClass: req.PriorityClass,
 	}
 	if req.Quota != nil {
 		svcReq.Quota = *req.Quota
@@ -213,6 +220,7 @@ func (h *APIKeyHandler) Update(c *gin.Context) {
 		RateLimit1d:         req.RateLimit1d,
 		RateLimit7d:         req.RateLimit7d,
 		ResetRateLimitUsage: req.ResetRateLimitUsage,
+		PriorityClass:       req.PriorityClass,
 	}
 	if req.Name != "" {
 		svcReq.Name = &req.Name
@@ -319,7 +327,7 @@ func (h *APIKeyHandler) GetAvailableGroups(c *gin.Context) {
 	response.Success(c, out)
 }
 
-// GetUserGroupRates 获取当前用户的专属分组倍率配置
+// GetUserGroupRates 获取当前用户的分组实际计费倍率（专属倍率叠加用量阶梯折扣）及阶梯进度
 // GET /api/v1/groups/rates
 func (h *APIKeyHandler) GetUserGroupRates(c *gin.Context) {
 	subject, ok := middleware2.GetAuthSubjectFromContext(c)
@@ -328,11 +336,33 @@ func (h *APIKeyHandler) GetUserGroupRates(c *gin.Context) {
 		return
 	}
 
-	rates, err := h.apiKeyService.GetUserGroupRates(c.Request.Context(), subject.UserID)
+	ctx := c.Request.Context()
+	userRates, err := h.apiKeyService.GetUserGroupRates(ctx, subject.UserID)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	groups, err := h.apiKeyService.GetAvailableGroups(ctx, subject.UserID)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	progress, err := h.volumeTiers.Progress(ctx, subject.UserID, groups, userRates)
 	if err != nil {
 		response.ErrorFrom(c, err)
 		return
 	}
 
-	response.Success(c, rates)
+	out := dto.UserGroupRates{
+		Rates:       make(map[int64]float64, len(userRates)+len(progress)),
+		VolumeTiers: make([]dto.VolumeTierProgress, 0, len(progress)),
+	}
+	for groupID, rate := range userRates {
+		out.Rates[groupID] = rate
+	}
+	for i := range progress {
+		out.Rates[progress[i].GroupID] = progress[i].RateMultiplier
+		out.VolumeTiers = append(out.VolumeTiers, dto.VolumeTierProgressFromService(&progress[i]))
+	}
+	response.Success(c, out)
 }
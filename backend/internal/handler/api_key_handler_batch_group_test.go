@@ -79,7 +79,7 @@ func setupBatchAPIKeyHandlerTest() (*gin.Engine, *handlerBatchAPIKeyRepoStub) {
 		nil,
 		nil,
 	)
-	handler := NewAPIKeyHandler(apiKeyService)
+	handler := NewAPIKeyHandler(apiKeyService, nil)
 	router := gin.New()
 	router.Use(func(c *gin.Context) {
 		c.Set(string(middleware2.ContextKeyUser), middleware2.AuthSubject{UserID: 7})
@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"context"
 	"log/slog"
 	"strings"
 
@@ -77,10 +78,25 @@ type AuthResponse struct {
 	User         *dto.User `json:"user"`
 }
 
+// maxSessionUserAgentLength 会话记录的 User-Agent 最大长度
+const maxSessionUserAgentLength = 512
+
+// sessionClientContext 将客户端设备信息（User-Agent/IP）写入请求 context，用于登录会话记录
+func sessionClientContext(c *gin.Context) context.Context {
+	userAgent := strings.TrimSpace(c.GetHeader("User-Agent"))
+	if len(userAgent) > maxSessionUserAgentLength {
+		userAgent = userAgent[:maxSessionUserAgentLength]
+	}
+	return service.WithSessionClient(c.Request.Context(), service.SessionClientInfo{
+		UserAgent: userAgent,
+		IPAddress: ip.GetClientIP(c),
+	})
+}
+
 // respondWithTokenPair 生成 Token 对并返回认证响应
 // 如果 Token 对生成失败，回退到只返回 Access Token（向后兼容）
 func (h *AuthHandler) respondWithTokenPair(c *gin.Context, user *service.User) {
-	tokenPair, err := h.authService.GenerateTokenPair(c.Request.Context(), user, "")
+	tokenPair, err := h.authService.GenerateTokenPair(sessionClientContext(c), user, "")
 	if err != nil {
 		slog.Error("failed to generate token pair", "error", err, "user_id", user.ID)
 		// 回退到只返回Access Token
@@ -535,7 +551,7 @@ func (h *AuthHandler) RefreshToken(c *gin.Context) {
 		return
 	}
 
-	result, err := h.authService.RefreshTokenPair(c.Request.Context(), req.RefreshToken)
+	result, err := h.authService.RefreshTokenPair(sessionClientContext(c), req.RefreshToken)
 	if err != nil {
 		response.ErrorFrom(c, err)
 		return
@@ -609,3 +625,37 @@ func (h *AuthHandler) RevokeAllSessions(c *gin.Context) {
 		Message: "All sessions have been revoked. Please log in again.",
 	})
 }
+
+// ListSessions 列出当前用户的登录会话
+// GET /api/v1/user/sessions
+func (h *AuthHandler) ListSessions(c *gin.Context) {
+	subject, ok := middleware2.GetAuthSubjectFromContext(c)
+	if !ok {
+		response.Unauthorized(c, "User not authenticated")
+		return
+	}
+	currentSessionID, _ := middleware2.GetAuthSessionIDFromContext(c)
+
+	sessions, err := h.authService.ListUserSessions(c.Request.Context(), subject.UserID, currentSessionID)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, sessions)
+}
+
+// RevokeSession 撤销当前用户的单个登录会话
+// DELETE /api/v1/user/sessions/:session_id
+func (h *AuthHandler) RevokeSession(c *gin.Context) {
+	subject, ok := middleware2.GetAuthSubjectFromContext(c)
+	if !ok {
+		response.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	if err := h.authService.RevokeUserSession(c.Request.Context(), subject.UserID, c.Param("session_id")); err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, gin.H{"message": "Session revoked"})
+}
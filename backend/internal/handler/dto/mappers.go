@@ -99,6 +99,7 @@ func APIKeyFromService(k *service.APIKey) *APIKey {
 		Window5hStart: k.Window5hStart,
 		Window1dStart: k.Window1dStart,
 		Window7dStart: k.Window7dStart,
+		PriorityClass: k.PriorityClass,
 		User:          UserFromServiceShallow(k.User),
 		Group:         GroupFromServiceShallow(k.Group),
 	}
@@ -149,6 +150,15 @@ func GroupFromServiceAdmin(g *service.Group) *AdminGroup {
 		ActiveAccountCount:      g.ActiveAccountCount,
 		RateLimitedAccountCount: g.RateLimitedAccountCount,
 		SortOrder:               g.SortOrder,
+		SchedulingStrategy:      g.SchedulingStrategy,
+		HedgeEnabled:            g.HedgeEnabled,
+		HedgeDelayMs:            g.HedgeDelayMs,
+		TrafficSplitRules:       g.TrafficSplitRules,
+		ModelFallbackChains:     g.ModelFallbackChains,
+		VolumeTiers:             g.VolumeTiers,
+		AvailabilitySchedule:    g.AvailabilitySchedule,
+		MaintenanceMode:         g.MaintenanceMode,
+		MaintenanceMessage:      g.MaintenanceMessage,
 	}
 	if len(g.AccountGroups) > 0 {
 		out.AccountGroups = make([]AccountGroup, 0, len(g.AccountGroups))
@@ -206,6 +216,7 @@ func AccountFromServiceShallow(a *service.Account) *Account {
 		Credentials:             a.Credentials,
 		Extra:                   a.Extra,
 		ProxyID:                 a.ProxyID,
+		ProxyPoolID:             a.ProxyPoolID,
 		Concurrency:             a.Concurrency,
 		LoadFactor:              a.LoadFactor,
 		Priority:                a.Priority,
@@ -226,6 +237,7 @@ func AccountFromServiceShallow(a *service.Account) *Account {
 		SessionWindowStart:      a.SessionWindowStart,
 		SessionWindowEnd:        a.SessionWindowEnd,
 		SessionWindowStatus:     a.SessionWindowStatus,
+		AvailabilitySchedule:    a.AvailabilitySchedule,
 		GroupIDs:                a.GroupIDs,
 	}
 
@@ -495,6 +507,41 @@ func RedeemCodeFromServiceAdmin(rc *service.RedeemCode) *AdminRedeemCode {
 	}
 }
 
+// BalanceBucketsFromService converts service balance buckets to DTOs.
+func BalanceBucketsFromService(buckets []service.BalanceBucket) []BalanceBucket {
+	out := make([]BalanceBucket, 0, len(buckets))
+	for i := range buckets {
+		b := &buckets[i]
+		out = append(out, BalanceBucket{
+			ID:            b.ID,
+			Source:        b.Source,
+			SourceRef:     b.SourceRef,
+			Amount:        b.Amount,
+			Remaining:     b.Remaining,
+			Priority:      b.Priority,
+			Status:        b.Status,
+			ExpiresAt:     b.ExpiresAt,
+			ExpiredAmount: b.ExpiredAmount,
+			CreatedAt:     b.CreatedAt,
+		})
+	}
+	return out
+}
+
+func VolumeTierProgressFromService(p *service.VolumeTierProgress) VolumeTierProgress {
+	return VolumeTierProgress{
+		GroupID:        p.GroupID,
+		GroupName:      p.GroupName,
+		Month:          p.Month,
+		Spend:          p.Spend,
+		Tiers:          p.Tiers,
+		CurrentTier:    p.CurrentTier,
+		NextTier:       p.NextTier,
+		RemainingSpend: p.RemainingSpend,
+		RateMultiplier: p.RateMultiplier,
+	}
+}
+
 func redeemCodeFromServiceBase(rc *service.RedeemCode) RedeemCode {
 	out := RedeemCode{
 		ID:           rc.ID,
@@ -576,6 +623,9 @@ func usageLogFromServiceUser(l *service.UsageLog) UsageLog {
 		ImageSize:             l.ImageSize,
 		UserAgent:             l.UserAgent,
 		CacheTTLOverridden:    l.CacheTTLOverridden,
+		SubKeyID:              l.SubKeyID,
+		EndUserID:             l.EndUserID,
+		FallbackModel:         l.FallbackModel,
 		CreatedAt:             l.CreatedAt,
 		User:                  UserFromServiceShallow(l.User),
 		APIKey:                APIKeyFromService(l.APIKey),
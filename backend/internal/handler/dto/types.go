@@ -1,6 +1,10 @@
 package dto
 
-import "time"
+import (
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/model"
+)
 
 type User struct {
 	ID            int64     `json:"id"`
@@ -66,6 +70,9 @@ type APIKey struct {
 	Reset1dAt     *time.Time `json:"reset_1d_at,omitempty"`
 	Reset7dAt     *time.Time `json:"reset_7d_at,omitempty"`
 
+	// PriorityClass 请求优先级分类（空 = standard）
+	PriorityClass string `json:"priority_class"`
+
 	User  *User  `json:"user,omitempty"`
 	Group *Group `json:"group,omitempty"`
 }
@@ -134,6 +141,27 @@ type AdminGroup struct {
 
 	// 分组排序
 	SortOrder int `json:"sort_order"`
+
+	// 账号调度策略（为空使用 priority_lru）
+	SchedulingStrategy string `json:"scheduling_strategy"`
+
+	// 流式请求对冲
+	HedgeEnabled bool `json:"hedge_enabled"`
+	HedgeDelayMs int  `json:"hedge_delay_ms"`
+
+	// 模型流量拆分规则
+	TrafficSplitRules []model.TrafficSplitRule `json:"traffic_split_rules"`
+
+	// 模型降级链
+	ModelFallbackChains []model.ModelFallbackChain `json:"model_fallback_chains"`
+
+	// 用量阶梯定价
+	VolumeTiers []model.VolumeTier `json:"volume_tiers"`
+
+	// 可用时段与维护模式
+	AvailabilitySchedule string `json:"availability_schedule"`
+	MaintenanceMode      bool   `json:"maintenance_mode"`
+	MaintenanceMessage   string `json:"maintenance_message"`
 }
 
 type Account struct {
@@ -145,6 +173,7 @@ type Account struct {
 	Credentials        map[string]any `json:"credentials"`
 	Extra              map[string]any `json:"extra"`
 	ProxyID            *int64         `json:"proxy_id"`
+	ProxyPoolID        *int64         `json:"proxy_pool_id,omitempty"`
 	Concurrency        int            `json:"concurrency"`
 	LoadFactor         *int           `json:"load_factor,omitempty"`
 	Priority           int            `json:"priority"`
@@ -170,6 +199,9 @@ type Account struct {
 	SessionWindowEnd    *time.Time `json:"session_window_end"`
 	SessionWindowStatus string     `json:"session_window_status"`
 
+	// 可用时段 cron 表达式（为空表示全天可用）
+	AvailabilitySchedule string `json:"availability_schedule"`
+
 	// 5h窗口费用控制（仅 Anthropic OAuth/SetupToken 账号有效）
 	// 从 extra 字段提取，方便前端显示和编辑
 	WindowCostLimit         *float64 `json:"window_cost_limit,omitempty"`
@@ -334,6 +366,41 @@ type AdminRedeemCode struct {
 	Notes string `json:"notes"`
 }
 
+// BalanceBucket 是余额分桶明细（来源、剩余、到期时间）。
+type BalanceBucket struct {
+	ID            int64      `json:"id"`
+	Source        string     `json:"source"`
+	SourceRef     string     `json:"source_ref,omitempty"`
+	Amount        float64    `json:"amount"`
+	Remaining     float64    `json:"remaining"`
+	Priority      int        `json:"priority"`
+	Status        string     `json:"status"`
+	ExpiresAt     *time.Time `json:"expires_at,omitempty"`
+	ExpiredAmount float64    `json:"expired_amount"`
+	CreatedAt     time.Time  `json:"created_at"`
+}
+
+// VolumeTierProgress 用户在某分组的当月用量阶梯进度
+type VolumeTierProgress struct {
+	GroupID        int64              `json:"group_id"`
+	GroupName      string             `json:"group_name"`
+	Month          string             `json:"month"`
+	Spend          float64            `json:"spend"`
+	Tiers          []model.VolumeTier `json:"tiers"`
+	CurrentTier    *model.VolumeTier  `json:"current_tier,omitempty"`
+	NextTier       *model.VolumeTier  `json:"next_tier,omitempty"`
+	RemainingSpend float64            `json:"remaining_spend"`
+	RateMultiplier float64            `json:"rate_multiplier"`
+}
+
+// UserGroupRates 当前用户的分组实际计费倍率与用量阶梯进度
+type UserGroupRates struct {
+	// Rates 分组 ID → 实际计费倍率（专属倍率或分组默认，叠加当月阶梯折扣）；
+	// 仅包含设置了专属倍率或配置了阶梯的分组，其余分组按分组默认倍率计费
+	Rates       map[int64]float64    `json:"rates"`
+	VolumeTiers []VolumeTierProgress `json:"volume_tiers"`
+}
+
 // UsageLog 是普通用户接口使用的 usage log DTO（不包含管理员字段）。
 type UsageLog struct {
 	ID        int64  `json:"id"`
@@ -388,6 +455,13 @@ type UsageLog struct {
 	// Cache TTL Override 标记
 	CacheTTLOverridden bool `json:"cache_ttl_overridden"`
 
+	// 临时子密钥 ID 与终端用户标识
+	SubKeyID  *string `json:"sub_key_id,omitempty"`
+	EndUserID *string `json:"end_user_id,omitempty"`
+
+	// FallbackModel 模型降级链实际使用的模型（未降级为空）
+	FallbackModel *string `json:"fallback_model,omitempty"`
+
 	CreatedAt time.Time `json:"created_at"`
 
 	User         *User             `json:"user,omitempty"`
@@ -154,6 +154,18 @@ func (s *FailoverState) HandleSelectionExhausted(ctx context.Context) FailoverAc
 	return FailoverExhausted
 }
 
+// ConsumeModelFallback 为切换到下一级降级模型消耗一次切换预算。
+// 返回 false 表示预算已耗尽；成功时清空失败账号列表（同一账号可能仍能服务降级模型）。
+func (s *FailoverState) ConsumeModelFallback() bool {
+	if s.SwitchCount >= s.MaxSwitches {
+		return false
+	}
+	s.SwitchCount++
+	s.FailedAccountIDs = make(map[int64]struct{})
+	s.SameAccountRetryCount = make(map[int64]int)
+	return true
+}
+
 // needForceCacheBilling 判断 failover 时是否需要强制缓存计费。
 // 粘性会话切换账号、或上游明确标记时，将 input_tokens 转为 cache_read 计费。
 func needForceCacheBilling(hasBoundSession bool, failoverErr *service.UpstreamFailoverError) bool {
@@ -727,3 +727,31 @@ func TestHandleSelectionExhausted(t *testing.T) {
 		require.Equal(t, FailoverContinue, action)
 	})
 }
+
+// ---------------------------------------------------------------------------
+// ConsumeModelFallback
+// ---------------------------------------------------------------------------
+
+func TestConsumeModelFallback(t *testing.T) {
+	t.Run("消耗切换预算并清空失败账号", func(t *testing.T) {
+		fs := NewFailoverState(2, false)
+		fs.FailedAccountIDs[1] = struct{}{}
+		fs.SameAccountRetryCount[1] = 2
+		fs.SwitchCount = 1
+
+		require.True(t, fs.ConsumeModelFallback())
+		require.Equal(t, 2, fs.SwitchCount)
+		require.Empty(t, fs.FailedAccountIDs)
+		require.Empty(t, fs.SameAccountRetryCount)
+	})
+
+	t.Run("预算耗尽时拒绝降级", func(t *testing.T) {
+		fs := NewFailoverState(1, false)
+		fs.SwitchCount = 1
+		fs.FailedAccountIDs[1] = struct{}{}
+
+		require.False(t, fs.ConsumeModelFallback())
+		require.Equal(t, 1, fs.SwitchCount)
+		require.Len(t, fs.FailedAccountIDs, 1, "未降级时保留失败账号")
+	})
+}
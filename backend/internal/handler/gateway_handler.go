@@ -39,12 +39,15 @@ type GatewayHandler struct {
 	gatewayService            *service.GatewayService
 	geminiCompatService       *service.GeminiMessagesCompatService
 	antigravityGatewayService *service.AntigravityGatewayService
+	openAIGatewayService      *service.OpenAIGatewayService
 	userService               *service.UserService
 	billingCacheService       *service.BillingCacheService
 	usageService              *service.UsageService
 	apiKeyService             *service.APIKeyService
 	usageRecordWorkerPool     *service.UsageRecordWorkerPool
 	errorPassthroughService   *service.ErrorPassthroughService
+	requestTransformService   *service.RequestTransformService
+	guardrailService          *service.GuardrailService
 	concurrencyHelper         *ConcurrencyHelper
 	userMsgQueueHelper        *UserMsgQueueHelper
 	maxAccountSwitches        int
@@ -58,6 +61,7 @@ func NewGatewayHandler(
 	gatewayService *service.GatewayService,
 	geminiCompatService *service.GeminiMessagesCompatService,
 	antigravityGatewayService *service.AntigravityGatewayService,
+	openAIGatewayService *service.OpenAIGatewayService,
 	userService *service.UserService,
 	concurrencyService *service.ConcurrencyService,
 	billingCacheService *service.BillingCacheService,
@@ -65,6 +69,8 @@ func NewGatewayHandler(
 	apiKeyService *service.APIKeyService,
 	usageRecordWorkerPool *service.UsageRecordWorkerPool,
 	errorPassthroughService *service.ErrorPassthroughService,
+	requestTransformService *service.RequestTransformService,
+	guardrailService *service.GuardrailService,
 	userMsgQueueService *service.UserMessageQueueService,
 	cfg *config.Config,
 	settingService *service.SettingService,
@@ -92,12 +98,15 @@ func NewGatewayHandler(
 		gatewayService:            gatewayService,
 		geminiCompatService:       geminiCompatService,
 		antigravityGatewayService: antigravityGatewayService,
+		openAIGatewayService:      openAIGatewayService,
 		userService:               userService,
 		billingCacheService:       billingCacheService,
 		usageService:              usageService,
 		apiKeyService:             apiKeyService,
 		usageRecordWorkerPool:     usageRecordWorkerPool,
 		errorPassthroughService:   errorPassthroughService,
+		requestTransformService:   requestTransformService,
+		guardrailService:          guardrailService,
 		concurrencyHelper:         NewConcurrencyHelper(concurrencyService, SSEPingFormatClaude, pingInterval),
 		userMsgQueueHelper:        umqHelper,
 		maxAccountSwitches:        maxAccountSwitches,
@@ -186,6 +195,10 @@ func (h *GatewayHandler) Messages(c *gin.Context) {
 		h.errorResponse(c, http.StatusBadRequest, "invalid_request_error", "model is required")
 		return
 	}
+	if ephemeralKeyModelDenied(apiKey, reqModel) {
+		h.errorResponse(c, http.StatusForbidden, "permission_error", ephemeralKeyModelDeniedMessage)
+		return
+	}
 
 	// Track if we've started streaming (for error handling)
 	streamStarted := false
@@ -194,6 +207,26 @@ func (h *GatewayHandler) Messages(c *gin.Context) {
 	if h.errorPassthroughService != nil {
 		service.BindErrorPassthroughService(c, h.errorPassthroughService)
 	}
+	if h.requestTransformService != nil {
+		service.BindRequestTransformService(c, h.requestTransformService)
+	}
+
+	// 内容护栏：命中 block 规则直接拒绝，redact 规则脱敏后再转发
+	guardedBody, guardrail := applyGuardrails(c, h.guardrailService, apiKey, body)
+	if guardrail.Blocked() {
+		h.errorResponse(c, http.StatusBadRequest, "invalid_request_error", guardrailBlockedMessage(guardrail))
+		return
+	}
+	if guardrail.Redacted() {
+		// 脱敏后重新解析失败时拒绝请求，不能回退到未脱敏的原始请求体
+		reparsed, parseErr := reparseRedactedGatewayRequest(guardedBody, parsedReq)
+		if parseErr != nil {
+			reqLog.Error("gateway.guardrail_reparse_failed", zap.Error(parseErr))
+			h.errorResponse(c, http.StatusInternalServerError, "api_error", "Failed to process redacted request")
+			return
+		}
+		body, parsedReq = guardedBody, reparsed
+	}
 
 	// 获取订阅信息（可能为nil）- 提前获取用于后续检查
 	subscription, _ := middleware2.GetSubscriptionFromContext(c)
@@ -258,6 +291,18 @@ func (h *GatewayHandler) Messages(c *gin.Context) {
 	}
 	sessionHash := h.gatewayService.GenerateSessionHashForGroup(parsedReq, apiKey.Group)
 
+	// 模型流量拆分：同一会话固定落到同一目标模型/账号，影子流量使用改写前的请求体
+	shadowBody := body
+	trafficSplit := apiKey.Group.ResolveTrafficSplit(reqModel, sessionHash)
+	if trafficSplit != nil {
+		c.Request = c.Request.WithContext(service.WithTrafficSplit(c.Request.Context(), trafficSplit))
+		if trafficSplit.ModelRewritten() {
+			body, parsedReq = h.gatewayService.ApplyTrafficSplitModel(trafficSplit, body, parsedReq, domain.PlatformAnthropic)
+			reqModel = parsedReq.Model
+			reqLog = reqLog.With(zap.String("traffic_split_model", reqModel))
+		}
+	}
+
 	// 获取平台：优先使用强制平台（/antigravity 路由，中间件已设置 request.Context），否则使用分组平台
 	platform := ""
 	if forcePlatform, ok := middleware2.GetForcePlatformFromContext(c); ok {
@@ -407,6 +452,7 @@ func (h *GatewayHandler) Messages(c *gin.Context) {
 			if accountReleaseFunc != nil {
 				accountReleaseFunc()
 			}
+			reportAccountScheduleResult(h.gatewayService, account.ID, result, err)
 			if err != nil {
 				var failoverErr *service.UpstreamFailoverError
 				if errors.As(err, &failoverErr) {
@@ -456,10 +502,12 @@ func (h *GatewayHandler) Messages(c *gin.Context) {
 			}
 
 			// 使用量记录通过有界 worker 池提交，避免请求热路径创建无界 goroutine。
+			usageHook := service.UsageHookFromContext(c.Request.Context())
 			h.submitUsageRecordTask(func(ctx context.Context) {
 				if err := h.gatewayService.RecordUsage(ctx, &service.RecordUsageInput{
 					Result:             result,
 					APIKey:             apiKey,
+					UsageHook:          usageHook,
 					User:               apiKey.User,
 					Account:            account,
 					Subscription:       subscription,
@@ -470,6 +518,7 @@ func (h *GatewayHandler) Messages(c *gin.Context) {
 					RequestPayloadHash: requestPayloadHash,
 					ForceCacheBilling:  fs.ForceCacheBilling,
 					APIKeyService:      h.apiKeyService,
+					TrafficLabel:       trafficSplit.LabelForGroup(apiKey.GroupID),
 				}); err != nil {
 					logger.L().With(
 						zap.String("component", "handler.gateway.messages"),
@@ -493,6 +542,17 @@ func (h *GatewayHandler) Messages(c *gin.Context) {
 	}
 	fallbackUsed := false
 
+	// 模型降级链：过载或无可调度账号时按分组配置依次改用降级模型
+	modelFallback := newModelFallbackState(apiKey.Group, reqModel)
+	modelFallbackReq := &modelFallbackRequest{
+		APIKey:        apiKey,
+		UserID:        subject.UserID,
+		Body:          body,
+		SessionHash:   sessionHash,
+		Stream:        reqStream,
+		StreamStarted: &streamStarted,
+	}
+
 	// 单账号分组提前设置 SingleAccountRetry 标记，让 Service 层首次 503 就不设模型限流标记。
 	// 避免单账号分组收到 503 (MODEL_CAPACITY_EXHAUSTED) 时设 29s 限流，导致后续请求连续快速失败。
 	if h.gatewayService.IsSingleAntigravityAccountGroup(c.Request.Context(), currentAPIKey.GroupID) {
@@ -506,8 +566,19 @@ func (h *GatewayHandler) Messages(c *gin.Context) {
 
 		for {
 			// 选择支持该模型的账号
-			selection, err := h.gatewayService.SelectAccountWithLoadAwareness(c.Request.Context(), currentAPIKey.GroupID, sessionKey, reqModel, fs.FailedAccountIDs, parsedReq.MetadataUserID)
+			selection, err := h.gatewayService.SelectAccountWithLoadAwareness(c.Request.Context(), currentAPIKey.GroupID, sessionKey, modelFallback.scheduleModel(reqModel), fs.FailedAccountIDs, parsedReq.MetadataUserID)
 			if err != nil {
+				switch action, fallbackAPIKey := h.tryModelFallback(c, reqLog, fs, modelFallback, modelFallbackReq); action {
+				case modelFallbackRetry:
+					currentAPIKey = fallbackAPIKey
+					currentSubscription = nil
+					if fallbackAPIKey == apiKey {
+						currentSubscription = subscription
+					}
+					continue
+				case modelFallbackDone:
+					return
+				}
 				if len(fs.FailedAccountIDs) == 0 {
 					h.handleStreamingAwareError(c, http.StatusServiceUnavailable, "api_error", "No available accounts: "+err.Error(), streamStarted)
 					return
@@ -658,6 +729,9 @@ func (h *GatewayHandler) Messages(c *gin.Context) {
 			if fs.SwitchCount > 0 {
 				requestCtx = service.WithAccountSwitchCount(requestCtx, fs.SwitchCount, h.metadataBridgeEnabled())
 			}
+			if fallbackModel := modelFallback.activeModel(); fallbackModel != "" {
+				c.Header(service.ModelFallbackResponseHeader, fallbackModel)
+			}
 			// 记录 Forward 前已写入字节数，Forward 后若增加则说明 SSE 内容已发，禁止 failover
 			writerSizeBeforeForward := c.Writer.Size()
 			if account.Platform == service.PlatformAntigravity && account.Type != service.AccountTypeAPIKey {
@@ -676,6 +750,7 @@ func (h *GatewayHandler) Messages(c *gin.Context) {
 			if accountReleaseFunc != nil {
 				accountReleaseFunc()
 			}
+			reportAccountScheduleResult(h.gatewayService, account.ID, result, err)
 			if err != nil {
 				// Beta policy block: return 400 immediately, no failover
 				var betaBlockedErr *service.BetaBlockedError
@@ -769,16 +844,19 @@ func (h *GatewayHandler) Messages(c *gin.Context) {
 			requestPayloadHash := service.HashUsageRequestPayload(body)
 			inboundEndpoint := GetInboundEndpoint(c)
 			upstreamEndpoint := GetUpstreamEndpoint(c, account.Platform)
+			fallbackModel := modelFallback.activeModel()
 
 			if result.ReasoningEffort == nil {
 				result.ReasoningEffort = service.NormalizeClaudeOutputEffort(parsedReq.OutputEffort)
 			}
 
 			// 使用量记录通过有界 worker 池提交，避免请求热路径创建无界 goroutine。
+			usageHook := service.UsageHookFromContext(c.Request.Context())
 			h.submitUsageRecordTask(func(ctx context.Context) {
 				if err := h.gatewayService.RecordUsage(ctx, &service.RecordUsageInput{
 					Result:             result,
 					APIKey:             currentAPIKey,
+					UsageHook:          usageHook,
 					User:               currentAPIKey.User,
 					Account:            account,
 					Subscription:       currentSubscription,
@@ -789,6 +867,8 @@ func (h *GatewayHandler) Messages(c *gin.Context) {
 					RequestPayloadHash: requestPayloadHash,
 					ForceCacheBilling:  fs.ForceCacheBilling,
 					APIKeyService:      h.apiKeyService,
+					TrafficLabel:       trafficSplit.LabelForGroup(currentAPIKey.GroupID),
+					FallbackModel:      fallbackModel,
 				}); err != nil {
 					logger.L().With(
 						zap.String("component", "handler.gateway.messages"),
@@ -800,6 +880,20 @@ func (h *GatewayHandler) Messages(c *gin.Context) {
 					).Error("gateway.record_usage_failed", zap.Error(err))
 				}
 			})
+
+			// 影子流量：异步复制到影子模型，仅记录对比用量
+			if trafficSplit != nil && trafficSplit.Shadow != nil {
+				h.gatewayService.SubmitTrafficShadow(&service.TrafficShadowInput{
+					Decision:       trafficSplit,
+					APIKey:         currentAPIKey,
+					Subscription:   currentSubscription,
+					Body:           shadowBody,
+					Request:        c.Request,
+					PrimaryAccount: account.ID,
+					UserAgent:      userAgent,
+					IPAddress:      clientIP,
+				})
+			}
 			return
 		}
 		if !retryWithFallback {
@@ -1393,10 +1487,30 @@ func (h *GatewayHandler) CountTokens(c *gin.Context) {
 		h.errorResponse(c, http.StatusBadRequest, "invalid_request_error", "model is required")
 		return
 	}
+	if ephemeralKeyModelDenied(apiKey, parsedReq.Model) {
+		h.errorResponse(c, http.StatusForbidden, "permission_error", ephemeralKeyModelDeniedMessage)
+		return
+	}
 
 	setOpsRequestContext(c, parsedReq.Model, parsedReq.Stream, body)
 	setOpsEndpointContext(c, "", int16(service.RequestTypeFromLegacy(parsedReq.Stream, false)))
 
+	// 内容护栏：count_tokens 同样会把请求内容发往上游，与 Messages 保持一致
+	guardedBody, guardrail := applyGuardrails(c, h.guardrailService, apiKey, body)
+	if guardrail.Blocked() {
+		h.errorResponse(c, http.StatusBadRequest, "invalid_request_error", guardrailBlockedMessage(guardrail))
+		return
+	}
+	if guardrail.Redacted() {
+		reparsed, parseErr := reparseRedactedGatewayRequest(guardedBody, parsedReq)
+		if parseErr != nil {
+			reqLog.Error("gateway.count_tokens_guardrail_reparse_failed", zap.Error(parseErr))
+			h.errorResponse(c, http.StatusInternalServerError, "api_error", "Failed to process redacted request")
+			return
+		}
+		body, parsedReq = guardedBody, reparsed
+	}
+
 	// 获取订阅信息（可能为nil）
 	subscription, _ := middleware2.GetSubscriptionFromContext(c)
 
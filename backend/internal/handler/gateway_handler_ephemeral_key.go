@@ -0,0 +1,53 @@
+package handler
+
+import (
+	"net/http"
+
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+	middleware2 "github.com/Wei-Shaw/sub2api/internal/server/middleware"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// MintEphemeralKey 使用当前 API Key 签发短期、范围受限的临时子密钥
+// POST /v1/ephemeral-keys
+//
+// 子密钥可限制模型、消费上限、RPM 与终端用户标识，用量计入父 Key，
+// 并在使用记录中标注子密钥 ID。子密钥本身不能再签发子密钥。
+func (h *GatewayHandler) MintEphemeralKey(c *gin.Context) {
+	apiKey, ok := middleware2.GetAPIKeyFromContext(c)
+	if !ok {
+		h.errorResponse(c, http.StatusUnauthorized, "authentication_error", "Invalid API key")
+		return
+	}
+
+	var req service.MintEphemeralKeyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.errorResponse(c, http.StatusBadRequest, "invalid_request_error", "Invalid request body: "+err.Error())
+		return
+	}
+
+	key, err := h.apiKeyService.MintEphemeralKey(c.Request.Context(), apiKey, req)
+	if err != nil {
+		status := infraerrors.Code(err)
+		errType := "api_error"
+		switch status {
+		case http.StatusBadRequest:
+			errType = "invalid_request_error"
+		case http.StatusForbidden:
+			errType = "permission_error"
+		}
+		h.errorResponse(c, status, errType, infraerrors.Message(err))
+		return
+	}
+	c.JSON(http.StatusOK, key)
+}
+
+// ephemeralKeyModelDeniedMessage 子密钥模型范围之外的请求返回的错误信息
+var ephemeralKeyModelDeniedMessage = infraerrors.Message(service.ErrEphemeralKeyModelNotAllowed)
+
+// ephemeralKeyModelDenied 判断临时子密钥是否限制了当前模型
+func ephemeralKeyModelDenied(apiKey *service.APIKey, model string) bool {
+	return apiKey != nil && apiKey.Ephemeral != nil && !apiKey.Ephemeral.AllowsModel(model)
+}
@@ -0,0 +1,245 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/Wei-Shaw/sub2api/internal/model"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/ctxkey"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/ip"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/logger"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// modelFallbackAction 表示尝试模型降级后的下一步动作
+type modelFallbackAction int
+
+const (
+	// modelFallbackUnavailable 无可用降级步骤（调用方沿用原有错误处理）
+	modelFallbackUnavailable modelFallbackAction = iota
+	// modelFallbackRetry 已切换到降级模型（调用方使用返回的 API Key 重新选号）
+	modelFallbackRetry
+	// modelFallbackDone 请求已由 OpenAI 分组降级处理完毕（含错误响应）
+	modelFallbackDone
+)
+
+// modelFallbackState 跟踪请求在分组模型降级链中的位置
+type modelFallbackState struct {
+	steps  []model.ModelFallbackStep
+	next   int
+	active string
+}
+
+func newModelFallbackState(group *service.Group, requestedModel string) *modelFallbackState {
+	return &modelFallbackState{steps: group.ResolveModelFallbackSteps(requestedModel)}
+}
+
+// activeModel 返回当前生效的降级模型（未降级为空）
+func (s *modelFallbackState) activeModel() string {
+	if s == nil {
+		return ""
+	}
+	return s.active
+}
+
+// scheduleModel 返回选号使用的模型：降级后按降级模型筛选账号
+func (s *modelFallbackState) scheduleModel(requestedModel string) string {
+	if m := s.activeModel(); m != "" {
+		return m
+	}
+	return requestedModel
+}
+
+// modelFallbackRequest 降级重试所需的请求信息
+type modelFallbackRequest struct {
+	APIKey        *service.APIKey // 原始 API Key（未指定分组的步骤在原分组调度）
+	UserID        int64
+	Body          []byte
+	SessionHash   string
+	Stream        bool
+	StreamStarted *bool
+}
+
+// tryModelFallback 在过载或无可调度账号时切换到下一级降级模型。
+// 每个降级步骤消耗一次 FailoverState 切换预算；跨分组步骤指向 OpenAI 分组时，
+// 通过 Anthropic→Responses 转换直接完成请求。
+func (h *GatewayHandler) tryModelFallback(c *gin.Context, reqLog *zap.Logger, fs *FailoverState, fb *modelFallbackState, req *modelFallbackRequest) (modelFallbackAction, *service.APIKey) {
+	if fb == nil || !service.IsModelFallbackTrigger(fs.LastFailoverErr) {
+		return modelFallbackUnavailable, nil
+	}
+	for fb.next < len(fb.steps) {
+		step := fb.steps[fb.next]
+		if !fs.ConsumeModelFallback() {
+			return modelFallbackUnavailable, nil
+		}
+		fb.next++
+		fb.active = step.Model
+
+		targetKey := req.APIKey
+		if step.GroupID != nil {
+			group, ok := h.resolveModelFallbackGroup(c, reqLog, *step.GroupID)
+			if !ok {
+				continue
+			}
+			targetKey = cloneAPIKeyWithGroup(req.APIKey, group)
+			if err := h.billingCacheService.CheckBillingEligibility(c.Request.Context(), targetKey.User, targetKey, group, nil); err != nil {
+				reqLog.Warn("gateway.model_fallback_billing_ineligible", zap.Int64("fallback_group_id", group.ID), zap.Error(err))
+				continue
+			}
+			// 跨分组降级按"直接请求目标分组"处理：清除强制平台，允许按分组平台调度
+			c.Request = c.Request.WithContext(context.WithValue(c.Request.Context(), ctxkey.ForcePlatform, ""))
+		}
+
+		reqLog.Warn("gateway.model_fallback",
+			zap.String("fallback_model", step.Model),
+			zap.Any("fallback_group_id", targetKey.GroupID),
+			zap.Int("switch_count", fs.SwitchCount),
+			zap.Int("max_switches", fs.MaxSwitches),
+		)
+		c.Request = c.Request.WithContext(service.WithModelFallback(c.Request.Context(), step.Model))
+
+		if targetKey.Group != nil && targetKey.Group.Platform == service.PlatformOpenAI {
+			if h.forwardModelFallbackToOpenAI(c, reqLog, fs, targetKey, step.Model, req) {
+				return modelFallbackDone, nil
+			}
+			continue
+		}
+		return modelFallbackRetry, targetKey
+	}
+	return modelFallbackUnavailable, nil
+}
+
+func (h *GatewayHandler) resolveModelFallbackGroup(c *gin.Context, reqLog *zap.Logger, groupID int64) (*service.Group, bool) {
+	group, err := h.gatewayService.ResolveGroupByID(c.Request.Context(), groupID)
+	if err != nil {
+		reqLog.Warn("gateway.resolve_model_fallback_group_failed", zap.Int64("fallback_group_id", groupID), zap.Error(err))
+		return nil, false
+	}
+	if group.IsSubscriptionType() ||
+		(group.Platform != service.PlatformAnthropic && group.Platform != service.PlatformOpenAI) ||
+		(group.Platform == service.PlatformOpenAI && h.openAIGatewayService == nil) {
+		reqLog.Warn("gateway.model_fallback_group_invalid",
+			zap.Int64("fallback_group_id", group.ID),
+			zap.String("fallback_platform", group.Platform),
+			zap.String("fallback_subscription_type", group.SubscriptionType),
+		)
+		return nil, false
+	}
+	return group, true
+}
+
+// forwardModelFallbackToOpenAI 在 OpenAI 分组中选号，并经 Anthropic→Responses 转换转发降级请求。
+// 返回 false 表示目标分组无可调度账号（调用方继续尝试下一级降级），返回 true 表示请求已处理完毕。
+func (h *GatewayHandler) forwardModelFallbackToOpenAI(c *gin.Context, reqLog *zap.Logger, fs *FailoverState, apiKey *service.APIKey, fallbackModel string, req *modelFallbackRequest) bool {
+	failedAccountIDs := make(map[int64]struct{})
+	for {
+		ctx := c.Request.Context()
+		selection, _, err := h.openAIGatewayService.SelectAccountWithScheduler(ctx, apiKey.GroupID, "", req.SessionHash, fallbackModel, failedAccountIDs, service.OpenAIUpstreamTransportAny)
+		if err != nil || selection == nil || selection.Account == nil {
+			if len(failedAccountIDs) == 0 {
+				reqLog.Info("gateway.model_fallback_openai_no_account", zap.String("fallback_model", fallbackModel), zap.Error(err))
+				return false
+			}
+			if fs.LastFailoverErr != nil {
+				h.handleFailoverExhausted(c, fs.LastFailoverErr, service.PlatformOpenAI, *req.StreamStarted)
+			} else {
+				h.handleFailoverExhaustedSimple(c, http.StatusBadGateway, *req.StreamStarted)
+			}
+			return true
+		}
+		account := selection.Account
+		setOpsSelectedAccount(c, account.ID, account.Platform)
+
+		accountReleaseFunc := selection.ReleaseFunc
+		if !selection.Acquired {
+			if selection.WaitPlan == nil {
+				h.handleStreamingAwareError(c, http.StatusServiceUnavailable, "api_error", "No available accounts", *req.StreamStarted)
+				return true
+			}
+			accountReleaseFunc, err = h.concurrencyHelper.AcquireAccountSlotWithWaitTimeout(
+				c,
+				account.ID,
+				selection.WaitPlan.MaxConcurrency,
+				selection.WaitPlan.Timeout,
+				req.Stream,
+				req.StreamStarted,
+			)
+			if err != nil {
+				reqLog.Warn("gateway.account_slot_acquire_failed", zap.Int64("account_id", account.ID), zap.Error(err))
+				h.handleConcurrencyError(c, err, "account", *req.StreamStarted)
+				return true
+			}
+		}
+		accountReleaseFunc = wrapReleaseOnDone(ctx, accountReleaseFunc)
+
+		c.Header(service.ModelFallbackResponseHeader, fallbackModel)
+		writerSizeBeforeForward := c.Writer.Size()
+		result, err := h.openAIGatewayService.ForwardAsAnthropic(ctx, c, account, req.Body, "", "")
+		if accountReleaseFunc != nil {
+			accountReleaseFunc()
+		}
+		if err != nil {
+			h.openAIGatewayService.ReportOpenAIAccountScheduleResult(account.ID, false, nil)
+			var failoverErr *service.UpstreamFailoverError
+			if errors.As(err, &failoverErr) && c.Writer.Size() == writerSizeBeforeForward {
+				failedAccountIDs[account.ID] = struct{}{}
+				fs.LastFailoverErr = failoverErr
+				if fs.SwitchCount >= fs.MaxSwitches {
+					h.handleFailoverExhausted(c, failoverErr, account.Platform, *req.StreamStarted)
+					return true
+				}
+				fs.SwitchCount++
+				continue
+			}
+			wroteFallback := h.ensureForwardErrorResponse(c, *req.StreamStarted)
+			reqLog.Error("gateway.model_fallback_forward_failed",
+				zap.Int64("account_id", account.ID),
+				zap.Bool("fallback_error_response_written", wroteFallback),
+				zap.Error(err),
+			)
+			return true
+		}
+		if result == nil {
+			h.openAIGatewayService.ReportOpenAIAccountScheduleResult(account.ID, true, nil)
+			return true
+		}
+		h.openAIGatewayService.ReportOpenAIAccountScheduleResult(account.ID, true, result.FirstTokenMs)
+
+		userAgent := c.GetHeader("User-Agent")
+		clientIP := ip.GetClientIP(c)
+		requestPayloadHash := service.HashUsageRequestPayload(req.Body)
+		inboundEndpoint := GetInboundEndpoint(c)
+		upstreamEndpoint := GetUpstreamEndpoint(c, account.Platform)
+		usageHook := service.UsageHookFromContext(c.Request.Context())
+		h.submitUsageRecordTask(func(ctx context.Context) {
+			if err := h.openAIGatewayService.RecordUsage(ctx, &service.OpenAIRecordUsageInput{
+				Result:             result,
+				APIKey:             apiKey,
+				UsageHook:          usageHook,
+				User:               apiKey.User,
+				Account:            account,
+				InboundEndpoint:    inboundEndpoint,
+				UpstreamEndpoint:   upstreamEndpoint,
+				UserAgent:          userAgent,
+				IPAddress:          clientIP,
+				RequestPayloadHash: requestPayloadHash,
+				APIKeyService:      h.apiKeyService,
+				FallbackModel:      fallbackModel,
+			}); err != nil {
+				logger.L().With(
+					zap.String("component", "handler.gateway.messages"),
+					zap.Int64("user_id", req.UserID),
+					zap.Int64("api_key_id", apiKey.ID),
+					zap.Any("group_id", apiKey.GroupID),
+					zap.String("fallback_model", fallbackModel),
+					zap.Int64("account_id", account.ID),
+				).Error("gateway.record_usage_failed", zap.Error(err))
+			}
+		})
+		return true
+	}
+}
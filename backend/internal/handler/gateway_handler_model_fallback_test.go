@@ -0,0 +1,80 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Wei-Shaw/sub2api/internal/model"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func newModelFallbackTestContext() *gin.Context {
+	gin.SetMode(gin.TestMode)
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest(http.MethodPost, "/v1/messages", nil)
+	return c
+}
+
+func TestTryModelFallback_SameGroupSteps(t *testing.T) {
+	h := &GatewayHandler{}
+	c := newModelFallbackTestContext()
+	group := &service.Group{
+		ID: 1,
+		ModelFallbackChains: []model.ModelFallbackChain{{
+			ModelPattern: "claude-opus-*",
+			Steps:        []model.ModelFallbackStep{{Model: "claude-sonnet-4-5"}, {Model: "claude-haiku-4-5"}},
+		}},
+	}
+	apiKey := &service.APIKey{ID: 1, Group: group}
+	fb := newModelFallbackState(group, "claude-opus-4-1")
+	req := &modelFallbackRequest{APIKey: apiKey}
+	fs := NewFailoverState(3, false)
+	fs.LastFailoverErr = &service.UpstreamFailoverError{StatusCode: 529}
+	fs.FailedAccountIDs[10] = struct{}{}
+
+	action, key := h.tryModelFallback(c, zap.NewNop(), fs, fb, req)
+	require.Equal(t, modelFallbackRetry, action)
+	require.Same(t, apiKey, key)
+	require.Equal(t, "claude-sonnet-4-5", fb.scheduleModel("claude-opus-4-1"))
+	require.Equal(t, "claude-sonnet-4-5", service.ModelFallbackFromContext(c.Request.Context()))
+	require.Equal(t, 1, fs.SwitchCount)
+	require.Empty(t, fs.FailedAccountIDs)
+
+	action, _ = h.tryModelFallback(c, zap.NewNop(), fs, fb, req)
+	require.Equal(t, modelFallbackRetry, action)
+	require.Equal(t, "claude-haiku-4-5", fb.activeModel())
+
+	action, _ = h.tryModelFallback(c, zap.NewNop(), fs, fb, req)
+	require.Equal(t, modelFallbackUnavailable, action, "降级链已用完")
+}
+
+func TestTryModelFallback_SkipsNonOverloadAndExhaustedBudget(t *testing.T) {
+	h := &GatewayHandler{}
+	c := newModelFallbackTestContext()
+	group := &service.Group{
+		ModelFallbackChains: []model.ModelFallbackChain{{
+			ModelPattern: "claude-opus-4-1",
+			Steps:        []model.ModelFallbackStep{{Model: "claude-sonnet-4-5"}},
+		}},
+	}
+	req := &modelFallbackRequest{APIKey: &service.APIKey{Group: group}}
+
+	fs := NewFailoverState(3, false)
+	fs.LastFailoverErr = &service.UpstreamFailoverError{StatusCode: http.StatusUnauthorized}
+	action, _ := h.tryModelFallback(c, zap.NewNop(), fs, newModelFallbackState(group, "claude-opus-4-1"), req)
+	require.Equal(t, modelFallbackUnavailable, action, "非过载错误不降级")
+
+	fs = NewFailoverState(1, false)
+	fs.SwitchCount = 1
+	action, _ = h.tryModelFallback(c, zap.NewNop(), fs, newModelFallbackState(group, "claude-opus-4-1"), req)
+	require.Equal(t, modelFallbackUnavailable, action, "切换预算耗尽")
+	require.Empty(t, service.ModelFallbackFromContext(c.Request.Context()))
+
+	fb := newModelFallbackState(group, "claude-haiku-4-5")
+	require.Equal(t, "claude-haiku-4-5", fb.scheduleModel("claude-haiku-4-5"), "未命中降级链时按请求模型选号")
+}
@@ -75,6 +75,10 @@ func (h *GatewayHandler) Responses(c *gin.Context) {
 		return
 	}
 	reqModel := modelResult.String()
+	if ephemeralKeyModelDenied(apiKey, reqModel) {
+		h.responsesErrorResponse(c, http.StatusForbidden, "permission_error", ephemeralKeyModelDeniedMessage)
+		return
+	}
 	reqStream := gjson.GetBytes(body, "stream").Bool()
 	reqLog = reqLog.With(zap.String("model", reqModel), zap.Bool("stream", reqStream))
 
@@ -97,6 +101,17 @@ func (h *GatewayHandler) Responses(c *gin.Context) {
 	if h.errorPassthroughService != nil {
 		service.BindErrorPassthroughService(c, h.errorPassthroughService)
 	}
+	if h.requestTransformService != nil {
+		service.BindRequestTransformService(c, h.requestTransformService)
+	}
+
+	// 内容护栏：命中 block 规则直接拒绝，redact 规则脱敏后再转发
+	guardedBody, guardrail := applyGuardrails(c, h.guardrailService, apiKey, body)
+	if guardrail.Blocked() {
+		h.responsesErrorResponse(c, http.StatusBadRequest, "invalid_request_error", guardrailBlockedMessage(guardrail))
+		return
+	}
+	body = guardedBody
 
 	subscription, _ := middleware2.GetSubscriptionFromContext(c)
 
@@ -160,6 +175,18 @@ func (h *GatewayHandler) Responses(c *gin.Context) {
 	}
 	sessionHash := h.gatewayService.GenerateSessionHashForGroup(parsedReq, apiKey.Group)
 
+	// 模型流量拆分：同一会话固定落到同一目标模型/账号，影子流量使用改写前的请求体
+	shadowBody := body
+	trafficSplit := apiKey.Group.ResolveTrafficSplit(reqModel, sessionHash)
+	if trafficSplit != nil {
+		c.Request = c.Request.WithContext(service.WithTrafficSplit(c.Request.Context(), trafficSplit))
+		if trafficSplit.ModelRewritten() {
+			body, parsedReq = h.gatewayService.ApplyTrafficSplitModel(trafficSplit, body, parsedReq, "responses")
+			reqModel = parsedReq.Model
+			reqLog = reqLog.With(zap.String("traffic_split_model", reqModel))
+		}
+	}
+
 	// 3. Account selection + failover loop
 	fs := NewFailoverState(h.maxAccountSwitches, false)
 
@@ -218,6 +245,7 @@ func (h *GatewayHandler) Responses(c *gin.Context) {
 		if accountReleaseFunc != nil {
 			accountReleaseFunc()
 		}
+		reportAccountScheduleResult(h.gatewayService, account.ID, result, err)
 
 		if err != nil {
 			var failoverErr *service.UpstreamFailoverError
@@ -253,10 +281,12 @@ func (h *GatewayHandler) Responses(c *gin.Context) {
 		inboundEndpoint := GetInboundEndpoint(c)
 		upstreamEndpoint := GetUpstreamEndpoint(c, account.Platform)
 
+		usageHook := service.UsageHookFromContext(c.Request.Context())
 		h.submitUsageRecordTask(func(ctx context.Context) {
 			if err := h.gatewayService.RecordUsage(ctx, &service.RecordUsageInput{
 				Result:             result,
 				APIKey:             apiKey,
+				UsageHook:          usageHook,
 				User:               apiKey.User,
 				Account:            account,
 				Subscription:       subscription,
@@ -266,6 +296,7 @@ func (h *GatewayHandler) Responses(c *gin.Context) {
 				IPAddress:          clientIP,
 				RequestPayloadHash: requestPayloadHash,
 				APIKeyService:      h.apiKeyService,
+				TrafficLabel:       trafficSplit.LabelForGroup(apiKey.GroupID),
 			}); err != nil {
 				reqLog.Error("gateway.responses.record_usage_failed",
 					zap.Int64("account_id", account.ID),
@@ -273,6 +304,21 @@ func (h *GatewayHandler) Responses(c *gin.Context) {
 				)
 			}
 		})
+
+		// 影子流量：异步复制到影子模型，仅记录对比用量
+		if trafficSplit != nil && trafficSplit.Shadow != nil {
+			h.gatewayService.SubmitTrafficShadow(&service.TrafficShadowInput{
+				Protocol:       "responses",
+				Decision:       trafficSplit,
+				APIKey:         apiKey,
+				Subscription:   subscription,
+				Body:           shadowBody,
+				Request:        c.Request,
+				PrimaryAccount: account.ID,
+				UserAgent:      userAgent,
+				IPAddress:      clientIP,
+			})
+		}
 		return
 	}
 }
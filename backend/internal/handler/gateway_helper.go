@@ -3,6 +3,7 @@ package handler
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math/rand/v2"
 	"net/http"
@@ -10,6 +11,7 @@ import (
 	"sync"
 	"time"
 
+	middleware2 "github.com/Wei-Shaw/sub2api/internal/server/middleware"
 	"github.com/Wei-Shaw/sub2api/internal/service"
 
 	"github.com/gin-gonic/gin"
@@ -292,7 +294,17 @@ func (h *ConcurrencyHelper) waitForSlotWithPingTimeout(c *gin.Context, slotType
 	ctx, cancel := context.WithTimeout(c.Request.Context(), timeout)
 	defer cancel()
 
+	// 分组级公平排队：账号槽位等待者按用户加权公平排队，仅轮到的票据才尝试抢占
+	fairQueue, fairTicket := h.enterFairQueue(c, slotType, id, timeout)
+	fairServed := false
+	if fairTicket != nil {
+		defer func() { fairQueue.Leave(fairTicket, fairServed) }()
+	}
+
 	acquireSlot := func() (*service.AcquireResult, error) {
+		if fairTicket != nil && !fairQueue.Ready(ctx, fairTicket, maxConcurrency, timeout) {
+			return &service.AcquireResult{}, nil
+		}
 		if slotType == "user" {
 			return h.concurrencyService.AcquireUserSlot(ctx, id, maxConcurrency)
 		}
@@ -305,6 +317,7 @@ func (h *ConcurrencyHelper) waitForSlotWithPingTimeout(c *gin.Context, slotType
 			return nil, err
 		}
 		if result.Acquired {
+			fairServed = true
 			return result.ReleaseFunc, nil
 		}
 	}
@@ -363,6 +376,7 @@ func (h *ConcurrencyHelper) waitForSlotWithPingTimeout(c *gin.Context, slotType
 			}
 
 			if result.Acquired {
+				fairServed = true
 				return result.ReleaseFunc, nil
 			}
 			backoff = nextBackoff(backoff)
@@ -371,6 +385,27 @@ func (h *ConcurrencyHelper) waitForSlotWithPingTimeout(c *gin.Context, slotType
 	}
 }
 
+// enterFairQueue 为账号槽位等待入队（仅在启用公平排队/优先级分类且请求属于某个分组时生效）
+func (h *ConcurrencyHelper) enterFairQueue(c *gin.Context, slotType string, accountID int64, timeout time.Duration) (*service.FairQueueService, *service.FairQueueTicket) {
+	if slotType != "account" {
+		return nil, nil
+	}
+	fairQueue := h.concurrencyService.FairQueue()
+	if fairQueue == nil {
+		return nil, nil
+	}
+	apiKey, ok := middleware2.GetAPIKeyFromContext(c)
+	if !ok || apiKey == nil || apiKey.GroupID == nil {
+		return nil, nil
+	}
+	ctx := c.Request.Context()
+	ticket := fairQueue.Enter(ctx, *apiKey.GroupID, accountID, apiKey.UserID, service.PriorityClassFromContext(ctx), timeout)
+	if ticket == nil {
+		return nil, nil
+	}
+	return fairQueue, ticket
+}
+
 // AcquireAccountSlotWithWaitTimeout acquires an account slot with a custom timeout (keeps SSE ping).
 func (h *ConcurrencyHelper) AcquireAccountSlotWithWaitTimeout(c *gin.Context, accountID int64, maxConcurrency int, timeout time.Duration, isStream bool, streamStarted *bool) (func(), error) {
 	return h.waitForSlotWithPingTimeout(c, "account", accountID, maxConcurrency, timeout, isStream, streamStarted, true)
@@ -398,3 +433,25 @@ func nextBackoff(current time.Duration) time.Duration {
 	}
 	return jittered
 }
+
+// reportAccountScheduleResult 将一次转发结果回报给账号调度器（用于 EWMA / P2C 等策略的运行时统计）。
+// 请求本身不合法或客户端主动取消的错误与账号健康度无关，不计入失败。
+func reportAccountScheduleResult(gatewayService *service.GatewayService, accountID int64, result *service.ForwardResult, err error) {
+	if gatewayService == nil {
+		return
+	}
+	if err == nil {
+		var firstTokenMs *int
+		if result != nil {
+			firstTokenMs = result.FirstTokenMs
+		}
+		gatewayService.ReportAccountScheduleResult(accountID, true, firstTokenMs)
+		return
+	}
+	var betaBlockedErr *service.BetaBlockedError
+	var promptTooLongErr *service.PromptTooLongError
+	if errors.Is(err, context.Canceled) || errors.As(err, &betaBlockedErr) || errors.As(err, &promptTooLongErr) {
+		return
+	}
+	gatewayService.ReportAccountScheduleResult(accountID, false, nil)
+}
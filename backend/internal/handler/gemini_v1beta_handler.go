@@ -163,6 +163,10 @@ func (h *GatewayHandler) GeminiV1BetaModels(c *gin.Context) {
 		googleError(c, http.StatusNotFound, err.Error())
 		return
 	}
+	if ephemeralKeyModelDenied(apiKey, modelName) {
+		googleError(c, http.StatusForbidden, ephemeralKeyModelDeniedMessage)
+		return
+	}
 
 	stream := action == "streamGenerateContent"
 	reqLog = reqLog.With(zap.String("model", modelName), zap.String("action", action), zap.Bool("stream", stream))
@@ -215,6 +219,17 @@ func (h *GatewayHandler) GeminiV1BetaModels(c *gin.Context) {
 	if h.errorPassthroughService != nil {
 		service.BindErrorPassthroughService(c, h.errorPassthroughService)
 	}
+	if h.requestTransformService != nil {
+		service.BindRequestTransformService(c, h.requestTransformService)
+	}
+
+	// 内容护栏：命中 block 规则直接拒绝，redact 规则脱敏后再转发
+	guardedBody, guardrail := applyGuardrails(c, h.guardrailService, apiKey, body)
+	if guardrail.Blocked() {
+		googleError(c, http.StatusBadRequest, guardrailBlockedMessage(guardrail))
+		return
+	}
+	body = guardedBody
 	userReleaseFunc, err := geminiConcurrency.AcquireUserSlotWithWait(c, authSubject.UserID, authSubject.Concurrency, stream, &streamStarted)
 	if err != nil {
 		reqLog.Warn("gemini.user_slot_acquire_failed", zap.Error(err))
@@ -465,6 +480,7 @@ func (h *GatewayHandler) GeminiV1BetaModels(c *gin.Context) {
 		if accountReleaseFunc != nil {
 			accountReleaseFunc()
 		}
+		reportAccountScheduleResult(h.gatewayService, account.ID, result, err)
 		if err != nil {
 			var failoverErr *service.UpstreamFailoverError
 			if errors.As(err, &failoverErr) {
@@ -507,10 +523,12 @@ func (h *GatewayHandler) GeminiV1BetaModels(c *gin.Context) {
 		requestPayloadHash := service.HashUsageRequestPayload(body)
 		inboundEndpoint := GetInboundEndpoint(c)
 		upstreamEndpoint := GetUpstreamEndpoint(c, account.Platform)
+		usageHook := service.UsageHookFromContext(c.Request.Context())
 		h.submitUsageRecordTask(func(ctx context.Context) {
 			if err := h.gatewayService.RecordUsageWithLongContext(ctx, &service.RecordUsageLongContextInput{
 				Result:                result,
 				APIKey:                apiKey,
+				UsageHook:             usageHook,
 				User:                  apiKey.User,
 				Account:               account,
 				Subscription:          subscription,
@@ -519,8 +537,8 @@ func (h *GatewayHandler) GeminiV1BetaModels(c *gin.Context) {
 				UserAgent:             userAgent,
 				IPAddress:             clientIP,
 				RequestPayloadHash:    requestPayloadHash,
-				LongContextThreshold:  200000, // Gemini 200K 阈值
-				LongContextMultiplier: 2.0,    // 超出部分双倍计费
+				LongContextThreshold:  service.GeminiLongContextThreshold,
+				LongContextMultiplier: service.GeminiLongContextMultiplier,
 				ForceCacheBilling:     fs.ForceCacheBilling,
 				APIKeyService:         h.apiKeyService,
 			}); err != nil {
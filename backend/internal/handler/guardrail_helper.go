@@ -0,0 +1,163 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/domain"
+	"github.com/Wei-Shaw/sub2api/internal/model"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/ctxkey"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/ip"
+	middleware2 "github.com/Wei-Shaw/sub2api/internal/server/middleware"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// opsGuardrailKey 护栏命中结果，供 OpsErrorLoggerMiddleware 记录 guardrail_violation 日志
+	opsGuardrailKey = "ops_guardrail"
+
+	opsErrTypeGuardrailViolation = "guardrail_violation"
+
+	guardrailViolationRecordTimeout = 3 * time.Second
+)
+
+// applyGuardrails 在转发前对请求体执行内容护栏扫描。
+// 返回应转发的请求体（redact 时为脱敏后的内容）；result.Blocked() 为 true 时调用方应直接拒绝请求。
+func applyGuardrails(c *gin.Context, svc *service.GuardrailService, apiKey *service.APIKey, body []byte) ([]byte, *service.GuardrailResult) {
+	if svc == nil || apiKey == nil {
+		return body, nil
+	}
+
+	scope := service.GuardrailScope{}
+	if apiKey.GroupID != nil {
+		scope.GroupID = *apiKey.GroupID
+	}
+	if apiKey.Group != nil {
+		scope.Platform = apiKey.Group.Platform
+	}
+
+	result := svc.Scan(scope, body)
+	if result == nil {
+		return body, nil
+	}
+
+	c.Set(opsGuardrailKey, result)
+	// 运维日志只保留脱敏后的请求体，避免敏感内容落库
+	c.Set(opsRequestBodyKey, result.LogBody)
+
+	userID := apiKey.UserID
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), guardrailViolationRecordTimeout)
+		defer cancel()
+		svc.RecordViolation(ctx, userID, result)
+	}()
+
+	return result.Body, result
+}
+
+// reparseRedactedGatewayRequest 脱敏后重新解析 Messages 请求，保证 Forward 使用的 ParsedRequest 与转发内容一致。
+// 解析失败时返回错误，调用方必须拒绝请求。
+func reparseRedactedGatewayRequest(body []byte, original *service.ParsedRequest) (*service.ParsedRequest, error) {
+	reparsed, err := service.ParseGatewayRequest(body, domain.PlatformAnthropic)
+	if err != nil {
+		return nil, fmt.Errorf("reparse redacted request: %w", err)
+	}
+	if original != nil {
+		reparsed.GroupID = original.GroupID
+	}
+	return reparsed, nil
+}
+
+// guardrailBlockedMessage 拦截时返回给客户端的错误信息（仅包含检测类别，不回显命中内容）
+func guardrailBlockedMessage(result *service.GuardrailResult) string {
+	seen := make(map[string]struct{})
+	labels := make([]string, 0, len(result.Matches))
+	for _, m := range result.Matches {
+		if m.Action != model.GuardrailActionBlock {
+			continue
+		}
+		if _, ok := seen[m.Label]; ok {
+			continue
+		}
+		seen[m.Label] = struct{}{}
+		labels = append(labels, m.Label)
+	}
+	return "Request blocked by content guardrail policy (" + strings.Join(labels, ", ") + ")"
+}
+
+// buildOpsGuardrailEntry 构造护栏命中的运维日志条目（拦截 / 脱敏 / 标记均记录）
+func buildOpsGuardrailEntry(c *gin.Context, status int, result *service.GuardrailResult) *service.OpsInsertErrorLogInput {
+	apiKey, _ := middleware2.GetAPIKeyFromContext(c)
+	clientRequestID, _ := c.Request.Context().Value(ctxkey.ClientRequestID).(string)
+
+	var modelName string
+	if v, ok := c.Get(opsModelKey); ok {
+		modelName, _ = v.(string)
+	}
+	stream := false
+	if v, ok := c.Get(opsStreamKey); ok {
+		stream, _ = v.(bool)
+	}
+
+	platform := resolveOpsPlatform(apiKey, guessPlatformFromPath(c.Request.URL.Path))
+	requestID := c.Writer.Header().Get("X-Request-Id")
+
+	entry := &service.OpsInsertErrorLogInput{
+		RequestID:       requestID,
+		ClientRequestID: clientRequestID,
+
+		Platform:         platform,
+		Model:            modelName,
+		RequestPath:      c.Request.URL.Path,
+		Stream:           stream,
+		InboundEndpoint:  GetInboundEndpoint(c),
+		UpstreamEndpoint: GetUpstreamEndpoint(c, platform),
+		RequestedModel:   modelName,
+		UserAgent:        c.GetHeader("User-Agent"),
+
+		ErrorPhase: "request",
+		ErrorType:  opsErrTypeGuardrailViolation,
+		Severity:   classifyOpsSeverity(opsErrTypeGuardrailViolation, status),
+		StatusCode: status,
+		// 护栏命中属于用户侧策略限制，不计入 SLA / 错误率
+		IsBusinessLimited: true,
+		IsCountTokens:     isCountTokensRequest(c),
+
+		ErrorMessage: truncateString("Guardrail "+result.Action+": "+result.Summary(), 2048),
+		ErrorSource:  "client_request",
+		ErrorOwner:   "client",
+
+		IsRetryable: false,
+		CreatedAt:   time.Now(),
+	}
+
+	if apiKey != nil {
+		entry.APIKeyID = &apiKey.ID
+		if apiKey.User != nil {
+			entry.UserID = &apiKey.User.ID
+		}
+		if apiKey.GroupID != nil {
+			entry.GroupID = apiKey.GroupID
+		}
+		if apiKey.Group != nil && apiKey.Group.Platform != "" {
+			entry.Platform = apiKey.Group.Platform
+		}
+	}
+
+	if clientIP := strings.TrimSpace(ip.GetClientIP(c)); clientIP != "" {
+		entry.ClientIP = &clientIP
+	}
+
+	// 错误体记录命中的规则与检测类别（不含命中原文）
+	if detail, err := json.Marshal(result); err == nil {
+		entry.ErrorBody = string(detail)
+	}
+
+	entry.RequestHeadersJSON = extractOpsRetryRequestHeaders(c)
+	attachOpsRequestBodyToEntry(c, entry)
+	return entry
+}
@@ -0,0 +1,130 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/model"
+	"github.com/Wei-Shaw/sub2api/internal/server/middleware"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	coderws "github.com/coder/websocket"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+)
+
+func TestReparseRedactedGatewayRequest(t *testing.T) {
+	groupID := int64(7)
+	original := &service.ParsedRequest{Model: "claude-sonnet-4-5", GroupID: &groupID}
+
+	reparsed, err := reparseRedactedGatewayRequest([]byte(`{"model":"claude-sonnet-4-5","messages":[{"role":"user","content":"[REDACTED]"}]}`), original)
+	require.NoError(t, err)
+	require.Equal(t, "claude-sonnet-4-5", reparsed.Model)
+	require.Equal(t, &groupID, reparsed.GroupID)
+
+	// 脱敏后的请求体无法解析时必须返回错误，不能回退到未脱敏的原始请求
+	for _, body := range []string{`{"model":`, `{"model":123}`, `{"model":"m","stream":"yes"}`} {
+		reparsed, err = reparseRedactedGatewayRequest([]byte(body), original)
+		require.Error(t, err, body)
+		require.Nil(t, reparsed)
+	}
+}
+
+type guardrailRepoStub struct {
+	service.GuardrailRepository
+	rules []*model.GuardrailRule
+}
+
+func (s *guardrailRepoStub) List(context.Context) ([]*model.GuardrailRule, error) {
+	return s.rules, nil
+}
+
+func newBlockingGuardrailService() *service.GuardrailService {
+	return service.NewGuardrailService(&guardrailRepoStub{rules: []*model.GuardrailRule{{
+		ID:       1,
+		Name:     "secret",
+		Enabled:  true,
+		Type:     model.GuardrailTypeKeyword,
+		Patterns: []string{"top-secret-token"},
+		Action:   model.GuardrailActionBlock,
+	}}}, nil)
+}
+
+func TestGatewayCountTokens_GuardrailBlocked(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	h := &GatewayHandler{guardrailService: newBlockingGuardrailService()}
+	groupID := int64(2)
+	apiKey := &service.APIKey{ID: 101, UserID: 1, GroupID: &groupID, User: &service.User{ID: 1}}
+
+	rec := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(rec)
+	body := `{"model":"claude-sonnet-4-5","messages":[{"role":"user","content":"my key is top-secret-token"}]}`
+	c.Request = httptest.NewRequest(http.MethodPost, "/v1/messages/count_tokens", strings.NewReader(body))
+	c.Set(string(middleware.ContextKeyAPIKey), apiKey)
+	c.Set(string(middleware.ContextKeyUser), middleware.AuthSubject{UserID: 1, Concurrency: 1})
+
+	h.CountTokens(c)
+
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	require.Equal(t, "invalid_request_error", gjson.Get(rec.Body.String(), "error.type").String())
+	require.Contains(t, gjson.Get(rec.Body.String(), "error.message").String(), "content guardrail policy")
+}
+
+func TestOpenAIResponsesWebSocket_GuardrailBlockedFirstMessage(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	h := newOpenAIHandlerForPreviousResponseIDValidation(t, nil)
+	h.guardrailService = newBlockingGuardrailService()
+	wsServer := newOpenAIWSHandlerTestServer(t, h, middleware.AuthSubject{UserID: 1, Concurrency: 1})
+	defer wsServer.Close()
+
+	dialCtx, cancelDial := context.WithTimeout(context.Background(), 3*time.Second)
+	clientConn, _, err := coderws.Dial(dialCtx, "ws"+strings.TrimPrefix(wsServer.URL, "http")+"/openai/v1/responses", nil)
+	cancelDial()
+	require.NoError(t, err)
+	defer func() {
+		_ = clientConn.CloseNow()
+	}()
+
+	writeCtx, cancelWrite := context.WithTimeout(context.Background(), 3*time.Second)
+	err = clientConn.Write(writeCtx, coderws.MessageText, []byte(
+		`{"type":"response.create","model":"gpt-5.1","input":"my key is top-secret-token"}`,
+	))
+	cancelWrite()
+	require.NoError(t, err)
+
+	readCtx, cancelRead := context.WithTimeout(context.Background(), 3*time.Second)
+	_, _, err = clientConn.Read(readCtx)
+	cancelRead()
+	var closeErr coderws.CloseError
+	require.ErrorAs(t, err, &closeErr)
+	require.Equal(t, coderws.StatusPolicyViolation, closeErr.Code)
+	require.Contains(t, closeErr.Reason, "content guardrail policy")
+}
+
+func TestOpenAIGuardOpenAIWSPayload(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	h := &OpenAIGatewayHandler{guardrailService: newBlockingGuardrailService()}
+	groupID := int64(2)
+	apiKey := &service.APIKey{ID: 101, UserID: 1, GroupID: &groupID}
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest(http.MethodGet, "/openai/v1/responses", nil)
+
+	// 后续请求帧命中 block 规则时返回 PolicyViolation 关闭错误
+	payload, err := h.guardOpenAIWSPayload(c, apiKey, []byte(`{"type":"response.create","model":"gpt-5.1","input":"top-secret-token"}`))
+	require.Nil(t, payload)
+	var closeErr *service.OpenAIWSClientCloseError
+	require.ErrorAs(t, err, &closeErr)
+	require.Equal(t, coderws.StatusPolicyViolation, closeErr.StatusCode())
+
+	clean := []byte(`{"type":"response.create","model":"gpt-5.1","input":"hello"}`)
+	payload, err = h.guardOpenAIWSPayload(c, apiKey, clean)
+	require.NoError(t, err)
+	require.Equal(t, clean, payload)
+}
@@ -29,10 +29,17 @@ type AdminHandlers struct {
 	Usage                  *admin.UsageHandler
 	UserAttribute          *admin.UserAttributeHandler
 	ErrorPassthrough       *admin.ErrorPassthroughHandler
+	RequestTransform       *admin.RequestTransformHandler
+	GatewayPlugin          *admin.GatewayPluginHandler
+	Guardrail              *admin.GuardrailHandler
+	ProxyPool              *admin.ProxyPoolHandler
 	APIKey                 *admin.AdminAPIKeyHandler
 	ScheduledTest          *admin.ScheduledTestHandler
 	ChannelMonitor         *admin.ChannelMonitorHandler
 	ChannelMonitorTemplate *admin.ChannelMonitorRequestTemplateHandler
+	Postpaid               *admin.PostpaidHandler
+	ReferralWithdrawal     *admin.ReferralWithdrawalHandler
+	BillingRecompute       *admin.BillingRecomputeHandler
 }
 
 // Handlers contains all HTTP handlers
@@ -54,6 +61,7 @@ type Handlers struct {
 	Setting        *SettingHandler
 	Totp           *TotpHandler
 	ChannelMonitor *ChannelMonitorUserHandler
+	Postpaid       *PostpaidHandler
 }
 
 // BuildInfo contains build-time information
@@ -72,6 +72,10 @@ func (h *OpenAIGatewayHandler) ChatCompletions(c *gin.Context) {
 		return
 	}
 	reqModel := modelResult.String()
+	if ephemeralKeyModelDenied(apiKey, reqModel) {
+		h.errorResponse(c, http.StatusForbidden, "permission_error", ephemeralKeyModelDeniedMessage)
+		return
+	}
 	reqStream := gjson.GetBytes(body, "stream").Bool()
 
 	reqLog = reqLog.With(zap.String("model", reqModel), zap.Bool("stream", reqStream))
@@ -82,6 +86,17 @@ func (h *OpenAIGatewayHandler) ChatCompletions(c *gin.Context) {
 	if h.errorPassthroughService != nil {
 		service.BindErrorPassthroughService(c, h.errorPassthroughService)
 	}
+	if h.requestTransformService != nil {
+		service.BindRequestTransformService(c, h.requestTransformService)
+	}
+
+	// 内容护栏：命中 block 规则直接拒绝，redact 规则脱敏后再转发
+	guardedBody, guardrail := applyGuardrails(c, h.guardrailService, apiKey, body)
+	if guardrail.Blocked() {
+		h.errorResponse(c, http.StatusBadRequest, "invalid_request_error", guardrailBlockedMessage(guardrail))
+		return
+	}
+	body = guardedBody
 
 	subscription, _ := middleware2.GetSubscriptionFromContext(c)
 
@@ -255,10 +270,12 @@ func (h *OpenAIGatewayHandler) ChatCompletions(c *gin.Context) {
 		userAgent := c.GetHeader("User-Agent")
 		clientIP := ip.GetClientIP(c)
 
+		usageHook := service.UsageHookFromContext(c.Request.Context())
 		h.submitUsageRecordTask(func(ctx context.Context) {
 			if err := h.gatewayService.RecordUsage(ctx, &service.OpenAIRecordUsageInput{
 				Result:           result,
 				APIKey:           apiKey,
+				UsageHook:        usageHook,
 				User:             apiKey.User,
 				Account:          account,
 				Subscription:     subscription,
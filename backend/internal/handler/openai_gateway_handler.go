@@ -12,6 +12,8 @@ import (
 	"time"
 
 	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/Wei-Shaw/sub2api/internal/model"
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
 	pkghttputil "github.com/Wei-Shaw/sub2api/internal/pkg/httputil"
 	"github.com/Wei-Shaw/sub2api/internal/pkg/ip"
 	"github.com/Wei-Shaw/sub2api/internal/pkg/logger"
@@ -22,6 +24,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
 	"go.uber.org/zap"
 )
 
@@ -32,6 +35,8 @@ type OpenAIGatewayHandler struct {
 	apiKeyService           *service.APIKeyService
 	usageRecordWorkerPool   *service.UsageRecordWorkerPool
 	errorPassthroughService *service.ErrorPassthroughService
+	requestTransformService *service.RequestTransformService
+	guardrailService        *service.GuardrailService
 	concurrencyHelper       *ConcurrencyHelper
 	maxAccountSwitches      int
 	cfg                     *config.Config
@@ -55,6 +60,8 @@ func NewOpenAIGatewayHandler(
 	apiKeyService *service.APIKeyService,
 	usageRecordWorkerPool *service.UsageRecordWorkerPool,
 	errorPassthroughService *service.ErrorPassthroughService,
+	requestTransformService *service.RequestTransformService,
+	guardrailService *service.GuardrailService,
 	cfg *config.Config,
 ) *OpenAIGatewayHandler {
 	pingInterval := time.Duration(0)
@@ -71,6 +78,8 @@ func NewOpenAIGatewayHandler(
 		apiKeyService:           apiKeyService,
 		usageRecordWorkerPool:   usageRecordWorkerPool,
 		errorPassthroughService: errorPassthroughService,
+		requestTransformService: requestTransformService,
+		guardrailService:        guardrailService,
 		concurrencyHelper:       NewConcurrencyHelper(concurrencyService, SSEPingFormatComment, pingInterval),
 		maxAccountSwitches:      maxAccountSwitches,
 		cfg:                     cfg,
@@ -157,6 +166,10 @@ func (h *OpenAIGatewayHandler) Responses(c *gin.Context) {
 		return
 	}
 	reqModel := modelResult.String()
+	if ephemeralKeyModelDenied(apiKey, reqModel) {
+		h.errorResponse(c, http.StatusForbidden, "permission_error", ephemeralKeyModelDeniedMessage)
+		return
+	}
 
 	streamResult := gjson.GetBytes(body, "stream")
 	if streamResult.Exists() && streamResult.Type != gjson.True && streamResult.Type != gjson.False {
@@ -194,6 +207,17 @@ func (h *OpenAIGatewayHandler) Responses(c *gin.Context) {
 	if h.errorPassthroughService != nil {
 		service.BindErrorPassthroughService(c, h.errorPassthroughService)
 	}
+	if h.requestTransformService != nil {
+		service.BindRequestTransformService(c, h.requestTransformService)
+	}
+
+	// 内容护栏：命中 block 规则直接拒绝，redact 规则脱敏后再转发
+	guardedBody, guardrail := applyGuardrails(c, h.guardrailService, apiKey, body)
+	if guardrail.Blocked() {
+		h.errorResponse(c, http.StatusBadRequest, "invalid_request_error", guardrailBlockedMessage(guardrail))
+		return
+	}
+	body = guardedBody
 
 	// Get subscription info (may be nil)
 	subscription, _ := middleware2.GetSubscriptionFromContext(c)
@@ -284,11 +308,23 @@ func (h *OpenAIGatewayHandler) Responses(c *gin.Context) {
 		// Forward request
 		service.SetOpsLatencyMs(c, service.OpsRoutingLatencyMsKey, time.Since(routingStart).Milliseconds())
 		forwardStart := time.Now()
-		result, err := h.gatewayService.Forward(c.Request.Context(), c, account, body)
+		result, servedAccount, err := h.gatewayService.ForwardHedged(c.Request.Context(), c, account, service.OpenAIHedgeRequest{
+			Group:          apiKey.Group,
+			RequestedModel: reqModel,
+			Stream:         reqStream,
+			ExcludedIDs:    failedAccountIDs,
+		}, func(ctx context.Context, fc *gin.Context, target *service.Account) (*service.OpenAIForwardResult, error) {
+			return h.gatewayService.Forward(ctx, fc, target, body)
+		})
 		forwardDurationMs := time.Since(forwardStart).Milliseconds()
 		if accountReleaseFunc != nil {
 			accountReleaseFunc()
 		}
+		if servedAccount != nil && servedAccount.ID != account.ID {
+			// 对冲请求胜出：计费与调度回报以实际输出响应的账号为准
+			account = servedAccount
+			setOpsSelectedAccount(c, account.ID, account.Platform)
+		}
 		upstreamLatencyMs, _ := getContextInt64(c, service.OpsUpstreamLatencyMsKey)
 		responseLatencyMs := forwardDurationMs
 		if upstreamLatencyMs > 0 && forwardDurationMs > upstreamLatencyMs {
@@ -366,10 +402,12 @@ func (h *OpenAIGatewayHandler) Responses(c *gin.Context) {
 		requestPayloadHash := service.HashUsageRequestPayload(body)
 
 		// 使用量记录通过有界 worker 池提交，避免请求热路径创建无界 goroutine。
+		usageHook := service.UsageHookFromContext(c.Request.Context())
 		h.submitUsageRecordTask(func(ctx context.Context) {
 			if err := h.gatewayService.RecordUsage(ctx, &service.OpenAIRecordUsageInput{
 				Result:             result,
 				APIKey:             apiKey,
+				UsageHook:          usageHook,
 				User:               apiKey.User,
 				Account:            account,
 				Subscription:       subscription,
@@ -543,6 +581,10 @@ func (h *OpenAIGatewayHandler) Messages(c *gin.Context) {
 		return
 	}
 	reqModel := modelResult.String()
+	if ephemeralKeyModelDenied(apiKey, reqModel) {
+		h.anthropicErrorResponse(c, http.StatusForbidden, "permission_error", ephemeralKeyModelDeniedMessage)
+		return
+	}
 	reqStream := gjson.GetBytes(body, "stream").Bool()
 
 	reqLog = reqLog.With(zap.String("model", reqModel), zap.Bool("stream", reqStream))
@@ -554,6 +596,17 @@ func (h *OpenAIGatewayHandler) Messages(c *gin.Context) {
 	if h.errorPassthroughService != nil {
 		service.BindErrorPassthroughService(c, h.errorPassthroughService)
 	}
+	if h.requestTransformService != nil {
+		service.BindRequestTransformService(c, h.requestTransformService)
+	}
+
+	// 内容护栏：命中 block 规则直接拒绝，redact 规则脱敏后再转发
+	guardedBody, guardrail := applyGuardrails(c, h.guardrailService, apiKey, body)
+	if guardrail.Blocked() {
+		h.anthropicErrorResponse(c, http.StatusBadRequest, "invalid_request_error", guardrailBlockedMessage(guardrail))
+		return
+	}
+	body = guardedBody
 
 	subscription, _ := middleware2.GetSubscriptionFromContext(c)
 
@@ -674,12 +727,24 @@ func (h *OpenAIGatewayHandler) Messages(c *gin.Context) {
 		// Forward 层需要始终拿到 group 默认映射模型，这样未命中账号级映射的
 		// Claude 兼容模型才不会在后续 Codex 规范化中意外退化到 gpt-5.1。
 		defaultMappedModel := resolveOpenAIForwardDefaultMappedModel(apiKey, c.GetString("openai_messages_fallback_model"))
-		result, err := h.gatewayService.ForwardAsAnthropic(c.Request.Context(), c, account, body, promptCacheKey, defaultMappedModel)
+		result, servedAccount, err := h.gatewayService.ForwardHedged(c.Request.Context(), c, account, service.OpenAIHedgeRequest{
+			Group:          apiKey.Group,
+			RequestedModel: reqModel,
+			Stream:         reqStream,
+			ExcludedIDs:    failedAccountIDs,
+		}, func(ctx context.Context, fc *gin.Context, target *service.Account) (*service.OpenAIForwardResult, error) {
+			return h.gatewayService.ForwardAsAnthropic(ctx, fc, target, body, promptCacheKey, defaultMappedModel)
+		})
 
 		forwardDurationMs := time.Since(forwardStart).Milliseconds()
 		if accountReleaseFunc != nil {
 			accountReleaseFunc()
 		}
+		if servedAccount != nil && servedAccount.ID != account.ID {
+			// 对冲请求胜出：计费与调度回报以实际输出响应的账号为准
+			account = servedAccount
+			setOpsSelectedAccount(c, account.ID, account.Platform)
+		}
 		upstreamLatencyMs, _ := getContextInt64(c, service.OpsUpstreamLatencyMsKey)
 		responseLatencyMs := forwardDurationMs
 		if upstreamLatencyMs > 0 && forwardDurationMs > upstreamLatencyMs {
@@ -747,10 +812,12 @@ func (h *OpenAIGatewayHandler) Messages(c *gin.Context) {
 		clientIP := ip.GetClientIP(c)
 		requestPayloadHash := service.HashUsageRequestPayload(body)
 
+		usageHook := service.UsageHookFromContext(c.Request.Context())
 		h.submitUsageRecordTask(func(ctx context.Context) {
 			if err := h.gatewayService.RecordUsage(ctx, &service.OpenAIRecordUsageInput{
 				Result:             result,
 				APIKey:             apiKey,
+				UsageHook:          usageHook,
 				User:               apiKey.User,
 				Account:            account,
 				Subscription:       subscription,
@@ -1081,12 +1148,27 @@ func (h *OpenAIGatewayHandler) ResponsesWebSocket(c *gin.Context) {
 		closeOpenAIClientWS(wsConn, coderws.StatusPolicyViolation, "invalid JSON payload")
 		return
 	}
+	// 网关插件与内容护栏：首个请求在选号前检查，后续请求帧通过 InspectPayload 逐帧检查
+	firstMessage, err = h.inspectOpenAIWSPayload(c, apiKey, firstMessage)
+	if err != nil {
+		var closeErr *service.OpenAIWSClientCloseError
+		if errors.As(err, &closeErr) {
+			closeOpenAIClientWS(wsConn, closeErr.StatusCode(), closeErr.Reason())
+			return
+		}
+		closeOpenAIClientWS(wsConn, coderws.StatusInternalError, "failed to process request")
+		return
+	}
 
 	reqModel := strings.TrimSpace(gjson.GetBytes(firstMessage, "model").String())
 	if reqModel == "" {
 		closeOpenAIClientWS(wsConn, coderws.StatusPolicyViolation, "model is required in first response.create payload")
 		return
 	}
+	if ephemeralKeyModelDenied(apiKey, reqModel) {
+		closeOpenAIClientWS(wsConn, coderws.StatusPolicyViolation, ephemeralKeyModelDeniedMessage)
+		return
+	}
 	previousResponseID := strings.TrimSpace(gjson.GetBytes(firstMessage, "previous_response_id").String())
 	previousResponseIDKind := service.ClassifyOpenAIPreviousResponseIDKind(previousResponseID)
 	if previousResponseID != "" && previousResponseIDKind == service.OpenAIPreviousResponseIDKindMessageID {
@@ -1206,6 +1288,7 @@ func (h *OpenAIGatewayHandler) ResponsesWebSocket(c *gin.Context) {
 		zap.Int("candidate_count", scheduleDecision.CandidateCount),
 	)
 
+	usageHook := service.UsageHookFromContext(ctx)
 	hooks := &service.OpenAIWSIngressHooks{
 		BeforeTurn: func(turn int) error {
 			if turn == 1 {
@@ -1238,6 +1321,9 @@ func (h *OpenAIGatewayHandler) ResponsesWebSocket(c *gin.Context) {
 			currentAccountRelease = wrapReleaseOnDone(ctx, accountReleaseFunc)
 			return nil
 		},
+		InspectPayload: func(payload []byte) ([]byte, error) {
+			return h.inspectOpenAIWSPayload(c, apiKey, payload)
+		},
 		AfterTurn: func(turn int, result *service.OpenAIForwardResult, turnErr error) {
 			releaseTurnSlots()
 			if turnErr != nil || result == nil {
@@ -1260,6 +1346,7 @@ func (h *OpenAIGatewayHandler) ResponsesWebSocket(c *gin.Context) {
 					IPAddress:          clientIP,
 					RequestPayloadHash: service.HashUsageRequestPayload(firstMessage),
 					APIKeyService:      h.apiKeyService,
+					UsageHook:          usageHook,
 				}); err != nil {
 					reqLog.Error("openai.websocket_record_usage_failed",
 						zap.Int64("account_id", account.ID),
@@ -1291,6 +1378,66 @@ func (h *OpenAIGatewayHandler) ResponsesWebSocket(c *gin.Context) {
 	reqLog.Info("openai.websocket_ingress_closed", zap.Int64("account_id", account.ID))
 }
 
+// inspectOpenAIWSPayload 依次对 WS 请求帧执行网关插件钩子与内容护栏，顺序与 HTTP 请求经过的中间件、handler 一致。
+func (h *OpenAIGatewayHandler) inspectOpenAIWSPayload(c *gin.Context, apiKey *service.APIKey, payload []byte) ([]byte, error) {
+	payload, err := applyOpenAIWSPluginHooks(c, apiKey, payload)
+	if err != nil {
+		return nil, err
+	}
+	return h.guardOpenAIWSPayload(c, apiKey, payload)
+}
+
+// applyOpenAIWSPluginHooks 对 WS 请求帧执行握手时挂载的网关插件 on_request / on_route 钩子。
+// 连接建立后账号已绑定，on_route 返回的分组切换在 WS 上不生效，仅改写模型。
+func applyOpenAIWSPluginHooks(c *gin.Context, apiKey *service.APIKey, payload []byte) ([]byte, error) {
+	ctx := c.Request.Context()
+	session := service.GatewayPluginSessionFromContext(ctx)
+	if !session.HasHook(model.PluginHookOnRequest) && !session.HasHook(model.PluginHookOnRoute) {
+		return payload, nil
+	}
+	platform := service.PlatformOpenAI
+	if apiKey != nil && apiKey.Group != nil && apiKey.Group.Platform != "" {
+		platform = apiKey.Group.Platform
+	}
+
+	req := &service.GatewayPluginRequest{
+		Platform: platform,
+		Method:   c.Request.Method,
+		Path:     c.Request.URL.Path,
+		Model:    gjson.GetBytes(payload, "model").String(),
+		Header:   c.Request.Header,
+		Body:     payload,
+	}
+	rejection, err := session.OnRequest(ctx, req)
+	if err != nil {
+		return nil, service.NewOpenAIWSClientCloseError(coderws.StatusInternalError, infraerrors.Message(err), err)
+	}
+	if rejection != nil {
+		return nil, service.NewOpenAIWSClientCloseError(coderws.StatusPolicyViolation, rejection.Message, nil)
+	}
+	payload = req.Body
+
+	decision, err := session.OnRoute(ctx, platform, gjson.GetBytes(payload, "model").String())
+	if err != nil {
+		return nil, service.NewOpenAIWSClientCloseError(coderws.StatusInternalError, infraerrors.Message(err), err)
+	}
+	if decision.Model != "" && gjson.GetBytes(payload, "model").Exists() {
+		if next, setErr := sjson.SetBytes(payload, "model", decision.Model); setErr == nil {
+			payload = next
+		}
+	}
+	return payload, nil
+}
+
+// guardOpenAIWSPayload 对 WS 请求帧执行内容护栏：命中 block 规则返回关闭错误，redact 规则返回脱敏后的请求帧。
+func (h *OpenAIGatewayHandler) guardOpenAIWSPayload(c *gin.Context, apiKey *service.APIKey, payload []byte) ([]byte, error) {
+	guarded, guardrail := applyGuardrails(c, h.guardrailService, apiKey, payload)
+	if guardrail.Blocked() {
+		return nil, service.NewOpenAIWSClientCloseError(coderws.StatusPolicyViolation, guardrailBlockedMessage(guardrail), nil)
+	}
+	return guarded, nil
+}
+
 func (h *OpenAIGatewayHandler) recoverResponsesPanic(c *gin.Context, streamStarted *bool) {
 	recovered := recover()
 	if recovered == nil {
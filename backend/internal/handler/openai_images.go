@@ -83,6 +83,10 @@ func (h *OpenAIGatewayHandler) handleImages(c *gin.Context, endpoint string) {
 		h.errorResponse(c, http.StatusBadRequest, "invalid_request_error", "model is required")
 		return
 	}
+	if ephemeralKeyModelDenied(apiKey, reqModel) {
+		h.errorResponse(c, http.StatusForbidden, "permission_error", ephemeralKeyModelDeniedMessage)
+		return
+	}
 	reqLog = reqLog.With(zap.String("model", reqModel), zap.String("image_size", imageSize))
 
 	setOpsRequestContext(c, reqModel, false, body)
@@ -91,6 +95,17 @@ func (h *OpenAIGatewayHandler) handleImages(c *gin.Context, endpoint string) {
 	if h.errorPassthroughService != nil {
 		service.BindErrorPassthroughService(c, h.errorPassthroughService)
 	}
+	if h.requestTransformService != nil {
+		service.BindRequestTransformService(c, h.requestTransformService)
+	}
+
+	// 内容护栏：命中 block 规则直接拒绝，redact 规则脱敏后再转发
+	guardedBody, guardrail := applyGuardrails(c, h.guardrailService, apiKey, body)
+	if guardrail.Blocked() {
+		h.errorResponse(c, http.StatusBadRequest, "invalid_request_error", guardrailBlockedMessage(guardrail))
+		return
+	}
+	body = guardedBody
 
 	subscription, _ := middleware2.GetSubscriptionFromContext(c)
 
@@ -240,10 +255,12 @@ func (h *OpenAIGatewayHandler) handleImages(c *gin.Context, endpoint string) {
 		clientIP := ip.GetClientIP(c)
 		requestPayloadHash := service.HashUsageRequestPayload(body)
 
+		usageHook := service.UsageHookFromContext(c.Request.Context())
 		h.submitUsageRecordTask(func(ctx context.Context) {
 			if err := h.gatewayService.RecordUsage(ctx, &service.OpenAIRecordUsageInput{
 				Result:             result,
 				APIKey:             apiKey,
+				UsageHook:          usageHook,
 				User:               apiKey.User,
 				Account:            account,
 				Subscription:       subscription,
@@ -480,6 +480,18 @@ func OpsErrorLoggerMiddleware(ops *service.OpsService) gin.HandlerFunc {
 		}
 
 		status := c.Writer.Status()
+
+		// 护栏命中（拦截 / 脱敏 / 标记）单独记录一条 guardrail_violation 日志；
+		// 被拦截的请求不再按普通 4xx 错误重复记录。
+		if v, ok := c.Get(opsGuardrailKey); ok {
+			if result, ok := v.(*service.GuardrailResult); ok && result != nil {
+				enqueueOpsErrorLog(ops, buildOpsGuardrailEntry(c, status, result))
+				if result.Blocked() {
+					return
+				}
+			}
+		}
+
 		if status < 400 {
 			// Even when the client request succeeds, we still want to persist upstream error attempts
 			// (retries/failover) so ops can observe upstream instability that gets "covered" by retries.
@@ -1090,7 +1102,8 @@ func isKnownOpsErrorType(t string) bool {
 		"overloaded_error",
 		"api_error",
 		"not_found_error",
-		"forbidden_error":
+		"forbidden_error",
+		opsErrTypeGuardrailViolation:
 		return true
 	}
 	return false
@@ -1129,7 +1142,7 @@ func classifyOpsPhase(errType, message, code string) string {
 			return "request"
 		}
 		return "upstream"
-	case "invalid_request_error":
+	case "invalid_request_error", opsErrTypeGuardrailViolation:
 		return "request"
 	case "upstream_error", "overloaded_error":
 		return "upstream"
@@ -1147,6 +1160,9 @@ func classifyOpsSeverity(errType string, status int) string {
 	switch errType {
 	case "invalid_request_error", "authentication_error", "billing_error", "subscription_error":
 		return "P3"
+	case opsErrTypeGuardrailViolation:
+		// 数据外泄风险需要关注，无论是否拦截
+		return "P2"
 	}
 	if status >= 500 {
 		return "P1"
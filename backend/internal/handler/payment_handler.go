@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"net/http"
 	"strconv"
 	"strings"
 
@@ -15,13 +16,15 @@ import (
 type PaymentHandler struct {
 	paymentService *service.PaymentService
 	configService  *service.PaymentConfigService
+	invoiceService *service.InvoiceService
 }
 
 // NewPaymentHandler creates a new PaymentHandler.
-func NewPaymentHandler(paymentService *service.PaymentService, configService *service.PaymentConfigService) *PaymentHandler {
+func NewPaymentHandler(paymentService *service.PaymentService, configService *service.PaymentConfigService, invoiceService *service.InvoiceService) *PaymentHandler {
 	return &PaymentHandler{
 		paymentService: paymentService,
 		configService:  configService,
+		invoiceService: invoiceService,
 	}
 }
 
@@ -59,6 +62,7 @@ func (h *PaymentHandler) GetPlans(c *gin.Context) {
 		ProductName   string   `json:"product_name"`
 		ForSale       bool     `json:"for_sale"`
 		SortOrder     int      `json:"sort_order"`
+		AutoRenew     bool     `json:"auto_renew_available"`
 	}
 	platformMap := h.configService.GetGroupPlatformMap(c.Request.Context(), plans)
 	result := make([]planWithPlatform, 0, len(plans))
@@ -68,6 +72,7 @@ func (h *PaymentHandler) GetPlans(c *gin.Context) {
 			Name: p.Name, Description: p.Description, Price: p.Price, OriginalPrice: p.OriginalPrice,
 			ValidityDays: p.ValidityDays, ValidityUnit: p.ValidityUnit, Features: p.Features,
 			ProductName: p.ProductName, ForSale: p.ForSale, SortOrder: p.SortOrder,
+			AutoRenew: p.StripePriceID != "",
 		})
 	}
 	response.Success(c, result)
@@ -113,7 +118,7 @@ func (h *PaymentHandler) GetCheckoutInfo(c *gin.Context) {
 			ModelScopes: gi.ModelScopes,
 			Name:        p.Name, Description: p.Description, Price: p.Price, OriginalPrice: p.OriginalPrice,
 			ValidityDays: p.ValidityDays, ValidityUnit: p.ValidityUnit, Features: parseFeatures(p.Features),
-			ProductName: p.ProductName,
+			ProductName: p.ProductName, AutoRenew: p.StripePriceID != "",
 		})
 	}
 
@@ -162,6 +167,7 @@ type checkoutPlan struct {
 	ValidityUnit    string   `json:"validity_unit"`
 	Features        []string `json:"features"`
 	ProductName     string   `json:"product_name"`
+	AutoRenew       bool     `json:"auto_renew_available"`
 }
 
 // parseFeatures splits a newline-separated features string into a string slice.
@@ -198,6 +204,8 @@ type CreateOrderRequest struct {
 	PaymentType string  `json:"payment_type" binding:"required"`
 	OrderType   string  `json:"order_type"`
 	PlanID      int64   `json:"plan_id"`
+	AutoRenew   bool    `json:"auto_renew"`
+	CouponCode  string  `json:"coupon_code"`
 }
 
 // CreateOrder creates a new payment order.
@@ -224,6 +232,8 @@ func (h *PaymentHandler) CreateOrder(c *gin.Context) {
 		SrcURL:      c.Request.Referer(),
 		OrderType:   req.OrderType,
 		PlanID:      req.PlanID,
+		AutoRenew:   req.AutoRenew,
+		CouponCode:  req.CouponCode,
 	})
 	if err != nil {
 		response.ErrorFrom(c, err)
@@ -232,6 +242,42 @@ func (h *PaymentHandler) CreateOrder(c *gin.Context) {
 	response.Success(c, result)
 }
 
+// PreviewCouponRequest is the request body for previewing a checkout coupon.
+type PreviewCouponRequest struct {
+	Code      string  `json:"code" binding:"required"`
+	Amount    float64 `json:"amount"`
+	OrderType string  `json:"order_type"`
+	PlanID    int64   `json:"plan_id"`
+}
+
+// PreviewCoupon validates a checkout coupon and returns the discount it would give.
+// POST /api/v1/payment/coupons/preview
+func (h *PaymentHandler) PreviewCoupon(c *gin.Context) {
+	subject, ok := requireAuth(c)
+	if !ok {
+		return
+	}
+
+	var req PreviewCouponRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+
+	preview, err := h.paymentService.PreviewCoupon(c.Request.Context(), service.CreateOrderRequest{
+		UserID:     subject.UserID,
+		Amount:     req.Amount,
+		OrderType:  req.OrderType,
+		PlanID:     req.PlanID,
+		CouponCode: req.Code,
+	})
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, preview)
+}
+
 // GetMyOrders returns the authenticated user's orders.
 // GET /api/v1/payment/orders/my
 func (h *PaymentHandler) GetMyOrders(c *gin.Context) {
@@ -277,6 +323,56 @@ func (h *PaymentHandler) GetOrder(c *gin.Context) {
 	response.Success(c, order)
 }
 
+// GetMyInvoices returns the authenticated user's invoices and credit notes.
+// GET /api/v1/payment/invoices
+func (h *PaymentHandler) GetMyInvoices(c *gin.Context) {
+	subject, ok := requireAuth(c)
+	if !ok {
+		return
+	}
+
+	page, pageSize := response.ParsePagination(c)
+	invoices, total, err := h.invoiceService.ListInvoices(c.Request.Context(), service.InvoiceListParams{
+		UserID:   subject.UserID,
+		Kind:     c.Query("kind"),
+		Page:     page,
+		PageSize: pageSize,
+	})
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Paginated(c, invoices, int64(total), page, pageSize)
+}
+
+// DownloadInvoice renders one of the authenticated user's invoices as PDF (default) or HTML.
+// GET /api/v1/payment/invoices/:id/download?format=pdf|html
+func (h *PaymentHandler) DownloadInvoice(c *gin.Context) {
+	subject, ok := requireAuth(c)
+	if !ok {
+		return
+	}
+
+	invoiceID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid invoice ID")
+		return
+	}
+
+	inv, err := h.invoiceService.GetInvoice(c.Request.Context(), invoiceID, subject.UserID)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	rendered, err := h.invoiceService.RenderInvoice(c.Request.Context(), inv, c.Query("format"))
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	c.Header("Content-Disposition", "attachment; filename="+rendered.Filename)
+	c.Data(http.StatusOK, rendered.ContentType, rendered.Data)
+}
+
 // CancelOrder cancels a pending order for the authenticated user.
 // POST /api/v1/payment/orders/:id/cancel
 func (h *PaymentHandler) CancelOrder(c *gin.Context) {
@@ -299,6 +395,43 @@ func (h *PaymentHandler) CancelOrder(c *gin.Context) {
 	response.Success(c, gin.H{"message": msg})
 }
 
+// ListAutoRenewals returns the authenticated user's subscription auto-renewals.
+// GET /api/v1/subscriptions/auto-renewals
+func (h *PaymentHandler) ListAutoRenewals(c *gin.Context) {
+	subject, ok := requireAuth(c)
+	if !ok {
+		return
+	}
+
+	renewals, err := h.paymentService.ListUserAutoRenewals(c.Request.Context(), subject.UserID)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, renewals)
+}
+
+// CancelAutoRenewal turns off auto-renew for one of the user's subscriptions.
+// POST /api/v1/subscriptions/auto-renewals/:id/cancel
+func (h *PaymentHandler) CancelAutoRenewal(c *gin.Context) {
+	subject, ok := requireAuth(c)
+	if !ok {
+		return
+	}
+
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid auto-renewal ID")
+		return
+	}
+
+	if err := h.paymentService.CancelAutoRenewal(c.Request.Context(), id, subject.UserID); err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, gin.H{"message": "auto-renew cancelled"})
+}
+
 // RefundRequestBody is the request body for requesting a refund.
 type RefundRequestBody struct {
 	Reason string `json:"reason"`
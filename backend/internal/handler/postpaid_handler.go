@@ -0,0 +1,54 @@
+package handler
+
+import (
+	"github.com/Wei-Shaw/sub2api/internal/pkg/response"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// PostpaidHandler exposes a postpaid user's account terms and billing statements.
+type PostpaidHandler struct {
+	postpaidService *service.PostpaidService
+}
+
+// NewPostpaidHandler creates a new PostpaidHandler
+func NewPostpaidHandler(postpaidService *service.PostpaidService) *PostpaidHandler {
+	return &PostpaidHandler{postpaidService: postpaidService}
+}
+
+// GetAccount returns the authenticated user's postpaid account (404 for prepaid users).
+// GET /api/v1/user/postpaid
+func (h *PostpaidHandler) GetAccount(c *gin.Context) {
+	subject, ok := requireAuth(c)
+	if !ok {
+		return
+	}
+	account, err := h.postpaidService.GetAccount(c.Request.Context(), subject.UserID)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, account)
+}
+
+// ListStatements returns the authenticated user's billing statements.
+// GET /api/v1/user/postpaid/statements
+func (h *PostpaidHandler) ListStatements(c *gin.Context) {
+	subject, ok := requireAuth(c)
+	if !ok {
+		return
+	}
+	page, pageSize := response.ParsePagination(c)
+	statements, total, err := h.postpaidService.ListStatements(c.Request.Context(), service.BillingStatementListParams{
+		UserID:   subject.UserID,
+		Status:   c.Query("status"),
+		Page:     page,
+		PageSize: pageSize,
+	})
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Paginated(c, statements, int64(total), page, pageSize)
+}
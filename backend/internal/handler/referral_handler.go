@@ -12,15 +12,21 @@ import (
 
 // ReferralHandler handles referral-related requests
 type ReferralHandler struct {
-	referralService *service.ReferralService
-	settingService  *service.SettingService
+	referralService   *service.ReferralService
+	settingService    *service.SettingService
+	commissionService *service.ReferralCommissionService
 }
 
 // NewReferralHandler creates a new ReferralHandler
-func NewReferralHandler(referralService *service.ReferralService, settingService *service.SettingService) *ReferralHandler {
+func NewReferralHandler(
+	referralService *service.ReferralService,
+	settingService *service.SettingService,
+	commissionService *service.ReferralCommissionService,
+) *ReferralHandler {
 	return &ReferralHandler{
-		referralService: referralService,
-		settingService:  settingService,
+		referralService:   referralService,
+		settingService:    settingService,
+		commissionService: commissionService,
 	}
 }
 
@@ -47,6 +53,27 @@ type ReferralRewardResponse struct {
 	CreatedAt    string  `json:"created_at"`
 }
 
+// CommissionSummaryResponse represents the state of the user's commission account
+type CommissionSummaryResponse struct {
+	Enabled           bool    `json:"enabled"`
+	MinWithdrawAmount float64 `json:"min_withdraw_amount"`
+	HoldingDays       int     `json:"holding_days"`
+	Total             float64 `json:"total"`
+	Held              float64 `json:"held"`
+	Withdrawing       float64 `json:"withdrawing"`
+	Withdrawn         float64 `json:"withdrawn"`
+	ClawedBack        float64 `json:"clawed_back"`
+	Available         float64 `json:"available"`
+}
+
+// CreateWithdrawalRequest is the request body for a commission withdrawal
+type CreateWithdrawalRequest struct {
+	Amount        float64 `json:"amount" binding:"required,gt=0"`
+	PayoutMethod  string  `json:"payout_method" binding:"required"`
+	PayoutAccount string  `json:"payout_account" binding:"required"`
+	PayoutName    string  `json:"payout_name"`
+}
+
 // GetReferralInfo returns the user's referral information
 // GET /api/v1/user/referral
 func (h *ReferralHandler) GetReferralInfo(c *gin.Context) {
@@ -148,3 +175,110 @@ func (h *ReferralHandler) GetReferralSettings(c *gin.Context) {
 		"commission_rate": settings.CommissionRate,
 	})
 }
+
+// GetCommissionSummary returns the user's commission account
+// GET /api/v1/referral/commission
+func (h *ReferralHandler) GetCommissionSummary(c *gin.Context) {
+	subject, ok := requireAuth(c)
+	if !ok {
+		return
+	}
+	summary, err := h.commissionService.GetSummary(c.Request.Context(), subject.UserID)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, CommissionSummaryResponse{
+		Enabled:           summary.Enabled,
+		MinWithdrawAmount: summary.MinWithdrawAmount,
+		HoldingDays:       summary.HoldingDays,
+		Total:             summary.Total,
+		Held:              summary.Held,
+		Withdrawing:       summary.Withdrawing,
+		Withdrawn:         summary.Withdrawn,
+		ClawedBack:        summary.ClawedBack,
+		Available:         summary.Available,
+	})
+}
+
+// ListCommissions returns the user's commission ledger
+// GET /api/v1/referral/commissions
+func (h *ReferralHandler) ListCommissions(c *gin.Context) {
+	subject, ok := requireAuth(c)
+	if !ok {
+		return
+	}
+	page, pageSize := response.ParsePagination(c)
+	items, total, err := h.commissionService.ListCommissions(c.Request.Context(), subject.UserID, page, pageSize)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Paginated(c, items, int64(total), page, pageSize)
+}
+
+// ListWithdrawals returns the user's withdrawal requests
+// GET /api/v1/referral/withdrawals
+func (h *ReferralHandler) ListWithdrawals(c *gin.Context) {
+	subject, ok := requireAuth(c)
+	if !ok {
+		return
+	}
+	page, pageSize := response.ParsePagination(c)
+	items, total, err := h.commissionService.ListWithdrawals(c.Request.Context(), service.CommissionWithdrawalListParams{
+		UserID:   subject.UserID,
+		Status:   c.Query("status"),
+		Page:     page,
+		PageSize: pageSize,
+	})
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Paginated(c, items, int64(total), page, pageSize)
+}
+
+// CreateWithdrawal requests a payout of withdrawable commission
+// POST /api/v1/referral/withdrawals
+func (h *ReferralHandler) CreateWithdrawal(c *gin.Context) {
+	subject, ok := requireAuth(c)
+	if !ok {
+		return
+	}
+	var req CreateWithdrawalRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "Invalid request: "+err.Error())
+		return
+	}
+	withdrawal, err := h.commissionService.RequestWithdrawal(c.Request.Context(), subject.UserID, service.CommissionWithdrawalInput{
+		Amount:        req.Amount,
+		PayoutMethod:  req.PayoutMethod,
+		PayoutAccount: req.PayoutAccount,
+		PayoutName:    req.PayoutName,
+	})
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, withdrawal)
+}
+
+// CancelWithdrawal cancels one of the user's pending withdrawal requests
+// POST /api/v1/referral/withdrawals/:id/cancel
+func (h *ReferralHandler) CancelWithdrawal(c *gin.Context) {
+	subject, ok := requireAuth(c)
+	if !ok {
+		return
+	}
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		response.BadRequest(c, "Invalid withdrawal ID")
+		return
+	}
+	withdrawal, err := h.commissionService.CancelWithdrawal(c.Request.Context(), subject.UserID, id)
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Success(c, withdrawal)
+}
@@ -11,17 +11,19 @@ import (
 
 // UserHandler handles user-related requests
 type UserHandler struct {
-	userService  *service.UserService
-	emailService *service.EmailService
-	emailCache   service.EmailCache
+	userService    *service.UserService
+	emailService   *service.EmailService
+	emailCache     service.EmailCache
+	balanceBuckets *service.BalanceBucketService
 }
 
 // NewUserHandler creates a new UserHandler
-func NewUserHandler(userService *service.UserService, emailService *service.EmailService, emailCache service.EmailCache) *UserHandler {
+func NewUserHandler(userService *service.UserService, emailService *service.EmailService, emailCache service.EmailCache, balanceBuckets *service.BalanceBucketService) *UserHandler {
 	return &UserHandler{
-		userService:  userService,
-		emailService: emailService,
-		emailCache:   emailCache,
+		userService:    userService,
+		emailService:   emailService,
+		emailCache:     emailCache,
+		balanceBuckets: balanceBuckets,
 	}
 }
 
@@ -56,6 +58,24 @@ func (h *UserHandler) GetProfile(c *gin.Context) {
 	response.Success(c, dto.UserFromService(userData))
 }
 
+// GetBalanceBuckets returns the current user's balance buckets (source, remaining, expiry)
+// GET /api/v1/user/balance-buckets
+func (h *UserHandler) GetBalanceBuckets(c *gin.Context) {
+	subject, ok := middleware2.GetAuthSubjectFromContext(c)
+	if !ok {
+		response.Unauthorized(c, "User not authenticated")
+		return
+	}
+
+	page, pageSize := response.ParsePagination(c)
+	buckets, total, err := h.balanceBuckets.ListByUser(c.Request.Context(), subject.UserID, page, pageSize, c.Query("status"))
+	if err != nil {
+		response.ErrorFrom(c, err)
+		return
+	}
+	response.Paginated(c, dto.BalanceBucketsFromService(buckets), total, page, pageSize)
+}
+
 // ChangePassword handles changing user password
 // POST /api/v1/users/me/password
 func (h *UserHandler) ChangePassword(c *gin.Context) {
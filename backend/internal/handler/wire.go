@@ -32,10 +32,17 @@ func ProvideAdminHandlers(
 	usageHandler *admin.UsageHandler,
 	userAttributeHandler *admin.UserAttributeHandler,
 	errorPassthroughHandler *admin.ErrorPassthroughHandler,
+	requestTransformHandler *admin.RequestTransformHandler,
+	gatewayPluginHandler *admin.GatewayPluginHandler,
+	guardrailHandler *admin.GuardrailHandler,
+	proxyPoolHandler *admin.ProxyPoolHandler,
 	apiKeyHandler *admin.AdminAPIKeyHandler,
 	scheduledTestHandler *admin.ScheduledTestHandler,
 	channelMonitorHandler *admin.ChannelMonitorHandler,
 	channelMonitorTemplateHandler *admin.ChannelMonitorRequestTemplateHandler,
+	postpaidHandler *admin.PostpaidHandler,
+	referralWithdrawalHandler *admin.ReferralWithdrawalHandler,
+	billingRecomputeHandler *admin.BillingRecomputeHandler,
 ) *AdminHandlers {
 	return &AdminHandlers{
 		Dashboard:              dashboardHandler,
@@ -61,10 +68,17 @@ func ProvideAdminHandlers(
 		Usage:                  usageHandler,
 		UserAttribute:          userAttributeHandler,
 		ErrorPassthrough:       errorPassthroughHandler,
+		RequestTransform:       requestTransformHandler,
+		GatewayPlugin:          gatewayPluginHandler,
+		Guardrail:              guardrailHandler,
+		ProxyPool:              proxyPoolHandler,
 		APIKey:                 apiKeyHandler,
 		ScheduledTest:          scheduledTestHandler,
 		ChannelMonitor:         channelMonitorHandler,
 		ChannelMonitorTemplate: channelMonitorTemplateHandler,
+		Postpaid:               postpaidHandler,
+		ReferralWithdrawal:     referralWithdrawalHandler,
+		BillingRecompute:       billingRecomputeHandler,
 	}
 }
 
@@ -97,6 +111,7 @@ func ProvideHandlers(
 	settingHandler *SettingHandler,
 	totpHandler *TotpHandler,
 	channelMonitorUserHandler *ChannelMonitorUserHandler,
+	postpaidHandler *PostpaidHandler,
 	_ *service.IdempotencyCoordinator,
 	_ *service.IdempotencyCleanupService,
 ) *Handlers {
@@ -118,6 +133,7 @@ func ProvideHandlers(
 		Setting:        settingHandler,
 		Totp:           totpHandler,
 		ChannelMonitor: channelMonitorUserHandler,
+		Postpaid:       postpaidHandler,
 	}
 }
 
@@ -137,6 +153,7 @@ var ProviderSet = wire.NewSet(
 	NewPaygHandler,
 	NewPaymentHandler,
 	NewPaymentWebhookHandler,
+	NewPostpaidHandler,
 	NewTotpHandler,
 	NewChannelMonitorUserHandler,
 	ProvideSettingHandler,
@@ -165,6 +182,13 @@ var ProviderSet = wire.NewSet(
 	admin.NewUsageHandler,
 	admin.NewUserAttributeHandler,
 	admin.NewErrorPassthroughHandler,
+	admin.NewRequestTransformHandler,
+	admin.NewGatewayPluginHandler,
+	admin.NewGuardrailHandler,
+	admin.NewPostpaidHandler,
+	admin.NewReferralWithdrawalHandler,
+	admin.NewBillingRecomputeHandler,
+	admin.NewProxyPoolHandler,
 	admin.NewAdminAPIKeyHandler,
 	admin.NewScheduledTestHandler,
 	admin.NewChannelMonitorHandler,
@@ -0,0 +1,66 @@
+package model
+
+import "time"
+
+// 计费重算模式
+const (
+	BillingRecomputeModeCurrent   = "current"   // 按当前价格重放，用于价格修正后的核对
+	BillingRecomputeModeCandidate = "candidate" // 按候选价格 / 倍率模拟
+)
+
+// CandidateModelPricing 候选模型价格（USD / 百万 token），未设置的项沿用当前价格
+type CandidateModelPricing struct {
+	InputPerMTok           *float64 `json:"input_per_mtok,omitempty"`
+	OutputPerMTok          *float64 `json:"output_per_mtok,omitempty"`
+	CacheCreationPerMTok   *float64 `json:"cache_creation_per_mtok,omitempty"`
+	CacheCreation1hPerMTok *float64 `json:"cache_creation_1h_per_mtok,omitempty"`
+	CacheReadPerMTok       *float64 `json:"cache_read_per_mtok,omitempty"`
+}
+
+// BillingRecomputeParams 计费重算参数
+type BillingRecomputeParams struct {
+	StartTime time.Time `json:"start_time"`
+	EndTime   time.Time `json:"end_time"`
+	UserID    int64     `json:"user_id,omitempty"`
+	GroupID   int64     `json:"group_id,omitempty"`
+	Model     string    `json:"model,omitempty"`
+
+	// Pricing 按模型名（小写）覆盖价格
+	Pricing map[string]CandidateModelPricing `json:"pricing,omitempty"`
+	// RateMultiplier 覆盖所有请求记录的倍率
+	RateMultiplier *float64 `json:"rate_multiplier,omitempty"`
+	// GroupRateMultipliers 按分组覆盖倍率，优先于 RateMultiplier
+	GroupRateMultipliers map[int64]float64 `json:"group_rate_multipliers,omitempty"`
+}
+
+// BillingRecomputeLine 报告中某一维度（用户 / 分组 / 模型）的汇总
+type BillingRecomputeLine struct {
+	ID             int64   `json:"id,omitempty"`  // 用户 / 分组 ID（无分组为 0）
+	Key            string  `json:"key,omitempty"` // 模型名
+	Requests       int64   `json:"requests"`
+	ChangedRows    int64   `json:"changed_rows"`
+	OriginalCost   float64 `json:"original_cost"`
+	RecomputedCost float64 `json:"recomputed_cost"`
+	Diff           float64 `json:"diff"`
+	// 余额计费部分（订阅计费不影响余额）
+	BalanceRows int64   `json:"balance_rows"`
+	BalanceDiff float64 `json:"balance_diff"`
+}
+
+// BillingRecomputeReport 计费重算差异报告
+type BillingRecomputeReport struct {
+	ScannedRows    int64   `json:"scanned_rows"`
+	ChangedRows    int64   `json:"changed_rows"`
+	SkippedRows    int64   `json:"skipped_rows"` // 图片计费或找不到价格的记录
+	OriginalCost   float64 `json:"original_cost"`
+	RecomputedCost float64 `json:"recomputed_cost"`
+	Diff           float64 `json:"diff"`
+	BalanceDiff    float64 `json:"balance_diff"`
+
+	// 各维度按差额绝对值降序
+	Users  []BillingRecomputeLine `json:"users"`
+	Groups []BillingRecomputeLine `json:"groups"`
+	Models []BillingRecomputeLine `json:"models"`
+	// MissingPricingModels 找不到价格而跳过的模型
+	MissingPricingModels []string `json:"missing_pricing_models,omitempty"`
+}
@@ -0,0 +1,103 @@
+package model
+
+import (
+	"strings"
+	"time"
+)
+
+// 插件钩子名称（WASM 模块导出的函数名）
+const (
+	PluginHookOnRequest       = "on_request"
+	PluginHookOnRoute         = "on_route"
+	PluginHookOnResponseChunk = "on_response_chunk"
+	PluginHookOnUsage         = "on_usage"
+)
+
+// PluginHooks 所有支持的插件钩子（按调用顺序）
+var PluginHooks = []string{PluginHookOnRequest, PluginHookOnRoute, PluginHookOnResponseChunk, PluginHookOnUsage}
+
+// 插件失败处理模式
+const (
+	PluginFailureModeOpen   = "open"   // 插件出错时跳过该插件继续处理请求
+	PluginFailureModeClosed = "closed" // 插件出错时拒绝请求
+)
+
+// 插件资源限制
+const (
+	PluginDefaultTimeoutMs     = 50
+	PluginMaxTimeoutMs         = 5000
+	PluginDefaultMemoryLimitMB = 16
+	PluginMaxMemoryLimitMB     = 256
+	PluginMaxModuleSize        = 16 << 20
+)
+
+// GatewayPlugin 网关 WASM 插件
+// 插件按分组挂载，按优先级顺序在请求/路由/响应/用量各阶段执行
+type GatewayPlugin struct {
+	ID            int64     `json:"id"`
+	Name          string    `json:"name"`            // 插件名称
+	Enabled       bool      `json:"enabled"`         // 是否启用
+	Priority      int       `json:"priority"`        // 优先级（数字越小越先执行）
+	GroupIDs      []int64   `json:"group_ids"`       // 挂载的分组（空表示全部分组）
+	FailureMode   string    `json:"failure_mode"`    // open/closed
+	TimeoutMs     int       `json:"timeout_ms"`      // 单次钩子调用超时（毫秒）
+	MemoryLimitMB int       `json:"memory_limit_mb"` // 单个实例内存上限（MB）
+	Hooks         []string  `json:"hooks"`           // 模块导出的钩子（上传时检测）
+	ModuleSHA256  string    `json:"module_sha256"`   // 模块摘要
+	ModuleSize    int       `json:"module_size"`     // 模块大小（字节）
+	Module        []byte    `json:"-"`               // WASM 模块二进制
+	Description   *string   `json:"description"`     // 插件描述
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// HasHook 判断插件是否实现了指定钩子
+func (p *GatewayPlugin) HasHook(hook string) bool {
+	for _, h := range p.Hooks {
+		if h == hook {
+			return true
+		}
+	}
+	return false
+}
+
+// AppliesToGroup 判断插件是否挂载到指定分组
+func (p *GatewayPlugin) AppliesToGroup(groupID int64) bool {
+	if len(p.GroupIDs) == 0 {
+		return true
+	}
+	for _, id := range p.GroupIDs {
+		if id == groupID {
+			return true
+		}
+	}
+	return false
+}
+
+// FailClosed 判断插件出错时是否拒绝请求
+func (p *GatewayPlugin) FailClosed() bool {
+	return p.FailureMode == PluginFailureModeClosed
+}
+
+// Validate 验证插件配置的有效性（不校验模块内容，由运行时编译时校验）
+func (p *GatewayPlugin) Validate() error {
+	if strings.TrimSpace(p.Name) == "" {
+		return &ValidationError{Field: "name", Message: "name is required"}
+	}
+	if p.FailureMode != PluginFailureModeOpen && p.FailureMode != PluginFailureModeClosed {
+		return &ValidationError{Field: "failure_mode", Message: "failure_mode must be 'open' or 'closed'"}
+	}
+	if p.TimeoutMs <= 0 || p.TimeoutMs > PluginMaxTimeoutMs {
+		return &ValidationError{Field: "timeout_ms", Message: "timeout_ms must be between 1 and 5000"}
+	}
+	if p.MemoryLimitMB <= 0 || p.MemoryLimitMB > PluginMaxMemoryLimitMB {
+		return &ValidationError{Field: "memory_limit_mb", Message: "memory_limit_mb must be between 1 and 256"}
+	}
+	if len(p.Module) == 0 {
+		return &ValidationError{Field: "module", Message: "module is required"}
+	}
+	if len(p.Module) > PluginMaxModuleSize {
+		return &ValidationError{Field: "module", Message: "module exceeds 16MB limit"}
+	}
+	return nil
+}
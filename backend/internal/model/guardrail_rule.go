@@ -0,0 +1,118 @@
+package model
+
+import (
+	"regexp"
+	"strings"
+	"time"
+)
+
+// GuardrailRule 内容护栏规则
+// 在请求转发到上游前扫描消息文本，命中后按动作拦截、脱敏或仅标记
+type GuardrailRule struct {
+	ID            int64     `json:"id"`
+	Name          string    `json:"name"`           // 规则名称
+	Enabled       bool      `json:"enabled"`        // 是否启用
+	Priority      int       `json:"priority"`       // 优先级（数字越小越先评估）
+	Platforms     []string  `json:"platforms"`      // 适用平台（空表示全部）
+	GroupIDs      []int64   `json:"group_ids"`      // 适用分组（空表示全部）
+	Type          string    `json:"type"`           // 匹配类型：regex / keyword / builtin
+	Patterns      []string  `json:"patterns"`       // 正则表达式 / 关键词 / 内置检测器名称
+	CaseSensitive bool      `json:"case_sensitive"` // 关键词与正则是否区分大小写
+	Action        string    `json:"action"`         // 命中动作：block / redact / flag
+	Description   *string   `json:"description"`    // 规则描述
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// 护栏匹配类型
+const (
+	GuardrailTypeRegex   = "regex"
+	GuardrailTypeKeyword = "keyword"
+	GuardrailTypeBuiltin = "builtin"
+)
+
+// 护栏命中动作（严重程度：block > redact > flag）
+const (
+	GuardrailActionBlock  = "block"  // 拒绝请求
+	GuardrailActionRedact = "redact" // 将命中内容替换为占位符后继续转发
+	GuardrailActionFlag   = "flag"   // 仅记录，不修改请求
+)
+
+// 内置检测器
+const (
+	GuardrailDetectorAPIKey     = "api_key"     // 常见云厂商 / AI 平台密钥
+	GuardrailDetectorEmail      = "email"       // 邮箱地址
+	GuardrailDetectorPhone      = "phone"       // 手机号（中国大陆 / E.164 国际格式）
+	GuardrailDetectorCreditCard = "credit_card" // 银行卡号（Luhn 校验）
+	GuardrailDetectorCNID       = "cn_id"       // 中国居民身份证号（校验位校验）
+)
+
+// GuardrailDetectors 所有内置检测器名称
+var GuardrailDetectors = []string{
+	GuardrailDetectorAPIKey,
+	GuardrailDetectorEmail,
+	GuardrailDetectorPhone,
+	GuardrailDetectorCreditCard,
+	GuardrailDetectorCNID,
+}
+
+// IsGuardrailDetector 判断是否为已知的内置检测器
+func IsGuardrailDetector(name string) bool {
+	for _, d := range GuardrailDetectors {
+		if d == name {
+			return true
+		}
+	}
+	return false
+}
+
+// GuardrailActionSeverity 返回动作的严重程度，用于多条规则命中时取最严格的动作
+func GuardrailActionSeverity(action string) int {
+	switch action {
+	case GuardrailActionBlock:
+		return 3
+	case GuardrailActionRedact:
+		return 2
+	case GuardrailActionFlag:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Validate 验证规则配置的有效性
+func (r *GuardrailRule) Validate() error {
+	if strings.TrimSpace(r.Name) == "" {
+		return &ValidationError{Field: "name", Message: "name is required"}
+	}
+	if GuardrailActionSeverity(r.Action) == 0 {
+		return &ValidationError{Field: "action", Message: "action must be one of block, redact, flag"}
+	}
+	if len(r.Patterns) == 0 {
+		return &ValidationError{Field: "patterns", Message: "at least one pattern is required"}
+	}
+	for _, p := range r.Patterns {
+		if strings.TrimSpace(p) == "" {
+			return &ValidationError{Field: "patterns", Message: "pattern cannot be empty"}
+		}
+	}
+
+	switch r.Type {
+	case GuardrailTypeRegex:
+		for _, p := range r.Patterns {
+			if _, err := regexp.Compile(p); err != nil {
+				return &ValidationError{Field: "patterns", Message: "invalid regex " + p + ": " + err.Error()}
+			}
+		}
+	case GuardrailTypeKeyword:
+	case GuardrailTypeBuiltin:
+		for _, p := range r.Patterns {
+			if !IsGuardrailDetector(p) {
+				return &ValidationError{Field: "patterns", Message: "unknown detector " + p + ", must be one of " + strings.Join(GuardrailDetectors, ", ")}
+			}
+		}
+	default:
+		return &ValidationError{Field: "type", Message: "type must be one of regex, keyword, builtin"}
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MaxModelFallbackSteps 单条降级链的最大步数
+const MaxModelFallbackSteps = 5
+
+// ModelFallbackChain 分组级模型降级链
+// 命中 ModelPattern 的请求在过载（429/503/529）或无可调度账号时，按 Steps 顺序依次改用降级模型重试；
+// 每次降级消耗一次账号切换预算。
+type ModelFallbackChain struct {
+	ModelPattern string              `json:"model_pattern"` // 匹配的请求模型（支持末尾 *）
+	Steps        []ModelFallbackStep `json:"steps"`         // 降级步骤，按顺序尝试
+}
+
+// ModelFallbackStep 模型降级步骤
+type ModelFallbackStep struct {
+	Model   string `json:"model"`              // 降级使用的模型
+	GroupID *int64 `json:"group_id,omitempty"` // 可选：在其他分组调度（OpenAI 分组经 Responses 转换转发）
+}
+
+// Validate 验证降级链配置的有效性
+func (c *ModelFallbackChain) Validate() error {
+	if strings.TrimSpace(c.ModelPattern) == "" {
+		return &ValidationError{Field: "model_pattern", Message: "model_pattern is required"}
+	}
+	if len(c.Steps) == 0 {
+		return &ValidationError{Field: "steps", Message: "at least one step is required"}
+	}
+	if len(c.Steps) > MaxModelFallbackSteps {
+		return &ValidationError{Field: "steps", Message: fmt.Sprintf("at most %d steps are allowed", MaxModelFallbackSteps)}
+	}
+	for _, step := range c.Steps {
+		if strings.TrimSpace(step.Model) == "" {
+			return &ValidationError{Field: "steps", Message: "step model is required"}
+		}
+		if step.GroupID != nil && *step.GroupID <= 0 {
+			return &ValidationError{Field: "steps", Message: "step group_id must be positive"}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+package model
+
+// 代理池成员权重范围
+const (
+	ProxyPoolMinWeight     = 1
+	ProxyPoolMaxWeight     = 100
+	ProxyPoolDefaultWeight = 1
+)
+
+// ProxyPoolMember 代理池成员
+// 账号的出口代理按权重从健康成员中粘性选择：权重越大，被分配到的账号越多。
+type ProxyPoolMember struct {
+	ProxyID int64 `json:"proxy_id"`
+	Weight  int   `json:"weight"`
+}
+
+// NormalizeProxyPoolMembers 规范化成员列表：去除重复/非法代理 ID，权重越界时取默认值或截断
+func NormalizeProxyPoolMembers(members []ProxyPoolMember) []ProxyPoolMember {
+	out := make([]ProxyPoolMember, 0, len(members))
+	seen := make(map[int64]struct{}, len(members))
+	for _, m := range members {
+		if m.ProxyID <= 0 {
+			continue
+		}
+		if _, ok := seen[m.ProxyID]; ok {
+			continue
+		}
+		seen[m.ProxyID] = struct{}{}
+		switch {
+		case m.Weight < ProxyPoolMinWeight:
+			m.Weight = ProxyPoolDefaultWeight
+		case m.Weight > ProxyPoolMaxWeight:
+			m.Weight = ProxyPoolMaxWeight
+		}
+		out = append(out, m)
+	}
+	return out
+}
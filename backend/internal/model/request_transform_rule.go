@@ -0,0 +1,132 @@
+package model
+
+import (
+	"encoding/json"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+)
+
+// RequestTransformRule 请求体/请求头改写规则
+// 按优先级顺序对转发到上游的请求依次应用所有命中的规则
+type RequestTransformRule struct {
+	ID               int64                    `json:"id"`
+	Name             string                   `json:"name"`              // 规则名称
+	Enabled          bool                     `json:"enabled"`           // 是否启用
+	Priority         int                      `json:"priority"`          // 优先级（数字越小越先应用）
+	Platforms        []string                 `json:"platforms"`         // 适用平台（仅 anthropic / openai，空表示两者）
+	GroupIDs         []int64                  `json:"group_ids"`         // 适用分组（空表示全部）
+	AccountIDs       []int64                  `json:"account_ids"`       // 适用账号（空表示全部）
+	ModelPatterns    []string                 `json:"model_patterns"`    // 适用模型（支持末尾 *，空表示全部）
+	BodyOperations   []RequestBodyOperation   `json:"body_operations"`   // 请求体 JSON 操作（按顺序执行）
+	HeaderOperations []RequestHeaderOperation `json:"header_operations"` // 上游请求头操作
+	Description      *string                  `json:"description"`       // 规则描述
+	CreatedAt        time.Time                `json:"created_at"`
+	UpdatedAt        time.Time                `json:"updated_at"`
+}
+
+// 请求体操作类型
+const (
+	BodyOpSet     = "set"     // 设置 path 为 value（覆盖）
+	BodyOpDelete  = "delete"  // 删除 path
+	BodyOpRename  = "rename"  // 将 path 的值移动到 to
+	BodyOpDefault = "default" // path 不存在时设置为 value
+)
+
+// 请求头操作类型
+const (
+	HeaderOpSet    = "set"
+	HeaderOpRemove = "remove"
+)
+
+// RequestBodyOperation 请求体 JSON 操作，path 使用 sjson/gjson 路径语法（如 "metadata.user_id"）
+type RequestBodyOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	To    string          `json:"to,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// RequestHeaderOperation 上游请求头操作
+type RequestHeaderOperation struct {
+	Op    string `json:"op"`
+	Name  string `json:"name"`
+	Value string `json:"value,omitempty"`
+}
+
+// RequestTransformPlatforms 支持请求改写的平台
+// Gemini / Antigravity 请求在转发时会转换为各自的上游协议，改写规则无法作用于其请求体，因此不支持
+func RequestTransformPlatforms() []string {
+	return []string{PlatformAnthropic, PlatformOpenAI}
+}
+
+// protectedTransformHeaders 不允许通过规则改写的请求头（认证、签名与传输层头部）
+var protectedTransformHeaders = map[string]struct{}{
+	"Authorization":     {},
+	"X-Api-Key":         {},
+	"X-Goog-Api-Key":    {},
+	"Host":              {},
+	"Content-Type":      {},
+	"Content-Length":    {},
+	"Transfer-Encoding": {},
+	"Connection":        {},
+}
+
+// protectedTransformHeaderPrefix Bedrock SigV4 签名头（X-Amz-Date、X-Amz-Security-Token 等）在规则之前签名，改写会使签名失效
+const protectedTransformHeaderPrefix = "X-Amz-"
+
+// IsProtectedTransformHeader 判断请求头是否受保护（规则不可改写）
+func IsProtectedTransformHeader(name string) bool {
+	key := http.CanonicalHeaderKey(strings.TrimSpace(name))
+	if strings.HasPrefix(key, protectedTransformHeaderPrefix) {
+		return true
+	}
+	_, ok := protectedTransformHeaders[key]
+	return ok
+}
+
+// Validate 验证规则配置的有效性
+func (r *RequestTransformRule) Validate() error {
+	if strings.TrimSpace(r.Name) == "" {
+		return &ValidationError{Field: "name", Message: "name is required"}
+	}
+	for _, p := range r.Platforms {
+		if !slices.Contains(RequestTransformPlatforms(), strings.ToLower(strings.TrimSpace(p))) {
+			return &ValidationError{Field: "platforms", Message: "request transforms only support platforms: " + strings.Join(RequestTransformPlatforms(), ", ")}
+		}
+	}
+	if len(r.BodyOperations) == 0 && len(r.HeaderOperations) == 0 {
+		return &ValidationError{Field: "operations", Message: "at least one body or header operation is required"}
+	}
+	for _, op := range r.BodyOperations {
+		if strings.TrimSpace(op.Path) == "" {
+			return &ValidationError{Field: "body_operations", Message: "path is required"}
+		}
+		switch op.Op {
+		case BodyOpSet, BodyOpDefault:
+			if len(op.Value) == 0 || !json.Valid(op.Value) {
+				return &ValidationError{Field: "body_operations", Message: "value must be valid JSON for " + op.Op}
+			}
+		case BodyOpRename:
+			if strings.TrimSpace(op.To) == "" {
+				return &ValidationError{Field: "body_operations", Message: "to is required for rename"}
+			}
+		case BodyOpDelete:
+		default:
+			return &ValidationError{Field: "body_operations", Message: "op must be one of set, delete, rename, default"}
+		}
+	}
+	for _, op := range r.HeaderOperations {
+		if strings.TrimSpace(op.Name) == "" {
+			return &ValidationError{Field: "header_operations", Message: "name is required"}
+		}
+		if IsProtectedTransformHeader(op.Name) {
+			return &ValidationError{Field: "header_operations", Message: "header " + op.Name + " cannot be modified"}
+		}
+		if op.Op != HeaderOpSet && op.Op != HeaderOpRemove {
+			return &ValidationError{Field: "header_operations", Message: "op must be 'set' or 'remove'"}
+		}
+	}
+	return nil
+}
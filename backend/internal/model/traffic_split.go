@@ -0,0 +1,76 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+)
+
+// TrafficSplitRule 分组级模型流量拆分规则
+// 命中 ModelPattern 的请求按会话哈希确定性地分配到 Targets 之一（同一会话始终落在同一目标），
+// 并可按 Shadow.Percent 采样异步复制到影子模型（影子响应不返回给客户端）。
+type TrafficSplitRule struct {
+	ModelPattern string               `json:"model_pattern"`    // 匹配的请求模型（支持末尾 *）
+	Targets      []TrafficSplitTarget `json:"targets"`          // 拆分目标，权重之和必须为 100
+	Shadow       *TrafficShadowConfig `json:"shadow,omitempty"` // 可选：影子流量
+}
+
+// TrafficSplitTarget 流量拆分目标
+type TrafficSplitTarget struct {
+	Label      string  `json:"label,omitempty"`       // 报表标签（为空时使用 Model，再为空使用 target-N）
+	Model      string  `json:"model,omitempty"`       // 改写后的模型（为空表示保持请求模型）
+	AccountIDs []int64 `json:"account_ids,omitempty"` // 限定账号（为空表示分组内任意账号）
+	Percent    int     `json:"percent"`               // 流量百分比
+}
+
+// TrafficShadowConfig 影子流量配置
+type TrafficShadowConfig struct {
+	Model      string  `json:"model"`                 // 影子请求使用的模型
+	AccountIDs []int64 `json:"account_ids,omitempty"` // 限定账号（为空表示分组内任意账号）
+	Percent    int     `json:"percent"`               // 采样百分比（1-100）
+}
+
+// Validate 验证规则配置的有效性
+func (r *TrafficSplitRule) Validate() error {
+	if strings.TrimSpace(r.ModelPattern) == "" {
+		return &ValidationError{Field: "model_pattern", Message: "model_pattern is required"}
+	}
+	if len(r.Targets) == 0 && r.Shadow == nil {
+		return &ValidationError{Field: "targets", Message: "at least one target or shadow is required"}
+	}
+	if len(r.Targets) > 0 {
+		total := 0
+		for _, target := range r.Targets {
+			if target.Percent < 0 {
+				return &ValidationError{Field: "targets", Message: "percent must be >= 0"}
+			}
+			total += target.Percent
+		}
+		if total != 100 {
+			return &ValidationError{Field: "targets", Message: fmt.Sprintf("target percents must sum to 100, got %d", total)}
+		}
+	}
+	if r.Shadow != nil {
+		if strings.TrimSpace(r.Shadow.Model) == "" {
+			return &ValidationError{Field: "shadow", Message: "shadow model is required"}
+		}
+		if r.Shadow.Percent <= 0 || r.Shadow.Percent > 100 {
+			return &ValidationError{Field: "shadow", Message: "shadow percent must be between 1 and 100"}
+		}
+	}
+	return nil
+}
+
+// TargetLabel 返回拆分目标在报表中的标签
+func (r *TrafficSplitRule) TargetLabel(idx int) string {
+	if idx < 0 || idx >= len(r.Targets) {
+		return ""
+	}
+	target := r.Targets[idx]
+	if label := strings.TrimSpace(target.Label); label != "" {
+		return label
+	}
+	if m := strings.TrimSpace(target.Model); m != "" {
+		return m
+	}
+	return fmt.Sprintf("target-%d", idx+1)
+}
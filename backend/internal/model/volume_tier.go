@@ -0,0 +1,47 @@
+package model
+
+import "fmt"
+
+// MaxVolumeTiers 单个分组最多可配置的阶梯数
+const MaxVolumeTiers = 10
+
+// VolumeTier 分组级用量阶梯定价
+// 用户当月在该分组的累计消费达到 MinSpend（美元）后，费率倍数再乘以 Multiplier。
+// 例如 [{0, 1.0}, {100, 0.9}, {1000, 0.8}] 表示月消费满 $100 起打 9 折、满 $1000 起打 8 折。
+type VolumeTier struct {
+	MinSpend   float64 `json:"min_spend"`  // 阶梯起点（当月累计消费，美元）
+	Multiplier float64 `json:"multiplier"` // 该阶梯的折扣倍数（叠加在分组/用户专属倍率之上）
+}
+
+// ValidateVolumeTiers 校验阶梯配置：起点从 0 开始严格递增，倍数为正
+func ValidateVolumeTiers(tiers []VolumeTier) error {
+	if len(tiers) == 0 {
+		return nil
+	}
+	if len(tiers) > MaxVolumeTiers {
+		return &ValidationError{Field: "volume_tiers", Message: fmt.Sprintf("at most %d tiers are allowed", MaxVolumeTiers)}
+	}
+	if tiers[0].MinSpend != 0 {
+		return &ValidationError{Field: "volume_tiers", Message: "first tier must start at min_spend 0"}
+	}
+	for i, tier := range tiers {
+		if tier.Multiplier <= 0 {
+			return &ValidationError{Field: "volume_tiers", Message: "multiplier must be positive"}
+		}
+		if i > 0 && tier.MinSpend <= tiers[i-1].MinSpend {
+			return &ValidationError{Field: "volume_tiers", Message: "min_spend must be strictly increasing"}
+		}
+	}
+	return nil
+}
+
+// VolumeTierIndex 返回 spend 所在阶梯的下标；未配置阶梯时返回 -1
+func VolumeTierIndex(tiers []VolumeTier, spend float64) int {
+	idx := -1
+	for i := range tiers {
+		if spend >= tiers[i].MinSpend {
+			idx = i
+		}
+	}
+	return idx
+}
@@ -0,0 +1,665 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/payment"
+	"github.com/shopspring/decimal"
+)
+
+// PayPal constants.
+const (
+	paypalAPIBaseLive       = "https://api-m.paypal.com"
+	paypalAPIBaseSandbox    = "https://api-m.sandbox.paypal.com"
+	paypalDefaultCurrency   = "CNY"
+	paypalHTTPTimeout       = 15 * time.Second
+	maxPaypalResponseSize   = 1 << 20 // 1MB
+	paypalTokenRefreshAhead = 60 * time.Second
+	paypalMaxDescriptionLen = 127
+
+	paypalOrderStatusApproved  = "APPROVED"
+	paypalOrderStatusCompleted = "COMPLETED"
+	paypalOrderStatusVoided    = "VOIDED"
+
+	paypalCaptureStatusCompleted = "COMPLETED"
+	paypalCaptureStatusDeclined  = "DECLINED"
+	paypalRefundStatusCompleted  = "COMPLETED"
+	paypalRefundStatusFailed     = "FAILED"
+	paypalRefundStatusCancelled  = "CANCELLED"
+
+	paypalEventOrderApproved   = "CHECKOUT.ORDER.APPROVED"
+	paypalEventCaptureComplete = "PAYMENT.CAPTURE.COMPLETED"
+	paypalEventCaptureDenied   = "PAYMENT.CAPTURE.DENIED"
+	paypalEventCaptureDeclined = "PAYMENT.CAPTURE.DECLINED"
+
+	paypalWebhookVerifySuccess  = "SUCCESS"
+	paypalIssueAlreadyCaptured  = "ORDER_ALREADY_CAPTURED"
+	paypalCustomIDAmountDivider = "|"
+)
+
+// paypalZeroDecimalCurrencies are currencies PayPal requires without a fractional part.
+var paypalZeroDecimalCurrencies = map[string]bool{"HUF": true, "JPY": true, "TWD": true}
+
+// PayPal implements payment.CancelableProvider using the PayPal Orders v2 API.
+//
+// Flow: CreatePayment creates an order (intent CAPTURE) and returns the payer approval link
+// as PayURL. After approval the order is captured either when the payer returns
+// (QueryOrder captures APPROVED orders) or when the CHECKOUT.ORDER.APPROVED webhook arrives.
+// TradeNo is the PayPal order ID; out_trade_no travels as invoice_id / custom_id.
+type PayPal struct {
+	instanceID string
+	config     map[string]string
+	apiBase    string
+	currency   string
+	// exchangeRate converts order amounts (CNY) into the instance currency; zero means no conversion.
+	exchangeRate decimal.Decimal
+	httpClient   *http.Client
+
+	mu          sync.Mutex
+	accessToken string
+	tokenExpiry time.Time
+}
+
+// NewPayPal creates a new PayPal provider.
+// config keys: clientId, clientSecret, webhookId, returnUrl, cancelUrl,
+// sandbox ("true" for sandbox), apiBase (overrides sandbox/live), currency, exchangeRate
+func NewPayPal(instanceID string, config map[string]string) (*PayPal, error) {
+	for _, k := range []string{"clientId", "clientSecret"} {
+		if config[k] == "" {
+			return nil, fmt.Errorf("paypal config missing required key: %s", k)
+		}
+	}
+	apiBase := strings.TrimRight(config["apiBase"], "/")
+	if apiBase == "" {
+		apiBase = paypalAPIBaseLive
+		if config["sandbox"] == "true" {
+			apiBase = paypalAPIBaseSandbox
+		}
+	}
+	currency := strings.ToUpper(strings.TrimSpace(config["currency"]))
+	if currency == "" {
+		currency = paypalDefaultCurrency
+	}
+	var rate decimal.Decimal
+	if raw := strings.TrimSpace(config["exchangeRate"]); raw != "" {
+		d, err := decimal.NewFromString(raw)
+		if err != nil || !d.IsPositive() {
+			return nil, fmt.Errorf("paypal config invalid exchangeRate: %s", raw)
+		}
+		rate = d
+	}
+	return &PayPal{
+		instanceID:   instanceID,
+		config:       config,
+		apiBase:      apiBase,
+		currency:     currency,
+		exchangeRate: rate,
+		httpClient:   &http.Client{Timeout: paypalHTTPTimeout},
+	}, nil
+}
+
+func (p *PayPal) Name() string        { return "PayPal" }
+func (p *PayPal) ProviderKey() string { return payment.TypePayPal }
+func (p *PayPal) SupportedTypes() []payment.PaymentType {
+	return []payment.PaymentType{payment.TypePayPal}
+}
+
+// --- API payloads ---
+
+type paypalAmount struct {
+	CurrencyCode string `json:"currency_code"`
+	Value        string `json:"value"`
+}
+
+type paypalLink struct {
+	Href string `json:"href"`
+	Rel  string `json:"rel"`
+}
+
+type paypalCapture struct {
+	ID                string       `json:"id"`
+	Status            string       `json:"status"`
+	Amount            paypalAmount `json:"amount"`
+	InvoiceID         string       `json:"invoice_id"`
+	CustomID          string       `json:"custom_id"`
+	SupplementaryData struct {
+		RelatedIDs struct {
+			OrderID string `json:"order_id"`
+		} `json:"related_ids"`
+	} `json:"supplementary_data"`
+}
+
+type paypalPurchaseUnit struct {
+	InvoiceID string       `json:"invoice_id"`
+	CustomID  string       `json:"custom_id"`
+	Amount    paypalAmount `json:"amount"`
+	Payments  struct {
+		Captures []paypalCapture `json:"captures"`
+	} `json:"payments"`
+}
+
+type paypalOrder struct {
+	ID            string               `json:"id"`
+	Status        string               `json:"status"`
+	PurchaseUnits []paypalPurchaseUnit `json:"purchase_units"`
+	Links         []paypalLink         `json:"links"`
+}
+
+type paypalRefund struct {
+	ID     string `json:"id"`
+	Status string `json:"status"`
+}
+
+type paypalWebhookEvent struct {
+	ID        string          `json:"id"`
+	EventType string          `json:"event_type"`
+	Resource  json.RawMessage `json:"resource"`
+}
+
+// paypalAPIError is returned for non-2xx PayPal API responses.
+type paypalAPIError struct {
+	StatusCode int
+	Name       string `json:"name"`
+	Message    string `json:"message"`
+	Details    []struct {
+		Issue string `json:"issue"`
+	} `json:"details"`
+}
+
+func (e *paypalAPIError) Error() string {
+	return fmt.Sprintf("paypal api error: status=%d name=%s message=%s", e.StatusCode, e.Name, e.Message)
+}
+
+func (e *paypalAPIError) hasIssue(issue string) bool {
+	for _, d := range e.Details {
+		if d.Issue == issue {
+			return true
+		}
+	}
+	return false
+}
+
+// --- Provider methods ---
+
+// CreatePayment creates a PayPal order and returns its payer approval link.
+func (p *PayPal) CreatePayment(ctx context.Context, req payment.CreatePaymentRequest) (*payment.CreatePaymentResponse, error) {
+	value, err := p.convertAmount(req.Amount)
+	if err != nil {
+		return nil, fmt.Errorf("paypal create payment: %w", err)
+	}
+	returnURL := req.ReturnURL
+	if returnURL == "" {
+		returnURL = p.config["returnUrl"]
+	}
+	cancelURL := p.config["cancelUrl"]
+	if cancelURL == "" {
+		cancelURL = returnURL
+	}
+
+	customID := req.OrderID
+	if !p.exchangeRate.IsZero() {
+		// Keep the CNY amount so notifications can be checked against the order amount.
+		customID = req.OrderID + paypalCustomIDAmountDivider + req.Amount
+	}
+	experience := map[string]any{
+		"user_action":         "PAY_NOW",
+		"shipping_preference": "NO_SHIPPING",
+	}
+	if returnURL != "" {
+		experience["return_url"] = appendOutTradeNo(returnURL, req.OrderID)
+		experience["cancel_url"] = appendOutTradeNo(cancelURL, req.OrderID)
+	}
+	body := map[string]any{
+		"intent": "CAPTURE",
+		"purchase_units": []map[string]any{{
+			"invoice_id":  req.OrderID,
+			"custom_id":   customID,
+			"description": truncateRunes(req.Subject, paypalMaxDescriptionLen),
+			"amount":      paypalAmount{CurrencyCode: p.currency, Value: value},
+		}},
+		"payment_source": map[string]any{
+			"paypal": map[string]any{"experience_context": experience},
+		},
+	}
+
+	var order paypalOrder
+	if err := p.do(ctx, http.MethodPost, "/v2/checkout/orders", body, "order-"+req.OrderID, &order); err != nil {
+		return nil, fmt.Errorf("paypal create payment: %w", err)
+	}
+	approveURL := ""
+	for _, l := range order.Links {
+		if l.Rel == "payer-action" || l.Rel == "approve" {
+			approveURL = l.Href
+			break
+		}
+	}
+	if approveURL == "" {
+		return nil, fmt.Errorf("paypal create payment: no approval link in response (order %s)", order.ID)
+	}
+	return &payment.CreatePaymentResponse{TradeNo: order.ID, PayURL: approveURL}, nil
+}
+
+// QueryOrder retrieves a PayPal order. APPROVED orders are captured here,
+// which is how a payer returning from PayPal completes the payment.
+func (p *PayPal) QueryOrder(ctx context.Context, tradeNo string) (*payment.QueryOrderResponse, error) {
+	order, err := p.getOrder(ctx, tradeNo)
+	if err != nil {
+		return nil, fmt.Errorf("paypal query order: %w", err)
+	}
+	if order.Status == paypalOrderStatusApproved {
+		if order, err = p.captureOrder(ctx, tradeNo); err != nil {
+			return nil, fmt.Errorf("paypal query order: %w", err)
+		}
+	}
+
+	resp := &payment.QueryOrderResponse{TradeNo: order.ID, Status: payment.ProviderStatusPending}
+	switch order.Status {
+	case paypalOrderStatusCompleted:
+		capture := firstPaypalCapture(order)
+		if capture != nil && capture.Status == paypalCaptureStatusCompleted {
+			resp.Status = payment.ProviderStatusPaid
+			resp.Amount = p.notifiedAmount(capture.Amount, capture.CustomID)
+		} else if capture != nil && capture.Status == paypalCaptureStatusDeclined {
+			resp.Status = payment.ProviderStatusFailed
+		}
+	case paypalOrderStatusVoided:
+		resp.Status = payment.ProviderStatusFailed
+	}
+	return resp, nil
+}
+
+// VerifyNotification verifies a PayPal webhook through the verify-webhook-signature API.
+// CHECKOUT.ORDER.APPROVED events trigger a capture; capture events map to success/failure.
+func (p *PayPal) VerifyNotification(ctx context.Context, rawBody string, headers map[string]string) (*payment.PaymentNotification, error) {
+	if err := p.verifyWebhookSignature(ctx, rawBody, headers); err != nil {
+		return nil, err
+	}
+
+	var event paypalWebhookEvent
+	if err := json.Unmarshal([]byte(rawBody), &event); err != nil {
+		return nil, fmt.Errorf("paypal parse webhook event: %w", err)
+	}
+
+	switch event.EventType {
+	case paypalEventOrderApproved:
+		var order paypalOrder
+		if err := json.Unmarshal(event.Resource, &order); err != nil {
+			return nil, fmt.Errorf("paypal parse order: %w", err)
+		}
+		captured, err := p.captureOrder(ctx, order.ID)
+		if err != nil {
+			return nil, fmt.Errorf("paypal capture approved order: %w", err)
+		}
+		capture := firstPaypalCapture(captured)
+		if captured.Status != paypalOrderStatusCompleted || capture == nil || capture.Status != paypalCaptureStatusCompleted {
+			// Capture pending (e.g. eCheck); PAYMENT.CAPTURE.COMPLETED will follow.
+			return nil, nil
+		}
+		return &payment.PaymentNotification{
+			TradeNo: captured.ID,
+			OrderID: paypalOutTradeNo(capture.InvoiceID, capture.CustomID, captured),
+			Amount:  p.notifiedAmount(capture.Amount, capture.CustomID),
+			Status:  payment.ProviderStatusSuccess,
+			RawData: rawBody,
+		}, nil
+	case paypalEventCaptureComplete, paypalEventCaptureDenied, paypalEventCaptureDeclined:
+		var capture paypalCapture
+		if err := json.Unmarshal(event.Resource, &capture); err != nil {
+			return nil, fmt.Errorf("paypal parse capture: %w", err)
+		}
+		status := payment.ProviderStatusSuccess
+		if event.EventType != paypalEventCaptureComplete {
+			status = payment.ProviderStatusFailed
+		}
+		return &payment.PaymentNotification{
+			TradeNo: capture.SupplementaryData.RelatedIDs.OrderID,
+			OrderID: paypalOutTradeNo(capture.InvoiceID, capture.CustomID, nil),
+			Amount:  p.notifiedAmount(capture.Amount, capture.CustomID),
+			Status:  status,
+			RawData: rawBody,
+		}, nil
+	}
+	return nil, nil
+}
+
+func (p *PayPal) verifyWebhookSignature(ctx context.Context, rawBody string, headers map[string]string) error {
+	webhookID := p.config["webhookId"]
+	if webhookID == "" {
+		return fmt.Errorf("paypal webhookId not configured")
+	}
+	required := []string{"paypal-auth-algo", "paypal-cert-url", "paypal-transmission-id", "paypal-transmission-sig", "paypal-transmission-time"}
+	for _, h := range required {
+		if headers[h] == "" {
+			return fmt.Errorf("paypal notification missing %s header", h)
+		}
+	}
+	if !json.Valid([]byte(rawBody)) {
+		return fmt.Errorf("paypal notification body is not valid JSON")
+	}
+
+	body := map[string]any{
+		"auth_algo":         headers["paypal-auth-algo"],
+		"cert_url":          headers["paypal-cert-url"],
+		"transmission_id":   headers["paypal-transmission-id"],
+		"transmission_sig":  headers["paypal-transmission-sig"],
+		"transmission_time": headers["paypal-transmission-time"],
+		"webhook_id":        webhookID,
+		"webhook_event":     json.RawMessage(rawBody),
+	}
+	var result struct {
+		VerificationStatus string `json:"verification_status"`
+	}
+	if err := p.do(ctx, http.MethodPost, "/v1/notifications/verify-webhook-signature", body, "", &result); err != nil {
+		return fmt.Errorf("paypal verify notification: %w", err)
+	}
+	if result.VerificationStatus != paypalWebhookVerifySuccess {
+		return fmt.Errorf("paypal verify notification: verification_status=%s", result.VerificationStatus)
+	}
+	return nil
+}
+
+// Refund refunds the capture of a PayPal order (full or partial).
+func (p *PayPal) Refund(ctx context.Context, req payment.RefundRequest) (*payment.RefundResponse, error) {
+	order, err := p.getOrder(ctx, req.TradeNo)
+	if err != nil {
+		return nil, fmt.Errorf("paypal refund: %w", err)
+	}
+	capture := firstPaypalCapture(order)
+	if capture == nil {
+		return nil, fmt.Errorf("paypal refund: order %s has no capture", req.TradeNo)
+	}
+	value, err := p.convertAmount(req.Amount)
+	if err != nil {
+		return nil, fmt.Errorf("paypal refund: %w", err)
+	}
+
+	body := map[string]any{
+		"amount": paypalAmount{CurrencyCode: capture.Amount.CurrencyCode, Value: value},
+	}
+	if req.Reason != "" {
+		body["note_to_payer"] = truncateRunes(req.Reason, 255)
+	}
+	var refund paypalRefund
+	requestID := fmt.Sprintf("refund-%s-%s", req.OrderID, req.Amount)
+	if err := p.do(ctx, http.MethodPost, "/v2/payments/captures/"+url.PathEscape(capture.ID)+"/refund", body, requestID, &refund); err != nil {
+		return nil, fmt.Errorf("paypal refund: %w", err)
+	}
+
+	status := payment.ProviderStatusPending
+	switch refund.Status {
+	case paypalRefundStatusCompleted:
+		status = payment.ProviderStatusSuccess
+	case paypalRefundStatusFailed, paypalRefundStatusCancelled:
+		status = payment.ProviderStatusFailed
+	}
+	return &payment.RefundResponse{RefundID: refund.ID, Status: status}, nil
+}
+
+// CancelPayment cancels an unapproved PayPal order.
+// The Orders v2 API has no cancel endpoint: unapproved orders simply expire upstream and are
+// never captured by us once the local order is cancelled. A captured order cannot be cancelled.
+func (p *PayPal) CancelPayment(ctx context.Context, tradeNo string) error {
+	order, err := p.getOrder(ctx, tradeNo)
+	if err != nil {
+		var apiErr *paypalAPIError
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+			return nil
+		}
+		return fmt.Errorf("paypal cancel payment: %w", err)
+	}
+	if order.Status == paypalOrderStatusCompleted {
+		return fmt.Errorf("paypal cancel payment: order %s already captured", tradeNo)
+	}
+	return nil
+}
+
+// --- API helpers ---
+
+func (p *PayPal) getOrder(ctx context.Context, orderID string) (*paypalOrder, error) {
+	var order paypalOrder
+	if err := p.do(ctx, http.MethodGet, "/v2/checkout/orders/"+url.PathEscape(orderID), nil, "", &order); err != nil {
+		return nil, err
+	}
+	return &order, nil
+}
+
+// captureOrder captures an approved order; an already captured order is re-fetched.
+func (p *PayPal) captureOrder(ctx context.Context, orderID string) (*paypalOrder, error) {
+	var order paypalOrder
+	err := p.do(ctx, http.MethodPost, "/v2/checkout/orders/"+url.PathEscape(orderID)+"/capture", map[string]any{}, "capture-"+orderID, &order)
+	if err != nil {
+		var apiErr *paypalAPIError
+		if errors.As(err, &apiErr) && apiErr.hasIssue(paypalIssueAlreadyCaptured) {
+			return p.getOrder(ctx, orderID)
+		}
+		return nil, err
+	}
+	return &order, nil
+}
+
+// token returns a cached OAuth access token, refreshing it shortly before expiry.
+func (p *PayPal) token(ctx context.Context) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.accessToken != "" && time.Now().Before(p.tokenExpiry) {
+		return p.accessToken, nil
+	}
+
+	form := url.Values{"grant_type": {"client_credentials"}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.apiBase+"/v1/oauth2/token", strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", fmt.Errorf("paypal token: %w", err)
+	}
+	req.SetBasicAuth(p.config["clientId"], p.config["clientSecret"])
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	var out struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}
+	if err := p.send(req, &out); err != nil {
+		return "", fmt.Errorf("paypal token: %w", err)
+	}
+	if out.AccessToken == "" {
+		return "", fmt.Errorf("paypal token: empty access_token")
+	}
+	p.accessToken = out.AccessToken
+	p.tokenExpiry = time.Now().Add(time.Duration(out.ExpiresIn)*time.Second - paypalTokenRefreshAhead)
+	return p.accessToken, nil
+}
+
+// do performs an authenticated JSON API call. requestID (optional) is sent as
+// PayPal-Request-Id so retries of create/capture/refund stay idempotent.
+func (p *PayPal) do(ctx context.Context, method, path string, body any, requestID string, out any) error {
+	token, err := p.token(ctx)
+	if err != nil {
+		return err
+	}
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("marshal request: %w", err)
+		}
+		reader = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, p.apiBase+path, reader)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if requestID != "" {
+		req.Header.Set("PayPal-Request-Id", requestID)
+		req.Header.Set("Prefer", "return=representation")
+	}
+	return p.send(req, out)
+}
+
+func (p *PayPal) send(req *http.Request, out any) error {
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxPaypalResponseSize))
+	if err != nil {
+		return fmt.Errorf("read response: %w", err)
+	}
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		apiErr := &paypalAPIError{StatusCode: resp.StatusCode}
+		_ = json.Unmarshal(data, apiErr)
+		return apiErr
+	}
+	if out == nil || len(data) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(data, out); err != nil {
+		return fmt.Errorf("parse response: %w", err)
+	}
+	return nil
+}
+
+// --- Amount helpers ---
+
+// convertAmount converts a CNY amount string into the instance currency value string.
+func (p *PayPal) convertAmount(amount string) (string, error) {
+	d, err := decimal.NewFromString(amount)
+	if err != nil {
+		return "", fmt.Errorf("invalid amount: %s", amount)
+	}
+	if !p.exchangeRate.IsZero() {
+		d = d.Mul(p.exchangeRate)
+	}
+	places := int32(2)
+	if paypalZeroDecimalCurrencies[p.currency] {
+		places = 0
+	}
+	return d.Round(places).StringFixed(places), nil
+}
+
+// notifiedAmount converts a PayPal amount back to CNY for the order amount check.
+// With an exchange rate, the CNY amount recorded in custom_id is reported when the captured
+// value matches its conversion exactly; otherwise the reverse conversion is reported.
+func (p *PayPal) notifiedAmount(amount paypalAmount, customID string) float64 {
+	value, err := decimal.NewFromString(amount.Value)
+	if err != nil {
+		return 0
+	}
+	if p.exchangeRate.IsZero() {
+		return value.InexactFloat64()
+	}
+	if idx := strings.LastIndex(customID, paypalCustomIDAmountDivider); idx >= 0 {
+		cny := customID[idx+1:]
+		if expected, err := p.convertAmount(cny); err == nil && expected == amount.Value && strings.EqualFold(amount.CurrencyCode, p.currency) {
+			if d, err := decimal.NewFromString(cny); err == nil {
+				return d.InexactFloat64()
+			}
+		}
+	}
+	return value.Div(p.exchangeRate).Round(2).InexactFloat64()
+}
+
+// --- Misc helpers ---
+
+func firstPaypalCapture(order *paypalOrder) *paypalCapture {
+	if order == nil {
+		return nil
+	}
+	for i := range order.PurchaseUnits {
+		captures := order.PurchaseUnits[i].Payments.Captures
+		if len(captures) > 0 {
+			c := captures[0]
+			if c.InvoiceID == "" {
+				c.InvoiceID = order.PurchaseUnits[i].InvoiceID
+			}
+			if c.CustomID == "" {
+				c.CustomID = order.PurchaseUnits[i].CustomID
+			}
+			return &c
+		}
+	}
+	return nil
+}
+
+// paypalOutTradeNo resolves our out_trade_no from invoice_id, custom_id or the order's purchase unit.
+func paypalOutTradeNo(invoiceID, customID string, order *paypalOrder) string {
+	if invoiceID != "" {
+		return invoiceID
+	}
+	if customID != "" {
+		if idx := strings.LastIndex(customID, paypalCustomIDAmountDivider); idx >= 0 {
+			return customID[:idx]
+		}
+		return customID
+	}
+	if order != nil && len(order.PurchaseUnits) > 0 {
+		return order.PurchaseUnits[0].InvoiceID
+	}
+	return ""
+}
+
+// ExtractPayPalOutTradeNo extracts out_trade_no from a PayPal webhook body
+// so the webhook can be verified with the order's provider instance.
+func ExtractPayPalOutTradeNo(rawBody string) string {
+	var event struct {
+		Resource struct {
+			InvoiceID     string `json:"invoice_id"`
+			CustomID      string `json:"custom_id"`
+			PurchaseUnits []struct {
+				InvoiceID string `json:"invoice_id"`
+				CustomID  string `json:"custom_id"`
+			} `json:"purchase_units"`
+		} `json:"resource"`
+	}
+	if err := json.Unmarshal([]byte(rawBody), &event); err != nil {
+		return ""
+	}
+	r := event.Resource
+	if r.InvoiceID != "" || r.CustomID != "" {
+		return paypalOutTradeNo(r.InvoiceID, r.CustomID, nil)
+	}
+	if len(r.PurchaseUnits) > 0 {
+		return paypalOutTradeNo(r.PurchaseUnits[0].InvoiceID, r.PurchaseUnits[0].CustomID, nil)
+	}
+	return ""
+}
+
+func appendOutTradeNo(rawURL, outTradeNo string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+	q := u.Query()
+	q.Set("out_trade_no", outTradeNo)
+	u.RawQuery = q.Encode()
+	return u.String()
+}
+
+func truncateRunes(s string, limit int) string {
+	r := []rune(s)
+	if len(r) <= limit {
+		return s
+	}
+	return string(r[:limit])
+}
+
+// Ensure interface compliance.
+var (
+	_ payment.Provider           = (*PayPal)(nil)
+	_ payment.CancelableProvider = (*PayPal)(nil)
+)
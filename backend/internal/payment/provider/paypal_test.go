@@ -0,0 +1,343 @@
+//go:build unit
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/Wei-Shaw/sub2api/internal/payment"
+)
+
+// fakePayPal is a minimal local stand-in of the PayPal REST API.
+type fakePayPal struct {
+	mu            sync.Mutex
+	orders        map[string]*paypalOrder
+	createBodies  []map[string]any
+	captureCalls  int
+	tokenCalls    int
+	verifyStatus  string
+	refundStatus  string
+	refundBodies  []map[string]any
+	lastRequestID string
+}
+
+func newFakePayPal(t *testing.T) (*fakePayPal, *httptest.Server) {
+	t.Helper()
+	f := &fakePayPal{orders: map[string]*paypalOrder{}, verifyStatus: "SUCCESS", refundStatus: "COMPLETED"}
+	srv := httptest.NewServer(http.HandlerFunc(f.serve))
+	t.Cleanup(srv.Close)
+	return f, srv
+}
+
+func (f *fakePayPal) serve(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if r.URL.Path == "/v1/oauth2/token" {
+		user, pass, ok := r.BasicAuth()
+		if !ok || user != "cid" || pass != "csecret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		f.tokenCalls++
+		writeJSON(w, http.StatusOK, map[string]any{"access_token": "tok", "expires_in": 3600})
+		return
+	}
+	if r.Header.Get("Authorization") != "Bearer tok" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	f.lastRequestID = r.Header.Get("PayPal-Request-Id")
+
+	var body map[string]any
+	_ = json.NewDecoder(r.Body).Decode(&body)
+
+	switch {
+	case r.Method == http.MethodPost && r.URL.Path == "/v2/checkout/orders":
+		f.createBodies = append(f.createBodies, body)
+		unit := body["purchase_units"].([]any)[0].(map[string]any)
+		amount := unit["amount"].(map[string]any)
+		id := "PP-" + unit["invoice_id"].(string)
+		f.orders[id] = &paypalOrder{
+			ID:     id,
+			Status: "PAYER_ACTION_REQUIRED",
+			PurchaseUnits: []paypalPurchaseUnit{{
+				InvoiceID: unit["invoice_id"].(string),
+				CustomID:  unit["custom_id"].(string),
+				Amount:    paypalAmount{CurrencyCode: amount["currency_code"].(string), Value: amount["value"].(string)},
+			}},
+			Links: []paypalLink{{Rel: "self", Href: "https://paypal.test/self"}, {Rel: "payer-action", Href: "https://paypal.test/approve?token=" + id}},
+		}
+		writeJSON(w, http.StatusOK, f.orders[id])
+	case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/v2/checkout/orders/"):
+		order, ok := f.orders[strings.TrimPrefix(r.URL.Path, "/v2/checkout/orders/")]
+		if !ok {
+			writeJSON(w, http.StatusNotFound, map[string]any{"name": "RESOURCE_NOT_FOUND"})
+			return
+		}
+		writeJSON(w, http.StatusOK, order)
+	case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/capture"):
+		f.captureCalls++
+		id := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/v2/checkout/orders/"), "/capture")
+		order := f.orders[id]
+		if order.Status == "COMPLETED" {
+			writeJSON(w, http.StatusUnprocessableEntity, map[string]any{"name": "UNPROCESSABLE_ENTITY", "details": []map[string]any{{"issue": "ORDER_ALREADY_CAPTURED"}}})
+			return
+		}
+		order.Status = "COMPLETED"
+		unit := &order.PurchaseUnits[0]
+		unit.Payments.Captures = []paypalCapture{{ID: "CAP-" + id, Status: "COMPLETED", Amount: unit.Amount}}
+		writeJSON(w, http.StatusCreated, order)
+	case r.Method == http.MethodPost && strings.HasPrefix(r.URL.Path, "/v2/payments/captures/"):
+		f.refundBodies = append(f.refundBodies, body)
+		writeJSON(w, http.StatusCreated, map[string]any{"id": "REF-1", "status": f.refundStatus})
+	case r.Method == http.MethodPost && r.URL.Path == "/v1/notifications/verify-webhook-signature":
+		if body["webhook_id"] != "wh-1" || body["transmission_sig"] == "" || body["webhook_event"] == nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]any{"verification_status": f.verifyStatus})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func newTestPayPal(t *testing.T, srv *httptest.Server, extra map[string]string) *PayPal {
+	t.Helper()
+	cfg := map[string]string{
+		"clientId":     "cid",
+		"clientSecret": "csecret",
+		"webhookId":    "wh-1",
+		"apiBase":      srv.URL,
+		"returnUrl":    "https://example.com/payment/result",
+	}
+	for k, v := range extra {
+		cfg[k] = v
+	}
+	p, err := NewPayPal("1", cfg)
+	if err != nil {
+		t.Fatalf("NewPayPal: %v", err)
+	}
+	return p
+}
+
+func paypalWebhookHeaders() map[string]string {
+	return map[string]string{
+		"paypal-auth-algo":         "SHA256withRSA",
+		"paypal-cert-url":          "https://api.paypal.com/cert.pem",
+		"paypal-transmission-id":   "tid",
+		"paypal-transmission-sig":  "sig",
+		"paypal-transmission-time": "2026-01-01T00:00:00Z",
+	}
+}
+
+func TestNewPayPal_Validation(t *testing.T) {
+	if _, err := NewPayPal("1", map[string]string{"clientId": "cid"}); err == nil {
+		t.Fatal("expected error for missing clientSecret")
+	}
+	if _, err := NewPayPal("1", map[string]string{"clientId": "cid", "clientSecret": "s", "exchangeRate": "-1"}); err == nil {
+		t.Fatal("expected error for invalid exchangeRate")
+	}
+	p, err := NewPayPal("1", map[string]string{"clientId": "cid", "clientSecret": "s", "sandbox": "true"})
+	if err != nil {
+		t.Fatalf("NewPayPal: %v", err)
+	}
+	if p.apiBase != paypalAPIBaseSandbox || p.currency != paypalDefaultCurrency {
+		t.Fatalf("unexpected defaults: base=%s currency=%s", p.apiBase, p.currency)
+	}
+
+	created, err := CreateProvider(payment.TypePayPal, "1", map[string]string{"clientId": "cid", "clientSecret": "s"})
+	if err != nil || created.ProviderKey() != payment.TypePayPal {
+		t.Fatalf("CreateProvider paypal: %v", err)
+	}
+}
+
+func TestPayPal_CreateAndCaptureOnReturn(t *testing.T) {
+	fake, srv := newFakePayPal(t)
+	p := newTestPayPal(t, srv, nil)
+	ctx := context.Background()
+
+	resp, err := p.CreatePayment(ctx, payment.CreatePaymentRequest{OrderID: "sub2_100", Amount: "12.50", Subject: "Balance"})
+	if err != nil {
+		t.Fatalf("CreatePayment: %v", err)
+	}
+	if resp.TradeNo != "PP-sub2_100" || resp.PayURL != "https://paypal.test/approve?token=PP-sub2_100" {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+	if fake.lastRequestID != "order-sub2_100" {
+		t.Fatalf("expected idempotent request id, got %q", fake.lastRequestID)
+	}
+	experience := fake.createBodies[0]["payment_source"].(map[string]any)["paypal"].(map[string]any)["experience_context"].(map[string]any)
+	if !strings.Contains(experience["return_url"].(string), "out_trade_no=sub2_100") {
+		t.Fatalf("return url should carry out_trade_no: %v", experience["return_url"])
+	}
+
+	// 未批准：保持 pending，不触发 capture
+	q, err := p.QueryOrder(ctx, resp.TradeNo)
+	if err != nil || q.Status != payment.ProviderStatusPending || fake.captureCalls != 0 {
+		t.Fatalf("expected pending without capture, got %+v err=%v captures=%d", q, err, fake.captureCalls)
+	}
+
+	// 付款人批准后返回：QueryOrder 执行 capture
+	fake.orders[resp.TradeNo].Status = "APPROVED"
+	q, err = p.QueryOrder(ctx, resp.TradeNo)
+	if err != nil {
+		t.Fatalf("QueryOrder: %v", err)
+	}
+	if q.Status != payment.ProviderStatusPaid || q.Amount != 12.5 || fake.captureCalls != 1 {
+		t.Fatalf("expected paid 12.5 after capture, got %+v captures=%d", q, fake.captureCalls)
+	}
+
+	// 已 capture 的订单再次 capture 时回退为查询
+	captured, err := p.captureOrder(ctx, resp.TradeNo)
+	if err != nil || captured.Status != "COMPLETED" {
+		t.Fatalf("expected already-captured fallback, got %+v err=%v", captured, err)
+	}
+	if fake.tokenCalls != 1 {
+		t.Fatalf("access token should be cached, got %d token calls", fake.tokenCalls)
+	}
+}
+
+func TestPayPal_VerifyNotification(t *testing.T) {
+	fake, srv := newFakePayPal(t)
+	p := newTestPayPal(t, srv, nil)
+	ctx := context.Background()
+	resp, err := p.CreatePayment(ctx, payment.CreatePaymentRequest{OrderID: "sub2_200", Amount: "30.00"})
+	if err != nil {
+		t.Fatalf("CreatePayment: %v", err)
+	}
+
+	captureEvent := `{"id":"WH-1","event_type":"PAYMENT.CAPTURE.COMPLETED","resource":{"id":"CAP-1","status":"COMPLETED","amount":{"currency_code":"CNY","value":"30.00"},"invoice_id":"sub2_200","custom_id":"sub2_200","supplementary_data":{"related_ids":{"order_id":"` + resp.TradeNo + `"}}}}`
+	n, err := p.VerifyNotification(ctx, captureEvent, paypalWebhookHeaders())
+	if err != nil {
+		t.Fatalf("VerifyNotification: %v", err)
+	}
+	if n == nil || n.Status != payment.ProviderStatusSuccess || n.OrderID != "sub2_200" || n.TradeNo != resp.TradeNo || n.Amount != 30 {
+		t.Fatalf("unexpected notification: %+v", n)
+	}
+	if got := ExtractPayPalOutTradeNo(captureEvent); got != "sub2_200" {
+		t.Fatalf("ExtractPayPalOutTradeNo = %q", got)
+	}
+
+	// 订单批准事件：服务端 capture 后返回成功通知
+	approvedEvent := `{"id":"WH-2","event_type":"CHECKOUT.ORDER.APPROVED","resource":{"id":"` + resp.TradeNo + `","status":"APPROVED","purchase_units":[{"invoice_id":"sub2_200","custom_id":"sub2_200"}]}}`
+	fake.orders[resp.TradeNo].Status = "APPROVED"
+	n, err = p.VerifyNotification(ctx, approvedEvent, paypalWebhookHeaders())
+	if err != nil {
+		t.Fatalf("VerifyNotification approved: %v", err)
+	}
+	if n == nil || n.Status != payment.ProviderStatusSuccess || n.OrderID != "sub2_200" || fake.captureCalls != 1 {
+		t.Fatalf("expected capture + success notification, got %+v captures=%d", n, fake.captureCalls)
+	}
+	if got := ExtractPayPalOutTradeNo(approvedEvent); got != "sub2_200" {
+		t.Fatalf("ExtractPayPalOutTradeNo approved = %q", got)
+	}
+
+	// 无关事件忽略
+	n, err = p.VerifyNotification(ctx, `{"event_type":"BILLING.PLAN.CREATED","resource":{}}`, paypalWebhookHeaders())
+	if err != nil || n != nil {
+		t.Fatalf("expected ignored event, got %+v err=%v", n, err)
+	}
+
+	// 签名校验失败 / 缺少签名头
+	fake.verifyStatus = "FAILURE"
+	if _, err := p.VerifyNotification(ctx, captureEvent, paypalWebhookHeaders()); err == nil {
+		t.Fatal("expected verification failure")
+	}
+	headers := paypalWebhookHeaders()
+	delete(headers, "paypal-transmission-sig")
+	if _, err := p.VerifyNotification(ctx, captureEvent, headers); err == nil {
+		t.Fatal("expected missing header error")
+	}
+}
+
+func TestPayPal_RefundAndCancel(t *testing.T) {
+	fake, srv := newFakePayPal(t)
+	p := newTestPayPal(t, srv, nil)
+	ctx := context.Background()
+	resp, err := p.CreatePayment(ctx, payment.CreatePaymentRequest{OrderID: "sub2_300", Amount: "20.00"})
+	if err != nil {
+		t.Fatalf("CreatePayment: %v", err)
+	}
+
+	// 未批准订单可取消（上游自动过期）
+	if err := p.CancelPayment(ctx, resp.TradeNo); err != nil {
+		t.Fatalf("CancelPayment unapproved: %v", err)
+	}
+	if err := p.CancelPayment(ctx, "PP-missing"); err != nil {
+		t.Fatalf("CancelPayment missing order: %v", err)
+	}
+	// 没有 capture 的订单无法退款
+	if _, err := p.Refund(ctx, payment.RefundRequest{TradeNo: resp.TradeNo, OrderID: "sub2_300", Amount: "5.00"}); err == nil {
+		t.Fatal("expected refund error without capture")
+	}
+
+	fake.orders[resp.TradeNo].Status = "APPROVED"
+	if _, err := p.QueryOrder(ctx, resp.TradeNo); err != nil {
+		t.Fatalf("QueryOrder: %v", err)
+	}
+	if err := p.CancelPayment(ctx, resp.TradeNo); err == nil {
+		t.Fatal("expected cancel error for captured order")
+	}
+
+	refund, err := p.Refund(ctx, payment.RefundRequest{TradeNo: resp.TradeNo, OrderID: "sub2_300", Amount: "5.00", Reason: "requested"})
+	if err != nil {
+		t.Fatalf("Refund: %v", err)
+	}
+	if refund.RefundID != "REF-1" || refund.Status != payment.ProviderStatusSuccess {
+		t.Fatalf("unexpected refund: %+v", refund)
+	}
+	amount := fake.refundBodies[0]["amount"].(map[string]any)
+	if amount["value"] != "5.00" || amount["currency_code"] != "CNY" {
+		t.Fatalf("unexpected refund amount: %v", amount)
+	}
+
+	fake.refundStatus = "PENDING"
+	refund, err = p.Refund(ctx, payment.RefundRequest{TradeNo: resp.TradeNo, OrderID: "sub2_300", Amount: "1.00"})
+	if err != nil || refund.Status != payment.ProviderStatusPending {
+		t.Fatalf("expected pending refund, got %+v err=%v", refund, err)
+	}
+}
+
+func TestPayPal_ExchangeRateAmounts(t *testing.T) {
+	fake, srv := newFakePayPal(t)
+	p := newTestPayPal(t, srv, map[string]string{"currency": "usd", "exchangeRate": "0.1389"})
+	ctx := context.Background()
+
+	resp, err := p.CreatePayment(ctx, payment.CreatePaymentRequest{OrderID: "sub2_400", Amount: "100.00"})
+	if err != nil {
+		t.Fatalf("CreatePayment: %v", err)
+	}
+	unit := fake.orders[resp.TradeNo].PurchaseUnits[0]
+	if unit.Amount.CurrencyCode != "USD" || unit.Amount.Value != "13.89" || unit.CustomID != "sub2_400|100.00" {
+		t.Fatalf("unexpected converted purchase unit: %+v", unit)
+	}
+
+	// 捕获金额与换算一致时回报原始人民币金额
+	fake.orders[resp.TradeNo].Status = "APPROVED"
+	q, err := p.QueryOrder(ctx, resp.TradeNo)
+	if err != nil || q.Amount != 100 {
+		t.Fatalf("expected CNY amount 100, got %+v err=%v", q, err)
+	}
+	// 金额被篡改时回报反向换算金额（由服务层判定金额不符）
+	if got := p.notifiedAmount(paypalAmount{CurrencyCode: "USD", Value: "1.00"}, "sub2_400|100.00"); got == 100 {
+		t.Fatalf("tampered amount should not map to order amount, got %v", got)
+	}
+
+	jpy := newTestPayPal(t, srv, map[string]string{"currency": "JPY", "exchangeRate": "21.3"})
+	if v, _ := jpy.convertAmount("10.00"); v != "213" {
+		t.Fatalf("zero-decimal currency conversion = %q", v)
+	}
+}
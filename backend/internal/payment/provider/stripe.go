@@ -17,6 +17,10 @@ const (
 	stripeCurrency            = "cny"
 	stripeEventPaymentSuccess = "payment_intent.succeeded"
 	stripeEventPaymentFailed  = "payment_intent.payment_failed"
+
+	stripeEventInvoicePaid          = "invoice.paid"
+	stripeEventInvoicePaymentFailed = "invoice.payment_failed"
+	stripeEventSubscriptionDeleted  = "customer.subscription.deleted"
 )
 
 // Stripe implements the payment.CancelableProvider interface for Stripe payments.
@@ -79,6 +83,9 @@ func (s *Stripe) CreatePayment(ctx context.Context, req payment.CreatePaymentReq
 
 	// Collect all Stripe payment_method_types from the instance's configured sub-methods
 	methods := resolveStripeMethodTypes(req.InstanceSubMethods)
+	if req.RecurringPriceID != "" {
+		return s.createSubscription(ctx, req, methods)
+	}
 
 	pmTypes := make([]*string, len(methods))
 	for i, m := range methods {
@@ -119,6 +126,9 @@ func (s *Stripe) CreatePayment(ctx context.Context, req payment.CreatePaymentReq
 // QueryOrder retrieves a PaymentIntent by ID.
 func (s *Stripe) QueryOrder(ctx context.Context, tradeNo string) (*payment.QueryOrderResponse, error) {
 	s.ensureInit()
+	if isStripeSubscriptionID(tradeNo) {
+		return s.querySubscription(ctx, tradeNo)
+	}
 
 	pi, err := s.sc.V1PaymentIntents.Retrieve(ctx, tradeNo, nil)
 	if err != nil {
@@ -164,6 +174,12 @@ func (s *Stripe) VerifyNotification(_ context.Context, rawBody string, headers m
 		return parseStripePaymentIntent(&event, payment.ProviderStatusSuccess, rawBody)
 	case stripeEventPaymentFailed:
 		return parseStripePaymentIntent(&event, payment.ProviderStatusFailed, rawBody)
+	case stripeEventInvoicePaid:
+		return parseStripeInvoice(&event, payment.RecurringEventPaid, rawBody)
+	case stripeEventInvoicePaymentFailed:
+		return parseStripeInvoice(&event, payment.RecurringEventPaymentFailed, rawBody)
+	case stripeEventSubscriptionDeleted:
+		return parseStripeSubscriptionDeleted(&event, rawBody)
 	}
 
 	return nil, nil
@@ -174,6 +190,11 @@ func parseStripePaymentIntent(event *stripe.Event, status string, rawBody string
 	if err := json.Unmarshal(event.Data.Raw, &pi); err != nil {
 		return nil, fmt.Errorf("stripe parse payment_intent: %w", err)
 	}
+	// PaymentIntents created by Stripe Billing for subscription invoices carry no
+	// orderId; those payments are reported through the invoice events instead.
+	if pi.Metadata["orderId"] == "" {
+		return nil, nil
+	}
 	return &payment.PaymentNotification{
 		TradeNo: pi.ID,
 		OrderID: pi.Metadata["orderId"],
@@ -192,8 +213,13 @@ func (s *Stripe) Refund(ctx context.Context, req payment.RefundRequest) (*paymen
 		return nil, fmt.Errorf("stripe refund: %w", err)
 	}
 
+	piID, err := s.refundPaymentIntentID(ctx, req.TradeNo)
+	if err != nil {
+		return nil, fmt.Errorf("stripe refund: %w", err)
+	}
+
 	params := &stripe.RefundCreateParams{
-		PaymentIntent: stripe.String(req.TradeNo),
+		PaymentIntent: stripe.String(piID),
 		Amount:        stripe.Int64(amountInCents),
 		Reason:        stripe.String(string(stripe.RefundReasonRequestedByCustomer)),
 	}
@@ -244,9 +270,13 @@ func hasStripeMethod(methods []string, target string) bool {
 	return false
 }
 
-// CancelPayment cancels a pending PaymentIntent.
+// CancelPayment cancels a pending PaymentIntent, or the incomplete subscription of an
+// auto-renewing checkout.
 func (s *Stripe) CancelPayment(ctx context.Context, tradeNo string) error {
 	s.ensureInit()
+	if isStripeSubscriptionID(tradeNo) {
+		return s.CancelRecurring(ctx, tradeNo)
+	}
 
 	_, err := s.sc.V1PaymentIntents.Cancel(ctx, tradeNo, nil)
 	if err != nil {
@@ -259,4 +289,5 @@ func (s *Stripe) CancelPayment(ctx context.Context, tradeNo string) error {
 var (
 	_ payment.Provider           = (*Stripe)(nil)
 	_ payment.CancelableProvider = (*Stripe)(nil)
+	_ payment.RecurringProvider  = (*Stripe)(nil)
 )
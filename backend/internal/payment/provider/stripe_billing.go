@@ -0,0 +1,229 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/payment"
+	stripe "github.com/stripe/stripe-go/v85"
+)
+
+// Stripe Billing object ID prefixes.
+const (
+	stripeSubscriptionIDPrefix = "sub_"
+	stripeInvoiceIDPrefix      = "in_"
+	stripeInvoicePaymentPaid   = "paid"
+)
+
+// stripeRecurringMethods are the Stripe payment methods that can be charged off-session
+// for subscription renewals. Wallet methods such as WeChat Pay only support one-off payments.
+var stripeRecurringMethods = map[string]bool{
+	"card": true,
+	"link": true,
+}
+
+func isStripeSubscriptionID(id string) bool {
+	return strings.HasPrefix(id, stripeSubscriptionIDPrefix)
+}
+
+// createSubscription starts a Stripe Billing subscription for an auto-renewing plan.
+// The subscription is created incomplete: its first invoice is paid on the checkout
+// page with the returned client secret, the same way as a one-off PaymentIntent, and
+// the payment method is saved to charge later renewals.
+func (s *Stripe) createSubscription(ctx context.Context, req payment.CreatePaymentRequest, methods []string) (*payment.CreatePaymentResponse, error) {
+	var pmTypes []*string
+	for _, m := range methods {
+		if stripeRecurringMethods[m] {
+			pmTypes = append(pmTypes, stripe.String(m))
+		}
+	}
+	if len(pmTypes) == 0 {
+		return nil, fmt.Errorf("stripe create subscription: no configured payment method supports recurring charges")
+	}
+
+	cp := &stripe.CustomerCreateParams{
+		Description: stripe.String(req.Subject),
+		Metadata:    map[string]string{"orderId": req.OrderID},
+	}
+	if req.PayerEmail != "" {
+		cp.Email = stripe.String(req.PayerEmail)
+	}
+	cp.SetIdempotencyKey(fmt.Sprintf("cus-%s", req.OrderID))
+	cus, err := s.sc.V1Customers.Create(ctx, cp)
+	if err != nil {
+		return nil, fmt.Errorf("stripe create customer: %w", err)
+	}
+
+	params := &stripe.SubscriptionCreateParams{
+		Customer:        stripe.String(cus.ID),
+		Items:           []*stripe.SubscriptionCreateItemParams{{Price: stripe.String(req.RecurringPriceID)}},
+		PaymentBehavior: stripe.String("default_incomplete"),
+		PaymentSettings: &stripe.SubscriptionCreatePaymentSettingsParams{
+			PaymentMethodTypes:       pmTypes,
+			SaveDefaultPaymentMethod: stripe.String("on_subscription"),
+		},
+		Metadata: map[string]string{"orderId": req.OrderID},
+	}
+	params.AddExpand("latest_invoice.confirmation_secret")
+	params.SetIdempotencyKey(fmt.Sprintf("sub-%s", req.OrderID))
+
+	sub, err := s.sc.V1Subscriptions.Create(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("stripe create subscription: %w", err)
+	}
+	if sub.LatestInvoice == nil || sub.LatestInvoice.ConfirmationSecret == nil {
+		return nil, fmt.Errorf("stripe create subscription: first invoice of %s has no confirmation secret", sub.ID)
+	}
+
+	return &payment.CreatePaymentResponse{
+		TradeNo:        sub.ID,
+		ClientSecret:   sub.LatestInvoice.ConfirmationSecret.ClientSecret,
+		SubscriptionID: sub.ID,
+	}, nil
+}
+
+// querySubscription reports an auto-renewal checkout as paid once its subscription
+// is active, i.e. the first invoice has been paid.
+func (s *Stripe) querySubscription(ctx context.Context, subscriptionID string) (*payment.QueryOrderResponse, error) {
+	sub, err := s.sc.V1Subscriptions.Retrieve(ctx, subscriptionID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("stripe query subscription: %w", err)
+	}
+	status := payment.ProviderStatusPending
+	switch sub.Status {
+	case stripe.SubscriptionStatusActive, stripe.SubscriptionStatusTrialing:
+		status = payment.ProviderStatusPaid
+	case stripe.SubscriptionStatusIncompleteExpired, stripe.SubscriptionStatusCanceled:
+		status = payment.ProviderStatusFailed
+	}
+	return &payment.QueryOrderResponse{
+		TradeNo: sub.ID,
+		Status:  status,
+	}, nil
+}
+
+// CancelRecurring cancels a Stripe subscription immediately. The period already paid
+// for stays credited to the user; Stripe simply stops creating renewal invoices.
+func (s *Stripe) CancelRecurring(ctx context.Context, subscriptionID string) error {
+	s.ensureInit()
+
+	_, err := s.sc.V1Subscriptions.Cancel(ctx, subscriptionID, nil)
+	if err != nil {
+		return fmt.Errorf("stripe cancel subscription: %w", err)
+	}
+	return nil
+}
+
+// refundPaymentIntentID resolves the PaymentIntent to refund for an order's trade number.
+// Renewal orders record the Stripe invoice, and the initial order of an auto-renewal
+// records the subscription; both were charged through an invoice PaymentIntent.
+func (s *Stripe) refundPaymentIntentID(ctx context.Context, tradeNo string) (string, error) {
+	switch {
+	case strings.HasPrefix(tradeNo, stripeInvoiceIDPrefix):
+		return s.invoicePaymentIntentID(ctx, tradeNo)
+	case isStripeSubscriptionID(tradeNo):
+		params := &stripe.InvoiceListParams{Subscription: stripe.String(tradeNo)}
+		for inv, err := range s.sc.V1Invoices.List(ctx, params).All(ctx) {
+			if err != nil {
+				return "", fmt.Errorf("list invoices of %s: %w", tradeNo, err)
+			}
+			if inv.BillingReason == stripe.InvoiceBillingReasonSubscriptionCreate {
+				return s.invoicePaymentIntentID(ctx, inv.ID)
+			}
+		}
+		return "", fmt.Errorf("initial invoice of %s not found", tradeNo)
+	}
+	return tradeNo, nil
+}
+
+func (s *Stripe) invoicePaymentIntentID(ctx context.Context, invoiceID string) (string, error) {
+	params := &stripe.InvoiceRetrieveParams{}
+	params.AddExpand("payments")
+	inv, err := s.sc.V1Invoices.Retrieve(ctx, invoiceID, params)
+	if err != nil {
+		return "", fmt.Errorf("retrieve invoice %s: %w", invoiceID, err)
+	}
+	if inv.Payments != nil {
+		for _, p := range inv.Payments.Data {
+			if p.Status == stripeInvoicePaymentPaid && p.Payment != nil && p.Payment.PaymentIntent != nil {
+				return p.Payment.PaymentIntent.ID, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("invoice %s has no paid PaymentIntent", invoiceID)
+}
+
+// parseStripeInvoice maps invoice.paid / invoice.payment_failed of a subscription
+// invoice to a recurring notification. Invoices not created by a subscription are ignored.
+func parseStripeInvoice(event *stripe.Event, kind, rawBody string) (*payment.PaymentNotification, error) {
+	var inv stripe.Invoice
+	if err := json.Unmarshal(event.Data.Raw, &inv); err != nil {
+		return nil, fmt.Errorf("stripe parse invoice: %w", err)
+	}
+	if inv.Parent == nil || inv.Parent.SubscriptionDetails == nil || inv.Parent.SubscriptionDetails.Subscription == nil {
+		return nil, nil
+	}
+	details := inv.Parent.SubscriptionDetails
+	rec := &payment.RecurringEvent{
+		Kind:           kind,
+		SubscriptionID: details.Subscription.ID,
+		InvoiceID:      inv.ID,
+		Initial:        inv.BillingReason == stripe.InvoiceBillingReasonSubscriptionCreate,
+		PeriodEnd:      stripeInvoicePeriodEnd(&inv),
+	}
+	status := payment.ProviderStatusSuccess
+	if kind != payment.RecurringEventPaid {
+		status = payment.ProviderStatusFailed
+	}
+	// The initial order is tracked by subscription ID from checkout on; renewals by invoice.
+	tradeNo := inv.ID
+	if rec.Initial {
+		tradeNo = rec.SubscriptionID
+	}
+	return &payment.PaymentNotification{
+		TradeNo:   tradeNo,
+		OrderID:   details.Metadata["orderId"],
+		Amount:    payment.FenToYuan(inv.AmountPaid),
+		Status:    status,
+		RawData:   rawBody,
+		Recurring: rec,
+	}, nil
+}
+
+func parseStripeSubscriptionDeleted(event *stripe.Event, rawBody string) (*payment.PaymentNotification, error) {
+	var sub stripe.Subscription
+	if err := json.Unmarshal(event.Data.Raw, &sub); err != nil {
+		return nil, fmt.Errorf("stripe parse subscription: %w", err)
+	}
+	return &payment.PaymentNotification{
+		TradeNo: sub.ID,
+		OrderID: sub.Metadata["orderId"],
+		Status:  payment.ProviderStatusFailed,
+		RawData: rawBody,
+		Recurring: &payment.RecurringEvent{
+			Kind:           payment.RecurringEventCanceled,
+			SubscriptionID: sub.ID,
+		},
+	}, nil
+}
+
+// stripeInvoicePeriodEnd returns the end of the subscription period an invoice pays for.
+// The invoice's own period_end marks the previous cycle, so the line item period is used.
+func stripeInvoicePeriodEnd(inv *stripe.Invoice) time.Time {
+	if inv.Lines == nil {
+		return time.Time{}
+	}
+	var end int64
+	for _, line := range inv.Lines.Data {
+		if line.Period != nil && line.Period.End > end {
+			end = line.Period.End
+		}
+	}
+	if end == 0 {
+		return time.Time{}
+	}
+	return time.Unix(end, 0)
+}
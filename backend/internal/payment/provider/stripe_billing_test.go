@@ -0,0 +1,123 @@
+//go:build unit
+
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/Wei-Shaw/sub2api/internal/payment"
+	stripe "github.com/stripe/stripe-go/v85"
+)
+
+func stripeTestEvent(t *testing.T, obj map[string]any) *stripe.Event {
+	t.Helper()
+	raw, err := json.Marshal(obj)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	return &stripe.Event{Data: &stripe.EventData{Raw: raw}}
+}
+
+func stripeTestInvoice(reason string) map[string]any {
+	return map[string]any{
+		"id":             "in_123",
+		"object":         "invoice",
+		"amount_paid":    2990,
+		"billing_reason": reason,
+		"parent": map[string]any{
+			"type": "subscription_details",
+			"subscription_details": map[string]any{
+				"subscription": "sub_abc",
+				"metadata":     map[string]string{"orderId": "sub2_20260101abcdefgh"},
+			},
+		},
+		"lines": map[string]any{
+			"object": "list",
+			"data": []map[string]any{
+				{"id": "il_1", "period": map[string]any{"start": 1767225600, "end": 1769904000}},
+			},
+		},
+	}
+}
+
+func TestParseStripeInvoice_Renewal(t *testing.T) {
+	n, err := parseStripeInvoice(stripeTestEvent(t, stripeTestInvoice("subscription_cycle")), payment.RecurringEventPaid, "raw")
+	if err != nil || n == nil {
+		t.Fatalf("parse: n=%v err=%v", n, err)
+	}
+	if n.Recurring == nil || n.Recurring.Initial {
+		t.Fatalf("expected non-initial recurring event, got %+v", n.Recurring)
+	}
+	// 续费订单以发票号作为交易号
+	if n.TradeNo != "in_123" || n.Recurring.InvoiceID != "in_123" || n.Recurring.SubscriptionID != "sub_abc" {
+		t.Fatalf("unexpected ids: tradeNo=%s event=%+v", n.TradeNo, n.Recurring)
+	}
+	if n.Status != payment.ProviderStatusSuccess || n.Amount != 29.90 || n.OrderID != "sub2_20260101abcdefgh" {
+		t.Fatalf("unexpected notification: %+v", n)
+	}
+	if n.Recurring.PeriodEnd.Unix() != 1769904000 {
+		t.Fatalf("period end = %v", n.Recurring.PeriodEnd)
+	}
+}
+
+func TestParseStripeInvoice_InitialUsesSubscriptionTradeNo(t *testing.T) {
+	n, err := parseStripeInvoice(stripeTestEvent(t, stripeTestInvoice("subscription_create")), payment.RecurringEventPaid, "raw")
+	if err != nil || n == nil {
+		t.Fatalf("parse: n=%v err=%v", n, err)
+	}
+	// 首期订单在结账时已记录订阅 ID
+	if !n.Recurring.Initial || n.TradeNo != "sub_abc" {
+		t.Fatalf("expected initial event traded by subscription, got tradeNo=%s event=%+v", n.TradeNo, n.Recurring)
+	}
+}
+
+func TestParseStripeInvoice_PaymentFailed(t *testing.T) {
+	n, err := parseStripeInvoice(stripeTestEvent(t, stripeTestInvoice("subscription_cycle")), payment.RecurringEventPaymentFailed, "raw")
+	if err != nil || n == nil {
+		t.Fatalf("parse: n=%v err=%v", n, err)
+	}
+	if n.Status != payment.ProviderStatusFailed || n.Recurring.Kind != payment.RecurringEventPaymentFailed {
+		t.Fatalf("unexpected notification: %+v / %+v", n, n.Recurring)
+	}
+}
+
+func TestParseStripeInvoice_IgnoresNonSubscriptionInvoice(t *testing.T) {
+	inv := stripeTestInvoice("manual")
+	delete(inv, "parent")
+	n, err := parseStripeInvoice(stripeTestEvent(t, inv), payment.RecurringEventPaid, "raw")
+	if err != nil || n != nil {
+		t.Fatalf("expected nil notification, got n=%v err=%v", n, err)
+	}
+}
+
+func TestParseStripeSubscriptionDeleted(t *testing.T) {
+	ev := stripeTestEvent(t, map[string]any{
+		"id":       "sub_abc",
+		"object":   "subscription",
+		"status":   "canceled",
+		"metadata": map[string]string{"orderId": "sub2_20260101abcdefgh"},
+	})
+	n, err := parseStripeSubscriptionDeleted(ev, "raw")
+	if err != nil || n == nil {
+		t.Fatalf("parse: n=%v err=%v", n, err)
+	}
+	if n.Recurring == nil || n.Recurring.Kind != payment.RecurringEventCanceled || n.Recurring.SubscriptionID != "sub_abc" {
+		t.Fatalf("unexpected event: %+v", n.Recurring)
+	}
+}
+
+func TestParseStripePaymentIntent_IgnoresInvoicePaymentIntent(t *testing.T) {
+	// Stripe Billing 为订阅发票创建的 PaymentIntent 不带 orderId，由发票事件处理
+	ev := stripeTestEvent(t, map[string]any{"id": "pi_1", "object": "payment_intent", "amount": 2990})
+	n, err := parseStripePaymentIntent(ev, payment.ProviderStatusSuccess, "raw")
+	if err != nil || n != nil {
+		t.Fatalf("expected nil notification, got n=%v err=%v", n, err)
+	}
+
+	ev = stripeTestEvent(t, map[string]any{"id": "pi_2", "object": "payment_intent", "amount": 1000, "metadata": map[string]string{"orderId": "sub2_x"}})
+	n, err = parseStripePaymentIntent(ev, payment.ProviderStatusSuccess, "raw")
+	if err != nil || n == nil || n.OrderID != "sub2_x" || n.Amount != 10 {
+		t.Fatalf("unexpected notification: n=%+v err=%v", n, err)
+	}
+}
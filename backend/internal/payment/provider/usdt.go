@@ -0,0 +1,628 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/payment"
+	"github.com/shopspring/decimal"
+)
+
+// USDT constants.
+const (
+	usdtNetworkTron = "tron"
+	usdtNetworkEVM  = "evm"
+
+	usdtTronAPIBase      = "https://api.trongrid.io"
+	usdtTronContract     = "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t"
+	usdtDefaultDecimals  = 6
+	usdtTronConfirmDepth = 19
+	usdtEVMConfirmDepth  = 12
+	usdtHTTPTimeout      = 15 * time.Second
+	maxUSDTResponseSize  = 4 << 20 // 4MB
+	usdtTronPageLimit    = 200
+	usdtTronMaxPages     = 5
+
+	// Invoice amounts are expressed in micro-units (6 decimals). The base amount is
+	// rounded to cents and the last four digits carry a per-order tag chosen so that no
+	// two unsettled invoices share an amount, and a transfer can be attributed to its
+	// order on a shared receiving address by its full amount.
+	usdtMicroPlaces  = 6
+	usdtTagModulus   = 10000
+	usdtTradeNoParts = 4
+	usdtTradeNoTag   = "usdt"
+	usdtTradeNoSep   = ":"
+	// usdtTronStartSkew widens the TRON search window to absorb clock skew between us and the chain.
+	usdtTronStartSkew = 2 * time.Minute
+
+	// evmTransferTopic is keccak256("Transfer(address,address,uint256)").
+	evmTransferTopic = "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"
+)
+
+// USDT implements payment.Provider for USDT transfers on TRON (TRC20) or an EVM chain (ERC20/BEP20).
+//
+// Flow: CreatePayment issues an amount-tagged invoice on the instance's receiving address,
+// distinct from every unsettled invoice the service reports in ReservedInvoices. TradeNo encodes the out_trade_no, the invoiced micro-amount and the chain position at
+// creation ("usdt:<out_trade_no>:<micro>:<start>"), so QueryOrder can scan incoming
+// transfers statelessly. There are no webhooks: the payment service polls QueryOrder
+// for pending orders and fulfills them once the matched transfer is deep enough.
+type USDT struct {
+	instanceID string
+	config     map[string]string
+	network    string
+	apiBase    string
+	address    string
+	contract   string
+	decimals   int32
+	required   int
+	// exchangeRate is the CNY price of 1 USDT used to convert order amounts.
+	exchangeRate decimal.Decimal
+	// tolerance is how far (in USDT) a transfer may deviate from the invoice and still match it.
+	tolerance  decimal.Decimal
+	httpClient *http.Client
+}
+
+// NewUSDT creates a new USDT provider.
+// config keys: network ("tron" or "evm"), address, exchangeRate (CNY per USDT),
+// contract, apiBase (TronGrid base URL or EVM JSON-RPC URL), apiKey, decimals,
+// confirmations, amountTolerance
+func NewUSDT(instanceID string, config map[string]string) (*USDT, error) {
+	for _, k := range []string{"network", "address", "exchangeRate"} {
+		if strings.TrimSpace(config[k]) == "" {
+			return nil, fmt.Errorf("usdt config missing required key: %s", k)
+		}
+	}
+	u := &USDT{
+		instanceID: instanceID,
+		config:     config,
+		network:    strings.ToLower(strings.TrimSpace(config["network"])),
+		apiBase:    strings.TrimRight(strings.TrimSpace(config["apiBase"]), "/"),
+		address:    strings.TrimSpace(config["address"]),
+		contract:   strings.TrimSpace(config["contract"]),
+		decimals:   usdtDefaultDecimals,
+		httpClient: &http.Client{Timeout: usdtHTTPTimeout},
+	}
+	switch u.network {
+	case usdtNetworkTron:
+		u.required = usdtTronConfirmDepth
+		if u.apiBase == "" {
+			u.apiBase = usdtTronAPIBase
+		}
+		if u.contract == "" {
+			u.contract = usdtTronContract
+		}
+	case usdtNetworkEVM:
+		u.required = usdtEVMConfirmDepth
+		if u.apiBase == "" || u.contract == "" {
+			return nil, fmt.Errorf("usdt evm network requires apiBase and contract")
+		}
+		if !isEVMAddress(u.address) || !isEVMAddress(u.contract) {
+			return nil, fmt.Errorf("usdt config invalid evm address")
+		}
+		u.address = strings.ToLower(u.address)
+		u.contract = strings.ToLower(u.contract)
+	default:
+		return nil, fmt.Errorf("usdt config invalid network: %s", config["network"])
+	}
+
+	rate, err := decimal.NewFromString(strings.TrimSpace(config["exchangeRate"]))
+	if err != nil || !rate.IsPositive() {
+		return nil, fmt.Errorf("usdt config invalid exchangeRate: %s", config["exchangeRate"])
+	}
+	u.exchangeRate = rate
+	if raw := strings.TrimSpace(config["decimals"]); raw != "" {
+		n, err := strconv.Atoi(raw)
+		if err != nil || n < 0 || n > 36 {
+			return nil, fmt.Errorf("usdt config invalid decimals: %s", raw)
+		}
+		u.decimals = int32(n)
+	}
+	if raw := strings.TrimSpace(config["confirmations"]); raw != "" {
+		n, err := strconv.Atoi(raw)
+		if err != nil || n < 1 {
+			return nil, fmt.Errorf("usdt config invalid confirmations: %s", raw)
+		}
+		u.required = n
+	}
+	if raw := strings.TrimSpace(config["amountTolerance"]); raw != "" {
+		d, err := decimal.NewFromString(raw)
+		if err != nil || d.IsNegative() {
+			return nil, fmt.Errorf("usdt config invalid amountTolerance: %s", raw)
+		}
+		u.tolerance = d
+	}
+	return u, nil
+}
+
+func (u *USDT) Name() string        { return "USDT" }
+func (u *USDT) ProviderKey() string { return payment.TypeUSDT }
+func (u *USDT) SupportedTypes() []payment.PaymentType {
+	return []payment.PaymentType{payment.TypeUSDT}
+}
+
+// usdtInvoice is the decoded form of a USDT TradeNo.
+type usdtInvoice struct {
+	OutTradeNo string
+	Micro      int64 // invoiced amount in micro-USDT, tag included
+	Start      int64 // TRON: earliest block timestamp (ms); EVM: first block to scan
+}
+
+// usdtTransfer is an incoming token transfer normalised across networks.
+type usdtTransfer struct {
+	TxHash        string
+	Micro         int64
+	Confirmations int
+	Timestamp     time.Time
+}
+
+// CreatePayment issues an amount-tagged invoice payable to the receiving address.
+// QRCode carries the bare receiving address, which every wallet can scan.
+func (u *USDT) CreatePayment(ctx context.Context, req payment.CreatePaymentRequest) (*payment.CreatePaymentResponse, error) {
+	micro, err := u.invoiceMicro(req.Amount, req.OrderID, req.ReservedInvoices)
+	if err != nil {
+		return nil, fmt.Errorf("usdt create payment: %w", err)
+	}
+	start, err := u.startMarker(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("usdt create payment: %w", err)
+	}
+	inv := usdtInvoice{OutTradeNo: req.OrderID, Micro: micro, Start: start}
+	return &payment.CreatePaymentResponse{
+		TradeNo:      inv.String(),
+		QRCode:       u.address,
+		CryptoAmount: microToDecimal(micro).StringFixed(usdtMicroPlaces),
+		CryptoAsset:  u.assetLabel(),
+	}, nil
+}
+
+// QueryOrder scans transfers to the receiving address since the invoice was issued and
+// reports the transfer carrying the invoiced amount. Amount is left at zero: the CNY amount of a paid invoice
+// is the order's own pay amount, and the token amounts are reported in Chain.
+func (u *USDT) QueryOrder(ctx context.Context, tradeNo string) (*payment.QueryOrderResponse, error) {
+	inv, err := parseUSDTInvoice(tradeNo)
+	if err != nil {
+		return nil, fmt.Errorf("usdt query order: %w", err)
+	}
+	var transfers []usdtTransfer
+	if u.network == usdtNetworkTron {
+		transfers, err = u.tronTransfers(ctx, inv.Start)
+	} else {
+		transfers, err = u.evmTransfers(ctx, inv.Start)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("usdt query order: %w", err)
+	}
+
+	resp := &payment.QueryOrderResponse{TradeNo: tradeNo, Status: payment.ProviderStatusPending}
+	match := u.matchTransfer(inv, transfers)
+	if match == nil {
+		resp.Unmatched = u.unmatchedTransfers(inv, transfers)
+		return resp, nil
+	}
+	resp.TradeNo = match.TxHash
+	resp.Chain = &payment.ChainPayment{
+		TxHash:        match.TxHash,
+		Confirmations: match.Confirmations,
+		Required:      u.required,
+		Expected:      microToDecimal(inv.Micro).StringFixed(usdtMicroPlaces),
+		Received:      microToDecimal(match.Micro).StringFixed(usdtMicroPlaces),
+	}
+	if match.Confirmations < u.required {
+		resp.Status = payment.ProviderStatusConfirming
+		return resp, nil
+	}
+	resp.Status = payment.ProviderStatusPaid
+	if !match.Timestamp.IsZero() {
+		resp.PaidAt = match.Timestamp.UTC().Format(time.RFC3339)
+	}
+	return resp, nil
+}
+
+// VerifyNotification is not supported: USDT payments are detected by polling the chain.
+func (u *USDT) VerifyNotification(_ context.Context, _ string, _ map[string]string) (*payment.PaymentNotification, error) {
+	return nil, nil
+}
+
+// Refund is not supported: on-chain transfers must be returned manually from the receiving wallet.
+func (u *USDT) Refund(_ context.Context, req payment.RefundRequest) (*payment.RefundResponse, error) {
+	return nil, fmt.Errorf("usdt refund: on-chain payments must be refunded manually (tx %s)", req.TradeNo)
+}
+
+// matchTransfer picks the transfer whose full amount equals the invoice within the
+// tolerance; among matches the deepest (earliest) transfer wins. Transfers of any other
+// amount are never attributed to the invoice: a partial or excess payment cannot be told
+// apart from a payment for another order and is reported in Unmatched for manual follow-up.
+func (u *USDT) matchTransfer(inv *usdtInvoice, transfers []usdtTransfer) *usdtTransfer {
+	tolerance := u.toleranceMicro()
+	var best *usdtTransfer
+	for i := range transfers {
+		t := &transfers[i]
+		if absMicro(t.Micro-inv.Micro) > tolerance {
+			continue
+		}
+		if best == nil || t.Confirmations > best.Confirmations {
+			best = t
+		}
+	}
+	return best
+}
+
+// unmatchedTransfers reports the confirmed transfers in the invoice window whose amount is
+// outside the invoice tolerance, so the service can surface them for manual settlement.
+// Unconfirmed transfers are left out until they are final.
+func (u *USDT) unmatchedTransfers(inv *usdtInvoice, transfers []usdtTransfer) []payment.ChainPayment {
+	tolerance := u.toleranceMicro()
+	expected := microToDecimal(inv.Micro).StringFixed(usdtMicroPlaces)
+	var out []payment.ChainPayment
+	for _, t := range transfers {
+		if absMicro(t.Micro-inv.Micro) <= tolerance || t.Confirmations < u.required {
+			continue
+		}
+		out = append(out, payment.ChainPayment{
+			TxHash:        t.TxHash,
+			Confirmations: t.Confirmations,
+			Required:      u.required,
+			Expected:      expected,
+			Received:      microToDecimal(t.Micro).StringFixed(usdtMicroPlaces),
+		})
+	}
+	return out
+}
+
+// --- TRON (TronGrid) ---
+
+type tronTRC20Page struct {
+	Data []struct {
+		TransactionID  string `json:"transaction_id"`
+		BlockTimestamp int64  `json:"block_timestamp"`
+		To             string `json:"to"`
+		Value          string `json:"value"`
+		TokenInfo      struct {
+			Address string `json:"address"`
+		} `json:"token_info"`
+	} `json:"data"`
+	Meta struct {
+		Fingerprint string `json:"fingerprint"`
+	} `json:"meta"`
+}
+
+func (u *USDT) tronTransfers(ctx context.Context, minTimestamp int64) ([]usdtTransfer, error) {
+	q := url.Values{}
+	q.Set("only_to", "true")
+	q.Set("contract_address", u.contract)
+	q.Set("min_timestamp", strconv.FormatInt(minTimestamp, 10))
+	q.Set("limit", strconv.Itoa(usdtTronPageLimit))
+	q.Set("order_by", "block_timestamp,asc")
+
+	var out []usdtTransfer
+	for page := 0; page < usdtTronMaxPages; page++ {
+		var resp tronTRC20Page
+		path := "/v1/accounts/" + url.PathEscape(u.address) + "/transactions/trc20?" + q.Encode()
+		if err := u.tronCall(ctx, http.MethodGet, path, nil, &resp); err != nil {
+			return nil, err
+		}
+		for _, d := range resp.Data {
+			if d.To != u.address || d.TokenInfo.Address != u.contract {
+				continue
+			}
+			micro, ok := u.toMicro(d.Value, 10)
+			if !ok {
+				continue
+			}
+			out = append(out, usdtTransfer{TxHash: d.TransactionID, Micro: micro, Timestamp: time.UnixMilli(d.BlockTimestamp)})
+		}
+		if resp.Meta.Fingerprint == "" || len(resp.Data) < usdtTronPageLimit {
+			break
+		}
+		q.Set("fingerprint", resp.Meta.Fingerprint)
+	}
+	if len(out) == 0 {
+		return nil, nil
+	}
+
+	head, err := u.tronHeadBlock(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range out {
+		var info struct {
+			BlockNumber int64 `json:"blockNumber"`
+			Receipt     struct {
+				Result string `json:"result"`
+			} `json:"receipt"`
+		}
+		if err := u.tronCall(ctx, http.MethodPost, "/wallet/gettransactioninfobyid", map[string]any{"value": out[i].TxHash}, &info); err != nil {
+			return nil, err
+		}
+		if info.BlockNumber > 0 && (info.Receipt.Result == "" || info.Receipt.Result == "SUCCESS") {
+			out[i].Confirmations = int(head - info.BlockNumber + 1)
+		}
+	}
+	return out, nil
+}
+
+func (u *USDT) tronHeadBlock(ctx context.Context) (int64, error) {
+	var block struct {
+		BlockHeader struct {
+			RawData struct {
+				Number int64 `json:"number"`
+			} `json:"raw_data"`
+		} `json:"block_header"`
+	}
+	if err := u.tronCall(ctx, http.MethodPost, "/wallet/getnowblock", map[string]any{}, &block); err != nil {
+		return 0, err
+	}
+	return block.BlockHeader.RawData.Number, nil
+}
+
+func (u *USDT) tronCall(ctx context.Context, method, path string, body any, out any) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("marshal request: %w", err)
+		}
+		reader = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u.apiBase+path, reader)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if key := u.config["apiKey"]; key != "" {
+		req.Header.Set("TRON-PRO-API-KEY", key)
+	}
+	return u.send(req, out)
+}
+
+// --- EVM (JSON-RPC) ---
+
+type evmLog struct {
+	TransactionHash string `json:"transactionHash"`
+	BlockNumber     string `json:"blockNumber"`
+	Data            string `json:"data"`
+	Removed         bool   `json:"removed"`
+}
+
+func (u *USDT) evmTransfers(ctx context.Context, fromBlock int64) ([]usdtTransfer, error) {
+	head, err := u.evmBlockNumber(ctx)
+	if err != nil {
+		return nil, err
+	}
+	filter := map[string]any{
+		"fromBlock": "0x" + strconv.FormatInt(fromBlock, 16),
+		"toBlock":   "latest",
+		"address":   u.contract,
+		"topics":    []any{evmTransferTopic, nil, evmAddressTopic(u.address)},
+	}
+	var logs []evmLog
+	if err := u.rpcCall(ctx, "eth_getLogs", []any{filter}, &logs); err != nil {
+		return nil, err
+	}
+	out := make([]usdtTransfer, 0, len(logs))
+	for _, l := range logs {
+		if l.Removed {
+			continue
+		}
+		block, err := strconv.ParseInt(strings.TrimPrefix(l.BlockNumber, "0x"), 16, 64)
+		if err != nil {
+			continue
+		}
+		micro, ok := u.toMicro(strings.TrimPrefix(l.Data, "0x"), 16)
+		if !ok {
+			continue
+		}
+		out = append(out, usdtTransfer{TxHash: l.TransactionHash, Micro: micro, Confirmations: int(head - block + 1)})
+	}
+	return out, nil
+}
+
+func (u *USDT) evmBlockNumber(ctx context.Context) (int64, error) {
+	var hex string
+	if err := u.rpcCall(ctx, "eth_blockNumber", []any{}, &hex); err != nil {
+		return 0, err
+	}
+	n, err := strconv.ParseInt(strings.TrimPrefix(hex, "0x"), 16, 64)
+	if err != nil {
+		return 0, fmt.Errorf("parse block number %q: %w", hex, err)
+	}
+	return n, nil
+}
+
+// usdtRPCError is a JSON-RPC error object.
+type usdtRPCError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *usdtRPCError) Error() string {
+	return fmt.Sprintf("rpc error: code=%d message=%s", e.Code, e.Message)
+}
+
+func (u *USDT) rpcCall(ctx context.Context, method string, params []any, out any) error {
+	data, err := json.Marshal(map[string]any{"jsonrpc": "2.0", "id": 1, "method": method, "params": params})
+	if err != nil {
+		return fmt.Errorf("marshal request: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u.apiBase, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	var resp struct {
+		Result json.RawMessage `json:"result"`
+		Error  *usdtRPCError   `json:"error"`
+	}
+	if err := u.send(req, &resp); err != nil {
+		return err
+	}
+	if resp.Error != nil {
+		return fmt.Errorf("%s: %w", method, resp.Error)
+	}
+	if err := json.Unmarshal(resp.Result, out); err != nil {
+		return fmt.Errorf("%s: parse result: %w", method, err)
+	}
+	return nil
+}
+
+func (u *USDT) send(req *http.Request, out any) error {
+	resp, err := u.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxUSDTResponseSize))
+	if err != nil {
+		return fmt.Errorf("read response: %w", err)
+	}
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("chain api error: status=%d body=%s", resp.StatusCode, truncateRunes(string(data), 200))
+	}
+	if err := json.Unmarshal(data, out); err != nil {
+		return fmt.Errorf("parse response: %w", err)
+	}
+	return nil
+}
+
+// --- Invoice helpers ---
+
+// startMarker returns where QueryOrder begins scanning for this invoice.
+func (u *USDT) startMarker(ctx context.Context) (int64, error) {
+	if u.network == usdtNetworkTron {
+		return time.Now().Add(-usdtTronStartSkew).UnixMilli(), nil
+	}
+	return u.evmBlockNumber(ctx)
+}
+
+// invoiceMicro converts a CNY amount to micro-USDT: the converted amount is rounded
+// up to cents and a tag fills the four digits below. The tag starts at the order's hash
+// and moves on until no reserved invoice lies within twice the tolerance, so a single
+// transfer can never match two unsettled invoices.
+func (u *USDT) invoiceMicro(amount, outTradeNo string, reserved []string) (int64, error) {
+	cny, err := decimal.NewFromString(amount)
+	if err != nil || !cny.IsPositive() {
+		return 0, fmt.Errorf("invalid amount: %s", amount)
+	}
+	base := cny.Div(u.exchangeRate).RoundCeil(2).Shift(usdtMicroPlaces).IntPart()
+
+	taken := make([]int64, 0, len(reserved))
+	for _, tradeNo := range reserved {
+		if inv, err := parseUSDTInvoice(tradeNo); err == nil {
+			taken = append(taken, inv.Micro)
+		}
+	}
+	gap := 2 * u.toleranceMicro()
+	first := usdtAmountTag(outTradeNo)
+	for i := int64(0); i < usdtTagModulus-1; i++ {
+		micro := base + (first-1+i)%(usdtTagModulus-1) + 1
+		free := true
+		for _, r := range taken {
+			if absMicro(micro-r) <= gap {
+				free = false
+				break
+			}
+		}
+		if free {
+			return micro, nil
+		}
+	}
+	return 0, fmt.Errorf("no distinct invoice amount available near %s USDT, too many unsettled invoices", microToDecimal(base).StringFixed(2))
+}
+
+// toleranceMicro returns the amount tolerance in micro-USDT.
+func (u *USDT) toleranceMicro() int64 {
+	return u.tolerance.Shift(usdtMicroPlaces).Truncate(0).IntPart()
+}
+
+// toMicro converts a raw token amount (base units, in the given base) to micro-USDT.
+// Sub-micro precision is truncated.
+func (u *USDT) toMicro(raw string, base int) (int64, bool) {
+	v, ok := new(big.Int).SetString(raw, base)
+	if !ok || v.Sign() < 0 {
+		return 0, false
+	}
+	d := decimal.NewFromBigInt(v, -u.decimals).Shift(usdtMicroPlaces).Truncate(0)
+	if !d.BigInt().IsInt64() {
+		return 0, false
+	}
+	return d.IntPart(), true
+}
+
+func (u *USDT) assetLabel() string {
+	if u.network == usdtNetworkTron {
+		return "USDT-TRC20"
+	}
+	return "USDT-EVM"
+}
+
+// usdtAmountTag derives the preferred per-order tag (1..9999 micro-USDT) from out_trade_no.
+func usdtAmountTag(outTradeNo string) int64 {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(outTradeNo))
+	return int64(h.Sum32()%(usdtTagModulus-1)) + 1
+}
+
+func absMicro(v int64) int64 {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+func microToDecimal(micro int64) decimal.Decimal {
+	return decimal.New(micro, -usdtMicroPlaces)
+}
+
+func (inv usdtInvoice) String() string {
+	return strings.Join([]string{usdtTradeNoTag, inv.OutTradeNo, strconv.FormatInt(inv.Micro, 10), strconv.FormatInt(inv.Start, 10)}, usdtTradeNoSep)
+}
+
+func parseUSDTInvoice(tradeNo string) (*usdtInvoice, error) {
+	parts := strings.Split(tradeNo, usdtTradeNoSep)
+	if len(parts) != usdtTradeNoParts || parts[0] != usdtTradeNoTag || parts[1] == "" {
+		return nil, fmt.Errorf("invalid usdt trade no: %s", tradeNo)
+	}
+	micro, err := strconv.ParseInt(parts[2], 10, 64)
+	if err != nil || micro <= 0 {
+		return nil, fmt.Errorf("invalid usdt trade no amount: %s", tradeNo)
+	}
+	start, err := strconv.ParseInt(parts[3], 10, 64)
+	if err != nil || start < 0 {
+		return nil, fmt.Errorf("invalid usdt trade no start: %s", tradeNo)
+	}
+	return &usdtInvoice{OutTradeNo: parts[1], Micro: micro, Start: start}, nil
+}
+
+func isEVMAddress(s string) bool {
+	if len(s) != 42 || !strings.HasPrefix(strings.ToLower(s), "0x") {
+		return false
+	}
+	for _, c := range strings.ToLower(s[2:]) {
+		if (c < '0' || c > '9') && (c < 'a' || c > 'f') {
+			return false
+		}
+	}
+	return true
+}
+
+// evmAddressTopic left-pads an address to a 32-byte log topic.
+func evmAddressTopic(addr string) string {
+	return "0x" + strings.Repeat("0", 24) + strings.TrimPrefix(addr, "0x")
+}
+
+// Ensure interface compliance.
+var _ payment.Provider = (*USDT)(nil)
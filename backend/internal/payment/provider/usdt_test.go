@@ -0,0 +1,385 @@
+//go:build unit
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/Wei-Shaw/sub2api/internal/payment"
+)
+
+const (
+	testTronAddress = "TXYZreceiving000000000000000000000"
+	testEVMAddress  = "0x1111111111111111111111111111111111111111"
+	testEVMContract = "0x2222222222222222222222222222222222222222"
+)
+
+// fakeTronTransfer is a TRC20 transfer served by fakeTron.
+type fakeTronTransfer struct {
+	txID   string
+	value  string
+	block  int64
+	result string
+}
+
+// fakeTron is a minimal local stand-in of the TronGrid API.
+type fakeTron struct {
+	mu        sync.Mutex
+	head      int64
+	transfers []fakeTronTransfer
+	apiKey    string
+}
+
+func newFakeTron(t *testing.T) (*fakeTron, *httptest.Server) {
+	t.Helper()
+	f := &fakeTron{head: 1000}
+	srv := httptest.NewServer(http.HandlerFunc(f.serve))
+	t.Cleanup(srv.Close)
+	return f, srv
+}
+
+func (f *fakeTron) serve(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.apiKey = r.Header.Get("TRON-PRO-API-KEY")
+
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/v1/accounts/"+testTronAddress+"/transactions/trc20":
+		if r.URL.Query().Get("only_to") != "true" || r.URL.Query().Get("contract_address") != usdtTronContract {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		data := make([]map[string]any, 0, len(f.transfers))
+		for _, tr := range f.transfers {
+			data = append(data, map[string]any{
+				"transaction_id":  tr.txID,
+				"block_timestamp": 1767225600000,
+				"to":              testTronAddress,
+				"value":           tr.value,
+				"token_info":      map[string]any{"address": usdtTronContract, "decimals": 6},
+			})
+		}
+		writeJSON(w, http.StatusOK, map[string]any{"data": data, "success": true, "meta": map[string]any{}})
+	case r.Method == http.MethodPost && r.URL.Path == "/wallet/getnowblock":
+		writeJSON(w, http.StatusOK, map[string]any{"block_header": map[string]any{"raw_data": map[string]any{"number": f.head}}})
+	case r.Method == http.MethodPost && r.URL.Path == "/wallet/gettransactioninfobyid":
+		var body struct {
+			Value string `json:"value"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		for _, tr := range f.transfers {
+			if tr.txID == body.Value {
+				writeJSON(w, http.StatusOK, map[string]any{"blockNumber": tr.block, "receipt": map[string]any{"result": tr.result}})
+				return
+			}
+		}
+		writeJSON(w, http.StatusOK, map[string]any{})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func newTestTronUSDT(t *testing.T, srv *httptest.Server, extra map[string]string) *USDT {
+	t.Helper()
+	cfg := map[string]string{
+		"network":      "tron",
+		"address":      testTronAddress,
+		"exchangeRate": "7.2",
+		"apiBase":      srv.URL,
+		"apiKey":       "tg-key",
+	}
+	for k, v := range extra {
+		cfg[k] = v
+	}
+	u, err := NewUSDT("1", cfg)
+	if err != nil {
+		t.Fatalf("NewUSDT: %v", err)
+	}
+	return u
+}
+
+func TestNewUSDT_Validation(t *testing.T) {
+	if _, err := NewUSDT("1", map[string]string{"network": "tron", "address": testTronAddress}); err == nil {
+		t.Fatal("expected error for missing exchangeRate")
+	}
+	if _, err := NewUSDT("1", map[string]string{"network": "btc", "address": "a", "exchangeRate": "7"}); err == nil {
+		t.Fatal("expected error for unknown network")
+	}
+	if _, err := NewUSDT("1", map[string]string{"network": "evm", "address": testEVMAddress, "exchangeRate": "7"}); err == nil {
+		t.Fatal("expected error for evm without apiBase/contract")
+	}
+	if _, err := NewUSDT("1", map[string]string{"network": "evm", "address": "0x12", "contract": testEVMContract, "apiBase": "http://rpc", "exchangeRate": "7"}); err == nil {
+		t.Fatal("expected error for malformed evm address")
+	}
+	if _, err := NewUSDT("1", map[string]string{"network": "tron", "address": testTronAddress, "exchangeRate": "0"}); err == nil {
+		t.Fatal("expected error for non-positive exchangeRate")
+	}
+	if _, err := NewUSDT("1", map[string]string{"network": "tron", "address": testTronAddress, "exchangeRate": "7", "confirmations": "0"}); err == nil {
+		t.Fatal("expected error for invalid confirmations")
+	}
+
+	u, err := NewUSDT("1", map[string]string{"network": "TRON", "address": testTronAddress, "exchangeRate": "7.2"})
+	if err != nil {
+		t.Fatalf("NewUSDT: %v", err)
+	}
+	if u.apiBase != usdtTronAPIBase || u.contract != usdtTronContract || u.required != usdtTronConfirmDepth {
+		t.Fatalf("unexpected tron defaults: base=%s contract=%s required=%d", u.apiBase, u.contract, u.required)
+	}
+
+	created, err := CreateProvider(payment.TypeUSDT, "1", map[string]string{"network": "tron", "address": testTronAddress, "exchangeRate": "7"})
+	if err != nil || created.ProviderKey() != payment.TypeUSDT {
+		t.Fatalf("CreateProvider usdt: %v", err)
+	}
+}
+
+func TestUSDT_InvoiceAmountAndTradeNo(t *testing.T) {
+	_, srv := newFakeTron(t)
+	u := newTestTronUSDT(t, srv, nil)
+
+	resp, err := u.CreatePayment(context.Background(), payment.CreatePaymentRequest{OrderID: "sub2_100", Amount: "72.00"})
+	if err != nil {
+		t.Fatalf("CreatePayment: %v", err)
+	}
+	tag := usdtAmountTag("sub2_100")
+	want := 10_000_000 + tag
+	if resp.CryptoAmount != fmt.Sprintf("10.%06d", tag) || resp.QRCode != testTronAddress || resp.CryptoAsset != "USDT-TRC20" {
+		t.Fatalf("unexpected invoice: %+v", resp)
+	}
+	inv, err := parseUSDTInvoice(resp.TradeNo)
+	if err != nil || inv.OutTradeNo != "sub2_100" || inv.Micro != want || inv.Start <= 0 {
+		t.Fatalf("unexpected trade no %q: %+v err=%v", resp.TradeNo, inv, err)
+	}
+
+	// 换算结果向上取整到分，标签落在分以下的四位
+	micro, err := u.invoiceMicro("10.00", "sub2_101", nil)
+	if err != nil || micro != 1_390_000+usdtAmountTag("sub2_101") {
+		t.Fatalf("invoiceMicro(10.00) = %d err=%v", micro, err)
+	}
+	if tag < 1 || tag >= usdtTagModulus {
+		t.Fatalf("tag out of range: %d", tag)
+	}
+	for _, bad := range []string{"", "usdt:sub2_1:abc:1", "paypal:sub2_1:100:1", "usdt::100:1"} {
+		if _, err := parseUSDTInvoice(bad); err == nil {
+			t.Fatalf("expected parse error for %q", bad)
+		}
+	}
+}
+
+func TestUSDT_TronConfirmations(t *testing.T) {
+	fake, srv := newFakeTron(t)
+	u := newTestTronUSDT(t, srv, map[string]string{"confirmations": "20"})
+	ctx := context.Background()
+
+	resp, err := u.CreatePayment(ctx, payment.CreatePaymentRequest{OrderID: "sub2_200", Amount: "72.00"})
+	if err != nil {
+		t.Fatalf("CreatePayment: %v", err)
+	}
+	inv, _ := parseUSDTInvoice(resp.TradeNo)
+
+	// 无转账：pending
+	q, err := u.QueryOrder(ctx, resp.TradeNo)
+	if err != nil || q.Status != payment.ProviderStatusPending || q.Chain != nil {
+		t.Fatalf("expected pending, got %+v err=%v", q, err)
+	}
+	if fake.apiKey != "tg-key" {
+		t.Fatalf("api key header not sent: %q", fake.apiKey)
+	}
+
+	// 金额不符的转账忽略；金额相符但确认数不足：confirming
+	fake.transfers = []fakeTronTransfer{
+		{txID: "tx-other", value: fmt.Sprint(inv.Micro + 1), block: 900, result: "SUCCESS"},
+		{txID: "tx-pay", value: fmt.Sprint(inv.Micro), block: 990, result: "SUCCESS"},
+	}
+	q, err = u.QueryOrder(ctx, resp.TradeNo)
+	if err != nil || q.Status != payment.ProviderStatusConfirming || q.TradeNo != "tx-pay" || q.Chain.Confirmations != 11 || q.Chain.Required != 20 {
+		t.Fatalf("expected confirming tx-pay, got %+v chain=%+v err=%v", q, q.Chain, err)
+	}
+
+	// 达到确认数：paid，金额由服务层按订单金额入账
+	fake.head = 1009
+	q, err = u.QueryOrder(ctx, resp.TradeNo)
+	if err != nil || q.Status != payment.ProviderStatusPaid || q.Amount != 0 || q.PaidAt == "" {
+		t.Fatalf("expected paid, got %+v chain=%+v err=%v", q, q.Chain, err)
+	}
+
+	// 执行失败的交易不计确认
+	fake.transfers[1].result = "REVERT"
+	q, err = u.QueryOrder(ctx, resp.TradeNo)
+	if err != nil || q.Status != payment.ProviderStatusConfirming || q.Chain.Confirmations != 0 {
+		t.Fatalf("expected reverted tx to stay unconfirmed, got %+v err=%v", q, err)
+	}
+}
+
+func TestUSDT_MatchesFullAmountOnly(t *testing.T) {
+	fake, srv := newFakeTron(t)
+	u := newTestTronUSDT(t, srv, map[string]string{"amountTolerance": "0.0001"})
+	ctx := context.Background()
+	resp, err := u.CreatePayment(ctx, payment.CreatePaymentRequest{OrderID: "sub2_300", Amount: "72.00"})
+	if err != nil {
+		t.Fatalf("CreatePayment: %v", err)
+	}
+	inv, _ := parseUSDTInvoice(resp.TradeNo)
+
+	// 少付、多付（尾数标签相同）均不归属该订单
+	fake.transfers = []fakeTronTransfer{
+		{txID: "tx-short", value: fmt.Sprint(inv.Micro - 5_000_000), block: 900, result: "SUCCESS"},
+		{txID: "tx-over", value: fmt.Sprint(inv.Micro + 3_000_000), block: 900, result: "SUCCESS"},
+	}
+	q, err := u.QueryOrder(ctx, resp.TradeNo)
+	if err != nil || q.Status != payment.ProviderStatusPending || q.Chain != nil {
+		t.Fatalf("expected tag-only matches to be ignored, got %+v err=%v", q, err)
+	}
+	// 金额不符的已确认转账交由服务层记录，供人工核对
+	if len(q.Unmatched) != 2 || q.Unmatched[0].TxHash != "tx-short" || q.Unmatched[1].TxHash != "tx-over" {
+		t.Fatalf("expected mismatched transfers to be reported, got %+v", q.Unmatched)
+	}
+	if want := microToDecimal(inv.Micro - 5_000_000).StringFixed(usdtMicroPlaces); q.Unmatched[0].Received != want || q.Unmatched[0].Expected != resp.CryptoAmount {
+		t.Fatalf("expected short transfer %s of %s, got %+v", want, resp.CryptoAmount, q.Unmatched[0])
+	}
+
+	// 容差内视为足额；多笔匹配时取确认最深的转账
+	fake.transfers = append(fake.transfers,
+		fakeTronTransfer{txID: "tx-near", value: fmt.Sprint(inv.Micro - 100), block: 950, result: "SUCCESS"},
+		fakeTronTransfer{txID: "tx-exact", value: fmt.Sprint(inv.Micro), block: 920, result: "SUCCESS"},
+	)
+	q, err = u.QueryOrder(ctx, resp.TradeNo)
+	if err != nil || q.Status != payment.ProviderStatusPaid || q.TradeNo != "tx-exact" || q.Chain.Received != q.Chain.Expected {
+		t.Fatalf("expected deepest full-amount transfer, got %+v chain=%+v err=%v", q, q.Chain, err)
+	}
+	if q.Unmatched != nil {
+		t.Fatalf("expected no unmatched report once paid, got %+v", q.Unmatched)
+	}
+
+	if _, err := u.Refund(ctx, payment.RefundRequest{TradeNo: "tx-exact", Amount: "72.00"}); err == nil {
+		t.Fatal("expected manual refund error")
+	}
+	if n, err := u.VerifyNotification(ctx, "{}", nil); n != nil || err != nil {
+		t.Fatalf("expected no-op notification, got %+v err=%v", n, err)
+	}
+}
+
+func TestUSDT_InvoiceAmountAvoidsReservedInvoices(t *testing.T) {
+	_, srv := newFakeTron(t)
+	u := newTestTronUSDT(t, srv, map[string]string{"amountTolerance": "0.000002"})
+	ctx := context.Background()
+
+	first, err := u.CreatePayment(ctx, payment.CreatePaymentRequest{OrderID: "sub2_500", Amount: "72.00"})
+	if err != nil {
+		t.Fatalf("CreatePayment: %v", err)
+	}
+	firstInv, _ := parseUSDTInvoice(first.TradeNo)
+
+	// 与待支付发票哈希标签相同（或落在两倍容差内）时顺延到下一个可区分的金额
+	reserved := []string{first.TradeNo, "0xpaid-tx-hash"}
+	micro, err := u.invoiceMicro("72.00", "sub2_500", reserved)
+	if err != nil {
+		t.Fatalf("invoiceMicro: %v", err)
+	}
+	if diff := micro - firstInv.Micro; diff >= -4 && diff <= 4 {
+		t.Fatalf("expected amount at least 2x tolerance away from %d, got %d", firstInv.Micro, micro)
+	}
+	second, err := u.CreatePayment(ctx, payment.CreatePaymentRequest{OrderID: "sub2_500", Amount: "72.00", ReservedInvoices: reserved})
+	if err != nil {
+		t.Fatalf("CreatePayment: %v", err)
+	}
+	if secondInv, _ := parseUSDTInvoice(second.TradeNo); secondInv.Micro != micro {
+		t.Fatalf("expected invoice %d, got %+v", micro, secondInv)
+	}
+
+	// 容差覆盖整个标签区间时，同一基础金额只能有一张待支付发票
+	wide := newTestTronUSDT(t, srv, map[string]string{"amountTolerance": "0.01"})
+	taken, err := wide.CreatePayment(ctx, payment.CreatePaymentRequest{OrderID: "sub2_501", Amount: "72.00"})
+	if err != nil {
+		t.Fatalf("CreatePayment: %v", err)
+	}
+	if _, err := wide.CreatePayment(ctx, payment.CreatePaymentRequest{OrderID: "sub2_502", Amount: "72.00", ReservedInvoices: []string{taken.TradeNo}}); err == nil {
+		t.Fatal("expected error when no distinct invoice amount is available")
+	}
+}
+
+// fakeEVM is a minimal local stand-in of an EVM JSON-RPC node.
+type fakeEVM struct {
+	mu     sync.Mutex
+	head   int64
+	logs   []evmLog
+	filter map[string]any
+}
+
+func (f *fakeEVM) serve(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var req struct {
+		Method string            `json:"method"`
+		Params []json.RawMessage `json:"params"`
+	}
+	_ = json.NewDecoder(r.Body).Decode(&req)
+	switch req.Method {
+	case "eth_blockNumber":
+		writeJSON(w, http.StatusOK, map[string]any{"jsonrpc": "2.0", "id": 1, "result": fmt.Sprintf("0x%x", f.head)})
+	case "eth_getLogs":
+		_ = json.Unmarshal(req.Params[0], &f.filter)
+		writeJSON(w, http.StatusOK, map[string]any{"jsonrpc": "2.0", "id": 1, "result": f.logs})
+	default:
+		writeJSON(w, http.StatusOK, map[string]any{"jsonrpc": "2.0", "id": 1, "error": map[string]any{"code": -32601, "message": "method not found"}})
+	}
+}
+
+func evmValueData(micro int64, decimals int) string {
+	v := new(big.Int).Mul(big.NewInt(micro), new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals-usdtMicroPlaces)), nil))
+	return fmt.Sprintf("0x%064x", v)
+}
+
+func TestUSDT_EVMLogs(t *testing.T) {
+	fake := &fakeEVM{head: 5000}
+	srv := httptest.NewServer(http.HandlerFunc(fake.serve))
+	t.Cleanup(srv.Close)
+	u, err := NewUSDT("1", map[string]string{
+		"network":      "evm",
+		"address":      strings.ToUpper(testEVMAddress[:2]) + testEVMAddress[2:],
+		"contract":     testEVMContract,
+		"apiBase":      srv.URL,
+		"decimals":     "18",
+		"exchangeRate": "7.2",
+	})
+	if err != nil {
+		t.Fatalf("NewUSDT: %v", err)
+	}
+	ctx := context.Background()
+
+	resp, err := u.CreatePayment(ctx, payment.CreatePaymentRequest{OrderID: "sub2_400", Amount: "36.00"})
+	if err != nil {
+		t.Fatalf("CreatePayment: %v", err)
+	}
+	inv, _ := parseUSDTInvoice(resp.TradeNo)
+	if inv.Start != 5000 || resp.CryptoAsset != "USDT-EVM" {
+		t.Fatalf("expected start block 5000, got %+v asset=%s", inv, resp.CryptoAsset)
+	}
+
+	fake.logs = []evmLog{
+		{TransactionHash: "0xremoved", BlockNumber: "0x1388", Data: evmValueData(inv.Micro, 18), Removed: true},
+		{TransactionHash: "0xpay", BlockNumber: fmt.Sprintf("0x%x", 5002), Data: evmValueData(inv.Micro, 18)},
+	}
+	fake.head = 5010
+	q, err := u.QueryOrder(ctx, resp.TradeNo)
+	if err != nil || q.Status != payment.ProviderStatusConfirming || q.TradeNo != "0xpay" || q.Chain.Confirmations != 9 {
+		t.Fatalf("expected confirming 0xpay, got %+v err=%v", q, err)
+	}
+	topics := fake.filter["topics"].([]any)
+	if fake.filter["fromBlock"] != "0x1388" || fake.filter["address"] != testEVMContract || topics[0] != evmTransferTopic || topics[2] != evmAddressTopic(testEVMAddress) {
+		t.Fatalf("unexpected log filter: %v", fake.filter)
+	}
+
+	fake.head = 5013
+	q, err = u.QueryOrder(ctx, resp.TradeNo)
+	if err != nil || q.Status != payment.ProviderStatusPaid {
+		t.Fatalf("expected paid, got %+v err=%v", q, err)
+	}
+}
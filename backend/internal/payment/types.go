@@ -2,7 +2,10 @@
 // registry, load balancing, and shared utilities for the payment subsystem.
 package payment
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 // PaymentType represents a supported payment method.
 type PaymentType = string
@@ -17,6 +20,8 @@ const (
 	TypeCard         PaymentType = "card"
 	TypeLink         PaymentType = "link"
 	TypeEasyPay      PaymentType = "easypay"
+	TypePayPal       PaymentType = "paypal"
+	TypeUSDT         PaymentType = "usdt"
 )
 
 // Order status constants shared across payment and service layers.
@@ -67,6 +72,9 @@ const (
 	ProviderStatusSuccess  = "success"
 	ProviderStatusFailed   = "failed"
 	ProviderStatusRefunded = "refunded"
+	// ProviderStatusConfirming means a matching on-chain transfer was seen but has
+	// not reached the required number of confirmations yet.
+	ProviderStatusConfirming = "confirming"
 )
 
 // DefaultLoadBalanceStrategy is the default load-balancing strategy
@@ -104,6 +112,11 @@ type CreatePaymentRequest struct {
 	ClientIP           string // Payer's IP address
 	IsMobile           bool   // Whether the request comes from a mobile device
 	InstanceSubMethods string // Comma-separated sub-methods from instance supported_types (for Stripe)
+	RecurringPriceID   string // Provider price to subscribe to for auto-renewing plans (Stripe Billing)
+	PayerEmail         string // Payer's email, used to create the provider-side customer of a recurring payment
+	// ReservedInvoices are the trade numbers of the instance's unsettled crypto invoices;
+	// amount-tagged providers must issue an amount that cannot be confused with any of them.
+	ReservedInvoices []string
 }
 
 // CreatePaymentResponse is returned after successfully initiating a payment.
@@ -112,14 +125,33 @@ type CreatePaymentResponse struct {
 	PayURL       string // H5 payment URL (alipay/wxpay)
 	QRCode       string // QR code content for scanning
 	ClientSecret string // Stripe PaymentIntent client secret
+	CryptoAmount string // Exact token amount the payer must transfer (crypto invoices)
+	CryptoAsset  string // Token and network of a crypto invoice, e.g. "USDT-TRC20"
+	// SubscriptionID is the provider-side recurring subscription created for an
+	// auto-renewing checkout; empty for one-off payments.
+	SubscriptionID string
 }
 
 // QueryOrderResponse describes the payment status from the upstream provider.
 type QueryOrderResponse struct {
 	TradeNo string
-	Status  string  // "pending", "paid", "failed", "refunded"
+	Status  string  // "pending", "paid", "failed", "refunded", "confirming"
 	Amount  float64 // Amount in CNY
 	PaidAt  string  // RFC3339 timestamp or empty
+	Chain   *ChainPayment
+	// Unmatched lists confirmed transfers inside the invoice window whose amount does not
+	// match the invoice (crypto providers, only while no transfer matches). They may pay
+	// this order short or over, or belong to someone else; an admin decides.
+	Unmatched []ChainPayment
+}
+
+// ChainPayment describes the on-chain transfer matched to an order by a crypto provider.
+type ChainPayment struct {
+	TxHash        string
+	Confirmations int
+	Required      int    // Confirmations required before the order counts as paid
+	Expected      string // Invoiced token amount
+	Received      string // Token amount actually transferred (equals Expected within the instance tolerance, except in Unmatched)
 }
 
 // PaymentNotification is the parsed result of a webhook/notify callback.
@@ -129,6 +161,25 @@ type PaymentNotification struct {
 	Amount  float64
 	Status  string // "success" or "failed"
 	RawData string // Raw notification body for audit
+	// Recurring is set for billing events of a provider-side recurring subscription
+	// (renewal charged, renewal failed, subscription ended).
+	Recurring *RecurringEvent
+}
+
+// Recurring event kinds reported by providers that support auto-renewal.
+const (
+	RecurringEventPaid          = "paid"
+	RecurringEventPaymentFailed = "payment_failed"
+	RecurringEventCanceled      = "canceled"
+)
+
+// RecurringEvent describes a billing event of a provider-side recurring subscription.
+type RecurringEvent struct {
+	Kind           string // RecurringEventPaid, RecurringEventPaymentFailed or RecurringEventCanceled
+	SubscriptionID string
+	InvoiceID      string    // Provider invoice of a paid/failed billing cycle
+	Initial        bool      // The invoice is the first one, paid at checkout for the initial order
+	PeriodEnd      time.Time // End of the billing period the invoice covers (zero if unknown)
 }
 
 // RefundRequest contains the parameters for requesting a refund.
@@ -179,3 +230,11 @@ type CancelableProvider interface {
 	// CancelPayment cancels/expires a pending payment on the upstream platform.
 	CancelPayment(ctx context.Context, tradeNo string) error
 }
+
+// RecurringProvider extends Provider with provider-side recurring subscriptions
+// used for auto-renewing plan purchases.
+type RecurringProvider interface {
+	Provider
+	// CancelRecurring stops a recurring subscription so no further renewals are charged.
+	CancelRecurring(ctx context.Context, subscriptionID string) error
+}
@@ -53,6 +53,21 @@ const (
 	// Service 层仅在分组匹配时复用 PrefetchedStickyAccountID，避免分组切换重试误用旧 sticky。
 	PrefetchedStickyGroupID Key = "ctx_prefetched_sticky_group_id"
 
+	// PriorityClass 当前请求的优先级分类（interactive/standard/batch），由 API Key 认证中间件设置
+	PriorityClass Key = "ctx_priority_class"
+
+	// TrafficSplit 当前请求命中的分组流量拆分决策（*service.TrafficSplitDecision）
+	TrafficSplit Key = "ctx_traffic_split"
+
+	// ModelFallback 当前请求因模型降级链改用的模型，Forward 以此替换上游模型并保留客户端模型用于响应
+	ModelFallback Key = "ctx_model_fallback"
+
+	// UsageHook 网关插件 on_usage 回调（func(*service.UsageLog)），由插件中间件设置，handler 提交用量记录时随输入传递
+	UsageHook Key = "ctx_usage_hook"
+
+	// GatewayPluginSession 网关插件会话（*service.GatewayPluginSession），仅 WebSocket 握手时由插件中间件设置，供 handler 逐帧执行钩子
+	GatewayPluginSession Key = "ctx_gateway_plugin_session"
+
 	// ClaudeCodeVersion stores the extracted Claude Code version from User-Agent (e.g. "2.1.22")
 	ClaudeCodeVersion Key = "ctx_claude_code_version"
 )
@@ -0,0 +1,312 @@
+// Package pdf is a minimal pure-Go PDF writer for simple documents such as invoices.
+//
+// It supports A4 pages with text, lines and filled rectangles. Latin text uses the
+// standard Helvetica fonts (WinAnsi encoding); other characters fall back to the
+// non-embedded STSong-Light CJK font, which every conforming PDF reader provides.
+// Coordinates are in points with the origin at the top-left corner of the page.
+package pdf
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"strings"
+	"unicode/utf16"
+)
+
+// A4 页面尺寸（pt）
+const (
+	PageWidth  = 595.28
+	PageHeight = 841.89
+)
+
+// Font 字体
+type Font int
+
+const (
+	Helvetica Font = iota
+	HelveticaBold
+)
+
+const cjkFontName = "F3"
+
+// Document PDF 文档
+type Document struct {
+	title string
+	pages []*Page
+}
+
+// Page PDF 页面
+type Page struct {
+	content bytes.Buffer
+}
+
+// New 创建空文档
+func New() *Document {
+	return &Document{}
+}
+
+// SetTitle 设置文档标题（写入 Info 字典）
+func (d *Document) SetTitle(title string) {
+	d.title = title
+}
+
+// AddPage 追加一页 A4 页面
+func (d *Document) AddPage() *Page {
+	p := &Page{}
+	d.pages = append(d.pages, p)
+	return p
+}
+
+// Text 在 (x, y) 处绘制文本，y 为基线位置
+func (p *Page) Text(x, y, size float64, font Font, s string) {
+	for _, r := range splitRuns(s) {
+		name, encoded := fontName(font), encodeWinAnsi(r.text)
+		if r.cjk {
+			name, encoded = cjkFontName, encodeUCS2(r.text)
+		}
+		fmt.Fprintf(&p.content, "BT /%s %s Tf %s %s Td %s Tj ET\n", name, num(size), num(x), num(PageHeight-y), encoded)
+		x += runWidth(r, size, font)
+	}
+}
+
+// TextRight 绘制右对齐文本，xRight 为文本右边缘
+func (p *Page) TextRight(xRight, y, size float64, font Font, s string) {
+	p.Text(xRight-TextWidth(s, size, font), y, size, font, s)
+}
+
+// Line 绘制线段
+func (p *Page) Line(x1, y1, x2, y2, width float64) {
+	fmt.Fprintf(&p.content, "%s w %s %s m %s %s l S\n", num(width), num(x1), num(PageHeight-y1), num(x2), num(PageHeight-y2))
+}
+
+// FillRect 以灰度 gray（0 黑 - 1 白）填充矩形，(x, y) 为左上角
+func (p *Page) FillRect(x, y, w, h, gray float64) {
+	fmt.Fprintf(&p.content, "%s g %s %s %s %s re f 0 g\n", num(gray), num(x), num(PageHeight-y-h), num(w), num(h))
+}
+
+// TextWidth 返回文本以指定字号渲染时的宽度（pt）
+func TextWidth(s string, size float64, font Font) float64 {
+	var w float64
+	for _, r := range splitRuns(s) {
+		w += runWidth(r, size, font)
+	}
+	return w
+}
+
+// Bytes 序列化文档
+func (d *Document) Bytes() ([]byte, error) {
+	if len(d.pages) == 0 {
+		d.AddPage()
+	}
+	w := &writer{}
+	w.buf.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+
+	// 固定对象：1 Catalog, 2 Pages, 3-5 字体, 6 CIDFont, 7 FontDescriptor, 8 Info；之后每页两个对象
+	const firstPageObj = 9
+	kids := make([]string, len(d.pages))
+	for i := range d.pages {
+		kids[i] = fmt.Sprintf("%d 0 R", firstPageObj+2*i)
+	}
+	w.object(1, "<< /Type /Catalog /Pages 2 0 R >>")
+	w.object(2, fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(d.pages)))
+	w.object(3, "<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>")
+	w.object(4, "<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>")
+	w.object(5, "<< /Type /Font /Subtype /Type0 /BaseFont /STSong-Light /Encoding /UniGB-UCS2-H /DescendantFonts [6 0 R] >>")
+	w.object(6, "<< /Type /Font /Subtype /CIDFontType0 /BaseFont /STSong-Light "+
+		"/CIDSystemInfo << /Registry (Adobe) /Ordering (GB1) /Supplement 2 >> /FontDescriptor 7 0 R /DW 1000 >>")
+	w.object(7, "<< /Type /FontDescriptor /FontName /STSong-Light /Flags 6 /FontBBox [-25 -254 1000 880] "+
+		"/ItalicAngle 0 /Ascent 880 /Descent -120 /CapHeight 880 /StemV 93 >>")
+	w.object(8, fmt.Sprintf("<< /Producer (sub2api) /Title %s >>", encodeTextString(d.title)))
+
+	for i, p := range d.pages {
+		pageObj := firstPageObj + 2*i
+		w.object(pageObj, fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %s %s] "+
+			"/Resources << /Font << /F1 3 0 R /F2 4 0 R /F3 5 0 R >> >> /Contents %d 0 R >>",
+			num(PageWidth), num(PageHeight), pageObj+1))
+
+		var compressed bytes.Buffer
+		zw := zlib.NewWriter(&compressed)
+		if _, err := zw.Write(p.content.Bytes()); err != nil {
+			return nil, fmt.Errorf("compress page %d: %w", i+1, err)
+		}
+		if err := zw.Close(); err != nil {
+			return nil, fmt.Errorf("compress page %d: %w", i+1, err)
+		}
+		w.stream(pageObj+1, compressed.Bytes())
+	}
+	return w.finish(8), nil
+}
+
+type writer struct {
+	buf     bytes.Buffer
+	offsets []int
+}
+
+func (w *writer) begin(id int) {
+	for len(w.offsets) < id {
+		w.offsets = append(w.offsets, 0)
+	}
+	w.offsets[id-1] = w.buf.Len()
+	fmt.Fprintf(&w.buf, "%d 0 obj\n", id)
+}
+
+func (w *writer) object(id int, body string) {
+	w.begin(id)
+	w.buf.WriteString(body)
+	w.buf.WriteString("\nendobj\n")
+}
+
+func (w *writer) stream(id int, data []byte) {
+	w.begin(id)
+	fmt.Fprintf(&w.buf, "<< /Length %d /Filter /FlateDecode >>\nstream\n", len(data))
+	w.buf.Write(data)
+	w.buf.WriteString("\nendstream\nendobj\n")
+}
+
+func (w *writer) finish(infoObj int) []byte {
+	xref := w.buf.Len()
+	fmt.Fprintf(&w.buf, "xref\n0 %d\n0000000000 65535 f \n", len(w.offsets)+1)
+	for _, off := range w.offsets {
+		fmt.Fprintf(&w.buf, "%010d 00000 n \n", off)
+	}
+	fmt.Fprintf(&w.buf, "trailer\n<< /Size %d /Root 1 0 R /Info %d 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(w.offsets)+1, infoObj, xref)
+	return w.buf.Bytes()
+}
+
+type run struct {
+	text string
+	cjk  bool
+}
+
+// splitRuns 将文本切分为 WinAnsi 可编码段与 CJK 段
+func splitRuns(s string) []run {
+	var runs []run
+	var cur strings.Builder
+	curCJK := false
+	for _, r := range s {
+		cjk := !winAnsiEncodable(r)
+		if cur.Len() > 0 && cjk != curCJK {
+			runs = append(runs, run{text: cur.String(), cjk: curCJK})
+			cur.Reset()
+		}
+		curCJK = cjk
+		cur.WriteRune(r)
+	}
+	if cur.Len() > 0 {
+		runs = append(runs, run{text: cur.String(), cjk: curCJK})
+	}
+	return runs
+}
+
+func runWidth(r run, size float64, font Font) float64 {
+	if r.cjk {
+		return float64(len([]rune(r.text))) * size
+	}
+	widths := &helveticaWidths
+	if font == HelveticaBold {
+		widths = &helveticaBoldWidths
+	}
+	var units int
+	for _, c := range r.text {
+		if c >= 32 && c <= 126 {
+			units += widths[c-32]
+		} else {
+			units += defaultWidth
+		}
+	}
+	return float64(units) * size / 1000
+}
+
+func fontName(f Font) string {
+	if f == HelveticaBold {
+		return "F2"
+	}
+	return "F1"
+}
+
+// winAnsiExtras 是 WinAnsiEncoding 在 0x80-0x9F 区间中常用的非 Latin-1 字符
+var winAnsiExtras = map[rune]byte{
+	'€': 0x80, '‘': 0x91, '’': 0x92, '“': 0x93, '”': 0x94, '•': 0x95, '–': 0x96, '—': 0x97,
+}
+
+func winAnsiEncodable(r rune) bool {
+	if _, ok := winAnsiExtras[r]; ok {
+		return true
+	}
+	return (r >= 0x20 && r <= 0x7E) || (r >= 0xA0 && r <= 0xFF)
+}
+
+// encodeWinAnsi 编码为 PDF 字面量字符串
+func encodeWinAnsi(s string) string {
+	var b strings.Builder
+	b.WriteByte('(')
+	for _, r := range s {
+		c, ok := winAnsiExtras[r]
+		if !ok {
+			c = byte(r)
+		}
+		switch {
+		case c == '(' || c == ')' || c == '\\':
+			b.WriteByte('\\')
+			b.WriteByte(c)
+		case c < 0x20 || c > 0x7E:
+			fmt.Fprintf(&b, "\\%03o", c)
+		default:
+			b.WriteByte(c)
+		}
+	}
+	b.WriteByte(')')
+	return b.String()
+}
+
+// encodeUCS2 编码为 UniGB-UCS2-H 使用的大端 UCS-2 十六进制字符串，BMP 外字符替换为 '?'
+func encodeUCS2(s string) string {
+	var b strings.Builder
+	b.WriteByte('<')
+	for _, r := range s {
+		if r > 0xFFFF {
+			r = '?'
+		}
+		fmt.Fprintf(&b, "%04X", r)
+	}
+	b.WriteByte('>')
+	return b.String()
+}
+
+// encodeTextString 编码为带 BOM 的 UTF-16BE 文本字符串（用于 Info 字典）
+func encodeTextString(s string) string {
+	var b strings.Builder
+	b.WriteString("<FEFF")
+	for _, u := range utf16.Encode([]rune(s)) {
+		fmt.Fprintf(&b, "%04X", u)
+	}
+	b.WriteByte('>')
+	return b.String()
+}
+
+func num(f float64) string {
+	s := strings.TrimRight(fmt.Sprintf("%.2f", f), "0")
+	return strings.TrimSuffix(s, ".")
+}
+
+const defaultWidth = 556
+
+// Helvetica / Helvetica-Bold 字符宽度（ASCII 32-126，单位 1/1000 em，取自 Adobe AFM）
+var helveticaWidths = [95]int{
+	278, 278, 355, 556, 556, 889, 667, 191, 333, 333, 389, 584, 278, 333, 278, 278,
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 278, 278, 584, 584, 584, 556,
+	1015, 667, 667, 722, 722, 667, 611, 778, 722, 278, 500, 667, 556, 833, 722, 778,
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 278, 278, 278, 469, 556,
+	333, 556, 556, 500, 556, 556, 278, 556, 556, 222, 222, 500, 222, 833, 556, 556,
+	556, 556, 333, 500, 278, 556, 500, 722, 500, 500, 500, 334, 260, 334, 584,
+}
+
+var helveticaBoldWidths = [95]int{
+	278, 333, 474, 556, 556, 889, 722, 238, 333, 333, 389, 584, 278, 333, 278, 278,
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 333, 333, 584, 584, 584, 611,
+	975, 722, 722, 722, 722, 667, 611, 778, 722, 278, 556, 722, 611, 833, 722, 778,
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 333, 278, 333, 584, 556,
+	333, 556, 611, 556, 611, 556, 333, 611, 611, 278, 278, 556, 278, 889, 611, 611,
+	611, 611, 389, 556, 333, 611, 556, 778, 556, 556, 500, 389, 280, 389, 584,
+}
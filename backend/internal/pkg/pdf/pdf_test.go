@@ -0,0 +1,60 @@
+package pdf
+
+import (
+	"bytes"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDocumentBytes_XrefOffsets(t *testing.T) {
+	doc := New()
+	doc.SetTitle("INV-000001")
+	p := doc.AddPage()
+	p.Text(50, 60, 12, HelveticaBold, "Invoice (copy) \\ 100%")
+	p.TextRight(545, 80, 10, Helvetica, "¥99.00 — 发票")
+	p.Line(50, 90, 545, 90, 0.5)
+	p.FillRect(50, 100, 495, 20, 0.9)
+	doc.AddPage().Text(50, 60, 10, Helvetica, "page 2")
+
+	out, err := doc.Bytes()
+	require.NoError(t, err)
+	require.True(t, bytes.HasPrefix(out, []byte("%PDF-1.4\n")))
+	require.True(t, bytes.HasSuffix(out, []byte("%%EOF\n")))
+
+	m := regexp.MustCompile(`startxref\n(\d+)\n`).FindSubmatch(out)
+	require.NotNil(t, m)
+	xref, _ := strconv.Atoi(string(m[1]))
+	require.True(t, bytes.HasPrefix(out[xref:], []byte("xref\n0 13\n")))
+
+	// 每个 xref 条目都必须指向对应对象的起始位置
+	entries := regexp.MustCompile(`(\d{10}) 00000 n `).FindAllSubmatch(out[xref:], -1)
+	require.Len(t, entries, 12)
+	for i, e := range entries {
+		off, _ := strconv.Atoi(string(e[1]))
+		assert.True(t, bytes.HasPrefix(out[off:], []byte(strconv.Itoa(i+1)+" 0 obj\n")), "object %d", i+1)
+	}
+}
+
+func TestTextWidth(t *testing.T) {
+	assert.InDelta(t, 5.56, TextWidth("0", 10, Helvetica), 1e-9)
+	assert.InDelta(t, 6.11, TextWidth("b", 10, HelveticaBold), 1e-9)
+	// CJK 字符按全角计算
+	assert.InDelta(t, 20+5.56, TextWidth("发票0", 10, Helvetica), 1e-9)
+}
+
+func TestSplitRuns(t *testing.T) {
+	runs := splitRuns("No. 发票 €5")
+	require.Len(t, runs, 3)
+	assert.Equal(t, run{text: "No. ", cjk: false}, runs[0])
+	assert.Equal(t, run{text: "发票", cjk: true}, runs[1])
+	assert.Equal(t, run{text: " €5", cjk: false}, runs[2])
+}
+
+func TestEncodeWinAnsi(t *testing.T) {
+	assert.Equal(t, `(a\(b\)\\ \245 \200)`, encodeWinAnsi("a(b)\\ ¥ €"))
+	assert.Equal(t, "<53D17968>", encodeUCS2("发票"))
+}
@@ -101,10 +101,16 @@ func (r *accountRepository) Create(ctx context.Context, account *service.Account
 	if account.LoadFactor != nil {
 		builder.SetLoadFactor(*account.LoadFactor)
 	}
+	if account.AvailabilitySchedule != "" {
+		builder.SetAvailabilitySchedule(account.AvailabilitySchedule)
+	}
 
 	if account.ProxyID != nil {
 		builder.SetProxyID(*account.ProxyID)
 	}
+	if account.ProxyPoolID != nil {
+		builder.SetProxyPoolID(*account.ProxyPoolID)
+	}
 	if account.LastUsedAt != nil {
 		builder.SetLastUsedAt(*account.LastUsedAt)
 	}
@@ -340,12 +346,22 @@ func (r *accountRepository) Update(ctx context.Context, account *service.Account
 	} else {
 		builder.ClearLoadFactor()
 	}
+	if account.AvailabilitySchedule != "" {
+		builder.SetAvailabilitySchedule(account.AvailabilitySchedule)
+	} else {
+		builder.ClearAvailabilitySchedule()
+	}
 
 	if account.ProxyID != nil {
 		builder.SetProxyID(*account.ProxyID)
 	} else {
 		builder.ClearProxyID()
 	}
+	if account.ProxyPoolID != nil {
+		builder.SetProxyPoolID(*account.ProxyPoolID)
+	} else {
+		builder.ClearProxyPoolID()
+	}
 	if account.LastUsedAt != nil {
 		builder.SetLastUsedAt(*account.LastUsedAt)
 	} else {
@@ -1292,6 +1308,16 @@ func (r *accountRepository) BulkUpdate(ctx context.Context, ids []int64, updates
 			idx++
 		}
 	}
+	if updates.ProxyPoolID != nil {
+		// 0 表示清除代理池
+		if *updates.ProxyPoolID == 0 {
+			setClauses = append(setClauses, "proxy_pool_id = NULL")
+		} else {
+			setClauses = append(setClauses, "proxy_pool_id = $"+itoa(idx))
+			args = append(args, *updates.ProxyPoolID)
+			idx++
+		}
+	}
 	if updates.Concurrency != nil {
 		setClauses = append(setClauses, "concurrency = $"+itoa(idx))
 		args = append(args, *updates.Concurrency)
@@ -1635,6 +1661,7 @@ func accountEntityToService(m *dbent.Account) *service.Account {
 		Credentials:             copyJSONMap(m.Credentials),
 		Extra:                   copyJSONMap(m.Extra),
 		ProxyID:                 m.ProxyID,
+		ProxyPoolID:             m.ProxyPoolID,
 		Concurrency:             m.Concurrency,
 		Priority:                m.Priority,
 		RateMultiplier:          &rateMultiplier,
@@ -1655,6 +1682,7 @@ func accountEntityToService(m *dbent.Account) *service.Account {
 		SessionWindowStart:      m.SessionWindowStart,
 		SessionWindowEnd:        m.SessionWindowEnd,
 		SessionWindowStatus:     derefString(m.SessionWindowStatus),
+		AvailabilitySchedule:    derefString(m.AvailabilitySchedule),
 	}
 }
 
@@ -17,6 +17,9 @@ const (
 	apiKeyRateLimitDuration    = 24 * time.Hour
 	apiKeyAuthCachePrefix      = "apikey:auth:"
 	authCacheInvalidateChannel = "auth:cache:invalidate"
+	ephemeralKeyRPMPrefix      = "apikey:eph:rpm:"
+	ephemeralKeySpendPrefix    = "apikey:eph:spend:"
+	ephemeralKeyRPMWindowTTL   = 2 * time.Minute
 )
 
 // apiKeyRateLimitKey generates the Redis key for API key creation rate limiting.
@@ -28,6 +31,14 @@ func apiKeyAuthCacheKey(key string) string {
 	return fmt.Sprintf("%s%s", apiKeyAuthCachePrefix, key)
 }
 
+func ephemeralKeyRPMKey(subKeyID string, minute int64) string {
+	return fmt.Sprintf("%s%s:%d", ephemeralKeyRPMPrefix, subKeyID, minute)
+}
+
+func ephemeralKeySpendKey(subKeyID string) string {
+	return fmt.Sprintf("%s%s", ephemeralKeySpendPrefix, subKeyID)
+}
+
 type apiKeyCache struct {
 	rdb *redis.Client
 }
@@ -36,6 +47,8 @@ func NewAPIKeyCache(rdb *redis.Client) service.APIKeyCache {
 	return &apiKeyCache{rdb: rdb}
 }
 
+var _ service.EphemeralKeyCache = (*apiKeyCache)(nil)
+
 func (c *apiKeyCache) GetCreateAttemptCount(ctx context.Context, userID int64) (int, error) {
 	key := apiKeyRateLimitKey(userID)
 	count, err := c.rdb.Get(ctx, key).Int()
@@ -135,3 +148,31 @@ func (c *apiKeyCache) SubscribeAuthCacheInvalidation(ctx context.Context, handle
 
 	return nil
 }
+
+func (c *apiKeyCache) IncrementEphemeralKeyRequests(ctx context.Context, subKeyID string, minute int64) (int64, error) {
+	key := ephemeralKeyRPMKey(subKeyID, minute)
+	pipe := c.rdb.TxPipeline()
+	incr := pipe.Incr(ctx, key)
+	pipe.Expire(ctx, key, ephemeralKeyRPMWindowTTL)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, err
+	}
+	return incr.Val(), nil
+}
+
+func (c *apiKeyCache) GetEphemeralKeySpend(ctx context.Context, subKeyID string) (float64, error) {
+	spent, err := c.rdb.Get(ctx, ephemeralKeySpendKey(subKeyID)).Float64()
+	if errors.Is(err, redis.Nil) {
+		return 0, nil
+	}
+	return spent, err
+}
+
+func (c *apiKeyCache) AddEphemeralKeySpend(ctx context.Context, subKeyID string, amount float64, ttl time.Duration) error {
+	key := ephemeralKeySpendKey(subKeyID)
+	pipe := c.rdb.TxPipeline()
+	pipe.IncrByFloat(ctx, key, amount)
+	pipe.Expire(ctx, key, ttl)
+	_, err := pipe.Exec(ctx)
+	return err
+}
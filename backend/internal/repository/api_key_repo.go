@@ -46,7 +46,8 @@ func (r *apiKeyRepository) Create(ctx context.Context, key *service.APIKey) erro
 		SetNillableExpiresAt(key.ExpiresAt).
 		SetRateLimit5h(key.RateLimit5h).
 		SetRateLimit1d(key.RateLimit1d).
-		SetRateLimit7d(key.RateLimit7d)
+		SetRateLimit7d(key.RateLimit7d).
+		SetPriorityClass(key.PriorityClass)
 
 	if len(key.IPWhitelist) > 0 {
 		builder.SetIPWhitelist(key.IPWhitelist)
@@ -130,6 +131,7 @@ func (r *apiKeyRepository) GetByKeyForAuth(ctx context.Context, key string) (*se
 			apikey.FieldRateLimit5h,
 			apikey.FieldRateLimit1d,
 			apikey.FieldRateLimit7d,
+			apikey.FieldPriorityClass,
 		).
 		WithUser(func(q *dbent.UserQuery) {
 			q.Select(
@@ -169,6 +171,15 @@ func (r *apiKeyRepository) GetByKeyForAuth(ctx context.Context, key string) (*se
 				group.FieldAllowMessagesDispatch,
 				group.FieldDefaultMappedModel,
 				group.FieldForceApplicationJSONForNonStream,
+				group.FieldSchedulingStrategy,
+				group.FieldHedgeEnabled,
+				group.FieldHedgeDelayMs,
+				group.FieldTrafficSplitRules,
+				group.FieldModelFallbackChains,
+				group.FieldVolumeTiers,
+				group.FieldAvailabilitySchedule,
+				group.FieldMaintenanceMode,
+				group.FieldMaintenanceMessage,
 			)
 		}).
 		Only(ctx)
@@ -201,6 +212,7 @@ func (r *apiKeyRepository) Update(ctx context.Context, key *service.APIKey) erro
 		SetUsage5h(key.Usage5h).
 		SetUsage1d(key.Usage1d).
 		SetUsage7d(key.Usage7d).
+		SetPriorityClass(key.PriorityClass).
 		SetUpdatedAt(now)
 	if key.GroupID != nil {
 		builder.SetGroupID(*key.GroupID)
@@ -618,6 +630,7 @@ func apiKeyEntityToService(m *dbent.APIKey) *service.APIKey {
 		Window5hStart: m.Window5hStart,
 		Window1dStart: m.Window1dStart,
 		Window7dStart: m.Window7dStart,
+		PriorityClass: m.PriorityClass,
 	}
 	if m.Edges.User != nil {
 		out.User = userEntityToService(m.Edges.User)
@@ -699,6 +712,15 @@ func groupEntityToService(g *dbent.Group) *service.Group {
 		RequirePrivacySet:                g.RequirePrivacySet,
 		DefaultMappedModel:               g.DefaultMappedModel,
 		ForceApplicationJSONForNonStream: g.ForceApplicationJSONForNonStream,
+		SchedulingStrategy:               g.SchedulingStrategy,
+		HedgeEnabled:                     g.HedgeEnabled,
+		HedgeDelayMs:                     g.HedgeDelayMs,
+		TrafficSplitRules:                g.TrafficSplitRules,
+		ModelFallbackChains:              g.ModelFallbackChains,
+		VolumeTiers:                      g.VolumeTiers,
+		AvailabilitySchedule:             derefString(g.AvailabilitySchedule),
+		MaintenanceMode:                  g.MaintenanceMode,
+		MaintenanceMessage:               derefString(g.MaintenanceMessage),
 		CreatedAt:                        g.CreatedAt,
 		UpdatedAt:                        g.UpdatedAt,
 	}
@@ -0,0 +1,272 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	dbent "github.com/Wei-Shaw/sub2api/ent"
+	"github.com/Wei-Shaw/sub2api/ent/balancebucket"
+	dbuser "github.com/Wei-Shaw/sub2api/ent/user"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/lib/pq"
+)
+
+// consumeBalanceBucketsSQL 按 priority、expires_at、id 顺序从可用桶中扣减 $2。
+// 对每个桶：新剩余 = LEAST(remaining, GREATEST(0, 累计剩余 - 扣减额))，
+// 只更新累计剩余（不含自身）小于扣减额的桶；$4 为空数组时不限来源。
+const consumeBalanceBucketsSQL = `
+	WITH locked AS (
+		SELECT id, remaining, priority, expires_at
+		FROM balance_buckets
+		WHERE user_id = $1
+			AND status = 'active'
+			AND remaining > 0
+			AND (expires_at IS NULL OR expires_at > NOW())
+			AND (cardinality($4::text[]) = 0 OR source = ANY($4::text[]))
+		FOR UPDATE
+	), ordered AS (
+		SELECT id, remaining,
+			SUM(remaining) OVER (ORDER BY priority, expires_at NULLS LAST, id) AS running
+		FROM locked
+	)
+	UPDATE balance_buckets b
+	SET remaining = LEAST(o.remaining, GREATEST(0, o.running - $2::numeric)),
+		status = CASE WHEN o.running <= $2::numeric THEN $3 ELSE b.status END,
+		updated_at = NOW()
+	FROM ordered o
+	WHERE b.id = o.id
+		AND o.running - o.remaining < $2::numeric
+`
+
+// consumeBalanceBuckets 从用户的可用桶中扣减 amount，不修改 users.balance。
+// 桶不足时剩余部分视为透支，由 users.balance 变为负数体现。
+func consumeBalanceBuckets(ctx context.Context, exec sqlExecutor, userID int64, amount float64, sources []string, spentStatus string) error {
+	if amount <= 0 {
+		return nil
+	}
+	if sources == nil {
+		sources = []string{}
+	}
+	_, err := exec.ExecContext(ctx, consumeBalanceBucketsSQL, userID, amount, spentStatus, pq.Array(sources))
+	return err
+}
+
+// runInBalanceTx 在 ctx 已有事务时直接复用，否则开启新事务，保证余额与分桶同步提交。
+func runInBalanceTx(ctx context.Context, client *dbent.Client, fn func(ctx context.Context, c *dbent.Client) error) error {
+	if tx := dbent.TxFromContext(ctx); tx != nil {
+		return fn(ctx, tx.Client())
+	}
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+	if err := fn(dbent.NewTxContext(ctx, tx), tx.Client()); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+type balanceBucketRepository struct {
+	client *dbent.Client
+}
+
+func NewBalanceBucketRepository(client *dbent.Client) service.BalanceBucketRepository {
+	return &balanceBucketRepository{client: client}
+}
+
+func (r *balanceBucketRepository) Create(ctx context.Context, bucket *service.BalanceBucket) error {
+	client := clientFromContext(ctx, r.client)
+
+	// 入账前若处于透支状态，先抵扣欠款，只有实际形成的正余额计入桶
+	u, err := client.User.Query().Where(dbuser.IDEQ(bucket.UserID)).Only(ctx)
+	if err != nil {
+		return translatePersistenceError(err, service.ErrUserNotFound, nil)
+	}
+	remaining := bucket.Amount
+	if u.Balance < remaining {
+		remaining = u.Balance
+	}
+	if remaining < 0 {
+		remaining = 0
+	}
+	status := bucket.Status
+	if remaining == 0 {
+		status = service.BalanceBucketStatusExhausted
+	}
+
+	builder := client.BalanceBucket.Create().
+		SetUserID(bucket.UserID).
+		SetSource(bucket.Source).
+		SetSourceRef(bucket.SourceRef).
+		SetAmount(bucket.Amount).
+		SetRemaining(remaining).
+		SetPriority(bucket.Priority).
+		SetStatus(status).
+		SetNillableExpiresAt(bucket.ExpiresAt)
+	created, err := builder.Save(ctx)
+	if err != nil {
+		return err
+	}
+	bucket.ID = created.ID
+	bucket.Remaining = created.Remaining
+	bucket.Status = created.Status
+	bucket.CreatedAt = created.CreatedAt
+	bucket.UpdatedAt = created.UpdatedAt
+	return nil
+}
+
+func (r *balanceBucketRepository) Consume(ctx context.Context, userID int64, amount float64) error {
+	return consumeBalanceBuckets(ctx, clientFromContext(ctx, r.client), userID, amount, nil, service.BalanceBucketStatusExhausted)
+}
+
+func (r *balanceBucketRepository) DeductRefundable(ctx context.Context, userID int64, amount float64) error {
+	if amount <= 0 {
+		return nil
+	}
+	return runInBalanceTx(ctx, r.client, func(ctx context.Context, c *dbent.Client) error {
+		n, err := c.User.Update().Where(dbuser.IDEQ(userID)).AddBalance(-amount).Save(ctx)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return service.ErrUserNotFound
+		}
+		return consumeBalanceBuckets(ctx, c, userID, amount, service.RefundableBalanceSources, service.BalanceBucketStatusRefunded)
+	})
+}
+
+func (r *balanceBucketRepository) SumRemaining(ctx context.Context, userID int64, sources []string) (float64, error) {
+	q := clientFromContext(ctx, r.client).BalanceBucket.Query().
+		Where(
+			balancebucket.UserIDEQ(userID),
+			balancebucket.StatusEQ(service.BalanceBucketStatusActive),
+		)
+	if len(sources) > 0 {
+		q = q.Where(balancebucket.SourceIn(sources...))
+	}
+	var rows []struct {
+		Sum *float64 `json:"sum"`
+	}
+	if err := q.Aggregate(dbent.As(dbent.Sum(balancebucket.FieldRemaining), "sum")).Scan(ctx, &rows); err != nil {
+		return 0, err
+	}
+	if len(rows) == 0 || rows[0].Sum == nil {
+		return 0, nil
+	}
+	return *rows[0].Sum, nil
+}
+
+func (r *balanceBucketRepository) ListByUser(ctx context.Context, userID int64, params pagination.PaginationParams, status string) ([]service.BalanceBucket, *pagination.PaginationResult, error) {
+	q := r.client.BalanceBucket.Query().Where(balancebucket.UserIDEQ(userID))
+	if status != "" {
+		q = q.Where(balancebucket.StatusEQ(status))
+	}
+
+	total, err := q.Clone().Count(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	buckets, err := q.
+		Offset(params.Offset()).
+		Limit(params.Limit()).
+		Order(dbent.Desc(balancebucket.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return balanceBucketEntitiesToService(buckets), paginationResultFromTotal(int64(total), params), nil
+}
+
+func (r *balanceBucketRepository) ListExpired(ctx context.Context, now time.Time, limit int) ([]service.BalanceBucket, error) {
+	buckets, err := r.client.BalanceBucket.Query().
+		Where(
+			balancebucket.StatusEQ(service.BalanceBucketStatusActive),
+			balancebucket.ExpiresAtNotNil(),
+			balancebucket.ExpiresAtLTE(now),
+		).
+		Order(dbent.Asc(balancebucket.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return balanceBucketEntitiesToService(buckets), nil
+}
+
+func (r *balanceBucketRepository) Expire(ctx context.Context, id int64) (float64, error) {
+	var recovered float64
+	err := runInBalanceTx(ctx, r.client, func(ctx context.Context, c *dbent.Client) error {
+		bucket, err := c.BalanceBucket.Query().
+			Where(balancebucket.IDEQ(id)).
+			ForUpdate().
+			Only(ctx)
+		if err != nil {
+			return err
+		}
+		if bucket.Status != service.BalanceBucketStatusActive {
+			return nil
+		}
+
+		// 回收金额不超过当前正余额，避免因历史偏差把用户扣成负数
+		var balance float64
+		if err := scanSingleRow(ctx, c, `SELECT balance FROM users WHERE id = $1 FOR UPDATE`, []any{bucket.UserID}, &balance); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return service.ErrUserNotFound
+			}
+			return err
+		}
+		recovered = bucket.Remaining
+		if balance < recovered {
+			recovered = balance
+		}
+		if recovered < 0 {
+			recovered = 0
+		}
+
+		if recovered > 0 {
+			if _, err := c.User.UpdateOneID(bucket.UserID).AddBalance(-recovered).Save(ctx); err != nil {
+				return err
+			}
+		}
+		return c.BalanceBucket.UpdateOneID(id).
+			SetStatus(service.BalanceBucketStatusExpired).
+			SetRemaining(0).
+			SetExpiredAmount(recovered).
+			Exec(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return recovered, nil
+}
+
+func balanceBucketEntityToService(m *dbent.BalanceBucket) service.BalanceBucket {
+	return service.BalanceBucket{
+		ID:            m.ID,
+		UserID:        m.UserID,
+		Source:        m.Source,
+		SourceRef:     m.SourceRef,
+		Amount:        m.Amount,
+		Remaining:     m.Remaining,
+		Priority:      m.Priority,
+		Status:        m.Status,
+		ExpiresAt:     m.ExpiresAt,
+		ExpiredAmount: m.ExpiredAmount,
+		CreatedAt:     m.CreatedAt,
+		UpdatedAt:     m.UpdatedAt,
+	}
+}
+
+func balanceBucketEntitiesToService(models []*dbent.BalanceBucket) []service.BalanceBucket {
+	out := make([]service.BalanceBucket, 0, len(models))
+	for i := range models {
+		out = append(out, balanceBucketEntityToService(models[i]))
+	}
+	return out
+}
@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/Wei-Shaw/sub2api/internal/service"
+)
+
+type billingRecomputeRepository struct {
+	sql sqlExecutor
+}
+
+func NewBillingRecomputeRepository(sqlDB *sql.DB) service.BillingRecomputeRepository {
+	return &billingRecomputeRepository{sql: sqlDB}
+}
+
+// ListUsageLogsForRecompute 按 id 游标分页读取 [start, end) 内实际计费的使用记录（排除影子请求与未扣费记录）
+func (r *billingRecomputeRepository) ListUsageLogsForRecompute(ctx context.Context, filter service.BillingRecomputeFilter, afterID int64, limit int) (logs []service.UsageLog, err error) {
+	conditions := []string{"created_at >= $1", "created_at < $2", "id > $3", "shadow = FALSE", "unbilled = FALSE"}
+	args := []any{filter.StartTime, filter.EndTime, afterID}
+	if filter.UserID > 0 {
+		args = append(args, filter.UserID)
+		conditions = append(conditions, fmt.Sprintf("user_id = $%d", len(args)))
+	}
+	if filter.GroupID > 0 {
+		args = append(args, filter.GroupID)
+		conditions = append(conditions, fmt.Sprintf("group_id = $%d", len(args)))
+	}
+	if filter.Model != "" {
+		args = append(args, filter.Model)
+		conditions = append(conditions, fmt.Sprintf("model = $%d", len(args)))
+	}
+	args = append(args, limit)
+	query := fmt.Sprintf("SELECT %s FROM usage_logs WHERE %s ORDER BY id LIMIT $%d",
+		usageLogSelectColumns, strings.Join(conditions, " AND "), len(args))
+
+	rows, err := r.sql.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil && err == nil {
+			err = closeErr
+			logs = nil
+		}
+	}()
+
+	logs = make([]service.UsageLog, 0, limit)
+	for rows.Next() {
+		var log *service.UsageLog
+		log, err = scanUsageLog(rows)
+		if err != nil {
+			return nil, err
+		}
+		logs = append(logs, *log)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return logs, nil
+}
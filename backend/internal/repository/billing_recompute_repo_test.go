@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBillingRecomputeRepository_ExcludesShadowAndUnbilledRows(t *testing.T) {
+	db, mock := newSQLMock(t)
+	repo := NewBillingRecomputeRepository(db)
+
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(24 * time.Hour)
+	mock.ExpectQuery(`FROM usage_logs WHERE created_at >= \$1 AND created_at < \$2 AND id > \$3 AND shadow = FALSE AND unbilled = FALSE AND user_id = \$4 ORDER BY id LIMIT \$5`).
+		WithArgs(start, end, int64(7), int64(3), 100).
+		WillReturnRows(sqlmock.NewRows(strings.Split(usageLogSelectColumns, ", ")))
+
+	logs, err := repo.ListUsageLogsForRecompute(context.Background(), service.BillingRecomputeFilter{
+		StartTime: start,
+		EndTime:   end,
+		UserID:    3,
+	}, 7, 100)
+	require.NoError(t, err)
+	require.Empty(t, logs)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
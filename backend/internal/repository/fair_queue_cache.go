@@ -0,0 +1,242 @@
+package repository
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/redis/go-redis/v9"
+)
+
+// Redis Key 模式（hash tag 确保同一分组的 key 落入同一 slot）
+// 格式: fq:{groupID}:q / fq:{groupID}:exp / fq:{groupID}:vt
+const (
+	fairQueueKeyPrefix    = "fq:"
+	fairQueueQueueSuffix  = ":q"   // ZSET member=accountID:userID:class:ticketID score=分类区间+完成标签
+	fairQueueExpireSuffix = ":exp" // ZSET member 同上 score=过期时间（秒）
+	fairQueueVTSuffix     = ":vt"  // HASH v=分组虚拟时间 u:{userID}=用户上次完成标签
+	// fairQueueGroupsKey 存在排队的分组集合，供运维统计遍历
+	fairQueueGroupsKey = "fq:groups"
+	// fairQueueKeyTTL 队列相关 key 的空闲过期时间
+	fairQueueKeyTTL = time.Hour
+	// fairQueueClassBand 优先级分类的分数区间：高优先级分类的票据总是排在低优先级之前
+	fairQueueClassBand = 1e12
+)
+
+// fairQueuePurgeLua 清理过期票据（复用于各脚本开头）
+const fairQueuePurgeLua = `
+local now = tonumber(redis.call('TIME')[1])
+local expired = redis.call('ZRANGEBYSCORE', KEYS[2], '-inf', now)
+for _, m in ipairs(expired) do
+    redis.call('ZREM', KEYS[1], m)
+end
+if #expired > 0 then
+    redis.call('ZREMRANGEBYSCORE', KEYS[2], '-inf', now)
+end
+`
+
+// fairQueueEnterScript 入队：开始标签 = max(分组虚拟时间, 用户上次完成标签)，完成标签 = 开始 + cost
+// 队列分数 = 分类区间偏移 + 完成标签
+// KEYS: q, exp, vt; ARGV: member, userID, cost, ttlSeconds, keyTTLSeconds, classOffset
+var fairQueueEnterScript = redis.NewScript(fairQueuePurgeLua + `
+local member = ARGV[1]
+local userField = 'u:' .. ARGV[2]
+local cost = tonumber(ARGV[3])
+local ttl = tonumber(ARGV[4])
+local keyTTL = tonumber(ARGV[5])
+local offset = tonumber(ARGV[6])
+
+local v = tonumber(redis.call('HGET', KEYS[3], 'v') or '0')
+local f = tonumber(redis.call('HGET', KEYS[3], userField) or '0')
+local start = v
+if f > start then start = f end
+local finish = start + cost
+
+redis.call('HSET', KEYS[3], userField, tostring(finish))
+redis.call('ZADD', KEYS[1], offset + finish, member)
+redis.call('ZADD', KEYS[2], now + ttl, member)
+for i = 1, 3 do redis.call('EXPIRE', KEYS[i], keyTTL) end
+return tostring(start)
+`)
+
+// fairQueueEligibleScript 检查票据在同一账号的等待者中的排名是否小于 window
+// KEYS: q, exp; ARGV: member, accountPrefix, window
+// 返回: -1 票据不存在；1 可抢占；0 需继续等待
+var fairQueueEligibleScript = redis.NewScript(fairQueuePurgeLua + `
+local member = ARGV[1]
+local prefix = ARGV[2]
+local window = tonumber(ARGV[3])
+if redis.call('ZSCORE', KEYS[1], member) == false then
+    return -1
+end
+local rank = 0
+for _, m in ipairs(redis.call('ZRANGE', KEYS[1], 0, -1)) do
+    if m == member then
+        if rank < window then return 1 end
+        return 0
+    end
+    if string.sub(m, 1, #prefix) == prefix then
+        rank = rank + 1
+        if rank >= window then return 0 end
+    end
+end
+return 0
+`)
+
+// fairQueueLeaveScript 出队；served=1 时推进分组虚拟时间
+// KEYS: q, exp, vt; ARGV: member, start, served
+var fairQueueLeaveScript = redis.NewScript(`
+redis.call('ZREM', KEYS[1], ARGV[1])
+redis.call('ZREM', KEYS[2], ARGV[1])
+if ARGV[3] == '1' then
+    local start = tonumber(ARGV[2])
+    local v = tonumber(redis.call('HGET', KEYS[3], 'v') or '0')
+    if start > v then
+        redis.call('HSET', KEYS[3], 'v', tostring(start))
+    end
+end
+return 1
+`)
+
+type fairQueueCache struct {
+	rdb *redis.Client
+}
+
+// NewFairQueueCache 创建分组公平排队缓存
+func NewFairQueueCache(rdb *redis.Client) service.FairQueueCache {
+	return &fairQueueCache{rdb: rdb}
+}
+
+func fairQueueKey(groupID int64, suffix string) string {
+	// 格式: fq:{123}:q — 花括号确保 Redis Cluster hash tag 生效
+	return fairQueueKeyPrefix + "{" + strconv.FormatInt(groupID, 10) + "}" + suffix
+}
+
+func fairQueueKeys(groupID int64) []string {
+	return []string{
+		fairQueueKey(groupID, fairQueueQueueSuffix),
+		fairQueueKey(groupID, fairQueueExpireSuffix),
+		fairQueueKey(groupID, fairQueueVTSuffix),
+	}
+}
+
+func fairQueueAccountPrefix(accountID int64) string {
+	return strconv.FormatInt(accountID, 10) + ":"
+}
+
+func fairQueueMember(ticket *service.FairQueueTicket) string {
+	return fairQueueAccountPrefix(ticket.AccountID) + strconv.FormatInt(ticket.UserID, 10) + ":" + ticket.Class + ":" + ticket.ID
+}
+
+func (c *fairQueueCache) Enter(ctx context.Context, ticket *service.FairQueueTicket, cost float64, ttl time.Duration) (float64, error) {
+	ttlSeconds := int(ttl.Seconds())
+	if ttlSeconds <= 0 {
+		ttlSeconds = 1
+	}
+	offset := float64(ticket.PriorityRank()) * fairQueueClassBand
+	res, err := fairQueueEnterScript.Run(ctx, c.rdb, fairQueueKeys(ticket.GroupID),
+		fairQueueMember(ticket), ticket.UserID, strconv.FormatFloat(cost, 'f', -1, 64), ttlSeconds, int(fairQueueKeyTTL.Seconds()),
+		strconv.FormatFloat(offset, 'f', -1, 64),
+	).Text()
+	if err != nil {
+		return 0, err
+	}
+
+	pipe := c.rdb.Pipeline()
+	pipe.SAdd(ctx, fairQueueGroupsKey, ticket.GroupID)
+	pipe.Expire(ctx, fairQueueGroupsKey, fairQueueKeyTTL)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, err
+	}
+
+	start, err := strconv.ParseFloat(res, 64)
+	if err != nil {
+		return 0, err
+	}
+	return start, nil
+}
+
+func (c *fairQueueCache) IsEligible(ctx context.Context, ticket *service.FairQueueTicket, maxConcurrency int) (bool, bool, error) {
+	// 可抢占窗口 = 账号剩余槽位（至少为 1，保证队首始终可以尝试）
+	window := 1
+	if maxConcurrency > 0 {
+		inUse, err := c.rdb.ZCard(ctx, accountSlotKey(ticket.AccountID)).Result()
+		if err == nil && maxConcurrency-int(inUse) > window {
+			window = maxConcurrency - int(inUse)
+		}
+	}
+
+	keys := fairQueueKeys(ticket.GroupID)[:2]
+	res, err := fairQueueEligibleScript.Run(ctx, c.rdb, keys, fairQueueMember(ticket), fairQueueAccountPrefix(ticket.AccountID), window).Int()
+	if err != nil {
+		return false, false, err
+	}
+	switch res {
+	case -1:
+		return false, false, nil
+	case 1:
+		return true, true, nil
+	default:
+		return false, true, nil
+	}
+}
+
+func (c *fairQueueCache) Leave(ctx context.Context, ticket *service.FairQueueTicket, served bool) error {
+	servedFlag := "0"
+	if served {
+		servedFlag = "1"
+	}
+	return fairQueueLeaveScript.Run(ctx, c.rdb, fairQueueKeys(ticket.GroupID),
+		fairQueueMember(ticket), strconv.FormatFloat(ticket.Start, 'f', -1, 64), servedFlag,
+	).Err()
+}
+
+func (c *fairQueueCache) GetQueueDepths(ctx context.Context) ([]service.FairQueueDepth, error) {
+	groupIDs, err := c.rdb.SMembers(ctx, fairQueueGroupsKey).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	now := strconv.FormatInt(time.Now().Unix(), 10)
+	var out []service.FairQueueDepth
+	for _, raw := range groupIDs {
+		groupID, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			continue
+		}
+		// 只统计未过期的票据（过期票据在下次入队/检查时清理）
+		members, err := c.rdb.ZRangeByScore(ctx, fairQueueKey(groupID, fairQueueExpireSuffix), &redis.ZRangeBy{
+			Min: "(" + now,
+			Max: "+inf",
+		}).Result()
+		if err != nil {
+			return nil, err
+		}
+		if len(members) == 0 {
+			_ = c.rdb.SRem(ctx, fairQueueGroupsKey, raw).Err()
+			continue
+		}
+		type depthKey struct {
+			userID int64
+			class  string
+		}
+		counts := make(map[depthKey]int)
+		for _, m := range members {
+			parts := strings.SplitN(m, ":", 4)
+			if len(parts) != 4 {
+				continue
+			}
+			userID, err := strconv.ParseInt(parts[1], 10, 64)
+			if err != nil {
+				continue
+			}
+			counts[depthKey{userID: userID, class: parts[2]}]++
+		}
+		for k, n := range counts {
+			out = append(out, service.FairQueueDepth{GroupID: groupID, UserID: k.userID, Class: k.class, Count: n})
+		}
+	}
+	return out, nil
+}
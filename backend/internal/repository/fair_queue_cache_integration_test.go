@@ -0,0 +1,139 @@
+//go:build integration
+
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type FairQueueCacheSuite struct {
+	IntegrationRedisSuite
+	cache service.FairQueueCache
+}
+
+func TestFairQueueCacheSuite(t *testing.T) {
+	suite.Run(t, new(FairQueueCacheSuite))
+}
+
+func (s *FairQueueCacheSuite) SetupTest() {
+	s.IntegrationRedisSuite.SetupTest()
+	s.cache = NewFairQueueCache(s.rdb)
+}
+
+func fairQueueTestTicket(groupID, accountID, userID int64, id string) *service.FairQueueTicket {
+	return &service.FairQueueTicket{
+		GroupID:   groupID,
+		AccountID: accountID,
+		UserID:    userID,
+		Class:     service.PriorityClassStandard,
+		ID:        id,
+	}
+}
+
+func (s *FairQueueCacheSuite) enter(ticket *service.FairQueueTicket) float64 {
+	start, err := s.cache.Enter(s.ctx, ticket, 1000, time.Minute)
+	require.NoError(s.T(), err)
+	ticket.Start = start
+	return start
+}
+
+func (s *FairQueueCacheSuite) eligible(ticket *service.FairQueueTicket, maxConcurrency int) bool {
+	ok, present, err := s.cache.IsEligible(s.ctx, ticket, maxConcurrency)
+	require.NoError(s.T(), err)
+	require.True(s.T(), present, "ticket %s should be present", ticket.ID)
+	return ok
+}
+
+func (s *FairQueueCacheSuite) TestHeavyUserDoesNotStarveOthers() {
+	groupID, accountID := int64(1), int64(10)
+	heavy, light := int64(100), int64(200)
+
+	var heavyTickets []*service.FairQueueTicket
+	var starts []float64
+	for _, id := range []string{"a1", "a2", "a3"} {
+		ticket := fairQueueTestTicket(groupID, accountID, heavy, id)
+		heavyTickets = append(heavyTickets, ticket)
+		starts = append(starts, s.enter(ticket))
+	}
+	require.Equal(s.T(), []float64{0, 1000, 2000}, starts)
+
+	lightTicket := fairQueueTestTicket(groupID, accountID, light, "b1")
+	require.Equal(s.T(), float64(0), s.enter(lightTicket))
+
+	require.True(s.T(), s.eligible(heavyTickets[0], 0))
+	require.False(s.T(), s.eligible(lightTicket, 0))
+
+	require.NoError(s.T(), s.cache.Leave(s.ctx, heavyTickets[0], true))
+
+	// 后到的轻量用户排在重度用户剩余请求之前
+	require.True(s.T(), s.eligible(lightTicket, 0))
+	require.False(s.T(), s.eligible(heavyTickets[1], 0))
+
+	depths, err := s.cache.GetQueueDepths(s.ctx)
+	require.NoError(s.T(), err)
+	byUser := map[int64]int{}
+	for _, d := range depths {
+		require.Equal(s.T(), groupID, d.GroupID)
+		require.Equal(s.T(), service.PriorityClassStandard, d.Class)
+		byUser[d.UserID] += d.Count
+	}
+	require.Equal(s.T(), map[int64]int{heavy: 2, light: 1}, byUser)
+}
+
+func (s *FairQueueCacheSuite) TestWindowFollowsFreeSlotsAndAccounts() {
+	groupID := int64(2)
+	var tickets []*service.FairQueueTicket
+	for _, id := range []string{"t1", "t2", "t3"} {
+		ticket := fairQueueTestTicket(groupID, 10, 1, id)
+		s.enter(ticket)
+		tickets = append(tickets, ticket)
+	}
+	other := fairQueueTestTicket(groupID, 11, 1, "other")
+	s.enter(other)
+
+	// 其他账号的等待者不占用排名
+	require.True(s.T(), s.eligible(other, 0))
+
+	// 账号空闲 2 个槽位时，前两名可同时抢占
+	require.True(s.T(), s.eligible(tickets[1], 2))
+	require.False(s.T(), s.eligible(tickets[2], 2))
+
+	_, present, err := s.cache.IsEligible(s.ctx, fairQueueTestTicket(groupID, 10, 1, "missing"), 2)
+	require.NoError(s.T(), err)
+	require.False(s.T(), present)
+}
+
+func (s *FairQueueCacheSuite) TestHigherPriorityClassServedFirst() {
+	groupID, accountID := int64(3), int64(10)
+
+	batch := fairQueueTestTicket(groupID, accountID, 1, "batch")
+	batch.Class = service.PriorityClassBatch
+	s.enter(batch)
+	standard := fairQueueTestTicket(groupID, accountID, 2, "standard")
+	s.enter(standard)
+	interactive := fairQueueTestTicket(groupID, accountID, 3, "interactive")
+	interactive.Class = service.PriorityClassInteractive
+	s.enter(interactive)
+
+	// 后到的高优先级请求排在先到的低优先级请求之前
+	require.True(s.T(), s.eligible(interactive, 0))
+	require.False(s.T(), s.eligible(standard, 0))
+	require.False(s.T(), s.eligible(batch, 0))
+
+	require.NoError(s.T(), s.cache.Leave(s.ctx, interactive, true))
+	require.True(s.T(), s.eligible(standard, 0))
+	require.False(s.T(), s.eligible(batch, 0))
+
+	depths, err := s.cache.GetQueueDepths(s.ctx)
+	require.NoError(s.T(), err)
+	byClass := map[string]int{}
+	for _, d := range depths {
+		byClass[d.Class] += d.Count
+	}
+	require.Equal(s.T(), map[string]int{service.PriorityClassStandard: 1, service.PriorityClassBatch: 1}, byClass)
+}
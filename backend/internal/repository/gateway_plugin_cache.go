@@ -0,0 +1,46 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/redis/go-redis/v9"
+)
+
+// 插件模块体积较大，不写入 Redis，只通过 Pub/Sub 通知各实例从数据库重新加载
+const gatewayPluginPubSubKey = "gateway_plugins_updated"
+
+type gatewayPluginCache struct {
+	rdb *redis.Client
+}
+
+// NewGatewayPluginCache 创建网关插件更新通知
+func NewGatewayPluginCache(rdb *redis.Client) service.GatewayPluginCache {
+	return &gatewayPluginCache{rdb: rdb}
+}
+
+// NotifyUpdate 通知其他实例重新加载插件
+func (c *gatewayPluginCache) NotifyUpdate(ctx context.Context) error {
+	return c.rdb.Publish(ctx, gatewayPluginPubSubKey, "refresh").Err()
+}
+
+// SubscribeUpdates 订阅插件更新通知
+func (c *gatewayPluginCache) SubscribeUpdates(ctx context.Context, handler func()) {
+	go func() {
+		sub := c.rdb.Subscribe(ctx, gatewayPluginPubSubKey)
+		defer func() { _ = sub.Close() }()
+
+		ch := sub.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case msg := <-ch:
+				if msg == nil {
+					return
+				}
+				handler()
+			}
+		}
+	}()
+}
@@ -0,0 +1,135 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/Wei-Shaw/sub2api/ent"
+	"github.com/Wei-Shaw/sub2api/ent/gatewayplugin"
+	"github.com/Wei-Shaw/sub2api/internal/model"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+)
+
+type gatewayPluginRepository struct {
+	client *ent.Client
+}
+
+// NewGatewayPluginRepository 创建网关插件仓库
+func NewGatewayPluginRepository(client *ent.Client) service.GatewayPluginRepository {
+	return &gatewayPluginRepository{client: client}
+}
+
+// List 获取所有插件（按优先级排序，包含模块二进制）
+func (r *gatewayPluginRepository) List(ctx context.Context) ([]*model.GatewayPlugin, error) {
+	plugins, err := r.client.GatewayPlugin.Query().
+		Order(ent.Asc(gatewayplugin.FieldPriority), ent.Asc(gatewayplugin.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.GatewayPlugin, len(plugins))
+	for i, p := range plugins {
+		result[i] = r.toModel(p)
+	}
+	return result, nil
+}
+
+// GetByID 根据 ID 获取插件
+func (r *gatewayPluginRepository) GetByID(ctx context.Context, id int64) (*model.GatewayPlugin, error) {
+	p, err := r.client.GatewayPlugin.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return r.toModel(p), nil
+}
+
+// Create 创建插件
+func (r *gatewayPluginRepository) Create(ctx context.Context, plugin *model.GatewayPlugin) (*model.GatewayPlugin, error) {
+	builder := r.client.GatewayPlugin.Create().
+		SetName(plugin.Name).
+		SetEnabled(plugin.Enabled).
+		SetPriority(plugin.Priority).
+		SetGroupIds(plugin.GroupIDs).
+		SetFailureMode(plugin.FailureMode).
+		SetTimeoutMs(plugin.TimeoutMs).
+		SetMemoryLimitMB(plugin.MemoryLimitMB).
+		SetHooks(plugin.Hooks).
+		SetModule(plugin.Module).
+		SetModuleSha256(plugin.ModuleSHA256)
+
+	if plugin.Description != nil {
+		builder.SetDescription(*plugin.Description)
+	}
+
+	created, err := builder.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.toModel(created), nil
+}
+
+// Update 更新插件
+func (r *gatewayPluginRepository) Update(ctx context.Context, plugin *model.GatewayPlugin) (*model.GatewayPlugin, error) {
+	builder := r.client.GatewayPlugin.UpdateOneID(plugin.ID).
+		SetName(plugin.Name).
+		SetEnabled(plugin.Enabled).
+		SetPriority(plugin.Priority).
+		SetGroupIds(plugin.GroupIDs).
+		SetFailureMode(plugin.FailureMode).
+		SetTimeoutMs(plugin.TimeoutMs).
+		SetMemoryLimitMB(plugin.MemoryLimitMB).
+		SetHooks(plugin.Hooks).
+		SetModule(plugin.Module).
+		SetModuleSha256(plugin.ModuleSHA256)
+
+	if plugin.Description != nil {
+		builder.SetDescription(*plugin.Description)
+	} else {
+		builder.ClearDescription()
+	}
+
+	updated, err := builder.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.toModel(updated), nil
+}
+
+// Delete 删除插件
+func (r *gatewayPluginRepository) Delete(ctx context.Context, id int64) error {
+	return r.client.GatewayPlugin.DeleteOneID(id).Exec(ctx)
+}
+
+// toModel 将 Ent 实体转换为服务模型
+func (r *gatewayPluginRepository) toModel(e *ent.GatewayPlugin) *model.GatewayPlugin {
+	plugin := &model.GatewayPlugin{
+		ID:            e.ID,
+		Name:          e.Name,
+		Enabled:       e.Enabled,
+		Priority:      e.Priority,
+		GroupIDs:      e.GroupIds,
+		FailureMode:   e.FailureMode,
+		TimeoutMs:     e.TimeoutMs,
+		MemoryLimitMB: e.MemoryLimitMB,
+		Hooks:         e.Hooks,
+		Module:        e.Module,
+		ModuleSHA256:  e.ModuleSha256,
+		ModuleSize:    len(e.Module),
+		Description:   e.Description,
+		CreatedAt:     e.CreatedAt,
+		UpdatedAt:     e.UpdatedAt,
+	}
+
+	// 确保切片不为 nil
+	if plugin.GroupIDs == nil {
+		plugin.GroupIDs = []int64{}
+	}
+	if plugin.Hooks == nil {
+		plugin.Hooks = []string{}
+	}
+
+	return plugin
+}
@@ -64,12 +64,31 @@ func (r *groupRepository) Create(ctx context.Context, groupIn *service.Group) er
 		SetRequireOauthOnly(groupIn.RequireOAuthOnly).
 		SetRequirePrivacySet(groupIn.RequirePrivacySet).
 		SetDefaultMappedModel(groupIn.DefaultMappedModel).
-		SetForceApplicationJSONForNonStream(groupIn.ForceApplicationJSONForNonStream)
+		SetForceApplicationJSONForNonStream(groupIn.ForceApplicationJSONForNonStream).
+		SetSchedulingStrategy(groupIn.SchedulingStrategy).
+		SetHedgeEnabled(groupIn.HedgeEnabled).
+		SetHedgeDelayMs(groupIn.HedgeDelayMs).
+		SetMaintenanceMode(groupIn.MaintenanceMode)
 
 	// 设置模型路由配置
 	if groupIn.ModelRouting != nil {
 		builder = builder.SetModelRouting(groupIn.ModelRouting)
 	}
+	if groupIn.TrafficSplitRules != nil {
+		builder = builder.SetTrafficSplitRules(groupIn.TrafficSplitRules)
+	}
+	if groupIn.ModelFallbackChains != nil {
+		builder = builder.SetModelFallbackChains(groupIn.ModelFallbackChains)
+	}
+	if groupIn.VolumeTiers != nil {
+		builder = builder.SetVolumeTiers(groupIn.VolumeTiers)
+	}
+	if groupIn.AvailabilitySchedule != "" {
+		builder = builder.SetAvailabilitySchedule(groupIn.AvailabilitySchedule)
+	}
+	if groupIn.MaintenanceMessage != "" {
+		builder = builder.SetMaintenanceMessage(groupIn.MaintenanceMessage)
+	}
 
 	// 设置支持的模型系列（始终设置，空数组表示不限制）
 	builder = builder.SetSupportedModelScopes(groupIn.SupportedModelScopes)
@@ -136,7 +155,11 @@ func (r *groupRepository) Update(ctx context.Context, groupIn *service.Group) er
 		SetRequireOauthOnly(groupIn.RequireOAuthOnly).
 		SetRequirePrivacySet(groupIn.RequirePrivacySet).
 		SetDefaultMappedModel(groupIn.DefaultMappedModel).
-		SetForceApplicationJSONForNonStream(groupIn.ForceApplicationJSONForNonStream)
+		SetForceApplicationJSONForNonStream(groupIn.ForceApplicationJSONForNonStream).
+		SetSchedulingStrategy(groupIn.SchedulingStrategy).
+		SetHedgeEnabled(groupIn.HedgeEnabled).
+		SetHedgeDelayMs(groupIn.HedgeDelayMs).
+		SetMaintenanceMode(groupIn.MaintenanceMode)
 
 	// 显式处理可空字段：nil 需要 clear，非 nil 需要 set。
 	if groupIn.DailyLimitUSD != nil {
@@ -190,6 +213,39 @@ func (r *groupRepository) Update(ctx context.Context, groupIn *service.Group) er
 		builder = builder.ClearModelRouting()
 	}
 
+	// 处理 TrafficSplitRules：nil 时清除，否则设置
+	if groupIn.TrafficSplitRules != nil {
+		builder = builder.SetTrafficSplitRules(groupIn.TrafficSplitRules)
+	} else {
+		builder = builder.ClearTrafficSplitRules()
+	}
+
+	// 处理 ModelFallbackChains：nil 时清除，否则设置
+	if groupIn.ModelFallbackChains != nil {
+		builder = builder.SetModelFallbackChains(groupIn.ModelFallbackChains)
+	} else {
+		builder = builder.ClearModelFallbackChains()
+	}
+
+	// 处理 VolumeTiers：nil 时清除，否则设置
+	if groupIn.VolumeTiers != nil {
+		builder = builder.SetVolumeTiers(groupIn.VolumeTiers)
+	} else {
+		builder = builder.ClearVolumeTiers()
+	}
+
+	// 处理可用时段与维护提示：空字符串时清除
+	if groupIn.AvailabilitySchedule != "" {
+		builder = builder.SetAvailabilitySchedule(groupIn.AvailabilitySchedule)
+	} else {
+		builder = builder.ClearAvailabilitySchedule()
+	}
+	if groupIn.MaintenanceMessage != "" {
+		builder = builder.SetMaintenanceMessage(groupIn.MaintenanceMessage)
+	} else {
+		builder = builder.ClearMaintenanceMessage()
+	}
+
 	// 处理 SupportedModelScopes（始终设置，空数组表示不限制）
 	builder = builder.SetSupportedModelScopes(groupIn.SupportedModelScopes)
 
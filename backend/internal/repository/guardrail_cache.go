@@ -0,0 +1,195 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/model"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	guardrailCacheKey  = "guardrail_rules"
+	guardrailPubSubKey = "guardrail_rules_updated"
+	guardrailCacheTTL  = 24 * time.Hour
+
+	// 违规计数：按用户的动作哈希 + 全局排行有序集合
+	guardrailViolationUserKeyPrefix = "guardrail_violations:user:"
+	guardrailViolationRankKey       = "guardrail_violations:rank"
+)
+
+type guardrailCache struct {
+	rdb        *redis.Client
+	localCache []*model.GuardrailRule
+	localMu    sync.RWMutex
+}
+
+// NewGuardrailCache 创建护栏规则缓存
+func NewGuardrailCache(rdb *redis.Client) service.GuardrailCache {
+	return &guardrailCache{
+		rdb: rdb,
+	}
+}
+
+// Get 从缓存获取规则列表
+func (c *guardrailCache) Get(ctx context.Context) ([]*model.GuardrailRule, bool) {
+	// 先检查本地缓存
+	c.localMu.RLock()
+	if c.localCache != nil {
+		rules := c.localCache
+		c.localMu.RUnlock()
+		return rules, true
+	}
+	c.localMu.RUnlock()
+
+	// 从 Redis 获取
+	data, err := c.rdb.Get(ctx, guardrailCacheKey).Bytes()
+	if err != nil {
+		if err != redis.Nil {
+			log.Printf("[GuardrailCache] Failed to get from Redis: %v", err)
+		}
+		return nil, false
+	}
+
+	var rules []*model.GuardrailRule
+	if err := json.Unmarshal(data, &rules); err != nil {
+		log.Printf("[GuardrailCache] Failed to unmarshal rules: %v", err)
+		return nil, false
+	}
+
+	// 更新本地缓存
+	c.localMu.Lock()
+	c.localCache = rules
+	c.localMu.Unlock()
+
+	return rules, true
+}
+
+// Set 设置缓存
+func (c *guardrailCache) Set(ctx context.Context, rules []*model.GuardrailRule) error {
+	data, err := json.Marshal(rules)
+	if err != nil {
+		return err
+	}
+
+	if err := c.rdb.Set(ctx, guardrailCacheKey, data, guardrailCacheTTL).Err(); err != nil {
+		return err
+	}
+
+	// 更新本地缓存
+	c.localMu.Lock()
+	c.localCache = rules
+	c.localMu.Unlock()
+
+	return nil
+}
+
+// Invalidate 使缓存失效
+func (c *guardrailCache) Invalidate(ctx context.Context) error {
+	// 清除本地缓存
+	c.localMu.Lock()
+	c.localCache = nil
+	c.localMu.Unlock()
+
+	// 清除 Redis 缓存
+	return c.rdb.Del(ctx, guardrailCacheKey).Err()
+}
+
+// NotifyUpdate 通知其他实例刷新缓存
+func (c *guardrailCache) NotifyUpdate(ctx context.Context) error {
+	return c.rdb.Publish(ctx, guardrailPubSubKey, "refresh").Err()
+}
+
+// SubscribeUpdates 订阅缓存更新通知
+func (c *guardrailCache) SubscribeUpdates(ctx context.Context, handler func()) {
+	go func() {
+		sub := c.rdb.Subscribe(ctx, guardrailPubSubKey)
+		defer func() { _ = sub.Close() }()
+
+		ch := sub.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case msg := <-ch:
+				if msg == nil {
+					return
+				}
+				// 清除本地缓存，下次访问时会从 Redis 或数据库重新加载
+				c.localMu.Lock()
+				c.localCache = nil
+				c.localMu.Unlock()
+
+				// 调用处理函数
+				handler()
+			}
+		}
+	}()
+}
+
+func guardrailViolationUserKey(userID int64) string {
+	return guardrailViolationUserKeyPrefix + strconv.FormatInt(userID, 10)
+}
+
+// IncrViolation 累加用户的违规次数（按动作分别计数，同时更新总排行）
+func (c *guardrailCache) IncrViolation(ctx context.Context, userID int64, action string) error {
+	pipe := c.rdb.TxPipeline()
+	pipe.HIncrBy(ctx, guardrailViolationUserKey(userID), action, 1)
+	pipe.ZIncrBy(ctx, guardrailViolationRankKey, 1, strconv.FormatInt(userID, 10))
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// GetUserViolations 获取单个用户按动作划分的违规次数
+func (c *guardrailCache) GetUserViolations(ctx context.Context, userID int64) (map[string]int64, error) {
+	raw, err := c.rdb.HGetAll(ctx, guardrailViolationUserKey(userID)).Result()
+	if err != nil {
+		return nil, err
+	}
+	counts := make(map[string]int64, len(raw))
+	for action, v := range raw {
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			continue
+		}
+		counts[action] = n
+	}
+	return counts, nil
+}
+
+// ListTopViolators 按违规总次数倒序返回用户列表
+func (c *guardrailCache) ListTopViolators(ctx context.Context, limit int) ([]service.GuardrailUserViolations, error) {
+	if limit <= 0 {
+		limit = 20
+	}
+	members, err := c.rdb.ZRevRangeWithScores(ctx, guardrailViolationRankKey, 0, int64(limit-1)).Result()
+	if err != nil {
+		return nil, err
+	}
+	result := make([]service.GuardrailUserViolations, 0, len(members))
+	for _, m := range members {
+		member, ok := m.Member.(string)
+		if !ok {
+			continue
+		}
+		userID, err := strconv.ParseInt(member, 10, 64)
+		if err != nil {
+			continue
+		}
+		counts, err := c.GetUserViolations(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, service.GuardrailUserViolations{
+			UserID:   userID,
+			Total:    int64(m.Score),
+			ByAction: counts,
+		})
+	}
+	return result, nil
+}
@@ -0,0 +1,134 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/Wei-Shaw/sub2api/ent"
+	"github.com/Wei-Shaw/sub2api/ent/guardrailrule"
+	"github.com/Wei-Shaw/sub2api/internal/model"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+)
+
+type guardrailRepository struct {
+	client *ent.Client
+}
+
+// NewGuardrailRepository 创建护栏规则仓库
+func NewGuardrailRepository(client *ent.Client) service.GuardrailRepository {
+	return &guardrailRepository{client: client}
+}
+
+// List 获取所有规则（按优先级排序）
+func (r *guardrailRepository) List(ctx context.Context) ([]*model.GuardrailRule, error) {
+	rules, err := r.client.GuardrailRule.Query().
+		Order(ent.Asc(guardrailrule.FieldPriority), ent.Asc(guardrailrule.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.GuardrailRule, len(rules))
+	for i, rule := range rules {
+		result[i] = r.toModel(rule)
+	}
+	return result, nil
+}
+
+// GetByID 根据 ID 获取规则
+func (r *guardrailRepository) GetByID(ctx context.Context, id int64) (*model.GuardrailRule, error) {
+	rule, err := r.client.GuardrailRule.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return r.toModel(rule), nil
+}
+
+// Create 创建规则
+func (r *guardrailRepository) Create(ctx context.Context, rule *model.GuardrailRule) (*model.GuardrailRule, error) {
+	builder := r.client.GuardrailRule.Create().
+		SetName(rule.Name).
+		SetEnabled(rule.Enabled).
+		SetPriority(rule.Priority).
+		SetPlatforms(rule.Platforms).
+		SetGroupIds(rule.GroupIDs).
+		SetType(rule.Type).
+		SetPatterns(rule.Patterns).
+		SetCaseSensitive(rule.CaseSensitive).
+		SetAction(rule.Action)
+
+	if rule.Description != nil {
+		builder.SetDescription(*rule.Description)
+	}
+
+	created, err := builder.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.toModel(created), nil
+}
+
+// Update 更新规则
+func (r *guardrailRepository) Update(ctx context.Context, rule *model.GuardrailRule) (*model.GuardrailRule, error) {
+	builder := r.client.GuardrailRule.UpdateOneID(rule.ID).
+		SetName(rule.Name).
+		SetEnabled(rule.Enabled).
+		SetPriority(rule.Priority).
+		SetPlatforms(rule.Platforms).
+		SetGroupIds(rule.GroupIDs).
+		SetType(rule.Type).
+		SetPatterns(rule.Patterns).
+		SetCaseSensitive(rule.CaseSensitive).
+		SetAction(rule.Action)
+
+	if rule.Description != nil {
+		builder.SetDescription(*rule.Description)
+	} else {
+		builder.ClearDescription()
+	}
+
+	updated, err := builder.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.toModel(updated), nil
+}
+
+// Delete 删除规则
+func (r *guardrailRepository) Delete(ctx context.Context, id int64) error {
+	return r.client.GuardrailRule.DeleteOneID(id).Exec(ctx)
+}
+
+// toModel 将 Ent 实体转换为服务模型
+func (r *guardrailRepository) toModel(e *ent.GuardrailRule) *model.GuardrailRule {
+	rule := &model.GuardrailRule{
+		ID:            e.ID,
+		Name:          e.Name,
+		Enabled:       e.Enabled,
+		Priority:      e.Priority,
+		Platforms:     e.Platforms,
+		GroupIDs:      e.GroupIds,
+		Type:          e.Type,
+		Patterns:      e.Patterns,
+		CaseSensitive: e.CaseSensitive,
+		Action:        e.Action,
+		Description:   e.Description,
+		CreatedAt:     e.CreatedAt,
+		UpdatedAt:     e.UpdatedAt,
+	}
+
+	// 确保切片不为 nil
+	if rule.Platforms == nil {
+		rule.Platforms = []string{}
+	}
+	if rule.GroupIDs == nil {
+		rule.GroupIDs = []int64{}
+	}
+	if rule.Patterns == nil {
+		rule.Patterns = []string{}
+	}
+
+	return rule
+}
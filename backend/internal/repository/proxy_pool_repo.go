@@ -0,0 +1,131 @@
+package repository
+
+import (
+	"context"
+
+	dbent "github.com/Wei-Shaw/sub2api/ent"
+	"github.com/Wei-Shaw/sub2api/ent/account"
+	"github.com/Wei-Shaw/sub2api/ent/proxypool"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+)
+
+type proxyPoolRepository struct {
+	client *dbent.Client
+}
+
+// NewProxyPoolRepository 创建代理池仓库
+func NewProxyPoolRepository(client *dbent.Client) service.ProxyPoolRepository {
+	return &proxyPoolRepository{client: client}
+}
+
+func (r *proxyPoolRepository) Create(ctx context.Context, pool *service.ProxyPool) error {
+	builder := r.client.ProxyPool.Create().
+		SetName(pool.Name).
+		SetStatus(pool.Status).
+		SetMembers(pool.Members)
+	if pool.Description != nil {
+		builder.SetDescription(*pool.Description)
+	}
+
+	created, err := builder.Save(ctx)
+	if err != nil {
+		return err
+	}
+	applyProxyPoolEntityToService(pool, created)
+	return nil
+}
+
+func (r *proxyPoolRepository) GetByID(ctx context.Context, id int64) (*service.ProxyPool, error) {
+	m, err := r.client.ProxyPool.Get(ctx, id)
+	if err != nil {
+		if dbent.IsNotFound(err) {
+			return nil, service.ErrProxyPoolNotFound
+		}
+		return nil, err
+	}
+	out := &service.ProxyPool{}
+	applyProxyPoolEntityToService(out, m)
+	return out, nil
+}
+
+func (r *proxyPoolRepository) Update(ctx context.Context, pool *service.ProxyPool) error {
+	builder := r.client.ProxyPool.UpdateOneID(pool.ID).
+		SetName(pool.Name).
+		SetStatus(pool.Status).
+		SetMembers(pool.Members)
+	if pool.Description != nil {
+		builder.SetDescription(*pool.Description)
+	} else {
+		builder.ClearDescription()
+	}
+
+	updated, err := builder.Save(ctx)
+	if err != nil {
+		if dbent.IsNotFound(err) {
+			return service.ErrProxyPoolNotFound
+		}
+		return err
+	}
+	applyProxyPoolEntityToService(pool, updated)
+	return nil
+}
+
+func (r *proxyPoolRepository) Delete(ctx context.Context, id int64) error {
+	_, err := r.client.ProxyPool.Delete().Where(proxypool.IDEQ(id)).Exec(ctx)
+	return err
+}
+
+func (r *proxyPoolRepository) List(ctx context.Context) ([]service.ProxyPool, error) {
+	pools, err := r.client.ProxyPool.Query().
+		Order(dbent.Asc(proxypool.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return proxyPoolEntitiesToService(pools), nil
+}
+
+func (r *proxyPoolRepository) ListActive(ctx context.Context) ([]service.ProxyPool, error) {
+	pools, err := r.client.ProxyPool.Query().
+		Where(proxypool.StatusEQ(service.StatusActive)).
+		Order(dbent.Asc(proxypool.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return proxyPoolEntitiesToService(pools), nil
+}
+
+// CountAccountsByPoolID 统计引用该代理池的账号数（不含已删除账号）
+func (r *proxyPoolRepository) CountAccountsByPoolID(ctx context.Context, poolID int64) (int64, error) {
+	count, err := r.client.Account.Query().
+		Where(account.ProxyPoolIDEQ(poolID)).
+		Count(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return int64(count), nil
+}
+
+func proxyPoolEntitiesToService(pools []*dbent.ProxyPool) []service.ProxyPool {
+	out := make([]service.ProxyPool, 0, len(pools))
+	for _, m := range pools {
+		var pool service.ProxyPool
+		applyProxyPoolEntityToService(&pool, m)
+		out = append(out, pool)
+	}
+	return out
+}
+
+func applyProxyPoolEntityToService(dst *service.ProxyPool, src *dbent.ProxyPool) {
+	if dst == nil || src == nil {
+		return
+	}
+	dst.ID = src.ID
+	dst.Name = src.Name
+	dst.Status = src.Status
+	dst.Members = src.Members
+	dst.Description = src.Description
+	dst.CreatedAt = src.CreatedAt
+	dst.UpdatedAt = src.UpdatedAt
+}
@@ -14,6 +14,8 @@ const (
 	refreshTokenKeyPrefix   = "refresh_token:"
 	userRefreshTokensPrefix = "user_refresh_tokens:"
 	tokenFamilyPrefix       = "token_family:"
+	// userKnownDevicesPrefix holds the set of device fingerprints (User-Agent hashes) a user has signed in from.
+	userKnownDevicesPrefix = "user_known_devices:"
 )
 
 // refreshTokenKey generates the Redis key for a refresh token.
@@ -31,6 +33,11 @@ func tokenFamilyKey(familyID string) string {
 	return tokenFamilyPrefix + familyID
 }
 
+// userKnownDevicesKey generates the Redis key for user's known device fingerprints.
+func userKnownDevicesKey(userID int64) string {
+	return fmt.Sprintf("%s%d", userKnownDevicesPrefix, userID)
+}
+
 type refreshTokenCache struct {
 	rdb *redis.Client
 }
@@ -156,3 +163,34 @@ func (c *refreshTokenCache) IsTokenInFamily(ctx context.Context, familyID string
 	key := tokenFamilyKey(familyID)
 	return c.rdb.SIsMember(ctx, key, tokenHash).Result()
 }
+
+func (c *refreshTokenCache) HasActiveFamilyToken(ctx context.Context, familyID string) (bool, error) {
+	tokenHashes, err := c.GetFamilyTokenHashes(ctx, familyID)
+	if err != nil && err != redis.Nil {
+		return false, fmt.Errorf("get family token hashes: %w", err)
+	}
+	if len(tokenHashes) == 0 {
+		return false, nil
+	}
+	keys := make([]string, 0, len(tokenHashes))
+	for _, hash := range tokenHashes {
+		keys = append(keys, refreshTokenKey(hash))
+	}
+	n, err := c.rdb.Exists(ctx, keys...).Result()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+func (c *refreshTokenCache) MarkKnownDevice(ctx context.Context, userID int64, fingerprint string, ttl time.Duration) (bool, bool, error) {
+	key := userKnownDevicesKey(userID)
+	pipe := c.rdb.TxPipeline()
+	cardCmd := pipe.SCard(ctx, key)
+	addCmd := pipe.SAdd(ctx, key, fingerprint)
+	pipe.Expire(ctx, key, ttl)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return false, false, err
+	}
+	return addCmd.Val() > 0, cardCmd.Val() > 0, nil
+}
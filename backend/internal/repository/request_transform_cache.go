@@ -0,0 +1,128 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/model"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	requestTransformCacheKey  = "request_transform_rules"
+	requestTransformPubSubKey = "request_transform_rules_updated"
+	requestTransformCacheTTL  = 24 * time.Hour
+)
+
+type requestTransformCache struct {
+	rdb        *redis.Client
+	localCache []*model.RequestTransformRule
+	localMu    sync.RWMutex
+}
+
+// NewRequestTransformCache 创建请求改写规则缓存
+func NewRequestTransformCache(rdb *redis.Client) service.RequestTransformCache {
+	return &requestTransformCache{
+		rdb: rdb,
+	}
+}
+
+// Get 从缓存获取规则列表
+func (c *requestTransformCache) Get(ctx context.Context) ([]*model.RequestTransformRule, bool) {
+	// 先检查本地缓存
+	c.localMu.RLock()
+	if c.localCache != nil {
+		rules := c.localCache
+		c.localMu.RUnlock()
+		return rules, true
+	}
+	c.localMu.RUnlock()
+
+	// 从 Redis 获取
+	data, err := c.rdb.Get(ctx, requestTransformCacheKey).Bytes()
+	if err != nil {
+		if err != redis.Nil {
+			log.Printf("[RequestTransformCache] Failed to get from Redis: %v", err)
+		}
+		return nil, false
+	}
+
+	var rules []*model.RequestTransformRule
+	if err := json.Unmarshal(data, &rules); err != nil {
+		log.Printf("[RequestTransformCache] Failed to unmarshal rules: %v", err)
+		return nil, false
+	}
+
+	// 更新本地缓存
+	c.localMu.Lock()
+	c.localCache = rules
+	c.localMu.Unlock()
+
+	return rules, true
+}
+
+// Set 设置缓存
+func (c *requestTransformCache) Set(ctx context.Context, rules []*model.RequestTransformRule) error {
+	data, err := json.Marshal(rules)
+	if err != nil {
+		return err
+	}
+
+	if err := c.rdb.Set(ctx, requestTransformCacheKey, data, requestTransformCacheTTL).Err(); err != nil {
+		return err
+	}
+
+	// 更新本地缓存
+	c.localMu.Lock()
+	c.localCache = rules
+	c.localMu.Unlock()
+
+	return nil
+}
+
+// Invalidate 使缓存失效
+func (c *requestTransformCache) Invalidate(ctx context.Context) error {
+	// 清除本地缓存
+	c.localMu.Lock()
+	c.localCache = nil
+	c.localMu.Unlock()
+
+	// 清除 Redis 缓存
+	return c.rdb.Del(ctx, requestTransformCacheKey).Err()
+}
+
+// NotifyUpdate 通知其他实例刷新缓存
+func (c *requestTransformCache) NotifyUpdate(ctx context.Context) error {
+	return c.rdb.Publish(ctx, requestTransformPubSubKey, "refresh").Err()
+}
+
+// SubscribeUpdates 订阅缓存更新通知
+func (c *requestTransformCache) SubscribeUpdates(ctx context.Context, handler func()) {
+	go func() {
+		sub := c.rdb.Subscribe(ctx, requestTransformPubSubKey)
+		defer func() { _ = sub.Close() }()
+
+		ch := sub.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case msg := <-ch:
+				if msg == nil {
+					return
+				}
+				// 清除本地缓存，下次访问时会从 Redis 或数据库重新加载
+				c.localMu.Lock()
+				c.localCache = nil
+				c.localMu.Unlock()
+
+				// 调用处理函数
+				handler()
+			}
+		}
+	}()
+}
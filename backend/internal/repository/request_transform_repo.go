@@ -0,0 +1,143 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/Wei-Shaw/sub2api/ent"
+	"github.com/Wei-Shaw/sub2api/ent/requesttransformrule"
+	"github.com/Wei-Shaw/sub2api/internal/model"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+)
+
+type requestTransformRepository struct {
+	client *ent.Client
+}
+
+// NewRequestTransformRepository 创建请求改写规则仓库
+func NewRequestTransformRepository(client *ent.Client) service.RequestTransformRepository {
+	return &requestTransformRepository{client: client}
+}
+
+// List 获取所有规则（按优先级排序）
+func (r *requestTransformRepository) List(ctx context.Context) ([]*model.RequestTransformRule, error) {
+	rules, err := r.client.RequestTransformRule.Query().
+		Order(ent.Asc(requesttransformrule.FieldPriority), ent.Asc(requesttransformrule.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.RequestTransformRule, len(rules))
+	for i, rule := range rules {
+		result[i] = r.toModel(rule)
+	}
+	return result, nil
+}
+
+// GetByID 根据 ID 获取规则
+func (r *requestTransformRepository) GetByID(ctx context.Context, id int64) (*model.RequestTransformRule, error) {
+	rule, err := r.client.RequestTransformRule.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return r.toModel(rule), nil
+}
+
+// Create 创建规则
+func (r *requestTransformRepository) Create(ctx context.Context, rule *model.RequestTransformRule) (*model.RequestTransformRule, error) {
+	builder := r.client.RequestTransformRule.Create().
+		SetName(rule.Name).
+		SetEnabled(rule.Enabled).
+		SetPriority(rule.Priority).
+		SetPlatforms(rule.Platforms).
+		SetGroupIds(rule.GroupIDs).
+		SetAccountIds(rule.AccountIDs).
+		SetModelPatterns(rule.ModelPatterns).
+		SetBodyOperations(rule.BodyOperations).
+		SetHeaderOperations(rule.HeaderOperations)
+
+	if rule.Description != nil {
+		builder.SetDescription(*rule.Description)
+	}
+
+	created, err := builder.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.toModel(created), nil
+}
+
+// Update 更新规则
+func (r *requestTransformRepository) Update(ctx context.Context, rule *model.RequestTransformRule) (*model.RequestTransformRule, error) {
+	builder := r.client.RequestTransformRule.UpdateOneID(rule.ID).
+		SetName(rule.Name).
+		SetEnabled(rule.Enabled).
+		SetPriority(rule.Priority).
+		SetPlatforms(rule.Platforms).
+		SetGroupIds(rule.GroupIDs).
+		SetAccountIds(rule.AccountIDs).
+		SetModelPatterns(rule.ModelPatterns).
+		SetBodyOperations(rule.BodyOperations).
+		SetHeaderOperations(rule.HeaderOperations)
+
+	if rule.Description != nil {
+		builder.SetDescription(*rule.Description)
+	} else {
+		builder.ClearDescription()
+	}
+
+	updated, err := builder.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.toModel(updated), nil
+}
+
+// Delete 删除规则
+func (r *requestTransformRepository) Delete(ctx context.Context, id int64) error {
+	return r.client.RequestTransformRule.DeleteOneID(id).Exec(ctx)
+}
+
+// toModel 将 Ent 实体转换为服务模型
+func (r *requestTransformRepository) toModel(e *ent.RequestTransformRule) *model.RequestTransformRule {
+	rule := &model.RequestTransformRule{
+		ID:               e.ID,
+		Name:             e.Name,
+		Enabled:          e.Enabled,
+		Priority:         e.Priority,
+		Platforms:        e.Platforms,
+		GroupIDs:         e.GroupIds,
+		AccountIDs:       e.AccountIds,
+		ModelPatterns:    e.ModelPatterns,
+		BodyOperations:   e.BodyOperations,
+		HeaderOperations: e.HeaderOperations,
+		Description:      e.Description,
+		CreatedAt:        e.CreatedAt,
+		UpdatedAt:        e.UpdatedAt,
+	}
+
+	// 确保切片不为 nil
+	if rule.Platforms == nil {
+		rule.Platforms = []string{}
+	}
+	if rule.GroupIDs == nil {
+		rule.GroupIDs = []int64{}
+	}
+	if rule.AccountIDs == nil {
+		rule.AccountIDs = []int64{}
+	}
+	if rule.ModelPatterns == nil {
+		rule.ModelPatterns = []string{}
+	}
+	if rule.BodyOperations == nil {
+		rule.BodyOperations = []model.RequestBodyOperation{}
+	}
+	if rule.HeaderOperations == nil {
+		rule.HeaderOperations = []model.RequestHeaderOperation{}
+	}
+
+	return rule
+}
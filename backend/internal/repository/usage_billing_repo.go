@@ -183,10 +183,10 @@ func deductUsageBillingBalance(ctx context.Context, tx *sql.Tx, userID int64, am
 	if err != nil {
 		return err
 	}
-	if affected > 0 {
-		return nil
+	if affected == 0 {
+		return service.ErrUserNotFound
 	}
-	return service.ErrUserNotFound
+	return consumeBalanceBuckets(ctx, tx, userID, amount, nil, service.BalanceBucketStatusExhausted)
 }
 
 func incrementUsageBillingAPIKeyQuota(ctx context.Context, tx *sql.Tx, apiKeyID int64, amount float64) (bool, error) {
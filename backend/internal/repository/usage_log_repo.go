@@ -28,7 +28,7 @@ import (
 	gocache "github.com/patrickmn/go-cache"
 )
 
-const usageLogSelectColumns = "id, user_id, api_key_id, account_id, request_id, model, requested_model, upstream_model, group_id, subscription_id, input_tokens, output_tokens, cache_creation_tokens, cache_read_tokens, cache_creation_5m_tokens, cache_creation_1h_tokens, input_cost, output_cost, cache_creation_cost, cache_read_cost, total_cost, actual_cost, rate_multiplier, account_rate_multiplier, billing_type, request_type, stream, openai_ws_mode, duration_ms, first_token_ms, user_agent, ip_address, image_count, image_size, service_tier, reasoning_effort, inbound_endpoint, upstream_endpoint, cache_ttl_overridden, created_at"
+const usageLogSelectColumns = "id, user_id, api_key_id, account_id, request_id, model, requested_model, upstream_model, group_id, subscription_id, input_tokens, output_tokens, cache_creation_tokens, cache_read_tokens, cache_creation_5m_tokens, cache_creation_1h_tokens, input_cost, output_cost, cache_creation_cost, cache_read_cost, total_cost, actual_cost, rate_multiplier, account_rate_multiplier, billing_type, request_type, stream, openai_ws_mode, duration_ms, first_token_ms, user_agent, ip_address, image_count, image_size, service_tier, reasoning_effort, inbound_endpoint, upstream_endpoint, cache_ttl_overridden, sub_key_id, end_user_id, traffic_label, fallback_model, shadow, unbilled, long_context, created_at"
 
 // usageLogInsertArgTypes must stay in the same order as:
 //  1. prepareUsageLogInsert().args
@@ -76,6 +76,13 @@ var usageLogInsertArgTypes = [...]string{
 	"text",        // inbound_endpoint
 	"text",        // upstream_endpoint
 	"boolean",     // cache_ttl_overridden
+	"text",        // sub_key_id
+	"text",        // end_user_id
+	"text",        // traffic_label
+	"text",        // fallback_model
+	"bool",        // shadow
+	"bool",        // unbilled
+	"bool",        // long_context
 	"timestamptz", // created_at
 }
 
@@ -221,7 +228,7 @@ func (r *usageLogRepository) getPerformanceStats(ctx context.Context, userID int
 			COUNT(*) as request_count,
 			COALESCE(SUM(input_tokens + output_tokens), 0) as token_count
 		FROM usage_logs
-		WHERE created_at >= $1`
+		WHERE created_at >= $1 AND shadow = FALSE`
 	args := []any{fiveMinutesAgo}
 	if userID > 0 {
 		query += " AND user_id = $2"
@@ -348,6 +355,13 @@ func (r *usageLogRepository) createSingle(ctx context.Context, sqlq sqlExecutor,
 			inbound_endpoint,
 			upstream_endpoint,
 			cache_ttl_overridden,
+			sub_key_id,
+			end_user_id,
+			traffic_label,
+			fallback_model,
+			shadow,
+			unbilled,
+			long_context,
 			created_at
 		) VALUES (
 			$1, $2, $3, $4, $5, $6, $7,
@@ -355,7 +369,7 @@ func (r *usageLogRepository) createSingle(ctx context.Context, sqlq sqlExecutor,
 			$10, $11, $12, $13,
 			$14, $15,
 			$16, $17, $18, $19, $20, $21,
-			$22, $23, $24, $25, $26, $27, $28, $29, $30, $31, $32, $33, $34, $35, $36, $37, $38, $39
+			$22, $23, $24, $25, $26, $27, $28, $29, $30, $31, $32, $33, $34, $35, $36, $37, $38, $39, $40, $41, $42, $43, $44, $45, $46
 		)
 		ON CONFLICT (request_id, api_key_id) DO NOTHING
 		RETURNING id, created_at
@@ -779,10 +793,17 @@ func buildUsageLogBatchInsertQuery(keys []string, preparedByKey map[string]usage
 			inbound_endpoint,
 			upstream_endpoint,
 			cache_ttl_overridden,
+			sub_key_id,
+			end_user_id,
+			traffic_label,
+			fallback_model,
+			shadow,
+			unbilled,
+			long_context,
 			created_at
 		) AS (VALUES `)
 
-	args := make([]any, 0, len(keys)*39)
+	args := make([]any, 0, len(keys)*46)
 	argPos := 1
 	for idx, key := range keys {
 		if idx > 0 {
@@ -849,6 +870,13 @@ func buildUsageLogBatchInsertQuery(keys []string, preparedByKey map[string]usage
 				inbound_endpoint,
 				upstream_endpoint,
 				cache_ttl_overridden,
+				sub_key_id,
+				end_user_id,
+				traffic_label,
+				fallback_model,
+				shadow,
+				unbilled,
+				long_context,
 				created_at
 			)
 			SELECT
@@ -890,6 +918,13 @@ func buildUsageLogBatchInsertQuery(keys []string, preparedByKey map[string]usage
 				inbound_endpoint,
 				upstream_endpoint,
 				cache_ttl_overridden,
+				sub_key_id,
+				end_user_id,
+				traffic_label,
+				fallback_model,
+				shadow,
+				unbilled,
+				long_context,
 				created_at
 			FROM input
 			ON CONFLICT (request_id, api_key_id) DO NOTHING
@@ -971,10 +1006,17 @@ func buildUsageLogBestEffortInsertQuery(preparedList []usageLogInsertPrepared) (
 			inbound_endpoint,
 			upstream_endpoint,
 			cache_ttl_overridden,
+			sub_key_id,
+			end_user_id,
+			traffic_label,
+			fallback_model,
+			shadow,
+			unbilled,
+			long_context,
 			created_at
 		) AS (VALUES `)
 
-	args := make([]any, 0, len(preparedList)*40)
+	args := make([]any, 0, len(preparedList)*47)
 	argPos := 1
 	for idx, prepared := range preparedList {
 		if idx > 0 {
@@ -1038,6 +1080,13 @@ func buildUsageLogBestEffortInsertQuery(preparedList []usageLogInsertPrepared) (
 			inbound_endpoint,
 			upstream_endpoint,
 			cache_ttl_overridden,
+			sub_key_id,
+			end_user_id,
+			traffic_label,
+			fallback_model,
+			shadow,
+			unbilled,
+			long_context,
 			created_at
 		)
 		SELECT
@@ -1079,6 +1128,13 @@ func buildUsageLogBestEffortInsertQuery(preparedList []usageLogInsertPrepared) (
 			inbound_endpoint,
 			upstream_endpoint,
 			cache_ttl_overridden,
+			sub_key_id,
+			end_user_id,
+			traffic_label,
+			fallback_model,
+			shadow,
+			unbilled,
+			long_context,
 			created_at
 		FROM input
 		ON CONFLICT (request_id, api_key_id) DO NOTHING
@@ -1128,6 +1184,13 @@ func execUsageLogInsertNoResult(ctx context.Context, sqlq sqlExecutor, prepared
 			inbound_endpoint,
 			upstream_endpoint,
 			cache_ttl_overridden,
+			sub_key_id,
+			end_user_id,
+			traffic_label,
+			fallback_model,
+			shadow,
+			unbilled,
+			long_context,
 			created_at
 		) VALUES (
 			$1, $2, $3, $4, $5, $6, $7,
@@ -1135,7 +1198,7 @@ func execUsageLogInsertNoResult(ctx context.Context, sqlq sqlExecutor, prepared
 			$10, $11, $12, $13,
 			$14, $15,
 			$16, $17, $18, $19, $20, $21,
-			$22, $23, $24, $25, $26, $27, $28, $29, $30, $31, $32, $33, $34, $35, $36, $37, $38, $39
+			$22, $23, $24, $25, $26, $27, $28, $29, $30, $31, $32, $33, $34, $35, $36, $37, $38, $39, $40, $41, $42, $43, $44, $45, $46
 		)
 		ON CONFLICT (request_id, api_key_id) DO NOTHING
 	`, prepared.args...)
@@ -1166,6 +1229,10 @@ func prepareUsageLogInsert(log *service.UsageLog) usageLogInsertPrepared {
 	reasoningEffort := nullString(log.ReasoningEffort)
 	inboundEndpoint := nullString(log.InboundEndpoint)
 	upstreamEndpoint := nullString(log.UpstreamEndpoint)
+	subKeyID := nullString(log.SubKeyID)
+	endUserID := nullString(log.EndUserID)
+	trafficLabel := nullString(log.TrafficLabel)
+	fallbackModel := nullString(log.FallbackModel)
 	requestedModel := strings.TrimSpace(log.RequestedModel)
 	if requestedModel == "" {
 		requestedModel = strings.TrimSpace(log.Model)
@@ -1221,6 +1288,13 @@ func prepareUsageLogInsert(log *service.UsageLog) usageLogInsertPrepared {
 			inboundEndpoint,
 			upstreamEndpoint,
 			log.CacheTTLOverridden,
+			subKeyID,
+			endUserID,
+			trafficLabel,
+			fallbackModel,
+			log.Shadow,
+			log.Unbilled,
+			log.LongContext,
 			createdAt,
 		},
 	}
@@ -1306,7 +1380,7 @@ func (r *usageLogRepository) GetUserStats(ctx context.Context, userID int64, sta
 			COALESCE(SUM(output_tokens), 0) as output_tokens,
 			COALESCE(SUM(cache_read_tokens), 0) as cache_read_tokens
 		FROM usage_logs
-		WHERE user_id = $1 AND created_at >= $2 AND created_at < $3
+		WHERE user_id = $1 AND created_at >= $2 AND created_at < $3 AND shadow = FALSE
 	`
 
 	stats := &UserStats{}
@@ -1541,7 +1615,7 @@ func (r *usageLogRepository) fillDashboardUsageStatsFromUsageLogs(ctx context.Co
 				actual_cost,
 				COALESCE(duration_ms, 0) AS duration_ms
 			FROM usage_logs
-			WHERE created_at >= LEAST($1::timestamptz, $3::timestamptz)
+			WHERE created_at >= LEAST($1::timestamptz, $3::timestamptz) AND shadow = FALSE
 				AND created_at < GREATEST($2::timestamptz, $4::timestamptz)
 		)
 		SELECT
@@ -1599,7 +1673,7 @@ func (r *usageLogRepository) fillDashboardUsageStatsFromUsageLogs(ctx context.Co
 		WITH scoped AS (
 			SELECT user_id, created_at
 			FROM usage_logs
-			WHERE created_at >= LEAST($1::timestamptz, $3::timestamptz)
+			WHERE created_at >= LEAST($1::timestamptz, $3::timestamptz) AND shadow = FALSE
 				AND created_at < GREATEST($2::timestamptz, $4::timestamptz)
 		)
 		SELECT
@@ -1636,7 +1710,7 @@ func (r *usageLogRepository) GetUserStatsAggregated(ctx context.Context, userID
 			COALESCE(SUM(actual_cost), 0) as total_actual_cost,
 			COALESCE(AVG(COALESCE(duration_ms, 0)), 0) as avg_duration_ms
 		FROM usage_logs
-		WHERE user_id = $1 AND created_at >= $2 AND created_at < $3
+		WHERE user_id = $1 AND created_at >= $2 AND created_at < $3 AND shadow = FALSE
 	`
 
 	var stats usagestats.UsageStats
@@ -1671,7 +1745,7 @@ func (r *usageLogRepository) GetAPIKeyStatsAggregated(ctx context.Context, apiKe
 			COALESCE(SUM(actual_cost), 0) as total_actual_cost,
 			COALESCE(AVG(COALESCE(duration_ms, 0)), 0) as avg_duration_ms
 		FROM usage_logs
-		WHERE api_key_id = $1 AND created_at >= $2 AND created_at < $3
+		WHERE api_key_id = $1 AND created_at >= $2 AND created_at < $3 AND shadow = FALSE
 	`
 
 	var stats usagestats.UsageStats
@@ -1752,7 +1826,7 @@ func (r *usageLogRepository) GetModelStatsAggregated(ctx context.Context, modelN
 			COALESCE(SUM(actual_cost), 0) as total_actual_cost,
 			COALESCE(AVG(COALESCE(duration_ms, 0)), 0) as avg_duration_ms
 		FROM usage_logs
-		WHERE %s = $1 AND created_at >= $2 AND created_at < $3
+		WHERE %s = $1 AND created_at >= $2 AND created_at < $3 AND shadow = FALSE
 	`, rawUsageLogModelColumn)
 
 	var stats usagestats.UsageStats
@@ -1791,7 +1865,7 @@ func (r *usageLogRepository) GetDailyStatsAggregated(ctx context.Context, userID
 			COALESCE(SUM(actual_cost), 0) as total_actual_cost,
 			COALESCE(AVG(COALESCE(duration_ms, 0)), 0) as avg_duration_ms
 		FROM usage_logs
-		WHERE user_id = $1 AND created_at >= $2 AND created_at < $3
+		WHERE user_id = $1 AND created_at >= $2 AND created_at < $3 AND shadow = FALSE
 		GROUP BY 1
 		ORDER BY 1
 	`
@@ -2081,7 +2155,7 @@ func (r *usageLogRepository) GetAPIKeyUsageTrend(ctx context.Context, startTime,
 		WITH top_keys AS (
 			SELECT api_key_id
 			FROM usage_logs
-			WHERE created_at >= $1 AND created_at < $2
+			WHERE created_at >= $1 AND created_at < $2 AND shadow = FALSE
 			GROUP BY api_key_id
 			ORDER BY SUM(input_tokens + output_tokens + cache_creation_tokens + cache_read_tokens) DESC
 			LIMIT $3
@@ -2094,7 +2168,7 @@ func (r *usageLogRepository) GetAPIKeyUsageTrend(ctx context.Context, startTime,
 			COALESCE(SUM(u.input_tokens + u.output_tokens + u.cache_creation_tokens + u.cache_read_tokens), 0) as tokens
 		FROM usage_logs u
 		LEFT JOIN api_keys k ON u.api_key_id = k.id
-		WHERE u.api_key_id IN (SELECT api_key_id FROM top_keys)
+		WHERE u.api_key_id IN (SELECT api_key_id FROM top_keys) AND u.shadow = FALSE
 		  AND u.created_at >= $4 AND u.created_at < $5
 		GROUP BY date, u.api_key_id, k.name
 		ORDER BY date ASC, tokens DESC
@@ -2136,7 +2210,7 @@ func (r *usageLogRepository) GetUserUsageTrend(ctx context.Context, startTime, e
 		WITH top_users AS (
 			SELECT user_id
 			FROM usage_logs
-			WHERE created_at >= $1 AND created_at < $2
+			WHERE created_at >= $1 AND created_at < $2 AND shadow = FALSE
 			GROUP BY user_id
 			ORDER BY SUM(input_tokens + output_tokens + cache_creation_tokens + cache_read_tokens) DESC
 			LIMIT $3
@@ -2152,7 +2226,7 @@ func (r *usageLogRepository) GetUserUsageTrend(ctx context.Context, startTime, e
 			COALESCE(SUM(u.actual_cost), 0) as actual_cost
 		FROM usage_logs u
 		LEFT JOIN users us ON u.user_id = us.id
-		WHERE u.user_id IN (SELECT user_id FROM top_users)
+		WHERE u.user_id IN (SELECT user_id FROM top_users) AND u.shadow = FALSE
 		  AND u.created_at >= $4 AND u.created_at < $5
 		GROUP BY date, u.user_id, us.email, us.username
 		ORDER BY date ASC, tokens DESC
@@ -2202,7 +2276,7 @@ func (r *usageLogRepository) GetUserSpendingRanking(ctx context.Context, startTi
 				COALESCE(SUM(u.input_tokens + u.output_tokens + u.cache_creation_tokens + u.cache_read_tokens), 0) as tokens
 			FROM usage_logs u
 			LEFT JOIN users us ON u.user_id = us.id
-			WHERE u.created_at >= $1 AND u.created_at < $2
+			WHERE u.created_at >= $1 AND u.created_at < $2 AND u.shadow = FALSE
 			GROUP BY u.user_id, us.email
 		),
 		ranked AS (
@@ -2306,7 +2380,7 @@ func (r *usageLogRepository) GetUserDashboardStats(ctx context.Context, userID i
 			COALESCE(SUM(actual_cost), 0) as total_actual_cost,
 			COALESCE(AVG(duration_ms), 0) as avg_duration_ms
 		FROM usage_logs
-		WHERE user_id = $1
+		WHERE user_id = $1 AND shadow = FALSE
 	`
 	if err := scanSingleRow(
 		ctx,
@@ -2337,7 +2411,7 @@ func (r *usageLogRepository) GetUserDashboardStats(ctx context.Context, userID i
 			COALESCE(SUM(total_cost), 0) as today_cost,
 			COALESCE(SUM(actual_cost), 0) as today_actual_cost
 		FROM usage_logs
-		WHERE user_id = $1 AND created_at >= $2
+		WHERE user_id = $1 AND created_at >= $2 AND shadow = FALSE
 	`
 	if err := scanSingleRow(
 		ctx,
@@ -2375,7 +2449,7 @@ func (r *usageLogRepository) getPerformanceStatsByAPIKey(ctx context.Context, ap
 			COUNT(*) as request_count,
 			COALESCE(SUM(input_tokens + output_tokens + cache_creation_tokens + cache_read_tokens), 0) as token_count
 		FROM usage_logs
-		WHERE created_at >= $1 AND api_key_id = $2`
+		WHERE created_at >= $1 AND api_key_id = $2 AND shadow = FALSE`
 	args := []any{fiveMinutesAgo, apiKeyID}
 
 	var requestCount int64
@@ -2407,7 +2481,7 @@ func (r *usageLogRepository) GetAPIKeyDashboardStats(ctx context.Context, apiKey
 			COALESCE(SUM(actual_cost), 0) as total_actual_cost,
 			COALESCE(AVG(duration_ms), 0) as avg_duration_ms
 		FROM usage_logs
-		WHERE api_key_id = $1
+		WHERE api_key_id = $1 AND shadow = FALSE
 	`
 	if err := scanSingleRow(
 		ctx,
@@ -2438,7 +2512,7 @@ func (r *usageLogRepository) GetAPIKeyDashboardStats(ctx context.Context, apiKey
 			COALESCE(SUM(total_cost), 0) as today_cost,
 			COALESCE(SUM(actual_cost), 0) as today_actual_cost
 		FROM usage_logs
-		WHERE api_key_id = $1 AND created_at >= $2
+		WHERE api_key_id = $1 AND created_at >= $2 AND shadow = FALSE
 	`
 	if err := scanSingleRow(
 		ctx,
@@ -2484,7 +2558,7 @@ func (r *usageLogRepository) GetUserUsageTrendByUserID(ctx context.Context, user
 			COALESCE(SUM(total_cost), 0) as cost,
 			COALESCE(SUM(actual_cost), 0) as actual_cost
 		FROM usage_logs
-		WHERE user_id = $1 AND created_at >= $2 AND created_at < $3
+		WHERE user_id = $1 AND created_at >= $2 AND created_at < $3 AND shadow = FALSE
 		GROUP BY date
 		ORDER BY date ASC
 	`, dateFormat)
@@ -2523,7 +2597,7 @@ func (r *usageLogRepository) GetUserModelStats(ctx context.Context, userID int64
 			COALESCE(SUM(total_cost), 0) as cost,
 			COALESCE(SUM(actual_cost), 0) as actual_cost
 		FROM usage_logs
-		WHERE user_id = $1 AND created_at >= $2 AND created_at < $3
+		WHERE user_id = $1 AND created_at >= $2 AND created_at < $3 AND shadow = FALSE
 		GROUP BY model
 		ORDER BY total_tokens DESC
 	`
@@ -2668,7 +2742,7 @@ func (r *usageLogRepository) GetBatchUserUsageStats(ctx context.Context, userIDs
 			COALESCE(SUM(actual_cost) FILTER (WHERE created_at >= $2 AND created_at < $3), 0) as total_cost,
 			COALESCE(SUM(actual_cost) FILTER (WHERE created_at >= $4), 0) as today_cost
 		FROM usage_logs
-		WHERE user_id = ANY($1)
+		WHERE user_id = ANY($1) AND shadow = FALSE
 		  AND created_at >= LEAST($2, $4)
 		GROUP BY user_id
 	`
@@ -2730,7 +2804,7 @@ func (r *usageLogRepository) GetBatchAPIKeyUsageStats(ctx context.Context, apiKe
 			COALESCE(SUM(actual_cost) FILTER (WHERE created_at >= $2 AND created_at < $3), 0) as total_cost,
 			COALESCE(SUM(actual_cost) FILTER (WHERE created_at >= $4), 0) as today_cost
 		FROM usage_logs
-		WHERE api_key_id = ANY($1)
+		WHERE api_key_id = ANY($1) AND shadow = FALSE
 		  AND created_at >= LEAST($2, $4)
 		GROUP BY api_key_id
 	`
@@ -2785,7 +2859,7 @@ func (r *usageLogRepository) GetUsageTrendWithFilters(ctx context.Context, start
 			COALESCE(SUM(total_cost), 0) as cost,
 			COALESCE(SUM(actual_cost), 0) as actual_cost
 		FROM usage_logs
-		WHERE created_at >= $1 AND created_at < $2
+		WHERE created_at >= $1 AND created_at < $2 AND shadow = FALSE
 	`, dateFormat)
 
 	args := []any{startTime, endTime}
@@ -2938,7 +3012,7 @@ func (r *usageLogRepository) getModelStatsWithFiltersBySource(ctx context.Contex
 			COALESCE(SUM(total_cost), 0) as cost,
 			%s
 		FROM usage_logs
-		WHERE created_at >= $1 AND created_at < $2
+		WHERE created_at >= $1 AND created_at < $2 AND shadow = FALSE
 	`, modelExpr, actualCostExpr)
 
 	args := []any{startTime, endTime}
@@ -2997,7 +3071,7 @@ func (r *usageLogRepository) GetGroupStatsWithFilters(ctx context.Context, start
 			COALESCE(SUM(ul.actual_cost), 0) as actual_cost
 		FROM usage_logs ul
 		LEFT JOIN groups g ON g.id = ul.group_id
-		WHERE ul.created_at >= $1 AND ul.created_at < $2
+		WHERE ul.created_at >= $1 AND ul.created_at < $2 AND ul.shadow = FALSE
 	`
 
 	args := []any{startTime, endTime}
@@ -3068,7 +3142,7 @@ func (r *usageLogRepository) GetUserBreakdownStats(ctx context.Context, startTim
 			COALESCE(SUM(ul.actual_cost), 0) as actual_cost
 		FROM usage_logs ul
 		LEFT JOIN users u ON u.id = ul.user_id
-		WHERE ul.created_at >= $1 AND ul.created_at < $2
+		WHERE ul.created_at >= $1 AND ul.created_at < $2 AND ul.shadow = FALSE
 	`
 	args := []any{startTime, endTime}
 
@@ -3158,6 +3232,58 @@ func (r *usageLogRepository) GetAllGroupUsageSummary(ctx context.Context, todayS
 	return results, nil
 }
 
+// GetTrafficSplitReport 按流量拆分标签与实际模型聚合分组用量，用于拆分/影子流量对比
+func (r *usageLogRepository) GetTrafficSplitReport(ctx context.Context, groupID int64, startTime, endTime time.Time) ([]service.TrafficSplitReportItem, error) {
+	query := `
+		SELECT
+			traffic_label,
+			shadow,
+			COALESCE(NULLIF(TRIM(upstream_model), ''), model) AS model,
+			COUNT(*) AS requests,
+			COALESCE(SUM(input_tokens), 0) AS input_tokens,
+			COALESCE(SUM(output_tokens), 0) AS output_tokens,
+			COALESCE(SUM(total_cost), 0) AS total_cost,
+			COALESCE(SUM(actual_cost), 0) AS actual_cost,
+			COALESCE(AVG(duration_ms), 0) AS avg_duration_ms,
+			COALESCE(AVG(first_token_ms), 0) AS avg_first_token_ms
+		FROM usage_logs
+		WHERE group_id = $1
+			AND traffic_label IS NOT NULL
+			AND created_at >= $2 AND created_at < $3
+		GROUP BY traffic_label, shadow, 3
+		ORDER BY shadow, traffic_label, 3
+	`
+
+	rows, err := r.sql.QueryContext(ctx, query, groupID, startTime, endTime)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+	results := make([]service.TrafficSplitReportItem, 0)
+	for rows.Next() {
+		var row service.TrafficSplitReportItem
+		if err := rows.Scan(
+			&row.Label,
+			&row.Shadow,
+			&row.Model,
+			&row.Requests,
+			&row.InputTokens,
+			&row.OutputTokens,
+			&row.TotalCost,
+			&row.ActualCost,
+			&row.AvgDurationMs,
+			&row.AvgFirstTokenMs,
+		); err != nil {
+			return nil, err
+		}
+		results = append(results, row)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
 // resolveModelDimensionExpression maps model source type to a safe SQL expression.
 func resolveModelDimensionExpression(modelType string) string {
 	requestedExpr := "COALESCE(NULLIF(TRIM(requested_model), ''), model)"
@@ -3195,7 +3321,7 @@ func (r *usageLogRepository) GetGlobalStats(ctx context.Context, startTime, endT
 			COALESCE(SUM(actual_cost), 0) as total_actual_cost,
 			COALESCE(AVG(duration_ms), 0) as avg_duration_ms
 		FROM usage_logs
-		WHERE created_at >= $1 AND created_at < $2
+		WHERE created_at >= $1 AND created_at < $2 AND shadow = FALSE
 	`
 
 	stats := &UsageStats{}
@@ -3253,6 +3379,8 @@ func (r *usageLogRepository) GetStatsWithFilters(ctx context.Context, filters Us
 		conditions = append(conditions, fmt.Sprintf("created_at < $%d", len(args)+1))
 		args = append(args, *filters.EndTime)
 	}
+	// 影子请求仅用于流量拆分对比，不计入用量统计
+	conditions = append(conditions, "shadow = FALSE")
 
 	query := fmt.Sprintf(`
 		SELECT
@@ -3348,7 +3476,7 @@ func (r *usageLogRepository) getEndpointStatsByColumnWithFilters(ctx context.Con
 			COALESCE(SUM(total_cost), 0) as cost,
 			%s
 		FROM usage_logs
-		WHERE created_at >= $1 AND created_at < $2
+		WHERE created_at >= $1 AND created_at < $2 AND shadow = FALSE
 	`, endpointColumn, actualCostExpr)
 
 	args := []any{startTime, endTime}
@@ -3419,7 +3547,7 @@ func (r *usageLogRepository) getEndpointPathStatsWithFilters(ctx context.Context
 			COALESCE(SUM(total_cost), 0) as cost,
 			%s
 		FROM usage_logs
-		WHERE created_at >= $1 AND created_at < $2
+		WHERE created_at >= $1 AND created_at < $2 AND shadow = FALSE
 	`, actualCostExpr)
 
 	args := []any{startTime, endTime}
@@ -3947,6 +4075,13 @@ func scanUsageLog(scanner interface{ Scan(...any) error }) (*service.UsageLog, e
 		inboundEndpoint       sql.NullString
 		upstreamEndpoint      sql.NullString
 		cacheTTLOverridden    bool
+		subKeyID              sql.NullString
+		endUserID             sql.NullString
+		trafficLabel          sql.NullString
+		fallbackModel         sql.NullString
+		shadow                bool
+		unbilled              bool
+		longContext           bool
 		createdAt             time.Time
 	)
 
@@ -3990,6 +4125,13 @@ func scanUsageLog(scanner interface{ Scan(...any) error }) (*service.UsageLog, e
 		&inboundEndpoint,
 		&upstreamEndpoint,
 		&cacheTTLOverridden,
+		&subKeyID,
+		&endUserID,
+		&trafficLabel,
+		&fallbackModel,
+		&shadow,
+		&unbilled,
+		&longContext,
 		&createdAt,
 	); err != nil {
 		return nil, err
@@ -4071,6 +4213,21 @@ func scanUsageLog(scanner interface{ Scan(...any) error }) (*service.UsageLog, e
 	if upstreamModel.Valid {
 		log.UpstreamModel = &upstreamModel.String
 	}
+	if subKeyID.Valid {
+		log.SubKeyID = &subKeyID.String
+	}
+	if endUserID.Valid {
+		log.EndUserID = &endUserID.String
+	}
+	if trafficLabel.Valid {
+		log.TrafficLabel = &trafficLabel.String
+	}
+	if fallbackModel.Valid {
+		log.FallbackModel = &fallbackModel.String
+	}
+	log.Shadow = shadow
+	log.Unbilled = unbilled
+	log.LongContext = longContext
 
 	return log, nil
 }
@@ -371,7 +371,13 @@ func (r *userRepository) filterUsersByAttributes(ctx context.Context, attrs map[
 	return result, nil
 }
 
+// UpdateBalance 调整用户余额
+// 正数入账不生成余额分桶（由调用方通过 BalanceBucketService.Grant 记录来源）；
+// 负数按优先级同步消耗分桶。
 func (r *userRepository) UpdateBalance(ctx context.Context, id int64, amount float64) error {
+	if amount < 0 {
+		return r.DeductBalance(ctx, id, -amount)
+	}
 	client := clientFromContext(ctx, r.client)
 	update := client.User.Update().Where(dbuser.IDEQ(id)).AddBalance(amount)
 	if amount > 0 {
@@ -387,22 +393,23 @@ func (r *userRepository) UpdateBalance(ctx context.Context, id int64, amount flo
 	return nil
 }
 
-// DeductBalance 扣除用户余额
+// DeductBalance 扣除用户余额，并在同一事务内按优先级消耗余额分桶
 // 透支策略：允许余额变为负数，确保当前请求能够完成
 // 中间件会阻止余额 <= 0 的用户发起后续请求
 func (r *userRepository) DeductBalance(ctx context.Context, id int64, amount float64) error {
-	client := clientFromContext(ctx, r.client)
-	n, err := client.User.Update().
-		Where(dbuser.IDEQ(id)).
-		AddBalance(-amount).
-		Save(ctx)
-	if err != nil {
-		return err
-	}
-	if n == 0 {
-		return service.ErrUserNotFound
-	}
-	return nil
+	return runInBalanceTx(ctx, r.client, func(ctx context.Context, client *dbent.Client) error {
+		n, err := client.User.Update().
+			Where(dbuser.IDEQ(id)).
+			AddBalance(-amount).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return service.ErrUserNotFound
+		}
+		return consumeBalanceBuckets(ctx, client, id, amount, nil, service.BalanceBucketStatusExhausted)
+	})
 }
 
 func (r *userRepository) UpdateConcurrency(ctx context.Context, id int64, amount int) error {
@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/redis/go-redis/v9"
+)
+
+const volumeTierKeyPrefix = "volume_tier:"
+
+// volumeTierKey generates the Redis key for a user's monthly tier state in a group.
+// Format: volume_tier:{userID}:{groupID}:{YYYY-MM}
+func volumeTierKey(userID, groupID int64, month string) string {
+	return fmt.Sprintf("%s%d:%d:%s", volumeTierKeyPrefix, userID, groupID, month)
+}
+
+type volumeTierCache struct {
+	rdb *redis.Client
+}
+
+func NewVolumeTierCache(rdb *redis.Client) service.VolumeTierCache {
+	return &volumeTierCache{rdb: rdb}
+}
+
+func (c *volumeTierCache) GetVolumeTierState(ctx context.Context, userID, groupID int64, month string) (*service.VolumeTierState, error) {
+	val, err := c.rdb.Get(ctx, volumeTierKey(userID, groupID, month)).Result()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var state service.VolumeTierState
+	if err := json.Unmarshal([]byte(val), &state); err != nil {
+		return nil, err
+	}
+	return &state, nil
+}
+
+func (c *volumeTierCache) SetVolumeTierState(ctx context.Context, userID, groupID int64, state *service.VolumeTierState, ttl time.Duration) error {
+	val, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	return c.rdb.Set(ctx, volumeTierKey(userID, groupID, state.Month), val, ttl).Err()
+}
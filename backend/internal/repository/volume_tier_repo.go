@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/service"
+)
+
+type volumeTierRepository struct {
+	sql sqlExecutor
+}
+
+func NewVolumeTierRepository(sqlDB *sql.DB) service.VolumeTierRepository {
+	return &volumeTierRepository{sql: sqlDB}
+}
+
+func (r *volumeTierRepository) GetUserGroupSpend(ctx context.Context, userID, groupID int64, start, end time.Time) (float64, error) {
+	var spend float64
+	query := `
+		SELECT COALESCE(SUM(actual_cost), 0)
+		FROM usage_logs
+		WHERE user_id = $1 AND group_id = $2 AND created_at >= $3 AND created_at < $4
+	`
+	if err := scanSingleRow(ctx, r.sql, query, []any{userID, groupID, start, end}, &spend); err != nil {
+		return 0, err
+	}
+	return spend, nil
+}
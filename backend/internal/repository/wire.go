@@ -31,6 +31,11 @@ func ProvideGitHubReleaseClient(cfg *config.Config) service.GitHubReleaseClient
 	return NewGitHubReleaseClient(cfg.Update.ProxyURL, cfg.Security.ProxyFallback.AllowDirectOnError)
 }
 
+// ProvideHTTPUpstream 创建上游 HTTP 客户端，并将经由代理池成员的转发结果上报给代理池用于故障切换
+func ProvideHTTPUpstream(cfg *config.Config, proxyPools *service.ProxyPoolService) service.HTTPUpstream {
+	return service.WrapHTTPUpstreamWithProxyPools(NewHTTPUpstream(cfg), proxyPools)
+}
+
 // ProvidePricingRemoteClient 创建定价数据远程客户端
 // 从配置中读取代理设置，支持国内服务器通过代理访问 GitHub 上的定价数据
 func ProvidePricingRemoteClient(cfg *config.Config) service.PricingRemoteClient {
@@ -57,10 +62,12 @@ var ProviderSet = wire.NewSet(
 	NewScheduledTestPlanRepository,   // 定时测试计划仓储
 	NewScheduledTestResultRepository, // 定时测试结果仓储
 	NewProxyRepository,
+	NewProxyPoolRepository,
 	NewRedeemCodeRepository,
 	NewReferralRepository,
 	NewPaygOrderRepository,
 	NewPromoCodeRepository,
+	NewBalanceBucketRepository,
 	NewAnnouncementRepository,
 	NewAnnouncementReadRepository,
 	NewUsageLogRepository,
@@ -75,8 +82,13 @@ var ProviderSet = wire.NewSet(
 	NewUserAttributeValueRepository,
 	NewUserGroupRateRepository,
 	NewErrorPassthroughRepository,
+	NewRequestTransformRepository,
+	NewGatewayPluginRepository,
+	NewGuardrailRepository,
 	NewChannelMonitorRepository,
 	NewChannelMonitorRequestTemplateRepository,
+	NewVolumeTierRepository,
+	NewBillingRecomputeRepository,
 
 	// Cache implementations
 	NewGatewayCache,
@@ -100,6 +112,11 @@ var ProviderSet = wire.NewSet(
 	NewTotpCache,
 	NewRefreshTokenCache,
 	NewErrorPassthroughCache,
+	NewRequestTransformCache,
+	NewGatewayPluginCache,
+	NewGuardrailCache,
+	NewFairQueueCache,
+	NewVolumeTierCache,
 
 	// Encryptors
 	NewAESEncryptor,
@@ -115,7 +132,7 @@ var ProviderSet = wire.NewSet(
 	NewProxyExitInfoProber,
 	NewClaudeUsageFetcher,
 	NewClaudeOAuthClient,
-	NewHTTPUpstream,
+	ProvideHTTPUpstream,
 	NewOpenAIOAuthClient,
 	NewGeminiOAuthClient,
 	NewGeminiCliCodeAssistClient,
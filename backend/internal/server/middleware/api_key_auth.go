@@ -64,6 +64,13 @@ func apiKeyAuthWithSubscription(apiKeyService *service.APIKeyService, subscripti
 			return
 		}
 
+		// 临时子密钥：离线校验后按父 Key 鉴权
+		apiKeyString, ephemeral, err := resolveEphemeralKey(apiKeyService, apiKeyString)
+		if err != nil {
+			abortWithEphemeralKeyError(c, err)
+			return
+		}
+
 		// ── 2. 验证 Key 存在 ─────────────────────────────────────────
 
 		apiKey, err := apiKeyService.GetByKey(c.Request.Context(), apiKeyString)
@@ -75,9 +82,19 @@ func apiKeyAuthWithSubscription(apiKeyService *service.APIKeyService, subscripti
 			AbortWithError(c, 500, "INTERNAL_ERROR", "Failed to validate API key")
 			return
 		}
+		if apiKey, err = scopeEphemeralKey(apiKey, ephemeral); err != nil {
+			abortWithEphemeralKeyError(c, err)
+			return
+		}
 
 		// ── 3. 基础鉴权（始终执行） ─────────────────────────────────
 
+		// 账单逾期暂停
+		if apiKey.Status == service.StatusAPIKeySuspended {
+			AbortWithError(c, 403, "API_KEY_SUSPENDED", "API key is suspended because a billing statement is overdue")
+			return
+		}
+
 		// disabled / 未知状态 → 无条件拦截（expired 和 quota_exhausted 留给计费阶段）
 		if !apiKey.IsActive() &&
 			apiKey.Status != service.StatusAPIKeyExpired &&
@@ -112,6 +129,10 @@ func apiKeyAuthWithSubscription(apiKeyService *service.APIKeyService, subscripti
 		// ── 4. SimpleMode → early return ─────────────────────────────
 
 		if cfg.RunMode == config.RunModeSimple {
+			if err := checkEphemeralKeyLimits(c.Request.Context(), apiKeyService, apiKey); err != nil {
+				abortWithEphemeralKeyError(c, err)
+				return
+			}
 			c.Set(string(ContextKeyAPIKey), apiKey)
 			c.Set(string(ContextKeyUser), AuthSubject{
 				UserID:      apiKey.User.ID,
@@ -119,6 +140,7 @@ func apiKeyAuthWithSubscription(apiKeyService *service.APIKeyService, subscripti
 			})
 			c.Set(string(ContextKeyUserRole), apiKey.User.Role)
 			setGroupContext(c, apiKey.Group)
+			setPriorityClassContext(c, apiKey, cfg)
 			_ = apiKeyService.TouchLastUsed(c.Request.Context(), apiKey.ID)
 			c.Next()
 			return
@@ -172,6 +194,12 @@ func apiKeyAuthWithSubscription(apiKeyService *service.APIKeyService, subscripti
 				return
 			}
 
+			// 临时子密钥：RPM 与消费上限
+			if err := checkEphemeralKeyLimits(c.Request.Context(), apiKeyService, apiKey); err != nil {
+				abortWithEphemeralKeyError(c, err)
+				return
+			}
+
 			// 订阅模式：验证订阅限额
 			if subscription != nil {
 				needsMaintenance, validateErr := subscriptionService.ValidateAndCheckLimits(subscription, apiKey.Group)
@@ -195,7 +223,7 @@ func apiKeyAuthWithSubscription(apiKeyService *service.APIKeyService, subscripti
 				}
 			} else {
 				// 非订阅模式 或 订阅模式但 subscriptionService 未注入：回退到余额检查
-				if apiKey.User.Balance <= 0 {
+				if apiKeyService.IsBalanceExhausted(apiKey.User) {
 					AbortWithError(c, 403, "INSUFFICIENT_BALANCE", "Insufficient account balance")
 					return
 				}
@@ -214,6 +242,7 @@ func apiKeyAuthWithSubscription(apiKeyService *service.APIKeyService, subscripti
 		})
 		c.Set(string(ContextKeyUserRole), apiKey.User.Role)
 		setGroupContext(c, apiKey.Group)
+		setPriorityClassContext(c, apiKey, cfg)
 		_ = apiKeyService.TouchLastUsed(c.Request.Context(), apiKey.ID)
 
 		c.Next()
@@ -240,6 +269,19 @@ func GetSubscriptionFromContext(c *gin.Context) (*service.UserSubscription, bool
 	return subscription, ok
 }
 
+// setPriorityClassContext 根据 API Key 与请求头确定请求优先级分类并写入 context（未启用时跳过）
+func setPriorityClassContext(c *gin.Context, apiKey *service.APIKey, cfg *config.Config) {
+	if cfg == nil || !cfg.Gateway.PriorityClasses.Enabled || apiKey == nil {
+		return
+	}
+	requested := ""
+	if header := strings.TrimSpace(cfg.Gateway.PriorityClasses.HeaderName); header != "" {
+		requested = c.GetHeader(header)
+	}
+	class := service.ResolvePriorityClass(apiKey.PriorityClass, requested)
+	c.Request = c.Request.WithContext(service.WithPriorityClass(c.Request.Context(), class))
+}
+
 func setGroupContext(c *gin.Context, group *service.Group) {
 	if !service.IsGroupContextValid(group) {
 		return
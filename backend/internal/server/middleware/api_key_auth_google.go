@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/Wei-Shaw/sub2api/internal/config"
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
 	"github.com/Wei-Shaw/sub2api/internal/pkg/googleapi"
 	"github.com/Wei-Shaw/sub2api/internal/service"
 
@@ -32,6 +33,12 @@ func APIKeyAuthWithSubscriptionGoogle(apiKeyService *service.APIKeyService, subs
 			return
 		}
 
+		apiKeyString, ephemeral, err := resolveEphemeralKey(apiKeyService, apiKeyString)
+		if err != nil {
+			abortWithGoogleError(c, infraerrors.Code(err), infraerrors.Message(err))
+			return
+		}
+
 		apiKey, err := apiKeyService.GetByKey(c.Request.Context(), apiKeyString)
 		if err != nil {
 			if errors.Is(err, service.ErrAPIKeyNotFound) {
@@ -41,6 +48,10 @@ func APIKeyAuthWithSubscriptionGoogle(apiKeyService *service.APIKeyService, subs
 			abortWithGoogleError(c, 500, "Failed to validate API key")
 			return
 		}
+		if apiKey, err = scopeEphemeralKey(apiKey, ephemeral); err != nil {
+			abortWithGoogleError(c, infraerrors.Code(err), infraerrors.Message(err))
+			return
+		}
 
 		if !apiKey.IsActive() {
 			abortWithGoogleError(c, 401, "API key is disabled")
@@ -54,6 +65,10 @@ func APIKeyAuthWithSubscriptionGoogle(apiKeyService *service.APIKeyService, subs
 			abortWithGoogleError(c, 401, "User account is not active")
 			return
 		}
+		if err := checkEphemeralKeyLimits(c.Request.Context(), apiKeyService, apiKey); err != nil {
+			abortWithGoogleError(c, infraerrors.Code(err), infraerrors.Message(err))
+			return
+		}
 
 		// 简易模式：跳过余额和订阅检查
 		if cfg.RunMode == config.RunModeSimple {
@@ -64,6 +79,7 @@ func APIKeyAuthWithSubscriptionGoogle(apiKeyService *service.APIKeyService, subs
 			})
 			c.Set(string(ContextKeyUserRole), apiKey.User.Role)
 			setGroupContext(c, apiKey.Group)
+			setPriorityClassContext(c, apiKey, cfg)
 			_ = apiKeyService.TouchLastUsed(c.Request.Context(), apiKey.ID)
 			c.Next()
 			return
@@ -100,7 +116,7 @@ func APIKeyAuthWithSubscriptionGoogle(apiKeyService *service.APIKeyService, subs
 				subscriptionService.DoWindowMaintenance(&maintenanceCopy)
 			}
 		} else {
-			if apiKey.User.Balance <= 0 {
+			if apiKeyService.IsBalanceExhausted(apiKey.User) {
 				abortWithGoogleError(c, 403, "Insufficient account balance")
 				return
 			}
@@ -113,6 +129,7 @@ func APIKeyAuthWithSubscriptionGoogle(apiKeyService *service.APIKeyService, subs
 		})
 		c.Set(string(ContextKeyUserRole), apiKey.User.Role)
 		setGroupContext(c, apiKey.Group)
+		setPriorityClassContext(c, apiKey, cfg)
 		_ = apiKeyService.TouchLastUsed(c.Request.Context(), apiKey.ID)
 		c.Next()
 	}
@@ -492,6 +492,76 @@ func TestAPIKeyAuthTouchesLastUsedInStandardMode(t *testing.T) {
 	require.Equal(t, 1, touchCalls)
 }
 
+func TestAPIKeyAuthAcceptsEphemeralKey(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	user := &service.User{
+		ID:          9,
+		Role:        service.RoleUser,
+		Status:      service.StatusActive,
+		Balance:     10,
+		Concurrency: 3,
+	}
+	apiKey := &service.APIKey{
+		ID:     300,
+		UserID: user.ID,
+		Key:    "parent-key-for-ephemeral",
+		Status: service.StatusActive,
+		User:   user,
+	}
+	apiKeyRepo := &stubApiKeyRepo{
+		getByKey: func(ctx context.Context, key string) (*service.APIKey, error) {
+			if key != apiKey.Key {
+				return nil, service.ErrAPIKeyNotFound
+			}
+			clone := *apiKey
+			return &clone, nil
+		},
+	}
+
+	cfg := &config.Config{RunMode: config.RunModeStandard}
+	cfg.JWT.Secret = "ephemeral-test-secret"
+	apiKeyService := service.NewAPIKeyService(apiKeyRepo, nil, nil, nil, nil, nil, cfg)
+	minted, err := apiKeyService.MintEphemeralKey(context.Background(), apiKey, service.MintEphemeralKeyRequest{
+		Models:    []string{"claude-sonnet-*"},
+		EndUserID: "desktop-user",
+	})
+	require.NoError(t, err)
+
+	router := gin.New()
+	router.Use(gin.HandlerFunc(NewAPIKeyAuthMiddleware(apiKeyService, nil, cfg)))
+	router.GET("/t", func(c *gin.Context) {
+		key, ok := GetAPIKeyFromContext(c)
+		require.True(t, ok)
+		require.Equal(t, apiKey.ID, key.ID)
+		require.NotNil(t, key.Ephemeral)
+		require.Equal(t, minted.ID, key.Ephemeral.ID)
+		require.Equal(t, "desktop-user", key.Ephemeral.EndUserID)
+		c.JSON(http.StatusOK, gin.H{"ok": true})
+	})
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/t", nil)
+	req.Header.Set("Authorization", "Bearer "+minted.Key)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	// 父 Key 被重建（ID 变化）后子密钥失效
+	apiKey.ID = 301
+	w = httptest.NewRecorder()
+	req = httptest.NewRequest(http.MethodGet, "/t", nil)
+	req.Header.Set("Authorization", "Bearer "+minted.Key)
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusUnauthorized, w.Code)
+	require.Contains(t, w.Body.String(), "EPHEMERAL_KEY_INVALID")
+
+	w = httptest.NewRecorder()
+	req = httptest.NewRequest(http.MethodGet, "/t", nil)
+	req.Header.Set("x-api-key", minted.Key+"x")
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusUnauthorized, w.Code)
+}
+
 func newAuthTestRouter(apiKeyService *service.APIKeyService, subscriptionService *service.SubscriptionService, cfg *config.Config) *gin.Engine {
 	router := gin.New()
 	router.Use(gin.HandlerFunc(NewAPIKeyAuthMiddleware(apiKeyService, subscriptionService, cfg)))
@@ -716,3 +786,57 @@ func (r *stubUserSubscriptionRepo) IncrementUsage(ctx context.Context, id int64,
 func (r *stubUserSubscriptionRepo) BatchUpdateExpiredStatus(ctx context.Context) (int64, error) {
 	return 0, errors.New("not implemented")
 }
+
+type creditLimitStub map[int64]float64
+
+func (s creditLimitStub) CreditLimit(userID int64) float64 { return s[userID] }
+
+func TestAPIKeyAuthPostpaidBalance(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	user := &service.User{
+		ID:          11,
+		Role:        service.RoleUser,
+		Status:      service.StatusActive,
+		Balance:     -20,
+		Concurrency: 3,
+	}
+	apiKey := &service.APIKey{
+		ID:     110,
+		UserID: user.ID,
+		Key:    "postpaid-key",
+		Status: service.StatusActive,
+		User:   user,
+	}
+	apiKeyRepo := &stubApiKeyRepo{
+		getByKey: func(ctx context.Context, key string) (*service.APIKey, error) {
+			clone := *apiKey
+			return &clone, nil
+		},
+		updateLastUsed: func(ctx context.Context, id int64, usedAt time.Time) error { return nil },
+	}
+
+	cfg := &config.Config{RunMode: config.RunModeStandard}
+	apiKeyService := service.NewAPIKeyService(apiKeyRepo, nil, nil, nil, nil, nil, cfg)
+	router := newAuthTestRouter(apiKeyService, nil, cfg)
+	serve := func() *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/t", nil)
+		req.Header.Set("x-api-key", apiKey.Key)
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	// 预付费：负余额拒绝
+	require.Equal(t, http.StatusForbidden, serve().Code)
+
+	// 后付费：信用额度内放行
+	apiKeyService.SetCreditLimitProvider(creditLimitStub{user.ID: 100})
+	require.Equal(t, http.StatusOK, serve().Code)
+
+	// 账单逾期暂停
+	apiKey.Status = service.StatusAPIKeySuspended
+	w := serve()
+	require.Equal(t, http.StatusForbidden, w.Code)
+	require.Contains(t, w.Body.String(), "API_KEY_SUSPENDED")
+}
@@ -0,0 +1,50 @@
+package middleware
+
+import (
+	"context"
+
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// resolveEphemeralKey 若为临时子密钥（sk-eph-），离线解密校验后返回父 Key 与子密钥声明；
+// 普通 Key 原样返回。
+func resolveEphemeralKey(apiKeyService *service.APIKeyService, apiKeyString string) (string, *service.EphemeralKeyClaims, error) {
+	if !service.IsEphemeralKey(apiKeyString) {
+		return apiKeyString, nil, nil
+	}
+	claims, err := apiKeyService.ParseEphemeralKey(apiKeyString)
+	if err != nil {
+		return "", nil, err
+	}
+	return claims.ParentKey, claims, nil
+}
+
+// scopeEphemeralKey 返回携带子密钥范围的父 Key 副本（不修改缓存中的对象）。
+// 父 Key 被删除后重新创建同名 Key 时 ID 不同，子密钥随之失效。
+func scopeEphemeralKey(apiKey *service.APIKey, claims *service.EphemeralKeyClaims) (*service.APIKey, error) {
+	if claims == nil {
+		return apiKey, nil
+	}
+	if apiKey.ID != claims.ParentKeyID {
+		return nil, service.ErrEphemeralKeyInvalid
+	}
+	scoped := *apiKey
+	scoped.Ephemeral = claims
+	return &scoped, nil
+}
+
+// checkEphemeralKeyLimits 计入一次请求并检查子密钥 RPM / 消费上限
+func checkEphemeralKeyLimits(ctx context.Context, apiKeyService *service.APIKeyService, apiKey *service.APIKey) error {
+	if apiKey.Ephemeral == nil {
+		return nil
+	}
+	return apiKeyService.CheckEphemeralKeyLimits(ctx, apiKey.Ephemeral)
+}
+
+// abortWithEphemeralKeyError 将子密钥错误转换为标准错误响应
+func abortWithEphemeralKeyError(c *gin.Context, err error) {
+	AbortWithError(c, infraerrors.Code(err), infraerrors.Reason(err), infraerrors.Message(err))
+}
@@ -26,3 +26,13 @@ func GetUserRoleFromContext(c *gin.Context) (string, bool) {
 	role, ok := value.(string)
 	return role, ok
 }
+
+// GetAuthSessionIDFromContext 返回当前 access token 所属的登录会话ID
+func GetAuthSessionIDFromContext(c *gin.Context) (string, bool) {
+	value, exists := c.Get(string(ContextKeyAuthSessionID))
+	if !exists {
+		return "", false
+	}
+	sessionID, ok := value.(string)
+	return sessionID, ok && sessionID != ""
+}
@@ -0,0 +1,187 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/Wei-Shaw/sub2api/internal/model"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/ctxkey"
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/logger"
+	"github.com/Wei-Shaw/sub2api/internal/service"
+
+	"github.com/gin-gonic/gin"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+// GatewayPluginHooks 执行分组挂载的 WASM 插件钩子：
+//   - on_request：改写请求体/请求头或拒绝请求
+//   - on_route：改写模型或切换到同平台的其他可用分组
+//   - on_response_chunk：包装 ResponseWriter 逐片改写响应
+//   - on_usage：挂载到请求上下文，由 handler 随用量记录输入传递，在写入用量记录前异步回调
+//
+// WebSocket 握手（GET + Upgrade）没有请求体，会话挂载到请求上下文，由 handler 对每个请求帧执行
+// on_request / on_route；on_response_chunk 不作用于 WebSocket 下行帧。
+//
+// 必须位于 API Key 认证与分组校验之后。
+func GatewayPluginHooks(pluginService *service.GatewayPluginService, writeError GatewayErrorWriter) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		websocket := isWebSocketUpgrade(c.Request)
+		if pluginService == nil || (c.Request.Method != http.MethodPost && !websocket) {
+			c.Next()
+			return
+		}
+		apiKey, ok := GetAPIKeyFromContext(c)
+		if !ok {
+			c.Next()
+			return
+		}
+		groupID := int64(0)
+		if apiKey.GroupID != nil {
+			groupID = *apiKey.GroupID
+		}
+		session := pluginService.NewSession(groupID)
+		if session == nil {
+			c.Next()
+			return
+		}
+		defer session.Close(context.Background())
+
+		if websocket {
+			ctx := service.WithGatewayPluginSession(c.Request.Context(), session)
+			c.Request = c.Request.WithContext(service.WithUsageHook(ctx, session.UsageHook()))
+			c.Next()
+			return
+		}
+
+		ctx := c.Request.Context()
+		platform := ""
+		if forcePlatform, ok := GetForcePlatformFromContext(c); ok {
+			platform = forcePlatform
+		} else if apiKey.Group != nil {
+			platform = apiKey.Group.Platform
+		}
+
+		if session.HasHook(model.PluginHookOnRequest) || session.HasHook(model.PluginHookOnRoute) {
+			body, err := io.ReadAll(c.Request.Body)
+			if err != nil {
+				// 读取失败（如超出大小限制）时原样交给 handler 处理
+				c.Request.Body = io.NopCloser(io.MultiReader(bytes.NewReader(body), errReader{err: err}))
+				c.Next()
+				return
+			}
+
+			req := &service.GatewayPluginRequest{
+				Platform: platform,
+				Method:   c.Request.Method,
+				Path:     c.Request.URL.Path,
+				Model:    gjson.GetBytes(body, "model").String(),
+				Header:   c.Request.Header,
+				Body:     body,
+			}
+			rejection, err := session.OnRequest(ctx, req)
+			if err != nil {
+				writeError(c, infraerrors.Code(err), infraerrors.Message(err))
+				c.Abort()
+				return
+			}
+			if rejection != nil {
+				writeError(c, rejection.Status, rejection.Message)
+				c.Abort()
+				return
+			}
+			body = req.Body
+
+			decision, err := session.OnRoute(ctx, platform, gjson.GetBytes(body, "model").String())
+			if err != nil {
+				writeError(c, infraerrors.Code(err), infraerrors.Message(err))
+				c.Abort()
+				return
+			}
+			// 仅当请求体携带 model 字段时改写（Gemini 原生协议的模型在路径中）
+			if decision.Model != "" && gjson.GetBytes(body, "model").Exists() {
+				if next, err := sjson.SetBytes(body, "model", decision.Model); err == nil {
+					body = next
+				}
+			}
+			if decision.GroupID != nil && (apiKey.GroupID == nil || *decision.GroupID != *apiKey.GroupID) {
+				group, err := pluginService.ResolveRouteGroup(ctx, apiKey, *decision.GroupID)
+				if err != nil {
+					logger.LegacyPrintf("middleware.gateway_plugin", "[GatewayPlugin] ignore route to group %d for api_key=%d: %v", *decision.GroupID, apiKey.ID, err)
+				} else {
+					routed := *apiKey
+					routed.GroupID = &group.ID
+					routed.Group = group
+					apiKey = &routed
+					c.Set(string(ContextKeyAPIKey), apiKey)
+					// 请求上下文中的分组需同步切换，否则调度与计费仍使用原分组
+					c.Request = c.Request.WithContext(context.WithValue(c.Request.Context(), ctxkey.Group, group))
+				}
+			}
+
+			c.Request.Body = io.NopCloser(bytes.NewReader(body))
+			c.Request.ContentLength = int64(len(body))
+			c.Request.Header.Set("Content-Length", strconv.Itoa(len(body)))
+		}
+
+		if usageHook := session.UsageHook(); usageHook != nil {
+			c.Request = c.Request.WithContext(service.WithUsageHook(c.Request.Context(), usageHook))
+		}
+
+		if session.HasHook(model.PluginHookOnResponseChunk) {
+			c.Writer = &pluginResponseWriter{ResponseWriter: c.Writer, session: session, ctx: ctx}
+		}
+
+		c.Next()
+	}
+}
+
+// pluginResponseWriter 将响应分片交给插件改写后再写出
+type pluginResponseWriter struct {
+	gin.ResponseWriter
+	session *service.GatewayPluginSession
+	ctx     context.Context
+}
+
+func (w *pluginResponseWriter) Write(data []byte) (int, error) {
+	if !w.Written() {
+		// 插件可能改变分片长度，上游透传的 Content-Length 不再可靠
+		w.Header().Del("Content-Length")
+	}
+	out, err := w.session.OnResponseChunk(w.ctx, w.Status(), data)
+	if err != nil {
+		return 0, err
+	}
+	if len(out) > 0 {
+		if _, err := w.ResponseWriter.Write(out); err != nil {
+			return 0, err
+		}
+	}
+	// 向调用方报告原始长度，避免改写后的长度差异被视为短写
+	return len(data), nil
+}
+
+func (w *pluginResponseWriter) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
+
+// isWebSocketUpgrade 判断是否为 WebSocket 握手请求
+func isWebSocketUpgrade(r *http.Request) bool {
+	return r.Method == http.MethodGet &&
+		strings.EqualFold(strings.TrimSpace(r.Header.Get("Upgrade")), "websocket") &&
+		strings.Contains(strings.ToLower(r.Header.Get("Connection")), "upgrade")
+}
+
+// errReader 在请求体读完后返回原始读取错误
+type errReader struct {
+	err error
+}
+
+func (r errReader) Read([]byte) (int, error) {
+	return 0, r.err
+}
@@ -0,0 +1,52 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GatewayUnavailableErrorWriter 按入站协议输出服务不可用错误：
+// OpenAI 兼容端点（/chat/completions、/responses、/images）使用 OpenAI 格式，其余使用 Anthropic 格式。
+func GatewayUnavailableErrorWriter(c *gin.Context, status int, message string) {
+	if isOpenAICompatiblePath(c.Request.URL.Path) {
+		c.JSON(status, gin.H{
+			"error": gin.H{
+				"type":    "server_error",
+				"code":    "service_unavailable",
+				"message": message,
+			},
+		})
+		return
+	}
+	c.JSON(status, gin.H{
+		"type":  "error",
+		"error": gin.H{"type": "api_error", "message": message},
+	})
+}
+
+func isOpenAICompatiblePath(path string) bool {
+	return strings.Contains(path, "/chat/completions") ||
+		strings.Contains(path, "/responses") ||
+		strings.Contains(path, "/images/")
+}
+
+// GroupMaintenanceGuard 分组维护拦截中间件：分组开启维护模式或处于可用时段之外时，
+// 直接返回 503 与管理员配置的提示信息，不进入调度。必须位于 API Key 认证之后。
+func GroupMaintenanceGuard(writeError GatewayErrorWriter) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		apiKey, ok := GetAPIKeyFromContext(c)
+		if !ok || apiKey.Group == nil {
+			c.Next()
+			return
+		}
+		if msg := apiKey.Group.UnavailableMessage(time.Now()); msg != "" {
+			writeError(c, http.StatusServiceUnavailable, msg)
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
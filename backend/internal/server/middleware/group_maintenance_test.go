@@ -0,0 +1,84 @@
+//go:build unit
+
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Wei-Shaw/sub2api/internal/service"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+func newGroupMaintenanceRouter(group *service.Group, writeError GatewayErrorWriter) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(func(c *gin.Context) {
+		c.Set(string(ContextKeyAPIKey), &service.APIKey{ID: 1, Group: group})
+		c.Next()
+	})
+	r.Use(GroupMaintenanceGuard(writeError))
+	ok := func(c *gin.Context) { c.Status(http.StatusOK) }
+	r.POST("/v1/messages", ok)
+	r.POST("/v1/chat/completions", ok)
+	r.POST("/v1beta/models/*modelAction", ok)
+	return r
+}
+
+func TestGroupMaintenanceGuard_ProtocolErrorShapes(t *testing.T) {
+	group := &service.Group{ID: 1, MaintenanceMode: true, MaintenanceMessage: "Upgrading, back at 10:00"}
+
+	w := httptest.NewRecorder()
+	newGroupMaintenanceRouter(group, GatewayUnavailableErrorWriter).ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/v1/messages", nil))
+	require.Equal(t, http.StatusServiceUnavailable, w.Code)
+	var anthropicBody struct {
+		Type  string `json:"type"`
+		Error struct {
+			Type    string `json:"type"`
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &anthropicBody))
+	require.Equal(t, "error", anthropicBody.Type)
+	require.Equal(t, "api_error", anthropicBody.Error.Type)
+	require.Equal(t, "Upgrading, back at 10:00", anthropicBody.Error.Message)
+
+	w = httptest.NewRecorder()
+	newGroupMaintenanceRouter(group, GatewayUnavailableErrorWriter).ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/v1/chat/completions", nil))
+	require.Equal(t, http.StatusServiceUnavailable, w.Code)
+	var openAIBody struct {
+		Type  string `json:"type"`
+		Error struct {
+			Type    string `json:"type"`
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &openAIBody))
+	require.Empty(t, openAIBody.Type)
+	require.Equal(t, "server_error", openAIBody.Error.Type)
+	require.Equal(t, "Upgrading, back at 10:00", openAIBody.Error.Message)
+
+	w = httptest.NewRecorder()
+	newGroupMaintenanceRouter(group, GoogleErrorWriter).ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/v1beta/models/gemini-2.5-pro:generateContent", nil))
+	require.Equal(t, http.StatusServiceUnavailable, w.Code)
+	var googleBody struct {
+		Error struct {
+			Code    int    `json:"code"`
+			Message string `json:"message"`
+			Status  string `json:"status"`
+		} `json:"error"`
+	}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &googleBody))
+	require.Equal(t, http.StatusServiceUnavailable, googleBody.Error.Code)
+	require.Equal(t, "UNAVAILABLE", googleBody.Error.Status)
+	require.Equal(t, "Upgrading, back at 10:00", googleBody.Error.Message)
+}
+
+func TestGroupMaintenanceGuard_PassesAvailableGroup(t *testing.T) {
+	w := httptest.NewRecorder()
+	newGroupMaintenanceRouter(&service.Group{ID: 1}, GatewayUnavailableErrorWriter).ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/v1/messages", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+}
@@ -68,11 +68,20 @@ func jwtAuth(authService *service.AuthService, userService *service.UserService)
 			return
 		}
 
+		// 会话已被撤销（单设备下线 / 全部下线 / 登出）时立即拒绝，不等待 Access Token 过期
+		if claims.SessionID != "" && !authService.IsSessionActive(c.Request.Context(), claims.SessionID) {
+			AbortWithError(c, 401, "SESSION_REVOKED", "Session has been revoked")
+			return
+		}
+
 		c.Set(string(ContextKeyUser), AuthSubject{
 			UserID:      user.ID,
 			Concurrency: user.Concurrency,
 		})
 		c.Set(string(ContextKeyUserRole), user.Role)
+		if claims.SessionID != "" {
+			c.Set(string(ContextKeyAuthSessionID), claims.SessionID)
+		}
 
 		c.Next()
 	}
@@ -9,10 +9,12 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/Wei-Shaw/sub2api/internal/config"
 	"github.com/Wei-Shaw/sub2api/internal/service"
 	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/stretchr/testify/require"
 )
 
@@ -33,6 +35,20 @@ func (r *stubJWTUserRepo) GetByID(_ context.Context, id int64) (*service.User, e
 // newJWTTestEnv 创建 JWT 认证中间件测试环境。
 // 返回 gin.Engine（已注册 JWT 中间件）和 AuthService（用于生成 Token）。
 func newJWTTestEnv(users map[int64]*service.User) (*gin.Engine, *service.AuthService) {
+	return newJWTTestEnvWithSessions(users, nil)
+}
+
+// stubSessionCache 实现 RefreshTokenCache 的最小子集，仅支持会话存活检查。
+type stubSessionCache struct {
+	service.RefreshTokenCache
+	active map[string]bool
+}
+
+func (c *stubSessionCache) HasActiveFamilyToken(_ context.Context, familyID string) (bool, error) {
+	return c.active[familyID], nil
+}
+
+func newJWTTestEnvWithSessions(users map[int64]*service.User, sessions service.RefreshTokenCache) (*gin.Engine, *service.AuthService) {
 	gin.SetMode(gin.TestMode)
 
 	cfg := &config.Config{}
@@ -40,7 +56,7 @@ func newJWTTestEnv(users map[int64]*service.User) (*gin.Engine, *service.AuthSer
 	cfg.JWT.AccessTokenExpireMinutes = 60
 
 	userRepo := &stubJWTUserRepo{users: users}
-	authSvc := service.NewAuthService(nil, userRepo, nil, nil, cfg, nil, nil, nil, nil, nil, nil)
+	authSvc := service.NewAuthService(nil, userRepo, nil, sessions, cfg, nil, nil, nil, nil, nil, nil)
 	userSvc := service.NewUserService(userRepo, nil, nil)
 	mw := NewJWTAuthMiddleware(authSvc, userSvc)
 
@@ -254,3 +270,49 @@ func TestJWTAuth_TokenVersionMismatch(t *testing.T) {
 	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
 	require.Equal(t, "TOKEN_REVOKED", body.Code)
 }
+
+func TestJWTAuth_SessionRevoked(t *testing.T) {
+	user := &service.User{
+		ID:     1,
+		Email:  "test@example.com",
+		Role:   "user",
+		Status: service.StatusActive,
+	}
+	sessions := &stubSessionCache{active: map[string]bool{"live": true}}
+	router, _ := newJWTTestEnvWithSessions(map[int64]*service.User{1: user}, sessions)
+
+	signToken := func(sessionID string) string {
+		now := time.Now()
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, &service.JWTClaims{
+			UserID:    user.ID,
+			Email:     user.Email,
+			Role:      user.Role,
+			SessionID: sessionID,
+			RegisteredClaims: jwt.RegisteredClaims{
+				ExpiresAt: jwt.NewNumericDate(now.Add(time.Hour)),
+				IssuedAt:  jwt.NewNumericDate(now),
+				NotBefore: jwt.NewNumericDate(now),
+			},
+		})
+		signed, err := token.SignedString([]byte("test-jwt-secret-32bytes-long!!!"))
+		require.NoError(t, err)
+		return signed
+	}
+
+	// 会话仍有效
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/protected", nil)
+	req.Header.Set("Authorization", "Bearer "+signToken("live"))
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	// 会话已撤销：Access Token 未过期也被拒绝
+	w = httptest.NewRecorder()
+	req = httptest.NewRequest(http.MethodGet, "/protected", nil)
+	req.Header.Set("Authorization", "Bearer "+signToken("revoked"))
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusUnauthorized, w.Code)
+	var body ErrorResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	require.Equal(t, "SESSION_REVOKED", body.Code)
+}
@@ -80,6 +80,23 @@ func RegisterAdminRoutes(
 		// 错误透传规则管理
 		registerErrorPassthroughRoutes(admin, h)
 
+		// 请求改写规则管理
+		registerRequestTransformRoutes(admin, h)
+
+		// 网关插件管理
+		registerGatewayPluginRoutes(admin, h)
+
+		// 内容护栏规则管理
+		registerGuardrailRoutes(admin, h)
+		registerPostpaidRoutes(admin, h)
+		registerReferralWithdrawalRoutes(admin, h)
+
+		// 计费重算
+		registerBillingRecomputeRoutes(admin, h)
+
+		// 代理池管理
+		registerProxyPoolRoutes(admin, h)
+
 		// API Key 管理
 		registerAdminAPIKeyRoutes(admin, h)
 
@@ -253,6 +270,9 @@ func registerUserManagementRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
 		users.GET("/:id/usage", h.Admin.User.GetUserUsage)
 		users.GET("/:id/balance-history", h.Admin.User.GetBalanceHistory)
 		users.POST("/:id/replace-group", h.Admin.User.ReplaceGroup)
+		users.GET("/:id/sessions", h.Admin.User.ListSessions)
+		users.DELETE("/:id/sessions", h.Admin.User.RevokeAllSessions)
+		users.DELETE("/:id/sessions/:session_id", h.Admin.User.RevokeSession)
 
 		// User attribute values
 		users.GET("/:id/attributes", h.Admin.UserAttribute.GetUserAttributes)
@@ -277,6 +297,7 @@ func registerGroupRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
 		groups.PUT("/:id/rate-multipliers", h.Admin.Group.BatchSetGroupRateMultipliers)
 		groups.DELETE("/:id/rate-multipliers", h.Admin.Group.ClearGroupRateMultipliers)
 		groups.GET("/:id/api-keys", h.Admin.Group.GetGroupAPIKeys)
+		groups.GET("/:id/traffic-split-report", h.Admin.Group.GetTrafficSplitReport)
 	}
 }
 
@@ -389,6 +410,18 @@ func registerProxyRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
 	}
 }
 
+func registerProxyPoolRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
+	pools := admin.Group("/proxy-pools")
+	{
+		pools.GET("", h.Admin.ProxyPool.List)
+		pools.GET("/:id", h.Admin.ProxyPool.GetByID)
+		pools.POST("", h.Admin.ProxyPool.Create)
+		pools.PUT("/:id", h.Admin.ProxyPool.Update)
+		pools.DELETE("/:id", h.Admin.ProxyPool.Delete)
+		pools.POST("/:id/probe", h.Admin.ProxyPool.Probe)
+	}
+}
+
 func registerRedeemCodeRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
 	codes := admin.Group("/redeem-codes")
 	{
@@ -572,3 +605,75 @@ func registerErrorPassthroughRoutes(admin *gin.RouterGroup, h *handler.Handlers)
 		rules.DELETE("/:id", h.Admin.ErrorPassthrough.Delete)
 	}
 }
+
+func registerRequestTransformRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
+	rules := admin.Group("/request-transform-rules")
+	{
+		rules.GET("", h.Admin.RequestTransform.List)
+		rules.POST("/test", h.Admin.RequestTransform.Test)
+		rules.GET("/:id", h.Admin.RequestTransform.GetByID)
+		rules.POST("", h.Admin.RequestTransform.Create)
+		rules.PUT("/:id", h.Admin.RequestTransform.Update)
+		rules.DELETE("/:id", h.Admin.RequestTransform.Delete)
+	}
+}
+
+func registerGuardrailRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
+	rules := admin.Group("/guardrail-rules")
+	{
+		rules.GET("", h.Admin.Guardrail.List)
+		rules.POST("/test", h.Admin.Guardrail.Test)
+		rules.GET("/violations", h.Admin.Guardrail.ListViolations)
+		rules.GET("/violations/:user_id", h.Admin.Guardrail.GetUserViolations)
+		rules.GET("/:id", h.Admin.Guardrail.GetByID)
+		rules.POST("", h.Admin.Guardrail.Create)
+		rules.PUT("/:id", h.Admin.Guardrail.Update)
+		rules.DELETE("/:id", h.Admin.Guardrail.Delete)
+	}
+}
+
+func registerGatewayPluginRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
+	plugins := admin.Group("/gateway-plugins")
+	{
+		plugins.GET("", h.Admin.GatewayPlugin.List)
+		plugins.GET("/:id", h.Admin.GatewayPlugin.GetByID)
+		plugins.POST("", h.Admin.GatewayPlugin.Create)
+		plugins.PUT("/:id", h.Admin.GatewayPlugin.Update)
+		plugins.DELETE("/:id", h.Admin.GatewayPlugin.Delete)
+	}
+}
+
+func registerPostpaidRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
+	postpaid := admin.Group("/postpaid")
+	{
+		postpaid.GET("/accounts", h.Admin.Postpaid.ListAccounts)
+		postpaid.GET("/accounts/:user_id", h.Admin.Postpaid.GetAccount)
+		postpaid.PUT("/accounts/:user_id", h.Admin.Postpaid.SaveAccount)
+		postpaid.DELETE("/accounts/:user_id", h.Admin.Postpaid.DeleteAccount)
+		postpaid.GET("/statements", h.Admin.Postpaid.ListStatements)
+		postpaid.POST("/statements/:id/paid", h.Admin.Postpaid.MarkStatementPaid)
+		postpaid.POST("/run", h.Admin.Postpaid.RunBillingCycle)
+	}
+}
+
+func registerReferralWithdrawalRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
+	withdrawals := admin.Group("/referral/withdrawals")
+	{
+		withdrawals.GET("", h.Admin.ReferralWithdrawal.List)
+		withdrawals.POST("/:id/approve", h.Admin.ReferralWithdrawal.Approve)
+		withdrawals.POST("/:id/reject", h.Admin.ReferralWithdrawal.Reject)
+		withdrawals.POST("/:id/paid", h.Admin.ReferralWithdrawal.MarkPaid)
+		withdrawals.GET("/:id/logs", h.Admin.ReferralWithdrawal.ListLogs)
+	}
+}
+
+func registerBillingRecomputeRoutes(admin *gin.RouterGroup, h *handler.Handlers) {
+	jobs := admin.Group("/billing/recompute/jobs")
+	{
+		jobs.GET("", h.Admin.BillingRecompute.ListJobs)
+		jobs.POST("", h.Admin.BillingRecompute.CreateJob)
+		jobs.GET("/:id", h.Admin.BillingRecompute.GetJob)
+		jobs.POST("/:id/apply", h.Admin.BillingRecompute.ApplyJob)
+		jobs.GET("/:id/adjustments", h.Admin.BillingRecompute.ListAdjustments)
+	}
+}
@@ -30,6 +30,7 @@ func newGatewayRoutesTestRouter() *gin.Engine {
 		nil,
 		nil,
 		nil,
+		nil,
 		&config.Config{},
 	)
 
@@ -30,6 +30,7 @@ func RegisterPaymentRoutes(
 		authenticated.GET("/plans", paymentHandler.GetPlans)
 		authenticated.GET("/channels", paymentHandler.GetChannels)
 		authenticated.GET("/limits", paymentHandler.GetLimits)
+		authenticated.POST("/coupons/preview", paymentHandler.PreviewCoupon)
 
 		orders := authenticated.Group("/orders")
 		{
@@ -41,6 +42,12 @@ func RegisterPaymentRoutes(
 			orders.POST("/:id/refund-request", paymentHandler.RequestRefund)
 			orders.GET("/refund-eligible-providers", paymentHandler.GetRefundEligibleProviders)
 		}
+
+		invoices := authenticated.Group("/invoices")
+		{
+			invoices.GET("", paymentHandler.GetMyInvoices)
+			invoices.GET("/:id/download", paymentHandler.DownloadInvoice)
+		}
 	}
 
 	// --- Public payment endpoints (no auth) ---
@@ -60,6 +67,7 @@ func RegisterPaymentRoutes(
 		webhook.POST("/alipay", webhookHandler.AlipayNotify)
 		webhook.POST("/wxpay", webhookHandler.WxpayNotify)
 		webhook.POST("/stripe", webhookHandler.StripeWebhook)
+		webhook.POST("/paypal", webhookHandler.PayPalWebhook)
 	}
 
 	// --- Admin payment endpoints (admin auth) ---
@@ -80,6 +88,7 @@ func RegisterPaymentRoutes(
 			adminOrders.GET("/:id", adminPaymentHandler.GetOrderDetail)
 			adminOrders.POST("/:id/cancel", adminPaymentHandler.CancelOrder)
 			adminOrders.POST("/:id/retry", adminPaymentHandler.RetryFulfillment)
+			adminOrders.POST("/:id/settle-transfer", adminPaymentHandler.SettleChainTransfer)
 			adminOrders.POST("/:id/refund", adminPaymentHandler.ProcessRefund)
 		}
 
@@ -92,6 +101,24 @@ func RegisterPaymentRoutes(
 			plans.DELETE("/:id", adminPaymentHandler.DeletePlan)
 		}
 
+		// Checkout Coupons
+		coupons := adminGroup.Group("/coupons")
+		{
+			coupons.GET("", adminPaymentHandler.ListCoupons)
+			coupons.POST("", adminPaymentHandler.CreateCoupon)
+			coupons.PUT("/:id", adminPaymentHandler.UpdateCoupon)
+			coupons.DELETE("/:id", adminPaymentHandler.DeleteCoupon)
+		}
+
+		// Invoices & Credit Notes
+		invoices := adminGroup.Group("/invoices")
+		{
+			invoices.GET("", adminPaymentHandler.ListInvoices)
+			invoices.POST("", adminPaymentHandler.IssueInvoice)
+			invoices.GET("/:id/download", adminPaymentHandler.DownloadInvoice)
+			invoices.POST("/:id/email", adminPaymentHandler.ResendInvoice)
+		}
+
 		// Provider Instances
 		providers := adminGroup.Group("/providers")
 		{
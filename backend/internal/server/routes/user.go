@@ -25,6 +25,11 @@ func RegisterUserRoutes(
 			user.GET("/profile", h.User.GetProfile)
 			user.PUT("/password", h.User.ChangePassword)
 			user.PUT("", h.User.UpdateProfile)
+			user.GET("/balance-buckets", h.User.GetBalanceBuckets)
+
+			// 登录会话管理
+			user.GET("/sessions", h.Auth.ListSessions)
+			user.DELETE("/sessions/:session_id", h.Auth.RevokeSession)
 
 			notifyEmail := user.Group("/notify-email")
 			{
@@ -105,6 +110,15 @@ func RegisterUserRoutes(
 			subscriptions.GET("/active", h.Subscription.GetActive)
 			subscriptions.GET("/progress", h.Subscription.GetProgress)
 			subscriptions.GET("/summary", h.Subscription.GetSummary)
+			subscriptions.GET("/auto-renewals", h.Payment.ListAutoRenewals)
+			subscriptions.POST("/auto-renewals/:id/cancel", h.Payment.CancelAutoRenewal)
+		}
+
+		// 后付费账单
+		postpaid := authenticated.Group("/user/postpaid")
+		{
+			postpaid.GET("", h.Postpaid.GetAccount)
+			postpaid.GET("/statements", h.Postpaid.ListStatements)
 		}
 
 		// 推荐系统
@@ -112,6 +126,11 @@ func RegisterUserRoutes(
 		{
 			referral.GET("", h.Referral.GetReferralInfo)
 			referral.GET("/rewards", h.Referral.GetReferralRewards)
+			referral.GET("/commission", h.Referral.GetCommissionSummary)
+			referral.GET("/commissions", h.Referral.ListCommissions)
+			referral.GET("/withdrawals", h.Referral.ListWithdrawals)
+			referral.POST("/withdrawals", h.Referral.CreateWithdrawal)
+			referral.POST("/withdrawals/:id/cancel", h.Referral.CancelWithdrawal)
 		}
 	}
 }
@@ -24,6 +24,8 @@ type Account struct {
 	Credentials map[string]any
 	Extra       map[string]any
 	ProxyID     *int64
+	// ProxyPoolID 关联的代理池（设置后出口代理由 ProxyPoolService 从池内健康成员中选择）
+	ProxyPoolID *int64
 	Concurrency int
 	Priority    int
 	// RateMultiplier 账号计费倍率（>=0，允许 0 表示该账号计费为 0）。
@@ -51,6 +53,12 @@ type Account struct {
 	SessionWindowEnd    *time.Time
 	SessionWindowStatus string
 
+	// AvailabilitySchedule 可用时段 cron 表达式（为空表示全天可用）
+	AvailabilitySchedule string
+
+	// CircuitOpen 账号熔断器拒绝调度（读取账号时由 SchedulerSnapshotService 标记，非持久化字段）
+	CircuitOpen bool `json:"-"`
+
 	Proxy         *Proxy
 	AccountGroups []AccountGroup
 	GroupIDs      []int64
@@ -104,7 +112,7 @@ func (a *Account) EffectiveLoadFactor() int {
 }
 
 func (a *Account) IsSchedulable() bool {
-	if !a.IsActive() || !a.Schedulable {
+	if !a.IsActive() || !a.Schedulable || a.CircuitOpen {
 		return false
 	}
 	now := time.Now()
@@ -120,6 +128,9 @@ func (a *Account) IsSchedulable() bool {
 	if a.TempUnschedulableUntil != nil && now.Before(*a.TempUnschedulableUntil) {
 		return false
 	}
+	if !IsWithinAvailabilitySchedule(a.AvailabilitySchedule, now) {
+		return false
+	}
 	return true
 }
 
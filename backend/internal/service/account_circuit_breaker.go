@@ -0,0 +1,450 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/logger"
+)
+
+// 账号熔断状态
+const (
+	AccountCircuitClosed   = "closed"
+	AccountCircuitOpen     = "open"
+	AccountCircuitHalfOpen = "half_open"
+)
+
+const (
+	// accountCircuitBuckets 滚动窗口的分桶数量
+	accountCircuitBuckets = 10
+	// accountCircuitMaxTransitions 每个账号保留的最近状态转换记录数
+	accountCircuitMaxTransitions = 10
+	// accountCircuitWorkerInterval 半开状态合成探测的检查间隔
+	accountCircuitWorkerInterval = 5 * time.Second
+	// accountCircuitProbeTimeout 单次合成探测超时
+	accountCircuitProbeTimeout = 60 * time.Second
+)
+
+// AccountCircuitTransition 熔断状态转换记录
+type AccountCircuitTransition struct {
+	From   string    `json:"from"`
+	To     string    `json:"to"`
+	Reason string    `json:"reason"`
+	At     time.Time `json:"at"`
+}
+
+// AccountCircuitSnapshot 账号熔断器当前状态
+type AccountCircuitSnapshot struct {
+	State             string                     `json:"state"`
+	Requests          int                        `json:"requests"` // 滚动窗口内请求数
+	Failures          int                        `json:"failures"` // 滚动窗口内失败数（含超时）
+	Timeouts          int                        `json:"timeouts"` // 滚动窗口内超时/慢调用数
+	ErrorRate         float64                    `json:"error_rate"`
+	OpenUntil         *time.Time                 `json:"open_until,omitempty"`
+	HalfOpenSuccesses int                        `json:"half_open_successes,omitempty"`
+	Trips             int                        `json:"trips"` // 连续熔断次数（决定熔断时长退避）
+	Transitions       []AccountCircuitTransition `json:"transitions,omitempty"`
+}
+
+// accountSyntheticProber 半开状态下的合成探测（复用账号测试）
+type accountSyntheticProber interface {
+	RunTestBackground(ctx context.Context, accountID int64, modelID string) (*ScheduledTestResult, error)
+}
+
+type accountCircuitBucket struct {
+	slot     int64
+	total    int
+	failures int
+	timeouts int
+}
+
+type accountCircuit struct {
+	mu                sync.Mutex
+	state             string
+	buckets           [accountCircuitBuckets]accountCircuitBucket
+	openUntil         time.Time
+	trips             int
+	halfOpenSince     time.Time
+	halfOpenSuccesses int
+	lastAdmitAt       time.Time
+	lastResultAt      time.Time
+	probing           bool
+	transitions       []AccountCircuitTransition
+}
+
+// AccountCircuitBreaker 账号级熔断器（进程内）
+//
+//   - closed：所有转发路径回报的结果进入滚动窗口，请求数达到 MinRequests 且失败率（错误 + 超时/慢调用）
+//     达到阈值时熔断
+//   - open：账号在调度读取时被标记为不可调度；熔断时长随连续熔断次数指数退避
+//   - half-open：按最小间隔限量放行真实请求，长时间没有探测结果时发起合成探测（账号测试）；
+//     连续成功 HalfOpenSuccesses 次后关闭熔断，任一失败则重新熔断
+//
+// 调度读取账号时只通过 Allow 查看状态（无副作用），账号被实际选中并取得并发槽位后才由 Admit 消耗半开放行名额。
+type AccountCircuitBreaker struct {
+	cfg      config.GatewayAccountCircuitBreakerConfig
+	circuits sync.Map // accountID -> *accountCircuit
+	prober   accountSyntheticProber
+	now      func() time.Time
+
+	stopCh   chan struct{}
+	stopOnce sync.Once
+	wg       sync.WaitGroup
+}
+
+// NewAccountCircuitBreaker 创建账号熔断器；未启用时返回 nil（nil 熔断器放行所有账号）
+func NewAccountCircuitBreaker(cfg *config.Config) *AccountCircuitBreaker {
+	if cfg == nil || !cfg.Gateway.AccountCircuitBreaker.Enabled {
+		return nil
+	}
+	c := cfg.Gateway.AccountCircuitBreaker
+	if c.WindowSeconds <= 0 {
+		c.WindowSeconds = 60
+	}
+	if c.MinRequests <= 0 {
+		c.MinRequests = 10
+	}
+	if c.ErrorRateThreshold <= 0 || c.ErrorRateThreshold > 1 {
+		c.ErrorRateThreshold = 0.5
+	}
+	if c.OpenSeconds <= 0 {
+		c.OpenSeconds = 30
+	}
+	if c.MaxOpenSeconds < c.OpenSeconds {
+		c.MaxOpenSeconds = c.OpenSeconds
+	}
+	if c.HalfOpenSuccesses <= 0 {
+		c.HalfOpenSuccesses = 1
+	}
+	if c.HalfOpenAdmitIntervalSeconds < 0 {
+		c.HalfOpenAdmitIntervalSeconds = 0
+	}
+	return &AccountCircuitBreaker{
+		cfg:    c,
+		now:    time.Now,
+		stopCh: make(chan struct{}),
+	}
+}
+
+// SetSyntheticProber 设置半开状态的合成探测实现
+func (b *AccountCircuitBreaker) SetSyntheticProber(prober accountSyntheticProber) {
+	if b == nil {
+		return
+	}
+	b.prober = prober
+}
+
+// Start 启动半开状态合成探测 worker
+func (b *AccountCircuitBreaker) Start() {
+	if b == nil || b.cfg.SyntheticProbeAfterSeconds <= 0 {
+		return
+	}
+	b.wg.Add(1)
+	go func() {
+		defer b.wg.Done()
+		ticker := time.NewTicker(accountCircuitWorkerInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-b.stopCh:
+				return
+			case <-ticker.C:
+				b.runSyntheticProbes()
+			}
+		}
+	}()
+}
+
+// Stop 停止合成探测 worker
+func (b *AccountCircuitBreaker) Stop() {
+	if b == nil {
+		return
+	}
+	b.stopOnce.Do(func() {
+		close(b.stopCh)
+	})
+	b.wg.Wait()
+}
+
+// Allow 查看账号当前是否可参与调度，不改变熔断状态、不消耗半开放行名额（供调度读取账号时标记使用）
+func (b *AccountCircuitBreaker) Allow(accountID int64) bool {
+	if b == nil {
+		return true
+	}
+	v, ok := b.circuits.Load(accountID)
+	if !ok {
+		return true
+	}
+	c := v.(*accountCircuit)
+	now := b.now()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	switch c.state {
+	case AccountCircuitOpen:
+		// 熔断期满后由 Admit 转为半开，期间的首个放行名额必然可用
+		return !now.Before(c.openUntil)
+	case AccountCircuitHalfOpen:
+		return c.lastAdmitAt.IsZero() || now.Sub(c.lastAdmitAt) >= time.Duration(b.cfg.HalfOpenAdmitIntervalSeconds)*time.Second
+	default:
+		return true
+	}
+}
+
+// Admit 账号被选中时确认放行：closed 放行；open 拒绝；
+// half-open 每 HalfOpenAdmitIntervalSeconds 放行一次真实请求作为探测（消耗放行名额）。
+func (b *AccountCircuitBreaker) Admit(accountID int64) bool {
+	if b == nil {
+		return true
+	}
+	v, ok := b.circuits.Load(accountID)
+	if !ok {
+		return true
+	}
+	c := v.(*accountCircuit)
+	now := b.now()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	switch c.state {
+	case AccountCircuitOpen:
+		if now.Before(c.openUntil) {
+			return false
+		}
+		b.toHalfOpenLocked(accountID, c, now)
+		fallthrough
+	case AccountCircuitHalfOpen:
+		if !c.lastAdmitAt.IsZero() && now.Sub(c.lastAdmitAt) < time.Duration(b.cfg.HalfOpenAdmitIntervalSeconds)*time.Second {
+			return false
+		}
+		c.lastAdmitAt = now
+		return true
+	default:
+		return true
+	}
+}
+
+// admitAcquiredAccountSlot 账号被选中并取得并发槽位后确认熔断放行；未获放行时释放槽位并视为未取得，
+// 使调度继续尝试其他候选账号。
+func admitAcquiredAccountSlot(breaker *AccountCircuitBreaker, accountID int64, result *AcquireResult) *AcquireResult {
+	if result == nil || !result.Acquired || breaker.Admit(accountID) {
+		return result
+	}
+	if result.ReleaseFunc != nil {
+		result.ReleaseFunc()
+	}
+	return &AcquireResult{Acquired: false}
+}
+
+// Record 回报一次转发结果。firstTokenMs 超过慢调用阈值时视为超时失败。
+func (b *AccountCircuitBreaker) Record(accountID int64, success bool, firstTokenMs *int) {
+	if b == nil || accountID <= 0 {
+		return
+	}
+	timeout := success && b.cfg.SlowCallThresholdMs > 0 && firstTokenMs != nil && *firstTokenMs > b.cfg.SlowCallThresholdMs
+	b.record(accountID, !success || timeout, timeout, "request")
+}
+
+func (b *AccountCircuitBreaker) record(accountID int64, failed, timeout bool, source string) {
+	v, _ := b.circuits.LoadOrStore(accountID, &accountCircuit{state: AccountCircuitClosed})
+	c := v.(*accountCircuit)
+	now := b.now()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.lastResultAt = now
+	switch c.state {
+	case AccountCircuitOpen:
+		// 熔断前已发出的请求的迟到结果，不影响状态
+		return
+	case AccountCircuitHalfOpen:
+		if failed {
+			b.openLocked(accountID, c, now, fmt.Sprintf("half-open %s failed", source))
+			return
+		}
+		c.halfOpenSuccesses++
+		if c.halfOpenSuccesses >= b.cfg.HalfOpenSuccesses {
+			b.transitionLocked(accountID, c, AccountCircuitClosed, now, fmt.Sprintf("%d consecutive half-open successes", c.halfOpenSuccesses))
+			c.buckets = [accountCircuitBuckets]accountCircuitBucket{}
+			c.trips = 0
+			c.halfOpenSuccesses = 0
+		}
+		return
+	}
+
+	bucket := b.bucketLocked(c, now)
+	bucket.total++
+	if failed {
+		bucket.failures++
+	}
+	if timeout {
+		bucket.timeouts++
+	}
+	if !failed {
+		return
+	}
+	total, failures, timeouts := b.windowLocked(c, now)
+	if total < b.cfg.MinRequests {
+		return
+	}
+	if rate := float64(failures) / float64(total); rate >= b.cfg.ErrorRateThreshold {
+		b.openLocked(accountID, c, now, fmt.Sprintf("error rate %.0f%% (%d/%d, %d timeouts) in %ds", rate*100, failures, total, timeouts, b.cfg.WindowSeconds))
+	}
+}
+
+func (b *AccountCircuitBreaker) bucketWidth() time.Duration {
+	width := time.Duration(b.cfg.WindowSeconds) * time.Second / accountCircuitBuckets
+	if width <= 0 {
+		width = time.Second
+	}
+	return width
+}
+
+func (b *AccountCircuitBreaker) bucketLocked(c *accountCircuit, now time.Time) *accountCircuitBucket {
+	slot := now.UnixNano() / int64(b.bucketWidth())
+	bucket := &c.buckets[slot%accountCircuitBuckets]
+	if bucket.slot != slot {
+		*bucket = accountCircuitBucket{slot: slot}
+	}
+	return bucket
+}
+
+func (b *AccountCircuitBreaker) windowLocked(c *accountCircuit, now time.Time) (total, failures, timeouts int) {
+	current := now.UnixNano() / int64(b.bucketWidth())
+	for i := range c.buckets {
+		bucket := c.buckets[i]
+		if bucket.slot <= current-accountCircuitBuckets || bucket.slot > current {
+			continue
+		}
+		total += bucket.total
+		failures += bucket.failures
+		timeouts += bucket.timeouts
+	}
+	return total, failures, timeouts
+}
+
+func (b *AccountCircuitBreaker) openLocked(accountID int64, c *accountCircuit, now time.Time, reason string) {
+	c.trips++
+	duration := time.Duration(b.cfg.OpenSeconds) * time.Second
+	maxDuration := time.Duration(b.cfg.MaxOpenSeconds) * time.Second
+	for i := 1; i < c.trips && duration < maxDuration; i++ {
+		duration *= 2
+	}
+	if duration > maxDuration {
+		duration = maxDuration
+	}
+	c.openUntil = now.Add(duration)
+	c.halfOpenSuccesses = 0
+	b.transitionLocked(accountID, c, AccountCircuitOpen, now, fmt.Sprintf("%s; open for %s", reason, duration))
+}
+
+func (b *AccountCircuitBreaker) toHalfOpenLocked(accountID int64, c *accountCircuit, now time.Time) {
+	c.halfOpenSince = now
+	c.halfOpenSuccesses = 0
+	c.lastAdmitAt = time.Time{}
+	b.transitionLocked(accountID, c, AccountCircuitHalfOpen, now, "open period elapsed")
+}
+
+func (b *AccountCircuitBreaker) transitionLocked(accountID int64, c *accountCircuit, to string, now time.Time, reason string) {
+	from := c.state
+	c.state = to
+	c.transitions = append(c.transitions, AccountCircuitTransition{From: from, To: to, Reason: reason, At: now})
+	if len(c.transitions) > accountCircuitMaxTransitions {
+		c.transitions = c.transitions[len(c.transitions)-accountCircuitMaxTransitions:]
+	}
+	logger.LegacyPrintf("service.account_circuit_breaker", "[AccountCircuit] account=%d %s -> %s: %s", accountID, from, to, reason)
+}
+
+// Snapshot 返回账号熔断器当前状态（从未回报过结果的账号返回 nil）
+func (b *AccountCircuitBreaker) Snapshot(accountID int64) *AccountCircuitSnapshot {
+	if b == nil {
+		return nil
+	}
+	v, ok := b.circuits.Load(accountID)
+	if !ok {
+		return nil
+	}
+	c := v.(*accountCircuit)
+	now := b.now()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	total, failures, timeouts := b.windowLocked(c, now)
+	out := &AccountCircuitSnapshot{
+		State:             c.state,
+		Requests:          total,
+		Failures:          failures,
+		Timeouts:          timeouts,
+		HalfOpenSuccesses: c.halfOpenSuccesses,
+		Trips:             c.trips,
+		Transitions:       append([]AccountCircuitTransition(nil), c.transitions...),
+	}
+	if total > 0 {
+		out.ErrorRate = float64(failures) / float64(total)
+	}
+	if c.state == AccountCircuitOpen {
+		openUntil := c.openUntil
+		out.OpenUntil = &openUntil
+	}
+	return out
+}
+
+// runSyntheticProbes 对长时间没有探测结果的半开（或熔断期已满）账号发起合成探测
+func (b *AccountCircuitBreaker) runSyntheticProbes() {
+	if b.prober == nil {
+		return
+	}
+	staleAfter := time.Duration(b.cfg.SyntheticProbeAfterSeconds) * time.Second
+	now := b.now()
+	b.circuits.Range(func(key, value any) bool {
+		accountID := key.(int64)
+		c := value.(*accountCircuit)
+
+		c.mu.Lock()
+		if c.state == AccountCircuitOpen && !now.Before(c.openUntil) {
+			b.toHalfOpenLocked(accountID, c, now)
+		}
+		due := c.state == AccountCircuitHalfOpen && !c.probing &&
+			now.Sub(latestTime(c.halfOpenSince, c.lastAdmitAt, c.lastResultAt)) >= staleAfter
+		if due {
+			c.probing = true
+		}
+		c.mu.Unlock()
+
+		if due {
+			b.wg.Add(1)
+			go func() {
+				defer b.wg.Done()
+				b.syntheticProbe(accountID, c)
+			}()
+		}
+		return true
+	})
+}
+
+func (b *AccountCircuitBreaker) syntheticProbe(accountID int64, c *accountCircuit) {
+	defer func() {
+		c.mu.Lock()
+		c.probing = false
+		c.mu.Unlock()
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), accountCircuitProbeTimeout)
+	defer cancel()
+	result, err := b.prober.RunTestBackground(ctx, accountID, "")
+	failed := err != nil || result == nil || result.Status != "success"
+	b.record(accountID, failed, false, "synthetic probe")
+}
+
+func latestTime(times ...time.Time) time.Time {
+	var latest time.Time
+	for _, t := range times {
+		if t.After(latest) {
+			latest = t
+		}
+	}
+	return latest
+}
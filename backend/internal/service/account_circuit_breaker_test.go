@@ -0,0 +1,232 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/stretchr/testify/require"
+)
+
+func newAccountCircuitBreakerForTest(t *testing.T) (*AccountCircuitBreaker, *time.Time) {
+	t.Helper()
+	cfg := &config.Config{}
+	cfg.Gateway.AccountCircuitBreaker = config.GatewayAccountCircuitBreakerConfig{
+		Enabled:                      true,
+		WindowSeconds:                60,
+		MinRequests:                  4,
+		ErrorRateThreshold:           0.5,
+		SlowCallThresholdMs:          1000,
+		OpenSeconds:                  10,
+		MaxOpenSeconds:               30,
+		HalfOpenSuccesses:            2,
+		HalfOpenAdmitIntervalSeconds: 5,
+		SyntheticProbeAfterSeconds:   15,
+	}
+	breaker := NewAccountCircuitBreaker(cfg)
+	require.NotNil(t, breaker)
+	now := time.Unix(1_700_000_000, 0)
+	breaker.now = func() time.Time { return now }
+	return breaker, &now
+}
+
+func TestAccountCircuitBreaker_OpensOnErrorRateAndTimeouts(t *testing.T) {
+	breaker, _ := newAccountCircuitBreakerForTest(t)
+	slow := 2000
+	fast := 100
+
+	// 未达到最小请求数前不熔断
+	breaker.Record(1, false, nil)
+	breaker.Record(1, true, &slow)
+	breaker.Record(1, true, &fast)
+	require.True(t, breaker.Admit(1))
+	require.Equal(t, AccountCircuitClosed, breaker.Snapshot(1).State)
+
+	// 慢调用计为超时失败：3/4 = 75% >= 50%
+	breaker.Record(1, true, &slow)
+	snapshot := breaker.Snapshot(1)
+	require.Equal(t, AccountCircuitOpen, snapshot.State)
+	require.Equal(t, 2, snapshot.Timeouts)
+	require.NotNil(t, snapshot.OpenUntil)
+	require.Len(t, snapshot.Transitions, 1)
+	require.False(t, breaker.Admit(1))
+
+	// 从未回报过的账号放行
+	require.True(t, breaker.Admit(2))
+	require.Nil(t, breaker.Snapshot(2))
+}
+
+func TestAccountCircuitBreaker_HalfOpenAdmitsLimitedProbesAndCloses(t *testing.T) {
+	breaker, now := newAccountCircuitBreakerForTest(t)
+	for i := 0; i < 4; i++ {
+		breaker.Record(1, false, nil)
+	}
+	require.False(t, breaker.Admit(1))
+
+	*now = now.Add(10 * time.Second)
+	require.True(t, breaker.Admit(1))
+	require.Equal(t, AccountCircuitHalfOpen, breaker.Snapshot(1).State)
+	// 放行间隔内只放行一次
+	require.False(t, breaker.Admit(1))
+
+	breaker.Record(1, true, nil)
+	require.Equal(t, AccountCircuitHalfOpen, breaker.Snapshot(1).State)
+
+	*now = now.Add(5 * time.Second)
+	require.True(t, breaker.Admit(1))
+	breaker.Record(1, true, nil)
+
+	snapshot := breaker.Snapshot(1)
+	require.Equal(t, AccountCircuitClosed, snapshot.State)
+	require.Zero(t, snapshot.Requests)
+	require.Zero(t, snapshot.Trips)
+	require.True(t, breaker.Admit(1))
+}
+
+func TestAccountCircuitBreaker_HalfOpenFailureReopensWithBackoff(t *testing.T) {
+	breaker, now := newAccountCircuitBreakerForTest(t)
+	for i := 0; i < 4; i++ {
+		breaker.Record(1, false, nil)
+	}
+	require.Equal(t, now.Add(10*time.Second), *breaker.Snapshot(1).OpenUntil)
+
+	*now = now.Add(10 * time.Second)
+	require.True(t, breaker.Admit(1))
+	breaker.Record(1, false, nil)
+	snapshot := breaker.Snapshot(1)
+	require.Equal(t, AccountCircuitOpen, snapshot.State)
+	require.Equal(t, 2, snapshot.Trips)
+	require.Equal(t, now.Add(20*time.Second), *snapshot.OpenUntil)
+
+	// 退避上限为 MaxOpenSeconds
+	*now = now.Add(20 * time.Second)
+	require.True(t, breaker.Admit(1))
+	breaker.Record(1, false, nil)
+	require.Equal(t, now.Add(30*time.Second), *breaker.Snapshot(1).OpenUntil)
+}
+
+type circuitProberStub struct {
+	err    error
+	status string
+	calls  int
+}
+
+func (p *circuitProberStub) RunTestBackground(context.Context, int64, string) (*ScheduledTestResult, error) {
+	p.calls++
+	if p.err != nil {
+		return nil, p.err
+	}
+	return &ScheduledTestResult{Status: p.status}, nil
+}
+
+func TestAccountCircuitBreaker_SyntheticProbeWhenHalfOpenIsIdle(t *testing.T) {
+	breaker, now := newAccountCircuitBreakerForTest(t)
+	prober := &circuitProberStub{status: "success"}
+	breaker.SetSyntheticProber(prober)
+	for i := 0; i < 4; i++ {
+		breaker.Record(1, false, nil)
+	}
+
+	// 熔断期未满不探测
+	breaker.runSyntheticProbes()
+	breaker.wg.Wait()
+	require.Zero(t, prober.calls)
+
+	// 熔断期满后进入半开；半开空闲超过阈值才发起合成探测
+	*now = now.Add(10 * time.Second)
+	breaker.runSyntheticProbes()
+	breaker.wg.Wait()
+	require.Zero(t, prober.calls)
+	require.Equal(t, AccountCircuitHalfOpen, breaker.Snapshot(1).State)
+
+	*now = now.Add(15 * time.Second)
+	breaker.runSyntheticProbes()
+	breaker.wg.Wait()
+	require.Equal(t, 1, prober.calls)
+	require.Equal(t, 1, breaker.Snapshot(1).HalfOpenSuccesses)
+
+	*now = now.Add(15 * time.Second)
+	breaker.runSyntheticProbes()
+	breaker.wg.Wait()
+	require.Equal(t, AccountCircuitClosed, breaker.Snapshot(1).State)
+
+	// 探测失败重新熔断
+	for i := 0; i < 4; i++ {
+		breaker.Record(1, false, nil)
+	}
+	prober.err = errors.New("upstream 500")
+	*now = now.Add(10 * time.Second)
+	breaker.runSyntheticProbes()
+	*now = now.Add(15 * time.Second)
+	breaker.runSyntheticProbes()
+	breaker.wg.Wait()
+	require.Equal(t, AccountCircuitOpen, breaker.Snapshot(1).State)
+}
+
+func TestAccountCircuitBreaker_NilIsPermissive(t *testing.T) {
+	var breaker *AccountCircuitBreaker
+	require.True(t, breaker.Admit(1))
+	require.True(t, breaker.Allow(1))
+	breaker.Record(1, false, nil)
+	require.Nil(t, breaker.Snapshot(1))
+	require.Nil(t, NewAccountCircuitBreaker(&config.Config{}))
+}
+
+func TestSchedulerSnapshotService_MarksCircuitOpenAccountsUnschedulable(t *testing.T) {
+	breaker, _ := newAccountCircuitBreakerForTest(t)
+	for i := 0; i < 4; i++ {
+		breaker.Record(1, false, nil)
+	}
+	svc := &SchedulerSnapshotService{}
+	svc.SetAccountCircuitBreaker(breaker)
+
+	accounts := svc.annotateAccounts([]Account{
+		{ID: 1, Status: StatusActive, Schedulable: true},
+		{ID: 2, Status: StatusActive, Schedulable: true},
+	})
+	require.False(t, accounts[0].IsSchedulable())
+	require.True(t, accounts[1].IsSchedulable())
+}
+
+func TestAccountCircuitBreaker_AnnotationDoesNotConsumeHalfOpenAdmission(t *testing.T) {
+	breaker, now := newAccountCircuitBreakerForTest(t)
+	for i := 0; i < 4; i++ {
+		breaker.Record(1, false, nil)
+	}
+	svc := &SchedulerSnapshotService{}
+	svc.SetAccountCircuitBreaker(breaker)
+	account := Account{ID: 1, Status: StatusActive, Schedulable: true}
+
+	require.False(t, svc.annotateAccounts([]Account{account})[0].IsSchedulable())
+
+	// 熔断期满：反复读取账号只查看状态，不转换状态也不消耗放行名额
+	*now = now.Add(10 * time.Second)
+	for i := 0; i < 3; i++ {
+		require.True(t, svc.annotateAccounts([]Account{account})[0].IsSchedulable())
+	}
+	require.Equal(t, AccountCircuitOpen, breaker.Snapshot(1).State)
+
+	// 账号被选中取得槽位时才消耗名额；间隔内的下一次选中被拒绝并释放槽位
+	gateway := &GatewayService{schedulerSnapshot: svc}
+	first, err := gateway.tryAcquireAccountSlot(context.Background(), 1, 1)
+	require.NoError(t, err)
+	require.True(t, first.Acquired)
+	require.Equal(t, AccountCircuitHalfOpen, breaker.Snapshot(1).State)
+	require.False(t, svc.annotateAccounts([]Account{account})[0].IsSchedulable())
+
+	released := false
+	second := admitAcquiredAccountSlot(breaker, 1, &AcquireResult{Acquired: true, ReleaseFunc: func() { released = true }})
+	require.False(t, second.Acquired)
+	require.True(t, released)
+
+	*now = now.Add(5 * time.Second)
+	require.True(t, svc.annotateAccounts([]Account{account})[0].IsSchedulable())
+	openai := &OpenAIGatewayService{schedulerSnapshot: svc}
+	third, err := openai.tryAcquireAccountSlot(context.Background(), 1, 1)
+	require.NoError(t, err)
+	require.True(t, third.Acquired)
+}
@@ -0,0 +1,539 @@
+package service
+
+import (
+	"math"
+	mathrand "math/rand"
+	"sort"
+	"sync"
+	"sync/atomic"
+)
+
+// 账号调度策略（分组级配置，为空时使用 priority_lru）
+const (
+	// AccountSchedulingPriorityLRU 优先级 → 负载率 → 最久未用（默认策略）
+	AccountSchedulingPriorityLRU = "priority_lru"
+	// AccountSchedulingWeightedRoundRobin 按负载因子做平滑加权轮询
+	AccountSchedulingWeightedRoundRobin = "weighted_round_robin"
+	// AccountSchedulingLeastOutstanding 优先选择进行中 + 排队请求最少的账号
+	AccountSchedulingLeastOutstanding = "least_outstanding"
+	// AccountSchedulingEWMA 按首字延迟 / 错误率的 EWMA 与当前负载综合打分
+	AccountSchedulingEWMA = "ewma"
+	// AccountSchedulingPowerOfTwo 随机取两个账号，选择得分更优者（power-of-two-choices）
+	AccountSchedulingPowerOfTwo = "p2c"
+)
+
+// AccountSchedulingStrategies 所有可选的调度策略
+var AccountSchedulingStrategies = []string{
+	AccountSchedulingPriorityLRU,
+	AccountSchedulingWeightedRoundRobin,
+	AccountSchedulingLeastOutstanding,
+	AccountSchedulingEWMA,
+	AccountSchedulingPowerOfTwo,
+}
+
+// IsValidAccountSchedulingStrategy 检查调度策略是否合法（空字符串表示默认策略）
+func IsValidAccountSchedulingStrategy(strategy string) bool {
+	if strategy == "" {
+		return true
+	}
+	for _, s := range AccountSchedulingStrategies {
+		if s == strategy {
+			return true
+		}
+	}
+	return false
+}
+
+const (
+	// accountScheduleErrorPenalty EWMA 打分中错误率的惩罚倍数
+	accountScheduleErrorPenalty = 4.0
+)
+
+// AccountScheduleCandidate 参与调度的候选账号及其实时负载
+type AccountScheduleCandidate struct {
+	Account  *Account
+	LoadInfo *AccountLoadInfo
+}
+
+// AccountScheduleRequest 调度请求上下文
+type AccountScheduleRequest struct {
+	PreferOAuth bool
+}
+
+// AccountScheduler 账号调度策略。
+// Order 返回候选账号的尝试顺序（调用方按顺序抢占并发槽位），ReportResult 在每次转发后回报运行时统计。
+// 所有策略都先按优先级分层，仅在同一优先级内应用各自的排序规则。
+type AccountScheduler interface {
+	Strategy() string
+	Order(req AccountScheduleRequest, candidates []AccountScheduleCandidate) []AccountScheduleCandidate
+	ReportResult(accountID int64, success bool, firstTokenMs *int)
+}
+
+// accountSchedulerSet 按策略名持有调度器实例，所有策略共享同一份运行时统计
+type accountSchedulerSet struct {
+	stats      *accountRuntimeStats
+	schedulers map[string]AccountScheduler
+}
+
+func newAccountSchedulerSet(stats *accountRuntimeStats) *accountSchedulerSet {
+	if stats == nil {
+		stats = newAccountRuntimeStats()
+	}
+	base := accountSchedulerBase{stats: stats}
+	set := &accountSchedulerSet{
+		stats:      stats,
+		schedulers: make(map[string]AccountScheduler, len(AccountSchedulingStrategies)),
+	}
+	for _, scheduler := range []AccountScheduler{
+		&priorityLRUAccountScheduler{accountSchedulerBase: base},
+		&weightedRoundRobinAccountScheduler{accountSchedulerBase: base, current: make(map[int64]int64)},
+		&leastOutstandingAccountScheduler{accountSchedulerBase: base},
+		&ewmaAccountScheduler{accountSchedulerBase: base},
+		&powerOfTwoAccountScheduler{accountSchedulerBase: base},
+	} {
+		set.schedulers[scheduler.Strategy()] = scheduler
+	}
+	return set
+}
+
+// get 返回指定策略的调度器；未知策略或未初始化时回退到 priority_lru
+func (s *accountSchedulerSet) get(strategy string) AccountScheduler {
+	if s != nil {
+		if scheduler, ok := s.schedulers[strategy]; ok {
+			return scheduler
+		}
+		if scheduler, ok := s.schedulers[AccountSchedulingPriorityLRU]; ok {
+			return scheduler
+		}
+	}
+	return &priorityLRUAccountScheduler{}
+}
+
+func (s *accountSchedulerSet) report(accountID int64, success bool, firstTokenMs *int) {
+	if s == nil {
+		return
+	}
+	s.stats.report(accountID, success, firstTokenMs)
+}
+
+type accountSchedulerBase struct {
+	stats *accountRuntimeStats
+}
+
+func (b accountSchedulerBase) ReportResult(accountID int64, success bool, firstTokenMs *int) {
+	b.stats.report(accountID, success, firstTokenMs)
+}
+
+// ============ priority_lru ============
+
+type priorityLRUAccountScheduler struct {
+	accountSchedulerBase
+}
+
+func (s *priorityLRUAccountScheduler) Strategy() string { return AccountSchedulingPriorityLRU }
+
+// Order 分层过滤：每轮取优先级最小 → 负载率最低 → 最久未用的账号，与原有 Layer 2 选择顺序一致
+func (s *priorityLRUAccountScheduler) Order(req AccountScheduleRequest, candidates []AccountScheduleCandidate) []AccountScheduleCandidate {
+	remaining := make([]accountWithLoad, 0, len(candidates))
+	for _, c := range candidates {
+		remaining = append(remaining, accountWithLoad{account: c.Account, loadInfo: c.LoadInfo})
+	}
+
+	ordered := make([]AccountScheduleCandidate, 0, len(candidates))
+	for len(remaining) > 0 {
+		selected := selectByLRU(filterByMinLoadRate(filterByMinPriority(remaining)), req.PreferOAuth)
+		if selected == nil {
+			break
+		}
+		selectedID := selected.account.ID
+		ordered = append(ordered, AccountScheduleCandidate{Account: selected.account, LoadInfo: selected.loadInfo})
+
+		next := remaining[:0]
+		for _, item := range remaining {
+			if item.account.ID != selectedID {
+				next = append(next, item)
+			}
+		}
+		remaining = next
+	}
+	return ordered
+}
+
+// ============ weighted_round_robin ============
+
+type weightedRoundRobinAccountScheduler struct {
+	accountSchedulerBase
+	mu      sync.Mutex
+	current map[int64]int64
+}
+
+func (s *weightedRoundRobinAccountScheduler) Strategy() string {
+	return AccountSchedulingWeightedRoundRobin
+}
+
+func (s *weightedRoundRobinAccountScheduler) Order(_ AccountScheduleRequest, candidates []AccountScheduleCandidate) []AccountScheduleCandidate {
+	return orderWithinPriorityTiers(candidates, s.orderTier)
+}
+
+// orderTier 平滑加权轮询（nginx SWRR）：权重为账号负载因子。
+// 本轮命中者排在首位，其余按累计权重降序排列（即后续轮次的预期命中顺序）。
+func (s *weightedRoundRobinAccountScheduler) orderTier(tier []AccountScheduleCandidate) []AccountScheduleCandidate {
+	if len(tier) <= 1 {
+		return tier
+	}
+
+	s.mu.Lock()
+	var total int64
+	best := -1
+	for i, c := range tier {
+		weight := int64(c.Account.EffectiveLoadFactor())
+		total += weight
+		s.current[c.Account.ID] += weight
+		if best < 0 || s.current[c.Account.ID] > s.current[tier[best].Account.ID] {
+			best = i
+		}
+	}
+	s.current[tier[best].Account.ID] -= total
+	current := make(map[int64]int64, len(tier))
+	for _, c := range tier {
+		current[c.Account.ID] = s.current[c.Account.ID]
+	}
+	s.mu.Unlock()
+
+	ordered := make([]AccountScheduleCandidate, 0, len(tier))
+	ordered = append(ordered, tier[best])
+	rest := make([]AccountScheduleCandidate, 0, len(tier)-1)
+	rest = append(rest, tier[:best]...)
+	rest = append(rest, tier[best+1:]...)
+	sort.SliceStable(rest, func(i, j int) bool {
+		return current[rest[i].Account.ID] > current[rest[j].Account.ID]
+	})
+	return append(ordered, rest...)
+}
+
+// ============ least_outstanding ============
+
+type leastOutstandingAccountScheduler struct {
+	accountSchedulerBase
+}
+
+func (s *leastOutstandingAccountScheduler) Strategy() string {
+	return AccountSchedulingLeastOutstanding
+}
+
+func (s *leastOutstandingAccountScheduler) Order(_ AccountScheduleRequest, candidates []AccountScheduleCandidate) []AccountScheduleCandidate {
+	return orderWithinPriorityTiers(candidates, func(tier []AccountScheduleCandidate) []AccountScheduleCandidate {
+		// 先打乱再稳定排序，使完全相同的账号之间随机分摊
+		mathrand.Shuffle(len(tier), func(i, j int) { tier[i], tier[j] = tier[j], tier[i] })
+		sort.SliceStable(tier, func(i, j int) bool {
+			a, b := accountOutstanding(tier[i]), accountOutstanding(tier[j])
+			if a != b {
+				return a < b
+			}
+			return accountLoadRate(tier[i]) < accountLoadRate(tier[j])
+		})
+		return tier
+	})
+}
+
+// ============ ewma ============
+
+type ewmaAccountScheduler struct {
+	accountSchedulerBase
+}
+
+func (s *ewmaAccountScheduler) Strategy() string { return AccountSchedulingEWMA }
+
+func (s *ewmaAccountScheduler) Order(_ AccountScheduleRequest, candidates []AccountScheduleCandidate) []AccountScheduleCandidate {
+	return orderWithinPriorityTiers(candidates, func(tier []AccountScheduleCandidate) []AccountScheduleCandidate {
+		costs := s.stats.costs(tier)
+		mathrand.Shuffle(len(tier), func(i, j int) { tier[i], tier[j] = tier[j], tier[i] })
+		sort.SliceStable(tier, func(i, j int) bool {
+			return costs[tier[i].Account.ID] < costs[tier[j].Account.ID]
+		})
+		return tier
+	})
+}
+
+// ============ p2c ============
+
+type powerOfTwoAccountScheduler struct {
+	accountSchedulerBase
+}
+
+func (s *powerOfTwoAccountScheduler) Strategy() string { return AccountSchedulingPowerOfTwo }
+
+// Order 随机抽取两个账号，得分更优者排在首位；其余按得分升序作为抢占失败后的回退顺序。
+// 相比全局最优排序，随机两选一能避免大量并发请求同时涌向同一个“最优”账号。
+func (s *powerOfTwoAccountScheduler) Order(_ AccountScheduleRequest, candidates []AccountScheduleCandidate) []AccountScheduleCandidate {
+	return orderWithinPriorityTiers(candidates, func(tier []AccountScheduleCandidate) []AccountScheduleCandidate {
+		if len(tier) <= 1 {
+			return tier
+		}
+		costs := s.stats.costs(tier)
+		i := mathrand.Intn(len(tier))
+		j := mathrand.Intn(len(tier) - 1)
+		if j >= i {
+			j++
+		}
+		winner := i
+		if costs[tier[j].Account.ID] < costs[tier[i].Account.ID] {
+			winner = j
+		}
+		tier[0], tier[winner] = tier[winner], tier[0]
+		rest := tier[1:]
+		sort.SliceStable(rest, func(a, b int) bool {
+			return costs[rest[a].Account.ID] < costs[rest[b].Account.ID]
+		})
+		return tier
+	})
+}
+
+// ============ helpers ============
+
+// orderWithinPriorityTiers 按优先级升序分层，对每层调用 orderTier 排序后拼接
+func orderWithinPriorityTiers(candidates []AccountScheduleCandidate, orderTier func([]AccountScheduleCandidate) []AccountScheduleCandidate) []AccountScheduleCandidate {
+	if len(candidates) == 0 {
+		return candidates
+	}
+	sorted := append([]AccountScheduleCandidate(nil), candidates...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Account.Priority < sorted[j].Account.Priority
+	})
+
+	ordered := make([]AccountScheduleCandidate, 0, len(sorted))
+	for start := 0; start < len(sorted); {
+		end := start + 1
+		for end < len(sorted) && sorted[end].Account.Priority == sorted[start].Account.Priority {
+			end++
+		}
+		ordered = append(ordered, orderTier(sorted[start:end])...)
+		start = end
+	}
+	return ordered
+}
+
+func toAccountScheduleCandidates(accounts []accountWithLoad) []AccountScheduleCandidate {
+	out := make([]AccountScheduleCandidate, 0, len(accounts))
+	for _, item := range accounts {
+		out = append(out, AccountScheduleCandidate{Account: item.account, LoadInfo: item.loadInfo})
+	}
+	return out
+}
+
+func fromAccountScheduleCandidates(candidates []AccountScheduleCandidate) []accountWithLoad {
+	out := make([]accountWithLoad, 0, len(candidates))
+	for _, c := range candidates {
+		out = append(out, accountWithLoad{account: c.Account, loadInfo: c.LoadInfo})
+	}
+	return out
+}
+
+func accountOutstanding(c AccountScheduleCandidate) int {
+	if c.LoadInfo == nil {
+		return 0
+	}
+	return c.LoadInfo.CurrentConcurrency + c.LoadInfo.WaitingCount
+}
+
+func accountLoadRate(c AccountScheduleCandidate) int {
+	if c.LoadInfo == nil {
+		return 0
+	}
+	return c.LoadInfo.LoadRate
+}
+
+// costs 计算候选账号的调度代价（越小越优）：
+// 首字延迟 EWMA × (1 + 错误率惩罚) × (进行中请求数 + 1)。
+// 尚无延迟样本的账号使用同层已知延迟的均值，避免新账号长期得不到流量或被过度偏好。
+func (s *accountRuntimeStats) costs(tier []AccountScheduleCandidate) map[int64]float64 {
+	type sample struct {
+		errorRate float64
+		ttft      float64
+		hasTTFT   bool
+	}
+	samples := make([]sample, len(tier))
+	ttftSum, ttftCount := 0.0, 0
+	for i, c := range tier {
+		errorRate, ttft, hasTTFT := s.snapshot(c.Account.ID)
+		samples[i] = sample{errorRate: errorRate, ttft: ttft, hasTTFT: hasTTFT && ttft > 0}
+		if samples[i].hasTTFT {
+			ttftSum += ttft
+			ttftCount++
+		}
+	}
+	defaultTTFT := 1.0
+	if ttftCount > 0 {
+		defaultTTFT = ttftSum / float64(ttftCount)
+	}
+
+	costs := make(map[int64]float64, len(tier))
+	for i, c := range tier {
+		latency := defaultTTFT
+		if samples[i].hasTTFT {
+			latency = samples[i].ttft
+		}
+		cost := latency * (1 + accountScheduleErrorPenalty*samples[i].errorRate) * float64(accountOutstanding(c)+1)
+		if math.IsNaN(cost) || math.IsInf(cost, 0) {
+			cost = math.MaxFloat64
+		}
+		costs[c.Account.ID] = cost
+	}
+	return costs
+}
+
+// ============ 运行时统计 ============
+
+// accountRuntimeStats 账号级运行时统计（错误率 / 首字延迟 EWMA），由转发结果回报更新，供调度打分使用
+type accountRuntimeStats struct {
+	accounts     sync.Map
+	accountCount atomic.Int64
+}
+
+// accountRuntimeTTFTSamples 每个账号保留的最近首字延迟样本数（用于分位数估计）
+const accountRuntimeTTFTSamples = 64
+
+type accountRuntimeStat struct {
+	errorRateEWMABits atomic.Uint64
+	ttftEWMABits      atomic.Uint64
+
+	ttftMu      sync.Mutex
+	ttftSamples [accountRuntimeTTFTSamples]float64
+	ttftCount   int
+	ttftNext    int
+}
+
+func (s *accountRuntimeStat) recordTTFT(ttft float64) {
+	s.ttftMu.Lock()
+	s.ttftSamples[s.ttftNext] = ttft
+	s.ttftNext = (s.ttftNext + 1) % accountRuntimeTTFTSamples
+	if s.ttftCount < accountRuntimeTTFTSamples {
+		s.ttftCount++
+	}
+	s.ttftMu.Unlock()
+}
+
+func newAccountRuntimeStats() *accountRuntimeStats {
+	return &accountRuntimeStats{}
+}
+
+func (s *accountRuntimeStats) loadOrCreate(accountID int64) *accountRuntimeStat {
+	if value, ok := s.accounts.Load(accountID); ok {
+		stat, _ := value.(*accountRuntimeStat)
+		if stat != nil {
+			return stat
+		}
+	}
+
+	stat := &accountRuntimeStat{}
+	stat.ttftEWMABits.Store(math.Float64bits(math.NaN()))
+	actual, loaded := s.accounts.LoadOrStore(accountID, stat)
+	if !loaded {
+		s.accountCount.Add(1)
+		return stat
+	}
+	existing, _ := actual.(*accountRuntimeStat)
+	if existing != nil {
+		return existing
+	}
+	return stat
+}
+
+func updateEWMAAtomic(target *atomic.Uint64, sample float64, alpha float64) {
+	for {
+		oldBits := target.Load()
+		oldValue := math.Float64frombits(oldBits)
+		newValue := alpha*sample + (1-alpha)*oldValue
+		if target.CompareAndSwap(oldBits, math.Float64bits(newValue)) {
+			return
+		}
+	}
+}
+
+func (s *accountRuntimeStats) report(accountID int64, success bool, firstTokenMs *int) {
+	if s == nil || accountID <= 0 {
+		return
+	}
+	const alpha = 0.2
+	stat := s.loadOrCreate(accountID)
+
+	errorSample := 1.0
+	if success {
+		errorSample = 0.0
+	}
+	updateEWMAAtomic(&stat.errorRateEWMABits, errorSample, alpha)
+
+	if firstTokenMs != nil && *firstTokenMs > 0 {
+		ttft := float64(*firstTokenMs)
+		stat.recordTTFT(ttft)
+		ttftBits := math.Float64bits(ttft)
+		for {
+			oldBits := stat.ttftEWMABits.Load()
+			oldValue := math.Float64frombits(oldBits)
+			if math.IsNaN(oldValue) {
+				if stat.ttftEWMABits.CompareAndSwap(oldBits, ttftBits) {
+					break
+				}
+				continue
+			}
+			newValue := alpha*ttft + (1-alpha)*oldValue
+			if stat.ttftEWMABits.CompareAndSwap(oldBits, math.Float64bits(newValue)) {
+				break
+			}
+		}
+	}
+}
+
+func (s *accountRuntimeStats) snapshot(accountID int64) (errorRate float64, ttft float64, hasTTFT bool) {
+	if s == nil || accountID <= 0 {
+		return 0, 0, false
+	}
+	value, ok := s.accounts.Load(accountID)
+	if !ok {
+		return 0, 0, false
+	}
+	stat, _ := value.(*accountRuntimeStat)
+	if stat == nil {
+		return 0, 0, false
+	}
+	errorRate = clamp01(math.Float64frombits(stat.errorRateEWMABits.Load()))
+	ttftValue := math.Float64frombits(stat.ttftEWMABits.Load())
+	if math.IsNaN(ttftValue) {
+		return errorRate, 0, false
+	}
+	return errorRate, ttftValue, true
+}
+
+// ttftQuantile 返回账号最近首字延迟样本的 q 分位数（毫秒）；样本数少于 minSamples 时返回 false
+func (s *accountRuntimeStats) ttftQuantile(accountID int64, q float64, minSamples int) (float64, bool) {
+	if s == nil || accountID <= 0 {
+		return 0, false
+	}
+	value, ok := s.accounts.Load(accountID)
+	if !ok {
+		return 0, false
+	}
+	stat, _ := value.(*accountRuntimeStat)
+	if stat == nil {
+		return 0, false
+	}
+	stat.ttftMu.Lock()
+	samples := append([]float64(nil), stat.ttftSamples[:stat.ttftCount]...)
+	stat.ttftMu.Unlock()
+	if len(samples) == 0 || len(samples) < minSamples {
+		return 0, false
+	}
+	sort.Float64s(samples)
+	idx := int(math.Ceil(clamp01(q)*float64(len(samples)))) - 1
+	if idx < 0 {
+		idx = 0
+	}
+	return samples[idx], true
+}
+
+func (s *accountRuntimeStats) size() int {
+	if s == nil {
+		return 0
+	}
+	return int(s.accountCount.Load())
+}
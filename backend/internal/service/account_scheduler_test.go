@@ -0,0 +1,141 @@
+//go:build unit
+
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newScheduleCandidate(id int64, priority int, loadFactor int, concurrency, waiting, loadRate int) AccountScheduleCandidate {
+	lf := loadFactor
+	return AccountScheduleCandidate{
+		Account: &Account{ID: id, Priority: priority, LoadFactor: &lf},
+		LoadInfo: &AccountLoadInfo{
+			AccountID:          id,
+			CurrentConcurrency: concurrency,
+			WaitingCount:       waiting,
+			LoadRate:           loadRate,
+		},
+	}
+}
+
+func scheduleOrderIDs(candidates []AccountScheduleCandidate) []int64 {
+	ids := make([]int64, 0, len(candidates))
+	for _, c := range candidates {
+		ids = append(ids, c.Account.ID)
+	}
+	return ids
+}
+
+func TestAccountSchedulerSet_UnknownStrategyFallsBack(t *testing.T) {
+	set := newAccountSchedulerSet(nil)
+	require.Equal(t, AccountSchedulingPriorityLRU, set.get("").Strategy())
+	require.Equal(t, AccountSchedulingPriorityLRU, set.get("unknown").Strategy())
+	require.Equal(t, AccountSchedulingEWMA, set.get(AccountSchedulingEWMA).Strategy())
+
+	var nilSet *accountSchedulerSet
+	require.Equal(t, AccountSchedulingPriorityLRU, nilSet.get(AccountSchedulingEWMA).Strategy())
+	nilSet.report(1, true, nil)
+
+	require.True(t, IsValidAccountSchedulingStrategy(""))
+	require.True(t, IsValidAccountSchedulingStrategy(AccountSchedulingPowerOfTwo))
+	require.False(t, IsValidAccountSchedulingStrategy("random"))
+}
+
+func TestPriorityLRUScheduler_Order(t *testing.T) {
+	old := time.Now().Add(-time.Hour)
+	recent := time.Now()
+
+	a := newScheduleCandidate(1, 1, 1, 0, 0, 50)
+	b := newScheduleCandidate(2, 1, 1, 0, 0, 10)
+	b.Account.LastUsedAt = &recent
+	c := newScheduleCandidate(3, 1, 1, 0, 0, 10)
+	c.Account.LastUsedAt = &old
+	d := newScheduleCandidate(4, 0, 1, 0, 0, 90)
+
+	ordered := newAccountSchedulerSet(nil).get("").Order(AccountScheduleRequest{}, []AccountScheduleCandidate{a, b, c, d})
+	// 优先级 → 负载率 → 最久未用
+	require.Equal(t, []int64{4, 3, 2, 1}, scheduleOrderIDs(ordered))
+}
+
+func TestWeightedRoundRobinScheduler_Distribution(t *testing.T) {
+	scheduler := newAccountSchedulerSet(nil).get(AccountSchedulingWeightedRoundRobin)
+	candidates := []AccountScheduleCandidate{
+		newScheduleCandidate(1, 1, 3, 0, 0, 0),
+		newScheduleCandidate(2, 1, 1, 0, 0, 0),
+		newScheduleCandidate(3, 0, 1, 0, 0, 0),
+	}
+
+	counts := map[int64]int{}
+	for i := 0; i < 8; i++ {
+		ordered := scheduler.Order(AccountScheduleRequest{}, candidates)
+		require.Len(t, ordered, 3)
+		// 高优先级账号始终排在首位
+		require.Equal(t, int64(3), ordered[0].Account.ID)
+		counts[ordered[1].Account.ID]++
+	}
+	// 同一优先级内按负载因子 3:1 轮询
+	require.Equal(t, 6, counts[1])
+	require.Equal(t, 2, counts[2])
+}
+
+func TestLeastOutstandingScheduler_Order(t *testing.T) {
+	scheduler := newAccountSchedulerSet(nil).get(AccountSchedulingLeastOutstanding)
+	ordered := scheduler.Order(AccountScheduleRequest{}, []AccountScheduleCandidate{
+		newScheduleCandidate(1, 1, 10, 5, 1, 60),
+		newScheduleCandidate(2, 1, 10, 1, 0, 10),
+		newScheduleCandidate(3, 1, 10, 0, 2, 20),
+		newScheduleCandidate(4, 2, 10, 0, 0, 0),
+	})
+	require.Equal(t, []int64{2, 3, 1, 4}, scheduleOrderIDs(ordered))
+}
+
+func TestEWMAScheduler_PrefersFastHealthyAccounts(t *testing.T) {
+	set := newAccountSchedulerSet(nil)
+	scheduler := set.get(AccountSchedulingEWMA)
+
+	fast, slow := 200, 2000
+	for i := 0; i < 5; i++ {
+		scheduler.ReportResult(1, true, &slow)
+		scheduler.ReportResult(2, true, &fast)
+		scheduler.ReportResult(3, false, nil)
+		scheduler.ReportResult(3, true, &fast)
+	}
+
+	ordered := scheduler.Order(AccountScheduleRequest{}, []AccountScheduleCandidate{
+		newScheduleCandidate(1, 1, 10, 0, 0, 0),
+		newScheduleCandidate(2, 1, 10, 0, 0, 0),
+		newScheduleCandidate(3, 1, 10, 0, 0, 0),
+	})
+	require.Equal(t, []int64{2, 3, 1}, scheduleOrderIDs(ordered))
+
+	// 负载会放大代价：快账号积压请求后让位
+	ordered = scheduler.Order(AccountScheduleRequest{}, []AccountScheduleCandidate{
+		newScheduleCandidate(1, 1, 10, 0, 0, 0),
+		newScheduleCandidate(2, 1, 10, 9, 5, 0),
+	})
+	require.Equal(t, []int64{1, 2}, scheduleOrderIDs(ordered))
+}
+
+func TestPowerOfTwoScheduler_PicksBetterOfTwo(t *testing.T) {
+	scheduler := newAccountSchedulerSet(nil).get(AccountSchedulingPowerOfTwo)
+	candidates := []AccountScheduleCandidate{
+		newScheduleCandidate(1, 1, 10, 8, 0, 0),
+		newScheduleCandidate(2, 1, 10, 0, 0, 0),
+		newScheduleCandidate(3, 1, 10, 4, 0, 0),
+	}
+
+	firsts := map[int64]int{}
+	for i := 0; i < 200; i++ {
+		ordered := scheduler.Order(AccountScheduleRequest{}, candidates)
+		require.ElementsMatch(t, []int64{1, 2, 3}, scheduleOrderIDs(ordered))
+		firsts[ordered[0].Account.ID]++
+	}
+	// 最繁忙的账号永远不会在两两比较中胜出
+	require.Zero(t, firsts[1])
+	require.Positive(t, firsts[2])
+	require.Positive(t, firsts[3])
+}
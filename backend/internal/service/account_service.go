@@ -81,6 +81,7 @@ type AccountRepository interface {
 type AccountBulkUpdate struct {
 	Name           *string
 	ProxyID        *int64
+	ProxyPoolID    *int64
 	Concurrency    *int
 	Priority       *int
 	RateMultiplier *float64
@@ -148,6 +148,8 @@ type UsageProgress struct {
 	WindowStats      *WindowStats `json:"window_stats,omitempty"` // 窗口期统计（从窗口开始到当前的使用量）
 	UsedRequests     int64        `json:"used_requests,omitempty"`
 	LimitRequests    int64        `json:"limit_requests,omitempty"`
+
+	Forecast *AccountWindowForecast `json:"forecast,omitempty"` // 5h 窗口消耗预测（仅 FiveHour）
 }
 
 // AntigravityModelQuota Antigravity 单个模型的配额信息
@@ -628,10 +630,7 @@ func (s *AccountUsageService) probeOpenAICodexSnapshot(ctx context.Context, acco
 		req.Header.Set("chatgpt-account-id", chatgptAccountID)
 	}
 
-	proxyURL := ""
-	if account.ProxyID != nil && account.Proxy != nil {
-		proxyURL = account.Proxy.URL()
-	}
+	proxyURL := resolveAccountProxyURL(account)
 	client, err := httppool.GetClient(httppool.Options{
 		ProxyURL:              proxyURL,
 		Timeout:               15 * time.Second,
@@ -976,9 +975,25 @@ func (s *AccountUsageService) addWindowStats(ctx context.Context, account *Accou
 	// 为 FiveHour 添加 WindowStats（5h 窗口统计）
 	if usage.FiveHour != nil {
 		usage.FiveHour.WindowStats = windowStats
+		usage.FiveHour.Forecast = forecastFiveHourUsage(account, usage.FiveHour, windowStats.StandardCost, time.Now())
 	}
 }
 
+// forecastFiveHourUsage 基于上游返回的 5h 使用率与重置时间预测窗口消耗；
+// 缺少重置时间时回退到账号缓存的会话窗口数据。
+func forecastFiveHourUsage(account *Account, fiveHour *UsageProgress, windowCost float64, now time.Time) *AccountWindowForecast {
+	if fiveHour.ResetsAt == nil || !now.Before(*fiveHour.ResetsAt) {
+		return account.ForecastWindow(windowCost, now)
+	}
+	return forecastAccountWindow(accountWindowForecastInput{
+		WindowStart:     fiveHour.ResetsAt.Add(-sessionWindowDuration),
+		WindowEnd:       *fiveHour.ResetsAt,
+		Utilization:     fiveHour.Utilization,
+		WindowCost:      windowCost,
+		WindowCostLimit: account.GetWindowCostLimit(),
+	}, now)
+}
+
 // GetTodayStats 获取账号今日统计
 func (s *AccountUsageService) GetTodayStats(ctx context.Context, accountID int64) (*WindowStats, error) {
 	stats, err := s.usageLogRepo.GetAccountTodayStats(ctx, accountID)
@@ -1148,10 +1163,7 @@ func (s *AccountUsageService) fetchOAuthUsageRaw(ctx context.Context, account *A
 		return nil, fmt.Errorf("no access token available")
 	}
 
-	var proxyURL string
-	if account.ProxyID != nil && account.Proxy != nil {
-		proxyURL = account.Proxy.URL()
-	}
+	proxyURL := resolveAccountProxyURL(account)
 
 	// 构建完整的选项
 	opts := &ClaudeUsageFetchOptions{
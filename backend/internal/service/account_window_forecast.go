@@ -0,0 +1,193 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+const (
+	// sessionWindowDuration Anthropic 5h 会话窗口时长
+	sessionWindowDuration = 5 * time.Hour
+	// windowForecastMinElapsed 窗口开始后至少经过该时长才进行预测，避免窗口初期的速率噪声
+	windowForecastMinElapsed = 15 * time.Minute
+)
+
+// AccountWindowForecast 账号当前 5h 窗口的消耗预测。
+// 按窗口内平均消耗速率线性外推到窗口结束：预测值 = 当前值 × 窗口时长 / 已用时长。
+type AccountWindowForecast struct {
+	WindowStart time.Time `json:"window_start"`
+	WindowEnd   time.Time `json:"window_end"`
+
+	// 上游 5h 使用率（0-100）及窗口结束时的预测值；未知时为 0
+	Utilization          float64 `json:"utilization"`
+	ProjectedUtilization float64 `json:"projected_utilization"`
+
+	// 窗口费用（标准费用，USD）及预测值；WindowCostLimit 为 0 表示未配置窗口费用上限
+	WindowCost          float64 `json:"window_cost"`
+	WindowCostLimit     float64 `json:"window_cost_limit,omitempty"`
+	ProjectedWindowCost float64 `json:"projected_window_cost"`
+
+	// ExhaustionRatio 预测耗尽程度：max(预测使用率 / 100, 预测费用 / 上限)，>= 1 表示预计在窗口结束前耗尽
+	ExhaustionRatio float64 `json:"exhaustion_ratio"`
+	// ExhaustAt 预计耗尽时间（预计不会耗尽时为空）
+	ExhaustAt *time.Time `json:"exhaust_at,omitempty"`
+	AtRisk    bool       `json:"at_risk"`
+}
+
+// accountWindowForecastInput 预测所需的窗口状态
+type accountWindowForecastInput struct {
+	WindowStart     time.Time
+	WindowEnd       time.Time
+	Utilization     float64 // 0-100，未知为 0
+	WindowCost      float64
+	WindowCostLimit float64
+}
+
+// forecastAccountWindow 计算窗口消耗预测；窗口无效或刚开始不足 windowForecastMinElapsed 时返回 nil
+func forecastAccountWindow(in accountWindowForecastInput, now time.Time) *AccountWindowForecast {
+	if in.WindowEnd.IsZero() || !in.WindowEnd.After(in.WindowStart) || !now.Before(in.WindowEnd) {
+		return nil
+	}
+	elapsed := now.Sub(in.WindowStart)
+	if elapsed < windowForecastMinElapsed {
+		return nil
+	}
+	if in.Utilization <= 0 && (in.WindowCostLimit <= 0 || in.WindowCost <= 0) {
+		return nil
+	}
+
+	scale := float64(in.WindowEnd.Sub(in.WindowStart)) / float64(elapsed)
+	f := &AccountWindowForecast{
+		WindowStart:          in.WindowStart,
+		WindowEnd:            in.WindowEnd,
+		Utilization:          in.Utilization,
+		ProjectedUtilization: in.Utilization * scale,
+		WindowCost:           in.WindowCost,
+		WindowCostLimit:      in.WindowCostLimit,
+		ProjectedWindowCost:  in.WindowCost * scale,
+	}
+
+	// 预计耗尽时间：当前值 / 速率 = 已用时长 × 剩余额度比例
+	var exhaustAfter time.Duration = -1
+	consider := func(current, limit float64) {
+		if current <= 0 || limit <= 0 {
+			return
+		}
+		if ratio := current * scale / limit; ratio > f.ExhaustionRatio {
+			f.ExhaustionRatio = ratio
+		}
+		d := time.Duration(float64(elapsed) * limit / current)
+		if exhaustAfter < 0 || d < exhaustAfter {
+			exhaustAfter = d
+		}
+	}
+	consider(in.Utilization, 100)
+	consider(in.WindowCost, in.WindowCostLimit)
+
+	if exhaustAfter >= 0 {
+		if at := in.WindowStart.Add(exhaustAfter); at.Before(in.WindowEnd) {
+			f.ExhaustAt = &at
+		}
+	}
+	f.AtRisk = f.ExhaustionRatio >= 1
+	return f
+}
+
+// windowForecastInput 基于账号缓存的会话窗口数据（session_window_* 与 session_window_utilization）构建预测输入。
+// 仅适用于 Anthropic OAuth/SetupToken 账号；windowCost 为当前窗口标准费用。
+func (a *Account) windowForecastInput(windowCost float64, now time.Time) (accountWindowForecastInput, bool) {
+	if a == nil || !a.IsAnthropicOAuthOrSetupToken() {
+		return accountWindowForecastInput{}, false
+	}
+	in := accountWindowForecastInput{
+		WindowCost:      windowCost,
+		WindowCostLimit: a.GetWindowCostLimit(),
+	}
+	if a.SessionWindowStart != nil && a.SessionWindowEnd != nil && now.Before(*a.SessionWindowEnd) {
+		in.WindowStart = *a.SessionWindowStart
+		in.WindowEnd = *a.SessionWindowEnd
+		in.Utilization = a.sessionWindowUtilizationPercent()
+		return in, true
+	}
+	if in.WindowCostLimit <= 0 {
+		return accountWindowForecastInput{}, false
+	}
+	in.WindowStart = a.GetCurrentWindowStartTime()
+	in.WindowEnd = in.WindowStart.Add(sessionWindowDuration)
+	return in, true
+}
+
+// sessionWindowUtilizationPercent 读取响应头记录的 5h 使用率（0-1 小数，转为 0-100 百分比）
+func (a *Account) sessionWindowUtilizationPercent() float64 {
+	if a.Extra == nil {
+		return 0
+	}
+	switch v := a.Extra["session_window_utilization"].(type) {
+	case float64:
+		return v * 100
+	case json.Number:
+		if f, err := v.Float64(); err == nil {
+			return f * 100
+		}
+	}
+	return 0
+}
+
+// ForecastWindow 预测账号当前 5h 窗口的消耗（非 Anthropic OAuth/SetupToken 账号或数据不足时返回 nil）
+func (a *Account) ForecastWindow(windowCost float64, now time.Time) *AccountWindowForecast {
+	in, ok := a.windowForecastInput(windowCost, now)
+	if !ok {
+		return nil
+	}
+	return forecastAccountWindow(in, now)
+}
+
+// windowForecastSteeringEnabled 是否启用基于窗口消耗预测的调度降权（默认关闭）
+func (s *GatewayService) windowForecastSteeringEnabled() bool {
+	return s.cfg != nil && s.cfg.Gateway.Scheduling.WindowForecastEnabled
+}
+
+// steerAwayFromWindowExhaustion 在调度策略给出的顺序中，将预计在窗口结束前耗尽的账号移到
+// 相邻同优先级账号之后（稳定划分，不重新排序），未预警账号及预警账号之间的相对顺序均保持不变。
+func (s *GatewayService) steerAwayFromWindowExhaustion(ctx context.Context, ordered []AccountScheduleCandidate) []AccountScheduleCandidate {
+	if len(ordered) < 2 || !s.windowForecastSteeringEnabled() {
+		return ordered
+	}
+	now := time.Now()
+	atRisk := make([]bool, len(ordered))
+	anyAtRisk := false
+	for i, c := range ordered {
+		if c.Account == nil {
+			continue
+		}
+		windowCost, _ := windowCostFromPrefetchContext(ctx, c.Account.ID)
+		if f := c.Account.ForecastWindow(windowCost, now); f != nil && f.AtRisk {
+			atRisk[i] = true
+			anyAtRisk = true
+		}
+	}
+	if !anyAtRisk {
+		return ordered
+	}
+
+	steered := make([]AccountScheduleCandidate, 0, len(ordered))
+	var demoted []AccountScheduleCandidate
+	for i, c := range ordered {
+		if i > 0 && !sameSchedulePriority(ordered[i-1], c) {
+			steered = append(steered, demoted...)
+			demoted = demoted[:0]
+		}
+		if atRisk[i] {
+			demoted = append(demoted, c)
+			continue
+		}
+		steered = append(steered, c)
+	}
+	return append(steered, demoted...)
+}
+
+// sameSchedulePriority 两个候选账号是否处于同一优先级段（账号缺失时视为不同段）
+func sameSchedulePriority(a, b AccountScheduleCandidate) bool {
+	return a.Account != nil && b.Account != nil && a.Account.Priority == b.Account.Priority
+}
@@ -0,0 +1,113 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestForecastAccountWindow_LinearExtrapolation(t *testing.T) {
+	start := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	now := start.Add(time.Hour)
+
+	f := forecastAccountWindow(accountWindowForecastInput{
+		WindowStart: start,
+		WindowEnd:   start.Add(5 * time.Hour),
+		Utilization: 40,
+	}, now)
+	require.NotNil(t, f)
+	require.InDelta(t, 200, f.ProjectedUtilization, 1e-9)
+	require.InDelta(t, 2, f.ExhaustionRatio, 1e-9)
+	require.True(t, f.AtRisk)
+	require.NotNil(t, f.ExhaustAt)
+	require.Equal(t, start.Add(150*time.Minute), *f.ExhaustAt)
+
+	// 费用维度：1h 消耗 10，上限 100，预测 50，不会耗尽
+	f = forecastAccountWindow(accountWindowForecastInput{
+		WindowStart:     start,
+		WindowEnd:       start.Add(5 * time.Hour),
+		WindowCost:      10,
+		WindowCostLimit: 100,
+	}, now)
+	require.NotNil(t, f)
+	require.InDelta(t, 50, f.ProjectedWindowCost, 1e-9)
+	require.InDelta(t, 0.5, f.ExhaustionRatio, 1e-9)
+	require.False(t, f.AtRisk)
+	require.Nil(t, f.ExhaustAt)
+}
+
+func TestForecastAccountWindow_InsufficientData(t *testing.T) {
+	start := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	in := accountWindowForecastInput{WindowStart: start, WindowEnd: start.Add(5 * time.Hour), Utilization: 10}
+
+	require.Nil(t, forecastAccountWindow(in, start.Add(5*time.Minute)), "窗口初期不预测")
+	require.Nil(t, forecastAccountWindow(in, start.Add(6*time.Hour)), "窗口已过期")
+	require.Nil(t, forecastAccountWindow(accountWindowForecastInput{WindowStart: start, WindowEnd: start.Add(5 * time.Hour)}, start.Add(time.Hour)), "无消耗数据")
+}
+
+func TestAccountForecastWindow_OnlyAnthropicOAuth(t *testing.T) {
+	now := time.Now()
+	start := now.Add(-time.Hour)
+	end := start.Add(5 * time.Hour)
+	account := &Account{
+		Platform:           PlatformAnthropic,
+		Type:               AccountTypeOAuth,
+		SessionWindowStart: &start,
+		SessionWindowEnd:   &end,
+		Extra:              map[string]any{"session_window_utilization": 0.5},
+	}
+	f := account.ForecastWindow(0, now)
+	require.NotNil(t, f)
+	require.InDelta(t, 50, f.Utilization, 1e-9)
+	require.True(t, f.AtRisk)
+
+	account.Type = AccountTypeAPIKey
+	require.Nil(t, account.ForecastWindow(0, now))
+}
+
+func TestSteerAwayFromWindowExhaustion_DemotesAtRiskWithinPriority(t *testing.T) {
+	now := time.Now()
+	start := now.Add(-time.Hour)
+	end := start.Add(5 * time.Hour)
+	newAccount := func(id int64, priority int, utilization float64) *Account {
+		return &Account{
+			ID:                 id,
+			Priority:           priority,
+			Platform:           PlatformAnthropic,
+			Type:               AccountTypeOAuth,
+			SessionWindowStart: &start,
+			SessionWindowEnd:   &end,
+			Extra:              map[string]any{"session_window_utilization": utilization},
+		}
+	}
+	ordered := []AccountScheduleCandidate{
+		{Account: newAccount(1, 1, 0.6)}, // 预测 300%
+		{Account: newAccount(2, 1, 0.3)}, // 预测 150%
+		{Account: newAccount(3, 1, 0.1)}, // 预测 50%
+		{Account: newAccount(4, 2, 0.1)},
+	}
+
+	cfg := &config.Config{}
+	cfg.Gateway.Scheduling.WindowForecastEnabled = true
+	svc := &GatewayService{cfg: cfg}
+	require.Equal(t, []int64{3, 1, 2, 4}, scheduleOrderIDs(svc.steerAwayFromWindowExhaustion(context.Background(), ordered)))
+
+	// 调度策略给出的顺序不按优先级重排，仅在相邻同优先级段内后移预警账号
+	loadOrdered := []AccountScheduleCandidate{
+		{Account: newAccount(5, 2, 0.1)},
+		{Account: newAccount(6, 1, 0.6)},
+		{Account: newAccount(7, 1, 0.1)},
+		{Account: newAccount(8, 1, 0.1)},
+	}
+	require.Equal(t, []int64{5, 7, 8, 6}, scheduleOrderIDs(svc.steerAwayFromWindowExhaustion(context.Background(), loadOrdered)))
+
+	cfg.Gateway.Scheduling.WindowForecastEnabled = false
+	require.Equal(t, []int64{1, 2, 3, 4}, scheduleOrderIDs(svc.steerAwayFromWindowExhaustion(context.Background(), ordered)))
+
+	require.Equal(t, []int64{1, 2, 3, 4}, scheduleOrderIDs((&GatewayService{}).steerAwayFromWindowExhaustion(context.Background(), ordered)))
+}
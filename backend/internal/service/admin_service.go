@@ -10,6 +10,7 @@ import (
 	"time"
 
 	dbent "github.com/Wei-Shaw/sub2api/ent"
+	"github.com/Wei-Shaw/sub2api/internal/model"
 	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
 	"github.com/Wei-Shaw/sub2api/internal/pkg/httpclient"
 	"github.com/Wei-Shaw/sub2api/internal/pkg/logger"
@@ -124,6 +125,12 @@ type UpdateUserInput struct {
 
 var ErrInvalidUserCommissionRate = infraerrors.BadRequest("INVALID_USER_COMMISSION_RATE", "commission rate must be between 0 and 1")
 
+// ErrInvalidSchedulingStrategy 分组账号调度策略不合法
+var ErrInvalidSchedulingStrategy = infraerrors.BadRequest("INVALID_SCHEDULING_STRATEGY", "scheduling_strategy must be one of priority_lru, weighted_round_robin, least_outstanding, ewma, p2c")
+
+// ErrInvalidHedgeDelay 分组对冲阈值不合法
+var ErrInvalidHedgeDelay = infraerrors.BadRequest("INVALID_HEDGE_DELAY", "hedge_delay_ms must be >= 0")
+
 type UserCommissionRateInfo struct {
 	UserCommissionRate   *float64 `json:"user_commission_rate"`
 	GlobalCommissionRate float64  `json:"global_commission_rate"`
@@ -166,6 +173,21 @@ type CreateGroupInput struct {
 	RequirePrivacySet     bool
 	// 非流式响应头控制
 	ForceApplicationJSONForNonStream bool
+	// 账号调度策略（为空使用默认策略）
+	SchedulingStrategy string
+	// 流式请求对冲
+	HedgeEnabled bool
+	HedgeDelayMs int
+	// 模型流量拆分规则
+	TrafficSplitRules []model.TrafficSplitRule
+	// 模型降级链
+	ModelFallbackChains []model.ModelFallbackChain
+	// 用量阶梯定价
+	VolumeTiers []model.VolumeTier
+	// 可用时段 cron 表达式（为空表示全天可用）与维护模式
+	AvailabilitySchedule string
+	MaintenanceMode      bool
+	MaintenanceMessage   string
 	// 从指定分组复制账号（创建分组后在同一事务内绑定）
 	CopyAccountsFromGroupIDs []int64
 }
@@ -207,6 +229,21 @@ type UpdateGroupInput struct {
 	RequirePrivacySet     *bool
 	// 非流式响应头控制
 	ForceApplicationJSONForNonStream *bool
+	// 账号调度策略（空字符串表示恢复默认策略）
+	SchedulingStrategy *string
+	// 流式请求对冲
+	HedgeEnabled *bool
+	HedgeDelayMs *int
+	// 模型流量拆分规则（nil 表示不修改，空数组表示清除）
+	TrafficSplitRules *[]model.TrafficSplitRule
+	// 模型降级链（nil 表示不修改，空数组表示清除）
+	ModelFallbackChains *[]model.ModelFallbackChain
+	// 用量阶梯定价（nil 表示不修改，空数组表示清除）
+	VolumeTiers *[]model.VolumeTier
+	// 可用时段 cron 表达式（空字符串表示全天可用）与维护模式
+	AvailabilitySchedule *string
+	MaintenanceMode      *bool
+	MaintenanceMessage   *string
 	// 从指定分组复制账号（同步操作：先清空当前分组的账号绑定，再绑定源分组的账号）
 	CopyAccountsFromGroupIDs []int64
 }
@@ -219,6 +256,7 @@ type CreateAccountInput struct {
 	Credentials        map[string]any
 	Extra              map[string]any
 	ProxyID            *int64
+	ProxyPoolID        *int64
 	Concurrency        int
 	Priority           int
 	RateMultiplier     *float64 // 账号计费倍率（>=0，允许 0）
@@ -226,6 +264,8 @@ type CreateAccountInput struct {
 	GroupIDs           []int64
 	ExpiresAt          *int64
 	AutoPauseOnExpired *bool
+	// AvailabilitySchedule 可用时段 cron 表达式（为空表示全天可用）
+	AvailabilitySchedule string
 	// SkipDefaultGroupBind prevents auto-binding to platform default group when GroupIDs is empty.
 	SkipDefaultGroupBind bool
 	// SkipMixedChannelCheck skips the mixed channel risk check when binding groups.
@@ -240,6 +280,7 @@ type UpdateAccountInput struct {
 	Credentials           map[string]any
 	Extra                 map[string]any
 	ProxyID               *int64
+	ProxyPoolID           *int64   // 0 表示清除代理池
 	Concurrency           *int     // 使用指针区分"未提供"和"设置为0"
 	Priority              *int     // 使用指针区分"未提供"和"设置为0"
 	RateMultiplier        *float64 // 账号计费倍率（>=0，允许 0）
@@ -248,7 +289,8 @@ type UpdateAccountInput struct {
 	GroupIDs              *[]int64
 	ExpiresAt             *int64
 	AutoPauseOnExpired    *bool
-	SkipMixedChannelCheck bool // 跳过混合渠道检查（用户已确认风险）
+	AvailabilitySchedule  *string // 可用时段 cron 表达式（空字符串表示全天可用）
+	SkipMixedChannelCheck bool    // 跳过混合渠道检查（用户已确认风险）
 }
 
 // BulkUpdateAccountsInput describes the payload for bulk updating accounts.
@@ -256,6 +298,7 @@ type BulkUpdateAccountsInput struct {
 	AccountIDs     []int64
 	Name           string
 	ProxyID        *int64
+	ProxyPoolID    *int64
 	Concurrency    *int
 	Priority       *int
 	RateMultiplier *float64 // 账号计费倍率（>=0，允许 0）
@@ -454,6 +497,8 @@ type adminServiceImpl struct {
 	defaultSubAssigner   DefaultSubscriptionAssigner
 	userSubRepo          UserSubscriptionRepository
 	privacyClientFactory PrivacyClientFactory
+	balanceBuckets       *BalanceBucketService
+	proxyPoolRepo        ProxyPoolRepository
 }
 
 type userGroupRateBatchReader interface {
@@ -478,6 +523,8 @@ func NewAdminService(
 	defaultSubAssigner DefaultSubscriptionAssigner,
 	userSubRepo UserSubscriptionRepository,
 	privacyClientFactory PrivacyClientFactory,
+	balanceBuckets *BalanceBucketService,
+	proxyPoolRepo ProxyPoolRepository,
 ) AdminService {
 	return &adminServiceImpl{
 		userRepo:             userRepo,
@@ -496,6 +543,8 @@ func NewAdminService(
 		defaultSubAssigner:   defaultSubAssigner,
 		userSubRepo:          userSubRepo,
 		privacyClientFactory: privacyClientFactory,
+		balanceBuckets:       balanceBuckets,
+		proxyPoolRepo:        proxyPoolRepo,
 	}
 }
 
@@ -605,6 +654,9 @@ func (s *adminServiceImpl) CreateUser(ctx context.Context, input *CreateUserInpu
 	if err := s.userRepo.Create(ctx, user); err != nil {
 		return nil, err
 	}
+	if err := s.balanceBuckets.Grant(ctx, user.ID, BalanceSourceAdmin, user.Balance, "create_user"); err != nil {
+		logger.LegacyPrintf("service.admin", "failed to record initial balance bucket: user_id=%d err=%v", user.ID, err)
+	}
 	s.assignDefaultSubscriptions(ctx, user.ID)
 	return user, nil
 }
@@ -772,6 +824,16 @@ func (s *adminServiceImpl) UpdateUserBalance(ctx context.Context, userID int64,
 		return nil, err
 	}
 	balanceDiff := user.Balance - oldBalance
+	// 管理员直接改写 users.balance，分桶需单独同步：加款记为 admin 桶，减款按优先级消耗
+	if balanceDiff > 0 {
+		if err := s.balanceBuckets.Grant(ctx, userID, BalanceSourceAdmin, balanceDiff, "balance_adjust"); err != nil {
+			logger.LegacyPrintf("service.admin", "failed to record balance bucket: user_id=%d err=%v", userID, err)
+		}
+	} else if balanceDiff < 0 {
+		if err := s.balanceBuckets.Consume(ctx, userID, -balanceDiff); err != nil {
+			logger.LegacyPrintf("service.admin", "failed to consume balance buckets: user_id=%d err=%v", userID, err)
+		}
+	}
 	if s.authCacheInvalidator != nil && balanceDiff != 0 {
 		s.authCacheInvalidator.InvalidateAuthCacheByUserID(ctx, userID)
 	}
@@ -890,6 +952,27 @@ func (s *adminServiceImpl) CreateGroup(ctx context.Context, input *CreateGroupIn
 	imagePrice2K := normalizePrice(input.ImagePrice2K)
 	imagePrice4K := normalizePrice(input.ImagePrice4K)
 
+	schedulingStrategy := strings.TrimSpace(input.SchedulingStrategy)
+	if !IsValidAccountSchedulingStrategy(schedulingStrategy) {
+		return nil, ErrInvalidSchedulingStrategy
+	}
+	if input.HedgeDelayMs < 0 {
+		return nil, ErrInvalidHedgeDelay
+	}
+	if err := ValidateTrafficSplitRules(platform, input.TrafficSplitRules); err != nil {
+		return nil, err
+	}
+	if err := s.validateModelFallbackChains(ctx, 0, input.ModelFallbackChains); err != nil {
+		return nil, err
+	}
+	if err := validateVolumeTiers(input.VolumeTiers); err != nil {
+		return nil, err
+	}
+	availabilitySchedule := strings.TrimSpace(input.AvailabilitySchedule)
+	if err := ValidateAvailabilitySchedule(availabilitySchedule); err != nil {
+		return nil, err
+	}
+
 	// 校验降级分组
 	if input.FallbackGroupID != nil {
 		if err := s.validateFallbackGroup(ctx, 0, *input.FallbackGroupID); err != nil {
@@ -983,6 +1066,15 @@ func (s *adminServiceImpl) CreateGroup(ctx context.Context, input *CreateGroupIn
 		RequirePrivacySet:                input.RequirePrivacySet,
 		DefaultMappedModel:               input.DefaultMappedModel,
 		ForceApplicationJSONForNonStream: input.ForceApplicationJSONForNonStream,
+		SchedulingStrategy:               schedulingStrategy,
+		HedgeEnabled:                     input.HedgeEnabled,
+		HedgeDelayMs:                     input.HedgeDelayMs,
+		TrafficSplitRules:                input.TrafficSplitRules,
+		ModelFallbackChains:              input.ModelFallbackChains,
+		VolumeTiers:                      input.VolumeTiers,
+		AvailabilitySchedule:             availabilitySchedule,
+		MaintenanceMode:                  input.MaintenanceMode,
+		MaintenanceMessage:               strings.TrimSpace(input.MaintenanceMessage),
 	}
 	if err := s.groupRepo.Create(ctx, group); err != nil {
 		return nil, err
@@ -1251,6 +1343,55 @@ func (s *adminServiceImpl) UpdateGroup(ctx context.Context, id int64, input *Upd
 	if input.ForceApplicationJSONForNonStream != nil {
 		group.ForceApplicationJSONForNonStream = *input.ForceApplicationJSONForNonStream
 	}
+	if input.SchedulingStrategy != nil {
+		strategy := strings.TrimSpace(*input.SchedulingStrategy)
+		if !IsValidAccountSchedulingStrategy(strategy) {
+			return nil, ErrInvalidSchedulingStrategy
+		}
+		group.SchedulingStrategy = strategy
+	}
+	if input.HedgeEnabled != nil {
+		group.HedgeEnabled = *input.HedgeEnabled
+	}
+	if input.HedgeDelayMs != nil {
+		if *input.HedgeDelayMs < 0 {
+			return nil, ErrInvalidHedgeDelay
+		}
+		group.HedgeDelayMs = *input.HedgeDelayMs
+	}
+	if input.TrafficSplitRules != nil {
+		group.TrafficSplitRules = *input.TrafficSplitRules
+	}
+	if input.TrafficSplitRules != nil || input.Platform != "" {
+		if err := ValidateTrafficSplitRules(group.Platform, group.TrafficSplitRules); err != nil {
+			return nil, err
+		}
+	}
+	if input.ModelFallbackChains != nil {
+		if err := s.validateModelFallbackChains(ctx, id, *input.ModelFallbackChains); err != nil {
+			return nil, err
+		}
+		group.ModelFallbackChains = *input.ModelFallbackChains
+	}
+	if input.VolumeTiers != nil {
+		if err := validateVolumeTiers(*input.VolumeTiers); err != nil {
+			return nil, err
+		}
+		group.VolumeTiers = *input.VolumeTiers
+	}
+	if input.AvailabilitySchedule != nil {
+		schedule := strings.TrimSpace(*input.AvailabilitySchedule)
+		if err := ValidateAvailabilitySchedule(schedule); err != nil {
+			return nil, err
+		}
+		group.AvailabilitySchedule = schedule
+	}
+	if input.MaintenanceMode != nil {
+		group.MaintenanceMode = *input.MaintenanceMode
+	}
+	if input.MaintenanceMessage != nil {
+		group.MaintenanceMessage = strings.TrimSpace(*input.MaintenanceMessage)
+	}
 
 	if err := s.groupRepo.Update(ctx, group); err != nil {
 		return nil, err
@@ -1615,6 +1756,9 @@ func (s *adminServiceImpl) CreateAccount(ctx context.Context, input *CreateAccou
 	if err := s.validateGroupOAuthRequirement(ctx, input.Type, groupIDs); err != nil {
 		return nil, err
 	}
+	if err := s.validateProxyPoolExists(ctx, input.ProxyPoolID); err != nil {
+		return nil, err
+	}
 
 	account := &Account{
 		Name:        input.Name,
@@ -1624,6 +1768,7 @@ func (s *adminServiceImpl) CreateAccount(ctx context.Context, input *CreateAccou
 		Credentials: input.Credentials,
 		Extra:       input.Extra,
 		ProxyID:     input.ProxyID,
+		ProxyPoolID: input.ProxyPoolID,
 		Concurrency: input.Concurrency,
 		Priority:    input.Priority,
 		Status:      StatusActive,
@@ -1645,6 +1790,10 @@ func (s *adminServiceImpl) CreateAccount(ctx context.Context, input *CreateAccou
 	} else {
 		account.AutoPauseOnExpired = true
 	}
+	account.AvailabilitySchedule = strings.TrimSpace(input.AvailabilitySchedule)
+	if err := ValidateAvailabilitySchedule(account.AvailabilitySchedule); err != nil {
+		return nil, err
+	}
 	if input.RateMultiplier != nil {
 		if *input.RateMultiplier < 0 {
 			return nil, errors.New("rate_multiplier must be >= 0")
@@ -1726,6 +1875,17 @@ func (s *adminServiceImpl) UpdateAccount(ctx context.Context, id int64, input *U
 		}
 		account.Proxy = nil // 清除关联对象，防止 GORM Save 时根据 Proxy.ID 覆盖 ProxyID
 	}
+	if input.ProxyPoolID != nil {
+		if err := s.validateProxyPoolExists(ctx, input.ProxyPoolID); err != nil {
+			return nil, err
+		}
+		// 0 表示清除代理池
+		if *input.ProxyPoolID == 0 {
+			account.ProxyPoolID = nil
+		} else {
+			account.ProxyPoolID = input.ProxyPoolID
+		}
+	}
 	// 只在指针非 nil 时更新 Concurrency（支持设置为 0）
 	if input.Concurrency != nil {
 		account.Concurrency = *input.Concurrency
@@ -1763,6 +1923,13 @@ func (s *adminServiceImpl) UpdateAccount(ctx context.Context, id int64, input *U
 	if input.AutoPauseOnExpired != nil {
 		account.AutoPauseOnExpired = *input.AutoPauseOnExpired
 	}
+	if input.AvailabilitySchedule != nil {
+		schedule := strings.TrimSpace(*input.AvailabilitySchedule)
+		if err := ValidateAvailabilitySchedule(schedule); err != nil {
+			return nil, err
+		}
+		account.AvailabilitySchedule = schedule
+	}
 
 	// 先验证分组是否存在（在任何写操作之前）
 	if input.GroupIDs != nil {
@@ -1812,6 +1979,9 @@ func (s *adminServiceImpl) BulkUpdateAccounts(ctx context.Context, input *BulkUp
 	if len(input.AccountIDs) == 0 {
 		return result, nil
 	}
+	if err := s.validateProxyPoolExists(ctx, input.ProxyPoolID); err != nil {
+		return nil, err
+	}
 	if input.GroupIDs != nil {
 		if err := s.validateGroupIDsExist(ctx, *input.GroupIDs); err != nil {
 			return nil, err
@@ -1876,6 +2046,9 @@ func (s *adminServiceImpl) BulkUpdateAccounts(ctx context.Context, input *BulkUp
 	if input.ProxyID != nil {
 		repoUpdates.ProxyID = input.ProxyID
 	}
+	if input.ProxyPoolID != nil {
+		repoUpdates.ProxyPoolID = input.ProxyPoolID
+	}
 	if input.Concurrency != nil {
 		repoUpdates.Concurrency = input.Concurrency
 	}
@@ -2583,6 +2756,20 @@ func (s *adminServiceImpl) checkMixedChannelRisk(ctx context.Context, currentAcc
 	return nil
 }
 
+// validateProxyPoolExists 校验账号引用的代理池存在（nil 或 0 表示不设置/清除，无需校验）
+func (s *adminServiceImpl) validateProxyPoolExists(ctx context.Context, poolID *int64) error {
+	if poolID == nil || *poolID == 0 {
+		return nil
+	}
+	if s.proxyPoolRepo == nil {
+		return errors.New("proxy pool repository not configured")
+	}
+	if _, err := s.proxyPoolRepo.GetByID(ctx, *poolID); err != nil {
+		return err
+	}
+	return nil
+}
+
 func (s *adminServiceImpl) validateGroupIDsExist(ctx context.Context, groupIDs []int64) error {
 	if len(groupIDs) == 0 {
 		return nil
@@ -2659,12 +2846,17 @@ func (s *adminServiceImpl) attachProxyLatency(ctx context.Context, proxies []Pro
 }
 
 func (s *adminServiceImpl) saveProxyLatency(ctx context.Context, proxyID int64, info *ProxyLatencyInfo) {
-	if s.proxyLatencyCache == nil || info == nil {
+	saveProxyLatencyToCache(ctx, s.proxyLatencyCache, proxyID, info)
+}
+
+// saveProxyLatencyToCache 写入代理延迟缓存；新记录不含质量检测结果时保留已有的质量检测字段
+func saveProxyLatencyToCache(ctx context.Context, cache ProxyLatencyCache, proxyID int64, info *ProxyLatencyInfo) {
+	if cache == nil || info == nil {
 		return
 	}
 
 	merged := *info
-	if latencies, err := s.proxyLatencyCache.GetProxyLatencies(ctx, []int64{proxyID}); err == nil {
+	if latencies, err := cache.GetProxyLatencies(ctx, []int64{proxyID}); err == nil {
 		if existing := latencies[proxyID]; existing != nil {
 			if merged.QualityCheckedAt == nil &&
 				merged.QualityScore == nil &&
@@ -2682,7 +2874,7 @@ func (s *adminServiceImpl) saveProxyLatency(ctx context.Context, proxyID int64,
 		}
 	}
 
-	if err := s.proxyLatencyCache.SetProxyLatency(ctx, proxyID, &merged); err != nil {
+	if err := cache.SetProxyLatency(ctx, proxyID, &merged); err != nil {
 		logger.LegacyPrintf("service.admin", "Warning: store proxy latency cache failed: %v", err)
 	}
 }
@@ -2736,12 +2928,7 @@ func (s *adminServiceImpl) EnsureOpenAIPrivacy(ctx context.Context, account *Acc
 		return ""
 	}
 
-	var proxyURL string
-	if account.ProxyID != nil {
-		if p, err := s.proxyRepo.GetByID(ctx, *account.ProxyID); err == nil && p != nil {
-			proxyURL = p.URL()
-		}
-	}
+	proxyURL := resolveAccountProxyURLWithRepo(ctx, s.proxyRepo, account)
 
 	mode := disableOpenAITraining(ctx, s.privacyClientFactory, token, proxyURL)
 	if mode == "" {
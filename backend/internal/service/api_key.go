@@ -12,6 +12,8 @@ const (
 	StatusAPIKeyDisabled       = "disabled"
 	StatusAPIKeyQuotaExhausted = "quota_exhausted"
 	StatusAPIKeyExpired        = "expired"
+	// StatusAPIKeySuspended is set by postpaid billing while a statement is overdue.
+	StatusAPIKeySuspended = "suspended"
 )
 
 // Rate limit window durations
@@ -60,6 +62,12 @@ type APIKey struct {
 	Window5hStart *time.Time // Start of current 5h window
 	Window1dStart *time.Time // Start of current 1d window
 	Window7dStart *time.Time // Start of current 7d window
+
+	// PriorityClass 请求优先级分类：interactive/standard/batch（空 = standard）
+	PriorityClass string
+
+	// Ephemeral 非空表示本次请求使用的是由该 Key 签发的临时子密钥（仅存在于请求上下文）
+	Ephemeral *EphemeralKeyClaims `json:"-"`
 }
 
 func (k *APIKey) IsActive() bool {
@@ -1,6 +1,10 @@
 package service
 
-import "time"
+import (
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/model"
+)
 
 // APIKeyAuthSnapshot API Key 认证缓存快照（仅包含认证所需字段）
 type APIKeyAuthSnapshot struct {
@@ -24,6 +28,9 @@ type APIKeyAuthSnapshot struct {
 	RateLimit5h float64 `json:"rate_limit_5h"`
 	RateLimit1d float64 `json:"rate_limit_1d"`
 	RateLimit7d float64 `json:"rate_limit_7d"`
+
+	// PriorityClass 请求优先级分类
+	PriorityClass string `json:"priority_class,omitempty"`
 }
 
 // APIKeyAuthUserSnapshot 用户快照
@@ -72,6 +79,21 @@ type APIKeyAuthGroupSnapshot struct {
 	DefaultMappedModel    string `json:"default_mapped_model,omitempty"`
 	// 非流式响应头控制
 	ForceApplicationJSONForNonStream bool `json:"force_application_json_for_non_stream"`
+	// 账号调度策略
+	SchedulingStrategy string `json:"scheduling_strategy,omitempty"`
+	// 流式请求对冲
+	HedgeEnabled bool `json:"hedge_enabled,omitempty"`
+	HedgeDelayMs int  `json:"hedge_delay_ms,omitempty"`
+	// 模型流量拆分规则（网关请求改写模型与选号使用）
+	TrafficSplitRules []model.TrafficSplitRule `json:"traffic_split_rules,omitempty"`
+	// 模型降级链（网关在过载或无可调度账号时使用）
+	ModelFallbackChains []model.ModelFallbackChain `json:"model_fallback_chains,omitempty"`
+	// 用量阶梯定价（计费时叠加折扣倍数）
+	VolumeTiers []model.VolumeTier `json:"volume_tiers,omitempty"`
+	// 可用时段与维护模式（网关入口拦截使用）
+	AvailabilitySchedule string `json:"availability_schedule,omitempty"`
+	MaintenanceMode      bool   `json:"maintenance_mode,omitempty"`
+	MaintenanceMessage   string `json:"maintenance_message,omitempty"`
 }
 
 // APIKeyAuthCacheEntry 缓存条目，支持负缓存
@@ -200,18 +200,19 @@ func (s *APIKeyService) snapshotFromAPIKey(apiKey *APIKey) *APIKeyAuthSnapshot {
 		return nil
 	}
 	snapshot := &APIKeyAuthSnapshot{
-		APIKeyID:    apiKey.ID,
-		UserID:      apiKey.UserID,
-		GroupID:     apiKey.GroupID,
-		Status:      apiKey.Status,
-		IPWhitelist: apiKey.IPWhitelist,
-		IPBlacklist: apiKey.IPBlacklist,
-		Quota:       apiKey.Quota,
-		QuotaUsed:   apiKey.QuotaUsed,
-		ExpiresAt:   apiKey.ExpiresAt,
-		RateLimit5h: apiKey.RateLimit5h,
-		RateLimit1d: apiKey.RateLimit1d,
-		RateLimit7d: apiKey.RateLimit7d,
+		APIKeyID:      apiKey.ID,
+		UserID:        apiKey.UserID,
+		GroupID:       apiKey.GroupID,
+		Status:        apiKey.Status,
+		IPWhitelist:   apiKey.IPWhitelist,
+		IPBlacklist:   apiKey.IPBlacklist,
+		Quota:         apiKey.Quota,
+		QuotaUsed:     apiKey.QuotaUsed,
+		ExpiresAt:     apiKey.ExpiresAt,
+		RateLimit5h:   apiKey.RateLimit5h,
+		RateLimit1d:   apiKey.RateLimit1d,
+		RateLimit7d:   apiKey.RateLimit7d,
+		PriorityClass: apiKey.PriorityClass,
 		User: APIKeyAuthUserSnapshot{
 			ID:          apiKey.User.ID,
 			Status:      apiKey.User.Status,
@@ -249,6 +250,15 @@ func (s *APIKeyService) snapshotFromAPIKey(apiKey *APIKey) *APIKeyAuthSnapshot {
 			AllowMessagesDispatch:            apiKey.Group.AllowMessagesDispatch,
 			DefaultMappedModel:               apiKey.Group.DefaultMappedModel,
 			ForceApplicationJSONForNonStream: apiKey.Group.ForceApplicationJSONForNonStream,
+			SchedulingStrategy:               apiKey.Group.SchedulingStrategy,
+			HedgeEnabled:                     apiKey.Group.HedgeEnabled,
+			HedgeDelayMs:                     apiKey.Group.HedgeDelayMs,
+			TrafficSplitRules:                apiKey.Group.TrafficSplitRules,
+			ModelFallbackChains:              apiKey.Group.ModelFallbackChains,
+			VolumeTiers:                      apiKey.Group.VolumeTiers,
+			AvailabilitySchedule:             apiKey.Group.AvailabilitySchedule,
+			MaintenanceMode:                  apiKey.Group.MaintenanceMode,
+			MaintenanceMessage:               apiKey.Group.MaintenanceMessage,
 		}
 	}
 	return snapshot
@@ -259,19 +269,20 @@ func (s *APIKeyService) snapshotToAPIKey(key string, snapshot *APIKeyAuthSnapsho
 		return nil
 	}
 	apiKey := &APIKey{
-		ID:          snapshot.APIKeyID,
-		UserID:      snapshot.UserID,
-		GroupID:     snapshot.GroupID,
-		Key:         key,
-		Status:      snapshot.Status,
-		IPWhitelist: snapshot.IPWhitelist,
-		IPBlacklist: snapshot.IPBlacklist,
-		Quota:       snapshot.Quota,
-		QuotaUsed:   snapshot.QuotaUsed,
-		ExpiresAt:   snapshot.ExpiresAt,
-		RateLimit5h: snapshot.RateLimit5h,
-		RateLimit1d: snapshot.RateLimit1d,
-		RateLimit7d: snapshot.RateLimit7d,
+		ID:            snapshot.APIKeyID,
+		UserID:        snapshot.UserID,
+		GroupID:       snapshot.GroupID,
+		Key:           key,
+		Status:        snapshot.Status,
+		IPWhitelist:   snapshot.IPWhitelist,
+		IPBlacklist:   snapshot.IPBlacklist,
+		Quota:         snapshot.Quota,
+		QuotaUsed:     snapshot.QuotaUsed,
+		ExpiresAt:     snapshot.ExpiresAt,
+		RateLimit5h:   snapshot.RateLimit5h,
+		RateLimit1d:   snapshot.RateLimit1d,
+		RateLimit7d:   snapshot.RateLimit7d,
+		PriorityClass: snapshot.PriorityClass,
 		User: &User{
 			ID:          snapshot.User.ID,
 			Status:      snapshot.User.Status,
@@ -310,6 +321,15 @@ func (s *APIKeyService) snapshotToAPIKey(key string, snapshot *APIKeyAuthSnapsho
 			AllowMessagesDispatch:            snapshot.Group.AllowMessagesDispatch,
 			DefaultMappedModel:               snapshot.Group.DefaultMappedModel,
 			ForceApplicationJSONForNonStream: snapshot.Group.ForceApplicationJSONForNonStream,
+			SchedulingStrategy:               snapshot.Group.SchedulingStrategy,
+			HedgeEnabled:                     snapshot.Group.HedgeEnabled,
+			HedgeDelayMs:                     snapshot.Group.HedgeDelayMs,
+			TrafficSplitRules:                snapshot.Group.TrafficSplitRules,
+			ModelFallbackChains:              snapshot.Group.ModelFallbackChains,
+			VolumeTiers:                      snapshot.Group.VolumeTiers,
+			AvailabilitySchedule:             snapshot.Group.AvailabilitySchedule,
+			MaintenanceMode:                  snapshot.Group.MaintenanceMode,
+			MaintenanceMessage:               snapshot.Group.MaintenanceMessage,
 		}
 	}
 	s.compileAPIKeyIPRules(apiKey)
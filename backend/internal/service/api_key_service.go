@@ -26,6 +26,7 @@ var (
 	ErrAPIKeyTooShort       = infraerrors.BadRequest("API_KEY_TOO_SHORT", "api key must be at least 16 characters")
 	ErrAPIKeyInvalidChars   = infraerrors.BadRequest("API_KEY_INVALID_CHARS", "api key can only contain letters, numbers, underscores, and hyphens")
 	ErrAPIKeyRateLimited    = infraerrors.TooManyRequests("API_KEY_RATE_LIMITED", "too many failed attempts, please try again later")
+	ErrAPIKeyReservedPrefix = infraerrors.BadRequest("API_KEY_RESERVED_PREFIX", "api key must not use the reserved sk-eph- prefix")
 	ErrInvalidIPPattern     = infraerrors.BadRequest("INVALID_IP_PATTERN", "invalid IP or CIDR pattern")
 	ErrNoAPIKeysSelected    = infraerrors.BadRequest("NO_API_KEYS_SELECTED", "no api keys selected")
 	ErrInvalidAPIKeyID      = infraerrors.BadRequest("INVALID_API_KEY_ID", "api key id must be positive")
@@ -41,6 +42,7 @@ var (
 	ErrAPIKeyExpired = infraerrors.Forbidden("API_KEY_EXPIRED", "api key 已过期")
 	// ErrAPIKeyQuotaExhausted = infraerrors.TooManyRequests("API_KEY_QUOTA_EXHAUSTED", "api key quota exhausted")
 	ErrAPIKeyQuotaExhausted = infraerrors.TooManyRequests("API_KEY_QUOTA_EXHAUSTED", "api key 额度已用完")
+	ErrAPIKeySuspended      = infraerrors.Forbidden("API_KEY_SUSPENDED", "api key 因账单逾期已暂停，结清后自动恢复")
 
 	// Rate limit errors
 	ErrAPIKeyRateLimit5hExceeded = infraerrors.TooManyRequests("API_KEY_RATE_5H_EXCEEDED", "api key 5小时限额已用完")
@@ -175,6 +177,9 @@ type CreateAPIKeyRequest struct {
 	RateLimit5h float64 `json:"rate_limit_5h"`
 	RateLimit1d float64 `json:"rate_limit_1d"`
 	RateLimit7d float64 `json:"rate_limit_7d"`
+
+	// PriorityClass 请求优先级分类（空 = standard）
+	PriorityClass string `json:"priority_class"`
 }
 
 // UpdateAPIKeyRequest 更新API Key请求
@@ -196,6 +201,9 @@ type UpdateAPIKeyRequest struct {
 	RateLimit1d         *float64 `json:"rate_limit_1d"`
 	RateLimit7d         *float64 `json:"rate_limit_7d"`
 	ResetRateLimitUsage *bool    `json:"reset_rate_limit_usage"` // Reset all usage counters to 0
+
+	// PriorityClass 请求优先级分类（nil = 不修改）
+	PriorityClass *string `json:"priority_class"`
 }
 
 // APIKeyService API Key服务
@@ -212,12 +220,14 @@ type APIKeyService struct {
 	userGroupRateRepo     UserGroupRateRepository
 	cache                 APIKeyCache
 	rateLimitCacheInvalid RateLimitCacheInvalidator // optional: invalidate Redis rate limit cache
+	ephemeralCache        EphemeralKeyCache         // optional: sub-key rpm/spend counters
 	cfg                   *config.Config
 	authCacheL1           *ristretto.Cache
 	authCfg               apiKeyAuthCacheConfig
 	authGroup             singleflight.Group
 	lastUsedTouchL1       sync.Map // keyID -> nextAllowedAt(time.Time)
 	lastUsedTouchSF       singleflight.Group
+	creditLimits          CreditLimitProvider
 }
 
 // NewAPIKeyService 创建API Key服务实例
@@ -239,10 +249,28 @@ func NewAPIKeyService(
 		cache:             cache,
 		cfg:               cfg,
 	}
+	if ephemeralCache, ok := cache.(EphemeralKeyCache); ok {
+		svc.ephemeralCache = ephemeralCache
+	}
 	svc.initAuthCache(cfg)
 	return svc
 }
 
+// SetCreditLimitProvider sets the optional postpaid credit limit provider.
+// Called after construction (e.g. in wire) to avoid circular dependencies.
+func (s *APIKeyService) SetCreditLimitProvider(p CreditLimitProvider) {
+	s.creditLimits = p
+}
+
+// IsBalanceExhausted 判断用户余额是否已不足以发起按余额计费的请求（后付费用户可透支至信用额度）
+func (s *APIKeyService) IsBalanceExhausted(user *User) bool {
+	var limit float64
+	if s.creditLimits != nil {
+		limit = s.creditLimits.CreditLimit(user.ID)
+	}
+	return balanceExhausted(user.Balance, limit)
+}
+
 // SetRateLimitCacheInvalidator sets the optional rate limit cache invalidator.
 // Called after construction (e.g. in wire) to avoid circular dependencies.
 func (s *APIKeyService) SetRateLimitCacheInvalidator(inv RateLimitCacheInvalidator) {
@@ -293,6 +321,11 @@ func (s *APIKeyService) ValidateCustomKey(key string) error {
 		return ErrAPIKeyInvalidChars
 	}
 
+	// 临时子密钥前缀保留，避免与普通 Key 混淆
+	if IsEphemeralKey(key) {
+		return ErrAPIKeyReservedPrefix
+	}
+
 	return nil
 }
 
@@ -359,6 +392,10 @@ func (s *APIKeyService) Create(ctx context.Context, userID int64, req CreateAPIK
 		}
 	}
 
+	if !IsValidPriorityClass(req.PriorityClass) {
+		return nil, ErrInvalidPriorityClass
+	}
+
 	// 验证分组权限（如果指定了分组）
 	if req.GroupID != nil {
 		group, err := s.groupRepo.GetByID(ctx, *req.GroupID)
@@ -409,18 +446,19 @@ func (s *APIKeyService) Create(ctx context.Context, userID int64, req CreateAPIK
 
 	// 创建API Key记录
 	apiKey := &APIKey{
-		UserID:      userID,
-		Key:         key,
-		Name:        req.Name,
-		GroupID:     req.GroupID,
-		Status:      StatusActive,
-		IPWhitelist: req.IPWhitelist,
-		IPBlacklist: req.IPBlacklist,
-		Quota:       req.Quota,
-		QuotaUsed:   0,
-		RateLimit5h: req.RateLimit5h,
-		RateLimit1d: req.RateLimit1d,
-		RateLimit7d: req.RateLimit7d,
+		UserID:        userID,
+		Key:           key,
+		Name:          req.Name,
+		GroupID:       req.GroupID,
+		Status:        StatusActive,
+		IPWhitelist:   req.IPWhitelist,
+		IPBlacklist:   req.IPBlacklist,
+		Quota:         req.Quota,
+		QuotaUsed:     0,
+		RateLimit5h:   req.RateLimit5h,
+		RateLimit1d:   req.RateLimit1d,
+		RateLimit7d:   req.RateLimit7d,
+		PriorityClass: req.PriorityClass,
 	}
 
 	// Set expiration time if specified
@@ -620,6 +658,10 @@ func (s *APIKeyService) Update(ctx context.Context, id int64, userID int64, req
 		}
 	}
 
+	if req.PriorityClass != nil && !IsValidPriorityClass(*req.PriorityClass) {
+		return nil, ErrInvalidPriorityClass
+	}
+
 	// 更新字段
 	if req.Name != nil {
 		apiKey.Name = *req.Name
@@ -645,6 +687,10 @@ func (s *APIKeyService) Update(ctx context.Context, id int64, userID int64, req
 	}
 
 	if req.Status != nil {
+		// 账单逾期暂停的 Key 只能由结清账单恢复
+		if apiKey.Status == StatusAPIKeySuspended && *req.Status != StatusAPIKeySuspended {
+			return nil, ErrAPIKeySuspended
+		}
 		apiKey.Status = *req.Status
 		// 如果状态改变，清除Redis缓存
 		if s.cache != nil {
@@ -695,6 +741,9 @@ func (s *APIKeyService) Update(ctx context.Context, id int64, userID int64, req
 	if req.RateLimit7d != nil {
 		apiKey.RateLimit7d = *req.RateLimit7d
 	}
+	if req.PriorityClass != nil {
+		apiKey.PriorityClass = *req.PriorityClass
+	}
 	resetRateLimit := req.ResetRateLimitUsage != nil && *req.ResetRateLimitUsage
 	if resetRateLimit {
 		apiKey.Usage5h = 0
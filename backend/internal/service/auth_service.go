@@ -55,6 +55,7 @@ type JWTClaims struct {
 	Email        string `json:"email"`
 	Role         string `json:"role"`
 	TokenVersion int64  `json:"token_version"` // Used to invalidate tokens on password change
+	SessionID    string `json:"sid,omitempty"` // 登录会话（Refresh Token 家族）ID，用于标记当前会话
 	jwt.RegisteredClaims
 }
 
@@ -883,6 +884,11 @@ func isReservedEmail(email string) bool {
 // GenerateToken 生成JWT access token
 // 使用新的access_token_expire_minutes配置项（如果配置了），否则回退到expire_hour
 func (s *AuthService) GenerateToken(user *User) (string, error) {
+	return s.generateToken(user, "")
+}
+
+// generateToken 生成JWT access token，sessionID 非空时写入 sid 声明
+func (s *AuthService) generateToken(user *User, sessionID string) (string, error) {
 	now := time.Now()
 	var expiresAt time.Time
 	if s.cfg.JWT.AccessTokenExpireMinutes > 0 {
@@ -897,6 +903,7 @@ func (s *AuthService) GenerateToken(user *User) (string, error) {
 		Email:        user.Email,
 		Role:         user.Role,
 		TokenVersion: user.TokenVersion,
+		SessionID:    sessionID,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(expiresAt),
 			IssuedAt:  jwt.NewNumericDate(now),
@@ -1145,8 +1152,18 @@ func (s *AuthService) GenerateTokenPair(ctx context.Context, user *User, familyI
 		return nil, errors.New("refresh token cache not configured")
 	}
 
-	// 生成Access Token
-	accessToken, err := s.GenerateToken(user)
+	// 如果没有提供familyID，说明是一次新的登录，生成新的会话家族
+	newSession := familyID == ""
+	if newSession {
+		familyBytes := make([]byte, 16)
+		if _, err := rand.Read(familyBytes); err != nil {
+			return nil, fmt.Errorf("generate family id: %w", err)
+		}
+		familyID = hex.EncodeToString(familyBytes)
+	}
+
+	// 生成Access Token（携带会话ID，用于标记当前会话）
+	accessToken, err := s.generateToken(user, familyID)
 	if err != nil {
 		return nil, fmt.Errorf("generate access token: %w", err)
 	}
@@ -1157,6 +1174,10 @@ func (s *AuthService) GenerateTokenPair(ctx context.Context, user *User, familyI
 		return nil, fmt.Errorf("generate refresh token: %w", err)
 	}
 
+	if newSession {
+		s.checkNewDeviceLogin(ctx, user)
+	}
+
 	return &TokenPair{
 		AccessToken:  accessToken,
 		RefreshToken: refreshToken,
@@ -1176,24 +1197,31 @@ func (s *AuthService) generateRefreshToken(ctx context.Context, user *User, fami
 	// 计算Token哈希（存储哈希而非原始Token）
 	tokenHash := hashToken(rawToken)
 
-	// 如果没有提供familyID，生成新的
-	if familyID == "" {
-		familyBytes := make([]byte, 16)
-		if _, err := rand.Read(familyBytes); err != nil {
-			return "", fmt.Errorf("generate family id: %w", err)
-		}
-		familyID = hex.EncodeToString(familyBytes)
-	}
-
 	now := time.Now()
 	ttl := time.Duration(s.cfg.JWT.RefreshTokenExpireDays) * 24 * time.Hour
 
 	data := &RefreshTokenData{
-		UserID:       user.ID,
-		TokenVersion: user.TokenVersion,
-		FamilyID:     familyID,
-		CreatedAt:    now,
-		ExpiresAt:    now.Add(ttl),
+		UserID:           user.ID,
+		TokenVersion:     user.TokenVersion,
+		FamilyID:         familyID,
+		CreatedAt:        now,
+		ExpiresAt:        now.Add(ttl),
+		SessionCreatedAt: now,
+	}
+	// Token轮转时沿用会话的首次登录时间
+	if prev, ok := rotatedSessionFromContext(ctx); ok && prev.FamilyID == familyID {
+		data.SessionCreatedAt = prev.sessionStartedAt()
+		data.UserAgent = prev.UserAgent
+		data.IPAddress = prev.IPAddress
+	}
+	// 记录当前客户端信息（设备/IP），用于会话列表展示
+	if client, ok := SessionClientFromContext(ctx); ok {
+		if client.UserAgent != "" {
+			data.UserAgent = client.UserAgent
+		}
+		if client.IPAddress != "" {
+			data.IPAddress = client.IPAddress
+		}
 	}
 
 	// 存储Token数据
@@ -1283,7 +1311,7 @@ func (s *AuthService) RefreshTokenPair(ctx context.Context, refreshToken string)
 	}
 
 	// 生成新的Token对，保持同一个家族ID
-	pair, err := s.GenerateTokenPair(ctx, user, data.FamilyID)
+	pair, err := s.GenerateTokenPair(withRotatedSession(ctx, data), user, data.FamilyID)
 	if err != nil {
 		return nil, err
 	}
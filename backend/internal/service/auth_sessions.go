@@ -0,0 +1,267 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"sort"
+	"strings"
+	"time"
+
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/logger"
+)
+
+// ErrSessionNotFound 会话不存在或不属于当前用户
+var ErrSessionNotFound = infraerrors.NotFound("SESSION_NOT_FOUND", "session not found")
+
+const (
+	// knownDeviceTTL 已知设备指纹的保留时间，超过后再次登录会被视为新设备
+	knownDeviceTTL = 180 * 24 * time.Hour
+	// newDeviceEmailTimeout 新设备登录提醒邮件的发送超时
+	newDeviceEmailTimeout = 30 * time.Second
+)
+
+// SessionClientInfo 登录/刷新时的客户端信息
+type SessionClientInfo struct {
+	UserAgent string
+	IPAddress string
+}
+
+type sessionClientContextKey struct{}
+
+type rotatedSessionContextKey struct{}
+
+// WithSessionClient 将客户端信息写入 context，供 Refresh Token 记录设备信息
+func WithSessionClient(ctx context.Context, info SessionClientInfo) context.Context {
+	return context.WithValue(ctx, sessionClientContextKey{}, info)
+}
+
+// SessionClientFromContext 从 context 读取客户端信息
+func SessionClientFromContext(ctx context.Context) (SessionClientInfo, bool) {
+	if ctx == nil {
+		return SessionClientInfo{}, false
+	}
+	info, ok := ctx.Value(sessionClientContextKey{}).(SessionClientInfo)
+	return info, ok
+}
+
+// withRotatedSession 在 Token 轮转时传递旧 Token 数据，以沿用会话信息
+func withRotatedSession(ctx context.Context, data *RefreshTokenData) context.Context {
+	if data == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, rotatedSessionContextKey{}, data)
+}
+
+func rotatedSessionFromContext(ctx context.Context) (*RefreshTokenData, bool) {
+	data, ok := ctx.Value(rotatedSessionContextKey{}).(*RefreshTokenData)
+	return data, ok && data != nil
+}
+
+// sessionStartedAt 返回会话首次登录时间（兼容未记录该字段的旧 Token）
+func (d *RefreshTokenData) sessionStartedAt() time.Time {
+	if d.SessionCreatedAt.IsZero() {
+		return d.CreatedAt
+	}
+	return d.SessionCreatedAt
+}
+
+// UserSession 用户登录会话（一个 Refresh Token 家族）
+type UserSession struct {
+	ID            string    `json:"id"`
+	UserAgent     string    `json:"user_agent"`
+	IPAddress     string    `json:"ip_address"`
+	CreatedAt     time.Time `json:"created_at"`
+	LastRefreshAt time.Time `json:"last_refresh_at"`
+	ExpiresAt     time.Time `json:"expires_at"`
+	Current       bool      `json:"current"`
+}
+
+// ListUserSessions 列出用户当前有效的登录会话
+// currentSessionID 为当前请求所属会话，用于标记 Current
+func (s *AuthService) ListUserSessions(ctx context.Context, userID int64, currentSessionID string) ([]UserSession, error) {
+	if s.refreshTokenCache == nil {
+		return []UserSession{}, nil
+	}
+	hashes, err := s.refreshTokenCache.GetUserTokenHashes(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("get user token hashes: %w", err)
+	}
+
+	now := time.Now()
+	byFamily := make(map[string]*UserSession, len(hashes))
+	for _, hash := range hashes {
+		data, err := s.refreshTokenCache.GetRefreshToken(ctx, hash)
+		if err != nil {
+			if errors.Is(err, ErrRefreshTokenNotFound) {
+				// 已轮转或已过期的 Token
+				continue
+			}
+			return nil, fmt.Errorf("get refresh token: %w", err)
+		}
+		if data.UserID != userID || data.FamilyID == "" || now.After(data.ExpiresAt) {
+			continue
+		}
+		session, ok := byFamily[data.FamilyID]
+		if ok && !data.CreatedAt.After(session.LastRefreshAt) {
+			continue
+		}
+		if !ok {
+			session = &UserSession{ID: data.FamilyID}
+			byFamily[data.FamilyID] = session
+		}
+		session.UserAgent = data.UserAgent
+		session.IPAddress = data.IPAddress
+		session.CreatedAt = data.sessionStartedAt()
+		session.LastRefreshAt = data.CreatedAt
+		session.ExpiresAt = data.ExpiresAt
+		session.Current = currentSessionID != "" && data.FamilyID == currentSessionID
+	}
+
+	sessions := make([]UserSession, 0, len(byFamily))
+	for _, session := range byFamily {
+		sessions = append(sessions, *session)
+	}
+	sort.Slice(sessions, func(i, j int) bool {
+		if sessions[i].Current != sessions[j].Current {
+			return sessions[i].Current
+		}
+		return sessions[i].LastRefreshAt.After(sessions[j].LastRefreshAt)
+	})
+	return sessions, nil
+}
+
+// RevokeUserSession 撤销用户的单个登录会话（整个 Refresh Token 家族）
+func (s *AuthService) RevokeUserSession(ctx context.Context, userID int64, sessionID string) error {
+	sessionID = strings.TrimSpace(sessionID)
+	if s.refreshTokenCache == nil || sessionID == "" {
+		return ErrSessionNotFound
+	}
+
+	hashes, err := s.refreshTokenCache.GetFamilyTokenHashes(ctx, sessionID)
+	if err != nil {
+		return fmt.Errorf("get family token hashes: %w", err)
+	}
+	owned := false
+	for _, hash := range hashes {
+		data, err := s.refreshTokenCache.GetRefreshToken(ctx, hash)
+		if err != nil {
+			if errors.Is(err, ErrRefreshTokenNotFound) {
+				continue
+			}
+			return fmt.Errorf("get refresh token: %w", err)
+		}
+		if data.UserID != userID {
+			return ErrSessionNotFound
+		}
+		owned = true
+	}
+	if !owned {
+		return ErrSessionNotFound
+	}
+	return s.refreshTokenCache.DeleteTokenFamily(ctx, sessionID)
+}
+
+// IsSessionActive 检查 Access Token 所属会话是否仍然有效
+// 会话被撤销、登出或全部下线后，其 Refresh Token 家族中不再有存活的 Token，
+// 此时即使 Access Token 尚未过期也应拒绝。缓存不可用时放行，避免 Redis 故障导致全部用户掉线。
+func (s *AuthService) IsSessionActive(ctx context.Context, sessionID string) bool {
+	if s.refreshTokenCache == nil || sessionID == "" {
+		return true
+	}
+	active, err := s.refreshTokenCache.HasActiveFamilyToken(ctx, sessionID)
+	if err != nil {
+		logger.LegacyPrintf("service.auth", "[Auth] Failed to check session %s: %v", sessionID, err)
+		return true
+	}
+	return active
+}
+
+// deviceFingerprint 登录设备指纹：仅使用 User-Agent，IP 变化（移动网络、VPN）不视为新设备
+func deviceFingerprint(client SessionClientInfo) string {
+	return hashToken(strings.ToLower(strings.TrimSpace(client.UserAgent)))
+}
+
+// checkNewDeviceLogin 记录登录设备，若为新设备则异步发送登录提醒邮件
+// 首次登录（此前没有任何已知设备）不发送提醒
+func (s *AuthService) checkNewDeviceLogin(ctx context.Context, user *User) {
+	client, ok := SessionClientFromContext(ctx)
+	if !ok || user == nil || s.refreshTokenCache == nil {
+		return
+	}
+	isNew, hadDevices, err := s.refreshTokenCache.MarkKnownDevice(ctx, user.ID, deviceFingerprint(client), knownDeviceTTL)
+	if err != nil {
+		logger.LegacyPrintf("service.auth", "[Auth] Failed to record login device for user %d: %v", user.ID, err)
+		return
+	}
+	if !isNew || !hadDevices || s.emailService == nil || user.Email == "" || isReservedEmail(user.Email) {
+		return
+	}
+
+	siteName := "Sub2API"
+	if s.settingService != nil {
+		siteName = s.settingService.GetSiteName(ctx)
+	}
+	email := user.Email
+	loginAt := time.Now()
+	go func() {
+		sendCtx, cancel := context.WithTimeout(context.Background(), newDeviceEmailTimeout)
+		defer cancel()
+		subject := fmt.Sprintf("[%s] 新设备登录提醒 / New Device Login", sanitizeEmailHeader(siteName))
+		body := buildNewDeviceLoginEmailBody(html.EscapeString(siteName), client, loginAt)
+		if err := s.emailService.SendEmail(sendCtx, email, subject, body); err != nil {
+			logger.LegacyPrintf("service.auth", "[Auth] Failed to send new device login email to %s: %v", email, err)
+		}
+	}()
+}
+
+// buildNewDeviceLoginEmailBody 构建新设备登录提醒邮件内容
+func buildNewDeviceLoginEmailBody(siteName string, client SessionClientInfo, loginAt time.Time) string {
+	userAgent := client.UserAgent
+	if userAgent == "" {
+		userAgent = "-"
+	}
+	ipAddress := client.IPAddress
+	if ipAddress == "" {
+		ipAddress = "-"
+	}
+	return fmt.Sprintf(`
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <style>
+        body { font-family: -apple-system, BlinkMacSystemFont, 'Segoe UI', Roboto, Oxygen, Ubuntu, sans-serif; background-color: #f5f5f5; margin: 0; padding: 20px; }
+        .container { max-width: 600px; margin: 0 auto; background-color: #ffffff; border-radius: 8px; overflow: hidden; box-shadow: 0 2px 8px rgba(0,0,0,0.1); }
+        .header { background: linear-gradient(135deg, #667eea 0%%, #764ba2 100%%); color: white; padding: 30px; text-align: center; }
+        .header h1 { margin: 0; font-size: 24px; }
+        .content { padding: 30px; color: #333; }
+        .info { background-color: #f8f9fa; border-radius: 4px; padding: 15px; font-size: 14px; line-height: 1.8; word-break: break-all; }
+        .warning { color: #e74c3c; font-size: 14px; margin-top: 20px; }
+        .footer { background-color: #f8f9fa; padding: 20px; text-align: center; color: #999; font-size: 12px; }
+    </style>
+</head>
+<body>
+    <div class="container">
+        <div class="header">
+            <h1>%s</h1>
+        </div>
+        <div class="content">
+            <p>您的账号刚刚在一台新设备上登录。 / Your account was just signed in from a new device.</p>
+            <div class="info">
+                时间 / Time: %s<br>
+                IP: %s<br>
+                设备 / Device: %s
+            </div>
+            <p class="warning">如果这不是您本人的操作，请立即修改密码，并在个人设置中撤销该会话。<br>If this wasn't you, change your password and revoke the session from your profile settings.</p>
+        </div>
+        <div class="footer">
+            <p>这是一封自动发送的邮件，请勿回复。</p>
+        </div>
+    </div>
+</body>
+</html>
+`, siteName, loginAt.UTC().Format(time.RFC3339), html.EscapeString(ipAddress), html.EscapeString(userAgent))
+}
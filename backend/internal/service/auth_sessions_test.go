@@ -0,0 +1,272 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/stretchr/testify/require"
+)
+
+type memoryRefreshTokenCache struct {
+	mu       sync.Mutex
+	tokens   map[string]*RefreshTokenData
+	users    map[int64]map[string]struct{}
+	families map[string]map[string]struct{}
+	devices  map[int64]map[string]struct{}
+}
+
+func newMemoryRefreshTokenCache() *memoryRefreshTokenCache {
+	return &memoryRefreshTokenCache{
+		tokens:   map[string]*RefreshTokenData{},
+		users:    map[int64]map[string]struct{}{},
+		families: map[string]map[string]struct{}{},
+		devices:  map[int64]map[string]struct{}{},
+	}
+}
+
+func addToSet[K comparable](sets map[K]map[string]struct{}, key K, member string) {
+	if sets[key] == nil {
+		sets[key] = map[string]struct{}{}
+	}
+	sets[key][member] = struct{}{}
+}
+
+func setMembers(set map[string]struct{}) []string {
+	out := make([]string, 0, len(set))
+	for member := range set {
+		out = append(out, member)
+	}
+	return out
+}
+
+func (c *memoryRefreshTokenCache) StoreRefreshToken(_ context.Context, tokenHash string, data *RefreshTokenData, _ time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	copied := *data
+	c.tokens[tokenHash] = &copied
+	return nil
+}
+
+func (c *memoryRefreshTokenCache) GetRefreshToken(_ context.Context, tokenHash string) (*RefreshTokenData, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	data, ok := c.tokens[tokenHash]
+	if !ok {
+		return nil, ErrRefreshTokenNotFound
+	}
+	copied := *data
+	return &copied, nil
+}
+
+func (c *memoryRefreshTokenCache) DeleteRefreshToken(_ context.Context, tokenHash string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.tokens, tokenHash)
+	return nil
+}
+
+func (c *memoryRefreshTokenCache) DeleteUserRefreshTokens(_ context.Context, userID int64) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for hash := range c.users[userID] {
+		delete(c.tokens, hash)
+	}
+	delete(c.users, userID)
+	return nil
+}
+
+func (c *memoryRefreshTokenCache) DeleteTokenFamily(_ context.Context, familyID string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for hash := range c.families[familyID] {
+		delete(c.tokens, hash)
+	}
+	delete(c.families, familyID)
+	return nil
+}
+
+func (c *memoryRefreshTokenCache) AddToUserTokenSet(_ context.Context, userID int64, tokenHash string, _ time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	addToSet(c.users, userID, tokenHash)
+	return nil
+}
+
+func (c *memoryRefreshTokenCache) AddToFamilyTokenSet(_ context.Context, familyID string, tokenHash string, _ time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	addToSet(c.families, familyID, tokenHash)
+	return nil
+}
+
+func (c *memoryRefreshTokenCache) GetUserTokenHashes(_ context.Context, userID int64) ([]string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return setMembers(c.users[userID]), nil
+}
+
+func (c *memoryRefreshTokenCache) GetFamilyTokenHashes(_ context.Context, familyID string) ([]string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return setMembers(c.families[familyID]), nil
+}
+
+func (c *memoryRefreshTokenCache) IsTokenInFamily(_ context.Context, familyID string, tokenHash string) (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	_, ok := c.families[familyID][tokenHash]
+	return ok, nil
+}
+
+func (c *memoryRefreshTokenCache) HasActiveFamilyToken(_ context.Context, familyID string) (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for hash := range c.families[familyID] {
+		if _, ok := c.tokens[hash]; ok {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (c *memoryRefreshTokenCache) MarkKnownDevice(_ context.Context, userID int64, fingerprint string, _ time.Duration) (bool, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	hadDevices := len(c.devices[userID]) > 0
+	_, exists := c.devices[userID][fingerprint]
+	addToSet(c.devices, userID, fingerprint)
+	return !exists, hadDevices, nil
+}
+
+func newSessionTestAuthService(cache RefreshTokenCache, users ...*User) *AuthService {
+	cfg := &config.Config{}
+	cfg.JWT.Secret = "test-secret"
+	cfg.JWT.AccessTokenExpireMinutes = 15
+	cfg.JWT.RefreshTokenExpireDays = 7
+	repo := &userRepoStub{}
+	if len(users) > 0 {
+		repo.user = users[0]
+	}
+	return NewAuthService(nil, repo, nil, cache, cfg, nil, nil, nil, nil, nil, nil)
+}
+
+func TestAuthService_ListUserSessions_TracksDeviceAndCurrent(t *testing.T) {
+	cache := newMemoryRefreshTokenCache()
+	user := &User{ID: 7, Email: "user@example.com", Status: StatusActive}
+	svc := newSessionTestAuthService(cache, user)
+
+	laptopCtx := WithSessionClient(context.Background(), SessionClientInfo{UserAgent: "laptop", IPAddress: "10.0.0.1"})
+	laptop, err := svc.GenerateTokenPair(laptopCtx, user, "")
+	require.NoError(t, err)
+	phoneCtx := WithSessionClient(context.Background(), SessionClientInfo{UserAgent: "phone", IPAddress: "10.0.0.2"})
+	_, err = svc.GenerateTokenPair(phoneCtx, user, "")
+	require.NoError(t, err)
+
+	claims, err := svc.ValidateToken(laptop.AccessToken)
+	require.NoError(t, err)
+	require.NotEmpty(t, claims.SessionID)
+
+	// 刷新后会话数量不变，且保留首次登录时间
+	refreshed, err := svc.RefreshTokenPair(laptopCtx, laptop.RefreshToken)
+	require.NoError(t, err)
+	refreshedClaims, err := svc.ValidateToken(refreshed.AccessToken)
+	require.NoError(t, err)
+	require.Equal(t, claims.SessionID, refreshedClaims.SessionID)
+
+	sessions, err := svc.ListUserSessions(context.Background(), user.ID, claims.SessionID)
+	require.NoError(t, err)
+	require.Len(t, sessions, 2)
+	require.True(t, sessions[0].Current)
+	require.Equal(t, claims.SessionID, sessions[0].ID)
+	require.Equal(t, "laptop", sessions[0].UserAgent)
+	require.Equal(t, "10.0.0.1", sessions[0].IPAddress)
+	require.False(t, sessions[0].CreatedAt.After(sessions[0].LastRefreshAt))
+	require.False(t, sessions[1].Current)
+	require.Equal(t, "phone", sessions[1].UserAgent)
+}
+
+func TestAuthService_RevokeUserSession(t *testing.T) {
+	cache := newMemoryRefreshTokenCache()
+	user := &User{ID: 7, Email: "user@example.com", Status: StatusActive}
+	svc := newSessionTestAuthService(cache, user)
+
+	pair, err := svc.GenerateTokenPair(context.Background(), user, "")
+	require.NoError(t, err)
+	claims, err := svc.ValidateToken(pair.AccessToken)
+	require.NoError(t, err)
+
+	// 其他用户不能撤销该会话
+	require.ErrorIs(t, svc.RevokeUserSession(context.Background(), 8, claims.SessionID), ErrSessionNotFound)
+	require.ErrorIs(t, svc.RevokeUserSession(context.Background(), user.ID, "missing"), ErrSessionNotFound)
+
+	require.NoError(t, svc.RevokeUserSession(context.Background(), user.ID, claims.SessionID))
+	sessions, err := svc.ListUserSessions(context.Background(), user.ID, "")
+	require.NoError(t, err)
+	require.Empty(t, sessions)
+
+	_, err = svc.RefreshTokenPair(context.Background(), pair.RefreshToken)
+	require.ErrorIs(t, err, ErrRefreshTokenInvalid)
+}
+
+func TestAuthService_IsSessionActive(t *testing.T) {
+	cache := newMemoryRefreshTokenCache()
+	user := &User{ID: 7, Email: "user@example.com", Status: StatusActive}
+	svc := newSessionTestAuthService(cache, user)
+
+	pair, err := svc.GenerateTokenPair(context.Background(), user, "")
+	require.NoError(t, err)
+	claims, err := svc.ValidateToken(pair.AccessToken)
+	require.NoError(t, err)
+	other, err := svc.GenerateTokenPair(context.Background(), user, "")
+	require.NoError(t, err)
+	otherClaims, err := svc.ValidateToken(other.AccessToken)
+	require.NoError(t, err)
+	require.True(t, svc.IsSessionActive(context.Background(), claims.SessionID))
+
+	// 刷新轮转后会话仍有效
+	_, err = svc.RefreshTokenPair(context.Background(), pair.RefreshToken)
+	require.NoError(t, err)
+	require.True(t, svc.IsSessionActive(context.Background(), claims.SessionID))
+
+	// 撤销后 Access Token 所属会话立即失效，其他会话不受影响
+	require.NoError(t, svc.RevokeUserSession(context.Background(), user.ID, claims.SessionID))
+	require.False(t, svc.IsSessionActive(context.Background(), claims.SessionID))
+	require.True(t, svc.IsSessionActive(context.Background(), otherClaims.SessionID))
+
+	// 登出（撤销当前 Refresh Token）同样使会话失效
+	require.NoError(t, svc.RevokeRefreshToken(context.Background(), other.RefreshToken))
+	require.False(t, svc.IsSessionActive(context.Background(), otherClaims.SessionID))
+
+	// 未配置缓存或旧 Token 没有会话ID 时放行
+	require.True(t, svc.IsSessionActive(context.Background(), ""))
+	require.True(t, newSessionTestAuthService(nil, user).IsSessionActive(context.Background(), claims.SessionID))
+}
+
+func TestAuthService_CheckNewDeviceLogin_RecordsDevices(t *testing.T) {
+	cache := newMemoryRefreshTokenCache()
+	user := &User{ID: 7, Email: "user@example.com", Status: StatusActive}
+	svc := newSessionTestAuthService(cache, user)
+
+	ctx := WithSessionClient(context.Background(), SessionClientInfo{UserAgent: "laptop", IPAddress: "10.0.0.1"})
+	_, err := svc.GenerateTokenPair(ctx, user, "")
+	require.NoError(t, err)
+	_, err = svc.GenerateTokenPair(ctx, user, "")
+	require.NoError(t, err)
+	require.Len(t, cache.devices[user.ID], 1)
+
+	// 同一设备换 IP 不视为新设备
+	roaming := WithSessionClient(context.Background(), SessionClientInfo{UserAgent: "laptop", IPAddress: "10.9.9.9"})
+	_, err = svc.GenerateTokenPair(roaming, user, "")
+	require.NoError(t, err)
+	require.Len(t, cache.devices[user.ID], 1)
+
+	other := WithSessionClient(context.Background(), SessionClientInfo{UserAgent: "phone", IPAddress: "10.0.0.2"})
+	_, err = svc.GenerateTokenPair(other, user, "")
+	require.NoError(t, err)
+	require.Len(t, cache.devices[user.ID], 2)
+}
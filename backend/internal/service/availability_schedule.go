@@ -0,0 +1,89 @@
+package service
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+	"github.com/robfig/cron/v3"
+)
+
+const (
+	// DefaultGroupMaintenanceMessage 分组维护模式下未配置提示信息时的默认提示
+	DefaultGroupMaintenanceMessage = "This group is under maintenance. Please try again later."
+	// DefaultGroupOffScheduleMessage 分组处于可用时段之外时的默认提示
+	DefaultGroupOffScheduleMessage = "This group is outside its availability schedule. Please try again later."
+)
+
+// ErrInvalidAvailabilitySchedule 可用时段 cron 表达式不合法
+var ErrInvalidAvailabilitySchedule = infraerrors.BadRequest("INVALID_AVAILABILITY_SCHEDULE", "invalid availability schedule")
+
+// availabilityScheduleCache 缓存已解析的可用时段表达式（IsSchedulable 处于调度热路径，避免重复解析）
+var availabilityScheduleCache sync.Map // map[string]cron.Schedule（解析失败存 nil）
+
+// ValidateAvailabilitySchedule 校验可用时段 cron 表达式（与定时测试计划使用同一解析器，支持 CRON_TZ= 前缀），空表达式合法
+func ValidateAvailabilitySchedule(expr string) error {
+	expr = strings.TrimSpace(expr)
+	if expr == "" {
+		return nil
+	}
+	if _, err := scheduledTestCronParser.Parse(expr); err != nil {
+		return infraerrors.BadRequest(ErrInvalidAvailabilitySchedule.Reason, "invalid availability schedule: "+err.Error())
+	}
+	return nil
+}
+
+// IsWithinAvailabilitySchedule 判断 t 所在的分钟是否命中可用时段表达式。
+// 例如 "* 0-7 * * *" 表示每天 0:00-7:59 可用。表达式为空或无法解析时视为全天可用。
+func IsWithinAvailabilitySchedule(expr string, t time.Time) bool {
+	expr = strings.TrimSpace(expr)
+	if expr == "" {
+		return true
+	}
+	sched := parseAvailabilitySchedule(expr)
+	if sched == nil {
+		return true
+	}
+	minute := t.Truncate(time.Minute)
+	return sched.Next(minute.Add(-time.Second)).Equal(minute)
+}
+
+func parseAvailabilitySchedule(expr string) cron.Schedule {
+	if cached, ok := availabilityScheduleCache.Load(expr); ok {
+		sched, _ := cached.(cron.Schedule)
+		return sched
+	}
+	sched, err := scheduledTestCronParser.Parse(expr)
+	if err != nil {
+		availabilityScheduleCache.Store(expr, nil)
+		return nil
+	}
+	availabilityScheduleCache.Store(expr, sched)
+	return sched
+}
+
+// IsAvailableAt 判断分组在 t 时是否处于可用时段
+func (g *Group) IsAvailableAt(t time.Time) bool {
+	return g == nil || IsWithinAvailabilitySchedule(g.AvailabilitySchedule, t)
+}
+
+// UnavailableMessage 返回分组当前不可用时的提示信息（维护模式或可用时段之外），可用时返回空
+func (g *Group) UnavailableMessage(now time.Time) string {
+	if g == nil {
+		return ""
+	}
+	if g.MaintenanceMode {
+		if msg := strings.TrimSpace(g.MaintenanceMessage); msg != "" {
+			return msg
+		}
+		return DefaultGroupMaintenanceMessage
+	}
+	if !g.IsAvailableAt(now) {
+		if msg := strings.TrimSpace(g.MaintenanceMessage); msg != "" {
+			return msg
+		}
+		return DefaultGroupOffScheduleMessage
+	}
+	return ""
+}
@@ -0,0 +1,83 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsWithinAvailabilitySchedule(t *testing.T) {
+	loc := time.FixedZone("UTC+8", 8*3600)
+	night := time.Date(2026, 3, 2, 3, 30, 45, 0, loc) // 周一 03:30
+	day := time.Date(2026, 3, 2, 14, 0, 0, 0, loc)    // 周一 14:00
+
+	require.True(t, IsWithinAvailabilitySchedule("", day), "空表达式全天可用")
+	require.True(t, IsWithinAvailabilitySchedule("not a cron", day), "无法解析时视为可用")
+
+	require.True(t, IsWithinAvailabilitySchedule("CRON_TZ=Asia/Shanghai * 0-7 * * *", night))
+	require.False(t, IsWithinAvailabilitySchedule("CRON_TZ=Asia/Shanghai * 0-7 * * *", day))
+
+	// 维护窗口之外可用：周一 14:00-14:59 维护
+	schedule := "CRON_TZ=Asia/Shanghai * 0-13,15-23 * * *"
+	require.False(t, IsWithinAvailabilitySchedule(schedule, day))
+	require.True(t, IsWithinAvailabilitySchedule(schedule, day.Add(time.Hour)))
+
+	// 仅工作日可用
+	require.False(t, IsWithinAvailabilitySchedule("CRON_TZ=Asia/Shanghai * * * * 1-5", day.AddDate(0, 0, -1)))
+}
+
+func TestValidateAvailabilitySchedule(t *testing.T) {
+	require.NoError(t, ValidateAvailabilitySchedule(""))
+	require.NoError(t, ValidateAvailabilitySchedule("CRON_TZ=America/New_York * 19-23 * * 1-5"))
+	require.ErrorIs(t, ValidateAvailabilitySchedule("* * *"), ErrInvalidAvailabilitySchedule)
+}
+
+func TestAccountIsSchedulable_AvailabilitySchedule(t *testing.T) {
+	now := time.Now()
+	hour := now.In(time.UTC).Hour()
+	account := &Account{Status: StatusActive, Schedulable: true}
+	account.AvailabilitySchedule = "CRON_TZ=UTC * " + strconv.Itoa(hour) + " * * *"
+	require.True(t, account.IsSchedulable())
+
+	account.AvailabilitySchedule = "CRON_TZ=UTC * " + strconv.Itoa((hour+12)%24) + " * * *"
+	require.False(t, account.IsSchedulable())
+}
+
+func TestGroupUnavailableMessage(t *testing.T) {
+	now := time.Date(2026, 3, 2, 14, 0, 0, 0, time.UTC)
+	require.Empty(t, (&Group{}).UnavailableMessage(now))
+	require.Equal(t, DefaultGroupMaintenanceMessage, (&Group{MaintenanceMode: true}).UnavailableMessage(now))
+	require.Equal(t, "back soon", (&Group{MaintenanceMode: true, MaintenanceMessage: " back soon "}).UnavailableMessage(now))
+	require.Equal(t, DefaultGroupOffScheduleMessage, (&Group{AvailabilitySchedule: "CRON_TZ=UTC * 0-7 * * *"}).UnavailableMessage(now))
+}
+
+func TestSchedulerSnapshotFilterByAvailabilitySchedule(t *testing.T) {
+	night := time.Date(2026, 3, 2, 3, 0, 0, 0, time.UTC)
+	day := time.Date(2026, 3, 2, 14, 0, 0, 0, time.UTC)
+	nightOnly := "CRON_TZ=UTC * 0-7 * * *"
+
+	svc := &SchedulerSnapshotService{groupRepo: &groupRepoStubForModelFallback{groups: map[int64]*Group{
+		1: {ID: 1},
+		2: {ID: 2, AvailabilitySchedule: nightOnly},
+	}}}
+	accounts := []Account{{ID: 10}, {ID: 11, AvailabilitySchedule: nightOnly}}
+
+	filtered := svc.filterByAvailabilitySchedule(context.Background(), SchedulerBucket{GroupID: 1}, accounts, day)
+	require.Len(t, filtered, 1)
+	require.Equal(t, int64(10), filtered[0].ID)
+
+	filtered = svc.filterByAvailabilitySchedule(context.Background(), SchedulerBucket{GroupID: 1}, accounts, night)
+	require.Len(t, filtered, 2)
+
+	require.Empty(t, svc.filterByAvailabilitySchedule(context.Background(), SchedulerBucket{GroupID: 2}, accounts, day), "分组不在可用时段内时桶为空")
+
+	// 表达式已在白天首次记录，夜间检测到翻转，之后同一时段不再重复触发
+	require.True(t, svc.availabilityScheduleFlipped(night))
+	require.False(t, svc.availabilityScheduleFlipped(night.Add(time.Minute)))
+	require.True(t, svc.availabilityScheduleFlipped(day))
+}
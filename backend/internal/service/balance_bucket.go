@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+)
+
+// Balance bucket sources
+const (
+	BalanceSourcePaid     = "paid"     // 在线支付充值（payment / payg 订单）
+	BalanceSourceRedeem   = "redeem"   // 余额兑换码
+	BalanceSourcePromo    = "promo"    // 优惠码赠送
+	BalanceSourceReferral = "referral" // 邀请奖励 / 返利
+	BalanceSourceAdmin    = "admin"    // 管理员调整
+	BalanceSourceLegacy   = "legacy"   // 分桶上线前的存量余额
+)
+
+// Balance bucket status
+const (
+	BalanceBucketStatusActive    = "active"
+	BalanceBucketStatusExhausted = "exhausted"
+	BalanceBucketStatusExpired   = "expired"
+	BalanceBucketStatusRefunded  = "refunded"
+)
+
+// balanceSourcePriority 决定扣费顺序：数值越小越先消耗，赠送类额度先于付费额度。
+var balanceSourcePriority = map[string]int{
+	BalanceSourcePromo:    10,
+	BalanceSourceReferral: 20,
+	BalanceSourceAdmin:    50,
+	BalanceSourceRedeem:   100,
+	BalanceSourcePaid:     100,
+	BalanceSourceLegacy:   100,
+}
+
+// RefundableBalanceSources 是退款时允许回收的来源；赠送额度不随订单退款返还。
+var RefundableBalanceSources = []string{BalanceSourcePaid, BalanceSourceRedeem, BalanceSourceLegacy}
+
+// BalanceSourcePriority returns the consumption priority for a source (unknown sources spend last).
+func BalanceSourcePriority(source string) int {
+	if p, ok := balanceSourcePriority[source]; ok {
+		return p
+	}
+	return 100
+}
+
+// BalanceBucket is one credit that makes up part of users.balance.
+type BalanceBucket struct {
+	ID            int64
+	UserID        int64
+	Source        string
+	SourceRef     string
+	Amount        float64
+	Remaining     float64
+	Priority      int
+	Status        string
+	ExpiresAt     *time.Time
+	ExpiredAmount float64
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// BalanceBucketRepository persists balance buckets.
+//
+// 约定：users.balance 由 UserRepository 维护，本仓储只负责桶的构成；
+// DeductBalance / UpdateBalance(负数) 会在同一语句事务内自动按优先级消耗桶。
+type BalanceBucketRepository interface {
+	// Create 记录一笔已计入 users.balance 的入账；remaining 按入账后余额截断（先抵扣透支部分）
+	Create(ctx context.Context, bucket *BalanceBucket) error
+	// Consume 按优先级从可用桶中扣减 amount，不修改 users.balance
+	Consume(ctx context.Context, userID int64, amount float64) error
+	// DeductRefundable 扣减 users.balance 并只从可退款来源的桶中回收 amount
+	DeductRefundable(ctx context.Context, userID int64, amount float64) error
+	// SumRemaining 汇总指定来源的可用余额；sources 为空表示全部来源
+	SumRemaining(ctx context.Context, userID int64, sources []string) (float64, error)
+	ListByUser(ctx context.Context, userID int64, params pagination.PaginationParams, status string) ([]BalanceBucket, *pagination.PaginationResult, error)
+	// ListExpired 返回已到期但仍有剩余的桶
+	ListExpired(ctx context.Context, now time.Time, limit int) ([]BalanceBucket, error)
+	// Expire 将桶标记为过期并从 users.balance 中扣除剩余金额，返回实际回收的金额
+	Expire(ctx context.Context, id int64) (float64, error)
+}
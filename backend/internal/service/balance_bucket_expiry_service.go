@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/timezone"
+	"github.com/robfig/cron/v3"
+)
+
+const (
+	// balanceBucketExpirySchedule 每晚 00:10（系统时区）回收到期的赠送额度
+	balanceBucketExpirySchedule = "10 0 * * *"
+	balanceBucketExpiryTimeout  = 10 * time.Minute
+)
+
+// BalanceBucketExpiryService runs the nightly job that expires bonus balance buckets.
+// Expire is idempotent per bucket, so running it on several instances is harmless.
+type BalanceBucketExpiryService struct {
+	bucketSvc *BalanceBucketService
+	cron      *cron.Cron
+	startOnce sync.Once
+	stopOnce  sync.Once
+}
+
+func NewBalanceBucketExpiryService(bucketSvc *BalanceBucketService) *BalanceBucketExpiryService {
+	return &BalanceBucketExpiryService{bucketSvc: bucketSvc}
+}
+
+func (s *BalanceBucketExpiryService) Start() {
+	if s == nil || s.bucketSvc == nil {
+		return
+	}
+	s.startOnce.Do(func() {
+		c := cron.New(cron.WithLocation(timezone.Location()))
+		if _, err := c.AddFunc(balanceBucketExpirySchedule, s.runOnce); err != nil {
+			slog.Error("[BalanceBucketExpiry] not started", "schedule", balanceBucketExpirySchedule, "error", err)
+			return
+		}
+		s.cron = c
+		s.cron.Start()
+	})
+}
+
+func (s *BalanceBucketExpiryService) Stop() {
+	if s == nil {
+		return
+	}
+	s.stopOnce.Do(func() {
+		if s.cron == nil {
+			return
+		}
+		ctx := s.cron.Stop()
+		select {
+		case <-ctx.Done():
+		case <-time.After(3 * time.Second):
+			slog.Warn("[BalanceBucketExpiry] cron stop timed out")
+		}
+	})
+}
+
+func (s *BalanceBucketExpiryService) runOnce() {
+	ctx, cancel := context.WithTimeout(context.Background(), balanceBucketExpiryTimeout)
+	defer cancel()
+
+	count, recovered, err := s.bucketSvc.ExpireDue(ctx)
+	if err != nil {
+		slog.Error("[BalanceBucketExpiry] expiry run failed", "error", err)
+		return
+	}
+	if count > 0 {
+		slog.Info("[BalanceBucketExpiry] expired balance buckets", "count", count, "recovered", recovered)
+	}
+}
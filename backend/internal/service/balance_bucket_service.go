@@ -0,0 +1,177 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/timezone"
+)
+
+const balanceBucketExpireBatch = 200
+
+// nonRefundableBalanceSources 是不随订单退款回收的赠送类来源。
+var nonRefundableBalanceSources = []string{BalanceSourcePromo, BalanceSourceReferral, BalanceSourceAdmin}
+
+// BalanceBucketService tracks where a user's balance came from: it records a bucket for
+// every credit, expires bonus buckets and restricts refunds to paid buckets.
+//
+// All methods are nil-safe so callers without bucket tracking (tests, legacy wiring) keep
+// working with the plain users.balance semantics.
+type BalanceBucketService struct {
+	repo                 BalanceBucketRepository
+	settingService       *SettingService
+	billingCache         *BillingCacheService
+	authCacheInvalidator APIKeyAuthCacheInvalidator
+}
+
+// NewBalanceBucketService creates a new BalanceBucketService
+func NewBalanceBucketService(
+	repo BalanceBucketRepository,
+	settingService *SettingService,
+	billingCache *BillingCacheService,
+	authCacheInvalidator APIKeyAuthCacheInvalidator,
+) *BalanceBucketService {
+	return &BalanceBucketService{
+		repo:                 repo,
+		settingService:       settingService,
+		billingCache:         billingCache,
+		authCacheInvalidator: authCacheInvalidator,
+	}
+}
+
+// Grant records a credit that has already been added to users.balance. Call it with the same
+// (transaction) context as the balance update so both commit together.
+func (s *BalanceBucketService) Grant(ctx context.Context, userID int64, source string, amount float64, ref string) error {
+	if s == nil || s.repo == nil || amount <= 0 {
+		return nil
+	}
+	bucket := &BalanceBucket{
+		UserID:    userID,
+		Source:    source,
+		SourceRef: ref,
+		Amount:    amount,
+		Remaining: amount,
+		Priority:  BalanceSourcePriority(source),
+		Status:    BalanceBucketStatusActive,
+		ExpiresAt: s.bucketExpiry(ctx, source, timezone.Now()),
+	}
+	if err := s.repo.Create(ctx, bucket); err != nil {
+		return fmt.Errorf("create balance bucket: %w", err)
+	}
+	return nil
+}
+
+// Consume spends amount from the user's buckets in priority order. Use it only for balance
+// reductions that bypass UserRepository.DeductBalance / UpdateBalance (e.g. admin "set").
+func (s *BalanceBucketService) Consume(ctx context.Context, userID int64, amount float64) error {
+	if s == nil || s.repo == nil || amount <= 0 {
+		return nil
+	}
+	return s.repo.Consume(ctx, userID, amount)
+}
+
+// RefundableBalance returns how much of balance may be clawed back by an order refund:
+// bonus buckets (promo / referral / admin) are excluded.
+func (s *BalanceBucketService) RefundableBalance(ctx context.Context, userID int64, balance float64) (float64, error) {
+	if balance <= 0 {
+		return 0, nil
+	}
+	if s == nil || s.repo == nil {
+		return balance, nil
+	}
+	bonus, err := s.repo.SumRemaining(ctx, userID, nonRefundableBalanceSources)
+	if err != nil {
+		return 0, fmt.Errorf("sum bonus balance: %w", err)
+	}
+	return math.Max(0, balance-bonus), nil
+}
+
+// DeductRefundable deducts amount from users.balance, taking it only from paid buckets.
+// ok=false means bucket tracking is not wired and the caller should fall back to a plain deduction.
+func (s *BalanceBucketService) DeductRefundable(ctx context.Context, userID int64, amount float64) (ok bool, err error) {
+	if s == nil || s.repo == nil {
+		return false, nil
+	}
+	return true, s.repo.DeductRefundable(ctx, userID, amount)
+}
+
+// ListByUser returns the user's buckets, newest first.
+func (s *BalanceBucketService) ListByUser(ctx context.Context, userID int64, page, pageSize int, status string) ([]BalanceBucket, int64, error) {
+	if s == nil || s.repo == nil {
+		return []BalanceBucket{}, 0, nil
+	}
+	buckets, result, err := s.repo.ListByUser(ctx, userID, pagination.PaginationParams{Page: page, PageSize: pageSize}, status)
+	if err != nil {
+		return nil, 0, err
+	}
+	return buckets, result.Total, nil
+}
+
+// ExpireDue expires every bucket past its expiry date and removes the unused remainder from
+// the owner's balance. It returns the number of buckets expired and the total amount recovered.
+func (s *BalanceBucketService) ExpireDue(ctx context.Context) (int, float64, error) {
+	if s == nil || s.repo == nil {
+		return 0, 0, nil
+	}
+	now := timezone.Now()
+	var (
+		count     int
+		recovered float64
+		touched   = make(map[int64]struct{})
+	)
+	for {
+		buckets, err := s.repo.ListExpired(ctx, now, balanceBucketExpireBatch)
+		if err != nil {
+			return count, recovered, fmt.Errorf("list expired balance buckets: %w", err)
+		}
+		expired := 0
+		for i := range buckets {
+			amount, err := s.repo.Expire(ctx, buckets[i].ID)
+			if err != nil {
+				slog.Error("[BalanceBucket] expire bucket failed", "bucketID", buckets[i].ID, "error", err)
+				continue
+			}
+			expired++
+			recovered += amount
+			if amount > 0 {
+				touched[buckets[i].UserID] = struct{}{}
+			}
+		}
+		count += expired
+		// 整批失败时避免死循环，剩余的留给下次调度
+		if len(buckets) < balanceBucketExpireBatch || expired == 0 {
+			break
+		}
+	}
+	for userID := range touched {
+		s.invalidateBalance(ctx, userID)
+	}
+	return count, recovered, nil
+}
+
+func (s *BalanceBucketService) bucketExpiry(ctx context.Context, source string, now time.Time) *time.Time {
+	if s.settingService == nil {
+		return nil
+	}
+	days := s.settingService.GetBonusCreditValidDays(ctx, source)
+	if days <= 0 {
+		return nil
+	}
+	expiresAt := now.AddDate(0, 0, days)
+	return &expiresAt
+}
+
+func (s *BalanceBucketService) invalidateBalance(ctx context.Context, userID int64) {
+	if s.authCacheInvalidator != nil {
+		s.authCacheInvalidator.InvalidateAuthCacheByUserID(ctx, userID)
+	}
+	if s.billingCache != nil {
+		if err := s.billingCache.InvalidateUserBalance(ctx, userID); err != nil {
+			slog.Warn("[BalanceBucket] invalidate balance cache failed", "userID", userID, "error", err)
+		}
+	}
+}
@@ -0,0 +1,158 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pagination"
+	"github.com/stretchr/testify/require"
+)
+
+type balanceBucketRepoStub struct {
+	created     []*BalanceBucket
+	bonusSum    float64
+	sumSources  []string
+	expiredRuns [][]BalanceBucket
+	expireAmts  map[int64]float64
+	expireErrs  map[int64]error
+}
+
+func (s *balanceBucketRepoStub) Create(ctx context.Context, bucket *BalanceBucket) error {
+	s.created = append(s.created, bucket)
+	return nil
+}
+
+func (s *balanceBucketRepoStub) Consume(ctx context.Context, userID int64, amount float64) error {
+	return nil
+}
+
+func (s *balanceBucketRepoStub) DeductRefundable(ctx context.Context, userID int64, amount float64) error {
+	return nil
+}
+
+func (s *balanceBucketRepoStub) SumRemaining(ctx context.Context, userID int64, sources []string) (float64, error) {
+	s.sumSources = sources
+	return s.bonusSum, nil
+}
+
+func (s *balanceBucketRepoStub) ListByUser(ctx context.Context, userID int64, params pagination.PaginationParams, status string) ([]BalanceBucket, *pagination.PaginationResult, error) {
+	return nil, &pagination.PaginationResult{}, nil
+}
+
+func (s *balanceBucketRepoStub) ListExpired(ctx context.Context, now time.Time, limit int) ([]BalanceBucket, error) {
+	if len(s.expiredRuns) == 0 {
+		return nil, nil
+	}
+	run := s.expiredRuns[0]
+	s.expiredRuns = s.expiredRuns[1:]
+	return run, nil
+}
+
+func (s *balanceBucketRepoStub) Expire(ctx context.Context, id int64) (float64, error) {
+	if err := s.expireErrs[id]; err != nil {
+		return 0, err
+	}
+	return s.expireAmts[id], nil
+}
+
+func TestBalanceSourcePriority_BonusSpentBeforePaid(t *testing.T) {
+	require.Less(t, BalanceSourcePriority(BalanceSourcePromo), BalanceSourcePriority(BalanceSourceReferral))
+	require.Less(t, BalanceSourcePriority(BalanceSourceReferral), BalanceSourcePriority(BalanceSourceAdmin))
+	require.Less(t, BalanceSourcePriority(BalanceSourceAdmin), BalanceSourcePriority(BalanceSourcePaid))
+	require.Equal(t, BalanceSourcePriority(BalanceSourcePaid), BalanceSourcePriority(BalanceSourceLegacy))
+	require.Equal(t, 100, BalanceSourcePriority("unknown"))
+}
+
+func TestBalanceBucketService_GrantAppliesExpiryAndPriority(t *testing.T) {
+	repo := &balanceBucketRepoStub{}
+	settings := NewSettingService(&settingRepoStub{values: map[string]string{
+		SettingKeyPromoCreditValidDays: "30",
+	}}, &config.Config{})
+	svc := NewBalanceBucketService(repo, settings, nil, nil)
+
+	require.NoError(t, svc.Grant(context.Background(), 7, BalanceSourcePromo, 5, "WELCOME"))
+	require.NoError(t, svc.Grant(context.Background(), 7, BalanceSourcePaid, 20, "PAY-1-2"))
+	// 赠送额度有效期未配置时永不过期
+	require.NoError(t, svc.Grant(context.Background(), 7, BalanceSourceReferral, 3, "register:8"))
+	require.NoError(t, svc.Grant(context.Background(), 7, BalanceSourcePaid, 0, "noop"))
+	require.Len(t, repo.created, 3)
+
+	promo := repo.created[0]
+	require.Equal(t, 10, promo.Priority)
+	require.Equal(t, 5.0, promo.Remaining)
+	require.Equal(t, BalanceBucketStatusActive, promo.Status)
+	require.NotNil(t, promo.ExpiresAt)
+	require.WithinDuration(t, time.Now().AddDate(0, 0, 30), *promo.ExpiresAt, time.Minute)
+
+	paid := repo.created[1]
+	require.Equal(t, 100, paid.Priority)
+	require.Nil(t, paid.ExpiresAt)
+	require.Nil(t, repo.created[2].ExpiresAt)
+}
+
+func TestBalanceBucketService_NilSafe(t *testing.T) {
+	var svc *BalanceBucketService
+	require.NoError(t, svc.Grant(context.Background(), 1, BalanceSourcePromo, 5, ""))
+	require.NoError(t, svc.Consume(context.Background(), 1, 5))
+
+	refundable, err := svc.RefundableBalance(context.Background(), 1, 12)
+	require.NoError(t, err)
+	require.Equal(t, 12.0, refundable)
+
+	ok, err := svc.DeductRefundable(context.Background(), 1, 5)
+	require.NoError(t, err)
+	require.False(t, ok)
+}
+
+func TestBalanceBucketService_RefundableBalanceExcludesBonus(t *testing.T) {
+	repo := &balanceBucketRepoStub{bonusSum: 20}
+	svc := NewBalanceBucketService(repo, nil, nil, nil)
+
+	refundable, err := svc.RefundableBalance(context.Background(), 1, 50)
+	require.NoError(t, err)
+	require.Equal(t, 30.0, refundable)
+	require.ElementsMatch(t, []string{BalanceSourcePromo, BalanceSourceReferral, BalanceSourceAdmin}, repo.sumSources)
+
+	repo.bonusSum = 80
+	refundable, err = svc.RefundableBalance(context.Background(), 1, 50)
+	require.NoError(t, err)
+	require.Zero(t, refundable)
+
+	refundable, err = svc.RefundableBalance(context.Background(), 1, -5)
+	require.NoError(t, err)
+	require.Zero(t, refundable)
+}
+
+func TestBalanceBucketService_ExpireDue(t *testing.T) {
+	batch := make([]BalanceBucket, balanceBucketExpireBatch)
+	for i := range batch {
+		batch[i] = BalanceBucket{ID: int64(i + 1), UserID: 1}
+	}
+	repo := &balanceBucketRepoStub{
+		expiredRuns: [][]BalanceBucket{
+			batch,
+			{{ID: 1001, UserID: 2}, {ID: 1002, UserID: 3}},
+		},
+		expireAmts: map[int64]float64{1: 1.5, 1001: 2},
+		expireErrs: map[int64]error{1002: errors.New("boom")},
+	}
+	invalidator := &authCacheInvalidatorStub{}
+	svc := NewBalanceBucketService(repo, nil, nil, invalidator)
+
+	count, recovered, err := svc.ExpireDue(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, balanceBucketExpireBatch+1, count)
+	require.InDelta(t, 3.5, recovered, 1e-9)
+	// 只有实际回收了余额的用户需要失效缓存
+	require.ElementsMatch(t, []int64{1, 2}, invalidator.userIDs)
+}
+
+func TestRedeemBalanceSource(t *testing.T) {
+	require.Equal(t, BalanceSourcePaid, redeemBalanceSource("PAY-12-345"))
+	require.Equal(t, BalanceSourceRedeem, redeemBalanceSource("A1B2C3D4"))
+}
@@ -89,6 +89,7 @@ type BillingCacheService struct {
 	apiKeyRateLimitLoader apiKeyRateLimitLoader
 	cfg                   *config.Config
 	circuitBreaker        *billingCircuitBreaker
+	creditLimits          CreditLimitProvider
 
 	cacheWriteChan     chan cacheWriteTask
 	cacheWriteWg       sync.WaitGroup
@@ -117,6 +118,11 @@ func NewBillingCacheService(cache BillingCache, userRepo UserRepository, subRepo
 	return svc
 }
 
+// SetCreditLimitProvider 设置后付费信用额度来源（在 wire 中注入，避免循环依赖）
+func (s *BillingCacheService) SetCreditLimitProvider(p CreditLimitProvider) {
+	s.creditLimits = p
+}
+
 // Stop 关闭缓存写入工作池
 func (s *BillingCacheService) Stop() {
 	s.cacheWriteStopOnce.Do(func() {
@@ -633,7 +639,7 @@ func (s *BillingCacheService) QueueUpdateAPIKeyRateLimitUsage(apiKeyID int64, co
 // ============================================
 
 // CheckBillingEligibility 检查用户是否有资格发起请求
-// 余额模式：检查缓存余额 > 0
+// 余额模式：检查缓存余额 > 0（后付费用户 > -信用额度）
 // 订阅模式：检查缓存用量未超过限额（Group限额从参数传入）
 func (s *BillingCacheService) CheckBillingEligibility(ctx context.Context, user *User, apiKey *APIKey, group *Group, subscription *UserSubscription) error {
 	// 简易模式：跳过所有计费检查
@@ -681,7 +687,11 @@ func (s *BillingCacheService) checkBalanceEligibility(ctx context.Context, userI
 		s.circuitBreaker.OnSuccess()
 	}
 
-	if balance <= 0 {
+	var creditLimit float64
+	if s.creditLimits != nil {
+		creditLimit = s.creditLimits.CreditLimit(userID)
+	}
+	if balanceExhausted(balance, creditLimit) {
 		return ErrInsufficientBalance
 	}
 
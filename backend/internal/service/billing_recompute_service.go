@@ -0,0 +1,760 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	dbent "github.com/Wei-Shaw/sub2api/ent"
+	"github.com/Wei-Shaw/sub2api/ent/billingadjustment"
+	"github.com/Wei-Shaw/sub2api/ent/billingrecomputejob"
+	dbuser "github.com/Wei-Shaw/sub2api/ent/user"
+	"github.com/Wei-Shaw/sub2api/internal/model"
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/timezone"
+)
+
+// Billing recompute job statuses.
+const (
+	BillingRecomputeStatusPending   = "pending"
+	BillingRecomputeStatusRunning   = "running"
+	BillingRecomputeStatusSucceeded = "succeeded"
+	BillingRecomputeStatusFailed    = "failed"
+	BillingRecomputeStatusApplying  = "applying"
+	BillingRecomputeStatusApplied   = "applied"
+)
+
+const (
+	billingRecomputeMaxRange  = 92 * 24 * time.Hour
+	billingRecomputeBatchSize = 1000
+	// billingRecomputeEpsilon treats cost differences below decimal(20,8) precision as unchanged.
+	billingRecomputeEpsilon = 1e-8
+	// billingRecomputeConfirmTolerance is how far the confirmed balance diff may be from the report.
+	billingRecomputeConfirmTolerance = 0.01
+)
+
+var (
+	ErrBillingRecomputeJobNotFound   = infraerrors.NotFound("BILLING_RECOMPUTE_JOB_NOT_FOUND", "billing recompute job not found")
+	ErrBillingRecomputeJobStatus     = infraerrors.Conflict("BILLING_RECOMPUTE_JOB_INVALID_STATUS", "billing recompute job status does not allow this action")
+	ErrBillingRecomputeJobRunning    = infraerrors.Conflict("BILLING_RECOMPUTE_JOB_RUNNING", "another billing recompute job is still running")
+	ErrBillingRecomputeInvalidRange  = infraerrors.BadRequest("BILLING_RECOMPUTE_INVALID_RANGE", "time range must be non-empty and at most 92 days")
+	ErrBillingRecomputeInvalidParams = infraerrors.BadRequest("BILLING_RECOMPUTE_INVALID_PARAMS", "candidate prices and rate multipliers must not be negative")
+	ErrBillingRecomputeNotConfirmed  = infraerrors.BadRequest("BILLING_RECOMPUTE_NOT_CONFIRMED", "applying balance adjustments requires explicit confirmation")
+	ErrBillingRecomputeDiffMismatch  = infraerrors.BadRequest("BILLING_RECOMPUTE_DIFF_MISMATCH", "confirmed balance diff does not match the job report")
+	ErrBillingRecomputeNotApplicable = infraerrors.BadRequest("BILLING_RECOMPUTE_NOT_APPLICABLE", "only jobs replayed at current pricing can be applied; candidate jobs are simulations")
+	ErrBillingRecomputeOverlap       = infraerrors.Conflict("BILLING_RECOMPUTE_OVERLAP", "another applied billing recompute job already covers part of this range")
+)
+
+// BillingRecomputeFilter selects the usage logs replayed by a recompute job.
+type BillingRecomputeFilter struct {
+	StartTime time.Time
+	EndTime   time.Time
+	UserID    int64
+	GroupID   int64
+	Model     string
+}
+
+// BillingRecomputeRepository reads usage logs for replay.
+type BillingRecomputeRepository interface {
+	// ListUsageLogsForRecompute returns up to limit billed logs with id > afterID, ordered by id.
+	// Shadow and unbilled (simple mode) rows are excluded.
+	ListUsageLogsForRecompute(ctx context.Context, filter BillingRecomputeFilter, afterID int64, limit int) ([]UsageLog, error)
+}
+
+// BillingRecomputeApplyInput is the admin's confirmation for applying a job's balance diff.
+type BillingRecomputeApplyInput struct {
+	Confirm bool
+	// ExpectedBalanceDiff must repeat the report's balance_diff so a stale page cannot apply a different job result.
+	ExpectedBalanceDiff float64
+	// RefundOnly only returns overcharges and never charges users for undercharges.
+	RefundOnly bool
+	// MinAmount skips users whose adjustment is smaller than this (absolute value).
+	MinAmount float64
+	Note      string
+}
+
+// BillingRecomputeJobListParams filters job lists.
+type BillingRecomputeJobListParams struct {
+	Status   string
+	Page     int
+	PageSize int
+}
+
+// billingRecomputeAdjustment is the balance change planned for one user.
+type billingRecomputeAdjustment struct {
+	UserID int64
+	Amount float64
+	Rows   int64
+}
+
+// BillingRecomputeService replays usage logs through the billing rules with current or candidate
+// pricing to report the cost impact per user / group / model. After explicit confirmation the
+// balance-billed difference can be applied as audited balance adjustments.
+type BillingRecomputeService struct {
+	entClient            *dbent.Client
+	repo                 BillingRecomputeRepository
+	billingService       *BillingService
+	balanceBuckets       *BalanceBucketService
+	billingCacheService  *BillingCacheService
+	authCacheInvalidator APIKeyAuthCacheInvalidator
+	redeemCodeRepo       RedeemCodeRepository
+
+	ctx      context.Context
+	cancel   context.CancelFunc
+	stopOnce sync.Once
+	wg       sync.WaitGroup
+}
+
+// NewBillingRecomputeService creates a new BillingRecomputeService.
+func NewBillingRecomputeService(
+	entClient *dbent.Client,
+	repo BillingRecomputeRepository,
+	billingService *BillingService,
+	balanceBuckets *BalanceBucketService,
+	billingCacheService *BillingCacheService,
+	authCacheInvalidator APIKeyAuthCacheInvalidator,
+	redeemCodeRepo RedeemCodeRepository,
+) *BillingRecomputeService {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &BillingRecomputeService{
+		entClient:            entClient,
+		repo:                 repo,
+		billingService:       billingService,
+		balanceBuckets:       balanceBuckets,
+		billingCacheService:  billingCacheService,
+		authCacheInvalidator: authCacheInvalidator,
+		redeemCodeRepo:       redeemCodeRepo,
+		ctx:                  ctx,
+		cancel:               cancel,
+	}
+}
+
+// Start recovers jobs interrupted by a restart: running jobs fail, applying jobs can be applied again.
+func (s *BillingRecomputeService) Start() {
+	if s == nil || s.entClient == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	now := timezone.Now()
+	if _, err := s.entClient.BillingRecomputeJob.Update().
+		Where(billingrecomputejob.StatusIn(BillingRecomputeStatusPending, BillingRecomputeStatusRunning)).
+		SetStatus(BillingRecomputeStatusFailed).
+		SetErrorMessage("interrupted by restart").
+		SetFinishedAt(now).
+		Save(ctx); err != nil {
+		slog.Error("[BillingRecompute] recover running jobs failed", "error", err)
+	}
+	if _, err := s.entClient.BillingRecomputeJob.Update().
+		Where(billingrecomputejob.StatusEQ(BillingRecomputeStatusApplying)).
+		SetStatus(BillingRecomputeStatusSucceeded).
+		Save(ctx); err != nil {
+		slog.Error("[BillingRecompute] recover applying jobs failed", "error", err)
+	}
+}
+
+// Stop cancels running jobs and waits for them to exit.
+func (s *BillingRecomputeService) Stop() {
+	if s == nil {
+		return
+	}
+	s.stopOnce.Do(s.cancel)
+	s.wg.Wait()
+}
+
+// CreateJob validates params, stores a pending job and replays usage logs in the background.
+func (s *BillingRecomputeService) CreateJob(ctx context.Context, adminID int64, params model.BillingRecomputeParams) (*dbent.BillingRecomputeJob, error) {
+	if err := normalizeBillingRecomputeParams(&params); err != nil {
+		return nil, err
+	}
+	busy, err := s.entClient.BillingRecomputeJob.Query().
+		Where(billingrecomputejob.StatusIn(BillingRecomputeStatusPending, BillingRecomputeStatusRunning)).
+		Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("check running jobs: %w", err)
+	}
+	if busy {
+		return nil, ErrBillingRecomputeJobRunning
+	}
+
+	job, err := s.entClient.BillingRecomputeJob.Create().
+		SetMode(billingRecomputeMode(params)).
+		SetParams(params).
+		SetCreatedBy(adminID).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("create billing recompute job: %w", err)
+	}
+	slog.Info("[BillingRecompute] job created", "jobID", job.ID, "adminID", adminID, "mode", job.Mode)
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		s.run(s.ctx, job.ID, params)
+	}()
+	return job, nil
+}
+
+// GetJob returns a job with its report.
+func (s *BillingRecomputeService) GetJob(ctx context.Context, id int64) (*dbent.BillingRecomputeJob, error) {
+	job, err := s.entClient.BillingRecomputeJob.Get(ctx, id)
+	if err != nil {
+		if dbent.IsNotFound(err) {
+			return nil, ErrBillingRecomputeJobNotFound
+		}
+		return nil, fmt.Errorf("get billing recompute job: %w", err)
+	}
+	return job, nil
+}
+
+// ListJobs returns jobs newest first.
+func (s *BillingRecomputeService) ListJobs(ctx context.Context, p BillingRecomputeJobListParams) ([]*dbent.BillingRecomputeJob, int, error) {
+	q := s.entClient.BillingRecomputeJob.Query()
+	if p.Status != "" {
+		q = q.Where(billingrecomputejob.StatusEQ(p.Status))
+	}
+	total, err := q.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("count billing recompute jobs: %w", err)
+	}
+	ps, pg := applyPagination(p.PageSize, p.Page)
+	items, err := q.Order(dbent.Desc(billingrecomputejob.FieldID)).Limit(ps).Offset((pg - 1) * ps).All(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("query billing recompute jobs: %w", err)
+	}
+	return items, total, nil
+}
+
+// ListAdjustments returns the balance adjustments applied by a job.
+func (s *BillingRecomputeService) ListAdjustments(ctx context.Context, jobID int64) ([]*dbent.BillingAdjustment, error) {
+	items, err := s.entClient.BillingAdjustment.Query().
+		Where(billingadjustment.JobIDEQ(jobID)).
+		Order(dbent.Asc(billingadjustment.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query billing adjustments: %w", err)
+	}
+	return items, nil
+}
+
+func (s *BillingRecomputeService) run(ctx context.Context, jobID int64, params model.BillingRecomputeParams) {
+	n, err := s.entClient.BillingRecomputeJob.Update().
+		Where(billingrecomputejob.IDEQ(jobID), billingrecomputejob.StatusEQ(BillingRecomputeStatusPending)).
+		SetStatus(BillingRecomputeStatusRunning).
+		SetStartedAt(timezone.Now()).
+		Save(ctx)
+	if err != nil || n == 0 {
+		slog.Error("[BillingRecompute] start job failed", "jobID", jobID, "error", err)
+		return
+	}
+
+	report, err := s.recompute(ctx, params)
+	// 任务可能因停机被取消，使用独立 context 落库最终状态
+	saveCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	update := s.entClient.BillingRecomputeJob.UpdateOneID(jobID).SetFinishedAt(timezone.Now())
+	if err != nil {
+		slog.Error("[BillingRecompute] job failed", "jobID", jobID, "error", err)
+		update.SetStatus(BillingRecomputeStatusFailed).SetErrorMessage(err.Error())
+	} else {
+		slog.Info("[BillingRecompute] job finished", "jobID", jobID, "scanned", report.ScannedRows, "changed", report.ChangedRows, "diff", report.Diff)
+		update.SetStatus(BillingRecomputeStatusSucceeded).
+			SetReport(report).
+			SetScannedRows(report.ScannedRows).
+			SetChangedRows(report.ChangedRows).
+			SetOriginalCost(report.OriginalCost).
+			SetRecomputedCost(report.RecomputedCost).
+			SetBalanceDiff(report.BalanceDiff)
+	}
+	if err := update.Exec(saveCtx); err != nil {
+		slog.Error("[BillingRecompute] save job result failed", "jobID", jobID, "error", err)
+	}
+}
+
+// recompute replays all matching usage logs and aggregates the differences.
+func (s *BillingRecomputeService) recompute(ctx context.Context, params model.BillingRecomputeParams) (*model.BillingRecomputeReport, error) {
+	filter := BillingRecomputeFilter{
+		StartTime: params.StartTime,
+		EndTime:   params.EndTime,
+		UserID:    params.UserID,
+		GroupID:   params.GroupID,
+		Model:     params.Model,
+	}
+	agg := newBillingRecomputeAggregator()
+	var afterID int64
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		logs, err := s.repo.ListUsageLogsForRecompute(ctx, filter, afterID, billingRecomputeBatchSize)
+		if err != nil {
+			return nil, fmt.Errorf("list usage logs: %w", err)
+		}
+		for i := range logs {
+			log := &logs[i]
+			cost, ok := s.recomputeUsageLog(log, params)
+			if !ok {
+				agg.skip(log)
+				continue
+			}
+			agg.add(log, cost)
+		}
+		if len(logs) < billingRecomputeBatchSize {
+			break
+		}
+		afterID = logs[len(logs)-1].ID
+	}
+	return agg.finish(), nil
+}
+
+// recomputeUsageLog returns the actual cost of log under params. Image requests and models
+// without pricing are not replayed; rows billed with the Gemini long-context rule replay it.
+func (s *BillingRecomputeService) recomputeUsageLog(log *UsageLog, params model.BillingRecomputeParams) (float64, bool) {
+	if log.ImageCount > 0 {
+		return 0, false
+	}
+	billingModel := forwardResultBillingModel(log.Model, derefStr(log.UpstreamModel))
+	candidate, hasCandidate := params.Pricing[strings.ToLower(billingModel)]
+
+	pricing, err := s.billingService.GetModelPricing(billingModel)
+	if err != nil {
+		if !hasCandidate {
+			return 0, false
+		}
+		pricing = &ModelPricing{}
+	}
+	if hasCandidate {
+		pricing = applyCandidatePricing(pricing, candidate)
+	}
+
+	tokens := UsageTokens{
+		InputTokens:           log.InputTokens,
+		OutputTokens:          log.OutputTokens,
+		CacheCreationTokens:   log.CacheCreationTokens,
+		CacheReadTokens:       log.CacheReadTokens,
+		CacheCreation5mTokens: log.CacheCreation5mTokens,
+		CacheCreation1hTokens: log.CacheCreation1hTokens,
+	}
+	multiplier := billingRecomputeMultiplier(log, params)
+	if log.LongContext {
+		cost := s.billingService.CalculateCostWithPricingLongContext(pricing, tokens, multiplier, GeminiLongContextThreshold, GeminiLongContextMultiplier)
+		return cost.ActualCost, true
+	}
+	cost := s.billingService.CalculateCostWithPricing(pricing, tokens, multiplier, derefStr(log.ServiceTier))
+	return cost.ActualCost, true
+}
+
+// ApplyJob credits overcharges and charges undercharges of balance-billed usage for each user
+// in a succeeded job. Users already adjusted by this job are skipped, so it is safe to retry.
+// Only current-mode jobs can be applied, and a job is rejected when an applied job already
+// covers overlapping usage, since the usage logs themselves are never rewritten.
+func (s *BillingRecomputeService) ApplyJob(ctx context.Context, id, adminID int64, in BillingRecomputeApplyInput) (*dbent.BillingRecomputeJob, error) {
+	if !in.Confirm {
+		return nil, ErrBillingRecomputeNotConfirmed
+	}
+	job, err := s.GetJob(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if job.Status != BillingRecomputeStatusSucceeded || job.Report == nil {
+		return nil, ErrBillingRecomputeJobStatus
+	}
+	if job.Mode != model.BillingRecomputeModeCurrent {
+		return nil, ErrBillingRecomputeNotApplicable
+	}
+	if math.Abs(job.Report.BalanceDiff-in.ExpectedBalanceDiff) > billingRecomputeConfirmTolerance {
+		return nil, ErrBillingRecomputeDiffMismatch
+	}
+
+	n, err := s.entClient.BillingRecomputeJob.Update().
+		Where(billingrecomputejob.IDEQ(id), billingrecomputejob.StatusEQ(BillingRecomputeStatusSucceeded)).
+		SetStatus(BillingRecomputeStatusApplying).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("lock billing recompute job: %w", err)
+	}
+	if n == 0 {
+		return nil, ErrBillingRecomputeJobStatus
+	}
+	revert := func() {
+		if revertErr := s.entClient.BillingRecomputeJob.UpdateOneID(id).SetStatus(BillingRecomputeStatusSucceeded).Exec(ctx); revertErr != nil {
+			slog.Error("[BillingRecompute] revert job status failed", "jobID", id, "error", revertErr)
+		}
+	}
+	// Checked after taking the lock so two overlapping jobs applied concurrently both back off.
+	if err := s.checkAppliedOverlap(ctx, job); err != nil {
+		revert()
+		return nil, err
+	}
+
+	note := strings.TrimSpace(in.Note)
+	if note == "" {
+		note = fmt.Sprintf("billing recompute #%d", id)
+	}
+	applied := 0
+	for _, adj := range planBillingRecomputeAdjustments(job.Report.Users, in) {
+		ok, err := s.applyAdjustment(ctx, id, adminID, adj, note)
+		if err != nil {
+			revert()
+			return nil, err
+		}
+		if ok {
+			applied++
+		}
+	}
+
+	job, err = s.entClient.BillingRecomputeJob.UpdateOneID(id).
+		SetStatus(BillingRecomputeStatusApplied).
+		SetAppliedBy(adminID).
+		SetAppliedAt(timezone.Now()).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("mark billing recompute job applied: %w", err)
+	}
+	slog.Info("[BillingRecompute] job applied", "jobID", id, "adminID", adminID, "users", applied)
+	return job, nil
+}
+
+// checkAppliedOverlap rejects a job whose usage overlaps a job that is applied or being applied.
+func (s *BillingRecomputeService) checkAppliedOverlap(ctx context.Context, job *dbent.BillingRecomputeJob) error {
+	others, err := s.entClient.BillingRecomputeJob.Query().
+		Where(
+			billingrecomputejob.IDNEQ(job.ID),
+			billingrecomputejob.StatusIn(BillingRecomputeStatusApplying, BillingRecomputeStatusApplied),
+		).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("query applied billing recompute jobs: %w", err)
+	}
+	for _, other := range others {
+		if billingRecomputeScopesOverlap(job.Params, other.Params) {
+			return ErrBillingRecomputeOverlap.WithMetadata(map[string]string{"job_id": fmt.Sprintf("%d", other.ID)})
+		}
+	}
+	return nil
+}
+
+// applyAdjustment changes one user's balance and records it. It returns false when the user
+// was already adjusted by this job or no longer exists.
+func (s *BillingRecomputeService) applyAdjustment(ctx context.Context, jobID, adminID int64, adj billingRecomputeAdjustment, note string) (bool, error) {
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return false, fmt.Errorf("begin adjustment transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+	txCtx := dbent.NewTxContext(ctx, tx)
+
+	u, err := tx.User.Query().Where(dbuser.IDEQ(adj.UserID)).ForUpdate().Only(ctx)
+	if err != nil {
+		if dbent.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("lock user: %w", err)
+	}
+	done, err := tx.BillingAdjustment.Query().
+		Where(billingadjustment.JobIDEQ(jobID), billingadjustment.UserIDEQ(adj.UserID)).
+		Exist(ctx)
+	if err != nil {
+		return false, fmt.Errorf("check billing adjustment: %w", err)
+	}
+	if done {
+		return false, nil
+	}
+
+	// 直接改写余额：退还多收不计入累计充值，补扣允许透支（与请求扣费一致）
+	if err := tx.User.UpdateOneID(adj.UserID).AddBalance(adj.Amount).Exec(ctx); err != nil {
+		return false, fmt.Errorf("update balance: %w", err)
+	}
+	if adj.Amount > 0 {
+		if err := s.balanceBuckets.Grant(txCtx, adj.UserID, BalanceSourceAdmin, adj.Amount, fmt.Sprintf("billing_recompute:%d", jobID)); err != nil {
+			return false, err
+		}
+	} else if err := s.balanceBuckets.Consume(txCtx, adj.UserID, -adj.Amount); err != nil {
+		return false, err
+	}
+	if _, err := tx.BillingAdjustment.Create().
+		SetJobID(jobID).
+		SetUserID(adj.UserID).
+		SetAmount(adj.Amount).
+		SetBalanceBefore(u.Balance).
+		SetBalanceAfter(u.Balance + adj.Amount).
+		SetUsageRows(adj.Rows).
+		SetOperatorID(adminID).
+		SetNote(note).
+		Save(ctx); err != nil {
+		return false, fmt.Errorf("create billing adjustment: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("commit adjustment transaction: %w", err)
+	}
+
+	if s.authCacheInvalidator != nil {
+		s.authCacheInvalidator.InvalidateAuthCacheByUserID(ctx, adj.UserID)
+	}
+	if s.billingCacheService != nil {
+		if err := s.billingCacheService.InvalidateUserBalance(ctx, adj.UserID); err != nil {
+			slog.Error("[BillingRecompute] invalidate user balance cache failed", "userID", adj.UserID, "error", err)
+		}
+	}
+	s.recordAuditEntry(ctx, adj, note)
+	return true, nil
+}
+
+// recordAuditEntry mirrors admin balance changes into the user's redeem history.
+func (s *BillingRecomputeService) recordAuditEntry(ctx context.Context, adj billingRecomputeAdjustment, note string) {
+	if s.redeemCodeRepo == nil {
+		return
+	}
+	code, err := GenerateRedeemCode()
+	if err != nil {
+		slog.Error("[BillingRecompute] generate adjustment code failed", "error", err)
+		return
+	}
+	userID := adj.UserID
+	now := time.Now()
+	record := &RedeemCode{
+		Code:   code,
+		Type:   AdjustmentTypeAdminBalance,
+		Value:  adj.Amount,
+		Status: StatusUsed,
+		UsedBy: &userID,
+		UsedAt: &now,
+		Notes:  note,
+	}
+	if err := s.redeemCodeRepo.Create(ctx, record); err != nil {
+		slog.Error("[BillingRecompute] create adjustment record failed", "userID", adj.UserID, "error", err)
+	}
+}
+
+func normalizeBillingRecomputeParams(p *model.BillingRecomputeParams) error {
+	if p.StartTime.IsZero() || p.EndTime.IsZero() || !p.EndTime.After(p.StartTime) ||
+		p.EndTime.Sub(p.StartTime) > billingRecomputeMaxRange {
+		return ErrBillingRecomputeInvalidRange
+	}
+	p.Model = strings.TrimSpace(p.Model)
+	if p.RateMultiplier != nil && *p.RateMultiplier < 0 {
+		return ErrBillingRecomputeInvalidParams
+	}
+	for _, m := range p.GroupRateMultipliers {
+		if m < 0 {
+			return ErrBillingRecomputeInvalidParams
+		}
+	}
+	if len(p.Pricing) > 0 {
+		pricing := make(map[string]model.CandidateModelPricing, len(p.Pricing))
+		for name, c := range p.Pricing {
+			name = strings.ToLower(strings.TrimSpace(name))
+			if name == "" {
+				continue
+			}
+			for _, v := range []*float64{c.InputPerMTok, c.OutputPerMTok, c.CacheCreationPerMTok, c.CacheCreation1hPerMTok, c.CacheReadPerMTok} {
+				if v != nil && *v < 0 {
+					return ErrBillingRecomputeInvalidParams
+				}
+			}
+			pricing[name] = c
+		}
+		p.Pricing = pricing
+	}
+	return nil
+}
+
+// billingRecomputeScopesOverlap reports whether two jobs can select the same usage log:
+// their time ranges intersect and no filter set on both sides differs.
+func billingRecomputeScopesOverlap(a, b model.BillingRecomputeParams) bool {
+	if !a.StartTime.Before(b.EndTime) || !b.StartTime.Before(a.EndTime) {
+		return false
+	}
+	if a.UserID != 0 && b.UserID != 0 && a.UserID != b.UserID {
+		return false
+	}
+	if a.GroupID != 0 && b.GroupID != 0 && a.GroupID != b.GroupID {
+		return false
+	}
+	if a.Model != "" && b.Model != "" && a.Model != b.Model {
+		return false
+	}
+	return true
+}
+
+func billingRecomputeMode(p model.BillingRecomputeParams) string {
+	if len(p.Pricing) > 0 || p.RateMultiplier != nil || len(p.GroupRateMultipliers) > 0 {
+		return model.BillingRecomputeModeCandidate
+	}
+	return model.BillingRecomputeModeCurrent
+}
+
+// billingRecomputeMultiplier picks the group override, then the global override, then the
+// multiplier recorded with the request (which already includes user and volume tier rates).
+func billingRecomputeMultiplier(log *UsageLog, p model.BillingRecomputeParams) float64 {
+	if log.GroupID != nil {
+		if m, ok := p.GroupRateMultipliers[*log.GroupID]; ok {
+			return m
+		}
+	}
+	if p.RateMultiplier != nil {
+		return *p.RateMultiplier
+	}
+	return log.RateMultiplier
+}
+
+// applyCandidatePricing returns a copy of base with the candidate prices (per million tokens) applied.
+func applyCandidatePricing(base *ModelPricing, c model.CandidateModelPricing) *ModelPricing {
+	p := *base
+	if c.InputPerMTok != nil {
+		p.InputPricePerToken = *c.InputPerMTok / 1e6
+	}
+	if c.OutputPerMTok != nil {
+		p.OutputPricePerToken = *c.OutputPerMTok / 1e6
+	}
+	if c.CacheCreationPerMTok != nil {
+		p.CacheCreationPricePerToken = *c.CacheCreationPerMTok / 1e6
+		p.CacheCreation5mPrice = p.CacheCreationPricePerToken
+	}
+	if c.CacheCreation1hPerMTok != nil {
+		p.CacheCreation1hPrice = *c.CacheCreation1hPerMTok / 1e6
+	}
+	if c.CacheCreationPerMTok != nil || c.CacheCreation1hPerMTok != nil {
+		p.SupportsCacheBreakdown = p.CacheCreation1hPrice > 0 && p.CacheCreation1hPrice > p.CacheCreation5mPrice
+	}
+	if c.CacheReadPerMTok != nil {
+		p.CacheReadPricePerToken = *c.CacheReadPerMTok / 1e6
+	}
+	return &p
+}
+
+// planBillingRecomputeAdjustments turns per-user balance diffs into balance changes:
+// recomputed cost above the charged cost is charged, below it is refunded.
+func planBillingRecomputeAdjustments(users []model.BillingRecomputeLine, in BillingRecomputeApplyInput) []billingRecomputeAdjustment {
+	minAmount := math.Max(in.MinAmount, billingRecomputeEpsilon)
+	out := make([]billingRecomputeAdjustment, 0, len(users))
+	for _, u := range users {
+		if u.BalanceRows == 0 {
+			continue
+		}
+		amount := roundBillingAmount(-u.BalanceDiff)
+		if math.Abs(amount) < minAmount {
+			continue
+		}
+		if in.RefundOnly && amount < 0 {
+			continue
+		}
+		out = append(out, billingRecomputeAdjustment{UserID: u.ID, Amount: amount, Rows: u.BalanceRows})
+	}
+	return out
+}
+
+func roundBillingAmount(v float64) float64 {
+	return math.Round(v*1e8) / 1e8
+}
+
+// billingRecomputeAggregator sums replay results per user, group and model.
+type billingRecomputeAggregator struct {
+	report  model.BillingRecomputeReport
+	users   map[int64]*model.BillingRecomputeLine
+	groups  map[int64]*model.BillingRecomputeLine
+	models  map[string]*model.BillingRecomputeLine
+	missing map[string]struct{}
+}
+
+func newBillingRecomputeAggregator() *billingRecomputeAggregator {
+	return &billingRecomputeAggregator{
+		users:   make(map[int64]*model.BillingRecomputeLine),
+		groups:  make(map[int64]*model.BillingRecomputeLine),
+		models:  make(map[string]*model.BillingRecomputeLine),
+		missing: make(map[string]struct{}),
+	}
+}
+
+func (a *billingRecomputeAggregator) skip(log *UsageLog) {
+	a.report.ScannedRows++
+	a.report.SkippedRows++
+	if log.ImageCount == 0 {
+		a.missing[forwardResultBillingModel(log.Model, derefStr(log.UpstreamModel))] = struct{}{}
+	}
+}
+
+func (a *billingRecomputeAggregator) add(log *UsageLog, recomputed float64) {
+	diff := recomputed - log.ActualCost
+	changed := math.Abs(diff) >= billingRecomputeEpsilon
+	balance := log.BillingType == BillingTypeBalance
+
+	a.report.ScannedRows++
+	billingModel := forwardResultBillingModel(log.Model, derefStr(log.UpstreamModel))
+	var groupID int64
+	if log.GroupID != nil {
+		groupID = *log.GroupID
+	}
+	for _, line := range []*model.BillingRecomputeLine{
+		lineFor(a.users, log.UserID, func(l *model.BillingRecomputeLine) { l.ID = log.UserID }),
+		lineFor(a.groups, groupID, func(l *model.BillingRecomputeLine) { l.ID = groupID }),
+		lineFor(a.models, billingModel, func(l *model.BillingRecomputeLine) { l.Key = billingModel }),
+	} {
+		line.Requests++
+		line.OriginalCost += log.ActualCost
+		line.RecomputedCost += recomputed
+		line.Diff += diff
+		if changed {
+			line.ChangedRows++
+		}
+		if balance {
+			line.BalanceRows++
+			line.BalanceDiff += diff
+		}
+	}
+	if changed {
+		a.report.ChangedRows++
+	}
+	a.report.OriginalCost += log.ActualCost
+	a.report.RecomputedCost += recomputed
+	a.report.Diff += diff
+	if balance {
+		a.report.BalanceDiff += diff
+	}
+}
+
+func lineFor[K comparable](m map[K]*model.BillingRecomputeLine, key K, init func(*model.BillingRecomputeLine)) *model.BillingRecomputeLine {
+	line, ok := m[key]
+	if !ok {
+		line = &model.BillingRecomputeLine{}
+		init(line)
+		m[key] = line
+	}
+	return line
+}
+
+func (a *billingRecomputeAggregator) finish() *model.BillingRecomputeReport {
+	report := a.report
+	report.Users = sortedBillingRecomputeLines(a.users)
+	report.Groups = sortedBillingRecomputeLines(a.groups)
+	report.Models = sortedBillingRecomputeLines(a.models)
+	for name := range a.missing {
+		report.MissingPricingModels = append(report.MissingPricingModels, name)
+	}
+	sort.Strings(report.MissingPricingModels)
+	return &report
+}
+
+// sortedBillingRecomputeLines orders lines by absolute diff, largest first.
+func sortedBillingRecomputeLines[K comparable](m map[K]*model.BillingRecomputeLine) []model.BillingRecomputeLine {
+	out := make([]model.BillingRecomputeLine, 0, len(m))
+	for _, line := range m {
+		out = append(out, *line)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		di, dj := math.Abs(out[i].Diff), math.Abs(out[j].Diff)
+		if di != dj {
+			return di > dj
+		}
+		if out[i].ID != out[j].ID {
+			return out[i].ID < out[j].ID
+		}
+		return out[i].Key < out[j].Key
+	})
+	return out
+}
@@ -0,0 +1,322 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	dbent "github.com/Wei-Shaw/sub2api/ent"
+	"github.com/Wei-Shaw/sub2api/ent/enttest"
+	"github.com/Wei-Shaw/sub2api/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type billingRecomputeRepoStub struct {
+	logs    []UsageLog
+	batches int
+}
+
+func (r *billingRecomputeRepoStub) ListUsageLogsForRecompute(_ context.Context, _ BillingRecomputeFilter, afterID int64, limit int) ([]UsageLog, error) {
+	r.batches++
+	out := make([]UsageLog, 0, limit)
+	for _, l := range r.logs {
+		if l.ID > afterID && len(out) < limit {
+			out = append(out, l)
+		}
+	}
+	return out, nil
+}
+
+func TestNormalizeBillingRecomputeParams(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+	p := model.BillingRecomputeParams{
+		StartTime: start,
+		EndTime:   start.Add(24 * time.Hour),
+		Pricing:   map[string]model.CandidateModelPricing{" Claude-Sonnet-4 ": {InputPerMTok: float64Ptr(2)}},
+	}
+	require.NoError(t, normalizeBillingRecomputeParams(&p))
+	// 模型名统一为小写，与价格查询一致
+	assert.Contains(t, p.Pricing, "claude-sonnet-4")
+	assert.Equal(t, model.BillingRecomputeModeCandidate, billingRecomputeMode(p))
+
+	current := model.BillingRecomputeParams{StartTime: start, EndTime: start.Add(time.Hour)}
+	require.NoError(t, normalizeBillingRecomputeParams(&current))
+	assert.Equal(t, model.BillingRecomputeModeCurrent, billingRecomputeMode(current))
+
+	// 时间范围为空、倒置或超过上限
+	for _, end := range []time.Time{start, start.Add(-time.Hour), start.Add(93 * 24 * time.Hour)} {
+		bad := model.BillingRecomputeParams{StartTime: start, EndTime: end}
+		assert.ErrorIs(t, normalizeBillingRecomputeParams(&bad), ErrBillingRecomputeInvalidRange)
+	}
+	// 负数价格 / 倍率
+	neg := model.BillingRecomputeParams{StartTime: start, EndTime: start.Add(time.Hour), RateMultiplier: float64Ptr(-1)}
+	assert.ErrorIs(t, normalizeBillingRecomputeParams(&neg), ErrBillingRecomputeInvalidParams)
+	neg = model.BillingRecomputeParams{StartTime: start, EndTime: start.Add(time.Hour),
+		Pricing: map[string]model.CandidateModelPricing{"m": {OutputPerMTok: float64Ptr(-1)}}}
+	assert.ErrorIs(t, normalizeBillingRecomputeParams(&neg), ErrBillingRecomputeInvalidParams)
+}
+
+func TestBillingRecomputeMultiplier(t *testing.T) {
+	t.Parallel()
+
+	groupID := int64(7)
+	log := &UsageLog{GroupID: &groupID, RateMultiplier: 1.5}
+
+	// 未覆盖时沿用请求记录的倍率
+	assert.Equal(t, 1.5, billingRecomputeMultiplier(log, model.BillingRecomputeParams{}))
+	// 全局覆盖
+	assert.Equal(t, 2.0, billingRecomputeMultiplier(log, model.BillingRecomputeParams{RateMultiplier: float64Ptr(2)}))
+	// 分组覆盖优先于全局覆盖
+	assert.Equal(t, 0.8, billingRecomputeMultiplier(log, model.BillingRecomputeParams{
+		RateMultiplier:       float64Ptr(2),
+		GroupRateMultipliers: map[int64]float64{7: 0.8},
+	}))
+	// 无分组的记录不受分组覆盖影响
+	assert.Equal(t, 1.5, billingRecomputeMultiplier(&UsageLog{RateMultiplier: 1.5}, model.BillingRecomputeParams{
+		GroupRateMultipliers: map[int64]float64{7: 0.8},
+	}))
+}
+
+func TestApplyCandidatePricing(t *testing.T) {
+	t.Parallel()
+
+	base := &ModelPricing{
+		InputPricePerToken:         3e-6,
+		OutputPricePerToken:        15e-6,
+		CacheCreationPricePerToken: 3.75e-6,
+		CacheCreation5mPrice:       3.75e-6,
+		CacheCreation1hPrice:       6e-6,
+		SupportsCacheBreakdown:     true,
+		CacheReadPricePerToken:     0.3e-6,
+	}
+	p := applyCandidatePricing(base, model.CandidateModelPricing{
+		InputPerMTok:         float64Ptr(2),
+		CacheCreationPerMTok: float64Ptr(8),
+	})
+	assert.InDelta(t, 2e-6, p.InputPricePerToken, 1e-15)
+	assert.InDelta(t, 15e-6, p.OutputPricePerToken, 1e-15)
+	assert.InDelta(t, 8e-6, p.CacheCreationPricePerToken, 1e-15)
+	assert.InDelta(t, 8e-6, p.CacheCreation5mPrice, 1e-15)
+	// 1h 价格不高于 5m 价格时关闭分类计费
+	assert.False(t, p.SupportsCacheBreakdown)
+	// 不修改原价格
+	assert.InDelta(t, 3e-6, base.InputPricePerToken, 1e-15)
+	assert.True(t, base.SupportsCacheBreakdown)
+}
+
+func TestBillingRecomputeService_Recompute(t *testing.T) {
+	t.Parallel()
+
+	group1, group2 := int64(1), int64(2)
+	cost := 1000*3e-6 + 1000*15e-6 // claude-sonnet-4 回退价格，1000 输入 + 1000 输出
+	repo := &billingRecomputeRepoStub{logs: []UsageLog{
+		// 原始扣费偏低：按当前价格重算需补扣
+		{ID: 1, UserID: 10, GroupID: &group1, Model: "claude-sonnet-4", InputTokens: 1000, OutputTokens: 1000, RateMultiplier: 1, ActualCost: cost / 2, BillingType: BillingTypeBalance},
+		// 与当前价格一致
+		{ID: 2, UserID: 10, GroupID: &group1, Model: "claude-sonnet-4", InputTokens: 1000, OutputTokens: 1000, RateMultiplier: 1, ActualCost: cost, BillingType: BillingTypeBalance},
+		// 订阅计费：计入差异但不影响余额
+		{ID: 3, UserID: 20, GroupID: &group2, Model: "claude-sonnet-4", InputTokens: 1000, OutputTokens: 1000, RateMultiplier: 1, ActualCost: 2 * cost, BillingType: BillingTypeSubscription},
+		// 图片请求与无价格的模型跳过
+		{ID: 4, UserID: 10, Model: "claude-sonnet-4", ImageCount: 1, ActualCost: 1},
+		{ID: 5, UserID: 10, Model: "no-such-model-xyz", InputTokens: 10, ActualCost: 1},
+	}}
+	svc := &BillingRecomputeService{repo: repo, billingService: newTestBillingService()}
+
+	report, err := svc.recompute(context.Background(), model.BillingRecomputeParams{})
+	require.NoError(t, err)
+
+	assert.EqualValues(t, 5, report.ScannedRows)
+	assert.EqualValues(t, 2, report.SkippedRows)
+	assert.EqualValues(t, 2, report.ChangedRows)
+	assert.Equal(t, []string{"no-such-model-xyz"}, report.MissingPricingModels)
+	assert.InDelta(t, cost/2-cost, report.Diff, 1e-12)
+	assert.InDelta(t, cost/2, report.BalanceDiff, 1e-12)
+
+	// 按差额绝对值降序：用户 20 多收 cost，用户 10 少收 cost/2
+	require.Len(t, report.Users, 2)
+	assert.EqualValues(t, 20, report.Users[0].ID)
+	assert.InDelta(t, -cost, report.Users[0].Diff, 1e-12)
+	assert.Zero(t, report.Users[0].BalanceRows)
+	assert.EqualValues(t, 10, report.Users[1].ID)
+	assert.EqualValues(t, 2, report.Users[1].Requests)
+	assert.EqualValues(t, 1, report.Users[1].ChangedRows)
+	assert.InDelta(t, cost/2, report.Users[1].BalanceDiff, 1e-12)
+
+	require.Len(t, report.Groups, 2)
+	require.Len(t, report.Models, 1)
+	assert.Equal(t, "claude-sonnet-4", report.Models[0].Key)
+
+	// 候选价格：输出降为 $5/MTok
+	report, err = svc.recompute(context.Background(), model.BillingRecomputeParams{
+		Pricing: map[string]model.CandidateModelPricing{"claude-sonnet-4": {OutputPerMTok: float64Ptr(5)}},
+	})
+	require.NoError(t, err)
+	candidateCost := 1000*3e-6 + 1000*5e-6
+	assert.InDelta(t, 3*candidateCost, report.RecomputedCost, 1e-12)
+}
+
+func TestBillingRecomputeService_RecomputeReplaysLongContext(t *testing.T) {
+	t.Parallel()
+
+	// Gemini 长上下文规则：输入 250k 中超出 200k 的 50k 按双倍计费
+	tokens := UsageTokens{InputTokens: 250000, OutputTokens: 1000}
+	billing := newTestBillingService()
+	original, err := billing.CalculateCostWithLongContext("claude-sonnet-4", tokens, 1, GeminiLongContextThreshold, GeminiLongContextMultiplier)
+	require.NoError(t, err)
+	plain, err := billing.CalculateCost("claude-sonnet-4", tokens, 1)
+	require.NoError(t, err)
+	require.Greater(t, original.ActualCost, plain.ActualCost)
+
+	repo := &billingRecomputeRepoStub{logs: []UsageLog{
+		{ID: 1, UserID: 10, Model: "claude-sonnet-4", InputTokens: tokens.InputTokens, OutputTokens: tokens.OutputTokens, RateMultiplier: 1, ActualCost: original.ActualCost, BillingType: BillingTypeBalance, LongContext: true},
+	}}
+	svc := &BillingRecomputeService{repo: repo, billingService: billing}
+
+	// 当前价格重算：长上下文加价被保留，不产生差额
+	report, err := svc.recompute(context.Background(), model.BillingRecomputeParams{})
+	require.NoError(t, err)
+	assert.Zero(t, report.ChangedRows)
+	assert.InDelta(t, original.ActualCost, report.RecomputedCost, 1e-12)
+	assert.InDelta(t, 0, report.BalanceDiff, 1e-12)
+
+	// 候选价格同样按长上下文规则拆分
+	report, err = svc.recompute(context.Background(), model.BillingRecomputeParams{
+		Pricing: map[string]model.CandidateModelPricing{"claude-sonnet-4": {InputPerMTok: float64Ptr(1)}},
+	})
+	require.NoError(t, err)
+	assert.InDelta(t, 200000*1e-6+50000*1e-6*2+1000*15e-6, report.RecomputedCost, 1e-9)
+}
+
+func TestBillingRecomputeService_RecomputePaginates(t *testing.T) {
+	t.Parallel()
+
+	logs := make([]UsageLog, billingRecomputeBatchSize+1)
+	for i := range logs {
+		logs[i] = UsageLog{ID: int64(i + 1), UserID: 1, Model: "claude-sonnet-4", InputTokens: 1, RateMultiplier: 1}
+	}
+	repo := &billingRecomputeRepoStub{logs: logs}
+	svc := &BillingRecomputeService{repo: repo, billingService: newTestBillingService()}
+
+	report, err := svc.recompute(context.Background(), model.BillingRecomputeParams{})
+	require.NoError(t, err)
+	assert.EqualValues(t, len(logs), report.ScannedRows)
+	assert.Equal(t, 2, repo.batches)
+}
+
+func TestPlanBillingRecomputeAdjustments(t *testing.T) {
+	t.Parallel()
+
+	users := []model.BillingRecomputeLine{
+		{ID: 1, BalanceRows: 3, BalanceDiff: -2.5}, // 多收，退还
+		{ID: 2, BalanceRows: 1, BalanceDiff: 1.2},  // 少收，补扣
+		{ID: 3, BalanceRows: 2, BalanceDiff: 0.001},
+		{ID: 4, Diff: 5}, // 仅订阅计费
+	}
+
+	plan := planBillingRecomputeAdjustments(users, BillingRecomputeApplyInput{Confirm: true})
+	require.Len(t, plan, 3)
+	assert.Equal(t, billingRecomputeAdjustment{UserID: 1, Amount: 2.5, Rows: 3}, plan[0])
+	assert.Equal(t, billingRecomputeAdjustment{UserID: 2, Amount: -1.2, Rows: 1}, plan[1])
+
+	// 仅退款 + 最小金额
+	plan = planBillingRecomputeAdjustments(users, BillingRecomputeApplyInput{Confirm: true, RefundOnly: true, MinAmount: 0.01})
+	require.Len(t, plan, 1)
+	assert.EqualValues(t, 1, plan[0].UserID)
+}
+
+func TestBillingRecomputeScopesOverlap(t *testing.T) {
+	day := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	base := model.BillingRecomputeParams{StartTime: day, EndTime: day.AddDate(0, 0, 7)}
+	with := func(mut func(*model.BillingRecomputeParams)) model.BillingRecomputeParams {
+		p := base
+		mut(&p)
+		return p
+	}
+	between := func(fromDay, toDay int) model.BillingRecomputeParams {
+		return model.BillingRecomputeParams{StartTime: day.AddDate(0, 0, fromDay), EndTime: day.AddDate(0, 0, toDay)}
+	}
+
+	tests := []struct {
+		name string
+		a, b model.BillingRecomputeParams
+		want bool
+	}{
+		{"same range", base, base, true},
+		{"partial range", base, between(6, 10), true},
+		{"adjacent range", base, between(7, 14), false},
+		{"unfiltered covers user", base, with(func(p *model.BillingRecomputeParams) { p.UserID = 10 }), true},
+		{"different users", with(func(p *model.BillingRecomputeParams) { p.UserID = 10 }), with(func(p *model.BillingRecomputeParams) { p.UserID = 20 }), false},
+		{"different groups", with(func(p *model.BillingRecomputeParams) { p.GroupID = 1 }), with(func(p *model.BillingRecomputeParams) { p.GroupID = 2 }), false},
+		{"different models", with(func(p *model.BillingRecomputeParams) { p.Model = "a" }), with(func(p *model.BillingRecomputeParams) { p.Model = "b" }), false},
+		{"user and group filters", with(func(p *model.BillingRecomputeParams) { p.UserID = 10 }), with(func(p *model.BillingRecomputeParams) { p.GroupID = 2 }), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, billingRecomputeScopesOverlap(tt.a, tt.b))
+			assert.Equal(t, tt.want, billingRecomputeScopesOverlap(tt.b, tt.a))
+		})
+	}
+}
+
+func TestBillingRecomputeService_ApplyJobRejectsCandidateAndOverlap(t *testing.T) {
+	db, err := sql.Open("sqlite", "file:billing_recompute_apply?mode=memory&cache=shared")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+	_, err = db.Exec("PRAGMA foreign_keys = ON")
+	require.NoError(t, err)
+	client := enttest.NewClient(t, enttest.WithOptions(dbent.Driver(entsql.OpenDB(dialect.SQLite, db))))
+	t.Cleanup(func() { _ = client.Close() })
+
+	ctx := context.Background()
+	svc := NewBillingRecomputeService(client, &billingRecomputeRepoStub{}, nil, nil, nil, nil, nil)
+	t.Cleanup(svc.Stop)
+
+	day := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	report := &model.BillingRecomputeReport{}
+	createJob := func(mode, status string, params model.BillingRecomputeParams) *dbent.BillingRecomputeJob {
+		job, err := client.BillingRecomputeJob.Create().
+			SetMode(mode).
+			SetStatus(status).
+			SetParams(params).
+			SetReport(report).
+			SetCreatedBy(1).
+			Save(ctx)
+		require.NoError(t, err)
+		return job
+	}
+	in := BillingRecomputeApplyInput{Confirm: true}
+
+	// 候选价格模拟的结果不能写回余额
+	candidate := createJob(model.BillingRecomputeModeCandidate, BillingRecomputeStatusSucceeded, model.BillingRecomputeParams{
+		StartTime: day, EndTime: day.AddDate(0, 0, 7), RateMultiplier: float64Ptr(0.5),
+	})
+	_, err = svc.ApplyJob(ctx, candidate.ID, 1, in)
+	require.ErrorIs(t, err, ErrBillingRecomputeNotApplicable)
+
+	createJob(model.BillingRecomputeModeCurrent, BillingRecomputeStatusApplied, model.BillingRecomputeParams{
+		StartTime: day, EndTime: day.AddDate(0, 0, 7),
+	})
+	overlapping := createJob(model.BillingRecomputeModeCurrent, BillingRecomputeStatusSucceeded, model.BillingRecomputeParams{
+		StartTime: day.AddDate(0, 0, 3), EndTime: day.AddDate(0, 0, 10), UserID: 10,
+	})
+	_, err = svc.ApplyJob(ctx, overlapping.ID, 1, in)
+	require.ErrorIs(t, err, ErrBillingRecomputeOverlap)
+	reloaded, err := svc.GetJob(ctx, overlapping.ID)
+	require.NoError(t, err)
+	require.Equal(t, BillingRecomputeStatusSucceeded, reloaded.Status, "rejected job must stay applicable after review")
+
+	disjoint := createJob(model.BillingRecomputeModeCurrent, BillingRecomputeStatusSucceeded, model.BillingRecomputeParams{
+		StartTime: day.AddDate(0, 0, 7), EndTime: day.AddDate(0, 0, 14),
+	})
+	applied, err := svc.ApplyJob(ctx, disjoint.ID, 1, in)
+	require.NoError(t, err)
+	require.Equal(t, BillingRecomputeStatusApplied, applied.Status)
+}
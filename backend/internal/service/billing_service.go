@@ -65,6 +65,12 @@ const (
 	openAIGPTLongContextOutputMultiplier = 1.5
 )
 
+// Gemini 原生接口（/v1beta/models）的长上下文计费：总输入超过阈值时，超出部分按倍率计费
+const (
+	GeminiLongContextThreshold  = 200000
+	GeminiLongContextMultiplier = 2.0
+)
+
 func normalizeBillingServiceTier(serviceTier string) string {
 	return strings.ToLower(strings.TrimSpace(serviceTier))
 }
@@ -416,7 +422,11 @@ func (s *BillingService) CalculateCostWithServiceTier(model string, tokens Usage
 	if err != nil {
 		return nil, err
 	}
+	return s.CalculateCostWithPricing(pricing, tokens, rateMultiplier, serviceTier), nil
+}
 
+// CalculateCostWithPricing 按指定价格计算费用（用于候选价格模拟，计费规则与 CalculateCostWithServiceTier 一致）
+func (s *BillingService) CalculateCostWithPricing(pricing *ModelPricing, tokens UsageTokens, rateMultiplier float64, serviceTier string) *CostBreakdown {
 	breakdown := &CostBreakdown{}
 	inputPricePerToken := pricing.InputPricePerToken
 	outputPricePerToken := pricing.OutputPricePerToken
@@ -480,7 +490,7 @@ func (s *BillingService) CalculateCostWithServiceTier(model string, tokens Usage
 	}
 	breakdown.ActualCost = breakdown.TotalCost * rateMultiplier
 
-	return breakdown, nil
+	return breakdown
 }
 
 func (s *BillingService) applyModelSpecificPricingPolicy(model string, pricing *ModelPricing) *ModelPricing {
@@ -539,15 +549,30 @@ func (s *BillingService) CalculateCostWithConfig(model string, tokens UsageToken
 // 拆分为：范围内 (200k, 0) + 范围外 (10k, 10k)
 // 范围内正常计费，范围外 × 2 计费
 func (s *BillingService) CalculateCostWithLongContext(model string, tokens UsageTokens, rateMultiplier float64, threshold int, extraMultiplier float64) (*CostBreakdown, error) {
+	return calculateLongContextCost(tokens, rateMultiplier, threshold, extraMultiplier, func(t UsageTokens, m float64) (*CostBreakdown, error) {
+		return s.CalculateCost(model, t, m)
+	})
+}
+
+// CalculateCostWithPricingLongContext 按指定价格计算长上下文费用（用于计费重算，拆分规则与 CalculateCostWithLongContext 一致）
+func (s *BillingService) CalculateCostWithPricingLongContext(pricing *ModelPricing, tokens UsageTokens, rateMultiplier float64, threshold int, extraMultiplier float64) *CostBreakdown {
+	cost, _ := calculateLongContextCost(tokens, rateMultiplier, threshold, extraMultiplier, func(t UsageTokens, m float64) (*CostBreakdown, error) {
+		return s.CalculateCostWithPricing(pricing, t, m, ""), nil
+	})
+	return cost
+}
+
+// calculateLongContextCost 按阈值拆分输入，范围外部分以 rateMultiplier*extraMultiplier 调用 calc 计费
+func calculateLongContextCost(tokens UsageTokens, rateMultiplier float64, threshold int, extraMultiplier float64, calc func(UsageTokens, float64) (*CostBreakdown, error)) (*CostBreakdown, error) {
 	// 未启用长上下文计费，直接走正常计费
 	if threshold <= 0 || extraMultiplier <= 1 {
-		return s.CalculateCost(model, tokens, rateMultiplier)
+		return calc(tokens, rateMultiplier)
 	}
 
 	// 计算总输入 token（缓存读取 + 新输入）
 	total := tokens.CacheReadTokens + tokens.InputTokens
 	if total <= threshold {
-		return s.CalculateCost(model, tokens, rateMultiplier)
+		return calc(tokens, rateMultiplier)
 	}
 
 	// 拆分成范围内和范围外
@@ -577,7 +602,7 @@ func (s *BillingService) CalculateCostWithLongContext(model string, tokens Usage
 		CacheCreation5mTokens: tokens.CacheCreation5mTokens,
 		CacheCreation1hTokens: tokens.CacheCreation1hTokens,
 	}
-	inRangeCost, err := s.CalculateCost(model, inRangeTokens, rateMultiplier)
+	inRangeCost, err := calc(inRangeTokens, rateMultiplier)
 	if err != nil {
 		return nil, err
 	}
@@ -587,7 +612,7 @@ func (s *BillingService) CalculateCostWithLongContext(model string, tokens Usage
 		InputTokens:     outRangeInputTokens,
 		CacheReadTokens: outRangeCacheTokens,
 	}
-	outRangeCost, err := s.CalculateCost(model, outRangeTokens, rateMultiplier*extraMultiplier)
+	outRangeCost, err := calc(outRangeTokens, rateMultiplier*extraMultiplier)
 	if err != nil {
 		return inRangeCost, fmt.Errorf("out-range cost: %w", err)
 	}
@@ -85,7 +85,8 @@ const (
 
 // ConcurrencyService manages concurrent request limiting for accounts and users
 type ConcurrencyService struct {
-	cache ConcurrencyCache
+	cache     ConcurrencyCache
+	fairQueue *FairQueueService // 可选：账号槽位等待的分组级公平排队
 }
 
 // NewConcurrencyService creates a new ConcurrencyService
@@ -93,6 +94,22 @@ func NewConcurrencyService(cache ConcurrencyCache) *ConcurrencyService {
 	return &ConcurrencyService{cache: cache}
 }
 
+// SetFairQueue 设置分组级公平排队（nil 表示禁用）
+func (s *ConcurrencyService) SetFairQueue(fairQueue *FairQueueService) {
+	if s == nil {
+		return
+	}
+	s.fairQueue = fairQueue
+}
+
+// FairQueue 返回分组级公平排队服务（未启用时返回 nil）
+func (s *ConcurrencyService) FairQueue() *FairQueueService {
+	if s == nil || !s.fairQueue.Enabled() {
+		return nil
+	}
+	return s.fairQueue
+}
+
 // AcquireResult represents the result of acquiring a concurrency slot
 type AcquireResult struct {
 	Acquired    bool
@@ -116,6 +116,11 @@ const (
 	SettingKeyReferralRegisterBonus       = "referral_register_bonus"
 	SettingKeyReferralCommissionRate      = "referral_commission_rate"
 	SettingKeyReferralMaxTotalReward      = "referral_max_total_reward"
+	SettingKeyReferralCreditValidDays     = "referral_credit_valid_days"    // 邀请奖励额度有效期（天，0=永不过期）
+	SettingKeyReferralWithdrawEnabled     = "referral_withdraw_enabled"     // 返佣是否进入可提现佣金账户（false=直接计入余额）
+	SettingKeyReferralWithdrawMinAmount   = "referral_withdraw_min_amount"  // 单笔最低提现金额
+	SettingKeyReferralHoldingDays         = "referral_holding_days"         // 佣金冻结期（天），期内订单退款将追回佣金
+	SettingKeyPromoCreditValidDays        = "promo_credit_valid_days"       // 优惠码赠送额度有效期（天，0=永不过期）
 	SettingKeyContactInfo                 = "contact_info"                  // 客服联系方式
 	SettingKeyDocURL                      = "doc_url"                       // 文档链接
 	SettingKeyHomeContent                 = "home_content"                  // 首页内容（支持 Markdown/HTML，或 URL 作为 iframe src）
@@ -1,15 +1,20 @@
 package service
 
 import (
+	"bytes"
 	"context"
 	"crypto/rand"
 	"crypto/subtle"
 	"crypto/tls"
+	"encoding/base64"
 	"encoding/hex"
 	"fmt"
 	"log"
 	"math/big"
+	"mime"
+	"mime/multipart"
 	"net/smtp"
+	"net/textproto"
 	"net/url"
 	"strconv"
 	"strings"
@@ -164,14 +169,89 @@ func (s *EmailService) SendEmailWithConfig(config *SMTPConfig, to, subject, body
 	msg := fmt.Sprintf("From: %s\r\nTo: %s\r\nSubject: %s\r\nMIME-Version: 1.0\r\nContent-Type: text/html; charset=UTF-8\r\n\r\n%s",
 		from, to, subject, body)
 
+	return s.deliver(config, to, []byte(msg))
+}
+
+// EmailAttachment 邮件附件
+type EmailAttachment struct {
+	Filename    string
+	ContentType string
+	Data        []byte
+}
+
+// SendEmailWithAttachments 发送带附件的 HTML 邮件（使用数据库中保存的配置）
+func (s *EmailService) SendEmailWithAttachments(ctx context.Context, to, subject, body string, attachments []EmailAttachment) error {
+	config, err := s.GetSMTPConfig(ctx)
+	if err != nil {
+		return err
+	}
+	from := config.From
+	if config.FromName != "" {
+		from = fmt.Sprintf("%s <%s>", config.FromName, config.From)
+	}
+	msg, err := buildMultipartMessage(from, to, subject, body, attachments)
+	if err != nil {
+		return err
+	}
+	return s.deliver(config, to, msg)
+}
+
+// buildMultipartMessage 构造 multipart/mixed 邮件：HTML 正文 + base64 编码的附件
+func buildMultipartMessage(from, to, subject, body string, attachments []EmailAttachment) ([]byte, error) {
+	var buf bytes.Buffer
+	mw := multipart.NewWriter(&buf)
+	fmt.Fprintf(&buf, "From: %s\r\nTo: %s\r\nSubject: %s\r\nMIME-Version: 1.0\r\nContent-Type: multipart/mixed; boundary=%q\r\n\r\n",
+		from, to, mime.QEncoding.Encode("utf-8", subject), mw.Boundary())
+
+	part, err := mw.CreatePart(textproto.MIMEHeader{"Content-Type": {"text/html; charset=UTF-8"}})
+	if err != nil {
+		return nil, fmt.Errorf("create body part: %w", err)
+	}
+	if _, err := part.Write([]byte(body)); err != nil {
+		return nil, fmt.Errorf("write body part: %w", err)
+	}
+
+	for _, a := range attachments {
+		contentType := a.ContentType
+		if contentType == "" {
+			contentType = "application/octet-stream"
+		}
+		part, err := mw.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {mime.FormatMediaType(contentType, map[string]string{"name": a.Filename})},
+			"Content-Disposition":       {mime.FormatMediaType("attachment", map[string]string{"filename": a.Filename})},
+			"Content-Transfer-Encoding": {"base64"},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("create attachment part: %w", err)
+		}
+		// RFC 2045 要求 base64 每行不超过 76 字符
+		encoded := base64.StdEncoding.EncodeToString(a.Data)
+		for len(encoded) > 76 {
+			if _, err := part.Write([]byte(encoded[:76] + "\r\n")); err != nil {
+				return nil, fmt.Errorf("write attachment part: %w", err)
+			}
+			encoded = encoded[76:]
+		}
+		if _, err := part.Write([]byte(encoded)); err != nil {
+			return nil, fmt.Errorf("write attachment part: %w", err)
+		}
+	}
+	if err := mw.Close(); err != nil {
+		return nil, fmt.Errorf("close multipart writer: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// deliver 通过 SMTP 投递已构造好的邮件
+func (s *EmailService) deliver(config *SMTPConfig, to string, msg []byte) error {
 	addr := fmt.Sprintf("%s:%d", config.Host, config.Port)
 	auth := smtp.PlainAuth("", config.Username, config.Password, config.Host)
 
 	if config.UseTLS {
-		return s.sendMailTLS(addr, auth, config.From, to, []byte(msg), config.Host)
+		return s.sendMailTLS(addr, auth, config.From, to, msg, config.Host)
 	}
 
-	return smtp.SendMail(addr, auth, config.From, []string{to}, []byte(msg))
+	return smtp.SendMail(addr, auth, config.From, []string{to}, msg)
 }
 
 // sendMailTLS 使用TLS发送邮件
@@ -0,0 +1,294 @@
+package service
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/logger"
+)
+
+// EphemeralKeyPrefix 临时子密钥前缀
+const EphemeralKeyPrefix = "sk-eph-"
+
+const (
+	ephemeralKeyDefaultTTL = time.Hour
+	ephemeralKeyMinTTL     = time.Minute
+	ephemeralKeyMaxTTL     = 24 * time.Hour
+	// ephemeralKeyMaxModels 单个临时子密钥最多允许的模型数量（控制 Token 长度）
+	ephemeralKeyMaxModels = 32
+	// ephemeralKeyMaxEndUserLen 终端用户标识最大长度（与 usage_logs.end_user_id 一致）
+	ephemeralKeyMaxEndUserLen = 128
+)
+
+var (
+	ErrEphemeralKeyInvalid         = infraerrors.Unauthorized("EPHEMERAL_KEY_INVALID", "invalid ephemeral key")
+	ErrEphemeralKeyExpired         = infraerrors.Unauthorized("EPHEMERAL_KEY_EXPIRED", "ephemeral key has expired")
+	ErrEphemeralKeyRPMExceeded     = infraerrors.TooManyRequests("EPHEMERAL_KEY_RPM_EXCEEDED", "ephemeral key rpm limit exceeded")
+	ErrEphemeralKeySpendExceeded   = infraerrors.TooManyRequests("EPHEMERAL_KEY_SPEND_EXCEEDED", "ephemeral key spend limit exceeded")
+	ErrEphemeralKeyModelNotAllowed = infraerrors.Forbidden("EPHEMERAL_KEY_MODEL_NOT_ALLOWED", "model is not allowed for this ephemeral key")
+	ErrEphemeralKeyNested          = infraerrors.Forbidden("EPHEMERAL_KEY_NESTED", "ephemeral keys cannot mint other ephemeral keys")
+	ErrEphemeralKeyInvalidTTL      = infraerrors.BadRequest("EPHEMERAL_KEY_INVALID_TTL", "ttl_seconds must be between 60 and 86400")
+	ErrEphemeralKeyInvalidScope    = infraerrors.BadRequest("EPHEMERAL_KEY_INVALID_SCOPE", "invalid ephemeral key scope")
+	ErrEphemeralKeyUnavailable     = infraerrors.ServiceUnavailable("EPHEMERAL_KEY_UNAVAILABLE", "ephemeral keys are not available")
+)
+
+// EphemeralKeyCache 临时子密钥的 RPM 与消费计数（由 APIKeyCache 的实现可选提供）
+type EphemeralKeyCache interface {
+	// IncrementEphemeralKeyRequests 递增当前分钟的请求数并返回递增后的值
+	IncrementEphemeralKeyRequests(ctx context.Context, subKeyID string, minute int64) (int64, error)
+	GetEphemeralKeySpend(ctx context.Context, subKeyID string) (float64, error)
+	AddEphemeralKeySpend(ctx context.Context, subKeyID string, amount float64, ttl time.Duration) error
+}
+
+// EphemeralKeyClaims 临时子密钥内容（加密后放入 Token，无需落库即可离线校验）
+type EphemeralKeyClaims struct {
+	ID          string   `json:"id"`
+	ParentKey   string   `json:"pk"`
+	ParentKeyID int64    `json:"pid"`
+	Models      []string `json:"models,omitempty"`
+	SpendLimit  float64  `json:"spend,omitempty"`
+	RPMLimit    int      `json:"rpm,omitempty"`
+	EndUserID   string   `json:"eu,omitempty"`
+	IssuedAt    int64    `json:"iat"`
+	ExpiresAt   int64    `json:"exp"`
+}
+
+// AllowsModel 检查模型是否在子密钥允许范围内
+// 未限制模型时允许全部；支持末尾通配符，如 "claude-sonnet-*"
+func (c *EphemeralKeyClaims) AllowsModel(model string) bool {
+	if c == nil || len(c.Models) == 0 {
+		return true
+	}
+	model = strings.TrimSpace(model)
+	for _, allowed := range c.Models {
+		if prefix, ok := strings.CutSuffix(allowed, "*"); ok {
+			if strings.HasPrefix(strings.ToLower(model), strings.ToLower(prefix)) {
+				return true
+			}
+			continue
+		}
+		if strings.EqualFold(allowed, model) {
+			return true
+		}
+	}
+	return false
+}
+
+// MintEphemeralKeyRequest 签发临时子密钥请求
+type MintEphemeralKeyRequest struct {
+	TTLSeconds int      `json:"ttl_seconds"`
+	Models     []string `json:"models"`
+	SpendLimit float64  `json:"spend_limit"`
+	RPMLimit   int      `json:"rpm_limit"`
+	EndUserID  string   `json:"end_user_id"`
+}
+
+// EphemeralKey 签发结果
+type EphemeralKey struct {
+	Key        string    `json:"key"`
+	ID         string    `json:"id"`
+	Models     []string  `json:"models"`
+	SpendLimit float64   `json:"spend_limit"`
+	RPMLimit   int       `json:"rpm_limit"`
+	EndUserID  string    `json:"end_user_id,omitempty"`
+	ExpiresAt  time.Time `json:"expires_at"`
+}
+
+// IsEphemeralKey 判断字符串是否为临时子密钥
+func IsEphemeralKey(key string) bool {
+	return strings.HasPrefix(key, EphemeralKeyPrefix)
+}
+
+// ephemeralKeyAEAD 基于 JWT 密钥派生加密密钥，使各实例无需共享状态即可校验子密钥
+func (s *APIKeyService) ephemeralKeyAEAD() (cipher.AEAD, error) {
+	if s.cfg == nil || s.cfg.JWT.Secret == "" {
+		return nil, ErrEphemeralKeyUnavailable
+	}
+	sum := sha256.Sum256([]byte("sub2api:ephemeral-key:" + s.cfg.JWT.Secret))
+	block, err := aes.NewCipher(sum[:])
+	if err != nil {
+		return nil, fmt.Errorf("create cipher: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
+
+// MintEphemeralKey 基于父 API Key 签发临时子密钥
+func (s *APIKeyService) MintEphemeralKey(ctx context.Context, parent *APIKey, req MintEphemeralKeyRequest) (*EphemeralKey, error) {
+	if parent == nil {
+		return nil, ErrAPIKeyNotFound
+	}
+	if parent.Ephemeral != nil {
+		return nil, ErrEphemeralKeyNested
+	}
+
+	ttl := ephemeralKeyDefaultTTL
+	if req.TTLSeconds != 0 {
+		ttl = time.Duration(req.TTLSeconds) * time.Second
+		if ttl < ephemeralKeyMinTTL || ttl > ephemeralKeyMaxTTL {
+			return nil, ErrEphemeralKeyInvalidTTL
+		}
+	}
+	models, err := normalizeEphemeralKeyModels(req.Models)
+	if err != nil {
+		return nil, err
+	}
+	endUserID := strings.TrimSpace(req.EndUserID)
+	if req.SpendLimit < 0 || req.RPMLimit < 0 || len(endUserID) > ephemeralKeyMaxEndUserLen {
+		return nil, ErrEphemeralKeyInvalidScope
+	}
+
+	idBytes := make([]byte, 12)
+	if _, err := rand.Read(idBytes); err != nil {
+		return nil, fmt.Errorf("generate random bytes: %w", err)
+	}
+	now := time.Now()
+	claims := &EphemeralKeyClaims{
+		ID:          "eph_" + hex.EncodeToString(idBytes),
+		ParentKey:   parent.Key,
+		ParentKeyID: parent.ID,
+		Models:      models,
+		SpendLimit:  req.SpendLimit,
+		RPMLimit:    req.RPMLimit,
+		EndUserID:   endUserID,
+		IssuedAt:    now.Unix(),
+		ExpiresAt:   now.Add(ttl).Unix(),
+	}
+	token, err := s.sealEphemeralKey(claims)
+	if err != nil {
+		return nil, err
+	}
+	return &EphemeralKey{
+		Key:        token,
+		ID:         claims.ID,
+		Models:     models,
+		SpendLimit: claims.SpendLimit,
+		RPMLimit:   claims.RPMLimit,
+		EndUserID:  claims.EndUserID,
+		ExpiresAt:  time.Unix(claims.ExpiresAt, 0).UTC(),
+	}, nil
+}
+
+func normalizeEphemeralKeyModels(models []string) ([]string, error) {
+	out := make([]string, 0, len(models))
+	seen := make(map[string]struct{}, len(models))
+	for _, model := range models {
+		model = strings.TrimSpace(model)
+		if model == "" || model == "*" {
+			continue
+		}
+		if _, ok := seen[model]; ok {
+			continue
+		}
+		seen[model] = struct{}{}
+		out = append(out, model)
+	}
+	if len(out) > ephemeralKeyMaxModels {
+		return nil, ErrEphemeralKeyInvalidScope
+	}
+	return out, nil
+}
+
+func (s *APIKeyService) sealEphemeralKey(claims *EphemeralKeyClaims) (string, error) {
+	aead, err := s.ephemeralKeyAEAD()
+	if err != nil {
+		return "", err
+	}
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", fmt.Errorf("marshal ephemeral key claims: %w", err)
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("generate nonce: %w", err)
+	}
+	sealed := aead.Seal(nonce, nonce, payload, []byte(EphemeralKeyPrefix))
+	return EphemeralKeyPrefix + base64.RawURLEncoding.EncodeToString(sealed), nil
+}
+
+// ParseEphemeralKey 离线校验并解析临时子密钥
+func (s *APIKeyService) ParseEphemeralKey(token string) (*EphemeralKeyClaims, error) {
+	if !IsEphemeralKey(token) {
+		return nil, ErrEphemeralKeyInvalid
+	}
+	aead, err := s.ephemeralKeyAEAD()
+	if err != nil {
+		return nil, err
+	}
+	sealed, err := base64.RawURLEncoding.DecodeString(strings.TrimPrefix(token, EphemeralKeyPrefix))
+	if err != nil || len(sealed) < aead.NonceSize() {
+		return nil, ErrEphemeralKeyInvalid
+	}
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	payload, err := aead.Open(nil, nonce, ciphertext, []byte(EphemeralKeyPrefix))
+	if err != nil {
+		return nil, ErrEphemeralKeyInvalid
+	}
+	var claims EphemeralKeyClaims
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.ID == "" || claims.ParentKey == "" {
+		return nil, ErrEphemeralKeyInvalid
+	}
+	if time.Now().Unix() >= claims.ExpiresAt {
+		return nil, ErrEphemeralKeyExpired
+	}
+	return &claims, nil
+}
+
+// CheckEphemeralKeyLimits 计入一次请求并检查子密钥的 RPM 与消费上限
+// 计数缓存不可用时放行（fail-open），与其他限流保持一致
+func (s *APIKeyService) CheckEphemeralKeyLimits(ctx context.Context, claims *EphemeralKeyClaims) error {
+	if claims == nil || s.ephemeralCache == nil {
+		return nil
+	}
+	if claims.SpendLimit > 0 {
+		spent, err := s.ephemeralCache.GetEphemeralKeySpend(ctx, claims.ID)
+		if err != nil {
+			logger.LegacyPrintf("service.api_key", "[EphemeralKey] get spend failed: sub_key=%s err=%v", claims.ID, err)
+		} else if spent >= claims.SpendLimit {
+			return ErrEphemeralKeySpendExceeded
+		}
+	}
+	if claims.RPMLimit > 0 {
+		count, err := s.ephemeralCache.IncrementEphemeralKeyRequests(ctx, claims.ID, time.Now().Unix()/60)
+		if err != nil {
+			logger.LegacyPrintf("service.api_key", "[EphemeralKey] increment rpm failed: sub_key=%s err=%v", claims.ID, err)
+		} else if count > int64(claims.RPMLimit) {
+			return ErrEphemeralKeyRPMExceeded
+		}
+	}
+	return nil
+}
+
+// RecordEphemeralKeySpend 累加子密钥消费（计数随子密钥过期自动清理）
+func (s *APIKeyService) RecordEphemeralKeySpend(ctx context.Context, claims *EphemeralKeyClaims, cost float64) error {
+	if claims == nil || claims.SpendLimit <= 0 || cost <= 0 || s.ephemeralCache == nil {
+		return nil
+	}
+	ttl := time.Until(time.Unix(claims.ExpiresAt, 0)) + time.Minute
+	if ttl < time.Minute {
+		ttl = time.Minute
+	}
+	return s.ephemeralCache.AddEphemeralKeySpend(ctx, claims.ID, cost, ttl)
+}
+
+// applyEphemeralKeyToUsageLog 记录发起请求的子密钥 ID 与终端用户标识
+func applyEphemeralKeyToUsageLog(usageLog *UsageLog, apiKey *APIKey) {
+	if usageLog == nil || apiKey == nil || apiKey.Ephemeral == nil {
+		return
+	}
+	subKeyID := apiKey.Ephemeral.ID
+	usageLog.SubKeyID = &subKeyID
+	if apiKey.Ephemeral.EndUserID != "" {
+		endUserID := apiKey.Ephemeral.EndUserID
+		usageLog.EndUserID = &endUserID
+	}
+}
@@ -0,0 +1,159 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/stretchr/testify/require"
+)
+
+type ephemeralKeyCacheStub struct {
+	mu       sync.Mutex
+	requests map[string]int64
+	spend    map[string]float64
+}
+
+func newEphemeralKeyCacheStub() *ephemeralKeyCacheStub {
+	return &ephemeralKeyCacheStub{requests: map[string]int64{}, spend: map[string]float64{}}
+}
+
+func (s *ephemeralKeyCacheStub) IncrementEphemeralKeyRequests(_ context.Context, subKeyID string, _ int64) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests[subKeyID]++
+	return s.requests[subKeyID], nil
+}
+
+func (s *ephemeralKeyCacheStub) GetEphemeralKeySpend(_ context.Context, subKeyID string) (float64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.spend[subKeyID], nil
+}
+
+func (s *ephemeralKeyCacheStub) AddEphemeralKeySpend(_ context.Context, subKeyID string, amount float64, _ time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.spend[subKeyID] += amount
+	return nil
+}
+
+func newEphemeralKeyTestService(secret string) *APIKeyService {
+	cfg := &config.Config{}
+	cfg.JWT.Secret = secret
+	return &APIKeyService{cfg: cfg}
+}
+
+func TestMintEphemeralKey_RoundTrip(t *testing.T) {
+	svc := newEphemeralKeyTestService("test-secret")
+	parent := &APIKey{ID: 42, Key: "sk-parent-key-0123456789"}
+
+	minted, err := svc.MintEphemeralKey(context.Background(), parent, MintEphemeralKeyRequest{
+		TTLSeconds: 600,
+		Models:     []string{" claude-sonnet-* ", "gpt-5", "gpt-5", ""},
+		SpendLimit: 1.5,
+		RPMLimit:   10,
+		EndUserID:  "user-1",
+	})
+	require.NoError(t, err)
+	require.True(t, IsEphemeralKey(minted.Key))
+	require.NotContains(t, minted.Key, parent.Key)
+	require.Equal(t, []string{"claude-sonnet-*", "gpt-5"}, minted.Models)
+	require.WithinDuration(t, time.Now().Add(10*time.Minute), minted.ExpiresAt, 2*time.Second)
+
+	claims, err := svc.ParseEphemeralKey(minted.Key)
+	require.NoError(t, err)
+	require.Equal(t, minted.ID, claims.ID)
+	require.Equal(t, parent.Key, claims.ParentKey)
+	require.Equal(t, parent.ID, claims.ParentKeyID)
+	require.Equal(t, "user-1", claims.EndUserID)
+	require.True(t, claims.AllowsModel("claude-sonnet-4-5"))
+	require.True(t, claims.AllowsModel("GPT-5"))
+	require.False(t, claims.AllowsModel("claude-opus-4-1"))
+}
+
+func TestMintEphemeralKey_Validation(t *testing.T) {
+	svc := newEphemeralKeyTestService("test-secret")
+	parent := &APIKey{ID: 1, Key: "sk-parent-key-0123456789"}
+
+	_, err := svc.MintEphemeralKey(context.Background(), parent, MintEphemeralKeyRequest{TTLSeconds: 30})
+	require.ErrorIs(t, err, ErrEphemeralKeyInvalidTTL)
+	_, err = svc.MintEphemeralKey(context.Background(), parent, MintEphemeralKeyRequest{TTLSeconds: 2 * 86400})
+	require.ErrorIs(t, err, ErrEphemeralKeyInvalidTTL)
+	_, err = svc.MintEphemeralKey(context.Background(), parent, MintEphemeralKeyRequest{SpendLimit: -1})
+	require.ErrorIs(t, err, ErrEphemeralKeyInvalidScope)
+
+	scoped := *parent
+	scoped.Ephemeral = &EphemeralKeyClaims{ID: "eph_x"}
+	_, err = svc.MintEphemeralKey(context.Background(), &scoped, MintEphemeralKeyRequest{})
+	require.ErrorIs(t, err, ErrEphemeralKeyNested)
+
+	_, err = newEphemeralKeyTestService("").MintEphemeralKey(context.Background(), parent, MintEphemeralKeyRequest{})
+	require.ErrorIs(t, err, ErrEphemeralKeyUnavailable)
+}
+
+func TestParseEphemeralKey_RejectsTamperedExpiredAndForeign(t *testing.T) {
+	svc := newEphemeralKeyTestService("test-secret")
+	parent := &APIKey{ID: 1, Key: "sk-parent-key-0123456789"}
+	minted, err := svc.MintEphemeralKey(context.Background(), parent, MintEphemeralKeyRequest{})
+	require.NoError(t, err)
+
+	tampered := minted.Key[:len(minted.Key)-2] + "AA"
+	if tampered == minted.Key {
+		tampered = minted.Key[:len(minted.Key)-2] + "BB"
+	}
+	_, err = svc.ParseEphemeralKey(tampered)
+	require.ErrorIs(t, err, ErrEphemeralKeyInvalid)
+
+	_, err = newEphemeralKeyTestService("other-secret").ParseEphemeralKey(minted.Key)
+	require.ErrorIs(t, err, ErrEphemeralKeyInvalid)
+
+	_, err = svc.ParseEphemeralKey(EphemeralKeyPrefix + "!!!")
+	require.ErrorIs(t, err, ErrEphemeralKeyInvalid)
+
+	expired, err := svc.sealEphemeralKey(&EphemeralKeyClaims{
+		ID:          "eph_expired",
+		ParentKey:   parent.Key,
+		ParentKeyID: parent.ID,
+		IssuedAt:    time.Now().Add(-2 * time.Hour).Unix(),
+		ExpiresAt:   time.Now().Add(-time.Hour).Unix(),
+	})
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(expired, EphemeralKeyPrefix))
+	_, err = svc.ParseEphemeralKey(expired)
+	require.ErrorIs(t, err, ErrEphemeralKeyExpired)
+}
+
+func TestCheckEphemeralKeyLimits(t *testing.T) {
+	svc := newEphemeralKeyTestService("test-secret")
+	cache := newEphemeralKeyCacheStub()
+	svc.ephemeralCache = cache
+	claims := &EphemeralKeyClaims{ID: "eph_limits", RPMLimit: 2, SpendLimit: 1, ExpiresAt: time.Now().Add(time.Hour).Unix()}
+
+	require.NoError(t, svc.CheckEphemeralKeyLimits(context.Background(), claims))
+	require.NoError(t, svc.CheckEphemeralKeyLimits(context.Background(), claims))
+	require.ErrorIs(t, svc.CheckEphemeralKeyLimits(context.Background(), claims), ErrEphemeralKeyRPMExceeded)
+
+	cache.requests = map[string]int64{}
+	require.NoError(t, svc.RecordEphemeralKeySpend(context.Background(), claims, 0.6))
+	require.NoError(t, svc.CheckEphemeralKeyLimits(context.Background(), claims))
+	require.NoError(t, svc.RecordEphemeralKeySpend(context.Background(), claims, 0.6))
+	require.ErrorIs(t, svc.CheckEphemeralKeyLimits(context.Background(), claims), ErrEphemeralKeySpendExceeded)
+}
+
+func TestApplyEphemeralKeyToUsageLog(t *testing.T) {
+	log := &UsageLog{}
+	applyEphemeralKeyToUsageLog(log, &APIKey{ID: 1})
+	require.Nil(t, log.SubKeyID)
+
+	applyEphemeralKeyToUsageLog(log, &APIKey{ID: 1, Ephemeral: &EphemeralKeyClaims{ID: "eph_1", EndUserID: "end-user"}})
+	require.NotNil(t, log.SubKeyID)
+	require.Equal(t, "eph_1", *log.SubKeyID)
+	require.NotNil(t, log.EndUserID)
+	require.Equal(t, "end-user", *log.EndUserID)
+}
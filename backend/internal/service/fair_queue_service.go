@@ -0,0 +1,260 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/logger"
+	gocache "github.com/patrickmn/go-cache"
+)
+
+const (
+	// fairQueueTicketGrace 排队票据在等待超时之外额外保留的时间，防止进程异常退出后票据长期残留
+	fairQueueTicketGrace = 10 * time.Second
+	// fairQueueWeightScale 虚拟时间步长基数：一次服务的代价 = 基数 / 权重
+	fairQueueWeightScale = 1000.0
+)
+
+// FairQueueCache 分组级公平排队的存储接口（Redis 实现，多实例共享）
+//
+// 采用加权公平排队（WFQ）：每个用户在分组内维护“完成标签”，入队票据的分数为
+// max(分组虚拟时间, 用户上次完成标签) + 1000/权重；同一账号的等待者先按优先级分类、
+// 再按分数从小到大获得抢占资格。
+type FairQueueCache interface {
+	// Enter 入队并返回票据的开始标签
+	Enter(ctx context.Context, ticket *FairQueueTicket, cost float64, ttl time.Duration) (float64, error)
+	// IsEligible 检查票据是否处于该账号可抢占窗口内（窗口大小 = 账号剩余槽位，至少为 1）
+	// present=false 表示票据已丢失（过期被清理），调用方应重新入队
+	IsEligible(ctx context.Context, ticket *FairQueueTicket, maxConcurrency int) (eligible bool, present bool, err error)
+	// Leave 出队；served=true 时将分组虚拟时间推进到票据的开始标签
+	Leave(ctx context.Context, ticket *FairQueueTicket, served bool) error
+	// GetQueueDepths 返回当前排队数（按分组、用户、优先级分类聚合）
+	GetQueueDepths(ctx context.Context) ([]FairQueueDepth, error)
+}
+
+// FairQueueTicket 一次排队的票据
+type FairQueueTicket struct {
+	GroupID   int64
+	AccountID int64
+	UserID    int64
+	// Class 优先级分类（interactive/standard/batch）
+	Class string
+	ID    string
+	// Start 票据的开始标签（由 Enter 写回，出队时用于推进分组虚拟时间）
+	Start float64
+
+	enteredAt time.Time
+}
+
+// PriorityRank 票据的优先级排名（数值越小越先服务）
+func (t *FairQueueTicket) PriorityRank() int {
+	return priorityClassRank(t.Class)
+}
+
+// FairQueueDepth 排队深度聚合项
+type FairQueueDepth struct {
+	GroupID int64
+	UserID  int64
+	Class   string
+	Count   int
+}
+
+// FairQueueService 账号槽位等待的分组级公平排队
+type FairQueueService struct {
+	cache       FairQueueCache
+	defRepo     UserAttributeDefinitionRepository
+	valueRepo   UserAttributeValueRepository
+	cfg         config.GatewayFairQueueConfig
+	priorityCfg config.GatewayPriorityClassConfig
+	weightCache *gocache.Cache
+	classStats  *priorityClassTracker
+}
+
+// NewFairQueueService 创建公平排队服务
+func NewFairQueueService(
+	cache FairQueueCache,
+	defRepo UserAttributeDefinitionRepository,
+	valueRepo UserAttributeValueRepository,
+	cfg *config.Config,
+) *FairQueueService {
+	svc := &FairQueueService{
+		cache:     cache,
+		defRepo:   defRepo,
+		valueRepo: valueRepo,
+	}
+	if cfg != nil {
+		svc.cfg = cfg.Gateway.FairQueue
+		svc.priorityCfg = cfg.Gateway.PriorityClasses
+	}
+	ttl := time.Duration(svc.cfg.WeightCacheTTLSeconds) * time.Second
+	if ttl <= 0 {
+		ttl = time.Minute
+	}
+	svc.weightCache = gocache.New(ttl, 2*ttl)
+	svc.classStats = newPriorityClassTracker(time.Duration(svc.priorityCfg.StatsWindowSeconds) * time.Second)
+	return svc
+}
+
+// Enabled 是否启用分组等待队列（公平排队或优先级分类任一启用即生效）
+func (s *FairQueueService) Enabled() bool {
+	return s != nil && s.cache != nil && (s.cfg.Enabled || s.priorityCfg.Enabled)
+}
+
+// PriorityClassesEnabled 是否启用请求优先级分类
+func (s *FairQueueService) PriorityClassesEnabled() bool {
+	return s.Enabled() && s.priorityCfg.Enabled
+}
+
+// Enter 为等待账号槽位的请求入队。未启用或无分组时返回 nil（调用方按原有方式等待）。
+func (s *FairQueueService) Enter(ctx context.Context, groupID, accountID, userID int64, class string, timeout time.Duration) *FairQueueTicket {
+	if !s.Enabled() || groupID <= 0 || accountID <= 0 || userID <= 0 {
+		return nil
+	}
+	if !s.priorityCfg.Enabled {
+		class = PriorityClassStandard
+	}
+	ticket := &FairQueueTicket{
+		GroupID:   groupID,
+		AccountID: accountID,
+		UserID:    userID,
+		Class:     NormalizePriorityClass(class),
+		ID:        generateRequestID(),
+		enteredAt: time.Now(),
+	}
+	cost := fairQueueWeightScale / s.UserWeight(ctx, userID)
+	start, err := s.cache.Enter(ctx, ticket, cost, timeout+fairQueueTicketGrace)
+	if err != nil {
+		// 公平排队失败不影响请求：退化为先到先得
+		logger.LegacyPrintf("service.fair_queue", "Warning: enter fair queue failed group=%d account=%d user=%d: %v", groupID, accountID, userID, err)
+		return nil
+	}
+	ticket.Start = start
+	s.classStats.enter(ticket.Class)
+	return ticket
+}
+
+// Ready 检查票据当前是否轮到抢占槽位。存储异常时放行（fail-open），票据丢失时自动重新入队。
+func (s *FairQueueService) Ready(ctx context.Context, ticket *FairQueueTicket, maxConcurrency int, timeout time.Duration) bool {
+	if ticket == nil || !s.Enabled() {
+		return true
+	}
+	eligible, present, err := s.cache.IsEligible(ctx, ticket, maxConcurrency)
+	if err != nil {
+		return true
+	}
+	if !present {
+		cost := fairQueueWeightScale / s.UserWeight(ctx, ticket.UserID)
+		start, enterErr := s.cache.Enter(ctx, ticket, cost, timeout+fairQueueTicketGrace)
+		if enterErr != nil {
+			return true
+		}
+		ticket.Start = start
+		return false
+	}
+	return eligible
+}
+
+// Leave 出队。served 表示已获得槽位（推进分组虚拟时间）。
+// 使用独立 context，确保客户端断开时也能清理票据。
+func (s *FairQueueService) Leave(ticket *FairQueueTicket, served bool) {
+	if ticket == nil || !s.Enabled() {
+		return
+	}
+	s.classStats.leave(ticket.Class, time.Since(ticket.enteredAt), served, time.Now())
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	if err := s.cache.Leave(ctx, ticket, served); err != nil {
+		logger.LegacyPrintf("service.fair_queue", "Warning: leave fair queue failed group=%d ticket=%s: %v", ticket.GroupID, ticket.ID, err)
+	}
+}
+
+// UserWeight 返回用户在公平排队中的权重（来自用户属性，缺省为 default_weight）。
+// 仅启用优先级分类而未启用公平排队时，所有用户权重相同。
+func (s *FairQueueService) UserWeight(ctx context.Context, userID int64) float64 {
+	if !s.cfg.Enabled {
+		return 1
+	}
+	defaultWeight := s.cfg.DefaultWeight
+	if defaultWeight <= 0 {
+		defaultWeight = 1
+	}
+	key := strings.TrimSpace(s.cfg.WeightAttributeKey)
+	if key == "" || s.defRepo == nil || s.valueRepo == nil || userID <= 0 {
+		return defaultWeight
+	}
+
+	cacheKey := strconv.FormatInt(userID, 10)
+	if cached, ok := s.weightCache.Get(cacheKey); ok {
+		if weight, ok := cached.(float64); ok {
+			return weight
+		}
+	}
+
+	weight := defaultWeight
+	if def, err := s.defRepo.GetByKey(ctx, key); err == nil && def != nil && def.Enabled {
+		if values, err := s.valueRepo.GetByUserID(ctx, userID); err == nil {
+			for _, v := range values {
+				if v.AttributeID != def.ID {
+					continue
+				}
+				if parsed, err := strconv.ParseFloat(strings.TrimSpace(v.Value), 64); err == nil && parsed > 0 {
+					weight = parsed
+				}
+				break
+			}
+		}
+	}
+	s.weightCache.Set(cacheKey, weight, gocache.DefaultExpiration)
+	return weight
+}
+
+// GetQueueDepths 返回各分组内每个用户的公平排队深度（未启用时返回空）
+func (s *FairQueueService) GetQueueDepths(ctx context.Context) map[int64]map[int64]int {
+	if !s.Enabled() {
+		return map[int64]map[int64]int{}
+	}
+	depths, err := s.cache.GetQueueDepths(ctx)
+	if err != nil {
+		logger.LegacyPrintf("service.fair_queue", "Warning: get fair queue depths failed: %v", err)
+		return map[int64]map[int64]int{}
+	}
+	out := make(map[int64]map[int64]int)
+	for _, d := range depths {
+		users, ok := out[d.GroupID]
+		if !ok {
+			users = make(map[int64]int)
+			out[d.GroupID] = users
+		}
+		users[d.UserID] += d.Count
+	}
+	return out
+}
+
+// GetPriorityClassStats 返回各优先级分类的排队统计（队列深度为全局，延迟为当前实例滑动窗口）。
+// groupID 非空时仅统计该分组的队列深度。未启用优先级分类时返回 nil。
+func (s *FairQueueService) GetPriorityClassStats(ctx context.Context, groupID *int64) []*PriorityClassQueueStats {
+	if !s.PriorityClassesEnabled() {
+		return nil
+	}
+	stats := s.classStats.snapshot(time.Now())
+	if depths, err := s.cache.GetQueueDepths(ctx); err == nil {
+		for _, d := range depths {
+			if groupID != nil && d.GroupID != *groupID {
+				continue
+			}
+			if item := stats[NormalizePriorityClass(d.Class)]; item != nil {
+				item.QueueDepth += int64(d.Count)
+			}
+		}
+	} else {
+		logger.LegacyPrintf("service.fair_queue", "Warning: get fair queue depths failed: %v", err)
+	}
+	out := make([]*PriorityClassQueueStats, 0, len(PriorityClasses))
+	for _, class := range PriorityClasses {
+		out = append(out, stats[class])
+	}
+	return out
+}
@@ -0,0 +1,146 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/stretchr/testify/require"
+)
+
+type fairQueueCacheStub struct {
+	enterCost   float64
+	enterCalls  int
+	enterClass  string
+	eligible    bool
+	present     bool
+	eligibleErr error
+	leaveServed *bool
+}
+
+func (s *fairQueueCacheStub) Enter(_ context.Context, ticket *FairQueueTicket, cost float64, _ time.Duration) (float64, error) {
+	s.enterCalls++
+	s.enterCost = cost
+	s.enterClass = ticket.Class
+	return 42, nil
+}
+
+func (s *fairQueueCacheStub) IsEligible(context.Context, *FairQueueTicket, int) (bool, bool, error) {
+	return s.eligible, s.present, s.eligibleErr
+}
+
+func (s *fairQueueCacheStub) Leave(_ context.Context, _ *FairQueueTicket, served bool) error {
+	s.leaveServed = &served
+	return nil
+}
+
+func (s *fairQueueCacheStub) GetQueueDepths(context.Context) ([]FairQueueDepth, error) {
+	return []FairQueueDepth{
+		{GroupID: 1, UserID: 7, Class: PriorityClassStandard, Count: 1},
+		{GroupID: 1, UserID: 7, Class: PriorityClassBatch, Count: 1},
+		{GroupID: 2, UserID: 8, Class: PriorityClassInteractive, Count: 3},
+	}, nil
+}
+
+type fairQueueAttrDefRepoStub struct {
+	UserAttributeDefinitionRepository
+	def *UserAttributeDefinition
+}
+
+func (r *fairQueueAttrDefRepoStub) GetByKey(context.Context, string) (*UserAttributeDefinition, error) {
+	if r.def == nil {
+		return nil, errors.New("not found")
+	}
+	return r.def, nil
+}
+
+type fairQueueAttrValueRepoStub struct {
+	UserAttributeValueRepository
+	values map[int64][]UserAttributeValue
+	calls  int
+}
+
+func (r *fairQueueAttrValueRepoStub) GetByUserID(_ context.Context, userID int64) ([]UserAttributeValue, error) {
+	r.calls++
+	return r.values[userID], nil
+}
+
+func newFairQueueTestService(cache FairQueueCache, valueRepo *fairQueueAttrValueRepoStub) *FairQueueService {
+	cfg := &config.Config{}
+	cfg.Gateway.FairQueue = config.GatewayFairQueueConfig{
+		Enabled:            true,
+		WeightAttributeKey: "queue_weight",
+		DefaultWeight:      1,
+	}
+	defRepo := &fairQueueAttrDefRepoStub{def: &UserAttributeDefinition{ID: 5, Key: "queue_weight", Enabled: true}}
+	return NewFairQueueService(cache, defRepo, valueRepo, cfg)
+}
+
+func TestFairQueueService_UserWeightFromAttribute(t *testing.T) {
+	valueRepo := &fairQueueAttrValueRepoStub{values: map[int64][]UserAttributeValue{
+		1: {{AttributeID: 5, Value: "4"}},
+		2: {{AttributeID: 5, Value: "-1"}},
+		3: {{AttributeID: 9, Value: "8"}},
+	}}
+	svc := newFairQueueTestService(&fairQueueCacheStub{}, valueRepo)
+
+	require.Equal(t, 4.0, svc.UserWeight(context.Background(), 1))
+	require.Equal(t, 1.0, svc.UserWeight(context.Background(), 2), "非法权重回退默认值")
+	require.Equal(t, 1.0, svc.UserWeight(context.Background(), 3), "其他属性不影响权重")
+
+	// 命中本地缓存
+	calls := valueRepo.calls
+	require.Equal(t, 4.0, svc.UserWeight(context.Background(), 1))
+	require.Equal(t, calls, valueRepo.calls)
+}
+
+func TestFairQueueService_EnterReadyLeave(t *testing.T) {
+	cache := &fairQueueCacheStub{present: true}
+	valueRepo := &fairQueueAttrValueRepoStub{values: map[int64][]UserAttributeValue{
+		7: {{AttributeID: 5, Value: "2"}},
+	}}
+	svc := newFairQueueTestService(cache, valueRepo)
+
+	require.Nil(t, svc.Enter(context.Background(), 0, 10, 7, PriorityClassStandard, time.Second), "无分组不排队")
+
+	ticket := svc.Enter(context.Background(), 1, 10, 7, PriorityClassBatch, time.Second)
+	require.NotNil(t, ticket)
+	require.Equal(t, fairQueueWeightScale/2, cache.enterCost)
+	require.Equal(t, PriorityClassStandard, cache.enterClass, "未启用优先级分类时统一为 standard")
+	require.Equal(t, float64(42), ticket.Start)
+
+	require.False(t, svc.Ready(context.Background(), ticket, 1, time.Second))
+	cache.eligible = true
+	require.True(t, svc.Ready(context.Background(), ticket, 1, time.Second))
+
+	// 票据丢失时重新入队并继续等待
+	cache.present = false
+	require.False(t, svc.Ready(context.Background(), ticket, 1, time.Second))
+	require.Equal(t, 2, cache.enterCalls)
+
+	// 存储异常时放行
+	cache.eligibleErr = errors.New("redis down")
+	require.True(t, svc.Ready(context.Background(), ticket, 1, time.Second))
+
+	svc.Leave(ticket, true)
+	require.NotNil(t, cache.leaveServed)
+	require.True(t, *cache.leaveServed)
+
+	require.Equal(t, map[int64]map[int64]int{1: {7: 2}, 2: {8: 3}}, svc.GetQueueDepths(context.Background()))
+	require.Nil(t, svc.GetPriorityClassStats(context.Background(), nil))
+}
+
+func TestFairQueueService_Disabled(t *testing.T) {
+	svc := NewFairQueueService(&fairQueueCacheStub{}, nil, nil, &config.Config{})
+	require.False(t, svc.Enabled())
+	require.Nil(t, svc.Enter(context.Background(), 1, 10, 7, PriorityClassStandard, time.Second))
+	require.Empty(t, svc.GetQueueDepths(context.Background()))
+
+	concurrency := NewConcurrencyService(nil)
+	concurrency.SetFairQueue(svc)
+	require.Nil(t, concurrency.FairQueue())
+}
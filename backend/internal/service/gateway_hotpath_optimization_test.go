@@ -214,7 +214,7 @@ func TestGetUserGroupRateMultiplier_UsesCacheAndSingleflight(t *testing.T) {
 		go func(idx int) {
 			defer wg.Done()
 			<-start
-			results[idx] = svc.getUserGroupRateMultiplier(context.Background(), 101, 202, 1.2)
+			results[idx] = svc.getUserGroupRateMultiplier(context.Background(), 101, 202, 1.2, nil)
 		}(i)
 	}
 
@@ -229,7 +229,7 @@ func TestGetUserGroupRateMultiplier_UsesCacheAndSingleflight(t *testing.T) {
 	require.Equal(t, int64(1), repo.calls.Load())
 
 	// 再次读取应命中缓存，不再回源。
-	got := svc.getUserGroupRateMultiplier(context.Background(), 101, 202, 1.2)
+	got := svc.getUserGroupRateMultiplier(context.Background(), 101, 202, 1.2, nil)
 	require.Equal(t, rate, got)
 	require.Equal(t, int64(1), repo.calls.Load())
 
@@ -257,7 +257,7 @@ func TestGetUserGroupRateMultiplier_FallbackOnRepoError(t *testing.T) {
 		},
 	}
 
-	got := svc.getUserGroupRateMultiplier(context.Background(), 101, 202, 1.25)
+	got := svc.getUserGroupRateMultiplier(context.Background(), 101, 202, 1.25, nil)
 	require.Equal(t, 1.25, got)
 	require.Equal(t, int64(1), repo.calls.Load())
 
@@ -278,7 +278,7 @@ func TestGetUserGroupRateMultiplier_CacheHitAndNilRepo(t *testing.T) {
 	key := "101:202"
 	svc.userGroupRateCache.Set(key, 2.3, time.Minute)
 
-	got := svc.getUserGroupRateMultiplier(context.Background(), 101, 202, 1.1)
+	got := svc.getUserGroupRateMultiplier(context.Background(), 101, 202, 1.1, nil)
 	require.Equal(t, 2.3, got)
 
 	hit, miss, load, _, fallback := GatewayUserGroupRateCacheStats()
@@ -293,10 +293,10 @@ func TestGetUserGroupRateMultiplier_CacheHitAndNilRepo(t *testing.T) {
 		userGroupRateCache: gocache.New(time.Minute, time.Minute),
 	}
 	svc2.userGroupRateCache.Set(key, 1.9, time.Minute)
-	require.Equal(t, 1.9, svc2.getUserGroupRateMultiplier(context.Background(), 101, 202, 1.4))
-	require.Equal(t, 1.4, svc2.getUserGroupRateMultiplier(context.Background(), 0, 202, 1.4))
+	require.Equal(t, 1.9, svc2.getUserGroupRateMultiplier(context.Background(), 101, 202, 1.4, nil))
+	require.Equal(t, 1.4, svc2.getUserGroupRateMultiplier(context.Background(), 0, 202, 1.4, nil))
 	svc2.userGroupRateCache.Delete(key)
-	require.Equal(t, 1.4, svc2.getUserGroupRateMultiplier(context.Background(), 101, 202, 1.4))
+	require.Equal(t, 1.4, svc2.getUserGroupRateMultiplier(context.Background(), 101, 202, 1.4, nil))
 }
 
 func TestWithWindowCostPrefetch_BatchReadAndContextReuse(t *testing.T) {
@@ -0,0 +1,209 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/model"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/logger"
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+	"github.com/tetratelabs/wazero/imports/wasi_snapshot_preview1"
+)
+
+// 插件 ABI（WASM 模块需遵循）：
+//
+//	导出 memory 与 alloc(size i32) i32，宿主通过 alloc 分配输入缓冲区；
+//	每个钩子导出为 hook(ptr i32, len i32) i64，输入为 UTF-8 JSON，
+//	返回值高 32 位为输出指针、低 32 位为输出长度，返回 0 表示不做修改。
+//	可选导入 sub2api.log(ptr i32, len i32) 输出调试日志；支持 WASI reactor 模块（_initialize）。
+const (
+	gatewayPluginAllocExport = "alloc"
+	gatewayPluginHostModule  = "sub2api"
+	gatewayPluginMaxOutput   = 8 << 20
+	// wasmPageSize WebAssembly 内存页大小（64KiB）
+	wasmPageSize = 64 << 10
+)
+
+var (
+	errGatewayPluginNoOutputMemory = errors.New("plugin does not export memory")
+	errGatewayPluginOutputTooLarge = errors.New("plugin output exceeds limit")
+)
+
+// gatewayPluginModule 编译后的插件模块（每个插件独立运行时以隔离内存上限）
+type gatewayPluginModule struct {
+	plugin   *model.GatewayPlugin
+	runtime  wazero.Runtime
+	compiled wazero.CompiledModule
+	timeout  time.Duration
+}
+
+// compileGatewayPlugin 编译插件模块并检测导出的钩子
+func compileGatewayPlugin(ctx context.Context, plugin *model.GatewayPlugin) (*gatewayPluginModule, []string, error) {
+	memoryLimitMB := plugin.MemoryLimitMB
+	if memoryLimitMB <= 0 {
+		memoryLimitMB = model.PluginDefaultMemoryLimitMB
+	}
+	cfg := wazero.NewRuntimeConfig().
+		WithMemoryLimitPages(uint32(memoryLimitMB * (1 << 20) / wasmPageSize)).
+		WithCloseOnContextDone(true)
+	rt := wazero.NewRuntimeWithConfig(ctx, cfg)
+
+	if _, err := wasi_snapshot_preview1.Instantiate(ctx, rt); err != nil {
+		_ = rt.Close(ctx)
+		return nil, nil, fmt.Errorf("instantiate wasi: %w", err)
+	}
+	if err := instantiateGatewayPluginHost(ctx, rt, plugin); err != nil {
+		_ = rt.Close(ctx)
+		return nil, nil, fmt.Errorf("instantiate host module: %w", err)
+	}
+
+	compiled, err := rt.CompileModule(ctx, plugin.Module)
+	if err != nil {
+		_ = rt.Close(ctx)
+		return nil, nil, fmt.Errorf("compile module: %w", err)
+	}
+
+	hooks, err := detectGatewayPluginHooks(compiled)
+	if err != nil {
+		_ = rt.Close(ctx)
+		return nil, nil, err
+	}
+
+	timeoutMs := plugin.TimeoutMs
+	if timeoutMs <= 0 {
+		timeoutMs = model.PluginDefaultTimeoutMs
+	}
+	return &gatewayPluginModule{
+		plugin:   plugin,
+		runtime:  rt,
+		compiled: compiled,
+		timeout:  time.Duration(timeoutMs) * time.Millisecond,
+	}, hooks, nil
+}
+
+// detectGatewayPluginHooks 校验 ABI 并返回模块实现的钩子
+func detectGatewayPluginHooks(compiled wazero.CompiledModule) ([]string, error) {
+	if len(compiled.ExportedMemories()) == 0 {
+		return nil, errGatewayPluginNoOutputMemory
+	}
+	exports := compiled.ExportedFunctions()
+	alloc, ok := exports[gatewayPluginAllocExport]
+	if !ok || !signatureMatches(alloc, []api.ValueType{api.ValueTypeI32}, []api.ValueType{api.ValueTypeI32}) {
+		return nil, errors.New("plugin must export alloc(i32) -> i32")
+	}
+
+	var hooks []string
+	for _, hook := range model.PluginHooks {
+		def, ok := exports[hook]
+		if !ok {
+			continue
+		}
+		if !signatureMatches(def, []api.ValueType{api.ValueTypeI32, api.ValueTypeI32}, []api.ValueType{api.ValueTypeI64}) {
+			return nil, fmt.Errorf("plugin hook %s must have signature (i32, i32) -> i64", hook)
+		}
+		hooks = append(hooks, hook)
+	}
+	if len(hooks) == 0 {
+		return nil, errors.New("plugin does not export any hook")
+	}
+	return hooks, nil
+}
+
+func signatureMatches(def api.FunctionDefinition, params, results []api.ValueType) bool {
+	gotParams, gotResults := def.ParamTypes(), def.ResultTypes()
+	if len(gotParams) != len(params) || len(gotResults) != len(results) {
+		return false
+	}
+	for i := range params {
+		if gotParams[i] != params[i] {
+			return false
+		}
+	}
+	for i := range results {
+		if gotResults[i] != results[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// instantiateGatewayPluginHost 注册宿主函数（调试日志）
+func instantiateGatewayPluginHost(ctx context.Context, rt wazero.Runtime, plugin *model.GatewayPlugin) error {
+	pluginID, pluginName := plugin.ID, plugin.Name
+	_, err := rt.NewHostModuleBuilder(gatewayPluginHostModule).
+		NewFunctionBuilder().
+		WithFunc(func(_ context.Context, m api.Module, ptr, size uint32) {
+			if size > 4096 {
+				size = 4096
+			}
+			if msg, ok := m.Memory().Read(ptr, size); ok {
+				logger.LegacyPrintf("service.gateway_plugin", "[GatewayPlugin] plugin=%d(%s) log: %s", pluginID, pluginName, string(msg))
+			}
+		}).
+		Export("log").
+		Instantiate(ctx)
+	return err
+}
+
+// instantiate 创建新的模块实例（实例非并发安全，按请求独占）
+func (m *gatewayPluginModule) instantiate(ctx context.Context) (api.Module, error) {
+	callCtx, cancel := context.WithTimeout(ctx, m.timeout)
+	defer cancel()
+	return m.runtime.InstantiateModule(callCtx, m.compiled,
+		wazero.NewModuleConfig().WithName("").WithStartFunctions("_initialize"))
+}
+
+// call 调用实例上的钩子，输入输出均为 JSON；超时会中断执行并关闭实例
+func (m *gatewayPluginModule) call(ctx context.Context, inst api.Module, hook string, input []byte) ([]byte, error) {
+	fn := inst.ExportedFunction(hook)
+	if fn == nil {
+		return nil, nil
+	}
+	alloc := inst.ExportedFunction(gatewayPluginAllocExport)
+	mem := inst.Memory()
+	if alloc == nil || mem == nil {
+		return nil, errGatewayPluginNoOutputMemory
+	}
+
+	callCtx, cancel := context.WithTimeout(ctx, m.timeout)
+	defer cancel()
+
+	res, err := alloc.Call(callCtx, uint64(len(input)))
+	if err != nil {
+		return nil, fmt.Errorf("alloc: %w", err)
+	}
+	ptr := uint32(res[0])
+	if !mem.Write(ptr, input) {
+		return nil, errors.New("alloc returned out-of-range pointer")
+	}
+
+	res, err = fn.Call(callCtx, uint64(ptr), uint64(len(input)))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", hook, err)
+	}
+	packed := res[0]
+	if packed == 0 {
+		return nil, nil
+	}
+	outPtr, outLen := uint32(packed>>32), uint32(packed)
+	if outLen > gatewayPluginMaxOutput {
+		return nil, errGatewayPluginOutputTooLarge
+	}
+	out, ok := mem.Read(outPtr, outLen)
+	if !ok {
+		return nil, errors.New("plugin output out of range")
+	}
+	// 复制输出，实例关闭后内存即失效
+	return append([]byte(nil), out...), nil
+}
+
+// close 释放运行时
+func (m *gatewayPluginModule) close(ctx context.Context) {
+	if m == nil || m.runtime == nil {
+		return
+	}
+	_ = m.runtime.Close(ctx)
+}
@@ -0,0 +1,720 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/Wei-Shaw/sub2api/internal/model"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/ctxkey"
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/logger"
+	"github.com/tetratelabs/wazero/api"
+)
+
+var (
+	// ErrGatewayPluginFailed fail-closed 插件执行失败时拒绝请求
+	ErrGatewayPluginFailed = infraerrors.ServiceUnavailable("GATEWAY_PLUGIN_FAILED", "request rejected: gateway plugin failed")
+	// ErrGatewayPluginRouteDenied 插件选择的分组不可用于当前 Key
+	ErrGatewayPluginRouteDenied = infraerrors.Forbidden("GATEWAY_PLUGIN_ROUTE_DENIED", "plugin selected a group that is not available for this API key")
+)
+
+const (
+	// gatewayPluginRetireDelay 插件更新后旧运行时延迟关闭，避免中断进行中的流式请求
+	gatewayPluginRetireDelay = 10 * time.Minute
+	// gatewayPluginUsageConcurrency on_usage 异步调用的并发上限，超出时丢弃
+	gatewayPluginUsageConcurrency = 16
+)
+
+// GatewayPluginRepository 定义网关插件的数据访问接口
+type GatewayPluginRepository interface {
+	// List 获取所有插件（包含模块二进制）
+	List(ctx context.Context) ([]*model.GatewayPlugin, error)
+	// GetByID 根据 ID 获取插件
+	GetByID(ctx context.Context, id int64) (*model.GatewayPlugin, error)
+	// Create 创建插件
+	Create(ctx context.Context, plugin *model.GatewayPlugin) (*model.GatewayPlugin, error)
+	// Update 更新插件
+	Update(ctx context.Context, plugin *model.GatewayPlugin) (*model.GatewayPlugin, error)
+	// Delete 删除插件
+	Delete(ctx context.Context, id int64) error
+}
+
+// GatewayPluginCache 定义插件更新通知接口（模块不进 Redis，各实例从数据库加载）
+type GatewayPluginCache interface {
+	// NotifyUpdate 通知其他实例重新加载
+	NotifyUpdate(ctx context.Context) error
+	// SubscribeUpdates 订阅更新通知
+	SubscribeUpdates(ctx context.Context, handler func())
+}
+
+// GatewayPluginService 网关 WASM 插件服务
+type GatewayPluginService struct {
+	repo      GatewayPluginRepository
+	cache     GatewayPluginCache
+	groupRepo GroupRepository
+
+	// 已编译的启用插件（按优先级排序）
+	modules   []*gatewayPluginModule
+	modulesMu sync.RWMutex
+	reloadMu  sync.Mutex
+
+	usageSem chan struct{}
+}
+
+// NewGatewayPluginService 创建网关插件服务
+func NewGatewayPluginService(
+	repo GatewayPluginRepository,
+	cache GatewayPluginCache,
+	groupRepo GroupRepository,
+) *GatewayPluginService {
+	svc := &GatewayPluginService{
+		repo:      repo,
+		cache:     cache,
+		groupRepo: groupRepo,
+		usageSem:  make(chan struct{}, gatewayPluginUsageConcurrency),
+	}
+
+	// 启动时加载并编译插件
+	ctx := context.Background()
+	if err := svc.reload(ctx); err != nil {
+		logger.LegacyPrintf("service.gateway_plugin", "[GatewayPluginService] Failed to load plugins on startup: %v", err)
+	}
+
+	// 订阅更新通知
+	if cache != nil {
+		cache.SubscribeUpdates(ctx, func() {
+			if err := svc.reload(context.Background()); err != nil {
+				logger.LegacyPrintf("service.gateway_plugin", "[GatewayPluginService] Failed to reload plugins on notification: %v", err)
+			}
+		})
+	}
+
+	return svc
+}
+
+// List 获取所有插件
+func (s *GatewayPluginService) List(ctx context.Context) ([]*model.GatewayPlugin, error) {
+	return s.repo.List(ctx)
+}
+
+// GetByID 根据 ID 获取插件
+func (s *GatewayPluginService) GetByID(ctx context.Context, id int64) (*model.GatewayPlugin, error) {
+	return s.repo.GetByID(ctx, id)
+}
+
+// Create 创建插件（上传时编译模块以校验 ABI 并检测钩子）
+func (s *GatewayPluginService) Create(ctx context.Context, plugin *model.GatewayPlugin) (*model.GatewayPlugin, error) {
+	if err := s.prepare(ctx, plugin); err != nil {
+		return nil, err
+	}
+
+	created, err := s.repo.Create(ctx, plugin)
+	if err != nil {
+		return nil, err
+	}
+
+	s.reloadAndNotify()
+	return created, nil
+}
+
+// Update 更新插件
+func (s *GatewayPluginService) Update(ctx context.Context, plugin *model.GatewayPlugin) (*model.GatewayPlugin, error) {
+	if err := s.prepare(ctx, plugin); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.repo.Update(ctx, plugin)
+	if err != nil {
+		return nil, err
+	}
+
+	s.reloadAndNotify()
+	return updated, nil
+}
+
+// Delete 删除插件
+func (s *GatewayPluginService) Delete(ctx context.Context, id int64) error {
+	if err := s.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	s.reloadAndNotify()
+	return nil
+}
+
+// prepare 校验配置并编译模块，回填钩子列表与模块摘要
+func (s *GatewayPluginService) prepare(ctx context.Context, plugin *model.GatewayPlugin) error {
+	if err := plugin.Validate(); err != nil {
+		return err
+	}
+	compiled, hooks, err := compileGatewayPlugin(ctx, plugin)
+	if err != nil {
+		return &model.ValidationError{Field: "module", Message: err.Error()}
+	}
+	compiled.close(ctx)
+
+	sum := sha256.Sum256(plugin.Module)
+	plugin.Hooks = hooks
+	plugin.ModuleSHA256 = hex.EncodeToString(sum[:])
+	plugin.ModuleSize = len(plugin.Module)
+	return nil
+}
+
+// reloadAndNotify 重新加载本实例插件并通知其他实例，使用独立上下文避免受请求取消影响
+func (s *GatewayPluginService) reloadAndNotify() {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	if err := s.reload(ctx); err != nil {
+		logger.LegacyPrintf("service.gateway_plugin", "[GatewayPluginService] Failed to reload plugins: %v", err)
+	}
+	if s.cache != nil {
+		if err := s.cache.NotifyUpdate(ctx); err != nil {
+			logger.LegacyPrintf("service.gateway_plugin", "[GatewayPluginService] Failed to notify plugin update: %v", err)
+		}
+	}
+}
+
+// reload 从数据库加载启用的插件并编译；未变化的插件复用已编译模块
+func (s *GatewayPluginService) reload(ctx context.Context) error {
+	plugins, err := s.repo.List(ctx)
+	if err != nil {
+		return err
+	}
+
+	s.reloadMu.Lock()
+	defer s.reloadMu.Unlock()
+
+	s.modulesMu.RLock()
+	previous := make(map[int64]*gatewayPluginModule, len(s.modules))
+	for _, m := range s.modules {
+		previous[m.plugin.ID] = m
+	}
+	s.modulesMu.RUnlock()
+
+	next := make([]*gatewayPluginModule, 0, len(plugins))
+	kept := make(map[*gatewayPluginModule]struct{}, len(plugins))
+	for _, p := range plugins {
+		if !p.Enabled {
+			continue
+		}
+		if old, ok := previous[p.ID]; ok && sameGatewayPluginRuntime(old.plugin, p) {
+			// 仅配置（分组/优先级/失败模式）变化时复用编译结果
+			reused := *old
+			reused.plugin = p
+			next = append(next, &reused)
+			kept[old] = struct{}{}
+			continue
+		}
+		compiled, hooks, err := compileGatewayPlugin(ctx, p)
+		if err != nil {
+			logger.LegacyPrintf("service.gateway_plugin", "[GatewayPluginService] Failed to compile plugin %d (%s): %v", p.ID, p.Name, err)
+			continue
+		}
+		p.Hooks = hooks
+		next = append(next, compiled)
+	}
+	sort.SliceStable(next, func(i, j int) bool {
+		return next[i].plugin.Priority < next[j].plugin.Priority
+	})
+
+	s.modulesMu.Lock()
+	s.modules = next
+	s.modulesMu.Unlock()
+
+	// 延迟关闭不再使用的运行时，进行中的请求仍持有实例
+	for _, old := range previous {
+		if _, ok := kept[old]; ok {
+			continue
+		}
+		retired := old
+		time.AfterFunc(gatewayPluginRetireDelay, func() {
+			retired.close(context.Background())
+		})
+	}
+	return nil
+}
+
+// sameGatewayPluginRuntime 模块与资源限制均未变化时可复用运行时
+func sameGatewayPluginRuntime(a, b *model.GatewayPlugin) bool {
+	return a.ModuleSHA256 == b.ModuleSHA256 &&
+		a.TimeoutMs == b.TimeoutMs &&
+		a.MemoryLimitMB == b.MemoryLimitMB
+}
+
+// modulesForGroup 返回挂载到指定分组的启用插件
+func (s *GatewayPluginService) modulesForGroup(groupID int64) []*gatewayPluginModule {
+	s.modulesMu.RLock()
+	defer s.modulesMu.RUnlock()
+
+	var matched []*gatewayPluginModule
+	for _, m := range s.modules {
+		if m.plugin.AppliesToGroup(groupID) {
+			matched = append(matched, m)
+		}
+	}
+	return matched
+}
+
+// NewSession 为一次请求创建插件会话；分组未挂载任何插件时返回 nil
+func (s *GatewayPluginService) NewSession(groupID int64) *GatewayPluginSession {
+	if s == nil {
+		return nil
+	}
+	modules := s.modulesForGroup(groupID)
+	if len(modules) == 0 {
+		return nil
+	}
+	return &GatewayPluginSession{
+		svc:       s,
+		groupID:   groupID,
+		modules:   modules,
+		instances: make(map[*gatewayPluginModule]api.Module, len(modules)),
+		failed:    make(map[*gatewayPluginModule]bool),
+	}
+}
+
+// ResolveRouteGroup 校验插件选择的分组：需为同平台、启用、标准计费且当前用户可用
+func (s *GatewayPluginService) ResolveRouteGroup(ctx context.Context, apiKey *APIKey, groupID int64) (*Group, error) {
+	if apiKey == nil || apiKey.Group == nil || apiKey.User == nil || s.groupRepo == nil {
+		return nil, ErrGatewayPluginRouteDenied
+	}
+	current := apiKey.Group
+	if current.ID == groupID {
+		return current, nil
+	}
+	group, err := s.groupRepo.GetByID(ctx, groupID)
+	if err != nil || group == nil {
+		return nil, ErrGatewayPluginRouteDenied
+	}
+	// 订阅分组的额度校验绑定在原分组上，不允许插件切入或切出
+	if !group.IsActive() || group.Platform != current.Platform ||
+		group.IsSubscriptionType() || current.IsSubscriptionType() ||
+		!apiKey.User.CanBindGroup(group.ID, group.IsExclusive) {
+		return nil, ErrGatewayPluginRouteDenied
+	}
+	return group, nil
+}
+
+// GatewayPluginRequest on_request 钩子的请求视图
+type GatewayPluginRequest struct {
+	Platform string
+	Method   string
+	Path     string
+	Model    string
+	Header   http.Header
+	Body     []byte
+}
+
+// GatewayPluginRejection 插件拒绝请求时返回的状态码与错误信息
+type GatewayPluginRejection struct {
+	Status  int    `json:"status"`
+	Message string `json:"message"`
+}
+
+// GatewayPluginRouteDecision on_route 钩子的路由决策
+type GatewayPluginRouteDecision struct {
+	GroupID *int64 `json:"group_id,omitempty"`
+	Model   string `json:"model,omitempty"`
+}
+
+type gatewayPluginRequestInput struct {
+	Hook        string            `json:"hook"`
+	GroupID     int64             `json:"group_id"`
+	Platform    string            `json:"platform"`
+	Method      string            `json:"method"`
+	Path        string            `json:"path"`
+	Model       string            `json:"model"`
+	Headers     map[string]string `json:"headers"`
+	Body        string            `json:"body"`
+	BodyOmitted bool              `json:"body_omitted,omitempty"`
+}
+
+type gatewayPluginRequestOutput struct {
+	Reject        *GatewayPluginRejection `json:"reject"`
+	Body          *string                 `json:"body"`
+	SetHeaders    map[string]string       `json:"set_headers"`
+	RemoveHeaders []string                `json:"remove_headers"`
+}
+
+type gatewayPluginRouteInput struct {
+	Hook     string `json:"hook"`
+	GroupID  int64  `json:"group_id"`
+	Platform string `json:"platform"`
+	Model    string `json:"model"`
+}
+
+type gatewayPluginChunkInput struct {
+	Hook    string `json:"hook"`
+	GroupID int64  `json:"group_id"`
+	Status  int    `json:"status"`
+	Chunk   string `json:"chunk"`
+}
+
+type gatewayPluginChunkOutput struct {
+	Chunk *string `json:"chunk"`
+}
+
+type gatewayPluginUsageInput struct {
+	Hook                string  `json:"hook"`
+	GroupID             int64   `json:"group_id"`
+	RequestID           string  `json:"request_id"`
+	UserID              int64   `json:"user_id"`
+	APIKeyID            int64   `json:"api_key_id"`
+	AccountID           int64   `json:"account_id"`
+	Model               string  `json:"model"`
+	InputTokens         int     `json:"input_tokens"`
+	OutputTokens        int     `json:"output_tokens"`
+	CacheCreationTokens int     `json:"cache_creation_tokens"`
+	CacheReadTokens     int     `json:"cache_read_tokens"`
+	TotalCost           float64 `json:"total_cost"`
+	ActualCost          float64 `json:"actual_cost"`
+	Stream              bool    `json:"stream"`
+	DurationMs          *int    `json:"duration_ms,omitempty"`
+	SubKeyID            *string `json:"sub_key_id,omitempty"`
+	EndUserID           *string `json:"end_user_id,omitempty"`
+}
+
+// GatewayPluginSession 单次请求的插件会话
+// 每个插件在会话内懒加载一个独占实例，钩子之间可保留模块内状态；会话非并发安全。
+type GatewayPluginSession struct {
+	svc       *GatewayPluginService
+	groupID   int64
+	modules   []*gatewayPluginModule
+	instances map[*gatewayPluginModule]api.Module
+	// failed fail-open 插件出错后在本会话内不再调用
+	failed map[*gatewayPluginModule]bool
+}
+
+// HasHook 判断会话内是否有插件实现了指定钩子
+func (s *GatewayPluginSession) HasHook(hook string) bool {
+	if s == nil {
+		return false
+	}
+	for _, m := range s.modules {
+		if m.plugin.HasHook(hook) {
+			return true
+		}
+	}
+	return false
+}
+
+// Close 释放会话持有的实例
+func (s *GatewayPluginSession) Close(ctx context.Context) {
+	if s == nil {
+		return
+	}
+	for m, inst := range s.instances {
+		_ = inst.Close(ctx)
+		delete(s.instances, m)
+	}
+}
+
+// invoke 调用单个插件的钩子；返回 (nil, nil) 表示跳过或无输出
+func (s *GatewayPluginSession) invoke(ctx context.Context, m *gatewayPluginModule, hook string, input any) ([]byte, error) {
+	if s.failed[m] || !m.plugin.HasHook(hook) {
+		return nil, nil
+	}
+	payload, err := json.Marshal(input)
+	if err != nil {
+		return nil, err
+	}
+	inst, ok := s.instances[m]
+	if !ok || inst.IsClosed() {
+		inst, err = m.instantiate(ctx)
+		if err != nil {
+			return nil, s.fail(m, hook, err)
+		}
+		s.instances[m] = inst
+	}
+	out, err := m.call(ctx, inst, hook, payload)
+	if err != nil {
+		return nil, s.fail(m, hook, err)
+	}
+	return out, nil
+}
+
+// fail 记录插件失败：fail-closed 返回错误，fail-open 在本会话内禁用该插件
+func (s *GatewayPluginSession) fail(m *gatewayPluginModule, hook string, err error) error {
+	logger.LegacyPrintf("service.gateway_plugin", "[GatewayPlugin] plugin=%d(%s) hook=%s failed (mode=%s): %v",
+		m.plugin.ID, m.plugin.Name, hook, m.plugin.FailureMode, err)
+	if m.plugin.FailClosed() {
+		return ErrGatewayPluginFailed
+	}
+	s.failed[m] = true
+	return nil
+}
+
+// decode 解析插件输出；格式错误视为插件失败
+func (s *GatewayPluginSession) decode(m *gatewayPluginModule, hook string, out []byte, v any) (bool, error) {
+	if len(out) == 0 {
+		return false, nil
+	}
+	if err := json.Unmarshal(out, v); err != nil {
+		return false, s.fail(m, hook, err)
+	}
+	return true, nil
+}
+
+// OnRequest 依次执行 on_request 钩子，原地改写请求体与请求头
+// 受保护的请求头（认证等）不会传给插件，也不允许被改写。
+func (s *GatewayPluginSession) OnRequest(ctx context.Context, req *GatewayPluginRequest) (*GatewayPluginRejection, error) {
+	for _, m := range s.modules {
+		input := gatewayPluginRequestInput{
+			Hook:     model.PluginHookOnRequest,
+			GroupID:  s.groupID,
+			Platform: req.Platform,
+			Method:   req.Method,
+			Path:     req.Path,
+			Model:    req.Model,
+			Headers:  pluginVisibleHeaders(req.Header),
+		}
+		// 非 UTF-8 请求体（如 multipart 二进制）不传给插件
+		bodyVisible := utf8.Valid(req.Body)
+		if bodyVisible {
+			input.Body = string(req.Body)
+		} else {
+			input.BodyOmitted = true
+		}
+
+		out, err := s.invoke(ctx, m, model.PluginHookOnRequest, input)
+		if err != nil {
+			return nil, err
+		}
+		var result gatewayPluginRequestOutput
+		ok, err := s.decode(m, model.PluginHookOnRequest, out, &result)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+		if result.Reject != nil {
+			rejection := *result.Reject
+			if rejection.Status < 400 || rejection.Status > 599 {
+				rejection.Status = http.StatusForbidden
+			}
+			if rejection.Message == "" {
+				rejection.Message = "request rejected by gateway plugin"
+			}
+			return &rejection, nil
+		}
+		if result.Body != nil && bodyVisible {
+			req.Body = []byte(*result.Body)
+		}
+		for name, value := range result.SetHeaders {
+			if !model.IsProtectedTransformHeader(name) {
+				req.Header.Set(name, value)
+			}
+		}
+		for _, name := range result.RemoveHeaders {
+			if !model.IsProtectedTransformHeader(name) {
+				req.Header.Del(name)
+			}
+		}
+	}
+	return nil, nil
+}
+
+// OnRoute 依次执行 on_route 钩子，后执行的插件可看到前者选择的分组/模型
+func (s *GatewayPluginSession) OnRoute(ctx context.Context, platform, reqModel string) (*GatewayPluginRouteDecision, error) {
+	decision := &GatewayPluginRouteDecision{}
+	groupID := s.groupID
+	for _, m := range s.modules {
+		routeModel := reqModel
+		if decision.Model != "" {
+			routeModel = decision.Model
+		}
+		out, err := s.invoke(ctx, m, model.PluginHookOnRoute, gatewayPluginRouteInput{
+			Hook:     model.PluginHookOnRoute,
+			GroupID:  groupID,
+			Platform: platform,
+			Model:    routeModel,
+		})
+		if err != nil {
+			return nil, err
+		}
+		var result GatewayPluginRouteDecision
+		ok, err := s.decode(m, model.PluginHookOnRoute, out, &result)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+		if result.Model != "" {
+			decision.Model = result.Model
+		}
+		if result.GroupID != nil && *result.GroupID > 0 {
+			id := *result.GroupID
+			decision.GroupID = &id
+			groupID = id
+		}
+	}
+	return decision, nil
+}
+
+// OnResponseChunk 依次执行 on_response_chunk 钩子；插件返回空字符串表示丢弃该分片
+func (s *GatewayPluginSession) OnResponseChunk(ctx context.Context, status int, chunk []byte) ([]byte, error) {
+	if !utf8.Valid(chunk) {
+		return chunk, nil
+	}
+	for _, m := range s.modules {
+		out, err := s.invoke(ctx, m, model.PluginHookOnResponseChunk, gatewayPluginChunkInput{
+			Hook:    model.PluginHookOnResponseChunk,
+			GroupID: s.groupID,
+			Status:  status,
+			Chunk:   string(chunk),
+		})
+		if err != nil {
+			return nil, err
+		}
+		var result gatewayPluginChunkOutput
+		ok, err := s.decode(m, model.PluginHookOnResponseChunk, out, &result)
+		if err != nil {
+			return nil, err
+		}
+		if ok && result.Chunk != nil {
+			chunk = []byte(*result.Chunk)
+			if len(chunk) == 0 {
+				return chunk, nil
+			}
+		}
+	}
+	return chunk, nil
+}
+
+// UsageHook 返回用量记录回调（在 RecordUsage 后台执行，使用独立实例异步调用 on_usage）
+func (s *GatewayPluginSession) UsageHook() func(*UsageLog) {
+	if s == nil {
+		return nil
+	}
+	var modules []*gatewayPluginModule
+	for _, m := range s.modules {
+		if m.plugin.HasHook(model.PluginHookOnUsage) {
+			modules = append(modules, m)
+		}
+	}
+	if len(modules) == 0 {
+		return nil
+	}
+	svc, groupID := s.svc, s.groupID
+	return func(usageLog *UsageLog) {
+		svc.dispatchUsage(groupID, modules, usageLog)
+	}
+}
+
+// dispatchUsage 异步调用 on_usage；并发已满时丢弃，避免拖慢计费链路
+func (s *GatewayPluginService) dispatchUsage(groupID int64, modules []*gatewayPluginModule, usageLog *UsageLog) {
+	if usageLog == nil {
+		return
+	}
+	input := gatewayPluginUsageInput{
+		Hook:                model.PluginHookOnUsage,
+		GroupID:             groupID,
+		RequestID:           usageLog.RequestID,
+		UserID:              usageLog.UserID,
+		APIKeyID:            usageLog.APIKeyID,
+		AccountID:           usageLog.AccountID,
+		Model:               usageLog.Model,
+		InputTokens:         usageLog.InputTokens,
+		OutputTokens:        usageLog.OutputTokens,
+		CacheCreationTokens: usageLog.CacheCreationTokens,
+		CacheReadTokens:     usageLog.CacheReadTokens,
+		TotalCost:           usageLog.TotalCost,
+		ActualCost:          usageLog.ActualCost,
+		Stream:              usageLog.Stream,
+		DurationMs:          usageLog.DurationMs,
+		SubKeyID:            usageLog.SubKeyID,
+		EndUserID:           usageLog.EndUserID,
+	}
+
+	select {
+	case s.usageSem <- struct{}{}:
+	default:
+		logger.LegacyPrintf("service.gateway_plugin", "[GatewayPlugin] on_usage dropped: too many pending calls (request_id=%s)", usageLog.RequestID)
+		return
+	}
+	go func() {
+		defer func() { <-s.usageSem }()
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				logger.LegacyPrintf("service.gateway_plugin", "[GatewayPlugin] on_usage panic recovered: %v", recovered)
+			}
+		}()
+		ctx := context.Background()
+		session := &GatewayPluginSession{
+			svc:       s,
+			groupID:   groupID,
+			modules:   modules,
+			instances: make(map[*gatewayPluginModule]api.Module, len(modules)),
+			failed:    make(map[*gatewayPluginModule]bool),
+		}
+		defer session.Close(ctx)
+		for _, m := range modules {
+			// on_usage 仅用于观察，失败不影响计费
+			_, _ = session.invoke(ctx, m, model.PluginHookOnUsage, input)
+		}
+	}()
+}
+
+// WithUsageHook 将网关插件的用量回调挂载到请求上下文
+func WithUsageHook(ctx context.Context, hook func(*UsageLog)) context.Context {
+	if hook == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, ctxkey.UsageHook, hook)
+}
+
+// UsageHookFromContext 返回请求上下文中挂载的用量回调
+func UsageHookFromContext(ctx context.Context) func(*UsageLog) {
+	if ctx == nil {
+		return nil
+	}
+	hook, _ := ctx.Value(ctxkey.UsageHook).(func(*UsageLog))
+	return hook
+}
+
+// WithGatewayPluginSession 将网关插件会话挂载到请求上下文（用于 WebSocket 逐帧执行钩子）
+func WithGatewayPluginSession(ctx context.Context, session *GatewayPluginSession) context.Context {
+	if session == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, ctxkey.GatewayPluginSession, session)
+}
+
+// GatewayPluginSessionFromContext 返回请求上下文中挂载的网关插件会话
+func GatewayPluginSessionFromContext(ctx context.Context) *GatewayPluginSession {
+	if ctx == nil {
+		return nil
+	}
+	session, _ := ctx.Value(ctxkey.GatewayPluginSession).(*GatewayPluginSession)
+	return session
+}
+
+// dispatchUsageHook 触发随用量记录输入传递的回调
+func dispatchUsageHook(hook func(*UsageLog), usageLog *UsageLog) {
+	if hook == nil || usageLog == nil {
+		return
+	}
+	hook(usageLog)
+}
+
+// pluginVisibleHeaders 返回传给插件的请求头（排除认证等受保护头）
+func pluginVisibleHeaders(header http.Header) map[string]string {
+	out := make(map[string]string, len(header))
+	for name := range header {
+		if model.IsProtectedTransformHeader(name) {
+			continue
+		}
+		out[name] = header.Get(name)
+	}
+	return out
+}
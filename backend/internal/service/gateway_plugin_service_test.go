@@ -0,0 +1,274 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/model"
+	"github.com/stretchr/testify/require"
+)
+
+// buildTestPluginModule 手工组装最小 WASM 模块：alloc 固定返回 1024，
+// 每个钩子返回一段预置的 JSON 输出；输出为 "loop" 时钩子死循环（用于超时测试）。
+func buildTestPluginModule(hooks map[string]string, withAlloc bool) []byte {
+	uleb := func(v uint64) []byte {
+		var out []byte
+		for {
+			b := byte(v & 0x7f)
+			v >>= 7
+			if v != 0 {
+				out = append(out, b|0x80)
+				continue
+			}
+			return append(out, b)
+		}
+	}
+	sleb := func(v int64) []byte {
+		var out []byte
+		for {
+			b := byte(v & 0x7f)
+			v >>= 7
+			if (v == 0 && b&0x40 == 0) || (v == -1 && b&0x40 != 0) {
+				return append(out, b)
+			}
+			out = append(out, b|0x80)
+		}
+	}
+	name := func(s string) []byte { return append(uleb(uint64(len(s))), s...) }
+	section := func(id byte, items ...[]byte) []byte {
+		var content []byte
+		content = append(content, uleb(uint64(len(items)))...)
+		for _, item := range items {
+			content = append(content, item...)
+		}
+		return append(append([]byte{id}, uleb(uint64(len(content)))...), content...)
+	}
+
+	var funcs, exports, bodies, data [][]byte
+	exports = append(exports, append(name("memory"), 0x02, 0x00))
+	if withAlloc {
+		funcs = append(funcs, []byte{0x00})
+		exports = append(exports, append(name("alloc"), 0x00, byte(len(funcs)-1)))
+		body := append([]byte{0x00, 0x41}, sleb(1024)...)
+		body = append(body, 0x0b)
+		bodies = append(bodies, append(uleb(uint64(len(body))), body...))
+	}
+	offset := int64(4096)
+	for _, hook := range model.PluginHooks {
+		output, ok := hooks[hook]
+		if !ok {
+			continue
+		}
+		funcs = append(funcs, []byte{0x01})
+		exports = append(exports, append(name(hook), 0x00, byte(len(funcs)-1)))
+		var body []byte
+		if output == "loop" {
+			body = []byte{0x00, 0x03, 0x40, 0x0c, 0x00, 0x0b, 0x42, 0x00, 0x0b}
+		} else {
+			packed := offset<<32 | int64(len(output))
+			body = append([]byte{0x00, 0x42}, sleb(packed)...)
+			body = append(body, 0x0b)
+			segment := append([]byte{0x00, 0x41}, sleb(offset)...)
+			segment = append(segment, 0x0b)
+			segment = append(segment, name(output)...)
+			data = append(data, segment)
+			offset += int64(len(output)) + 16
+		}
+		bodies = append(bodies, append(uleb(uint64(len(body))), body...))
+	}
+
+	module := []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00}
+	module = append(module, section(0x01,
+		[]byte{0x60, 0x01, 0x7f, 0x01, 0x7f},
+		[]byte{0x60, 0x02, 0x7f, 0x7f, 0x01, 0x7e},
+	)...)
+	module = append(module, section(0x03, funcs...)...)
+	module = append(module, section(0x05, []byte{0x00, 0x01})...)
+	module = append(module, section(0x07, exports...)...)
+	module = append(module, section(0x0a, bodies...)...)
+	if len(data) > 0 {
+		module = append(module, section(0x0b, data...)...)
+	}
+	return module
+}
+
+func newGatewayPluginTestService(t *testing.T, plugins ...*model.GatewayPlugin) *GatewayPluginService {
+	t.Helper()
+	svc := &GatewayPluginService{usageSem: make(chan struct{}, gatewayPluginUsageConcurrency)}
+	for _, p := range plugins {
+		compiled, hooks, err := compileGatewayPlugin(context.Background(), p)
+		require.NoError(t, err)
+		p.Hooks = hooks
+		svc.modules = append(svc.modules, compiled)
+		t.Cleanup(func() { compiled.close(context.Background()) })
+	}
+	return svc
+}
+
+func testGatewayPlugin(id int64, mode string, hooks map[string]string) *model.GatewayPlugin {
+	return &model.GatewayPlugin{
+		ID:            id,
+		Name:          "plugin",
+		Enabled:       true,
+		FailureMode:   mode,
+		TimeoutMs:     50,
+		MemoryLimitMB: 1,
+		Module:        buildTestPluginModule(hooks, true),
+	}
+}
+
+func TestGatewayPluginPrepare_ValidatesModule(t *testing.T) {
+	svc := &GatewayPluginService{}
+	plugin := testGatewayPlugin(1, model.PluginFailureModeOpen, map[string]string{model.PluginHookOnUsage: "{}"})
+	require.NoError(t, svc.prepare(context.Background(), plugin))
+	require.Equal(t, []string{model.PluginHookOnUsage}, plugin.Hooks)
+	require.Len(t, plugin.ModuleSHA256, 64)
+
+	plugin.Module = []byte("not wasm")
+	err := svc.prepare(context.Background(), plugin)
+	require.Error(t, err)
+	require.IsType(t, &model.ValidationError{}, err)
+
+	plugin.Module = buildTestPluginModule(map[string]string{model.PluginHookOnRequest: "{}"}, false)
+	require.ErrorContains(t, svc.prepare(context.Background(), plugin), "alloc")
+
+	plugin.Module = buildTestPluginModule(nil, true)
+	require.ErrorContains(t, svc.prepare(context.Background(), plugin), "any hook")
+
+	plugin.FailureMode = "maybe"
+	require.IsType(t, &model.ValidationError{}, svc.prepare(context.Background(), plugin))
+}
+
+func TestGatewayPluginSession_OnRequestRewritesBodyAndHeaders(t *testing.T) {
+	svc := newGatewayPluginTestService(t, testGatewayPlugin(1, model.PluginFailureModeOpen, map[string]string{
+		model.PluginHookOnRequest: `{"body":"{\"model\":\"rewritten\"}","set_headers":{"X-Plugin":"ok","Authorization":"Bearer evil"},"remove_headers":["X-Debug"]}`,
+	}))
+	session := svc.NewSession(7)
+	require.NotNil(t, session)
+	defer session.Close(context.Background())
+	require.True(t, session.HasHook(model.PluginHookOnRequest))
+	require.False(t, session.HasHook(model.PluginHookOnResponseChunk))
+
+	header := http.Header{}
+	header.Set("Authorization", "Bearer real")
+	header.Set("X-Debug", "1")
+	req := &GatewayPluginRequest{Header: header, Body: []byte(`{"model":"original"}`)}
+
+	rejection, err := session.OnRequest(context.Background(), req)
+	require.NoError(t, err)
+	require.Nil(t, rejection)
+	require.JSONEq(t, `{"model":"rewritten"}`, string(req.Body))
+	require.Equal(t, "ok", header.Get("X-Plugin"))
+	require.Equal(t, "Bearer real", header.Get("Authorization"))
+	require.Empty(t, header.Get("X-Debug"))
+}
+
+func TestGatewayPluginSession_OnRequestReject(t *testing.T) {
+	svc := newGatewayPluginTestService(t, testGatewayPlugin(1, model.PluginFailureModeOpen, map[string]string{
+		model.PluginHookOnRequest: `{"reject":{"status":451,"message":"pii detected"}}`,
+	}))
+	session := svc.NewSession(1)
+	defer session.Close(context.Background())
+
+	rejection, err := session.OnRequest(context.Background(), &GatewayPluginRequest{Header: http.Header{}, Body: []byte(`{}`)})
+	require.NoError(t, err)
+	require.Equal(t, &GatewayPluginRejection{Status: 451, Message: "pii detected"}, rejection)
+}
+
+func TestGatewayPluginSessionContext(t *testing.T) {
+	require.Nil(t, GatewayPluginSessionFromContext(context.Background()))
+	require.Nil(t, GatewayPluginSessionFromContext(WithGatewayPluginSession(context.Background(), nil)))
+
+	svc := newGatewayPluginTestService(t, testGatewayPlugin(1, model.PluginFailureModeOpen, map[string]string{
+		model.PluginHookOnRequest: `{"reject":{"status":403,"message":"blocked frame"}}`,
+	}))
+	session := svc.NewSession(1)
+	defer session.Close(context.Background())
+
+	// WebSocket 握手挂载的会话需在后续请求帧上继续生效
+	ctx := WithGatewayPluginSession(context.Background(), session)
+	fromCtx := GatewayPluginSessionFromContext(ctx)
+	require.Same(t, session, fromCtx)
+	for i := 0; i < 2; i++ {
+		rejection, err := fromCtx.OnRequest(ctx, &GatewayPluginRequest{Header: http.Header{}, Body: []byte(`{"type":"response.create"}`)})
+		require.NoError(t, err)
+		require.Equal(t, &GatewayPluginRejection{Status: 403, Message: "blocked frame"}, rejection)
+	}
+}
+
+func TestGatewayPluginSession_RouteAndResponseChunk(t *testing.T) {
+	svc := newGatewayPluginTestService(t, testGatewayPlugin(1, model.PluginFailureModeOpen, map[string]string{
+		model.PluginHookOnRoute:         `{"model":"claude-haiku-4-5","group_id":9}`,
+		model.PluginHookOnResponseChunk: `{"chunk":"[redacted]"}`,
+	}))
+	session := svc.NewSession(1)
+	defer session.Close(context.Background())
+
+	decision, err := session.OnRoute(context.Background(), PlatformAnthropic, "claude-opus-4-1")
+	require.NoError(t, err)
+	require.Equal(t, "claude-haiku-4-5", decision.Model)
+	require.NotNil(t, decision.GroupID)
+	require.Equal(t, int64(9), *decision.GroupID)
+
+	chunk, err := session.OnResponseChunk(context.Background(), http.StatusOK, []byte("secret"))
+	require.NoError(t, err)
+	require.Equal(t, "[redacted]", string(chunk))
+}
+
+func TestGatewayPluginSession_FailureModes(t *testing.T) {
+	hooks := map[string]string{model.PluginHookOnRequest: "loop"}
+
+	open := newGatewayPluginTestService(t, testGatewayPlugin(1, model.PluginFailureModeOpen, hooks))
+	session := open.NewSession(1)
+	rejection, err := session.OnRequest(context.Background(), &GatewayPluginRequest{Header: http.Header{}, Body: []byte(`{}`)})
+	require.NoError(t, err)
+	require.Nil(t, rejection)
+	session.Close(context.Background())
+
+	closed := newGatewayPluginTestService(t, testGatewayPlugin(2, model.PluginFailureModeClosed, hooks))
+	session = closed.NewSession(1)
+	_, err = session.OnRequest(context.Background(), &GatewayPluginRequest{Header: http.Header{}, Body: []byte(`{}`)})
+	require.ErrorIs(t, err, ErrGatewayPluginFailed)
+	session.Close(context.Background())
+}
+
+func TestGatewayPluginService_NewSessionFiltersByGroup(t *testing.T) {
+	plugin := testGatewayPlugin(1, model.PluginFailureModeOpen, map[string]string{model.PluginHookOnUsage: "{}"})
+	plugin.GroupIDs = []int64{3}
+	svc := newGatewayPluginTestService(t, plugin)
+
+	require.Nil(t, svc.NewSession(4))
+	session := svc.NewSession(3)
+	require.NotNil(t, session)
+	require.NotNil(t, session.UsageHook())
+}
+
+func TestGatewayServiceRecordUsage_DispatchesInputUsageHook(t *testing.T) {
+	usageRepo := &openAIRecordUsageLogRepoStub{inserted: true}
+	svc := newGatewayRecordUsageServiceForTest(usageRepo, &openAIRecordUsageUserRepoStub{}, &openAIRecordUsageSubRepoStub{})
+
+	// 回调通过请求上下文挂载，由 handler 取出后随用量记录输入传递
+	var hooked *UsageLog
+	ctx := WithUsageHook(context.Background(), func(usageLog *UsageLog) { hooked = usageLog })
+	require.Nil(t, UsageHookFromContext(context.Background()))
+
+	err := svc.RecordUsage(context.Background(), &RecordUsageInput{
+		Result: &ForwardResult{
+			RequestID: "plugin_usage_hook",
+			Usage:     ClaudeUsage{InputTokens: 10, OutputTokens: 6},
+			Model:     "claude-sonnet-4",
+			Duration:  time.Second,
+		},
+		APIKey:    &APIKey{ID: 501, Quota: 100},
+		User:      &User{ID: 601},
+		Account:   &Account{ID: 701},
+		UsageHook: UsageHookFromContext(ctx),
+	})
+	require.NoError(t, err)
+	require.NotNil(t, hooked)
+	require.Equal(t, usageRepo.lastLog, hooked)
+}
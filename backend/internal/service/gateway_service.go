@@ -22,6 +22,7 @@ import (
 	"time"
 
 	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/Wei-Shaw/sub2api/internal/model"
 	"github.com/Wei-Shaw/sub2api/internal/pkg/claude"
 	"github.com/Wei-Shaw/sub2api/internal/pkg/ctxkey"
 	"github.com/Wei-Shaw/sub2api/internal/pkg/logger"
@@ -555,6 +556,7 @@ type GatewayService struct {
 	sessionLimitCache     SessionLimitCache // 会话数量限制缓存（仅 Anthropic OAuth/SetupToken）
 	rpmCache              RPMCache          // RPM 计数缓存（仅 Anthropic OAuth/SetupToken）
 	userGroupRateResolver *userGroupRateResolver
+	volumeTierService     *VolumeTierService
 	userGroupRateCache    *gocache.Cache
 	userGroupRateSF       singleflight.Group
 	modelsListCache       *gocache.Cache
@@ -563,6 +565,8 @@ type GatewayService struct {
 	channelService        *ChannelService
 	balanceNotifier       balanceNotifier
 	responseHeaderFilter  *responseheaders.CompiledHeaderFilter
+	accountSchedulers     *accountSchedulerSet // 账号调度策略（按分组 scheduling_strategy 选择）
+	trafficShadowSem      chan struct{}        // 影子流量并发上限
 	debugModelRouting     atomic.Bool
 	debugClaudeMimic      atomic.Bool
 }
@@ -633,6 +637,8 @@ func NewGatewayService(
 		modelsListCache:      gocache.New(modelsListTTL, time.Minute),
 		modelsListCacheTTL:   modelsListTTL,
 		responseHeaderFilter: compileResponseHeaderFilter(cfg),
+		accountSchedulers:    newAccountSchedulerSet(nil),
+		trafficShadowSem:     make(chan struct{}, trafficShadowMaxConcurrency),
 	}
 	svc.userGroupRateResolver = newUserGroupRateResolver(
 		userGroupRateRepo,
@@ -1282,7 +1288,8 @@ func (s *GatewayService) SelectAccountWithLoadAwareness(ctx context.Context, gro
 				continue
 			}
 
-			if stickyAccountID > 0 && stickyAccountID == account.ID && s.concurrencyService != nil {
+			// batch 请求不参与粘性会话等待，直接使用兜底排队
+			if stickyAccountID > 0 && stickyAccountID == account.ID && s.concurrencyService != nil && !isBatchPriorityRequest(ctx) {
 				waitingCount, _ := s.concurrencyService.GetAccountWaitingCount(ctx, account.ID)
 				if waitingCount < cfg.StickySessionMaxWaiting {
 					return &AccountSelectionResult{
@@ -1313,6 +1320,8 @@ func (s *GatewayService) SelectAccountWithLoadAwareness(ctx context.Context, gro
 		return nil, err
 	}
 	preferOAuth := platform == PlatformGemini
+	// batch 请求只直接使用负载低于阈值的账号，其余请求上限为 100%
+	loadCeiling := priorityLoadCeiling(ctx, s.cfg)
 	if s.debugModelRoutingEnabled() && platform == PlatformAnthropic && requestedModel != "" {
 		logger.LegacyPrintf("service.gateway", "[ModelRoutingDebug] load-aware enabled: group_id=%v model=%s session=%s platform=%s", derefGroupID(groupID), requestedModel, shortSessionHash(sessionHash), platform)
 	}
@@ -1344,7 +1353,7 @@ func (s *GatewayService) SelectAccountWithLoadAwareness(ctx context.Context, gro
 	// 获取模型路由配置（仅 anthropic 平台）
 	var routingAccountIDs []int64
 	if group != nil && requestedModel != "" && group.Platform == PlatformAnthropic {
-		routingAccountIDs = group.GetRoutingAccountIDs(requestedModel)
+		routingAccountIDs = groupRoutingAccountIDs(ctx, group, requestedModel)
 		if s.debugModelRoutingEnabled() {
 			logger.LegacyPrintf("service.gateway", "[ModelRoutingDebug] context group routing: group_id=%d model=%s enabled=%v rules=%d matched_ids=%v session=%s sticky_account=%d",
 				group.ID, requestedModel, group.ModelRoutingEnabled, len(group.ModelRouting), routingAccountIDs, shortSessionHash(sessionHash), stickyAccountID)
@@ -1438,8 +1447,10 @@ func (s *GatewayService) SelectAccountWithLoadAwareness(ctx context.Context, gro
 							s.isAccountSchedulableForWindowCost(ctx, stickyAccount, true)
 
 						rpmPass := gatePass && s.isAccountSchedulableForRPM(ctx, stickyAccount, true)
+						// batch 请求仅在粘性账号负载低于阈值时使用
+						priorityPass := rpmPass && priorityAllowsAccountLoad(ctx, s.cfg, s.concurrencyService, stickyAccount)
 
-						if rpmPass { // 粘性会话窗口费用+RPM 检查
+						if priorityPass { // 粘性会话窗口费用+RPM+优先级负载检查
 							result, err := s.tryAcquireAccountSlot(ctx, stickyAccountID, stickyAccount.Concurrency)
 							if err == nil && result.Acquired {
 								// 会话数量限制检查
@@ -1459,6 +1470,10 @@ func (s *GatewayService) SelectAccountWithLoadAwareness(ctx context.Context, gro
 								}
 							}
 
+							if stickyCacheMissReason == "" && isBatchPriorityRequest(ctx) {
+								// batch 请求不占用粘性会话等待队列，让位给交互式流量
+								stickyCacheMissReason = "batch_preempted"
+							}
 							if stickyCacheMissReason == "" {
 								waitingCount, _ := s.concurrencyService.GetAccountWaitingCount(ctx, stickyAccountID)
 								if waitingCount < cfg.StickySessionMaxWaiting {
@@ -1484,8 +1499,10 @@ func (s *GatewayService) SelectAccountWithLoadAwareness(ctx context.Context, gro
 							// 粘性账号槽位满且等待队列已满，继续使用负载感知选择
 						} else if !gatePass {
 							stickyCacheMissReason = "gate_check"
-						} else {
+						} else if !rpmPass {
 							stickyCacheMissReason = "rpm_red"
+						} else {
+							stickyCacheMissReason = "batch_load"
 						}
 
 						if stickyCacheMissReason != "" {
@@ -1522,7 +1539,7 @@ func (s *GatewayService) SelectAccountWithLoadAwareness(ctx context.Context, gro
 				if loadInfo == nil {
 					loadInfo = &AccountLoadInfo{AccountID: acc.ID}
 				}
-				if loadInfo.LoadRate < 100 {
+				if loadInfo.LoadRate < loadCeiling {
 					routingAvailable = append(routingAvailable, accountWithLoad{account: acc, loadInfo: loadInfo})
 				}
 			}
@@ -1549,6 +1566,16 @@ func (s *GatewayService) SelectAccountWithLoadAwareness(ctx context.Context, gro
 					}
 				})
 				shuffleWithinSortGroups(routingAvailable)
+				// 分组配置了非默认调度策略时，路由账号同样按该策略排序
+				if scheduler := s.accountSchedulerForGroup(group); scheduler.Strategy() != AccountSchedulingPriorityLRU {
+					routingAvailable = fromAccountScheduleCandidates(
+						scheduler.Order(AccountScheduleRequest{PreferOAuth: preferOAuth}, toAccountScheduleCandidates(routingAvailable)),
+					)
+				}
+				// 预计在 5h 窗口结束前耗尽的账号在同优先级内降权
+				routingAvailable = fromAccountScheduleCandidates(
+					s.steerAwayFromWindowExhaustion(ctx, toAccountScheduleCandidates(routingAvailable)),
+				)
 
 				// 4. 尝试获取槽位
 				for _, item := range routingAvailable {
@@ -1618,7 +1645,8 @@ func (s *GatewayService) SelectAccountWithLoadAwareness(ctx context.Context, gro
 					s.isAccountSchedulableForQuota(account) &&
 					s.isAccountSchedulableForWindowCost(ctx, account, true) &&
 
-					s.isAccountSchedulableForRPM(ctx, account, true) { // 粘性会话窗口费用+RPM 检查
+					s.isAccountSchedulableForRPM(ctx, account, true) && // 粘性会话窗口费用+RPM 检查
+					priorityAllowsAccountLoad(ctx, s.cfg, s.concurrencyService, account) {
 					result, err := s.tryAcquireAccountSlot(ctx, accountID, account.Concurrency)
 					if err == nil && result.Acquired {
 						// 会话数量限制检查
@@ -1634,8 +1662,9 @@ func (s *GatewayService) SelectAccountWithLoadAwareness(ctx context.Context, gro
 						}
 					}
 
+					// batch 请求不参与粘性会话等待（让位给交互式流量），继续到 Layer 2
 					waitingCount, _ := s.concurrencyService.GetAccountWaitingCount(ctx, accountID)
-					if waitingCount < cfg.StickySessionMaxWaiting {
+					if waitingCount < cfg.StickySessionMaxWaiting && !isBatchPriorityRequest(ctx) {
 						// 会话数量限制检查（等待计划也需要占用会话配额）
 						// Session count limit check (wait plan also requires session quota)
 						if !s.checkAndRegisterSession(ctx, account, sessionHash) {
@@ -1719,7 +1748,7 @@ func (s *GatewayService) SelectAccountWithLoadAwareness(ctx context.Context, gro
 			if loadInfo == nil {
 				loadInfo = &AccountLoadInfo{AccountID: acc.ID}
 			}
-			if loadInfo.LoadRate < 100 {
+			if loadInfo.LoadRate < loadCeiling {
 				available = append(available, accountWithLoad{
 					account:  acc,
 					loadInfo: loadInfo,
@@ -1727,44 +1756,29 @@ func (s *GatewayService) SelectAccountWithLoadAwareness(ctx context.Context, gro
 			}
 		}
 
-		// 分层过滤选择：优先级 → 负载率 → LRU
-		for len(available) > 0 {
-			// 1. 取优先级最小的集合
-			candidates := filterByMinPriority(available)
-			// 2. 取负载率最低的集合
-			candidates = filterByMinLoadRate(candidates)
-			// 3. LRU 选择最久未用的账号
-			selected := selectByLRU(candidates, preferOAuth)
-			if selected == nil {
-				break
+		// 按分组调度策略排序后依次尝试（默认：优先级 → 负载率 → LRU）
+		scheduler := s.accountSchedulerForGroup(group)
+		ordered := scheduler.Order(AccountScheduleRequest{PreferOAuth: preferOAuth}, toAccountScheduleCandidates(available))
+		// 预计在 5h 窗口结束前耗尽的账号在同优先级内降权
+		ordered = s.steerAwayFromWindowExhaustion(ctx, ordered)
+		for _, selected := range ordered {
+			result, err := s.tryAcquireAccountSlot(ctx, selected.Account.ID, selected.Account.Concurrency)
+			if err != nil || !result.Acquired {
+				continue
 			}
-
-			result, err := s.tryAcquireAccountSlot(ctx, selected.account.ID, selected.account.Concurrency)
-			if err == nil && result.Acquired {
-				// 会话数量限制检查
-				if !s.checkAndRegisterSession(ctx, selected.account, sessionHash) {
-					result.ReleaseFunc() // 释放槽位，继续尝试下一个账号
-				} else {
-					if sessionHash != "" && s.cache != nil {
-						_ = s.cache.SetSessionAccountID(ctx, derefGroupID(groupID), sessionHash, selected.account.ID, stickySessionTTL)
-					}
-					return &AccountSelectionResult{
-						Account:     selected.account,
-						Acquired:    true,
-						ReleaseFunc: result.ReleaseFunc,
-					}, nil
-				}
+			// 会话数量限制检查
+			if !s.checkAndRegisterSession(ctx, selected.Account, sessionHash) {
+				result.ReleaseFunc() // 释放槽位，继续尝试下一个账号
+				continue
 			}
-
-			// 移除已尝试的账号，重新进行分层过滤
-			selectedID := selected.account.ID
-			newAvailable := make([]accountWithLoad, 0, len(available)-1)
-			for _, acc := range available {
-				if acc.account.ID != selectedID {
-					newAvailable = append(newAvailable, acc)
-				}
+			if sessionHash != "" && s.cache != nil {
+				_ = s.cache.SetSessionAccountID(ctx, derefGroupID(groupID), sessionHash, selected.Account.ID, stickySessionTTL)
 			}
-			available = newAvailable
+			return &AccountSelectionResult{
+				Account:     selected.Account,
+				Acquired:    true,
+				ReleaseFunc: result.ReleaseFunc,
+			}, nil
 		}
 	}
 
@@ -1878,7 +1892,7 @@ func (s *GatewayService) routingAccountIDsForRequest(ctx context.Context, groupI
 		}
 		return nil
 	}
-	ids := group.GetRoutingAccountIDs(requestedModel)
+	ids := groupRoutingAccountIDs(ctx, group, requestedModel)
 	if s.debugModelRoutingEnabled() {
 		logger.LegacyPrintf("service.gateway", "[ModelRoutingDebug] routing lookup: group_id=%d model=%s enabled=%v rules=%d matched_ids=%v",
 			group.ID, requestedModel, group.ModelRoutingEnabled, len(group.ModelRouting), ids)
@@ -2110,9 +2124,13 @@ func (s *GatewayService) isAccountInGroup(account *Account, groupID *int64) bool
 
 func (s *GatewayService) tryAcquireAccountSlot(ctx context.Context, accountID int64, maxConcurrency int) (*AcquireResult, error) {
 	if s.concurrencyService == nil {
-		return &AcquireResult{Acquired: true, ReleaseFunc: func() {}}, nil
+		return admitAcquiredAccountSlot(s.schedulerSnapshot.AccountCircuitBreaker(), accountID, &AcquireResult{Acquired: true, ReleaseFunc: func() {}}), nil
 	}
-	return s.concurrencyService.AcquireAccountSlot(ctx, accountID, maxConcurrency)
+	result, err := s.concurrencyService.AcquireAccountSlot(ctx, accountID, maxConcurrency)
+	if err != nil {
+		return result, err
+	}
+	return admitAcquiredAccountSlot(s.schedulerSnapshot.AccountCircuitBreaker(), accountID, result), nil
 }
 
 type usageLogWindowStatsBatchProvider interface {
@@ -2426,6 +2444,24 @@ func (s *GatewayService) getSchedulableAccount(ctx context.Context, accountID in
 	return s.accountRepo.GetByID(ctx, accountID)
 }
 
+// accountSchedulerForGroup 返回分组配置的账号调度策略（未配置时为 priority_lru）
+func (s *GatewayService) accountSchedulerForGroup(group *Group) AccountScheduler {
+	strategy := ""
+	if group != nil {
+		strategy = group.SchedulingStrategy
+	}
+	return s.accountSchedulers.get(strategy)
+}
+
+// ReportAccountScheduleResult 回报一次转发结果（成功与否、首字延迟），供 EWMA / P2C 等调度策略打分及账号熔断器统计
+func (s *GatewayService) ReportAccountScheduleResult(accountID int64, success bool, firstTokenMs *int) {
+	if s == nil {
+		return
+	}
+	s.accountSchedulers.report(accountID, success, firstTokenMs)
+	s.schedulerSnapshot.AccountCircuitBreaker().Record(accountID, success, firstTokenMs)
+}
+
 // filterByMinPriority 过滤出优先级最小的账号集合
 func filterByMinPriority(accounts []accountWithLoad) []accountWithLoad {
 	if len(accounts) == 0 {
@@ -3885,6 +3921,10 @@ func (s *GatewayService) Forward(ctx context.Context, c *gin.Context, account *A
 	if account != nil && account.IsAnthropicAPIKeyPassthroughEnabled() {
 		passthroughBody := parsed.Body
 		passthroughModel := parsed.Model
+		if fallbackModel := ModelFallbackFromContext(ctx); fallbackModel != "" && fallbackModel != passthroughModel {
+			passthroughBody = s.replaceModelInBody(passthroughBody, fallbackModel)
+			passthroughModel = fallbackModel
+		}
 		if passthroughModel != "" {
 			if mappedModel := account.GetMappedModel(passthroughModel); mappedModel != passthroughModel {
 				passthroughBody = s.replaceModelInBody(passthroughBody, mappedModel)
@@ -3924,6 +3964,12 @@ func (s *GatewayService) Forward(ctx context.Context, c *gin.Context, account *A
 	reqStream := resolveClientStreamingPreference(c, parsed.Stream)
 	originalModel := reqModel
 
+	// 模型降级链：上游改用降级模型，响应中仍回写客户端请求的模型，计费按实际上游模型
+	if fallbackModel := ModelFallbackFromContext(ctx); fallbackModel != "" && fallbackModel != reqModel {
+		body = s.replaceModelInBody(body, fallbackModel)
+		reqModel = fallbackModel
+	}
+
 	// === DEBUG: 打印客户端原始请求 body ===
 	debugLogRequestBody("CLIENT_ORIGINAL", body)
 
@@ -3997,10 +4043,8 @@ func (s *GatewayService) Forward(ctx context.Context, c *gin.Context, account *A
 
 	// 获取代理URL（自定义 base URL 模式下，proxy 通过 buildCustomRelayURL 作为查询参数传递）
 	proxyURL := ""
-	if account.ProxyID != nil && account.Proxy != nil {
-		if !account.IsCustomBaseURLEnabled() || account.GetCustomBaseURL() == "" {
-			proxyURL = account.Proxy.URL()
-		}
+	if !account.IsCustomBaseURLEnabled() || account.GetCustomBaseURL() == "" {
+		proxyURL = resolveAccountProxyURL(account)
 	}
 
 	// 调试日志：记录即将转发的账号信息
@@ -4551,10 +4595,7 @@ func (s *GatewayService) forwardAnthropicAPIKeyPassthroughWithInput(
 		return nil, fmt.Errorf("anthropic api key passthrough requires apikey token, got: %s", tokenType)
 	}
 
-	proxyURL := ""
-	if account.ProxyID != nil && account.Proxy != nil {
-		proxyURL = account.Proxy.URL()
-	}
+	proxyURL := resolveAccountProxyURL(account)
 
 	logger.LegacyPrintf("service.gateway", "[Anthropic 自动透传] 命中 API Key 透传分支: account=%d name=%s model=%s stream=%v",
 		account.ID, account.Name, input.RequestModel, input.RequestStream)
@@ -4841,6 +4882,9 @@ func (s *GatewayService) buildUpstreamRequestAnthropicAPIKeyPassthrough(
 		targetURL = validatedURL + "/v1/messages?beta=true"
 	}
 
+	// 应用管理员配置的请求改写规则
+	body, transformResult := applyBoundRequestTransforms(c, account, gjson.GetBytes(body, "model").String(), body)
+
 	req, err := http.NewRequestWithContext(ctx, http.MethodPost, targetURL, bytes.NewReader(body))
 	if err != nil {
 		return nil, err
@@ -4871,6 +4915,7 @@ func (s *GatewayService) buildUpstreamRequestAnthropicAPIKeyPassthrough(
 	if req.Header.Get("anthropic-version") == "" {
 		req.Header.Set("anthropic-version", "2023-06-01")
 	}
+	applyRequestTransformHeaders(req, transformResult)
 
 	return req, nil
 }
@@ -5244,9 +5289,13 @@ func (s *GatewayService) forwardBedrock(
 	body := parsed.Body
 
 	region := bedrockRuntimeRegion(account)
-	mappedModel, ok := ResolveBedrockModelID(account, reqModel)
+	upstreamModel := reqModel
+	if fallbackModel := ModelFallbackFromContext(ctx); fallbackModel != "" {
+		upstreamModel = fallbackModel
+	}
+	mappedModel, ok := ResolveBedrockModelID(account, upstreamModel)
 	if !ok {
-		return nil, fmt.Errorf("unsupported bedrock model: %s", reqModel)
+		return nil, fmt.Errorf("unsupported bedrock model: %s", upstreamModel)
 	}
 	if mappedModel != reqModel {
 		logger.LegacyPrintf("service.gateway", "[Bedrock] Model mapping: %s -> %s (account: %s)", reqModel, mappedModel, account.Name)
@@ -5257,6 +5306,9 @@ func (s *GatewayService) forwardBedrock(
 		betaHeader = c.GetHeader("anthropic-beta")
 	}
 
+	// 应用管理员配置的请求改写规则（作用于 Anthropic 格式请求体，在转换为 Bedrock 格式之前）
+	body, transformResult := applyBoundRequestTransforms(c, account, upstreamModel, body)
+
 	// 准备请求体（注入 anthropic_version/anthropic_beta，移除 Bedrock 不支持的字段，清理 cache_control）
 	betaTokens, err := s.resolveBedrockBetaTokensForRequest(ctx, account, betaHeader, body, mappedModel)
 	if err != nil {
@@ -5268,10 +5320,7 @@ func (s *GatewayService) forwardBedrock(
 		return nil, fmt.Errorf("prepare bedrock request body: %w", err)
 	}
 
-	proxyURL := ""
-	if account.ProxyID != nil && account.Proxy != nil {
-		proxyURL = account.Proxy.URL()
-	}
+	proxyURL := resolveAccountProxyURL(account)
 
 	logger.LegacyPrintf("service.gateway", "[Bedrock] 命中 Bedrock 分支: account=%d name=%s model=%s->%s stream=%v",
 		account.ID, account.Name, reqModel, mappedModel, reqStream)
@@ -5292,7 +5341,7 @@ func (s *GatewayService) forwardBedrock(
 	}
 
 	// 执行上游请求（含重试）
-	resp, err := s.executeBedrockUpstream(ctx, c, account, bedrockBody, mappedModel, region, reqStream, signer, bedrockAPIKey, proxyURL)
+	resp, err := s.executeBedrockUpstream(ctx, c, account, bedrockBody, mappedModel, region, reqStream, signer, bedrockAPIKey, proxyURL, transformResult)
 	if err != nil {
 		return nil, err
 	}
@@ -5355,6 +5404,7 @@ func (s *GatewayService) executeBedrockUpstream(
 	signer *BedrockSigner,
 	apiKey string,
 	proxyURL string,
+	transformResult *RequestTransformResult,
 ) (*http.Response, error) {
 	var resp *http.Response
 	var err error
@@ -5369,6 +5419,8 @@ func (s *GatewayService) executeBedrockUpstream(
 		if err != nil {
 			return nil, err
 		}
+		// 规则请求头在签名之后追加：签名覆盖的头部（Content-Type、X-Amz-*）受保护，规则无法改写
+		applyRequestTransformHeaders(upstreamReq, transformResult)
 
 		resp, err = s.httpUpstream.DoWithTLS(upstreamReq, proxyURL, account.ID, account.Concurrency, false)
 		if err != nil {
@@ -5654,6 +5706,9 @@ func (s *GatewayService) buildUpstreamRequest(ctx context.Context, c *gin.Contex
 		}
 	}
 
+	// 应用管理员配置的请求改写规则
+	body, transformResult := applyBoundRequestTransforms(c, account, modelID, body)
+
 	// === DEBUG: 打印转发给上游的 body（metadata 已重写） ===
 	debugLogRequestBody("UPSTREAM_FORWARD", body)
 
@@ -5733,6 +5788,8 @@ func (s *GatewayService) buildUpstreamRequest(ctx context.Context, c *gin.Contex
 		}
 	}
 
+	applyRequestTransformHeaders(req, transformResult)
+
 	// Always capture a compact fingerprint line for later error diagnostics.
 	// We only print it when needed (or when the explicit debug flag is enabled).
 	if c != nil && tokenType == "oauth" {
@@ -7398,7 +7455,8 @@ func (s *GatewayService) replaceModelInResponseBody(body []byte, fromModel, toMo
 	return body
 }
 
-func (s *GatewayService) getUserGroupRateMultiplier(ctx context.Context, userID, groupID int64, groupDefaultMultiplier float64) float64 {
+// getUserGroupRateMultiplier 解析用户在分组的费率倍数：用户专属倍率（或分组默认）再叠加当月用量阶梯折扣。
+func (s *GatewayService) getUserGroupRateMultiplier(ctx context.Context, userID, groupID int64, groupDefaultMultiplier float64, volumeTiers []model.VolumeTier) float64 {
 	if s == nil {
 		return groupDefaultMultiplier
 	}
@@ -7412,7 +7470,13 @@ func (s *GatewayService) getUserGroupRateMultiplier(ctx context.Context, userID,
 			"service.gateway",
 		)
 	}
-	return resolver.Resolve(ctx, userID, groupID, groupDefaultMultiplier)
+	multiplier := resolver.Resolve(ctx, userID, groupID, groupDefaultMultiplier)
+	return multiplier * s.volumeTierService.Multiplier(ctx, userID, groupID, volumeTiers)
+}
+
+// SetVolumeTierService 注入用量阶梯定价服务（可选，未注入时不打折）
+func (s *GatewayService) SetVolumeTierService(volumeTierService *VolumeTierService) {
+	s.volumeTierService = volumeTierService
 }
 
 // RecordUsageInput 记录使用量的输入参数
@@ -7429,6 +7493,10 @@ type RecordUsageInput struct {
 	RequestPayloadHash string             // 请求体语义哈希，用于降低 request_id 误复用时的静默误去重风险
 	ForceCacheBilling  bool               // 强制缓存计费：将 input_tokens 转为 cache_read 计费（用于粘性会话切换）
 	APIKeyService      APIKeyQuotaUpdater // 可选：用于更新API Key配额
+	TrafficLabel       string             // 可选：分组流量拆分标签（用于对比报表）
+	Shadow             bool               // 影子请求：仅记录用量，不计费
+	FallbackModel      string             // 可选：模型降级链实际使用的模型
+	UsageHook          func(*UsageLog)    // 可选：网关插件 on_usage 回调，写入用量记录前触发
 }
 
 // APIKeyQuotaUpdater defines the interface for updating API Key quota and rate limit usage
@@ -7441,6 +7509,10 @@ type apiKeyAuthCacheInvalidator interface {
 	InvalidateAuthCacheByKey(ctx context.Context, key string)
 }
 
+type ephemeralKeySpendRecorder interface {
+	RecordEphemeralKeySpend(ctx context.Context, claims *EphemeralKeyClaims, cost float64) error
+}
+
 type usageLogBestEffortWriter interface {
 	CreateBestEffort(ctx context.Context, log *UsageLog) error
 }
@@ -7645,6 +7717,17 @@ func finalizePostUsageBilling(p *postUsageBillingParams, deps *billingDeps) {
 		deps.billingCacheService.QueueUpdateAPIKeyRateLimitUsage(p.APIKey.ID, p.Cost.ActualCost)
 	}
 
+	// 临时子密钥消费计数（父 Key 已按正常口径扣费）
+	if p.Cost.ActualCost > 0 && p.APIKey != nil && p.APIKey.Ephemeral != nil {
+		if recorder, ok := p.APIKeyService.(ephemeralKeySpendRecorder); ok {
+			spendCtx, cancel := detachedBillingContext(context.Background())
+			if err := recorder.RecordEphemeralKeySpend(spendCtx, p.APIKey.Ephemeral, p.Cost.ActualCost); err != nil {
+				slog.Error("record ephemeral key spend failed", "sub_key_id", p.APIKey.Ephemeral.ID, "error", err)
+			}
+			cancel()
+		}
+	}
+
 	deps.deferredService.ScheduleLastUsedUpdate(p.Account.ID)
 
 	go notifyBalanceLow(p, deps)
@@ -7779,7 +7862,7 @@ func (s *GatewayService) RecordUsage(ctx context.Context, input *RecordUsageInpu
 	}
 	if apiKey.GroupID != nil && apiKey.Group != nil {
 		groupDefault := apiKey.Group.RateMultiplier
-		multiplier = s.getUserGroupRateMultiplier(ctx, user.ID, *apiKey.GroupID, groupDefault)
+		multiplier = s.getUserGroupRateMultiplier(ctx, user.ID, *apiKey.GroupID, groupDefault, apiKey.Group.VolumeTiers)
 	}
 
 	var cost *CostBreakdown
@@ -7865,6 +7948,9 @@ func (s *GatewayService) RecordUsage(ctx context.Context, input *RecordUsageInpu
 		CreatedAt:             time.Now(),
 	}
 
+	// 临时子密钥归因
+	applyEphemeralKeyToUsageLog(usageLog, apiKey)
+
 	// 添加 UserAgent
 	if input.UserAgent != "" {
 		usageLog.UserAgent = &input.UserAgent
@@ -7882,8 +7968,24 @@ func (s *GatewayService) RecordUsage(ctx context.Context, input *RecordUsageInpu
 	if subscription != nil {
 		usageLog.SubscriptionID = &subscription.ID
 	}
+	if input.TrafficLabel != "" {
+		usageLog.TrafficLabel = &input.TrafficLabel
+	}
+	if input.FallbackModel != "" {
+		usageLog.FallbackModel = &input.FallbackModel
+	}
+
+	// 影子请求：响应未返回给用户，仅记录用量用于对比，不计费
+	if input.Shadow {
+		usageLog.Shadow = true
+		usageLog.ActualCost = 0
+		writeUsageLogBestEffort(ctx, s.usageLogRepo, usageLog, "service.gateway")
+		return nil
+	}
 
 	if s.cfg != nil && s.cfg.RunMode == config.RunModeSimple {
+		usageLog.Unbilled = true
+		dispatchUsageHook(input.UsageHook, usageLog)
 		writeUsageLogBestEffort(ctx, s.usageLogRepo, usageLog, "service.gateway")
 		logger.LegacyPrintf("service.gateway", "[SIMPLE MODE] Usage recorded (not billed): user=%d, tokens=%d", usageLog.UserID, usageLog.TotalTokens())
 		s.deferredService.ScheduleLastUsedUpdate(account.ID)
@@ -7908,6 +8010,7 @@ func (s *GatewayService) RecordUsage(ctx context.Context, input *RecordUsageInpu
 	if billingErr != nil {
 		return billingErr
 	}
+	dispatchUsageHook(input.UsageHook, usageLog)
 	writeUsageLogBestEffort(ctx, s.usageLogRepo, usageLog, "service.gateway")
 
 	return nil
@@ -7929,6 +8032,7 @@ type RecordUsageLongContextInput struct {
 	LongContextMultiplier float64            // 超出阈值部分的倍率（如 2.0）
 	ForceCacheBilling     bool               // 强制缓存计费：将 input_tokens 转为 cache_read 计费（用于粘性会话切换）
 	APIKeyService         APIKeyQuotaUpdater // API Key 配额服务（可选）
+	UsageHook             func(*UsageLog)    // 可选：网关插件 on_usage 回调，写入用量记录前触发
 }
 
 // RecordUsageWithLongContext 记录使用量并扣费，支持长上下文双倍计费（用于 Gemini）
@@ -7965,7 +8069,7 @@ func (s *GatewayService) RecordUsageWithLongContext(ctx context.Context, input *
 	}
 	if apiKey.GroupID != nil && apiKey.Group != nil {
 		groupDefault := apiKey.Group.RateMultiplier
-		multiplier = s.getUserGroupRateMultiplier(ctx, user.ID, *apiKey.GroupID, groupDefault)
+		multiplier = s.getUserGroupRateMultiplier(ctx, user.ID, *apiKey.GroupID, groupDefault, apiKey.Group.VolumeTiers)
 	}
 
 	var cost *CostBreakdown
@@ -8048,9 +8152,13 @@ func (s *GatewayService) RecordUsageWithLongContext(ctx context.Context, input *
 		ImageCount:            result.ImageCount,
 		ImageSize:             imageSize,
 		CacheTTLOverridden:    cacheTTLOverridden,
+		LongContext:           result.ImageCount == 0 && input.LongContextThreshold > 0 && input.LongContextMultiplier > 1,
 		CreatedAt:             time.Now(),
 	}
 
+	// 临时子密钥归因
+	applyEphemeralKeyToUsageLog(usageLog, apiKey)
+
 	// 添加 UserAgent
 	if input.UserAgent != "" {
 		usageLog.UserAgent = &input.UserAgent
@@ -8070,6 +8178,8 @@ func (s *GatewayService) RecordUsageWithLongContext(ctx context.Context, input *
 	}
 
 	if s.cfg != nil && s.cfg.RunMode == config.RunModeSimple {
+		usageLog.Unbilled = true
+		dispatchUsageHook(input.UsageHook, usageLog)
 		writeUsageLogBestEffort(ctx, s.usageLogRepo, usageLog, "service.gateway")
 		logger.LegacyPrintf("service.gateway", "[SIMPLE MODE] Usage recorded (not billed): user=%d, tokens=%d", usageLog.UserID, usageLog.TotalTokens())
 		s.deferredService.ScheduleLastUsedUpdate(account.ID)
@@ -8094,6 +8204,7 @@ func (s *GatewayService) RecordUsageWithLongContext(ctx context.Context, input *
 	if billingErr != nil {
 		return billingErr
 	}
+	dispatchUsageHook(input.UsageHook, usageLog)
 	writeUsageLogBestEffort(ctx, s.usageLogRepo, usageLog, "service.gateway")
 
 	return nil
@@ -8189,10 +8300,8 @@ func (s *GatewayService) ForwardCountTokens(ctx context.Context, c *gin.Context,
 
 	// 获取代理URL（自定义 base URL 模式下，proxy 通过 buildCustomRelayURL 作为查询参数传递）
 	proxyURL := ""
-	if account.ProxyID != nil && account.Proxy != nil {
-		if !account.IsCustomBaseURLEnabled() || account.GetCustomBaseURL() == "" {
-			proxyURL = account.Proxy.URL()
-		}
+	if !account.IsCustomBaseURLEnabled() || account.GetCustomBaseURL() == "" {
+		proxyURL = resolveAccountProxyURL(account)
 	}
 
 	// 发送请求
@@ -8336,10 +8445,7 @@ func (s *GatewayService) forwardCountTokensAnthropicAPIKeyPassthrough(ctx contex
 		return err
 	}
 
-	proxyURL := ""
-	if account.ProxyID != nil && account.Proxy != nil {
-		proxyURL = account.Proxy.URL()
-	}
+	proxyURL := resolveAccountProxyURL(account)
 
 	resp, err := s.httpUpstream.DoWithTLS(upstreamReq, proxyURL, account.ID, account.Concurrency, account.IsTLSFingerprintEnabled())
 	if err != nil {
@@ -8632,11 +8738,8 @@ func (s *GatewayService) countTokensError(c *gin.Context, status int, errType, m
 // 在 path 后附加 beta=true 和可选的 proxy 查询参数。
 func (s *GatewayService) buildCustomRelayURL(baseURL, path string, account *Account) string {
 	u := strings.TrimRight(baseURL, "/") + path + "?beta=true"
-	if account.ProxyID != nil && account.Proxy != nil {
-		proxyURL := account.Proxy.URL()
-		if proxyURL != "" {
-			u += "&proxy=" + url.QueryEscape(proxyURL)
-		}
+	if proxyURL := resolveAccountProxyURL(account); proxyURL != "" {
+		u += "&proxy=" + url.QueryEscape(proxyURL)
 	}
 	return u
 }
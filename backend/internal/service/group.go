@@ -5,6 +5,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/Wei-Shaw/sub2api/internal/model"
 	"github.com/Wei-Shaw/sub2api/internal/pkg/ctxkey"
 )
 
@@ -70,6 +71,28 @@ type Group struct {
 	// 非流式响应头控制
 	ForceApplicationJSONForNonStream bool
 
+	// 账号调度策略（为空使用 priority_lru）
+	SchedulingStrategy string
+
+	// 流式请求对冲：首字节超过阈值后在第二个账号上并发重发，取先响应者
+	HedgeEnabled bool
+	// 对冲阈值（毫秒），0 表示使用所选账号的 P90 首字延迟
+	HedgeDelayMs int
+
+	// 模型流量拆分规则（按会话哈希确定性分配目标模型/账号，可选影子流量）
+	TrafficSplitRules []model.TrafficSplitRule
+
+	// 模型降级链（过载或无可调度账号时按顺序改用降级模型）
+	ModelFallbackChains []model.ModelFallbackChain
+
+	// 用量阶梯定价（按用户当月在该分组的累计消费叠加折扣倍数）
+	VolumeTiers []model.VolumeTier
+
+	// 可用时段 cron 表达式（为空表示全天可用）与维护模式
+	AvailabilitySchedule string
+	MaintenanceMode      bool
+	MaintenanceMessage   string
+
 	CreatedAt time.Time
 	UpdatedAt time.Time
 
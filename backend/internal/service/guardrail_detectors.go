@@ -0,0 +1,236 @@
+package service
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/Wei-Shaw/sub2api/internal/model"
+	"github.com/tidwall/gjson"
+)
+
+// guardrailMatcher 单个匹配器：正则 + 可选的二次校验（如 Luhn / 身份证校验位）
+type guardrailMatcher struct {
+	label    string
+	re       *regexp.Regexp
+	validate func(match string) bool
+}
+
+// count 统计文本中通过校验的命中次数
+func (m *guardrailMatcher) count(text string) int {
+	locs := m.re.FindAllStringIndex(text, -1)
+	if m.validate == nil {
+		return len(locs)
+	}
+	n := 0
+	for _, loc := range locs {
+		if m.validate(text[loc[0]:loc[1]]) {
+			n++
+		}
+	}
+	return n
+}
+
+// redact 将命中内容替换为 [REDACTED:<label>] 占位符
+func (m *guardrailMatcher) redact(text string) string {
+	placeholder := "[REDACTED:" + m.label + "]"
+	return m.re.ReplaceAllStringFunc(text, func(match string) string {
+		if m.validate != nil && !m.validate(match) {
+			return match
+		}
+		return placeholder
+	})
+}
+
+// 内置检测器：正则负责粗筛，校验函数负责排除误报
+var guardrailBuiltinMatchers = map[string]*guardrailMatcher{
+	model.GuardrailDetectorAPIKey: {
+		label: model.GuardrailDetectorAPIKey,
+		re: regexp.MustCompile(`\b(?:sk-(?:ant-|proj-)?[A-Za-z0-9_\-]{20,}` + // OpenAI / Anthropic
+			`|AKIA[0-9A-Z]{16}` + // AWS Access Key
+			`|AIza[0-9A-Za-z_\-]{35}` + // Google API Key
+			`|gh[pousr]_[A-Za-z0-9]{36,}` + // GitHub Token
+			`|glpat-[A-Za-z0-9_\-]{20,}` + // GitLab Token
+			`|xox[abposr]-[A-Za-z0-9\-]{10,})`), // Slack Token
+	},
+	model.GuardrailDetectorEmail: {
+		label: model.GuardrailDetectorEmail,
+		re:    regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`),
+	},
+	model.GuardrailDetectorPhone: {
+		label: model.GuardrailDetectorPhone,
+		re:    regexp.MustCompile(`\+[1-9]\d{7,14}\b|\b1[3-9]\d{9}\b`),
+	},
+	model.GuardrailDetectorCreditCard: {
+		label:    model.GuardrailDetectorCreditCard,
+		re:       regexp.MustCompile(`\b(?:\d[ \-]?){12,18}\d\b`),
+		validate: isValidCardNumber,
+	},
+	model.GuardrailDetectorCNID: {
+		label:    model.GuardrailDetectorCNID,
+		re:       regexp.MustCompile(`\b[1-9]\d{5}(?:18|19|20)\d{2}(?:0[1-9]|1[0-2])(?:0[1-9]|[12]\d|3[01])\d{3}[\dXx]\b`),
+		validate: isValidCNIDNumber,
+	},
+}
+
+// isValidCardNumber 校验银行卡号长度（13-19 位）与 Luhn 校验和
+func isValidCardNumber(s string) bool {
+	digits := make([]int, 0, len(s))
+	for _, r := range s {
+		if r >= '0' && r <= '9' {
+			digits = append(digits, int(r-'0'))
+		}
+	}
+	if len(digits) < 13 || len(digits) > 19 {
+		return false
+	}
+	sum := 0
+	double := false
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := digits[i]
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return sum%10 == 0
+}
+
+var cnIDWeights = [17]int{7, 9, 10, 5, 8, 4, 2, 1, 6, 3, 7, 9, 10, 5, 8, 4, 2}
+
+const cnIDCheckCodes = "10X98765432"
+
+// isValidCNIDNumber 校验 18 位居民身份证号的校验位（GB 11643-1999）
+func isValidCNIDNumber(s string) bool {
+	if len(s) != 18 {
+		return false
+	}
+	sum := 0
+	for i := 0; i < 17; i++ {
+		c := s[i]
+		if c < '0' || c > '9' {
+			return false
+		}
+		sum += int(c-'0') * cnIDWeights[i]
+	}
+	return cnIDCheckCodes[sum%11] == strings.ToUpper(s[17:])[0]
+}
+
+// compileGuardrailMatchers 将规则编译为匹配器列表（规则已通过 Validate 校验）
+func compileGuardrailMatchers(r *model.GuardrailRule) []*guardrailMatcher {
+	flags := ""
+	if !r.CaseSensitive {
+		flags = "(?i)"
+	}
+
+	switch r.Type {
+	case model.GuardrailTypeBuiltin:
+		matchers := make([]*guardrailMatcher, 0, len(r.Patterns))
+		for _, name := range r.Patterns {
+			if m, ok := guardrailBuiltinMatchers[name]; ok {
+				matchers = append(matchers, m)
+			}
+		}
+		return matchers
+	case model.GuardrailTypeKeyword:
+		quoted := make([]string, 0, len(r.Patterns))
+		for _, kw := range r.Patterns {
+			if kw = strings.TrimSpace(kw); kw != "" {
+				quoted = append(quoted, regexp.QuoteMeta(kw))
+			}
+		}
+		if len(quoted) == 0 {
+			return nil
+		}
+		re, err := regexp.Compile(flags + "(?:" + strings.Join(quoted, "|") + ")")
+		if err != nil {
+			return nil
+		}
+		return []*guardrailMatcher{{label: model.GuardrailTypeKeyword, re: re}}
+	case model.GuardrailTypeRegex:
+		matchers := make([]*guardrailMatcher, 0, len(r.Patterns))
+		for _, p := range r.Patterns {
+			re, err := regexp.Compile(flags + p)
+			if err != nil {
+				continue
+			}
+			matchers = append(matchers, &guardrailMatcher{label: model.GuardrailTypeRegex, re: re})
+		}
+		return matchers
+	}
+	return nil
+}
+
+// guardrailTextKeys 承载用户文本的字段名，覆盖 Anthropic / OpenAI / Gemini 请求格式：
+// messages[].content、content[].text、system、input、instructions、prompt、contents[].parts[].text 等
+var guardrailTextKeys = map[string]struct{}{
+	"text":         {},
+	"content":      {},
+	"input":        {},
+	"system":       {},
+	"prompt":       {},
+	"instructions": {},
+}
+
+// guardrailText 请求体中的一段待扫描文本及其 JSON 路径
+type guardrailText struct {
+	path  string
+	value string
+}
+
+// collectGuardrailTexts 遍历 JSON 请求体，收集文本字段下的字符串值
+// 数组元素继承父字段名；对象只按自身字段名判断，避免扫描 type 等结构字段
+func collectGuardrailTexts(body []byte) []guardrailText {
+	var texts []guardrailText
+	var walk func(value gjson.Result, path string, isText bool)
+	walk = func(value gjson.Result, path string, isText bool) {
+		switch {
+		case value.Type == gjson.String:
+			if isText && value.Str != "" {
+				texts = append(texts, guardrailText{path: path, value: value.Str})
+			}
+		case value.IsArray():
+			i := 0
+			value.ForEach(func(_, item gjson.Result) bool {
+				walk(item, joinGuardrailPath(path, strconv.Itoa(i)), isText)
+				i++
+				return true
+			})
+		case value.IsObject():
+			value.ForEach(func(key, item gjson.Result) bool {
+				_, textKey := guardrailTextKeys[key.Str]
+				walk(item, joinGuardrailPath(path, escapeGuardrailPathKey(key.Str)), textKey)
+				return true
+			})
+		}
+	}
+	walk(gjson.ParseBytes(body), "", false)
+	return texts
+}
+
+func joinGuardrailPath(parent, key string) string {
+	if parent == "" {
+		return key
+	}
+	return parent + "." + key
+}
+
+// escapeGuardrailPathKey 转义 gjson/sjson 路径中的特殊字符
+func escapeGuardrailPathKey(key string) string {
+	if !strings.ContainsAny(key, `.*?|#@\:!=<>%`) {
+		return key
+	}
+	var sb strings.Builder
+	sb.Grow(len(key) + 4)
+	for _, r := range key {
+		if strings.ContainsRune(`.*?|#@\:!=<>%`, r) {
+			sb.WriteByte('\\')
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
@@ -0,0 +1,510 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/model"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/logger"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+// GuardrailRepository 定义护栏规则的数据访问接口
+type GuardrailRepository interface {
+	// List 获取所有规则
+	List(ctx context.Context) ([]*model.GuardrailRule, error)
+	// GetByID 根据 ID 获取规则
+	GetByID(ctx context.Context, id int64) (*model.GuardrailRule, error)
+	// Create 创建规则
+	Create(ctx context.Context, rule *model.GuardrailRule) (*model.GuardrailRule, error)
+	// Update 更新规则
+	Update(ctx context.Context, rule *model.GuardrailRule) (*model.GuardrailRule, error)
+	// Delete 删除规则
+	Delete(ctx context.Context, id int64) error
+}
+
+// GuardrailCache 定义护栏规则缓存与用户违规计数接口
+type GuardrailCache interface {
+	// Get 从缓存获取规则列表
+	Get(ctx context.Context) ([]*model.GuardrailRule, bool)
+	// Set 设置缓存
+	Set(ctx context.Context, rules []*model.GuardrailRule) error
+	// Invalidate 使缓存失效
+	Invalidate(ctx context.Context) error
+	// NotifyUpdate 通知其他实例刷新缓存
+	NotifyUpdate(ctx context.Context) error
+	// SubscribeUpdates 订阅缓存更新通知
+	SubscribeUpdates(ctx context.Context, handler func())
+
+	// IncrViolation 累加用户的违规次数
+	IncrViolation(ctx context.Context, userID int64, action string) error
+	// GetUserViolations 获取单个用户按动作划分的违规次数
+	GetUserViolations(ctx context.Context, userID int64) (map[string]int64, error)
+	// ListTopViolators 按违规总次数倒序返回用户列表
+	ListTopViolators(ctx context.Context, limit int) ([]GuardrailUserViolations, error)
+}
+
+// GuardrailUserViolations 用户违规统计
+type GuardrailUserViolations struct {
+	UserID   int64            `json:"user_id"`
+	Total    int64            `json:"total"`
+	ByAction map[string]int64 `json:"by_action"`
+}
+
+// GuardrailScope 规则匹配范围（来自当前请求）
+type GuardrailScope struct {
+	Platform string
+	GroupID  int64
+}
+
+// GuardrailMatch 单条规则的命中情况（不包含命中原文，避免敏感内容二次扩散）
+type GuardrailMatch struct {
+	RuleID   int64  `json:"rule_id"`
+	RuleName string `json:"rule_name"`
+	Action   string `json:"action"`
+	Label    string `json:"label"`
+	Count    int    `json:"count"`
+}
+
+// GuardrailResult 护栏扫描结果
+type GuardrailResult struct {
+	// Action 所有命中规则中最严格的动作
+	Action string `json:"action"`
+	// Body 应转发到上游的请求体（仅 redact 时与原始请求体不同）
+	Body []byte `json:"-"`
+	// LogBody 对所有命中内容脱敏后的请求体，用于写入运维日志
+	LogBody []byte           `json:"-"`
+	Matches []GuardrailMatch `json:"matches"`
+}
+
+// Blocked 请求是否应被拦截
+func (r *GuardrailResult) Blocked() bool {
+	return r != nil && r.Action == model.GuardrailActionBlock
+}
+
+// Redacted 请求体是否已被脱敏
+func (r *GuardrailResult) Redacted() bool {
+	return r != nil && r.Action == model.GuardrailActionRedact
+}
+
+// Summary 生成不含敏感原文的命中摘要，如 "rule#3 PII(email x2)"
+func (r *GuardrailResult) Summary() string {
+	if r == nil || len(r.Matches) == 0 {
+		return ""
+	}
+	parts := make([]string, 0, len(r.Matches))
+	for _, m := range r.Matches {
+		parts = append(parts, "rule#"+strconv.FormatInt(m.RuleID, 10)+" "+m.RuleName+"("+m.Label+" x"+strconv.Itoa(m.Count)+")")
+	}
+	return strings.Join(parts, ", ")
+}
+
+// GuardrailService 内容护栏服务
+type GuardrailService struct {
+	repo  GuardrailRepository
+	cache GuardrailCache
+
+	// 本地内存缓存，用于热路径快速匹配
+	localCache   []*cachedGuardrailRule
+	localCacheMu sync.RWMutex
+}
+
+// cachedGuardrailRule 预编译的规则缓存
+type cachedGuardrailRule struct {
+	*model.GuardrailRule
+	lowerPlatforms []string
+	groupIDSet     map[int64]struct{}
+	matchers       []*guardrailMatcher
+}
+
+// NewGuardrailService 创建内容护栏服务
+func NewGuardrailService(
+	repo GuardrailRepository,
+	cache GuardrailCache,
+) *GuardrailService {
+	svc := &GuardrailService{
+		repo:  repo,
+		cache: cache,
+	}
+
+	// 启动时加载规则到本地缓存
+	ctx := context.Background()
+	if err := svc.reloadRulesFromDB(ctx); err != nil {
+		logger.LegacyPrintf("service.guardrail", "[GuardrailService] Failed to load rules from DB on startup: %v", err)
+		if fallbackErr := svc.refreshLocalCache(ctx); fallbackErr != nil {
+			logger.LegacyPrintf("service.guardrail", "[GuardrailService] Failed to load rules from cache fallback on startup: %v", fallbackErr)
+		}
+	}
+
+	// 订阅缓存更新通知
+	if cache != nil {
+		cache.SubscribeUpdates(ctx, func() {
+			if err := svc.refreshLocalCache(context.Background()); err != nil {
+				logger.LegacyPrintf("service.guardrail", "[GuardrailService] Failed to refresh cache on notification: %v", err)
+			}
+		})
+	}
+
+	return svc
+}
+
+// List 获取所有规则
+func (s *GuardrailService) List(ctx context.Context) ([]*model.GuardrailRule, error) {
+	return s.repo.List(ctx)
+}
+
+// GetByID 根据 ID 获取规则
+func (s *GuardrailService) GetByID(ctx context.Context, id int64) (*model.GuardrailRule, error) {
+	return s.repo.GetByID(ctx, id)
+}
+
+// Create 创建规则
+func (s *GuardrailService) Create(ctx context.Context, rule *model.GuardrailRule) (*model.GuardrailRule, error) {
+	if err := rule.Validate(); err != nil {
+		return nil, err
+	}
+
+	created, err := s.repo.Create(ctx, rule)
+	if err != nil {
+		return nil, err
+	}
+
+	refreshCtx, cancel := s.newCacheRefreshContext()
+	defer cancel()
+	s.invalidateAndNotify(refreshCtx)
+
+	return created, nil
+}
+
+// Update 更新规则
+func (s *GuardrailService) Update(ctx context.Context, rule *model.GuardrailRule) (*model.GuardrailRule, error) {
+	if err := rule.Validate(); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.repo.Update(ctx, rule)
+	if err != nil {
+		return nil, err
+	}
+
+	refreshCtx, cancel := s.newCacheRefreshContext()
+	defer cancel()
+	s.invalidateAndNotify(refreshCtx)
+
+	return updated, nil
+}
+
+// Delete 删除规则
+func (s *GuardrailService) Delete(ctx context.Context, id int64) error {
+	if err := s.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	refreshCtx, cancel := s.newCacheRefreshContext()
+	defer cancel()
+	s.invalidateAndNotify(refreshCtx)
+
+	return nil
+}
+
+// Scan 使用当前生效的规则扫描请求体
+// 未命中任何规则时返回 nil，调用方应继续使用原始 body
+func (s *GuardrailService) Scan(scope GuardrailScope, body []byte) *GuardrailResult {
+	if s == nil {
+		return nil
+	}
+	return evaluateGuardrailRules(s.getCachedRules(), scope, body)
+}
+
+// Preview 对样例请求预览扫描结果
+// rule 非空时仅评估该规则（可用于保存前试运行），否则使用当前生效的全部规则
+func (s *GuardrailService) Preview(scope GuardrailScope, body []byte, rule *model.GuardrailRule) (*GuardrailResult, error) {
+	var rules []*cachedGuardrailRule
+	if rule != nil {
+		if err := rule.Validate(); err != nil {
+			return nil, err
+		}
+		draft := *rule
+		draft.Enabled = true
+		rules = []*cachedGuardrailRule{compileGuardrailRule(&draft)}
+	} else {
+		rules = s.getCachedRules()
+	}
+
+	result := evaluateGuardrailRules(rules, scope, body)
+	if result == nil {
+		result = &GuardrailResult{Body: body, LogBody: body}
+	}
+	if result.Matches == nil {
+		result.Matches = []GuardrailMatch{}
+	}
+	return result, nil
+}
+
+// RecordViolation 按用户累加违规次数（计入最终生效的动作）
+func (s *GuardrailService) RecordViolation(ctx context.Context, userID int64, result *GuardrailResult) {
+	if s == nil || s.cache == nil || userID <= 0 || result == nil || result.Action == "" {
+		return
+	}
+	if err := s.cache.IncrViolation(ctx, userID, result.Action); err != nil {
+		logger.LegacyPrintf("service.guardrail", "[GuardrailService] Failed to record violation for user %d: %v", userID, err)
+	}
+}
+
+// ListViolators 按违规总次数倒序返回用户列表
+func (s *GuardrailService) ListViolators(ctx context.Context, limit int) ([]GuardrailUserViolations, error) {
+	if s.cache == nil {
+		return []GuardrailUserViolations{}, nil
+	}
+	return s.cache.ListTopViolators(ctx, limit)
+}
+
+// GetUserViolations 获取单个用户的违规统计
+func (s *GuardrailService) GetUserViolations(ctx context.Context, userID int64) (*GuardrailUserViolations, error) {
+	result := &GuardrailUserViolations{UserID: userID, ByAction: map[string]int64{}}
+	if s.cache == nil {
+		return result, nil
+	}
+	counts, err := s.cache.GetUserViolations(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for action, n := range counts {
+		result.ByAction[action] = n
+		result.Total += n
+	}
+	return result, nil
+}
+
+// evaluateGuardrailRules 评估所有命中范围的启用规则
+// 多条规则命中时取最严格的动作（block > redact > flag）；redact 仅替换 redact 规则的命中内容
+func evaluateGuardrailRules(rules []*cachedGuardrailRule, scope GuardrailScope, body []byte) *GuardrailResult {
+	if len(rules) == 0 {
+		return nil
+	}
+
+	lowerPlatform := strings.ToLower(scope.Platform)
+	applicable := make([]*cachedGuardrailRule, 0, len(rules))
+	for _, rule := range rules {
+		if rule.Enabled && len(rule.matchers) > 0 && rule.matches(lowerPlatform, scope) {
+			applicable = append(applicable, rule)
+		}
+	}
+	if len(applicable) == 0 {
+		return nil
+	}
+
+	// 非 JSON 请求体（如 multipart 图片上传）不做扫描
+	if !gjson.ValidBytes(body) || !gjson.ParseBytes(body).IsObject() {
+		return nil
+	}
+	texts := collectGuardrailTexts(body)
+	if len(texts) == 0 {
+		return nil
+	}
+
+	var result *GuardrailResult
+	var matched []*guardrailMatcher
+	var redactMatchers []*guardrailMatcher
+	for _, rule := range applicable {
+		for _, m := range rule.matchers {
+			count := 0
+			for _, t := range texts {
+				count += m.count(t.value)
+			}
+			if count == 0 {
+				continue
+			}
+			if result == nil {
+				result = &GuardrailResult{Body: body}
+			}
+			result.Matches = append(result.Matches, GuardrailMatch{
+				RuleID:   rule.ID,
+				RuleName: rule.Name,
+				Action:   rule.Action,
+				Label:    m.label,
+				Count:    count,
+			})
+			if model.GuardrailActionSeverity(rule.Action) > model.GuardrailActionSeverity(result.Action) {
+				result.Action = rule.Action
+			}
+			matched = append(matched, m)
+			if rule.Action == model.GuardrailActionRedact {
+				redactMatchers = append(redactMatchers, m)
+			}
+		}
+	}
+	if result == nil {
+		return nil
+	}
+
+	result.LogBody = redactGuardrailTexts(body, texts, matched)
+	if result.Action == model.GuardrailActionRedact {
+		if len(redactMatchers) == len(matched) {
+			result.Body = result.LogBody
+		} else {
+			result.Body = redactGuardrailTexts(body, texts, redactMatchers)
+		}
+	}
+	return result
+}
+
+// redactGuardrailTexts 对收集到的文本依次应用匹配器脱敏，并写回请求体
+func redactGuardrailTexts(body []byte, texts []guardrailText, matchers []*guardrailMatcher) []byte {
+	out := body
+	for _, t := range texts {
+		value := t.value
+		for _, m := range matchers {
+			value = m.redact(value)
+		}
+		if value == t.value {
+			continue
+		}
+		if next, err := sjson.SetBytes(out, t.path, value); err == nil {
+			out = next
+		}
+	}
+	return out
+}
+
+// matches 检查规则范围：平台、分组均需满足（空表示不限制）
+func (r *cachedGuardrailRule) matches(lowerPlatform string, scope GuardrailScope) bool {
+	if len(r.lowerPlatforms) > 0 {
+		matched := false
+		for _, p := range r.lowerPlatforms {
+			if p == lowerPlatform {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	if len(r.groupIDSet) > 0 {
+		if _, ok := r.groupIDSet[scope.GroupID]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// getCachedRules 获取缓存的规则列表（按优先级排序）
+func (s *GuardrailService) getCachedRules() []*cachedGuardrailRule {
+	s.localCacheMu.RLock()
+	rules := s.localCache
+	s.localCacheMu.RUnlock()
+
+	if rules != nil {
+		return rules
+	}
+
+	// 如果本地缓存为空，尝试刷新
+	ctx := context.Background()
+	if err := s.refreshLocalCache(ctx); err != nil {
+		logger.LegacyPrintf("service.guardrail", "[GuardrailService] Failed to refresh cache: %v", err)
+		return nil
+	}
+
+	s.localCacheMu.RLock()
+	defer s.localCacheMu.RUnlock()
+	return s.localCache
+}
+
+// refreshLocalCache 刷新本地缓存
+func (s *GuardrailService) refreshLocalCache(ctx context.Context) error {
+	if s.cache != nil {
+		if rules, ok := s.cache.Get(ctx); ok {
+			s.setLocalCache(rules)
+			return nil
+		}
+	}
+
+	return s.reloadRulesFromDB(ctx)
+}
+
+// reloadRulesFromDB 从数据库加载规则，绕过 cache.Get 以拿到最新值
+func (s *GuardrailService) reloadRulesFromDB(ctx context.Context) error {
+	rules, err := s.repo.List(ctx)
+	if err != nil {
+		return err
+	}
+
+	if s.cache != nil {
+		if err := s.cache.Set(ctx, rules); err != nil {
+			logger.LegacyPrintf("service.guardrail", "[GuardrailService] Failed to set cache: %v", err)
+		}
+	}
+
+	s.setLocalCache(rules)
+	return nil
+}
+
+func compileGuardrailRule(r *model.GuardrailRule) *cachedGuardrailRule {
+	cr := &cachedGuardrailRule{GuardrailRule: r, matchers: compileGuardrailMatchers(r)}
+	if len(r.Platforms) > 0 {
+		cr.lowerPlatforms = make([]string, len(r.Platforms))
+		for i, p := range r.Platforms {
+			cr.lowerPlatforms[i] = strings.ToLower(p)
+		}
+	}
+	if len(r.GroupIDs) > 0 {
+		cr.groupIDSet = make(map[int64]struct{}, len(r.GroupIDs))
+		for _, id := range r.GroupIDs {
+			cr.groupIDSet[id] = struct{}{}
+		}
+	}
+	return cr
+}
+
+// setLocalCache 设置本地缓存（按优先级稳定排序）
+func (s *GuardrailService) setLocalCache(rules []*model.GuardrailRule) {
+	cached := make([]*cachedGuardrailRule, len(rules))
+	for i, r := range rules {
+		cached[i] = compileGuardrailRule(r)
+	}
+	sort.SliceStable(cached, func(i, j int) bool {
+		return cached[i].Priority < cached[j].Priority
+	})
+
+	s.localCacheMu.Lock()
+	s.localCache = cached
+	s.localCacheMu.Unlock()
+}
+
+// clearLocalCache 清空本地缓存，避免刷新失败时继续命中陈旧规则。
+func (s *GuardrailService) clearLocalCache() {
+	s.localCacheMu.Lock()
+	s.localCache = nil
+	s.localCacheMu.Unlock()
+}
+
+// newCacheRefreshContext 为写路径缓存同步创建独立上下文，避免受请求取消影响。
+func (s *GuardrailService) newCacheRefreshContext() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), 3*time.Second)
+}
+
+// invalidateAndNotify 使缓存失效并通知其他实例
+func (s *GuardrailService) invalidateAndNotify(ctx context.Context) {
+	if s.cache != nil {
+		if err := s.cache.Invalidate(ctx); err != nil {
+			logger.LegacyPrintf("service.guardrail", "[GuardrailService] Failed to invalidate cache: %v", err)
+		}
+	}
+
+	if err := s.reloadRulesFromDB(ctx); err != nil {
+		logger.LegacyPrintf("service.guardrail", "[GuardrailService] Failed to refresh local cache: %v", err)
+		s.clearLocalCache()
+	}
+
+	if s.cache != nil {
+		if err := s.cache.NotifyUpdate(ctx); err != nil {
+			logger.LegacyPrintf("service.guardrail", "[GuardrailService] Failed to notify cache update: %v", err)
+		}
+	}
+}
@@ -0,0 +1,238 @@
+package service
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"math"
+	"strconv"
+	"strings"
+
+	dbent "github.com/Wei-Shaw/sub2api/ent"
+	"github.com/Wei-Shaw/sub2api/internal/model"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/pdf"
+)
+
+// RenderedInvoice is an invoice rendered for download or email.
+type RenderedInvoice struct {
+	Filename    string
+	ContentType string
+	Data        []byte
+}
+
+func invoiceTitle(kind string) string {
+	if kind == model.InvoiceKindCreditNote {
+		return "Credit Note"
+	}
+	return "Invoice"
+}
+
+func formatInvoiceMoney(currency string, v float64) string {
+	return currency + " " + strconv.FormatFloat(v, 'f', 2, 64)
+}
+
+// invoiceTotalsRows returns the label/value rows of the totals block; zero discount and fee rows are omitted.
+func invoiceTotalsRows(inv *dbent.Invoice) [][2]string {
+	rows := [][2]string{{"Subtotal", formatInvoiceMoney(inv.Currency, inv.Subtotal)}}
+	if inv.DiscountAmount > 0 {
+		rows = append(rows, [2]string{"Discount", "-" + formatInvoiceMoney(inv.Currency, inv.DiscountAmount)})
+	}
+	if inv.FeeAmount > 0 {
+		rows = append(rows, [2]string{"Payment fee", formatInvoiceMoney(inv.Currency, inv.FeeAmount)})
+	}
+	return rows
+}
+
+// invoiceMetaRows returns the label/value rows of the document details block.
+func invoiceMetaRows(inv *dbent.Invoice, originalNo string) [][2]string {
+	rows := [][2]string{
+		{"Number", inv.InvoiceNo},
+		{"Date", inv.IssuedAt.Format("2006-01-02")},
+	}
+	if originalNo != "" {
+		rows = append(rows, [2]string{"Original invoice", originalNo})
+	}
+	if inv.SourceNo != "" {
+		rows = append(rows, [2]string{"Order", inv.SourceNo})
+	}
+	if inv.PaymentMethod != "" {
+		rows = append(rows, [2]string{"Payment method", inv.PaymentMethod})
+	}
+	return rows
+}
+
+var invoiceHTMLTemplate = template.Must(template.New("invoice").Funcs(template.FuncMap{
+	"money": formatInvoiceMoney,
+	"lines": func(s string) []string { return strings.Split(s, "\n") },
+}).Parse(`<!DOCTYPE html>
+<html><head><meta charset="UTF-8"><title>{{.Title}} {{.Inv.InvoiceNo}}</title></head>
+<body style="margin:0;padding:24px;background:#f5f5f5;font-family:Helvetica,Arial,'PingFang SC','Microsoft YaHei',sans-serif;color:#222;">
+<div style="max-width:720px;margin:0 auto;background:#fff;padding:32px;border-radius:8px;">
+  <table width="100%" cellpadding="0" cellspacing="0"><tr>
+    <td valign="top">
+      <div style="font-size:20px;font-weight:bold;">{{.Inv.SellerName}}</div>
+      {{if .Inv.SellerTaxID}}<div style="font-size:13px;color:#555;">Tax ID: {{.Inv.SellerTaxID}}</div>{{end}}
+      {{range lines .Inv.SellerAddress}}<div style="font-size:13px;color:#555;">{{.}}</div>{{end}}
+    </td>
+    <td valign="top" align="right">
+      <div style="font-size:22px;font-weight:bold;text-transform:uppercase;">{{.Title}}</div>
+      <table cellpadding="2" cellspacing="0" style="font-size:13px;margin-top:8px;">
+        {{range .Meta}}<tr><td style="color:#777;padding-right:12px;">{{index . 0}}</td><td align="right">{{index . 1}}</td></tr>{{end}}
+      </table>
+    </td>
+  </tr></table>
+  <div style="margin-top:28px;font-size:12px;color:#777;text-transform:uppercase;">Bill to</div>
+  <div style="font-size:14px;font-weight:bold;">{{.Inv.BuyerName}}</div>
+  {{if .Inv.BuyerTaxID}}<div style="font-size:13px;">Tax ID: {{.Inv.BuyerTaxID}}</div>{{end}}
+  {{if .Inv.BuyerAddress}}{{range lines .Inv.BuyerAddress}}<div style="font-size:13px;">{{.}}</div>{{end}}{{end}}
+  {{if .Inv.BuyerEmail}}<div style="font-size:13px;">{{.Inv.BuyerEmail}}</div>{{end}}
+  <table width="100%" cellpadding="8" cellspacing="0" style="margin-top:24px;font-size:13px;border-collapse:collapse;">
+    <tr style="background:#f0f0f0;"><th align="left">Description</th><th align="right">Qty</th><th align="right">Unit price</th><th align="right">Amount</th></tr>
+    {{range .Inv.Lines}}<tr style="border-bottom:1px solid #eee;"><td>{{.Description}}</td><td align="right">{{.Quantity}}</td><td align="right">{{money $.Inv.Currency .UnitPrice}}</td><td align="right">{{money $.Inv.Currency .Amount}}</td></tr>{{end}}
+  </table>
+  <table cellpadding="4" cellspacing="0" style="margin:16px 0 0 auto;font-size:13px;">
+    {{range .Totals}}<tr><td style="color:#777;padding-right:24px;">{{index . 0}}</td><td align="right">{{index . 1}}</td></tr>{{end}}
+    <tr><td style="font-weight:bold;padding-right:24px;">{{if .CreditNote}}Total credited{{else}}Total paid{{end}}</td><td align="right" style="font-weight:bold;">{{money .Inv.Currency .Inv.Total}}</td></tr>
+  </table>
+  {{if .Inv.Notes}}<div style="margin-top:24px;font-size:13px;color:#555;">Notes: {{.Inv.Notes}}</div>{{end}}
+</div>
+</body></html>
+`))
+
+// renderInvoiceHTML renders an invoice as a standalone HTML page (also used as the email body).
+func renderInvoiceHTML(inv *dbent.Invoice, originalNo string) ([]byte, error) {
+	var buf bytes.Buffer
+	err := invoiceHTMLTemplate.Execute(&buf, map[string]any{
+		"Inv":        inv,
+		"Title":      invoiceTitle(inv.Kind),
+		"CreditNote": inv.Kind == model.InvoiceKindCreditNote,
+		"Meta":       invoiceMetaRows(inv, originalNo),
+		"Totals":     invoiceTotalsRows(inv),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("render invoice html: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// PDF layout (points, A4).
+const (
+	invoicePDFLeft  = 50.0
+	invoicePDFRight = pdf.PageWidth - 50
+)
+
+// wrapPDFText splits text into lines no wider than maxWidth, honouring explicit newlines.
+func wrapPDFText(s string, maxWidth, size float64, font pdf.Font) []string {
+	var out []string
+	for _, para := range strings.Split(s, "\n") {
+		var line []rune
+		for _, r := range para {
+			if len(line) > 0 && pdf.TextWidth(string(append(line, r)), size, font) > maxWidth {
+				out = append(out, string(line))
+				line = line[:0]
+			}
+			line = append(line, r)
+		}
+		out = append(out, string(line))
+	}
+	return out
+}
+
+// renderInvoicePDF renders an invoice as a single-page A4 PDF.
+func renderInvoicePDF(inv *dbent.Invoice, originalNo string) ([]byte, error) {
+	doc := pdf.New()
+	doc.SetTitle(invoiceTitle(inv.Kind) + " " + inv.InvoiceNo)
+	p := doc.AddPage()
+
+	// Seller (left) and document title (right)
+	p.Text(invoicePDFLeft, 70, 18, pdf.HelveticaBold, inv.SellerName)
+	p.TextRight(invoicePDFRight, 70, 20, pdf.HelveticaBold, strings.ToUpper(invoiceTitle(inv.Kind)))
+	y := 88.0
+	if inv.SellerTaxID != "" {
+		p.Text(invoicePDFLeft, y, 9, pdf.Helvetica, "Tax ID: "+inv.SellerTaxID)
+		y += 13
+	}
+	for _, l := range wrapPDFText(inv.SellerAddress, 260, 9, pdf.Helvetica) {
+		if l != "" {
+			p.Text(invoicePDFLeft, y, 9, pdf.Helvetica, l)
+			y += 13
+		}
+	}
+	metaY := 92.0
+	for _, row := range invoiceMetaRows(inv, originalNo) {
+		p.TextRight(invoicePDFRight-130, metaY, 9, pdf.Helvetica, row[0])
+		p.TextRight(invoicePDFRight, metaY, 9, pdf.HelveticaBold, row[1])
+		metaY += 14
+	}
+
+	// Buyer
+	y = math.Max(y, metaY) + 20
+	p.Text(invoicePDFLeft, y, 8, pdf.Helvetica, "BILL TO")
+	y += 15
+	p.Text(invoicePDFLeft, y, 11, pdf.HelveticaBold, inv.BuyerName)
+	y += 14
+	if inv.BuyerTaxID != "" {
+		p.Text(invoicePDFLeft, y, 9, pdf.Helvetica, "Tax ID: "+inv.BuyerTaxID)
+		y += 13
+	}
+	if inv.BuyerAddress != "" {
+		for _, l := range wrapPDFText(inv.BuyerAddress, 300, 9, pdf.Helvetica) {
+			p.Text(invoicePDFLeft, y, 9, pdf.Helvetica, l)
+			y += 13
+		}
+	}
+	if inv.BuyerEmail != "" {
+		p.Text(invoicePDFLeft, y, 9, pdf.Helvetica, inv.BuyerEmail)
+		y += 13
+	}
+
+	// Line items
+	y += 20
+	colQty, colUnit, colAmount := invoicePDFRight-210, invoicePDFRight-100, invoicePDFRight-6
+	p.FillRect(invoicePDFLeft, y, invoicePDFRight-invoicePDFLeft, 22, 0.92)
+	p.Text(invoicePDFLeft+6, y+15, 9, pdf.HelveticaBold, "Description")
+	p.TextRight(colQty, y+15, 9, pdf.HelveticaBold, "Qty")
+	p.TextRight(colUnit, y+15, 9, pdf.HelveticaBold, "Unit price")
+	p.TextRight(colAmount, y+15, 9, pdf.HelveticaBold, "Amount")
+	y += 22
+	for _, line := range inv.Lines {
+		descLines := wrapPDFText(line.Description, colQty-invoicePDFLeft-50, 10, pdf.Helvetica)
+		for i, l := range descLines {
+			p.Text(invoicePDFLeft+6, y+16+float64(i)*13, 10, pdf.Helvetica, l)
+		}
+		p.TextRight(colQty, y+16, 10, pdf.Helvetica, strconv.Itoa(line.Quantity))
+		p.TextRight(colUnit, y+16, 10, pdf.Helvetica, formatInvoiceMoney(inv.Currency, line.UnitPrice))
+		p.TextRight(colAmount, y+16, 10, pdf.Helvetica, formatInvoiceMoney(inv.Currency, line.Amount))
+		y += 10 + 13*float64(len(descLines))
+		p.Line(invoicePDFLeft, y, invoicePDFRight, y, 0.5)
+	}
+
+	// Totals
+	y += 22
+	for _, row := range invoiceTotalsRows(inv) {
+		p.TextRight(colUnit, y, 10, pdf.Helvetica, row[0])
+		p.TextRight(colAmount, y, 10, pdf.Helvetica, row[1])
+		y += 16
+	}
+	totalLabel := "Total paid"
+	if inv.Kind == model.InvoiceKindCreditNote {
+		totalLabel = "Total credited"
+	}
+	p.Line(colUnit-80, y-8, invoicePDFRight, y-8, 0.5)
+	p.TextRight(colUnit, y+6, 11, pdf.HelveticaBold, totalLabel)
+	p.TextRight(colAmount, y+6, 11, pdf.HelveticaBold, formatInvoiceMoney(inv.Currency, inv.Total))
+	y += 36
+
+	if inv.Notes != "" {
+		for _, l := range wrapPDFText("Notes: "+inv.Notes, invoicePDFRight-invoicePDFLeft, 9, pdf.Helvetica) {
+			p.Text(invoicePDFLeft, y, 9, pdf.Helvetica, l)
+			y += 13
+		}
+	}
+
+	data, err := doc.Bytes()
+	if err != nil {
+		return nil, fmt.Errorf("render invoice pdf: %w", err)
+	}
+	return data, nil
+}
@@ -0,0 +1,540 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	dbent "github.com/Wei-Shaw/sub2api/ent"
+	"github.com/Wei-Shaw/sub2api/ent/invoice"
+	"github.com/Wei-Shaw/sub2api/ent/invoicesequence"
+	"github.com/Wei-Shaw/sub2api/internal/model"
+	"github.com/Wei-Shaw/sub2api/internal/payment"
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+// Invoice source types.
+const (
+	InvoiceSourcePaymentOrder = "payment_order"
+	InvoiceSourcePaygOrder    = "payg_order"
+)
+
+// User attribute keys read into the buyer block of an invoice.
+const (
+	InvoiceAttrCompanyName = "company_name"
+	InvoiceAttrTaxID       = "tax_id"
+	InvoiceAttrAddress     = "address"
+)
+
+const (
+	invoiceCurrency     = "CNY"
+	invoiceIssueTimeout = 30 * time.Second
+)
+
+// invoiceNumberPrefixes maps an invoice kind (which is also its numbering series) to its number prefix.
+var invoiceNumberPrefixes = map[string]string{
+	model.InvoiceKindInvoice:    "INV",
+	model.InvoiceKindCreditNote: "CN",
+}
+
+// invoiceEligibleStatuses are the payment order statuses that have been paid and fulfilled.
+var invoiceEligibleStatuses = []string{
+	OrderStatusCompleted, OrderStatusRefundRequested, OrderStatusRefunding,
+	OrderStatusPartiallyRefunded, OrderStatusRefunded, OrderStatusRefundFailed,
+}
+
+// InvoiceListParams filters invoice lists.
+type InvoiceListParams struct {
+	UserID     int64
+	Kind       string
+	SourceType string
+	Page       int
+	PageSize   int
+}
+
+// InvoiceService issues sequentially numbered invoices for completed orders and credit
+// notes for refunds, renders them as HTML and PDF, and emails them to the buyer.
+type InvoiceService struct {
+	entClient            *dbent.Client
+	userRepo             UserRepository
+	emailService         *EmailService
+	userAttributeService *UserAttributeService
+	configService        *PaymentConfigService
+	settingService       *SettingService
+}
+
+// NewInvoiceService creates a new InvoiceService.
+func NewInvoiceService(
+	entClient *dbent.Client,
+	userRepo UserRepository,
+	emailService *EmailService,
+	userAttributeService *UserAttributeService,
+	configService *PaymentConfigService,
+	settingService *SettingService,
+) *InvoiceService {
+	return &InvoiceService{
+		entClient:            entClient,
+		userRepo:             userRepo,
+		emailService:         emailService,
+		userAttributeService: userAttributeService,
+		configService:        configService,
+		settingService:       settingService,
+	}
+}
+
+// formatInvoiceNumber formats the n-th number of an invoice series, e.g. INV-000042.
+func formatInvoiceNumber(kind string, n int64) string {
+	return fmt.Sprintf("%s-%06d", invoiceNumberPrefixes[kind], n)
+}
+
+// splitPayAmount splits the amount paid through the gateway into the pre-fee charge and
+// the fee, reversing payment.CalculatePayAmount (which rounds the fee up to the cent).
+func splitPayAmount(payAmount, feeRate float64) (charge, fee float64) {
+	pay := decimal.NewFromFloat(payAmount).Round(2)
+	if feeRate <= 0 {
+		return pay.InexactFloat64(), 0
+	}
+	guess := pay.Div(decimal.NewFromFloat(1 + feeRate/100)).Round(2)
+	cent := decimal.New(1, -2)
+	for _, d := range []int64{0, -1, 1, -2, 2} {
+		c := guess.Add(cent.Mul(decimal.NewFromInt(d)))
+		if payment.CalculatePayAmount(c.InexactFloat64(), feeRate) == pay.StringFixed(2) {
+			return c.InexactFloat64(), pay.Sub(c).InexactFloat64()
+		}
+	}
+	return guess.InexactFloat64(), pay.Sub(guess).InexactFloat64()
+}
+
+// buildPaymentOrderInvoice builds the unsaved invoice of a completed payment order.
+// The subtotal is the price before the coupon discount; the total is what was paid.
+func buildPaymentOrderInvoice(o *dbent.PaymentOrder, planName string) *dbent.Invoice {
+	charge, fee := splitPayAmount(o.PayAmount, o.FeeRate)
+	subtotal := decimal.NewFromFloat(charge).Add(decimal.NewFromFloat(o.DiscountAmount)).Round(2).InexactFloat64()
+
+	var desc string
+	switch {
+	case o.OrderType == payment.OrderTypeSubscription && planName != "" && o.SubscriptionDays != nil:
+		desc = fmt.Sprintf("Subscription: %s (%d days)", planName, *o.SubscriptionDays)
+	case o.OrderType == payment.OrderTypeSubscription && planName != "":
+		desc = "Subscription: " + planName
+	case o.OrderType == payment.OrderTypeSubscription:
+		desc = "Subscription"
+	default:
+		desc = fmt.Sprintf("Account balance top-up (%.2f credited)", o.Amount)
+	}
+	var notes string
+	if o.CouponCode != nil && o.DiscountAmount > 0 {
+		notes = "Coupon " + *o.CouponCode
+	}
+	return &dbent.Invoice{
+		Kind:           model.InvoiceKindInvoice,
+		UserID:         o.UserID,
+		SourceType:     InvoiceSourcePaymentOrder,
+		SourceID:       o.ID,
+		SourceNo:       firstNonEmpty(o.OutTradeNo, o.RechargeCode),
+		Currency:       invoiceCurrency,
+		Subtotal:       subtotal,
+		DiscountAmount: o.DiscountAmount,
+		FeeAmount:      fee,
+		Total:          o.PayAmount,
+		Lines:          []model.InvoiceLine{{Description: desc, Quantity: 1, UnitPrice: subtotal, Amount: subtotal}},
+		PaymentMethod:  o.PaymentType,
+		BuyerEmail:     o.UserEmail,
+		BuyerName:      o.UserName,
+		Notes:          notes,
+	}
+}
+
+// buildCreditNote builds the unsaved credit note of a refund against an original invoice.
+// Amounts are positive; the kind marks the document as a reversal.
+func buildCreditNote(orig *dbent.Invoice, refundAmount float64, reason string) *dbent.Invoice {
+	amount := decimal.NewFromFloat(refundAmount).Round(2).InexactFloat64()
+	origID := orig.ID
+	return &dbent.Invoice{
+		Kind:              model.InvoiceKindCreditNote,
+		UserID:            orig.UserID,
+		SourceType:        orig.SourceType,
+		SourceID:          orig.SourceID,
+		SourceNo:          orig.SourceNo,
+		OriginalInvoiceID: &origID,
+		Currency:          orig.Currency,
+		Subtotal:          amount,
+		Total:             amount,
+		Lines: []model.InvoiceLine{{
+			Description: "Refund of invoice " + orig.InvoiceNo,
+			Quantity:    1,
+			UnitPrice:   amount,
+			Amount:      amount,
+		}},
+		PaymentMethod: orig.PaymentMethod,
+		BuyerEmail:    orig.BuyerEmail,
+		BuyerName:     orig.BuyerName,
+		BuyerTaxID:    orig.BuyerTaxID,
+		BuyerAddress:  orig.BuyerAddress,
+		Notes:         strings.TrimSpace(reason),
+	}
+}
+
+// buildPaygOrderInvoice builds the unsaved invoice of a paid pay-as-you-go order.
+func buildPaygOrderInvoice(o *dbent.PaygOrder) *dbent.Invoice {
+	amount := decimal.NewFromFloat(o.AmountYuan).Round(2).InexactFloat64()
+	return &dbent.Invoice{
+		Kind:       model.InvoiceKindInvoice,
+		UserID:     o.UserID,
+		SourceType: InvoiceSourcePaygOrder,
+		SourceID:   o.ID,
+		SourceNo:   firstNonEmpty(o.ClientSn, psStringValue(o.Sn)),
+		Currency:   invoiceCurrency,
+		Subtotal:   amount,
+		Total:      amount,
+		Lines: []model.InvoiceLine{{
+			Description: fmt.Sprintf("Pay-as-you-go top-up (%.2f credited)", o.CreditAmount),
+			Quantity:    1,
+			UnitPrice:   amount,
+			Amount:      amount,
+		}},
+		PaymentMethod: firstNonEmpty(o.PaywayName, o.Payway),
+	}
+}
+
+// buyerFromAttributes picks the invoice buyer fields out of a user's attribute values.
+func buyerFromAttributes(defs []UserAttributeDefinition, values []UserAttributeValue) (name, taxID, address string) {
+	keys := make(map[int64]string, len(defs))
+	for _, d := range defs {
+		keys[d.ID] = d.Key
+	}
+	for _, v := range values {
+		val := strings.TrimSpace(v.Value)
+		switch keys[v.AttributeID] {
+		case InvoiceAttrCompanyName:
+			name = val
+		case InvoiceAttrTaxID:
+			taxID = val
+		case InvoiceAttrAddress:
+			address = val
+		}
+	}
+	return name, taxID, address
+}
+
+// resolveBuyer fills the buyer block from the user's profile and invoice attributes.
+// Attribute lookup failures only lose the company details, never the invoice.
+func (s *InvoiceService) resolveBuyer(ctx context.Context, inv *dbent.Invoice) {
+	if user, err := s.userRepo.GetByID(ctx, inv.UserID); err == nil {
+		inv.BuyerEmail = firstNonEmpty(user.Email, inv.BuyerEmail)
+		inv.BuyerName = firstNonEmpty(user.Username, inv.BuyerName, user.Email)
+	}
+	if s.userAttributeService == nil {
+		return
+	}
+	defs, err := s.userAttributeService.ListDefinitions(ctx, false)
+	if err != nil {
+		slog.Warn("[Invoice] list attribute definitions failed", "userID", inv.UserID, "error", err)
+		return
+	}
+	values, err := s.userAttributeService.GetUserAttributes(ctx, inv.UserID)
+	if err != nil {
+		slog.Warn("[Invoice] get user attributes failed", "userID", inv.UserID, "error", err)
+		return
+	}
+	name, taxID, address := buyerFromAttributes(defs, values)
+	inv.BuyerName = firstNonEmpty(name, inv.BuyerName)
+	inv.BuyerTaxID = taxID
+	inv.BuyerAddress = address
+}
+
+// resolveSeller fills the seller block from the payment config; the seller name defaults to the site name.
+func (s *InvoiceService) resolveSeller(ctx context.Context, inv *dbent.Invoice, cfg *PaymentConfig) {
+	inv.SellerName = cfg.InvoiceSellerName
+	if inv.SellerName == "" && s.settingService != nil {
+		inv.SellerName = s.settingService.GetSiteName(ctx)
+	}
+	inv.SellerTaxID = cfg.InvoiceSellerTaxID
+	inv.SellerAddress = cfg.InvoiceSellerAddress
+}
+
+// issue saves a draft invoice under the next number of its series. The series row is
+// locked for the whole transaction, so numbers are gapless and issued in order. Issuing
+// is idempotent per (source, kind): an existing invoice is returned with created=false.
+func (s *InvoiceService) issue(ctx context.Context, draft *dbent.Invoice) (inv *dbent.Invoice, created bool, err error) {
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return nil, false, fmt.Errorf("begin invoice transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	seq, err := tx.InvoiceSequence.Query().Where(invoicesequence.SeriesEQ(draft.Kind)).ForUpdate().Only(ctx)
+	if err != nil {
+		return nil, false, fmt.Errorf("lock invoice sequence %s: %w", draft.Kind, err)
+	}
+	// Checked after taking the lock so a concurrent issue of the same source is visible.
+	existing, err := tx.Invoice.Query().
+		Where(invoice.SourceTypeEQ(draft.SourceType), invoice.SourceIDEQ(draft.SourceID), invoice.KindEQ(draft.Kind)).
+		Only(ctx)
+	if err == nil {
+		return existing, false, nil
+	}
+	if !dbent.IsNotFound(err) {
+		return nil, false, fmt.Errorf("check existing invoice: %w", err)
+	}
+
+	next := seq.LastNumber + 1
+	if _, err := tx.InvoiceSequence.UpdateOneID(seq.ID).SetLastNumber(next).Save(ctx); err != nil {
+		return nil, false, fmt.Errorf("advance invoice sequence: %w", err)
+	}
+	inv, err = tx.Invoice.Create().
+		SetInvoiceNo(formatInvoiceNumber(draft.Kind, next)).
+		SetKind(draft.Kind).
+		SetUserID(draft.UserID).
+		SetSourceType(draft.SourceType).
+		SetSourceID(draft.SourceID).
+		SetSourceNo(draft.SourceNo).
+		SetNillableOriginalInvoiceID(draft.OriginalInvoiceID).
+		SetCurrency(draft.Currency).
+		SetSubtotal(draft.Subtotal).
+		SetDiscountAmount(draft.DiscountAmount).
+		SetFeeAmount(draft.FeeAmount).
+		SetTotal(draft.Total).
+		SetLines(draft.Lines).
+		SetPaymentMethod(draft.PaymentMethod).
+		SetBuyerEmail(draft.BuyerEmail).
+		SetBuyerName(draft.BuyerName).
+		SetBuyerTaxID(draft.BuyerTaxID).
+		SetBuyerAddress(draft.BuyerAddress).
+		SetSellerName(draft.SellerName).
+		SetSellerTaxID(draft.SellerTaxID).
+		SetSellerAddress(draft.SellerAddress).
+		SetNotes(draft.Notes).
+		SetIssuedAt(paymentBeijingNow()).
+		Save(ctx)
+	if err != nil {
+		return nil, false, fmt.Errorf("create invoice: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, false, fmt.Errorf("commit invoice: %w", err)
+	}
+	return inv, true, nil
+}
+
+// issueAndNotify issues a draft and, for newly issued documents, emails it when auto-email is on.
+func (s *InvoiceService) issueAndNotify(ctx context.Context, draft *dbent.Invoice, fillBuyer bool) (*dbent.Invoice, error) {
+	cfg, err := s.configService.GetPaymentConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get payment config: %w", err)
+	}
+	if fillBuyer {
+		s.resolveBuyer(ctx, draft)
+	}
+	s.resolveSeller(ctx, draft, cfg)
+	inv, created, err := s.issue(ctx, draft)
+	if err != nil {
+		return nil, err
+	}
+	if created {
+		slog.Info("[Invoice] issued", "invoiceNo", inv.InvoiceNo, "kind", inv.Kind, "source", inv.SourceType, "sourceID", inv.SourceID, "total", inv.Total)
+		if cfg.InvoiceAutoEmail && inv.BuyerEmail != "" {
+			if err := s.SendInvoiceEmail(ctx, inv); err != nil {
+				slog.Warn("[Invoice] auto email failed", "invoiceNo", inv.InvoiceNo, "error", err)
+			} else if updated, err := s.entClient.Invoice.Get(ctx, inv.ID); err == nil {
+				inv = updated
+			}
+		}
+	}
+	return inv, nil
+}
+
+// IssueForPaymentOrder issues the invoice of a fulfilled payment order, or returns the existing one.
+func (s *InvoiceService) IssueForPaymentOrder(ctx context.Context, orderID int64) (*dbent.Invoice, error) {
+	o, err := s.entClient.PaymentOrder.Get(ctx, orderID)
+	if err != nil {
+		if dbent.IsNotFound(err) {
+			return nil, infraerrors.NotFound("NOT_FOUND", "order not found")
+		}
+		return nil, fmt.Errorf("get payment order: %w", err)
+	}
+	if !psSliceContains(invoiceEligibleStatuses, o.Status) {
+		return nil, infraerrors.BadRequest("INVOICE_NOT_ELIGIBLE", "only completed orders can be invoiced")
+	}
+	var planName string
+	if o.PlanID != nil {
+		if plan, err := s.entClient.SubscriptionPlan.Get(ctx, *o.PlanID); err == nil {
+			planName = firstNonEmpty(plan.ProductName, plan.Name)
+		}
+	}
+	return s.issueAndNotify(ctx, buildPaymentOrderInvoice(o, planName), true)
+}
+
+// IssueForPaygOrder issues the invoice of a paid pay-as-you-go order, or returns the existing one.
+func (s *InvoiceService) IssueForPaygOrder(ctx context.Context, orderID int64) (*dbent.Invoice, error) {
+	o, err := s.entClient.PaygOrder.Get(ctx, orderID)
+	if err != nil {
+		if dbent.IsNotFound(err) {
+			return nil, infraerrors.NotFound("NOT_FOUND", "order not found")
+		}
+		return nil, fmt.Errorf("get payg order: %w", err)
+	}
+	if o.Status != PaygOrderStatusPaid {
+		return nil, infraerrors.BadRequest("INVOICE_NOT_ELIGIBLE", "only paid orders can be invoiced")
+	}
+	return s.issueAndNotify(ctx, buildPaygOrderInvoice(o), true)
+}
+
+// IssueCreditNoteForPaymentOrder issues the credit note of a payment order refund. The
+// original invoice is issued first when the order was never invoiced.
+func (s *InvoiceService) IssueCreditNoteForPaymentOrder(ctx context.Context, orderID int64, refundAmount float64, reason string) (*dbent.Invoice, error) {
+	if refundAmount <= 0 {
+		return nil, infraerrors.BadRequest("INVALID_AMOUNT", "credit note amount must be positive")
+	}
+	orig, err := s.IssueForPaymentOrder(ctx, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("issue original invoice: %w", err)
+	}
+	return s.issueAndNotify(ctx, buildCreditNote(orig, refundAmount, reason), false)
+}
+
+// runAsync runs an issuing call in the background with its own timeout so that payment
+// fulfillment never waits on (or fails because of) invoicing.
+func (s *InvoiceService) runAsync(what string, sourceID int64, fn func(ctx context.Context) (*dbent.Invoice, error)) {
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), invoiceIssueTimeout)
+		defer cancel()
+		if _, err := fn(ctx); err != nil {
+			slog.Error("[Invoice] async issue failed", "what", what, "sourceID", sourceID, "error", err)
+		}
+	}()
+}
+
+// IssueForPaymentOrderAsync issues a payment order invoice in the background.
+func (s *InvoiceService) IssueForPaymentOrderAsync(orderID int64) {
+	s.runAsync("payment_order_invoice", orderID, func(ctx context.Context) (*dbent.Invoice, error) {
+		return s.IssueForPaymentOrder(ctx, orderID)
+	})
+}
+
+// IssueForPaygOrderAsync issues a pay-as-you-go order invoice in the background.
+func (s *InvoiceService) IssueForPaygOrderAsync(orderID int64) {
+	s.runAsync("payg_order_invoice", orderID, func(ctx context.Context) (*dbent.Invoice, error) {
+		return s.IssueForPaygOrder(ctx, orderID)
+	})
+}
+
+// IssueCreditNoteForPaymentOrderAsync issues a refund credit note in the background.
+func (s *InvoiceService) IssueCreditNoteForPaymentOrderAsync(orderID int64, refundAmount float64, reason string) {
+	s.runAsync("payment_order_credit_note", orderID, func(ctx context.Context) (*dbent.Invoice, error) {
+		return s.IssueCreditNoteForPaymentOrder(ctx, orderID, refundAmount, reason)
+	})
+}
+
+// AdminIssueInvoice issues (or returns) the invoice of an order, e.g. one completed before invoicing was enabled.
+func (s *InvoiceService) AdminIssueInvoice(ctx context.Context, sourceType string, sourceID int64) (*dbent.Invoice, error) {
+	switch sourceType {
+	case InvoiceSourcePaymentOrder:
+		return s.IssueForPaymentOrder(ctx, sourceID)
+	case InvoiceSourcePaygOrder:
+		return s.IssueForPaygOrder(ctx, sourceID)
+	}
+	return nil, infraerrors.BadRequest("INVALID_SOURCE_TYPE", "source_type must be payment_order or payg_order")
+}
+
+// ListInvoices returns a page of invoices, newest first. UserID 0 lists all users.
+func (s *InvoiceService) ListInvoices(ctx context.Context, p InvoiceListParams) ([]*dbent.Invoice, int, error) {
+	q := s.entClient.Invoice.Query()
+	if p.UserID > 0 {
+		q = q.Where(invoice.UserIDEQ(p.UserID))
+	}
+	if p.Kind != "" {
+		q = q.Where(invoice.KindEQ(p.Kind))
+	}
+	if p.SourceType != "" {
+		q = q.Where(invoice.SourceTypeEQ(p.SourceType))
+	}
+	total, err := q.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("count invoices: %w", err)
+	}
+	ps, pg := applyPagination(p.PageSize, p.Page)
+	items, err := q.Order(dbent.Desc(invoice.FieldIssuedAt), dbent.Desc(invoice.FieldID)).Limit(ps).Offset((pg - 1) * ps).All(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("query invoices: %w", err)
+	}
+	return items, total, nil
+}
+
+// GetInvoice returns an invoice. A positive userID restricts it to that user's invoices.
+func (s *InvoiceService) GetInvoice(ctx context.Context, id, userID int64) (*dbent.Invoice, error) {
+	inv, err := s.entClient.Invoice.Get(ctx, id)
+	if err != nil {
+		if dbent.IsNotFound(err) {
+			return nil, infraerrors.NotFound("INVOICE_NOT_FOUND", "invoice not found")
+		}
+		return nil, fmt.Errorf("get invoice: %w", err)
+	}
+	if userID > 0 && inv.UserID != userID {
+		return nil, infraerrors.NotFound("INVOICE_NOT_FOUND", "invoice not found")
+	}
+	return inv, nil
+}
+
+// originalInvoiceNo returns the number of the invoice a credit note reverses.
+func (s *InvoiceService) originalInvoiceNo(ctx context.Context, inv *dbent.Invoice) string {
+	if inv.OriginalInvoiceID == nil {
+		return ""
+	}
+	orig, err := s.entClient.Invoice.Get(ctx, *inv.OriginalInvoiceID)
+	if err != nil {
+		return ""
+	}
+	return orig.InvoiceNo
+}
+
+// RenderInvoice renders an invoice in the given format ("pdf" or "html").
+func (s *InvoiceService) RenderInvoice(ctx context.Context, inv *dbent.Invoice, format string) (*RenderedInvoice, error) {
+	origNo := s.originalInvoiceNo(ctx, inv)
+	switch format {
+	case "", "pdf":
+		data, err := renderInvoicePDF(inv, origNo)
+		if err != nil {
+			return nil, err
+		}
+		return &RenderedInvoice{Filename: inv.InvoiceNo + ".pdf", ContentType: "application/pdf", Data: data}, nil
+	case "html":
+		data, err := renderInvoiceHTML(inv, origNo)
+		if err != nil {
+			return nil, err
+		}
+		return &RenderedInvoice{Filename: inv.InvoiceNo + ".html", ContentType: "text/html; charset=utf-8", Data: data}, nil
+	}
+	return nil, infraerrors.BadRequest("INVALID_FORMAT", "format must be pdf or html")
+}
+
+// SendInvoiceEmail emails an invoice to its buyer with the HTML rendering as the body
+// and the PDF attached, then records the send time.
+func (s *InvoiceService) SendInvoiceEmail(ctx context.Context, inv *dbent.Invoice) error {
+	if s.emailService == nil {
+		return ErrEmailNotConfigured
+	}
+	if inv.BuyerEmail == "" {
+		return infraerrors.BadRequest("INVOICE_NO_EMAIL", "invoice has no buyer email")
+	}
+	origNo := s.originalInvoiceNo(ctx, inv)
+	body, err := renderInvoiceHTML(inv, origNo)
+	if err != nil {
+		return err
+	}
+	pdfData, err := renderInvoicePDF(inv, origNo)
+	if err != nil {
+		return err
+	}
+	subject := fmt.Sprintf("[%s] %s %s", inv.SellerName, invoiceTitle(inv.Kind), inv.InvoiceNo)
+	attachment := EmailAttachment{Filename: inv.InvoiceNo + ".pdf", ContentType: "application/pdf", Data: pdfData}
+	if err := s.emailService.SendEmailWithAttachments(ctx, inv.BuyerEmail, subject, string(body), []EmailAttachment{attachment}); err != nil {
+		return fmt.Errorf("send invoice email: %w", err)
+	}
+	if _, err := s.entClient.Invoice.UpdateOneID(inv.ID).SetEmailedAt(paymentBeijingNow()).Save(ctx); err != nil {
+		slog.Warn("[Invoice] record emailed_at failed", "invoiceNo", inv.InvoiceNo, "error", err)
+	}
+	return nil
+}
@@ -0,0 +1,201 @@
+//go:build unit
+
+package service
+
+import (
+	"bytes"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	dbent "github.com/Wei-Shaw/sub2api/ent"
+	"github.com/Wei-Shaw/sub2api/internal/model"
+	"github.com/Wei-Shaw/sub2api/internal/payment"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ---------------------------------------------------------------------------
+// splitPayAmount — reverse of payment.CalculatePayAmount
+// ---------------------------------------------------------------------------
+
+func TestSplitPayAmount_RoundTrips(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		charge, rate float64
+	}{
+		{100, 0}, {100, 3}, {99.99, 2.5}, {0.01, 1}, {1234.56, 0.6}, {84.91, 3},
+	} {
+		pay, _ := strconv.ParseFloat(payment.CalculatePayAmount(tc.charge, tc.rate), 64)
+		charge, fee := splitPayAmount(pay, tc.rate)
+		assert.Equal(t, tc.charge, charge, "charge for %v@%v", tc.charge, tc.rate)
+		sum := decimal.NewFromFloat(charge).Add(decimal.NewFromFloat(fee)).InexactFloat64()
+		assert.Equal(t, pay, sum, "charge+fee for %v@%v", tc.charge, tc.rate)
+	}
+}
+
+// ---------------------------------------------------------------------------
+// buildPaymentOrderInvoice / buildCreditNote / buildPaygOrderInvoice
+// ---------------------------------------------------------------------------
+
+func TestBuildPaymentOrderInvoice_DiscountAndFee(t *testing.T) {
+	t.Parallel()
+	code := "SPRING"
+	days := 30
+	// 套餐原价 100，优惠 20，手续费 3%：实付 82.40
+	o := &dbent.PaymentOrder{
+		ID: 7, UserID: 3, UserEmail: "a@example.com", UserName: "alice",
+		Amount: 100, PayAmount: 82.4, FeeRate: 3, DiscountAmount: 20, CouponCode: &code,
+		OrderType: payment.OrderTypeSubscription, SubscriptionDays: &days,
+		OutTradeNo: "sub2_abc", PaymentType: "alipay",
+	}
+	inv := buildPaymentOrderInvoice(o, "Pro")
+	assert.Equal(t, model.InvoiceKindInvoice, inv.Kind)
+	assert.Equal(t, InvoiceSourcePaymentOrder, inv.SourceType)
+	assert.Equal(t, int64(7), inv.SourceID)
+	assert.Equal(t, "sub2_abc", inv.SourceNo)
+	assert.Equal(t, 100.0, inv.Subtotal)
+	assert.Equal(t, 20.0, inv.DiscountAmount)
+	assert.Equal(t, 2.4, inv.FeeAmount)
+	assert.Equal(t, 82.4, inv.Total)
+	require.Len(t, inv.Lines, 1)
+	assert.Equal(t, "Subscription: Pro (30 days)", inv.Lines[0].Description)
+	assert.Equal(t, 100.0, inv.Lines[0].Amount)
+	assert.Equal(t, "Coupon SPRING", inv.Notes)
+}
+
+func TestBuildPaymentOrderInvoice_BalanceTopUp(t *testing.T) {
+	t.Parallel()
+	o := &dbent.PaymentOrder{ID: 1, Amount: 70, PayAmount: 50, OrderType: payment.OrderTypeBalance, RechargeCode: "RC1"}
+	inv := buildPaymentOrderInvoice(o, "")
+	assert.Equal(t, "RC1", inv.SourceNo)
+	assert.Equal(t, 50.0, inv.Subtotal)
+	assert.Equal(t, 0.0, inv.FeeAmount)
+	assert.Equal(t, "Account balance top-up (70.00 credited)", inv.Lines[0].Description)
+	assert.Empty(t, inv.Notes)
+}
+
+func TestBuildCreditNote(t *testing.T) {
+	t.Parallel()
+	orig := &dbent.Invoice{
+		ID: 11, InvoiceNo: "INV-000011", UserID: 3, SourceType: InvoiceSourcePaymentOrder, SourceID: 7,
+		SourceNo: "sub2_abc", Currency: "CNY", BuyerName: "ACME Ltd", BuyerTaxID: "91310000", PaymentMethod: "alipay",
+	}
+	cn := buildCreditNote(orig, 41.2, "  partial refund ")
+	assert.Equal(t, model.InvoiceKindCreditNote, cn.Kind)
+	require.NotNil(t, cn.OriginalInvoiceID)
+	assert.Equal(t, int64(11), *cn.OriginalInvoiceID)
+	assert.Equal(t, orig.SourceID, cn.SourceID)
+	assert.Equal(t, 41.2, cn.Total)
+	assert.Equal(t, "Refund of invoice INV-000011", cn.Lines[0].Description)
+	assert.Equal(t, "ACME Ltd", cn.BuyerName)
+	assert.Equal(t, "partial refund", cn.Notes)
+}
+
+func TestBuildPaygOrderInvoice(t *testing.T) {
+	t.Parallel()
+	sn := "789"
+	o := &dbent.PaygOrder{ID: 5, UserID: 2, ClientSn: "", Sn: &sn, AmountYuan: 80, CreditAmount: 80, Payway: "3", PaywayName: "WeChat"}
+	inv := buildPaygOrderInvoice(o)
+	assert.Equal(t, InvoiceSourcePaygOrder, inv.SourceType)
+	assert.Equal(t, "789", inv.SourceNo)
+	assert.Equal(t, 80.0, inv.Total)
+	assert.Equal(t, "WeChat", inv.PaymentMethod)
+}
+
+func TestFormatInvoiceNumber(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "INV-000042", formatInvoiceNumber(model.InvoiceKindInvoice, 42))
+	assert.Equal(t, "CN-1234567", formatInvoiceNumber(model.InvoiceKindCreditNote, 1234567))
+}
+
+func TestBuyerFromAttributes(t *testing.T) {
+	t.Parallel()
+	defs := []UserAttributeDefinition{
+		{ID: 1, Key: InvoiceAttrCompanyName}, {ID: 2, Key: InvoiceAttrTaxID}, {ID: 3, Key: InvoiceAttrAddress}, {ID: 4, Key: "wechat"},
+	}
+	values := []UserAttributeValue{
+		{AttributeID: 1, Value: " ACME Ltd "}, {AttributeID: 2, Value: "91310000"}, {AttributeID: 4, Value: "ignored"},
+	}
+	name, taxID, address := buyerFromAttributes(defs, values)
+	assert.Equal(t, "ACME Ltd", name)
+	assert.Equal(t, "91310000", taxID)
+	assert.Empty(t, address)
+}
+
+// ---------------------------------------------------------------------------
+// renderInvoiceHTML / renderInvoicePDF
+// ---------------------------------------------------------------------------
+
+func sampleInvoice() *dbent.Invoice {
+	return &dbent.Invoice{
+		InvoiceNo: "INV-000001", Kind: model.InvoiceKindInvoice, Currency: "CNY",
+		Subtotal: 100, DiscountAmount: 20, FeeAmount: 2.4, Total: 82.4,
+		Lines:     []model.InvoiceLine{{Description: "Subscription: Pro <30d>", Quantity: 1, UnitPrice: 100, Amount: 100}},
+		BuyerName: "上海某某科技有限公司", BuyerTaxID: "91310000", BuyerAddress: "Line 1\nLine 2",
+		SellerName: "Sub2API", IssuedAt: time.Date(2026, 5, 1, 10, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestRenderInvoiceHTML(t *testing.T) {
+	t.Parallel()
+	out, err := renderInvoiceHTML(sampleInvoice(), "")
+	require.NoError(t, err)
+	html := string(out)
+	assert.Contains(t, html, "INV-000001")
+	assert.Contains(t, html, "上海某某科技有限公司")
+	assert.Contains(t, html, "-CNY 20.00")
+	assert.Contains(t, html, "CNY 82.40")
+	assert.Contains(t, html, "Subscription: Pro &lt;30d&gt;")
+	assert.Contains(t, html, "2026-05-01")
+
+	cn := sampleInvoice()
+	cn.Kind = model.InvoiceKindCreditNote
+	out, err = renderInvoiceHTML(cn, "INV-000001")
+	require.NoError(t, err)
+	assert.Contains(t, string(out), "Credit Note")
+	assert.Contains(t, string(out), "Total credited")
+}
+
+func TestRenderInvoicePDF(t *testing.T) {
+	t.Parallel()
+	out, err := renderInvoicePDF(sampleInvoice(), "")
+	require.NoError(t, err)
+	assert.True(t, bytes.HasPrefix(out, []byte("%PDF-")))
+	assert.True(t, bytes.HasSuffix(out, []byte("%%EOF\n")))
+}
+
+// ---------------------------------------------------------------------------
+// buildMultipartMessage — invoice emails with a PDF attachment
+// ---------------------------------------------------------------------------
+
+func TestBuildMultipartMessage(t *testing.T) {
+	t.Parallel()
+	data := bytes.Repeat([]byte("%PDF"), 100)
+	msg, err := buildMultipartMessage("Site <noreply@example.com>", "a@example.com", "发票 INV-000001", "<p>hi</p>",
+		[]EmailAttachment{{Filename: "INV-000001.pdf", ContentType: "application/pdf", Data: data}})
+	require.NoError(t, err)
+
+	m, err := mail.ReadMessage(bytes.NewReader(msg))
+	require.NoError(t, err)
+	subject, err := new(mime.WordDecoder).DecodeHeader(m.Header.Get("Subject"))
+	require.NoError(t, err)
+	assert.Equal(t, "发票 INV-000001", subject)
+	mediaType, params, err := mime.ParseMediaType(m.Header.Get("Content-Type"))
+	require.NoError(t, err)
+	require.Equal(t, "multipart/mixed", mediaType)
+
+	r := multipart.NewReader(m.Body, params["boundary"])
+	body, err := r.NextPart()
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(body.Header.Get("Content-Type"), "text/html"))
+	att, err := r.NextPart()
+	require.NoError(t, err)
+	assert.Equal(t, "INV-000001.pdf", att.FileName())
+	assert.Equal(t, "base64", att.Header.Get("Content-Transfer-Encoding"))
+}
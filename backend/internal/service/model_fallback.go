@@ -0,0 +1,100 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/Wei-Shaw/sub2api/internal/model"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/ctxkey"
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+)
+
+// ModelFallbackResponseHeader 降级响应的标记头，值为实际使用的降级模型
+const ModelFallbackResponseHeader = "X-Model-Fallback"
+
+// ErrInvalidModelFallbackChains 分组模型降级链配置不合法
+var ErrInvalidModelFallbackChains = infraerrors.BadRequest("INVALID_MODEL_FALLBACK_CHAINS", "invalid model fallback chains")
+
+func invalidModelFallbackChains(msg string) error {
+	return infraerrors.BadRequest(ErrInvalidModelFallbackChains.Reason, "invalid model fallback chains: "+msg)
+}
+
+// validateModelFallbackChains 校验模型降级链：结构合法，跨分组步骤指向的分组必须存在、
+// 不是当前分组、平台为 anthropic/openai 且不是订阅分组（降级请求不消耗订阅额度）。
+func (s *adminServiceImpl) validateModelFallbackChains(ctx context.Context, currentGroupID int64, chains []model.ModelFallbackChain) error {
+	for i := range chains {
+		if err := chains[i].Validate(); err != nil {
+			return invalidModelFallbackChains(err.Error())
+		}
+		for _, step := range chains[i].Steps {
+			if step.GroupID == nil {
+				continue
+			}
+			if *step.GroupID == currentGroupID {
+				return invalidModelFallbackChains("step group_id cannot be the current group")
+			}
+			target, err := s.groupRepo.GetByIDLite(ctx, *step.GroupID)
+			if err != nil {
+				return invalidModelFallbackChains(fmt.Sprintf("step group %d not found", *step.GroupID))
+			}
+			if target.Platform != PlatformAnthropic && target.Platform != PlatformOpenAI {
+				return invalidModelFallbackChains(fmt.Sprintf("step group %d must be an anthropic or openai group", target.ID))
+			}
+			if target.IsSubscriptionType() {
+				return invalidModelFallbackChains(fmt.Sprintf("step group %d cannot be a subscription group", target.ID))
+			}
+		}
+	}
+	return nil
+}
+
+// ResolveModelFallbackSteps 返回请求模型对应的降级步骤：精确匹配优先，其次按配置顺序匹配通配符
+func (g *Group) ResolveModelFallbackSteps(requestedModel string) []model.ModelFallbackStep {
+	if g == nil || requestedModel == "" {
+		return nil
+	}
+	for i := range g.ModelFallbackChains {
+		if g.ModelFallbackChains[i].ModelPattern == requestedModel {
+			return g.ModelFallbackChains[i].Steps
+		}
+	}
+	for i := range g.ModelFallbackChains {
+		if matchModelPattern(g.ModelFallbackChains[i].ModelPattern, requestedModel) {
+			return g.ModelFallbackChains[i].Steps
+		}
+	}
+	return nil
+}
+
+// IsModelFallbackTrigger 判断是否应进入下一级降级模型：
+// 无可调度账号（lastErr 为 nil）或上游过载（429/503/529）时触发，其他错误沿用原有处理。
+func IsModelFallbackTrigger(lastErr *UpstreamFailoverError) bool {
+	if lastErr == nil {
+		return true
+	}
+	switch lastErr.StatusCode {
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable, 529:
+		return true
+	}
+	return false
+}
+
+// WithModelFallback 将降级模型写入 context，Forward 会以此作为上游模型，响应中仍回写客户端请求的模型
+func WithModelFallback(ctx context.Context, fallbackModel string) context.Context {
+	fallbackModel = strings.TrimSpace(fallbackModel)
+	if fallbackModel == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, ctxkey.ModelFallback, fallbackModel)
+}
+
+// ModelFallbackFromContext 读取当前请求的降级模型（未降级返回空）
+func ModelFallbackFromContext(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	fallbackModel, _ := ctx.Value(ctxkey.ModelFallback).(string)
+	return fallbackModel
+}
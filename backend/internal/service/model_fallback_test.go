@@ -0,0 +1,102 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Wei-Shaw/sub2api/internal/model"
+	"github.com/stretchr/testify/require"
+)
+
+type groupRepoStubForModelFallback struct {
+	groupRepoStubForGroupUpdate
+	groups map[int64]*Group
+}
+
+func (s *groupRepoStubForModelFallback) GetByIDLite(_ context.Context, id int64) (*Group, error) {
+	if g, ok := s.groups[id]; ok {
+		return g, nil
+	}
+	return nil, ErrGroupNotFound
+}
+
+func TestGroupResolveModelFallbackSteps(t *testing.T) {
+	group := &Group{
+		ModelFallbackChains: []model.ModelFallbackChain{
+			{ModelPattern: "claude-opus-*", Steps: []model.ModelFallbackStep{{Model: "claude-sonnet-4-5"}}},
+			{ModelPattern: "claude-opus-4-1", Steps: []model.ModelFallbackStep{{Model: "claude-opus-4"}, {Model: "gpt-5", GroupID: int64Ptr(9)}}},
+		},
+	}
+
+	steps := group.ResolveModelFallbackSteps("claude-opus-4-1")
+	require.Len(t, steps, 2, "精确匹配优先于通配符")
+	require.Equal(t, "claude-opus-4", steps[0].Model)
+
+	steps = group.ResolveModelFallbackSteps("claude-opus-4-5")
+	require.Len(t, steps, 1)
+	require.Equal(t, "claude-sonnet-4-5", steps[0].Model)
+
+	require.Nil(t, group.ResolveModelFallbackSteps("claude-haiku-4-5"))
+	require.Nil(t, (*Group)(nil).ResolveModelFallbackSteps("claude-opus-4-1"))
+}
+
+func TestIsModelFallbackTrigger(t *testing.T) {
+	require.True(t, IsModelFallbackTrigger(nil), "无可调度账号")
+	require.True(t, IsModelFallbackTrigger(&UpstreamFailoverError{StatusCode: http.StatusTooManyRequests}))
+	require.True(t, IsModelFallbackTrigger(&UpstreamFailoverError{StatusCode: http.StatusServiceUnavailable}))
+	require.True(t, IsModelFallbackTrigger(&UpstreamFailoverError{StatusCode: 529}))
+	require.False(t, IsModelFallbackTrigger(&UpstreamFailoverError{StatusCode: http.StatusUnauthorized}))
+	require.False(t, IsModelFallbackTrigger(&UpstreamFailoverError{StatusCode: http.StatusInternalServerError}))
+}
+
+func TestModelFallbackContext(t *testing.T) {
+	require.Empty(t, ModelFallbackFromContext(context.Background()))
+	require.Empty(t, ModelFallbackFromContext(WithModelFallback(context.Background(), "  ")))
+	require.Equal(t, "claude-sonnet-4-5", ModelFallbackFromContext(WithModelFallback(context.Background(), "claude-sonnet-4-5")))
+}
+
+func TestGroupRoutingAccountIDs_ModelFallbackIgnoresTrafficSplitAccounts(t *testing.T) {
+	group := &Group{
+		ID:                  7,
+		ModelRoutingEnabled: true,
+		ModelRouting:        map[string][]int64{"claude-sonnet-4-5": {5}},
+	}
+	ctx := WithTrafficSplit(context.Background(), &TrafficSplitDecision{GroupID: 7, AccountIDs: []int64{11}})
+	ctx = WithModelFallback(ctx, "claude-sonnet-4-5")
+
+	require.Equal(t, []int64{5}, groupRoutingAccountIDs(ctx, group, "claude-sonnet-4-5"))
+}
+
+func TestAdminService_ValidateModelFallbackChains(t *testing.T) {
+	svc := &adminServiceImpl{groupRepo: &groupRepoStubForModelFallback{groups: map[int64]*Group{
+		2: {ID: 2, Platform: PlatformOpenAI, SubscriptionType: SubscriptionTypeStandard},
+		3: {ID: 3, Platform: PlatformAnthropic, SubscriptionType: SubscriptionTypeSubscription},
+		4: {ID: 4, Platform: PlatformGemini, SubscriptionType: SubscriptionTypeStandard},
+	}}}
+	chain := func(steps ...model.ModelFallbackStep) []model.ModelFallbackChain {
+		return []model.ModelFallbackChain{{ModelPattern: "claude-opus-*", Steps: steps}}
+	}
+	ctx := context.Background()
+
+	require.NoError(t, svc.validateModelFallbackChains(ctx, 1, chain(
+		model.ModelFallbackStep{Model: "claude-sonnet-4-5"},
+		model.ModelFallbackStep{Model: "gpt-5", GroupID: int64Ptr(2)},
+	)))
+
+	invalid := [][]model.ModelFallbackChain{
+		{{Steps: []model.ModelFallbackStep{{Model: "claude-sonnet-4-5"}}}},
+		chain(),
+		chain(model.ModelFallbackStep{Model: " "}),
+		chain(model.ModelFallbackStep{Model: "gpt-5", GroupID: int64Ptr(1)}),
+		chain(model.ModelFallbackStep{Model: "gpt-5", GroupID: int64Ptr(99)}),
+		chain(model.ModelFallbackStep{Model: "claude-sonnet-4-5", GroupID: int64Ptr(3)}),
+		chain(model.ModelFallbackStep{Model: "gemini-2.5-pro", GroupID: int64Ptr(4)}),
+	}
+	for i, chains := range invalid {
+		err := svc.validateModelFallbackChains(ctx, 1, chains)
+		require.ErrorIs(t, err, ErrInvalidModelFallbackChains, "case %d", i)
+	}
+}
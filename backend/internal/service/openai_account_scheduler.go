@@ -10,7 +10,6 @@ import (
 	"sort"
 	"strconv"
 	"strings"
-	"sync"
 	"sync/atomic"
 	"time"
 )
@@ -55,12 +54,17 @@ type OpenAIAccountSchedulerMetricsSnapshot struct {
 	AccountSwitchRate        float64
 	LoadSkewAvg              float64
 	RuntimeStatsAccountCount int
+	// 流式请求对冲：发起次数 / 对冲请求胜出次数 / 胜出率
+	HedgeTotal    int64
+	HedgeWinTotal int64
+	HedgeWinRate  float64
 }
 
 type OpenAIAccountScheduler interface {
 	Select(ctx context.Context, req OpenAIAccountScheduleRequest) (*AccountSelectionResult, OpenAIAccountScheduleDecision, error)
 	ReportResult(accountID int64, success bool, firstTokenMs *int)
 	ReportSwitch()
+	ReportHedge(won bool)
 	SnapshotMetrics() OpenAIAccountSchedulerMetricsSnapshot
 }
 
@@ -72,6 +76,8 @@ type openAIAccountSchedulerMetrics struct {
 	accountSwitchTotal     atomic.Int64
 	latencyMsTotal         atomic.Int64
 	loadSkewMilliTotal     atomic.Int64
+	hedgeTotal             atomic.Int64
+	hedgeWinTotal          atomic.Int64
 }
 
 func (m *openAIAccountSchedulerMetrics) recordSelect(decision OpenAIAccountScheduleDecision) {
@@ -99,126 +105,31 @@ func (m *openAIAccountSchedulerMetrics) recordSwitch() {
 	m.accountSwitchTotal.Add(1)
 }
 
-type openAIAccountRuntimeStats struct {
-	accounts     sync.Map
-	accountCount atomic.Int64
-}
-
-type openAIAccountRuntimeStat struct {
-	errorRateEWMABits atomic.Uint64
-	ttftEWMABits      atomic.Uint64
-}
-
-func newOpenAIAccountRuntimeStats() *openAIAccountRuntimeStats {
-	return &openAIAccountRuntimeStats{}
-}
-
-func (s *openAIAccountRuntimeStats) loadOrCreate(accountID int64) *openAIAccountRuntimeStat {
-	if value, ok := s.accounts.Load(accountID); ok {
-		stat, _ := value.(*openAIAccountRuntimeStat)
-		if stat != nil {
-			return stat
-		}
-	}
-
-	stat := &openAIAccountRuntimeStat{}
-	stat.ttftEWMABits.Store(math.Float64bits(math.NaN()))
-	actual, loaded := s.accounts.LoadOrStore(accountID, stat)
-	if !loaded {
-		s.accountCount.Add(1)
-		return stat
-	}
-	existing, _ := actual.(*openAIAccountRuntimeStat)
-	if existing != nil {
-		return existing
-	}
-	return stat
-}
-
-func updateEWMAAtomic(target *atomic.Uint64, sample float64, alpha float64) {
-	for {
-		oldBits := target.Load()
-		oldValue := math.Float64frombits(oldBits)
-		newValue := alpha*sample + (1-alpha)*oldValue
-		if target.CompareAndSwap(oldBits, math.Float64bits(newValue)) {
-			return
-		}
-	}
-}
-
-func (s *openAIAccountRuntimeStats) report(accountID int64, success bool, firstTokenMs *int) {
-	if s == nil || accountID <= 0 {
+func (m *openAIAccountSchedulerMetrics) recordHedge(won bool) {
+	if m == nil {
 		return
 	}
-	const alpha = 0.2
-	stat := s.loadOrCreate(accountID)
-
-	errorSample := 1.0
-	if success {
-		errorSample = 0.0
-	}
-	updateEWMAAtomic(&stat.errorRateEWMABits, errorSample, alpha)
-
-	if firstTokenMs != nil && *firstTokenMs > 0 {
-		ttft := float64(*firstTokenMs)
-		ttftBits := math.Float64bits(ttft)
-		for {
-			oldBits := stat.ttftEWMABits.Load()
-			oldValue := math.Float64frombits(oldBits)
-			if math.IsNaN(oldValue) {
-				if stat.ttftEWMABits.CompareAndSwap(oldBits, ttftBits) {
-					break
-				}
-				continue
-			}
-			newValue := alpha*ttft + (1-alpha)*oldValue
-			if stat.ttftEWMABits.CompareAndSwap(oldBits, math.Float64bits(newValue)) {
-				break
-			}
-		}
-	}
-}
-
-func (s *openAIAccountRuntimeStats) snapshot(accountID int64) (errorRate float64, ttft float64, hasTTFT bool) {
-	if s == nil || accountID <= 0 {
-		return 0, 0, false
-	}
-	value, ok := s.accounts.Load(accountID)
-	if !ok {
-		return 0, 0, false
-	}
-	stat, _ := value.(*openAIAccountRuntimeStat)
-	if stat == nil {
-		return 0, 0, false
-	}
-	errorRate = clamp01(math.Float64frombits(stat.errorRateEWMABits.Load()))
-	ttftValue := math.Float64frombits(stat.ttftEWMABits.Load())
-	if math.IsNaN(ttftValue) {
-		return errorRate, 0, false
-	}
-	return errorRate, ttftValue, true
-}
-
-func (s *openAIAccountRuntimeStats) size() int {
-	if s == nil {
-		return 0
+	m.hedgeTotal.Add(1)
+	if won {
+		m.hedgeWinTotal.Add(1)
 	}
-	return int(s.accountCount.Load())
 }
 
 type defaultOpenAIAccountScheduler struct {
-	service *OpenAIGatewayService
-	metrics openAIAccountSchedulerMetrics
-	stats   *openAIAccountRuntimeStats
+	service    *OpenAIGatewayService
+	metrics    openAIAccountSchedulerMetrics
+	stats      *accountRuntimeStats
+	strategies *accountSchedulerSet
 }
 
-func newDefaultOpenAIAccountScheduler(service *OpenAIGatewayService, stats *openAIAccountRuntimeStats) OpenAIAccountScheduler {
+func newDefaultOpenAIAccountScheduler(service *OpenAIGatewayService, stats *accountRuntimeStats) OpenAIAccountScheduler {
 	if stats == nil {
-		stats = newOpenAIAccountRuntimeStats()
+		stats = newAccountRuntimeStats()
 	}
 	return &defaultOpenAIAccountScheduler{
-		service: service,
-		stats:   stats,
+		service:    service,
+		stats:      stats,
+		strategies: newAccountSchedulerSet(stats),
 	}
 }
 
@@ -336,6 +247,10 @@ func (s *defaultOpenAIAccountScheduler) selectBySessionHash(
 		_ = s.service.deleteStickySessionAccountID(ctx, req.GroupID, sessionHash)
 		return nil, nil
 	}
+	// batch 请求仅在粘性账号负载低于阈值时使用，否则回退到负载均衡
+	if !priorityAllowsAccountLoad(ctx, s.service.cfg, s.service.concurrencyService, account) {
+		return nil, nil
+	}
 
 	result, acquireErr := s.service.tryAcquireAccountSlot(ctx, accountID, account.Concurrency)
 	if acquireErr == nil && result.Acquired {
@@ -349,7 +264,8 @@ func (s *defaultOpenAIAccountScheduler) selectBySessionHash(
 
 	cfg := s.service.schedulingConfig()
 	// WaitPlan.MaxConcurrency 使用 Concurrency（非 EffectiveLoadFactor），因为 WaitPlan 控制的是 Redis 实际并发槽位等待。
-	if s.service.concurrencyService != nil {
+	// batch 请求不参与粘性会话等待，让位给交互式流量。
+	if s.service.concurrencyService != nil && !isBatchPriorityRequest(ctx) {
 		return &AccountSelectionResult{
 			Account: account,
 			WaitPlan: &AccountWaitPlan{
@@ -690,18 +606,30 @@ func (s *defaultOpenAIAccountScheduler) selectByLoadBalance(
 			weights.TTFT*ttftFactor
 	}
 
-	topK := s.service.openAIWSLBTopK()
-	if topK > len(candidates) {
-		topK = len(candidates)
-	}
-	if topK <= 0 {
-		topK = 1
+	var selectionOrder []openAIAccountCandidateScore
+	topK := len(candidates)
+	if schedGroup != nil && schedGroup.SchedulingStrategy != "" {
+		// 分组显式配置了调度策略时使用通用策略排序，否则沿用 OpenAI 默认的 topK 加权打分
+		selectionOrder = s.orderByGroupStrategy(schedGroup.SchedulingStrategy, candidates)
+	} else {
+		topK = s.service.openAIWSLBTopK()
+		if topK > len(candidates) {
+			topK = len(candidates)
+		}
+		if topK <= 0 {
+			topK = 1
+		}
+		rankedCandidates := selectTopKOpenAICandidates(candidates, topK)
+		selectionOrder = buildOpenAIWeightedSelectionOrder(rankedCandidates, req)
 	}
-	rankedCandidates := selectTopKOpenAICandidates(candidates, topK)
-	selectionOrder := buildOpenAIWeightedSelectionOrder(rankedCandidates, req)
 
+	// batch 请求只直接占用负载低于阈值的账号，否则进入等待队列（排在高优先级请求之后）
+	loadCeiling := priorityLoadCeiling(ctx, s.service.cfg)
 	for i := 0; i < len(selectionOrder); i++ {
 		candidate := selectionOrder[i]
+		if candidate.loadInfo != nil && candidate.loadInfo.LoadRate >= loadCeiling && loadCeiling < 100 {
+			continue
+		}
 		fresh := s.service.resolveFreshSchedulableOpenAIAccount(ctx, candidate.account, req.RequestedModel)
 		if fresh == nil || !s.isAccountTransportCompatible(fresh, req.RequiredTransport) {
 			continue
@@ -747,6 +675,26 @@ func (s *defaultOpenAIAccountScheduler) selectByLoadBalance(
 	return nil, len(candidates), topK, loadSkew, ErrNoAvailableAccounts
 }
 
+// orderByGroupStrategy 按分组配置的通用调度策略对候选账号排序
+func (s *defaultOpenAIAccountScheduler) orderByGroupStrategy(strategy string, candidates []openAIAccountCandidateScore) []openAIAccountCandidateScore {
+	byID := make(map[int64]openAIAccountCandidateScore, len(candidates))
+	scheduleCandidates := make([]AccountScheduleCandidate, 0, len(candidates))
+	for _, candidate := range candidates {
+		byID[candidate.account.ID] = candidate
+		scheduleCandidates = append(scheduleCandidates, AccountScheduleCandidate{
+			Account:  candidate.account,
+			LoadInfo: candidate.loadInfo,
+		})
+	}
+
+	ordered := s.strategies.get(strategy).Order(AccountScheduleRequest{}, scheduleCandidates)
+	out := make([]openAIAccountCandidateScore, 0, len(ordered))
+	for _, item := range ordered {
+		out = append(out, byID[item.Account.ID])
+	}
+	return out
+}
+
 func (s *defaultOpenAIAccountScheduler) isAccountTransportCompatible(account *Account, requiredTransport OpenAIUpstreamTransport) bool {
 	// HTTP 入站可回退到 HTTP 线路，不需要在账号选择阶段做传输协议强过滤。
 	if requiredTransport == OpenAIUpstreamTransportAny || requiredTransport == OpenAIUpstreamTransportHTTPSSE {
@@ -772,6 +720,13 @@ func (s *defaultOpenAIAccountScheduler) ReportSwitch() {
 	s.metrics.recordSwitch()
 }
 
+func (s *defaultOpenAIAccountScheduler) ReportHedge(won bool) {
+	if s == nil {
+		return
+	}
+	s.metrics.recordHedge(won)
+}
+
 func (s *defaultOpenAIAccountScheduler) SnapshotMetrics() OpenAIAccountSchedulerMetricsSnapshot {
 	if s == nil {
 		return OpenAIAccountSchedulerMetricsSnapshot{}
@@ -792,6 +747,11 @@ func (s *defaultOpenAIAccountScheduler) SnapshotMetrics() OpenAIAccountScheduler
 		AccountSwitchTotal:       switchTotal,
 		SchedulerLatencyMsTotal:  latencyTotal,
 		RuntimeStatsAccountCount: s.stats.size(),
+		HedgeTotal:               s.metrics.hedgeTotal.Load(),
+		HedgeWinTotal:            s.metrics.hedgeWinTotal.Load(),
+	}
+	if snapshot.HedgeTotal > 0 {
+		snapshot.HedgeWinRate = float64(snapshot.HedgeWinTotal) / float64(snapshot.HedgeTotal)
 	}
 	if selectTotal > 0 {
 		snapshot.SchedulerLatencyMsAvg = float64(latencyTotal) / float64(selectTotal)
@@ -808,7 +768,7 @@ func (s *OpenAIGatewayService) getOpenAIAccountScheduler() OpenAIAccountSchedule
 	}
 	s.openaiSchedulerOnce.Do(func() {
 		if s.openaiAccountStats == nil {
-			s.openaiAccountStats = newOpenAIAccountRuntimeStats()
+			s.openaiAccountStats = newAccountRuntimeStats()
 		}
 		if s.openaiScheduler == nil {
 			s.openaiScheduler = newDefaultOpenAIAccountScheduler(s, s.openaiAccountStats)
@@ -853,6 +813,10 @@ func (s *OpenAIGatewayService) SelectAccountWithScheduler(
 }
 
 func (s *OpenAIGatewayService) ReportOpenAIAccountScheduleResult(accountID int64, success bool, firstTokenMs *int) {
+	if s == nil {
+		return
+	}
+	s.schedulerSnapshot.AccountCircuitBreaker().Record(accountID, success, firstTokenMs)
 	scheduler := s.getOpenAIAccountScheduler()
 	if scheduler == nil {
 		return
@@ -868,6 +832,14 @@ func (s *OpenAIGatewayService) RecordOpenAIAccountSwitch() {
 	scheduler.ReportSwitch()
 }
 
+func (s *OpenAIGatewayService) RecordOpenAIHedge(won bool) {
+	scheduler := s.getOpenAIAccountScheduler()
+	if scheduler == nil {
+		return
+	}
+	scheduler.ReportHedge(won)
+}
+
 func (s *OpenAIGatewayService) SnapshotOpenAIAccountSchedulerMetrics() OpenAIAccountSchedulerMetricsSnapshot {
 	scheduler := s.getOpenAIAccountScheduler()
 	if scheduler == nil {
@@ -57,17 +57,18 @@ func (s *OpenAIGatewayService) ForwardImageRequest(
 		return nil, err
 	}
 
+	// 应用管理员配置的请求改写规则（multipart 请求体仅应用请求头操作）
+	requestBody, transformResult := applyBoundRequestTransforms(c, account, mappedModel, requestBody)
+
 	setOpsUpstreamRequestBody(c, requestBody)
 
 	upstreamReq, err := s.buildUpstreamImageRequest(ctx, c, account, requestBody, requestContentType, endpointPath, token)
 	if err != nil {
 		return nil, err
 	}
+	applyRequestTransformHeaders(upstreamReq, transformResult)
 
-	proxyURL := ""
-	if account.ProxyID != nil && account.Proxy != nil {
-		proxyURL = account.Proxy.URL()
-	}
+	proxyURL := resolveAccountProxyURL(account)
 
 	upstreamStart := time.Now()
 	resp, err := s.httpUpstream.Do(upstreamReq, proxyURL, account.ID, account.Concurrency)
@@ -60,6 +60,10 @@ func (s *OpenAIGatewayService) ForwardAsAnthropic(
 
 	// 3. Model mapping
 	mappedModel := resolveOpenAIForwardModel(account, originalModel, defaultMappedModel)
+	if fallbackModel := ModelFallbackFromContext(ctx); fallbackModel != "" {
+		// 模型降级链：降级模型替代请求模型参与映射，响应中仍回写客户端请求的模型
+		mappedModel = resolveOpenAIForwardModel(account, fallbackModel, "")
+	}
 	responsesReq.Model = mappedModel
 
 	logger.L().Debug("openai messages: model mapping applied",
@@ -115,10 +119,7 @@ func (s *OpenAIGatewayService) ForwardAsAnthropic(
 	}
 
 	// 7. Send request
-	proxyURL := ""
-	if account.Proxy != nil {
-		proxyURL = account.Proxy.URL()
-	}
+	proxyURL := resolveAccountProxyURL(account)
 	resp, err := s.httpUpstream.Do(upstreamReq, proxyURL, account.ID, account.Concurrency)
 	if err != nil {
 		safeErr := sanitizeUpstreamErrorMessage(err.Error())
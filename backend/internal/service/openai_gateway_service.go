@@ -319,6 +319,7 @@ type OpenAIGatewayService struct {
 	rateLimitService      *RateLimitService
 	billingCacheService   *BillingCacheService
 	userGroupRateResolver *userGroupRateResolver
+	volumeTierService     *VolumeTierService
 	httpUpstream          HTTPUpstream
 	deferredService       *DeferredService
 	openAITokenProvider   *OpenAITokenProvider
@@ -333,7 +334,7 @@ type OpenAIGatewayService struct {
 	openaiWSStateStore            OpenAIWSStateStore
 	openaiScheduler               OpenAIAccountScheduler
 	openaiWSPassthroughDialer     openAIWSClientDialer
-	openaiAccountStats            *openAIAccountRuntimeStats
+	openaiAccountStats            *accountRuntimeStats
 
 	openaiWSFallbackUntil sync.Map // key: int64(accountID), value: time.Time
 	openaiWSRetryMetrics  openAIWSRetryMetrics
@@ -393,6 +394,11 @@ func NewOpenAIGatewayService(
 	return svc
 }
 
+// SetVolumeTierService 注入用量阶梯定价服务（可选，未注入时不打折）
+func (s *OpenAIGatewayService) SetVolumeTierService(volumeTierService *VolumeTierService) {
+	s.volumeTierService = volumeTierService
+}
+
 func (s *OpenAIGatewayService) getCodexSnapshotThrottle() *accountWriteThrottle {
 	if s != nil && s.codexSnapshotThrottle != nil {
 		return s.codexSnapshotThrottle
@@ -1548,9 +1554,13 @@ func (s *OpenAIGatewayService) listSchedulableAccounts(ctx context.Context, grou
 
 func (s *OpenAIGatewayService) tryAcquireAccountSlot(ctx context.Context, accountID int64, maxConcurrency int) (*AcquireResult, error) {
 	if s.concurrencyService == nil {
-		return &AcquireResult{Acquired: true, ReleaseFunc: func() {}}, nil
+		return admitAcquiredAccountSlot(s.schedulerSnapshot.AccountCircuitBreaker(), accountID, &AcquireResult{Acquired: true, ReleaseFunc: func() {}}), nil
+	}
+	result, err := s.concurrencyService.AcquireAccountSlot(ctx, accountID, maxConcurrency)
+	if err != nil {
+		return result, err
 	}
-	return s.concurrencyService.AcquireAccountSlot(ctx, accountID, maxConcurrency)
+	return admitAcquiredAccountSlot(s.schedulerSnapshot.AccountCircuitBreaker(), accountID, result), nil
 }
 
 func (s *OpenAIGatewayService) resolveFreshSchedulableOpenAIAccount(ctx context.Context, account *Account, requestedModel string) *Account {
@@ -2197,10 +2207,7 @@ func (s *OpenAIGatewayService) Forward(ctx context.Context, c *gin.Context, acco
 		}
 
 		// Get proxy URL
-		proxyURL := ""
-		if account.ProxyID != nil && account.Proxy != nil {
-			proxyURL = account.Proxy.URL()
-		}
+		proxyURL := resolveAccountProxyURL(account)
 
 		// Send request
 		upstreamStart := time.Now()
@@ -2413,10 +2420,7 @@ func (s *OpenAIGatewayService) forwardOpenAIPassthrough(
 		return nil, err
 	}
 
-	proxyURL := ""
-	if account.ProxyID != nil && account.Proxy != nil {
-		proxyURL = account.Proxy.URL()
-	}
+	proxyURL := resolveAccountProxyURL(account)
 
 	setOpsUpstreamRequestBody(c, body)
 	if c != nil {
@@ -2545,6 +2549,9 @@ func (s *OpenAIGatewayService) buildUpstreamRequestOpenAIPassthrough(
 	}
 	targetURL = appendOpenAIResponsesRequestPathSuffix(targetURL, openAIResponsesRequestPathSuffix(c))
 
+	// 应用管理员配置的请求改写规则
+	body, transformResult := applyBoundRequestTransforms(c, account, gjson.GetBytes(body, "model").String(), body)
+
 	req, err := http.NewRequestWithContext(ctx, http.MethodPost, targetURL, bytes.NewReader(body))
 	if err != nil {
 		return nil, err
@@ -2634,6 +2641,7 @@ func (s *OpenAIGatewayService) buildUpstreamRequestOpenAIPassthrough(
 	if req.Header.Get("content-type") == "" {
 		req.Header.Set("content-type", "application/json")
 	}
+	applyRequestTransformHeaders(req, transformResult)
 
 	return req, nil
 }
@@ -2951,6 +2959,9 @@ func (s *OpenAIGatewayService) buildUpstreamRequest(ctx context.Context, c *gin.
 	}
 	targetURL = appendOpenAIResponsesRequestPathSuffix(targetURL, openAIResponsesRequestPathSuffix(c))
 
+	// 应用管理员配置的请求改写规则
+	body, transformResult := applyBoundRequestTransforms(c, account, gjson.GetBytes(body, "model").String(), body)
+
 	req, err := http.NewRequestWithContext(ctx, "POST", targetURL, bytes.NewReader(body))
 	if err != nil {
 		return nil, err
@@ -3021,6 +3032,8 @@ func (s *OpenAIGatewayService) buildUpstreamRequest(ctx context.Context, c *gin.
 		req.Header.Set("user-agent", codexCLIUserAgent)
 	}
 
+	applyRequestTransformHeaders(req, transformResult)
+
 	// Ensure required headers exist
 	if req.Header.Get("content-type") == "" {
 		req.Header.Set("content-type", "application/json")
@@ -4139,6 +4152,8 @@ type OpenAIRecordUsageInput struct {
 	IPAddress          string // 请求的客户端 IP 地址
 	RequestPayloadHash string
 	APIKeyService      APIKeyQuotaUpdater
+	FallbackModel      string          // 可选：模型降级链实际使用的模型
+	UsageHook          func(*UsageLog) // 可选：网关插件 on_usage 回调，写入用量记录前触发
 }
 
 // RecordUsage records usage and deducts balance
@@ -4180,6 +4195,7 @@ func (s *OpenAIGatewayService) RecordUsage(ctx context.Context, input *OpenAIRec
 			resolver = newUserGroupRateResolver(nil, nil, resolveUserGroupRateCacheTTL(s.cfg), nil, "service.openai_gateway")
 		}
 		multiplier = resolver.Resolve(ctx, user.ID, *apiKey.GroupID, apiKey.Group.RateMultiplier)
+		multiplier *= s.volumeTierService.Multiplier(ctx, user.ID, *apiKey.GroupID, apiKey.Group.VolumeTiers)
 	}
 
 	billingModel := forwardResultBillingModel(result.Model, result.UpstreamModel)
@@ -4258,6 +4274,9 @@ func (s *OpenAIGatewayService) RecordUsage(ctx context.Context, input *OpenAIRec
 		ImageSize:             imageSize,
 		CreatedAt:             time.Now(),
 	}
+	// 临时子密钥归因
+	applyEphemeralKeyToUsageLog(usageLog, apiKey)
+
 	// 添加 UserAgent
 	if input.UserAgent != "" {
 		usageLog.UserAgent = &input.UserAgent
@@ -4274,8 +4293,13 @@ func (s *OpenAIGatewayService) RecordUsage(ctx context.Context, input *OpenAIRec
 	if subscription != nil {
 		usageLog.SubscriptionID = &subscription.ID
 	}
+	if input.FallbackModel != "" {
+		usageLog.FallbackModel = &input.FallbackModel
+	}
 
 	if s.cfg != nil && s.cfg.RunMode == config.RunModeSimple {
+		usageLog.Unbilled = true
+		dispatchUsageHook(input.UsageHook, usageLog)
 		writeUsageLogBestEffort(ctx, s.usageLogRepo, usageLog, "service.openai_gateway")
 		logger.LegacyPrintf("service.openai_gateway", "[SIMPLE MODE] Usage recorded (not billed): user=%d, tokens=%d", usageLog.UserID, usageLog.TotalTokens())
 		s.deferredService.ScheduleLastUsedUpdate(account.ID)
@@ -4300,6 +4324,7 @@ func (s *OpenAIGatewayService) RecordUsage(ctx context.Context, input *OpenAIRec
 	if billingErr != nil {
 		return billingErr
 	}
+	dispatchUsageHook(input.UsageHook, usageLog)
 	writeUsageLogBestEffort(ctx, s.usageLogRepo, usageLog, "service.openai_gateway")
 
 	return nil
@@ -0,0 +1,414 @@
+package service
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// openAIHedgeTTFTQuantile 未配置固定阈值时，使用主账号首字延迟的 P90 作为对冲阈值
+	openAIHedgeTTFTQuantile = 0.9
+	// openAIHedgeMinTTFTSamples 计算分位数所需的最少样本数（样本不足时不发起对冲）
+	openAIHedgeMinTTFTSamples = 8
+	// openAIHedgeMinDelay 对冲阈值下限，避免抖动时几乎每个请求都双发
+	openAIHedgeMinDelay = 100 * time.Millisecond
+)
+
+var errOpenAIHedgeNotSupported = errors.New("hijack not supported for hedged requests")
+
+// OpenAIHedgeRequest 对冲转发所需的调度上下文
+type OpenAIHedgeRequest struct {
+	Group          *Group
+	RequestedModel string
+	Stream         bool
+	// ExcludedIDs 当前请求已失败的账号（对冲账号不会从中选择）
+	ExcludedIDs map[int64]struct{}
+}
+
+// OpenAIHedgeForwardFunc 在指定账号上执行一次转发（Forward / ForwardAsAnthropic 等）
+type OpenAIHedgeForwardFunc func(ctx context.Context, c *gin.Context, account *Account) (*OpenAIForwardResult, error)
+
+// openAIHedgeDelay 计算分组的对冲阈值；返回 false 表示本次请求不对冲
+func (s *OpenAIGatewayService) openAIHedgeDelay(req OpenAIHedgeRequest, account *Account) (time.Duration, bool) {
+	if !req.Stream || req.Group == nil || !req.Group.HedgeEnabled || account == nil {
+		return 0, false
+	}
+	delay := time.Duration(req.Group.HedgeDelayMs) * time.Millisecond
+	if delay <= 0 {
+		s.getOpenAIAccountScheduler()
+		ttft, ok := s.openaiAccountStats.ttftQuantile(account.ID, openAIHedgeTTFTQuantile, openAIHedgeMinTTFTSamples)
+		if !ok {
+			return 0, false
+		}
+		delay = time.Duration(ttft * float64(time.Millisecond))
+	}
+	if delay < openAIHedgeMinDelay {
+		delay = openAIHedgeMinDelay
+	}
+	return delay, true
+}
+
+// ForwardHedged 对流式请求进行对冲转发：主账号在阈值内未返回首个数据时，
+// 在同分组另一账号上发起相同请求，先返回数据的一方输出给客户端，另一方被取消。
+// 返回实际输出响应的账号（仅该账号计费）；未满足对冲条件时等价于直接调用 forward。
+func (s *OpenAIGatewayService) ForwardHedged(
+	ctx context.Context,
+	c *gin.Context,
+	account *Account,
+	req OpenAIHedgeRequest,
+	forward OpenAIHedgeForwardFunc,
+) (*OpenAIForwardResult, *Account, error) {
+	delay, ok := s.openAIHedgeDelay(req, account)
+	if !ok {
+		result, err := forward(ctx, c, account)
+		return result, account, err
+	}
+
+	race := newOpenAIHedgeRace(c.Writer)
+	done := make(chan *openAIHedgeAttempt, 2)
+	primary := race.start(ctx, c, account, nil, forward, done)
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	var secondary *openAIHedgeAttempt
+	pending := 1
+	for pending > 0 {
+		select {
+		case <-done:
+			// 对冲发起前主请求已结束（含失败）时直接返回，失败由上层按原有逻辑故障转移
+			pending--
+		case <-timer.C:
+			if secondary != nil || race.committed() || primary.finished() {
+				continue
+			}
+			secondary = s.startOpenAIHedgeAttempt(ctx, c, race, req, account, forward, done)
+			if secondary != nil {
+				pending++
+			}
+		}
+	}
+
+	winner := race.winnerAttempt()
+	if winner == nil {
+		// 所有请求均未输出成功数据：优先选择成功结束的请求，否则返回主请求的错误
+		winner = primary
+		if primary.err != nil && secondary != nil && secondary.err == nil {
+			winner = secondary
+		}
+		race.flush(winner)
+	}
+
+	if secondary != nil {
+		s.RecordOpenAIHedge(winner == secondary)
+		loser := primary
+		if winner == primary {
+			loser = secondary
+		}
+		if loser.err != nil && !loser.canceledByHedge() {
+			s.ReportOpenAIAccountScheduleResult(loser.account.ID, false, nil)
+		}
+		logger.LegacyPrintf("service.openai_hedge", "hedged request group=%d primary=%d hedge=%d delay_ms=%d winner=%d",
+			req.Group.ID, account.ID, secondary.account.ID, delay.Milliseconds(), winner.account.ID)
+	}
+
+	for k, v := range winner.ctx.Keys {
+		c.Set(k, v)
+	}
+	return winner.result, winner.account, winner.err
+}
+
+// startOpenAIHedgeAttempt 选择对冲账号并发起请求；仅使用可立即获得槽位的账号，不排队等待
+func (s *OpenAIGatewayService) startOpenAIHedgeAttempt(
+	ctx context.Context,
+	c *gin.Context,
+	race *openAIHedgeRace,
+	req OpenAIHedgeRequest,
+	primary *Account,
+	forward OpenAIHedgeForwardFunc,
+	done chan *openAIHedgeAttempt,
+) *openAIHedgeAttempt {
+	excluded := make(map[int64]struct{}, len(req.ExcludedIDs)+1)
+	for id := range req.ExcludedIDs {
+		excluded[id] = struct{}{}
+	}
+	excluded[primary.ID] = struct{}{}
+
+	groupID := req.Group.ID
+	selection, _, err := s.SelectAccountWithScheduler(ctx, &groupID, "", "", req.RequestedModel, excluded, OpenAIUpstreamTransportAny)
+	if err != nil || selection == nil || selection.Account == nil {
+		return nil
+	}
+	if !selection.Acquired {
+		return nil
+	}
+	return race.start(ctx, c, selection.Account, selection.ReleaseFunc, forward, done)
+}
+
+// openAIHedgeAttempt 一次对冲中的单个上游请求
+type openAIHedgeAttempt struct {
+	account *Account
+	ctx     *gin.Context
+	writer  *openAIHedgeWriter
+	cancel  context.CancelCauseFunc
+	doneCh  chan struct{}
+
+	result *OpenAIForwardResult
+	err    error
+}
+
+var errOpenAIHedgeLost = errors.New("hedged request lost the race")
+
+func (a *openAIHedgeAttempt) finished() bool {
+	select {
+	case <-a.doneCh:
+		return true
+	default:
+		return false
+	}
+}
+
+// canceledByHedge 请求是否因另一方胜出而被取消
+func (a *openAIHedgeAttempt) canceledByHedge() bool {
+	return errors.Is(context.Cause(a.ctx.Request.Context()), errOpenAIHedgeLost)
+}
+
+// openAIHedgeRace 协调多个请求对客户端响应的独占写入：首个写出成功数据的请求获胜
+type openAIHedgeRace struct {
+	mu       sync.Mutex
+	target   gin.ResponseWriter
+	winner   *openAIHedgeWriter
+	attempts []*openAIHedgeAttempt
+}
+
+func newOpenAIHedgeRace(target gin.ResponseWriter) *openAIHedgeRace {
+	return &openAIHedgeRace{target: target}
+}
+
+func (r *openAIHedgeRace) start(
+	ctx context.Context,
+	c *gin.Context,
+	account *Account,
+	release func(),
+	forward OpenAIHedgeForwardFunc,
+	done chan *openAIHedgeAttempt,
+) *openAIHedgeAttempt {
+	attemptCtx, cancel := context.WithCancelCause(ctx)
+	ac := c.Copy()
+	ac.Request = c.Request.WithContext(attemptCtx)
+	// 已解析的请求体可能被转发过程修改，各请求独立解析
+	delete(ac.Keys, OpenAIParsedRequestBodyKey)
+
+	attempt := &openAIHedgeAttempt{
+		account: account,
+		ctx:     ac,
+		cancel:  cancel,
+		doneCh:  make(chan struct{}),
+	}
+	attempt.writer = &openAIHedgeWriter{race: r, header: make(http.Header), size: -1}
+	ac.Writer = attempt.writer
+
+	r.mu.Lock()
+	r.attempts = append(r.attempts, attempt)
+	r.mu.Unlock()
+
+	go func() {
+		defer func() {
+			if release != nil {
+				release()
+			}
+			cancel(nil)
+			close(attempt.doneCh)
+			done <- attempt
+		}()
+		attempt.result, attempt.err = forward(attemptCtx, ac, account)
+	}()
+	return attempt
+}
+
+func (r *openAIHedgeRace) committed() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.winner != nil
+}
+
+func (r *openAIHedgeRace) winnerAttempt() *openAIHedgeAttempt {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, attempt := range r.attempts {
+		if r.winner != nil && attempt.writer == r.winner {
+			return attempt
+		}
+	}
+	return nil
+}
+
+// commitLocked 将 w 设为获胜方：输出其缓冲的响应头与数据，并取消其余请求（调用方持有锁）
+func (r *openAIHedgeRace) commitLocked(w *openAIHedgeWriter) error {
+	r.winner = w
+	dst := r.target.Header()
+	for k, v := range w.header {
+		dst[k] = append([]string(nil), v...)
+	}
+	r.target.WriteHeader(w.statusCode())
+	for _, attempt := range r.attempts {
+		if attempt.writer != w {
+			attempt.cancel(errOpenAIHedgeLost)
+		}
+	}
+	if len(w.buf) == 0 {
+		return nil
+	}
+	buf := w.buf
+	w.buf = nil
+	_, err := r.target.Write(buf)
+	return err
+}
+
+// flush 所有请求结束后仍无获胜方时，输出指定请求缓冲的内容（如上游错误响应）
+func (r *openAIHedgeRace) flush(attempt *openAIHedgeAttempt) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.winner != nil || attempt == nil {
+		return
+	}
+	w := attempt.writer
+	if w.status == 0 && w.size < 0 {
+		return
+	}
+	_ = r.commitLocked(w)
+	r.target.WriteHeaderNow()
+	if w.flushed {
+		r.target.Flush()
+	}
+}
+
+// openAIHedgeWriter 单个请求的响应写入器：获胜前缓冲，获胜后直接写入客户端，落败后丢弃
+type openAIHedgeWriter struct {
+	race    *openAIHedgeRace
+	header  http.Header
+	status  int
+	size    int
+	buf     []byte
+	flushed bool
+}
+
+var _ gin.ResponseWriter = (*openAIHedgeWriter)(nil)
+
+func (w *openAIHedgeWriter) Header() http.Header {
+	w.race.mu.Lock()
+	defer w.race.mu.Unlock()
+	if w.race.winner == w {
+		return w.race.target.Header()
+	}
+	return w.header
+}
+
+func (w *openAIHedgeWriter) WriteHeader(code int) {
+	if code <= 0 {
+		return
+	}
+	w.race.mu.Lock()
+	defer w.race.mu.Unlock()
+	if w.race.winner == w {
+		w.race.target.WriteHeader(code)
+		return
+	}
+	if w.size < 0 {
+		w.status = code
+	}
+}
+
+func (w *openAIHedgeWriter) WriteHeaderNow() {
+	w.race.mu.Lock()
+	defer w.race.mu.Unlock()
+	if w.race.winner == w {
+		w.race.target.WriteHeaderNow()
+		return
+	}
+	if w.size < 0 {
+		w.size = 0
+	}
+}
+
+func (w *openAIHedgeWriter) Write(p []byte) (int, error) {
+	w.race.mu.Lock()
+	defer w.race.mu.Unlock()
+	if w.size < 0 {
+		w.size = 0
+	}
+	w.size += len(p)
+	switch {
+	case w.race.winner == w:
+		return w.race.target.Write(p)
+	case w.race.winner != nil:
+		// 已落败：丢弃输出，请求随 context 取消结束
+		return len(p), nil
+	}
+	w.buf = append(w.buf, p...)
+	if w.statusCode() < http.StatusBadRequest {
+		if err := w.race.commitLocked(w); err != nil {
+			return 0, err
+		}
+	}
+	return len(p), nil
+}
+
+func (w *openAIHedgeWriter) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
+
+func (w *openAIHedgeWriter) Flush() {
+	w.race.mu.Lock()
+	defer w.race.mu.Unlock()
+	if w.race.winner == w {
+		w.race.target.Flush()
+		return
+	}
+	w.flushed = true
+}
+
+func (w *openAIHedgeWriter) Status() int {
+	w.race.mu.Lock()
+	defer w.race.mu.Unlock()
+	return w.statusCode()
+}
+
+// statusCode 返回缓冲的状态码（调用方持有锁）
+func (w *openAIHedgeWriter) statusCode() int {
+	if w.status == 0 {
+		return http.StatusOK
+	}
+	return w.status
+}
+
+func (w *openAIHedgeWriter) Size() int {
+	w.race.mu.Lock()
+	defer w.race.mu.Unlock()
+	return w.size
+}
+
+func (w *openAIHedgeWriter) Written() bool {
+	return w.Size() >= 0
+}
+
+func (w *openAIHedgeWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	return nil, nil, errOpenAIHedgeNotSupported
+}
+
+//nolint:staticcheck // gin.ResponseWriter 仍要求实现 http.CloseNotifier
+func (w *openAIHedgeWriter) CloseNotify() <-chan bool {
+	return w.race.target.CloseNotify()
+}
+
+func (w *openAIHedgeWriter) Pusher() http.Pusher {
+	return nil
+}
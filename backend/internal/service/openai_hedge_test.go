@@ -0,0 +1,177 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+func newOpenAIHedgeTestContext() (*gin.Context, *httptest.ResponseRecorder) {
+	gin.SetMode(gin.TestMode)
+	rec := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(rec)
+	c.Request = httptest.NewRequest(http.MethodPost, "/v1/responses", nil)
+	return c, rec
+}
+
+func TestOpenAIHedgeRace_FirstWriterWinsAndLoserCanceled(t *testing.T) {
+	c, rec := newOpenAIHedgeTestContext()
+	race := newOpenAIHedgeRace(c.Writer)
+	done := make(chan *openAIHedgeAttempt, 2)
+
+	slow := race.start(context.Background(), c, &Account{ID: 1}, nil, func(ctx context.Context, fc *gin.Context, _ *Account) (*OpenAIForwardResult, error) {
+		fc.Header("X-Account", "slow")
+		<-ctx.Done()
+		_, _ = fc.Writer.Write([]byte("slow"))
+		return nil, ctx.Err()
+	}, done)
+
+	released := false
+	fast := race.start(context.Background(), c, &Account{ID: 2}, func() { released = true }, func(_ context.Context, fc *gin.Context, _ *Account) (*OpenAIForwardResult, error) {
+		fc.Header("X-Account", "fast")
+		fc.Set("winner_key", "fast")
+		_, _ = fc.Writer.Write([]byte("data: 1\n\n"))
+		fc.Writer.Flush()
+		_, _ = fc.Writer.Write([]byte("data: 2\n\n"))
+		return &OpenAIForwardResult{Model: "gpt-5"}, nil
+	}, done)
+
+	<-done
+	<-done
+
+	require.Equal(t, fast, race.winnerAttempt())
+	require.True(t, slow.canceledByHedge())
+	require.False(t, fast.canceledByHedge())
+	require.True(t, released, "对冲账号槽位在请求结束后释放")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "fast", rec.Header().Get("X-Account"))
+	require.Equal(t, "data: 1\n\ndata: 2\n\n", rec.Body.String())
+	require.Nil(t, c.Keys["winner_key"], "未合并前不影响原 context")
+}
+
+func TestOpenAIHedgeRace_ErrorResponseDoesNotCommit(t *testing.T) {
+	c, rec := newOpenAIHedgeTestContext()
+	race := newOpenAIHedgeRace(c.Writer)
+	done := make(chan *openAIHedgeAttempt, 1)
+
+	failed := race.start(context.Background(), c, &Account{ID: 1}, nil, func(_ context.Context, fc *gin.Context, _ *Account) (*OpenAIForwardResult, error) {
+		fc.JSON(http.StatusBadGateway, gin.H{"error": "upstream"})
+		return nil, errors.New("upstream error: 502")
+	}, done)
+	<-done
+
+	require.Nil(t, race.winnerAttempt(), "错误响应不参与竞速")
+	require.False(t, c.Writer.Written())
+
+	race.flush(failed)
+	require.Equal(t, http.StatusBadGateway, rec.Code)
+	require.JSONEq(t, `{"error":"upstream"}`, rec.Body.String())
+}
+
+func TestForwardHedged_DisabledForwardsDirectly(t *testing.T) {
+	c, rec := newOpenAIHedgeTestContext()
+	svc := &OpenAIGatewayService{}
+	account := &Account{ID: 3}
+
+	calls := 0
+	result, served, err := svc.ForwardHedged(context.Background(), c, account, OpenAIHedgeRequest{
+		Group:  &Group{ID: 1, HedgeEnabled: false, HedgeDelayMs: 10},
+		Stream: true,
+	}, func(_ context.Context, fc *gin.Context, target *Account) (*OpenAIForwardResult, error) {
+		calls++
+		require.Same(t, c, fc)
+		fc.String(http.StatusOK, "ok")
+		return &OpenAIForwardResult{}, nil
+	})
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	require.Same(t, account, served)
+	require.Equal(t, 1, calls)
+	require.Equal(t, "ok", rec.Body.String())
+}
+
+func TestForwardHedged_PrimaryWinsWithinDelay(t *testing.T) {
+	c, rec := newOpenAIHedgeTestContext()
+	svc := &OpenAIGatewayService{}
+	account := &Account{ID: 3}
+
+	result, served, err := svc.ForwardHedged(context.Background(), c, account, OpenAIHedgeRequest{
+		Group:  &Group{ID: 1, HedgeEnabled: true, HedgeDelayMs: int(time.Minute / time.Millisecond)},
+		Stream: true,
+	}, func(_ context.Context, fc *gin.Context, _ *Account) (*OpenAIForwardResult, error) {
+		fc.Set(OpsUpstreamLatencyMsKey, int64(12))
+		_, _ = fc.Writer.Write([]byte("data: ok\n\n"))
+		return &OpenAIForwardResult{}, nil
+	})
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	require.Same(t, account, served)
+	require.Equal(t, "data: ok\n\n", rec.Body.String())
+	require.Equal(t, int64(12), c.GetInt64(OpsUpstreamLatencyMsKey), "获胜请求的 context 值回写")
+	require.Zero(t, svc.SnapshotOpenAIAccountSchedulerMetrics().HedgeTotal, "未触发对冲")
+}
+
+func TestOpenAIHedgeDelay(t *testing.T) {
+	svc := &OpenAIGatewayService{}
+	account := &Account{ID: 9}
+	req := OpenAIHedgeRequest{Group: &Group{ID: 1, HedgeEnabled: true}, Stream: true}
+
+	_, ok := svc.openAIHedgeDelay(req, account)
+	require.False(t, ok, "无首字延迟样本时不对冲")
+
+	svc.getOpenAIAccountScheduler()
+	for i := 1; i <= 10; i++ {
+		ms := i * 100
+		svc.ReportOpenAIAccountScheduleResult(account.ID, true, &ms)
+	}
+	delay, ok := svc.openAIHedgeDelay(req, account)
+	require.True(t, ok)
+	require.Equal(t, 900*time.Millisecond, delay)
+
+	req.Group.HedgeDelayMs = 20
+	delay, ok = svc.openAIHedgeDelay(req, account)
+	require.True(t, ok)
+	require.Equal(t, openAIHedgeMinDelay, delay, "固定阈值不低于下限")
+
+	req.Stream = false
+	_, ok = svc.openAIHedgeDelay(req, account)
+	require.False(t, ok, "仅对流式请求对冲")
+}
+
+func TestAccountRuntimeStats_TTFTQuantileKeepsRecentSamples(t *testing.T) {
+	stats := newAccountRuntimeStats()
+	for i := 0; i < accountRuntimeTTFTSamples+36; i++ {
+		ms := 1000
+		if i >= 36 {
+			ms = 100
+		}
+		stats.report(1, true, &ms)
+	}
+	p90, ok := stats.ttftQuantile(1, 0.9, 8)
+	require.True(t, ok)
+	require.Equal(t, 100.0, p90, "旧样本被环形缓冲覆盖")
+
+	_, ok = stats.ttftQuantile(2, 0.9, 8)
+	require.False(t, ok)
+}
+
+func TestOpenAIAccountScheduler_HedgeMetrics(t *testing.T) {
+	svc := &OpenAIGatewayService{}
+	svc.RecordOpenAIHedge(true)
+	svc.RecordOpenAIHedge(false)
+	svc.RecordOpenAIHedge(false)
+	svc.RecordOpenAIHedge(true)
+
+	snapshot := svc.SnapshotOpenAIAccountSchedulerMetrics()
+	require.Equal(t, int64(4), snapshot.HedgeTotal)
+	require.Equal(t, int64(2), snapshot.HedgeWinTotal)
+	require.Equal(t, 0.5, snapshot.HedgeWinRate)
+}
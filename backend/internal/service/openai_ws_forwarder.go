@@ -221,6 +221,17 @@ func (e *OpenAIWSClientCloseError) Reason() string {
 type OpenAIWSIngressHooks struct {
 	BeforeTurn func(turn int) error
 	AfterTurn  func(turn int, result *OpenAIForwardResult, turnErr error)
+	// InspectPayload 在后续客户端请求帧转发上游前调用，可返回改写后的请求体，返回错误时关闭连接。
+	// 首个请求帧由调用方在发起代理前自行处理。
+	InspectPayload func(payload []byte) ([]byte, error)
+}
+
+// inspectOpenAIWSClientPayload 执行 InspectPayload 回调；未配置时原样返回。
+func (h *OpenAIWSIngressHooks) inspectOpenAIWSClientPayload(payload []byte) ([]byte, error) {
+	if h == nil || h.InspectPayload == nil {
+		return payload, nil
+	}
+	return h.InspectPayload(payload)
 }
 
 func normalizeOpenAIWSLogValue(value string) string {
@@ -1716,6 +1727,8 @@ func (s *OpenAIGatewayService) forwardOpenAIWSV2(
 	)
 
 	payload := s.buildOpenAIWSCreatePayload(reqBody, account)
+	// 应用管理员配置的请求改写规则
+	payload, transformResult := applyBoundRequestTransformsToPayload(c, account, mappedModel, payload)
 	payloadStrategy, removedKeys := applyOpenAIWSRetryPayloadStrategy(payload, attempt)
 	previousResponseID := openAIWSPayloadString(payload, "previous_response_id")
 	previousResponseIDKind := ClassifyOpenAIPreviousResponseIDKind(previousResponseID)
@@ -1793,6 +1806,7 @@ func (s *OpenAIGatewayService) forwardOpenAIWSV2(
 	forceNewConnByPolicy := shouldForceNewConnOnStoreDisabled(storeDisabledConnMode, lastFailureReason)
 	forceNewConn := forceNewConnByPolicy && storeDisabled && previousResponseID == "" && sessionHash != "" && preferredConnID == ""
 	wsHeaders, sessionResolution := s.buildOpenAIWSHeaders(c, account, token, decision, isCodexCLI, turnState, turnMetadata, promptCacheKey)
+	transformResult.ApplyHeaders(wsHeaders)
 	logOpenAIWSModeDebug(
 		"acquire_start account_id=%d account_type=%s transport=%s preferred_conn_id=%s has_previous_response_id=%v session_hash=%s has_turn_state=%v turn_state_len=%d has_turn_metadata=%v turn_metadata_len=%d store_disabled=%v store_disabled_conn_mode=%s retry_last_reason=%s force_new_conn=%v header_user_agent=%s header_openai_beta=%s header_originator=%s header_accept_language=%s header_session_id=%s header_conversation_id=%s session_id_source=%s conversation_id_source=%s has_prompt_cache_key=%v has_chatgpt_account_id=%v has_authorization=%v has_session_id=%v has_conversation_id=%v proxy_enabled=%v",
 		account.ID,
@@ -1834,12 +1848,7 @@ func (s *OpenAIGatewayService) forwardOpenAIWSV2(
 		Headers:         wsHeaders,
 		PreferredConnID: preferredConnID,
 		ForceNewConn:    forceNewConn,
-		ProxyURL: func() string {
-			if account.ProxyID != nil && account.Proxy != nil {
-				return account.Proxy.URL()
-			}
-			return ""
-		}(),
+		ProxyURL:        resolveAccountProxyURL(account),
 	})
 	if err != nil {
 		dialStatus, dialClass, dialCloseStatus, dialCloseReason, dialRespServer, dialRespVia, dialRespCFRay, dialRespReqID := summarizeOpenAIWSDialError(err)
@@ -2427,6 +2436,7 @@ func (s *OpenAIGatewayService) ProxyResponsesWebSocketFromClient(
 		previousResponseID string
 		originalModel      string
 		payloadBytes       int
+		transformResult    *RequestTransformResult
 	}
 
 	applyPayloadMutation := func(current []byte, path string, value any) ([]byte, error) {
@@ -2526,6 +2536,8 @@ func (s *OpenAIGatewayService) ProxyResponsesWebSocketFromClient(
 			}
 			normalized = next
 		}
+		// 应用管理员配置的请求改写规则；请求头操作仅在首个请求建立上游连接时生效
+		normalized, transformResult := applyBoundRequestTransforms(c, account, mappedModel, normalized)
 
 		return openAIWSClientPayload{
 			payloadRaw:         normalized,
@@ -2534,6 +2546,7 @@ func (s *OpenAIGatewayService) ProxyResponsesWebSocketFromClient(
 			previousResponseID: previousResponseID,
 			originalModel:      originalModel,
 			payloadBytes:       len(normalized),
+			transformResult:    transformResult,
 		}, nil
 	}
 
@@ -2569,16 +2582,12 @@ func (s *OpenAIGatewayService) ProxyResponsesWebSocketFromClient(
 
 	isCodexCLI := openai.IsCodexOfficialClientByHeaders(c.GetHeader("User-Agent"), c.GetHeader("originator")) || (s.cfg != nil && s.cfg.Gateway.ForceCodexCLI)
 	wsHeaders, _ := s.buildOpenAIWSHeaders(c, account, token, wsDecision, isCodexCLI, turnState, strings.TrimSpace(c.GetHeader(openAIWSTurnMetadataHeader)), firstPayload.promptCacheKey)
+	firstPayload.transformResult.ApplyHeaders(wsHeaders)
 	baseAcquireReq := openAIWSAcquireRequest{
-		Account: account,
-		WSURL:   wsURL,
-		Headers: wsHeaders,
-		ProxyURL: func() string {
-			if account.ProxyID != nil && account.Proxy != nil {
-				return account.Proxy.URL()
-			}
-			return ""
-		}(),
+		Account:      account,
+		WSURL:        wsURL,
+		Headers:      wsHeaders,
+		ProxyURL:     resolveAccountProxyURL(account),
 		ForceNewConn: false,
 	}
 	pool := s.getOpenAIWSConnPool()
@@ -3472,6 +3481,10 @@ func (s *OpenAIGatewayService) ProxyResponsesWebSocketFromClient(
 			return fmt.Errorf("read client websocket request: %w", readErr)
 		}
 
+		nextClientMessage, inspectErr := hooks.inspectOpenAIWSClientPayload(nextClientMessage)
+		if inspectErr != nil {
+			return inspectErr
+		}
 		nextPayload, parseErr := parseClientPayload(nextClientMessage)
 		if parseErr != nil {
 			return parseErr
@@ -44,6 +44,7 @@ func (s *OpsService) GetAccountAvailabilityStats(ctx context.Context, platformFi
 	platform := make(map[string]*PlatformAvailability)
 	group := make(map[int64]*GroupAvailability)
 	account := make(map[int64]*AccountAvailability)
+	breaker := s.accountCircuitBreaker()
 
 	for _, acc := range accounts {
 		if acc.ID <= 0 {
@@ -65,7 +66,10 @@ func (s *OpsService) GetAccountAvailabilityStats(ctx context.Context, platformFi
 			isOverloaded = false
 		}
 
-		isAvailable := acc.Status == StatusActive && acc.Schedulable && !isRateLimited && !isOverloaded && !isTempUnsched
+		circuit := breaker.Snapshot(acc.ID)
+		isCircuitOpen := circuit != nil && circuit.State == AccountCircuitOpen && circuit.OpenUntil != nil && now.Before(*circuit.OpenUntil)
+
+		isAvailable := acc.Status == StatusActive && acc.Schedulable && !isRateLimited && !isOverloaded && !isTempUnsched && !isCircuitOpen
 
 		if acc.Platform != "" {
 			if _, ok := platform[acc.Platform]; !ok {
@@ -84,6 +88,9 @@ func (s *OpsService) GetAccountAvailabilityStats(ctx context.Context, platformFi
 			if hasError {
 				p.ErrorCount++
 			}
+			if isCircuitOpen {
+				p.CircuitOpenCount++
+			}
 		}
 
 		for _, grp := range acc.Groups {
@@ -108,6 +115,9 @@ func (s *OpsService) GetAccountAvailabilityStats(ctx context.Context, platformFi
 			if hasError {
 				g.ErrorCount++
 			}
+			if isCircuitOpen {
+				g.CircuitOpenCount++
+			}
 		}
 
 		displayGroupID := int64(0)
@@ -150,6 +160,15 @@ func (s *OpsService) GetAccountAvailabilityStats(ctx context.Context, platformFi
 		if isTempUnsched && acc.TempUnschedulableUntil != nil {
 			item.TempUnschedulableUntil = acc.TempUnschedulableUntil
 		}
+		if circuit != nil {
+			errorRate := circuit.ErrorRate
+			item.CircuitState = circuit.State
+			item.CircuitErrorRate = &errorRate
+			item.CircuitTransitions = circuit.Transitions
+			if isCircuitOpen {
+				item.CircuitOpenUntil = circuit.OpenUntil
+			}
+		}
 
 		account[acc.ID] = item
 	}
@@ -157,6 +176,19 @@ func (s *OpsService) GetAccountAvailabilityStats(ctx context.Context, platformFi
 	return platform, group, account, &collectedAt, nil
 }
 
+// accountCircuitBreaker 返回网关使用的账号熔断器（未启用时为 nil）
+func (s *OpsService) accountCircuitBreaker() *AccountCircuitBreaker {
+	if s.gatewayService != nil {
+		if breaker := s.gatewayService.schedulerSnapshot.AccountCircuitBreaker(); breaker != nil {
+			return breaker
+		}
+	}
+	if s.openAIGatewayService != nil {
+		return s.openAIGatewayService.schedulerSnapshot.AccountCircuitBreaker()
+	}
+	return nil
+}
+
 type OpsAccountAvailability struct {
 	Group       *GroupAvailability
 	Accounts    map[int64]*AccountAvailability
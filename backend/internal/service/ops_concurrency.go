@@ -256,6 +256,15 @@ func (s *OpsService) GetConcurrencyStats(
 			info.LoadPercentage = float64(info.CurrentInUse) / float64(info.MaxCapacity) * 100
 		}
 	}
+	for groupID, users := range s.concurrencyService.FairQueue().GetQueueDepths(ctx) {
+		info, ok := group[groupID]
+		if !ok {
+			continue
+		}
+		for _, depth := range users {
+			info.FairQueueDepth += int64(depth)
+		}
+	}
 
 	return platform, group, account, &collectedAt, nil
 }
@@ -362,6 +371,17 @@ func (s *OpsService) GetUserConcurrencyStats(ctx context.Context) (map[int64]*Us
 	collectedAt := time.Now()
 	loadMap := s.getUsersLoadMapBestEffort(ctx, users)
 
+	// 公平排队深度：userID -> groupID -> depth
+	fairQueued := make(map[int64]map[int64]int64)
+	for groupID, byUser := range s.concurrencyService.FairQueue().GetQueueDepths(ctx) {
+		for userID, depth := range byUser {
+			if fairQueued[userID] == nil {
+				fairQueued[userID] = make(map[int64]int64)
+			}
+			fairQueued[userID][groupID] += int64(depth)
+		}
+	}
+
 	result := make(map[int64]*UserConcurrencyInfo)
 
 	for _, u := range users {
@@ -377,8 +397,14 @@ func (s *OpsService) GetUserConcurrencyStats(ctx context.Context) (map[int64]*Us
 			waiting = int64(load.WaitingCount)
 		}
 
+		fairByGroup := fairQueued[u.ID]
+		fairWaiting := int64(0)
+		for _, depth := range fairByGroup {
+			fairWaiting += depth
+		}
+
 		// Skip users with no concurrency activity
-		if currentInUse == 0 && waiting == 0 {
+		if currentInUse == 0 && waiting == 0 && fairWaiting == 0 {
 			continue
 		}
 
@@ -389,6 +415,9 @@ func (s *OpsService) GetUserConcurrencyStats(ctx context.Context) (map[int64]*Us
 			CurrentInUse:   currentInUse,
 			MaxCapacity:    int64(u.Concurrency),
 			WaitingInQueue: waiting,
+
+			FairQueueWaiting: fairWaiting,
+			FairQueueByGroup: fairByGroup,
 		}
 		if info.MaxCapacity > 0 {
 			info.LoadPercentage = float64(info.CurrentInUse) / float64(info.MaxCapacity) * 100
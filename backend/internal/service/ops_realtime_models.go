@@ -22,6 +22,8 @@ type GroupConcurrencyInfo struct {
 	MaxCapacity    int64   `json:"max_capacity"`
 	LoadPercentage float64 `json:"load_percentage"`
 	WaitingInQueue int64   `json:"waiting_in_queue"`
+	// FairQueueDepth 分组公平排队中等待账号槽位的请求数
+	FairQueueDepth int64 `json:"fair_queue_depth"`
 }
 
 // AccountConcurrencyInfo represents real-time concurrency usage for a single account.
@@ -46,26 +48,32 @@ type UserConcurrencyInfo struct {
 	MaxCapacity    int64   `json:"max_capacity"`
 	LoadPercentage float64 `json:"load_percentage"`
 	WaitingInQueue int64   `json:"waiting_in_queue"`
+	// FairQueueWaiting 该用户在各分组公平排队中等待账号槽位的请求总数
+	FairQueueWaiting int64 `json:"fair_queue_waiting"`
+	// FairQueueByGroup 按分组拆分的公平排队深度：groupID -> depth
+	FairQueueByGroup map[int64]int64 `json:"fair_queue_by_group,omitempty"`
 }
 
 // PlatformAvailability aggregates account availability by platform.
 type PlatformAvailability struct {
-	Platform       string `json:"platform"`
-	TotalAccounts  int64  `json:"total_accounts"`
-	AvailableCount int64  `json:"available_count"`
-	RateLimitCount int64  `json:"rate_limit_count"`
-	ErrorCount     int64  `json:"error_count"`
+	Platform         string `json:"platform"`
+	TotalAccounts    int64  `json:"total_accounts"`
+	AvailableCount   int64  `json:"available_count"`
+	RateLimitCount   int64  `json:"rate_limit_count"`
+	ErrorCount       int64  `json:"error_count"`
+	CircuitOpenCount int64  `json:"circuit_open_count"`
 }
 
 // GroupAvailability aggregates account availability by group.
 type GroupAvailability struct {
-	GroupID        int64  `json:"group_id"`
-	GroupName      string `json:"group_name"`
-	Platform       string `json:"platform"`
-	TotalAccounts  int64  `json:"total_accounts"`
-	AvailableCount int64  `json:"available_count"`
-	RateLimitCount int64  `json:"rate_limit_count"`
-	ErrorCount     int64  `json:"error_count"`
+	GroupID          int64  `json:"group_id"`
+	GroupName        string `json:"group_name"`
+	Platform         string `json:"platform"`
+	TotalAccounts    int64  `json:"total_accounts"`
+	AvailableCount   int64  `json:"available_count"`
+	RateLimitCount   int64  `json:"rate_limit_count"`
+	ErrorCount       int64  `json:"error_count"`
+	CircuitOpenCount int64  `json:"circuit_open_count"`
 }
 
 // AccountAvailability represents current availability for a single account.
@@ -89,4 +97,10 @@ type AccountAvailability struct {
 	OverloadRemainingSec   *int64     `json:"overload_remaining_sec"`
 	ErrorMessage           string     `json:"error_message"`
 	TempUnschedulableUntil *time.Time `json:"temp_unschedulable_until,omitempty"`
+
+	// 账号熔断器状态（未启用熔断或账号尚无转发结果时为空）
+	CircuitState       string                     `json:"circuit_state,omitempty"`
+	CircuitOpenUntil   *time.Time                 `json:"circuit_open_until,omitempty"`
+	CircuitErrorRate   *float64                   `json:"circuit_error_rate,omitempty"`
+	CircuitTransitions []AccountCircuitTransition `json:"circuit_transitions,omitempty"`
 }
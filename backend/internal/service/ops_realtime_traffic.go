@@ -32,5 +32,12 @@ func (s *OpsService) GetRealtimeTrafficSummary(ctx context.Context, filter *OpsD
 	// Realtime traffic summary always uses raw logs (minute granularity peaks).
 	filter.QueryMode = OpsQueryModeRaw
 
-	return s.opsRepo.GetRealtimeTrafficSummary(ctx, filter)
+	summary, err := s.opsRepo.GetRealtimeTrafficSummary(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	if summary != nil && s.concurrencyService != nil {
+		summary.PriorityClasses = s.concurrencyService.FairQueue().GetPriorityClassStats(ctx, filter.GroupID)
+	}
+	return summary, nil
 }
@@ -16,4 +16,7 @@ type OpsRealtimeTrafficSummary struct {
 
 	QPS OpsRateSummary `json:"qps"`
 	TPS OpsRateSummary `json:"tps"`
+
+	// PriorityClasses per-class wait queue depth and queueing latency (only when priority classes are enabled).
+	PriorityClasses []*PriorityClassQueueStats `json:"priority_classes,omitempty"`
 }
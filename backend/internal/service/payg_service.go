@@ -42,6 +42,8 @@ type PaygService struct {
 	authCacheInvalidator APIKeyAuthCacheInvalidator
 	entClient            *dbent.Client
 	httpClient           *http.Client
+	invoiceService       *InvoiceService
+	balanceBuckets       *BalanceBucketService
 }
 
 func NewPaygService(
@@ -52,6 +54,7 @@ func NewPaygService(
 	billingCache BillingCache,
 	authCacheInvalidator APIKeyAuthCacheInvalidator,
 	entClient *dbent.Client,
+	balanceBuckets *BalanceBucketService,
 ) *PaygService {
 	return &PaygService{
 		repo:                 repo,
@@ -61,6 +64,7 @@ func NewPaygService(
 		billingCache:         billingCache,
 		authCacheInvalidator: authCacheInvalidator,
 		entClient:            entClient,
+		balanceBuckets:       balanceBuckets,
 		httpClient: &http.Client{
 			Timeout: 15 * time.Second,
 		},
@@ -313,6 +317,9 @@ func (s *PaygService) syncOrderByIdentifiers(ctx context.Context, sn, clientSN s
 	if err := s.userRepo.UpdateBalance(txCtx, lockedOrder.UserID, lockedOrder.CreditAmount); err != nil {
 		return nil, fmt.Errorf("credit user balance: %w", err)
 	}
+	if err := s.balanceBuckets.Grant(txCtx, lockedOrder.UserID, BalanceSourcePaid, lockedOrder.CreditAmount, lockedOrder.ClientSN); err != nil {
+		return nil, err
+	}
 
 	user, userErr := s.userRepo.GetByID(ctx, lockedOrder.UserID)
 	if userErr != nil {
@@ -335,9 +342,17 @@ func (s *PaygService) syncOrderByIdentifiers(ctx context.Context, sn, clientSN s
 	}
 
 	s.invalidateCaches(ctx, lockedOrder.UserID)
+	if s.invoiceService != nil {
+		s.invoiceService.IssueForPaygOrderAsync(lockedOrder.ID)
+	}
 	return lockedOrder, nil
 }
 
+// SetInvoiceService injects the invoice service so paid orders are invoiced.
+func (s *PaygService) SetInvoiceService(invoiceService *InvoiceService) {
+	s.invoiceService = invoiceService
+}
+
 func (s *PaygService) invalidateCaches(ctx context.Context, userID int64) {
 	if s.authCacheInvalidator != nil {
 		s.authCacheInvalidator.InvalidateAuthCacheByUserID(ctx, userID)
@@ -0,0 +1,280 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	dbent "github.com/Wei-Shaw/sub2api/ent"
+	"github.com/Wei-Shaw/sub2api/ent/paymentorder"
+	"github.com/Wei-Shaw/sub2api/ent/subscriptionautorenewal"
+	"github.com/Wei-Shaw/sub2api/internal/payment"
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+)
+
+// --- Auto-Renew Subscriptions ---
+
+// Auto-renewal status values.
+const (
+	AutoRenewStatusIncomplete = "incomplete"
+	AutoRenewStatusActive     = "active"
+	AutoRenewStatusPastDue    = "past_due"
+	AutoRenewStatusCanceled   = "canceled"
+)
+
+// autoRenewSuspendWindow is how close to expiry a user subscription must be for a
+// failed renewal charge to suspend it. Renewals are charged at the end of the paid
+// period, so only subscriptions kept alive by the failed renewal are affected.
+const autoRenewSuspendWindow = 24 * time.Hour
+
+func (s *PaymentService) validateAutoRenew(ctx context.Context, req CreateOrderRequest, plan *dbent.SubscriptionPlan) error {
+	if plan.StripePriceID == "" || payment.GetBasePaymentType(req.PaymentType) != payment.TypeStripe {
+		return infraerrors.BadRequest("AUTO_RENEW_UNSUPPORTED", "auto-renew is not available for this plan or payment method")
+	}
+	exists, err := s.entClient.SubscriptionAutoRenewal.Query().Where(
+		subscriptionautorenewal.UserIDEQ(req.UserID),
+		subscriptionautorenewal.GroupIDEQ(plan.GroupID),
+		subscriptionautorenewal.StatusIn(AutoRenewStatusActive, AutoRenewStatusPastDue),
+	).Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("check auto-renewals: %w", err)
+	}
+	if exists {
+		return infraerrors.Conflict("AUTO_RENEW_EXISTS", "auto-renew is already enabled for this subscription")
+	}
+	return nil
+}
+
+func (s *PaymentService) createAutoRenewal(ctx context.Context, o *dbent.PaymentOrder, plan *dbent.SubscriptionPlan, instanceID, subscriptionID string) error {
+	if subscriptionID == "" {
+		return infraerrors.ServiceUnavailable("PAYMENT_GATEWAY_ERROR", "payment gateway did not create a recurring subscription")
+	}
+	_, err := s.entClient.SubscriptionAutoRenewal.Create().
+		SetUserID(o.UserID).
+		SetPlanID(plan.ID).
+		SetGroupID(plan.GroupID).
+		SetSubscriptionDays(psComputeValidityDays(plan.ValidityDays, plan.ValidityUnit)).
+		SetAmount(o.Amount).
+		SetPaymentType(o.PaymentType).
+		SetNillableProviderInstanceID(psNilIfEmpty(instanceID)).
+		SetProviderSubscriptionID(subscriptionID).
+		SetInitialOrderID(o.ID).
+		SetStatus(AutoRenewStatusIncomplete).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("create auto-renewal: %w", err)
+	}
+	return nil
+}
+
+// handleRecurringEvent processes a billing event of a provider-side recurring subscription.
+func (s *PaymentService) handleRecurringEvent(ctx context.Context, n *payment.PaymentNotification, pk string) error {
+	ev := n.Recurring
+	r, err := s.entClient.SubscriptionAutoRenewal.Query().
+		Where(subscriptionautorenewal.ProviderSubscriptionIDEQ(ev.SubscriptionID)).
+		Only(ctx)
+	if err != nil {
+		if dbent.IsNotFound(err) {
+			slog.Warn("recurring event for unknown subscription", "provider", pk, "subscriptionID", ev.SubscriptionID, "kind", ev.Kind)
+			return nil
+		}
+		return fmt.Errorf("query auto-renewal: %w", err)
+	}
+	switch ev.Kind {
+	case payment.RecurringEventPaid:
+		if ev.Initial {
+			s.updateAutoRenewal(ctx, r, AutoRenewStatusActive, ev.PeriodEnd)
+			initial := *n
+			initial.Recurring = nil
+			return s.HandlePaymentNotification(ctx, &initial, pk)
+		}
+		return s.renewFromInvoice(ctx, r.ID, n, pk)
+	case payment.RecurringEventPaymentFailed:
+		// A failed first charge is shown on the checkout page; the order just stays pending.
+		if ev.Initial {
+			return nil
+		}
+		return s.handleRenewalFailure(ctx, r, ev, pk)
+	case payment.RecurringEventCanceled:
+		if r.Status == AutoRenewStatusCanceled {
+			return nil
+		}
+		s.cancelAutoRenewalRecord(ctx, r)
+		s.writeAuditLog(ctx, r.InitialOrderID, "AUTO_RENEW_ENDED", pk, map[string]any{"subscriptionId": ev.SubscriptionID})
+	}
+	return nil
+}
+
+// renewFromInvoice records a paid renewal invoice as a new subscription order and
+// fulfills it, extending the user's subscription. The invoice ID is the order's trade
+// number, so redelivered webhooks resolve to the same order.
+func (s *PaymentService) renewFromInvoice(ctx context.Context, renewalID int64, n *payment.PaymentNotification, pk string) error {
+	ev := n.Recurring
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+	// Lock the renewal so concurrent deliveries of the same invoice create one order.
+	r, err := tx.SubscriptionAutoRenewal.Query().Where(subscriptionautorenewal.IDEQ(renewalID)).ForUpdate().Only(ctx)
+	if err != nil {
+		return fmt.Errorf("lock auto-renewal: %w", err)
+	}
+	existing, err := tx.PaymentOrder.Query().Where(paymentorder.PaymentTradeNoEQ(ev.InvoiceID)).First(ctx)
+	if err == nil {
+		_ = tx.Rollback()
+		return s.alreadyProcessed(ctx, existing)
+	}
+	if !dbent.IsNotFound(err) {
+		return fmt.Errorf("query renewal order: %w", err)
+	}
+	user, err := s.userRepo.GetByID(ctx, r.UserID)
+	if err != nil {
+		return fmt.Errorf("get user: %w", err)
+	}
+	paid := n.Amount
+	if paid <= 0 {
+		paid = r.Amount
+	}
+	now := paymentBeijingNow()
+	order, err := tx.PaymentOrder.Create().
+		SetUserID(r.UserID).
+		SetUserEmail(user.Email).
+		SetUserName(user.Username).
+		SetNillableUserNotes(psNilIfEmpty(user.Notes)).
+		SetAmount(r.Amount).
+		SetPayAmount(paid).
+		SetRechargeCode("").
+		SetOutTradeNo(generateOutTradeNo()).
+		SetPaymentType(r.PaymentType).
+		SetPaymentTradeNo(ev.InvoiceID).
+		SetOrderType(payment.OrderTypeSubscription).
+		SetPlanID(r.PlanID).
+		SetSubscriptionGroupID(r.GroupID).
+		SetSubscriptionDays(r.SubscriptionDays).
+		SetNillableProviderInstanceID(r.ProviderInstanceID).
+		SetStatus(OrderStatusPaid).
+		SetExpiresAt(now).
+		SetPaidAt(now).
+		SetCreatedAt(now).
+		SetUpdatedAt(now).
+		SetClientIP("").
+		SetSrcHost("").
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("create renewal order: %w", err)
+	}
+	code := fmt.Sprintf("PAY-%d-%d", order.ID, now.UnixNano()%100000)
+	if _, err := tx.PaymentOrder.UpdateOneID(order.ID).SetRechargeCode(code).Save(ctx); err != nil {
+		return fmt.Errorf("set recharge code: %w", err)
+	}
+	upd := tx.SubscriptionAutoRenewal.UpdateOneID(r.ID).SetStatus(AutoRenewStatusActive)
+	if !ev.PeriodEnd.IsZero() {
+		upd.SetCurrentPeriodEnd(ev.PeriodEnd)
+	}
+	if _, err := upd.Save(ctx); err != nil {
+		return fmt.Errorf("update auto-renewal: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit renewal order: %w", err)
+	}
+	s.writeAuditLog(ctx, order.ID, "ORDER_CREATED", "system", map[string]any{
+		"autoRenewalId":  r.ID,
+		"subscriptionId": ev.SubscriptionID,
+		"invoiceId":      ev.InvoiceID,
+		"creditedAmount": r.Amount,
+		"payAmount":      paid,
+	})
+	s.writeAuditLog(ctx, order.ID, "ORDER_PAID", pk, map[string]any{"tradeNo": ev.InvoiceID, "paidAmount": paid})
+	return s.executeFulfillment(ctx, order.ID)
+}
+
+// handleRenewalFailure marks the renewal past due and suspends the user subscription
+// once its paid time is running out. The provider keeps retrying the charge; a later
+// successful renewal extends and reactivates the subscription.
+func (s *PaymentService) handleRenewalFailure(ctx context.Context, r *dbent.SubscriptionAutoRenewal, ev *payment.RecurringEvent, pk string) error {
+	if r.Status == AutoRenewStatusCanceled {
+		return nil
+	}
+	s.updateAutoRenewal(ctx, r, AutoRenewStatusPastDue, time.Time{})
+	suspended, err := s.subscriptionSvc.SuspendLapsedSubscription(ctx, r.UserID, r.GroupID, time.Now().Add(autoRenewSuspendWindow))
+	if err != nil {
+		return err
+	}
+	s.writeAuditLog(ctx, r.InitialOrderID, "AUTO_RENEW_PAYMENT_FAILED", pk, map[string]any{
+		"subscriptionId": ev.SubscriptionID,
+		"invoiceId":      ev.InvoiceID,
+		"suspended":      suspended,
+	})
+	return nil
+}
+
+func (s *PaymentService) updateAutoRenewal(ctx context.Context, r *dbent.SubscriptionAutoRenewal, status string, periodEnd time.Time) {
+	u := s.entClient.SubscriptionAutoRenewal.UpdateOneID(r.ID).
+		Where(subscriptionautorenewal.StatusNEQ(AutoRenewStatusCanceled)).
+		SetStatus(status)
+	if !periodEnd.IsZero() {
+		u.SetCurrentPeriodEnd(periodEnd)
+	}
+	if err := u.Exec(ctx); err != nil && !dbent.IsNotFound(err) {
+		slog.Error("update auto-renewal failed", "autoRenewalID", r.ID, "status", status, "error", err)
+	}
+}
+
+func (s *PaymentService) cancelAutoRenewalRecord(ctx context.Context, r *dbent.SubscriptionAutoRenewal) {
+	err := s.entClient.SubscriptionAutoRenewal.UpdateOneID(r.ID).
+		SetStatus(AutoRenewStatusCanceled).
+		SetCanceledAt(paymentBeijingNow()).
+		Exec(ctx)
+	if err != nil {
+		slog.Error("cancel auto-renewal record failed", "autoRenewalID", r.ID, "error", err)
+	}
+}
+
+// ListUserAutoRenewals returns the user's auto-renewals that got past checkout.
+func (s *PaymentService) ListUserAutoRenewals(ctx context.Context, userID int64) ([]*dbent.SubscriptionAutoRenewal, error) {
+	list, err := s.entClient.SubscriptionAutoRenewal.Query().
+		Where(
+			subscriptionautorenewal.UserIDEQ(userID),
+			subscriptionautorenewal.StatusNEQ(AutoRenewStatusIncomplete),
+		).
+		Order(dbent.Desc(subscriptionautorenewal.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query auto-renewals: %w", err)
+	}
+	return list, nil
+}
+
+// CancelAutoRenewal stops future renewals. The subscription time already paid for is kept.
+func (s *PaymentService) CancelAutoRenewal(ctx context.Context, id, userID int64) error {
+	r, err := s.entClient.SubscriptionAutoRenewal.Get(ctx, id)
+	if err != nil {
+		return infraerrors.NotFound("NOT_FOUND", "auto-renewal not found")
+	}
+	if r.UserID != userID {
+		return infraerrors.Forbidden("FORBIDDEN", "no permission for this auto-renewal")
+	}
+	switch r.Status {
+	case AutoRenewStatusCanceled:
+		return nil
+	case AutoRenewStatusIncomplete:
+		return infraerrors.BadRequest("INVALID_STATUS", "auto-renewal has not started yet")
+	}
+	prov, err := s.getInstanceProvider(ctx, r.ProviderInstanceID, r.PaymentType)
+	if err != nil {
+		return infraerrors.ServiceUnavailable("PAYMENT_GATEWAY_ERROR", "payment method is temporarily unavailable")
+	}
+	rp, ok := prov.(payment.RecurringProvider)
+	if !ok {
+		return infraerrors.ServiceUnavailable("PAYMENT_GATEWAY_ERROR", "payment method does not support auto-renew")
+	}
+	if err := rp.CancelRecurring(ctx, r.ProviderSubscriptionID); err != nil {
+		slog.Error("cancel recurring subscription failed", "autoRenewalID", r.ID, "subscriptionID", r.ProviderSubscriptionID, "error", err)
+		return infraerrors.ServiceUnavailable("PAYMENT_GATEWAY_ERROR", fmt.Sprintf("payment gateway error: %s", err.Error()))
+	}
+	s.cancelAutoRenewalRecord(ctx, r)
+	s.writeAuditLog(ctx, r.InitialOrderID, "AUTO_RENEW_CANCELLED", fmt.Sprintf("user:%d", userID), map[string]any{"subscriptionId": r.ProviderSubscriptionID})
+	return nil
+}
@@ -0,0 +1,272 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	dbent "github.com/Wei-Shaw/sub2api/ent"
+	"github.com/Wei-Shaw/sub2api/ent/paymentauditlog"
+	"github.com/Wei-Shaw/sub2api/ent/paymentorder"
+	"github.com/Wei-Shaw/sub2api/ent/paymentproviderinstance"
+	"github.com/Wei-Shaw/sub2api/ent/predicate"
+	"github.com/Wei-Shaw/sub2api/internal/payment"
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+)
+
+// --- On-chain Payments ---
+
+// chainPaymentTypes are payment types settled by polling the chain instead of webhooks.
+var chainPaymentTypes = []string{payment.TypeUSDT}
+
+func isChainPaymentType(paymentType string) bool {
+	for _, t := range chainPaymentTypes {
+		if t == paymentType {
+			return true
+		}
+	}
+	return false
+}
+
+// unsettledChainOrders matches crypto orders whose invoice may still be paid: pending
+// orders and those expired within the grace window (the watcher keeps polling both).
+func unsettledChainOrders() predicate.PaymentOrder {
+	grace := paymentBeijingNow().Add(-paymentGraceMinutes * time.Minute)
+	return paymentorder.And(
+		paymentorder.PaymentTypeIn(chainPaymentTypes...),
+		paymentorder.PaymentTradeNoNEQ(""),
+		paymentorder.Or(
+			paymentorder.StatusEQ(OrderStatusPending),
+			paymentorder.And(
+				paymentorder.StatusEQ(OrderStatusExpired),
+				paymentorder.UpdatedAtGTE(grace),
+			),
+		),
+	)
+}
+
+// createChainPayment issues a crypto invoice for the order. Crypto payments are attributed
+// by amount alone, so issuance is serialized per provider instance (row lock on the
+// instance) and the provider is handed the instance's unsettled invoices to stay clear of.
+// The invoice is recorded on the order before the lock is released.
+func (s *PaymentService) createChainPayment(ctx context.Context, prov payment.Provider, instanceID string, orderID int64, req payment.CreatePaymentRequest) (*payment.CreatePaymentResponse, error) {
+	iid, err := strconv.ParseInt(instanceID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid provider instance id %q: %w", instanceID, err)
+	}
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if _, err := tx.PaymentProviderInstance.Query().Where(paymentproviderinstance.IDEQ(iid)).ForUpdate().Only(ctx); err != nil {
+		return nil, fmt.Errorf("lock provider instance: %w", err)
+	}
+	reserved, err := tx.PaymentOrder.Query().
+		Where(paymentorder.ProviderInstanceIDEQ(instanceID), unsettledChainOrders()).
+		Select(paymentorder.FieldPaymentTradeNo).
+		Strings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query unsettled invoices: %w", err)
+	}
+	req.ReservedInvoices = reserved
+	pr, err := prov.CreatePayment(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := tx.PaymentOrder.UpdateOneID(orderID).
+		SetPaymentTradeNo(pr.TradeNo).
+		SetProviderInstanceID(instanceID).
+		SetUpdatedAt(paymentBeijingNow()).
+		Save(ctx); err != nil {
+		return nil, fmt.Errorf("record invoice: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit invoice: %w", err)
+	}
+	return pr, nil
+}
+
+// PollChainPayments checks pending (and just-expired) crypto orders against the chain
+// and fulfills those whose matched transfer reached the required confirmations.
+// Crypto providers have no webhooks, so this watcher is their notification path.
+func (s *PaymentService) PollChainPayments(ctx context.Context) (int, error) {
+	orders, err := s.entClient.PaymentOrder.Query().Where(unsettledChainOrders()).All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("query chain orders: %w", err)
+	}
+	n := 0
+	for _, o := range orders {
+		if ctx.Err() != nil {
+			break
+		}
+		if s.checkPaid(ctx, o) == checkPaidResultAlreadyPaid {
+			n++
+		}
+	}
+	return n, nil
+}
+
+// settleChainPayment maps a crypto provider's query result onto the order and returns
+// the checkPaid outcome. A transaction can pay for a single order only: the paid order
+// records the transaction hash as its trade number, which is unique among crypto orders
+// (partial unique index), so a second order matching the same hash fails to claim it
+// atomically and is refused.
+func (s *PaymentService) settleChainPayment(ctx context.Context, o *dbent.PaymentOrder, pk string, resp *payment.QueryOrderResponse) string {
+	chain := resp.Chain
+	switch resp.Status {
+	case payment.ProviderStatusConfirming:
+		return checkPaidResultConfirming
+	case payment.ProviderStatusPaid:
+	default:
+		// Once the invoice window has closed without a full-amount transfer, surface the
+		// transfers of other amounts for manual settlement.
+		if o.Status == OrderStatusExpired {
+			s.recordUnmatchedChainTransfers(ctx, o, pk, resp.Unmatched)
+		}
+		return ""
+	}
+
+	// Amount is left at zero: the invoice is in tokens, so the order's pay amount is credited.
+	n := &payment.PaymentNotification{TradeNo: chain.TxHash, OrderID: o.OutTradeNo, Status: payment.ProviderStatusSuccess}
+	if err := s.HandlePaymentNotification(ctx, n, pk); err != nil {
+		if errors.Is(err, errPaymentTradeNoClaimed) {
+			s.writeChainAuditLogOnce(ctx, o.ID, "PAYMENT_TX_CLAIMED", pk, chain)
+			return ""
+		}
+		slog.Error("fulfillment failed for chain payment", "orderID", o.ID, "txHash", chain.TxHash, "error", err)
+	}
+	return checkPaidResultAlreadyPaid
+}
+
+// writeChainAuditLogOnce records an on-chain anomaly for manual follow-up (e.g. a transfer
+// already claimed by another order), at most once per order and action since the watcher
+// re-checks the same transfer on every pass.
+func (s *PaymentService) writeChainAuditLogOnce(ctx context.Context, oid int64, action, pk string, chain *payment.ChainPayment) {
+	exists, err := s.entClient.PaymentAuditLog.Query().
+		Where(paymentauditlog.OrderIDEQ(strconv.FormatInt(oid, 10)), paymentauditlog.ActionEQ(action)).
+		Exist(ctx)
+	if err != nil || exists {
+		return
+	}
+	s.writeAuditLog(ctx, oid, action, pk, map[string]any{
+		"txHash":        chain.TxHash,
+		"expected":      chain.Expected,
+		"received":      chain.Received,
+		"confirmations": chain.Confirmations,
+	})
+}
+
+// chainAmountMismatchAction marks an on-chain transfer inside the invoice window whose
+// amount did not match the invoice; an admin may settle the order with it.
+const chainAmountMismatchAction = "PAYMENT_AMOUNT_MISMATCH"
+
+// recordUnmatchedChainTransfers writes a mismatch audit log per transfer, at most once per
+// order and transaction, skipping transfers that already paid an order.
+func (s *PaymentService) recordUnmatchedChainTransfers(ctx context.Context, o *dbent.PaymentOrder, pk string, transfers []payment.ChainPayment) {
+	for i := range transfers {
+		t := &transfers[i]
+		if t.TxHash == "" {
+			continue
+		}
+		claimed, err := s.entClient.PaymentOrder.Query().
+			Where(paymentorder.PaymentTypeIn(chainPaymentTypes...), paymentorder.PaymentTradeNoEQ(t.TxHash)).
+			Exist(ctx)
+		if err != nil || claimed {
+			continue
+		}
+		if _, ok, err := s.chainMismatchDetail(ctx, o.ID, t.TxHash); err != nil || ok {
+			continue
+		}
+		s.writeAuditLog(ctx, o.ID, chainAmountMismatchAction, pk, map[string]any{
+			"txHash":        t.TxHash,
+			"expected":      t.Expected,
+			"received":      t.Received,
+			"confirmations": t.Confirmations,
+		})
+	}
+}
+
+// chainMismatchDetail returns the mismatch audit detail recorded for the order and transaction.
+func (s *PaymentService) chainMismatchDetail(ctx context.Context, oid int64, txHash string) (map[string]any, bool, error) {
+	logs, err := s.entClient.PaymentAuditLog.Query().
+		Where(paymentauditlog.OrderIDEQ(strconv.FormatInt(oid, 10)), paymentauditlog.ActionEQ(chainAmountMismatchAction)).
+		All(ctx)
+	if err != nil {
+		return nil, false, fmt.Errorf("query mismatch audit logs: %w", err)
+	}
+	for _, l := range logs {
+		var detail map[string]any
+		if json.Unmarshal([]byte(l.Detail), &detail) != nil {
+			continue
+		}
+		if h, _ := detail["txHash"].(string); h == txHash {
+			return detail, true, nil
+		}
+	}
+	return nil, false, nil
+}
+
+// SettleChainTransfer lets an admin accept a transfer recorded as an amount mismatch for
+// the order as its payment. The order is credited its own amount whatever the transfer
+// carried, so a short payment should only be settled once the difference is resolved.
+// The transaction hash becomes the order's trade number, so it cannot pay a second order.
+func (s *PaymentService) SettleChainTransfer(ctx context.Context, oid int64, txHash string) error {
+	txHash = strings.TrimSpace(txHash)
+	if txHash == "" {
+		return infraerrors.BadRequest("INVALID_TX_HASH", "tx_hash is required")
+	}
+	o, err := s.entClient.PaymentOrder.Get(ctx, oid)
+	if err != nil {
+		return infraerrors.NotFound("NOT_FOUND", "order not found")
+	}
+	if !isChainPaymentType(o.PaymentType) {
+		return infraerrors.BadRequest("INVALID_PAYMENT_TYPE", "only on-chain orders can be settled with a transfer")
+	}
+	unpaid := []string{OrderStatusPending, OrderStatusExpired, OrderStatusCancelled}
+	if !slices.Contains(unpaid, o.Status) {
+		return infraerrors.BadRequest("INVALID_STATUS", "only unpaid orders can be settled")
+	}
+	detail, ok, err := s.chainMismatchDetail(ctx, oid, txHash)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return infraerrors.BadRequest("TRANSFER_NOT_RECORDED", "transfer is not recorded as a mismatched payment for this order")
+	}
+
+	now := paymentBeijingNow()
+	c, err := s.entClient.PaymentOrder.Update().
+		Where(paymentorder.IDEQ(oid), paymentorder.StatusIn(unpaid...)).
+		SetStatus(OrderStatusPaid).
+		SetPaymentTradeNo(txHash).
+		SetPaidAt(now).
+		SetUpdatedAt(now).
+		ClearFailedAt().
+		ClearFailedReason().
+		Save(ctx)
+	if err != nil {
+		if dbent.IsConstraintError(err) {
+			return infraerrors.Conflict("PAYMENT_TX_CLAIMED", "transfer already paid another order")
+		}
+		return fmt.Errorf("settle chain transfer: %w", err)
+	}
+	if c == 0 {
+		return infraerrors.Conflict("CONFLICT", "order status changed, please refresh")
+	}
+	s.writeAuditLog(ctx, oid, "ORDER_MANUAL_SETTLED", "admin", map[string]any{
+		"previous_status": o.Status,
+		"txHash":          txHash,
+		"expected":        detail["expected"],
+		"received":        detail["received"],
+		"paidAmount":      o.PayAmount,
+	})
+	return s.executeFulfillment(ctx, oid)
+}
@@ -0,0 +1,178 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	dbent "github.com/Wei-Shaw/sub2api/ent"
+	"github.com/Wei-Shaw/sub2api/ent/enttest"
+	"github.com/Wei-Shaw/sub2api/ent/paymentauditlog"
+	"github.com/Wei-Shaw/sub2api/ent/paymentorder"
+	"github.com/Wei-Shaw/sub2api/internal/payment"
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	_ "modernc.org/sqlite"
+)
+
+func newPaymentChainTestClient(t *testing.T) *dbent.Client {
+	t.Helper()
+	db, err := sql.Open("sqlite", "file:"+t.Name()+"?mode=memory&cache=shared")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+	_, err = db.Exec("PRAGMA foreign_keys = ON")
+	require.NoError(t, err)
+
+	client := enttest.NewClient(t, enttest.WithOptions(dbent.Driver(entsql.OpenDB(dialect.SQLite, db))))
+	t.Cleanup(func() { _ = client.Close() })
+
+	_, err = client.User.Create().
+		SetEmail("u@example.com").
+		SetPasswordHash("test-password-hash").
+		SetRole(RoleUser).
+		SetStatus(StatusActive).
+		Save(context.Background())
+	require.NoError(t, err)
+	return client
+}
+
+func createChainTestOrder(t *testing.T, client *dbent.Client, outTradeNo, tradeNo, status string, updatedAt time.Time) *dbent.PaymentOrder {
+	t.Helper()
+	now := paymentBeijingNow()
+	u, err := client.User.Query().First(context.Background())
+	require.NoError(t, err)
+	o, err := client.PaymentOrder.Create().
+		SetUserID(u.ID).
+		SetUserEmail("u@example.com").
+		SetUserName("u").
+		SetAmount(72).
+		SetPayAmount(72).
+		SetRechargeCode("PAY-" + outTradeNo).
+		SetOutTradeNo(outTradeNo).
+		SetPaymentType(payment.TypeUSDT).
+		SetPaymentTradeNo(tradeNo).
+		SetProviderInstanceID("1").
+		SetOrderType(payment.OrderTypeBalance).
+		SetStatus(status).
+		SetExpiresAt(now.Add(30 * time.Minute)).
+		SetCreatedAt(now).
+		SetUpdatedAt(updatedAt).
+		SetClientIP("127.0.0.1").
+		SetSrcHost("localhost").
+		Save(context.Background())
+	require.NoError(t, err)
+	return o
+}
+
+func TestSettleChainPayment_RefusesTransferClaimedByAnotherOrder(t *testing.T) {
+	client := newPaymentChainTestClient(t)
+	ctx := context.Background()
+	now := paymentBeijingNow()
+	createChainTestOrder(t, client, "sub2_1", "0xpaid", OrderStatusCompleted, now)
+	second := createChainTestOrder(t, client, "sub2_2", "usdt:sub2_2:10000001:1", OrderStatusPending, now)
+
+	svc := &PaymentService{entClient: client}
+	resp := &payment.QueryOrderResponse{
+		Status: payment.ProviderStatusPaid,
+		Chain:  &payment.ChainPayment{TxHash: "0xpaid", Confirmations: 20, Required: 19, Expected: "10.000001", Received: "10.000001"},
+	}
+	require.Empty(t, svc.settleChainPayment(ctx, second, payment.TypeUSDT, resp))
+
+	reloaded, err := client.PaymentOrder.Get(ctx, second.ID)
+	require.NoError(t, err)
+	require.Equal(t, OrderStatusPending, reloaded.Status)
+	require.Equal(t, "usdt:sub2_2:10000001:1", reloaded.PaymentTradeNo)
+	claimed, err := client.PaymentAuditLog.Query().Where(paymentauditlog.ActionEQ("PAYMENT_TX_CLAIMED")).Count(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, claimed)
+
+	// 认领失败重复出现时不重复写审计日志
+	require.Empty(t, svc.settleChainPayment(ctx, second, payment.TypeUSDT, resp))
+	claimed, err = client.PaymentAuditLog.Query().Where(paymentauditlog.ActionEQ("PAYMENT_TX_CLAIMED")).Count(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, claimed)
+}
+
+func TestUnsettledChainOrders_ReservesPollableInvoices(t *testing.T) {
+	client := newPaymentChainTestClient(t)
+	ctx := context.Background()
+	now := paymentBeijingNow()
+	createChainTestOrder(t, client, "sub2_1", "usdt:sub2_1:10000001:1", OrderStatusPending, now)
+	createChainTestOrder(t, client, "sub2_2", "usdt:sub2_2:10000002:1", OrderStatusExpired, now.Add(-time.Minute))
+	createChainTestOrder(t, client, "sub2_3", "usdt:sub2_3:10000003:1", OrderStatusExpired, now.Add(-(paymentGraceMinutes+5)*time.Minute))
+	createChainTestOrder(t, client, "sub2_4", "0xpaid", OrderStatusCompleted, now)
+	createChainTestOrder(t, client, "sub2_5", "", OrderStatusPending, now)
+
+	reserved, err := client.PaymentOrder.Query().
+		Where(unsettledChainOrders()).
+		Select(paymentorder.FieldPaymentTradeNo).
+		Strings(ctx)
+	require.NoError(t, err)
+	sort.Strings(reserved)
+	require.Equal(t, []string{"usdt:sub2_1:10000001:1", "usdt:sub2_2:10000002:1"}, reserved)
+}
+
+type chainSettleRedeemRepoStub struct {
+	redeemRepoStub
+}
+
+func (s *chainSettleRedeemRepoStub) GetByCode(ctx context.Context, code string) (*RedeemCode, error) {
+	return &RedeemCode{Code: code, Type: RedeemTypeBalance, Status: StatusUsed}, nil
+}
+
+func TestSettleChainPayment_RecordsMismatchedTransfersForManualSettlement(t *testing.T) {
+	client := newPaymentChainTestClient(t)
+	ctx := context.Background()
+	now := paymentBeijingNow()
+	createChainTestOrder(t, client, "sub2_1", "0xpaid", OrderStatusCompleted, now)
+	pending := createChainTestOrder(t, client, "sub2_2", "usdt:sub2_2:10000001:1", OrderStatusPending, now)
+	expired := createChainTestOrder(t, client, "sub2_3", "usdt:sub2_3:10000002:1", OrderStatusExpired, now)
+
+	svc := &PaymentService{entClient: client, redeemService: &RedeemService{redeemRepo: &chainSettleRedeemRepoStub{}}}
+	resp := &payment.QueryOrderResponse{
+		Status: payment.ProviderStatusPending,
+		Unmatched: []payment.ChainPayment{
+			{TxHash: "0xshort", Confirmations: 20, Required: 19, Expected: "10.000002", Received: "5.000002"},
+			{TxHash: "0xpaid", Confirmations: 30, Required: 19, Expected: "10.000002", Received: "10.000001"},
+		},
+	}
+	mismatches := func() []*dbent.PaymentAuditLog {
+		logs, err := client.PaymentAuditLog.Query().Where(paymentauditlog.ActionEQ(chainAmountMismatchAction)).All(ctx)
+		require.NoError(t, err)
+		return logs
+	}
+
+	// 支付窗口未关闭时不记录
+	require.Empty(t, svc.settleChainPayment(ctx, pending, payment.TypeUSDT, resp))
+	require.Empty(t, mismatches())
+
+	// 订单过期后记录一次，已支付其他订单的转账不记录
+	require.Empty(t, svc.settleChainPayment(ctx, expired, payment.TypeUSDT, resp))
+	require.Empty(t, svc.settleChainPayment(ctx, expired, payment.TypeUSDT, resp))
+	logs := mismatches()
+	require.Len(t, logs, 1)
+	require.Contains(t, logs[0].Detail, `"txHash":"0xshort"`)
+	require.Contains(t, logs[0].Detail, `"received":"5.000002"`)
+
+	require.Equal(t, "TRANSFER_NOT_RECORDED", infraerrors.Reason(svc.SettleChainTransfer(ctx, expired.ID, "0xpaid")))
+	require.Equal(t, "TRANSFER_NOT_RECORDED", infraerrors.Reason(svc.SettleChainTransfer(ctx, pending.ID, "0xshort")))
+
+	require.NoError(t, svc.SettleChainTransfer(ctx, expired.ID, "0xshort"))
+	reloaded, err := client.PaymentOrder.Get(ctx, expired.ID)
+	require.NoError(t, err)
+	require.Equal(t, OrderStatusCompleted, reloaded.Status)
+	require.Equal(t, "0xshort", reloaded.PaymentTradeNo)
+	settled, err := client.PaymentAuditLog.Query().Where(paymentauditlog.ActionEQ("ORDER_MANUAL_SETTLED")).Count(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, settled)
+
+	// 已结算的订单不可再次结算
+	require.Error(t, svc.SettleChainTransfer(ctx, expired.ID, "0xshort"))
+}
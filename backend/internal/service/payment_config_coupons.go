@@ -0,0 +1,297 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	dbent "github.com/Wei-Shaw/sub2api/ent"
+	"github.com/Wei-Shaw/sub2api/ent/paymentcoupon"
+	"github.com/Wei-Shaw/sub2api/ent/paymentorder"
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+)
+
+type CreateCouponRequest struct {
+	Code          string     `json:"code"`
+	Name          string     `json:"name"`
+	DiscountType  string     `json:"discount_type"`
+	DiscountValue float64    `json:"discount_value"`
+	MinAmount     float64    `json:"min_amount"`
+	AppliesTo     string     `json:"applies_to"`
+	PlanIDs       []int64    `json:"plan_ids"`
+	MaxUses       int        `json:"max_uses"`
+	PerUserLimit  *int       `json:"per_user_limit"`
+	Status        string     `json:"status"`
+	StartsAt      *time.Time `json:"starts_at"`
+	ExpiresAt     *time.Time `json:"expires_at"`
+	Notes         string     `json:"notes"`
+}
+
+type UpdateCouponRequest struct {
+	Name          *string    `json:"name"`
+	DiscountType  *string    `json:"discount_type"`
+	DiscountValue *float64   `json:"discount_value"`
+	MinAmount     *float64   `json:"min_amount"`
+	AppliesTo     *string    `json:"applies_to"`
+	PlanIDs       *[]int64   `json:"plan_ids"`
+	MaxUses       *int       `json:"max_uses"`
+	PerUserLimit  *int       `json:"per_user_limit"`
+	Status        *string    `json:"status"`
+	StartsAt      *time.Time `json:"starts_at"`
+	ExpiresAt     *time.Time `json:"expires_at"`
+	ClearStartsAt bool       `json:"clear_starts_at"`
+	ClearExpires  bool       `json:"clear_expires_at"`
+	Notes         *string    `json:"notes"`
+}
+
+// CouponWithUsage is a checkout coupon with the number of orders currently using it.
+type CouponWithUsage struct {
+	*dbent.PaymentCoupon
+	UsedCount int `json:"used_count"`
+}
+
+// validateCouponDiscount checks a discount type/value pair.
+func validateCouponDiscount(discountType string, value float64) error {
+	switch discountType {
+	case CouponDiscountPercent:
+		if value <= 0 || value > 100 {
+			return infraerrors.BadRequest("COUPON_DISCOUNT_INVALID", "percent discount must be in (0, 100]")
+		}
+	case CouponDiscountFixed:
+		if value <= 0 {
+			return infraerrors.BadRequest("COUPON_DISCOUNT_INVALID", "fixed discount must be > 0")
+		}
+	default:
+		return infraerrors.BadRequest("COUPON_DISCOUNT_TYPE_INVALID", "discount type must be percent or fixed")
+	}
+	return nil
+}
+
+func validateCouponScope(appliesTo string) error {
+	switch appliesTo {
+	case CouponAppliesAll, CouponAppliesBalance, CouponAppliesSubscription:
+		return nil
+	}
+	return infraerrors.BadRequest("COUPON_SCOPE_INVALID", "applies_to must be all, balance or subscription")
+}
+
+func validateCouponStatus(status string) error {
+	if status != CouponStatusActive && status != CouponStatusDisabled {
+		return infraerrors.BadRequest("COUPON_STATUS_INVALID", "status must be active or disabled")
+	}
+	return nil
+}
+
+func validateCouponLimits(minAmount float64, maxUses, perUserLimit int) error {
+	if minAmount < 0 {
+		return infraerrors.BadRequest("COUPON_MIN_AMOUNT_INVALID", "min amount must be >= 0")
+	}
+	if maxUses < 0 || perUserLimit < 0 {
+		return infraerrors.BadRequest("COUPON_LIMIT_INVALID", "usage limits must be >= 0")
+	}
+	return nil
+}
+
+func validateCouponWindow(startsAt, expiresAt *time.Time) error {
+	if startsAt != nil && expiresAt != nil && !expiresAt.After(*startsAt) {
+		return infraerrors.BadRequest("COUPON_WINDOW_INVALID", "expires_at must be after starts_at")
+	}
+	return nil
+}
+
+// --- Coupon CRUD ---
+
+// ListCoupons returns all checkout coupons with their current usage counts.
+func (s *PaymentConfigService) ListCoupons(ctx context.Context) ([]CouponWithUsage, error) {
+	coupons, err := s.entClient.PaymentCoupon.Query().Order(dbent.Desc(paymentcoupon.FieldCreatedAt)).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list coupons: %w", err)
+	}
+	used := make(map[int64]int, len(coupons))
+	if len(coupons) > 0 {
+		ids := make([]int64, 0, len(coupons))
+		for _, c := range coupons {
+			ids = append(ids, c.ID)
+		}
+		orders, err := s.entClient.PaymentOrder.Query().
+			Where(paymentorder.CouponIDIn(ids...), couponUsedPredicate()).
+			Select(paymentorder.FieldCouponID).
+			All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("count coupon usage: %w", err)
+		}
+		for _, o := range orders {
+			if o.CouponID != nil {
+				used[*o.CouponID]++
+			}
+		}
+	}
+	out := make([]CouponWithUsage, 0, len(coupons))
+	for _, c := range coupons {
+		out = append(out, CouponWithUsage{PaymentCoupon: c, UsedCount: used[c.ID]})
+	}
+	return out, nil
+}
+
+func (s *PaymentConfigService) CreateCoupon(ctx context.Context, req CreateCouponRequest) (*dbent.PaymentCoupon, error) {
+	code := normalizeCouponCode(req.Code)
+	if code == "" || len(code) > 32 {
+		return nil, infraerrors.BadRequest("COUPON_CODE_INVALID", "coupon code must be 1-32 characters")
+	}
+	if req.AppliesTo == "" {
+		req.AppliesTo = CouponAppliesAll
+	}
+	if req.Status == "" {
+		req.Status = CouponStatusActive
+	}
+	perUserLimit := 1
+	if req.PerUserLimit != nil {
+		perUserLimit = *req.PerUserLimit
+	}
+	if err := validateCouponDiscount(req.DiscountType, req.DiscountValue); err != nil {
+		return nil, err
+	}
+	if err := validateCouponScope(req.AppliesTo); err != nil {
+		return nil, err
+	}
+	if err := validateCouponStatus(req.Status); err != nil {
+		return nil, err
+	}
+	if err := validateCouponLimits(req.MinAmount, req.MaxUses, perUserLimit); err != nil {
+		return nil, err
+	}
+	if err := validateCouponWindow(req.StartsAt, req.ExpiresAt); err != nil {
+		return nil, err
+	}
+	planIDs := req.PlanIDs
+	if planIDs == nil {
+		planIDs = []int64{}
+	}
+	c, err := s.entClient.PaymentCoupon.Create().
+		SetCode(code).SetName(req.Name).
+		SetDiscountType(req.DiscountType).SetDiscountValue(req.DiscountValue).
+		SetMinAmount(req.MinAmount).SetAppliesTo(req.AppliesTo).SetPlanIds(planIDs).
+		SetMaxUses(req.MaxUses).SetPerUserLimit(perUserLimit).SetStatus(req.Status).
+		SetNillableStartsAt(req.StartsAt).SetNillableExpiresAt(req.ExpiresAt).
+		SetNillableNotes(psNilIfEmpty(req.Notes)).
+		Save(ctx)
+	if err != nil {
+		if dbent.IsConstraintError(err) {
+			return nil, infraerrors.Conflict("COUPON_CODE_EXISTS", "coupon code already exists")
+		}
+		return nil, fmt.Errorf("create coupon: %w", err)
+	}
+	return c, nil
+}
+
+// UpdateCoupon updates a checkout coupon by ID (patch semantics). The code is immutable
+// because orders record it.
+// NOTE: This function exceeds 30 lines due to per-field nil-check patch update boilerplate.
+func (s *PaymentConfigService) UpdateCoupon(ctx context.Context, id int64, req UpdateCouponRequest) (*dbent.PaymentCoupon, error) {
+	cur, err := s.entClient.PaymentCoupon.Get(ctx, id)
+	if err != nil {
+		return nil, infraerrors.NotFound("COUPON_NOT_FOUND", "coupon not found")
+	}
+	discountType, discountValue := cur.DiscountType, cur.DiscountValue
+	if req.DiscountType != nil {
+		discountType = *req.DiscountType
+	}
+	if req.DiscountValue != nil {
+		discountValue = *req.DiscountValue
+	}
+	if err := validateCouponDiscount(discountType, discountValue); err != nil {
+		return nil, err
+	}
+	if req.AppliesTo != nil {
+		if err := validateCouponScope(*req.AppliesTo); err != nil {
+			return nil, err
+		}
+	}
+	if req.Status != nil {
+		if err := validateCouponStatus(*req.Status); err != nil {
+			return nil, err
+		}
+	}
+	minAmount, maxUses, perUserLimit := cur.MinAmount, cur.MaxUses, cur.PerUserLimit
+	if req.MinAmount != nil {
+		minAmount = *req.MinAmount
+	}
+	if req.MaxUses != nil {
+		maxUses = *req.MaxUses
+	}
+	if req.PerUserLimit != nil {
+		perUserLimit = *req.PerUserLimit
+	}
+	if err := validateCouponLimits(minAmount, maxUses, perUserLimit); err != nil {
+		return nil, err
+	}
+	startsAt, expiresAt := cur.StartsAt, cur.ExpiresAt
+	if req.ClearStartsAt {
+		startsAt = nil
+	} else if req.StartsAt != nil {
+		startsAt = req.StartsAt
+	}
+	if req.ClearExpires {
+		expiresAt = nil
+	} else if req.ExpiresAt != nil {
+		expiresAt = req.ExpiresAt
+	}
+	if err := validateCouponWindow(startsAt, expiresAt); err != nil {
+		return nil, err
+	}
+
+	u := s.entClient.PaymentCoupon.UpdateOneID(id).
+		SetDiscountType(discountType).SetDiscountValue(discountValue).
+		SetMinAmount(minAmount).SetMaxUses(maxUses).SetPerUserLimit(perUserLimit)
+	if req.Name != nil {
+		u.SetName(*req.Name)
+	}
+	if req.AppliesTo != nil {
+		u.SetAppliesTo(*req.AppliesTo)
+	}
+	if req.PlanIDs != nil {
+		u.SetPlanIds(*req.PlanIDs)
+	}
+	if req.Status != nil {
+		u.SetStatus(*req.Status)
+	}
+	if startsAt != nil {
+		u.SetStartsAt(*startsAt)
+	} else {
+		u.ClearStartsAt()
+	}
+	if expiresAt != nil {
+		u.SetExpiresAt(*expiresAt)
+	} else {
+		u.ClearExpiresAt()
+	}
+	if req.Notes != nil {
+		if *req.Notes == "" {
+			u.ClearNotes()
+		} else {
+			u.SetNotes(*req.Notes)
+		}
+	}
+	return u.Save(ctx)
+}
+
+// DeleteCoupon deletes a checkout coupon. Orders keep the recorded code and discount.
+func (s *PaymentConfigService) DeleteCoupon(ctx context.Context, id int64) error {
+	pending, err := s.entClient.PaymentOrder.Query().
+		Where(paymentorder.CouponIDEQ(id), paymentorder.StatusIn(pendingOrderStatuses...)).
+		Count(ctx)
+	if err != nil {
+		return fmt.Errorf("check pending orders: %w", err)
+	}
+	if pending > 0 {
+		return infraerrors.Conflict("PENDING_ORDERS",
+			fmt.Sprintf("this coupon has %d in-progress orders and cannot be deleted — disable it instead", pending))
+	}
+	if err := s.entClient.PaymentCoupon.DeleteOneID(id).Exec(ctx); err != nil {
+		if dbent.IsNotFound(err) {
+			return infraerrors.NotFound("COUPON_NOT_FOUND", "coupon not found")
+		}
+		return fmt.Errorf("delete coupon: %w", err)
+	}
+	return nil
+}
@@ -34,6 +34,10 @@ const (
 	SettingCancelWindowSize    = "CANCEL_RATE_LIMIT_WINDOW"
 	SettingCancelWindowUnit    = "CANCEL_RATE_LIMIT_UNIT"
 	SettingCancelWindowMode    = "CANCEL_RATE_LIMIT_WINDOW_MODE"
+	SettingInvoiceSellerName   = "INVOICE_SELLER_NAME"
+	SettingInvoiceSellerTaxID  = "INVOICE_SELLER_TAX_ID"
+	SettingInvoiceSellerAddr   = "INVOICE_SELLER_ADDRESS"
+	SettingInvoiceAutoEmail    = "INVOICE_AUTO_EMAIL"
 )
 
 // Default values for payment configuration settings.
@@ -67,6 +71,12 @@ type PaymentConfig struct {
 	CancelRateLimitWindow  int    `json:"cancel_rate_limit_window"`
 	CancelRateLimitUnit    string `json:"cancel_rate_limit_unit"`
 	CancelRateLimitMode    string `json:"cancel_rate_limit_window_mode"`
+
+	// Invoice seller details; an empty seller name falls back to the site name.
+	InvoiceSellerName    string `json:"invoice_seller_name"`
+	InvoiceSellerTaxID   string `json:"invoice_seller_tax_id"`
+	InvoiceSellerAddress string `json:"invoice_seller_address"`
+	InvoiceAutoEmail     bool   `json:"invoice_auto_email"`
 }
 
 func defaultPaymentConfig() *PaymentConfig {
@@ -118,6 +128,12 @@ type UpdatePaymentConfigRequest struct {
 	CancelRateLimitWindow  *int    `json:"cancel_rate_limit_window"`
 	CancelRateLimitUnit    *string `json:"cancel_rate_limit_unit"`
 	CancelRateLimitMode    *string `json:"cancel_rate_limit_window_mode"`
+
+	// Invoice settings
+	InvoiceSellerName    *string `json:"invoice_seller_name"`
+	InvoiceSellerTaxID   *string `json:"invoice_seller_tax_id"`
+	InvoiceSellerAddress *string `json:"invoice_seller_address"`
+	InvoiceAutoEmail     *bool   `json:"invoice_auto_email"`
 }
 
 // MethodLimits holds per-payment-type limits.
@@ -173,6 +189,7 @@ type CreatePlanRequest struct {
 	ProductName   string   `json:"product_name"`
 	ForSale       bool     `json:"for_sale"`
 	SortOrder     int      `json:"sort_order"`
+	StripePriceID string   `json:"stripe_price_id"`
 }
 
 type UpdatePlanRequest struct {
@@ -187,6 +204,7 @@ type UpdatePlanRequest struct {
 	ProductName   *string  `json:"product_name"`
 	ForSale       *bool    `json:"for_sale"`
 	SortOrder     *int     `json:"sort_order"`
+	StripePriceID *string  `json:"stripe_price_id"`
 }
 
 // PaymentConfigService manages payment configuration and CRUD for
@@ -221,6 +239,7 @@ func (s *PaymentConfigService) GetPaymentConfig(ctx context.Context) (*PaymentCo
 		SettingHelpImageURL, SettingHelpText,
 		SettingCancelRateLimitOn, SettingCancelRateLimitMax,
 		SettingCancelWindowSize, SettingCancelWindowUnit, SettingCancelWindowMode,
+		SettingInvoiceSellerName, SettingInvoiceSellerTaxID, SettingInvoiceSellerAddr, SettingInvoiceAutoEmail,
 	}
 	vals, err := s.settingRepo.GetMultiple(ctx, keys)
 	if err != nil {
@@ -255,6 +274,10 @@ func (s *PaymentConfigService) parsePaymentConfig(vals map[string]string) *Payme
 	cfg.CancelRateLimitWindow = pcParseInt(vals[SettingCancelWindowSize], cfg.CancelRateLimitWindow)
 	cfg.CancelRateLimitUnit = vals[SettingCancelWindowUnit]
 	cfg.CancelRateLimitMode = vals[SettingCancelWindowMode]
+	cfg.InvoiceSellerName = vals[SettingInvoiceSellerName]
+	cfg.InvoiceSellerTaxID = vals[SettingInvoiceSellerTaxID]
+	cfg.InvoiceSellerAddress = vals[SettingInvoiceSellerAddr]
+	cfg.InvoiceAutoEmail = vals[SettingInvoiceAutoEmail] == "true"
 	if raw := vals[SettingEnabledPaymentTypes]; raw != "" {
 		cfg.EnabledTypes = cfg.EnabledTypes[:0]
 		for _, t := range strings.Split(raw, ",") {
@@ -324,6 +347,10 @@ func (s *PaymentConfigService) UpdatePaymentConfig(ctx context.Context, req Upda
 		SettingCancelWindowSize:    formatPositiveInt(req.CancelRateLimitWindow),
 		SettingCancelWindowUnit:    derefStr(req.CancelRateLimitUnit),
 		SettingCancelWindowMode:    derefStr(req.CancelRateLimitMode),
+		SettingInvoiceSellerName:   derefStr(req.InvoiceSellerName),
+		SettingInvoiceSellerTaxID:  derefStr(req.InvoiceSellerTaxID),
+		SettingInvoiceSellerAddr:   derefStr(req.InvoiceSellerAddress),
+		SettingInvoiceAutoEmail:    formatBoolOrEmpty(req.InvoiceAutoEmail),
 	}
 	if req.EnabledTypes != nil {
 		m[SettingEnabledPaymentTypes] = strings.Join(req.EnabledTypes, ",")
@@ -0,0 +1,232 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	dbent "github.com/Wei-Shaw/sub2api/ent"
+	"github.com/Wei-Shaw/sub2api/ent/paymentcoupon"
+	"github.com/Wei-Shaw/sub2api/ent/paymentorder"
+	"github.com/Wei-Shaw/sub2api/ent/predicate"
+	"github.com/Wei-Shaw/sub2api/internal/payment"
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+// Checkout coupon discount types.
+const (
+	CouponDiscountPercent = "percent"
+	CouponDiscountFixed   = "fixed"
+)
+
+// Checkout coupon scopes. Balance and subscription match the order types they apply to.
+const (
+	CouponAppliesAll          = "all"
+	CouponAppliesBalance      = payment.OrderTypeBalance
+	CouponAppliesSubscription = payment.OrderTypeSubscription
+)
+
+// Checkout coupon statuses.
+const (
+	CouponStatusActive   = "active"
+	CouponStatusDisabled = "disabled"
+)
+
+// couponUsedStatuses are the order statuses that consume a coupon use. Pending orders
+// reserve their use until they expire or are cancelled; fully refunded orders give it back.
+var couponUsedStatuses = []string{
+	OrderStatusPending, OrderStatusPaid, OrderStatusRecharging, OrderStatusCompleted,
+	OrderStatusRefundRequested, OrderStatusRefunding, OrderStatusPartiallyRefunded, OrderStatusRefundFailed,
+}
+
+// appliedCoupon is the coupon resolved for an order being created.
+type appliedCoupon struct {
+	ID       int64
+	Code     string
+	Name     string
+	Discount float64
+}
+
+// CouponPreview is the discount a coupon would give an order, shown at checkout.
+type CouponPreview struct {
+	Code           string  `json:"code"`
+	Name           string  `json:"name"`
+	OriginalAmount float64 `json:"original_amount"`
+	DiscountAmount float64 `json:"discount_amount"`
+	PayAmount      float64 `json:"pay_amount"`
+}
+
+func normalizeCouponCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// couponUsedPredicate matches orders that consume a use of a coupon. Orders that failed
+// before payment are excluded, but orders that were paid and failed fulfillment still count.
+func couponUsedPredicate() predicate.PaymentOrder {
+	return paymentorder.Or(
+		paymentorder.StatusIn(couponUsedStatuses...),
+		paymentorder.And(paymentorder.StatusEQ(OrderStatusFailed), paymentorder.PaidAtNotNil()),
+	)
+}
+
+// couponBaseAmount is the pre-fee amount a coupon discounts: the plan price for
+// subscriptions and the entered payment amount (before the recharge multiplier) for balance.
+func couponBaseAmount(req CreateOrderRequest, plan *dbent.SubscriptionPlan) float64 {
+	if plan != nil {
+		return plan.Price
+	}
+	return req.Amount
+}
+
+// calculateCouponDiscount returns the discount of a coupon on base, rounded to cents.
+// The discount never exceeds base minus one cent so every order still goes through a gateway.
+func calculateCouponDiscount(c *dbent.PaymentCoupon, base float64) float64 {
+	b := decimal.NewFromFloat(base)
+	var d decimal.Decimal
+	switch c.DiscountType {
+	case CouponDiscountPercent:
+		d = b.Mul(decimal.NewFromFloat(c.DiscountValue)).Div(decimal.NewFromInt(100)).Round(2)
+	case CouponDiscountFixed:
+		d = decimal.NewFromFloat(c.DiscountValue).Round(2)
+	}
+	maxDiscount := b.Sub(decimal.NewFromFloat(amountToleranceCNY))
+	if d.GreaterThan(maxDiscount) {
+		d = maxDiscount
+	}
+	if d.IsNegative() {
+		return 0
+	}
+	return d.InexactFloat64()
+}
+
+// subtractCouponDiscount returns the amount left to pay after a discount.
+func subtractCouponDiscount(amount, discount float64) float64 {
+	return decimal.NewFromFloat(amount).Sub(decimal.NewFromFloat(discount)).Round(2).InexactFloat64()
+}
+
+// checkCouponApplicable validates a coupon's status, validity window, scope and minimum amount.
+func checkCouponApplicable(c *dbent.PaymentCoupon, orderType string, planID int64, base float64, now time.Time) error {
+	if c.Status != CouponStatusActive {
+		return infraerrors.BadRequest("COUPON_INACTIVE", "coupon is not active")
+	}
+	if c.StartsAt != nil && now.Before(*c.StartsAt) {
+		return infraerrors.BadRequest("COUPON_NOT_STARTED", "coupon is not yet valid")
+	}
+	if c.ExpiresAt != nil && !now.Before(*c.ExpiresAt) {
+		return infraerrors.BadRequest("COUPON_EXPIRED", "coupon has expired")
+	}
+	switch c.AppliesTo {
+	case CouponAppliesAll:
+	case CouponAppliesBalance, CouponAppliesSubscription:
+		if orderType != c.AppliesTo {
+			return infraerrors.BadRequest("COUPON_NOT_APPLICABLE", "coupon does not apply to this order").
+				WithMetadata(map[string]string{"applies_to": c.AppliesTo})
+		}
+	default:
+		return infraerrors.BadRequest("COUPON_NOT_APPLICABLE", "coupon does not apply to this order")
+	}
+	if orderType == payment.OrderTypeSubscription && len(c.PlanIds) > 0 && !slices.Contains(c.PlanIds, planID) {
+		return infraerrors.BadRequest("COUPON_NOT_APPLICABLE", "coupon does not apply to this plan")
+	}
+	if c.MinAmount > 0 && base+amountToleranceCNY/2 < c.MinAmount {
+		return infraerrors.BadRequest("COUPON_MIN_AMOUNT", fmt.Sprintf("order amount must be at least %.2f to use this coupon", c.MinAmount)).
+			WithMetadata(map[string]string{"min": fmt.Sprintf("%.2f", c.MinAmount)})
+	}
+	return nil
+}
+
+// checkCouponUsage enforces the coupon's global and per-user usage limits.
+func checkCouponUsage(ctx context.Context, orders *dbent.PaymentOrderClient, c *dbent.PaymentCoupon, userID int64) error {
+	if c.MaxUses > 0 {
+		used, err := orders.Query().Where(paymentorder.CouponIDEQ(c.ID), couponUsedPredicate()).Count(ctx)
+		if err != nil {
+			return fmt.Errorf("count coupon usage: %w", err)
+		}
+		if used >= c.MaxUses {
+			return infraerrors.BadRequest("COUPON_EXHAUSTED", "coupon has reached its usage limit")
+		}
+	}
+	if c.PerUserLimit > 0 {
+		used, err := orders.Query().Where(paymentorder.CouponIDEQ(c.ID), paymentorder.UserIDEQ(userID), couponUsedPredicate()).Count(ctx)
+		if err != nil {
+			return fmt.Errorf("count user coupon usage: %w", err)
+		}
+		if used >= c.PerUserLimit {
+			return infraerrors.BadRequest("COUPON_USER_LIMIT", "you have already used this coupon").
+				WithMetadata(map[string]string{"limit": strconv.Itoa(c.PerUserLimit)})
+		}
+	}
+	return nil
+}
+
+// resolveCoupon validates the coupon code of an order request and computes its discount.
+// Usage limits are checked again under a row lock when the order is inserted.
+func (s *PaymentService) resolveCoupon(ctx context.Context, req CreateOrderRequest, plan *dbent.SubscriptionPlan) (*appliedCoupon, error) {
+	code := normalizeCouponCode(req.CouponCode)
+	if code == "" {
+		return nil, nil
+	}
+	if req.AutoRenew {
+		return nil, infraerrors.BadRequest("COUPON_NOT_APPLICABLE", "coupons cannot be combined with auto-renew")
+	}
+	c, err := s.entClient.PaymentCoupon.Query().Where(paymentcoupon.CodeEQ(code)).Only(ctx)
+	if err != nil {
+		if dbent.IsNotFound(err) {
+			return nil, infraerrors.NotFound("COUPON_NOT_FOUND", "coupon not found")
+		}
+		return nil, fmt.Errorf("get coupon: %w", err)
+	}
+	base := couponBaseAmount(req, plan)
+	if err := checkCouponApplicable(c, req.OrderType, req.PlanID, base, paymentBeijingNow()); err != nil {
+		return nil, err
+	}
+	if err := checkCouponUsage(ctx, s.entClient.PaymentOrder, c, req.UserID); err != nil {
+		return nil, err
+	}
+	return &appliedCoupon{ID: c.ID, Code: c.Code, Name: c.Name, Discount: calculateCouponDiscount(c, base)}, nil
+}
+
+// reserveCoupon locks the coupon row inside the order transaction and re-checks its
+// limits, so concurrent checkouts cannot exceed max_uses or per_user_limit.
+func reserveCoupon(ctx context.Context, tx *dbent.Tx, ac *appliedCoupon, userID int64) error {
+	c, err := tx.PaymentCoupon.Query().Where(paymentcoupon.IDEQ(ac.ID)).ForUpdate().Only(ctx)
+	if err != nil {
+		if dbent.IsNotFound(err) {
+			return infraerrors.NotFound("COUPON_NOT_FOUND", "coupon not found")
+		}
+		return fmt.Errorf("lock coupon: %w", err)
+	}
+	if c.Status != CouponStatusActive {
+		return infraerrors.BadRequest("COUPON_INACTIVE", "coupon is not active")
+	}
+	return checkCouponUsage(ctx, tx.PaymentOrder, c, userID)
+}
+
+// PreviewCoupon reports the discount a coupon would give the described order without creating it.
+func (s *PaymentService) PreviewCoupon(ctx context.Context, req CreateOrderRequest) (*CouponPreview, error) {
+	if req.OrderType == "" {
+		req.OrderType = payment.OrderTypeBalance
+	}
+	cfg, err := s.configService.GetPaymentConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get payment config: %w", err)
+	}
+	plan, err := s.validateOrderInput(ctx, req, cfg)
+	if err != nil {
+		return nil, err
+	}
+	ac, err := s.resolveCoupon(ctx, req, plan)
+	if err != nil {
+		return nil, err
+	}
+	if ac == nil {
+		return nil, infraerrors.BadRequest("COUPON_REQUIRED", "coupon code is required")
+	}
+	base := couponBaseAmount(req, plan)
+	payAmount, _ := strconv.ParseFloat(payment.CalculatePayAmount(subtractCouponDiscount(base, ac.Discount), cfg.RechargeFeeRate), 64)
+	return &CouponPreview{Code: ac.Code, Name: ac.Name, OriginalAmount: base, DiscountAmount: ac.Discount, PayAmount: payAmount}, nil
+}
@@ -0,0 +1,103 @@
+//go:build unit
+
+package service
+
+import (
+	"testing"
+	"time"
+
+	dbent "github.com/Wei-Shaw/sub2api/ent"
+	"github.com/Wei-Shaw/sub2api/internal/payment"
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ---------------------------------------------------------------------------
+// calculateCouponDiscount — discount amount on the pre-fee base
+// ---------------------------------------------------------------------------
+
+func TestCalculateCouponDiscount_Percent(t *testing.T) {
+	t.Parallel()
+	c := &dbent.PaymentCoupon{DiscountType: CouponDiscountPercent, DiscountValue: 15}
+	assert.Equal(t, 14.99, calculateCouponDiscount(c, 99.9))
+}
+
+func TestCalculateCouponDiscount_Fixed(t *testing.T) {
+	t.Parallel()
+	c := &dbent.PaymentCoupon{DiscountType: CouponDiscountFixed, DiscountValue: 20}
+	assert.Equal(t, 20.0, calculateCouponDiscount(c, 100))
+}
+
+func TestCalculateCouponDiscount_CappedBelowBase(t *testing.T) {
+	t.Parallel()
+	// 减免不能让实付金额归零
+	fixed := &dbent.PaymentCoupon{DiscountType: CouponDiscountFixed, DiscountValue: 50}
+	assert.Equal(t, 29.99, calculateCouponDiscount(fixed, 30))
+	full := &dbent.PaymentCoupon{DiscountType: CouponDiscountPercent, DiscountValue: 100}
+	assert.Equal(t, 9.99, calculateCouponDiscount(full, 10))
+}
+
+func TestSubtractCouponDiscount(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, 84.91, subtractCouponDiscount(99.9, 14.99))
+}
+
+// ---------------------------------------------------------------------------
+// checkCouponApplicable — status, validity window, scope and minimum amount
+// ---------------------------------------------------------------------------
+
+func couponCode(t *testing.T, err error) string {
+	t.Helper()
+	require.Error(t, err)
+	return infraerrors.Reason(err)
+}
+
+func TestCheckCouponApplicable(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	past, future := now.Add(-time.Hour), now.Add(time.Hour)
+	base := func() *dbent.PaymentCoupon {
+		return &dbent.PaymentCoupon{Status: CouponStatusActive, AppliesTo: CouponAppliesAll}
+	}
+
+	assert.NoError(t, checkCouponApplicable(base(), payment.OrderTypeBalance, 0, 10, now))
+
+	c := base()
+	c.Status = CouponStatusDisabled
+	assert.Equal(t, "COUPON_INACTIVE", couponCode(t, checkCouponApplicable(c, payment.OrderTypeBalance, 0, 10, now)))
+
+	c = base()
+	c.StartsAt = &future
+	assert.Equal(t, "COUPON_NOT_STARTED", couponCode(t, checkCouponApplicable(c, payment.OrderTypeBalance, 0, 10, now)))
+
+	c = base()
+	c.ExpiresAt = &past
+	assert.Equal(t, "COUPON_EXPIRED", couponCode(t, checkCouponApplicable(c, payment.OrderTypeBalance, 0, 10, now)))
+
+	c = base()
+	c.AppliesTo = CouponAppliesBalance
+	assert.Equal(t, "COUPON_NOT_APPLICABLE", couponCode(t, checkCouponApplicable(c, payment.OrderTypeSubscription, 3, 10, now)))
+
+	c = base()
+	c.AppliesTo = CouponAppliesSubscription
+	c.PlanIds = []int64{1, 2}
+	assert.NoError(t, checkCouponApplicable(c, payment.OrderTypeSubscription, 2, 10, now))
+	assert.Equal(t, "COUPON_NOT_APPLICABLE", couponCode(t, checkCouponApplicable(c, payment.OrderTypeSubscription, 3, 10, now)))
+
+	c = base()
+	c.MinAmount = 50
+	assert.Equal(t, "COUPON_MIN_AMOUNT", couponCode(t, checkCouponApplicable(c, payment.OrderTypeBalance, 0, 49.99, now)))
+	assert.NoError(t, checkCouponApplicable(c, payment.OrderTypeBalance, 0, 50, now))
+}
+
+// ---------------------------------------------------------------------------
+// calculateGatewayRefundAmount — refunds of discounted orders
+// ---------------------------------------------------------------------------
+
+func TestCalculateGatewayRefundAmount_DiscountedOrder(t *testing.T) {
+	t.Parallel()
+	// 套餐原价 100，优惠 30，实付 70：退一半只退实付的一半
+	assert.Equal(t, 35.0, calculateGatewayRefundAmount(100, 70, 50))
+	assert.Equal(t, 70.0, calculateGatewayRefundAmount(100, 70, 100))
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"math"
@@ -19,6 +20,9 @@ import (
 // --- Payment Notification & Fulfillment ---
 
 func (s *PaymentService) HandlePaymentNotification(ctx context.Context, n *payment.PaymentNotification, pk string) error {
+	if n.Recurring != nil {
+		return s.handleRecurringEvent(ctx, n, pk)
+	}
 	if n.Status != payment.NotificationStatusSuccess {
 		return nil
 	}
@@ -56,6 +60,10 @@ func (s *PaymentService) confirmPayment(ctx context.Context, oid int64, tradeNo
 	return s.toPaid(ctx, o, tradeNo, paid, pk)
 }
 
+// errPaymentTradeNoClaimed means the provider trade number is already recorded on another
+// order (unique among crypto orders, where one transfer must not pay for two orders).
+var errPaymentTradeNoClaimed = errors.New("payment trade number already claimed by another order")
+
 func (s *PaymentService) toPaid(ctx context.Context, o *dbent.PaymentOrder, tradeNo string, paid float64, pk string) error {
 	previousStatus := o.Status
 	now := paymentBeijingNow()
@@ -72,6 +80,9 @@ func (s *PaymentService) toPaid(ctx context.Context, o *dbent.PaymentOrder, trad
 		),
 	).SetStatus(OrderStatusPaid).SetPayAmount(paid).SetPaymentTradeNo(tradeNo).SetPaidAt(now).SetUpdatedAt(now).ClearFailedAt().ClearFailedReason().Save(ctx)
 	if err != nil {
+		if dbent.IsConstraintError(err) {
+			return fmt.Errorf("update to PAID: %w: %w", errPaymentTradeNoClaimed, err)
+		}
 		return fmt.Errorf("update to PAID: %w", err)
 	}
 	if c == 0 {
@@ -221,6 +232,9 @@ func (s *PaymentService) markCompleted(ctx context.Context, o *dbent.PaymentOrde
 		"creditedAmount": o.Amount,
 		"payAmount":      o.PayAmount,
 	})
+	if s.invoiceService != nil {
+		s.invoiceService.IssueForPaymentOrderAsync(o.ID)
+	}
 	return nil
 }
 
@@ -51,10 +51,18 @@ func (s *PaymentService) CreateOrder(ctx context.Context, req CreateOrderRequest
 	} else if req.OrderType == payment.OrderTypeBalance {
 		orderAmount = calculateCreditedBalance(req.Amount, cfg.BalanceRechargeMultiplier)
 	}
+	coupon, err := s.resolveCoupon(ctx, req, plan)
+	if err != nil {
+		return nil, err
+	}
+	chargeAmount := limitAmount
+	if coupon != nil {
+		chargeAmount = subtractCouponDiscount(limitAmount, coupon.Discount)
+	}
 	feeRate := cfg.RechargeFeeRate
-	payAmountStr := payment.CalculatePayAmount(limitAmount, feeRate)
+	payAmountStr := payment.CalculatePayAmount(chargeAmount, feeRate)
 	payAmount, _ := strconv.ParseFloat(payAmountStr, 64)
-	order, err := s.createOrderInTx(ctx, req, user, plan, cfg, orderAmount, limitAmount, feeRate, payAmount)
+	order, err := s.createOrderInTx(ctx, req, user, plan, cfg, orderAmount, limitAmount, feeRate, payAmount, coupon)
 	if err != nil {
 		return nil, err
 	}
@@ -76,7 +84,14 @@ func (s *PaymentService) validateOrderInput(ctx context.Context, req CreateOrder
 		return nil, infraerrors.Forbidden("BALANCE_PAYMENT_DISABLED", "balance recharge has been disabled")
 	}
 	if req.OrderType == payment.OrderTypeSubscription {
-		return s.validateSubOrder(ctx, req)
+		plan, err := s.validateSubOrder(ctx, req)
+		if err != nil || !req.AutoRenew {
+			return plan, err
+		}
+		return plan, s.validateAutoRenew(ctx, req, plan)
+	}
+	if req.AutoRenew {
+		return nil, infraerrors.BadRequest("AUTO_RENEW_UNSUPPORTED", "auto-renew is only available for subscription plans")
 	}
 	if math.IsNaN(req.Amount) || math.IsInf(req.Amount, 0) || req.Amount <= 0 {
 		return nil, infraerrors.BadRequest("INVALID_AMOUNT", "amount must be a positive number")
@@ -106,7 +121,7 @@ func (s *PaymentService) validateSubOrder(ctx context.Context, req CreateOrderRe
 	return plan, nil
 }
 
-func (s *PaymentService) createOrderInTx(ctx context.Context, req CreateOrderRequest, user *User, plan *dbent.SubscriptionPlan, cfg *PaymentConfig, orderAmount, limitAmount, feeRate, payAmount float64) (*dbent.PaymentOrder, error) {
+func (s *PaymentService) createOrderInTx(ctx context.Context, req CreateOrderRequest, user *User, plan *dbent.SubscriptionPlan, cfg *PaymentConfig, orderAmount, limitAmount, feeRate, payAmount float64, coupon *appliedCoupon) (*dbent.PaymentOrder, error) {
 	tx, err := s.entClient.Tx(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("begin transaction: %w", err)
@@ -118,6 +133,11 @@ func (s *PaymentService) createOrderInTx(ctx context.Context, req CreateOrderReq
 	if err := s.checkDailyLimit(ctx, tx, req.UserID, limitAmount, cfg.DailyLimit); err != nil {
 		return nil, err
 	}
+	if coupon != nil {
+		if err := reserveCoupon(ctx, tx, coupon, req.UserID); err != nil {
+			return nil, err
+		}
+	}
 	tm := cfg.OrderTimeoutMin
 	if tm <= 0 {
 		tm = defaultOrderTimeoutMin
@@ -149,6 +169,9 @@ func (s *PaymentService) createOrderInTx(ctx context.Context, req CreateOrderReq
 	if plan != nil {
 		b.SetPlanID(plan.ID).SetSubscriptionGroupID(plan.GroupID).SetSubscriptionDays(psComputeValidityDays(plan.ValidityDays, plan.ValidityUnit))
 	}
+	if coupon != nil {
+		b.SetCouponID(coupon.ID).SetCouponCode(coupon.Code).SetDiscountAmount(coupon.Discount)
+	}
 	order, err := b.Save(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("create order: %w", err)
@@ -218,7 +241,20 @@ func (s *PaymentService) invokeProvider(ctx context.Context, order *dbent.Paymen
 	}
 	subject := s.buildPaymentSubject(plan, limitAmount, cfg)
 	outTradeNo := order.OutTradeNo
-	pr, err := prov.CreatePayment(ctx, payment.CreatePaymentRequest{OrderID: outTradeNo, Amount: payAmountStr, PaymentType: req.PaymentType, Subject: subject, ClientIP: req.ClientIP, IsMobile: req.IsMobile, InstanceSubMethods: sel.SupportedTypes})
+	cpReq := payment.CreatePaymentRequest{OrderID: outTradeNo, Amount: payAmountStr, PaymentType: req.PaymentType, Subject: subject, ClientIP: req.ClientIP, IsMobile: req.IsMobile, InstanceSubMethods: sel.SupportedTypes}
+	if req.AutoRenew {
+		if _, ok := prov.(payment.RecurringProvider); !ok {
+			return nil, infraerrors.BadRequest("AUTO_RENEW_UNSUPPORTED", "the selected payment method does not support auto-renew")
+		}
+		cpReq.RecurringPriceID = plan.StripePriceID
+		cpReq.PayerEmail = order.UserEmail
+	}
+	var pr *payment.CreatePaymentResponse
+	if isChainPaymentType(req.PaymentType) {
+		pr, err = s.createChainPayment(ctx, prov, sel.InstanceID, order.ID, cpReq)
+	} else {
+		pr, err = prov.CreatePayment(ctx, cpReq)
+	}
 	if err != nil {
 		slog.Error("[PaymentService] CreatePayment failed", "provider", sel.ProviderKey, "instance", sel.InstanceID, "error", err)
 		return nil, infraerrors.ServiceUnavailable("PAYMENT_GATEWAY_ERROR", fmt.Sprintf("payment gateway error: %s", err.Error()))
@@ -227,15 +263,26 @@ func (s *PaymentService) invokeProvider(ctx context.Context, order *dbent.Paymen
 	if err != nil {
 		return nil, fmt.Errorf("update order with payment details: %w", err)
 	}
+	if req.AutoRenew {
+		if err := s.createAutoRenewal(ctx, order, plan, sel.InstanceID, pr.SubscriptionID); err != nil {
+			if rp, ok := prov.(payment.RecurringProvider); ok && pr.SubscriptionID != "" {
+				_ = rp.CancelRecurring(ctx, pr.SubscriptionID)
+			}
+			return nil, err
+		}
+	}
 	s.writeAuditLog(ctx, order.ID, "ORDER_CREATED", fmt.Sprintf("user:%d", req.UserID), map[string]any{
 		"paymentAmount":  req.Amount,
 		"creditedAmount": order.Amount,
 		"payAmount":      order.PayAmount,
 		"paymentType":    req.PaymentType,
 		"orderType":      req.OrderType,
+		"autoRenew":      req.AutoRenew,
+		"couponCode":     psStringValue(order.CouponCode),
+		"discountAmount": order.DiscountAmount,
 	})
 	order = normalizePaymentOrderTimes(order)
-	return &CreateOrderResponse{OrderID: order.ID, Amount: order.Amount, PayAmount: payAmount, FeeRate: order.FeeRate, Status: OrderStatusPending, PaymentType: req.PaymentType, PayURL: pr.PayURL, QRCode: pr.QRCode, ClientSecret: pr.ClientSecret, ExpiresAt: order.ExpiresAt, PaymentMode: sel.PaymentMode}, nil
+	return &CreateOrderResponse{OrderID: order.ID, Amount: order.Amount, PayAmount: payAmount, FeeRate: order.FeeRate, Status: OrderStatusPending, PaymentType: req.PaymentType, PayURL: pr.PayURL, QRCode: pr.QRCode, ClientSecret: pr.ClientSecret, ExpiresAt: order.ExpiresAt, PaymentMode: sel.PaymentMode, CryptoAmount: pr.CryptoAmount, CryptoAsset: pr.CryptoAsset, AutoRenew: req.AutoRenew, CouponCode: psStringValue(order.CouponCode), DiscountAmount: order.DiscountAmount}, nil
 }
 
 func (s *PaymentService) buildPaymentSubject(plan *dbent.SubscriptionPlan, limitAmount float64, cfg *PaymentConfig) string {
@@ -7,9 +7,14 @@ import (
 	"time"
 )
 
-const expiryCheckTimeout = 30 * time.Second
+const (
+	expiryCheckTimeout = 30 * time.Second
+	chainPollTimeout   = 30 * time.Second
+)
 
 // PaymentOrderExpiryService periodically expires timed-out payment orders.
+// Each pass first polls the chain for pending crypto orders, so transfers
+// confirmed just before the deadline are fulfilled instead of expired.
 type PaymentOrderExpiryService struct {
 	paymentSvc *PaymentService
 	interval   time.Duration
@@ -59,6 +64,8 @@ func (s *PaymentOrderExpiryService) Stop() {
 }
 
 func (s *PaymentOrderExpiryService) runOnce() {
+	s.pollChainPayments()
+
 	ctx, cancel := context.WithTimeout(context.Background(), expiryCheckTimeout)
 	defer cancel()
 
@@ -71,3 +78,17 @@ func (s *PaymentOrderExpiryService) runOnce() {
 		slog.Info("[PaymentOrderExpiry] expired timed-out orders", "count", expired)
 	}
 }
+
+func (s *PaymentOrderExpiryService) pollChainPayments() {
+	ctx, cancel := context.WithTimeout(context.Background(), chainPollTimeout)
+	defer cancel()
+
+	paid, err := s.paymentSvc.PollChainPayments(ctx)
+	if err != nil {
+		slog.Error("[PaymentOrderExpiry] failed to poll chain payments", "error", err)
+		return
+	}
+	if paid > 0 {
+		slog.Info("[PaymentOrderExpiry] confirmed on-chain payments", "count", paid)
+	}
+}
@@ -25,6 +25,7 @@ const (
 	rateLimitModeFixed         = "fixed"
 	checkPaidResultAlreadyPaid = "already_paid"
 	checkPaidResultCancelled   = "cancelled"
+	checkPaidResultConfirming  = "confirming"
 )
 
 func (s *PaymentService) checkCancelRateLimit(ctx context.Context, userID int64, cfg *PaymentConfig) error {
@@ -116,8 +117,15 @@ func (s *PaymentService) AdminCancelOrder(ctx context.Context, orderID int64) (s
 
 func (s *PaymentService) cancelCore(ctx context.Context, o *dbent.PaymentOrder, fs, op, ad string) (string, error) {
 	if o.PaymentTradeNo != "" || o.PaymentType != "" {
-		if s.checkPaid(ctx, o) == checkPaidResultAlreadyPaid {
+		switch s.checkPaid(ctx, o) {
+		case checkPaidResultAlreadyPaid:
 			return checkPaidResultAlreadyPaid, nil
+		case checkPaidResultConfirming:
+			// An on-chain transfer is waiting for confirmations; keep the order pending.
+			if fs == OrderStatusExpired {
+				return checkPaidResultConfirming, nil
+			}
+			return "", infraerrors.Conflict("PAYMENT_CONFIRMING", "payment is awaiting on-chain confirmations")
 		}
 	}
 	c, err := s.entClient.PaymentOrder.Update().Where(paymentorder.IDEQ(o.ID), paymentorder.StatusEQ(OrderStatusPending)).SetStatus(fs).SetUpdatedAt(paymentBeijingNow()).Save(ctx)
@@ -150,6 +158,9 @@ func (s *PaymentService) checkPaid(ctx context.Context, o *dbent.PaymentOrder) s
 		slog.Warn("query upstream failed", "orderID", o.ID, "error", err)
 		return ""
 	}
+	if resp.Chain != nil || len(resp.Unmatched) > 0 {
+		return s.settleChainPayment(ctx, o, prov.ProviderKey(), resp)
+	}
 	if resp.Status == payment.ProviderStatusPaid {
 		if err := s.HandlePaymentNotification(ctx, &payment.PaymentNotification{TradeNo: o.PaymentTradeNo, OrderID: o.OutTradeNo, Amount: resp.Amount, Status: payment.ProviderStatusSuccess}, prov.ProviderKey()); err != nil {
 			slog.Error("fulfillment failed during checkPaid", "orderID", o.ID, "error", err)
@@ -226,6 +237,10 @@ func (s *PaymentService) ExpireTimedOutOrders(ctx context.Context) (int, error)
 			slog.Info("order was paid during expiry", "orderID", o.ID)
 			continue
 		}
+		if outcome == checkPaidResultConfirming {
+			slog.Info("order expiry deferred while payment confirms on chain", "orderID", o.ID)
+			continue
+		}
 		if outcome != "" {
 			n++
 		}
@@ -236,16 +251,22 @@ func (s *PaymentService) ExpireTimedOutOrders(ctx context.Context) (int, error)
 // getOrderProvider creates a provider using the order's original instance config.
 // Falls back to registry lookup if instance ID is missing (legacy orders).
 func (s *PaymentService) getOrderProvider(ctx context.Context, o *dbent.PaymentOrder) (payment.Provider, error) {
-	if o.ProviderInstanceID != nil && *o.ProviderInstanceID != "" {
-		instID, err := strconv.ParseInt(*o.ProviderInstanceID, 10, 64)
+	return s.getInstanceProvider(ctx, o.ProviderInstanceID, o.PaymentType)
+}
+
+// getInstanceProvider creates a provider for the given instance ID, falling back to
+// the registry provider of the payment type when the instance is unknown.
+func (s *PaymentService) getInstanceProvider(ctx context.Context, instanceID *string, paymentType string) (payment.Provider, error) {
+	if instanceID != nil && *instanceID != "" {
+		instID, err := strconv.ParseInt(*instanceID, 10, 64)
 		if err == nil {
 			cfg, err := s.loadBalancer.GetInstanceConfig(ctx, instID)
 			if err == nil {
-				providerKey := s.registry.GetProviderKey(o.PaymentType)
+				providerKey := s.registry.GetProviderKey(paymentType)
 				if providerKey == "" {
-					providerKey = o.PaymentType
+					providerKey = paymentType
 				}
-				p, err := provider.CreateProvider(providerKey, *o.ProviderInstanceID, cfg)
+				p, err := provider.CreateProvider(providerKey, *instanceID, cfg)
 				if err == nil {
 					return p, nil
 				}
@@ -253,5 +274,5 @@ func (s *PaymentService) getOrderProvider(ctx context.Context, o *dbent.PaymentO
 		}
 	}
 	s.EnsureProviders(ctx)
-	return s.registry.GetProvider(o.PaymentType)
+	return s.registry.GetProvider(paymentType)
 }
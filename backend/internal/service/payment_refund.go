@@ -112,6 +112,8 @@ func (s *PaymentService) PrepareRefund(ctx context.Context, oid int64, amt float
 	if amt-o.Amount > amountToleranceCNY {
 		return nil, nil, infraerrors.BadRequest("REFUND_AMOUNT_EXCEEDED", "refund amount exceeds recharge")
 	}
+	// amt is in credited terms; the gateway refunds the same share of what was actually
+	// paid, so a coupon discount (already excluded from pay_amount) is pro-rated too.
 	ga := calculateGatewayRefundAmount(o.Amount, o.PayAmount, amt)
 	rr := strings.TrimSpace(reason)
 	if rr == "" && o.RefundRequestReason != nil {
@@ -150,8 +152,16 @@ func (s *PaymentService) prepDeduct(ctx context.Context, o *dbent.PaymentOrder,
 		}
 		return nil
 	}
+	// 赠送额度（优惠码 / 邀请奖励 / 管理员调整）不随订单退款回收
+	refundable, err := s.balanceBuckets.RefundableBalance(ctx, o.UserID, u.Balance)
+	if err != nil {
+		if !force {
+			return &RefundResult{Success: false, Warning: "cannot compute refundable balance, use force", RequireForce: true}
+		}
+		refundable = u.Balance
+	}
 	p.DeductionType = payment.DeductionTypeBalance
-	p.BalanceToDeduct = math.Min(p.RefundAmount, u.Balance)
+	p.BalanceToDeduct = math.Min(p.RefundAmount, refundable)
 	return nil
 }
 
@@ -167,7 +177,7 @@ func (s *PaymentService) ExecuteRefund(ctx context.Context, p *RefundPlan) (*Ref
 		// Skip balance deduction on retry if previous attempt already deducted
 		// but failed to roll back (REFUND_ROLLBACK_FAILED in audit log).
 		if !s.hasAuditLog(ctx, p.OrderID, "REFUND_ROLLBACK_FAILED") {
-			if err := s.userRepo.DeductBalance(ctx, p.Order.UserID, p.BalanceToDeduct); err != nil {
+			if err := s.deductRefundBalance(ctx, p.Order.UserID, p.BalanceToDeduct); err != nil {
 				s.restoreStatus(ctx, p)
 				return nil, fmt.Errorf("deduction: %w", err)
 			}
@@ -253,10 +263,35 @@ func (s *PaymentService) markRefundOk(ctx context.Context, p *RefundPlan) (*Refu
 	if err != nil {
 		return nil, fmt.Errorf("mark refund: %w", err)
 	}
-	s.writeAuditLog(ctx, p.OrderID, "REFUND_SUCCESS", "admin", map[string]any{"refundAmount": p.RefundAmount, "reason": p.Reason, "balanceDeducted": p.BalanceToDeduct, "force": p.Force})
+	s.writeAuditLog(ctx, p.OrderID, "REFUND_SUCCESS", "admin", map[string]any{"refundAmount": p.RefundAmount, "gatewayAmount": p.GatewayAmount, "discountAmount": p.Order.DiscountAmount, "reason": p.Reason, "balanceDeducted": p.BalanceToDeduct, "force": p.Force})
+	if s.invoiceService != nil && p.GatewayAmount > 0 {
+		s.invoiceService.IssueCreditNoteForPaymentOrderAsync(p.OrderID, p.GatewayAmount, p.Reason)
+	}
+	s.clawbackCommission(ctx, p)
 	return &RefundResult{Success: true, BalanceDeducted: p.BalanceToDeduct, SubDaysDeducted: p.SubDaysToDeduct}, nil
 }
 
+// clawbackCommission takes back the referrer's commission on a refunded balance order while
+// it is still in its holding period. Failures are logged and do not fail the refund.
+func (s *PaymentService) clawbackCommission(ctx context.Context, p *RefundPlan) {
+	if s.commissions == nil || p.Order.OrderType != payment.OrderTypeBalance || p.Order.RechargeCode == "" || p.Order.Amount <= 0 {
+		return
+	}
+	rc, err := s.redeemService.GetByCode(ctx, p.Order.RechargeCode)
+	if err != nil {
+		slog.Warn("refund: lookup recharge code for commission clawback failed", "orderID", p.OrderID, "error", err)
+		return
+	}
+	amount, err := s.commissions.Clawback(ctx, ReferralSourceTypeRedeemCode, rc.ID, p.RefundAmount/p.Order.Amount, fmt.Sprintf("refund order:%d", p.OrderID))
+	if err != nil {
+		slog.Error("refund: commission clawback failed", "orderID", p.OrderID, "error", err)
+		return
+	}
+	if amount > 0 {
+		s.writeAuditLog(ctx, p.OrderID, "COMMISSION_CLAWBACK", "admin", map[string]any{"amount": amount})
+	}
+}
+
 func (s *PaymentService) RollbackRefund(ctx context.Context, p *RefundPlan, gErr error) bool {
 	if p.DeductionType == payment.DeductionTypeBalance && p.BalanceToDeduct > 0 {
 		if err := s.userRepo.UpdateBalance(ctx, p.Order.UserID, p.BalanceToDeduct); err != nil {
@@ -264,6 +299,9 @@ func (s *PaymentService) RollbackRefund(ctx context.Context, p *RefundPlan, gErr
 			s.writeAuditLog(ctx, p.OrderID, "REFUND_ROLLBACK_FAILED", "admin", map[string]any{"gatewayError": psErrMsg(gErr), "rollbackError": psErrMsg(err), "balanceDeducted": p.BalanceToDeduct})
 			return false
 		}
+		if err := s.balanceBuckets.Grant(ctx, p.Order.UserID, BalanceSourcePaid, p.BalanceToDeduct, fmt.Sprintf("refund_rollback:%d", p.OrderID)); err != nil {
+			slog.Warn("refund rollback: record balance bucket failed", "orderID", p.OrderID, "error", err)
+		}
 	}
 	if p.DeductionType == payment.DeductionTypeSubscription && p.SubDaysToDeduct > 0 && p.SubscriptionID > 0 {
 		if _, err := s.subscriptionSvc.ExtendSubscription(ctx, p.SubscriptionID, p.SubDaysToDeduct); err != nil {
@@ -282,3 +320,13 @@ func (s *PaymentService) restoreStatus(ctx context.Context, p *RefundPlan) {
 	}
 	_, _ = s.entClient.PaymentOrder.UpdateOneID(p.OrderID).SetStatus(rs).SetUpdatedAt(paymentBeijingNow()).Save(ctx)
 }
+
+// deductRefundBalance takes the refunded amount back from the user's paid balance buckets,
+// falling back to a plain deduction when bucket tracking is not wired.
+func (s *PaymentService) deductRefundBalance(ctx context.Context, userID int64, amount float64) error {
+	ok, err := s.balanceBuckets.DeductRefundable(ctx, userID, amount)
+	if ok {
+		return err
+	}
+	return s.userRepo.DeductBalance(ctx, userID, amount)
+}
@@ -78,20 +78,27 @@ type CreateOrderRequest struct {
 	SrcURL      string
 	OrderType   string
 	PlanID      int64
+	AutoRenew   bool   // Subscribe to the plan with a provider-side recurring subscription
+	CouponCode  string // Checkout coupon discounting the pay amount
 }
 
 type CreateOrderResponse struct {
-	OrderID      int64     `json:"order_id"`
-	Amount       float64   `json:"amount"`
-	PayAmount    float64   `json:"pay_amount"`
-	FeeRate      float64   `json:"fee_rate"`
-	Status       string    `json:"status"`
-	PaymentType  string    `json:"payment_type"`
-	PayURL       string    `json:"pay_url,omitempty"`
-	QRCode       string    `json:"qr_code,omitempty"`
-	ClientSecret string    `json:"client_secret,omitempty"`
-	ExpiresAt    time.Time `json:"expires_at"`
-	PaymentMode  string    `json:"payment_mode,omitempty"`
+	OrderID        int64     `json:"order_id"`
+	Amount         float64   `json:"amount"`
+	PayAmount      float64   `json:"pay_amount"`
+	FeeRate        float64   `json:"fee_rate"`
+	Status         string    `json:"status"`
+	PaymentType    string    `json:"payment_type"`
+	PayURL         string    `json:"pay_url,omitempty"`
+	QRCode         string    `json:"qr_code,omitempty"`
+	ClientSecret   string    `json:"client_secret,omitempty"`
+	ExpiresAt      time.Time `json:"expires_at"`
+	PaymentMode    string    `json:"payment_mode,omitempty"`
+	CryptoAmount   string    `json:"crypto_amount,omitempty"`
+	CryptoAsset    string    `json:"crypto_asset,omitempty"`
+	AutoRenew      bool      `json:"auto_renew,omitempty"`
+	CouponCode     string    `json:"coupon_code,omitempty"`
+	DiscountAmount float64   `json:"discount_amount,omitempty"`
 }
 
 type OrderListParams struct {
@@ -169,12 +176,30 @@ type PaymentService struct {
 	configService   *PaymentConfigService
 	userRepo        UserRepository
 	groupRepo       GroupRepository
+	invoiceService  *InvoiceService
+	balanceBuckets  *BalanceBucketService
+	commissions     *ReferralCommissionService
 }
 
 func NewPaymentService(entClient *dbent.Client, registry *payment.Registry, loadBalancer payment.LoadBalancer, redeemService *RedeemService, subscriptionSvc *SubscriptionService, configService *PaymentConfigService, userRepo UserRepository, groupRepo GroupRepository) *PaymentService {
 	return &PaymentService{entClient: entClient, registry: registry, loadBalancer: loadBalancer, redeemService: redeemService, subscriptionSvc: subscriptionSvc, configService: configService, userRepo: userRepo, groupRepo: groupRepo}
 }
 
+// SetInvoiceService injects the invoice service so completed orders and refunds are invoiced.
+func (s *PaymentService) SetInvoiceService(invoiceService *InvoiceService) {
+	s.invoiceService = invoiceService
+}
+
+// SetBalanceBuckets injects the balance bucket service so refunds only claw back paid credits.
+func (s *PaymentService) SetBalanceBuckets(balanceBuckets *BalanceBucketService) {
+	s.balanceBuckets = balanceBuckets
+}
+
+// SetCommissionService injects the referral commission ledger so refunds claw back held commission.
+func (s *PaymentService) SetCommissionService(commissions *ReferralCommissionService) {
+	s.commissions = commissions
+}
+
 // --- Provider Registry ---
 
 // EnsureProviders lazily initializes the provider registry on first call.
@@ -266,6 +291,13 @@ func psNilIfEmpty(s string) *string {
 	return &s
 }
 
+func psStringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
 func psSliceContains(sl []string, s string) bool {
 	for _, v := range sl {
 		if v == s {
@@ -0,0 +1,69 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+const postpaidBillingTimeout = 2 * time.Minute
+
+// PostpaidBillingService periodically runs the postpaid billing cycle: it refreshes credit
+// limits, issues monthly statements, sends payment reminders and suspends overdue accounts.
+type PostpaidBillingService struct {
+	postpaidSvc *PostpaidService
+	interval    time.Duration
+	stopCh      chan struct{}
+	stopOnce    sync.Once
+	wg          sync.WaitGroup
+}
+
+func NewPostpaidBillingService(postpaidSvc *PostpaidService, interval time.Duration) *PostpaidBillingService {
+	return &PostpaidBillingService{
+		postpaidSvc: postpaidSvc,
+		interval:    interval,
+		stopCh:      make(chan struct{}),
+	}
+}
+
+func (s *PostpaidBillingService) Start() {
+	if s == nil || s.postpaidSvc == nil || s.interval <= 0 {
+		return
+	}
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		s.runOnce()
+		for {
+			select {
+			case <-ticker.C:
+				s.runOnce()
+			case <-s.stopCh:
+				return
+			}
+		}
+	}()
+}
+
+func (s *PostpaidBillingService) Stop() {
+	if s == nil {
+		return
+	}
+	s.stopOnce.Do(func() {
+		close(s.stopCh)
+	})
+	s.wg.Wait()
+}
+
+func (s *PostpaidBillingService) runOnce() {
+	ctx, cancel := context.WithTimeout(context.Background(), postpaidBillingTimeout)
+	defer cancel()
+
+	if err := s.postpaidSvc.RunBillingCycle(ctx); err != nil {
+		slog.Error("[PostpaidBilling] billing cycle failed", "error", err)
+	}
+}
@@ -0,0 +1,619 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"log/slog"
+	"math"
+	"sync/atomic"
+	"time"
+
+	dbent "github.com/Wei-Shaw/sub2api/ent"
+	"github.com/Wei-Shaw/sub2api/ent/apikey"
+	"github.com/Wei-Shaw/sub2api/ent/billingstatement"
+	"github.com/Wei-Shaw/sub2api/ent/postpaidaccount"
+	"github.com/Wei-Shaw/sub2api/ent/usagelog"
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/timezone"
+)
+
+// Postpaid account statuses.
+const (
+	PostpaidStatusActive    = "active"
+	PostpaidStatusSuspended = "suspended"
+)
+
+// Billing statement statuses.
+const (
+	StatementStatusUnpaid  = "unpaid"
+	StatementStatusOverdue = "overdue"
+	StatementStatusPaid    = "paid"
+)
+
+const (
+	postpaidDefaultTermsDays = 15
+	postpaidMaxTermsDays     = 90
+	// postpaidReminderLeadTime is how long before the due date the payment reminder is sent.
+	postpaidReminderLeadTime = 3 * 24 * time.Hour
+)
+
+var (
+	ErrPostpaidAccountNotFound  = infraerrors.NotFound("POSTPAID_ACCOUNT_NOT_FOUND", "postpaid account not found")
+	ErrPostpaidInvalidTerms     = infraerrors.BadRequest("POSTPAID_INVALID_TERMS", "credit limit must not be negative and payment terms must be between 1 and 90 days")
+	ErrPostpaidOutstanding      = infraerrors.Conflict("POSTPAID_OUTSTANDING_STATEMENTS", "settle outstanding statements before switching back to prepaid billing")
+	ErrBillingStatementNotFound = infraerrors.NotFound("BILLING_STATEMENT_NOT_FOUND", "billing statement not found")
+	ErrBillingStatementPaid     = infraerrors.Conflict("BILLING_STATEMENT_ALREADY_PAID", "billing statement is already paid")
+)
+
+// CreditLimitProvider returns how far below zero a user's balance may go.
+type CreditLimitProvider interface {
+	CreditLimit(userID int64) float64
+}
+
+// balanceExhausted reports whether a balance no longer covers new balance-billed requests.
+func balanceExhausted(balance, creditLimit float64) bool {
+	return balance <= -creditLimit
+}
+
+// PostpaidAccountInput is the admin-editable part of a postpaid account.
+type PostpaidAccountInput struct {
+	CreditLimit      float64
+	PaymentTermsDays int
+	Notes            string
+}
+
+// BillingStatementListParams filters billing statement lists.
+type BillingStatementListParams struct {
+	UserID   int64
+	Status   string
+	Page     int
+	PageSize int
+}
+
+// PostpaidService manages postpaid (invoice-later) accounts: balances may go negative down
+// to the credit limit, usage is billed in monthly statements, and a user's API keys are
+// suspended while one of their statements is overdue.
+type PostpaidService struct {
+	entClient            *dbent.Client
+	userRepo             UserRepository
+	emailService         *EmailService
+	settingService       *SettingService
+	billingCache         *BillingCacheService
+	authCacheInvalidator APIKeyAuthCacheInvalidator
+
+	// creditLimits is a snapshot of user ID → credit limit, read on every billing check.
+	creditLimits atomic.Pointer[map[int64]float64]
+}
+
+// NewPostpaidService creates a new PostpaidService.
+func NewPostpaidService(
+	entClient *dbent.Client,
+	userRepo UserRepository,
+	emailService *EmailService,
+	settingService *SettingService,
+	billingCache *BillingCacheService,
+	authCacheInvalidator APIKeyAuthCacheInvalidator,
+) *PostpaidService {
+	return &PostpaidService{
+		entClient:            entClient,
+		userRepo:             userRepo,
+		emailService:         emailService,
+		settingService:       settingService,
+		billingCache:         billingCache,
+		authCacheInvalidator: authCacheInvalidator,
+	}
+}
+
+// CreditLimit returns how far below zero the user's balance may go; 0 for prepaid users.
+func (s *PostpaidService) CreditLimit(userID int64) float64 {
+	if m := s.creditLimits.Load(); m != nil {
+		return (*m)[userID]
+	}
+	return 0
+}
+
+// RefreshCreditLimits reloads the credit limit snapshot from the database.
+func (s *PostpaidService) RefreshCreditLimits(ctx context.Context) error {
+	accounts, err := s.entClient.PostpaidAccount.Query().All(ctx)
+	if err != nil {
+		return fmt.Errorf("load postpaid accounts: %w", err)
+	}
+	m := make(map[int64]float64, len(accounts))
+	for _, a := range accounts {
+		if a.CreditLimit > 0 {
+			m[a.UserID] = a.CreditLimit
+		}
+	}
+	s.creditLimits.Store(&m)
+	return nil
+}
+
+// nextStatementPeriodEnd returns the first instant of the calendar month after start,
+// in the configured time zone.
+func nextStatementPeriodEnd(start time.Time) time.Time {
+	t := start.In(timezone.Location())
+	return time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+}
+
+// pendingStatementPeriods returns the [start, end) periods that have fully elapsed at now,
+// beginning at from. The first period may be partial; later ones are calendar months.
+func pendingStatementPeriods(from, now time.Time) [][2]time.Time {
+	var periods [][2]time.Time
+	for start := from; ; {
+		end := nextStatementPeriodEnd(start)
+		if end.After(now) {
+			return periods
+		}
+		periods = append(periods, [2]time.Time{start, end})
+		start = end
+	}
+}
+
+// statementCreditAmount returns the part of a period's usage that was drawn from credit.
+// Usage covered by a positive (prepaid) balance is not billed: crediting it back on payment
+// would pay for it twice. unbilledDebt is how far the balance was below zero at the end of
+// the period, less what outstanding statements already bill.
+func statementCreditAmount(usage, unbilledDebt float64) float64 {
+	return math.Max(0, math.Min(usage, unbilledDebt))
+}
+
+// statementReminderDue reports whether the payment reminder of a statement should be sent.
+func statementReminderDue(st *dbent.BillingStatement, now time.Time) bool {
+	return st.Status == StatementStatusUnpaid && st.ReminderSentAt == nil && st.Amount > 0 &&
+		!now.Before(st.DueAt.Add(-postpaidReminderLeadTime))
+}
+
+func validatePostpaidInput(in *PostpaidAccountInput) error {
+	if in.PaymentTermsDays == 0 {
+		in.PaymentTermsDays = postpaidDefaultTermsDays
+	}
+	if in.CreditLimit < 0 || in.PaymentTermsDays < 1 || in.PaymentTermsDays > postpaidMaxTermsDays {
+		return ErrPostpaidInvalidTerms
+	}
+	return nil
+}
+
+// --- Accounts ---
+
+// GetAccount returns the postpaid account of a user.
+func (s *PostpaidService) GetAccount(ctx context.Context, userID int64) (*dbent.PostpaidAccount, error) {
+	acc, err := s.entClient.PostpaidAccount.Query().Where(postpaidaccount.UserIDEQ(userID)).Only(ctx)
+	if err != nil {
+		if dbent.IsNotFound(err) {
+			return nil, ErrPostpaidAccountNotFound
+		}
+		return nil, fmt.Errorf("get postpaid account: %w", err)
+	}
+	return acc, nil
+}
+
+// ListAccounts lists postpaid accounts.
+func (s *PostpaidService) ListAccounts(ctx context.Context, page, pageSize int) ([]*dbent.PostpaidAccount, int, error) {
+	q := s.entClient.PostpaidAccount.Query()
+	total, err := q.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("count postpaid accounts: %w", err)
+	}
+	ps, pg := applyPagination(pageSize, page)
+	items, err := q.Order(dbent.Desc(postpaidaccount.FieldID)).Limit(ps).Offset((pg - 1) * ps).All(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("query postpaid accounts: %w", err)
+	}
+	return items, total, nil
+}
+
+// SaveAccount switches a user to postpaid billing, or updates the terms of an existing
+// postpaid account. Billing of a new account starts now.
+func (s *PostpaidService) SaveAccount(ctx context.Context, userID int64, in PostpaidAccountInput) (*dbent.PostpaidAccount, error) {
+	if err := validatePostpaidInput(&in); err != nil {
+		return nil, err
+	}
+	if _, err := s.userRepo.GetByID(ctx, userID); err != nil {
+		return nil, err
+	}
+	acc, err := s.GetAccount(ctx, userID)
+	switch {
+	case err == nil:
+		acc, err = acc.Update().
+			SetCreditLimit(in.CreditLimit).
+			SetPaymentTermsDays(in.PaymentTermsDays).
+			SetNotes(in.Notes).
+			Save(ctx)
+	case infraerrors.IsNotFound(err):
+		acc, err = s.entClient.PostpaidAccount.Create().
+			SetUserID(userID).
+			SetCreditLimit(in.CreditLimit).
+			SetPaymentTermsDays(in.PaymentTermsDays).
+			SetNotes(in.Notes).
+			SetBillingStart(timezone.Now()).
+			Save(ctx)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("save postpaid account: %w", err)
+	}
+	if err := s.RefreshCreditLimits(ctx); err != nil {
+		slog.Warn("[Postpaid] refresh credit limits failed", "error", err)
+	}
+	return acc, nil
+}
+
+// DeleteAccount switches a user back to prepaid billing. Usage since the last statement is
+// not billed; it stays reflected in the (possibly negative) balance.
+func (s *PostpaidService) DeleteAccount(ctx context.Context, userID int64) error {
+	acc, err := s.GetAccount(ctx, userID)
+	if err != nil {
+		return err
+	}
+	outstanding, err := s.entClient.BillingStatement.Query().
+		Where(billingstatement.UserIDEQ(userID), billingstatement.StatusIn(StatementStatusUnpaid, StatementStatusOverdue)).
+		Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("check outstanding statements: %w", err)
+	}
+	if outstanding {
+		return ErrPostpaidOutstanding
+	}
+	if err := s.entClient.PostpaidAccount.DeleteOne(acc).Exec(ctx); err != nil {
+		return fmt.Errorf("delete postpaid account: %w", err)
+	}
+	if acc.Status == PostpaidStatusSuspended {
+		s.resumeKeys(ctx, userID)
+	}
+	if err := s.RefreshCreditLimits(ctx); err != nil {
+		slog.Warn("[Postpaid] refresh credit limits failed", "error", err)
+	}
+	return nil
+}
+
+// --- Statements ---
+
+// ListStatements lists billing statements, newest period first.
+func (s *PostpaidService) ListStatements(ctx context.Context, p BillingStatementListParams) ([]*dbent.BillingStatement, int, error) {
+	q := s.entClient.BillingStatement.Query()
+	if p.UserID > 0 {
+		q = q.Where(billingstatement.UserIDEQ(p.UserID))
+	}
+	if p.Status != "" {
+		q = q.Where(billingstatement.StatusEQ(p.Status))
+	}
+	total, err := q.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("count billing statements: %w", err)
+	}
+	ps, pg := applyPagination(p.PageSize, p.Page)
+	items, err := q.Order(dbent.Desc(billingstatement.FieldPeriodStart), dbent.Desc(billingstatement.FieldID)).Limit(ps).Offset((pg - 1) * ps).All(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("query billing statements: %w", err)
+	}
+	return items, total, nil
+}
+
+// MarkStatementPaid records the settlement of a statement: the statement amount (the credit
+// used in the period) is credited back to the balance, and the user's API keys are resumed once nothing is overdue.
+func (s *PostpaidService) MarkStatementPaid(ctx context.Context, id int64, note string) (*dbent.BillingStatement, error) {
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("begin statement transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	st, err := tx.BillingStatement.Query().Where(billingstatement.IDEQ(id)).ForUpdate().Only(ctx)
+	if err != nil {
+		if dbent.IsNotFound(err) {
+			return nil, ErrBillingStatementNotFound
+		}
+		return nil, fmt.Errorf("get billing statement: %w", err)
+	}
+	if st.Status == StatementStatusPaid {
+		return nil, ErrBillingStatementPaid
+	}
+	st, err = tx.BillingStatement.UpdateOne(st).
+		SetStatus(StatementStatusPaid).
+		SetPaidAt(timezone.Now()).
+		SetPaymentNote(note).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("update billing statement: %w", err)
+	}
+	if st.Amount > 0 {
+		if err := tx.User.UpdateOneID(st.UserID).AddBalance(st.Amount).Exec(ctx); err != nil {
+			return nil, fmt.Errorf("credit balance: %w", err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit statement transaction: %w", err)
+	}
+
+	if s.billingCache != nil {
+		_ = s.billingCache.InvalidateUserBalance(ctx, st.UserID)
+	}
+	if err := s.resumeIfSettled(ctx, st.UserID); err != nil {
+		slog.Error("[Postpaid] resume account failed", "userID", st.UserID, "error", err)
+	}
+	return st, nil
+}
+
+// --- Billing cycle ---
+
+// RunBillingCycle issues statements for elapsed periods, sends payment reminders, and
+// suspends accounts with overdue statements. It is safe to run repeatedly.
+func (s *PostpaidService) RunBillingCycle(ctx context.Context) error {
+	now := timezone.Now()
+	if err := s.RefreshCreditLimits(ctx); err != nil {
+		return err
+	}
+	accounts, err := s.entClient.PostpaidAccount.Query().All(ctx)
+	if err != nil {
+		return fmt.Errorf("load postpaid accounts: %w", err)
+	}
+	for _, acc := range accounts {
+		if err := s.issueStatements(ctx, acc, now); err != nil {
+			slog.Error("[Postpaid] issue statements failed", "userID", acc.UserID, "error", err)
+		}
+	}
+	s.sendReminders(ctx, now)
+	return s.markOverdue(ctx, now)
+}
+
+// issueStatements creates a statement for every period that has elapsed since the last one.
+// A statement bills only the credit used in its period, not the usage paid from balance.
+func (s *PostpaidService) issueStatements(ctx context.Context, acc *dbent.PostpaidAccount, now time.Time) error {
+	from := acc.BillingStart
+	last, err := s.entClient.BillingStatement.Query().
+		Where(billingstatement.UserIDEQ(acc.UserID)).
+		Order(dbent.Desc(billingstatement.FieldPeriodEnd)).
+		First(ctx)
+	if err != nil && !dbent.IsNotFound(err) {
+		return fmt.Errorf("get last statement: %w", err)
+	}
+	if last != nil {
+		from = last.PeriodEnd
+	}
+	for _, period := range pendingStatementPeriods(from, now) {
+		usage, count, err := s.sumUsage(ctx, acc.UserID, period[0], period[1])
+		if err != nil {
+			return err
+		}
+		var amount float64
+		if usage > 0 {
+			debt, err := s.unbilledDebt(ctx, acc.UserID, period[1], now)
+			if err != nil {
+				return err
+			}
+			amount = statementCreditAmount(usage, debt)
+		}
+		create := s.entClient.BillingStatement.Create().
+			SetUserID(acc.UserID).
+			SetPeriodStart(period[0]).
+			SetPeriodEnd(period[1]).
+			SetAmount(amount).
+			SetRequestCount(count).
+			SetDueAt(period[1].AddDate(0, 0, acc.PaymentTermsDays))
+		if amount <= 0 {
+			create.SetStatus(StatementStatusPaid).SetPaidAt(now)
+		}
+		if _, err := create.Save(ctx); err != nil {
+			return fmt.Errorf("create statement: %w", err)
+		}
+		slog.Info("[Postpaid] statement issued", "userID", acc.UserID, "periodStart", period[0], "usage", usage, "amount", amount)
+	}
+	return nil
+}
+
+// sumUsage sums the balance-billed actual cost of a user's requests in [start, end).
+func (s *PostpaidService) sumUsage(ctx context.Context, userID int64, start, end time.Time) (float64, int64, error) {
+	var rows []struct {
+		Sum   *float64 `json:"sum"`
+		Count int64    `json:"count"`
+	}
+	err := s.entClient.UsageLog.Query().
+		Where(
+			usagelog.UserIDEQ(userID),
+			usagelog.BillingTypeEQ(BillingTypeBalance),
+			usagelog.CreatedAtGTE(start),
+			usagelog.CreatedAtLT(end),
+		).
+		Aggregate(dbent.As(dbent.Sum(usagelog.FieldActualCost), "sum"), dbent.As(dbent.Count(), "count")).
+		Scan(ctx, &rows)
+	if err != nil {
+		return 0, 0, fmt.Errorf("sum usage: %w", err)
+	}
+	if len(rows) == 0 || rows[0].Sum == nil {
+		return 0, 0, nil
+	}
+	return *rows[0].Sum, rows[0].Count, nil
+}
+
+// unbilledDebt returns how far the user's balance was below zero at periodEnd, less the
+// amount of outstanding statements. The balance at periodEnd is the current balance plus the
+// balance-billed usage since then.
+func (s *PostpaidService) unbilledDebt(ctx context.Context, userID int64, periodEnd, now time.Time) (float64, error) {
+	u, err := s.entClient.User.Get(ctx, userID)
+	if err != nil {
+		return 0, fmt.Errorf("get user: %w", err)
+	}
+	since, _, err := s.sumUsage(ctx, userID, periodEnd, now)
+	if err != nil {
+		return 0, err
+	}
+	var rows []struct {
+		Sum *float64 `json:"sum"`
+	}
+	err = s.entClient.BillingStatement.Query().
+		Where(billingstatement.UserIDEQ(userID), billingstatement.StatusIn(StatementStatusUnpaid, StatementStatusOverdue)).
+		Aggregate(dbent.As(dbent.Sum(billingstatement.FieldAmount), "sum")).
+		Scan(ctx, &rows)
+	if err != nil {
+		return 0, fmt.Errorf("sum outstanding statements: %w", err)
+	}
+	var outstanding float64
+	if len(rows) > 0 && rows[0].Sum != nil {
+		outstanding = *rows[0].Sum
+	}
+	return -(u.Balance + since) - outstanding, nil
+}
+
+// sendReminders emails the payment reminder of statements that are due soon.
+func (s *PostpaidService) sendReminders(ctx context.Context, now time.Time) {
+	if s.emailService == nil {
+		return
+	}
+	statements, err := s.entClient.BillingStatement.Query().
+		Where(
+			billingstatement.StatusEQ(StatementStatusUnpaid),
+			billingstatement.ReminderSentAtIsNil(),
+			billingstatement.DueAtLTE(now.Add(postpaidReminderLeadTime)),
+		).
+		All(ctx)
+	if err != nil {
+		slog.Error("[Postpaid] query statements for reminders failed", "error", err)
+		return
+	}
+	for _, st := range statements {
+		if !statementReminderDue(st, now) {
+			continue
+		}
+		if err := s.notify(ctx, st, false); err != nil {
+			slog.Warn("[Postpaid] send reminder failed", "statementID", st.ID, "error", err)
+			continue
+		}
+		if err := s.entClient.BillingStatement.UpdateOne(st).SetReminderSentAt(now).Exec(ctx); err != nil {
+			slog.Warn("[Postpaid] record reminder failed", "statementID", st.ID, "error", err)
+		}
+	}
+}
+
+// markOverdue flags unpaid statements past their due date and suspends their accounts.
+func (s *PostpaidService) markOverdue(ctx context.Context, now time.Time) error {
+	statements, err := s.entClient.BillingStatement.Query().
+		Where(billingstatement.StatusEQ(StatementStatusUnpaid), billingstatement.DueAtLTE(now)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("query due statements: %w", err)
+	}
+	for _, st := range statements {
+		n, err := s.entClient.BillingStatement.Update().
+			Where(billingstatement.IDEQ(st.ID), billingstatement.StatusEQ(StatementStatusUnpaid)).
+			SetStatus(StatementStatusOverdue).
+			SetOverdueAt(now).
+			Save(ctx)
+		if err != nil {
+			slog.Error("[Postpaid] mark statement overdue failed", "statementID", st.ID, "error", err)
+			continue
+		}
+		if n == 0 {
+			continue // paid concurrently
+		}
+		if err := s.suspend(ctx, st.UserID, now); err != nil {
+			slog.Error("[Postpaid] suspend account failed", "userID", st.UserID, "error", err)
+		}
+		if s.emailService != nil {
+			if err := s.notify(ctx, st, true); err != nil {
+				slog.Warn("[Postpaid] send overdue notice failed", "statementID", st.ID, "error", err)
+			}
+		}
+	}
+	return nil
+}
+
+// suspend marks a postpaid account suspended and suspends all of the user's active API keys.
+func (s *PostpaidService) suspend(ctx context.Context, userID int64, now time.Time) error {
+	if _, err := s.entClient.PostpaidAccount.Update().
+		Where(postpaidaccount.UserIDEQ(userID), postpaidaccount.StatusEQ(PostpaidStatusActive)).
+		SetStatus(PostpaidStatusSuspended).
+		SetSuspendedAt(now).
+		Save(ctx); err != nil {
+		return fmt.Errorf("suspend postpaid account: %w", err)
+	}
+	n, err := s.entClient.APIKey.Update().
+		Where(apikey.UserIDEQ(userID), apikey.StatusEQ(StatusAPIKeyActive)).
+		SetStatus(StatusAPIKeySuspended).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("suspend api keys: %w", err)
+	}
+	if s.authCacheInvalidator != nil {
+		s.authCacheInvalidator.InvalidateAuthCacheByUserID(ctx, userID)
+	}
+	slog.Info("[Postpaid] account suspended for overdue statement", "userID", userID, "keys", n)
+	return nil
+}
+
+// resumeIfSettled resumes a suspended account once none of its statements is overdue.
+func (s *PostpaidService) resumeIfSettled(ctx context.Context, userID int64) error {
+	overdue, err := s.entClient.BillingStatement.Query().
+		Where(billingstatement.UserIDEQ(userID), billingstatement.StatusEQ(StatementStatusOverdue)).
+		Exist(ctx)
+	if err != nil || overdue {
+		return err
+	}
+	n, err := s.entClient.PostpaidAccount.Update().
+		Where(postpaidaccount.UserIDEQ(userID), postpaidaccount.StatusEQ(PostpaidStatusSuspended)).
+		SetStatus(PostpaidStatusActive).
+		ClearSuspendedAt().
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("resume postpaid account: %w", err)
+	}
+	if n > 0 {
+		s.resumeKeys(ctx, userID)
+	}
+	return nil
+}
+
+// resumeKeys reactivates the API keys that were suspended for an overdue statement.
+func (s *PostpaidService) resumeKeys(ctx context.Context, userID int64) {
+	n, err := s.entClient.APIKey.Update().
+		Where(apikey.UserIDEQ(userID), apikey.StatusEQ(StatusAPIKeySuspended)).
+		SetStatus(StatusAPIKeyActive).
+		Save(ctx)
+	if err != nil {
+		slog.Error("[Postpaid] resume api keys failed", "userID", userID, "error", err)
+		return
+	}
+	if s.authCacheInvalidator != nil {
+		s.authCacheInvalidator.InvalidateAuthCacheByUserID(ctx, userID)
+	}
+	slog.Info("[Postpaid] account resumed", "userID", userID, "keys", n)
+}
+
+// notify emails a payment reminder, or an overdue notice when overdue is true.
+func (s *PostpaidService) notify(ctx context.Context, st *dbent.BillingStatement, overdue bool) error {
+	user, err := s.userRepo.GetByID(ctx, st.UserID)
+	if err != nil {
+		return err
+	}
+	siteName := "Sub2API"
+	if s.settingService != nil {
+		siteName = s.settingService.GetSiteName(ctx)
+	}
+	subject := fmt.Sprintf("[%s] 账单付款提醒 / Statement payment reminder", siteName)
+	if overdue {
+		subject = fmt.Sprintf("[%s] 账单已逾期 / Statement overdue", siteName)
+	}
+	return s.emailService.SendEmail(ctx, user.Email, subject, buildStatementEmailBody(siteName, user.Username, st, overdue))
+}
+
+func buildStatementEmailBody(siteName, userName string, st *dbent.BillingStatement, overdue bool) string {
+	loc := timezone.Location()
+	notice := "请在到期日前完成付款。<br>Please settle this statement before the due date."
+	if overdue {
+		notice = "该账单已逾期，您的 API Key 已被暂停，结清后将自动恢复。<br>" +
+			"This statement is overdue and your API keys have been suspended. They will be resumed once it is settled."
+	}
+	return fmt.Sprintf(`<div style="font-family:Helvetica,Arial,sans-serif;max-width:560px;margin:0 auto;color:#222;">
+<h2>%s</h2>
+<p>%s，您好 / Hello %s,</p>
+<table cellpadding="6" style="border-collapse:collapse;font-size:14px;">
+<tr><td>账期 / Period</td><td>%s – %s</td></tr>
+<tr><td>金额 / Amount</td><td><b>$%.2f</b></td></tr>
+<tr><td>请求数 / Requests</td><td>%d</td></tr>
+<tr><td>到期日 / Due date</td><td>%s</td></tr>
+</table>
+<p>%s</p>
+</div>`,
+		html.EscapeString(siteName), html.EscapeString(userName), html.EscapeString(userName),
+		st.PeriodStart.In(loc).Format("2006-01-02"), st.PeriodEnd.In(loc).AddDate(0, 0, -1).Format("2006-01-02"),
+		st.Amount, st.RequestCount, st.DueAt.In(loc).Format("2006-01-02"), notice)
+}
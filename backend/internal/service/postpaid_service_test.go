@@ -0,0 +1,195 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	dbent "github.com/Wei-Shaw/sub2api/ent"
+	"github.com/Wei-Shaw/sub2api/ent/billingstatement"
+	"github.com/Wei-Shaw/sub2api/ent/enttest"
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/timezone"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	_ "modernc.org/sqlite"
+)
+
+type creditLimitStub map[int64]float64
+
+func (s creditLimitStub) CreditLimit(userID int64) float64 { return s[userID] }
+
+func TestPendingStatementPeriods(t *testing.T) {
+	t.Parallel()
+	loc := timezone.Location()
+	start := time.Date(2026, 1, 20, 15, 30, 0, 0, loc)
+
+	// 当月尚未结束：不出账
+	assert.Empty(t, pendingStatementPeriods(start, time.Date(2026, 1, 31, 23, 59, 0, 0, loc)))
+
+	// 首期为不完整月份，之后按自然月
+	periods := pendingStatementPeriods(start, time.Date(2026, 3, 5, 0, 0, 0, 0, loc))
+	require.Len(t, periods, 2)
+	assert.Equal(t, start, periods[0][0])
+	assert.True(t, periods[0][1].Equal(time.Date(2026, 2, 1, 0, 0, 0, 0, loc)))
+	assert.True(t, periods[1][0].Equal(time.Date(2026, 2, 1, 0, 0, 0, 0, loc)))
+	assert.True(t, periods[1][1].Equal(time.Date(2026, 3, 1, 0, 0, 0, 0, loc)))
+
+	// 跨年
+	periods = pendingStatementPeriods(time.Date(2026, 12, 1, 0, 0, 0, 0, loc), time.Date(2027, 1, 1, 0, 0, 0, 0, loc))
+	require.Len(t, periods, 1)
+	assert.True(t, periods[0][1].Equal(time.Date(2027, 1, 1, 0, 0, 0, 0, loc)))
+}
+
+func TestStatementReminderDue(t *testing.T) {
+	t.Parallel()
+	due := time.Date(2026, 2, 16, 0, 0, 0, 0, time.UTC)
+	st := &dbent.BillingStatement{Status: StatementStatusUnpaid, Amount: 12.5, DueAt: due}
+
+	assert.False(t, statementReminderDue(st, due.Add(-postpaidReminderLeadTime-time.Minute)))
+	assert.True(t, statementReminderDue(st, due.Add(-postpaidReminderLeadTime)))
+
+	sent := due.Add(-48 * time.Hour)
+	assert.False(t, statementReminderDue(&dbent.BillingStatement{Status: StatementStatusUnpaid, Amount: 12.5, DueAt: due, ReminderSentAt: &sent}, due))
+	assert.False(t, statementReminderDue(&dbent.BillingStatement{Status: StatementStatusPaid, Amount: 12.5, DueAt: due}, due))
+	assert.False(t, statementReminderDue(&dbent.BillingStatement{Status: StatementStatusUnpaid, DueAt: due}, due))
+}
+
+func TestStatementCreditAmount(t *testing.T) {
+	t.Parallel()
+	// 用量全部由预付余额覆盖：不出账
+	assert.Zero(t, statementCreditAmount(30, -20))
+	// 部分用量动用额度：只收额度部分
+	assert.InDelta(t, 50, statementCreditAmount(150, 50), 1e-9)
+	// 欠款不超过本期用量：以本期用量为上限
+	assert.InDelta(t, 30, statementCreditAmount(30, 80), 1e-9)
+}
+
+func TestIssueStatements_BillsOnlyCreditUsed(t *testing.T) {
+	db, err := sql.Open("sqlite", "file:postpaid_issue_statements?mode=memory&cache=shared")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+	_, err = db.Exec("PRAGMA foreign_keys = ON")
+	require.NoError(t, err)
+	client := enttest.NewClient(t, enttest.WithOptions(dbent.Driver(entsql.OpenDB(dialect.SQLite, db))))
+	t.Cleanup(func() { _ = client.Close() })
+
+	ctx := context.Background()
+	loc := timezone.Location()
+	user, err := client.User.Create().
+		SetEmail("postpaid@example.com").
+		SetPasswordHash("test-password-hash").
+		SetRole(RoleUser).
+		SetStatus(StatusActive).
+		Save(ctx)
+	require.NoError(t, err)
+	key, err := client.APIKey.Create().SetUserID(user.ID).SetKey("sk-postpaid").SetName("k").Save(ctx)
+	require.NoError(t, err)
+	account, err := client.Account.Create().SetName("a").SetPlatform(PlatformAnthropic).SetType(AccountTypeAPIKey).Save(ctx)
+	require.NoError(t, err)
+	acc, err := client.PostpaidAccount.Create().
+		SetUserID(user.ID).
+		SetCreditLimit(200).
+		SetPaymentTermsDays(15).
+		SetBillingStart(time.Date(2026, 1, 1, 0, 0, 0, 0, loc)).
+		Save(ctx)
+	require.NoError(t, err)
+
+	addUsage := func(requestID string, cost float64, billingType int8, at time.Time) {
+		_, err := client.UsageLog.Create().
+			SetUserID(user.ID).
+			SetAPIKeyID(key.ID).
+			SetAccountID(account.ID).
+			SetRequestID(requestID).
+			SetModel("claude-sonnet-4-5").
+			SetTotalCost(cost).
+			SetActualCost(cost).
+			SetBillingType(billingType).
+			SetCreatedAt(at).
+			Save(ctx)
+		require.NoError(t, err)
+	}
+	// 1 月：预付余额 100，用量 150 → 余额 -50（动用额度 50）
+	addUsage("jan-1", 80, BillingTypeBalance, time.Date(2026, 1, 10, 0, 0, 0, 0, loc))
+	addUsage("jan-2", 70, BillingTypeBalance, time.Date(2026, 1, 20, 0, 0, 0, 0, loc))
+	// 2 月：用量 30 → 余额 -80；订阅计费的用量不计入账单
+	addUsage("feb-1", 30, BillingTypeBalance, time.Date(2026, 2, 10, 0, 0, 0, 0, loc))
+	addUsage("feb-2", 500, BillingTypeSubscription, time.Date(2026, 2, 11, 0, 0, 0, 0, loc))
+	require.NoError(t, client.User.UpdateOneID(user.ID).SetBalance(100-150-30).Exec(ctx))
+
+	svc := &PostpaidService{entClient: client}
+	require.NoError(t, svc.issueStatements(ctx, acc, time.Date(2026, 3, 5, 0, 0, 0, 0, loc)))
+
+	statements, err := client.BillingStatement.Query().Order(dbent.Asc(billingstatement.FieldPeriodStart)).All(ctx)
+	require.NoError(t, err)
+	require.Len(t, statements, 2)
+	assert.InDelta(t, 50, statements[0].Amount, 1e-9)
+	assert.EqualValues(t, 2, statements[0].RequestCount)
+	assert.InDelta(t, 30, statements[1].Amount, 1e-9)
+	assert.EqualValues(t, 1, statements[1].RequestCount)
+
+	// 结清后余额恰好回到 0，预付部分不会被重复返还
+	assert.InDelta(t, 0, -80+statements[0].Amount+statements[1].Amount, 1e-9)
+}
+
+func TestValidatePostpaidInput(t *testing.T) {
+	t.Parallel()
+	in := PostpaidAccountInput{CreditLimit: 500}
+	require.NoError(t, validatePostpaidInput(&in))
+	assert.Equal(t, postpaidDefaultTermsDays, in.PaymentTermsDays)
+
+	assert.ErrorIs(t, validatePostpaidInput(&PostpaidAccountInput{CreditLimit: -1}), ErrPostpaidInvalidTerms)
+	assert.ErrorIs(t, validatePostpaidInput(&PostpaidAccountInput{PaymentTermsDays: 91}), ErrPostpaidInvalidTerms)
+}
+
+func TestCheckBalanceEligibility_CreditLimit(t *testing.T) {
+	userRepo := &balanceLoadUserRepoStub{balance: -40}
+	svc := NewBillingCacheService(&billingCacheMissStub{}, userRepo, nil, nil, &config.Config{})
+	t.Cleanup(svc.Stop)
+	ctx := context.Background()
+
+	// 预付费：余额为负即拒绝
+	require.ErrorIs(t, svc.checkBalanceEligibility(ctx, 1), ErrInsufficientBalance)
+
+	// 后付费：额度内放行，触达 -credit_limit 时拒绝
+	svc.SetCreditLimitProvider(creditLimitStub{1: 100, 2: 40})
+	require.NoError(t, svc.checkBalanceEligibility(ctx, 1))
+	require.ErrorIs(t, svc.checkBalanceEligibility(ctx, 2), ErrInsufficientBalance)
+}
+
+func TestAPIKeyService_IsBalanceExhausted(t *testing.T) {
+	svc := &APIKeyService{}
+	user := &User{ID: 7, Balance: -10}
+	assert.True(t, svc.IsBalanceExhausted(user))
+	assert.False(t, svc.IsBalanceExhausted(&User{ID: 7, Balance: 0.01}))
+
+	svc.SetCreditLimitProvider(creditLimitStub{7: 50})
+	assert.False(t, svc.IsBalanceExhausted(user))
+	assert.True(t, svc.IsBalanceExhausted(&User{ID: 7, Balance: -50}))
+}
+
+func TestBuildStatementEmailBody(t *testing.T) {
+	t.Parallel()
+	loc := timezone.Location()
+	st := &dbent.BillingStatement{
+		PeriodStart:  time.Date(2026, 1, 1, 0, 0, 0, 0, loc),
+		PeriodEnd:    time.Date(2026, 2, 1, 0, 0, 0, 0, loc),
+		DueAt:        time.Date(2026, 2, 16, 0, 0, 0, 0, loc),
+		Amount:       123.456,
+		RequestCount: 42,
+	}
+	body := buildStatementEmailBody("Site", "<acme>", st, false)
+	assert.Contains(t, body, "&lt;acme&gt;")
+	assert.Contains(t, body, "2026-01-01 – 2026-01-31")
+	assert.Contains(t, body, "$123.46")
+	assert.Contains(t, body, "2026-02-16")
+	assert.NotContains(t, body, "suspended")
+
+	assert.Contains(t, buildStatementEmailBody("Site", "acme", st, true), "suspended")
+}
@@ -0,0 +1,244 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/ctxkey"
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+)
+
+// 请求优先级分类（从高到低）
+const (
+	PriorityClassInteractive = "interactive"
+	PriorityClassStandard    = "standard"
+	PriorityClassBatch       = "batch"
+)
+
+// PriorityClasses 所有优先级分类（从高到低）
+var PriorityClasses = []string{
+	PriorityClassInteractive,
+	PriorityClassStandard,
+	PriorityClassBatch,
+}
+
+// ErrInvalidPriorityClass 非法的优先级分类
+var ErrInvalidPriorityClass = infraerrors.BadRequest("INVALID_PRIORITY_CLASS", "invalid priority class, must be one of: interactive, standard, batch")
+
+const (
+	// priorityClassStatsMaxSamples 每个分类保留的排队延迟样本上限
+	priorityClassStatsMaxSamples = 4096
+	// defaultPriorityClassStatsWindow 排队延迟统计的默认滑动窗口
+	defaultPriorityClassStatsWindow = 5 * time.Minute
+)
+
+// IsValidPriorityClass 校验优先级分类（空字符串表示 standard）
+func IsValidPriorityClass(class string) bool {
+	if class == "" {
+		return true
+	}
+	for _, c := range PriorityClasses {
+		if class == c {
+			return true
+		}
+	}
+	return false
+}
+
+// NormalizePriorityClass 规范化优先级分类，空值或未知值视为 standard
+func NormalizePriorityClass(class string) string {
+	class = strings.ToLower(strings.TrimSpace(class))
+	if class == "" || !IsValidPriorityClass(class) {
+		return PriorityClassStandard
+	}
+	return class
+}
+
+// priorityClassRank 分类排名，数值越小优先级越高
+func priorityClassRank(class string) int {
+	switch NormalizePriorityClass(class) {
+	case PriorityClassInteractive:
+		return 0
+	case PriorityClassBatch:
+		return 2
+	default:
+		return 1
+	}
+}
+
+// ResolvePriorityClass 计算请求的最终优先级分类。
+// 请求头只能降低 API Key 声明的分类，不能提升（避免客户端自行抢占高优先级）。
+func ResolvePriorityClass(keyClass, requested string) string {
+	base := NormalizePriorityClass(keyClass)
+	requested = strings.ToLower(strings.TrimSpace(requested))
+	if requested == "" || !IsValidPriorityClass(requested) {
+		return base
+	}
+	if priorityClassRank(requested) > priorityClassRank(base) {
+		return requested
+	}
+	return base
+}
+
+// WithPriorityClass 将优先级分类写入 context
+func WithPriorityClass(ctx context.Context, class string) context.Context {
+	return context.WithValue(ctx, ctxkey.PriorityClass, NormalizePriorityClass(class))
+}
+
+// PriorityClassFromContext 读取请求的优先级分类（未设置时为 standard）
+func PriorityClassFromContext(ctx context.Context) string {
+	if ctx == nil {
+		return PriorityClassStandard
+	}
+	if class, ok := ctx.Value(ctxkey.PriorityClass).(string); ok {
+		return NormalizePriorityClass(class)
+	}
+	return PriorityClassStandard
+}
+
+// isBatchPriorityRequest 当前请求是否为 batch 分类
+func isBatchPriorityRequest(ctx context.Context) bool {
+	return PriorityClassFromContext(ctx) == PriorityClassBatch
+}
+
+// priorityLoadCeiling 返回当前请求可直接占用槽位的账号负载率上限（不含）。
+// batch 请求受 gateway.priority_classes.batch_max_load_rate 约束，其余请求为 100。
+func priorityLoadCeiling(ctx context.Context, cfg *config.Config) int {
+	if cfg == nil || !cfg.Gateway.PriorityClasses.Enabled || !isBatchPriorityRequest(ctx) {
+		return 100
+	}
+	limit := cfg.Gateway.PriorityClasses.BatchMaxLoadRate
+	if limit <= 0 || limit > 100 {
+		return 100
+	}
+	return limit
+}
+
+// priorityAllowsAccountLoad 检查账号当前负载是否允许本请求直接使用（仅 batch 请求需要查询负载）
+func priorityAllowsAccountLoad(ctx context.Context, cfg *config.Config, concurrency *ConcurrencyService, account *Account) bool {
+	ceiling := priorityLoadCeiling(ctx, cfg)
+	if ceiling >= 100 || concurrency == nil || account == nil {
+		return true
+	}
+	loadMap, err := concurrency.GetAccountsLoadBatch(ctx, []AccountWithConcurrency{{
+		ID:             account.ID,
+		MaxConcurrency: account.EffectiveLoadFactor(),
+	}})
+	if err != nil {
+		return true
+	}
+	if info := loadMap[account.ID]; info != nil {
+		return info.LoadRate < ceiling
+	}
+	return true
+}
+
+// PriorityClassQueueStats 单个优先级分类的排队统计
+type PriorityClassQueueStats struct {
+	Class string `json:"class"`
+	// QueueDepth 所有实例中该分类正在排队的请求数
+	QueueDepth int64 `json:"queue_depth"`
+	// LocalWaiting 当前实例中该分类正在等待的请求数
+	LocalWaiting int64 `json:"local_waiting"`
+	// Served/TimedOut 统计窗口内获得槽位/放弃等待的请求数（当前实例）
+	Served   int64 `json:"served"`
+	TimedOut int64 `json:"timed_out"`
+	// 排队延迟（毫秒，仅统计进入等待队列的请求）
+	WaitAvgMs float64 `json:"wait_avg_ms"`
+	WaitP50Ms int64   `json:"wait_p50_ms"`
+	WaitP95Ms int64   `json:"wait_p95_ms"`
+	WaitMaxMs int64   `json:"wait_max_ms"`
+}
+
+type priorityClassSample struct {
+	at     time.Time
+	wait   time.Duration
+	served bool
+}
+
+// priorityClassTracker 按分类记录当前实例的排队样本（滑动窗口）
+type priorityClassTracker struct {
+	mu      sync.Mutex
+	window  time.Duration
+	waiting map[string]int64
+	samples map[string][]priorityClassSample
+}
+
+func newPriorityClassTracker(window time.Duration) *priorityClassTracker {
+	if window <= 0 {
+		window = defaultPriorityClassStatsWindow
+	}
+	return &priorityClassTracker{
+		window:  window,
+		waiting: make(map[string]int64),
+		samples: make(map[string][]priorityClassSample),
+	}
+}
+
+func (t *priorityClassTracker) enter(class string) {
+	t.mu.Lock()
+	t.waiting[NormalizePriorityClass(class)]++
+	t.mu.Unlock()
+}
+
+func (t *priorityClassTracker) leave(class string, wait time.Duration, served bool, now time.Time) {
+	class = NormalizePriorityClass(class)
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.waiting[class] > 0 {
+		t.waiting[class]--
+	}
+	samples := t.prune(class, now)
+	if len(samples) >= priorityClassStatsMaxSamples {
+		samples = samples[1:]
+	}
+	t.samples[class] = append(samples, priorityClassSample{at: now, wait: wait, served: served})
+}
+
+// prune 丢弃窗口外的样本（调用方持有锁）
+func (t *priorityClassTracker) prune(class string, now time.Time) []priorityClassSample {
+	samples := t.samples[class]
+	cutoff := now.Add(-t.window)
+	idx := sort.Search(len(samples), func(i int) bool { return samples[i].at.After(cutoff) })
+	if idx > 0 {
+		samples = append(samples[:0:0], samples[idx:]...)
+		t.samples[class] = samples
+	}
+	return samples
+}
+
+func (t *priorityClassTracker) snapshot(now time.Time) map[string]*PriorityClassQueueStats {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	out := make(map[string]*PriorityClassQueueStats, len(PriorityClasses))
+	for _, class := range PriorityClasses {
+		stats := &PriorityClassQueueStats{Class: class, LocalWaiting: t.waiting[class]}
+		samples := t.prune(class, now)
+		if len(samples) > 0 {
+			waits := make([]int64, 0, len(samples))
+			var total int64
+			for _, sample := range samples {
+				if sample.served {
+					stats.Served++
+				} else {
+					stats.TimedOut++
+				}
+				ms := sample.wait.Milliseconds()
+				waits = append(waits, ms)
+				total += ms
+			}
+			sort.Slice(waits, func(i, j int) bool { return waits[i] < waits[j] })
+			stats.WaitAvgMs = float64(total) / float64(len(waits))
+			stats.WaitP50Ms = waits[(len(waits)-1)*50/100]
+			stats.WaitP95Ms = waits[(len(waits)-1)*95/100]
+			stats.WaitMaxMs = waits[len(waits)-1]
+		}
+		out[class] = stats
+	}
+	return out
+}
@@ -0,0 +1,89 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolvePriorityClass_HeaderOnlyDowngrades(t *testing.T) {
+	require.Equal(t, PriorityClassStandard, ResolvePriorityClass("", ""))
+	require.Equal(t, PriorityClassInteractive, ResolvePriorityClass(PriorityClassInteractive, ""))
+	require.Equal(t, PriorityClassBatch, ResolvePriorityClass(PriorityClassInteractive, " Batch "))
+	require.Equal(t, PriorityClassStandard, ResolvePriorityClass("", PriorityClassInteractive), "请求头不能提升分类")
+	require.Equal(t, PriorityClassBatch, ResolvePriorityClass(PriorityClassBatch, PriorityClassInteractive))
+	require.Equal(t, PriorityClassStandard, ResolvePriorityClass(PriorityClassStandard, "urgent"))
+
+	require.True(t, IsValidPriorityClass(""))
+	require.False(t, IsValidPriorityClass("urgent"))
+}
+
+func TestPriorityLoadCeiling(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.Gateway.PriorityClasses.BatchMaxLoadRate = 60
+	batchCtx := WithPriorityClass(context.Background(), PriorityClassBatch)
+
+	require.Equal(t, 100, priorityLoadCeiling(batchCtx, cfg), "未启用时不限制")
+
+	cfg.Gateway.PriorityClasses.Enabled = true
+	require.Equal(t, 60, priorityLoadCeiling(batchCtx, cfg))
+	require.Equal(t, 100, priorityLoadCeiling(context.Background(), cfg))
+	require.Equal(t, 100, priorityLoadCeiling(WithPriorityClass(context.Background(), PriorityClassInteractive), cfg))
+}
+
+func TestPriorityClassTracker_Snapshot(t *testing.T) {
+	tracker := newPriorityClassTracker(time.Minute)
+	now := time.Now()
+
+	tracker.enter(PriorityClassBatch)
+	tracker.enter(PriorityClassBatch)
+	tracker.enter(PriorityClassInteractive)
+	tracker.leave(PriorityClassBatch, 400*time.Millisecond, true, now.Add(-2*time.Minute)) // 窗口外
+	tracker.leave(PriorityClassBatch, 900*time.Millisecond, false, now)
+	tracker.leave(PriorityClassInteractive, 100*time.Millisecond, true, now)
+
+	stats := tracker.snapshot(now)
+	require.Len(t, stats, len(PriorityClasses))
+
+	batch := stats[PriorityClassBatch]
+	require.Zero(t, batch.LocalWaiting)
+	require.Equal(t, int64(0), batch.Served)
+	require.Equal(t, int64(1), batch.TimedOut)
+	require.Equal(t, int64(900), batch.WaitMaxMs)
+
+	interactive := stats[PriorityClassInteractive]
+	require.Equal(t, int64(1), interactive.Served)
+	require.Equal(t, 100.0, interactive.WaitAvgMs)
+	require.Equal(t, int64(100), interactive.WaitP95Ms)
+}
+
+func TestFairQueueService_PriorityClassStats(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.Gateway.PriorityClasses.Enabled = true
+	cache := &fairQueueCacheStub{present: true, eligible: true}
+	svc := NewFairQueueService(cache, nil, nil, cfg)
+	require.True(t, svc.Enabled(), "仅启用优先级分类时同样使用等待队列")
+
+	ticket := svc.Enter(context.Background(), 1, 10, 7, PriorityClassBatch, time.Second)
+	require.NotNil(t, ticket)
+	require.Equal(t, PriorityClassBatch, cache.enterClass)
+	require.Equal(t, fairQueueWeightScale, cache.enterCost, "未启用公平排队时权重统一为 1")
+	svc.Leave(ticket, true)
+
+	groupID := int64(1)
+	stats := svc.GetPriorityClassStats(context.Background(), &groupID)
+	require.Len(t, stats, 3)
+	byClass := map[string]*PriorityClassQueueStats{}
+	for _, item := range stats {
+		byClass[item.Class] = item
+	}
+	require.Equal(t, int64(0), byClass[PriorityClassInteractive].QueueDepth, "其他分组不计入")
+	require.Equal(t, int64(1), byClass[PriorityClassStandard].QueueDepth)
+	require.Equal(t, int64(1), byClass[PriorityClassBatch].QueueDepth)
+	require.Equal(t, int64(1), byClass[PriorityClassBatch].Served)
+}
@@ -29,6 +29,7 @@ type PromoService struct {
 	billingCacheService  *BillingCacheService
 	entClient            *dbent.Client
 	authCacheInvalidator APIKeyAuthCacheInvalidator
+	balanceBuckets       *BalanceBucketService
 }
 
 // NewPromoService 创建优惠码服务实例
@@ -38,6 +39,7 @@ func NewPromoService(
 	billingCacheService *BillingCacheService,
 	entClient *dbent.Client,
 	authCacheInvalidator APIKeyAuthCacheInvalidator,
+	balanceBuckets *BalanceBucketService,
 ) *PromoService {
 	return &PromoService{
 		promoRepo:            promoRepo,
@@ -45,6 +47,7 @@ func NewPromoService(
 		billingCacheService:  billingCacheService,
 		entClient:            entClient,
 		authCacheInvalidator: authCacheInvalidator,
+		balanceBuckets:       balanceBuckets,
 	}
 }
 
@@ -127,6 +130,9 @@ func (s *PromoService) ApplyPromoCode(ctx context.Context, userID int64, code st
 	if err := s.userRepo.UpdateBalance(txCtx, userID, promoCode.BonusAmount); err != nil {
 		return fmt.Errorf("update user balance: %w", err)
 	}
+	if err := s.balanceBuckets.Grant(txCtx, userID, BalanceSourcePromo, promoCode.BonusAmount, promoCode.Code); err != nil {
+		return err
+	}
 
 	// 创建使用记录
 	usage := &PromoCodeUsage{
@@ -0,0 +1,63 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/model"
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+)
+
+var (
+	ErrProxyPoolNotFound      = infraerrors.NotFound("PROXY_POOL_NOT_FOUND", "proxy pool not found")
+	ErrProxyPoolInUse         = infraerrors.Conflict("PROXY_POOL_IN_USE", "proxy pool is in use by accounts")
+	ErrProxyPoolInvalidMember = infraerrors.BadRequest("PROXY_POOL_INVALID_MEMBER", "proxy pool member does not exist")
+)
+
+// ProxyPool 代理池：带权重的代理集合
+type ProxyPool struct {
+	ID          int64                   `json:"id"`
+	Name        string                  `json:"name"`
+	Status      string                  `json:"status"`
+	Members     []model.ProxyPoolMember `json:"members"`
+	Description *string                 `json:"description,omitempty"`
+	CreatedAt   time.Time               `json:"created_at"`
+	UpdatedAt   time.Time               `json:"updated_at"`
+}
+
+func (p *ProxyPool) IsActive() bool {
+	return p.Status == StatusActive
+}
+
+// ProxyPoolMemberStatus 代理池成员运行状态（供管理端展示）
+type ProxyPoolMemberStatus struct {
+	ProxyID             int64      `json:"proxy_id"`
+	Name                string     `json:"name"`
+	Weight              int        `json:"weight"`
+	Available           bool       `json:"available"` // 代理存在且处于启用状态
+	Healthy             bool       `json:"healthy"`
+	LatencyMs           *int64     `json:"latency_ms,omitempty"`
+	ConsecutiveFailures int        `json:"consecutive_failures"`
+	LastError           string     `json:"last_error,omitempty"`
+	LastCheckedAt       *time.Time `json:"last_checked_at,omitempty"`
+	AssignedAccounts    int        `json:"assigned_accounts"` // 当前实例上粘性分配到该成员的账号数
+}
+
+// ProxyPoolWithStatus 代理池及其成员运行状态
+type ProxyPoolWithStatus struct {
+	ProxyPool
+	AccountCount  int64                   `json:"account_count"`
+	MemberStatus  []ProxyPoolMemberStatus `json:"member_status"`
+	HealthyCount  int                     `json:"healthy_count"`
+	AvailableSize int                     `json:"available_size"`
+}
+
+type ProxyPoolRepository interface {
+	Create(ctx context.Context, pool *ProxyPool) error
+	GetByID(ctx context.Context, id int64) (*ProxyPool, error)
+	Update(ctx context.Context, pool *ProxyPool) error
+	Delete(ctx context.Context, id int64) error
+	List(ctx context.Context) ([]ProxyPool, error)
+	ListActive(ctx context.Context) ([]ProxyPool, error)
+	CountAccountsByPoolID(ctx context.Context, poolID int64) (int64, error)
+}
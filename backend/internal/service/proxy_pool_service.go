@@ -0,0 +1,668 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"hash/fnv"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/Wei-Shaw/sub2api/internal/model"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/logger"
+)
+
+const (
+	defaultProxyPoolProbeTimeout     = 10 * time.Second
+	defaultProxyPoolFailureThreshold = 2
+	// proxyPoolProbeConcurrency 单轮探测的最大并发数
+	proxyPoolProbeConcurrency = 8
+)
+
+// CreateProxyPoolInput 创建代理池参数
+type CreateProxyPoolInput struct {
+	Name        string
+	Status      string
+	Members     []model.ProxyPoolMember
+	Description *string
+}
+
+// UpdateProxyPoolInput 更新代理池参数（nil 表示不修改）
+type UpdateProxyPoolInput struct {
+	Name        *string
+	Status      *string
+	Members     []model.ProxyPoolMember
+	Description *string
+}
+
+// proxyPoolRuntime 代理池运行时快照（启用的池及其可用成员）
+type proxyPoolRuntime struct {
+	pool    ProxyPool
+	members []proxyPoolRuntimeMember
+}
+
+type proxyPoolRuntimeMember struct {
+	proxy  *Proxy
+	weight int
+}
+
+// proxyMemberHealth 成员健康状态（进程内维护：周期探测 + 转发连接错误）
+type proxyMemberHealth struct {
+	healthy   bool
+	failures  int
+	latencyMs *int64
+	lastError string
+	checkedAt time.Time
+}
+
+// ProxyPoolService 代理池服务
+//
+// 账号引用代理池时，出口代理由 ApplyAccountProxy（调度读取账号时）与 resolveAccountProxyURL
+// （令牌刷新、账号测试、用量/配额查询等其余出站路径）统一解析：
+//   - 粘性：账号保持当前分配的成员，只要该成员仍在池内且健康，避免出口 IP 频繁变化
+//   - 选择：首次分配或当前成员不健康时，按加权 rendezvous 哈希在健康成员中选择，
+//     同一账号在各实例上得到相同结果，成员增减时只有少量账号迁移
+//   - 健康：周期探测（ProxyExitInfoProber，结果写入代理延迟缓存）与转发时的连续连接错误共同判定
+type ProxyPoolService struct {
+	repo         ProxyPoolRepository
+	proxyRepo    ProxyRepository
+	prober       ProxyExitInfoProber
+	latencyCache ProxyLatencyCache
+	cfg          *config.Config
+
+	mu          sync.RWMutex
+	pools       map[int64]*proxyPoolRuntime
+	proxyURLs   map[string]int64 // 成员代理 URL → 代理 ID
+	health      map[int64]*proxyMemberHealth
+	assignments map[int64]int64 // 账号 ID → 当前出口代理 ID
+
+	stopCh   chan struct{}
+	stopOnce sync.Once
+	wg       sync.WaitGroup
+}
+
+// NewProxyPoolService 创建代理池服务
+func NewProxyPoolService(
+	repo ProxyPoolRepository,
+	proxyRepo ProxyRepository,
+	prober ProxyExitInfoProber,
+	latencyCache ProxyLatencyCache,
+	cfg *config.Config,
+) *ProxyPoolService {
+	return &ProxyPoolService{
+		repo:         repo,
+		proxyRepo:    proxyRepo,
+		prober:       prober,
+		latencyCache: latencyCache,
+		cfg:          cfg,
+		pools:        make(map[int64]*proxyPoolRuntime),
+		proxyURLs:    make(map[string]int64),
+		health:       make(map[int64]*proxyMemberHealth),
+		assignments:  make(map[int64]int64),
+		stopCh:       make(chan struct{}),
+	}
+}
+
+// Start 加载代理池并启动周期探测
+func (s *ProxyPoolService) Start() {
+	if s == nil || s.repo == nil {
+		return
+	}
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		if err := s.Refresh(ctx); err != nil {
+			logger.LegacyPrintf("service.proxy_pool", "[ProxyPool] initial load failed: %v", err)
+		}
+		cancel()
+
+		interval := s.probeInterval()
+		if interval <= 0 {
+			return
+		}
+		s.probeAll()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-s.stopCh:
+				return
+			case <-ticker.C:
+				s.probeAll()
+			}
+		}
+	}()
+}
+
+// Stop 停止周期探测
+func (s *ProxyPoolService) Stop() {
+	if s == nil {
+		return
+	}
+	s.stopOnce.Do(func() {
+		close(s.stopCh)
+	})
+	s.wg.Wait()
+}
+
+func (s *ProxyPoolService) probeInterval() time.Duration {
+	if s.cfg == nil {
+		return 0
+	}
+	return time.Duration(s.cfg.Gateway.ProxyPool.ProbeIntervalSeconds) * time.Second
+}
+
+func (s *ProxyPoolService) probeTimeout() time.Duration {
+	if s.cfg != nil && s.cfg.Gateway.ProxyPool.ProbeTimeoutSeconds > 0 {
+		return time.Duration(s.cfg.Gateway.ProxyPool.ProbeTimeoutSeconds) * time.Second
+	}
+	return defaultProxyPoolProbeTimeout
+}
+
+func (s *ProxyPoolService) failureThreshold() int {
+	if s.cfg != nil && s.cfg.Gateway.ProxyPool.FailureThreshold > 0 {
+		return s.cfg.Gateway.ProxyPool.FailureThreshold
+	}
+	return defaultProxyPoolFailureThreshold
+}
+
+// ========== 管理端 ==========
+
+// List 列出所有代理池及成员状态
+func (s *ProxyPoolService) List(ctx context.Context) ([]ProxyPoolWithStatus, error) {
+	pools, err := s.repo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]ProxyPoolWithStatus, 0, len(pools))
+	for i := range pools {
+		view, err := s.withStatus(ctx, &pools[i])
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, *view)
+	}
+	return out, nil
+}
+
+// GetByID 获取代理池及成员状态
+func (s *ProxyPoolService) GetByID(ctx context.Context, id int64) (*ProxyPoolWithStatus, error) {
+	pool, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.withStatus(ctx, pool)
+}
+
+// Create 创建代理池
+func (s *ProxyPoolService) Create(ctx context.Context, input *CreateProxyPoolInput) (*ProxyPoolWithStatus, error) {
+	pool := &ProxyPool{
+		Name:        strings.TrimSpace(input.Name),
+		Status:      input.Status,
+		Members:     model.NormalizeProxyPoolMembers(input.Members),
+		Description: input.Description,
+	}
+	if pool.Status == "" {
+		pool.Status = StatusActive
+	}
+	if err := s.validateMembers(ctx, pool.Members); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Create(ctx, pool); err != nil {
+		return nil, err
+	}
+	s.refreshAfterChange(ctx)
+	return s.withStatus(ctx, pool)
+}
+
+// Update 更新代理池
+func (s *ProxyPoolService) Update(ctx context.Context, id int64, input *UpdateProxyPoolInput) (*ProxyPoolWithStatus, error) {
+	pool, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if input.Name != nil {
+		pool.Name = strings.TrimSpace(*input.Name)
+	}
+	if input.Status != nil {
+		pool.Status = *input.Status
+	}
+	if input.Members != nil {
+		pool.Members = model.NormalizeProxyPoolMembers(input.Members)
+		if err := s.validateMembers(ctx, pool.Members); err != nil {
+			return nil, err
+		}
+	}
+	if input.Description != nil {
+		pool.Description = input.Description
+	}
+	if err := s.repo.Update(ctx, pool); err != nil {
+		return nil, err
+	}
+	s.refreshAfterChange(ctx)
+	return s.withStatus(ctx, pool)
+}
+
+// Delete 删除代理池（仍被账号引用时拒绝）
+func (s *ProxyPoolService) Delete(ctx context.Context, id int64) error {
+	count, err := s.repo.CountAccountsByPoolID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return ErrProxyPoolInUse
+	}
+	if err := s.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+	s.refreshAfterChange(ctx)
+	return nil
+}
+
+// Probe 立即探测代理池的所有成员并返回最新状态
+func (s *ProxyPoolService) Probe(ctx context.Context, id int64) (*ProxyPoolWithStatus, error) {
+	pool, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	proxies, err := s.loadMemberProxies(ctx, pool.Members)
+	if err != nil {
+		return nil, err
+	}
+	s.probeProxies(ctx, proxies)
+	return s.withStatus(ctx, pool)
+}
+
+func (s *ProxyPoolService) validateMembers(ctx context.Context, members []model.ProxyPoolMember) error {
+	if len(members) == 0 {
+		return nil
+	}
+	ids := make([]int64, 0, len(members))
+	for _, m := range members {
+		ids = append(ids, m.ProxyID)
+	}
+	proxies, err := s.proxyRepo.ListByIDs(ctx, ids)
+	if err != nil {
+		return err
+	}
+	if len(proxies) != len(ids) {
+		return ErrProxyPoolInvalidMember
+	}
+	return nil
+}
+
+func (s *ProxyPoolService) refreshAfterChange(ctx context.Context) {
+	if err := s.Refresh(ctx); err != nil {
+		logger.LegacyPrintf("service.proxy_pool", "[ProxyPool] refresh after change failed: %v", err)
+	}
+}
+
+func (s *ProxyPoolService) withStatus(ctx context.Context, pool *ProxyPool) (*ProxyPoolWithStatus, error) {
+	view := &ProxyPoolWithStatus{ProxyPool: *pool, MemberStatus: make([]ProxyPoolMemberStatus, 0, len(pool.Members))}
+	count, err := s.repo.CountAccountsByPoolID(ctx, pool.ID)
+	if err != nil {
+		return nil, err
+	}
+	view.AccountCount = count
+
+	proxies, err := s.loadMemberProxies(ctx, pool.Members)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.RLock()
+	assigned := make(map[int64]int)
+	for _, proxyID := range s.assignments {
+		assigned[proxyID]++
+	}
+	for _, m := range pool.Members {
+		status := ProxyPoolMemberStatus{ProxyID: m.ProxyID, Weight: m.Weight, Healthy: true}
+		if p := proxies[m.ProxyID]; p != nil {
+			status.Name = p.Name
+			status.Available = p.IsActive()
+		}
+		if h := s.health[m.ProxyID]; h != nil {
+			status.Healthy = h.healthy
+			status.LatencyMs = h.latencyMs
+			status.ConsecutiveFailures = h.failures
+			status.LastError = h.lastError
+			if !h.checkedAt.IsZero() {
+				checkedAt := h.checkedAt
+				status.LastCheckedAt = &checkedAt
+			}
+		}
+		status.AssignedAccounts = assigned[m.ProxyID]
+		if status.Available {
+			view.AvailableSize++
+			if status.Healthy {
+				view.HealthyCount++
+			}
+		}
+		view.MemberStatus = append(view.MemberStatus, status)
+	}
+	s.mu.RUnlock()
+	return view, nil
+}
+
+func (s *ProxyPoolService) loadMemberProxies(ctx context.Context, members []model.ProxyPoolMember) (map[int64]*Proxy, error) {
+	out := make(map[int64]*Proxy, len(members))
+	if len(members) == 0 {
+		return out, nil
+	}
+	ids := make([]int64, 0, len(members))
+	for _, m := range members {
+		ids = append(ids, m.ProxyID)
+	}
+	proxies, err := s.proxyRepo.ListByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for i := range proxies {
+		out[proxies[i].ID] = &proxies[i]
+	}
+	return out, nil
+}
+
+// ========== 运行时 ==========
+
+// Refresh 重新加载启用的代理池及其成员代理
+func (s *ProxyPoolService) Refresh(ctx context.Context) error {
+	if s == nil || s.repo == nil {
+		return nil
+	}
+	pools, err := s.repo.ListActive(ctx)
+	if err != nil {
+		return err
+	}
+	var members []model.ProxyPoolMember
+	for i := range pools {
+		members = append(members, pools[i].Members...)
+	}
+	proxies, err := s.loadMemberProxies(ctx, members)
+	if err != nil {
+		return err
+	}
+
+	runtimes := make(map[int64]*proxyPoolRuntime, len(pools))
+	urls := make(map[string]int64)
+	for i := range pools {
+		rt := &proxyPoolRuntime{pool: pools[i]}
+		for _, m := range pools[i].Members {
+			p := proxies[m.ProxyID]
+			if p == nil || !p.IsActive() {
+				continue
+			}
+			rt.members = append(rt.members, proxyPoolRuntimeMember{proxy: p, weight: m.Weight})
+			urls[p.URL()] = p.ID
+		}
+		runtimes[pools[i].ID] = rt
+	}
+
+	s.mu.Lock()
+	s.pools = runtimes
+	s.proxyURLs = urls
+	s.mu.Unlock()
+	return nil
+}
+
+// ApplyAccountProxy 将引用代理池的账号出口替换为池内粘性选中的健康成员。
+// 代理池不存在、已停用或没有可用成员时保持账号自身的代理配置不变。
+func (s *ProxyPoolService) ApplyAccountProxy(account *Account) {
+	proxy := s.poolProxyFor(account)
+	if proxy == nil {
+		return
+	}
+	proxyID := proxy.ID
+	account.Proxy = proxy
+	account.ProxyID = &proxyID
+}
+
+// poolProxyFor 返回引用代理池的账号当前选中的成员副本；账号未引用代理池或池内无可用成员时返回 nil
+func (s *ProxyPoolService) poolProxyFor(account *Account) *Proxy {
+	if s == nil || account == nil || account.ProxyPoolID == nil {
+		return nil
+	}
+	proxy := s.selectMember(account.ID, *account.ProxyPoolID)
+	if proxy == nil {
+		return nil
+	}
+	selected := *proxy
+	return &selected
+}
+
+var activeProxyPoolsPtr atomic.Pointer[ProxyPoolService]
+
+// SetActiveProxyPoolService 注册进程内的代理池服务，供各出站路径解析账号出口代理
+func SetActiveProxyPoolService(pools *ProxyPoolService) {
+	activeProxyPoolsPtr.Store(pools)
+}
+
+// resolveAccountProxyURL 解析账号出站请求使用的代理 URL。
+// 转发、令牌刷新、账号测试、用量/配额查询等所有出站路径统一经由此处：
+// 引用代理池时使用池内粘性选中的成员，否则使用账号已加载的直连代理。
+func resolveAccountProxyURL(account *Account) string {
+	if proxy := activeProxyPoolsPtr.Load().poolProxyFor(account); proxy != nil {
+		return proxy.URL()
+	}
+	if account != nil && account.ProxyID != nil && account.Proxy != nil {
+		return account.Proxy.URL()
+	}
+	return ""
+}
+
+// resolveAccountProxyURLWithRepo 同 resolveAccountProxyURL，账号未加载代理对象时通过 proxyRepo 按 ProxyID 查询
+func resolveAccountProxyURLWithRepo(ctx context.Context, proxyRepo ProxyRepository, account *Account) string {
+	if proxyURL := resolveAccountProxyURL(account); proxyURL != "" {
+		return proxyURL
+	}
+	if account == nil || account.ProxyID == nil || proxyRepo == nil {
+		return ""
+	}
+	proxy, err := proxyRepo.GetByID(ctx, *account.ProxyID)
+	if err != nil || proxy == nil {
+		return ""
+	}
+	return proxy.URL()
+}
+
+func (s *ProxyPoolService) selectMember(accountID, poolID int64) *Proxy {
+	s.mu.RLock()
+	rt := s.pools[poolID]
+	if rt == nil || len(rt.members) == 0 {
+		s.mu.RUnlock()
+		return nil
+	}
+	current, hasCurrent := s.assignments[accountID]
+	if hasCurrent {
+		for _, m := range rt.members {
+			if m.proxy.ID == current && s.isHealthyLocked(current) {
+				s.mu.RUnlock()
+				return m.proxy
+			}
+		}
+	}
+	selected := pickProxyPoolMember(accountID, rt.members, s.isHealthyLocked)
+	s.mu.RUnlock()
+
+	s.mu.Lock()
+	s.assignments[accountID] = selected.ID
+	s.mu.Unlock()
+	if hasCurrent && current != selected.ID {
+		logger.LegacyPrintf("service.proxy_pool", "[ProxyPool] account %d switched egress proxy %d -> %d (pool=%d)", accountID, current, selected.ID, poolID)
+	}
+	return selected
+}
+
+func (s *ProxyPoolService) isHealthyLocked(proxyID int64) bool {
+	h := s.health[proxyID]
+	return h == nil || h.healthy
+}
+
+// pickProxyPoolMember 加权 rendezvous 哈希：在健康成员中选择得分最高者；没有健康成员时在全部成员中选择
+func pickProxyPoolMember(accountID int64, members []proxyPoolRuntimeMember, healthy func(int64) bool) *Proxy {
+	var best *Proxy
+	bestScore := math.Inf(-1)
+	for _, onlyHealthy := range []bool{true, false} {
+		for _, m := range members {
+			if onlyHealthy && !healthy(m.proxy.ID) {
+				continue
+			}
+			if score := rendezvousScore(accountID, m.proxy.ID, m.weight); best == nil || score > bestScore {
+				best, bestScore = m.proxy, score
+			}
+		}
+		if best != nil {
+			return best
+		}
+	}
+	return best
+}
+
+func rendezvousScore(accountID, proxyID int64, weight int) float64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(strconv.FormatInt(accountID, 10) + ":" + strconv.FormatInt(proxyID, 10)))
+	// 映射到 (0,1) 开区间
+	u := (float64(h.Sum64()>>11) + 0.5) / (1 << 53)
+	if weight < model.ProxyPoolMinWeight {
+		weight = model.ProxyPoolDefaultWeight
+	}
+	return -float64(weight) / math.Log(u)
+}
+
+// ReportProxyResult 记录经由代理的转发结果：连续连接错误达到阈值后将成员标记为不健康，成功则恢复健康。
+// 非代理池成员的代理直接忽略。
+func (s *ProxyPoolService) ReportProxyResult(proxyURL string, err error) {
+	if s == nil || proxyURL == "" {
+		return
+	}
+	if err != nil && errors.Is(err, context.Canceled) {
+		return
+	}
+	s.mu.RLock()
+	proxyID, ok := s.proxyURLs[proxyURL]
+	var healthyNoFailures bool
+	if ok {
+		h := s.health[proxyID]
+		healthyNoFailures = h == nil || (h.healthy && h.failures == 0)
+	}
+	s.mu.RUnlock()
+	if !ok || (err == nil && healthyNoFailures) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	h := s.healthLocked(proxyID)
+	if err == nil {
+		h.failures = 0
+		h.healthy = true
+		return
+	}
+	h.failures++
+	h.lastError = err.Error()
+	if h.healthy && h.failures >= s.failureThreshold() {
+		h.healthy = false
+		logger.LegacyPrintf("service.proxy_pool", "[ProxyPool] proxy %d marked unhealthy after %d consecutive failures: %v", proxyID, h.failures, err)
+	}
+}
+
+func (s *ProxyPoolService) healthLocked(proxyID int64) *proxyMemberHealth {
+	h := s.health[proxyID]
+	if h == nil {
+		h = &proxyMemberHealth{healthy: true}
+		s.health[proxyID] = h
+	}
+	return h
+}
+
+// probeAll 刷新代理池并探测所有成员
+func (s *ProxyPoolService) probeAll() {
+	ctx, cancel := context.WithTimeout(context.Background(), s.probeInterval()+s.probeTimeout())
+	defer cancel()
+	if err := s.Refresh(ctx); err != nil {
+		logger.LegacyPrintf("service.proxy_pool", "[ProxyPool] refresh failed: %v", err)
+		return
+	}
+	s.mu.RLock()
+	proxies := make(map[int64]*Proxy)
+	for _, rt := range s.pools {
+		for _, m := range rt.members {
+			proxies[m.proxy.ID] = m.proxy
+		}
+	}
+	s.mu.RUnlock()
+	s.probeProxies(ctx, proxies)
+}
+
+func (s *ProxyPoolService) probeProxies(ctx context.Context, proxies map[int64]*Proxy) {
+	if s.prober == nil || len(proxies) == 0 {
+		return
+	}
+	sem := make(chan struct{}, proxyPoolProbeConcurrency)
+	var wg sync.WaitGroup
+	for _, p := range proxies {
+		if p == nil {
+			continue
+		}
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(p *Proxy) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			s.probeProxy(ctx, p)
+		}(p)
+	}
+	wg.Wait()
+}
+
+func (s *ProxyPoolService) probeProxy(ctx context.Context, proxy *Proxy) {
+	probeCtx, cancel := context.WithTimeout(ctx, s.probeTimeout())
+	defer cancel()
+	exitInfo, latencyMs, err := s.prober.ProbeProxy(probeCtx, proxy.URL())
+	now := time.Now()
+
+	s.mu.Lock()
+	h := s.healthLocked(proxy.ID)
+	h.checkedAt = now
+	if err != nil {
+		h.healthy = false
+		h.lastError = err.Error()
+		h.latencyMs = nil
+	} else {
+		if !h.healthy {
+			logger.LegacyPrintf("service.proxy_pool", "[ProxyPool] proxy %d recovered (latency=%dms)", proxy.ID, latencyMs)
+		}
+		latency := latencyMs
+		h.healthy = true
+		h.failures = 0
+		h.lastError = ""
+		h.latencyMs = &latency
+	}
+	s.mu.Unlock()
+
+	if err != nil {
+		saveProxyLatencyToCache(ctx, s.latencyCache, proxy.ID, &ProxyLatencyInfo{
+			Success:   false,
+			Message:   err.Error(),
+			UpdatedAt: now,
+		})
+		return
+	}
+	latency := latencyMs
+	saveProxyLatencyToCache(ctx, s.latencyCache, proxy.ID, &ProxyLatencyInfo{
+		Success:     true,
+		LatencyMs:   &latency,
+		Message:     "Proxy is accessible",
+		IPAddress:   exitInfo.IP,
+		Country:     exitInfo.Country,
+		CountryCode: exitInfo.CountryCode,
+		Region:      exitInfo.Region,
+		City:        exitInfo.City,
+		UpdatedAt:   now,
+	})
+}
@@ -0,0 +1,257 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/Wei-Shaw/sub2api/internal/model"
+	"github.com/stretchr/testify/require"
+)
+
+type proxyPoolRepoStub struct {
+	ProxyPoolRepository
+	pools []ProxyPool
+}
+
+func (r *proxyPoolRepoStub) ListActive(context.Context) ([]ProxyPool, error) {
+	return r.pools, nil
+}
+
+func (r *proxyPoolRepoStub) GetByID(_ context.Context, id int64) (*ProxyPool, error) {
+	for i := range r.pools {
+		if r.pools[i].ID == id {
+			pool := r.pools[i]
+			return &pool, nil
+		}
+	}
+	return nil, ErrProxyPoolNotFound
+}
+
+type proxyRepoStubForPool struct {
+	ProxyRepository
+	proxies map[int64]Proxy
+}
+
+func (r *proxyRepoStubForPool) ListByIDs(_ context.Context, ids []int64) ([]Proxy, error) {
+	out := make([]Proxy, 0, len(ids))
+	for _, id := range ids {
+		if p, ok := r.proxies[id]; ok {
+			out = append(out, p)
+		}
+	}
+	return out, nil
+}
+
+func (r *proxyRepoStubForPool) GetByID(_ context.Context, id int64) (*Proxy, error) {
+	p, ok := r.proxies[id]
+	if !ok {
+		return nil, ErrProxyNotFound
+	}
+	return &p, nil
+}
+
+type proxyProberStub struct {
+	failing map[string]bool
+}
+
+func (p *proxyProberStub) ProbeProxy(_ context.Context, proxyURL string) (*ProxyExitInfo, int64, error) {
+	if p.failing[proxyURL] {
+		return nil, 0, errors.New("connect refused")
+	}
+	return &ProxyExitInfo{IP: "1.1.1.1"}, 42, nil
+}
+
+func newProxyPoolServiceForTest(t *testing.T, prober ProxyExitInfoProber) (*ProxyPoolService, map[int64]*Proxy) {
+	t.Helper()
+	proxies := map[int64]Proxy{
+		1: {ID: 1, Name: "p1", Protocol: "http", Host: "10.0.0.1", Port: 8080, Status: StatusActive},
+		2: {ID: 2, Name: "p2", Protocol: "http", Host: "10.0.0.2", Port: 8080, Status: StatusActive},
+		3: {ID: 3, Name: "p3", Protocol: "http", Host: "10.0.0.3", Port: 8080, Status: StatusActive},
+	}
+	repo := &proxyPoolRepoStub{pools: []ProxyPool{{
+		ID:     7,
+		Name:   "pool",
+		Status: StatusActive,
+		Members: []model.ProxyPoolMember{
+			{ProxyID: 1, Weight: 1},
+			{ProxyID: 2, Weight: 1},
+			{ProxyID: 3, Weight: 1},
+		},
+	}}}
+	cfg := &config.Config{}
+	cfg.Gateway.ProxyPool.FailureThreshold = 2
+	svc := NewProxyPoolService(repo, &proxyRepoStubForPool{proxies: proxies}, prober, nil, cfg)
+	require.NoError(t, svc.Refresh(context.Background()))
+
+	byID := make(map[int64]*Proxy, len(proxies))
+	for id := range proxies {
+		p := proxies[id]
+		byID[id] = &p
+	}
+	return svc, byID
+}
+
+func TestProxyPoolService_ApplyAccountProxyIsSticky(t *testing.T) {
+	svc, _ := newProxyPoolServiceForTest(t, nil)
+	poolID := int64(7)
+
+	first := &Account{ID: 100, ProxyPoolID: &poolID}
+	svc.ApplyAccountProxy(first)
+	require.NotNil(t, first.Proxy)
+	require.NotNil(t, first.ProxyID)
+	require.Equal(t, first.Proxy.ID, *first.ProxyID)
+
+	for i := 0; i < 5; i++ {
+		again := &Account{ID: 100, ProxyPoolID: &poolID}
+		svc.ApplyAccountProxy(again)
+		require.Equal(t, first.Proxy.ID, again.Proxy.ID)
+	}
+
+	// 未引用代理池或代理池不存在时保持账号自身代理
+	ownProxyID := int64(99)
+	own := &Account{ID: 101, ProxyID: &ownProxyID}
+	svc.ApplyAccountProxy(own)
+	require.Equal(t, ownProxyID, *own.ProxyID)
+	missingPool := int64(8)
+	missing := &Account{ID: 102, ProxyID: &ownProxyID, ProxyPoolID: &missingPool}
+	svc.ApplyAccountProxy(missing)
+	require.Equal(t, ownProxyID, *missing.ProxyID)
+	require.Nil(t, missing.Proxy)
+}
+
+func TestProxyPoolService_FailoverOnConnectionErrors(t *testing.T) {
+	svc, proxies := newProxyPoolServiceForTest(t, nil)
+	poolID := int64(7)
+
+	account := &Account{ID: 200, ProxyPoolID: &poolID}
+	svc.ApplyAccountProxy(account)
+	current := proxies[account.Proxy.ID]
+
+	// 未达到阈值前保持粘性
+	svc.ReportProxyResult(current.URL(), errors.New("dial tcp: connection refused"))
+	again := &Account{ID: 200, ProxyPoolID: &poolID}
+	svc.ApplyAccountProxy(again)
+	require.Equal(t, current.ID, again.Proxy.ID)
+
+	// 取消不计入失败
+	svc.ReportProxyResult(current.URL(), context.Canceled)
+	svc.ReportProxyResult(current.URL(), errors.New("proxyconnect tcp: i/o timeout"))
+	switched := &Account{ID: 200, ProxyPoolID: &poolID}
+	svc.ApplyAccountProxy(switched)
+	require.NotEqual(t, current.ID, switched.Proxy.ID)
+
+	// 原成员恢复后不再迁回（避免 IP 抖动）
+	svc.ReportProxyResult(current.URL(), nil)
+	stay := &Account{ID: 200, ProxyPoolID: &poolID}
+	svc.ApplyAccountProxy(stay)
+	require.Equal(t, switched.Proxy.ID, stay.Proxy.ID)
+}
+
+func TestProxyPoolService_ProbeMarksUnhealthyMembers(t *testing.T) {
+	prober := &proxyProberStub{failing: map[string]bool{}}
+	svc, proxies := newProxyPoolServiceForTest(t, prober)
+	poolID := int64(7)
+
+	account := &Account{ID: 300, ProxyPoolID: &poolID}
+	svc.ApplyAccountProxy(account)
+	prober.failing[proxies[account.Proxy.ID].URL()] = true
+
+	svc.probeProxies(context.Background(), proxies)
+	moved := &Account{ID: 300, ProxyPoolID: &poolID}
+	svc.ApplyAccountProxy(moved)
+	require.NotEqual(t, account.Proxy.ID, moved.Proxy.ID)
+}
+
+func TestPickProxyPoolMember_WeightsAndAllUnhealthyFallback(t *testing.T) {
+	heavy := &Proxy{ID: 1}
+	light := &Proxy{ID: 2}
+	members := []proxyPoolRuntimeMember{{proxy: heavy, weight: 9}, {proxy: light, weight: 1}}
+
+	counts := map[int64]int{}
+	for accountID := int64(1); accountID <= 2000; accountID++ {
+		counts[pickProxyPoolMember(accountID, members, func(int64) bool { return true }).ID]++
+	}
+	require.Greater(t, counts[1], counts[2]*4)
+
+	// 没有健康成员时仍返回一个成员（尽力而为）
+	require.NotNil(t, pickProxyPoolMember(1, members, func(int64) bool { return false }))
+}
+
+type recordingUpstream struct {
+	HTTPUpstream
+	err error
+}
+
+func (u *recordingUpstream) Do(*http.Request, string, int64, int) (*http.Response, error) {
+	return nil, u.err
+}
+
+func TestWrapHTTPUpstreamWithProxyPools_ReportsErrors(t *testing.T) {
+	svc, proxies := newProxyPoolServiceForTest(t, nil)
+	proxyURL := proxies[1].URL()
+	upstream := WrapHTTPUpstreamWithProxyPools(&recordingUpstream{err: errors.New("connection reset")}, svc)
+
+	for i := 0; i < 2; i++ {
+		_, err := upstream.Do(&http.Request{}, proxyURL, 1, 1)
+		require.Error(t, err)
+	}
+	svc.mu.RLock()
+	defer svc.mu.RUnlock()
+	require.False(t, svc.isHealthyLocked(1))
+	require.True(t, svc.isHealthyLocked(2))
+}
+
+func TestResolveAccountProxyURL_PrefersPoolOnOutboundPaths(t *testing.T) {
+	svc, proxies := newProxyPoolServiceForTest(t, &proxyProberStub{})
+	SetActiveProxyPoolService(svc)
+	t.Cleanup(func() { SetActiveProxyPoolService(nil) })
+
+	direct := Proxy{ID: 99, Name: "direct", Protocol: "http", Host: "10.0.0.99", Port: 8080, Status: StatusActive}
+	proxyRepo := &proxyRepoStubForPool{proxies: map[int64]Proxy{99: direct}}
+	poolID := int64(7)
+	directID := direct.ID
+	// 令牌刷新、用量/配额查询等路径读取的账号未经调度快照注解，仍指向直连代理
+	account := &Account{ID: 42, ProxyID: &directID, ProxyPoolID: &poolID}
+
+	poolURL := resolveAccountProxyURL(account)
+	require.NotEmpty(t, poolURL)
+	require.NotEqual(t, direct.URL(), poolURL)
+	require.Equal(t, poolURL, resolveAccountProxyURLWithRepo(context.Background(), proxyRepo, account))
+	require.Equal(t, poolURL, NewAntigravityQuotaFetcher(proxyRepo).GetProxyURL(context.Background(), account))
+
+	annotated := *account
+	svc.ApplyAccountProxy(&annotated)
+	require.Equal(t, proxies[*annotated.ProxyID].URL(), poolURL)
+
+	account.ProxyPoolID = nil
+	require.Equal(t, direct.URL(), resolveAccountProxyURLWithRepo(context.Background(), proxyRepo, account))
+	require.Empty(t, resolveAccountProxyURL(account))
+}
+
+func TestAdminService_ValidatesAccountProxyPool(t *testing.T) {
+	admin := &adminServiceImpl{proxyPoolRepo: &proxyPoolRepoStub{pools: []ProxyPool{{ID: 7, Status: StatusActive}}}}
+	missing := int64(8)
+	existing := int64(7)
+	cleared := int64(0)
+
+	_, err := admin.CreateAccount(context.Background(), &CreateAccountInput{
+		Name:                 "acc",
+		Platform:             PlatformAnthropic,
+		Type:                 AccountTypeOAuth,
+		ProxyPoolID:          &missing,
+		SkipDefaultGroupBind: true,
+	})
+	require.ErrorIs(t, err, ErrProxyPoolNotFound)
+
+	_, err = admin.BulkUpdateAccounts(context.Background(), &BulkUpdateAccountsInput{AccountIDs: []int64{1}, ProxyPoolID: &missing})
+	require.ErrorIs(t, err, ErrProxyPoolNotFound)
+
+	require.NoError(t, admin.validateProxyPoolExists(context.Background(), &existing))
+	require.NoError(t, admin.validateProxyPoolExists(context.Background(), &cleared))
+	require.NoError(t, admin.validateProxyPoolExists(context.Background(), nil))
+}
@@ -0,0 +1,30 @@
+package service
+
+import "net/http"
+
+// proxyPoolFailoverUpstream 包装 HTTPUpstream，将经由代理的转发结果上报给代理池，
+// 使连接错误能触发池成员健康判定与自动切换（下一次调度读取账号时生效）。
+type proxyPoolFailoverUpstream struct {
+	HTTPUpstream
+	pools *ProxyPoolService
+}
+
+// WrapHTTPUpstreamWithProxyPools 为 HTTPUpstream 增加代理池故障上报；pools 为 nil 时原样返回
+func WrapHTTPUpstreamWithProxyPools(upstream HTTPUpstream, pools *ProxyPoolService) HTTPUpstream {
+	if upstream == nil || pools == nil {
+		return upstream
+	}
+	return &proxyPoolFailoverUpstream{HTTPUpstream: upstream, pools: pools}
+}
+
+func (u *proxyPoolFailoverUpstream) Do(req *http.Request, proxyURL string, accountID int64, accountConcurrency int) (*http.Response, error) {
+	resp, err := u.HTTPUpstream.Do(req, proxyURL, accountID, accountConcurrency)
+	u.pools.ReportProxyResult(proxyURL, err)
+	return resp, err
+}
+
+func (u *proxyPoolFailoverUpstream) DoWithTLS(req *http.Request, proxyURL string, accountID int64, accountConcurrency int, enableTLSFingerprint bool) (*http.Response, error) {
+	resp, err := u.HTTPUpstream.DoWithTLS(req, proxyURL, accountID, accountConcurrency, enableTLSFingerprint)
+	u.pools.ReportProxyResult(proxyURL, err)
+	return resp, err
+}
@@ -81,6 +81,7 @@ type RedeemService struct {
 	entClient            *dbent.Client
 	authCacheInvalidator APIKeyAuthCacheInvalidator
 	referralService      *ReferralService
+	balanceBuckets       *BalanceBucketService
 }
 
 // NewRedeemService 创建兑换码服务实例
@@ -109,6 +110,19 @@ func (s *RedeemService) SetReferralService(referralService *ReferralService) {
 	s.referralService = referralService
 }
 
+// SetBalanceBuckets sets the balance bucket service used to record redeemed credits
+func (s *RedeemService) SetBalanceBuckets(balanceBuckets *BalanceBucketService) {
+	s.balanceBuckets = balanceBuckets
+}
+
+// redeemBalanceSource 区分在线支付订单生成的充值码（PAY- 前缀）与普通兑换码
+func redeemBalanceSource(code string) string {
+	if strings.HasPrefix(code, "PAY-") {
+		return BalanceSourcePaid
+	}
+	return BalanceSourceRedeem
+}
+
 // GenerateRandomCode 生成随机兑换码
 func (s *RedeemService) GenerateRandomCode() (string, error) {
 	// 生成16字节随机数据
@@ -327,6 +341,9 @@ func (s *RedeemService) Redeem(ctx context.Context, userID int64, code string) (
 		if err := s.userRepo.UpdateBalance(txCtx, userID, amount); err != nil {
 			return nil, fmt.Errorf("update user balance: %w", err)
 		}
+		if err := s.balanceBuckets.Grant(txCtx, userID, redeemBalanceSource(redeemCode.Code), amount, redeemCode.Code); err != nil {
+			return nil, err
+		}
 
 		// 处理邀请返利（在事务内执行）
 		if s.referralService != nil && user.ReferrerID != nil {
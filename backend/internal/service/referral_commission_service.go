@@ -0,0 +1,532 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"strings"
+	"time"
+
+	dbent "github.com/Wei-Shaw/sub2api/ent"
+	"github.com/Wei-Shaw/sub2api/ent/commissionwithdrawal"
+	"github.com/Wei-Shaw/sub2api/ent/commissionwithdrawallog"
+	"github.com/Wei-Shaw/sub2api/ent/predicate"
+	"github.com/Wei-Shaw/sub2api/ent/referralcommission"
+	dbuser "github.com/Wei-Shaw/sub2api/ent/user"
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/timezone"
+)
+
+// Referral commission statuses.
+const (
+	CommissionStatusActive     = "active"
+	CommissionStatusClawedBack = "clawed_back"
+)
+
+// Commission withdrawal statuses.
+const (
+	WithdrawalStatusPending   = "pending"
+	WithdrawalStatusApproved  = "approved"
+	WithdrawalStatusRejected  = "rejected"
+	WithdrawalStatusPaid      = "paid"
+	WithdrawalStatusCancelled = "cancelled"
+)
+
+// Commission withdrawal audit actions.
+const (
+	WithdrawalActionCreate  = "create"
+	WithdrawalActionCancel  = "cancel"
+	WithdrawalActionApprove = "approve"
+	WithdrawalActionReject  = "reject"
+	WithdrawalActionPay     = "pay"
+)
+
+// Withdrawal operator roles recorded in the audit trail.
+const (
+	WithdrawalOperatorUser  = "user"
+	WithdrawalOperatorAdmin = "admin"
+)
+
+const (
+	// commissionAmountEpsilon absorbs decimal(20,8) rounding when withdrawing the full balance.
+	commissionAmountEpsilon = 1e-8
+	withdrawalAccountMaxLen = 255
+	withdrawalNameMaxLen    = 100
+)
+
+// withdrawalPayoutMethods are the accepted payout methods.
+var withdrawalPayoutMethods = map[string]bool{
+	"alipay": true,
+	"wechat": true,
+	"bank":   true,
+	"usdt":   true,
+	"paypal": true,
+}
+
+// withdrawalTransitions maps an action to the statuses it may start from and the status it leads to.
+var withdrawalTransitions = map[string]struct {
+	from []string
+	to   string
+}{
+	WithdrawalActionCancel:  {from: []string{WithdrawalStatusPending}, to: WithdrawalStatusCancelled},
+	WithdrawalActionApprove: {from: []string{WithdrawalStatusPending}, to: WithdrawalStatusApproved},
+	WithdrawalActionReject:  {from: []string{WithdrawalStatusPending, WithdrawalStatusApproved}, to: WithdrawalStatusRejected},
+	WithdrawalActionPay:     {from: []string{WithdrawalStatusApproved}, to: WithdrawalStatusPaid},
+}
+
+var (
+	ErrCommissionWithdrawDisabled     = infraerrors.Forbidden("COMMISSION_WITHDRAW_DISABLED", "commission withdrawal is not enabled")
+	ErrCommissionWithdrawBelowMinimum = infraerrors.BadRequest("COMMISSION_WITHDRAW_BELOW_MINIMUM", "withdrawal amount is below the minimum")
+	ErrCommissionInsufficient         = infraerrors.BadRequest("COMMISSION_INSUFFICIENT", "withdrawal amount exceeds the withdrawable commission")
+	ErrCommissionInvalidPayout        = infraerrors.BadRequest("COMMISSION_INVALID_PAYOUT", "invalid payout method or account")
+	ErrCommissionWithdrawalPending    = infraerrors.Conflict("COMMISSION_WITHDRAWAL_PENDING", "a withdrawal request is already pending")
+	ErrCommissionWithdrawalNotFound   = infraerrors.NotFound("COMMISSION_WITHDRAWAL_NOT_FOUND", "withdrawal request not found")
+	ErrCommissionWithdrawalStatus     = infraerrors.Conflict("COMMISSION_WITHDRAWAL_INVALID_STATUS", "withdrawal request status does not allow this action")
+	ErrCommissionRejectReasonRequired = infraerrors.BadRequest("COMMISSION_REJECT_REASON_REQUIRED", "reject reason is required")
+)
+
+// ReferralCommissionInput describes a commission earned from a referee's payment.
+type ReferralCommissionInput struct {
+	ReferrerID   int64
+	RefereeID    int64
+	RewardID     *int64
+	SourceType   string
+	SourceID     int64
+	SourceAmount float64
+	Amount       float64
+}
+
+// CommissionWithdrawalInput is a user's withdrawal request.
+type CommissionWithdrawalInput struct {
+	Amount        float64
+	PayoutMethod  string
+	PayoutAccount string
+	PayoutName    string
+}
+
+// CommissionWithdrawalListParams filters withdrawal request lists.
+type CommissionWithdrawalListParams struct {
+	UserID   int64
+	Status   string
+	Page     int
+	PageSize int
+}
+
+// CommissionSummary is the state of a promoter's commission account.
+type CommissionSummary struct {
+	Enabled           bool
+	MinWithdrawAmount float64
+	HoldingDays       int
+
+	Total       float64 // 累计佣金（扣除追回）
+	Held        float64 // 冻结期内
+	Withdrawing float64 // 待审核 / 待打款
+	Withdrawn   float64 // 已打款
+	ClawedBack  float64 // 已追回
+	Available   float64 // 可提现
+}
+
+// ReferralCommissionService keeps referral commission in a ledger separate from the spendable
+// balance. Commission is held for a configurable period during which refunds of the source
+// order claw it back; afterwards it can be withdrawn through an admin-reviewed payout workflow.
+type ReferralCommissionService struct {
+	entClient      *dbent.Client
+	settingService *SettingService
+}
+
+// NewReferralCommissionService creates a new ReferralCommissionService.
+func NewReferralCommissionService(entClient *dbent.Client, settingService *SettingService) *ReferralCommissionService {
+	return &ReferralCommissionService{entClient: entClient, settingService: settingService}
+}
+
+// Enabled reports whether new commission goes to the withdrawable ledger instead of the balance.
+func (s *ReferralCommissionService) Enabled(ctx context.Context) bool {
+	return s != nil && s.settingService.IsReferralWithdrawEnabled(ctx)
+}
+
+// commissionClawbackAmount returns how much more of a commission a refund of refundRatio of
+// the source order claws back. Repeated calls for the same refund are no-ops.
+func commissionClawbackAmount(amount, clawedBack, refundRatio float64) float64 {
+	if refundRatio <= 0 {
+		return 0
+	}
+	target := amount * math.Min(refundRatio, 1)
+	return math.Max(target-clawedBack, 0)
+}
+
+// nextWithdrawalStatus returns the status a withdrawal moves to when action is applied in status from.
+func nextWithdrawalStatus(action, from string) (string, bool) {
+	t, ok := withdrawalTransitions[action]
+	if !ok {
+		return "", false
+	}
+	for _, f := range t.from {
+		if f == from {
+			return t.to, true
+		}
+	}
+	return "", false
+}
+
+func validateWithdrawalInput(in *CommissionWithdrawalInput, minAmount float64) error {
+	in.PayoutMethod = strings.ToLower(strings.TrimSpace(in.PayoutMethod))
+	in.PayoutAccount = strings.TrimSpace(in.PayoutAccount)
+	in.PayoutName = strings.TrimSpace(in.PayoutName)
+	if !withdrawalPayoutMethods[in.PayoutMethod] || in.PayoutAccount == "" ||
+		len(in.PayoutAccount) > withdrawalAccountMaxLen || len(in.PayoutName) > withdrawalNameMaxLen {
+		return ErrCommissionInvalidPayout
+	}
+	if math.IsNaN(in.Amount) || math.IsInf(in.Amount, 0) || in.Amount <= 0 || in.Amount < minAmount {
+		return ErrCommissionWithdrawBelowMinimum
+	}
+	return nil
+}
+
+// --- Ledger ---
+
+// Record adds a commission to the referrer's ledger, held until the holding period ends.
+func (s *ReferralCommissionService) Record(ctx context.Context, in ReferralCommissionInput) (*dbent.ReferralCommission, error) {
+	holdingDays := s.settingService.GetReferralHoldingDays(ctx)
+	c, err := s.entClient.ReferralCommission.Create().
+		SetReferrerID(in.ReferrerID).
+		SetRefereeID(in.RefereeID).
+		SetNillableRewardID(in.RewardID).
+		SetSourceType(in.SourceType).
+		SetSourceID(in.SourceID).
+		SetSourceAmount(in.SourceAmount).
+		SetAmount(in.Amount).
+		SetAvailableAt(timezone.Now().AddDate(0, 0, holdingDays)).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("create referral commission: %w", err)
+	}
+	return c, nil
+}
+
+// Clawback takes back the share of held commission earned from a refunded source order.
+// Commission past its holding period is no longer clawed back. Returns the amount taken back.
+func (s *ReferralCommissionService) Clawback(ctx context.Context, sourceType string, sourceID int64, refundRatio float64, reason string) (float64, error) {
+	now := timezone.Now()
+	commissions, err := s.entClient.ReferralCommission.Query().
+		Where(
+			referralcommission.SourceTypeEQ(sourceType),
+			referralcommission.SourceIDEQ(sourceID),
+			referralcommission.StatusEQ(CommissionStatusActive),
+			referralcommission.AvailableAtGT(now),
+		).
+		All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("query held commissions: %w", err)
+	}
+	var total float64
+	for _, c := range commissions {
+		delta := commissionClawbackAmount(c.Amount, c.ClawedBackAmount, refundRatio)
+		if delta <= 0 {
+			continue
+		}
+		clawed := c.ClawedBackAmount + delta
+		update := s.entClient.ReferralCommission.UpdateOne(c).
+			SetClawedBackAmount(clawed).
+			SetClawedBackAt(now).
+			SetClawbackReason(reason)
+		if clawed >= c.Amount-commissionAmountEpsilon {
+			update.SetStatus(CommissionStatusClawedBack)
+		}
+		if err := update.Exec(ctx); err != nil {
+			return total, fmt.Errorf("claw back commission: %w", err)
+		}
+		total += delta
+		slog.Info("[Referral] commission clawed back", "commissionID", c.ID, "referrerID", c.ReferrerID, "amount", delta)
+	}
+	return total, nil
+}
+
+// ListCommissions lists a promoter's commission ledger, newest first.
+func (s *ReferralCommissionService) ListCommissions(ctx context.Context, userID int64, page, pageSize int) ([]*dbent.ReferralCommission, int, error) {
+	q := s.entClient.ReferralCommission.Query().Where(referralcommission.ReferrerIDEQ(userID))
+	total, err := q.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("count referral commissions: %w", err)
+	}
+	ps, pg := applyPagination(pageSize, page)
+	items, err := q.Order(dbent.Desc(referralcommission.FieldID)).Limit(ps).Offset((pg - 1) * ps).All(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("query referral commissions: %w", err)
+	}
+	return items, total, nil
+}
+
+// GetSummary returns the state of a promoter's commission account.
+func (s *ReferralCommissionService) GetSummary(ctx context.Context, userID int64) (*CommissionSummary, error) {
+	summary, err := s.summarize(ctx, s.entClient, userID, timezone.Now())
+	if err != nil {
+		return nil, err
+	}
+	summary.Enabled = s.Enabled(ctx)
+	summary.MinWithdrawAmount = s.settingService.GetReferralWithdrawMinAmount(ctx)
+	summary.HoldingDays = s.settingService.GetReferralHoldingDays(ctx)
+	return summary, nil
+}
+
+func (s *ReferralCommissionService) summarize(ctx context.Context, client *dbent.Client, userID int64, now time.Time) (*CommissionSummary, error) {
+	settled, settledClawed, err := sumCommissions(ctx, client, referralcommission.ReferrerIDEQ(userID), referralcommission.AvailableAtLTE(now))
+	if err != nil {
+		return nil, err
+	}
+	held, heldClawed, err := sumCommissions(ctx, client, referralcommission.ReferrerIDEQ(userID), referralcommission.AvailableAtGT(now))
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []struct {
+		Status string   `json:"status"`
+		Sum    *float64 `json:"sum"`
+	}
+	err = client.CommissionWithdrawal.Query().
+		Where(commissionwithdrawal.UserIDEQ(userID)).
+		GroupBy(commissionwithdrawal.FieldStatus).
+		Aggregate(dbent.As(dbent.Sum(commissionwithdrawal.FieldAmount), "sum")).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("sum commission withdrawals: %w", err)
+	}
+	withdrawals := make(map[string]float64, len(rows))
+	for _, r := range rows {
+		if r.Sum != nil {
+			withdrawals[r.Status] = *r.Sum
+		}
+	}
+	return buildCommissionSummary(settled-settledClawed, held-heldClawed, settledClawed+heldClawed, withdrawals), nil
+}
+
+// buildCommissionSummary derives the account state from settled/held commission net of
+// clawbacks and withdrawal totals by status.
+func buildCommissionSummary(settled, held, clawedBack float64, withdrawals map[string]float64) *CommissionSummary {
+	withdrawing := withdrawals[WithdrawalStatusPending] + withdrawals[WithdrawalStatusApproved]
+	withdrawn := withdrawals[WithdrawalStatusPaid]
+	return &CommissionSummary{
+		Total:       settled + held,
+		Held:        held,
+		Withdrawing: withdrawing,
+		Withdrawn:   withdrawn,
+		ClawedBack:  clawedBack,
+		Available:   math.Max(settled-withdrawing-withdrawn, 0),
+	}
+}
+
+// sumCommissions returns the total amount and clawed back amount of matching commissions.
+func sumCommissions(ctx context.Context, client *dbent.Client, preds ...predicate.ReferralCommission) (float64, float64, error) {
+	var rows []struct {
+		Amount *float64 `json:"amount"`
+		Clawed *float64 `json:"clawed"`
+	}
+	err := client.ReferralCommission.Query().
+		Where(preds...).
+		Aggregate(
+			dbent.As(dbent.Sum(referralcommission.FieldAmount), "amount"),
+			dbent.As(dbent.Sum(referralcommission.FieldClawedBackAmount), "clawed"),
+		).
+		Scan(ctx, &rows)
+	if err != nil {
+		return 0, 0, fmt.Errorf("sum referral commissions: %w", err)
+	}
+	if len(rows) == 0 || rows[0].Amount == nil {
+		return 0, 0, nil
+	}
+	var clawed float64
+	if rows[0].Clawed != nil {
+		clawed = *rows[0].Clawed
+	}
+	return *rows[0].Amount, clawed, nil
+}
+
+// --- Withdrawals ---
+
+// RequestWithdrawal creates a withdrawal request for settled commission. The user row is
+// locked while the withdrawable amount is checked so concurrent requests cannot overdraw.
+func (s *ReferralCommissionService) RequestWithdrawal(ctx context.Context, userID int64, in CommissionWithdrawalInput) (*dbent.CommissionWithdrawal, error) {
+	if !s.Enabled(ctx) {
+		return nil, ErrCommissionWithdrawDisabled
+	}
+	if err := validateWithdrawalInput(&in, s.settingService.GetReferralWithdrawMinAmount(ctx)); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("begin withdrawal transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if _, err := tx.User.Query().Where(dbuser.IDEQ(userID)).ForUpdate().Only(ctx); err != nil {
+		if dbent.IsNotFound(err) {
+			return nil, ErrUserNotFound
+		}
+		return nil, fmt.Errorf("lock user: %w", err)
+	}
+	pending, err := tx.CommissionWithdrawal.Query().
+		Where(commissionwithdrawal.UserIDEQ(userID), commissionwithdrawal.StatusEQ(WithdrawalStatusPending)).
+		Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("check pending withdrawals: %w", err)
+	}
+	if pending {
+		return nil, ErrCommissionWithdrawalPending
+	}
+	summary, err := s.summarize(ctx, tx.Client(), userID, timezone.Now())
+	if err != nil {
+		return nil, err
+	}
+	if in.Amount > summary.Available+commissionAmountEpsilon {
+		return nil, ErrCommissionInsufficient
+	}
+
+	w, err := tx.CommissionWithdrawal.Create().
+		SetUserID(userID).
+		SetAmount(in.Amount).
+		SetPayoutMethod(in.PayoutMethod).
+		SetPayoutAccount(in.PayoutAccount).
+		SetPayoutName(in.PayoutName).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("create withdrawal: %w", err)
+	}
+	if err := writeWithdrawalLog(ctx, tx.Client(), w.ID, WithdrawalActionCreate, "", WithdrawalStatusPending, userID, WithdrawalOperatorUser, ""); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit withdrawal transaction: %w", err)
+	}
+	slog.Info("[Referral] withdrawal requested", "withdrawalID", w.ID, "userID", userID, "amount", in.Amount)
+	return w, nil
+}
+
+// CancelWithdrawal lets a user withdraw their own pending request.
+func (s *ReferralCommissionService) CancelWithdrawal(ctx context.Context, userID, id int64) (*dbent.CommissionWithdrawal, error) {
+	return s.transition(ctx, id, userID, WithdrawalActionCancel, WithdrawalOperatorUser, userID, "", nil)
+}
+
+// ApproveWithdrawal accepts a pending request; it is paid out separately.
+func (s *ReferralCommissionService) ApproveWithdrawal(ctx context.Context, id, adminID int64, note string) (*dbent.CommissionWithdrawal, error) {
+	return s.transition(ctx, id, 0, WithdrawalActionApprove, WithdrawalOperatorAdmin, adminID, note, func(u *dbent.CommissionWithdrawalUpdateOne, now time.Time) {
+		u.SetReviewerID(adminID).SetReviewedAt(now).SetReviewNote(note)
+	})
+}
+
+// RejectWithdrawal declines a pending or approved request; the amount becomes withdrawable again.
+func (s *ReferralCommissionService) RejectWithdrawal(ctx context.Context, id, adminID int64, reason string) (*dbent.CommissionWithdrawal, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, ErrCommissionRejectReasonRequired
+	}
+	return s.transition(ctx, id, 0, WithdrawalActionReject, WithdrawalOperatorAdmin, adminID, reason, func(u *dbent.CommissionWithdrawalUpdateOne, now time.Time) {
+		u.SetReviewerID(adminID).SetReviewedAt(now).SetReviewNote(reason)
+	})
+}
+
+// MarkWithdrawalPaid records that an approved request has been paid out.
+func (s *ReferralCommissionService) MarkWithdrawalPaid(ctx context.Context, id, adminID int64, reference, note string) (*dbent.CommissionWithdrawal, error) {
+	reference = strings.TrimSpace(reference)
+	return s.transition(ctx, id, 0, WithdrawalActionPay, WithdrawalOperatorAdmin, adminID, note, func(u *dbent.CommissionWithdrawalUpdateOne, now time.Time) {
+		u.SetPaidAt(now).SetPayoutReference(reference)
+	})
+}
+
+// transition applies a workflow action to a withdrawal and records it in the audit trail.
+// ownerID > 0 restricts the action to that user's own requests.
+func (s *ReferralCommissionService) transition(
+	ctx context.Context,
+	id, ownerID int64,
+	action, role string,
+	operatorID int64,
+	note string,
+	apply func(u *dbent.CommissionWithdrawalUpdateOne, now time.Time),
+) (*dbent.CommissionWithdrawal, error) {
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("begin withdrawal transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	q := tx.CommissionWithdrawal.Query().Where(commissionwithdrawal.IDEQ(id))
+	if ownerID > 0 {
+		q = q.Where(commissionwithdrawal.UserIDEQ(ownerID))
+	}
+	w, err := q.ForUpdate().Only(ctx)
+	if err != nil {
+		if dbent.IsNotFound(err) {
+			return nil, ErrCommissionWithdrawalNotFound
+		}
+		return nil, fmt.Errorf("get withdrawal: %w", err)
+	}
+	to, ok := nextWithdrawalStatus(action, w.Status)
+	if !ok {
+		return nil, ErrCommissionWithdrawalStatus
+	}
+	now := timezone.Now()
+	update := tx.CommissionWithdrawal.UpdateOne(w).SetStatus(to)
+	if apply != nil {
+		apply(update, now)
+	}
+	from := w.Status
+	if w, err = update.Save(ctx); err != nil {
+		return nil, fmt.Errorf("update withdrawal: %w", err)
+	}
+	if err := writeWithdrawalLog(ctx, tx.Client(), id, action, from, to, operatorID, role, note); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit withdrawal transaction: %w", err)
+	}
+	slog.Info("[Referral] withdrawal "+action, "withdrawalID", id, "operator", role, "operatorID", operatorID)
+	return w, nil
+}
+
+func writeWithdrawalLog(ctx context.Context, client *dbent.Client, withdrawalID int64, action, from, to string, operatorID int64, role, note string) error {
+	if err := client.CommissionWithdrawalLog.Create().
+		SetWithdrawalID(withdrawalID).
+		SetAction(action).
+		SetFromStatus(from).
+		SetToStatus(to).
+		SetOperatorID(operatorID).
+		SetOperatorRole(role).
+		SetNote(note).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("write withdrawal log: %w", err)
+	}
+	return nil
+}
+
+// ListWithdrawals lists withdrawal requests, newest first.
+func (s *ReferralCommissionService) ListWithdrawals(ctx context.Context, p CommissionWithdrawalListParams) ([]*dbent.CommissionWithdrawal, int, error) {
+	q := s.entClient.CommissionWithdrawal.Query()
+	if p.UserID > 0 {
+		q = q.Where(commissionwithdrawal.UserIDEQ(p.UserID))
+	}
+	if p.Status != "" {
+		q = q.Where(commissionwithdrawal.StatusEQ(p.Status))
+	}
+	total, err := q.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("count withdrawals: %w", err)
+	}
+	ps, pg := applyPagination(p.PageSize, p.Page)
+	items, err := q.Order(dbent.Desc(commissionwithdrawal.FieldID)).Limit(ps).Offset((pg - 1) * ps).All(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("query withdrawals: %w", err)
+	}
+	return items, total, nil
+}
+
+// ListWithdrawalLogs returns the audit trail of a withdrawal request, oldest first.
+func (s *ReferralCommissionService) ListWithdrawalLogs(ctx context.Context, id int64) ([]*dbent.CommissionWithdrawalLog, error) {
+	logs, err := s.entClient.CommissionWithdrawalLog.Query().
+		Where(commissionwithdrawallog.WithdrawalIDEQ(id)).
+		Order(dbent.Asc(commissionwithdrawallog.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query withdrawal logs: %w", err)
+	}
+	return logs, nil
+}
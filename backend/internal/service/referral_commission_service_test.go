@@ -0,0 +1,107 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommissionClawbackAmount(t *testing.T) {
+	t.Parallel()
+
+	// 全额退款追回全部佣金
+	assert.InDelta(t, 3.0, commissionClawbackAmount(3, 0, 1), 1e-9)
+	// 部分退款按比例追回
+	assert.InDelta(t, 1.5, commissionClawbackAmount(3, 0, 0.5), 1e-9)
+	// 重复处理同一笔退款不会重复追回
+	assert.Zero(t, commissionClawbackAmount(3, 1.5, 0.5))
+	// 后续更大比例的退款只追回差额
+	assert.InDelta(t, 1.5, commissionClawbackAmount(3, 1.5, 1), 1e-9)
+	// 比例超出 1 按 1 处理
+	assert.InDelta(t, 3.0, commissionClawbackAmount(3, 0, 1.2), 1e-9)
+	assert.Zero(t, commissionClawbackAmount(3, 0, 0))
+}
+
+func TestNextWithdrawalStatus(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		action, from, to string
+		ok               bool
+	}{
+		{WithdrawalActionApprove, WithdrawalStatusPending, WithdrawalStatusApproved, true},
+		{WithdrawalActionReject, WithdrawalStatusPending, WithdrawalStatusRejected, true},
+		{WithdrawalActionReject, WithdrawalStatusApproved, WithdrawalStatusRejected, true},
+		{WithdrawalActionPay, WithdrawalStatusApproved, WithdrawalStatusPaid, true},
+		{WithdrawalActionCancel, WithdrawalStatusPending, WithdrawalStatusCancelled, true},
+		// 未审核不可直接打款
+		{WithdrawalActionPay, WithdrawalStatusPending, "", false},
+		// 已审核通过后用户不可撤销
+		{WithdrawalActionCancel, WithdrawalStatusApproved, "", false},
+		// 终态不可再变更
+		{WithdrawalActionReject, WithdrawalStatusPaid, "", false},
+		{WithdrawalActionApprove, WithdrawalStatusRejected, "", false},
+		{WithdrawalActionApprove, WithdrawalStatusCancelled, "", false},
+		{WithdrawalActionCreate, "", "", false},
+	}
+	for _, tc := range cases {
+		to, ok := nextWithdrawalStatus(tc.action, tc.from)
+		assert.Equal(t, tc.ok, ok, "%s from %s", tc.action, tc.from)
+		assert.Equal(t, tc.to, to, "%s from %s", tc.action, tc.from)
+	}
+}
+
+func TestValidateWithdrawalInput(t *testing.T) {
+	t.Parallel()
+
+	in := CommissionWithdrawalInput{Amount: 20, PayoutMethod: " Alipay ", PayoutAccount: " user@example.com ", PayoutName: " 张三 "}
+	require.NoError(t, validateWithdrawalInput(&in, 10))
+	assert.Equal(t, "alipay", in.PayoutMethod)
+	assert.Equal(t, "user@example.com", in.PayoutAccount)
+	assert.Equal(t, "张三", in.PayoutName)
+
+	for _, bad := range []CommissionWithdrawalInput{
+		{Amount: 20, PayoutMethod: "cash", PayoutAccount: "x"},
+		{Amount: 20, PayoutMethod: "bank", PayoutAccount: "  "},
+	} {
+		assert.ErrorIs(t, validateWithdrawalInput(&bad, 10), ErrCommissionInvalidPayout)
+	}
+	for _, amount := range []float64{5, 0, -1, math.NaN(), math.Inf(1)} {
+		bad := CommissionWithdrawalInput{Amount: amount, PayoutMethod: "usdt", PayoutAccount: "T123"}
+		assert.ErrorIs(t, validateWithdrawalInput(&bad, 10), ErrCommissionWithdrawBelowMinimum, "amount %v", amount)
+	}
+}
+
+func TestBuildCommissionSummary(t *testing.T) {
+	t.Parallel()
+
+	summary := buildCommissionSummary(100, 30, 5, map[string]float64{
+		WithdrawalStatusPending:   20,
+		WithdrawalStatusApproved:  10,
+		WithdrawalStatusPaid:      40,
+		WithdrawalStatusRejected:  50,
+		WithdrawalStatusCancelled: 15,
+	})
+	assert.Equal(t, 130.0, summary.Total)
+	assert.Equal(t, 30.0, summary.Held)
+	assert.Equal(t, 30.0, summary.Withdrawing)
+	assert.Equal(t, 40.0, summary.Withdrawn)
+	assert.Equal(t, 5.0, summary.ClawedBack)
+	// 驳回 / 撤销的申请退回可提现金额，冻结中的佣金不可提现
+	assert.Equal(t, 30.0, summary.Available)
+
+	// 不会出现负数
+	assert.Zero(t, buildCommissionSummary(10, 0, 0, map[string]float64{WithdrawalStatusPaid: 20}).Available)
+}
+
+func TestReferralCommissionService_NilIsDisabled(t *testing.T) {
+	t.Parallel()
+
+	var svc *ReferralCommissionService
+	assert.False(t, svc.Enabled(context.Background()))
+}
@@ -16,6 +16,8 @@ type ReferralService struct {
 	referralRepo   ReferralRepository
 	userRepo       UserRepository
 	settingService *SettingService
+	balanceBuckets *BalanceBucketService
+	commissions    *ReferralCommissionService
 }
 
 // NewReferralService creates a new referral service instance
@@ -23,14 +25,21 @@ func NewReferralService(
 	referralRepo ReferralRepository,
 	userRepo UserRepository,
 	settingService *SettingService,
+	balanceBuckets *BalanceBucketService,
 ) *ReferralService {
 	return &ReferralService{
 		referralRepo:   referralRepo,
 		userRepo:       userRepo,
 		settingService: settingService,
+		balanceBuckets: balanceBuckets,
 	}
 }
 
+// SetCommissionService injects the commission ledger so commission can be withdrawn instead of spent.
+func (s *ReferralService) SetCommissionService(commissions *ReferralCommissionService) {
+	s.commissions = commissions
+}
+
 // GetSettings returns the current referral system settings
 func (s *ReferralService) GetSettings(ctx context.Context) *ReferralSettings {
 	return &ReferralSettings{
@@ -119,11 +128,18 @@ func (s *ReferralService) ProcessRegistrationReferral(ctx context.Context, newUs
 	if err := s.userRepo.UpdateBalance(ctx, referrerID, bonus); err != nil {
 		return 0, fmt.Errorf("update referrer balance: %w", err)
 	}
+	registerRef := fmt.Sprintf("register:%d", newUserID)
+	if err := s.balanceBuckets.Grant(ctx, referrerID, BalanceSourceReferral, bonus, registerRef); err != nil {
+		return 0, err
+	}
 
 	// Add balance to new user
 	if err := s.userRepo.UpdateBalance(ctx, newUserID, bonus); err != nil {
 		return 0, fmt.Errorf("update new user balance: %w", err)
 	}
+	if err := s.balanceBuckets.Grant(ctx, newUserID, BalanceSourceReferral, bonus, registerRef); err != nil {
+		return 0, err
+	}
 
 	log.Printf("[Referral] Registration bonus: referrer=%d, referee=%d, amount=%.2f", referrerID, newUserID, bonus)
 	return bonus, nil
@@ -182,7 +198,7 @@ func (s *ReferralService) ProcessCommission(ctx context.Context, userID int64, r
 	}
 
 	// Create commission reward record
-	_, err = s.referralRepo.CreateReward(ctx, &ReferralReward{
+	reward, err := s.referralRepo.CreateReward(ctx, &ReferralReward{
 		ReferrerID:     referrerID,
 		RefereeID:      userID,
 		RewardType:     ReferralRewardTypeCommission,
@@ -196,10 +212,35 @@ func (s *ReferralService) ProcessCommission(ctx context.Context, userID int64, r
 		return 0, fmt.Errorf("create commission reward: %w", err)
 	}
 
+	// 开启佣金提现时记入佣金账户（冻结期后可提现），否则直接计入可消费余额
+	if s.commissions.Enabled(ctx) {
+		var rewardID *int64
+		if reward != nil {
+			rewardID = &reward.ID
+		}
+		if _, err := s.commissions.Record(ctx, ReferralCommissionInput{
+			ReferrerID:   referrerID,
+			RefereeID:    userID,
+			RewardID:     rewardID,
+			SourceType:   sourceType,
+			SourceID:     sourceID,
+			SourceAmount: sourceAmount,
+			Amount:       commission,
+		}); err != nil {
+			return 0, err
+		}
+		log.Printf("[Referral] Commission recorded to ledger: referrer=%d, user=%d, amount=%.2f, rate=%.2f, source=%d",
+			referrerID, userID, commission, rate, sourceID)
+		return commission, nil
+	}
+
 	// Add commission to referrer's balance
 	if err := s.userRepo.UpdateBalance(ctx, referrerID, commission); err != nil {
 		return 0, fmt.Errorf("update referrer balance: %w", err)
 	}
+	if err := s.balanceBuckets.Grant(ctx, referrerID, BalanceSourceReferral, commission, fmt.Sprintf("commission:%s:%d", sourceType, sourceID)); err != nil {
+		return 0, err
+	}
 
 	log.Printf("[Referral] Commission: referrer=%d, user=%d, amount=%.2f, rate=%.2f, source=%d",
 		referrerID, userID, commission, rate, sourceID)
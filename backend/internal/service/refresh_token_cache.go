@@ -17,6 +17,11 @@ type RefreshTokenData struct {
 	FamilyID     string    `json:"family_id"`     // Token家族ID，用于防重放攻击
 	CreatedAt    time.Time `json:"created_at"`
 	ExpiresAt    time.Time `json:"expires_at"`
+
+	// 会话（设备）信息，Token轮转时沿用
+	SessionCreatedAt time.Time `json:"session_created_at,omitempty"` // 会话首次登录时间
+	UserAgent        string    `json:"user_agent,omitempty"`
+	IPAddress        string    `json:"ip_address,omitempty"`
 }
 
 // RefreshTokenCache 管理Refresh Token的Redis缓存
@@ -26,6 +31,7 @@ type RefreshTokenData struct {
 //   - refresh_token:{token_hash}     -> RefreshTokenData (JSON)
 //   - user_refresh_tokens:{user_id}  -> Set<token_hash>
 //   - token_family:{family_id}       -> Set<token_hash>
+//   - user_known_devices:{user_id}   -> Set<device_fingerprint>
 type RefreshTokenCache interface {
 	// StoreRefreshToken 存储Refresh Token
 	// tokenHash: Token的SHA256哈希值（不存储原始Token）
@@ -70,4 +76,12 @@ type RefreshTokenCache interface {
 	// IsTokenInFamily 检查Token是否属于指定家族
 	// 用于验证Token家族关系
 	IsTokenInFamily(ctx context.Context, familyID string, tokenHash string) (bool, error)
+
+	// HasActiveFamilyToken 检查家族中是否仍有未删除的Token
+	// 用于校验 Access Token 所属会话是否已被撤销或登出
+	HasActiveFamilyToken(ctx context.Context, familyID string) (bool, error)
+
+	// MarkKnownDevice 记录用户登录过的设备指纹
+	// 返回 (isNew, hadDevices, err)：isNew 表示该设备此前未出现过，hadDevices 表示记录前用户已有已知设备
+	MarkKnownDevice(ctx context.Context, userID int64, fingerprint string, ttl time.Duration) (isNew bool, hadDevices bool, err error)
 }
@@ -0,0 +1,83 @@
+package service
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/ctxkey"
+	"github.com/gin-gonic/gin"
+)
+
+const requestTransformServiceContextKey = "request_transform_service"
+
+// BindRequestTransformService 将请求改写服务绑定到请求上下文，供构建上游请求时应用规则。
+func BindRequestTransformService(c *gin.Context, svc *RequestTransformService) {
+	if c == nil || svc == nil {
+		return
+	}
+	c.Set(requestTransformServiceContextKey, svc)
+}
+
+func getBoundRequestTransformService(c *gin.Context) *RequestTransformService {
+	if c == nil {
+		return nil
+	}
+	v, ok := c.Get(requestTransformServiceContextKey)
+	if !ok {
+		return nil
+	}
+	svc, ok := v.(*RequestTransformService)
+	if !ok {
+		return nil
+	}
+	return svc
+}
+
+// applyBoundRequestTransforms 对即将转发的请求体应用命中的改写规则；未绑定服务或未命中时原样返回。
+func applyBoundRequestTransforms(c *gin.Context, account *Account, model string, body []byte) ([]byte, *RequestTransformResult) {
+	svc := getBoundRequestTransformService(c)
+	if svc == nil || account == nil {
+		return body, nil
+	}
+
+	scope := RequestTransformScope{
+		Platform:  account.Platform,
+		AccountID: account.ID,
+		Model:     model,
+	}
+	if c.Request != nil {
+		if group, ok := c.Request.Context().Value(ctxkey.Group).(*Group); ok && group != nil {
+			scope.GroupID = group.ID
+		}
+	}
+
+	result := svc.Apply(scope, body)
+	if result == nil {
+		return body, nil
+	}
+	return result.Body, result
+}
+
+// applyBoundRequestTransformsToPayload 对 WS 模式下 map 形式的请求载荷应用改写规则
+func applyBoundRequestTransformsToPayload(c *gin.Context, account *Account, model string, payload map[string]any) (map[string]any, *RequestTransformResult) {
+	if getBoundRequestTransformService(c) == nil {
+		return payload, nil
+	}
+	body, result := applyBoundRequestTransforms(c, account, model, payloadAsJSONBytes(payload))
+	if result == nil {
+		return payload, nil
+	}
+	transformed := make(map[string]any)
+	if err := json.Unmarshal(body, &transformed); err != nil {
+		return payload, result
+	}
+	return transformed, result
+}
+
+// applyRequestTransformHeaders 将命中规则的请求头操作应用到上游请求
+func applyRequestTransformHeaders(req *http.Request, result *RequestTransformResult) {
+	if req == nil || result == nil {
+		return
+	}
+	result.ApplyHeaders(req.Header)
+}
@@ -0,0 +1,463 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/model"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/logger"
+	"github.com/tidwall/gjson"
+)
+
+// RequestTransformRepository 定义请求改写规则的数据访问接口
+type RequestTransformRepository interface {
+	// List 获取所有规则
+	List(ctx context.Context) ([]*model.RequestTransformRule, error)
+	// GetByID 根据 ID 获取规则
+	GetByID(ctx context.Context, id int64) (*model.RequestTransformRule, error)
+	// Create 创建规则
+	Create(ctx context.Context, rule *model.RequestTransformRule) (*model.RequestTransformRule, error)
+	// Update 更新规则
+	Update(ctx context.Context, rule *model.RequestTransformRule) (*model.RequestTransformRule, error)
+	// Delete 删除规则
+	Delete(ctx context.Context, id int64) error
+}
+
+// RequestTransformCache 定义请求改写规则的缓存接口
+type RequestTransformCache interface {
+	// Get 从缓存获取规则列表
+	Get(ctx context.Context) ([]*model.RequestTransformRule, bool)
+	// Set 设置缓存
+	Set(ctx context.Context, rules []*model.RequestTransformRule) error
+	// Invalidate 使缓存失效
+	Invalidate(ctx context.Context) error
+	// NotifyUpdate 通知其他实例刷新缓存
+	NotifyUpdate(ctx context.Context) error
+	// SubscribeUpdates 订阅缓存更新通知
+	SubscribeUpdates(ctx context.Context, handler func())
+}
+
+// RequestTransformScope 规则匹配范围（来自当前转发请求）
+type RequestTransformScope struct {
+	Platform  string
+	GroupID   int64
+	AccountID int64
+	Model     string
+}
+
+// RequestTransformResult 规则应用结果
+type RequestTransformResult struct {
+	Body             []byte
+	HeaderOperations []model.RequestHeaderOperation
+	AppliedRuleIDs   []int64
+}
+
+// ApplyHeaders 将命中规则的请求头操作应用到上游请求头（受保护的头部始终跳过）
+func (r *RequestTransformResult) ApplyHeaders(header http.Header) {
+	if r == nil || header == nil {
+		return
+	}
+	for _, op := range r.HeaderOperations {
+		if model.IsProtectedTransformHeader(op.Name) {
+			continue
+		}
+		switch op.Op {
+		case model.HeaderOpSet:
+			header.Set(op.Name, op.Value)
+		case model.HeaderOpRemove:
+			header.Del(op.Name)
+		}
+	}
+}
+
+// RequestTransformService 请求改写规则服务
+type RequestTransformService struct {
+	repo  RequestTransformRepository
+	cache RequestTransformCache
+
+	// 本地内存缓存，用于热路径快速匹配
+	localCache   []*cachedTransformRule
+	localCacheMu sync.RWMutex
+}
+
+// cachedTransformRule 预计算的规则缓存
+type cachedTransformRule struct {
+	*model.RequestTransformRule
+	lowerPlatforms []string
+	groupIDSet     map[int64]struct{}
+	accountIDSet   map[int64]struct{}
+}
+
+// NewRequestTransformService 创建请求改写规则服务
+func NewRequestTransformService(
+	repo RequestTransformRepository,
+	cache RequestTransformCache,
+) *RequestTransformService {
+	svc := &RequestTransformService{
+		repo:  repo,
+		cache: cache,
+	}
+
+	// 启动时加载规则到本地缓存
+	ctx := context.Background()
+	if err := svc.reloadRulesFromDB(ctx); err != nil {
+		logger.LegacyPrintf("service.request_transform", "[RequestTransformService] Failed to load rules from DB on startup: %v", err)
+		if fallbackErr := svc.refreshLocalCache(ctx); fallbackErr != nil {
+			logger.LegacyPrintf("service.request_transform", "[RequestTransformService] Failed to load rules from cache fallback on startup: %v", fallbackErr)
+		}
+	}
+
+	// 订阅缓存更新通知
+	if cache != nil {
+		cache.SubscribeUpdates(ctx, func() {
+			if err := svc.refreshLocalCache(context.Background()); err != nil {
+				logger.LegacyPrintf("service.request_transform", "[RequestTransformService] Failed to refresh cache on notification: %v", err)
+			}
+		})
+	}
+
+	return svc
+}
+
+// List 获取所有规则
+func (s *RequestTransformService) List(ctx context.Context) ([]*model.RequestTransformRule, error) {
+	return s.repo.List(ctx)
+}
+
+// GetByID 根据 ID 获取规则
+func (s *RequestTransformService) GetByID(ctx context.Context, id int64) (*model.RequestTransformRule, error) {
+	return s.repo.GetByID(ctx, id)
+}
+
+// Create 创建规则
+func (s *RequestTransformService) Create(ctx context.Context, rule *model.RequestTransformRule) (*model.RequestTransformRule, error) {
+	if err := rule.Validate(); err != nil {
+		return nil, err
+	}
+
+	created, err := s.repo.Create(ctx, rule)
+	if err != nil {
+		return nil, err
+	}
+
+	refreshCtx, cancel := s.newCacheRefreshContext()
+	defer cancel()
+	s.invalidateAndNotify(refreshCtx)
+
+	return created, nil
+}
+
+// Update 更新规则
+func (s *RequestTransformService) Update(ctx context.Context, rule *model.RequestTransformRule) (*model.RequestTransformRule, error) {
+	if err := rule.Validate(); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.repo.Update(ctx, rule)
+	if err != nil {
+		return nil, err
+	}
+
+	refreshCtx, cancel := s.newCacheRefreshContext()
+	defer cancel()
+	s.invalidateAndNotify(refreshCtx)
+
+	return updated, nil
+}
+
+// Delete 删除规则
+func (s *RequestTransformService) Delete(ctx context.Context, id int64) error {
+	if err := s.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	refreshCtx, cancel := s.newCacheRefreshContext()
+	defer cancel()
+	s.invalidateAndNotify(refreshCtx)
+
+	return nil
+}
+
+// Apply 按优先级顺序应用所有命中范围的启用规则
+// 未命中任何规则时返回 nil，调用方应继续使用原始 body
+func (s *RequestTransformService) Apply(scope RequestTransformScope, body []byte) *RequestTransformResult {
+	if s == nil {
+		return nil
+	}
+	return applyTransformRules(s.getCachedRules(), scope, body)
+}
+
+// Preview 对样例请求预览改写结果
+// rule 非空时仅评估该规则（可用于保存前试运行），否则使用当前生效的全部规则
+func (s *RequestTransformService) Preview(scope RequestTransformScope, body []byte, rule *model.RequestTransformRule) (*RequestTransformResult, error) {
+	var rules []*cachedTransformRule
+	if rule != nil {
+		if err := rule.Validate(); err != nil {
+			return nil, err
+		}
+		draft := *rule
+		draft.Enabled = true
+		rules = []*cachedTransformRule{compileTransformRule(&draft)}
+	} else {
+		rules = s.getCachedRules()
+	}
+
+	result := applyTransformRules(rules, scope, body)
+	if result == nil {
+		result = &RequestTransformResult{Body: body}
+	}
+	if result.HeaderOperations == nil {
+		result.HeaderOperations = []model.RequestHeaderOperation{}
+	}
+	if result.AppliedRuleIDs == nil {
+		result.AppliedRuleIDs = []int64{}
+	}
+	return result, nil
+}
+
+func applyTransformRules(rules []*cachedTransformRule, scope RequestTransformScope, body []byte) *RequestTransformResult {
+	if len(rules) == 0 {
+		return nil
+	}
+
+	lowerPlatform := strings.ToLower(scope.Platform)
+	// 非 JSON 请求体（如 multipart 图片上传）只应用请求头操作
+	bodyIsJSON := gjson.ValidBytes(body) && gjson.ParseBytes(body).IsObject()
+
+	var result *RequestTransformResult
+	for _, rule := range rules {
+		if !rule.Enabled || !rule.matches(lowerPlatform, scope) {
+			continue
+		}
+		if result == nil {
+			result = &RequestTransformResult{Body: body}
+		}
+		if bodyIsJSON {
+			result.Body = applyBodyOperations(result.Body, rule.BodyOperations)
+		}
+		result.HeaderOperations = append(result.HeaderOperations, rule.HeaderOperations...)
+		result.AppliedRuleIDs = append(result.AppliedRuleIDs, rule.ID)
+	}
+	return result
+}
+
+// matches 检查规则范围：平台、分组、账号、模型均需满足（空表示不限制）
+func (r *cachedTransformRule) matches(lowerPlatform string, scope RequestTransformScope) bool {
+	if len(r.lowerPlatforms) > 0 {
+		matched := false
+		for _, p := range r.lowerPlatforms {
+			if p == lowerPlatform {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	if len(r.groupIDSet) > 0 {
+		if _, ok := r.groupIDSet[scope.GroupID]; !ok {
+			return false
+		}
+	}
+	if len(r.accountIDSet) > 0 {
+		if _, ok := r.accountIDSet[scope.AccountID]; !ok {
+			return false
+		}
+	}
+	if len(r.ModelPatterns) > 0 {
+		for _, pattern := range r.ModelPatterns {
+			if matchModelPattern(pattern, scope.Model) {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// applyBodyOperations 按顺序执行请求体 JSON 操作，单个操作失败时跳过
+func applyBodyOperations(body []byte, ops []model.RequestBodyOperation) []byte {
+	for _, op := range ops {
+		switch op.Op {
+		case model.BodyOpSet:
+			body, _ = setJSONRawBytes(body, op.Path, op.Value)
+		case model.BodyOpDefault:
+			if !gjson.GetBytes(body, op.Path).Exists() {
+				body, _ = setJSONRawBytes(body, op.Path, op.Value)
+			}
+		case model.BodyOpDelete:
+			body, _ = deleteJSONPathBytes(body, op.Path)
+		case model.BodyOpRename:
+			value := gjson.GetBytes(body, op.Path)
+			if !value.Exists() {
+				continue
+			}
+			if next, ok := setJSONRawBytes(body, op.To, []byte(value.Raw)); ok {
+				body, _ = deleteJSONPathBytes(next, op.Path)
+			}
+		}
+	}
+	return body
+}
+
+// getCachedRules 获取缓存的规则列表（按优先级排序）
+func (s *RequestTransformService) getCachedRules() []*cachedTransformRule {
+	s.localCacheMu.RLock()
+	rules := s.localCache
+	s.localCacheMu.RUnlock()
+
+	if rules != nil {
+		return rules
+	}
+
+	// 如果本地缓存为空，尝试刷新
+	ctx := context.Background()
+	if err := s.refreshLocalCache(ctx); err != nil {
+		logger.LegacyPrintf("service.request_transform", "[RequestTransformService] Failed to refresh cache: %v", err)
+		return nil
+	}
+
+	s.localCacheMu.RLock()
+	defer s.localCacheMu.RUnlock()
+	return s.localCache
+}
+
+// refreshLocalCache 刷新本地缓存
+func (s *RequestTransformService) refreshLocalCache(ctx context.Context) error {
+	if s.cache != nil {
+		if rules, ok := s.cache.Get(ctx); ok {
+			s.setLocalCache(rules)
+			return nil
+		}
+	}
+
+	return s.reloadRulesFromDB(ctx)
+}
+
+// reloadRulesFromDB 从数据库加载规则，绕过 cache.Get 以拿到最新值
+func (s *RequestTransformService) reloadRulesFromDB(ctx context.Context) error {
+	rules, err := s.repo.List(ctx)
+	if err != nil {
+		return err
+	}
+
+	if s.cache != nil {
+		if err := s.cache.Set(ctx, rules); err != nil {
+			logger.LegacyPrintf("service.request_transform", "[RequestTransformService] Failed to set cache: %v", err)
+		}
+	}
+
+	s.setLocalCache(rules)
+	return nil
+}
+
+func compileTransformRule(r *model.RequestTransformRule) *cachedTransformRule {
+	cr := &cachedTransformRule{RequestTransformRule: r}
+	if len(r.Platforms) > 0 {
+		cr.lowerPlatforms = make([]string, len(r.Platforms))
+		for i, p := range r.Platforms {
+			cr.lowerPlatforms[i] = strings.ToLower(p)
+		}
+	}
+	if len(r.GroupIDs) > 0 {
+		cr.groupIDSet = make(map[int64]struct{}, len(r.GroupIDs))
+		for _, id := range r.GroupIDs {
+			cr.groupIDSet[id] = struct{}{}
+		}
+	}
+	if len(r.AccountIDs) > 0 {
+		cr.accountIDSet = make(map[int64]struct{}, len(r.AccountIDs))
+		for _, id := range r.AccountIDs {
+			cr.accountIDSet[id] = struct{}{}
+		}
+	}
+	return cr
+}
+
+// setLocalCache 设置本地缓存（按优先级稳定排序）
+func (s *RequestTransformService) setLocalCache(rules []*model.RequestTransformRule) {
+	cached := make([]*cachedTransformRule, len(rules))
+	for i, r := range rules {
+		cached[i] = compileTransformRule(r)
+	}
+	sort.SliceStable(cached, func(i, j int) bool {
+		return cached[i].Priority < cached[j].Priority
+	})
+
+	s.localCacheMu.Lock()
+	s.localCache = cached
+	s.localCacheMu.Unlock()
+}
+
+// clearLocalCache 清空本地缓存，避免刷新失败时继续命中陈旧规则。
+func (s *RequestTransformService) clearLocalCache() {
+	s.localCacheMu.Lock()
+	s.localCache = nil
+	s.localCacheMu.Unlock()
+}
+
+// newCacheRefreshContext 为写路径缓存同步创建独立上下文，避免受请求取消影响。
+func (s *RequestTransformService) newCacheRefreshContext() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), 3*time.Second)
+}
+
+// invalidateAndNotify 使缓存失效并通知其他实例
+func (s *RequestTransformService) invalidateAndNotify(ctx context.Context) {
+	if s.cache != nil {
+		if err := s.cache.Invalidate(ctx); err != nil {
+			logger.LegacyPrintf("service.request_transform", "[RequestTransformService] Failed to invalidate cache: %v", err)
+		}
+	}
+
+	if err := s.reloadRulesFromDB(ctx); err != nil {
+		logger.LegacyPrintf("service.request_transform", "[RequestTransformService] Failed to refresh local cache: %v", err)
+		s.clearLocalCache()
+	}
+
+	if s.cache != nil {
+		if err := s.cache.NotifyUpdate(ctx); err != nil {
+			logger.LegacyPrintf("service.request_transform", "[RequestTransformService] Failed to notify cache update: %v", err)
+		}
+	}
+}
+
+// previewHeaders 将请求头操作应用到样例请求头，返回结果供预览展示
+func previewHeaders(headers map[string]string, result *RequestTransformResult) map[string]string {
+	h := http.Header{}
+	for k, v := range headers {
+		h.Set(k, v)
+	}
+	result.ApplyHeaders(h)
+	out := make(map[string]string, len(h))
+	for k := range h {
+		out[k] = h.Get(k)
+	}
+	return out
+}
+
+// RequestTransformPreview 预览结果
+type RequestTransformPreview struct {
+	Body           json.RawMessage   `json:"body"`
+	Headers        map[string]string `json:"headers"`
+	AppliedRuleIDs []int64           `json:"applied_rule_ids"`
+}
+
+// BuildPreview 组装预览结果；非 JSON 请求体按字符串返回
+func (r *RequestTransformResult) BuildPreview(sampleHeaders map[string]string) *RequestTransformPreview {
+	body := json.RawMessage(r.Body)
+	if !json.Valid(r.Body) {
+		quoted, _ := json.Marshal(string(r.Body))
+		body = quoted
+	}
+	return &RequestTransformPreview{
+		Body:           body,
+		Headers:        previewHeaders(sampleHeaders, r),
+		AppliedRuleIDs: r.AppliedRuleIDs,
+	}
+}
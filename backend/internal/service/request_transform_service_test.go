@@ -0,0 +1,207 @@
+//go:build unit
+
+package service
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Wei-Shaw/sub2api/internal/config"
+	"github.com/Wei-Shaw/sub2api/internal/model"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+)
+
+func newRequestTransformTestService(rules ...*model.RequestTransformRule) *RequestTransformService {
+	svc := &RequestTransformService{}
+	svc.setLocalCache(rules)
+	return svc
+}
+
+func TestRequestTransformApply_BodyOperations(t *testing.T) {
+	svc := newRequestTransformTestService(&model.RequestTransformRule{
+		ID:      1,
+		Name:    "normalize",
+		Enabled: true,
+		BodyOperations: []model.RequestBodyOperation{
+			{Op: model.BodyOpSet, Path: "metadata.tier", Value: json.RawMessage(`"gold"`)},
+			{Op: model.BodyOpDefault, Path: "temperature", Value: json.RawMessage(`0.2`)},
+			{Op: model.BodyOpDefault, Path: "max_tokens", Value: json.RawMessage(`1`)},
+			{Op: model.BodyOpRename, Path: "user", To: "metadata.user_id"},
+			{Op: model.BodyOpDelete, Path: "logprobs"},
+		},
+	})
+
+	result := svc.Apply(RequestTransformScope{Platform: PlatformAnthropic, Model: "claude-sonnet-4-5"},
+		[]byte(`{"model":"claude-sonnet-4-5","max_tokens":100,"user":"u-1","logprobs":true}`))
+	require.NotNil(t, result)
+	require.Equal(t, []int64{1}, result.AppliedRuleIDs)
+	require.JSONEq(t, `{"model":"claude-sonnet-4-5","max_tokens":100,"temperature":0.2,"metadata":{"tier":"gold","user_id":"u-1"}}`, string(result.Body))
+}
+
+func TestRequestTransformApply_ScopeAndPriority(t *testing.T) {
+	svc := newRequestTransformTestService(
+		&model.RequestTransformRule{
+			ID: 2, Name: "second", Enabled: true, Priority: 20,
+			BodyOperations: []model.RequestBodyOperation{{Op: model.BodyOpSet, Path: "tag", Value: json.RawMessage(`"second"`)}},
+		},
+		&model.RequestTransformRule{
+			ID: 1, Name: "first", Enabled: true, Priority: 10,
+			Platforms: []string{"OpenAI"}, GroupIDs: []int64{7}, ModelPatterns: []string{"gpt-5*"},
+			BodyOperations: []model.RequestBodyOperation{{Op: model.BodyOpSet, Path: "tag", Value: json.RawMessage(`"first"`)}},
+		},
+		&model.RequestTransformRule{
+			ID: 3, Name: "disabled", Enabled: false,
+			BodyOperations: []model.RequestBodyOperation{{Op: model.BodyOpSet, Path: "tag", Value: json.RawMessage(`"disabled"`)}},
+		},
+		&model.RequestTransformRule{
+			ID: 4, Name: "other account", Enabled: true, AccountIDs: []int64{99},
+			BodyOperations: []model.RequestBodyOperation{{Op: model.BodyOpSet, Path: "tag", Value: json.RawMessage(`"account"`)}},
+		},
+	)
+
+	result := svc.Apply(RequestTransformScope{Platform: PlatformOpenAI, GroupID: 7, AccountID: 1, Model: "gpt-5.1"}, []byte(`{}`))
+	require.NotNil(t, result)
+	require.Equal(t, []int64{1, 2}, result.AppliedRuleIDs)
+	require.JSONEq(t, `{"tag":"second"}`, string(result.Body))
+
+	result = svc.Apply(RequestTransformScope{Platform: PlatformOpenAI, GroupID: 8, AccountID: 1, Model: "gpt-5.1"}, []byte(`{}`))
+	require.Equal(t, []int64{2}, result.AppliedRuleIDs)
+}
+
+func TestRequestTransformApply_NonJSONBodyOnlyAppliesHeaders(t *testing.T) {
+	svc := newRequestTransformTestService(&model.RequestTransformRule{
+		ID: 1, Name: "headers", Enabled: true,
+		BodyOperations:   []model.RequestBodyOperation{{Op: model.BodyOpSet, Path: "a", Value: json.RawMessage(`1`)}},
+		HeaderOperations: []model.RequestHeaderOperation{{Op: model.HeaderOpSet, Name: "X-Team", Value: "core"}},
+	})
+
+	body := []byte("--boundary\r\nmultipart")
+	result := svc.Apply(RequestTransformScope{}, body)
+	require.NotNil(t, result)
+	require.Equal(t, body, result.Body)
+
+	header := http.Header{}
+	result.ApplyHeaders(header)
+	require.Equal(t, "core", header.Get("X-Team"))
+}
+
+func TestRequestTransformResult_ApplyHeadersSkipsProtected(t *testing.T) {
+	result := &RequestTransformResult{HeaderOperations: []model.RequestHeaderOperation{
+		{Op: model.HeaderOpSet, Name: "authorization", Value: "Bearer evil"},
+		{Op: model.HeaderOpRemove, Name: "x-api-key"},
+		{Op: model.HeaderOpRemove, Name: "X-Debug"},
+		// Bedrock SigV4 签名覆盖的头部
+		{Op: model.HeaderOpSet, Name: "content-type", Value: "text/plain"},
+		{Op: model.HeaderOpSet, Name: "x-amz-date", Value: "20200101T000000Z"},
+		{Op: model.HeaderOpRemove, Name: "X-Amz-Security-Token"},
+	}}
+	header := http.Header{}
+	header.Set("Authorization", "Bearer real")
+	header.Set("X-Api-Key", "key")
+	header.Set("X-Debug", "1")
+	header.Set("Content-Type", "application/json")
+	header.Set("X-Amz-Date", "20260101T000000Z")
+	header.Set("X-Amz-Security-Token", "token")
+
+	result.ApplyHeaders(header)
+	require.Equal(t, "Bearer real", header.Get("Authorization"))
+	require.Equal(t, "key", header.Get("X-Api-Key"))
+	require.Empty(t, header.Get("X-Debug"))
+	require.Equal(t, "application/json", header.Get("Content-Type"))
+	require.Equal(t, "20260101T000000Z", header.Get("X-Amz-Date"))
+	require.Equal(t, "token", header.Get("X-Amz-Security-Token"))
+}
+
+func TestRequestTransformRule_Validate(t *testing.T) {
+	valid := &model.RequestTransformRule{
+		Name:           "ok",
+		BodyOperations: []model.RequestBodyOperation{{Op: model.BodyOpDelete, Path: "a"}},
+	}
+	require.NoError(t, valid.Validate())
+	scoped := &model.RequestTransformRule{Name: "scoped", Platforms: []string{"Anthropic", "openai"}, BodyOperations: valid.BodyOperations}
+	require.NoError(t, scoped.Validate())
+
+	cases := []*model.RequestTransformRule{
+		{Name: "", BodyOperations: valid.BodyOperations},
+		{Name: "no ops"},
+		{Name: "bad json", BodyOperations: []model.RequestBodyOperation{{Op: model.BodyOpSet, Path: "a", Value: json.RawMessage(`{`)}}},
+		{Name: "rename", BodyOperations: []model.RequestBodyOperation{{Op: model.BodyOpRename, Path: "a"}}},
+		{Name: "bad op", BodyOperations: []model.RequestBodyOperation{{Op: "merge", Path: "a"}}},
+		{Name: "protected", HeaderOperations: []model.RequestHeaderOperation{{Op: model.HeaderOpSet, Name: "Authorization", Value: "x"}}},
+		{Name: "signed", HeaderOperations: []model.RequestHeaderOperation{{Op: model.HeaderOpSet, Name: "X-Amz-Content-Sha256", Value: "x"}}},
+		// Gemini / Antigravity 的转发链路不应用改写规则，不允许配置
+		{Name: "gemini", Platforms: []string{"gemini"}, BodyOperations: valid.BodyOperations},
+		{Name: "antigravity", Platforms: []string{PlatformAnthropic, "antigravity"}, BodyOperations: valid.BodyOperations},
+	}
+	for _, rule := range cases {
+		err := rule.Validate()
+		require.Error(t, err, rule.Name)
+		_, ok := err.(*model.ValidationError)
+		require.True(t, ok)
+	}
+}
+
+func TestRequestTransformPreview_DraftRule(t *testing.T) {
+	svc := newRequestTransformTestService()
+	draft := &model.RequestTransformRule{
+		ID: 0, Name: "draft", Enabled: false,
+		BodyOperations:   []model.RequestBodyOperation{{Op: model.BodyOpSet, Path: "stream", Value: json.RawMessage(`false`)}},
+		HeaderOperations: []model.RequestHeaderOperation{{Op: model.HeaderOpRemove, Name: "X-Trace"}},
+	}
+
+	result, err := svc.Preview(RequestTransformScope{Model: "gpt-5"}, []byte(`{"model":"gpt-5","stream":true}`), draft)
+	require.NoError(t, err)
+	preview := result.BuildPreview(map[string]string{"X-Trace": "1", "Accept": "text/event-stream"})
+	require.JSONEq(t, `{"model":"gpt-5","stream":false}`, string(preview.Body))
+	require.Equal(t, map[string]string{"Accept": "text/event-stream"}, preview.Headers)
+
+	result, err = svc.Preview(RequestTransformScope{}, []byte(`{}`), nil)
+	require.NoError(t, err)
+	require.Empty(t, result.AppliedRuleIDs)
+}
+
+func TestRequestTransform_PassthroughBuildersApplyRules(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	svc := newRequestTransformTestService(&model.RequestTransformRule{
+		ID: 1, Name: "tag", Enabled: true,
+		BodyOperations:   []model.RequestBodyOperation{{Op: model.BodyOpSet, Path: "metadata.tier", Value: json.RawMessage(`"gold"`)}},
+		HeaderOperations: []model.RequestHeaderOperation{{Op: model.HeaderOpSet, Name: "X-Tier", Value: "gold"}},
+	})
+	newContext := func(path string) *gin.Context {
+		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+		c.Request = httptest.NewRequest(http.MethodPost, path, nil)
+		BindRequestTransformService(c, svc)
+		return c
+	}
+	assertTransformed := func(req *http.Request) {
+		body, err := io.ReadAll(req.Body)
+		require.NoError(t, err)
+		require.Equal(t, "gold", gjson.GetBytes(body, "metadata.tier").String())
+		require.Equal(t, "gold", req.Header.Get("X-Tier"))
+	}
+
+	// Anthropic API Key 透传
+	c := newContext("/v1/messages")
+	anthropicAccount := &Account{ID: 1, Platform: PlatformAnthropic, Type: AccountTypeAPIKey}
+	req, err := (&GatewayService{cfg: &config.Config{}}).buildUpstreamRequestAnthropicAPIKeyPassthrough(c.Request.Context(), c, anthropicAccount, []byte(`{"model":"claude-sonnet-4-5"}`), "k")
+	require.NoError(t, err)
+	assertTransformed(req)
+
+	// OpenAI 透传
+	c = newContext("/v1/responses")
+	openaiAccount := &Account{ID: 2, Platform: PlatformOpenAI, Type: AccountTypeAPIKey}
+	req, err = (&OpenAIGatewayService{cfg: &config.Config{}}).buildUpstreamRequestOpenAIPassthrough(c.Request.Context(), c, openaiAccount, []byte(`{"model":"gpt-5"}`), "k", false)
+	require.NoError(t, err)
+	assertTransformed(req)
+
+	// WS 模式的 map 载荷
+	c = newContext("/v1/responses")
+	payload, result := applyBoundRequestTransformsToPayload(c, openaiAccount, "gpt-5", map[string]any{"model": "gpt-5"})
+	require.NotNil(t, result)
+	require.Equal(t, map[string]any{"tier": "gold"}, payload["metadata"])
+}
@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/pkg/logger"
+)
+
+// availabilityScheduleCheckInterval 可用时段边界检测间隔（时段精度为分钟）
+const availabilityScheduleCheckInterval = 15 * time.Second
+
+// filterByAvailabilitySchedule 构建桶时剔除不在可用时段内的账号；分组不在可用时段内时整个桶为空。
+// 出现过的时段表达式会被记录，供边界检测在可用性翻转时触发重建。
+func (s *SchedulerSnapshotService) filterByAvailabilitySchedule(ctx context.Context, bucket SchedulerBucket, accounts []Account, now time.Time) []Account {
+	if bucket.GroupID > 0 && !s.isRunModeSimple() && s.groupRepo != nil {
+		group, err := s.groupRepo.GetByIDLite(ctx, bucket.GroupID)
+		if err == nil && group != nil && group.AvailabilitySchedule != "" {
+			s.trackAvailabilitySchedule(group.AvailabilitySchedule, now)
+			if !group.IsAvailableAt(now) {
+				return []Account{}
+			}
+		}
+	}
+
+	filtered := make([]Account, 0, len(accounts))
+	for _, acc := range accounts {
+		if acc.AvailabilitySchedule != "" {
+			s.trackAvailabilitySchedule(acc.AvailabilitySchedule, now)
+			if !IsWithinAvailabilitySchedule(acc.AvailabilitySchedule, now) {
+				continue
+			}
+		}
+		filtered = append(filtered, acc)
+	}
+	return filtered
+}
+
+func (s *SchedulerSnapshotService) trackAvailabilitySchedule(expr string, now time.Time) {
+	s.scheduleMu.Lock()
+	defer s.scheduleMu.Unlock()
+	if s.scheduleStates == nil {
+		s.scheduleStates = make(map[string]bool)
+	}
+	if _, ok := s.scheduleStates[expr]; !ok {
+		s.scheduleStates[expr] = IsWithinAvailabilitySchedule(expr, now)
+	}
+}
+
+// availabilityScheduleFlipped 检测已记录的时段表达式在 now 时是否有可用性翻转
+func (s *SchedulerSnapshotService) availabilityScheduleFlipped(now time.Time) bool {
+	s.scheduleMu.Lock()
+	defer s.scheduleMu.Unlock()
+	flipped := false
+	for expr, active := range s.scheduleStates {
+		current := IsWithinAvailabilitySchedule(expr, now)
+		if current != active {
+			s.scheduleStates[expr] = current
+			flipped = true
+		}
+	}
+	return flipped
+}
+
+func (s *SchedulerSnapshotService) runAvailabilityScheduleWorker(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if !s.availabilityScheduleFlipped(time.Now()) {
+				continue
+			}
+			if err := s.triggerFullRebuild("availability_schedule"); err != nil {
+				logger.LegacyPrintf("service.scheduler_snapshot", "[Scheduler] availability schedule rebuild failed: %v", err)
+			}
+		case <-s.stopCh:
+			return
+		}
+	}
+}
@@ -32,6 +32,15 @@ type SchedulerSnapshotService struct {
 	fallbackLimit *fallbackLimiter
 	lagMu         sync.Mutex
 	lagFailures   int
+
+	// 可用时段表达式 → 上次检测时是否处于可用时段（用于边界翻转时触发重建）
+	scheduleMu     sync.Mutex
+	scheduleStates map[string]bool
+
+	// 代理池：读取账号时将引用代理池的账号出口解析为池内健康成员
+	proxyPools *ProxyPoolService
+	// 账号熔断器：读取账号时将熔断中的账号标记为不可调度
+	circuitBreaker *AccountCircuitBreaker
 }
 
 func NewSchedulerSnapshotService(
@@ -46,16 +55,55 @@ func NewSchedulerSnapshotService(
 		maxQPS = cfg.Gateway.Scheduling.DbFallbackMaxQPS
 	}
 	return &SchedulerSnapshotService{
-		cache:         cache,
-		outboxRepo:    outboxRepo,
-		accountRepo:   accountRepo,
-		groupRepo:     groupRepo,
-		cfg:           cfg,
-		stopCh:        make(chan struct{}),
-		fallbackLimit: newFallbackLimiter(maxQPS),
+		cache:          cache,
+		outboxRepo:     outboxRepo,
+		accountRepo:    accountRepo,
+		groupRepo:      groupRepo,
+		cfg:            cfg,
+		stopCh:         make(chan struct{}),
+		fallbackLimit:  newFallbackLimiter(maxQPS),
+		scheduleStates: make(map[string]bool),
 	}
 }
 
+// SetProxyPoolService 设置代理池服务（可选依赖）
+func (s *SchedulerSnapshotService) SetProxyPoolService(proxyPools *ProxyPoolService) {
+	s.proxyPools = proxyPools
+}
+
+// SetAccountCircuitBreaker 设置账号熔断器（可选依赖）
+func (s *SchedulerSnapshotService) SetAccountCircuitBreaker(breaker *AccountCircuitBreaker) {
+	s.circuitBreaker = breaker
+}
+
+// AccountCircuitBreaker 返回账号熔断器（未启用时为 nil）
+func (s *SchedulerSnapshotService) AccountCircuitBreaker() *AccountCircuitBreaker {
+	if s == nil {
+		return nil
+	}
+	return s.circuitBreaker
+}
+
+// annotateAccounts 为读取到的账号解析代理池出口并标记熔断状态（账号为缓存/数据库读取的副本，可直接修改）。
+// 熔断标记只查看状态，半开放行名额在账号被选中取得槽位时才消耗（见 admitAcquiredAccountSlot）。
+func (s *SchedulerSnapshotService) annotateAccounts(accounts []Account) []Account {
+	if s.proxyPools == nil && s.circuitBreaker == nil {
+		return accounts
+	}
+	for i := range accounts {
+		s.annotateAccount(&accounts[i])
+	}
+	return accounts
+}
+
+func (s *SchedulerSnapshotService) annotateAccount(account *Account) {
+	if account == nil {
+		return
+	}
+	s.proxyPools.ApplyAccountProxy(account)
+	account.CircuitOpen = !s.circuitBreaker.Allow(account.ID)
+}
+
 func (s *SchedulerSnapshotService) Start() {
 	if s == nil || s.cache == nil {
 		return
@@ -84,6 +132,12 @@ func (s *SchedulerSnapshotService) Start() {
 			s.runFullRebuildWorker(fullInterval)
 		}()
 	}
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		s.runAvailabilityScheduleWorker(availabilityScheduleCheckInterval)
+	}()
 }
 
 func (s *SchedulerSnapshotService) Stop() {
@@ -106,7 +160,7 @@ func (s *SchedulerSnapshotService) ListSchedulableAccounts(ctx context.Context,
 		if err != nil {
 			logger.LegacyPrintf("service.scheduler_snapshot", "[Scheduler] cache read failed: bucket=%s err=%v", bucket.String(), err)
 		} else if hit {
-			return derefAccounts(cached), useMixed, nil
+			return s.annotateAccounts(derefAccounts(cached)), useMixed, nil
 		}
 	}
 
@@ -128,7 +182,7 @@ func (s *SchedulerSnapshotService) ListSchedulableAccounts(ctx context.Context,
 		}
 	}
 
-	return accounts, useMixed, nil
+	return s.annotateAccounts(accounts), useMixed, nil
 }
 
 func (s *SchedulerSnapshotService) GetAccount(ctx context.Context, accountID int64) (*Account, error) {
@@ -140,6 +194,7 @@ func (s *SchedulerSnapshotService) GetAccount(ctx context.Context, accountID int
 		if err != nil {
 			logger.LegacyPrintf("service.scheduler_snapshot", "[Scheduler] account cache read failed: id=%d err=%v", accountID, err)
 		} else if account != nil {
+			s.annotateAccount(account)
 			return account, nil
 		}
 	}
@@ -149,7 +204,12 @@ func (s *SchedulerSnapshotService) GetAccount(ctx context.Context, accountID int
 	}
 	fallbackCtx, cancel := s.withFallbackTimeout(ctx)
 	defer cancel()
-	return s.accountRepo.GetByID(fallbackCtx, accountID)
+	account, err := s.accountRepo.GetByID(fallbackCtx, accountID)
+	if err != nil {
+		return nil, err
+	}
+	s.annotateAccount(account)
+	return account, nil
 }
 
 // GetGroupByID 获取分组信息（供调度器使用）
@@ -599,6 +659,14 @@ func (s *SchedulerSnapshotService) checkOutboxLag(ctx context.Context, oldest Sc
 }
 
 func (s *SchedulerSnapshotService) loadAccountsFromDB(ctx context.Context, bucket SchedulerBucket, useMixed bool) ([]Account, error) {
+	accounts, err := s.querySchedulableAccounts(ctx, bucket, useMixed)
+	if err != nil {
+		return nil, err
+	}
+	return s.filterByAvailabilitySchedule(ctx, bucket, accounts, time.Now()), nil
+}
+
+func (s *SchedulerSnapshotService) querySchedulableAccounts(ctx context.Context, bucket SchedulerBucket, useMixed bool) ([]Account, error) {
 	if s.accountRepo == nil {
 		return nil, ErrSchedulerCacheNotReady
 	}
@@ -1712,6 +1712,61 @@ func (s *SettingService) GetReferralMaxTotalReward(ctx context.Context) float64
 	return 0
 }
 
+// IsReferralWithdrawEnabled 检查返佣是否计入可提现佣金账户
+func (s *SettingService) IsReferralWithdrawEnabled(ctx context.Context) bool {
+	value, err := s.settingRepo.GetValue(ctx, SettingKeyReferralWithdrawEnabled)
+	if err != nil {
+		return false
+	}
+	return value == "true"
+}
+
+// GetReferralWithdrawMinAmount 获取单笔最低提现金额
+func (s *SettingService) GetReferralWithdrawMinAmount(ctx context.Context) float64 {
+	value, err := s.settingRepo.GetValue(ctx, SettingKeyReferralWithdrawMinAmount)
+	if err != nil {
+		return 10.0
+	}
+	if v, err := strconv.ParseFloat(value, 64); err == nil && v >= 0 {
+		return v
+	}
+	return 10.0
+}
+
+// GetReferralHoldingDays 获取佣金冻结期（天）
+func (s *SettingService) GetReferralHoldingDays(ctx context.Context) int {
+	value, err := s.settingRepo.GetValue(ctx, SettingKeyReferralHoldingDays)
+	if err != nil {
+		return 7
+	}
+	if v, err := strconv.Atoi(strings.TrimSpace(value)); err == nil && v >= 0 {
+		return v
+	}
+	return 7
+}
+
+// GetBonusCreditValidDays 获取赠送额度有效期（天，0=永不过期）
+// source 取 BalanceSourcePromo / BalanceSourceReferral，其他来源永不过期。
+func (s *SettingService) GetBonusCreditValidDays(ctx context.Context, source string) int {
+	var key string
+	switch source {
+	case BalanceSourcePromo:
+		key = SettingKeyPromoCreditValidDays
+	case BalanceSourceReferral:
+		key = SettingKeyReferralCreditValidDays
+	default:
+		return 0
+	}
+	value, err := s.settingRepo.GetValue(ctx, key)
+	if err != nil {
+		return 0
+	}
+	if v, err := strconv.Atoi(strings.TrimSpace(value)); err == nil && v > 0 {
+		return v
+	}
+	return 0
+}
+
 // GetAPIBaseURL 获取 API 基础 URL
 func (s *SettingService) GetAPIBaseURL(ctx context.Context) string {
 	value, err := s.settingRepo.GetValue(ctx, SettingKeyAPIBaseURL)
@@ -555,6 +555,33 @@ func (s *SubscriptionService) ExtendSubscription(ctx context.Context, subscripti
 	return s.userSubRepo.GetByID(ctx, subscriptionID)
 }
 
+// SuspendLapsedSubscription 暂停即将到期的订阅（用于自动续费扣款失败）
+// 仅当订阅处于 active 且过期时间早于 before 时暂停，剩余时长较多的订阅不受影响；
+// 后续续费成功时 AssignOrExtendSubscription 会恢复为 active。
+func (s *SubscriptionService) SuspendLapsedSubscription(ctx context.Context, userID, groupID int64, before time.Time) (bool, error) {
+	sub, err := s.userSubRepo.GetByUserIDAndGroupID(ctx, userID, groupID)
+	if err != nil {
+		return false, nil
+	}
+	if sub.Status != SubscriptionStatusActive || !sub.ExpiresAt.Before(before) {
+		return false, nil
+	}
+	if err := s.userSubRepo.UpdateStatus(ctx, sub.ID, SubscriptionStatusSuspended); err != nil {
+		return false, fmt.Errorf("suspend subscription: %w", err)
+	}
+
+	// 失效订阅缓存
+	s.InvalidateSubCache(userID, groupID)
+	if s.billingCacheService != nil {
+		go func() {
+			cacheCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			_ = s.billingCacheService.InvalidateSubscription(cacheCtx, userID, groupID)
+		}()
+	}
+	return true, nil
+}
+
 // GetByID 根据ID获取订阅
 func (s *SubscriptionService) GetByID(ctx context.Context, id int64) (*UserSubscription, error) {
 	return s.userSubRepo.GetByID(ctx, id)
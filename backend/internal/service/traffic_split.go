@@ -0,0 +1,387 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"hash/fnv"
+	"math/rand/v2"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/model"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/ctxkey"
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// TrafficShadowLabelPrefix 影子请求在报表中的标签前缀（仅用于展示，是否影子以 UsageLog.Shadow 为准）
+	TrafficShadowLabelPrefix = "shadow:"
+
+	// trafficShadowMaxConcurrency 单实例同时执行的影子请求上限，超出时丢弃采样
+	trafficShadowMaxConcurrency = 16
+	// trafficShadowTimeout 单个影子请求的最长执行时间
+	trafficShadowTimeout = 10 * time.Minute
+)
+
+var (
+	// ErrInvalidTrafficSplitRules 分组流量拆分规则不合法
+	ErrInvalidTrafficSplitRules = infraerrors.BadRequest("INVALID_TRAFFIC_SPLIT_RULES", "invalid traffic split rules")
+	// ErrTrafficSplitUnsupportedPlatform 流量拆分仅在 Anthropic 分组的网关链路（Messages / Chat Completions / Responses）中生效
+	ErrTrafficSplitUnsupportedPlatform = infraerrors.BadRequest("TRAFFIC_SPLIT_UNSUPPORTED_PLATFORM", "traffic split rules are only supported on anthropic groups")
+)
+
+// ValidateTrafficSplitRules 校验分组流量拆分规则
+func ValidateTrafficSplitRules(platform string, rules []model.TrafficSplitRule) error {
+	if len(rules) > 0 && platform != PlatformAnthropic {
+		return ErrTrafficSplitUnsupportedPlatform
+	}
+	for i := range rules {
+		if err := rules[i].Validate(); err != nil {
+			return infraerrors.BadRequest(ErrInvalidTrafficSplitRules.Reason, "invalid traffic split rules: "+err.Error()).WithCause(err)
+		}
+	}
+	return nil
+}
+
+// TrafficSplitDecision 请求命中的流量拆分决策
+type TrafficSplitDecision struct {
+	GroupID        int64
+	RequestedModel string
+	// Model 实际转发的模型（与 RequestedModel 相同表示不改写）
+	Model string
+	// AccountIDs 目标限定的账号（为空表示沿用分组模型路由）
+	AccountIDs []int64
+	// Label 用量日志中的流量标签
+	Label string
+	// Shadow 本次请求被采样复制时的影子配置（未采样为 nil）
+	Shadow *model.TrafficShadowConfig
+}
+
+// ModelRewritten 是否需要改写请求模型
+func (d *TrafficSplitDecision) ModelRewritten() bool {
+	return d != nil && d.Model != "" && d.Model != d.RequestedModel
+}
+
+// LabelForGroup 返回决策在指定分组下的流量标签（请求切换到兜底分组后不再打标签）
+func (d *TrafficSplitDecision) LabelForGroup(groupID *int64) string {
+	if d == nil || groupID == nil || *groupID != d.GroupID {
+		return ""
+	}
+	return d.Label
+}
+
+// ResolveTrafficSplit 根据请求模型与会话哈希计算流量拆分决策，未命中规则时返回 nil。
+// 同一会话哈希总是落到同一目标，保证多轮对话使用一致的模型；无会话哈希时随机分配。
+func (g *Group) ResolveTrafficSplit(requestedModel, sessionHash string) *TrafficSplitDecision {
+	if g == nil || len(g.TrafficSplitRules) == 0 || requestedModel == "" {
+		return nil
+	}
+	rule := g.matchTrafficSplitRule(requestedModel)
+	if rule == nil {
+		return nil
+	}
+
+	decision := &TrafficSplitDecision{
+		GroupID:        g.ID,
+		RequestedModel: requestedModel,
+		Model:          requestedModel,
+	}
+	if len(rule.Targets) > 0 {
+		idx := pickTrafficSplitTarget(rule.Targets, trafficSplitBucket(rule.ModelPattern, sessionHash))
+		target := rule.Targets[idx]
+		if m := strings.TrimSpace(target.Model); m != "" {
+			decision.Model = m
+		}
+		decision.AccountIDs = target.AccountIDs
+		decision.Label = rule.TargetLabel(idx)
+	}
+	if rule.Shadow != nil && rand.IntN(100) < rule.Shadow.Percent {
+		decision.Shadow = rule.Shadow
+	}
+	if decision.Label == "" && decision.Shadow == nil {
+		return nil
+	}
+	return decision
+}
+
+// matchTrafficSplitRule 精确匹配优先，其次按配置顺序匹配通配符规则
+func (g *Group) matchTrafficSplitRule(requestedModel string) *model.TrafficSplitRule {
+	for i := range g.TrafficSplitRules {
+		if g.TrafficSplitRules[i].ModelPattern == requestedModel {
+			return &g.TrafficSplitRules[i]
+		}
+	}
+	for i := range g.TrafficSplitRules {
+		if matchModelPattern(g.TrafficSplitRules[i].ModelPattern, requestedModel) {
+			return &g.TrafficSplitRules[i]
+		}
+	}
+	return nil
+}
+
+// trafficSplitBucket 将会话哈希映射到 [0,100) 的分桶
+func trafficSplitBucket(pattern, sessionHash string) int {
+	if sessionHash == "" {
+		return rand.IntN(100)
+	}
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(pattern))
+	_, _ = h.Write([]byte{0})
+	_, _ = h.Write([]byte(sessionHash))
+	return int(h.Sum32() % 100)
+}
+
+func pickTrafficSplitTarget(targets []model.TrafficSplitTarget, bucket int) int {
+	acc := 0
+	for i, target := range targets {
+		acc += target.Percent
+		if bucket < acc {
+			return i
+		}
+	}
+	return len(targets) - 1
+}
+
+// WithTrafficSplit 将流量拆分决策写入 context
+func WithTrafficSplit(ctx context.Context, decision *TrafficSplitDecision) context.Context {
+	if decision == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, ctxkey.TrafficSplit, decision)
+}
+
+// TrafficSplitFromContext 读取当前请求的流量拆分决策
+func TrafficSplitFromContext(ctx context.Context) *TrafficSplitDecision {
+	if ctx == nil {
+		return nil
+	}
+	decision, _ := ctx.Value(ctxkey.TrafficSplit).(*TrafficSplitDecision)
+	return decision
+}
+
+// groupRoutingAccountIDs 返回分组的路由账号：流量拆分目标限定了账号时优先于模型路由配置，
+// 请求已进入模型降级链时按降级模型的路由配置选号。
+func groupRoutingAccountIDs(ctx context.Context, group *Group, requestedModel string) []int64 {
+	if group == nil {
+		return nil
+	}
+	if ModelFallbackFromContext(ctx) != "" {
+		return group.GetRoutingAccountIDs(requestedModel)
+	}
+	if decision := TrafficSplitFromContext(ctx); decision != nil && decision.GroupID == group.ID && len(decision.AccountIDs) > 0 {
+		return decision.AccountIDs
+	}
+	return group.GetRoutingAccountIDs(requestedModel)
+}
+
+// ApplyTrafficSplitModel 按流量拆分决策改写请求体中的模型并按入站协议重新解析。
+// protocol 与 ParseGatewayRequest 一致（Anthropic Messages / chat_completions / responses）。
+// 改写失败时返回原请求，保证主请求不受拆分配置影响。
+func (s *GatewayService) ApplyTrafficSplitModel(decision *TrafficSplitDecision, body []byte, parsed *ParsedRequest, protocol string) ([]byte, *ParsedRequest) {
+	if !decision.ModelRewritten() || parsed == nil {
+		return body, parsed
+	}
+	newBody := s.replaceModelInBody(body, decision.Model)
+	reparsed, err := ParseGatewayRequest(newBody, protocol)
+	if err != nil {
+		logger.LegacyPrintf("service.traffic_split", "rewrite model failed group=%d model=%s: %v", decision.GroupID, decision.Model, err)
+		return body, parsed
+	}
+	reparsed.GroupID = parsed.GroupID
+	reparsed.SessionContext = parsed.SessionContext
+	return newBody, reparsed
+}
+
+// TrafficShadowInput 影子请求所需的原始请求信息
+type TrafficShadowInput struct {
+	// Protocol 入站协议（与 ParseGatewayRequest 一致），为空表示 Anthropic Messages
+	Protocol       string
+	Decision       *TrafficSplitDecision
+	APIKey         *APIKey
+	Subscription   *UserSubscription
+	Body           []byte
+	Request        *http.Request
+	PrimaryAccount int64
+	UserAgent      string
+	IPAddress      string
+}
+
+// SubmitTrafficShadow 异步复制一次影子请求：响应被丢弃，仅记录用量（不计费）用于对比报表。
+// 影子请求使用独立账号槽位，无法立即获得槽位或并发已满时直接放弃本次采样。
+func (s *GatewayService) SubmitTrafficShadow(input *TrafficShadowInput) bool {
+	if input == nil || input.Decision == nil || input.Decision.Shadow == nil || input.APIKey == nil || input.Request == nil {
+		return false
+	}
+	// 请求切换到兜底分组后不再复制影子流量
+	if input.APIKey.GroupID == nil || *input.APIKey.GroupID != input.Decision.GroupID {
+		return false
+	}
+	select {
+	case s.trafficShadowSem <- struct{}{}:
+	default:
+		return false
+	}
+	// 请求结束后 gin 可能复用 http.Request 的部分字段，提前复制
+	req := input.Request.Clone(context.Background())
+	body := append([]byte(nil), input.Body...)
+
+	go func() {
+		defer func() {
+			<-s.trafficShadowSem
+			if recovered := recover(); recovered != nil {
+				logger.LegacyPrintf("service.traffic_shadow", "panic recovered: %v", recovered)
+			}
+		}()
+		ctx, cancel := context.WithTimeout(context.Background(), trafficShadowTimeout)
+		defer cancel()
+		if err := s.runTrafficShadow(ctx, input, req, body); err != nil {
+			logger.LegacyPrintf("service.traffic_shadow", "shadow request skipped group=%d model=%s: %v",
+				input.Decision.GroupID, input.Decision.Shadow.Model, err)
+		}
+	}()
+	return true
+}
+
+func (s *GatewayService) runTrafficShadow(ctx context.Context, input *TrafficShadowInput, req *http.Request, body []byte) error {
+	shadow := input.Decision.Shadow
+	groupID := input.Decision.GroupID
+	decision := &TrafficSplitDecision{
+		GroupID:        groupID,
+		RequestedModel: input.Decision.RequestedModel,
+		Model:          shadow.Model,
+		AccountIDs:     shadow.AccountIDs,
+		Label:          TrafficShadowLabelPrefix + shadow.Model,
+	}
+	ctx = WithTrafficSplit(ctx, decision)
+	if input.APIKey.Group != nil {
+		ctx = context.WithValue(ctx, ctxkey.Group, input.APIKey.Group)
+	}
+
+	protocol := input.Protocol
+	if protocol == "" {
+		protocol = PlatformAnthropic
+	}
+	body = s.replaceModelInBody(body, shadow.Model)
+	parsed, err := ParseGatewayRequest(body, protocol)
+	if err != nil {
+		return err
+	}
+	parsed.GroupID = &groupID
+
+	excluded := map[int64]struct{}{input.PrimaryAccount: {}}
+	selection, err := s.SelectAccountWithLoadAwareness(ctx, &groupID, "", shadow.Model, excluded, "")
+	if err != nil {
+		return err
+	}
+	if selection == nil || selection.Account == nil {
+		return ErrNoAvailableAccounts
+	}
+	if !selection.Acquired {
+		return errors.New("no free account slot")
+	}
+	if selection.ReleaseFunc != nil {
+		defer selection.ReleaseFunc()
+	}
+	account := selection.Account
+	if account.Platform == PlatformAntigravity {
+		return errors.New("shadow traffic is not supported on antigravity accounts")
+	}
+
+	var result *ForwardResult
+	serveTrafficShadow(req.WithContext(ctx), func(c *gin.Context) {
+		switch protocol {
+		case "chat_completions":
+			result, err = s.ForwardAsChatCompletions(ctx, c, account, body, parsed)
+		case "responses":
+			result, err = s.ForwardAsResponses(ctx, c, account, body, parsed)
+		default:
+			result, err = s.Forward(ctx, c, account, parsed)
+		}
+	})
+	if err != nil {
+		return err
+	}
+	if result == nil {
+		return nil
+	}
+	// 影子请求的用量日志使用独立请求 ID，避免与主请求去重冲突
+	result.RequestID = TrafficShadowLabelPrefix + generateRequestID()
+	return s.RecordUsage(ctx, &RecordUsageInput{
+		Result:       result,
+		APIKey:       input.APIKey,
+		User:         input.APIKey.User,
+		Account:      account,
+		Subscription: input.Subscription,
+		UserAgent:    input.UserAgent,
+		IPAddress:    input.IPAddress,
+		TrafficLabel: decision.Label,
+		Shadow:       true,
+	})
+}
+
+var (
+	trafficShadowEngineOnce sync.Once
+	trafficShadowEngine     *gin.Engine
+)
+
+type trafficShadowHandlerKey struct{}
+
+// serveTrafficShadow 在独立的 gin 引擎上执行影子转发：gin.Context 与客户端连接完全分离，响应写入丢弃型 writer
+func serveTrafficShadow(req *http.Request, handle func(c *gin.Context)) {
+	trafficShadowEngineOnce.Do(func() {
+		trafficShadowEngine = gin.New()
+		trafficShadowEngine.Any("/*path", func(c *gin.Context) {
+			if fn, ok := c.Request.Context().Value(trafficShadowHandlerKey{}).(func(*gin.Context)); ok {
+				fn(c)
+			}
+		})
+	})
+	req = req.WithContext(context.WithValue(req.Context(), trafficShadowHandlerKey{}, handle))
+	trafficShadowEngine.ServeHTTP(newTrafficShadowResponseWriter(), req)
+}
+
+// trafficShadowResponseWriter 丢弃影子请求的响应
+type trafficShadowResponseWriter struct {
+	header http.Header
+}
+
+func newTrafficShadowResponseWriter() *trafficShadowResponseWriter {
+	return &trafficShadowResponseWriter{header: make(http.Header)}
+}
+
+func (w *trafficShadowResponseWriter) Header() http.Header         { return w.header }
+func (w *trafficShadowResponseWriter) Write(p []byte) (int, error) { return len(p), nil }
+func (w *trafficShadowResponseWriter) WriteHeader(int)             {}
+func (w *trafficShadowResponseWriter) Flush()                      {}
+
+// TrafficSplitReportItem 流量拆分对比报表（按流量标签与模型聚合）
+type TrafficSplitReportItem struct {
+	Label           string  `json:"label"`
+	Shadow          bool    `json:"shadow"`
+	Model           string  `json:"model"`
+	Requests        int64   `json:"requests"`
+	InputTokens     int64   `json:"input_tokens"`
+	OutputTokens    int64   `json:"output_tokens"`
+	TotalCost       float64 `json:"total_cost"`
+	ActualCost      float64 `json:"actual_cost"`
+	AvgDurationMs   float64 `json:"avg_duration_ms"`
+	AvgFirstTokenMs float64 `json:"avg_first_token_ms"`
+}
+
+// trafficSplitReportProvider 用量日志仓储可选实现的流量拆分报表查询
+type trafficSplitReportProvider interface {
+	GetTrafficSplitReport(ctx context.Context, groupID int64, startTime, endTime time.Time) ([]TrafficSplitReportItem, error)
+}
+
+// GetTrafficSplitReport 返回分组在时间范围内各流量标签的用量对比
+func (s *DashboardService) GetTrafficSplitReport(ctx context.Context, groupID int64, startTime, endTime time.Time) ([]TrafficSplitReportItem, error) {
+	provider, ok := s.usageRepo.(trafficSplitReportProvider)
+	if !ok {
+		return []TrafficSplitReportItem{}, nil
+	}
+	return provider.GetTrafficSplitReport(ctx, groupID, startTime, endTime)
+}
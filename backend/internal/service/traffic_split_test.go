@@ -0,0 +1,203 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/model"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/ctxkey"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+func newTrafficSplitTestGroup() *Group {
+	return &Group{
+		ID: 7,
+		TrafficSplitRules: []model.TrafficSplitRule{
+			{
+				ModelPattern: "claude-sonnet-*",
+				Targets: []model.TrafficSplitTarget{
+					{Label: "control", Percent: 90},
+					{Model: "claude-sonnet-4-5", AccountIDs: []int64{11, 12}, Percent: 10},
+				},
+			},
+			{
+				ModelPattern: "claude-opus-4",
+				Shadow:       &model.TrafficShadowConfig{Model: "claude-opus-4-1", Percent: 100},
+			},
+		},
+	}
+}
+
+func TestResolveTrafficSplit_StickyPerSession(t *testing.T) {
+	group := newTrafficSplitTestGroup()
+
+	first := group.ResolveTrafficSplit("claude-sonnet-4", "session-a")
+	require.NotNil(t, first)
+	for i := 0; i < 20; i++ {
+		again := group.ResolveTrafficSplit("claude-sonnet-4", "session-a")
+		require.Equal(t, first.Model, again.Model, "同一会话始终落到同一目标")
+		require.Equal(t, first.Label, again.Label)
+	}
+}
+
+func TestResolveTrafficSplit_DistributionFollowsPercent(t *testing.T) {
+	group := newTrafficSplitTestGroup()
+
+	rewritten := 0
+	for i := 0; i < 2000; i++ {
+		decision := group.ResolveTrafficSplit("claude-sonnet-4", fmt.Sprintf("session-%d", i))
+		require.NotNil(t, decision)
+		if decision.ModelRewritten() {
+			rewritten++
+			require.Equal(t, "claude-sonnet-4-5", decision.Model)
+			require.Equal(t, "claude-sonnet-4-5", decision.Label, "无 label 时使用目标模型")
+			require.Equal(t, []int64{11, 12}, decision.AccountIDs)
+		} else {
+			require.Equal(t, "control", decision.Label)
+			require.Empty(t, decision.AccountIDs)
+		}
+	}
+	require.InDelta(t, 200, rewritten, 80)
+}
+
+func TestResolveTrafficSplit_ShadowOnlyAndMiss(t *testing.T) {
+	group := newTrafficSplitTestGroup()
+
+	decision := group.ResolveTrafficSplit("claude-opus-4", "")
+	require.NotNil(t, decision)
+	require.False(t, decision.ModelRewritten(), "仅影子规则不改写主请求")
+	require.Empty(t, decision.Label)
+	require.NotNil(t, decision.Shadow)
+	require.Equal(t, "claude-opus-4-1", decision.Shadow.Model)
+
+	require.Nil(t, group.ResolveTrafficSplit("gpt-5", "session-a"))
+	require.Nil(t, (*Group)(nil).ResolveTrafficSplit("claude-sonnet-4", "session-a"))
+}
+
+func TestTrafficSplitDecision_LabelForGroup(t *testing.T) {
+	decision := &TrafficSplitDecision{GroupID: 7, Label: "control"}
+	groupID := int64(7)
+	otherID := int64(8)
+
+	require.Equal(t, "control", decision.LabelForGroup(&groupID))
+	require.Empty(t, decision.LabelForGroup(&otherID), "兜底分组不打标签")
+	require.Empty(t, decision.LabelForGroup(nil))
+	require.Empty(t, (*TrafficSplitDecision)(nil).LabelForGroup(&groupID))
+}
+
+func TestGroupRoutingAccountIDs_TrafficSplitOverridesModelRouting(t *testing.T) {
+	group := &Group{
+		ID:                  7,
+		ModelRoutingEnabled: true,
+		ModelRouting:        map[string][]int64{"claude-sonnet-4-5": {1, 2}},
+	}
+
+	require.Equal(t, []int64{1, 2}, groupRoutingAccountIDs(context.Background(), group, "claude-sonnet-4-5"))
+
+	ctx := WithTrafficSplit(context.Background(), &TrafficSplitDecision{GroupID: 7, AccountIDs: []int64{11}})
+	require.Equal(t, []int64{11}, groupRoutingAccountIDs(ctx, group, "claude-sonnet-4-5"))
+
+	other := &Group{ID: 8, ModelRoutingEnabled: true, ModelRouting: map[string][]int64{"claude-sonnet-4-5": {3}}}
+	require.Equal(t, []int64{3}, groupRoutingAccountIDs(ctx, other, "claude-sonnet-4-5"), "决策仅作用于所属分组")
+}
+
+func TestValidateTrafficSplitRules(t *testing.T) {
+	require.NoError(t, ValidateTrafficSplitRules(PlatformAnthropic, newTrafficSplitTestGroup().TrafficSplitRules))
+	// 流量拆分仅支持 Anthropic 分组；其他平台只允许空规则
+	require.NoError(t, ValidateTrafficSplitRules(PlatformOpenAI, nil))
+	for _, platform := range []string{PlatformOpenAI, PlatformGemini, PlatformAntigravity} {
+		require.ErrorIs(t, ValidateTrafficSplitRules(platform, newTrafficSplitTestGroup().TrafficSplitRules), ErrTrafficSplitUnsupportedPlatform)
+	}
+
+	cases := []model.TrafficSplitRule{
+		{Targets: []model.TrafficSplitTarget{{Percent: 100}}},
+		{ModelPattern: "claude-*"},
+		{ModelPattern: "claude-*", Targets: []model.TrafficSplitTarget{{Percent: 60}, {Percent: 30}}},
+		{ModelPattern: "claude-*", Shadow: &model.TrafficShadowConfig{Model: "claude-opus-4", Percent: 0}},
+		{ModelPattern: "claude-*", Shadow: &model.TrafficShadowConfig{Percent: 10}},
+	}
+	for i, rule := range cases {
+		err := ValidateTrafficSplitRules(PlatformAnthropic, []model.TrafficSplitRule{rule})
+		require.Error(t, err, "case %d", i)
+		require.ErrorIs(t, err, ErrInvalidTrafficSplitRules, "case %d", i)
+	}
+}
+
+func TestApplyTrafficSplitModel_RewritesBody(t *testing.T) {
+	svc := &GatewayService{}
+	body := []byte(`{"model":"claude-sonnet-4","max_tokens":16,"messages":[{"role":"user","content":"hi"}]}`)
+	parsed, err := ParseGatewayRequest(body, PlatformAnthropic)
+	require.NoError(t, err)
+	groupID := int64(7)
+	parsed.GroupID = &groupID
+
+	newBody, reparsed := svc.ApplyTrafficSplitModel(&TrafficSplitDecision{RequestedModel: "claude-sonnet-4", Model: "claude-sonnet-4-5"}, body, parsed, PlatformAnthropic)
+	require.Equal(t, "claude-sonnet-4-5", reparsed.Model)
+	require.Same(t, parsed.GroupID, reparsed.GroupID)
+	require.Contains(t, string(newBody), `"claude-sonnet-4-5"`)
+
+	sameBody, same := svc.ApplyTrafficSplitModel(&TrafficSplitDecision{RequestedModel: "claude-sonnet-4", Model: "claude-sonnet-4"}, body, parsed, PlatformAnthropic)
+	require.Same(t, parsed, same)
+	require.Equal(t, body, sameBody)
+}
+
+func TestApplyTrafficSplitModel_ChatCompletionsProtocol(t *testing.T) {
+	svc := &GatewayService{}
+	body := []byte(`{"model":"claude-sonnet-4","messages":[{"role":"user","content":"hi"}]}`)
+	parsed, err := ParseGatewayRequest(body, "chat_completions")
+	require.NoError(t, err)
+
+	newBody, reparsed := svc.ApplyTrafficSplitModel(&TrafficSplitDecision{RequestedModel: "claude-sonnet-4", Model: "claude-sonnet-4-5"}, body, parsed, "chat_completions")
+	require.Equal(t, "claude-sonnet-4-5", reparsed.Model)
+	require.Contains(t, string(newBody), `"claude-sonnet-4-5"`)
+}
+
+func TestServeTrafficShadow_DetachedContext(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPost, "/v1/messages", nil)
+	req.Header.Set("X-Real-IP", "203.0.113.9")
+	ctx := context.WithValue(context.Background(), ctxkey.Group, &Group{ID: 7})
+
+	called := false
+	serveTrafficShadow(req.WithContext(ctx), func(c *gin.Context) {
+		called = true
+		// 独立引擎构造的 gin.Context 可正常使用请求信息，响应被丢弃
+		require.Equal(t, "203.0.113.9", c.GetHeader("X-Real-IP"))
+		require.NotEmpty(t, c.ClientIP())
+		group, _ := c.Request.Context().Value(ctxkey.Group).(*Group)
+		require.NotNil(t, group)
+		c.JSON(http.StatusOK, gin.H{"ok": true})
+		require.Equal(t, http.StatusOK, c.Writer.Status())
+	})
+	require.True(t, called)
+}
+
+func TestGatewayServiceRecordUsage_ShadowMarksUsageLog(t *testing.T) {
+	usageRepo := &openAIRecordUsageLogRepoStub{inserted: true}
+	svc := newGatewayRecordUsageServiceForTest(usageRepo, &openAIRecordUsageUserRepoStub{}, &openAIRecordUsageSubRepoStub{})
+
+	err := svc.RecordUsage(context.Background(), &RecordUsageInput{
+		Result: &ForwardResult{
+			RequestID: "shadow:req-1",
+			Usage:     ClaudeUsage{InputTokens: 10, OutputTokens: 6},
+			Model:     "claude-opus-4-1",
+			Duration:  time.Second,
+		},
+		APIKey:       &APIKey{ID: 501, Quota: 100},
+		User:         &User{ID: 601},
+		Account:      &Account{ID: 701},
+		TrafficLabel: TrafficShadowLabelPrefix + "claude-opus-4-1",
+		Shadow:       true,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, usageRepo.lastLog)
+	require.True(t, usageRepo.lastLog.Shadow)
+	require.Zero(t, usageRepo.lastLog.ActualCost)
+	require.Positive(t, usageRepo.lastLog.TotalCost)
+}
@@ -152,6 +152,25 @@ type UsageLog struct {
 	ImageCount int
 	ImageSize  *string
 
+	// 临时子密钥字段（通过 sk-eph- 临时密钥发起的请求）
+	SubKeyID  *string
+	EndUserID *string
+
+	// 分组流量拆分标签
+	TrafficLabel *string
+
+	// 影子请求：响应未返回给用户，不计费，也不计入用量统计
+	Shadow bool
+
+	// 未实际扣费（simple 模式仅记录用量）
+	Unbilled bool
+
+	// 按 Gemini 长上下文规则计费（超出阈值的输入按 GeminiLongContextMultiplier 倍计费）
+	LongContext bool
+
+	// 模型降级链实际使用的模型（未降级为空）
+	FallbackModel *string
+
 	CreatedAt time.Time
 
 	User         *User
@@ -70,14 +70,14 @@ func TestUserGroupRateResolverResolve_InvalidCacheEntryLoadsRepoAndCaches(t *tes
 
 func TestGatewayServiceGetUserGroupRateMultiplier_FallbacksAndUsesExistingResolver(t *testing.T) {
 	var nilSvc *GatewayService
-	require.Equal(t, 1.3, nilSvc.getUserGroupRateMultiplier(context.Background(), 101, 202, 1.3))
+	require.Equal(t, 1.3, nilSvc.getUserGroupRateMultiplier(context.Background(), 101, 202, 1.3, nil))
 
 	rate := 1.9
 	repo := &userGroupRateResolverRepoStub{rate: &rate}
 	resolver := newUserGroupRateResolver(repo, nil, time.Minute, nil, "service.gateway")
 	svc := &GatewayService{userGroupRateResolver: resolver}
 
-	got := svc.getUserGroupRateMultiplier(context.Background(), 101, 202, 1.2)
+	got := svc.getUserGroupRateMultiplier(context.Background(), 101, 202, 1.2, nil)
 	require.Equal(t, rate, got)
 	require.Equal(t, 1, repo.calls)
 }
@@ -0,0 +1,175 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/model"
+	infraerrors "github.com/Wei-Shaw/sub2api/internal/pkg/errors"
+	"github.com/Wei-Shaw/sub2api/internal/pkg/timezone"
+	"golang.org/x/sync/singleflight"
+)
+
+// volumeTierStateTTL 当月消费缓存有效期：消费变化最多延迟该时长反映到阶梯
+const volumeTierStateTTL = 5 * time.Minute
+
+// ErrInvalidVolumeTiers 分组用量阶梯配置不合法
+var ErrInvalidVolumeTiers = infraerrors.BadRequest("INVALID_VOLUME_TIERS", "invalid volume tiers")
+
+// validateVolumeTiers 校验分组用量阶梯配置
+func validateVolumeTiers(tiers []model.VolumeTier) error {
+	if err := model.ValidateVolumeTiers(tiers); err != nil {
+		return infraerrors.BadRequest(ErrInvalidVolumeTiers.Reason, "invalid volume tiers: "+err.Error()).WithCause(err)
+	}
+	return nil
+}
+
+// VolumeTierState 用户在某分组当月的累计消费与所在阶梯（Redis 缓存）
+type VolumeTierState struct {
+	Month     string  `json:"month"` // 统计月份 YYYY-MM（系统时区）
+	Spend     float64 `json:"spend"`
+	TierIndex int     `json:"tier_index"`
+}
+
+// VolumeTierRepository 统计用户在分组内的消费
+type VolumeTierRepository interface {
+	// GetUserGroupSpend 返回 [start, end) 内用户在分组的实际扣费合计
+	GetUserGroupSpend(ctx context.Context, userID, groupID int64, start, end time.Time) (float64, error)
+}
+
+// VolumeTierCache 缓存用户当月所在阶梯
+type VolumeTierCache interface {
+	// GetVolumeTierState 未命中时返回 (nil, nil)
+	GetVolumeTierState(ctx context.Context, userID, groupID int64, month string) (*VolumeTierState, error)
+	SetVolumeTierState(ctx context.Context, userID, groupID int64, state *VolumeTierState, ttl time.Duration) error
+}
+
+// VolumeTierProgress 用户在某分组的阶梯进度（/groups/rates 展示）
+type VolumeTierProgress struct {
+	GroupID        int64
+	GroupName      string
+	Month          string
+	Spend          float64
+	Tiers          []model.VolumeTier
+	CurrentTier    *model.VolumeTier
+	NextTier       *model.VolumeTier
+	RemainingSpend float64 // 距下一阶梯还需消费的金额，已在最高阶梯时为 0
+	RateMultiplier float64 // 实际计费倍率：用户专属倍率（或分组默认）叠加当前阶梯折扣
+}
+
+// VolumeTierService 按用户当月在分组的累计消费计算阶梯折扣
+type VolumeTierService struct {
+	repo  VolumeTierRepository
+	cache VolumeTierCache
+	sf    singleflight.Group
+}
+
+// NewVolumeTierService creates a new VolumeTierService
+func NewVolumeTierService(repo VolumeTierRepository, cache VolumeTierCache) *VolumeTierService {
+	return &VolumeTierService{repo: repo, cache: cache}
+}
+
+// Multiplier 返回用户在分组当前阶梯的折扣倍数；未配置阶梯或统计失败时返回 1（不打折）
+func (s *VolumeTierService) Multiplier(ctx context.Context, userID, groupID int64, tiers []model.VolumeTier) float64 {
+	if s == nil || len(tiers) == 0 || userID <= 0 || groupID <= 0 {
+		return 1
+	}
+	state, err := s.currentState(ctx, userID, groupID, tiers)
+	if err != nil {
+		slog.Warn("[VolumeTier] resolve tier failed, fallback to base rate", "userID", userID, "groupID", groupID, "error", err)
+		return 1
+	}
+	// 阶梯按缓存中的消费重新定位，管理员修改阶梯后立即生效
+	idx := model.VolumeTierIndex(tiers, state.Spend)
+	if idx < 0 {
+		return 1
+	}
+	return tiers[idx].Multiplier
+}
+
+// Progress 返回用户在配置了阶梯的分组中的当月进度，userRates 为用户专属倍率（分组 ID → 倍率）
+func (s *VolumeTierService) Progress(ctx context.Context, userID int64, groups []Group, userRates map[int64]float64) ([]VolumeTierProgress, error) {
+	out := make([]VolumeTierProgress, 0)
+	if s == nil {
+		return out, nil
+	}
+	for i := range groups {
+		g := &groups[i]
+		if len(g.VolumeTiers) == 0 {
+			continue
+		}
+		state, err := s.currentState(ctx, userID, g.ID, g.VolumeTiers)
+		if err != nil {
+			return nil, err
+		}
+		base := g.RateMultiplier
+		if rate, ok := userRates[g.ID]; ok {
+			base = rate
+		}
+		out = append(out, buildVolumeTierProgress(g, state, base))
+	}
+	return out, nil
+}
+
+// buildVolumeTierProgress 与 Multiplier 一致：按当月消费定位阶梯，阶梯折扣叠加在 baseRate 上
+func buildVolumeTierProgress(g *Group, state *VolumeTierState, baseRate float64) VolumeTierProgress {
+	p := VolumeTierProgress{
+		GroupID:        g.ID,
+		GroupName:      g.Name,
+		Month:          state.Month,
+		Spend:          state.Spend,
+		Tiers:          g.VolumeTiers,
+		RateMultiplier: baseRate,
+	}
+	idx := model.VolumeTierIndex(g.VolumeTiers, state.Spend)
+	if idx >= 0 {
+		current := g.VolumeTiers[idx]
+		p.CurrentTier = &current
+		p.RateMultiplier = baseRate * current.Multiplier
+	}
+	if idx+1 < len(g.VolumeTiers) {
+		next := g.VolumeTiers[idx+1]
+		p.NextTier = &next
+		p.RemainingSpend = next.MinSpend - state.Spend
+	}
+	return p
+}
+
+// currentState 读取缓存的当月状态，未命中时从用量日志统计并回写缓存
+func (s *VolumeTierService) currentState(ctx context.Context, userID, groupID int64, tiers []model.VolumeTier) (*VolumeTierState, error) {
+	now := timezone.Now()
+	month := now.Format("2006-01")
+	if s.cache != nil {
+		state, err := s.cache.GetVolumeTierState(ctx, userID, groupID, month)
+		if err != nil {
+			slog.Warn("[VolumeTier] read tier cache failed", "userID", userID, "groupID", groupID, "error", err)
+		} else if state != nil {
+			return state, nil
+		}
+	}
+	if s.repo == nil {
+		return &VolumeTierState{Month: month, TierIndex: model.VolumeTierIndex(tiers, 0)}, nil
+	}
+
+	key := fmt.Sprintf("%d:%d:%s", userID, groupID, month)
+	value, err, _ := s.sf.Do(key, func() (any, error) {
+		start := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+		spend, err := s.repo.GetUserGroupSpend(ctx, userID, groupID, start, start.AddDate(0, 1, 0))
+		if err != nil {
+			return nil, fmt.Errorf("get user group spend: %w", err)
+		}
+		state := &VolumeTierState{Month: month, Spend: spend, TierIndex: model.VolumeTierIndex(tiers, spend)}
+		if s.cache != nil {
+			if err := s.cache.SetVolumeTierState(ctx, userID, groupID, state, volumeTierStateTTL); err != nil {
+				slog.Warn("[VolumeTier] write tier cache failed", "userID", userID, "groupID", groupID, "error", err)
+			}
+		}
+		return state, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return value.(*VolumeTierState), nil
+}
@@ -0,0 +1,172 @@
+//go:build unit
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Wei-Shaw/sub2api/internal/model"
+	"github.com/stretchr/testify/require"
+)
+
+type volumeTierRepoStub struct {
+	spend float64
+	err   error
+	calls int
+	start time.Time
+	end   time.Time
+}
+
+func (s *volumeTierRepoStub) GetUserGroupSpend(ctx context.Context, userID, groupID int64, start, end time.Time) (float64, error) {
+	s.calls++
+	s.start, s.end = start, end
+	return s.spend, s.err
+}
+
+type volumeTierCacheStub struct {
+	states map[string]*VolumeTierState
+	getErr error
+}
+
+func (s *volumeTierCacheStub) key(userID, groupID int64, month string) string {
+	return fmt.Sprintf("%d:%d:%s", userID, groupID, month)
+}
+
+func (s *volumeTierCacheStub) GetVolumeTierState(ctx context.Context, userID, groupID int64, month string) (*VolumeTierState, error) {
+	if s.getErr != nil {
+		return nil, s.getErr
+	}
+	return s.states[s.key(userID, groupID, month)], nil
+}
+
+func (s *volumeTierCacheStub) SetVolumeTierState(ctx context.Context, userID, groupID int64, state *VolumeTierState, ttl time.Duration) error {
+	if s.states == nil {
+		s.states = map[string]*VolumeTierState{}
+	}
+	s.states[s.key(userID, groupID, state.Month)] = state
+	return nil
+}
+
+var testVolumeTiers = []model.VolumeTier{
+	{MinSpend: 0, Multiplier: 1.0},
+	{MinSpend: 100, Multiplier: 0.9},
+	{MinSpend: 1000, Multiplier: 0.8},
+}
+
+func TestValidateVolumeTiers(t *testing.T) {
+	require.NoError(t, validateVolumeTiers(nil))
+	require.NoError(t, validateVolumeTiers(testVolumeTiers))
+
+	cases := [][]model.VolumeTier{
+		{{MinSpend: 10, Multiplier: 1}},
+		{{MinSpend: 0, Multiplier: 0}},
+		{{MinSpend: 0, Multiplier: 1}, {MinSpend: 0, Multiplier: 0.9}},
+		{{MinSpend: 0, Multiplier: 1}, {MinSpend: 200, Multiplier: 0.9}, {MinSpend: 100, Multiplier: 0.8}},
+	}
+	for i, tiers := range cases {
+		require.ErrorIs(t, validateVolumeTiers(tiers), ErrInvalidVolumeTiers, "case %d", i)
+	}
+}
+
+func TestVolumeTierService_MultiplierByMonthlySpend(t *testing.T) {
+	for _, tc := range []struct {
+		spend float64
+		want  float64
+	}{
+		{0, 1.0},
+		{99.99, 1.0},
+		{100, 0.9},
+		{999, 0.9},
+		{5000, 0.8},
+	} {
+		repo := &volumeTierRepoStub{spend: tc.spend}
+		svc := NewVolumeTierService(repo, &volumeTierCacheStub{})
+		require.Equal(t, tc.want, svc.Multiplier(context.Background(), 1, 2, testVolumeTiers), "spend %v", tc.spend)
+	}
+}
+
+func TestVolumeTierService_CachesMonthlyState(t *testing.T) {
+	repo := &volumeTierRepoStub{spend: 150}
+	cache := &volumeTierCacheStub{}
+	svc := NewVolumeTierService(repo, cache)
+
+	require.Equal(t, 0.9, svc.Multiplier(context.Background(), 1, 2, testVolumeTiers))
+	require.Equal(t, 0.9, svc.Multiplier(context.Background(), 1, 2, testVolumeTiers))
+	require.Equal(t, 1, repo.calls)
+	// 统计区间为系统时区的自然月
+	require.Equal(t, 1, repo.start.Day())
+	require.Equal(t, repo.start.AddDate(0, 1, 0), repo.end)
+
+	require.Len(t, cache.states, 1)
+	for _, state := range cache.states {
+		require.Equal(t, 150.0, state.Spend)
+		require.Equal(t, 1, state.TierIndex)
+	}
+
+	// 管理员调整阶梯后按缓存的消费重新定位，无需等待缓存过期
+	changed := []model.VolumeTier{{MinSpend: 0, Multiplier: 1}, {MinSpend: 120, Multiplier: 0.7}}
+	require.Equal(t, 0.7, svc.Multiplier(context.Background(), 1, 2, changed))
+	require.Equal(t, 1, repo.calls)
+}
+
+func TestVolumeTierService_FallbacksToBaseRate(t *testing.T) {
+	var nilSvc *VolumeTierService
+	require.Equal(t, 1.0, nilSvc.Multiplier(context.Background(), 1, 2, testVolumeTiers))
+
+	repo := &volumeTierRepoStub{spend: 5000}
+	svc := NewVolumeTierService(repo, nil)
+	require.Equal(t, 1.0, svc.Multiplier(context.Background(), 1, 2, nil))
+	require.Zero(t, repo.calls)
+
+	// 缓存故障时直接统计
+	svc = NewVolumeTierService(repo, &volumeTierCacheStub{getErr: errors.New("redis down")})
+	require.Equal(t, 0.8, svc.Multiplier(context.Background(), 1, 2, testVolumeTiers))
+
+	// 统计失败时不打折
+	svc = NewVolumeTierService(&volumeTierRepoStub{err: errors.New("db down")}, nil)
+	require.Equal(t, 1.0, svc.Multiplier(context.Background(), 1, 2, testVolumeTiers))
+}
+
+func TestVolumeTierService_Progress(t *testing.T) {
+	repo := &volumeTierRepoStub{spend: 250}
+	svc := NewVolumeTierService(repo, nil)
+
+	groups := []Group{
+		{ID: 1, Name: "plain"},
+		{ID: 2, Name: "tiered", RateMultiplier: 1.5, VolumeTiers: testVolumeTiers},
+	}
+	progress, err := svc.Progress(context.Background(), 1, groups, nil)
+	require.NoError(t, err)
+	require.Len(t, progress, 1)
+	require.InDelta(t, 1.5*0.9, progress[0].RateMultiplier, 1e-9)
+
+	// 专属倍率优先于分组默认倍率，与网关计费一致
+	progress, err = svc.Progress(context.Background(), 1, groups, map[int64]float64{2: 1.2})
+	require.NoError(t, err)
+	require.InDelta(t, svc.Multiplier(context.Background(), 1, 2, testVolumeTiers)*1.2, progress[0].RateMultiplier, 1e-9)
+
+	p := progress[0]
+	require.Equal(t, int64(2), p.GroupID)
+	require.Equal(t, 250.0, p.Spend)
+	require.Equal(t, 0.9, p.CurrentTier.Multiplier)
+	require.Equal(t, 1000.0, p.NextTier.MinSpend)
+	require.Equal(t, 750.0, p.RemainingSpend)
+
+	repo.spend = 2000
+	progress, err = NewVolumeTierService(repo, nil).Progress(context.Background(), 1, []Group{{ID: 2, VolumeTiers: testVolumeTiers}}, nil)
+	require.NoError(t, err)
+	require.Nil(t, progress[0].NextTier)
+	require.Zero(t, progress[0].RemainingSpend)
+}
+
+func TestGatewayService_GetUserGroupRateMultiplier_AppliesVolumeTier(t *testing.T) {
+	svc := &GatewayService{}
+	svc.SetVolumeTierService(NewVolumeTierService(&volumeTierRepoStub{spend: 300}, nil))
+
+	require.InDelta(t, 1.2*0.9, svc.getUserGroupRateMultiplier(context.Background(), 101, 202, 1.2, testVolumeTiers), 1e-9)
+	require.Equal(t, 1.2, svc.getUserGroupRateMultiplier(context.Background(), 101, 202, 1.2, nil))
+}
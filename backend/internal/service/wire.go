@@ -45,6 +45,99 @@ func ProvidePaymentConfigService(entClient *dbent.Client, settingRepo SettingRep
 	return NewPaymentConfigService(entClient, settingRepo, []byte(key))
 }
 
+// ProvideInvoiceService creates InvoiceService and hooks it into payment and PAYG order completion.
+func ProvideInvoiceService(
+	entClient *dbent.Client,
+	userRepo UserRepository,
+	emailService *EmailService,
+	userAttributeService *UserAttributeService,
+	configService *PaymentConfigService,
+	settingService *SettingService,
+	paymentService *PaymentService,
+	paygService *PaygService,
+) *InvoiceService {
+	svc := NewInvoiceService(entClient, userRepo, emailService, userAttributeService, configService, settingService)
+	paymentService.SetInvoiceService(svc)
+	paygService.SetInvoiceService(svc)
+	return svc
+}
+
+// ProvideReferralCommissionService creates ReferralCommissionService and hooks it into referral
+// commission crediting and payment refunds.
+func ProvideReferralCommissionService(
+	entClient *dbent.Client,
+	settingService *SettingService,
+	referralService *ReferralService,
+	paymentService *PaymentService,
+) *ReferralCommissionService {
+	svc := NewReferralCommissionService(entClient, settingService)
+	referralService.SetCommissionService(svc)
+	paymentService.SetCommissionService(svc)
+	return svc
+}
+
+// ProvidePostpaidService creates PostpaidService and hooks its credit limits into balance checks.
+func ProvidePostpaidService(
+	entClient *dbent.Client,
+	userRepo UserRepository,
+	emailService *EmailService,
+	settingService *SettingService,
+	billingCacheService *BillingCacheService,
+	apiKeyService *APIKeyService,
+	authCacheInvalidator APIKeyAuthCacheInvalidator,
+) *PostpaidService {
+	svc := NewPostpaidService(entClient, userRepo, emailService, settingService, billingCacheService, authCacheInvalidator)
+	billingCacheService.SetCreditLimitProvider(svc)
+	apiKeyService.SetCreditLimitProvider(svc)
+	return svc
+}
+
+// ProvidePostpaidBillingService creates and starts PostpaidBillingService.
+func ProvidePostpaidBillingService(postpaidSvc *PostpaidService) *PostpaidBillingService {
+	svc := NewPostpaidBillingService(postpaidSvc, 5*time.Minute)
+	svc.Start()
+	return svc
+}
+
+// ProvideBillingRecomputeService creates BillingRecomputeService and recovers interrupted jobs.
+func ProvideBillingRecomputeService(
+	entClient *dbent.Client,
+	repo BillingRecomputeRepository,
+	billingService *BillingService,
+	balanceBuckets *BalanceBucketService,
+	billingCacheService *BillingCacheService,
+	authCacheInvalidator APIKeyAuthCacheInvalidator,
+	redeemCodeRepo RedeemCodeRepository,
+) *BillingRecomputeService {
+	svc := NewBillingRecomputeService(entClient, repo, billingService, balanceBuckets, billingCacheService, authCacheInvalidator, redeemCodeRepo)
+	svc.Start()
+	return svc
+}
+
+// ProvidePaymentService creates PaymentService and hooks balance buckets into refunds.
+func ProvidePaymentService(
+	entClient *dbent.Client,
+	registry *payment.Registry,
+	loadBalancer payment.LoadBalancer,
+	redeemService *RedeemService,
+	subscriptionSvc *SubscriptionService,
+	configService *PaymentConfigService,
+	userRepo UserRepository,
+	groupRepo GroupRepository,
+	balanceBuckets *BalanceBucketService,
+) *PaymentService {
+	svc := NewPaymentService(entClient, registry, loadBalancer, redeemService, subscriptionSvc, configService, userRepo, groupRepo)
+	svc.SetBalanceBuckets(balanceBuckets)
+	return svc
+}
+
+// ProvideBalanceBucketExpiryService creates and starts the nightly balance bucket expiry job.
+func ProvideBalanceBucketExpiryService(bucketSvc *BalanceBucketService) *BalanceBucketExpiryService {
+	svc := NewBalanceBucketExpiryService(bucketSvc)
+	svc.Start()
+	return svc
+}
+
 // ProvidePaymentOrderExpiryService creates and starts PaymentOrderExpiryService.
 func ProvidePaymentOrderExpiryService(paymentSvc *PaymentService) *PaymentOrderExpiryService {
 	svc := NewPaymentOrderExpiryService(paymentSvc, 60*time.Second)
@@ -92,6 +185,63 @@ func ProvideClaudeTokenProvider(
 	return p
 }
 
+// ProvideGatewayService creates GatewayService with volume tier pricing injected
+func ProvideGatewayService(
+	accountRepo AccountRepository,
+	groupRepo GroupRepository,
+	usageLogRepo UsageLogRepository,
+	usageBillingRepo UsageBillingRepository,
+	userRepo UserRepository,
+	userSubRepo UserSubscriptionRepository,
+	userGroupRateRepo UserGroupRateRepository,
+	cache GatewayCache,
+	cfg *config.Config,
+	schedulerSnapshot *SchedulerSnapshotService,
+	concurrencyService *ConcurrencyService,
+	billingService *BillingService,
+	rateLimitService *RateLimitService,
+	billingCacheService *BillingCacheService,
+	identityService *IdentityService,
+	httpUpstream HTTPUpstream,
+	deferredService *DeferredService,
+	claudeTokenProvider *ClaudeTokenProvider,
+	sessionLimitCache SessionLimitCache,
+	rpmCache RPMCache,
+	digestStore *DigestSessionStore,
+	settingService *SettingService,
+	balanceNotifyService *BalanceNotifyService,
+	volumeTierService *VolumeTierService,
+) *GatewayService {
+	svc := NewGatewayService(accountRepo, groupRepo, usageLogRepo, usageBillingRepo, userRepo, userSubRepo, userGroupRateRepo, cache, cfg, schedulerSnapshot, concurrencyService, billingService, rateLimitService, billingCacheService, identityService, httpUpstream, deferredService, claudeTokenProvider, sessionLimitCache, rpmCache, digestStore, settingService, balanceNotifyService)
+	svc.SetVolumeTierService(volumeTierService)
+	return svc
+}
+
+// ProvideOpenAIGatewayService creates OpenAIGatewayService with volume tier pricing injected
+func ProvideOpenAIGatewayService(
+	accountRepo AccountRepository,
+	usageLogRepo UsageLogRepository,
+	usageBillingRepo UsageBillingRepository,
+	userRepo UserRepository,
+	userSubRepo UserSubscriptionRepository,
+	userGroupRateRepo UserGroupRateRepository,
+	cache GatewayCache,
+	cfg *config.Config,
+	schedulerSnapshot *SchedulerSnapshotService,
+	concurrencyService *ConcurrencyService,
+	billingService *BillingService,
+	rateLimitService *RateLimitService,
+	billingCacheService *BillingCacheService,
+	httpUpstream HTTPUpstream,
+	deferredService *DeferredService,
+	openAITokenProvider *OpenAITokenProvider,
+	volumeTierService *VolumeTierService,
+) *OpenAIGatewayService {
+	svc := NewOpenAIGatewayService(accountRepo, usageLogRepo, usageBillingRepo, userRepo, userSubRepo, userGroupRateRepo, cache, cfg, schedulerSnapshot, concurrencyService, billingService, rateLimitService, billingCacheService, httpUpstream, deferredService, openAITokenProvider)
+	svc.SetVolumeTierService(volumeTierService)
+	return svc
+}
+
 // ProvideOpenAITokenProvider creates OpenAITokenProvider with OAuthRefreshAPI injection
 func ProvideOpenAITokenProvider(
 	accountRepo AccountRepository,
@@ -182,8 +332,9 @@ func ProvideDeferredService(accountRepo AccountRepository, timingWheel *TimingWh
 }
 
 // ProvideConcurrencyService creates ConcurrencyService and starts slot cleanup worker.
-func ProvideConcurrencyService(cache ConcurrencyCache, accountRepo AccountRepository, cfg *config.Config) *ConcurrencyService {
+func ProvideConcurrencyService(cache ConcurrencyCache, accountRepo AccountRepository, fairQueue *FairQueueService, cfg *config.Config) *ConcurrencyService {
 	svc := NewConcurrencyService(cache)
+	svc.SetFairQueue(fairQueue)
 	if err := svc.CleanupStaleProcessSlots(context.Background()); err != nil {
 		logger.LegacyPrintf("service.concurrency", "Warning: startup cleanup stale process slots failed: %v", err)
 	}
@@ -208,10 +359,50 @@ func ProvideSchedulerSnapshotService(
 	outboxRepo SchedulerOutboxRepository,
 	accountRepo AccountRepository,
 	groupRepo GroupRepository,
+	proxyPools *ProxyPoolService,
+	circuitBreaker *AccountCircuitBreaker,
 	cfg *config.Config,
 ) *SchedulerSnapshotService {
 	svc := NewSchedulerSnapshotService(cache, outboxRepo, accountRepo, groupRepo, cfg)
+	svc.SetProxyPoolService(proxyPools)
+	svc.SetAccountCircuitBreaker(circuitBreaker)
+	svc.Start()
+	return svc
+}
+
+// ProvideAccountCircuitBreaker creates AccountCircuitBreaker and starts its synthetic probe worker.
+func ProvideAccountCircuitBreaker(cfg *config.Config) *AccountCircuitBreaker {
+	breaker := NewAccountCircuitBreaker(cfg)
+	breaker.Start()
+	return breaker
+}
+
+// ProvideAccountTestService creates AccountTestService and registers it as the circuit breaker's synthetic prober.
+func ProvideAccountTestService(
+	accountRepo AccountRepository,
+	geminiTokenProvider *GeminiTokenProvider,
+	antigravityGatewayService *AntigravityGatewayService,
+	httpUpstream HTTPUpstream,
+	circuitBreaker *AccountCircuitBreaker,
+	cfg *config.Config,
+) *AccountTestService {
+	svc := NewAccountTestService(accountRepo, geminiTokenProvider, antigravityGatewayService, httpUpstream, cfg)
+	circuitBreaker.SetSyntheticProber(svc)
+	return svc
+}
+
+// ProvideProxyPoolService creates ProxyPoolService, starts its health probe worker and registers it
+// as the egress resolver shared by all outbound paths.
+func ProvideProxyPoolService(
+	repo ProxyPoolRepository,
+	proxyRepo ProxyRepository,
+	prober ProxyExitInfoProber,
+	latencyCache ProxyLatencyCache,
+	cfg *config.Config,
+) *ProxyPoolService {
+	svc := NewProxyPoolService(repo, proxyRepo, prober, latencyCache, cfg)
 	svc.Start()
+	SetActiveProxyPoolService(svc)
 	return svc
 }
 
@@ -436,6 +627,9 @@ var ProviderSet = wire.NewSet(
 	ProvideRedeemServiceWithReferral,
 	NewPromoService,
 	NewReferralService,
+	NewBalanceBucketService,
+	NewVolumeTierService,
+	ProvideBalanceBucketExpiryService,
 	NewPaygService,
 	NewUsageService,
 	NewDashboardService,
@@ -444,8 +638,8 @@ var ProviderSet = wire.NewSet(
 	NewBillingCacheService,
 	NewAnnouncementService,
 	NewAdminService,
-	NewGatewayService,
-	NewOpenAIGatewayService,
+	ProvideGatewayService,
+	ProvideOpenAIGatewayService,
 	NewOAuthService,
 	NewOpenAIOAuthService,
 	NewGeminiOAuthService,
@@ -462,7 +656,8 @@ var ProviderSet = wire.NewSet(
 	NewAntigravityGatewayService,
 	ProvideRateLimitService,
 	NewAccountUsageService,
-	NewAccountTestService,
+	ProvideAccountTestService,
+	ProvideAccountCircuitBreaker,
 	ProvideSettingService,
 	NewDataManagementService,
 	ProvideBackupService,
@@ -484,9 +679,11 @@ var ProviderSet = wire.NewSet(
 	NewSubscriptionService,
 	wire.Bind(new(DefaultSubscriptionAssigner), new(*SubscriptionService)),
 	ProvideConcurrencyService,
+	NewFairQueueService,
 	ProvideUserMessageQueueService,
 	NewUsageRecordWorkerPool,
 	ProvideSchedulerSnapshotService,
+	ProvideProxyPoolService,
 	NewIdentityService,
 	NewCRSSyncService,
 	ProvideUpdateService,
@@ -502,10 +699,18 @@ var ProviderSet = wire.NewSet(
 	NewUsageCache,
 	NewTotpService,
 	NewErrorPassthroughService,
+	NewRequestTransformService,
+	NewGatewayPluginService,
+	NewGuardrailService,
 	NewDigestSessionStore,
 	ProvidePaymentConfigService,
-	NewPaymentService,
+	ProvidePaymentService,
 	ProvidePaymentOrderExpiryService,
+	ProvideInvoiceService,
+	ProvidePostpaidService,
+	ProvideReferralCommissionService,
+	ProvidePostpaidBillingService,
+	ProvideBillingRecomputeService,
 	ProvideIdempotencyCoordinator,
 	ProvideSystemOperationLockService,
 	ProvideIdempotencyCleanupService,
@@ -544,8 +749,10 @@ func ProvideRedeemServiceWithReferral(
 	entClient *dbent.Client,
 	authCacheInvalidator APIKeyAuthCacheInvalidator,
 	referralService *ReferralService,
+	balanceBuckets *BalanceBucketService,
 ) *RedeemService {
 	svc := NewRedeemService(redeemRepo, userRepo, subscriptionService, cache, billingCacheService, entClient, authCacheInvalidator)
 	svc.SetReferralService(referralService)
+	svc.SetBalanceBuckets(balanceBuckets)
 	return svc
 }
@@ -0,0 +1,5 @@
+-- Migration: 112_add_usage_log_sub_key
+-- 临时子密钥（sk-eph-）：记录发起请求的子密钥 ID 与终端用户标识，便于按子密钥归因用量。
+
+ALTER TABLE usage_logs ADD COLUMN IF NOT EXISTS sub_key_id VARCHAR(64);
+ALTER TABLE usage_logs ADD COLUMN IF NOT EXISTS end_user_id VARCHAR(128);
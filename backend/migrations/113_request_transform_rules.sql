@@ -0,0 +1,22 @@
+-- Request Transform Rules table
+-- Allows administrators to declaratively rewrite upstream request bodies and headers
+-- scoped by platform / group / account / model pattern.
+
+CREATE TABLE IF NOT EXISTS request_transform_rules (
+    id BIGSERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    enabled BOOLEAN NOT NULL DEFAULT true,
+    priority INTEGER NOT NULL DEFAULT 0,
+    platforms JSONB DEFAULT '[]',
+    group_ids JSONB DEFAULT '[]',
+    account_ids JSONB DEFAULT '[]',
+    model_patterns JSONB DEFAULT '[]',
+    body_operations JSONB DEFAULT '[]',
+    header_operations JSONB DEFAULT '[]',
+    description TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_request_transform_rules_enabled ON request_transform_rules (enabled);
+CREATE INDEX IF NOT EXISTS idx_request_transform_rules_priority ON request_transform_rules (priority);
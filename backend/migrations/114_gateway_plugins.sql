@@ -0,0 +1,23 @@
+-- Gateway Plugins table
+-- Stores admin-uploaded WebAssembly modules that implement request/route/response/usage hooks,
+-- attached per group and executed with per-plugin time and memory limits.
+
+CREATE TABLE IF NOT EXISTS gateway_plugins (
+    id BIGSERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    enabled BOOLEAN NOT NULL DEFAULT true,
+    priority INTEGER NOT NULL DEFAULT 0,
+    group_ids JSONB DEFAULT '[]',
+    failure_mode VARCHAR(10) NOT NULL DEFAULT 'open',
+    timeout_ms INTEGER NOT NULL DEFAULT 50,
+    memory_limit_mb INTEGER NOT NULL DEFAULT 16,
+    hooks JSONB DEFAULT '[]',
+    module BYTEA NOT NULL,
+    module_sha256 VARCHAR(64) NOT NULL,
+    description TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_gateway_plugins_enabled ON gateway_plugins (enabled);
+CREATE INDEX IF NOT EXISTS idx_gateway_plugins_priority ON gateway_plugins (priority);
@@ -0,0 +1,22 @@
+-- Guardrail Rules table
+-- Scans message text before forwarding upstream and blocks, redacts or flags
+-- requests that match regex / keyword / built-in PII detectors.
+
+CREATE TABLE IF NOT EXISTS guardrail_rules (
+    id BIGSERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    enabled BOOLEAN NOT NULL DEFAULT true,
+    priority INTEGER NOT NULL DEFAULT 0,
+    platforms JSONB DEFAULT '[]',
+    group_ids JSONB DEFAULT '[]',
+    type VARCHAR(20) NOT NULL DEFAULT 'keyword',
+    patterns JSONB DEFAULT '[]',
+    case_sensitive BOOLEAN NOT NULL DEFAULT false,
+    action VARCHAR(20) NOT NULL DEFAULT 'flag',
+    description TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_guardrail_rules_enabled ON guardrail_rules (enabled);
+CREATE INDEX IF NOT EXISTS idx_guardrail_rules_priority ON guardrail_rules (priority);
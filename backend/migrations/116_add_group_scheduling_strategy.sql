@@ -0,0 +1,2 @@
+ALTER TABLE groups
+  ADD COLUMN IF NOT EXISTS scheduling_strategy VARCHAR(50) NOT NULL DEFAULT '';
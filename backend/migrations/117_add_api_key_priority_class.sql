@@ -0,0 +1,2 @@
+ALTER TABLE api_keys
+  ADD COLUMN IF NOT EXISTS priority_class VARCHAR(20) NOT NULL DEFAULT '';
@@ -0,0 +1,3 @@
+ALTER TABLE groups
+  ADD COLUMN IF NOT EXISTS hedge_enabled BOOLEAN NOT NULL DEFAULT FALSE,
+  ADD COLUMN IF NOT EXISTS hedge_delay_ms INTEGER NOT NULL DEFAULT 0;
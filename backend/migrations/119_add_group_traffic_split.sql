@@ -0,0 +1,12 @@
+-- 分组级模型流量拆分与影子流量
+-- traffic_split_rules 格式: [{"model_pattern": "claude-sonnet-*", "targets": [{"model": "...", "account_ids": [1], "percent": 90}, ...], "shadow": {"model": "...", "percent": 5}}]
+ALTER TABLE groups ADD COLUMN IF NOT EXISTS traffic_split_rules JSONB DEFAULT '[]';
+
+COMMENT ON COLUMN groups.traffic_split_rules IS '模型流量拆分规则：按会话哈希将请求按百分比分配到目标模型/账号，可选影子流量';
+
+-- 用量日志记录流量拆分标签（影子请求以 shadow: 开头，不计费），用于对比报表
+ALTER TABLE usage_logs ADD COLUMN IF NOT EXISTS traffic_label VARCHAR(100);
+
+CREATE INDEX IF NOT EXISTS idx_usage_logs_group_traffic_label
+    ON usage_logs (group_id, traffic_label, created_at)
+    WHERE traffic_label IS NOT NULL;
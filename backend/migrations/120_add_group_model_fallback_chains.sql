@@ -0,0 +1,8 @@
+-- 分组级模型降级链
+-- model_fallback_chains 格式: [{"model_pattern": "claude-opus-*", "steps": [{"model": "claude-sonnet-4-5"}, {"model": "gpt-5", "group_id": 12}]}]
+ALTER TABLE groups ADD COLUMN IF NOT EXISTS model_fallback_chains JSONB DEFAULT '[]';
+
+COMMENT ON COLUMN groups.model_fallback_chains IS '模型降级链：过载或无可调度账号时按顺序改用降级模型重试';
+
+-- 用量日志记录降级后实际使用的模型（未降级为空）
+ALTER TABLE usage_logs ADD COLUMN IF NOT EXISTS fallback_model VARCHAR(100);
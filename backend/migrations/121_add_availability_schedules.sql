@@ -0,0 +1,13 @@
+-- 账号/分组可用时段（cron 表达式，命中的分钟内才参与调度）与分组维护模式
+-- availability_schedule 示例: "* 0-7 * * *"（每天 0:00-7:59 可用）、"CRON_TZ=America/New_York * 19-23 * * 1-5"
+ALTER TABLE accounts ADD COLUMN IF NOT EXISTS availability_schedule VARCHAR(200);
+
+COMMENT ON COLUMN accounts.availability_schedule IS '可用时段 cron 表达式：命中的分钟内账号才可调度，为空表示全天可用';
+
+ALTER TABLE groups ADD COLUMN IF NOT EXISTS availability_schedule VARCHAR(200);
+ALTER TABLE groups ADD COLUMN IF NOT EXISTS maintenance_mode BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE groups ADD COLUMN IF NOT EXISTS maintenance_message TEXT;
+
+COMMENT ON COLUMN groups.availability_schedule IS '可用时段 cron 表达式：命中的分钟内分组才可调度，为空表示全天可用';
+COMMENT ON COLUMN groups.maintenance_mode IS '维护模式：开启后网关直接返回维护提示';
+COMMENT ON COLUMN groups.maintenance_message IS '维护提示信息，为空时使用默认提示';
@@ -0,0 +1,21 @@
+-- 代理池：命名的代理集合（带权重），账号可引用代理池代替单个代理
+-- 出口代理从池内健康成员中按权重粘性选择，成员连接失败时自动切换
+CREATE TABLE IF NOT EXISTS proxy_pools (
+    id BIGSERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'active',
+    members JSONB NOT NULL DEFAULT '[]',
+    description TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_proxy_pools_status ON proxy_pools (status);
+
+COMMENT ON COLUMN proxy_pools.members IS '池成员列表：[{"proxy_id": 1, "weight": 1}]';
+
+ALTER TABLE accounts ADD COLUMN IF NOT EXISTS proxy_pool_id BIGINT;
+
+CREATE INDEX IF NOT EXISTS idx_accounts_proxy_pool_id ON accounts (proxy_pool_id);
+
+COMMENT ON COLUMN accounts.proxy_pool_id IS '关联的代理池 ID，设置后优先于 proxy_id 使用池内健康成员作为出口';
@@ -0,0 +1,29 @@
+-- 套餐自动续费：套餐可映射到 Stripe Price，结账时创建 Stripe 周期订阅
+-- 每期账单支付成功后生成续费订单并延长用户订阅，扣款失败时暂停订阅
+ALTER TABLE subscription_plans ADD COLUMN IF NOT EXISTS stripe_price_id VARCHAR(100) NOT NULL DEFAULT '';
+
+COMMENT ON COLUMN subscription_plans.stripe_price_id IS 'Stripe 周期价格 ID（price_xxx），为空表示不支持自动续费';
+
+CREATE TABLE IF NOT EXISTS subscription_auto_renewals (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    plan_id BIGINT NOT NULL,
+    group_id BIGINT NOT NULL,
+    subscription_days INT NOT NULL,
+    amount DECIMAL(20,2) NOT NULL,
+    payment_type VARCHAR(30) NOT NULL,
+    provider_instance_id VARCHAR(64),
+    provider_subscription_id VARCHAR(128) NOT NULL,
+    initial_order_id BIGINT NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'incomplete',
+    current_period_end TIMESTAMPTZ,
+    canceled_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_subscription_auto_renewals_provider_sub ON subscription_auto_renewals(provider_subscription_id);
+CREATE INDEX IF NOT EXISTS idx_subscription_auto_renewals_user_id ON subscription_auto_renewals(user_id);
+CREATE INDEX IF NOT EXISTS idx_subscription_auto_renewals_status ON subscription_auto_renewals(status);
+
+COMMENT ON COLUMN subscription_auto_renewals.status IS '自动续费状态：incomplete（首期未支付）/ active / past_due（扣款失败）/ canceled';
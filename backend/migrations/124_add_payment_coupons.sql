@@ -0,0 +1,38 @@
+-- 结账优惠券：下单时按百分比或固定金额减免实付金额
+-- 订单上记录使用的优惠券与减免金额，退款时按实付金额比例计算
+CREATE TABLE IF NOT EXISTS payment_coupons (
+    id BIGSERIAL PRIMARY KEY,
+    code VARCHAR(32) NOT NULL,
+    name VARCHAR(100) NOT NULL DEFAULT '',
+    discount_type VARCHAR(20) NOT NULL DEFAULT 'percent',
+    discount_value DECIMAL(20,2) NOT NULL,
+    min_amount DECIMAL(20,2) NOT NULL DEFAULT 0,
+    applies_to VARCHAR(20) NOT NULL DEFAULT 'all',
+    plan_ids JSONB DEFAULT '[]',
+    max_uses INT NOT NULL DEFAULT 0,
+    per_user_limit INT NOT NULL DEFAULT 1,
+    status VARCHAR(20) NOT NULL DEFAULT 'active',
+    starts_at TIMESTAMPTZ,
+    expires_at TIMESTAMPTZ,
+    notes TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_payment_coupons_code ON payment_coupons(code);
+CREATE INDEX IF NOT EXISTS idx_payment_coupons_status ON payment_coupons(status);
+CREATE INDEX IF NOT EXISTS idx_payment_coupons_expires_at ON payment_coupons(expires_at);
+
+COMMENT ON COLUMN payment_coupons.discount_type IS '折扣类型：percent（百分比）/ fixed（固定金额）';
+COMMENT ON COLUMN payment_coupons.applies_to IS '适用范围：all / balance（仅充值）/ subscription（仅套餐）';
+COMMENT ON COLUMN payment_coupons.plan_ids IS '适用套餐 ID 列表，空列表表示所有套餐';
+COMMENT ON COLUMN payment_coupons.max_uses IS '总使用次数上限，0 表示不限制';
+COMMENT ON COLUMN payment_coupons.per_user_limit IS '每用户使用次数上限，0 表示不限制';
+
+ALTER TABLE payment_orders ADD COLUMN IF NOT EXISTS coupon_id BIGINT;
+ALTER TABLE payment_orders ADD COLUMN IF NOT EXISTS coupon_code VARCHAR(32);
+ALTER TABLE payment_orders ADD COLUMN IF NOT EXISTS discount_amount DECIMAL(20,2) NOT NULL DEFAULT 0;
+
+CREATE INDEX IF NOT EXISTS idx_payment_orders_coupon_user ON payment_orders(coupon_id, user_id);
+
+COMMENT ON COLUMN payment_orders.discount_amount IS '优惠券减免金额，pay_amount 为扣除减免后的实付金额';
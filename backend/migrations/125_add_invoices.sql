@@ -0,0 +1,49 @@
+-- 发票：为已完成的支付订单与 PAYG 订单开具连续编号的发票，退款时开具红字发票
+CREATE TABLE IF NOT EXISTS invoice_sequences (
+    id BIGSERIAL PRIMARY KEY,
+    series VARCHAR(20) NOT NULL,
+    last_number BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_invoice_sequences_series ON invoice_sequences(series);
+
+INSERT INTO invoice_sequences (series, last_number) VALUES ('invoice', 0), ('credit_note', 0)
+ON CONFLICT (series) DO NOTHING;
+
+CREATE TABLE IF NOT EXISTS invoices (
+    id BIGSERIAL PRIMARY KEY,
+    invoice_no VARCHAR(32) NOT NULL,
+    kind VARCHAR(20) NOT NULL DEFAULT 'invoice',
+    user_id BIGINT NOT NULL,
+    source_type VARCHAR(20) NOT NULL,
+    source_id BIGINT NOT NULL,
+    source_no VARCHAR(64) NOT NULL DEFAULT '',
+    original_invoice_id BIGINT,
+    currency VARCHAR(10) NOT NULL DEFAULT 'CNY',
+    subtotal DECIMAL(20,2) NOT NULL,
+    discount_amount DECIMAL(20,2) NOT NULL DEFAULT 0,
+    fee_amount DECIMAL(20,2) NOT NULL DEFAULT 0,
+    total DECIMAL(20,2) NOT NULL,
+    lines JSONB NOT NULL DEFAULT '[]',
+    payment_method VARCHAR(30) NOT NULL DEFAULT '',
+    buyer_email VARCHAR(255) NOT NULL DEFAULT '',
+    buyer_name VARCHAR(255) NOT NULL DEFAULT '',
+    buyer_tax_id VARCHAR(64) NOT NULL DEFAULT '',
+    buyer_address TEXT NOT NULL DEFAULT '',
+    seller_name VARCHAR(255) NOT NULL DEFAULT '',
+    seller_tax_id VARCHAR(64) NOT NULL DEFAULT '',
+    seller_address TEXT NOT NULL DEFAULT '',
+    notes TEXT NOT NULL DEFAULT '',
+    issued_at TIMESTAMPTZ NOT NULL,
+    emailed_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_invoices_invoice_no ON invoices(invoice_no);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_invoices_source_kind ON invoices(source_type, source_id, kind);
+CREATE INDEX IF NOT EXISTS idx_invoices_user_issued ON invoices(user_id, issued_at);
+CREATE INDEX IF NOT EXISTS idx_invoices_issued_at ON invoices(issued_at);
+
+COMMENT ON COLUMN invoices.kind IS '发票类型：invoice（发票）/ credit_note（红字发票，金额为正数）';
+COMMENT ON COLUMN invoices.source_type IS '来源订单类型：payment_order / payg_order';
+COMMENT ON COLUMN invoices.buyer_name IS '买方名称（开票时从用户属性 company_name 快照，缺省为用户名）';
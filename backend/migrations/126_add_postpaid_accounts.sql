@@ -0,0 +1,44 @@
+-- 后付费企业账户：余额可透支至 -credit_limit，按自然月生成账单，逾期自动暂停 API Key
+CREATE TABLE IF NOT EXISTS postpaid_accounts (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    credit_limit DECIMAL(20,8) NOT NULL DEFAULT 0,
+    payment_terms_days INT NOT NULL DEFAULT 15,
+    status VARCHAR(20) NOT NULL DEFAULT 'active',
+    billing_start TIMESTAMPTZ NOT NULL,
+    suspended_at TIMESTAMPTZ,
+    notes TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_postpaid_accounts_user_id ON postpaid_accounts(user_id);
+CREATE INDEX IF NOT EXISTS idx_postpaid_accounts_status ON postpaid_accounts(status);
+
+COMMENT ON COLUMN postpaid_accounts.credit_limit IS '信用额度（USD），余额最低可透支至 -credit_limit';
+COMMENT ON COLUMN postpaid_accounts.payment_terms_days IS '账期天数：账单生成后多少天到期';
+COMMENT ON COLUMN postpaid_accounts.status IS '状态：active / suspended（账单逾期，API Key 已暂停）';
+COMMENT ON COLUMN postpaid_accounts.billing_start IS '计费起点，首期账单从该时间开始统计';
+
+CREATE TABLE IF NOT EXISTS billing_statements (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    period_start TIMESTAMPTZ NOT NULL,
+    period_end TIMESTAMPTZ NOT NULL,
+    amount DECIMAL(20,8) NOT NULL DEFAULT 0,
+    request_count BIGINT NOT NULL DEFAULT 0,
+    status VARCHAR(20) NOT NULL DEFAULT 'unpaid',
+    due_at TIMESTAMPTZ NOT NULL,
+    reminder_sent_at TIMESTAMPTZ,
+    overdue_at TIMESTAMPTZ,
+    paid_at TIMESTAMPTZ,
+    payment_note TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_billing_statements_user_period ON billing_statements(user_id, period_start);
+CREATE INDEX IF NOT EXISTS idx_billing_statements_status_due ON billing_statements(status, due_at);
+
+COMMENT ON COLUMN billing_statements.amount IS '账期 [period_start, period_end) 内余额计费的 actual_cost 合计（USD）';
+COMMENT ON COLUMN billing_statements.status IS '状态：unpaid / overdue / paid';
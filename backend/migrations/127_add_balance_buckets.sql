@@ -0,0 +1,34 @@
+-- 余额分桶：记录 users.balance 的来源构成，支持赠送额度过期与按来源退款
+CREATE TABLE IF NOT EXISTS balance_buckets (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    source VARCHAR(20) NOT NULL,
+    source_ref VARCHAR(128) NOT NULL DEFAULT '',
+    amount DECIMAL(20,8) NOT NULL,
+    remaining DECIMAL(20,8) NOT NULL,
+    priority INT NOT NULL DEFAULT 100,
+    status VARCHAR(20) NOT NULL DEFAULT 'active',
+    expires_at TIMESTAMPTZ,
+    expired_amount DECIMAL(20,8) NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_balance_buckets_user_status ON balance_buckets(user_id, status);
+CREATE INDEX IF NOT EXISTS idx_balance_buckets_status_expires ON balance_buckets(status, expires_at);
+
+COMMENT ON COLUMN balance_buckets.source IS '来源：paid / redeem / promo / referral / admin / legacy';
+COMMENT ON COLUMN balance_buckets.source_ref IS '来源关联标识（订单号、兑换码、优惠码等）';
+COMMENT ON COLUMN balance_buckets.remaining IS '剩余可用金额（USD）';
+COMMENT ON COLUMN balance_buckets.priority IS '消费优先级，数值越小越先扣减';
+COMMENT ON COLUMN balance_buckets.status IS '状态：active / exhausted / expired / refunded';
+COMMENT ON COLUMN balance_buckets.expires_at IS '到期时间，为空表示永不过期';
+COMMENT ON COLUMN balance_buckets.expired_amount IS '过期时回收的金额';
+
+-- 存量余额无法区分来源，统一作为 legacy 桶（与付费同优先级、永不过期）
+INSERT INTO balance_buckets (user_id, source, amount, remaining, priority, status)
+SELECT id, 'legacy', balance, balance, 100, 'active'
+FROM users
+WHERE balance > 0
+  AND deleted_at IS NULL
+  AND NOT EXISTS (SELECT 1 FROM balance_buckets b WHERE b.user_id = users.id);
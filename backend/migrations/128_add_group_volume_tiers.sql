@@ -0,0 +1,9 @@
+-- 分组级用量阶梯定价
+-- volume_tiers 格式: [{"min_spend": 0, "multiplier": 1.0}, {"min_spend": 100, "multiplier": 0.9}, {"min_spend": 1000, "multiplier": 0.8}]
+ALTER TABLE groups ADD COLUMN IF NOT EXISTS volume_tiers JSONB DEFAULT '[]';
+
+COMMENT ON COLUMN groups.volume_tiers IS '用量阶梯定价：按用户当月在该分组的累计消费叠加折扣倍数';
+
+-- 按用户+分组+时间统计当月消费
+CREATE INDEX IF NOT EXISTS idx_usage_logs_user_group_created_at
+    ON usage_logs (user_id, group_id, created_at);
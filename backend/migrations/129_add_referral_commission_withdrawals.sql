@@ -0,0 +1,62 @@
+-- 佣金提现：返利记入独立的佣金账户，冻结期后可申请提现，由管理员审核打款
+CREATE TABLE IF NOT EXISTS referral_commissions (
+    id BIGSERIAL PRIMARY KEY,
+    referrer_id BIGINT NOT NULL,
+    referee_id BIGINT NOT NULL,
+    reward_id BIGINT,
+    source_type VARCHAR(20) NOT NULL,
+    source_id BIGINT NOT NULL,
+    source_amount DECIMAL(20,8) NOT NULL DEFAULT 0,
+    amount DECIMAL(20,8) NOT NULL,
+    clawed_back_amount DECIMAL(20,8) NOT NULL DEFAULT 0,
+    status VARCHAR(20) NOT NULL DEFAULT 'active',
+    available_at TIMESTAMPTZ NOT NULL,
+    clawed_back_at TIMESTAMPTZ,
+    clawback_reason TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_referral_commissions_referrer_status ON referral_commissions(referrer_id, status);
+CREATE INDEX IF NOT EXISTS idx_referral_commissions_source ON referral_commissions(source_type, source_id);
+
+COMMENT ON COLUMN referral_commissions.available_at IS '冻结期结束时间，之后计入可提现金额且不再追回';
+COMMENT ON COLUMN referral_commissions.clawed_back_amount IS '因来源订单退款追回的金额';
+COMMENT ON COLUMN referral_commissions.status IS '状态：active / clawed_back（已全额追回）';
+
+CREATE TABLE IF NOT EXISTS commission_withdrawals (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    amount DECIMAL(20,8) NOT NULL,
+    payout_method VARCHAR(20) NOT NULL,
+    payout_account VARCHAR(255) NOT NULL,
+    payout_name VARCHAR(100),
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    reviewer_id BIGINT,
+    review_note TEXT,
+    payout_reference VARCHAR(128),
+    reviewed_at TIMESTAMPTZ,
+    paid_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_commission_withdrawals_user_status ON commission_withdrawals(user_id, status);
+CREATE INDEX IF NOT EXISTS idx_commission_withdrawals_status_created ON commission_withdrawals(status, created_at);
+
+COMMENT ON COLUMN commission_withdrawals.payout_method IS '收款方式：alipay / wechat / bank / usdt / paypal';
+COMMENT ON COLUMN commission_withdrawals.status IS '状态：pending / approved / rejected / paid / cancelled';
+
+CREATE TABLE IF NOT EXISTS commission_withdrawal_logs (
+    id BIGSERIAL PRIMARY KEY,
+    withdrawal_id BIGINT NOT NULL,
+    action VARCHAR(20) NOT NULL,
+    from_status VARCHAR(20),
+    to_status VARCHAR(20) NOT NULL,
+    operator_id BIGINT NOT NULL,
+    operator_role VARCHAR(20) NOT NULL,
+    note TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_commission_withdrawal_logs_withdrawal_id ON commission_withdrawal_logs(withdrawal_id);
@@ -0,0 +1,45 @@
+-- 计费重算：按当前价格或候选价格/倍率重放 usage_logs，生成差异报告，可确认后修正余额
+CREATE TABLE IF NOT EXISTS billing_recompute_jobs (
+    id BIGSERIAL PRIMARY KEY,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    mode VARCHAR(20) NOT NULL,
+    params JSONB NOT NULL,
+    report JSONB,
+    scanned_rows BIGINT NOT NULL DEFAULT 0,
+    changed_rows BIGINT NOT NULL DEFAULT 0,
+    original_cost DECIMAL(20,8) NOT NULL DEFAULT 0,
+    recomputed_cost DECIMAL(20,8) NOT NULL DEFAULT 0,
+    balance_diff DECIMAL(20,8) NOT NULL DEFAULT 0,
+    error_message TEXT,
+    created_by BIGINT NOT NULL,
+    applied_by BIGINT,
+    started_at TIMESTAMPTZ,
+    finished_at TIMESTAMPTZ,
+    applied_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_billing_recompute_jobs_status_created ON billing_recompute_jobs(status, created_at);
+
+COMMENT ON COLUMN billing_recompute_jobs.status IS '状态：pending / running / succeeded / failed / applying / applied';
+COMMENT ON COLUMN billing_recompute_jobs.mode IS '模式：current（当前价格重放）/ candidate（候选价格或倍率模拟）';
+COMMENT ON COLUMN billing_recompute_jobs.balance_diff IS '余额计费部分的差额合计（重算 - 原始），正数表示少收';
+
+CREATE TABLE IF NOT EXISTS billing_adjustments (
+    id BIGSERIAL PRIMARY KEY,
+    job_id BIGINT NOT NULL,
+    user_id BIGINT NOT NULL,
+    amount DECIMAL(20,8) NOT NULL,
+    balance_before DECIMAL(20,8) NOT NULL,
+    balance_after DECIMAL(20,8) NOT NULL,
+    usage_rows BIGINT NOT NULL DEFAULT 0,
+    operator_id BIGINT NOT NULL,
+    note TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_billing_adjustments_job_user ON billing_adjustments(job_id, user_id);
+CREATE INDEX IF NOT EXISTS idx_billing_adjustments_user ON billing_adjustments(user_id);
+
+COMMENT ON COLUMN billing_adjustments.amount IS '余额变动：正数为退还多收费用，负数为补扣少收费用';
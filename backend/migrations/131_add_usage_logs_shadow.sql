@@ -0,0 +1,7 @@
+-- 影子请求显式标记：影子请求仅用于流量拆分对比，不计费，且不计入用户与平台用量统计
+ALTER TABLE usage_logs ADD COLUMN IF NOT EXISTS shadow BOOLEAN NOT NULL DEFAULT FALSE;
+
+COMMENT ON COLUMN usage_logs.shadow IS '是否为影子请求（响应未返回给用户，仅记录用量用于对比）';
+
+-- 回填历史影子请求（此前通过 traffic_label 的 shadow: 前缀区分）
+UPDATE usage_logs SET shadow = TRUE WHERE traffic_label LIKE 'shadow:%' AND shadow = FALSE;
@@ -0,0 +1,5 @@
+-- 链上支付：一笔链上转账只能支付一个订单。已支付订单以交易哈希作为 payment_trade_no，
+-- 唯一索引保证并发轮询时只有一个订单能认领同一笔转账（待支付订单的发票号同样唯一）
+CREATE UNIQUE INDEX IF NOT EXISTS idx_payment_orders_chain_trade_no
+    ON payment_orders(payment_trade_no)
+    WHERE payment_type = 'usdt' AND payment_trade_no <> '';
@@ -0,0 +1,11 @@
+-- 计费重算需要区分的使用记录标记：未实际扣费（simple 模式）与按 Gemini 长上下文规则计费
+ALTER TABLE usage_logs ADD COLUMN IF NOT EXISTS unbilled BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE usage_logs ADD COLUMN IF NOT EXISTS long_context BOOLEAN NOT NULL DEFAULT FALSE;
+
+COMMENT ON COLUMN usage_logs.unbilled IS '是否未实际扣费（simple 模式仅记录用量）';
+COMMENT ON COLUMN usage_logs.long_context IS '是否按 Gemini 长上下文规则计费（超出阈值的输入按倍率计费）';
+
+-- 回填历史长上下文记录：Gemini 原生接口（/v1beta/models）的文本请求一直按长上下文规则计费
+-- 历史 simple 模式记录无法区分，不回填
+UPDATE usage_logs SET long_context = TRUE
+WHERE inbound_endpoint = '/v1beta/models' AND image_count = 0 AND long_context = FALSE;
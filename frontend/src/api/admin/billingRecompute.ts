@@ -0,0 +1,58 @@
+import { apiClient } from '../client'
+import type {
+  BillingAdjustment,
+  BillingRecomputeJob,
+  BillingRecomputeParams,
+  BillingRecomputeStatus,
+  PaginatedResponse
+} from '@/types'
+
+export interface BillingRecomputeJobQuery {
+  page?: number
+  page_size?: number
+  status?: BillingRecomputeStatus | ''
+}
+
+export interface ApplyBillingRecomputeRequest {
+  confirm: boolean
+  /** Must repeat the job report's balance_diff. */
+  expected_balance_diff: number
+  refund_only?: boolean
+  min_amount?: number
+  note?: string
+}
+
+export async function listJobs(params: BillingRecomputeJobQuery = {}): Promise<PaginatedResponse<BillingRecomputeJob>> {
+  const { data } = await apiClient.get<PaginatedResponse<BillingRecomputeJob>>('/admin/billing/recompute/jobs', { params })
+  return data
+}
+
+export async function createJob(params: BillingRecomputeParams): Promise<BillingRecomputeJob> {
+  const { data } = await apiClient.post<BillingRecomputeJob>('/admin/billing/recompute/jobs', params)
+  return data
+}
+
+export async function getJob(id: number): Promise<BillingRecomputeJob> {
+  const { data } = await apiClient.get<BillingRecomputeJob>(`/admin/billing/recompute/jobs/${id}`)
+  return data
+}
+
+export async function applyJob(id: number, req: ApplyBillingRecomputeRequest): Promise<BillingRecomputeJob> {
+  const { data } = await apiClient.post<BillingRecomputeJob>(`/admin/billing/recompute/jobs/${id}/apply`, req)
+  return data
+}
+
+export async function listAdjustments(id: number): Promise<BillingAdjustment[]> {
+  const { data } = await apiClient.get<BillingAdjustment[]>(`/admin/billing/recompute/jobs/${id}/adjustments`)
+  return data
+}
+
+export const billingRecomputeAPI = {
+  listJobs,
+  createJob,
+  getJob,
+  applyJob,
+  listAdjustments,
+}
+
+export default billingRecomputeAPI
@@ -26,6 +26,9 @@ import scheduledTestsAPI from './scheduledTests'
 import backupAPI from './backup'
 import paygAPI from './payg'
 import adminPaymentAPI from './payment'
+import postpaidAPI from './postpaid'
+import referralWithdrawalsAPI from './referralWithdrawals'
+import billingRecomputeAPI from './billingRecompute'
 import channelMonitorAPI from './channelMonitor'
 import channelMonitorTemplateAPI from './channelMonitorTemplate'
 
@@ -56,6 +59,9 @@ export const adminAPI = {
   backup: backupAPI,
   payg: paygAPI,
   payment: adminPaymentAPI,
+  postpaid: postpaidAPI,
+  referralWithdrawals: referralWithdrawalsAPI,
+  billingRecompute: billingRecomputeAPI,
   channelMonitor: channelMonitorAPI,
   channelMonitorTemplate: channelMonitorTemplateAPI
 }
@@ -84,6 +90,9 @@ export {
   backupAPI,
   paygAPI,
   adminPaymentAPI,
+  postpaidAPI,
+  referralWithdrawalsAPI,
+  billingRecomputeAPI,
   channelMonitorAPI,
   channelMonitorTemplateAPI
 }
@@ -5,6 +5,8 @@
 import { apiClient } from '../client'
 import type {
   DashboardStats,
+  Invoice,
+  InvoiceFormat,
   PaymentOrder,
   SubscriptionPlan,
   ProviderInstance
@@ -32,6 +34,10 @@ export interface AdminPaymentConfig {
   cancel_rate_limit_window: number
   cancel_rate_limit_unit: string
   cancel_rate_limit_window_mode: string
+  invoice_seller_name: string
+  invoice_seller_tax_id: string
+  invoice_seller_address: string
+  invoice_auto_email: boolean
 }
 
 export interface UpdatePaymentConfigRequest {
@@ -55,6 +61,10 @@ export interface UpdatePaymentConfigRequest {
   cancel_rate_limit_window?: number
   cancel_rate_limit_unit?: string
   cancel_rate_limit_window_mode?: string
+  invoice_seller_name?: string
+  invoice_seller_tax_id?: string
+  invoice_seller_address?: string
+  invoice_auto_email?: boolean
 }
 
 export const adminPaymentAPI = {
@@ -98,6 +108,10 @@ export const adminPaymentAPI = {
     return apiClient.post(`/admin/payment/orders/${id}/retry`)
   },
 
+  settleChainTransfer(id: number, txHash: string) {
+    return apiClient.post(`/admin/payment/orders/${id}/settle-transfer`, { tx_hash: txHash })
+  },
+
   refundOrder(id: number, data: { amount: number; reason: string; deduct_balance?: boolean; force?: boolean }) {
     return apiClient.post(`/admin/payment/orders/${id}/refund`, data)
   },
@@ -132,6 +146,32 @@ export const adminPaymentAPI = {
 
   deleteProvider(id: number) {
     return apiClient.delete(`/admin/payment/providers/${id}`)
+  },
+
+  listInvoices(params?: {
+    page?: number
+    page_size?: number
+    user_id?: number
+    kind?: string
+    source_type?: string
+  }) {
+    return apiClient.get<BasePaginationResponse<Invoice>>('/admin/payment/invoices', { params })
+  },
+
+  issueInvoice(data: { source_type: string; source_id: number }) {
+    return apiClient.post<Invoice>('/admin/payment/invoices', data)
+  },
+
+  async downloadInvoice(id: number, format: InvoiceFormat = 'pdf'): Promise<Blob> {
+    const response = await apiClient.get(`/admin/payment/invoices/${id}/download`, {
+      params: { format },
+      responseType: 'blob'
+    })
+    return response.data
+  },
+
+  resendInvoice(id: number) {
+    return apiClient.post(`/admin/payment/invoices/${id}/email`)
   }
 }
 
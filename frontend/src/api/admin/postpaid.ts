@@ -0,0 +1,60 @@
+import { apiClient } from '../client'
+import type { BillingStatement, PaginatedResponse, PostpaidAccount } from '@/types'
+
+export interface PostpaidAccountPayload {
+  credit_limit: number
+  payment_terms_days?: number
+  notes?: string
+}
+
+export interface AdminBillingStatementQuery {
+  page?: number
+  page_size?: number
+  status?: string
+  user_id?: number
+}
+
+export async function listAccounts(params: { page?: number; page_size?: number } = {}): Promise<PaginatedResponse<PostpaidAccount>> {
+  const { data } = await apiClient.get<PaginatedResponse<PostpaidAccount>>('/admin/postpaid/accounts', { params })
+  return data
+}
+
+export async function getAccount(userId: number): Promise<PostpaidAccount> {
+  const { data } = await apiClient.get<PostpaidAccount>(`/admin/postpaid/accounts/${userId}`)
+  return data
+}
+
+export async function saveAccount(userId: number, payload: PostpaidAccountPayload): Promise<PostpaidAccount> {
+  const { data } = await apiClient.put<PostpaidAccount>(`/admin/postpaid/accounts/${userId}`, payload)
+  return data
+}
+
+export async function deleteAccount(userId: number): Promise<void> {
+  await apiClient.delete(`/admin/postpaid/accounts/${userId}`)
+}
+
+export async function listStatements(params: AdminBillingStatementQuery = {}): Promise<PaginatedResponse<BillingStatement>> {
+  const { data } = await apiClient.get<PaginatedResponse<BillingStatement>>('/admin/postpaid/statements', { params })
+  return data
+}
+
+export async function markStatementPaid(id: number, note?: string): Promise<BillingStatement> {
+  const { data } = await apiClient.post<BillingStatement>(`/admin/postpaid/statements/${id}/paid`, { note })
+  return data
+}
+
+export async function runBillingCycle(): Promise<void> {
+  await apiClient.post('/admin/postpaid/run')
+}
+
+export const postpaidAPI = {
+  listAccounts,
+  getAccount,
+  saveAccount,
+  deleteAccount,
+  listStatements,
+  markStatementPaid,
+  runBillingCycle,
+}
+
+export default postpaidAPI
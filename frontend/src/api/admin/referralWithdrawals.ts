@@ -0,0 +1,47 @@
+import { apiClient } from '../client'
+import type { CommissionWithdrawal, CommissionWithdrawalLog, PaginatedResponse, WithdrawalStatus } from '@/types'
+
+export interface AdminWithdrawalQuery {
+  page?: number
+  page_size?: number
+  status?: WithdrawalStatus | ''
+  user_id?: number
+}
+
+export async function list(params: AdminWithdrawalQuery = {}): Promise<PaginatedResponse<CommissionWithdrawal>> {
+  const { data } = await apiClient.get<PaginatedResponse<CommissionWithdrawal>>('/admin/referral/withdrawals', { params })
+  return data
+}
+
+export async function approve(id: number, note?: string): Promise<CommissionWithdrawal> {
+  const { data } = await apiClient.post<CommissionWithdrawal>(`/admin/referral/withdrawals/${id}/approve`, { note })
+  return data
+}
+
+export async function reject(id: number, note: string): Promise<CommissionWithdrawal> {
+  const { data } = await apiClient.post<CommissionWithdrawal>(`/admin/referral/withdrawals/${id}/reject`, { note })
+  return data
+}
+
+export async function markPaid(id: number, payoutReference?: string, note?: string): Promise<CommissionWithdrawal> {
+  const { data } = await apiClient.post<CommissionWithdrawal>(`/admin/referral/withdrawals/${id}/paid`, {
+    payout_reference: payoutReference,
+    note
+  })
+  return data
+}
+
+export async function listLogs(id: number): Promise<CommissionWithdrawalLog[]> {
+  const { data } = await apiClient.get<CommissionWithdrawalLog[]>(`/admin/referral/withdrawals/${id}/logs`)
+  return data
+}
+
+export const referralWithdrawalsAPI = {
+  list,
+  approve,
+  reject,
+  markPaid,
+  listLogs,
+}
+
+export default referralWithdrawalsAPI
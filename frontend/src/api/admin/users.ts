@@ -4,7 +4,7 @@
  */
 
 import { apiClient } from '../client'
-import type { AdminUser, UpdateUserRequest, PaginatedResponse, ApiKey, UserCommissionRateInfo } from '@/types'
+import type { AdminUser, UpdateUserRequest, PaginatedResponse, ApiKey, UserCommissionRateInfo, BalanceBucket } from '@/types'
 
 function createIdempotencyKey(scope: string): string {
   const randomPart =
@@ -244,8 +244,13 @@ export interface BalanceHistoryItem {
 }
 
 // Balance history response extends pagination with total_recharged summary
+// and a page of the user's balance buckets (paginated by bucket_page/bucket_page_size, filtered by bucket_status)
 export interface BalanceHistoryResponse extends PaginatedResponse<BalanceHistoryItem> {
   total_recharged: number
+  buckets: BalanceBucket[]
+  bucket_total: number
+  bucket_page: number
+  bucket_page_size: number
 }
 
 /**
@@ -254,16 +259,28 @@ export interface BalanceHistoryResponse extends PaginatedResponse<BalanceHistory
  * @param page - Page number
  * @param pageSize - Items per page
  * @param type - Optional type filter (balance, admin_balance, concurrency, admin_concurrency, subscription)
- * @returns Paginated balance history with total_recharged
+ * @param bucketStatus - Optional balance bucket status filter (active, exhausted, expired, refunded)
+ * @param bucketPage - Balance bucket page number, independent of page
+ * @param bucketPageSize - Balance buckets per page, independent of pageSize
+ * @returns Paginated balance history with total_recharged and balance buckets
  */
 export async function getUserBalanceHistory(
   id: number,
   page: number = 1,
   pageSize: number = 20,
-  type?: string
+  type?: string,
+  bucketStatus?: string,
+  bucketPage: number = 1,
+  bucketPageSize: number = 20
 ): Promise<BalanceHistoryResponse> {
-  const params: Record<string, any> = { page, page_size: pageSize }
+  const params: Record<string, any> = {
+    page,
+    page_size: pageSize,
+    bucket_page: bucketPage,
+    bucket_page_size: bucketPageSize
+  }
   if (type) params.type = type
+  if (bucketStatus) params.bucket_status = bucketStatus
   const { data } = await apiClient.get<BalanceHistoryResponse>(
     `/admin/users/${id}/balance-history`,
     { params }
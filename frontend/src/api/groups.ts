@@ -4,7 +4,7 @@
  */
 
 import { apiClient } from './client'
-import type { Group } from '@/types'
+import type { Group, VolumeTierProgress } from '@/types'
 
 /**
  * Get available groups that the current user can bind to API keys
@@ -18,18 +18,33 @@ export async function getAvailable(): Promise<Group[]> {
   return data
 }
 
+interface UserGroupRatesResponse {
+  rates: Record<number, number> | null
+  volume_tiers: VolumeTierProgress[] | null
+}
+
 /**
- * Get current user's custom group rate multipliers
- * @returns Map of group_id to custom rate_multiplier
+ * Get current user's effective group rate multipliers
+ * @returns Map of group_id to the billed rate_multiplier (custom or group default, with the volume tier discount applied)
  */
 export async function getUserGroupRates(): Promise<Record<number, number>> {
-  const { data } = await apiClient.get<Record<number, number> | null>('/groups/rates')
-  return data || {}
+  const { data } = await apiClient.get<UserGroupRatesResponse | null>('/groups/rates')
+  return data?.rates || {}
+}
+
+/**
+ * Get current user's monthly volume tier progress in tiered groups
+ * @returns Spend, current tier, next tier and effective rate per group that has volume tiers
+ */
+export async function getVolumeTiers(): Promise<VolumeTierProgress[]> {
+  const { data } = await apiClient.get<UserGroupRatesResponse | null>('/groups/rates')
+  return data?.volume_tiers || []
 }
 
 export const userGroupsAPI = {
   getAvailable,
-  getUserGroupRates
+  getUserGroupRates,
+  getVolumeTiers
 }
 
 export default userGroupsAPI
@@ -11,6 +11,10 @@ import type {
   CheckoutInfoResponse,
   CreateOrderRequest,
   CreateOrderResult,
+  CouponPreviewRequest,
+  CouponPreview,
+  Invoice,
+  InvoiceFormat,
   PaymentOrder
 } from '@/types/payment'
 import type { BasePaginationResponse } from '@/types'
@@ -40,6 +44,22 @@ export const paymentAPI = {
     return apiClient.post<CreateOrderResult>('/payment/orders', data)
   },
 
+  previewCoupon(data: CouponPreviewRequest) {
+    return apiClient.post<CouponPreview>('/payment/coupons/preview', data)
+  },
+
+  getMyInvoices(params?: { page?: number; page_size?: number; kind?: string }) {
+    return apiClient.get<BasePaginationResponse<Invoice>>('/payment/invoices', { params })
+  },
+
+  async downloadInvoice(id: number, format: InvoiceFormat = 'pdf'): Promise<Blob> {
+    const response = await apiClient.get(`/payment/invoices/${id}/download`, {
+      params: { format },
+      responseType: 'blob'
+    })
+    return response.data
+  },
+
   getMyOrders(params?: { page?: number; page_size?: number; status?: string }) {
     return apiClient.get<BasePaginationResponse<PaymentOrder>>('/payment/orders/my', { params })
   },
@@ -0,0 +1,25 @@
+import { apiClient } from './client'
+import type { BillingStatement, PaginatedResponse, PostpaidAccount } from '@/types'
+
+export interface BillingStatementQuery {
+  page?: number
+  page_size?: number
+  status?: string
+}
+
+export async function getAccount(): Promise<PostpaidAccount> {
+  const { data } = await apiClient.get<PostpaidAccount>('/user/postpaid')
+  return data
+}
+
+export async function getStatements(params: BillingStatementQuery = {}): Promise<PaginatedResponse<BillingStatement>> {
+  const { data } = await apiClient.get<PaginatedResponse<BillingStatement>>('/user/postpaid/statements', { params })
+  return data
+}
+
+export const postpaidAPI = {
+  getAccount,
+  getStatements,
+}
+
+export default postpaidAPI
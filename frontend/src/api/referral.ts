@@ -1,4 +1,13 @@
-import type { ReferralInfo, ReferralReward, ReferralSettings, BasePaginationResponse } from '@/types'
+import type {
+  ReferralInfo,
+  ReferralReward,
+  ReferralSettings,
+  BasePaginationResponse,
+  CommissionSummary,
+  CommissionWithdrawal,
+  ReferralCommission,
+  WithdrawalPayoutMethod
+} from '@/types'
 import { apiClient } from './client'
 
 export const referralAPI = {
@@ -16,6 +25,45 @@ export const referralAPI = {
     return data
   },
 
+  // Get commission account summary (withdrawable / held / withdrawn)
+  async getCommissionSummary(): Promise<CommissionSummary> {
+    const { data } = await apiClient.get('/referral/commission')
+    return data
+  },
+
+  // Get commission ledger
+  async getCommissions(page = 1, pageSize = 20): Promise<BasePaginationResponse<ReferralCommission>> {
+    const { data } = await apiClient.get('/referral/commissions', {
+      params: { page, page_size: pageSize }
+    })
+    return data
+  },
+
+  // Get withdrawal requests
+  async getWithdrawals(page = 1, pageSize = 20): Promise<BasePaginationResponse<CommissionWithdrawal>> {
+    const { data } = await apiClient.get('/referral/withdrawals', {
+      params: { page, page_size: pageSize }
+    })
+    return data
+  },
+
+  // Request a commission withdrawal
+  async createWithdrawal(payload: {
+    amount: number
+    payout_method: WithdrawalPayoutMethod
+    payout_account: string
+    payout_name?: string
+  }): Promise<CommissionWithdrawal> {
+    const { data } = await apiClient.post('/referral/withdrawals', payload)
+    return data
+  },
+
+  // Cancel a pending withdrawal request
+  async cancelWithdrawal(id: number): Promise<CommissionWithdrawal> {
+    const { data } = await apiClient.post(`/referral/withdrawals/${id}/cancel`)
+    return data
+  },
+
   // Get public referral settings
   async getReferralSettings(): Promise<ReferralSettings> {
     const { data } = await apiClient.get('/referral/settings')
@@ -5,6 +5,7 @@
 
 import { apiClient } from './client'
 import type { UserSubscription, SubscriptionProgress } from '@/types'
+import type { SubscriptionAutoRenewal } from '@/types/payment'
 
 /**
  * Subscription summary for user dashboard
@@ -67,10 +68,27 @@ export async function getSubscriptionProgress(
   return response.data
 }
 
+/**
+ * Get current user's subscription auto-renewals
+ */
+export async function getAutoRenewals(): Promise<SubscriptionAutoRenewal[]> {
+  const response = await apiClient.get<SubscriptionAutoRenewal[]>('/subscriptions/auto-renewals')
+  return response.data
+}
+
+/**
+ * Turn off auto-renew; the subscription time already paid for is kept
+ */
+export async function cancelAutoRenewal(id: number): Promise<void> {
+  await apiClient.post(`/subscriptions/auto-renewals/${id}/cancel`)
+}
+
 export default {
   getMySubscriptions,
   getActiveSubscriptions,
   getSubscriptionsProgress,
   getSubscriptionSummary,
-  getSubscriptionProgress
+  getSubscriptionProgress,
+  getAutoRenewals,
+  cancelAutoRenewal
 }
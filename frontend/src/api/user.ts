@@ -4,7 +4,7 @@
  */
 
 import { apiClient } from './client'
-import type { User, ChangePasswordRequest } from '@/types'
+import type { User, ChangePasswordRequest, BalanceBucket, PaginatedResponse } from '@/types'
 
 /**
  * Get current user profile
@@ -77,6 +77,22 @@ export async function toggleNotifyEmail(email: string, disabled: boolean): Promi
   return data
 }
 
+/**
+ * List current user's balance buckets (credits making up the balance)
+ * @param params - Pagination and optional status filter (active, exhausted, expired, refunded)
+ * @returns Paginated balance buckets, newest first
+ */
+export async function getBalanceBuckets(params: {
+  page?: number
+  page_size?: number
+  status?: string
+} = {}): Promise<PaginatedResponse<BalanceBucket>> {
+  const { data } = await apiClient.get<PaginatedResponse<BalanceBucket>>('/user/balance-buckets', {
+    params
+  })
+  return data
+}
+
 export const userAPI = {
   getProfile,
   updateProfile,
@@ -84,7 +100,8 @@ export const userAPI = {
   sendNotifyEmailCode,
   verifyNotifyEmail,
   removeNotifyEmail,
-  toggleNotifyEmail
+  toggleNotifyEmail,
+  getBalanceBuckets
 }
 
 export default userAPI
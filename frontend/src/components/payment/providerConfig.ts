@@ -31,13 +31,15 @@ export const PROVIDER_SUPPORTED_TYPES: Record<string, string[]> = {
   alipay: ['alipay'],
   wxpay: ['wxpay'],
   stripe: ['card', 'alipay', 'wxpay', 'link'],
+  paypal: ['paypal'],
+  usdt: ['usdt'],
 }
 
 /** Available payment modes for EasyPay providers. */
 export const EASYPAY_PAYMENT_MODES = ['qrcode', 'popup'] as const
 
 /** Fixed display order for user-facing payment methods */
-export const METHOD_ORDER = ['alipay', 'alipay_direct', 'wxpay', 'wxpay_direct', 'stripe'] as const
+export const METHOD_ORDER = ['alipay', 'alipay_direct', 'wxpay', 'wxpay_direct', 'stripe', 'paypal', 'usdt'] as const
 
 /** Payment mode constants */
 export const PAYMENT_MODE_QRCODE = 'qrcode'
@@ -55,6 +57,7 @@ export const WEBHOOK_PATHS: Record<string, string> = {
   alipay: '/api/v1/payment/webhook/alipay',
   wxpay: '/api/v1/payment/webhook/wxpay',
   stripe: '/api/v1/payment/webhook/stripe',
+  paypal: '/api/v1/payment/webhook/paypal',
 }
 
 export const RETURN_PATH = '/payment/result'
@@ -64,6 +67,8 @@ export const PROVIDER_CALLBACK_PATHS: Record<string, CallbackPaths> = {
   easypay: { notifyUrl: WEBHOOK_PATHS.easypay, returnUrl: RETURN_PATH },
   alipay: { notifyUrl: WEBHOOK_PATHS.alipay, returnUrl: RETURN_PATH },
   wxpay: { notifyUrl: WEBHOOK_PATHS.wxpay },
+  // paypal: webhook is registered in the PayPal developer dashboard; only the return page is configured here
+  paypal: { returnUrl: RETURN_PATH },
   // stripe: no callback URL config needed (webhook is separate)
 }
 
@@ -95,6 +100,26 @@ export const PROVIDER_CONFIG_FIELDS: Record<string, ConfigFieldDef[]> = {
     { key: 'publishableKey', label: '', sensitive: false },
     { key: 'webhookSecret', label: '', sensitive: true },
   ],
+  paypal: [
+    { key: 'clientId', label: 'Client ID', sensitive: false },
+    { key: 'clientSecret', label: 'Client Secret', sensitive: true },
+    { key: 'webhookId', label: 'Webhook ID', sensitive: false },
+    { key: 'sandbox', label: 'Sandbox (true/false)', sensitive: false, optional: true, defaultValue: 'false' },
+    { key: 'currency', label: 'Currency', sensitive: false, optional: true, defaultValue: 'CNY' },
+    { key: 'exchangeRate', label: 'Exchange Rate (per 1 CNY)', sensitive: false, optional: true },
+  ],
+  // usdt: no webhook; pending orders are confirmed by polling the chain API
+  usdt: [
+    { key: 'network', label: 'Network (tron/evm)', sensitive: false, defaultValue: 'tron' },
+    { key: 'address', label: 'Receiving Address', sensitive: false },
+    { key: 'exchangeRate', label: 'Exchange Rate (CNY per 1 USDT)', sensitive: false },
+    { key: 'apiBase', label: 'TronGrid / RPC URL', sensitive: false, optional: true },
+    { key: 'apiKey', label: 'API Key', sensitive: true, optional: true },
+    { key: 'contract', label: 'Token Contract', sensitive: false, optional: true },
+    { key: 'decimals', label: 'Token Decimals', sensitive: false, optional: true, defaultValue: '6' },
+    { key: 'confirmations', label: 'Required Confirmations', sensitive: false, optional: true },
+    { key: 'amountTolerance', label: 'Amount Tolerance (USDT)', sensitive: false, optional: true, defaultValue: '0' },
+  ],
 }
 
 // --- Helpers ---
@@ -817,6 +817,7 @@ export default {
       inactive: 'Inactive',
       quota_exhausted: 'Quota Exhausted',
       expired: 'Expired',
+      suspended: 'Suspended (statement overdue)',
     },
   },
 
@@ -1839,6 +1840,19 @@ export default {
       failedToLoadBalanceHistory: 'Failed to load balance history',
       createdAt: 'Created',
       totalRecharged: 'Total Recharged',
+      balanceBuckets: {
+        title: 'Active Balance Buckets',
+        expiresAt: 'Expires {date}',
+        neverExpires: 'Never expires',
+        sources: {
+          paid: 'Paid',
+          redeem: 'Redeem Code',
+          promo: 'Promo',
+          referral: 'Referral',
+          admin: 'Admin',
+          legacy: 'Legacy'
+        }
+      },
       roles: {
         admin: 'Admin',
         user: 'User'
@@ -4717,6 +4731,8 @@ export default {
         providerAlipay: 'Alipay (Direct)',
         providerWxpay: 'WeChat Pay (Direct)',
         providerStripe: 'Stripe',
+        providerPaypal: 'PayPal',
+        providerUsdt: 'USDT (TRON / EVM)',
         typeDisabled: 'type disabled',
         enableTypesFirst: 'Enable at least one payment type above first',
         easypayRedirect: 'Redirect',
@@ -5134,6 +5150,43 @@ export default {
       'The administrator enabled the entry but has not configured a recharge/subscription URL. Please contact admin.'
   },
 
+  referral: {
+    commission: {
+      title: 'Commission Account',
+      hint: 'Commission is held for {days} days before it can be withdrawn; refunds during the holding period are deducted. Minimum withdrawal: ${min}.',
+      available: 'Withdrawable',
+      held: 'On Hold',
+      withdrawing: 'Processing',
+      withdrawn: 'Paid Out',
+      clawedBack: '${amount} was reversed because the related orders were refunded',
+      withdraw: 'Withdraw',
+      withdrawals: 'Withdrawal Requests',
+      amount: 'Amount',
+      amountHint: 'Withdrawable: ${available}, minimum: ${min}',
+      payoutMethod: 'Payout Method',
+      payoutAccount: 'Payout Account',
+      payoutName: 'Account Holder Name',
+      status: 'Status',
+      submit: 'Submit Request',
+      submitted: 'Withdrawal request submitted',
+      cancelled: 'Withdrawal request cancelled',
+      methods: {
+        alipay: 'Alipay',
+        wechat: 'WeChat Pay',
+        bank: 'Bank Transfer',
+        usdt: 'USDT',
+        paypal: 'PayPal'
+      },
+      statuses: {
+        pending: 'Pending Review',
+        approved: 'Approved',
+        rejected: 'Rejected',
+        paid: 'Paid',
+        cancelled: 'Cancelled'
+      }
+    }
+  },
+
   wallet: {
     title: 'Wallet',
     description: 'Check balance, create recharge orders, and track payment status',
@@ -5180,7 +5233,48 @@ export default {
     createOrderFailed: 'Failed to create payment order',
     paymentSuccess: 'Payment successful. Wallet balance has been updated.',
     syncOrderFailed: 'Failed to sync order',
-    paymentCodeCopied: 'Payment link copied'
+    paymentCodeCopied: 'Payment link copied',
+    volumeTiers: {
+      title: 'Volume Discounts',
+      description: 'The more you spend in a group this month, the lower its rate multiplier. Discounts reset at the start of each month.',
+      monthSpend: 'Spent in {month}: {spend}',
+      effectiveRate: 'Billed rate: ×{rate}',
+      nextTier: 'Spend {amount} more to unlock ×{multiplier}',
+      topTier: 'You have reached the top tier'
+    },
+    buckets: {
+      title: 'Balance Breakdown',
+      description: 'Bonus credits are spent before paid balance, and expire on their expiry date. Refunds only return paid balance.',
+      source: 'Source',
+      remaining: 'Remaining',
+      amount: 'Credited',
+      expiresAt: 'Expires At',
+      neverExpires: 'Never expires',
+      sources: {
+        paid: 'Paid Recharge',
+        redeem: 'Redeem Code',
+        promo: 'Promo Bonus',
+        referral: 'Referral Reward',
+        admin: 'Admin Credit',
+        legacy: 'Existing Balance'
+      }
+    },
+    postpaid: {
+      title: 'Postpaid Statements',
+      description: 'Credit limit {limit}, payment due {days} days after each monthly statement',
+      active: 'Active',
+      suspended: 'Suspended (statement overdue)',
+      empty: 'No statements issued yet',
+      period: 'Billing Period',
+      amount: 'Amount',
+      requests: 'Requests',
+      dueAt: 'Due At (UTC+8)',
+      status: {
+        unpaid: 'Unpaid',
+        overdue: 'Overdue',
+        paid: 'Paid'
+      }
+    }
   },
 
   // Custom Page (iframe embed)
@@ -5228,7 +5322,22 @@ export default {
     status: {
       active: 'Active',
       expired: 'Expired',
-      revoked: 'Revoked'
+      revoked: 'Revoked',
+      suspended: 'Suspended'
+    },
+    autoRenew: {
+      status: {
+        active: 'Auto-renew on',
+        past_due: 'Renewal payment failed',
+        canceled: 'Auto-renew off'
+      },
+      nextRenewal: 'Next renewal {date}',
+      turnOff: 'Turn off auto-renew',
+      turnOffTitle: 'Turn Off Auto-Renew',
+      turnOffConfirm:
+        'No further renewals will be charged. Your subscription stays valid until its current expiry date.',
+      turnedOff: 'Auto-renew turned off',
+      turnOffFailed: 'Failed to turn off auto-renew'
     },
     usage: 'Usage',
     expires: 'Expires',
@@ -5257,11 +5366,42 @@ export default {
     fee: 'Fee',
     actualPay: 'Actual Payment',
     createOrder: 'Confirm Payment',
+    autoRenew: {
+      label: 'Auto-renew',
+      hint: 'Charge the saved card automatically at the end of each period. You can turn it off anytime in My Subscriptions.'
+    },
+    coupon: {
+      label: 'Coupon',
+      placeholder: 'Enter coupon code',
+      apply: 'Apply',
+      remove: 'Remove',
+      applied: '{code} applied, ¥{amount} off',
+      discount: 'Coupon discount',
+      invalid: 'This coupon cannot be used'
+    },
+    invoices: {
+      title: 'Invoices',
+      viewInvoices: 'Invoices',
+      number: 'Number',
+      kind: 'Type',
+      kindInvoice: 'Invoice',
+      kindCreditNote: 'Credit note',
+      order: 'Order',
+      total: 'Total',
+      issuedAt: 'Issued',
+      downloadPdf: 'PDF',
+      downloadHtml: 'HTML',
+      empty: 'No invoices yet. Invoices are issued automatically after a payment completes.',
+      companyHint: 'To show your company name, tax ID and address on invoices, fill in the matching fields in your profile before paying.',
+      downloadFailed: 'Failed to download invoice'
+    },
     methods: {
       easypay: 'EasyPay',
       alipay: 'Alipay',
       wxpay: 'WeChat Pay',
       stripe: 'Stripe',
+      paypal: 'PayPal',
+      usdt: 'USDT',
       card: 'Card',
       link: 'Link',
       alipay_direct: 'Alipay (Direct)',
@@ -5287,6 +5427,8 @@ export default {
       scanWxpay: 'WeChat QR Payment',
       scanAlipayHint: 'Open Alipay on your phone and scan the QR code to pay',
       scanWxpayHint: 'Open WeChat on your phone and scan the QR code to pay',
+      scanUsdt: 'USDT Transfer',
+      scanUsdtHint: 'Send exactly {amount} USDT ({asset}) to {address}. The order completes automatically after enough on-chain confirmations.',
       payInNewWindow: 'Complete Payment in New Window',
       payInNewWindowHint: 'The payment page has opened in a new window. Please complete the payment there and return to this page.',
       openPayWindow: 'Reopen Payment Page',
@@ -821,7 +821,8 @@ export default {
       active: '活跃',
       inactive: '已停用',
       quota_exhausted: '额度耗尽',
-      expired: '已过期'
+      expired: '已过期',
+      suspended: '已暂停（账单逾期）'
     }
   },
 
@@ -1899,6 +1900,19 @@ export default {
       failedToLoadBalanceHistory: '加载余额记录失败',
       createdAt: '创建时间',
       totalRecharged: '总充值',
+      balanceBuckets: {
+        title: '可用余额构成',
+        expiresAt: '{date} 到期',
+        neverExpires: '永不过期',
+        sources: {
+          paid: '付费充值',
+          redeem: '兑换码',
+          promo: '优惠码赠送',
+          referral: '邀请奖励',
+          admin: '管理员调整',
+          legacy: '历史余额'
+        }
+      },
       // Settings Dropdowns
       filterSettings: '筛选设置',
       columnSettings: '列设置',
@@ -4880,6 +4894,8 @@ export default {
         providerAlipay: '支付宝官方',
         providerWxpay: '微信官方',
         providerStripe: 'Stripe',
+        providerPaypal: 'PayPal',
+        providerUsdt: 'USDT（TRON / EVM）',
         typeDisabled: '类型已禁用',
         enableTypesFirst: '请先在上方启用至少一种服务商',
         easypayRedirect: '跳转',
@@ -5293,6 +5309,43 @@ export default {
     notConfiguredDesc: '管理员已开启入口，但尚未配置充值/订阅链接，请联系管理员。'
   },
 
+  referral: {
+    commission: {
+      title: '佣金账户',
+      hint: '佣金需冻结 {days} 天后方可提现，冻结期内订单退款将扣回对应佣金。最低提现金额 ${min}。',
+      available: '可提现',
+      held: '冻结中',
+      withdrawing: '提现中',
+      withdrawn: '已打款',
+      clawedBack: '因关联订单退款已扣回 ${amount}',
+      withdraw: '申请提现',
+      withdrawals: '提现记录',
+      amount: '金额',
+      amountHint: '可提现 ${available}，最低 ${min}',
+      payoutMethod: '收款方式',
+      payoutAccount: '收款账号',
+      payoutName: '收款人姓名',
+      status: '状态',
+      submit: '提交申请',
+      submitted: '提现申请已提交',
+      cancelled: '提现申请已撤销',
+      methods: {
+        alipay: '支付宝',
+        wechat: '微信',
+        bank: '银行卡',
+        usdt: 'USDT',
+        paypal: 'PayPal'
+      },
+      statuses: {
+        pending: '待审核',
+        approved: '待打款',
+        rejected: '已驳回',
+        paid: '已打款',
+        cancelled: '已撤销'
+      }
+    }
+  },
+
   wallet: {
     title: '钱包',
     description: '查看余额、创建充值订单并跟踪到账状态',
@@ -5339,7 +5392,48 @@ export default {
     createOrderFailed: '创建支付订单失败',
     paymentSuccess: '支付成功，钱包余额已更新',
     syncOrderFailed: '同步订单失败',
-    paymentCodeCopied: '支付链接已复制'
+    paymentCodeCopied: '支付链接已复制',
+    volumeTiers: {
+      title: '用量阶梯折扣',
+      description: '当月在分组内消费越多，该分组倍率越低；每月初重新累计。',
+      monthSpend: '{month} 已消费 {spend}',
+      effectiveRate: '当前计费倍率 ×{rate}',
+      nextTier: '再消费 {amount} 即可享受 ×{multiplier}',
+      topTier: '已达到最高阶梯'
+    },
+    buckets: {
+      title: '余额构成',
+      description: '赠送额度优先于付费余额扣费，并在到期后失效；退款仅退还付费余额。',
+      source: '来源',
+      remaining: '剩余',
+      amount: '入账金额',
+      expiresAt: '到期时间',
+      neverExpires: '永不过期',
+      sources: {
+        paid: '付费充值',
+        redeem: '兑换码',
+        promo: '优惠码赠送',
+        referral: '邀请奖励',
+        admin: '管理员赠送',
+        legacy: '历史余额'
+      }
+    },
+    postpaid: {
+      title: '后付费账单',
+      description: '信用额度 {limit}，每月出账后 {days} 天内付款',
+      active: '正常',
+      suspended: '已暂停（账单逾期）',
+      empty: '暂无账单',
+      period: '账期',
+      amount: '金额',
+      requests: '请求数',
+      dueAt: '到期时间（北京时间）',
+      status: {
+        unpaid: '待付款',
+        overdue: '已逾期',
+        paid: '已结清'
+      }
+    }
   },
 
   // Custom Page (iframe embed)
@@ -5386,7 +5480,21 @@ export default {
     status: {
       active: '有效',
       expired: '已过期',
-      revoked: '已撤销'
+      revoked: '已撤销',
+      suspended: '已暂停'
+    },
+    autoRenew: {
+      status: {
+        active: '自动续费已开启',
+        past_due: '续费扣款失败',
+        canceled: '自动续费已关闭'
+      },
+      nextRenewal: '下次续费 {date}',
+      turnOff: '关闭自动续费',
+      turnOffTitle: '关闭自动续费',
+      turnOffConfirm: '关闭后将不再自动扣款，当前订阅在到期前仍然有效。',
+      turnedOff: '已关闭自动续费',
+      turnOffFailed: '关闭自动续费失败'
     },
     usage: '用量',
     expires: '到期时间',
@@ -5415,11 +5523,42 @@ export default {
     fee: '手续费',
     actualPay: '实付金额',
     createOrder: '确认支付',
+    autoRenew: {
+      label: '自动续费',
+      hint: '每个周期结束时自动从已保存的银行卡扣款续期，可随时在「我的订阅」中关闭。'
+    },
+    coupon: {
+      label: '优惠券',
+      placeholder: '输入优惠券码',
+      apply: '使用',
+      remove: '移除',
+      applied: '已使用 {code}，减免 ¥{amount}',
+      discount: '优惠券减免',
+      invalid: '该优惠券无法使用'
+    },
+    invoices: {
+      title: '发票',
+      viewInvoices: '发票',
+      number: '编号',
+      kind: '类型',
+      kindInvoice: '发票',
+      kindCreditNote: '红字发票',
+      order: '订单',
+      total: '金额',
+      issuedAt: '开具时间',
+      downloadPdf: 'PDF',
+      downloadHtml: 'HTML',
+      empty: '暂无发票。支付完成后会自动开具发票。',
+      companyHint: '如需在发票上显示公司名称、税号和地址，请在支付前于个人资料中填写对应字段。',
+      downloadFailed: '下载发票失败'
+    },
     methods: {
       easypay: '易支付',
       alipay: '支付宝',
       wxpay: '微信支付',
       stripe: 'Stripe',
+      paypal: 'PayPal',
+      usdt: 'USDT',
       card: '银行卡',
       link: 'Link',
       alipay_direct: '支付宝（直连）',
@@ -5445,6 +5584,8 @@ export default {
       scanWxpay: '微信扫码支付',
       scanAlipayHint: '请使用手机打开支付宝，扫描二维码完成支付',
       scanWxpayHint: '请使用手机打开微信，扫描二维码完成支付',
+      scanUsdt: 'USDT 转账支付',
+      scanUsdtHint: '请向 {address} 转账 {amount} USDT（{asset}），金额须完全一致，链上确认足够后订单自动完成',
       payInNewWindow: '请在新窗口中完成支付',
       payInNewWindowHint: '支付页面已在新窗口打开，请在新窗口中完成支付后返回此页面',
       openPayWindow: '重新打开支付页面',
@@ -236,6 +236,18 @@ const routes: RouteRecordRaw[] = [
       requiresPayment: true
     }
   },
+  {
+    path: '/invoices',
+    name: 'InvoiceList',
+    component: () => import('@/views/user/UserInvoicesView.vue'),
+    meta: {
+      requiresAuth: true,
+      requiresAdmin: false,
+      title: 'Invoices',
+      titleKey: 'payment.invoices.title',
+      requiresPayment: true
+    }
+  },
   {
     path: '/payment/qrcode',
     name: 'PaymentQRCode',
@@ -209,6 +209,178 @@ export interface ReferralReward {
   created_at: string
 }
 
+export interface CommissionSummary {
+  enabled: boolean
+  min_withdraw_amount: number
+  holding_days: number
+  total: number
+  held: number
+  withdrawing: number
+  withdrawn: number
+  clawed_back: number
+  available: number
+}
+
+export interface ReferralCommission {
+  id: number
+  referrer_id: number
+  referee_id: number
+  source_type: string
+  source_id: number
+  source_amount?: number
+  amount: number
+  clawed_back_amount?: number
+  status: 'active' | 'clawed_back'
+  available_at: string
+  clawed_back_at?: string
+  clawback_reason?: string
+  created_at: string
+}
+
+export type WithdrawalStatus = 'pending' | 'approved' | 'rejected' | 'paid' | 'cancelled'
+export type WithdrawalPayoutMethod = 'alipay' | 'wechat' | 'bank' | 'usdt' | 'paypal'
+
+export interface CommissionWithdrawal {
+  id: number
+  user_id: number
+  amount: number
+  payout_method: WithdrawalPayoutMethod
+  payout_account: string
+  payout_name?: string
+  status: WithdrawalStatus
+  reviewer_id?: number
+  review_note?: string
+  payout_reference?: string
+  reviewed_at?: string
+  paid_at?: string
+  created_at: string
+  updated_at: string
+}
+
+export interface CommissionWithdrawalLog {
+  id: number
+  withdrawal_id: number
+  action: 'create' | 'cancel' | 'approve' | 'reject' | 'pay'
+  from_status?: string
+  to_status: string
+  operator_id: number
+  operator_role: 'user' | 'admin'
+  note?: string
+  created_at: string
+}
+
+export type BillingRecomputeStatus = 'pending' | 'running' | 'succeeded' | 'failed' | 'applying' | 'applied'
+
+/** Candidate prices in USD per million tokens; omitted fields keep the current price. */
+export interface CandidateModelPricing {
+  input_per_mtok?: number
+  output_per_mtok?: number
+  cache_creation_per_mtok?: number
+  cache_creation_1h_per_mtok?: number
+  cache_read_per_mtok?: number
+}
+
+export interface BillingRecomputeParams {
+  start_time: string
+  end_time: string
+  user_id?: number
+  group_id?: number
+  model?: string
+  pricing?: Record<string, CandidateModelPricing>
+  rate_multiplier?: number
+  group_rate_multipliers?: Record<number, number>
+}
+
+export interface BillingRecomputeLine {
+  id?: number
+  key?: string
+  requests: number
+  changed_rows: number
+  original_cost: number
+  recomputed_cost: number
+  diff: number
+  balance_rows: number
+  balance_diff: number
+}
+
+export interface BillingRecomputeReport {
+  scanned_rows: number
+  changed_rows: number
+  skipped_rows: number
+  original_cost: number
+  recomputed_cost: number
+  diff: number
+  balance_diff: number
+  users: BillingRecomputeLine[]
+  groups: BillingRecomputeLine[]
+  models: BillingRecomputeLine[]
+  missing_pricing_models?: string[]
+}
+
+export interface BillingRecomputeJob {
+  id: number
+  status: BillingRecomputeStatus
+  mode: 'current' | 'candidate'
+  params: BillingRecomputeParams
+  report?: BillingRecomputeReport
+  scanned_rows?: number
+  changed_rows?: number
+  original_cost?: number
+  recomputed_cost?: number
+  balance_diff?: number
+  error_message?: string
+  created_by: number
+  applied_by?: number
+  started_at?: string
+  finished_at?: string
+  applied_at?: string
+  created_at: string
+  updated_at: string
+}
+
+export interface BillingAdjustment {
+  id: number
+  job_id: number
+  user_id: number
+  amount: number
+  balance_before: number
+  balance_after: number
+  usage_rows?: number
+  operator_id: number
+  note?: string
+  created_at: string
+}
+
+export interface PostpaidAccount {
+  id: number
+  user_id: number
+  credit_limit?: number
+  payment_terms_days?: number
+  status: 'active' | 'suspended'
+  billing_start: string
+  suspended_at?: string
+  notes?: string
+  created_at: string
+  updated_at: string
+}
+
+export interface BillingStatement {
+  id: number
+  user_id: number
+  period_start: string
+  period_end: string
+  amount?: number
+  request_count?: number
+  status: 'unpaid' | 'overdue' | 'paid'
+  due_at: string
+  reminder_sent_at?: string
+  overdue_at?: string
+  paid_at?: string
+  payment_note?: string
+  created_at: string
+  updated_at: string
+}
+
 export interface PaygOrder {
   id: number
   user_id: number
@@ -426,6 +598,41 @@ export interface PaginatedResponse<T> {
   pages: number
 }
 
+// Balance bucket: one credit making up part of the user's balance
+export type BalanceBucketSource = 'paid' | 'redeem' | 'promo' | 'referral' | 'admin' | 'legacy'
+export type BalanceBucketStatus = 'active' | 'exhausted' | 'expired' | 'refunded'
+
+// Volume tier: discount multiplier applied once monthly spend in a group reaches min_spend
+export interface VolumeTier {
+  min_spend: number
+  multiplier: number
+}
+
+export interface VolumeTierProgress {
+  group_id: number
+  group_name: string
+  month: string
+  spend: number
+  tiers: VolumeTier[]
+  current_tier?: VolumeTier
+  next_tier?: VolumeTier
+  remaining_spend: number
+  rate_multiplier: number
+}
+
+export interface BalanceBucket {
+  id: number
+  source: BalanceBucketSource
+  source_ref?: string
+  amount: number
+  remaining: number
+  priority: number
+  status: BalanceBucketStatus
+  expires_at?: string
+  expired_amount: number
+  created_at: string
+}
+
 // ==================== UI State Types ====================
 
 export type ToastType = 'success' | 'error' | 'info' | 'warning'
@@ -549,7 +756,7 @@ export interface ApiKey {
   key: string
   name: string
   group_id: number | null
-  status: 'active' | 'inactive' | 'quota_exhausted' | 'expired'
+  status: 'active' | 'inactive' | 'quota_exhausted' | 'expired' | 'suspended'
   ip_whitelist: string[]
   ip_blacklist: string[]
   last_used_at: string | null
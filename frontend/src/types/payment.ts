@@ -23,6 +23,8 @@ export type PaymentType =
   | 'wxpay_direct'
   | 'stripe'
   | 'easypay'
+  | 'paypal'
+  | 'usdt'
 
 export type OrderType = 'balance' | 'subscription'
 
@@ -91,6 +93,8 @@ export interface PaymentOrder {
   refund_request_reason?: string
   plan_id?: number
   provider_instance_id?: string
+  coupon_code?: string
+  discount_amount?: number
 }
 
 export interface SubscriptionPlan {
@@ -112,6 +116,7 @@ export interface SubscriptionPlan {
   features: string[]
   for_sale: boolean
   sort_order: number
+  auto_renew_available?: boolean
 }
 
 export interface PaymentChannel {
@@ -145,6 +150,8 @@ export interface CreateOrderRequest {
   payment_type: string
   order_type: string
   plan_id?: number
+  auto_renew?: boolean
+  coupon_code?: string
 }
 
 export interface CreateOrderResult {
@@ -157,6 +164,80 @@ export interface CreateOrderResult {
   fee_rate: number
   expires_at: string
   payment_mode?: string
+  crypto_amount?: string
+  crypto_asset?: string
+  auto_renew?: boolean
+  coupon_code?: string
+  discount_amount?: number
+}
+
+export interface CouponPreviewRequest {
+  code: string
+  amount?: number
+  order_type: string
+  plan_id?: number
+}
+
+export interface CouponPreview {
+  code: string
+  name: string
+  original_amount: number
+  discount_amount: number
+  pay_amount: number
+}
+
+export type InvoiceKind = 'invoice' | 'credit_note'
+
+export interface InvoiceLine {
+  description: string
+  quantity: number
+  unit_price: number
+  amount: number
+}
+
+/** Invoice or credit note. Zero-valued fields may be omitted by the API. */
+export interface Invoice {
+  id: number
+  invoice_no: string
+  kind: InvoiceKind
+  user_id: number
+  source_type: 'payment_order' | 'payg_order'
+  source_id: number
+  source_no?: string
+  original_invoice_id?: number
+  currency: string
+  subtotal?: number
+  discount_amount?: number
+  fee_amount?: number
+  total?: number
+  lines: InvoiceLine[]
+  payment_method?: string
+  buyer_email?: string
+  buyer_name?: string
+  buyer_tax_id?: string
+  buyer_address?: string
+  seller_name?: string
+  notes?: string
+  issued_at: string
+  emailed_at?: string
+}
+
+export type InvoiceFormat = 'pdf' | 'html'
+
+export type AutoRenewalStatus = 'active' | 'past_due' | 'canceled'
+
+export interface SubscriptionAutoRenewal {
+  id: number
+  user_id: number
+  plan_id: number
+  group_id: number
+  subscription_days: number
+  amount: number
+  payment_type: string
+  status: AutoRenewalStatus
+  current_period_end?: string
+  canceled_at?: string
+  created_at: string
 }
 
 export interface DashboardStats {
@@ -1,10 +1,11 @@
 import { describe, expect, it, vi } from 'vitest'
 import { mount, flushPromises } from '@vue/test-utils'
 
-const { getReferralInfoMock, getReferralSettingsMock, getReferralRewardsMock } = vi.hoisted(() => ({
+const { getReferralInfoMock, getReferralSettingsMock, getReferralRewardsMock, getCommissionSummaryMock } = vi.hoisted(() => ({
   getReferralInfoMock: vi.fn(),
   getReferralSettingsMock: vi.fn(),
-  getReferralRewardsMock: vi.fn()
+  getReferralRewardsMock: vi.fn(),
+  getCommissionSummaryMock: vi.fn()
 }))
 
 vi.stubGlobal('localStorage', {
@@ -28,7 +29,8 @@ vi.mock('@/api/referral', () => ({
   referralAPI: {
     getReferralInfo: getReferralInfoMock,
     getReferralSettings: getReferralSettingsMock,
-    getReferralRewards: getReferralRewardsMock
+    getReferralRewards: getReferralRewardsMock,
+    getCommissionSummary: getCommissionSummaryMock
   }
 }))
 